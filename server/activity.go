@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"strconv"
 	"sync"
 	"time"
 
@@ -14,14 +15,36 @@ import (
 	proto "github.com/liftbridge-io/liftbridge/server/protocol"
 )
 
-const maxActivityPublishBackoff = 10 * time.Second
+const (
+	maxActivityPublishBackoff = 10 * time.Second
+
+	// activityEpochHeader is set on every activity stream message to the
+	// event's activity epoch. Epochs start at 1 and increase by one with each
+	// event published, so consumers can use them to detect gaps.
+	activityEpochHeader = "liftbridge-activity-epoch"
+
+	// activityOpHeader is set on every activity stream message to the name of
+	// the operation which caused the event, e.g. CREATE_STREAM. Partition
+	// events are identified by this header since they're not encoded as
+	// ActivityStreamEvents.
+	activityOpHeader = "liftbridge-activity-op"
+
+	// activityStreamHeader is set on stream creation events to the created
+	// stream, including its config and partition assignment, encoded as an
+	// internal Stream protobuf.
+	activityStreamHeader = "liftbridge-activity-stream"
+)
 
 // activityManager ensures that activity events get published to the activity
-// stream. This ensures that events are published at least once and in the
-// order in which they occur with respect to the Raft log.
+// stream. This ensures that events are published in the order in which they
+// occur with respect to the Raft log. Events are published as an idempotent
+// producer whose sequence numbers are derived from the activity epoch, so an
+// event republished after a failover is discarded by the activity stream
+// rather than written twice.
 type activityManager struct {
 	*Server
 	lastPublishedRaftIndex uint64
+	lastPublishedEpoch     uint64
 	commitCh               chan struct{}
 	leadershipLostCh       chan struct{}
 	mu                     sync.RWMutex
@@ -34,22 +57,23 @@ func newActivityManager(s *Server) *activityManager {
 	}
 }
 
-// SetLastPublishedRaftIndex sets the Raft index of the latest event published
-// to the activity stream. This is used to determine where to begin publishing
-// events from in the log in the case of failovers or restarts.
-func (a *activityManager) SetLastPublishedRaftIndex(index uint64) {
+// SetLastPublished sets the Raft index and activity epoch of the latest event
+// published to the activity stream. This is used to determine where to begin
+// publishing events from in the log in the case of failovers or restarts.
+func (a *activityManager) SetLastPublished(index, epoch uint64) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 	a.lastPublishedRaftIndex = index
+	a.lastPublishedEpoch = epoch
 }
 
-// LastPublishedRaftIndex returns the Raft index of the latest event published
-// to the activity stream. This is used to determine where to begin publishing
-// events from in the log in the case of failovers or restarts.
-func (a *activityManager) LastPublishedRaftIndex() uint64 {
+// LastPublished returns the Raft index and activity epoch of the latest event
+// published to the activity stream. This is used to determine where to begin
+// publishing events from in the log in the case of failovers or restarts.
+func (a *activityManager) LastPublished() (uint64, uint64) {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
-	return a.lastPublishedRaftIndex
+	return a.lastPublishedRaftIndex, a.lastPublishedEpoch
 }
 
 // SignalCommit indicates a new event was committed to the Raft log.
@@ -96,9 +120,21 @@ func (a *activityManager) BecomeFollower() error {
 // which they were committed to the log.
 func (a *activityManager) dispatch() {
 	var (
-		raftNode = a.getRaft()
-		index    = a.LastPublishedRaftIndex() + 1
+		raftNode     = a.getRaft()
+		index, epoch = a.LastPublished()
 	)
+	index++
+	firstIndex, err := raftNode.store.FirstIndex()
+	if err != nil {
+		panic(err)
+	}
+	if index < firstIndex {
+		// This can only happen if the last published event wasn't recorded
+		// in a snapshot, i.e. the snapshot was taken by an older version.
+		a.logger.Warnf("Activity events between Raft indexes %d and %d were "+
+			"compacted before being published", index, firstIndex-1)
+		index = firstIndex
+	}
 	for {
 		select {
 		case <-a.leadershipLostCh:
@@ -129,7 +165,8 @@ func (a *activityManager) dispatch() {
 
 		var backoff time.Duration
 	RETRY:
-		if err := a.handleRaftLog(log); err != nil {
+		published, err := a.handleRaftLog(log, epoch+1)
+		if err != nil {
 			a.logger.Errorf("Failed to publish activity event: %v", err)
 			backoff = computeActivityPublishBackoff(backoff)
 			select {
@@ -141,18 +178,30 @@ func (a *activityManager) dispatch() {
 				return
 			}
 		}
+		if published {
+			epoch++
+		}
 		index++
 	}
 }
 
 // handleRaftLog unmarshals the Raft log into an operation and, if applicable,
-// publishes an event to the activity stream.
-func (a *activityManager) handleRaftLog(l *raft.Log) error {
+// publishes an event with the given activity epoch to the activity stream. It
+// returns a bool indicating if an event was published.
+func (a *activityManager) handleRaftLog(l *raft.Log, epoch uint64) (bool, error) {
 	log := new(proto.RaftLog)
 	if err := log.Unmarshal(l.Data); err != nil {
 		panic(err)
 	}
-	event := new(client.ActivityStreamEvent)
+	if a.config.ActivityStream.PartitionEvents {
+		if value, ok := partitionActivityEvent(log); ok {
+			return true, a.publishActivityEvent(l.Index, epoch, log.Op.String(), value, nil)
+		}
+	}
+	var (
+		event   = new(client.ActivityStreamEvent)
+		headers map[string][]byte
+	)
 	switch log.Op {
 	case proto.Op_CREATE_STREAM:
 		partitions := make([]int32, len(log.CreateStreamOp.Stream.Partitions))
@@ -164,6 +213,11 @@ func (a *activityManager) handleRaftLog(l *raft.Log) error {
 			Stream:     log.CreateStreamOp.Stream.Name,
 			Partitions: partitions,
 		}
+		stream, err := log.CreateStreamOp.Stream.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		headers = map[string][]byte{activityStreamHeader: stream}
 	case proto.Op_DELETE_STREAM:
 		event.Op = client.ActivityStreamOp_DELETE_STREAM
 		event.DeleteStreamOp = &client.DeleteStreamOp{
@@ -189,11 +243,22 @@ func (a *activityManager) handleRaftLog(l *raft.Log) error {
 			Partitions: log.SetStreamReadonlyOp.Partitions,
 			Readonly:   log.SetStreamReadonlyOp.Readonly,
 		}
+	case proto.Op_EXECUTE_SCHEDULED_OPERATION:
+		// Only report operations which were executed by this entry.
+		op := a.metadata.getScheduledOperation(log.ExecuteScheduledOperationOp.Id)
+		if op == nil || op.ExecutedIndex != l.Index ||
+			op.State != proto.ScheduledOperationState_SCHEDULED_OPERATION_SUCCEEDED {
+			return false, nil
+		}
+		var ok bool
+		if headers, ok = scheduledOperationActivityEvent(op, event); !ok {
+			return false, nil
+		}
 	case proto.Op_CREATE_CONSUMER_GROUP:
 		// Members on create should always contain a single consumer.
 		members := log.CreateConsumerGroupOp.ConsumerGroup.Members
 		if len(members) == 0 {
-			return nil
+			return false, nil
 		}
 		// Treat this as a join since it bootstraps the group.
 		event.Op = client.ActivityStreamOp_JOIN_CONSUMER_GROUP
@@ -217,10 +282,43 @@ func (a *activityManager) handleRaftLog(l *raft.Log) error {
 			Expired:    log.LeaveConsumerGroupOp.Expired,
 		}
 	default:
-		return nil
+		return false, nil
 	}
 	event.Id = l.Index
-	return a.publishActivityEvent(event)
+	value, err := pb.Marshal(event)
+	if err != nil {
+		panic(err)
+	}
+	return true, a.publishActivityEvent(l.Index, epoch, log.Op.String(), value, headers)
+}
+
+// partitionActivityEvent returns the activity event value for the given Raft
+// operation if it's a partition leader change, ISR shrink or expansion, or a
+// change in the skew of a stream's partition load. These have no
+// representation in the client API's ActivityStreamEvent, so the event is the
+// internal protobuf of the operation itself, or of the stream's skew for skew
+// changes. The bool returned indicates if the operation is a partition event.
+func partitionActivityEvent(log *proto.RaftLog) ([]byte, bool) {
+	var (
+		value []byte
+		err   error
+	)
+	switch log.Op {
+	case proto.Op_CHANGE_LEADER:
+		value, err = log.ChangeLeaderOp.Marshal()
+	case proto.Op_SHRINK_ISR:
+		value, err = log.ShrinkISROp.Marshal()
+	case proto.Op_EXPAND_ISR:
+		value, err = log.ExpandISROp.Marshal()
+	case proto.Op_REPORT_PARTITION_SKEW:
+		value, err = log.ReportPartitionSkewOp.Skew.Marshal()
+	default:
+		return nil, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return value, true
 }
 
 // createActivityStream creates the activity stream and connects a local client
@@ -230,6 +328,12 @@ func (a *activityManager) createActivityStream() error {
 		Stream: &proto.Stream{
 			Name:    activityStream,
 			Subject: a.getActivityStreamSubject(),
+			Config: &proto.StreamConfig{
+				RetentionMaxAge: &proto.NullableInt64{
+					Value: a.config.ActivityStream.RetentionMaxAge.Milliseconds(),
+				},
+				CompactEnabled: &proto.NullableBool{Value: false},
+			},
 			Partitions: []*proto.Partition{
 				{
 					Stream:            activityStream,
@@ -250,35 +354,46 @@ func (a *activityManager) createActivityStream() error {
 	return nil
 }
 
-// publishActivityEvent publishes an event on the activity stream.
-func (a *activityManager) publishActivityEvent(event *client.ActivityStreamEvent) error {
-	data, err := pb.Marshal(event)
-	if err != nil {
-		panic(err)
+// publishActivityEvent publishes an event for the operation at the given Raft
+// index on the activity stream and records it as published in Raft. The
+// activity epoch is used as the event's producer sequence number, so if the
+// event was already published, e.g. by the previous metadata leader before it
+// could record it, the activity stream discards it.
+func (a *activityManager) publishActivityEvent(index, epoch uint64, op string, value []byte,
+	headers map[string][]byte) error {
+
+	if headers == nil {
+		headers = make(map[string][]byte, 4)
 	}
+	headers[activityEpochHeader] = []byte(strconv.FormatUint(epoch, 10))
+	headers[activityOpHeader] = []byte(op)
+	headers[producerIDHeader] = []byte(activityStream)
+	headers[producerSequenceHeader] = []byte(strconv.FormatUint(epoch-1, 10))
 
 	ctx, cancel := context.WithTimeout(context.Background(), a.config.ActivityStream.PublishTimeout)
 	defer cancel()
 
-	_, err = a.api.Publish(ctx, &client.PublishRequest{
-		Value:     data,
+	_, err := a.api.Publish(ctx, &client.PublishRequest{
+		Value:     value,
 		Stream:    activityStream,
+		Headers:   headers,
 		AckPolicy: a.config.ActivityStream.PublishAckPolicy,
 	})
 	if err != nil {
 		return errors.Wrap(err, "failed to publish event to stream")
 	}
 
-	a.logger.Debugf("Published %s event to activity stream", event.Op)
+	a.logger.Debugf("Published %s event to activity stream", op)
 
 	// Update last published index in Raft.
-	op := &proto.RaftLog{
+	raftOp := &proto.RaftLog{
 		Op: proto.Op_PUBLISH_ACTIVITY,
 		PublishActivityOp: &proto.PublishActivityOp{
-			RaftIndex: event.Id,
+			RaftIndex:     index,
+			ActivityEpoch: epoch,
 		},
 	}
-	future, err := a.getRaft().applyOperation(ctx, op, nil)
+	future, err := a.getRaft().applyOperation(ctx, raftOp, nil)
 	if err == nil {
 		err = future.Error()
 	}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
@@ -9,8 +10,24 @@ import (
 	liftApi "github.com/liftbridge-io/liftbridge-api/v2/go"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+
+	protocol "github.com/liftbridge-io/liftbridge/server/protocol"
 )
 
+// waitForActivityEpoch waits until the server has recorded the activity event
+// with the given epoch as published and returns the event's Raft index.
+func waitForActivityEpoch(t *testing.T, timeout time.Duration, s *Server, epoch uint64) uint64 {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if index, published := s.activity.LastPublished(); published == epoch {
+			return index
+		}
+		time.Sleep(15 * time.Millisecond)
+	}
+	stackFatalf(t, "Activity epoch did not reach %d", epoch)
+	return 0
+}
+
 // Ensure activity stream creation event occurs.
 func TestActivityStreamCreateStream(t *testing.T) {
 	defer cleanupStorage(t)
@@ -482,6 +499,142 @@ func TestActivityStreamJoinLeaveConsumerGroup(t *testing.T) {
 
 }
 
+// Ensure activity events carry contiguous activity epochs and the operation
+// name in their headers and that stream creation events include the stream's
+// config and partition assignment.
+func TestActivityStreamEventHeaders(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.ActivityStream.Enabled = true
+	s1Config.ActivityStream.PublishTimeout = time.Second
+	s1Config.ActivityStream.PublishAckPolicy = liftApi.AckPolicy_LEADER
+	s1Config.ActivityStream.RetentionMaxAge = time.Hour
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Wait for server to elect itself leader.
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	stream := "foo-stream"
+	require.NoError(t, client.CreateStream(context.Background(), "foo", stream))
+	require.NoError(t, client.DeleteStream(context.Background(), stream))
+
+	msgs := make(chan *lift.Message, 3)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err = client.Subscribe(ctx, activityStream, func(msg *lift.Message, err error) {
+		require.NoError(t, err)
+		msgs <- msg
+	}, lift.StartAtEarliestReceived())
+	require.NoError(t, err)
+
+	ops := []string{"CREATE_STREAM", "CREATE_STREAM", "DELETE_STREAM"}
+	for i, op := range ops {
+		select {
+		case msg := <-msgs:
+			headers := msg.Headers()
+			require.Equal(t, strconv.Itoa(i+1), string(headers[activityEpochHeader]))
+			require.Equal(t, op, string(headers[activityOpHeader]))
+			if i == 0 {
+				// The activity stream itself is created without compaction
+				// and with the configured retention.
+				created := new(protocol.Stream)
+				require.NoError(t, created.Unmarshal(headers[activityStreamHeader]))
+				require.Equal(t, activityStream, created.Name)
+				require.False(t, created.Config.CompactEnabled.Value)
+				require.Equal(t, time.Hour.Milliseconds(), created.Config.RetentionMaxAge.Value)
+				require.Equal(t, []string{"a"}, created.Partitions[0].Replicas)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Did not receive expected message")
+		}
+	}
+}
+
+// Ensure an activity event republished with the same epoch, e.g. by a new
+// metadata leader which didn't see it recorded, isn't written to the activity
+// stream twice.
+func TestActivityStreamRepublishIdempotent(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.ActivityStream.Enabled = true
+	s1Config.ActivityStream.PublishTimeout = time.Second
+	s1Config.ActivityStream.PublishAckPolicy = liftApi.AckPolicy_LEADER
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Wait for server to elect itself leader.
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	// Wait for the creation of the activity stream to be published.
+	index := waitForActivityEpoch(t, 5*time.Second, s1, 1)
+
+	// Republish the event.
+	value, err := proto.Marshal(&liftApi.ActivityStreamEvent{
+		Id: index,
+		Op: liftApi.ActivityStreamOp_CREATE_STREAM,
+	})
+	require.NoError(t, err)
+	require.NoError(t, s1.activity.publishActivityEvent(index, 1, "CREATE_STREAM", value, nil))
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.CreateStream(context.Background(), "foo", "foo-stream"))
+	waitForActivityEpoch(t, 5*time.Second, s1, 2)
+
+	partition := s1.metadata.GetPartition(activityStream, 0)
+	require.NotNil(t, partition)
+	require.Equal(t, int64(1), partition.log.NewestOffset())
+}
+
+// Ensure partition leader changes and ISR changes are published as their
+// internal operations.
+func TestPartitionActivityEvent(t *testing.T) {
+	shrink := &protocol.ShrinkISROp{Stream: "foo", Partition: 1, ReplicaToRemove: "b"}
+	value, ok := partitionActivityEvent(&protocol.RaftLog{
+		Op:          protocol.Op_SHRINK_ISR,
+		ShrinkISROp: shrink,
+	})
+	require.True(t, ok)
+	decoded := new(protocol.ShrinkISROp)
+	require.NoError(t, decoded.Unmarshal(value))
+	require.Equal(t, "b", decoded.ReplicaToRemove)
+
+	_, ok = partitionActivityEvent(&protocol.RaftLog{
+		Op:             protocol.Op_CHANGE_LEADER,
+		ChangeLeaderOp: &protocol.ChangeLeaderOp{Stream: "foo", Leader: "a"},
+	})
+	require.True(t, ok)
+
+	// Skew reports are published as the stream's skew.
+	value, ok = partitionActivityEvent(&protocol.RaftLog{
+		Op: protocol.Op_REPORT_PARTITION_SKEW,
+		ReportPartitionSkewOp: &protocol.ReportPartitionSkewOp{
+			Skew: &protocol.StreamSkew{Stream: "foo", Skewed: true, HotPartition: 2},
+		},
+	})
+	require.True(t, ok)
+	skew := new(protocol.StreamSkew)
+	require.NoError(t, skew.Unmarshal(value))
+	require.Equal(t, int32(2), skew.HotPartition)
+
+	_, ok = partitionActivityEvent(&protocol.RaftLog{
+		Op:             protocol.Op_DELETE_STREAM,
+		DeleteStreamOp: &protocol.DeleteStreamOp{Stream: "foo"},
+	})
+	require.False(t, ok)
+}
+
 // Ensure computeActivityPublishBackoff doubles the backoff time and caps it at
 // the max backoff.
 func TestComputeActivityPublishBackoff(t *testing.T) {
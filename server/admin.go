@@ -0,0 +1,1287 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// TransferLeader implements the AdminAPI TransferLeader RPC. It hands off
+// leadership of a partition to the given broker, which must be in the
+// partition's ISR. If WaitForLeaderTransfer is set, this will not return until
+// the target broker has assumed leadership.
+func (a *apiServer) TransferLeader(ctx context.Context, req *proto.TransferLeaderRequest) (
+	*proto.TransferLeaderResponse, error) {
+
+	resp := &proto.TransferLeaderResponse{}
+	a.logger.Debugf("api: TransferLeader [stream=%s, partition=%d, targetBroker=%s, wait=%v]",
+		req.Stream, req.Partition, req.TargetBroker, req.WaitForLeaderTransfer)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "TransferLeader")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+	if req.TargetBroker == "" {
+		return nil, status.Error(codes.InvalidArgument, "No target broker provided")
+	}
+
+	if e := a.metadata.TransferLeader(ctx, req.Stream, req.Partition, req.TargetBroker); e != nil {
+		a.logger.Errorf("api: Failed to transfer leader of partition [stream=%s, partition=%d] to %s: %v",
+			req.Stream, req.Partition, req.TargetBroker, e.Err())
+		return nil, e.Err()
+	}
+
+	if req.WaitForLeaderTransfer {
+		if e := a.metadata.WaitForLeaderTransfer(ctx, req.Stream, req.Partition, req.TargetBroker); e != nil {
+			a.logger.Errorf("api: Failed to wait for leader transfer of partition [stream=%s, partition=%d] to %s: %v",
+				req.Stream, req.Partition, req.TargetBroker, e.Err())
+			return nil, e.Err()
+		}
+	}
+
+	return resp, nil
+}
+
+// AllocationStats implements the AdminAPI AllocationStats RPC. It returns the
+// partitions on this server ordered by approximate bytes allocated on their
+// behalf, along with the process GC statistics, so that GC pressure can be
+// attributed to hot streams. The per-partition figures are sampled estimates.
+func (a *apiServer) AllocationStats(ctx context.Context, req *proto.AllocationStatsRequest) (
+	*proto.AllocationStatsResponse, error) {
+
+	a.logger.Debugf("api: AllocationStats [limit=%d]", req.Limit)
+
+	err := a.ensureAuthorizationPermission(ctx, "*", "AllocationStats")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Limit cannot be negative")
+	}
+
+	partitions, total := a.allocations.top(int(req.Limit))
+	return &proto.AllocationStatsResponse{
+		BrokerId:         a.config.Clustering.ServerID,
+		SampleRate:       int32(a.allocations.sampleRate),
+		ApproxTotalBytes: total,
+		Partitions:       partitions,
+		Gc:               readGCStats(),
+	}, nil
+}
+
+// MetadataMemoryStats implements the AdminAPI MetadataMemoryStats RPC. It
+// returns the entry counts and estimated sizes of the server's in-memory
+// metadata structures along with the limits some of them should never exceed.
+func (a *apiServer) MetadataMemoryStats(ctx context.Context, req *proto.MetadataMemoryStatsRequest) (
+	*proto.MetadataMemoryStatsResponse, error) {
+
+	a.logger.Debugf("api: MetadataMemoryStats")
+
+	err := a.ensureAuthorizationPermission(ctx, "*", "MetadataMemoryStats")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	return a.metadata.memoryStats(), nil
+}
+
+// FetchBrokerStats implements the AdminAPI FetchBrokerStats RPC. It returns
+// metrics for each partition this server is a replica of, optionally filtered
+// to a single stream. Paused partitions are omitted since their logs are
+// closed.
+func (a *apiServer) FetchBrokerStats(ctx context.Context, req *proto.FetchBrokerStatsRequest) (
+	*proto.FetchBrokerStatsResponse, error) {
+
+	a.logger.Debugf("api: FetchBrokerStats [stream=%s]", req.Stream)
+
+	resource := req.Stream
+	if resource == "" {
+		resource = "*"
+	}
+	err := a.ensureAuthorizationPermission(ctx, resource, "FetchBrokerStats")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	var streams []*stream
+	if req.Stream != "" {
+		s := a.metadata.GetStream(req.Stream)
+		if s == nil {
+			return nil, status.Error(codes.NotFound, "stream not found")
+		}
+		streams = []*stream{s}
+	} else {
+		streams = a.metadata.GetStreams()
+	}
+
+	resp := &proto.FetchBrokerStatsResponse{
+		BrokerId:               a.config.Clustering.ServerID,
+		UnauthenticatedDropped: a.auth.droppedCount(),
+		ActiveSubscriptions:    atomic.LoadInt64(&a.activeSubscriptions),
+		Propagation:            a.metadata.propagation.stats(),
+	}
+	for _, stream := range streams {
+		for _, partition := range stream.GetPartitions() {
+			if !partition.IsReplica(a.config.Clustering.ServerID) || partition.IsPaused() {
+				continue
+			}
+			resp.Partitions = append(resp.Partitions, partition.Stats())
+		}
+	}
+	sort.Slice(resp.Partitions, func(i, j int) bool {
+		if resp.Partitions[i].Stream != resp.Partitions[j].Stream {
+			return resp.Partitions[i].Stream < resp.Partitions[j].Stream
+		}
+		return resp.Partitions[i].Partition < resp.Partitions[j].Partition
+	})
+
+	return resp, nil
+}
+
+// FetchBrokerRTTs implements the AdminAPI FetchBrokerRTTs RPC. It returns the
+// inter-broker round-trip times this server has measured or received from its
+// peers. Rows which have not been refreshed recently are omitted.
+func (a *apiServer) FetchBrokerRTTs(ctx context.Context, req *proto.FetchBrokerRTTsRequest) (
+	*proto.FetchBrokerRTTsResponse, error) {
+
+	a.logger.Debugf("api: FetchBrokerRTTs")
+
+	err := a.ensureAuthorizationPermission(ctx, "*", "FetchBrokerRTTs")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	return &proto.FetchBrokerRTTsResponse{
+		BrokerId: a.config.Clustering.ServerID,
+		Brokers:  a.rtts.snapshot(),
+	}, nil
+}
+
+// DescribeStreams implements the AdminAPI DescribeStreams RPC. It returns the
+// full metadata of the given streams, or all streams if none are given,
+// including their configuration and the origin recorded when they were
+// created, along with the ingest source counts of the partitions this server
+// is a replica of.
+func (a *apiServer) DescribeStreams(ctx context.Context, req *proto.DescribeStreamsRequest) (
+	*proto.DescribeStreamsResponse, error) {
+
+	a.logger.Debugf("api: DescribeStreams [streams=%s]", req.Streams)
+
+	var streams []*stream
+	if len(req.Streams) == 0 {
+		err := a.ensureAuthorizationPermission(ctx, "*", "DescribeStreams")
+		if err != nil {
+			a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+			return nil, err
+		}
+		streams = a.metadata.GetStreams()
+	} else {
+		for _, name := range req.Streams {
+			err := a.ensureAuthorizationPermission(ctx, name, "DescribeStreams")
+			if err != nil {
+				a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+				return nil, err
+			}
+			if s := a.metadata.GetStream(name); s != nil {
+				streams = append(streams, s)
+			}
+		}
+	}
+
+	resp := &proto.DescribeStreamsResponse{
+		Streams: make([]*proto.Stream, len(streams)),
+	}
+	for i, stream := range streams {
+		resp.Streams[i] = stream.Proto()
+		for _, partition := range stream.GetPartitions() {
+			if !partition.IsReplica(a.config.Clustering.ServerID) || partition.IsPaused() {
+				continue
+			}
+			resp.IngestSources = append(resp.IngestSources, &proto.PartitionIngestSources{
+				Stream:    partition.Stream,
+				Partition: partition.Id,
+				Sources:   partition.ingestSources(),
+			})
+		}
+	}
+	sort.Slice(resp.Streams, func(i, j int) bool {
+		return resp.Streams[i].Name < resp.Streams[j].Name
+	})
+	sort.Slice(resp.IngestSources, func(i, j int) bool {
+		if resp.IngestSources[i].Stream != resp.IngestSources[j].Stream {
+			return resp.IngestSources[i].Stream < resp.IngestSources[j].Stream
+		}
+		return resp.IngestSources[i].Partition < resp.IngestSources[j].Partition
+	})
+
+	return resp, nil
+}
+
+// GetStreamConfig implements the AdminAPI GetStreamConfig RPC. It returns the
+// effective configuration of the given stream from this server's metadata.
+func (a *apiServer) GetStreamConfig(ctx context.Context, req *proto.GetStreamConfigRequest) (
+	*proto.GetStreamConfigResponse, error) {
+
+	a.logger.Debugf("api: GetStreamConfig [stream=%s]", req.Stream)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "GetStreamConfig")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+
+	config, st := a.metadata.GetStreamConfig(ctx, req.Stream)
+	if st != nil {
+		return nil, st.Err()
+	}
+
+	return &proto.GetStreamConfigResponse{Config: config}, nil
+}
+
+// UpdateStreamConfig implements the AdminAPI UpdateStreamConfig RPC. It
+// changes the retention, segment, or compaction settings of the given stream.
+func (a *apiServer) UpdateStreamConfig(ctx context.Context, req *proto.UpdateStreamConfigRequest) (
+	*proto.UpdateStreamConfigResponse, error) {
+
+	a.logger.Debugf("api: UpdateStreamConfig [stream=%s, config=%s]", req.Stream, req.Config)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "UpdateStreamConfig")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+	if isReservedStream(req.Stream) {
+		return nil, status.Error(codes.InvalidArgument, "Stream is reserved")
+	}
+
+	st := a.metadata.UpdateStreamConfig(ctx, &proto.UpdateStreamConfigOp{
+		Stream: req.Stream,
+		Config: req.Config,
+	})
+	if st != nil {
+		a.logger.Errorf("api: Failed to update config of stream %s: %v", req.Stream, st.Err())
+		return nil, st.Err()
+	}
+
+	return &proto.UpdateStreamConfigResponse{}, nil
+}
+
+// FetchPartitionStatus implements the AdminAPI FetchPartitionStatus RPC. It
+// returns the lifecycle state of a partition on this server, or on each of its
+// replicas if requested.
+func (a *apiServer) FetchPartitionStatus(ctx context.Context, req *proto.FetchPartitionStatusRequest) (
+	*proto.FetchPartitionStatusResponse, error) {
+
+	a.logger.Debugf("api: FetchPartitionStatus [stream=%s, partition=%d, allReplicas=%v]",
+		req.Stream, req.Partition, req.AllReplicas)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "FetchPartitionStatus")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+
+	resp, st := a.metadata.FetchPartitionStatus(ctx, req)
+	if st != nil {
+		return nil, st.Err()
+	}
+
+	return resp, nil
+}
+
+// ScheduleStreamOperation implements the AdminAPI ScheduleStreamOperation RPC.
+// It schedules a stream operation to be executed by the metadata leader once
+// its clock reaches the given execution time.
+func (a *apiServer) ScheduleStreamOperation(ctx context.Context, req *proto.ScheduleStreamOperationRequest) (
+	*proto.ScheduleStreamOperationResponse, error) {
+
+	a.logger.Debugf("api: ScheduleStreamOperation [type=%s, stream=%s, partitions=%v, executeAt=%d]",
+		req.Type, req.Stream, req.Partitions, req.ExecuteAt)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "ScheduleStreamOperation")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+	if _, ok := proto.ScheduledOperationType_name[int32(req.Type)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown operation type %d", req.Type)
+	}
+	if req.Readonly && req.Type != proto.ScheduledOperationType_SCHEDULED_SET_STREAM_READONLY {
+		return nil, status.Errorf(codes.InvalidArgument, "Readonly cannot be set for %s", req.Type)
+	}
+
+	operation, st := a.metadata.ScheduleOperation(ctx, &proto.ScheduleOperationOp{
+		Operation: &proto.ScheduledOperation{
+			Type:       req.Type,
+			Stream:     req.Stream,
+			Partitions: req.Partitions,
+			Readonly:   req.Readonly,
+			ExecuteAt:  req.ExecuteAt,
+			CreatedBy:  peerClientID(ctx),
+		},
+	})
+	if st != nil {
+		a.logger.Errorf("api: Failed to schedule operation on stream %s: %v", req.Stream, st.Err())
+		return nil, st.Err()
+	}
+
+	return &proto.ScheduleStreamOperationResponse{Operation: operation}, nil
+}
+
+// ListScheduledOperations implements the AdminAPI ListScheduledOperations RPC.
+// It returns the scheduled stream operations from this server's metadata.
+func (a *apiServer) ListScheduledOperations(ctx context.Context, req *proto.ListScheduledOperationsRequest) (
+	*proto.ListScheduledOperationsResponse, error) {
+
+	a.logger.Debugf("api: ListScheduledOperations [stream=%s, includeFinished=%v]",
+		req.Stream, req.IncludeFinished)
+
+	resource := req.Stream
+	if resource == "" {
+		resource = "*"
+	}
+	err := a.ensureAuthorizationPermission(ctx, resource, "ListScheduledOperations")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	return &proto.ListScheduledOperationsResponse{
+		Operations: a.metadata.GetScheduledOperations(req.Stream, req.IncludeFinished),
+	}, nil
+}
+
+// CancelScheduledOperation implements the AdminAPI CancelScheduledOperation
+// RPC. It cancels a scheduled stream operation which hasn't been executed yet.
+func (a *apiServer) CancelScheduledOperation(ctx context.Context, req *proto.CancelScheduledOperationRequest) (
+	*proto.CancelScheduledOperationResponse, error) {
+
+	a.logger.Debugf("api: CancelScheduledOperation [id=%d]", req.Id)
+
+	// Authorize against the operation's stream if it's known here.
+	resource := "*"
+	if op := a.metadata.getScheduledOperation(req.Id); op != nil {
+		resource = op.Stream
+	}
+	err := a.ensureAuthorizationPermission(ctx, resource, "CancelScheduledOperation")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	op := &proto.CancelScheduledOperationOp{Id: req.Id}
+	if e := a.metadata.CancelScheduledOperation(ctx, op); e != nil {
+		a.logger.Errorf("api: Failed to cancel scheduled operation %d: %v", req.Id, e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.CancelScheduledOperationResponse{}, nil
+}
+
+// UpdateStreamOwner implements the AdminAPI UpdateStreamOwner RPC. It changes
+// the owner recorded in the stream's origin.
+func (a *apiServer) UpdateStreamOwner(ctx context.Context, req *proto.UpdateStreamOwnerRequest) (
+	*proto.UpdateStreamOwnerResponse, error) {
+
+	a.logger.Debugf("api: UpdateStreamOwner [stream=%s, owner=%s]", req.Stream, req.Owner)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "UpdateStreamOwner")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+
+	op := &proto.UpdateStreamOwnerOp{Stream: req.Stream, Owner: req.Owner}
+	if e := a.metadata.UpdateStreamOwner(ctx, op); e != nil {
+		a.logger.Errorf("api: Failed to update owner of stream %s: %v", req.Stream, e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.UpdateStreamOwnerResponse{}, nil
+}
+
+// SetStreamTags implements the AdminAPI SetStreamTags RPC. It replaces the
+// tags of the given stream.
+func (a *apiServer) SetStreamTags(ctx context.Context, req *proto.SetStreamTagsRequest) (
+	*proto.SetStreamTagsResponse, error) {
+
+	a.logger.Debugf("api: SetStreamTags [stream=%s, tags=%v]", req.Stream, req.Tags)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "SetStreamTags")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+
+	op := &proto.SetStreamTagsOp{Stream: req.Stream, Tags: req.Tags}
+	if e := a.metadata.SetStreamTags(ctx, op); e != nil {
+		a.logger.Errorf("api: Failed to set tags of stream %s: %v", req.Stream, e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.SetStreamTagsResponse{}, nil
+}
+
+// DeletePartitionData implements the AdminAPI DeletePartitionData RPC. It
+// deletes the messages of the given partition before the given offset by
+// advancing the log start offset on each of its replicas.
+func (a *apiServer) DeletePartitionData(ctx context.Context, req *proto.DeletePartitionDataRequest) (
+	*proto.DeletePartitionDataResponse, error) {
+
+	a.logger.Debugf("api: DeletePartitionData [stream=%s, partition=%d, offset=%d]",
+		req.Stream, req.Partition, req.Offset)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "DeletePartitionData")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+	if req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Offset must not be negative")
+	}
+
+	op := &proto.DeletePartitionDataOp{
+		Stream:    req.Stream,
+		Partition: req.Partition,
+		Offset:    req.Offset,
+	}
+	if e := a.metadata.DeletePartitionData(ctx, op); e != nil {
+		a.logger.Errorf("api: Failed to delete data of partition %d of stream %s: %v",
+			req.Partition, req.Stream, e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.DeletePartitionDataResponse{}, nil
+}
+
+// DeletePartition implements the AdminAPI DeletePartition RPC. It removes a
+// single partition from the given stream and deletes its data on each of its
+// replicas. The last partition of a stream can't be deleted.
+func (a *apiServer) DeletePartition(ctx context.Context, req *proto.DeletePartitionRequest) (
+	*proto.DeletePartitionResponse, error) {
+
+	a.logger.Debugf("api: DeletePartition [stream=%s, partition=%d]", req.Stream, req.Partition)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "DeletePartition")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+	if isReservedStream(req.Stream) {
+		return nil, status.Error(codes.InvalidArgument, "Stream is reserved")
+	}
+
+	op := &proto.DeletePartitionOp{Stream: req.Stream, Partition: req.Partition}
+	if e := a.metadata.DeletePartition(ctx, op); e != nil {
+		a.logger.Errorf("api: Failed to delete partition %d of stream %s: %v",
+			req.Partition, req.Stream, e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.DeletePartitionResponse{}, nil
+}
+
+// SetStreamACL implements the AdminAPI SetStreamACL RPC. It replaces the
+// access control list of the given stream.
+func (a *apiServer) SetStreamACL(ctx context.Context, req *proto.SetStreamACLRequest) (
+	*proto.SetStreamACLResponse, error) {
+
+	a.logger.Debugf("api: SetStreamACL [stream=%s, acl=%v]", req.Stream, req.Acl)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "SetStreamACL")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+
+	op := &proto.SetStreamACLOp{Stream: req.Stream, Acl: req.Acl}
+	if e := a.metadata.SetStreamACL(ctx, op); e != nil {
+		a.logger.Errorf("api: Failed to set ACL of stream %s: %v", req.Stream, e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.SetStreamACLResponse{}, nil
+}
+
+// RegisterProducer implements the AdminAPI RegisterProducer RPC. It registers
+// an exclusive producer on a stream and returns its fencing token, fencing the
+// previous holder of the producer name.
+func (a *apiServer) RegisterProducer(ctx context.Context, req *proto.RegisterProducerRequest) (
+	*proto.RegisterProducerResponse, error) {
+
+	a.logger.Debugf("api: RegisterProducer [stream=%s, producer=%s, ttl=%d]",
+		req.Stream, req.Producer, req.Ttl)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "RegisterProducer")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+	if req.Producer == "" {
+		return nil, status.Error(codes.InvalidArgument, "No producer provided")
+	}
+	if req.Ttl < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid ttl %d", req.Ttl)
+	}
+
+	op := &proto.RegisterProducerOp{Stream: req.Stream, Producer: req.Producer, Ttl: req.Ttl}
+	epoch, e := a.metadata.RegisterProducer(ctx, op)
+	if e != nil {
+		a.logger.Errorf("api: Failed to register producer %q on stream %s: %v",
+			req.Producer, req.Stream, e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.RegisterProducerResponse{Epoch: epoch}, nil
+}
+
+// ReleaseProducer implements the AdminAPI ReleaseProducer RPC. It releases
+// the registration of an exclusive producer so that publishes carrying its
+// fencing token are rejected.
+func (a *apiServer) ReleaseProducer(ctx context.Context, req *proto.ReleaseProducerRequest) (
+	*proto.ReleaseProducerResponse, error) {
+
+	a.logger.Debugf("api: ReleaseProducer [stream=%s, producer=%s, epoch=%d]",
+		req.Stream, req.Producer, req.Epoch)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "ReleaseProducer")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+	if req.Producer == "" {
+		return nil, status.Error(codes.InvalidArgument, "No producer provided")
+	}
+
+	op := &proto.ReleaseProducerOp{Stream: req.Stream, Producer: req.Producer, Epoch: req.Epoch}
+	if e := a.metadata.ReleaseProducer(ctx, op); e != nil {
+		a.logger.Errorf("api: Failed to release producer %q on stream %s: %v",
+			req.Producer, req.Stream, e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.ReleaseProducerResponse{}, nil
+}
+
+// SetProducerQuota implements the AdminAPI SetProducerQuota RPC. It sets the
+// byte rate quota of a producer on every broker.
+func (a *apiServer) SetProducerQuota(ctx context.Context, req *proto.SetProducerQuotaRequest) (
+	*proto.SetProducerQuotaResponse, error) {
+
+	a.logger.Debugf("api: SetProducerQuota [quota=%v]", req.Quota)
+
+	err := a.ensureAuthorizationPermission(ctx, "*", "SetProducerQuota")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Quota.GetProducerID() == "" {
+		return nil, status.Error(codes.InvalidArgument, "No producer provided")
+	}
+	if req.Quota.BytesPerSecond <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Quota must be a positive number of bytes per second")
+	}
+
+	op := &proto.SetProducerQuotaOp{Quota: req.Quota}
+	if e := a.metadata.SetProducerQuota(ctx, op); e != nil {
+		a.logger.Errorf("api: Failed to set quota of producer %s: %v", req.Quota.ProducerID, e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.SetProducerQuotaResponse{}, nil
+}
+
+// GetProducerQuotas implements the AdminAPI GetProducerQuotas RPC. It returns
+// the producer quotas enforced by this server.
+func (a *apiServer) GetProducerQuotas(ctx context.Context, req *proto.GetProducerQuotasRequest) (
+	*proto.GetProducerQuotasResponse, error) {
+
+	a.logger.Debugf("api: GetProducerQuotas [producerID=%s]", req.ProducerID)
+
+	err := a.ensureAuthorizationPermission(ctx, "*", "GetProducerQuotas")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	return &proto.GetProducerQuotasResponse{Quotas: a.metadata.GetProducerQuotas(req.ProducerID)}, nil
+}
+
+// DeleteProducerQuota implements the AdminAPI DeleteProducerQuota RPC. It
+// removes the quota of a producer on every broker, including a quota set in
+// the brokers' configuration.
+func (a *apiServer) DeleteProducerQuota(ctx context.Context, req *proto.DeleteProducerQuotaRequest) (
+	*proto.DeleteProducerQuotaResponse, error) {
+
+	a.logger.Debugf("api: DeleteProducerQuota [producerID=%s]", req.ProducerID)
+
+	err := a.ensureAuthorizationPermission(ctx, "*", "DeleteProducerQuota")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.ProducerID == "" {
+		return nil, status.Error(codes.InvalidArgument, "No producer provided")
+	}
+
+	op := &proto.DeleteProducerQuotaOp{ProducerID: req.ProducerID}
+	if e := a.metadata.DeleteProducerQuota(ctx, op); e != nil {
+		a.logger.Errorf("api: Failed to delete quota of producer %s: %v", req.ProducerID, e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.DeleteProducerQuotaResponse{}, nil
+}
+
+// AddRaftServer implements the AdminAPI AddRaftServer RPC. It adds a server to
+// the metadata Raft group as a voter or, if Observer is set, as a non-voting
+// observer. This must be sent to the metadata leader.
+func (a *apiServer) AddRaftServer(ctx context.Context, req *proto.AddRaftServerRequest) (
+	*proto.AddRaftServerResponse, error) {
+
+	a.logger.Debugf("api: AddRaftServer [serverId=%s, observer=%v]", req.ServerId, req.Observer)
+
+	err := a.ensureAuthorizationPermission(ctx, "*", "AddRaftServer")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.ServerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "No server provided")
+	}
+
+	if e := a.metadata.AddRaftServer(ctx, req.ServerId, req.Observer); e != nil {
+		a.logger.Errorf("api: Failed to add server %s to metadata Raft group: %v", req.ServerId, e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.AddRaftServerResponse{}, nil
+}
+
+// RemoveRaftServer implements the AdminAPI RemoveRaftServer RPC. It removes a
+// server from the metadata Raft group, which it can't rejoin unless added
+// back with AddRaftServer. This must be sent to the metadata leader.
+func (a *apiServer) RemoveRaftServer(ctx context.Context, req *proto.RemoveRaftServerRequest) (
+	*proto.RemoveRaftServerResponse, error) {
+
+	a.logger.Debugf("api: RemoveRaftServer [serverId=%s, force=%v]", req.ServerId, req.Force)
+
+	err := a.ensureAuthorizationPermission(ctx, "*", "RemoveRaftServer")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.ServerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "No server provided")
+	}
+
+	if e := a.metadata.RemoveRaftServer(ctx, req.ServerId, req.Force); e != nil {
+		a.logger.Errorf("api: Failed to remove server %s from metadata Raft group: %v", req.ServerId, e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.RemoveRaftServerResponse{}, nil
+}
+
+// ListRaftServers implements the AdminAPI ListRaftServers RPC. It returns the
+// members of the metadata Raft group with their suffrage and the last time the
+// leader heard from them. This must be sent to the metadata leader.
+func (a *apiServer) ListRaftServers(ctx context.Context, req *proto.ListRaftServersRequest) (
+	*proto.ListRaftServersResponse, error) {
+
+	a.logger.Debugf("api: ListRaftServers")
+
+	err := a.ensureAuthorizationPermission(ctx, "*", "ListRaftServers")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	servers, e := a.metadata.ListRaftServers()
+	if e != nil {
+		a.logger.Errorf("api: Failed to list metadata Raft group servers: %v", e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.ListRaftServersResponse{Servers: servers}, nil
+}
+
+// FetchSubjectLayout implements the AdminAPI FetchSubjectLayout RPC. It
+// returns the NATS subjects this server uses for internal communication and
+// the internal streams, with wildcards in place of the tokens which vary.
+func (a *apiServer) FetchSubjectLayout(ctx context.Context, req *proto.FetchSubjectLayoutRequest) (
+	*proto.FetchSubjectLayoutResponse, error) {
+
+	a.logger.Debugf("api: FetchSubjectLayout")
+
+	err := a.ensureAuthorizationPermission(ctx, "*", "FetchSubjectLayout")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	return &proto.FetchSubjectLayoutResponse{
+		BrokerId:      a.config.Clustering.ServerID,
+		Namespace:     a.config.Clustering.Namespace,
+		SubjectPrefix: a.getSubjectPrefix(),
+		Subjects:      a.getSubjectLayout(),
+	}, nil
+}
+
+// WatchMetadata implements the AdminAPI WatchMetadata RPC. It sends the
+// metadata changes applied by this server for the streams matching the
+// request's prefixes until the client goes away. Watchers which fall behind
+// are disconnected with ResourceExhausted so they can watch again and
+// reconcile with FetchMetadata.
+func (a *apiServer) WatchMetadata(req *proto.WatchMetadataRequest,
+	out proto.AdminAPI_WatchMetadataServer) error {
+
+	a.logger.Debugf("api: WatchMetadata [streamPrefixes=%v]", req.StreamPrefixes)
+
+	err := a.ensureAuthorizationPermission(out.Context(), "*", "WatchMetadata")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return err
+	}
+
+	watcher, unwatch := a.metadata.events.watch(req.StreamPrefixes)
+	defer unwatch()
+
+	for {
+		select {
+		case event := <-watcher.events:
+			if err := out.Send(event); err != nil {
+				return err
+			}
+		case <-watcher.dropped:
+			return status.Error(codes.ResourceExhausted, "Watcher fell behind metadata changes")
+		case <-out.Context().Done():
+			return nil
+		case <-a.shutdownCh:
+			return status.Error(codes.Unavailable, "Server is shutting down")
+		}
+	}
+}
+
+// WatchPartition implements the AdminAPI WatchPartition RPC. It sends the
+// changes made to the partition, coalesced so the watcher gets at most the
+// requested number of events per second, until the client goes away or the
+// server stops leading the partition, which ends the stream with
+// FailedPrecondition so the client can watch the new leader.
+func (a *apiServer) WatchPartition(req *proto.WatchPartitionRequest,
+	out proto.AdminAPI_WatchPartitionServer) error {
+
+	a.logger.Debugf("api: WatchPartition [stream=%s, partition=%d, maxEventsPerSecond=%d]",
+		req.Stream, req.Partition, req.MaxEventsPerSecond)
+
+	err := a.ensureAuthorizationPermission(out.Context(), req.Stream, "WatchPartition")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return err
+	}
+
+	partition := a.metadata.GetPartition(req.Stream, req.Partition)
+	if partition == nil {
+		return status.Error(codes.NotFound, "No such partition")
+	}
+	watcher, unwatch, err := partition.Watch()
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, "Server not partition leader")
+	}
+	defer unwatch()
+
+	return a.sendPartitionEvents(out, partition, watcher, a.partitionEventInterval(req.MaxEventsPerSecond))
+}
+
+// ExportCursors implements the AdminAPI ExportCursors RPC. It returns the
+// cursors matching the request's cursor ID pattern and stream along with the
+// epochs and offset ranges of their stream partitions. The server must
+// replicate the cursors partitions and the partitions of the exported streams.
+func (a *apiServer) ExportCursors(ctx context.Context, req *proto.ExportCursorsRequest) (
+	*proto.ExportCursorsResponse, error) {
+
+	a.logger.Debugf("api: ExportCursors [cursorIdPattern=%s, stream=%s]", req.CursorIdPattern, req.Stream)
+
+	err := a.ensureAuthorizationPermission(ctx, "*", "ExportCursors")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	doc, e := a.exportCursors(ctx, req.CursorIdPattern, req.Stream)
+	if e != nil {
+		a.logger.Errorf("api: Failed to export cursors: %v", e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.ExportCursorsResponse{Document: doc}, nil
+}
+
+// ImportCursors implements the AdminAPI ImportCursors RPC. It sets the cursors
+// of a document returned by ExportCursors, mapping their streams and offsets
+// to the target partitions, and reports the outcome for each cursor. Like
+// SetCursor, the server must lead the cursors partitions the cursors map to.
+func (a *apiServer) ImportCursors(ctx context.Context, req *proto.ImportCursorsRequest) (
+	*proto.ImportCursorsResponse, error) {
+
+	a.logger.Debugf("api: ImportCursors [cursors=%d, offsetMapping=%s, clamp=%v, force=%v]",
+		len(req.GetDocument().GetCursors()), req.OffsetMapping, req.Clamp, req.Force)
+
+	err := a.ensureAuthorizationPermission(ctx, "*", "ImportCursors")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	cursors, e := a.importCursors(ctx, req)
+	if e != nil {
+		a.logger.Errorf("api: Failed to import cursors: %v", e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.ImportCursorsResponse{Cursors: cursors}, nil
+}
+
+// NackMessage implements the AdminAPI NackMessage RPC. It moves a message a
+// consumer failed to process to its stream's dead letter queue, which must
+// already exist, and then advances the consumer's cursor to the message so it
+// isn't redelivered. The cursor is left alone if it's already past the
+// message.
+func (a *apiServer) NackMessage(ctx context.Context, req *proto.NackMessageRequest) (
+	*proto.NackMessageResponse, error) {
+
+	a.logger.Debugf("api: NackMessage [stream=%s, partition=%d, offset=%d, cursorId=%s]",
+		req.Stream, req.Partition, req.Offset, req.CursorId)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "NackMessage")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+	if req.CursorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "No cursorId provided")
+	}
+
+	offset, e := a.moveToDeadLetterQueue(ctx, req)
+	if e != nil {
+		a.logger.Errorf("api: Failed to move message at offset %d of partition [stream=%s, partition=%d] "+
+			"to dead letter queue: %v", req.Offset, req.Stream, req.Partition, e.Err())
+		return nil, e.Err()
+	}
+
+	cursor, e := a.cursors.GetCursor(ctx, req.Stream, req.CursorId, req.Partition)
+	if e == nil && cursor < req.Offset {
+		e = a.cursors.SetCursor(ctx, req.Stream, req.CursorId, req.Partition, req.Offset)
+	}
+	if e != nil {
+		a.logger.Errorf("api: Failed to advance cursor %s past nacked message: %v", req.CursorId, e.Err())
+		return nil, e.Err()
+	}
+
+	return &proto.NackMessageResponse{DeadLetterOffset: offset}, nil
+}
+
+// ExportMessages streams the messages of a stream, or a subset of its
+// partitions, in chunks encoded as JSON lines or Parquet files. Each chunk
+// carries a token which can be used to resume the export after it.
+func (a *apiServer) ExportMessages(req *proto.ExportMessagesRequest,
+	out proto.AdminAPI_ExportMessagesServer) error {
+
+	a.logger.Debugf("api: ExportMessages [stream=%s, partitions=%v, format=%s]",
+		req.Stream, req.Partitions, req.Format)
+
+	// Exporting messages reads them, so it requires the same permission as
+	// subscribing.
+	err := a.ensureAuthorizationPermission(out.Context(), req.Stream, "Subscribe")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return err
+	}
+
+	if req.Stream == "" {
+		return status.Error(codes.InvalidArgument, "No stream provided")
+	}
+	if req.ChunkSize < 0 || req.ChunkSize > maxExportChunkSize {
+		return status.Errorf(codes.InvalidArgument, "chunkSize must be between 0 and %d", maxExportChunkSize)
+	}
+	if req.StartOffset != nil && req.StopOffset != nil && req.StartOffset.Value > req.StopOffset.Value {
+		return status.Error(codes.InvalidArgument, "startOffset cannot be after stopOffset")
+	}
+	if req.StartTimestamp != nil && req.StopTimestamp != nil &&
+		req.StartTimestamp.Value > req.StopTimestamp.Value {
+		return status.Error(codes.InvalidArgument, "startTimestamp cannot be after stopTimestamp")
+	}
+
+	if e := a.exportMessages(req, out); e != nil {
+		a.logger.Errorf("api: Failed to export messages from stream %s: %v", req.Stream, e.Err())
+		return e.Err()
+	}
+	return nil
+}
+
+// TriggerCompaction cleans a partition's log on this server immediately
+// rather than waiting for the cleaner interval and streams the progress of
+// its compaction until it finishes. Only one triggered compaction can run on
+// a partition at a time. If the client goes away, the compaction still runs to
+// completion.
+func (a *apiServer) TriggerCompaction(req *proto.TriggerCompactionRequest,
+	out proto.AdminAPI_TriggerCompactionServer) error {
+
+	a.logger.Debugf("api: TriggerCompaction [stream=%s, partition=%d]", req.Stream, req.Partition)
+
+	err := a.ensureAuthorizationPermission(out.Context(), req.Stream, "TriggerCompaction")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return err
+	}
+
+	if req.Stream == "" {
+		return status.Error(codes.InvalidArgument, "No stream provided")
+	}
+
+	partition := a.metadata.GetPartition(req.Stream, req.Partition)
+	if partition == nil {
+		return status.Errorf(codes.NotFound, "No such partition: %d", req.Partition)
+	}
+	if partition.IsPaused() {
+		return status.Errorf(codes.FailedPrecondition, "Partition %d is paused", req.Partition)
+	}
+
+	// Only the latest progress is kept so a slow client can't hold up the
+	// compaction.
+	progressC := make(chan commitlog.CompactionProgress, 1)
+	done, err := partition.TriggerCompaction(func(progress commitlog.CompactionProgress) {
+		select {
+		case <-progressC:
+		default:
+		}
+		progressC <- progress
+	})
+	if err == errCompactionInProgress {
+		return status.Errorf(codes.AlreadyExists, "Partition %d is already being compacted", req.Partition)
+	}
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	var last commitlog.CompactionProgress
+	send := func(progress commitlog.CompactionProgress, finished bool) error {
+		last = progress
+		return out.Send(&proto.TriggerCompactionResponse{
+			SegmentsProcessed: int32(progress.SegmentsProcessed),
+			BytesReclaimed:    progress.BytesReclaimed,
+			KeysCompacted:     progress.KeysCompacted,
+			TombstonesDropped: progress.TombstonesDropped,
+			Done:              finished,
+		})
+	}
+
+	for {
+		select {
+		case progress := <-progressC:
+			if err := send(progress, false); err != nil {
+				return err
+			}
+		case err := <-done:
+			if err != nil {
+				a.logger.Errorf("api: Failed to compact partition [stream=%s, partition=%d]: %v",
+					req.Stream, req.Partition, err)
+				return status.Errorf(codes.Internal, "Failed to compact partition: %v", err)
+			}
+			// Report the final progress if it wasn't sent yet.
+			select {
+			case last = <-progressC:
+			default:
+			}
+			return send(last, true)
+		case <-out.Context().Done():
+			return status.FromContextError(out.Context().Err()).Err()
+		}
+	}
+}
+
+// MergeSegments coalesces runs of consecutive small segments of a partition's
+// log on this server into larger ones, preserving their messages and offsets.
+func (a *apiServer) MergeSegments(ctx context.Context, req *proto.MergeSegmentsRequest) (
+	*proto.MergeSegmentsResponse, error) {
+
+	a.logger.Debugf("api: MergeSegments [stream=%s, partition=%d, targetBytes=%d]",
+		req.Stream, req.Partition, req.TargetBytes)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "MergeSegments")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+	if req.TargetBytes <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "targetBytes must be positive")
+	}
+
+	partition := a.metadata.GetPartition(req.Stream, req.Partition)
+	if partition == nil {
+		return nil, status.Errorf(codes.NotFound, "No such partition: %d", req.Partition)
+	}
+	if partition.IsPaused() {
+		return nil, status.Errorf(codes.FailedPrecondition, "Partition %d is paused", req.Partition)
+	}
+
+	before, after, err := partition.MergeSegments(req.TargetBytes)
+	if err != nil {
+		a.logger.Errorf("api: Failed to merge segments of partition [stream=%s, partition=%d]: %v",
+			req.Stream, req.Partition, err)
+		return nil, status.Errorf(codes.Internal, "Failed to merge segments: %v", err)
+	}
+	return &proto.MergeSegmentsResponse{
+		SegmentsBefore: int32(before),
+		SegmentsAfter:  int32(after),
+	}, nil
+}
+
+// CleanPartition implements the AdminAPI CleanPartition RPC. It applies the
+// retention and compaction rules to the partition's log on its leader, and
+// optionally its followers, immediately and returns a summary of each clean.
+// Unlike TriggerCompaction, this waits for a clean in progress rather than
+// failing.
+func (a *apiServer) CleanPartition(ctx context.Context, req *proto.CleanPartitionRequest) (
+	*proto.CleanPartitionResponse, error) {
+
+	a.logger.Debugf("api: CleanPartition [stream=%s, partition=%d, includeFollowers=%v]",
+		req.Stream, req.Partition, req.IncludeFollowers)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "CleanPartition")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+
+	resp, st := a.metadata.CleanPartition(ctx, req)
+	if st != nil {
+		return nil, st.Err()
+	}
+
+	return resp, nil
+}
+
+// GetReassignmentStatus implements the AdminAPI GetReassignmentStatus RPC. It
+// returns the progress of the latest reassignment of the partition's replicas
+// as applied by this server.
+func (a *apiServer) GetReassignmentStatus(ctx context.Context, req *proto.GetReassignmentStatusRequest) (
+	*proto.GetReassignmentStatusResponse, error) {
+
+	a.logger.Debugf("api: GetReassignmentStatus [stream=%s, partition=%d]", req.Stream, req.Partition)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "GetReassignmentStatus")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+
+	reassignment, st := a.metadata.GetReassignmentStatus(ctx, req.Stream, req.Partition)
+	if st != nil {
+		return nil, st.Err()
+	}
+
+	return &proto.GetReassignmentStatusResponse{
+		TargetReplicas: reassignment.TargetReplicas,
+		CurrentIsr:     reassignment.CurrentISR,
+		BytesRemaining: reassignment.BytesRemaining,
+		StartedAt:      reassignment.StartedAt.UnixNano(),
+		Phase:          reassignment.Phase,
+	}, nil
+}
+
+// CheckMessageExists implements the AdminAPI CheckMessageExists RPC. It
+// reports if a message was written to the partition, identified either by the
+// correlation ID it was published with in journaled mode or by the offset and
+// leader epoch it was acked with, so a client which didn't get an ack can
+// check before retrying. It must be sent to the partition leader.
+func (a *apiServer) CheckMessageExists(ctx context.Context, req *proto.CheckMessageExistsRequest) (
+	*proto.CheckMessageExistsResponse, error) {
+
+	a.logger.Debugf("api: CheckMessageExists [stream=%s, partition=%d, correlationId=%s, offset=%d, leaderEpoch=%d]",
+		req.Stream, req.Partition, req.CorrelationId, req.Offset, req.LeaderEpoch)
+
+	err := a.ensureAuthorizationPermission(ctx, req.Stream, "CheckMessageExists")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if req.Stream == "" {
+		return nil, status.Error(codes.InvalidArgument, "No stream provided")
+	}
+
+	partition := a.metadata.GetPartition(req.Stream, req.Partition)
+	if partition == nil {
+		return nil, status.Errorf(codes.NotFound, "No such partition [stream=%s, partition=%d]",
+			req.Stream, req.Partition)
+	}
+
+	resp, st := partition.CheckMessageExists(req)
+	if st != nil {
+		return nil, st.Err()
+	}
+	return resp, nil
+}
+
+// FetchStreamSkew implements the AdminAPI FetchStreamSkew RPC. It returns how
+// evenly the load of the given streams, or all streams if none are given, is
+// spread across their partitions, based on the partition loads this server
+// has most recently received from the partition leaders.
+func (a *apiServer) FetchStreamSkew(ctx context.Context, req *proto.FetchStreamSkewRequest) (
+	*proto.FetchStreamSkewResponse, error) {
+
+	a.logger.Debugf("api: FetchStreamSkew [streams=%s]", req.Streams)
+
+	err := a.ensureAuthorizationPermission(ctx, "*", "FetchStreamSkew")
+	if err != nil {
+		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+		return nil, err
+	}
+
+	if a.config.Skew.ReportInterval == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Partition load reports are disabled")
+	}
+	for _, stream := range req.Streams {
+		if a.metadata.GetStream(stream) == nil {
+			return nil, status.Errorf(codes.NotFound, "No such stream: %s", stream)
+		}
+	}
+
+	return &proto.FetchStreamSkewResponse{Streams: a.streamSkews(req.Streams)}, nil
+}
+
+// RestoreStreams implements the AdminAPI RestoreStreams RPC. It recreates the
+// given streams, or every stream in the backed up metadata which doesn't
+// exist if none are given, from their latest backups and returns the offset
+// each partition is restored to.
+func (a *apiServer) RestoreStreams(ctx context.Context, req *proto.RestoreStreamsRequest) (
+	*proto.RestoreStreamsResponse, error) {
+
+	a.logger.Debugf("api: RestoreStreams [streams=%s]", req.Streams)
+
+	resources := req.Streams
+	if len(resources) == 0 {
+		resources = []string{"*"}
+	}
+	for _, resource := range resources {
+		err := a.ensureAuthorizationPermission(ctx, resource, "RestoreStreams")
+		if err != nil {
+			a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+			return nil, err
+		}
+	}
+
+	if a.backup == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Backups are not configured")
+	}
+
+	streams := req.Streams
+	if len(streams) == 0 {
+		var st *status.Status
+		streams, st = a.backedUpStreams(ctx)
+		if st != nil {
+			return nil, st.Err()
+		}
+	}
+	for _, stream := range streams {
+		if stream == "" {
+			return nil, status.Error(codes.InvalidArgument, "No stream provided")
+		}
+		if isReservedStream(stream) {
+			return nil, status.Errorf(codes.InvalidArgument, "Stream %s is reserved", stream)
+		}
+	}
+
+	resp := &proto.RestoreStreamsResponse{}
+	for _, stream := range streams {
+		partitions, st := a.restoreStream(ctx, stream)
+		if st != nil {
+			a.logger.Errorf("api: Failed to restore stream %s: %v", stream, st.Err())
+			return nil, st.Err()
+		}
+		resp.Partitions = append(resp.Partitions, partitions...)
+	}
+
+	return resp, nil
+}
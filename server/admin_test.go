@@ -0,0 +1,1770 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math/rand"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure TransferLeader hands off partition leadership to the target broker
+// and that publishes are then accepted by the new leader.
+func TestTransferLeader(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	servers := []*Server{s1, s2}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	client, err := lift.Connect([]string{"localhost:5050", "localhost:5051"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err = client.CreateStream(ctx, "foo", name, lift.ReplicationFactor(2))
+	require.NoError(t, err)
+
+	waitForISR(t, 10*time.Second, name, 0, 2, servers...)
+
+	oldLeader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+	newLeader := s1
+	if oldLeader == s1 {
+		newLeader = s2
+	}
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	_, err = admin.TransferLeader(context.Background(), &proto.TransferLeaderRequest{
+		Stream:                name,
+		Partition:             0,
+		TargetBroker:          newLeader.config.Clustering.ServerID,
+		WaitForLeaderTransfer: true,
+	})
+	require.NoError(t, err)
+
+	// The target should now be leading the partition and the old leader
+	// should have stepped down.
+	require.Equal(t, newLeader, getPartitionLeader(t, 10*time.Second, name, 0, servers...))
+	require.True(t, newLeader.metadata.GetPartition(name, 0).IsLeader())
+	require.False(t, oldLeader.metadata.GetPartition(name, 0).IsLeader())
+
+	// Publishes should be accepted by the new leader.
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ack, err := client.Publish(ctx, name, []byte("hello"), lift.AckPolicyLeader())
+	require.NoError(t, err)
+	require.Equal(t, int64(0), ack.Offset())
+
+	// Transferring to the current leader should be a no-op.
+	_, epoch := newLeader.metadata.GetPartition(name, 0).GetLeader()
+	_, err = admin.TransferLeader(context.Background(), &proto.TransferLeaderRequest{
+		Stream:       name,
+		Partition:    0,
+		TargetBroker: newLeader.config.Clustering.ServerID,
+	})
+	require.NoError(t, err)
+	_, epochAfter := newLeader.metadata.GetPartition(name, 0).GetLeader()
+	require.Equal(t, epoch, epochAfter)
+}
+
+// Ensure TransferLeader is forwarded to the metadata leader when it is sent
+// to a follower.
+func TestTransferLeaderPropagate(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	servers := []*Server{s1, s2}
+	metadataLeader := getMetadataLeader(t, 10*time.Second, servers...)
+	follower := s1
+	if metadataLeader == s1 {
+		follower = s2
+	}
+
+	client, err := lift.Connect([]string{"localhost:5050", "localhost:5051"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err = client.CreateStream(ctx, "foo", name, lift.ReplicationFactor(2))
+	require.NoError(t, err)
+
+	waitForISR(t, 10*time.Second, name, 0, 2, servers...)
+
+	oldLeader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+	newLeader := s1
+	if oldLeader == s1 {
+		newLeader = s2
+	}
+
+	// Send the request to the metadata follower.
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", follower.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	_, err = admin.TransferLeader(context.Background(), &proto.TransferLeaderRequest{
+		Stream:                name,
+		Partition:             0,
+		TargetBroker:          newLeader.config.Clustering.ServerID,
+		WaitForLeaderTransfer: true,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, newLeader, getPartitionLeader(t, 10*time.Second, name, 0, servers...))
+}
+
+// Ensure TransferLeader returns an error if the target broker is not in the
+// partition's ISR or the partition does not exist.
+func TestTransferLeaderInvalid(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	servers := []*Server{s1, s2}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err = client.CreateStream(ctx, "foo", name)
+	require.NoError(t, err)
+
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+	other := s1
+	if leader == s1 {
+		other = s2
+	}
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	// Target is not a replica of the partition.
+	_, err = admin.TransferLeader(context.Background(), &proto.TransferLeaderRequest{
+		Stream:       name,
+		Partition:    0,
+		TargetBroker: other.config.Clustering.ServerID,
+	})
+	require.Error(t, err)
+	st := status.Convert(err)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+	require.Equal(t, ErrReplicaNotInISR.Error(), st.Message())
+
+	// Leadership should not have changed.
+	require.Equal(t, leader, getPartitionLeader(t, 10*time.Second, name, 0, servers...))
+
+	// Partition does not exist.
+	_, err = admin.TransferLeader(context.Background(), &proto.TransferLeaderRequest{
+		Stream:       name,
+		Partition:    1,
+		TargetBroker: other.config.Clustering.ServerID,
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+	// No target broker.
+	_, err = admin.TransferLeader(context.Background(), &proto.TransferLeaderRequest{
+		Stream:    name,
+		Partition: 0,
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+}
+
+// Ensure AllocationStats attributes more allocations to a stream which is
+// published to and consumed from heavily than to one which is mostly idle.
+func TestAllocationStats(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Diagnostics.AllocationSampleRate = 4
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.CreateStream(ctx, "hot", "hot"))
+	require.NoError(t, client.CreateStream(ctx, "cold", "cold"))
+
+	value := make([]byte, 1024)
+	publish := func(stream string, count int) {
+		for i := 0; i < count; i++ {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			_, err := client.Publish(ctx, stream, value)
+			cancel()
+			require.NoError(t, err)
+		}
+	}
+	publish("hot", 200)
+	publish("cold", 8)
+
+	// Read everything back from the hot stream.
+	received := make(chan struct{})
+	count := 0
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	err = client.Subscribe(ctx, "hot", func(msg *lift.Message, err error) {
+		require.NoError(t, err)
+		count++
+		if count == 200 {
+			close(received)
+		}
+	}, lift.StartAtEarliestReceived())
+	require.NoError(t, err)
+	select {
+	case <-received:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Did not receive all expected messages")
+	}
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	resp, err := admin.AllocationStats(context.Background(), &proto.AllocationStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "a", resp.BrokerId)
+	require.Equal(t, int32(4), resp.SampleRate)
+	require.NotNil(t, resp.Gc)
+	require.NotZero(t, resp.Gc.HeapAllocBytes)
+
+	var hot, cold *proto.PartitionAllocations
+	for _, p := range resp.Partitions {
+		switch p.Stream {
+		case "hot":
+			hot = p
+		case "cold":
+			cold = p
+		}
+	}
+	require.NotNil(t, hot)
+	require.NotNil(t, cold)
+	require.Greater(t, hot.ApproxTotalBytes, 10*cold.ApproxTotalBytes)
+	require.Greater(t, hot.ApproxSubscribeBytes, int64(0))
+	require.Equal(t, int64(0), cold.ApproxSubscribeBytes)
+	require.Equal(t, "hot", resp.Partitions[0].Stream)
+
+	// Limit the response to the hottest partition.
+	resp, err = admin.AllocationStats(context.Background(), &proto.AllocationStatsRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Partitions, 1)
+	require.Equal(t, "hot", resp.Partitions[0].Stream)
+}
+
+// Ensure MetadataMemoryStats reports the metadata structures growing with
+// the streams created and returning to their baseline once the streams are
+// deleted.
+func TestMetadataMemoryStats(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	fetchStats := func() (*proto.MetadataMemoryStatsResponse, map[string]*proto.MetadataStructureStats) {
+		resp, err := admin.MetadataMemoryStats(context.Background(), &proto.MetadataMemoryStatsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "a", resp.BrokerId)
+		structures := make(map[string]*proto.MetadataStructureStats, len(resp.Structures))
+		for _, structure := range resp.Structures {
+			structures[structure.Name] = structure
+		}
+		return resp, structures
+	}
+
+	// Create and delete a stream first so the broker cache and load counts
+	// are populated for the baseline.
+	require.NoError(t, client.CreateStream(context.Background(), "warmup", "warmup"))
+	require.NoError(t, client.DeleteStream(context.Background(), "warmup"))
+	baselineResp, baseline := fetchStats()
+	require.Equal(t, int64(0), baseline["streams"].Entries)
+	require.Equal(t, int64(0), baseline["partitions"].Entries)
+
+	// The structures grow with the streams.
+	for i := 0; i < 20; i++ {
+		name := fmt.Sprintf("foo-%d", i)
+		require.NoError(t, client.CreateStream(context.Background(), name, name, lift.Partitions(3)))
+	}
+	resp, structures := fetchStats()
+	require.Equal(t, int64(20), structures["streams"].Entries)
+	require.Equal(t, int64(60), structures["partitions"].Entries)
+	require.Equal(t, int64(60), structures["leaderReports"].MaxEntries)
+	require.LessOrEqual(t, structures["leaderReports"].Entries, structures["leaderReports"].MaxEntries)
+	require.Greater(t, structures["partitions"].ApproxBytes, baseline["partitions"].ApproxBytes)
+	require.Greater(t, resp.ApproxTotalBytes, baselineResp.ApproxTotalBytes)
+
+	// Repeatedly creating and deleting streams returns every structure to
+	// its baseline.
+	for i := 0; i < 20; i++ {
+		name := fmt.Sprintf("foo-%d", i)
+		require.NoError(t, client.DeleteStream(context.Background(), name))
+	}
+	for i := 0; i < 50; i++ {
+		name := fmt.Sprintf("bar-%d", i)
+		require.NoError(t, client.CreateStream(context.Background(), name, name))
+		require.NoError(t, client.DeleteStream(context.Background(), name))
+	}
+	resp, structures = fetchStats()
+	for name, structure := range baseline {
+		require.Equal(t, structure.Entries, structures[name].Entries, name)
+	}
+	require.Equal(t, baselineResp.ApproxTotalBytes, resp.ApproxTotalBytes)
+	require.Equal(t, int64(0), resp.LimitViolations)
+}
+
+// Ensure FetchBrokerStats reports log, ISR, and replication lag metrics for
+// the partitions a broker is a replica of and omits paused and deleted
+// partitions.
+func TestFetchBrokerStats(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	servers := []*Server{s1, s2}
+	metadataLeader := getMetadataLeader(t, 10*time.Second, servers...)
+
+	client, err := lift.Connect([]string{"localhost:5050", "localhost:5051"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err = client.CreateStream(ctx, "foo", name, lift.ReplicationFactor(2))
+	require.NoError(t, err)
+
+	waitForISR(t, 10*time.Second, name, 0, 2, servers...)
+
+	num := 10
+	for i := 0; i < num; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		_, err := client.Publish(ctx, name, []byte("hello"), lift.AckPolicyAll())
+		cancel()
+		require.NoError(t, err)
+	}
+
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+	follower := s1
+	if leader == s1 {
+		follower = s2
+	}
+
+	fetchStats := func(s *Server) *proto.FetchBrokerStatsResponse {
+		conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", s.config.Port), grpc.WithInsecure())
+		require.NoError(t, err)
+		defer conn.Close()
+		resp, err := proto.NewAdminAPIClient(conn).FetchBrokerStats(
+			context.Background(), &proto.FetchBrokerStatsRequest{})
+		require.NoError(t, err)
+		return resp
+	}
+
+	// The follower catches up on its next fetch, so wait for the leader to
+	// report no lag.
+	var stats *proto.PartitionStats
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		resp := fetchStats(leader)
+		require.Equal(t, leader.config.Clustering.ServerID, resp.BrokerId)
+		require.Len(t, resp.Partitions, 1)
+		stats = resp.Partitions[0]
+		require.Len(t, stats.ReplicaLag, 1)
+		if stats.ReplicaLag[0].Lag == 0 {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.Equal(t, name, stats.Stream)
+	require.Equal(t, int32(0), stats.Partition)
+	require.True(t, stats.IsLeader)
+	require.Equal(t, leader.config.Clustering.ServerID, stats.Leader)
+	require.Equal(t, int64(0), stats.LogStartOffset)
+	require.Equal(t, int64(num-1), stats.LogEndOffset)
+	require.Equal(t, int64(num-1), stats.HighWatermark)
+	require.True(t, stats.SizeBytes > 0)
+	require.Equal(t, int32(1), stats.SegmentCount)
+	require.Equal(t, &proto.SegmentRolls{}, stats.SegmentRolls)
+	require.Equal(t, []string{"a", "b"}, stats.Isr)
+	require.Equal(t, int64(num), stats.MessagesIn)
+	require.True(t, stats.BytesIn > 0)
+	require.True(t, stats.BytesOut > 0)
+	require.Equal(t, follower.config.Clustering.ServerID, stats.ReplicaLag[0].Replica)
+	require.Equal(t, int64(num-1), stats.ReplicaLag[0].LastFetchedOffset)
+	require.Equal(t, int64(0), stats.ReplicaLag[0].Lag)
+	require.True(t, stats.ReplicaLag[0].InIsr)
+
+	// Followers report their own log but not replication lag.
+	resp := fetchStats(follower)
+	require.Len(t, resp.Partitions, 1)
+	stats = resp.Partitions[0]
+	require.False(t, stats.IsLeader)
+	require.Equal(t, int64(num-1), stats.LogEndOffset)
+	require.Equal(t, int64(num), stats.MessagesIn)
+	require.Empty(t, stats.ReplicaLag)
+
+	// Paused partitions are omitted.
+	require.NoError(t, client.PauseStream(context.Background(), name))
+	require.Empty(t, fetchStats(metadataLeader).Partitions)
+
+	// Deleted partitions are omitted.
+	require.NoError(t, client.DeleteStream(context.Background(), name))
+	require.Empty(t, fetchStats(metadataLeader).Partitions)
+}
+
+// Ensure the read amplification of a partition compares the bytes read by
+// subscriptions to the bytes appended and is summed per stream in the skew
+// report.
+func TestFetchBrokerStatsReadAmplification(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	require.NoError(t, client.CreateStream(context.Background(), "foo", name))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	_, err = client.Publish(ctx, name, []byte("hello"), lift.AckPolicyAll())
+	cancel()
+	require.NoError(t, err)
+
+	// Read the message with five subscriptions.
+	subscriptions := 5
+	for i := 0; i < subscriptions; i++ {
+		received := make(chan struct{})
+		ctx, cancel := context.WithCancel(context.Background())
+		err := client.Subscribe(ctx, name, func(msg *lift.Message, err error) {
+			require.NoError(t, err)
+			close(received)
+		}, lift.StartAtEarliestReceived())
+		require.NoError(t, err)
+		select {
+		case <-received:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Did not receive expected message")
+		}
+		cancel()
+	}
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	resp, err := proto.NewAdminAPIClient(conn).FetchBrokerStats(
+		context.Background(), &proto.FetchBrokerStatsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Partitions, 1)
+	amp := resp.Partitions[0].ReadAmplification
+	require.NotNil(t, amp)
+	require.True(t, amp.BytesAppended > 0)
+	require.Equal(t, int64(subscriptions)*amp.BytesAppended, amp.HistoricalBytesRead)
+	require.Equal(t, int64(0), amp.TailBytesRead)
+	require.Equal(t, int64(0), amp.ReplicationBytesRead)
+	require.InDelta(t, float64(subscriptions), amp.Factor, 0.01)
+
+	// The skew report sums the read amplification of the stream's partitions.
+	require.NoError(t, s1.reportPartitionLoad())
+	skews := s1.streamSkews([]string{name})
+	require.Len(t, skews, 1)
+	require.NotNil(t, skews[0].ReadAmplification)
+	require.InDelta(t, float64(subscriptions), skews[0].ReadAmplification.Factor, 0.01)
+}
+
+// Ensure FetchBrokerRTTs returns the RTTs measured by each broker once they
+// have probed each other.
+func TestFetchBrokerRTTs(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Clustering.RTTProbeInterval = 100 * time.Millisecond
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2Config.Clustering.RTTProbeInterval = 100 * time.Millisecond
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	leader := getMetadataLeader(t, 10*time.Second, s1, s2)
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", leader.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	// Each broker reports its measurements on the probe after the one which
+	// took them, so wait for both rows to show up on the leader.
+	var resp *proto.FetchBrokerRTTsResponse
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err = admin.FetchBrokerRTTs(context.Background(), &proto.FetchBrokerRTTsRequest{})
+		require.NoError(t, err)
+		if len(resp.Brokers) == 2 && len(resp.Brokers[0].Peers) == 1 && len(resp.Brokers[1].Peers) == 1 {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.Equal(t, leader.config.Clustering.ServerID, resp.BrokerId)
+	require.Len(t, resp.Brokers, 2)
+	require.Equal(t, "a", resp.Brokers[0].Broker)
+	require.Equal(t, "b", resp.Brokers[1].Broker)
+	require.Len(t, resp.Brokers[0].Peers, 1)
+	require.Equal(t, "b", resp.Brokers[0].Peers[0].Peer)
+	require.True(t, resp.Brokers[0].Peers[0].Rtt > 0)
+	require.Len(t, resp.Brokers[1].Peers, 1)
+	require.Equal(t, "a", resp.Brokers[1].Peers[0].Peer)
+	require.True(t, resp.Brokers[1].Peers[0].Rtt > 0)
+}
+
+// Ensure the origin of a stream created by an authenticated client is
+// recorded, returned by DescribeStreams, can be reassigned with
+// UpdateStreamOwner, and survives a snapshot and restore.
+func TestStreamOrigin(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server with TLS client authentication.
+	tlsConfig, err := NewConfig("./configs/tls-authz.yaml")
+	require.NoError(t, err)
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.TLSCert = tlsConfig.TLSCert
+	s1Config.TLSKey = tlsConfig.TLSKey
+	s1Config.TLSClientAuth = tlsConfig.TLSClientAuth
+	s1Config.TLSClientAuthCA = tlsConfig.TLSClientAuthCA
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	certPool := x509.NewCertPool()
+	ca, err := os.ReadFile("./configs/certs/ca-cert.pem")
+	require.NoError(t, err)
+	certPool.AppendCertsFromPEM(ca)
+	certificate, err := tls.LoadX509KeyPair("./configs/certs/client/client-cert.pem",
+		"./configs/certs/client/client-key.pem")
+	require.NoError(t, err)
+	creds := credentials.NewTLS(&tls.Config{
+		ServerName:   "localhost",
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      certPool,
+	})
+
+	dial := func() *grpc.ClientConn {
+		conn, err := grpc.Dial("localhost:5050", grpc.WithTransportCredentials(creds))
+		require.NoError(t, err)
+		return conn
+	}
+	conn := dial()
+	defer conn.Close()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		applicationNameHeader, "billing",
+		applicationVersionHeader, "1.2.3")
+	_, err = client.NewAPIClient(conn).CreateStream(ctx, &client.CreateStreamRequest{
+		Name:              "foo",
+		Subject:           "foo",
+		Partitions:        2,
+		ReplicationFactor: 1,
+		Group:             "workers",
+	})
+	require.NoError(t, err)
+
+	expected := &proto.StreamOrigin{
+		CreatedBy:          "client1",
+		Owner:              "client1",
+		Broker:             "a",
+		ApplicationName:    "billing",
+		ApplicationVersion: "1.2.3",
+		Partitions:         2,
+		ReplicationFactor:  1,
+		Group:              "workers",
+	}
+	describe := func(conn *grpc.ClientConn) *proto.StreamOrigin {
+		resp, err := proto.NewAdminAPIClient(conn).DescribeStreams(context.Background(),
+			&proto.DescribeStreamsRequest{Streams: []string{"foo", "bar"}})
+		require.NoError(t, err)
+		require.Len(t, resp.Streams, 1)
+		require.Equal(t, "foo", resp.Streams[0].Name)
+		require.Len(t, resp.Streams[0].Partitions, 2)
+		return resp.Streams[0].Origin
+	}
+	require.Equal(t, expected, describe(conn))
+
+	// Reassign ownership.
+	_, err = proto.NewAdminAPIClient(conn).UpdateStreamOwner(context.Background(),
+		&proto.UpdateStreamOwnerRequest{Stream: "foo", Owner: "team-billing"})
+	require.NoError(t, err)
+	expected.Owner = "team-billing"
+	require.Equal(t, expected, describe(conn))
+
+	_, err = proto.NewAdminAPIClient(conn).UpdateStreamOwner(context.Background(),
+		&proto.UpdateStreamOwnerRequest{Stream: "bar", Owner: "team-billing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Force a snapshot and restart the server.
+	require.NoError(t, s1.getRaft().Snapshot().Error())
+	conn.Close()
+	s1.Stop()
+	s1 = runServerWithConfig(t, s1.config)
+	defer s1.Stop()
+	getMetadataLeader(t, 10*time.Second, s1)
+	waitForPartition(t, 10*time.Second, "foo", 0, s1)
+
+	conn = dial()
+	defer conn.Close()
+	require.Equal(t, expected, describe(conn))
+}
+
+// Ensure the GetStreamConfig RPC returns the effective configuration of a stream on
+// both the metadata leader and a follower, with defaults filled in for
+// settings the stream wasn't created with.
+func TestGetStreamConfigRPC(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	leader := getMetadataLeader(t, 10*time.Second, s1, s2)
+	follower := s1
+	if follower == leader {
+		follower = s2
+	}
+
+	lc, err := lift.Connect([]string{"localhost:5050", "localhost:5051"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), streamTTLMetadataKey, "3600000")
+	require.NoError(t, lc.CreateStream(ctx, "foo", "foo",
+		lift.SegmentMaxBytes(1024), lift.CompactEnabled(false)))
+	waitForPartition(t, 10*time.Second, "foo", 0, s1, s2)
+
+	for _, s := range []*Server{leader, follower} {
+		conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", s.config.Port), grpc.WithInsecure())
+		require.NoError(t, err)
+		defer conn.Close()
+		admin := proto.NewAdminAPIClient(conn)
+
+		resp, err := admin.GetStreamConfig(context.Background(),
+			&proto.GetStreamConfigRequest{Stream: "foo"})
+		require.NoError(t, err)
+		config := resp.Config
+		require.Equal(t, int64(1024), config.SegmentMaxBytes.Value)
+		require.False(t, config.CompactEnabled.Value)
+		require.Equal(t, time.Hour.Milliseconds(), config.Ttl.Value)
+		require.Equal(t, s.config.Streams.RetentionMaxMessages, config.RetentionMaxMessages.Value)
+		require.Equal(t, s.config.Streams.AutoResumeOnPublish, config.AutoResumeOnPublish.Value)
+		require.Equal(t, int32(s.config.Clustering.MinISR), config.MinIsr.Value)
+
+		_, err = admin.GetStreamConfig(context.Background(),
+			&proto.GetStreamConfigRequest{Stream: "bar"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	}
+}
+
+// Ensure UpdateStreamConfig changes the retention of an existing stream on
+// every replica and rejects settings which can't be updated.
+func TestUpdateStreamConfigRPC(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	leader := getMetadataLeader(t, 10*time.Second, s1, s2)
+	follower := s1
+	if follower == leader {
+		follower = s2
+	}
+
+	lc, err := lift.Connect([]string{"localhost:5050", "localhost:5051"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	require.NoError(t, lc.CreateStream(context.Background(), "foo", "foo",
+		lift.ReplicationFactor(2), lift.SegmentMaxBytes(100),
+		lift.CleanerInterval(50*time.Millisecond)))
+	waitForPartition(t, 10*time.Second, "foo", 0, s1, s2)
+
+	// Update the config through the follower so the request is forwarded to
+	// the metadata leader.
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", follower.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	_, err = admin.UpdateStreamConfig(context.Background(), &proto.UpdateStreamConfigRequest{
+		Stream: "foo",
+		Config: &proto.StreamConfig{RetentionMaxBytes: &proto.NullableInt64{Value: 300}},
+	})
+	require.NoError(t, err)
+
+	// Publish past the retention limit.
+	for i := 0; i < 20; i++ {
+		_, err := lc.Publish(context.Background(), "foo", []byte(fmt.Sprintf("msg-%d", i)),
+			lift.AckPolicyAll())
+		require.NoError(t, err)
+	}
+
+	// Wait for the cleaner to delete the oldest segments on every replica.
+	for _, s := range []*Server{s1, s2} {
+		partition := s.metadata.GetPartition("foo", 0)
+		require.NotNil(t, partition)
+		require.Eventually(t, func() bool {
+			return partition.log.OldestOffset() > 0
+		}, 10*time.Second, 10*time.Millisecond)
+
+		config := s.metadata.GetStream("foo").GetConfig()
+		require.Equal(t, int64(300), config.RetentionMaxBytes.Value)
+		require.Equal(t, int64(100), config.SegmentMaxBytes.Value)
+	}
+
+	// Only retention, segment, and compaction settings can be updated.
+	_, err = admin.UpdateStreamConfig(context.Background(), &proto.UpdateStreamConfigRequest{
+		Stream: "foo",
+		Config: &proto.StreamConfig{MinIsr: &proto.NullableInt32{Value: 2}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = admin.UpdateStreamConfig(context.Background(), &proto.UpdateStreamConfigRequest{
+		Stream: "foo",
+		Config: &proto.StreamConfig{RetentionMaxBytes: &proto.NullableInt64{Value: -1}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = admin.UpdateStreamConfig(context.Background(), &proto.UpdateStreamConfigRequest{
+		Stream: "foo",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = admin.UpdateStreamConfig(context.Background(), &proto.UpdateStreamConfigRequest{
+		Stream: "bar",
+		Config: &proto.StreamConfig{RetentionMaxBytes: &proto.NullableInt64{Value: 300}},
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// Ensure NackMessage moves a message to the stream's dead letter queue with
+// headers recording its origin and advances the consumer's cursor past it.
+func TestNackMessage(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.CursorsStream.Partitions = 1
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.CreateStream(context.Background(), "dlq", "dlq"))
+	ctx := metadata.AppendToOutgoingContext(context.Background(), deadLetterQueueMetadataKey, "dlq")
+	require.NoError(t, client.CreateStream(ctx, "foo", "foo"))
+	ctx = metadata.AppendToOutgoingContext(context.Background(), deadLetterQueueMetadataKey, "missing")
+	require.NoError(t, client.CreateStream(ctx, "bar", "bar"))
+	require.NoError(t, client.CreateStream(context.Background(), "baz", "baz"))
+
+	// A stream can't be its own dead letter queue.
+	ctx = metadata.AppendToOutgoingContext(context.Background(), deadLetterQueueMetadataKey, "qux")
+	err = client.CreateStream(ctx, "qux", "qux")
+	require.Error(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = client.Publish(context.Background(), "foo", []byte(fmt.Sprintf("msg-%d", i)),
+			lift.Key([]byte("key")), lift.Header("type", []byte("order")), lift.AckPolicyAll())
+		require.NoError(t, err)
+	}
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	resp, err := admin.NackMessage(context.Background(), &proto.NackMessageRequest{
+		Stream:   "foo",
+		Offset:   1,
+		Reason:   "failed to parse",
+		CursorId: "consumer",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), resp.DeadLetterOffset)
+
+	// The message should be in the dead letter queue with its origin.
+	msgs := make(chan *lift.Message, 1)
+	subCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err = client.Subscribe(subCtx, "dlq", func(msg *lift.Message, err error) {
+		require.NoError(t, err)
+		msgs <- msg
+	}, lift.StartAtEarliestReceived())
+	require.NoError(t, err)
+
+	select {
+	case msg := <-msgs:
+		require.Equal(t, []byte("msg-1"), msg.Value())
+		require.Equal(t, []byte("key"), msg.Key())
+		headers := msg.Headers()
+		require.Equal(t, "order", string(headers["type"]))
+		require.Equal(t, "foo", string(headers[deadLetterStreamHeader]))
+		require.Equal(t, "0", string(headers[deadLetterPartitionHeader]))
+		require.Equal(t, "1", string(headers[deadLetterOffsetHeader]))
+		require.Equal(t, "failed to parse", string(headers[deadLetterReasonHeader]))
+		require.NotEmpty(t, headers[deadLetterTimestampHeader])
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive expected message")
+	}
+
+	// The consumer's cursor should have advanced to the nacked message.
+	offset, err := client.FetchCursor(context.Background(), "consumer", "foo", 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), offset)
+
+	// Nacking an earlier message doesn't move the cursor back.
+	_, err = admin.NackMessage(context.Background(), &proto.NackMessageRequest{
+		Stream:   "foo",
+		Offset:   0,
+		CursorId: "consumer",
+	})
+	require.NoError(t, err)
+	offset, err = client.FetchCursor(context.Background(), "consumer", "foo", 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), offset)
+
+	// The dead letter queue must exist.
+	_, err = admin.NackMessage(context.Background(), &proto.NackMessageRequest{
+		Stream:   "bar",
+		CursorId: "consumer",
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// The stream must have a dead letter queue.
+	_, err = admin.NackMessage(context.Background(), &proto.NackMessageRequest{
+		Stream:   "baz",
+		CursorId: "consumer",
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The message must exist.
+	_, err = admin.NackMessage(context.Background(), &proto.NackMessageRequest{
+		Stream:   "foo",
+		Offset:   5,
+		CursorId: "consumer",
+	})
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+}
+
+// exportAll runs an export to completion and returns the chunks received.
+func exportAll(t *testing.T, admin proto.AdminAPIClient, req *proto.ExportMessagesRequest) []*proto.ExportMessagesResponse {
+	stream, err := admin.ExportMessages(context.Background(), req)
+	require.NoError(t, err)
+	var chunks []*proto.ExportMessagesResponse
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return chunks
+		}
+		require.NoError(t, err)
+		chunks = append(chunks, resp)
+	}
+}
+
+// decodeJSONLines decodes the records in the JSON lines export chunks.
+func decodeJSONLines(t *testing.T, chunks []*proto.ExportMessagesResponse) []*exportRecord {
+	var records []*exportRecord
+	for _, chunk := range chunks {
+		lines := bytes.Split(bytes.TrimSuffix(chunk.Data, []byte("\n")), []byte("\n"))
+		require.Len(t, lines, int(chunk.Messages))
+		for _, line := range lines {
+			record := new(exportRecord)
+			require.NoError(t, json.Unmarshal(line, record))
+			require.Equal(t, chunk.Partition, record.Partition)
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// Ensure ExportMessages exports the committed messages of a stream as JSON
+// lines or Parquet, within the requested bounds, and can be resumed.
+func TestExportMessages(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.CreateStream(context.Background(), "foo", "foo", lift.Partitions(2)))
+
+	// Partition 0 has binary keys and partition 1 has none.
+	for partition := int32(0); partition < 2; partition++ {
+		for i := 0; i < 5; i++ {
+			opts := []lift.MessageOption{
+				lift.ToPartition(partition),
+				lift.Header("seq", []byte(fmt.Sprintf("%d", i))),
+				lift.AckPolicyAll(),
+			}
+			if partition == 0 {
+				opts = append(opts, lift.Key([]byte{0xff, byte(i)}))
+			}
+			_, err = client.Publish(context.Background(), "foo", []byte(fmt.Sprintf("msg-%d-%d", partition, i)), opts...)
+			require.NoError(t, err)
+		}
+	}
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	verify := func(records []*exportRecord, partition int32, offsets ...int64) {
+		for _, record := range records {
+			if record.Partition != partition {
+				continue
+			}
+			require.NotEmpty(t, offsets)
+			offset := offsets[0]
+			offsets = offsets[1:]
+			require.Equal(t, "foo", record.Stream)
+			require.Equal(t, offset, record.Offset)
+			require.NotZero(t, record.Timestamp)
+			require.Equal(t, fmt.Sprintf("msg-%d-%d", partition, offset), string(record.Value))
+			require.Equal(t, fmt.Sprintf("%d", offset), string(record.Headers["seq"]))
+			if partition == 0 {
+				require.Equal(t, []byte{0xff, byte(offset)}, record.Key)
+			} else {
+				require.Empty(t, record.Key)
+			}
+		}
+		require.Empty(t, offsets)
+	}
+
+	// Export the whole stream as JSON lines.
+	chunks := exportAll(t, admin, &proto.ExportMessagesRequest{
+		Stream:    "foo",
+		Format:    proto.ExportFormat_JSONL,
+		ChunkSize: 3,
+	})
+	require.Len(t, chunks, 4)
+	records := decodeJSONLines(t, chunks)
+	require.Len(t, records, 10)
+	verify(records, 0, 0, 1, 2, 3, 4)
+	verify(records, 1, 0, 1, 2, 3, 4)
+
+	// Binary keys are base64-encoded.
+	require.Contains(t, string(chunks[0].Data), `"key":"/wA="`)
+
+	// Resume the export after the first chunk.
+	chunks = exportAll(t, admin, &proto.ExportMessagesRequest{
+		Stream:      "foo",
+		Format:      proto.ExportFormat_JSONL,
+		ResumeToken: chunks[0].ResumeToken,
+	})
+	records = decodeJSONLines(t, chunks)
+	require.Len(t, records, 7)
+	verify(records, 0, 3, 4)
+	verify(records, 1, 0, 1, 2, 3, 4)
+
+	// Resuming after the last chunk exports nothing.
+	chunks = exportAll(t, admin, &proto.ExportMessagesRequest{
+		Stream:      "foo",
+		Format:      proto.ExportFormat_JSONL,
+		ResumeToken: chunks[len(chunks)-1].ResumeToken,
+	})
+	require.Empty(t, chunks)
+
+	// Export a range of one partition as Parquet.
+	chunks = exportAll(t, admin, &proto.ExportMessagesRequest{
+		Stream:      "foo",
+		Partitions:  []int32{1},
+		StartOffset: &proto.NullableInt64{Value: 1},
+		StopOffset:  &proto.NullableInt64{Value: 3},
+		Format:      proto.ExportFormat_PARQUET,
+	})
+	require.Len(t, chunks, 1)
+	require.Equal(t, int32(3), chunks[0].Messages)
+	records = readParquetRecords(t, chunks[0].Data)
+	verify(records, 1, 1, 2, 3)
+
+	// Export the whole stream as Parquet.
+	chunks = exportAll(t, admin, &proto.ExportMessagesRequest{
+		Stream: "foo",
+		Format: proto.ExportFormat_PARQUET,
+	})
+	require.Len(t, chunks, 2)
+	records = nil
+	for _, chunk := range chunks {
+		records = append(records, readParquetRecords(t, chunk.Data)...)
+	}
+	require.Len(t, records, 10)
+	verify(records, 0, 0, 1, 2, 3, 4)
+	verify(records, 1, 0, 1, 2, 3, 4)
+
+	// Exporting a missing stream or partition fails.
+	stream, err := admin.ExportMessages(context.Background(), &proto.ExportMessagesRequest{Stream: "bar"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	stream, err = admin.ExportMessages(context.Background(), &proto.ExportMessagesRequest{
+		Stream:     "foo",
+		Partitions: []int32{2},
+	})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// An invalid resume token is rejected.
+	stream, err = admin.ExportMessages(context.Background(), &proto.ExportMessagesRequest{
+		Stream:      "foo",
+		ResumeToken: "not a token!",
+	})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// Ensure TriggerCompaction compacts a partition's log immediately, reporting
+// its progress, and that only one triggered compaction runs at a time.
+func TestTriggerCompaction(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server with compaction enabled and a segment per message.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Streams.SegmentMaxBytes = 1
+	s1Config.Streams.Compact = true
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.CreateStream(context.Background(), "foo", "foo"))
+
+	for _, key := range []string{"a", "b"} {
+		for i := 0; i < 5; i++ {
+			_, err = client.Publish(context.Background(), "foo", []byte(fmt.Sprintf("%s-%d", key, i)),
+				lift.Key([]byte(key)), lift.AckPolicyAll())
+			require.NoError(t, err)
+		}
+	}
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	// Only one triggered compaction can run at a time.
+	partition := s1.metadata.GetPartition("foo", 0)
+	require.NotNil(t, partition)
+	atomic.StoreInt32(&partition.compacting, 1)
+	stream, err := admin.TriggerCompaction(context.Background(), &proto.TriggerCompactionRequest{Stream: "foo"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	atomic.StoreInt32(&partition.compacting, 0)
+
+	stream, err = admin.TriggerCompaction(context.Background(), &proto.TriggerCompactionRequest{Stream: "foo"})
+	require.NoError(t, err)
+	var last *proto.TriggerCompactionResponse
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if last != nil {
+			require.False(t, last.Done)
+			require.GreaterOrEqual(t, resp.SegmentsProcessed, last.SegmentsProcessed)
+		}
+		last = resp
+	}
+	require.NotNil(t, last)
+	require.True(t, last.Done)
+	// Every message but the latest for each key is removed. The latest for
+	// "b" is in the active segment, which isn't compacted.
+	require.Equal(t, int64(8), last.KeysCompacted)
+	require.Greater(t, last.BytesReclaimed, int64(0))
+
+	// Only the latest value for each key should remain.
+	msgs := make(chan *lift.Message, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err = client.Subscribe(ctx, "foo", func(msg *lift.Message, err error) {
+		require.NoError(t, err)
+		msgs <- msg
+	}, lift.StartAtEarliestReceived())
+	require.NoError(t, err)
+
+	for _, expected := range []struct {
+		offset int64
+		value  string
+	}{{4, "a-4"}, {9, "b-4"}} {
+		select {
+		case msg := <-msgs:
+			require.Equal(t, expected.offset, msg.Offset())
+			require.Equal(t, expected.value, string(msg.Value()))
+		case <-time.After(5 * time.Second):
+			t.Fatal("Did not receive expected message")
+		}
+	}
+
+	// Compacting a missing partition fails.
+	stream, err = admin.TriggerCompaction(context.Background(), &proto.TriggerCompactionRequest{
+		Stream:    "foo",
+		Partition: 1,
+	})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// Ensure CleanPartition cleans a partition's log on its leader, and its
+// followers if requested, reports when compaction is disabled, and is safe to
+// call on readonly and paused partitions.
+func TestCleanPartition(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure servers with compaction enabled and a segment per message.
+	// Followers whose first replication request fails before the leader
+	// starts don't wait long to retry.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Streams.SegmentMaxBytes = 1
+	s1Config.Streams.Compact = true
+	s1Config.Clustering.ReplicaMaxIdleWait = 500 * time.Millisecond
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	s2Config := getTestConfig("b", false, 5051)
+	s2Config.Streams.SegmentMaxBytes = 1
+	s2Config.Streams.Compact = true
+	s2Config.Clustering.ReplicaMaxIdleWait = 500 * time.Millisecond
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	servers := []*Server{s1, s2}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	client, err := lift.Connect([]string{"localhost:5050", "localhost:5051"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.CreateStream(context.Background(), "foo", "foo",
+		lift.ReplicationFactor(2)))
+	require.NoError(t, client.CreateStream(context.Background(), "bar", "bar",
+		lift.ReplicationFactor(2), lift.CompactEnabled(false), lift.RetentionMaxMessages(2)))
+	waitForISR(t, 10*time.Second, "foo", 0, 2, servers...)
+	waitForISR(t, 10*time.Second, "bar", 0, 2, servers...)
+
+	for _, stream := range []string{"foo", "bar"} {
+		for _, key := range []string{"a", "b"} {
+			for i := 0; i < 5; i++ {
+				_, err = client.Publish(context.Background(), stream, []byte(fmt.Sprintf("%s-%d", key, i)),
+					lift.Key([]byte(key)), lift.AckPolicyAll())
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	// Send requests to the follower to ensure they're routed to the leader.
+	leader := getPartitionLeader(t, 10*time.Second, "foo", 0, servers...)
+	follower := s1
+	if leader == s1 {
+		follower = s2
+	}
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", follower.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	resp, err := admin.CleanPartition(context.Background(), &proto.CleanPartitionRequest{Stream: "foo"})
+	require.NoError(t, err)
+	require.Len(t, resp.Replicas, 1)
+	result := resp.Replicas[0]
+	require.Empty(t, result.Error)
+	require.Equal(t, leader.config.Clustering.ServerID, result.Broker)
+	require.True(t, result.IsLeader)
+	require.True(t, result.CompactEnabled)
+	require.False(t, result.Paused)
+	// Every message but the latest for each key is removed. The latest for
+	// "b" is in the active segment, which isn't compacted.
+	require.Equal(t, int32(10), result.SegmentsBefore)
+	require.Equal(t, int32(2), result.SegmentsAfter)
+	require.Equal(t, int64(8), result.KeysCompacted)
+	require.Greater(t, result.BytesReclaimed, int64(0))
+	require.Greater(t, result.Duration, int64(0))
+	require.Equal(t, 2, leader.metadata.GetPartition("foo", 0).log.SegmentCount())
+
+	// Followers are cleaned after the leader if requested.
+	resp, err = admin.CleanPartition(context.Background(), &proto.CleanPartitionRequest{
+		Stream:           "foo",
+		IncludeFollowers: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Replicas, 2)
+	require.Equal(t, leader.config.Clustering.ServerID, resp.Replicas[0].Broker)
+	require.Equal(t, int64(0), resp.Replicas[0].KeysCompacted)
+	result = resp.Replicas[1]
+	require.Empty(t, result.Error)
+	require.Equal(t, follower.config.Clustering.ServerID, result.Broker)
+	require.False(t, result.IsLeader)
+	require.Equal(t, int32(10), result.SegmentsBefore)
+	require.Less(t, result.SegmentsAfter, result.SegmentsBefore)
+	require.Greater(t, result.KeysCompacted, int64(0))
+
+	// Streams without compaction only have retention applied, which is also
+	// done on readonly partitions.
+	require.NoError(t, client.SetStreamReadonly(context.Background(), "bar"))
+	resp, err = admin.CleanPartition(context.Background(), &proto.CleanPartitionRequest{Stream: "bar"})
+	require.NoError(t, err)
+	require.Len(t, resp.Replicas, 1)
+	result = resp.Replicas[0]
+	require.Empty(t, result.Error)
+	require.False(t, result.CompactEnabled)
+	require.Equal(t, int32(10), result.SegmentsBefore)
+	require.Equal(t, int32(2), result.SegmentsAfter)
+	require.Equal(t, int64(0), result.KeysCompacted)
+	require.Greater(t, result.BytesReclaimed, int64(0))
+
+	// Paused partitions aren't cleaned since their logs are closed.
+	require.NoError(t, client.PauseStream(context.Background(), "foo"))
+	waitForPause(t, 5*time.Second, leader.metadata.GetPartition("foo", 0))
+	resp, err = admin.CleanPartition(context.Background(), &proto.CleanPartitionRequest{Stream: "foo"})
+	require.NoError(t, err)
+	require.Len(t, resp.Replicas, 1)
+	require.Empty(t, resp.Replicas[0].Error)
+	require.True(t, resp.Replicas[0].Paused)
+	require.Equal(t, int32(0), resp.Replicas[0].SegmentsBefore)
+
+	// Cleaning a missing partition fails.
+	_, err = admin.CleanPartition(context.Background(), &proto.CleanPartitionRequest{
+		Stream:    "foo",
+		Partition: 1,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// Ensure MergeSegments coalesces the small segments of a partition's log
+// without losing or reordering messages.
+func TestMergeSegments(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server with a segment per message.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Streams.SegmentMaxBytes = 1
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.CreateStream(context.Background(), "foo", "foo"))
+
+	for i := 0; i < 10; i++ {
+		_, err = client.Publish(context.Background(), "foo", []byte(fmt.Sprintf("%d", i)), lift.AckPolicyAll())
+		require.NoError(t, err)
+	}
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	_, err = admin.MergeSegments(context.Background(), &proto.MergeSegmentsRequest{Stream: "foo"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = admin.MergeSegments(context.Background(), &proto.MergeSegmentsRequest{
+		Stream:      "foo",
+		Partition:   1,
+		TargetBytes: 1024 * 1024,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Every segment but the active one is merged.
+	resp, err := admin.MergeSegments(context.Background(), &proto.MergeSegmentsRequest{
+		Stream:      "foo",
+		TargetBytes: 1024 * 1024,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(10), resp.SegmentsBefore)
+	require.Equal(t, int32(2), resp.SegmentsAfter)
+
+	msgs := make(chan *lift.Message, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err = client.Subscribe(ctx, "foo", func(msg *lift.Message, err error) {
+		require.NoError(t, err)
+		msgs <- msg
+	}, lift.StartAtEarliestReceived())
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		select {
+		case msg := <-msgs:
+			require.Equal(t, int64(i), msg.Offset())
+			require.Equal(t, fmt.Sprintf("%d", i), string(msg.Value()))
+		case <-time.After(5 * time.Second):
+			t.Fatal("Did not receive expected message")
+		}
+	}
+}
+
+// Ensure FetchStreamSkew identifies the hot partition of a stream published to
+// with a Zipfian key distribution along with its dominant key, and that the
+// skew is published to the activity stream.
+func TestFetchStreamSkew(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.ActivityStream.Enabled = true
+	s1Config.ActivityStream.PartitionEvents = true
+	s1Config.ActivityStream.PublishAckPolicy = client.AckPolicy_LEADER
+	s1Config.Skew.ReportInterval = 250 * time.Millisecond
+	s1Config.Skew.Threshold = 1.5
+	s1Config.Skew.MinRate = 1
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	lc, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	const numPartitions = 4
+	require.NoError(t, lc.CreateStream(context.Background(), "foo", "foo", lift.Partitions(numPartitions)))
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	_, err = admin.FetchStreamSkew(context.Background(), &proto.FetchStreamSkewRequest{
+		Streams: []string{"bar"},
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Publish keys drawn from a Zipfian distribution, partitioning them by
+	// hash, so that the most frequent key dominates its partition.
+	var (
+		zipf   = rand.NewZipf(rand.New(rand.NewSource(1)), 2, 1, 99)
+		counts = make([]int, numPartitions)
+	)
+	partitionOf := func(key []byte) int32 {
+		h := fnv.New32a()
+		h.Write(key)
+		return int32(h.Sum32() % numPartitions)
+	}
+	for i := 0; i < 2000; i++ {
+		key := []byte(fmt.Sprintf("key-%d", zipf.Uint64()))
+		partition := partitionOf(key)
+		counts[partition]++
+		_, err := lc.Publish(context.Background(), "foo", []byte("hello"),
+			lift.Key(key), lift.ToPartition(partition), lift.AckPolicyLeader())
+		require.NoError(t, err)
+	}
+	hot := partitionOf([]byte("key-0"))
+	for partition, count := range counts {
+		require.LessOrEqual(t, count, counts[hot], "partition %d", partition)
+	}
+
+	// Append rates are only updated every few seconds, so wait for the skew
+	// to be detected.
+	var skew *proto.StreamSkew
+	deadline := time.Now().Add(20 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := admin.FetchStreamSkew(context.Background(), &proto.FetchStreamSkewRequest{
+			Streams: []string{"foo"},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Streams, 1)
+		skew = resp.Streams[0]
+		if skew.Skewed {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	require.True(t, skew.Skewed)
+	require.Equal(t, "foo", skew.Stream)
+	require.Equal(t, hot, skew.HotPartition)
+	require.Len(t, skew.Partitions, numPartitions)
+	require.Greater(t, skew.MaxRate, skew.MeanRate)
+	require.NotEmpty(t, skew.TopKeys)
+	require.Equal(t, "key-0", string(skew.TopKeys[0].Key))
+
+	// The leader also reports the partition's top keys in its stats.
+	stats, err := admin.FetchBrokerStats(context.Background(), &proto.FetchBrokerStatsRequest{})
+	require.NoError(t, err)
+	for _, partition := range stats.Partitions {
+		if partition.Stream == "foo" && partition.Partition == hot {
+			require.NotEmpty(t, partition.TopKeys)
+			require.Equal(t, "key-0", string(partition.TopKeys[0].Key))
+		}
+	}
+
+	// The metadata leader publishes the skew to the activity stream.
+	msgs := make(chan *lift.Message, 64)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err = lc.Subscribe(ctx, activityStream, func(msg *lift.Message, err error) {
+		require.NoError(t, err)
+		msgs <- msg
+	}, lift.StartAtEarliestReceived())
+	require.NoError(t, err)
+
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case msg := <-msgs:
+			if string(msg.Headers()[activityOpHeader]) != "REPORT_PARTITION_SKEW" {
+				continue
+			}
+			event := new(proto.StreamSkew)
+			require.NoError(t, event.Unmarshal(msg.Value()))
+			require.Equal(t, "foo", event.Stream)
+			require.True(t, event.Skewed)
+			require.Equal(t, hot, event.HotPartition)
+			require.NotEmpty(t, event.TopKeys)
+			require.Equal(t, "key-0", string(event.TopKeys[0].Key))
+			return
+		case <-timeout:
+			t.Fatal("Did not receive skew activity event")
+		}
+	}
+}
+
+// Ensure DeletePartitionData advances the log start offset of each replica,
+// rejects offsets past the HW, and that subscribers before the new log start
+// offset receive an OutOfRange status and can resume at it.
+func TestDeletePartitionData(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	servers := []*Server{s1, s2}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	lc, err := lift.Connect([]string{"localhost:5050", "localhost:5051"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	name := "foo"
+	require.NoError(t, lc.CreateStream(context.Background(), "foo", name, lift.ReplicationFactor(2)))
+	waitForISR(t, 10*time.Second, name, 0, 2, servers...)
+
+	for i := 0; i < 10; i++ {
+		_, err = lc.Publish(context.Background(), name, []byte(fmt.Sprintf("%d", i)), lift.AckPolicyAll())
+		require.NoError(t, err)
+	}
+	waitForHW(t, 10*time.Second, name, 0, 9, servers...)
+
+	// Send the request to the metadata follower so that it's propagated.
+	follower := s2
+	if s2.metadata.IsLeader() {
+		follower = s1
+	}
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", follower.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	// Uncommitted messages can't be deleted.
+	_, err = admin.DeletePartitionData(context.Background(), &proto.DeletePartitionDataRequest{
+		Stream: name,
+		Offset: 11,
+	})
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+	_, err = admin.DeletePartitionData(context.Background(), &proto.DeletePartitionDataRequest{
+		Stream:    name,
+		Partition: 1,
+		Offset:    1,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = admin.DeletePartitionData(context.Background(), &proto.DeletePartitionDataRequest{
+		Stream: name,
+		Offset: 4,
+	})
+	require.NoError(t, err)
+	deadline := time.Now().Add(10 * time.Second)
+	for _, s := range servers {
+		for s.metadata.GetPartition(name, 0).log.LogStartOffset() != 4 {
+			if time.Now().After(deadline) {
+				t.Fatalf("Log start offset of server %s not advanced", s.config.Clustering.ServerID)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		require.Equal(t, int64(4), s.metadata.GetPartition(name, 0).log.OldestOffset())
+	}
+
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+	leaderConn, err := grpc.Dial(fmt.Sprintf("localhost:%d", leader.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer leaderConn.Close()
+	apiClient := client.NewAPIClient(leaderConn)
+
+	// The log start offset is returned in the FetchPartitionMetadata headers.
+	var header metadata.MD
+	_, err = apiClient.FetchPartitionMetadata(context.Background(),
+		&client.FetchPartitionMetadataRequest{Stream: name}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Equal(t, []string{"4"}, header.Get(logStartOffsetHeader))
+
+	// Subscribing before the log start offset fails with OutOfRange.
+	sub, err := apiClient.Subscribe(context.Background(), &client.SubscribeRequest{
+		Stream:        name,
+		StartPosition: client.StartPosition_OFFSET,
+		StartOffset:   2,
+	})
+	require.NoError(t, err)
+	_, err = sub.Recv()
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+	require.Contains(t, status.Convert(err).Message(), "log start offset 4")
+
+	// Subscribers can resume at the log start offset.
+	msgs := make(chan *lift.Message, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err = lc.Subscribe(ctx, name, func(msg *lift.Message, err error) {
+		require.NoError(t, err)
+		msgs <- msg
+	}, lift.StartAtEarliestReceived())
+	require.NoError(t, err)
+	select {
+	case msg := <-msgs:
+		require.Equal(t, int64(4), msg.Offset())
+		require.Equal(t, "4", string(msg.Value()))
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive expected message")
+	}
+
+	// Deleting before an earlier offset is a no-op.
+	_, err = admin.DeletePartitionData(context.Background(), &proto.DeletePartitionDataRequest{
+		Stream: name,
+		Offset: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), leader.metadata.GetPartition(name, 0).log.LogStartOffset())
+}
+
+// Ensure DeletePartition removes a partition and its data from every replica
+// and from the broker load counts, leaving the stream's other partitions, and
+// rejects deleting missing partitions and the last partition of a stream.
+func TestDeletePartition(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	servers := []*Server{s1, s2}
+	leader := getMetadataLeader(t, 10*time.Second, servers...)
+
+	lc, err := lift.Connect([]string{"localhost:5050", "localhost:5051"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	name := "foo"
+	require.NoError(t, lc.CreateStream(context.Background(), "foo", name,
+		lift.Partitions(3), lift.ReplicationFactor(2)))
+	for id := int32(0); id < 3; id++ {
+		waitForPartition(t, 10*time.Second, name, id, servers...)
+	}
+	before := leader.metadata.BrokerPartitionCounts()
+
+	// Send the requests to the metadata follower so that they're propagated.
+	follower := s2
+	if s2.metadata.IsLeader() {
+		follower = s1
+	}
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", follower.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	_, err = admin.DeletePartition(context.Background(), &proto.DeletePartitionRequest{
+		Stream:    name,
+		Partition: 3,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = admin.DeletePartition(context.Background(), &proto.DeletePartitionRequest{
+		Stream:    name,
+		Partition: 1,
+	})
+	require.NoError(t, err)
+	deadline := time.Now().Add(10 * time.Second)
+	for _, s := range servers {
+		for s.metadata.GetPartition(name, 1) != nil {
+			if time.Now().After(deadline) {
+				t.Fatalf("Partition not deleted on server %s", s.config.Clustering.ServerID)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		require.Equal(t, []int32{0, 2}, s.metadata.GetStream(name).GetPartitionIDs())
+		_, err := os.Stat(s.partitionDir(name, 1))
+		require.True(t, os.IsNotExist(err))
+		_, err = os.Stat(s.partitionDir(name, 0))
+		require.NoError(t, err)
+	}
+	after := leader.metadata.BrokerPartitionCounts()
+	require.Equal(t, before["a"]-1, after["a"])
+	require.Equal(t, before["b"]-1, after["b"])
+
+	// The deleted partition is left out of the stream's metadata.
+	meta, err := lc.FetchMetadata(context.Background())
+	require.NoError(t, err)
+	partitions := meta.GetStream(name).Partitions()
+	require.Len(t, partitions, 2)
+	require.NotContains(t, partitions, int32(1))
+
+	_, err = admin.DeletePartition(context.Background(), &proto.DeletePartitionRequest{
+		Stream:    name,
+		Partition: 2,
+	})
+	require.NoError(t, err)
+
+	// The last partition can only be deleted along with the stream.
+	_, err = admin.DeletePartition(context.Background(), &proto.DeletePartitionRequest{
+		Stream: name,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.NotNil(t, leader.metadata.GetPartition(name, 0))
+}
@@ -0,0 +1,253 @@
+package server
+
+import (
+	"math"
+	"runtime/metrics"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// allocSiteSubscribe is reported when a message is read from the log and
+// handed to a subscription.
+const allocSiteSubscribe = "subscribe"
+
+// Runtime metrics used to populate GCStats.
+const (
+	metricGCCycles        = "/gc/cycles/total:gc-cycles"
+	metricGCHeapAllocs    = "/gc/heap/allocs:bytes"
+	metricHeapObjectBytes = "/memory/classes/heap/objects:bytes"
+	metricGCPauses        = "/sched/pauses/total/gc:seconds"
+)
+
+// allocationTracker keeps approximate accounting of the bytes allocated on
+// behalf of each partition hosted by the server. Rather than recording every
+// allocation, only one in sampleRate calls at a given site is recorded and its
+// size is scaled by sampleRate. This keeps the overhead on the publish and
+// subscribe paths to a single atomic increment in the common case, at the cost
+// of the figures being estimates.
+type allocationTracker struct {
+	mu         sync.RWMutex
+	sampleRate int64
+	partitions map[string]map[int32]*partitionAllocations
+}
+
+// newAllocationTracker creates an allocationTracker which records one in
+// sampleRate allocations. A sampleRate of 0 or less disables accounting.
+func newAllocationTracker(sampleRate int) *allocationTracker {
+	return &allocationTracker{
+		sampleRate: int64(sampleRate),
+		partitions: make(map[string]map[int32]*partitionAllocations),
+	}
+}
+
+// forPartition returns the partitionAllocations for the given partition,
+// creating it if it doesn't exist. The same instance is returned across
+// pauses and leader changes so accounting is retained for the lifetime of the
+// partition on this server.
+func (a *allocationTracker) forPartition(stream string, partitionID int32) *partitionAllocations {
+	a.mu.RLock()
+	alloc, ok := a.partitions[stream][partitionID]
+	a.mu.RUnlock()
+	if ok {
+		return alloc
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	partitions, ok := a.partitions[stream]
+	if !ok {
+		partitions = make(map[int32]*partitionAllocations)
+		a.partitions[stream] = partitions
+	}
+	alloc, ok = partitions[partitionID]
+	if !ok {
+		alloc = &partitionAllocations{
+			stream:     stream,
+			partition:  partitionID,
+			sampleRate: a.sampleRate,
+		}
+		partitions[partitionID] = alloc
+	}
+	return alloc
+}
+
+// remove stops tracking the given partition, e.g. when it is deleted.
+func (a *allocationTracker) remove(stream string, partitionID int32) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	partitions, ok := a.partitions[stream]
+	if !ok {
+		return
+	}
+	delete(partitions, partitionID)
+	if len(partitions) == 0 {
+		delete(a.partitions, stream)
+	}
+}
+
+// top returns the accounting for the partitions with the most bytes
+// allocated, largest first, along with the total across all partitions. If
+// limit is 0 or less, all partitions are returned.
+func (a *allocationTracker) top(limit int) ([]*proto.PartitionAllocations, int64) {
+	a.mu.RLock()
+	stats := make([]*proto.PartitionAllocations, 0, len(a.partitions))
+	for _, partitions := range a.partitions {
+		for _, alloc := range partitions {
+			stats = append(stats, alloc.snapshot())
+		}
+	}
+	a.mu.RUnlock()
+
+	var total int64
+	for _, s := range stats {
+		total += s.ApproxTotalBytes
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].ApproxTotalBytes != stats[j].ApproxTotalBytes {
+			return stats[i].ApproxTotalBytes > stats[j].ApproxTotalBytes
+		}
+		if stats[i].Stream != stats[j].Stream {
+			return stats[i].Stream < stats[j].Stream
+		}
+		return stats[i].Partition < stats[j].Partition
+	})
+	if limit > 0 && len(stats) > limit {
+		stats = stats[:limit]
+	}
+	return stats, total
+}
+
+// sampledCounter counts the bytes allocated at a single site, recording only
+// one in every sampleRate calls.
+type sampledCounter struct {
+	calls int64 // Atomic
+	bytes int64 // Atomic
+}
+
+func (c *sampledCounter) record(bytes int, sampleRate int64) {
+	if atomic.AddInt64(&c.calls, 1)%sampleRate != 0 {
+		return
+	}
+	atomic.AddInt64(&c.bytes, int64(bytes)*sampleRate)
+}
+
+func (c *sampledCounter) load() int64 {
+	return atomic.LoadInt64(&c.bytes)
+}
+
+// partitionAllocations is the per-partition allocation accounting. It
+// implements commitlog.AllocationRecorder so it can be handed to the
+// partition's commit log in addition to being used by the partition itself.
+type partitionAllocations struct {
+	messageSet sampledCounter
+	subscribe  sampledCounter
+	compaction sampledCounter
+	stream     string
+	partition  int32
+	sampleRate int64
+}
+
+// RecordAllocation records that the given number of bytes were allocated at
+// the given site. Unknown sites are ignored.
+func (p *partitionAllocations) RecordAllocation(site string, bytes int) {
+	if p.sampleRate <= 0 {
+		return
+	}
+	switch site {
+	case commitlog.AllocSiteMessageSet:
+		p.messageSet.record(bytes, p.sampleRate)
+	case commitlog.AllocSiteCompaction:
+		p.compaction.record(bytes, p.sampleRate)
+	case allocSiteSubscribe:
+		p.subscribe.record(bytes, p.sampleRate)
+	}
+}
+
+func (p *partitionAllocations) snapshot() *proto.PartitionAllocations {
+	var (
+		messageSet = p.messageSet.load()
+		subscribe  = p.subscribe.load()
+		compaction = p.compaction.load()
+	)
+	return &proto.PartitionAllocations{
+		Stream:                p.stream,
+		Partition:             p.partition,
+		ApproxTotalBytes:      messageSet + subscribe + compaction,
+		ApproxMessageSetBytes: messageSet,
+		ApproxSubscribeBytes:  subscribe,
+		ApproxCompactionBytes: compaction,
+	}
+}
+
+// readGCStats reads the garbage collector statistics for the process from
+// runtime/metrics. Pause quantiles are taken from the upper bound of the
+// histogram bucket they fall in, so they overestimate slightly.
+func readGCStats() *proto.GCStats {
+	samples := []metrics.Sample{
+		{Name: metricGCCycles},
+		{Name: metricGCHeapAllocs},
+		{Name: metricHeapObjectBytes},
+		{Name: metricGCPauses},
+	}
+	metrics.Read(samples)
+
+	stats := &proto.GCStats{}
+	for _, sample := range samples {
+		switch sample.Value.Kind() {
+		case metrics.KindUint64:
+			switch sample.Name {
+			case metricGCCycles:
+				stats.Cycles = sample.Value.Uint64()
+			case metricGCHeapAllocs:
+				stats.HeapAllocBytes = sample.Value.Uint64()
+			case metricHeapObjectBytes:
+				stats.HeapObjectBytes = sample.Value.Uint64()
+			}
+		case metrics.KindFloat64Histogram:
+			hist := sample.Value.Float64Histogram()
+			stats.PauseP50 = histogramQuantile(hist, 0.5)
+			stats.PauseP99 = histogramQuantile(hist, 0.99)
+			stats.PauseMax = histogramQuantile(hist, 1)
+		}
+	}
+	return stats
+}
+
+// histogramQuantile returns the given quantile of a histogram of durations in
+// seconds as nanoseconds. It returns 0 if the histogram is empty.
+func histogramQuantile(hist *metrics.Float64Histogram, q float64) int64 {
+	var total uint64
+	for _, count := range hist.Counts {
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+	var (
+		threshold  = uint64(q * float64(total))
+		cumulative uint64
+	)
+	if threshold == 0 {
+		threshold = 1
+	}
+	for i, count := range hist.Counts {
+		cumulative += count
+		if cumulative < threshold {
+			continue
+		}
+		// Buckets has one more boundary than Counts. Use the upper bound of
+		// the bucket unless it is unbounded.
+		bound := hist.Buckets[i+1]
+		if math.IsInf(bound, 1) {
+			bound = hist.Buckets[i]
+		}
+		return int64(bound * float64(time.Second))
+	}
+	return 0
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"math"
+	"runtime/metrics"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+)
+
+// Ensure sampled allocations are scaled by the sample rate and partitions are
+// ranked by the approximate bytes allocated.
+func TestAllocationTrackerTop(t *testing.T) {
+	tracker := newAllocationTracker(4)
+
+	hot := tracker.forPartition("hot", 0)
+	cold := tracker.forPartition("cold", 0)
+	idle := tracker.forPartition("idle", 0)
+	require.Same(t, hot, tracker.forPartition("hot", 0))
+
+	for i := 0; i < 1000; i++ {
+		hot.RecordAllocation(commitlog.AllocSiteMessageSet, 100)
+		hot.RecordAllocation(allocSiteSubscribe, 100)
+	}
+	for i := 0; i < 10; i++ {
+		cold.RecordAllocation(commitlog.AllocSiteCompaction, 100)
+	}
+	idle.RecordAllocation("unknown", 100)
+
+	stats, total := tracker.top(0)
+	require.Len(t, stats, 3)
+	require.Equal(t, "hot", stats[0].Stream)
+	require.Equal(t, int64(100000), stats[0].ApproxMessageSetBytes)
+	require.Equal(t, int64(100000), stats[0].ApproxSubscribeBytes)
+	require.Equal(t, int64(200000), stats[0].ApproxTotalBytes)
+	require.Equal(t, "cold", stats[1].Stream)
+	require.Equal(t, int64(800), stats[1].ApproxCompactionBytes)
+	require.Equal(t, "idle", stats[2].Stream)
+	require.Equal(t, int64(0), stats[2].ApproxTotalBytes)
+	require.Equal(t, int64(200800), total)
+
+	stats, total = tracker.top(1)
+	require.Len(t, stats, 1)
+	require.Equal(t, "hot", stats[0].Stream)
+	require.Equal(t, int64(200800), total)
+
+	tracker.remove("hot", 0)
+	stats, _ = tracker.top(0)
+	require.Len(t, stats, 2)
+	require.Equal(t, "cold", stats[0].Stream)
+}
+
+// Ensure nothing is recorded when the sample rate is 0.
+func TestAllocationTrackerDisabled(t *testing.T) {
+	tracker := newAllocationTracker(0)
+	alloc := tracker.forPartition("foo", 0)
+	for i := 0; i < 100; i++ {
+		alloc.RecordAllocation(commitlog.AllocSiteMessageSet, 100)
+	}
+	stats, total := tracker.top(0)
+	require.Len(t, stats, 1)
+	require.Equal(t, int64(0), stats[0].ApproxTotalBytes)
+	require.Equal(t, int64(0), total)
+}
+
+// Ensure histogramQuantile returns the bucket bounds in nanoseconds.
+func TestHistogramQuantile(t *testing.T) {
+	hist := &metrics.Float64Histogram{
+		Counts:  []uint64{0, 90, 9, 1},
+		Buckets: []float64{0, 0.001, 0.01, 0.1, math.Inf(1)},
+	}
+	require.Equal(t, int64(10000000), histogramQuantile(hist, 0.5))
+	require.Equal(t, int64(100000000), histogramQuantile(hist, 0.99))
+	require.Equal(t, int64(100000000), histogramQuantile(hist, 1))
+
+	empty := &metrics.Float64Histogram{
+		Counts:  []uint64{0},
+		Buckets: []float64{0, 1},
+	}
+	require.Equal(t, int64(0), histogramQuantile(empty, 0.5))
+}
@@ -5,16 +5,20 @@ import (
 	"fmt"
 	"hash/crc32"
 	"io"
-	"strings"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/nats-io/nats.go"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
 	"github.com/liftbridge-io/liftbridge/server/encryption"
 	proto "github.com/liftbridge-io/liftbridge/server/protocol"
 )
@@ -22,13 +26,60 @@ import (
 const (
 	waitForNewMessages int64 = -1
 	asyncAckTimeout          = 5 * time.Second
+
+	// minISRViolatedHeader is the FetchPartitionMetadata response header set
+	// to "true" if the partition's ISR is below the minimum ISR size.
+	minISRViolatedHeader = "liftbridge-min-isr-violated"
+
+	// expiredMessagesHeader is the FetchPartitionMetadata response header
+	// containing the number of messages this broker's subscriptions skipped
+	// on the partition because their TTL elapsed.
+	expiredMessagesHeader = "liftbridge-expired-messages"
+
+	// readonlyTargetOffsetHeader is the FetchPartitionMetadata response
+	// header containing the offset the partition becomes readonly at once
+	// reached, or -1 if there is no pending target.
+	readonlyTargetOffsetHeader = "liftbridge-readonly-target-offset"
+
+	// mirrorLagHeader is the FetchPartitionMetadata response header set on
+	// mirror partitions to the number of committed messages in the source
+	// partition the mirror has yet to copy, or -1 if this broker isn't the
+	// partition's leader or the lag isn't known yet.
+	mirrorLagHeader = "liftbridge-mirror-lag"
+
+	// logStartOffsetHeader is the FetchPartitionMetadata response header
+	// containing the offset the partition's log starts at, which is advanced
+	// when messages are deleted with DeletePartitionData.
+	logStartOffsetHeader = "liftbridge-log-start-offset"
+
+	// readonlyAtOffsetMetadataKey is the SetStreamReadonly request metadata
+	// key used to make partitions readonly only once they reach the given
+	// offset since SetStreamReadonlyRequest has no field for it.
+	readonlyAtOffsetMetadataKey = "liftbridge-readonly-at-offset"
+
+	// applicationNameHeader and applicationVersionHeader are the request
+	// metadata keys clients can use to report their application when
+	// creating a stream. They're recorded in the stream's origin.
+	applicationNameHeader    = "liftbridge-application-name"
+	applicationVersionHeader = "liftbridge-application-version"
+
+	// maxApplicationHeaderLength is the maximum length of the application
+	// name and version recorded in a stream's origin.
+	maxApplicationHeaderLength = 256
+
+	// expectedOffsetHeader is the Publish response trailer set to the offset
+	// the message should be retried with when it's rejected because its
+	// expected offset is incorrect.
+	expectedOffsetHeader = "liftbridge-expected-offset"
 )
 
 var hasher = crc32.ChecksumIEEE
 
-// apiServer implements the gRPC server interface clients interact with.
+// apiServer implements the gRPC server interfaces clients and operators
+// interact with.
 type apiServer struct {
 	client.UnimplementedAPIServer
+	proto.UnimplementedAdminAPIServer
 	*Server
 }
 
@@ -62,9 +113,9 @@ func (a *apiServer) CreateStream(ctx context.Context, req *client.CreateStreamRe
 		a.logger.Errorf("api: Failed to create stream: name cannot be empty")
 		return nil, status.Error(codes.InvalidArgument, "Name cannot be empty")
 	}
-	if req.Subject == "" || !isValidSubject(req.Subject) {
-		a.logger.Errorf("api: Failed to create stream: subject is invalid")
-		return nil, status.Error(codes.InvalidArgument, "Subject is invalid")
+	if err := validateStreamSubject(req.Subject, req.Partitions); err != nil {
+		a.logger.Errorf("api: Failed to create stream: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "Subject is invalid: %v", err)
 	}
 	if isReservedStream(req.Name) {
 		a.logger.Errorf("api: Failed to create stream: stream is reserved")
@@ -82,32 +133,142 @@ func (a *apiServer) CreateStream(ctx context.Context, req *client.CreateStreamRe
 		}
 	}
 
-	stream := &proto.Stream{
-		Name:       req.Name,
-		Subject:    req.Subject,
-		Partitions: partitions,
-		Config:     getStreamConfig(req),
-	}
-
 	e := a.ensureAuthorizationPermission(ctx, req.Name, "CreateStream")
 	if e != nil {
 		a.logger.Errorf("api: Failed to authorize call on resource: %v", e)
 		return nil, e
 	}
 
+	if e := preferredReplicasFromContext(ctx, partitions); e != nil {
+		a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, e)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid preferred replicas: %v", e)
+	}
+
+	origin, e := a.getStreamOrigin(ctx, req)
+	if e != nil {
+		a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, e)
+		return nil, e
+	}
+
+	config := getStreamConfig(req)
+	config.DeadLetterQueue, e = deadLetterQueueFromContext(ctx, req.Name)
+	if e != nil {
+		a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, e)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid dead letter queue: %v", e)
+	}
+	config.MirrorSource, e = mirrorSourceFromContext(ctx, req.Name)
+	if e != nil {
+		a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, e)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid mirror source: %v", e)
+	}
+	if e := messageTimestampConfigFromContext(ctx, config); e != nil {
+		a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, e)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid message timestamp settings: %v", e)
+	}
+	config.Ttl, e = streamTTLFromContext(ctx)
+	if e != nil {
+		a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, e)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid stream TTL: %v", e)
+	}
+	config.AutoResumeOnPublish, e = autoResumeOnPublishFromContext(ctx)
+	if e != nil {
+		a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, e)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid auto resume on publish setting: %v", e)
+	}
+	config.MaxMessageBytes, e = maxMessageBytesFromContext(ctx)
+	if e == nil {
+		e = validateMaxMessageBytes(a.getStreamsConfig(config))
+	}
+	if e != nil {
+		a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, e)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid max message size: %v", e)
+	}
+	tags, e := streamTagsFromContext(ctx)
+	if e == nil {
+		e = validateStreamTags(tags)
+	}
+	if e != nil {
+		a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, e)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid stream tags: %v", e)
+	}
+
+	waitForISR, e := waitForISRFromContext(ctx, a.config.Streams.CreateWaitForISR)
+	if e != nil {
+		a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, e)
+		return nil, status.Error(codes.InvalidArgument, e.Error())
+	}
+
+	requestID, e := requestIDFromContext(ctx)
+	if e != nil {
+		a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, e)
+		return nil, status.Error(codes.InvalidArgument, e.Error())
+	}
+
+	companions, e := companionStreamsFromContext(ctx, req, config, origin)
+	if e != nil {
+		a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, e)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid companion streams: %v", e)
+	}
+
+	stream := &proto.Stream{
+		Name:       req.Name,
+		Subject:    req.Subject,
+		Partitions: partitions,
+		Config:     config,
+		Origin:     origin,
+		Tags:       tags,
+	}
+	for _, companion := range companions {
+		if e := a.ensureAuthorizationPermission(ctx, companion.Name, "CreateStream"); e != nil {
+			a.logger.Errorf("api: Failed to authorize call on resource: %v", e)
+			return nil, e
+		}
+		stream.Companions = append(stream.Companions, companion.Name)
+	}
+
 	err := a.ensureCreateStreamPrecondition(req)
 	if err != nil {
 		a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, err)
 		return nil, err.Err()
 	}
 
-	if e := a.metadata.CreateStream(ctx, &proto.CreateStreamOp{Stream: stream}); e != nil {
+	createCtx := ctx
+	if waitForISR {
+		// Stop waiting for the partition leaders in time to return the
+		// replicas which haven't confirmed.
+		var cancel context.CancelFunc
+		createCtx, cancel = isrWaitContext(ctx)
+		defer cancel()
+	}
+
+	op := &proto.CreateStreamOp{Stream: stream, Companions: companions, RequestID: requestID}
+	if e := a.metadata.CreateStream(createCtx, op); e != nil {
 		if e.Code() != codes.AlreadyExists {
 			a.logger.Errorf("api: Failed to create stream %s: %v", req.Name, e.Err())
 		}
 		return nil, e.Err()
 	}
 
+	// The stream exists even if some replicas don't confirm in time, so they
+	// are returned as headers rather than failing the request.
+	if waitForISR {
+		streams := append([]*proto.Stream{stream}, companions...)
+		if unconfirmed := a.metadata.waitForStreamsISR(createCtx, streams); len(unconfirmed) > 0 {
+			a.logger.Warnf("api: Timed out waiting for replicas of stream %s to join the ISR", req.Name)
+			header := metadata.MD{}
+			for _, replicas := range unconfirmed {
+				data, e := replicas.Marshal()
+				if e != nil {
+					panic(e)
+				}
+				header.Append(unconfirmedReplicasHeader, string(data))
+			}
+			if e := grpc.SetHeader(ctx, header); e != nil {
+				a.logger.Warnf("api: Failed to set CreateStream headers: %v", e)
+			}
+		}
+	}
+
 	return resp, nil
 }
 
@@ -130,8 +291,23 @@ func (a *apiServer) DeleteStream(ctx context.Context, req *client.DeleteStreamRe
 		return nil, status.Error(codes.InvalidArgument, "Stream is reserved")
 	}
 
+	cascade, e := deleteCompanionsFromContext(ctx)
+	if e != nil {
+		a.logger.Errorf("api: Failed to delete stream %s: %v", req.Name, e)
+		return nil, status.Error(codes.InvalidArgument, e.Error())
+	}
+	if stream := a.metadata.GetStream(req.Name); cascade && stream != nil {
+		for _, companion := range stream.GetCompanions() {
+			if err := a.ensureAuthorizationPermission(ctx, companion, "DeleteStream"); err != nil {
+				a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+				return nil, err
+			}
+		}
+	}
+
 	if e := a.metadata.DeleteStream(ctx, &proto.DeleteStreamOp{
-		Stream: req.Name,
+		Stream:  req.Name,
+		Cascade: cascade,
 	}); e != nil {
 		a.logger.Errorf("api: Failed to delete stream %v: %v", req.Name, e.Err())
 		return nil, e.Err()
@@ -142,7 +318,10 @@ func (a *apiServer) DeleteStream(ctx context.Context, req *client.DeleteStreamRe
 
 // PauseStream pauses a stream's partitions. If no partitions are specified,
 // all of the stream's partitions will be paused. Partitions are resumed when
-// they are published to via the Liftbridge Publish API.
+// they are published to via the Liftbridge Publish API. If
+// liftbridge-drain-before-pause is set in the request metadata, the partitions
+// are paused once their subscribers have read up to the HW or the drain
+// timeout elapses.
 func (a *apiServer) PauseStream(ctx context.Context, req *client.PauseStreamRequest) (
 	*client.PauseStreamResponse, error) {
 
@@ -166,10 +345,17 @@ func (a *apiServer) PauseStream(ctx context.Context, req *client.PauseStreamRequ
 		}
 	}
 
+	drain, err := drainBeforePauseFromContext(ctx)
+	if err != nil {
+		a.logger.Errorf("api: Failed to pause stream %v: %v", req.Name, err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	if e := a.metadata.PauseStream(ctx, &proto.PauseStreamOp{
-		Stream:     req.Name,
-		Partitions: req.Partitions,
-		ResumeAll:  req.ResumeAll,
+		Stream:           req.Name,
+		Partitions:       req.Partitions,
+		ResumeAll:        req.ResumeAll,
+		DrainBeforePause: drain,
 	}); e != nil {
 		a.logger.Errorf("api: Failed to pause stream %v: %v", req.Name, e.Err())
 		return nil, e.Err()
@@ -180,7 +366,9 @@ func (a *apiServer) PauseStream(ctx context.Context, req *client.PauseStreamRequ
 
 // SetStreamReadonly sets the readonly status on a stream's partitions. If no
 // partitions are specified, all of the stream's partitions will have their
-// readonly status set.
+// readonly status set. If liftbridge-readonly-at-offset is set in the request
+// metadata, the partitions keep accepting messages until their newest offset
+// reaches it.
 func (a *apiServer) SetStreamReadonly(ctx context.Context, req *client.SetStreamReadonlyRequest) (
 	*client.SetStreamReadonlyResponse, error) {
 
@@ -194,6 +382,15 @@ func (a *apiServer) SetStreamReadonly(ctx context.Context, req *client.SetStream
 		return nil, err
 	}
 
+	target, err := readonlyAtOffsetFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if target != nil && !req.Readonly {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"%s cannot be set when disabling readonly", readonlyAtOffsetMetadataKey)
+	}
+
 	if len(req.Partitions) == 0 {
 		stream := a.metadata.GetStream(req.Name)
 		if stream == nil {
@@ -205,9 +402,10 @@ func (a *apiServer) SetStreamReadonly(ctx context.Context, req *client.SetStream
 	}
 
 	if e := a.metadata.SetStreamReadonly(ctx, &proto.SetStreamReadonlyOp{
-		Stream:     req.Name,
-		Partitions: req.Partitions,
-		Readonly:   req.Readonly,
+		Stream:       req.Name,
+		Partitions:   req.Partitions,
+		Readonly:     req.Readonly,
+		TargetOffset: target,
 	}); e != nil {
 		a.logger.Errorf("api: Failed to set stream readonly flag %v: %v", req.Name, e.Err())
 		return nil, e.Err()
@@ -221,8 +419,37 @@ func (a *apiServer) SetStreamReadonly(ctx context.Context, req *client.SetStream
 // messages when it reaches the end of the partition. If the subscriber is part
 // of a consumer group, this will ensure only one member of the group is
 // subscribed to a given partition at a time. Use the request context to close
-// the subscription.
+// the subscription. Messages can be filtered on the server by setting
+// liftbridge-header-filter values and a liftbridge-value-filter regular
+// expression in the request metadata. Setting liftbridge-subscription-heartbeats
+// to "true" sends the subscriber periodic heartbeats. Subscriptions past the
+// configured per-partition or per-server limits are rejected with
+// ResourceExhausted.
 func (a *apiServer) Subscribe(req *client.SubscribeRequest, out client.API_SubscribeServer) error {
+	release, st := a.subLimiter.acquire(req.Stream, req.Partition)
+	if st != nil {
+		a.logger.Warnf("api: Failed to subscribe to partition "+
+			"[stream=%s, partition=%d]: %v", req.Stream, req.Partition, st.Message())
+		return st.Err()
+	}
+	defer release()
+
+	cursorID, autoCommit, err := subscriptionCursorFromContext(out.Context())
+	if err != nil {
+		a.logger.Errorf("api: Failed to subscribe to partition: %v", err)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if cursorID != "" {
+		if err := a.ensureCursorPermissions(out.Context(), req.Stream, autoCommit > 0); err != nil {
+			a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
+			return err
+		}
+		if st := a.resumeFromCursor(out.Context(), req, cursorID); st != nil {
+			a.logger.Errorf("api: Failed to subscribe to partition: %v", st.Err())
+			return st.Err()
+		}
+	}
+
 	sub, err := a.SubscribeInternal(out.Context(), req)
 	if err != nil {
 		return err
@@ -234,32 +461,28 @@ func (a *apiServer) Subscribe(req *client.SubscribeRequest, out client.API_Subsc
 		a.logger.Errorf("api: Failed to authorize call on resource: %v", e)
 		return e
 	}
+
+	heartbeats, err := subscriptionHeartbeatsFromContext(out.Context())
+	if err != nil {
+		a.logger.Errorf("api: Failed to subscribe to partition: %v", err)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if autoCommit > 0 {
+		stop := a.autoCommitCursor(sub, req, cursorID, autoCommit)
+		defer stop()
+	}
+
+	atomic.AddInt64(&a.activeSubscriptions, 1)
+	defer atomic.AddInt64(&a.activeSubscriptions, -1)
+
 	// Send an empty message which signals the subscription was successfully
 	// created.
 	if err := out.Send(&client.Message{}); err != nil {
 		return err
 	}
 
-	var (
-		msgC    = sub.Messages()
-		errC    = sub.Errors()
-		closedC = sub.Closed()
-	)
-
-	for {
-		select {
-		case <-out.Context().Done():
-			return nil
-		case <-closedC:
-			return nil
-		case m := <-msgC:
-			if err := out.Send(m); err != nil {
-				return err
-			}
-		case err := <-errC:
-			return err.Err()
-		}
-	}
+	return a.serveSubscription(out, sub, req, heartbeats)
 }
 
 // SubscribeInternal creates an ephemeral subscription for the given stream
@@ -283,12 +506,29 @@ func (a *apiServer) SubscribeInternal(ctx context.Context, req *client.Subscribe
 		"[stream=%s, partition=%d, start=%s, offset=%d, timestamp=%d, group=%s, consumer=%s, reverse=%v]",
 		req.Stream, req.Partition, req.StartPosition, req.StartOffset, req.StartTimestamp, group, consumer, req.Reverse)
 
+	if a.isDraining() {
+		a.logger.Errorf("api: Failed to subscribe to partition: server is shutting down")
+		return nil, status.Error(codes.Unavailable, "Server is shutting down")
+	}
+
 	if group != "" && consumer == "" {
 		a.logger.Errorf("api: Failed to subscribe to partition: no consumer id provided")
 		return nil, status.Error(codes.InvalidArgument,
 			"Consumer id cannot be empty when group id is provided")
 	}
 
+	filter, err := messageFilterFromContext(ctx)
+	if err != nil {
+		a.logger.Errorf("api: Failed to subscribe to partition: %v", err)
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid message filter: %v", err))
+	}
+
+	readPaused, err := readPausedFromContext(ctx)
+	if err != nil {
+		a.logger.Errorf("api: Failed to subscribe to partition: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	partition := a.metadata.GetPartition(req.Stream, req.Partition)
 	if partition == nil {
 		a.logger.Errorf("api: Failed to subscribe to partition "+
@@ -314,17 +554,19 @@ func (a *apiServer) SubscribeInternal(ctx context.Context, req *client.Subscribe
 		}
 	}
 
-	sub, err := a.subscribe(ctx, partition, req)
-	if err != nil {
-		a.logger.Errorf("api: Failed to subscribe to partition %s: %v", partition, err.Err())
-		return nil, err.Err()
+	sub, st := a.subscribe(ctx, partition, req, filter, readPaused)
+	if st != nil {
+		a.logger.Errorf("api: Failed to subscribe to partition %s: %v", partition, st.Err())
+		return nil, st.Err()
 	}
 
 	return sub, nil
 }
 
 // FetchMetadata retrieves the latest cluster metadata, including stream broker
-// information.
+// information. Broker addresses are those advertised for the listener set with
+// the liftbridge-listener request metadata or, if not set, the listener the
+// request was received on.
 func (a *apiServer) FetchMetadata(ctx context.Context, req *client.FetchMetadataRequest) (
 	*client.FetchMetadataResponse, error) {
 	a.logger.Debugf("api: FetchMetadata [streams=%s, groups=%s]", req.Streams, req.Groups)
@@ -335,12 +577,47 @@ func (a *apiServer) FetchMetadata(ctx context.Context, req *client.FetchMetadata
 		return nil, e
 	}
 
-	resp, err := a.metadata.FetchMetadata(ctx, req)
+	listener, e := a.clientListener(ctx)
+	if e != nil {
+		return nil, status.Error(codes.InvalidArgument, e.Error())
+	}
+
+	selector, e := tagSelectorFromContext(ctx)
+	if e != nil {
+		return nil, status.Error(codes.InvalidArgument, e.Error())
+	}
+
+	page, e := metadataPageFromContext(ctx)
+	if e != nil {
+		return nil, status.Error(codes.InvalidArgument, e.Error())
+	}
+
+	resp, nextPageToken, err := a.metadata.FetchMetadata(ctx, req, listener, page)
 	if err != nil {
 		a.logger.Errorf("api: Failed to fetch metadata: %v", err.Err())
 		return nil, err.Err()
 	}
 
+	// StreamMetadata has no field for the stream's tags and
+	// FetchMetadataResponse has none for the next page token, so these are
+	// returned as headers.
+	header := metadata.MD{}
+	for _, streamTags := range a.selectTaggedStreams(resp, selector) {
+		data, e := streamTags.Marshal()
+		if e != nil {
+			panic(e)
+		}
+		header.Append(streamTagsHeader, string(data))
+	}
+	if nextPageToken != "" {
+		header.Set(nextPageTokenHeader, nextPageToken)
+	}
+	if header.Len() > 0 {
+		if e := grpc.SetHeader(ctx, header); e != nil {
+			a.logger.Warnf("api: Failed to set FetchMetadata headers: %v", e)
+		}
+	}
+
 	return resp, nil
 }
 
@@ -362,6 +639,29 @@ func (a *apiServer) FetchPartitionMetadata(ctx context.Context, req *client.Fetc
 		a.logger.Errorf("api: Failed to fetch partition metadata: %v", err.Err())
 		return nil, err.Err()
 	}
+
+	// PartitionMetadata has no fields to indicate the ISR is below the minimum
+	// size, how many messages expired, the pending readonly target, a
+	// mirror's lag, or the log start offset, so these are returned as
+	// headers.
+	if partition := a.metadata.GetPartition(req.Stream, req.Partition); partition != nil {
+		readonlyTarget, ok := partition.ReadonlyTarget()
+		if !ok {
+			readonlyTarget = -1
+		}
+		header := metadata.Pairs(
+			minISRViolatedHeader, strconv.FormatBool(partition.IsBelowMinISR()),
+			expiredMessagesHeader, strconv.FormatInt(partition.ExpiredMessageCount(), 10),
+			readonlyTargetOffsetHeader, strconv.FormatInt(readonlyTarget, 10),
+			logStartOffsetHeader, strconv.FormatInt(partition.log.LogStartOffset(), 10),
+		)
+		if partition.mirrorSource != nil {
+			header.Set(mirrorLagHeader, strconv.FormatInt(partition.MirrorLag(), 10))
+		}
+		if err := grpc.SetHeader(ctx, header); err != nil {
+			a.logger.Warnf("api: Failed to set FetchPartitionMetadata headers: %v", err)
+		}
+	}
 	return resp, nil
 }
 
@@ -369,13 +669,36 @@ func (a *apiServer) FetchPartitionMetadata(ctx context.Context, req *client.Fetc
 // deadline is provided, this will synchronously block until the ack is
 // received. If the ack is not received in time, a DeadlineExceeded status code
 // is returned. A FailedPrecondition status code is returned if the partition is
-// readonly.
+// readonly or its ISR is below the minimum ISR size, or if the message is
+// published directly to the partition and this server isn't its leader. If
+// the partition by key metadata is set, the server chooses the partition from
+// the message key.
 func (a *apiServer) Publish(ctx context.Context, req *client.PublishRequest) (
 	*client.PublishResponse, error) {
 
 	// TODO: Deprecate in favor of PublishAsync and log a warning.
 	a.logger.Debugf("api: Publish [stream=%s, partition=%d]", req.Stream, req.Partition)
 
+	byKey, err := partitionByKeyFromContext(ctx)
+	if err != nil {
+		a.logger.Errorf("api: Failed to publish message: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if byKey {
+		partitions, e := a.assignKeyPartition(req)
+		if e != nil {
+			a.logger.Errorf("api: Failed to publish message: %v", e.Message)
+			return nil, convertPublishAsyncError(e)
+		}
+		header := metadata.Pairs(
+			keyPartitionHeader, strconv.FormatInt(int64(req.Partition), 10),
+			partitionCountHeader, strconv.Itoa(partitions),
+		)
+		if err := grpc.SetHeader(ctx, header); err != nil {
+			a.logger.Warnf("api: Failed to set Publish headers: %v", err)
+		}
+	}
+
 	subject, e := a.getPublishSubject(req)
 
 	if e != nil {
@@ -383,7 +706,7 @@ func (a *apiServer) Publish(ctx context.Context, req *client.PublishRequest) (
 		return nil, convertPublishAsyncError(e)
 	}
 
-	err := a.ensureAuthorizationPermission(ctx, req.Stream, "Publish")
+	err = a.ensureAuthorizationPermission(ctx, req.Stream, "Publish")
 	if err != nil {
 		a.logger.Errorf("api: Failed to authorize call on resource: %v", err)
 		return nil, err
@@ -393,31 +716,72 @@ func (a *apiServer) Publish(ctx context.Context, req *client.PublishRequest) (
 		return nil, convertPublishAsyncError(e)
 	}
 
-	if err := a.resumeStream(ctx, req.Stream, req.Partition); err != nil {
+	if err := a.resumeStreamOnPublish(ctx, req.Stream, req.Partition); err != nil {
 		a.logger.Errorf("api: Failed to resume stream: %v", err)
 		return nil, err
 	}
 
+	direct, err := publishDirectFromContext(ctx, a.config.Streams.PublishDirect)
+	if err != nil {
+		a.logger.Errorf("api: Failed to publish message: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var partition *partition
+	if direct {
+		partition, e = a.getDirectPublishPartition(req)
+		if e != nil {
+			a.logger.Errorf("api: Failed to publish message: %v", e.Message)
+			return nil, convertPublishAsyncError(e)
+		}
+	}
+
+	headers := withIngestID(req.Headers)
+	journaled, err := publishJournaledFromContext(ctx)
+	if err != nil {
+		a.logger.Errorf("api: Failed to publish message: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if journaled {
+		if req.CorrelationId == "" {
+			return nil, status.Error(codes.InvalidArgument, "Journaled publish requires a correlation ID")
+		}
+		headers[correlationIDHeader] = []byte(req.CorrelationId)
+	}
+
 	if req.AckInbox == "" {
 		req.AckInbox = a.getAckInbox()
 	}
 
-	var (
-		msg = &client.Message{
-			Key:           req.Key,
-			Value:         req.Value,
-			Stream:        req.Stream,
-			Subject:       subject,
-			Headers:       req.Headers,
-			AckInbox:      req.AckInbox,
-			CorrelationId: req.CorrelationId,
-			AckPolicy:     req.AckPolicy,
-			Offset:        req.ExpectedOffset,
+	buf, err := proto.MarshalPublish(&client.Message{
+		Key:           req.Key,
+		Value:         req.Value,
+		Stream:        req.Stream,
+		Subject:       subject,
+		Headers:       headers,
+		AckInbox:      req.AckInbox,
+		CorrelationId: req.CorrelationId,
+		AckPolicy:     req.AckPolicy,
+		Offset:        req.ExpectedOffset,
+	})
+	if err != nil {
+		a.logger.Errorf("api: Failed to marshal message: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if e := a.ensureMessageSize(req.Stream, req.Partition, buf); e != nil {
+		a.logger.Errorf("api: Failed to publish message: %v", e.Message)
+		return nil, convertPublishAsyncError(e)
+	}
+	if e, retryAfter := a.ensureProducerQuota(req.Headers, len(buf)); e != nil {
+		a.logger.Debugf("api: Failed to publish message: %v", e.Message)
+		trailer := metadata.Pairs(retryAfterTrailer, formatRetryAfter(retryAfter))
+		if err := grpc.SetTrailer(ctx, trailer); err != nil {
+			a.logger.Errorf("api: Failed to set retry-after trailer: %v", err)
 		}
-		resp = new(client.PublishResponse)
-	)
+		return nil, convertPublishAsyncError(e)
+	}
 
-	ack, err := a.publish(ctx, subject, req.AckInbox, req.AckPolicy, msg)
+	resp := new(client.PublishResponse)
+	ack, ackHeader, err := a.publish(ctx, subject, req.AckInbox, req.AckPolicy, buf, partition)
 	if err != nil {
 		a.logger.Errorf("api: Failed to publish message: %v", err)
 		return nil, err
@@ -426,8 +790,20 @@ func (a *apiServer) Publish(ctx context.Context, req *client.PublishRequest) (
 	if ack != nil {
 		if e := convertAckError(ack.AckError); e != nil {
 			a.logger.Errorf("api: Published message was rejected: %v", e.Message)
+			if ack.AckError == client.Ack_INCORRECT_OFFSET {
+				trailer := metadata.Pairs(expectedOffsetHeader, strconv.FormatInt(ack.Offset, 10))
+				if err := grpc.SetTrailer(ctx, trailer); err != nil {
+					a.logger.Errorf("api: Failed to set expected offset trailer: %v", err)
+				}
+			}
 			return nil, convertPublishAsyncError(e)
 		}
+		if epoch, ok := ackLeaderEpoch(ackHeader); ok && journaled {
+			header := metadata.Pairs(leaderEpochHeader, strconv.FormatUint(epoch, 10))
+			if err := grpc.SetHeader(ctx, header); err != nil {
+				a.logger.Warnf("api: Failed to set Publish headers: %v", err)
+			}
+		}
 	}
 
 	resp.Ack = ack
@@ -469,20 +845,22 @@ func (a *apiServer) PublishToSubject(ctx context.Context, req *client.PublishToS
 		return nil, e
 	}
 
-	var (
-		msg = &client.Message{
-			Key:           req.Key,
-			Value:         req.Value,
-			Subject:       req.Subject,
-			Headers:       req.Headers,
-			AckInbox:      req.AckInbox,
-			CorrelationId: req.CorrelationId,
-			AckPolicy:     req.AckPolicy,
-		}
-		resp = new(client.PublishToSubjectResponse)
-	)
+	buf, err := proto.MarshalPublish(&client.Message{
+		Key:           req.Key,
+		Value:         req.Value,
+		Subject:       req.Subject,
+		Headers:       withIngestID(req.Headers),
+		AckInbox:      req.AckInbox,
+		CorrelationId: req.CorrelationId,
+		AckPolicy:     req.AckPolicy,
+	})
+	if err != nil {
+		a.logger.Errorf("api: Failed to marshal message: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	ack, err := a.publish(ctx, req.Subject, req.AckInbox, req.AckPolicy, msg)
+	resp := new(client.PublishToSubjectResponse)
+	ack, _, err := a.publish(ctx, req.Subject, req.AckInbox, req.AckPolicy, buf, nil)
 	if err != nil {
 		a.logger.Errorf("api: Failed to publish message: %v", err)
 		return nil, err
@@ -695,20 +1073,6 @@ func (a *apiServer) ReportConsumerGroupCoordinator(ctx context.Context, req *cli
 	return new(client.ReportConsumerGroupCoordinatorResponse), nil
 }
 
-// isValidSubject indicates if the string is a valid NATS subject.
-func isValidSubject(subj string) bool {
-	if strings.ContainsAny(subj, " \t\r\n") {
-		return false
-	}
-	tokens := strings.Split(subj, ".")
-	for _, t := range tokens {
-		if len(t) == 0 {
-			return false
-		}
-	}
-	return true
-}
-
 func (a *apiServer) ensureAuthorizationPermission(ctx context.Context, stream, apiMethod string) error {
 	// Verify authorization permissions
 	if a.config.TLSClientAuthz {
@@ -781,6 +1145,64 @@ func (a *apiServer) ensurePublishPreconditions(req *client.PublishRequest) *clie
 		}
 	}
 
+	// Verify stream is not a mirror. Mirrors are only written to by copying
+	// their source stream.
+	if source := stream.GetConfig().GetMirrorSource(); source != nil {
+		return &client.PublishAsyncError{
+			Code:    client.PublishAsyncError_READONLY,
+			Message: fmt.Sprintf("stream %s is a mirror of stream %s", name, source.Stream),
+		}
+	}
+
+	// Verify this server isn't fenced from leading the partition because it
+	// lost its NATS connection.
+	if partition.IsLeader() && a.isLeaderFenced() {
+		return &client.PublishAsyncError{
+			Code:    publishAsyncErrorLeaderUnavailable,
+			Message: ErrLeaderUnavailable.Error(),
+		}
+	}
+
+	// Verify this server didn't step down from leading the partition because
+	// its leader lease expired. Until a leader is elected, the partition has
+	// no leader which accepts publishes.
+	if partition.IsLeaderLeaseExpired() {
+		return &client.PublishAsyncError{
+			Code:    publishAsyncErrorLeaderUnavailable,
+			Message: ErrLeaderLeaseExpired.Error(),
+		}
+	}
+
+	// Verify the ISR is large enough to commit the message. Otherwise it
+	// could never be acked, so reject it rather than let the client time
+	// out. There is no dedicated error code for this, so READONLY is used
+	// since the partition can't currently accept writes.
+	if isrSize, minISR := partition.ISRSize(), partition.MinISR(); isrSize < minISR {
+		return &client.PublishAsyncError{
+			Code: client.PublishAsyncError_READONLY,
+			Message: fmt.Sprintf("partition %d ISR size (%d) below minimum (%d)",
+				partitionID, isrSize, minISR),
+		}
+	}
+
+	// Verify idempotent producer headers are valid
+	if _, _, _, err := getProducerSequence(req.Headers); err != nil {
+		return &client.PublishAsyncError{
+			Code:    client.PublishAsyncError_BAD_REQUEST,
+			Message: err.Error(),
+		}
+	}
+
+	// Verify message TTL is valid
+	if ttl, ok := req.Headers[commitlog.TTLHeader]; ok {
+		if _, err := commitlog.ParseTTL(ttl); err != nil {
+			return &client.PublishAsyncError{
+				Code:    client.PublishAsyncError_BAD_REQUEST,
+				Message: err.Error(),
+			}
+		}
+	}
+
 	// Verify AckPolicy is set for streams with Optimistic Concurrency Control
 	if partition.log.IsConcurrencyControlEnabled() && req.AckPolicy == client.AckPolicy_NONE {
 		return &client.PublishAsyncError{
@@ -857,41 +1279,50 @@ func (a *apiServer) getPublishSubject(req *client.PublishRequest) (string, *clie
 	return subject, nil
 }
 
+// publish sends the marshaled message to the given NATS subject or, if a
+// partition is given, appends it directly to the partition which this server
+// leads. If AckPolicy is not NONE and a deadline is provided, this will block
+// until the ack is received.
 func (a *apiServer) publish(ctx context.Context, subject, ackInbox string,
-	ackPolicy client.AckPolicy, msg *client.Message) (*client.Ack, error) {
+	ackPolicy client.AckPolicy, buf []byte, direct *partition) (*client.Ack, nats.Header, error) {
 
-	buf, err := proto.MarshalPublish(msg)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to marshal message")
+	send := func() error {
+		if direct != nil {
+			return direct.appendDirect(subject, buf)
+		}
+		if err := a.ncPublishes.Publish(subject, buf); err != nil {
+			return errors.Wrap(err, "failed to publish to NATS")
+		}
+		return nil
 	}
 
 	// If AckPolicy is NONE or a timeout isn't specified, then we will fire and
 	// forget.
 	_, hasDeadline := ctx.Deadline()
 	if ackPolicy == client.AckPolicy_NONE || !hasDeadline {
-		if err := a.ncPublishes.Publish(subject, buf); err != nil {
-			return nil, errors.Wrap(err, "failed to publish to NATS")
-		}
-		return nil, nil
+		return nil, nil, send()
 	}
 
 	// Otherwise we need to publish and wait for the ack.
-	return a.publishSync(ctx, subject, ackInbox, buf)
+	return a.publishSync(ctx, ackInbox, send)
 }
 
-func (a *apiServer) publishSync(ctx context.Context, subject,
-	ackInbox string, msg []byte) (*client.Ack, error) {
+func (a *apiServer) publishSync(ctx context.Context, ackInbox string,
+	send func() error) (*client.Ack, nats.Header, error) {
 
 	sub, err := a.ncPublishes.SubscribeSync(ackInbox)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to subscribe to ack inbox")
+		return nil, nil, errors.Wrap(err, "failed to subscribe to ack inbox")
 	}
 	if err := sub.AutoUnsubscribe(1); err != nil {
-		return nil, errors.Wrap(err, "failed to auto unsubscribe from ack inbox")
+		return nil, nil, errors.Wrap(err, "failed to auto unsubscribe from ack inbox")
 	}
 
-	if err := a.ncPublishes.Publish(subject, msg); err != nil {
-		return nil, errors.Wrap(err, "failed to publish to NATS")
+	atomic.AddInt64(&a.inflightPublishes, 1)
+	defer atomic.AddInt64(&a.inflightPublishes, -1)
+
+	if err := send(); err != nil {
+		return nil, nil, err
 	}
 
 	ackMsg, err := sub.NextMsgWithContext(ctx)
@@ -899,22 +1330,24 @@ func (a *apiServer) publishSync(ctx context.Context, subject,
 		if err == nats.ErrTimeout {
 			err = status.Error(codes.DeadlineExceeded, err.Error())
 		}
-		return nil, err
+		return nil, nil, err
 	}
 
 	ack, err := proto.UnmarshalAck(ackMsg.Data)
 	if err != nil {
-		return nil, errors.Wrap(err, "Invalid ack for publish")
+		return nil, nil, errors.Wrap(err, "Invalid ack for publish")
 	}
-	return ack, nil
+	return ack, ackMsg.Header, nil
 }
 
 // subscribe sets up a subscription on the given partition and begins sending
 // messages on the returned channel. The subscription will run until the cancel
 // channel is closed, the context is canceled, or an error is returned
-// asynchronously on the status channel.
+// asynchronously on the status channel. If readPaused is set and the
+// partition is paused, it's read without being resumed unless the request
+// resumes it.
 func (a *apiServer) subscribe(ctx context.Context, partition *partition,
-	req *client.SubscribeRequest) (*subscription, *status.Status) {
+	req *client.SubscribeRequest, filter *messageFilter, readPaused bool) (*subscription, *status.Status) {
 
 	if req.Resume {
 		if err := a.resumeStream(ctx, req.Stream, req.Partition); err != nil {
@@ -931,9 +1364,76 @@ func (a *apiServer) subscribe(ctx context.Context, partition *partition,
 				req.Stream, req.Partition)
 			return nil, status.New(codes.NotFound, "No such partition")
 		}
+	} else if readPaused && partition.IsPaused() {
+		stream := a.metadata.GetStream(req.Stream)
+		if stream == nil {
+			return nil, status.New(codes.NotFound, "No such stream")
+		}
+		return partition.SubscribePaused(ctx, req, filter, stream.GetConfig())
+	}
+
+	return partition.Subscribe(ctx, req, filter)
+}
+
+// getStreamOrigin returns the origin to record for a stream created by the
+// given request. The client identity comes from the verified TLS client
+// certificate so it can't be spoofed, while the application name and version
+// are whatever the client reports in the request metadata, if anything.
+func (a *apiServer) getStreamOrigin(ctx context.Context, req *client.CreateStreamRequest) (
+	*proto.StreamOrigin, error) {
+
+	// The client ID is only added to the context when authorization is
+	// enabled, but TLS client authentication is enough to identify it.
+	clientID := peerClientID(ctx)
+	origin := &proto.StreamOrigin{
+		CreatedBy:         clientID,
+		Owner:             clientID,
+		Broker:            a.config.Clustering.ServerID,
+		Partitions:        req.Partitions,
+		ReplicationFactor: req.ReplicationFactor,
+		Group:             req.Group,
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for key, field := range map[string]*string{
+		applicationNameHeader:    &origin.ApplicationName,
+		applicationVersionHeader: &origin.ApplicationVersion,
+	} {
+		values := md.Get(key)
+		if len(values) == 0 {
+			continue
+		}
+		if len(values[0]) > maxApplicationHeaderLength {
+			return nil, status.Errorf(codes.InvalidArgument, "%s cannot exceed %d characters",
+				key, maxApplicationHeaderLength)
+		}
+		*field = values[0]
 	}
+	return origin, nil
+}
 
-	return partition.Subscribe(ctx, req)
+// readonlyAtOffsetFromContext returns the offset set with the
+// liftbridge-readonly-at-offset request metadata, or nil if it's not set.
+func readonlyAtOffsetFromContext(ctx context.Context) (*proto.NullableInt64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(readonlyAtOffsetMetadataKey)
+	switch len(values) {
+	case 0:
+		return nil, nil
+	case 1:
+		offset, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", readonlyAtOffsetMetadataKey, err)
+		}
+		if offset < 0 {
+			return nil, fmt.Errorf("%s cannot be negative", readonlyAtOffsetMetadataKey)
+		}
+		return &proto.NullableInt64{Value: offset}, nil
+	default:
+		return nil, fmt.Errorf("only one %s can be set", readonlyAtOffsetMetadataKey)
+	}
 }
 
 func getStreamConfig(req *client.CreateStreamRequest) *proto.StreamConfig {
@@ -995,6 +1495,14 @@ func convertPublishAsyncError(err *client.PublishAsyncError) error {
 		code = codes.FailedPrecondition
 	case client.PublishAsyncError_ENCRYPTION_FAILED:
 		code = codes.Internal
+	case publishAsyncErrorProducerFenced:
+		code = codes.Aborted
+	case publishAsyncErrorLeaderUnavailable:
+		code = codes.Unavailable
+	case publishAsyncErrorNotLeader:
+		code = codes.FailedPrecondition
+	case publishAsyncErrorQuotaExceeded:
+		code = codes.ResourceExhausted
 	case client.PublishAsyncError_UNKNOWN:
 		fallthrough
 	default:
@@ -1017,10 +1525,19 @@ func convertAckError(ackError client.Ack_Error) *client.PublishAsyncError {
 		message = "incorrect expected offset"
 	case client.Ack_TOO_LARGE:
 		code = client.PublishAsyncError_BAD_REQUEST
-		message = "message exceeds max replication size"
+		message = "message exceeds max message size"
 	case client.Ack_ENCRYPTION:
 		code = client.PublishAsyncError_ENCRYPTION_FAILED
 		message = "encryption failed on partition"
+	case ackProducerFenced:
+		code = publishAsyncErrorProducerFenced
+		message = ErrProducerFenced.Error()
+	case ackLeaderUnavailable:
+		code = publishAsyncErrorLeaderUnavailable
+		message = ErrLeaderUnavailable.Error()
+	case ackInvalidTimestamp:
+		code = client.PublishAsyncError_BAD_REQUEST
+		message = ErrInvalidTimestamp.Error()
 	default:
 		code = client.PublishAsyncError_UNKNOWN
 		message = "unknown error"
@@ -1059,15 +1576,20 @@ func (p *publishAsyncSession) dispatchAcks() error {
 			return
 		}
 		p.mu.Lock()
-		p.inflight--
-		if p.inflight < 0 {
-			p.inflight = 0
+		if p.inflight > 0 {
+			p.inflight--
+			atomic.AddInt64(&p.inflightPublishes, -1)
 		}
 		p.mu.Unlock()
 
 		if e := convertAckError(ack.AckError); e != nil {
 			p.logger.Errorf("api: Published async message was rejected: %v", e.Message)
-			p.sendPublishAsyncError(ack.CorrelationId, e)
+			resp := newPublishAsyncErrorResponse(ack.CorrelationId, e)
+			if ack.AckError == client.Ack_INCORRECT_OFFSET {
+				// Let the client know which offset to retry with.
+				resp.Ack.Offset = ack.Offset
+			}
+			p.sendPublishResponse(resp)
 			return
 		}
 
@@ -1088,6 +1610,14 @@ func (p *publishAsyncSession) dispatchAcks() error {
 // If the client closes the stream, this will attempt to wait for remaining
 // acks for any in-flight messages before ending the session.
 func (p *publishAsyncSession) publishLoop() error {
+	direct, err := publishDirectFromContext(p.stream.Context(), p.config.Streams.PublishDirect)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	byKey, err := partitionByKeyFromContext(p.stream.Context())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 	for {
 		req, err := p.stream.Recv()
 		if err != nil {
@@ -1105,6 +1635,23 @@ func (p *publishAsyncSession) publishLoop() error {
 			p.sendPublishAsyncError(req.CorrelationId, permissionDeniedAsyncError)
 		}
 
+		if err := p.checkStreamACL(p.stream.Context(), req.Stream, true); err != nil {
+			p.logger.Errorf("api: Failed to publish async message: %v", err)
+			p.sendPublishAsyncError(req.CorrelationId, &client.PublishAsyncError{
+				Code:    client.PublishAsyncError_PERMISSION_DENIED,
+				Message: status.Convert(err).Message(),
+			})
+			continue
+		}
+
+		if byKey {
+			if _, e := p.assignKeyPartition(req); e != nil {
+				p.logger.Errorf("api: Failed to publish async message: %v", e.Message)
+				p.sendPublishAsyncError(req.CorrelationId, e)
+				continue
+			}
+		}
+
 		if e := p.ensurePublishPreconditions(req); e != nil {
 			p.logger.Errorf("api: Failed to publish async message: %v", e.Message)
 			p.sendPublishAsyncError(req.CorrelationId, e)
@@ -1121,7 +1668,7 @@ func (p *publishAsyncSession) publishLoop() error {
 			p.sendPublishAsyncError(req.CorrelationId, e)
 			continue
 		}
-		if err := p.resumeStream(p.stream.Context(), req.Stream, req.Partition); err != nil {
+		if err := p.resumeStreamOnPublish(p.stream.Context(), req.Stream, req.Partition); err != nil {
 			err = errors.Wrap(err, "failed to resume stream")
 			p.logger.Errorf("api: Failed to publish async message: %v", err)
 			p.sendPublishAsyncError(req.CorrelationId, &client.PublishAsyncError{
@@ -1135,7 +1682,7 @@ func (p *publishAsyncSession) publishLoop() error {
 			Value:         req.Value,
 			Stream:        req.Stream,
 			Subject:       subject,
-			Headers:       req.Headers,
+			Headers:       withIngestID(req.Headers),
 			AckInbox:      req.AckInbox,
 			CorrelationId: req.CorrelationId,
 			AckPolicy:     req.AckPolicy,
@@ -1150,7 +1697,32 @@ func (p *publishAsyncSession) publishLoop() error {
 			})
 			continue
 		}
-		if err := p.ncPublishes.Publish(subject, msg); err != nil {
+		if e := p.ensureMessageSize(req.Stream, req.Partition, msg); e != nil {
+			p.logger.Errorf("api: Failed to publish async message: %v", e.Message)
+			p.sendPublishAsyncError(req.CorrelationId, e)
+			continue
+		}
+		if e, _ := p.ensureProducerQuota(req.Headers, len(msg)); e != nil {
+			p.logger.Debugf("api: Failed to publish async message: %v", e.Message)
+			p.sendPublishAsyncError(req.CorrelationId, e)
+			continue
+		}
+		if direct {
+			partition, e := p.getDirectPublishPartition(req)
+			if e != nil {
+				p.logger.Errorf("api: Failed to publish async message: %v", e.Message)
+				p.sendPublishAsyncError(req.CorrelationId, e)
+				continue
+			}
+			if err := partition.appendDirect(subject, msg); err != nil {
+				p.logger.Errorf("api: Failed to publish async message: %v", err)
+				p.sendPublishAsyncError(req.CorrelationId, &client.PublishAsyncError{
+					Code:    publishAsyncErrorNotLeader,
+					Message: err.Error(),
+				})
+				continue
+			}
+		} else if err := p.ncPublishes.Publish(subject, msg); err != nil {
 			err = errors.Wrap(err, "failed to publish to NATS")
 			p.logger.Errorf("api: Failed to publish async message: %v", err)
 			p.sendPublishAsyncError(req.CorrelationId, &client.PublishAsyncError{
@@ -1163,6 +1735,7 @@ func (p *publishAsyncSession) publishLoop() error {
 		if req.AckPolicy != client.AckPolicy_NONE {
 			p.mu.Lock()
 			p.inflight++
+			atomic.AddInt64(&p.inflightPublishes, 1)
 			p.mu.Unlock()
 		}
 	}
@@ -1171,7 +1744,21 @@ func (p *publishAsyncSession) publishLoop() error {
 // sendPublishAsyncError sends a PublishResponse containing an error back to
 // the client.
 func (p *publishAsyncSession) sendPublishAsyncError(correlationID string, err *client.PublishAsyncError) {
-	resp := &client.PublishResponse{
+	p.sendPublishResponse(newPublishAsyncErrorResponse(correlationID, err))
+}
+
+// sendPublishResponse sends a PublishResponse containing an error back to the
+// client.
+func (p *publishAsyncSession) sendPublishResponse(resp *client.PublishResponse) {
+	if err := p.stream.Send(resp); err != nil {
+		p.logger.Errorf("api: Failed to send PublishAsync error response: %v", err)
+	}
+}
+
+// newPublishAsyncErrorResponse returns a PublishResponse containing the given
+// error for the message with the given correlation ID.
+func newPublishAsyncErrorResponse(correlationID string, err *client.PublishAsyncError) *client.PublishResponse {
+	return &client.PublishResponse{
 		CorrelationId: correlationID,
 		// Set an Ack with an empty correlation id so we don't break older
 		// clients that are unaware of AsyncError. TODO (2.0.0): Remove when
@@ -1179,9 +1766,6 @@ func (p *publishAsyncSession) sendPublishAsyncError(correlationID string, err *c
 		Ack:        &client.Ack{CorrelationId: ""},
 		AsyncError: err,
 	}
-	if err := p.stream.Send(resp); err != nil {
-		p.logger.Errorf("api: Failed to send PublishAsync error response: %v", err)
-	}
 }
 
 // waitForInflight attempts to wait for remaining acks for any in-flight
@@ -1203,6 +1787,11 @@ func (p *publishAsyncSession) close() {
 	if p.sub != nil {
 		p.sub.Unsubscribe()
 	}
+	// Acks that never arrived are no longer waited for.
+	p.mu.Lock()
+	atomic.AddInt64(&p.inflightPublishes, -int64(p.inflight))
+	p.inflight = 0
+	p.mu.Unlock()
 }
 
 // isReservedStream indicates if the provided stream name is a reserved stream.
@@ -18,8 +18,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
 	"github.com/liftbridge-io/liftbridge/server/protocol"
 )
 
@@ -118,16 +120,21 @@ func TestCreateStreamNoMetadataLeader(t *testing.T) {
 	s1.Stop()
 	waitForNoMetadataLeader(t, 10*time.Second, s2)
 
-	// Connect and send the request to the follower.
-	client, err := lift.Connect([]string{"localhost:5050"})
+	// Connect and send the request to the follower, which gives up waiting
+	// for a leader after the default timeout. The client retries Unavailable
+	// errors, so use the API directly.
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
 	require.NoError(t, err)
-	defer client.Close()
+	defer conn.Close()
 
-	err = client.CreateStream(context.Background(), "foo", "foo")
+	_, err = proto.NewAPIClient(conn).CreateStream(context.Background(), &proto.CreateStreamRequest{
+		Name:    "foo",
+		Subject: "foo",
+	})
 	require.Error(t, err)
 	st := status.Convert(err)
 	require.Equal(t, "no known metadata leader", st.Message())
-	require.Equal(t, codes.Internal, st.Code())
+	require.Equal(t, codes.Unavailable, st.Code())
 }
 
 // Ensure creating a stream fails when the replication factor is greater than
@@ -183,6 +190,58 @@ func TestCreateStreamPartitioned(t *testing.T) {
 	require.Equal(t, 3, leaderCounts[s1.config.Clustering.ServerID])
 }
 
+// Ensure creating a stream with many partitions led by other brokers returns
+// as soon as the leaders have started the partitions rather than polling their
+// status.
+func TestCreateStreamManyPartitionsWaitsForLeaders(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure servers.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	s3Config := getTestConfig("c", false, 5052)
+	s3 := runServerWithConfig(t, s3Config)
+	defer s3.Stop()
+
+	servers := []*Server{s1, s2, s3}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	const numPartitions = 32
+	start := time.Now()
+	err = client.CreateStream(context.Background(), "foo", "foo",
+		lift.Partitions(numPartitions), lift.ReplicationFactor(3))
+	require.NoError(t, err)
+	elapsed := time.Since(start)
+
+	// Every partition should be started by its leader once the request
+	// returns.
+	for i := int32(0); i < numPartitions; i++ {
+		var leading bool
+		for _, s := range servers {
+			partition := s.metadata.GetPartition("foo", i)
+			require.NotNil(t, partition)
+			if partition.Leader == s.config.Clustering.ServerID {
+				leading = partition.IsLeader()
+			}
+		}
+		require.True(t, leading, "partition %d not started by its leader", i)
+	}
+
+	// Waiting on notifications should take nowhere near the propagate timeout
+	// the status polling could fall back to.
+	require.Less(t, int64(elapsed), int64(defaultPropagateTimeout/2))
+}
+
 // Ensure creating a stream with an invalid NATS subject fails.
 func TestCreateStreamInvalidSubject(t *testing.T) {
 	defer cleanupStorage(t)
@@ -203,6 +262,59 @@ func TestCreateStreamInvalidSubject(t *testing.T) {
 
 	err = client.CreateStream(context.Background(), "", "bar")
 	require.Error(t, err)
+
+	for _, subject := range []string{"foo..bar", "foo.>.bar", "foo.b*r", "foo.>>"} {
+		err = client.CreateStream(context.Background(), subject, "bar")
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), subject)
+	}
+
+	// A trailing ">" wildcard can't be extended with partition IDs.
+	err = client.CreateStream(context.Background(), "foo.>", "bar", lift.Partitions(2))
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Wildcards are otherwise allowed, and overlapping subjects are fine
+	// since exclusive subjects are disabled by default.
+	require.NoError(t, client.CreateStream(context.Background(), "foo.>", "bar"))
+	require.NoError(t, client.CreateStream(context.Background(), "foo.*.baz", "baz", lift.Partitions(2)))
+}
+
+// Ensure CreateStream rejects streams whose partition subjects overlap those
+// of an existing stream when exclusive subjects are enabled.
+func TestCreateStreamExclusiveSubjects(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Streams.ExclusiveSubjects = true
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx := context.Background()
+	require.NoError(t, client.CreateStream(ctx, "orders.eu", "eu", lift.Partitions(2)))
+
+	// Wildcards matching either partition's subject overlap.
+	for _, subject := range []string{"orders.eu", "orders.*", "orders.>", "*.eu.1", ">"} {
+		err = client.CreateStream(ctx, subject, "overlap")
+		require.Error(t, err, subject)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err), subject)
+	}
+
+	// So do the subjects of other partitions of the new stream.
+	require.NoError(t, client.CreateStream(ctx, "metrics.1", "metrics-1"))
+	err = client.CreateStream(ctx, "metrics", "overlap", lift.Partitions(2))
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	require.NoError(t, client.CreateStream(ctx, "orders.us", "us"))
+	require.NoError(t, client.CreateStream(ctx, "orders.*.archive", "archive"))
 }
 
 // Ensure subscribing to a non-existent stream returns an error.
@@ -671,6 +783,175 @@ func TestStreamPublishSubscribe(t *testing.T) {
 	require.Error(t, err)
 }
 
+// Ensure messages retried by an idempotent producer are only written once and
+// that out-of-order sequence numbers are rejected.
+func TestPublishIdempotentProducer(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	subject := "foo"
+	err = client.CreateStream(context.Background(), subject, name)
+	require.NoError(t, err)
+
+	publish := func(producerID string, sequence string) (*lift.Ack, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return client.Publish(ctx, name, []byte("seq-"+sequence),
+			lift.Header(producerIDHeader, []byte(producerID)),
+			lift.Header(producerSequenceHeader, []byte(sequence)))
+	}
+
+	ack, err := publish("p1", "0")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), ack.Offset())
+
+	// Retrying the same sequence returns the original offset.
+	ack, err = publish("p1", "0")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), ack.Offset())
+
+	ack, err = publish("p1", "1")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), ack.Offset())
+
+	// Sequences are tracked per producer.
+	ack, err = publish("p2", "0")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), ack.Offset())
+
+	ack, err = publish("p1", "1")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), ack.Offset())
+
+	// Skipping a sequence number is rejected.
+	_, err = publish("p1", "3")
+	require.Error(t, err)
+
+	// So is an invalid sequence number. The Publish endpoint is used since
+	// the client doesn't surface the error code of async publishes.
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = proto.NewAPIClient(conn).Publish(context.Background(), &proto.PublishRequest{
+		Stream: name,
+		Value:  []byte("seq-foo"),
+		Headers: map[string][]byte{
+			producerIDHeader:       []byte("p1"),
+			producerSequenceHeader: []byte("foo"),
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Only the distinct messages were written.
+	partition := s1.metadata.GetPartition(name, 0)
+	require.NotNil(t, partition)
+	require.Equal(t, int64(2), partition.log.NewestOffset())
+
+	// Messages without a producer ID are not deduplicated.
+	ack, err = client.Publish(context.Background(), name, []byte("plain"))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), ack.Offset())
+	ack, err = client.Publish(context.Background(), name, []byte("plain"))
+	require.NoError(t, err)
+	require.Equal(t, int64(4), ack.Offset())
+}
+
+// Ensure messages are not delivered to subscribers once their TTL elapses and
+// expired messages are counted in the FetchPartitionMetadata headers.
+func TestPublishMessageTTL(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	subject := "foo"
+	err = client.CreateStream(context.Background(), subject, name)
+	require.NoError(t, err)
+
+	ttl := []byte(strconv.FormatInt(int64(100*time.Millisecond), 10))
+	for i := 0; i < 5; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		_, err := client.Publish(ctx, name, []byte("expiring"), lift.Header(commitlog.TTLHeader, ttl))
+		cancel()
+		require.NoError(t, err)
+	}
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	apiClient := proto.NewAPIClient(conn)
+
+	// Invalid TTLs are rejected. The Publish endpoint is used since the
+	// client doesn't surface the error code of async publishes.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	_, err = apiClient.Publish(ctx, &proto.PublishRequest{
+		Stream:  name,
+		Value:   []byte("invalid"),
+		Headers: map[string][]byte{commitlog.TTLHeader: []byte("-1")},
+	})
+	cancel()
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	time.Sleep(200 * time.Millisecond)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	_, err = client.Publish(ctx, name, []byte("live"))
+	cancel()
+	require.NoError(t, err)
+
+	// Only the message without a TTL is delivered.
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	msgs := make(chan *lift.Message, 10)
+	err = client.Subscribe(ctx, name, func(msg *lift.Message, err error) {
+		if err == nil {
+			msgs <- msg
+		}
+	}, lift.StartAtEarliestReceived())
+	require.NoError(t, err)
+
+	select {
+	case msg := <-msgs:
+		require.Equal(t, int64(5), msg.Offset())
+		require.Equal(t, []byte("live"), msg.Value())
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive expected message")
+	}
+	select {
+	case msg := <-msgs:
+		t.Fatalf("Received unexpected message at offset %d", msg.Offset())
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	var header metadata.MD
+	_, err = apiClient.FetchPartitionMetadata(context.Background(),
+		&proto.FetchPartitionMetadataRequest{Stream: name}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Equal(t, []string{"5"}, header.Get(expiredMessagesHeader))
+}
+
 // Ensure legacy Publish endpoint works.
 func TestLegacyPublish(t *testing.T) {
 	defer cleanupStorage(t)
@@ -796,7 +1077,7 @@ func TestSubscribePartitionDeleted(t *testing.T) {
 	require.NoError(t, err)
 
 	req := &proto.SubscribeRequest{StartPosition: proto.StartPosition_NEW_ONLY}
-	sub, status := api.subscribe(context.Background(), stream.GetPartitions()[0], req)
+	sub, status := api.subscribe(context.Background(), stream.GetPartitions()[0], req, nil, false)
 	require.Nil(t, status)
 
 	require.NoError(t, stream.Delete())
@@ -809,8 +1090,9 @@ func TestSubscribePartitionDeleted(t *testing.T) {
 	}
 }
 
-// Ensure subscribe sends a FailedPrecondition error when the partition is
-// paused.
+// Ensure subscriptions to a partition which is paused are parked rather than
+// ended and are sent a FailedPrecondition error if the partition is closed
+// before it's resumed.
 func TestSubscribePartitionPaused(t *testing.T) {
 	defer cleanupStorage(t)
 
@@ -830,12 +1112,20 @@ func TestSubscribePartitionPaused(t *testing.T) {
 	require.NoError(t, err)
 
 	req := &proto.SubscribeRequest{StartPosition: proto.StartPosition_NEW_ONLY}
-	sub, status := api.subscribe(context.Background(), stream.GetPartitions()[0], req)
+	sub, status := api.subscribe(context.Background(), stream.GetPartitions()[0], req, nil, false)
 	require.Nil(t, status)
 
 	_, err = stream.Pause(nil, true)
 	require.NoError(t, err)
 
+	select {
+	case status := <-sub.Errors():
+		t.Fatalf("Subscription ended when paused: %v", status.Err())
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.NoError(t, stream.Close())
+
 	select {
 	case status := <-sub.Errors():
 		require.Equal(t, codes.FailedPrecondition, status.Code())
@@ -864,7 +1154,7 @@ func TestSubscribePartitionClosed(t *testing.T) {
 	require.NoError(t, err)
 
 	req := &proto.SubscribeRequest{StartPosition: proto.StartPosition_NEW_ONLY}
-	sub, status := api.subscribe(context.Background(), stream.GetPartitions()[0], req)
+	sub, status := api.subscribe(context.Background(), stream.GetPartitions()[0], req, nil, false)
 	require.Nil(t, status)
 
 	require.NoError(t, stream.Close())
@@ -1327,6 +1617,135 @@ func TestFetchPartitionMetadataReadonlyTimestamps(t *testing.T) {
 	require.True(t, metadata.ReadonlyTimestamps().LatestTime().After(firstReadonlyTimestamp))
 }
 
+// Ensure readonlyAtOffsetFromContext parses the liftbridge-readonly-at-offset
+// metadata.
+func TestReadonlyAtOffsetFromContext(t *testing.T) {
+	target, err := readonlyAtOffsetFromContext(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, target)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		readonlyAtOffsetMetadataKey, "42"))
+	target, err = readonlyAtOffsetFromContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), target.Value)
+
+	for _, md := range []metadata.MD{
+		metadata.Pairs(readonlyAtOffsetMetadataKey, "foo"),
+		metadata.Pairs(readonlyAtOffsetMetadataKey, "-1"),
+		metadata.Pairs(readonlyAtOffsetMetadataKey, "1", readonlyAtOffsetMetadataKey, "2"),
+	} {
+		_, err := readonlyAtOffsetFromContext(metadata.NewIncomingContext(context.Background(), md))
+		require.Error(t, err)
+	}
+}
+
+// Ensure setting a stream readonly at an offset keeps accepting messages until
+// the offset is reached, that FetchPartitionMetadata reports the pending
+// target, and that the target is enforced after a restart.
+func TestSetStreamReadonlyAtOffset(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	require.NoError(t, client.CreateStream(context.Background(), name, name))
+
+	dial := func() proto.APIClient {
+		conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return proto.NewAPIClient(conn)
+	}
+	apiClient := dial()
+	publish := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err := apiClient.Publish(ctx, &proto.PublishRequest{
+			Stream:    name,
+			Value:     []byte("hello"),
+			AckPolicy: proto.AckPolicy_LEADER,
+		})
+		return err
+	}
+	setReadonly := func(readonly bool, offset string) error {
+		ctx := context.Background()
+		if offset != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, readonlyAtOffsetMetadataKey, offset)
+		}
+		_, err := apiClient.SetStreamReadonly(ctx, &proto.SetStreamReadonlyRequest{
+			Name:     name,
+			Readonly: readonly,
+		})
+		return err
+	}
+	// fetchReadonly returns the partition's readonly flag and pending target.
+	fetchReadonly := func() (bool, string) {
+		var header metadata.MD
+		resp, err := apiClient.FetchPartitionMetadata(context.Background(),
+			&proto.FetchPartitionMetadataRequest{Stream: name}, grpc.Header(&header))
+		require.NoError(t, err)
+		values := header.Get(readonlyTargetOffsetHeader)
+		require.Len(t, values, 1)
+		return resp.Metadata.Readonly, values[0]
+	}
+
+	require.NoError(t, publish())
+	readonly, target := fetchReadonly()
+	require.False(t, readonly)
+	require.Equal(t, "-1", target)
+
+	require.Equal(t, codes.InvalidArgument, status.Code(setReadonly(true, "-1")))
+	require.Equal(t, codes.InvalidArgument, status.Code(setReadonly(false, "3")))
+
+	require.NoError(t, setReadonly(true, "3"))
+	readonly, target = fetchReadonly()
+	require.False(t, readonly)
+	require.Equal(t, "3", target)
+
+	// Restart the server from a snapshot before the target is reached.
+	// Recovered partitions are started once the Raft log is replayed, so
+	// an entry is appended after the snapshot.
+	require.NoError(t, s1.getRaft().Snapshot().Error())
+	require.NoError(t, client.CreateStream(context.Background(), "bar", "bar"))
+	s1.Stop()
+	s1 = runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+	getMetadataLeader(t, 10*time.Second, s1)
+	getPartitionLeader(t, 10*time.Second, name, 0, s1)
+	apiClient = dial()
+
+	readonly, target = fetchReadonly()
+	require.False(t, readonly)
+	require.Equal(t, "3", target)
+
+	// Messages are accepted until the target is reached.
+	for i := 0; i < 3; i++ {
+		require.NoError(t, publish())
+	}
+	readonly, target = fetchReadonly()
+	require.True(t, readonly)
+	require.Equal(t, "-1", target)
+	require.Equal(t, codes.FailedPrecondition, status.Code(publish()))
+
+	// A target the partition has already reached takes effect immediately.
+	require.NoError(t, setReadonly(false, ""))
+	require.NoError(t, publish())
+	require.NoError(t, setReadonly(true, "0"))
+	readonly, target = fetchReadonly()
+	require.True(t, readonly)
+	require.Equal(t, "-1", target)
+}
+
 // TestPublishAsync ensures async publish with AckHandler is able to handle async error.
 func TestPublishAsync(t *testing.T) {
 	defer cleanupStorage(t)
@@ -1371,6 +1790,90 @@ func TestPublishAsync(t *testing.T) {
 	}
 }
 
+// Ensure publishes are rejected with FailedPrecondition once the ISR shrinks
+// below the minimum ISR size and that FetchPartitionMetadata reports it.
+func TestPublishBelowMinISR(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use a central NATS server so it outlives the stopped follower.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	// Configure servers.
+	servers := make([]*Server, 3)
+	for i, id := range []string{"a", "b", "c"} {
+		config := getTestConfig(id, i == 0, 5050+i)
+		config.EmbeddedNATS = false
+		config.Clustering.MinISR = 2
+		config.Clustering.ReplicaMaxLagTime = time.Second
+		servers[i] = runServerWithConfig(t, config)
+		defer servers[i].Stop()
+	}
+
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	client, err := lift.Connect([]string{"localhost:5050", "localhost:5051", "localhost:5052"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	err = client.CreateStream(context.Background(), "foo", name, lift.ReplicationFactor(2))
+	require.NoError(t, err)
+	waitForISR(t, 10*time.Second, name, 0, 2, servers...)
+
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", leader.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	apiClient := proto.NewAPIClient(conn)
+
+	publish := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err := apiClient.Publish(ctx, &proto.PublishRequest{
+			Stream:    name,
+			Value:     []byte("hello"),
+			AckPolicy: proto.AckPolicy_ALL,
+		})
+		return err
+	}
+	minISRViolated := func() string {
+		var header metadata.MD
+		_, err := apiClient.FetchPartitionMetadata(context.Background(),
+			&proto.FetchPartitionMetadataRequest{Stream: name}, grpc.Header(&header))
+		require.NoError(t, err)
+		values := header.Get(minISRViolatedHeader)
+		require.Len(t, values, 1)
+		return values[0]
+	}
+
+	require.NoError(t, publish())
+	require.Equal(t, "false", minISRViolated())
+
+	// Stop the follower and wait for the ISR to shrink.
+	var followerID string
+	for _, replica := range leader.metadata.GetPartition(name, 0).GetReplicas() {
+		if replica != leader.config.Clustering.ServerID {
+			followerID = replica
+		}
+	}
+	var remaining []*Server
+	for _, s := range servers {
+		if s.config.Clustering.ServerID == followerID {
+			s.Stop()
+			continue
+		}
+		remaining = append(remaining, s)
+	}
+	require.Len(t, remaining, 2)
+	waitForISR(t, 10*time.Second, name, 0, 1, remaining...)
+
+	err = publish()
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Equal(t, "true", minISRViolated())
+}
+
 // TestPublishAsyncWithConcurrencyNoAckPolicy ensures an error is trigger in case of concurrent publishes
 // and no AckPolicy is set
 func TestPublishAsyncWithConcurrencyNoAckPolicy(t *testing.T) {
@@ -1817,6 +2320,145 @@ func TestMultiplePublishAsyncWithConcurrencyRetryWithFetchMetadata(t *testing.T)
 
 }
 
+// Ensure that when two publishers race to publish with the same expected
+// offset, exactly one succeeds and the other learns the offset to retry with.
+func TestConcurrentPublishAsyncExpectedOffsetRetry(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use a central NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.EmbeddedNATS = false
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client1, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client1.Close()
+
+	client2, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client2.Close()
+
+	err = client1.CreateStream(context.Background(), "foo", "foo", lift.OptimisticConcurrencyControl(true))
+	require.NoError(t, err)
+
+	type result struct {
+		client lift.Client
+		ack    *lift.Ack
+		err    error
+	}
+	results := make(chan result, 2)
+	for _, c := range []lift.Client{client1, client2} {
+		c := c
+		go func() {
+			err := c.PublishAsync(context.Background(), "foo", []byte("hello"),
+				func(ack *lift.Ack, err error) {
+					results <- result{client: c, ack: ack, err: err}
+				},
+				lift.AckPolicyLeader(),
+				lift.ExpectedOffset(0),
+			)
+			if err != nil {
+				results <- result{client: c, err: err}
+			}
+		}()
+	}
+
+	var winners, losers []result
+	for i := 0; i < 2; i++ {
+		select {
+		case r := <-results:
+			if r.err == nil {
+				winners = append(winners, r)
+			} else {
+				losers = append(losers, r)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Did not receive expected ack")
+		}
+	}
+	require.Len(t, winners, 1)
+	require.Len(t, losers, 1)
+	require.Equal(t, int64(0), winners[0].ack.Offset())
+
+	// The loser is told the offset to retry with.
+	loser := losers[0]
+	require.Equal(t, "incorrect expected offset", status.Convert(loser.err).Message())
+	require.NotNil(t, loser.ack)
+	require.Equal(t, int64(1), loser.ack.Offset())
+
+	err = loser.client.PublishAsync(context.Background(), "foo", []byte("hello"),
+		func(ack *lift.Ack, err error) {
+			results <- result{ack: ack, err: err}
+		},
+		lift.AckPolicyLeader(),
+		lift.ExpectedOffset(loser.ack.Offset()),
+	)
+	require.NoError(t, err)
+
+	select {
+	case r := <-results:
+		require.NoError(t, r.err)
+		require.Equal(t, int64(1), r.ack.Offset())
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive expected ack")
+	}
+}
+
+// Ensure a Publish rejected because of an incorrect expected offset sets the
+// expected offset trailer.
+func TestPublishExpectedOffsetTrailer(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use a central NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.EmbeddedNATS = false
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	err = client.CreateStream(context.Background(), "foo", "foo", lift.OptimisticConcurrencyControl(true))
+	require.NoError(t, err)
+
+	_, err = client.Publish(context.Background(), "foo", []byte("hello"),
+		lift.ExpectedOffset(0))
+	require.NoError(t, err)
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	apiClient := proto.NewAPIClient(conn)
+
+	// The Publish endpoint only waits for the ack if a deadline is set.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var trailer metadata.MD
+	_, err = apiClient.Publish(ctx, &proto.PublishRequest{
+		Stream:         "foo",
+		Value:          []byte("hello"),
+		AckPolicy:      proto.AckPolicy_LEADER,
+		ExpectedOffset: 0,
+	}, grpc.Trailer(&trailer))
+	require.Error(t, err)
+	require.Equal(t, "incorrect expected offset", status.Convert(err).Message())
+	require.Equal(t, []string{"1"}, trailer.Get(expectedOffsetHeader))
+}
+
 // TestDataEncryptionStream ensures publishing and receiving messages on a stream works with data Encryption-at-Rest.
 func TestDataEncryptionStream(t *testing.T) {
 	defer cleanupStorage(t)
@@ -2162,6 +2804,123 @@ func TestFetchMetadata(t *testing.T) {
 	}
 }
 
+// Ensure FetchMetadata returns the current broker partition and leader counts
+// even when the broker list is served from the metadata cache.
+func TestFetchMetadataCachedBrokerLoad(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server with a metadata cache that won't expire during the
+	// test.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.MetadataCacheMaxAge = time.Hour
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	apiClient := proto.NewAPIClient(conn)
+
+	_, err = apiClient.CreateStream(context.Background(), &proto.CreateStreamRequest{
+		Subject:    "foo",
+		Name:       "foo",
+		Partitions: 1,
+	})
+	require.NoError(t, err)
+
+	resp, err := apiClient.FetchMetadata(context.Background(), &proto.FetchMetadataRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Brokers, 1)
+	require.Equal(t, int32(1), resp.Brokers[0].PartitionCount)
+	require.Equal(t, int32(1), resp.Brokers[0].LeaderCount)
+
+	_, err = apiClient.CreateStream(context.Background(), &proto.CreateStreamRequest{
+		Subject:    "bar",
+		Name:       "bar",
+		Partitions: 3,
+	})
+	require.NoError(t, err)
+
+	// The broker list is cached but the counts should reflect the new stream.
+	resp, err = apiClient.FetchMetadata(context.Background(), &proto.FetchMetadataRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Brokers, 1)
+	require.Equal(t, "a", resp.Brokers[0].Id)
+	require.Equal(t, int32(4), resp.Brokers[0].PartitionCount)
+	require.Equal(t, int32(4), resp.Brokers[0].LeaderCount)
+}
+
+// Ensure FetchMetadata returns the broker addresses advertised for the
+// listener the request was received on or the one selected with request
+// metadata, falling back to the default address of brokers without it.
+func TestFetchMetadataListeners(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server with internal and external listeners.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Listeners = []ListenerConfig{
+		{Name: "internal", Listen: HostPort{Host: "localhost", Port: 5060}, Host: "a.internal"},
+		{Name: "external", Listen: HostPort{Host: "localhost", Port: 5061}, Host: "a.example.com", Port: 443},
+	}
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server with only an internal listener.
+	s2Config := getTestConfig("b", false, 5051)
+	s2Config.Listeners = []ListenerConfig{
+		{Name: "internal", Listen: HostPort{Host: "localhost", Port: 5062}, Host: "b.internal"},
+	}
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1, s2)
+
+	fetchBrokers := func(port int, listener string) map[string]string {
+		conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+		require.NoError(t, err)
+		defer conn.Close()
+		ctx := context.Background()
+		if listener != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, listenerMetadataKey, listener)
+		}
+		resp, err := proto.NewAPIClient(conn).FetchMetadata(ctx, &proto.FetchMetadataRequest{})
+		require.NoError(t, err)
+		brokers := make(map[string]string, len(resp.Brokers))
+		for _, broker := range resp.Brokers {
+			brokers[broker.Id] = fmt.Sprintf("%s:%d", broker.Host, broker.Port)
+		}
+		return brokers
+	}
+
+	// The default listener returns the default addresses.
+	require.Equal(t, map[string]string{"a": "localhost:5050", "b": "localhost:5051"},
+		fetchBrokers(5050, ""))
+
+	// The listener the request arrives on selects the addresses. The port
+	// defaults to the one bound to.
+	require.Equal(t, map[string]string{"a": "a.internal:5060", "b": "b.internal:5062"},
+		fetchBrokers(5060, ""))
+	require.Equal(t, map[string]string{"a": "a.example.com:443", "b": "localhost:5051"},
+		fetchBrokers(5061, ""))
+
+	// Request metadata overrides the listener the request arrives on.
+	require.Equal(t, map[string]string{"a": "a.example.com:443", "b": "localhost:5051"},
+		fetchBrokers(5050, "external"))
+	require.Equal(t, map[string]string{"a": "a.internal:5060", "b": "b.internal:5062"},
+		fetchBrokers(5051, "internal"))
+
+	// Listeners unknown to the broker receiving the request are rejected.
+	conn, err := grpc.Dial("localhost:5051", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx := metadata.AppendToOutgoingContext(context.Background(), listenerMetadataKey, "external")
+	_, err = proto.NewAPIClient(conn).FetchMetadata(ctx, &proto.FetchMetadataRequest{})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestAuthzWithGrantedResource(t *testing.T) {
 	defer cleanupStorage(t)
 
@@ -2352,3 +3111,201 @@ func TestAuthzWithDeniedResource(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "The client is not authorized to call")
 }
+
+// Ensure subscriptions with a header filter only receive matching messages,
+// honor a stop offset on a filtered message, and report filtered message
+// counts in the broker stats.
+func TestSubscribeHeaderFilter(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	require.NoError(t, client.CreateStream(context.Background(), "foo", name))
+
+	// Every third message is an order.
+	for i := 0; i < 10; i++ {
+		eventType := "refund"
+		if i%3 == 0 {
+			eventType = "order"
+		}
+		_, err = client.Publish(context.Background(), name, []byte(strconv.Itoa(i)),
+			lift.Header("type", []byte(eventType)))
+		require.NoError(t, err)
+	}
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	apiClient := proto.NewAPIClient(conn)
+
+	subscribe := func(ctx context.Context, req *proto.SubscribeRequest, filter ...string) proto.API_SubscribeClient {
+		for _, cond := range filter {
+			ctx = metadata.AppendToOutgoingContext(ctx, headerFilterMetadataKey, cond)
+		}
+		stream, err := apiClient.Subscribe(ctx, req)
+		require.NoError(t, err)
+		// Wait for the empty message signaling the subscription was created.
+		_, err = stream.Recv()
+		require.NoError(t, err)
+		return stream
+	}
+
+	// The stop offset is a filtered message, so the subscription should end
+	// after the last matching message before it.
+	stream := subscribe(context.Background(), &proto.SubscribeRequest{
+		Stream:        name,
+		StartPosition: proto.StartPosition_EARLIEST,
+		StopPosition:  proto.StopPosition_STOP_OFFSET,
+		StopOffset:    8,
+	}, "type=order")
+	for _, expected := range []int64{0, 3, 6} {
+		msg, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, expected, msg.Offset)
+		require.Equal(t, []byte("order"), msg.Headers["type"])
+	}
+	_, err = stream.Recv()
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Subscriptions waiting for new messages report their filtered counts.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream = subscribe(ctx, &proto.SubscribeRequest{
+		Stream:        name,
+		StartPosition: proto.StartPosition_EARLIEST,
+	}, "type=order")
+	for _, expected := range []int64{0, 3, 6, 9} {
+		msg, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, expected, msg.Offset)
+	}
+
+	var stats *protocol.SubscriptionStats
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := protocol.NewAdminAPIClient(conn).FetchBrokerStats(
+			context.Background(), &protocol.FetchBrokerStatsRequest{Stream: name})
+		require.NoError(t, err)
+		require.Len(t, resp.Partitions, 1)
+		if subs := resp.Partitions[0].Subscriptions; len(subs) == 1 && subs[0].LastOffset == 9 {
+			stats = subs[0]
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.NotNil(t, stats)
+	require.Equal(t, "type=order", stats.HeaderFilter)
+	require.Equal(t, int64(4), stats.MessagesSent)
+	require.Equal(t, int64(6), stats.MessagesFiltered)
+
+	// Filters exceeding the limits are rejected.
+	conditions := make([]string, maxHeaderFilterConditions+1)
+	for i := range conditions {
+		conditions[i] = "type"
+	}
+	ctx = context.Background()
+	for _, cond := range conditions {
+		ctx = metadata.AppendToOutgoingContext(ctx, headerFilterMetadataKey, cond)
+	}
+	stream, err = apiClient.Subscribe(ctx, &proto.SubscribeRequest{Stream: name})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// Ensure subscriptions with a header or value filter only receive the
+// matching messages.
+func TestSubscribeMessageFilter(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	require.NoError(t, client.CreateStream(context.Background(), "foo", name))
+
+	num := 1000
+	for i := 0; i < num; i++ {
+		parity := "even"
+		if i%2 == 1 {
+			parity = "odd"
+		}
+		_, err = client.Publish(context.Background(), name, []byte(strconv.Itoa(i)),
+			lift.Header("parity", []byte(parity)))
+		require.NoError(t, err)
+	}
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	apiClient := proto.NewAPIClient(conn)
+
+	// receive subscribes to the whole stream with the given filter metadata
+	// and returns the offsets of the messages received.
+	receive := func(kv ...string) []int64 {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), kv...)
+		stream, err := apiClient.Subscribe(ctx, &proto.SubscribeRequest{
+			Stream:        name,
+			StartPosition: proto.StartPosition_EARLIEST,
+			StopPosition:  proto.StopPosition_STOP_LATEST,
+		})
+		require.NoError(t, err)
+		// Wait for the empty message signaling the subscription was created.
+		_, err = stream.Recv()
+		require.NoError(t, err)
+
+		var offsets []int64
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				require.Equal(t, codes.ResourceExhausted, status.Code(err))
+				return offsets
+			}
+			offsets = append(offsets, msg.Offset)
+		}
+	}
+
+	offsets := receive(headerFilterMetadataKey, "parity=odd")
+	require.Len(t, offsets, num/2)
+	for _, offset := range offsets {
+		require.Equal(t, int64(1), offset%2)
+	}
+
+	offsets = receive(valueFilterMetadataKey, "[02468]$")
+	require.Len(t, offsets, num/2)
+	for _, offset := range offsets {
+		require.Equal(t, int64(0), offset%2)
+	}
+
+	// Both filters must match.
+	offsets = receive(headerFilterMetadataKey, "parity=even", valueFilterMetadataKey, "^9")
+	require.Len(t, offsets, 55)
+
+	// Invalid regular expressions are rejected.
+	ctx := metadata.AppendToOutgoingContext(context.Background(), valueFilterMetadataKey, "(")
+	stream, err := apiClient.Subscribe(ctx, &proto.SubscribeRequest{Stream: name})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
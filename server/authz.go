@@ -11,21 +11,33 @@ import (
 
 // addUserContext parses client ID from context and set client ID in context
 func addUserContext(ctx context.Context) context.Context {
+	clientName := peerClientID(ctx)
+	if clientName == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, "clientID", clientName)
+
+}
+
+// peerClientID returns the common name of the verified TLS client certificate
+// of the peer in the given context or an empty string if there is none.
+func peerClientID(ctx context.Context) string {
 	p, ok := peer.FromContext(ctx)
 
 	if !ok || p.AuthInfo == nil {
-		return ctx
+		return ""
 	}
 
-	tlsInfo := p.AuthInfo.(credentials.TLSInfo)
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return ""
+	}
 
 	if len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
-		return ctx
+		return ""
 	}
 
-	clientName := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
-	return context.WithValue(ctx, "clientID", clientName)
-
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
 }
 
 // AuthzUnaryInterceptor gets user from TLS-authenticated request and add user to ctx
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// autoResumeOnPublishMetadataKey is the gRPC metadata key used to set whether
+// a stream's paused partitions are resumed when published to when creating it
+// since CreateStreamRequest has no field for it.
+const autoResumeOnPublishMetadataKey = "liftbridge-auto-resume-on-publish"
+
+// autoResumeOnPublishFromContext returns the auto resume on publish setting
+// in the incoming gRPC metadata, if any.
+func autoResumeOnPublishFromContext(ctx context.Context) (*proto.NullableBool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(autoResumeOnPublishMetadataKey)
+	switch {
+	case len(values) == 0:
+		return nil, nil
+	case len(values) > 1:
+		return nil, fmt.Errorf("only one %s can be set", autoResumeOnPublishMetadataKey)
+	}
+	resume, err := strconv.ParseBool(values[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q", autoResumeOnPublishMetadataKey, values[0])
+	}
+	return &proto.NullableBool{Value: resume}, nil
+}
+
+// resumeStreamOnPublish resumes the given partition, or all of the stream's
+// paused partitions if it was paused with ResumeAll, before a message is
+// published to it. If the stream has auto resume on publish disabled,
+// publishing to a paused partition fails instead.
+func (a *apiServer) resumeStreamOnPublish(ctx context.Context, streamName string, partitionID int32) error {
+	stream := a.metadata.GetStream(streamName)
+	if stream == nil {
+		return status.Error(codes.NotFound, fmt.Sprintf("No such stream: %s", streamName))
+	}
+	if a.getStreamsConfig(stream.GetConfig()).AutoResumeOnPublish {
+		return a.resumeStream(ctx, streamName, partitionID)
+	}
+	partition := stream.GetPartition(partitionID)
+	if partition == nil {
+		return status.Error(codes.NotFound, fmt.Sprintf("No such partition: %d", partitionID))
+	}
+	if partition.IsPaused() {
+		return status.Error(codes.FailedPrecondition,
+			fmt.Sprintf("Partition %d of stream %s is paused", partitionID, streamName))
+	}
+	return nil
+}
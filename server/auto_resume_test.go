@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+// Ensure autoResumeOnPublishFromContext parses the auto resume setting from
+// the request metadata.
+func TestAutoResumeOnPublishFromContext(t *testing.T) {
+	resume, err := autoResumeOnPublishFromContext(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, resume)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		autoResumeOnPublishMetadataKey, "false"))
+	resume, err = autoResumeOnPublishFromContext(ctx)
+	require.NoError(t, err)
+	require.False(t, resume.Value)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		autoResumeOnPublishMetadataKey, "foo"))
+	_, err = autoResumeOnPublishFromContext(ctx)
+	require.Error(t, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		autoResumeOnPublishMetadataKey, "true", autoResumeOnPublishMetadataKey, "true"))
+	_, err = autoResumeOnPublishFromContext(ctx)
+	require.Error(t, err)
+}
+
+// Ensure an idle partition is automatically paused and resumed by the next
+// publish, unless its stream has auto resume on publish disabled, in which
+// case the publish fails and the partition stays paused.
+func TestAutoResumeOnPublish(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.CreateStream(context.Background(), "foo", "foo",
+		lift.AutoPauseTime(500*time.Millisecond)))
+	ctx := metadata.AppendToOutgoingContext(context.Background(), autoResumeOnPublishMetadataKey, "false")
+	require.NoError(t, client.CreateStream(ctx, "bar", "bar",
+		lift.AutoPauseTime(500*time.Millisecond)))
+
+	publish := func(stream string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err := client.Publish(ctx, stream, []byte("hello"), lift.AckPolicyAll())
+		return err
+	}
+	for _, stream := range []string{"foo", "bar"} {
+		require.NoError(t, publish(stream))
+	}
+
+	// Both partitions pause once they're idle.
+	time.Sleep(time.Second)
+	waitForPause(t, 5*time.Second, s1.metadata.GetPartition("foo", 0))
+	waitForPause(t, 5*time.Second, s1.metadata.GetPartition("bar", 0))
+
+	// Publishing resumes foo and stores the message.
+	require.NoError(t, publish("foo"))
+	partition := s1.metadata.GetPartition("foo", 0)
+	require.False(t, partition.IsPaused())
+	require.Equal(t, int64(1), partition.log.NewestOffset())
+
+	// Publishing to bar fails since it doesn't resume on publish.
+	err = publish("bar")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Partition 0 of stream bar is paused")
+	require.True(t, s1.metadata.GetPartition("bar", 0).IsPaused())
+}
@@ -0,0 +1,330 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	atomic_file "github.com/natefinch/atomic"
+	"github.com/pkg/errors"
+)
+
+const (
+	backupSnapshotPath     = "metadata/snapshot"
+	backupStreamsPrefix    = "streams"
+	backupStreamKey        = "stream"
+	backupManifestKey      = "manifest"
+	backupSegmentKeyFormat = "%d-%020d.log" // Stream creation timestamp and segment base offset
+)
+
+// errBackupNotFound is returned by a backupSink when there is no object with
+// the given key.
+var errBackupNotFound = errors.New("backup not found")
+
+// backupSink stores backed up objects by key. Keys are slash-separated paths,
+// like object keys in S3-style object stores.
+type backupSink interface {
+	// Put stores the object read from the given reader at the given key,
+	// replacing any existing object. The object must not be visible until
+	// it's completely stored.
+	Put(ctx context.Context, key string, r io.Reader) error
+
+	// Get returns a reader for the object at the given key or
+	// errBackupNotFound if there is none.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// dirBackupSink is a backupSink storing objects as files in a directory,
+// which is typically a mount of shared or object storage.
+type dirBackupSink struct {
+	dir string
+}
+
+func newDirBackupSink(dir string) *dirBackupSink {
+	return &dirBackupSink{dir: dir}
+}
+
+// Put atomically writes the object to the file at the given key.
+func (d *dirBackupSink) Put(ctx context.Context, key string, r io.Reader) error {
+	file := filepath.Join(d.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	return atomic_file.WriteFile(file, r)
+}
+
+// Get opens the file at the given key.
+func (d *dirBackupSink) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	f, err := os.Open(filepath.Join(d.dir, filepath.FromSlash(key)))
+	if os.IsNotExist(err) {
+		return nil, errBackupNotFound
+	}
+	return f, err
+}
+
+// backedUpSegment describes a segment copied to the backup sink.
+type backedUpSegment struct {
+	Key        string `json:"key"`
+	BaseOffset int64  `json:"baseOffset"`
+	LastOffset int64  `json:"lastOffset"`
+	Size       int64  `json:"size"`
+}
+
+// partitionBackupManifest tracks what has been backed up of a partition. It's
+// the partition's backup watermark: segments are only added to it once they
+// are completely stored in the sink.
+type partitionBackupManifest struct {
+	partitionManifest
+	HighWatermark int64              `json:"highWatermark"`
+	UpdatedAt     int64              `json:"updatedAt"`
+	Segments      []*backedUpSegment `json:"segments"`
+}
+
+// backedUpOffset returns the newest offset backed up or -1 if nothing has
+// been backed up.
+func (m *partitionBackupManifest) backedUpOffset() int64 {
+	offset := int64(-1)
+	for _, segment := range m.Segments {
+		if segment.LastOffset > offset {
+			offset = segment.LastOffset
+		}
+	}
+	return offset
+}
+
+// backupStreamPrefix returns the key prefix of the given stream's backup.
+func backupStreamPrefix(stream string) string {
+	return path.Join(backupStreamsPrefix, stream)
+}
+
+// backupPartitionPrefix returns the key prefix of the given partition's
+// backup.
+func backupPartitionPrefix(stream string, id int32) string {
+	return path.Join(backupStreamPrefix(stream), fmt.Sprintf("%d", id))
+}
+
+// getBackup reads the object at the given key from the backup sink.
+func (s *Server) getBackup(ctx context.Context, key string) ([]byte, error) {
+	r, err := s.backup.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// readPartitionBackupManifest reads the backup manifest of the given
+// partition. It returns nil if the partition has not been backed up.
+func (s *Server) readPartitionBackupManifest(ctx context.Context, stream string, id int32) (
+	*partitionBackupManifest, error) {
+
+	data, err := s.getBackup(ctx, path.Join(backupPartitionPrefix(stream, id), backupManifestKey))
+	if err == errBackupNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	manifest := new(partitionBackupManifest)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Wrap(err, "invalid partition backup manifest")
+	}
+	return manifest, nil
+}
+
+// writePartitionBackupManifest writes the backup manifest of the given
+// partition.
+func (s *Server) writePartitionBackupManifest(ctx context.Context, manifest *partitionBackupManifest) error {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	key := path.Join(backupPartitionPrefix(manifest.Stream, manifest.Partition), backupManifestKey)
+	return s.backup.Put(ctx, key, bytes.NewReader(data))
+}
+
+// backupLoop is a long-running loop which periodically backs up the metadata,
+// if this server is the metadata leader, and the sealed segments of the
+// partitions this server leads.
+func (s *Server) backupLoop() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-s.shutdownCh
+		cancel()
+	}()
+
+	ticker := time.NewTicker(s.config.Backup.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-ticker.C:
+		}
+		s.runBackup(ctx)
+	}
+}
+
+// runBackup runs a single backup cycle.
+func (s *Server) runBackup(ctx context.Context) {
+	if s.IsLeader() {
+		if err := s.backupMetadata(ctx); err != nil {
+			s.logger.Errorf("Failed to back up metadata: %v", err)
+		}
+	}
+	for _, stream := range s.metadata.GetStreams() {
+		if isReservedStream(stream.GetName()) {
+			continue
+		}
+		for _, partition := range stream.GetPartitions() {
+			if !partition.IsLeader() || partition.IsPaused() {
+				continue
+			}
+			if err := s.backupPartition(ctx, stream, partition); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				s.logger.Errorf("Failed to back up partition %s: %v", partition, err)
+			}
+		}
+	}
+}
+
+// backupMetadata stores a snapshot of the metadata along with the definition
+// of each stream. Stream definitions are kept after the stream is deleted so
+// it can be restored.
+func (s *Server) backupMetadata(ctx context.Context) error {
+	snapshot, err := s.Snapshot()
+	if err != nil {
+		return err
+	}
+	data, err := snapshot.(*fsmSnapshot).Marshal()
+	if err != nil {
+		return err
+	}
+	if err := s.backup.Put(ctx, backupSnapshotPath, bytes.NewReader(data)); err != nil {
+		return err
+	}
+	for _, stream := range s.metadata.GetStreams() {
+		if isReservedStream(stream.GetName()) {
+			continue
+		}
+		data, err := stream.Proto().Marshal()
+		if err != nil {
+			return err
+		}
+		key := path.Join(backupStreamPrefix(stream.GetName()), backupStreamKey)
+		if err := s.backup.Put(ctx, key, bytes.NewReader(data)); err != nil {
+			return errors.Wrapf(err, "failed to back up stream %s", stream.GetName())
+		}
+	}
+	return nil
+}
+
+// backupPartition copies the partition's sealed segments which haven't been
+// backed up yet and are fully committed to the backup sink. The active
+// segment is never read, so the backup lags the log by at most one segment.
+func (s *Server) backupPartition(ctx context.Context, stream *stream, p *partition) error {
+	if atomic.LoadInt32(&p.restoring) == 1 {
+		// The backup is what the partition is being restored from.
+		return nil
+	}
+	creationTimestamp := stream.GetCreationTime().UnixNano()
+
+	manifest, err := s.readPartitionBackupManifest(ctx, p.Stream, p.Id)
+	if err != nil {
+		return err
+	}
+	if manifest != nil && manifest.CreationTimestamp != creationTimestamp &&
+		stream.GetConfig().GetRestoreSource() == nil {
+		// The backup belongs to a deleted incarnation of the stream which
+		// this one wasn't restored from.
+		manifest = nil
+	}
+	if manifest == nil {
+		manifest = &partitionBackupManifest{HighWatermark: -1}
+	}
+	manifest.partitionManifest = partitionManifest{
+		Stream:            p.Stream,
+		Partition:         p.Id,
+		CreationTimestamp: creationTimestamp,
+	}
+
+	var (
+		hw       = p.log.HighWatermark()
+		backedUp = manifest.backedUpOffset()
+		shipped  = false
+	)
+	for _, segment := range p.log.SealedSegments() {
+		if segment.LastOffset <= backedUp || segment.LastOffset > hw {
+			continue
+		}
+		key := path.Join(backupPartitionPrefix(p.Stream, p.Id),
+			fmt.Sprintf(backupSegmentKeyFormat, creationTimestamp, segment.BaseOffset))
+		if err := s.backupSegment(ctx, key, segment.Path); err != nil {
+			if os.IsNotExist(err) {
+				// The segment was deleted by retention.
+				continue
+			}
+			return errors.Wrapf(err, "failed to back up segment %d", segment.BaseOffset)
+		}
+		manifest.Segments = append(manifest.Segments, &backedUpSegment{
+			Key:        key,
+			BaseOffset: segment.BaseOffset,
+			LastOffset: segment.LastOffset,
+			Size:       segment.Size,
+		})
+		manifest.HighWatermark = hw
+		manifest.UpdatedAt = time.Now().UnixNano()
+		if err := s.writePartitionBackupManifest(ctx, manifest); err != nil {
+			return err
+		}
+		backedUp = segment.LastOffset
+		shipped = true
+	}
+
+	if !shipped {
+		// Record that the partition was checked even though nothing new
+		// was sealed.
+		manifest.HighWatermark = hw
+		manifest.UpdatedAt = time.Now().UnixNano()
+		return s.writePartitionBackupManifest(ctx, manifest)
+	}
+	return nil
+}
+
+// backupSegment copies the segment's log file to the backup sink at the given
+// key, throttled to the configured backup rate.
+func (s *Server) backupSegment(ctx context.Context, key, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return s.backup.Put(ctx, key, &throttledReader{ctx: ctx, r: f, limiter: s.backupLimiter})
+}
+
+// throttledReader limits the rate at which bytes are read from a reader.
+type throttledReader struct {
+	ctx     context.Context
+	r       io.Reader
+	limiter *byteRateLimiter
+}
+
+func (t *throttledReader) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	if n > 0 {
+		if err := t.limiter.wait(t.ctx, n); err != nil {
+			return n, err
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure sealed segments are backed up and a deleted stream can be restored
+// from the backup up to the reported offsets.
+func TestBackupRestoreStream(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server with a segment per message so all but the last
+	// message are in sealed segments.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Streams.SegmentMaxBytes = 1
+	s1Config.Backup.Dir = t.TempDir()
+	s1Config.Backup.Interval = 50 * time.Millisecond
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	lc, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	name := "foo"
+	require.NoError(t, lc.CreateStream(context.Background(), name, name))
+	for i := 0; i < 10; i++ {
+		_, err := lc.Publish(context.Background(), name, []byte(fmt.Sprintf("msg-%d", i)),
+			lift.AckPolicyAll())
+		require.NoError(t, err)
+	}
+
+	// Wait for the sealed segments to be backed up. The active segment
+	// containing the last message is not.
+	require.Eventually(t, func() bool {
+		manifest, err := s1.readPartitionBackupManifest(context.Background(), name, 0)
+		require.NoError(t, err)
+		return manifest != nil && manifest.backedUpOffset() == 8 && manifest.HighWatermark == 9
+	}, 10*time.Second, 10*time.Millisecond)
+	_, err = s1.getBackup(context.Background(), path.Join(backupStreamPrefix(name), backupStreamKey))
+	require.NoError(t, err)
+
+	require.NoError(t, lc.DeleteStream(context.Background(), name))
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	// Streams without a backup can't be restored.
+	_, err = admin.RestoreStreams(context.Background(), &proto.RestoreStreamsRequest{Streams: []string{"bar"}})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	resp, err := admin.RestoreStreams(context.Background(), &proto.RestoreStreamsRequest{Streams: []string{name}})
+	require.NoError(t, err)
+	require.Equal(t, []*proto.RestoredPartition{{Stream: name, Partition: 0, Offset: 8}}, resp.Partitions)
+
+	// Existing streams can't be restored.
+	_, err = admin.RestoreStreams(context.Background(), &proto.RestoreStreamsRequest{Streams: []string{name}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// The restored stream contains the backed up messages.
+	waitForHW(t, 10*time.Second, name, 0, 8, s1)
+	msgs := make(chan *lift.Message, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err = lc.Subscribe(ctx, name, func(msg *lift.Message, err error) {
+		require.NoError(t, err)
+		msgs <- msg
+	}, lift.StartAtEarliestReceived())
+	require.NoError(t, err)
+	receive := func(from, to int) {
+		for i := from; i < to; i++ {
+			select {
+			case msg := <-msgs:
+				require.Equal(t, int64(i), msg.Offset())
+				require.Equal(t, []byte(fmt.Sprintf("msg-%d", i)), msg.Value())
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Did not receive restored message %d", i)
+			}
+		}
+	}
+	receive(0, 9)
+
+	// New messages are appended after the restored ones.
+	_, err = lc.Publish(context.Background(), name, []byte("msg-9"), lift.AckPolicyAll())
+	require.NoError(t, err)
+	receive(9, 10)
+}
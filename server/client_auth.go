@@ -0,0 +1,464 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/pkg/errors"
+	"go.yaml.in/yaml/v3"
+	"golang.org/x/crypto/bcrypt"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// AuthBackendFile authenticates clients against a YAML credentials file.
+	AuthBackendFile = "file"
+
+	// authorizationMetadataKey is the gRPC metadata key clients send their
+	// SASL mechanism and credentials in, e.g. "PLAIN <base64(user:pass)>".
+	authorizationMetadataKey = "authorization"
+
+	// authChallengeMetadataKey is the gRPC trailer key the server-first
+	// message of a SCRAM exchange is returned in.
+	authChallengeMetadataKey = "www-authenticate"
+
+	// authInfoMetadataKey is the gRPC header key the server-final message of
+	// a SCRAM exchange is returned in, which lets the client verify the
+	// server also knows its credentials.
+	authInfoMetadataKey = "authentication-info"
+
+	saslPlain          = "PLAIN"
+	saslSCRAMSHA256    = "SCRAM-SHA-256"
+	scramGS2Header     = "n,,"
+	scramNonceLen      = 18
+	scramMinIterations = 4096
+
+	// scramConversationTimeout is how long the server waits for the
+	// client-final message of a SCRAM exchange after sending its challenge.
+	scramConversationTimeout = 30 * time.Second
+
+	// maxSCRAMConversations bounds the number of SCRAM exchanges awaiting
+	// their client-final message so clients can't exhaust server memory by
+	// starting exchanges they never finish.
+	maxSCRAMConversations = 1024
+
+	// healthServicePrefix is the method prefix of the gRPC health service,
+	// which is exempt from authentication so probes keep working.
+	healthServicePrefix = "/grpc.health.v1.Health/"
+)
+
+var errAuthFailed = status.Error(codes.Unauthenticated, "authentication failed")
+
+// scramCredential is the SCRAM-SHA-256 verifier stored for a user, which
+// lets the server authenticate a client without knowing its password.
+type scramCredential struct {
+	salt       []byte
+	iterations int
+	storedKey  []byte
+	serverKey  []byte
+}
+
+// newSCRAMCredential derives the SCRAM-SHA-256 verifier for the given
+// password.
+func newSCRAMCredential(password string, salt []byte, iterations int) (*scramCredential, error) {
+	salted, err := pbkdf2.Key(sha256.New, password, salt, iterations, sha256.Size)
+	if err != nil {
+		return nil, err
+	}
+	clientKey := scramHMAC(salted, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+	return &scramCredential{
+		salt:       salt,
+		iterations: iterations,
+		storedKey:  storedKey[:],
+		serverKey:  scramHMAC(salted, []byte("Server Key")),
+	}, nil
+}
+
+// parseSCRAMCredential parses a verifier in the RFC 5803 format, i.e.
+// SCRAM-SHA-256$<iterations>:<salt>$<StoredKey>:<ServerKey> with base64
+// encoded salt and keys.
+func parseSCRAMCredential(s string) (*scramCredential, error) {
+	parts := strings.Split(s, "$")
+	if len(parts) != 3 || parts[0] != saslSCRAMSHA256 {
+		return nil, errors.New("malformed SCRAM-SHA-256 credential")
+	}
+	iterSalt := strings.SplitN(parts[1], ":", 2)
+	keys := strings.SplitN(parts[2], ":", 2)
+	if len(iterSalt) != 2 || len(keys) != 2 {
+		return nil, errors.New("malformed SCRAM-SHA-256 credential")
+	}
+	iterations, err := strconv.Atoi(iterSalt[0])
+	if err != nil || iterations < scramMinIterations {
+		return nil, fmt.Errorf("SCRAM-SHA-256 iteration count must be at least %d", scramMinIterations)
+	}
+	cred := &scramCredential{iterations: iterations}
+	for _, field := range []struct {
+		dst *[]byte
+		src string
+	}{
+		{&cred.salt, iterSalt[1]},
+		{&cred.storedKey, keys[0]},
+		{&cred.serverKey, keys[1]},
+	} {
+		if *field.dst, err = base64.StdEncoding.DecodeString(field.src); err != nil {
+			return nil, errors.Wrap(err, "malformed SCRAM-SHA-256 credential")
+		}
+	}
+	if len(cred.storedKey) != sha256.Size || len(cred.serverKey) != sha256.Size {
+		return nil, errors.New("malformed SCRAM-SHA-256 credential")
+	}
+	return cred, nil
+}
+
+// String returns the verifier in the RFC 5803 format.
+func (c *scramCredential) String() string {
+	enc := base64.StdEncoding.EncodeToString
+	return fmt.Sprintf("%s$%d:%s$%s:%s", saslSCRAMSHA256, c.iterations,
+		enc(c.salt), enc(c.storedKey), enc(c.serverKey))
+}
+
+// verifyPassword indicates if the verifier was derived from the given
+// password.
+func (c *scramCredential) verifyPassword(password string) bool {
+	derived, err := newSCRAMCredential(password, c.salt, c.iterations)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(derived.storedKey, c.storedKey)
+}
+
+// clientCredential is the secret stored for a user in the credentials file,
+// either a bcrypt hash of its password or a SCRAM-SHA-256 verifier. Users
+// with a bcrypt hash can only authenticate with SASL/PLAIN since SCRAM needs
+// a verifier derived from the password.
+type clientCredential struct {
+	bcryptHash []byte
+	scram      *scramCredential
+}
+
+// loadCredentialsFile reads a YAML mapping of username to credential.
+func loadCredentialsFile(path string) (map[string]*clientCredential, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read credentials file")
+	}
+	var entries map[string]string
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return nil, errors.Wrap(err, "failed to parse credentials file")
+	}
+	creds := make(map[string]*clientCredential, len(entries))
+	for user, secret := range entries {
+		if user == "" {
+			return nil, errors.New("credentials file contains an empty username")
+		}
+		if strings.HasPrefix(secret, saslSCRAMSHA256+"$") {
+			scram, err := parseSCRAMCredential(secret)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid credential for user %s", user)
+			}
+			creds[user] = &clientCredential{scram: scram}
+			continue
+		}
+		if _, err := bcrypt.Cost([]byte(secret)); err != nil {
+			return nil, errors.Wrapf(err, "invalid bcrypt hash for user %s", user)
+		}
+		creds[user] = &clientCredential{bcryptHash: []byte(secret)}
+	}
+	return creds, nil
+}
+
+// scramConversation is a SCRAM exchange awaiting its client-final message.
+type scramConversation struct {
+	username        string
+	clientFirstBare string
+	serverFirst     string
+	expires         time.Time
+}
+
+// clientAuthenticator authenticates gRPC clients with SASL/PLAIN or
+// SASL/SCRAM-SHA-256 credentials sent in the authorization request metadata.
+//
+// PLAIN credentials are "PLAIN <base64(username:password)>" and are checked
+// on every call, so they should only be used over TLS. SCRAM takes two round
+// trips: the client sends its client-first message, the call fails with
+// Unauthenticated and the server-first message in the www-authenticate
+// trailer, then the client retries with its client-final message and the
+// call proceeds with the server-final message in the authentication-info
+// header. Pending exchanges are keyed by their combined nonce.
+type clientAuthenticator struct {
+	credentials   map[string]*clientCredential
+	mu            sync.Mutex
+	verified      map[string][sha256.Size]byte // username -> digest of last verified PLAIN password
+	conversations map[string]*scramConversation
+}
+
+func newClientAuthenticator(config AuthConfig) (*clientAuthenticator, error) {
+	if config.Backend != AuthBackendFile {
+		return nil, fmt.Errorf("unsupported auth backend %q", config.Backend)
+	}
+	creds, err := loadCredentialsFile(config.CredentialsFile)
+	if err != nil {
+		return nil, err
+	}
+	return &clientAuthenticator{
+		credentials:   creds,
+		verified:      make(map[string][sha256.Size]byte),
+		conversations: make(map[string]*scramConversation),
+	}, nil
+}
+
+// unaryInterceptor rejects unary calls which aren't authenticated.
+func (c *clientAuthenticator) unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+		return handler(ctx, req)
+	}
+	username, header, trailer, err := c.authenticate(ctx)
+	if trailer != nil {
+		grpc.SetTrailer(ctx, trailer)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if header != nil {
+		grpc.SetHeader(ctx, header)
+	}
+	return handler(context.WithValue(ctx, "clientID", username), req)
+}
+
+// streamInterceptor rejects streaming calls which aren't authenticated.
+func (c *clientAuthenticator) streamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+		return handler(srv, ss)
+	}
+	username, header, trailer, err := c.authenticate(ss.Context())
+	if trailer != nil {
+		ss.SetTrailer(trailer)
+	}
+	if err != nil {
+		return err
+	}
+	if header != nil {
+		if err := ss.SetHeader(header); err != nil {
+			return err
+		}
+	}
+	wrapped := grpc_middleware.WrapServerStream(ss)
+	wrapped.WrappedContext = context.WithValue(ss.Context(), "clientID", username)
+	return handler(srv, wrapped)
+}
+
+// authenticate returns the username of the client which made the call along
+// with any header to send with the response. If the client isn't
+// authenticated, an Unauthenticated error is returned along with any trailer
+// to send with it.
+func (c *clientAuthenticator) authenticate(ctx context.Context) (string, metadata.MD, metadata.MD, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationMetadataKey)
+	if len(values) == 0 {
+		return "", nil, nil, status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	parts := strings.SplitN(values[0], " ", 2)
+	if len(parts) != 2 {
+		return "", nil, nil, status.Error(codes.Unauthenticated, "malformed credentials")
+	}
+	payload, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, status.Error(codes.Unauthenticated, "malformed credentials")
+	}
+	switch strings.ToUpper(parts[0]) {
+	case saslPlain:
+		username, err := c.authenticatePlain(string(payload))
+		return username, nil, nil, err
+	case saslSCRAMSHA256:
+		return c.authenticateSCRAM(string(payload))
+	default:
+		return "", nil, nil, status.Errorf(codes.Unauthenticated, "unsupported SASL mechanism %s", parts[0])
+	}
+}
+
+// authenticatePlain verifies a "username:password" payload.
+func (c *clientAuthenticator) authenticatePlain(payload string) (string, error) {
+	parts := strings.SplitN(payload, ":", 2)
+	if len(parts) != 2 {
+		return "", status.Error(codes.Unauthenticated, "malformed credentials")
+	}
+	username, password := parts[0], parts[1]
+	cred, ok := c.credentials[username]
+	if !ok {
+		return "", errAuthFailed
+	}
+
+	// bcrypt is deliberately slow, so remember the last password verified
+	// for each user rather than hashing it again on every call.
+	digest := sha256.Sum256([]byte(password))
+	c.mu.Lock()
+	last, ok := c.verified[username]
+	c.mu.Unlock()
+	if ok && subtle.ConstantTimeCompare(last[:], digest[:]) == 1 {
+		return username, nil
+	}
+
+	if cred.scram != nil {
+		ok = cred.scram.verifyPassword(password)
+	} else {
+		ok = bcrypt.CompareHashAndPassword(cred.bcryptHash, []byte(password)) == nil
+	}
+	if !ok {
+		return "", errAuthFailed
+	}
+	c.mu.Lock()
+	c.verified[username] = digest
+	c.mu.Unlock()
+	return username, nil
+}
+
+// authenticateSCRAM handles a SCRAM-SHA-256 client-first or client-final
+// message. A client-first message always fails the call with the
+// server-first message as a challenge in the trailer.
+func (c *clientAuthenticator) authenticateSCRAM(msg string) (string, metadata.MD, metadata.MD, error) {
+	if strings.HasPrefix(msg, scramGS2Header) {
+		trailer, err := c.startSCRAM(strings.TrimPrefix(msg, scramGS2Header))
+		if err != nil {
+			return "", nil, nil, err
+		}
+		return "", nil, trailer, status.Error(codes.Unauthenticated, "SCRAM challenge")
+	}
+	return c.finishSCRAM(msg)
+}
+
+// startSCRAM handles a client-first message, without its GS2 header, and
+// returns the server-first message to send back.
+func (c *clientAuthenticator) startSCRAM(clientFirstBare string) (metadata.MD, error) {
+	attrs := parseSCRAMAttributes(clientFirstBare)
+	username, clientNonce := attrs["n"], attrs["r"]
+	if username == "" || clientNonce == "" {
+		return nil, status.Error(codes.Unauthenticated, "malformed SCRAM client-first message")
+	}
+	cred, ok := c.credentials[username]
+	if !ok || cred.scram == nil {
+		return nil, errAuthFailed
+	}
+
+	serverNonce := make([]byte, scramNonceLen)
+	if _, err := rand.Read(serverNonce); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	nonce := clientNonce + base64.RawStdEncoding.EncodeToString(serverNonce)
+	serverFirst := fmt.Sprintf("r=%s,s=%s,i=%d", nonce,
+		base64.StdEncoding.EncodeToString(cred.scram.salt), cred.scram.iterations)
+
+	now := time.Now()
+	c.mu.Lock()
+	for key, conv := range c.conversations {
+		if now.After(conv.expires) {
+			delete(c.conversations, key)
+		}
+	}
+	if len(c.conversations) >= maxSCRAMConversations {
+		c.mu.Unlock()
+		return nil, status.Error(codes.Unauthenticated, "too many pending SCRAM exchanges")
+	}
+	c.conversations[nonce] = &scramConversation{
+		username:        username,
+		clientFirstBare: clientFirstBare,
+		serverFirst:     serverFirst,
+		expires:         now.Add(scramConversationTimeout),
+	}
+	c.mu.Unlock()
+
+	return metadata.Pairs(authChallengeMetadataKey,
+		saslSCRAMSHA256+" "+base64.StdEncoding.EncodeToString([]byte(serverFirst))), nil
+}
+
+// finishSCRAM handles a client-final message, verifying the client proof and
+// returning the server-final message to send back.
+func (c *clientAuthenticator) finishSCRAM(clientFinal string) (string, metadata.MD, metadata.MD, error) {
+	idx := strings.LastIndex(clientFinal, ",p=")
+	if idx < 0 {
+		return "", nil, nil, status.Error(codes.Unauthenticated, "malformed SCRAM client-final message")
+	}
+	withoutProof := clientFinal[:idx]
+	attrs := parseSCRAMAttributes(withoutProof)
+	proof, err := base64.StdEncoding.DecodeString(clientFinal[idx+len(",p="):])
+	if err != nil || len(proof) != sha256.Size {
+		return "", nil, nil, status.Error(codes.Unauthenticated, "malformed SCRAM client-final message")
+	}
+
+	c.mu.Lock()
+	conv, ok := c.conversations[attrs["r"]]
+	delete(c.conversations, attrs["r"])
+	c.mu.Unlock()
+	if !ok || time.Now().After(conv.expires) {
+		return "", nil, nil, status.Error(codes.Unauthenticated, "unknown or expired SCRAM exchange")
+	}
+	if attrs["c"] != base64.StdEncoding.EncodeToString([]byte(scramGS2Header)) {
+		return "", nil, nil, status.Error(codes.Unauthenticated, "SCRAM channel binding is not supported")
+	}
+
+	cred := c.credentials[conv.username].scram
+	authMessage := []byte(conv.clientFirstBare + "," + conv.serverFirst + "," + withoutProof)
+	clientSignature := scramHMAC(cred.storedKey, authMessage)
+	clientKey := make([]byte, sha256.Size)
+	for i := range clientKey {
+		clientKey[i] = proof[i] ^ clientSignature[i]
+	}
+	storedKey := sha256.Sum256(clientKey)
+	if !hmac.Equal(storedKey[:], cred.storedKey) {
+		return "", nil, nil, errAuthFailed
+	}
+
+	serverSignature := scramHMAC(cred.serverKey, authMessage)
+	serverFinal := "v=" + base64.StdEncoding.EncodeToString(serverSignature)
+	header := metadata.Pairs(authInfoMetadataKey,
+		saslSCRAMSHA256+" "+base64.StdEncoding.EncodeToString([]byte(serverFinal)))
+	return conv.username, header, nil, nil
+}
+
+// parseSCRAMAttributes parses a comma-separated list of SCRAM key=value
+// attributes. Usernames have "=" and "," escaped as "=3D" and "=2C".
+func parseSCRAMAttributes(msg string) map[string]string {
+	attrs := make(map[string]string)
+	for _, attr := range strings.Split(msg, ",") {
+		if len(attr) < 2 || attr[1] != '=' {
+			continue
+		}
+		value := attr[2:]
+		if attr[0] == 'n' {
+			value = strings.NewReplacer("=2C", ",", "=3D", "=").Replace(value)
+		}
+		attrs[attr[:1]] = value
+	}
+	return attrs
+}
+
+func scramHMAC(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,258 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// writeCredentialsFile writes a credentials file with a bcrypt hash for
+// "alice" and a SCRAM-SHA-256 verifier for "bob", both with password
+// "secret".
+func writeCredentialsFile(t *testing.T) string {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+	scram, err := newSCRAMCredential("secret", []byte("0123456789abcdef"), 4096)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "credentials.yaml")
+	data := fmt.Sprintf("alice: %q\nbob: %q\n", hash, scram)
+	require.NoError(t, os.WriteFile(path, []byte(data), 0600))
+	return path
+}
+
+func runAuthServer(t *testing.T) (*Server, *grpc.ClientConn) {
+	config := getTestConfig("a", true, 5050)
+	config.Auth.Enabled = true
+	config.Auth.CredentialsFile = writeCredentialsFile(t)
+	s := runServerWithConfig(t, config)
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	return s, conn
+}
+
+func plainContext(username, password string) context.Context {
+	creds := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	return metadata.AppendToOutgoingContext(context.Background(),
+		authorizationMetadataKey, saslPlain+" "+creds)
+}
+
+func scramContext(msg string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(),
+		authorizationMetadataKey, saslSCRAMSHA256+" "+base64.StdEncoding.EncodeToString([]byte(msg)))
+}
+
+// scramClient performs the client side of a SCRAM-SHA-256 exchange.
+type scramClient struct {
+	username        string
+	password        string
+	clientFirstBare string
+	authMessage     string
+	saltedPassword  []byte
+}
+
+func (c *scramClient) clientFirst(nonce string) string {
+	c.clientFirstBare = "n=" + c.username + ",r=" + nonce
+	return scramGS2Header + c.clientFirstBare
+}
+
+func (c *scramClient) clientFinal(t *testing.T, serverFirst string) string {
+	attrs := parseSCRAMAttributes(serverFirst)
+	salt, err := base64.StdEncoding.DecodeString(attrs["s"])
+	require.NoError(t, err)
+	iterations, err := strconv.Atoi(attrs["i"])
+	require.NoError(t, err)
+	c.saltedPassword, err = pbkdf2.Key(sha256.New, c.password, salt, iterations, sha256.Size)
+	require.NoError(t, err)
+
+	withoutProof := "c=" + base64.StdEncoding.EncodeToString([]byte(scramGS2Header)) + ",r=" + attrs["r"]
+	c.authMessage = c.clientFirstBare + "," + serverFirst + "," + withoutProof
+	clientKey := scramHMAC(c.saltedPassword, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+	clientSignature := scramHMAC(storedKey[:], []byte(c.authMessage))
+	proof := make([]byte, len(clientKey))
+	for i := range proof {
+		proof[i] = clientKey[i] ^ clientSignature[i]
+	}
+	return withoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof)
+}
+
+func (c *scramClient) verifyServerFinal(serverFinal string) bool {
+	serverKey := scramHMAC(c.saltedPassword, []byte("Server Key"))
+	expected := "v=" + base64.StdEncoding.EncodeToString(scramHMAC(serverKey, []byte(c.authMessage)))
+	return hmac.Equal([]byte(expected), []byte(serverFinal))
+}
+
+// decodeAuthMetadata returns the SCRAM message in the given metadata value.
+func decodeAuthMetadata(t *testing.T, values []string) string {
+	require.Len(t, values, 1)
+	require.True(t, strings.HasPrefix(values[0], saslSCRAMSHA256+" "))
+	msg, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(values[0], saslSCRAMSHA256+" "))
+	require.NoError(t, err)
+	return string(msg)
+}
+
+// Ensure unauthenticated calls are rejected and calls with valid PLAIN
+// credentials succeed when client authentication is enabled.
+func TestClientAuthPlain(t *testing.T) {
+	defer cleanupStorage(t)
+
+	s, conn := runAuthServer(t)
+	defer s.Stop()
+	defer conn.Close()
+	getMetadataLeader(t, 10*time.Second, s)
+	api := client.NewAPIClient(conn)
+
+	_, err := api.FetchMetadata(context.Background(), &client.FetchMetadataRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = api.FetchMetadata(plainContext("alice", "wrong"), &client.FetchMetadataRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = api.FetchMetadata(plainContext("mallory", "secret"), &client.FetchMetadataRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = api.FetchMetadata(plainContext("alice", "secret"), &client.FetchMetadataRequest{})
+	require.NoError(t, err)
+
+	// Users with a SCRAM verifier can also authenticate with PLAIN.
+	_, err = api.FetchMetadata(plainContext("bob", "secret"), &client.FetchMetadataRequest{})
+	require.NoError(t, err)
+
+	// Streaming calls are authenticated too.
+	stream, err := api.Subscribe(context.Background(), &client.SubscribeRequest{Stream: "foo"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	stream, err = api.Subscribe(plainContext("alice", "secret"), &client.SubscribeRequest{Stream: "foo"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// The health service doesn't require authentication.
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(context.Background(),
+		&grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+}
+
+// Ensure a SCRAM-SHA-256 exchange authenticates the client, returns a server
+// signature the client can verify, and rejects wrong passwords and replayed
+// client-final messages.
+func TestClientAuthSCRAM(t *testing.T) {
+	defer cleanupStorage(t)
+
+	s, conn := runAuthServer(t)
+	defer s.Stop()
+	defer conn.Close()
+	getMetadataLeader(t, 10*time.Second, s)
+	api := client.NewAPIClient(conn)
+
+	exchange := func(c *scramClient) (string, metadata.MD, error) {
+		var trailer metadata.MD
+		_, err := api.FetchMetadata(scramContext(c.clientFirst("clientnonce")),
+			&client.FetchMetadataRequest{}, grpc.Trailer(&trailer))
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		serverFirst := decodeAuthMetadata(t, trailer.Get(authChallengeMetadataKey))
+		require.True(t, strings.HasPrefix(serverFirst, "r=clientnonce"))
+
+		clientFinal := c.clientFinal(t, serverFirst)
+		var header metadata.MD
+		_, err = api.FetchMetadata(scramContext(clientFinal),
+			&client.FetchMetadataRequest{}, grpc.Header(&header))
+		return clientFinal, header, err
+	}
+
+	c := &scramClient{username: "bob", password: "secret"}
+	clientFinal, header, err := exchange(c)
+	require.NoError(t, err)
+	require.True(t, c.verifyServerFinal(decodeAuthMetadata(t, header.Get(authInfoMetadataKey))))
+
+	// The client-final message can't be replayed.
+	_, err = api.FetchMetadata(scramContext(clientFinal), &client.FetchMetadataRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, _, err = exchange(&scramClient{username: "bob", password: "wrong"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Users with only a bcrypt hash can't use SCRAM.
+	var trailer metadata.MD
+	_, err = api.FetchMetadata(scramContext("n,,n=alice,r=clientnonce"),
+		&client.FetchMetadataRequest{}, grpc.Trailer(&trailer))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Empty(t, trailer.Get(authChallengeMetadataKey))
+}
+
+// Ensure the server side of a SCRAM-SHA-256 exchange matches the RFC 7677
+// test vector.
+func TestSCRAMExchangeRFC7677(t *testing.T) {
+	salt, err := base64.StdEncoding.DecodeString("W22ZaJ0SNY7soEsUEjb6gQ==")
+	require.NoError(t, err)
+	cred, err := newSCRAMCredential("pencil", salt, 4096)
+	require.NoError(t, err)
+	auth := &clientAuthenticator{
+		credentials:   map[string]*clientCredential{"user": {scram: cred}},
+		conversations: make(map[string]*scramConversation),
+	}
+
+	// Use the server nonce from the RFC rather than a random one.
+	nonce := "rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0"
+	serverFirst := "r=" + nonce + ",s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096"
+	auth.conversations[nonce] = &scramConversation{
+		username:        "user",
+		clientFirstBare: "n=user,r=rOprNGfwEbeRWgbNEkqO",
+		serverFirst:     serverFirst,
+		expires:         time.Now().Add(time.Minute),
+	}
+
+	username, header, _, err := auth.finishSCRAM("c=biws,r=" + nonce +
+		",p=dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ=")
+	require.NoError(t, err)
+	require.Equal(t, "user", username)
+	require.Equal(t, "v=6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4=",
+		decodeAuthMetadata(t, header.Get(authInfoMetadataKey)))
+}
+
+// Ensure SCRAM verifiers round trip through the RFC 5803 format and invalid
+// credentials files are rejected.
+func TestLoadCredentialsFile(t *testing.T) {
+	path := writeCredentialsFile(t)
+	creds, err := loadCredentialsFile(path)
+	require.NoError(t, err)
+	require.Len(t, creds, 2)
+	require.NotNil(t, creds["alice"].bcryptHash)
+	require.True(t, creds["bob"].scram.verifyPassword("secret"))
+	require.False(t, creds["bob"].scram.verifyPassword("wrong"))
+
+	parsed, err := parseSCRAMCredential(creds["bob"].scram.String())
+	require.NoError(t, err)
+	require.Equal(t, creds["bob"].scram, parsed)
+
+	for _, data := range []string{
+		"alice: secret\n",
+		"bob: SCRAM-SHA-256$1000:c2FsdA==$AAAA:AAAA\n",
+		"- alice\n",
+	} {
+		path := filepath.Join(t.TempDir(), "credentials.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(data), 0600))
+		_, err := loadCredentialsFile(path)
+		require.Error(t, err, data)
+	}
+}
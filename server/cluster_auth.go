@@ -0,0 +1,121 @@
+package server
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/liftbridge-io/liftbridge/server/logger"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// clusterAuthWarnInterval is the minimum time between warnings logged for
+	// dropped unauthenticated messages.
+	clusterAuthWarnInterval = 10 * time.Second
+
+	// maxClusterAuthKeys is the number of keys which can be active at once.
+	// Two keys allow a new key to be rolled out while the old one is still
+	// in use.
+	maxClusterAuthKeys = 2
+)
+
+// errUnsignedEnvelope is reported when an unsigned message is dropped in
+// strict mode.
+var errUnsignedEnvelope = errors.New("envelope is not signed")
+
+// clusterAuth authenticates messages exchanged between brokers on internal
+// NATS subjects, i.e. propagated requests, server info, partition status,
+// partition notifications, Raft join requests, and bootstrap seed probes,
+// along with their responses. When keys are configured, outgoing envelopes are signed with an
+// HMAC using the first key and incoming envelopes are verified against all of
+// the keys. Any client with access to NATS can publish on these subjects, so
+// without this a forged message could be used to manipulate cluster metadata.
+//
+// Envelopes with an HMAC which doesn't match are always dropped. Unsigned
+// envelopes are only dropped in strict mode, which allows authentication to
+// be enabled on a running cluster one broker at a time before turning on
+// strict mode.
+type clusterAuth struct {
+	keys            [][]byte
+	strict          bool
+	logger          logger.Logger
+	dropped         int64 // Atomic
+	mu              sync.Mutex
+	lastWarn        time.Time
+	droppedSinceLog int64
+}
+
+func newClusterAuth(config ClusteringConfig, logger logger.Logger) *clusterAuth {
+	keys := make([][]byte, len(config.AuthKeys))
+	for i, key := range config.AuthKeys {
+		keys[i] = []byte(key)
+	}
+	return &clusterAuth{
+		keys:   keys,
+		strict: config.AuthStrict,
+		logger: logger,
+	}
+}
+
+// enabled indicates if messages are signed and verified.
+func (c *clusterAuth) enabled() bool {
+	return len(c.keys) > 0
+}
+
+// sign adds an HMAC to the envelope if authentication is enabled. Otherwise
+// the envelope is returned as is.
+func (c *clusterAuth) sign(data []byte) []byte {
+	if !c.enabled() {
+		return data
+	}
+	signed, err := proto.SignEnvelope(data, c.keys[0])
+	if err != nil {
+		// This only happens if the envelope is malformed, which indicates a
+		// bug in the caller.
+		panic(err)
+	}
+	return signed
+}
+
+// verify indicates if the envelope received on the given subject should be
+// trusted. If not, the message is counted as dropped and a rate-limited
+// warning is logged. If authentication is disabled, all messages are trusted.
+func (c *clusterAuth) verify(subject string, data []byte) bool {
+	if !c.enabled() {
+		return true
+	}
+	signed, err := proto.VerifyEnvelope(data, c.keys)
+	if err == nil && (signed || !c.strict) {
+		return true
+	}
+	if err == nil {
+		err = errUnsignedEnvelope
+	}
+	c.drop(subject, err)
+	return false
+}
+
+// droppedCount returns the number of messages dropped because they could not
+// be authenticated.
+func (c *clusterAuth) droppedCount() int64 {
+	return atomic.LoadInt64(&c.dropped)
+}
+
+func (c *clusterAuth) drop(subject string, err error) {
+	atomic.AddInt64(&c.dropped, 1)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.droppedSinceLog++
+	now := time.Now()
+	if now.Sub(c.lastWarn) < clusterAuthWarnInterval {
+		return
+	}
+	c.logger.Warnf("Dropped %d unauthenticated message(s) on internal subjects, "+
+		"most recently on %s: %v", c.droppedSinceLog, subject, err)
+	c.lastWarn = now
+	c.droppedSinceLog = 0
+}
@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure clusterAuth only accepts unsigned messages when not in strict mode
+// and always rejects messages signed with an unknown key.
+func TestClusterAuthVerify(t *testing.T) {
+	data, err := proto.MarshalServerInfoRequest(&proto.ServerInfoRequest{Id: "a"})
+	require.NoError(t, err)
+
+	// Disabled, nothing is signed and everything is accepted.
+	disabled := newClusterAuth(ClusteringConfig{}, noopLogger())
+	require.Equal(t, data, disabled.sign(data))
+	require.True(t, disabled.verify("foo", data))
+
+	lenient := newClusterAuth(ClusteringConfig{AuthKeys: []string{"new", "old"}}, noopLogger())
+	strict := newClusterAuth(ClusteringConfig{AuthKeys: []string{"old"}, AuthStrict: true}, noopLogger())
+	other := newClusterAuth(ClusteringConfig{AuthKeys: []string{"other"}}, noopLogger())
+
+	require.True(t, lenient.verify("foo", data))
+	require.False(t, strict.verify("foo", data))
+	require.Equal(t, int64(1), strict.droppedCount())
+
+	// Messages signed with either active key are accepted.
+	require.True(t, lenient.verify("foo", strict.sign(data)))
+	require.True(t, strict.verify("foo", strict.sign(data)))
+	require.False(t, strict.verify("foo", lenient.sign(data)))
+	require.Equal(t, int64(2), strict.droppedCount())
+
+	// A bad signature is rejected even when not in strict mode.
+	require.False(t, lenient.verify("foo", other.sign(data)))
+	require.Equal(t, int64(1), lenient.droppedCount())
+}
+
+// Ensure a forged propagated request is ignored by the metadata leader when
+// strict cluster authentication is enabled and processed when it's signed
+// with the cluster key.
+func TestClusterAuthForgedShrinkISR(t *testing.T) {
+	defer cleanupStorage(t)
+
+	key := "secret"
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Clustering.AuthKeys = []string{key}
+	s1Config.Clustering.AuthStrict = true
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 0)
+	s2Config.Clustering.AuthKeys = []string{key}
+	s2Config.Clustering.AuthStrict = true
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	// Wait for servers to elect a leader and agree on it.
+	controller := getMetadataLeader(t, 10*time.Second, s1, s2)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	// Create a stream so the brokers exchange authenticated messages.
+	name := "foo"
+	err = client.CreateStream(context.Background(), "foo", name, lift.ReplicationFactor(2))
+	require.NoError(t, err)
+
+	waitForISR(t, 10*time.Second, name, 0, 2, s1, s2)
+
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, s1, s2)
+	followerID := s1.config.Clustering.ServerID
+	if leader == s1 {
+		followerID = s2.config.Clustering.ServerID
+	}
+	partition := leader.metadata.GetPartition(name, 0)
+	require.NotNil(t, partition)
+
+	forged, err := proto.MarshalPropagatedRequest(&proto.PropagatedRequest{
+		Op: proto.Op_SHRINK_ISR,
+		ShrinkISROp: &proto.ShrinkISROp{
+			Stream:          name,
+			Partition:       0,
+			ReplicaToRemove: followerID,
+			Leader:          leader.config.Clustering.ServerID,
+			LeaderEpoch:     partition.LeaderEpoch,
+		},
+	})
+	require.NoError(t, err)
+
+	nc, err := nats.GetDefaultOptions().Connect()
+	require.NoError(t, err)
+	defer nc.Close()
+
+	// The unsigned request is dropped without a response.
+	_, err = nc.Request(controller.getPropagateInbox(), forged, 500*time.Millisecond)
+	require.Equal(t, nats.ErrTimeout, err)
+	require.Equal(t, int64(1), controller.auth.droppedCount())
+	require.Len(t, leader.metadata.GetPartition(name, 0).GetISR(), 2)
+
+	// The signed request is processed.
+	signed, err := proto.SignEnvelope(forged, []byte(key))
+	require.NoError(t, err)
+	resp, err := nc.Request(controller.getPropagateInbox(), signed, 5*time.Second)
+	require.NoError(t, err)
+	require.True(t, controller.auth.verify(resp.Subject, resp.Data))
+	r, err := proto.UnmarshalPropagatedResponse(resp.Data)
+	require.NoError(t, err)
+	require.Nil(t, r.Error)
+
+	// Wait for ISR to shrink.
+	waitForISR(t, 10*time.Second, name, 0, 1, s1, s2)
+	require.Equal(t, int64(1), controller.auth.droppedCount())
+}
+
+// Ensure forged bootstrap probes don't stop a seed server when strict cluster
+// authentication is enabled while signed probes do.
+func TestClusterAuthForgedBootstrapProbe(t *testing.T) {
+	defer cleanupStorage(t)
+
+	key := "secret"
+	s1Config := getTestConfig("a", true, 0)
+	s1Config.Clustering.AuthKeys = []string{key}
+	s1Config.Clustering.AuthStrict = true
+	s1 := New(s1Config)
+	fatalLogger := &captureFatalLogger{}
+	s1.logger = fatalLogger
+	require.NoError(t, s1.Start())
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	nc, err := nats.GetDefaultOptions().Connect()
+	require.NoError(t, err)
+	defer nc.Close()
+
+	subject := s1.baseClusterRaftSubject() + ".bootstrap"
+	waitForDropped := func(expected int64) {
+		deadline := time.Now().Add(5 * time.Second)
+		for s1.auth.droppedCount() < expected {
+			if time.Now().After(deadline) {
+				t.Fatalf("Expected %d dropped messages, got %d", expected, s1.auth.droppedCount())
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	// A bare server ID, as sent by older servers, is dropped.
+	require.NoError(t, nc.Publish(subject, []byte("b")))
+	waitForDropped(1)
+
+	// So is an unsigned probe.
+	probe, err := proto.MarshalBootstrapProbe(&proto.BootstrapProbe{ServerID: "b"})
+	require.NoError(t, err)
+	require.NoError(t, nc.Publish(subject, probe))
+	waitForDropped(2)
+
+	fatalLogger.Lock()
+	require.Empty(t, fatalLogger.fatal)
+	fatalLogger.Unlock()
+
+	// A signed probe is trusted.
+	signed, err := proto.SignEnvelope(probe, []byte(key))
+	require.NoError(t, err)
+	require.NoError(t, nc.Publish(subject, signed))
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		fatalLogger.Lock()
+		fatal := fatalLogger.fatal
+		fatalLogger.Unlock()
+		if fatal != "" {
+			require.Contains(t, fatal, "Server b was also started")
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected fatal error")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
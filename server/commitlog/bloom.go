@@ -0,0 +1,178 @@
+package commitlog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// bloomVersion is the version of the bloom filter sidecar file format.
+	bloomVersion = 1
+
+	// bloomBitsPerKey and bloomHashes size bloom filters for a false positive
+	// rate of roughly 1%.
+	bloomBitsPerKey = 10
+	bloomHashes     = 7
+)
+
+// errInvalidBloom is returned when a bloom filter sidecar file is truncated or
+// was written with an unknown format.
+var errInvalidBloom = errors.New("invalid bloom filter")
+
+// bloomFilter is a probabilistic set of keys. It never reports a key it
+// contains as absent, but may report a key it doesn't contain as present.
+type bloomFilter struct {
+	hashes uint32
+	bits   []uint64
+}
+
+// newBloomFilter returns a bloomFilter containing the keys with the given
+// hashes, as returned by hashKey.
+func newBloomFilter(keyHashes []uint64) *bloomFilter {
+	numBits := len(keyHashes) * bloomBitsPerKey
+	if numBits < 64 {
+		numBits = 64
+	}
+	f := &bloomFilter{
+		hashes: bloomHashes,
+		bits:   make([]uint64, (numBits+63)/64),
+	}
+	for _, hash := range keyHashes {
+		f.add(hash)
+	}
+	return f
+}
+
+// hashKey returns the hash of a message key used to add it to and look it up
+// in a bloomFilter.
+func hashKey(key []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(key) // nolint: errcheck
+	return h.Sum64()
+}
+
+// add adds the key with the given hash to the filter.
+func (f *bloomFilter) add(hash uint64) {
+	numBits := uint64(len(f.bits)) * 64
+	for i := uint32(0); i < f.hashes; i++ {
+		bit := f.bit(hash, i) % numBits
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// mayContain indicates if the key with the given hash may be in the filter. If
+// this returns false, the key is definitely not in it.
+func (f *bloomFilter) mayContain(hash uint64) bool {
+	numBits := uint64(len(f.bits)) * 64
+	for i := uint32(0); i < f.hashes; i++ {
+		bit := f.bit(hash, i) % numBits
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bit returns the i-th bit for the hash using double hashing, i.e. deriving
+// each bit from the two halves of the hash.
+func (f *bloomFilter) bit(hash uint64, i uint32) uint64 {
+	h1, h2 := hash&math.MaxUint32, hash>>32
+	return h1 + uint64(i)*h2
+}
+
+// segmentBloom is the content of a segment's bloom filter sidecar file. It
+// describes a compacted segment whose messages all precede the HW, which lets
+// compaction skip the segment if it's unaffected by newer messages.
+type segmentBloom struct {
+	position   int64 // Size of the segment the filter was built for
+	lastOffset int64 // Last offset of the segment the filter was built for
+	tombstones int64 // Number of tombstones in the segment
+	epochs     []epochStart
+	filter     *bloomFilter
+}
+
+// matches indicates if the sidecar was written for the segment as it is now.
+func (b *segmentBloom) matches(seg *segment) bool {
+	return b.position == seg.Position() && b.lastOffset == seg.LastOffset()
+}
+
+// writeSegmentBloom writes the bloom filter sidecar file to the given path.
+func writeSegmentBloom(path string, b *segmentBloom) error {
+	buf := new(bytes.Buffer)
+	fields := []interface{}{
+		uint8(bloomVersion),
+		b.position,
+		b.lastOffset,
+		b.tombstones,
+		uint32(len(b.epochs)),
+	}
+	for _, start := range b.epochs {
+		fields = append(fields, start.epoch, start.offset)
+	}
+	fields = append(fields, b.filter.hashes, uint32(len(b.filter.bits)), b.filter.bits)
+	for _, field := range fields {
+		if err := binary.Write(buf, encoding, field); err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// readSegmentBloom reads the bloom filter sidecar file at the given path. It
+// returns errInvalidBloom if the file isn't a valid sidecar.
+func readSegmentBloom(path string) (*segmentBloom, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		r         = bytes.NewReader(data)
+		version   uint8
+		numEpochs uint32
+		numWords  uint32
+		b         = &segmentBloom{filter: new(bloomFilter)}
+	)
+	read := func(fields ...interface{}) error {
+		for _, field := range fields {
+			if err := binary.Read(r, encoding, field); err != nil {
+				return errInvalidBloom
+			}
+		}
+		return nil
+	}
+	if err := read(&version); err != nil {
+		return nil, err
+	}
+	if version != bloomVersion {
+		return nil, errInvalidBloom
+	}
+	if err := read(&b.position, &b.lastOffset, &b.tombstones, &numEpochs); err != nil {
+		return nil, err
+	}
+	// Each leader epoch start takes 16 bytes.
+	if int64(numEpochs)*16 > int64(r.Len()) {
+		return nil, errInvalidBloom
+	}
+	b.epochs = make([]epochStart, numEpochs)
+	for i := range b.epochs {
+		if err := read(&b.epochs[i].epoch, &b.epochs[i].offset); err != nil {
+			return nil, err
+		}
+	}
+	if err := read(&b.filter.hashes, &numWords); err != nil {
+		return nil, err
+	}
+	if numWords == 0 || int64(numWords)*8 != int64(r.Len()) {
+		return nil, errInvalidBloom
+	}
+	b.filter.bits = make([]uint64, numWords)
+	if err := read(b.filter.bits); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,58 @@
+package commitlog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Ensure a bloom filter never reports a key it contains as absent and rarely
+// reports one it doesn't contain as present.
+func TestBloomFilter(t *testing.T) {
+	var hashes []uint64
+	for i := 0; i < 1000; i++ {
+		hashes = append(hashes, hashKey([]byte(fmt.Sprintf("key-%d", i))))
+	}
+	f := newBloomFilter(hashes)
+	for _, hash := range hashes {
+		require.True(t, f.mayContain(hash))
+	}
+	falsePositives := 0
+	for i := 0; i < 1000; i++ {
+		if f.mayContain(hashKey([]byte(fmt.Sprintf("other-%d", i)))) {
+			falsePositives++
+		}
+	}
+	require.Less(t, falsePositives, 50)
+}
+
+// Ensure segment bloom filters are read back as written and invalid ones are
+// rejected.
+func TestSegmentBloomReadWrite(t *testing.T) {
+	dir := tempDir(t)
+	defer remove(t, dir)
+	path := filepath.Join(dir, "bloom")
+
+	expected := &segmentBloom{
+		position:   100,
+		lastOffset: 9,
+		tombstones: 1,
+		epochs:     []epochStart{{epoch: 1, offset: 2}, {epoch: 3, offset: 7}},
+		filter:     newBloomFilter([]uint64{hashKey([]byte("foo"))}),
+	}
+	require.NoError(t, writeSegmentBloom(path, expected))
+	actual, err := readSegmentBloom(path)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for _, invalid := range [][]byte{nil, data[:len(data)-1], append(data, 0), append([]byte{2}, data[1:]...)} {
+		require.NoError(t, os.WriteFile(path, invalid, 0644))
+		_, err := readSegmentBloom(path)
+		require.Equal(t, errInvalidBloom, err)
+	}
+}
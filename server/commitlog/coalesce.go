@@ -0,0 +1,159 @@
+package commitlog
+
+import "time"
+
+// defaultMaxCoalesceBatchBytes is the size a coalesced batch of appends is
+// written at, before the coalesce window ends, if MaxCoalesceBatchBytes isn't
+// set.
+const defaultMaxCoalesceBatchBytes = 1024 * 1024
+
+// appendRequest is an Append call waiting to be written as part of a coalesced
+// batch.
+type appendRequest struct {
+	msgs   []*Message
+	size   int64
+	result chan appendResult
+}
+
+// appendResult is the outcome of a coalesced Append call.
+type appendResult struct {
+	offsets []int64
+	err     error
+}
+
+// appendCoalescer batches Append calls made within a window of each other
+// into a single write to the log, much like Nagle's algorithm, to reduce the
+// number of writes when many small appends are made concurrently.
+type appendCoalescer struct {
+	log      *commitLog
+	window   time.Duration
+	maxBytes int64
+	requests chan *appendRequest
+}
+
+func newAppendCoalescer(l *commitLog, window time.Duration, maxBytes int64) *appendCoalescer {
+	if maxBytes <= 0 {
+		maxBytes = defaultMaxCoalesceBatchBytes
+	}
+	return &appendCoalescer{
+		log:      l,
+		window:   window,
+		maxBytes: maxBytes,
+		requests: make(chan *appendRequest),
+	}
+}
+
+// append hands the messages to the coalescing goroutine and waits for them to
+// be written, returning their offsets.
+func (c *appendCoalescer) append(msgs []*Message) ([]int64, error) {
+	req := &appendRequest{
+		msgs:   msgs,
+		size:   messagesSize(msgs),
+		result: make(chan appendResult, 1),
+	}
+	// Check this first since the coalescing goroutine may not have stopped
+	// yet.
+	select {
+	case <-c.log.closed:
+		return nil, ErrCommitLogClosed
+	default:
+	}
+	select {
+	case c.requests <- req:
+	case <-c.log.closed:
+		return nil, ErrCommitLogClosed
+	}
+	result := <-req.result
+	return result.offsets, result.err
+}
+
+// loop is a long-running loop which collects the Append calls received within
+// the window following the first one, or until they reach the max batch
+// size, and writes them as one batch. It returns once the log is closed.
+func (c *appendCoalescer) loop() {
+	for {
+		select {
+		case <-c.log.closed:
+			return
+		case req := <-c.requests:
+			var (
+				batch = []*appendRequest{req}
+				size  = req.size
+				timer = time.NewTimer(c.window)
+			)
+		COLLECT:
+			for size < c.maxBytes {
+				select {
+				case req := <-c.requests:
+					batch = append(batch, req)
+					size += req.size
+				case <-timer.C:
+					break COLLECT
+				case <-c.log.closed:
+					break COLLECT
+				}
+			}
+			timer.Stop()
+			c.flush(batch)
+		}
+	}
+}
+
+// flush writes the batch of Append calls to the log and returns each caller
+// its offsets. Like uncoalesced appends, calls after the one making the log
+// readonly fail with ErrCommitLogReadonly.
+func (c *appendCoalescer) flush(batch []*appendRequest) {
+	l := c.log
+	l.appendMu.Lock()
+	defer l.appendMu.Unlock()
+
+	// Only write the calls starting at or before the readonly target, if any.
+	next := l.NewestOffset() + 1
+	n := 0
+	if !l.IsReadonly() {
+		target, hasTarget := l.ReadonlyTarget()
+		for _, req := range batch {
+			if hasTarget && next > target {
+				break
+			}
+			next += int64(len(req.msgs))
+			n++
+		}
+	}
+
+	var msgs []*Message
+	for _, req := range batch[:n] {
+		msgs = append(msgs, req.msgs...)
+	}
+	var (
+		offsets []int64
+		err     error
+	)
+	if len(msgs) > 0 {
+		offsets, err = l.appendMessages(msgs)
+	}
+	for _, req := range batch[:n] {
+		if err != nil {
+			req.result <- appendResult{err: err}
+			continue
+		}
+		req.result <- appendResult{offsets: offsets[:len(req.msgs)]}
+		offsets = offsets[len(req.msgs):]
+	}
+	for _, req := range batch[n:] {
+		req.result <- appendResult{err: ErrCommitLogReadonly}
+	}
+}
+
+// messagesSize returns the size of the messages once encoded.
+func messagesSize(msgs []*Message) int64 {
+	var size int64
+	for _, m := range msgs {
+		lenEnc := new(lenEncoder)
+		if err := m.Encode(lenEnc); err == nil {
+			size += int64(lenEnc.Length)
+		}
+		size += msgSetHeaderLen
+	}
+	return size
+}
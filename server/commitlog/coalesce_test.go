@@ -0,0 +1,147 @@
+package commitlog
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// countingWriter counts the writes made to the underlying writer.
+type countingWriter struct {
+	io.Writer
+	writes int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	atomic.AddInt64(&w.writes, 1)
+	return w.Writer.Write(p)
+}
+
+// countWrites replaces the writer of the log's active segment with one which
+// counts the writes made to it.
+func countWrites(l *commitLog) *countingWriter {
+	seg := l.activeSegment()
+	seg.Lock()
+	defer seg.Unlock()
+	w := &countingWriter{Writer: seg.writer}
+	seg.writer = w
+	return w
+}
+
+// Ensure concurrent appends made within the coalesce window are written
+// together and each caller gets back the offsets of its messages.
+func TestWriteCoalescing(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:                tempDir(t),
+		MaxSegmentBytes:     1024 * 1024,
+		WriteCoalesceWindow: 2 * time.Millisecond,
+	})
+	defer cleanup()
+	writes := countWrites(l)
+
+	var (
+		numAppends = 1000
+		offsets    = make([]int64, numAppends)
+		wg         sync.WaitGroup
+	)
+	wg.Add(numAppends)
+	for i := 0; i < numAppends; i++ {
+		go func(i int) {
+			defer wg.Done()
+			o, err := l.Append([]*Message{{Value: []byte(strconv.Itoa(i))}})
+			require.NoError(t, err)
+			require.Len(t, o, 1)
+			offsets[i] = o[0]
+		}(i)
+	}
+	wg.Wait()
+	require.Less(t, atomic.LoadInt64(&writes.writes), int64(100))
+	require.Equal(t, int64(numAppends-1), l.NewestOffset())
+
+	// Each caller got back the offset its message was written at.
+	values := make(map[int64]string, numAppends)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r, err := l.NewReader(0, true)
+	require.NoError(t, err)
+	headers := make([]byte, 28)
+	for i := 0; i < numAppends; i++ {
+		msg, offset, _, _, err := r.ReadMessage(ctx, headers)
+		require.NoError(t, err)
+		values[offset] = string(msg.Value())
+	}
+	for i, offset := range offsets {
+		require.Equal(t, strconv.Itoa(i), values[offset])
+	}
+}
+
+// Ensure coalesced appends are written before the window ends once they reach
+// the max batch size.
+func TestWriteCoalescingMaxBatchBytes(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:                  tempDir(t),
+		WriteCoalesceWindow:   time.Hour,
+		MaxCoalesceBatchBytes: 1,
+	})
+	defer cleanup()
+
+	for i := 0; i < 3; i++ {
+		offsets, err := l.Append([]*Message{{Value: []byte("foo")}})
+		require.NoError(t, err)
+		require.Equal(t, []int64{int64(i)}, offsets)
+	}
+}
+
+// Ensure coalesced appends after the one reaching the readonly target are
+// rejected like uncoalesced ones.
+func TestWriteCoalescingReadonlyTarget(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:                tempDir(t),
+		WriteCoalesceWindow: 10 * time.Millisecond,
+	})
+	defer cleanup()
+	l.SetReadonlyAt(4)
+
+	var (
+		appended int64
+		rejected int64
+		wg       sync.WaitGroup
+	)
+	wg.Add(10)
+	for i := 0; i < 10; i++ {
+		go func() {
+			defer wg.Done()
+			_, err := l.Append([]*Message{{Value: []byte("foo")}})
+			if err == ErrCommitLogReadonly {
+				atomic.AddInt64(&rejected, 1)
+				return
+			}
+			require.NoError(t, err)
+			atomic.AddInt64(&appended, 1)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int64(5), appended)
+	require.Equal(t, int64(5), rejected)
+	require.Equal(t, int64(4), l.NewestOffset())
+	require.True(t, l.IsReadonly())
+}
+
+// Ensure appends waiting to be coalesced fail once the log is closed.
+func TestWriteCoalescingClosed(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:                tempDir(t),
+		WriteCoalesceWindow: time.Millisecond,
+	})
+	defer cleanup()
+	require.NoError(t, l.Close())
+
+	_, err := l.Append([]*Message{{Value: []byte("foo")}})
+	require.Equal(t, ErrCommitLogClosed, err)
+}
@@ -2,7 +2,10 @@
 package commitlog
 
 import (
+	"fmt"
+	"hash/fnv"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -12,6 +15,7 @@ import (
 	"time"
 	"unsafe"
 
+	"github.com/dustin/go-humanize/english"
 	atomic_file "github.com/natefinch/atomic"
 	"github.com/pkg/errors"
 
@@ -25,10 +29,52 @@ var ErrSegmentNotFound = errors.New("segment not found")
 // Concurrency Control is activated.
 var ErrIncorrectOffset = errors.New("incorrect offset")
 
+// ErrOffsetOutOfRange is returned when reading from an offset before the log
+// start offset advanced by DeleteBefore.
+var ErrOffsetOutOfRange = errors.New("offset out of range")
+
+// IncorrectOffsetError is returned by Append when Optimistic Concurrency
+// Control is enabled and a message's expected offset isn't the offset it
+// would be written at. It matches ErrIncorrectOffset with errors.Is and
+// carries the offset the message should be retried with.
+type IncorrectOffsetError struct {
+	ExpectedOffset int64 // Offset the next message will be written at
+}
+
+func (e *IncorrectOffsetError) Error() string {
+	return fmt.Sprintf("%s, expected offset %d", ErrIncorrectOffset, e.ExpectedOffset)
+}
+
+// Is indicates if the target is ErrIncorrectOffset.
+func (e *IncorrectOffsetError) Is(target error) bool {
+	return target == ErrIncorrectOffset
+}
+
+// OffsetOutOfRangeError is returned by NewReader and Readers when the offset
+// to read is before the log start offset advanced by DeleteBefore. It matches
+// ErrOffsetOutOfRange with errors.Is and carries the offset reading can
+// resume at.
+type OffsetOutOfRangeError struct {
+	Offset         int64 // Offset which was requested
+	LogStartOffset int64 // Offset the log starts at
+}
+
+func (e *OffsetOutOfRangeError) Error() string {
+	return fmt.Sprintf("%s, offset %d is before the log start offset %d",
+		ErrOffsetOutOfRange, e.Offset, e.LogStartOffset)
+}
+
+// Is indicates if the target is ErrOffsetOutOfRange.
+func (e *OffsetOutOfRangeError) Is(target error) bool {
+	return target == ErrOffsetOutOfRange
+}
+
 const (
 	logFileSuffix               = ".log"
 	indexFileSuffix             = ".index"
+	bloomFileSuffix             = ".bloom"
 	hwFileName                  = "replication-offset-checkpoint"
+	logStartFileName            = "log-start-offset-checkpoint"
 	defaultMaxSegmentBytes      = 1073741824
 	defaultHWCheckpointInterval = 5 * time.Second
 	defaultCleanerInterval      = 5 * time.Minute
@@ -37,40 +83,67 @@ const (
 // commitLog implements the CommitLog interface, which is a durable write-ahead
 // log.
 type commitLog struct {
-	readonly         int32 // Atomic flag
+	readonlyTarget   int64                 // Atomic offset the log becomes readonly at, -1 if none
+	retentionFloor   int64                 // Atomic offset retention never deletes past, math.MaxInt64 if none
+	logStart         int64                 // Atomic offset messages were deleted before by DeleteBefore, 0 if none
+	coldSegmentOpens int64                 // Atomic count of readers positioned on an inactive segment
+	bytesAppended    int64                 // Atomic bytes of messages appended since the log was opened
+	rolls            [numRollReasons]int64 // Atomic counts of segments rolled since the log was opened by reason
+	readonly         int32                 // Atomic flag
 	deleteCleaner    *deleteCleaner
 	compactCleaner   *compactCleaner
 	name             string
 	mu               sync.RWMutex
+	cleanMu          sync.Mutex // Serializes log cleans
+	appendMu         sync.Mutex // Serializes appends so expected offsets and readonly targets are checked against the offsets assigned
 	hw               int64
+	optionsMu        sync.RWMutex // Protects the Options changed by UpdateOptions after the log is opened
 	closed           chan struct{}
 	segments         []*segment
 	vActiveSegment   *segment
 	hwWaiters        map[contextReader]chan bool
 	leaderEpochCache *leaderEpochCache
 	deleted          bool
+	cipher           *logCipher       // Set if an EncryptionKey is provided
+	coalescer        *appendCoalescer // Set if WriteCoalesceWindow is positive
+	observers        logObservers
+	snapshotsMu      sync.Mutex
+	snapshots        map[*Snapshot]struct{} // Snapshots held back from retention and compaction
 	Options
 }
 
 // Options contains settings for configuring a commitLog.
 type Options struct {
-	Name                 string        // commitLog name
-	Path                 string        // Path to log directory
-	MaxSegmentBytes      int64         // Max bytes a Segment can contain before creating a new one
-	MaxSegmentAge        time.Duration // Max time before a new log segment is rolled out.
-	MaxLogBytes          int64         // Retention by bytes
-	MaxLogMessages       int64         // Retention by messages
-	MaxLogAge            time.Duration // Retention by age
-	Compact              bool          // Run compaction on log clean
-	CompactMaxGoroutines int           // Max number of goroutines to use in a log compaction
-	CleanerInterval      time.Duration // Frequency to enforce retention policy
-	HWCheckpointInterval time.Duration // Frequency to checkpoint HW to disk
-	ConcurrencyControl   bool          // Optimistic Concurrency Control
-	Logger               logger.Logger
+	Name                    string        // commitLog name
+	Path                    string        // Path to log directory
+	MaxSegmentBytes         int64         // Max bytes a Segment can contain before creating a new one
+	MaxSegmentAge           time.Duration // Max time before a new log segment is rolled out.
+	MaxSegmentAgeJitter     time.Duration // Max time segments roll before MaxSegmentAge so logs don't roll at once
+	MaxLogBytes             int64         // Retention by bytes
+	MaxLogMessages          int64         // Retention by messages
+	MaxLogAge               time.Duration // Retention by age
+	Compact                 bool          // Run compaction on log clean
+	CompactMaxGoroutines    int           // Max number of goroutines to use in a log compaction
+	CompactBloomFilter      bool          // Keep a bloom filter of the keys in compacted segments to skip rewriting unaffected ones
+	TombstoneTTL            time.Duration // Time compaction retains tombstones for, negative to retain them indefinitely
+	CleanerInterval         time.Duration // Frequency to enforce retention policy
+	HWCheckpointInterval    time.Duration // Frequency to checkpoint HW to disk
+	ConcurrencyControl      bool          // Optimistic Concurrency Control
+	Encrypt                 bool          // Encrypt message payloads appended to the log
+	EncryptionKey           []byte        // Master key wrapping segment data keys, 16 or 32 bytes
+	ReadOnly                bool          // Open in readonly mode without cleaning or checkpointing the HW, see Upgrade
+	PreallocateSegmentBytes int64         // Disk space reserved for new segments up front to reduce fragmentation, 0 to disable
+	WriteCoalesceWindow     time.Duration // Time appends are collected for to write them together, 0 to disable
+	MaxCoalesceBatchBytes   int64         // Size coalesced appends are written at before the window ends, 1MB if unset
+	LazyLoad                bool          // Open the files of segments other than the active one on first access
+	Logger                  logger.Logger
+	AllocationRecorder      AllocationRecorder // Receives allocation accounting, optional
 }
 
 // New creates a new CommitLog and starts a background goroutine which
-// periodically checkpoints the high watermark to disk.
+// periodically checkpoints the high watermark to disk. If the ReadOnly option
+// is set, the log is opened in readonly mode and neither the checkpointing nor
+// the cleaner goroutine is started until the log is upgraded.
 func New(opts Options) (CommitLog, error) {
 	if opts.Path == "" {
 		return nil, errors.New("path is empty")
@@ -91,9 +164,24 @@ func New(opts Options) (CommitLog, error) {
 		opts.CleanerInterval = defaultCleanerInterval
 	}
 
+	// The master key is needed to read encrypted messages even if new ones
+	// aren't being encrypted.
+	var logCipher *logCipher
+	if opts.Encrypt && len(opts.EncryptionKey) == 0 {
+		return nil, errors.New("encryption key is empty")
+	}
+	if len(opts.EncryptionKey) > 0 {
+		var err error
+		logCipher, err = newLogCipher(opts.EncryptionKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	cleanerOpts := deleteCleanerOptions{
 		Name:   opts.Path,
 		Logger: opts.Logger,
+		Cipher: logCipher,
 	}
 	cleanerOpts.Retention.Bytes = opts.MaxLogBytes
 	cleanerOpts.Retention.Messages = opts.MaxLogMessages
@@ -104,14 +192,15 @@ func New(opts Options) (CommitLog, error) {
 		Name:          opts.Name,
 		Logger:        opts.Logger,
 		MaxGoroutines: opts.CompactMaxGoroutines,
+		Recorder:      opts.AllocationRecorder,
+		Cipher:        logCipher,
+		TombstoneTTL:  opts.TombstoneTTL,
+		BloomFilter:   opts.CompactBloomFilter,
 	}
 	compactCleaner := newCompactCleaner(compactCleanerOpts)
 
 	path, _ := filepath.Abs(opts.Path)
-	epochCache, err := newLeaderEpochCache(opts.Name, path, opts.Logger)
-	if err != nil {
-		return nil, err
-	}
+	epochCache := newLeaderEpochCache(opts.Name, path, opts.Logger)
 
 	l := &commitLog{
 		Options:          opts,
@@ -119,11 +208,17 @@ func New(opts Options) (CommitLog, error) {
 		deleteCleaner:    cleaner,
 		compactCleaner:   compactCleaner,
 		hw:               -1,
+		readonlyTarget:   -1,
+		retentionFloor:   math.MaxInt64,
 		closed:           make(chan struct{}),
 		hwWaiters:        make(map[contextReader]chan bool),
 		leaderEpochCache: epochCache,
+		cipher:           logCipher,
+		snapshots:        make(map[*Snapshot]struct{}),
 	}
 
+	cleaner.floor = l.RetentionFloor
+
 	if err := l.init(); err != nil {
 		return nil, err
 	}
@@ -146,12 +241,122 @@ func New(opts Options) (CommitLog, error) {
 		return nil, err
 	}
 
+	// The leader epoch checkpoint file could also be corrupt, e.g. if it was
+	// truncated or deleted, in which case it's rebuilt from the log.
+	if err := l.recoverLeaderEpochCache(); err != nil {
+		return nil, err
+	}
+
+	// Appends are coalesced once a readonly log is upgraded too.
+	if opts.WriteCoalesceWindow > 0 {
+		l.coalescer = newAppendCoalescer(l, opts.WriteCoalesceWindow, opts.MaxCoalesceBatchBytes)
+		go l.coalescer.loop()
+	}
+
+	if opts.ReadOnly {
+		l.SetReadonly(true)
+		return l, nil
+	}
+
 	go l.checkpointHWLoop()
 	go l.cleanerLoop()
 
 	return l, nil
 }
 
+// recoverLeaderEpochCache rebuilds the leader epoch cache by scanning the
+// leader epochs of the messages in the log if its checkpoint file couldn't be
+// read completely or disagrees with the leader epochs of the oldest and newest
+// messages. The rebuilt cache is written to the checkpoint file atomically.
+func (l *commitLog) recoverLeaderEpochCache() error {
+	consistent, err := l.leaderEpochCacheConsistent()
+	if err != nil {
+		return err
+	}
+	if consistent && !l.leaderEpochCache.corrupt {
+		return nil
+	}
+
+	rebuilt := newLeaderEpochCacheNoFile(l.Name, l.Logger)
+	for _, segment := range l.segments {
+		ss := newSegmentScanner(segment)
+		ms, _, err := ss.Scan()
+		for ; err == nil; ms, _, err = ss.Scan() {
+			if leaderEpoch := ms.LeaderEpoch(); leaderEpoch > rebuilt.LastLeaderEpoch() {
+				if err := rebuilt.Assign(leaderEpoch, ms.Offset()); err != nil {
+					return err
+				}
+			}
+		}
+		if err != io.EOF {
+			return errors.Wrapf(err, "failed to scan segment with base offset %d", segment.BaseOffset)
+		}
+	}
+	if err := l.leaderEpochCache.Replace(rebuilt); err != nil {
+		return errors.Wrap(err, "failed to write rebuilt leader epoch offsets")
+	}
+	l.leaderEpochCache.corrupt = false
+	l.Logger.Warnf("Rebuilt missing or corrupt leader epoch offsets of log %s with %s",
+		l.Path, english.Plural(len(rebuilt.epochOffsets), "entry", ""))
+	return nil
+}
+
+// leaderEpochCacheConsistent indicates if the leader epoch cache agrees with
+// the leader epochs of the oldest and newest messages in the log.
+func (l *commitLog) leaderEpochCacheConsistent() (bool, error) {
+	for _, segment := range l.segments {
+		if segment.IsEmpty() {
+			continue
+		}
+		ms, _, err := newSegmentScanner(segment).Scan()
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to read oldest message of segment with base offset %d",
+				segment.BaseOffset)
+		}
+		if !l.leaderEpochCache.HasEpochAt(ms.Offset(), ms.LeaderEpoch()) {
+			return false, nil
+		}
+		break
+	}
+	for i := len(l.segments) - 1; i >= 0; i-- {
+		segment := l.segments[i]
+		if segment.IsEmpty() {
+			continue
+		}
+		ms, _, err := newReverseSegmentScannerFromEnd(segment).Scan()
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to read newest message of segment with base offset %d",
+				segment.BaseOffset)
+		}
+		return l.leaderEpochCache.HasEpochAt(ms.Offset(), ms.LeaderEpoch()), nil
+	}
+	return true, nil
+}
+
+// Upgrade makes a log opened with the ReadOnly option writable and starts the
+// background goroutines checkpointing the high watermark and cleaning the log.
+// This does nothing if the log wasn't opened with the ReadOnly option or was
+// already upgraded.
+func (l *commitLog) Upgrade() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	select {
+	case <-l.closed:
+		return ErrCommitLogClosed
+	default:
+	}
+	if !l.ReadOnly {
+		return nil
+	}
+	l.ReadOnly = false
+	atomic.StoreInt32(&l.readonly, 0)
+
+	go l.checkpointHWLoop()
+	go l.cleanerLoop()
+	return nil
+}
+
 func (l *commitLog) init() error {
 	err := os.MkdirAll(l.Path, 0755)
 	if err != nil {
@@ -166,11 +371,18 @@ func (l *commitLog) open() error {
 		return errors.Wrap(err, "read dir failed")
 	}
 	for _, file := range files {
-		// If this file is an index file, make sure it has a corresponding .log
-		// file.
-		if strings.HasSuffix(file.Name(), indexFileSuffix) {
+		// Discard a snapshot which wasn't completely transferred.
+		if file.Name() == snapshotDirName {
+			if err := os.RemoveAll(filepath.Join(l.Path, file.Name())); err != nil {
+				return err
+			}
+			continue
+		}
+		// If this file is an index or bloom filter file, make sure it has a
+		// corresponding .log file.
+		if suffix := filepath.Ext(file.Name()); suffix == indexFileSuffix || suffix == bloomFileSuffix {
 			_, err := os.Stat(filepath.Join(
-				l.Path, strings.Replace(file.Name(), indexFileSuffix, logFileSuffix, 1)))
+				l.Path, strings.TrimSuffix(file.Name(), suffix)+logFileSuffix))
 			if os.IsNotExist(err) {
 				if err := os.Remove(filepath.Join(l.Path, file.Name())); err != nil {
 					return err
@@ -184,10 +396,19 @@ func (l *commitLog) open() error {
 			if err != nil {
 				return err
 			}
+			if l.LazyLoad {
+				l.segments = append(l.segments,
+					newLazySegment(l.Path, int64(baseOffset), l.MaxSegmentBytes, ""))
+				continue
+			}
 			segment, err := newSegment(l.Path, int64(baseOffset), l.MaxSegmentBytes, false, "")
 			if err != nil {
 				return err
 			}
+			if segment.indexRebuilt {
+				l.Logger.Warnf("Rebuilt missing or corrupt index for segment with base offset %d of log %s",
+					baseOffset, l.Path)
+			}
 			l.segments = append(l.segments, segment)
 		} else if file.Name() == hwFileName {
 			// Recover high watermark.
@@ -200,6 +421,17 @@ func (l *commitLog) open() error {
 				return errors.Wrap(err, "parse high watermark file failed")
 			}
 			l.hw = hw
+		} else if file.Name() == logStartFileName {
+			// Recover log start offset.
+			b, err := os.ReadFile(filepath.Join(l.Path, file.Name()))
+			if err != nil {
+				return errors.Wrap(err, "read log start offset file failed")
+			}
+			logStart, err := strconv.ParseInt(string(b), 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "parse log start offset file failed")
+			}
+			atomic.StoreInt64(&l.logStart, logStart)
 		}
 	}
 	if len(l.segments) == 0 {
@@ -207,9 +439,14 @@ func (l *commitLog) open() error {
 		if err != nil {
 			return err
 		}
+		l.preallocate(segment, l.MaxSegmentBytes)
 		l.segments = append(l.segments, segment)
 	}
+	// The active segment is always loaded since it's written to.
 	activeSegment := l.segments[len(l.segments)-1]
+	if err := activeSegment.load(); err != nil {
+		return err
+	}
 	atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&l.vActiveSegment)),
 		unsafe.Pointer(activeSegment))
 	return nil
@@ -217,14 +454,36 @@ func (l *commitLog) open() error {
 
 // Append writes the given batch of messages to the log and returns their
 // corresponding offsets in the log. This will return ErrCommitLogReadonly if
-// the log is in readonly mode.
+// the log is in readonly mode. If Optimistic Concurrency Control is enabled,
+// an IncorrectOffsetError is returned if the message's expected offset isn't
+// the next offset in the log. Appends are serialized so the check is atomic
+// with the append. If WriteCoalesceWindow is set and concurrency control is
+// disabled, appends made within the window are written together.
 func (l *commitLog) Append(msgs []*Message) ([]int64, error) {
 	if l.IsReadonly() {
 		return nil, ErrCommitLogReadonly
 	}
+	if l.coalescer != nil && !l.IsConcurrencyControlEnabled() {
+		return l.coalescer.append(msgs)
+	}
+	l.appendMu.Lock()
+	defer l.appendMu.Unlock()
+	// The log may have reached its readonly target while waiting.
+	if l.IsReadonly() {
+		return nil, ErrCommitLogReadonly
+	}
+	return l.appendMessages(msgs)
+}
+
+// appendMessages writes the batch of messages to the active segment. Must be
+// called within the scope of the append mutex.
+func (l *commitLog) appendMessages(msgs []*Message) ([]int64, error) {
 	if _, err := l.checkAndPerformSplit(); err != nil {
 		return nil, err
 	}
+	// Look this up before getting the active segment since it waits on
+	// truncation, which can replace the active segment.
+	latestTimestamp := l.lastIndexTimestamp()
 	var (
 		segment          = l.activeSegment()
 		basePosition     = segment.Position()
@@ -234,22 +493,86 @@ func (l *commitLog) Append(msgs []*Message) ([]int64, error) {
 	if err != nil {
 		return nil, err
 	}
+	if l.AllocationRecorder != nil {
+		l.AllocationRecorder.RecordAllocation(AllocSiteMessageSet, len(ms))
+	}
+	if l.Encrypt {
+		ms, entries, err = l.encryptMessageSet(segment, ms, basePosition)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encrypt messages")
+		}
+	}
+	clampIndexTimestamps(entries, latestTimestamp)
 	return l.append(segment, ms, entries)
 }
 
+// encryptMessageSet encrypts the message set with the segment's data key,
+// generating the data key if this is the first message set encrypted for the
+// segment.
+func (l *commitLog) encryptMessageSet(seg *segment, ms messageSet, basePos int64) (
+	messageSet, []*entry, error) {
+
+	seg.Lock()
+	key := seg.dataKey
+	if key == nil {
+		var err error
+		key, err = l.cipher.newDataKey()
+		if err != nil {
+			seg.Unlock()
+			return nil, nil, err
+		}
+		seg.dataKey = key
+	}
+	seg.Unlock()
+	return l.cipher.encryptMessageSet(key, ms, basePos)
+}
+
+// lastIndexTimestamp returns the timestamp of the last entry indexed in the
+// log or math.MinInt64 if the log is empty.
+func (l *commitLog) lastIndexTimestamp() int64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for i := len(l.segments) - 1; i >= 0; i-- {
+		if seg := l.segments[i]; !seg.IsEmpty() {
+			return seg.LastWriteTime()
+		}
+	}
+	return math.MinInt64
+}
+
+// clampIndexTimestamps raises the timestamps of the given index entries to the
+// latest timestamp indexed before them. Timestamp lookups rely on index
+// timestamps never decreasing, but messages can carry timestamps earlier than
+// those of the messages before them, e.g. if they were set by the client. The
+// messages themselves keep their timestamps.
+func clampIndexTimestamps(entries []*entry, latest int64) {
+	for _, e := range entries {
+		if e.Timestamp < latest {
+			e.Timestamp = latest
+		} else {
+			latest = e.Timestamp
+		}
+	}
+}
+
 // AppendMessageSet writes the given message set data to the log and returns
 // the corresponding offsets in the log. This can be called even if the log is
 // in readonly mode to allow for reconciliation, e.g. when replicating from
-// another log.
+// another log. The message set is written as is, so encrypted messages
+// replicated from another log stay encrypted with that log's data keys.
 func (l *commitLog) AppendMessageSet(ms []byte) ([]int64, error) {
+	l.appendMu.Lock()
+	defer l.appendMu.Unlock()
 	if _, err := l.checkAndPerformSplit(); err != nil {
 		return nil, err
 	}
+	latestTimestamp := l.lastIndexTimestamp()
 	var (
 		segment      = l.activeSegment()
 		basePosition = segment.Position()
 		entries      = entriesForMessageSet(basePosition, ms)
 	)
+	clampIndexTimestamps(entries, latestTimestamp)
 	return l.append(segment, ms, entries)
 }
 
@@ -257,6 +580,9 @@ func (l *commitLog) append(segment *segment, ms []byte, entries []*entry) ([]int
 	if err := segment.WriteMessageSet(ms, entries); err != nil {
 		return nil, err
 	}
+	defer l.notifyObservers(LEOChanged)
+	defer l.checkReadonlyTarget()
+	atomic.AddInt64(&l.bytesAppended, int64(len(ms)-len(entries)*msgSetHeaderLen))
 	var (
 		lastLeaderEpoch = l.leaderEpochCache.LastLeaderEpoch()
 		offsets         = make([]int64, len(entries))
@@ -282,11 +608,173 @@ func (l *commitLog) NewestOffset() int64 {
 }
 
 // OldestOffset returns the offset of the first message in the log or -1 if
-// empty.
+// empty. Messages before the log start offset advanced by DeleteBefore are
+// not included.
 func (l *commitLog) OldestOffset() int64 {
 	l.mu.RLock()
 	defer l.mu.RUnlock()
-	return l.segments[0].FirstOffset()
+	oldest := l.segments[0].FirstOffset()
+	if start := atomic.LoadInt64(&l.logStart); oldest != -1 && oldest < start {
+		return start
+	}
+	return oldest
+}
+
+// Size returns the number of bytes in the log across all segments. This does
+// not include the size of the segment indexes.
+func (l *commitLog) Size() int64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	var size int64
+	for _, segment := range l.segments {
+		size += segment.Position()
+	}
+	return size
+}
+
+// SegmentCount returns the number of segments in the log.
+func (l *commitLog) SegmentCount() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.segments)
+}
+
+// SegmentsTotal returns the number of segments in the log, whether or not
+// they're loaded. It's the same as SegmentCount and is provided alongside
+// SegmentsLoaded so the two can be reported together.
+func (l *commitLog) SegmentsTotal() int {
+	return l.SegmentCount()
+}
+
+// SegmentsLoaded returns the number of segments in the log whose files are
+// open. This is less than SegmentsTotal if the log was opened with the
+// LazyLoad option and some of its segments haven't been accessed since.
+func (l *commitLog) SegmentsLoaded() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	loaded := 0
+	for _, seg := range l.segments {
+		if seg.IsLoaded() {
+			loaded++
+		}
+	}
+	return loaded
+}
+
+// SetRetentionFloor sets an offset the retention limits never delete past,
+// in addition to the HW and the start of any snapshot being transferred, i.e.
+// segments containing messages after it are retained. Passing math.MaxInt64
+// removes the floor.
+func (l *commitLog) SetRetentionFloor(offset int64) {
+	atomic.StoreInt64(&l.retentionFloor, offset)
+}
+
+// RetentionFloor returns the last offset the retention limits can delete up
+// to, which is the lesser of the HW, the floor set with SetRetentionFloor,
+// and the offset preceding the segments held by snapshots.
+func (l *commitLog) RetentionFloor() int64 {
+	floor := atomic.LoadInt64(&l.retentionFloor)
+	if hw := l.HighWatermark(); hw < floor {
+		floor = hw
+	}
+	if start := l.snapshotStart(); start != -1 && start-1 < floor {
+		floor = start - 1
+	}
+	return floor
+}
+
+// SetRetention updates the retention limits enforced by the log using the
+// MaxLogBytes, MaxLogMessages, and MaxLogAge settings from the given Options.
+// Other settings are ignored. The new limits are applied the next time the log
+// is cleaned.
+func (l *commitLog) SetRetention(opts Options) error {
+	cleanerOpts := deleteCleanerOptions{
+		Name:   l.Path,
+		Logger: l.Logger,
+		Cipher: l.cipher,
+	}
+	cleanerOpts.Retention.Bytes = opts.MaxLogBytes
+	cleanerOpts.Retention.Messages = opts.MaxLogMessages
+	cleanerOpts.Retention.Age = opts.MaxLogAge
+	return l.SetRetentionOptions(cleanerOpts)
+}
+
+// SetRetentionOptions replaces the options used by the log's delete cleaner
+// if they are valid. The cleaner goroutine is not restarted, so the change
+// takes effect on its next tick.
+func (l *commitLog) SetRetentionOptions(opts deleteCleanerOptions) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	l.deleteCleaner.setOptions(opts)
+	return nil
+}
+
+// UpdateOptions updates the settings of the open log using the
+// MaxSegmentBytes, MaxSegmentAge, MaxLogBytes, MaxLogMessages, MaxLogAge, and
+// Compact settings from the given Options. Other settings are ignored. It
+// returns an error without applying them if any are invalid. Retention limits
+// and compaction are applied the next time the log is cleaned, while segment
+// limits only apply to segments rolled afterwards.
+func (l *commitLog) UpdateOptions(opts Options) error {
+	if opts.MaxSegmentBytes < 0 {
+		return errors.Errorf("invalid max segment bytes %d", opts.MaxSegmentBytes)
+	}
+	if opts.MaxSegmentAge < 0 {
+		return errors.Errorf("invalid max segment age %s", opts.MaxSegmentAge)
+	}
+	if err := l.SetRetention(opts); err != nil {
+		return err
+	}
+	if opts.MaxSegmentBytes == 0 {
+		opts.MaxSegmentBytes = defaultMaxSegmentBytes
+	}
+
+	// Wait for a clean in progress so it doesn't see compaction toggled
+	// partway through.
+	l.cleanMu.Lock()
+	defer l.cleanMu.Unlock()
+	l.optionsMu.Lock()
+	defer l.optionsMu.Unlock()
+	l.MaxSegmentBytes = opts.MaxSegmentBytes
+	l.MaxSegmentAge = opts.MaxSegmentAge
+	l.MaxLogBytes = opts.MaxLogBytes
+	l.MaxLogMessages = opts.MaxLogMessages
+	l.MaxLogAge = opts.MaxLogAge
+	l.Compact = opts.Compact
+	return nil
+}
+
+// preallocate reserves disk space for a new segment if PreallocateSegmentBytes
+// is set, capped at the segment's max bytes. Failures, e.g. because the
+// filesystem doesn't support it, are logged since pre-allocation is only a
+// hint.
+func (l *commitLog) preallocate(seg *segment, maxSegmentBytes int64) {
+	size := l.PreallocateSegmentBytes
+	if size <= 0 {
+		return
+	}
+	if size > maxSegmentBytes {
+		size = maxSegmentBytes
+	}
+	if err := seg.Preallocate(size); err != nil {
+		l.Logger.Warnf("Failed to preallocate %d bytes for segment with base offset %d of log %s: %v",
+			size, seg.BaseOffset, l.Path, err)
+	}
+}
+
+// segmentLimits returns the max bytes and age of new segments.
+func (l *commitLog) segmentLimits() (int64, time.Duration) {
+	l.optionsMu.RLock()
+	defer l.optionsMu.RUnlock()
+	return l.MaxSegmentBytes, l.MaxSegmentAge
+}
+
+// compactEnabled indicates if the log is compacted when it's cleaned.
+func (l *commitLog) compactEnabled() bool {
+	l.optionsMu.RLock()
+	defer l.optionsMu.RUnlock()
+	return l.Compact
 }
 
 // EarliestOffsetAfterTimestamp returns the earliest offset whose timestamp is
@@ -380,18 +868,27 @@ func (l *commitLog) LatestOffsetBeforeTimestamp(timestamp int64) (int64, error)
 		return 0, errors.Wrap(err, "failed to find log entry for timestamp")
 	}
 
-	return seg.lastOffset, nil
+	return seg.LastOffset(), nil
 }
 
 // SetHighWatermark sets the high watermark on the log. All messages up to and
 // including the high watermark are considered committed.
 func (l *commitLog) SetHighWatermark(hw int64) {
+	var (
+		waiters  map[contextReader]chan bool
+		advanced bool
+	)
 	l.mu.Lock()
 	if hw > l.hw {
 		l.hw = hw
-		l.notifyHWChange()
+		waiters = l.takeHWWaiters()
+		advanced = true
 	}
 	l.mu.Unlock()
+	notifyHWWaiters(waiters, false)
+	if advanced {
+		l.notifyObservers(HWChanged)
+	}
 	// TODO: should we flush the HW to disk here?
 }
 
@@ -401,31 +898,42 @@ func (l *commitLog) SetHighWatermark(hw int64) {
 func (l *commitLog) OverrideHighWatermark(hw int64) {
 	l.mu.Lock()
 	l.hw = hw
-	l.notifyHWChange()
+	waiters := l.takeHWWaiters()
 	l.mu.Unlock()
+	notifyHWWaiters(waiters, false)
 }
 
-// notifyHWChange signals all HW waiters to wake up because the HW has changed.
+// takeHWWaiters swaps out the registered HW waiters so that they can be
+// signaled with notifyHWWaiters once the log mutex is released. This keeps
+// the time the mutex is held constant regardless of the number of waiters.
 // This must be called within the log mutex.
-func (l *commitLog) notifyHWChange() {
-	for r, ch := range l.hwWaiters {
-		ch <- false
-		delete(l.hwWaiters, r)
+func (l *commitLog) takeHWWaiters() map[contextReader]chan bool {
+	if len(l.hwWaiters) == 0 {
+		return nil
 	}
+	waiters := l.hwWaiters
+	l.hwWaiters = make(map[contextReader]chan bool)
+	return waiters
 }
 
-// notifyReadonly signals all HW waiters to wake up if the HW is caught up to
-// the LEO because the log has become readonly. This must be called within the
-// log mutex.
-func (l *commitLog) notifyReadonly() {
-	if l.hw < l.NewestOffset() {
-		return
+// notifyHWWaiters signals the given HW waiters to wake up, either because the
+// HW has changed (false) or the log has become readonly (true). Waiter
+// channels are buffered, so this never blocks.
+func notifyHWWaiters(waiters map[contextReader]chan bool, readonly bool) {
+	for _, ch := range waiters {
+		ch <- readonly
 	}
-	// HW is caught up to LEO so notify HW waiters.
-	for r, ch := range l.hwWaiters {
-		ch <- true
-		delete(l.hwWaiters, r)
+}
+
+// takeReadonlyWaiters swaps out the registered HW waiters if the HW is caught
+// up to the LEO because the log has become readonly. The returned waiters
+// should be signaled with notifyHWWaiters once the log mutex is released.
+// This must be called within the log mutex.
+func (l *commitLog) takeReadonlyWaiters() map[contextReader]chan bool {
+	if l.hw < l.NewestOffset() {
+		return nil
 	}
+	return l.takeHWWaiters()
 }
 
 // waitForHW registers an HW waiter and returns a channel which will receive a
@@ -492,8 +1000,12 @@ func (l *commitLog) close() error {
 		return nil
 	default:
 	}
-	if err := l.checkpointHW(); err != nil {
-		return err
+	// Logs opened readonly never change the HW, so there's nothing to
+	// checkpoint.
+	if !l.ReadOnly {
+		if err := l.checkpointHW(); err != nil {
+			return err
+		}
 	}
 	close(l.closed)
 	for _, segment := range l.segments {
@@ -505,8 +1017,11 @@ func (l *commitLog) close() error {
 }
 
 // Close closes each log segment file and stops the background goroutine
-// checkpointing the high watermark to disk.
+// checkpointing the high watermark to disk. It waits for a clean in progress
+// to finish so the clean doesn't rewrite closed segments.
 func (l *commitLog) Close() error {
+	l.cleanMu.Lock()
+	defer l.cleanMu.Unlock()
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
@@ -516,6 +1031,8 @@ func (l *commitLog) Close() error {
 // Delete closes the log and removes all data associated with it from the
 // filesystem.
 func (l *commitLog) Delete() error {
+	l.cleanMu.Lock()
+	defer l.cleanMu.Unlock()
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
@@ -616,6 +1133,116 @@ func (l *commitLog) Truncate(offset int64) error {
 	return l.leaderEpochCache.ClearLatest(offset)
 }
 
+// TruncateAndStartAt removes all messages from the log and starts it at the
+// given offset, so the next message appended is assigned that offset. The HW
+// is set to the offset preceding it. This is used by followers which have
+// fallen behind the start of the leader's log.
+func (l *commitLog) TruncateAndStartAt(offset int64) error {
+	// Hold the clean mutex so that a clean in progress doesn't restore the
+	// deleted segments.
+	l.cleanMu.Lock()
+	defer l.cleanMu.Unlock()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.truncateAndStartAt(offset)
+}
+
+// truncateAndStartAt removes all messages from the log and starts it at the
+// given offset. Must be called within the scope of the clean mutex and the
+// log's write lock.
+func (l *commitLog) truncateAndStartAt(offset int64) error {
+	for _, seg := range l.segments {
+		if err := seg.Delete(); err != nil {
+			return err
+		}
+	}
+	maxSegmentBytes, _ := l.segmentLimits()
+	seg, err := newSegment(l.Path, offset, maxSegmentBytes, true, "")
+	if err != nil {
+		return err
+	}
+	l.preallocate(seg, maxSegmentBytes)
+	atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&l.vActiveSegment)),
+		unsafe.Pointer(seg))
+	l.segments = []*segment{seg}
+	l.hw = offset - 1
+	notifyHWWaiters(l.takeHWWaiters(), false)
+	if err := l.setLogStart(offset); err != nil {
+		return err
+	}
+
+	// Keep the latest leader epoch, starting at the new log start offset.
+	if err := l.leaderEpochCache.ClearLatest(offset); err != nil {
+		return err
+	}
+	return l.leaderEpochCache.ClearEarliest(offset)
+}
+
+// DeleteBefore advances the log start offset to the given offset, removing
+// the messages before it. Segments which only contain messages before the
+// offset are deleted, while the messages before it in the segment containing
+// it are hidden from readers until retention deletes the segment. If the
+// offset is past the newest offset, all messages are removed and the log
+// starts at the offset as with TruncateAndStartAt. The log start offset is
+// checkpointed to disk so that it's honored once the log is reopened. This is
+// a no-op if the offset isn't past the log start offset. It's up to the
+// caller to ensure the messages removed are committed.
+func (l *commitLog) DeleteBefore(offset int64) error {
+	// Hold the clean mutex so that a clean in progress doesn't restore the
+	// deleted segments and the append mutex so no messages are appended to
+	// segments being deleted.
+	l.cleanMu.Lock()
+	defer l.cleanMu.Unlock()
+	l.appendMu.Lock()
+	defer l.appendMu.Unlock()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if offset <= max(l.segments[0].BaseOffset, atomic.LoadInt64(&l.logStart)) {
+		return nil
+	}
+	if offset > l.NewestOffset() {
+		return l.truncateAndStartAt(offset)
+	}
+	if err := l.setLogStart(offset); err != nil {
+		return err
+	}
+
+	// The active segment contains the newest offset, so it's never deleted.
+	deleted := 0
+	for deleted < len(l.segments)-1 && l.segments[deleted+1].BaseOffset <= offset {
+		if err := l.segments[deleted].Delete(); err != nil {
+			return err
+		}
+		deleted++
+	}
+	l.segments = append([]*segment{}, l.segments[deleted:]...)
+	return l.leaderEpochCache.ClearEarliest(offset)
+}
+
+// setLogStart checkpoints the given log start offset to disk before using it.
+func (l *commitLog) setLogStart(offset int64) error {
+	var (
+		r    = strings.NewReader(strconv.FormatInt(offset, 10))
+		file = filepath.Join(l.Path, logStartFileName)
+	)
+	if err := atomic_file.WriteFile(file, r); err != nil {
+		return errors.Wrap(err, "failed to checkpoint log start offset")
+	}
+	atomic.StoreInt64(&l.logStart, offset)
+	return nil
+}
+
+// LogStartOffset returns the base offset of the log's first segment or, if
+// it's after it, the offset messages were deleted before with DeleteBefore.
+// Unlike OldestOffset, this is the offset the log starts at even if it's
+// empty.
+func (l *commitLog) LogStartOffset() int64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return max(l.segments[0].BaseOffset, atomic.LoadInt64(&l.logStart))
+}
+
 func (l *commitLog) Segments() []*segment {
 	l.mu.RLock()
 	defer l.mu.RUnlock()
@@ -639,15 +1266,107 @@ func (l *commitLog) NotifyLEO(waiter interface{}, expectedLEO int64) <-chan stru
 // still be written to the log with AppendMessageSet for reconciliation
 // purposes, e.g. when replicating from another log.
 func (l *commitLog) SetReadonly(readonly bool) {
+	l.appendMu.Lock()
+	defer l.appendMu.Unlock()
+	l.setReadonly(readonly)
+}
+
+// SetReadonlyAt marks the log as readonly once its newest offset reaches the
+// given offset. Until then, messages can still be added with Append. Once the
+// target is reached, by Append or AppendMessageSet, the log becomes readonly
+// as with SetReadonly. Since batches are appended atomically, the batch which
+// reaches the target may extend past it. If the newest offset is already at
+// or past the target, the log becomes readonly immediately. Otherwise, a
+// readonly log becomes writable until it reaches the target. The target is
+// cleared by SetReadonly.
+func (l *commitLog) SetReadonlyAt(offset int64) {
+	l.appendMu.Lock()
+	defer l.appendMu.Unlock()
+	if l.NewestOffset() >= offset {
+		l.setReadonly(true)
+		return
+	}
+	if atomic.SwapInt32(&l.readonly, 0) != 0 {
+		defer l.notifyObservers(ReadonlyChanged)
+	}
+	atomic.StoreInt64(&l.readonlyTarget, offset)
+}
+
+// ReadonlyTarget returns the offset the log becomes readonly at once reached.
+// The bool returned indicates if there is a pending target.
+func (l *commitLog) ReadonlyTarget() (int64, bool) {
+	target := atomic.LoadInt64(&l.readonlyTarget)
+	return target, target >= 0
+}
+
+// setReadonly clears any readonly target and sets the readonly flag, waking
+// HW waiters caught up to the LEO if the log became readonly. This must be
+// called within the append mutex.
+func (l *commitLog) setReadonly(readonly bool) {
 	value := int32(0)
 	if readonly {
 		value = 1
 	}
-	atomic.StoreInt32(&l.readonly, value)
+	atomic.StoreInt64(&l.readonlyTarget, -1)
+	if atomic.SwapInt32(&l.readonly, value) != value {
+		defer l.notifyObservers(ReadonlyChanged)
+	}
 	if readonly {
 		l.mu.Lock()
-		l.notifyReadonly()
+		waiters := l.takeReadonlyWaiters()
 		l.mu.Unlock()
+		notifyHWWaiters(waiters, true)
+	}
+}
+
+// checkReadonlyTarget makes the log readonly if its newest offset has reached
+// the pending readonly target. This must be called within the append mutex.
+func (l *commitLog) checkReadonlyTarget() {
+	target, ok := l.ReadonlyTarget()
+	if ok && l.NewestOffset() >= target {
+		l.setReadonly(true)
+	}
+}
+
+// BytesAppended returns the number of message bytes appended to the log since
+// it was opened, not including message set headers.
+func (l *commitLog) BytesAppended() int64 {
+	return atomic.LoadInt64(&l.bytesAppended)
+}
+
+// ColdSegmentOpens returns the number of times a reader started reading from
+// a segment other than the active one.
+func (l *commitLog) ColdSegmentOpens() int64 {
+	return atomic.LoadInt64(&l.coldSegmentOpens)
+}
+
+// SealedSegments returns the segments which are no longer written to, i.e.
+// every segment but the active one, oldest first. Empty segments are skipped.
+func (l *commitLog) SealedSegments() []SegmentInfo {
+	var (
+		active = l.activeSegment()
+		infos  []SegmentInfo
+	)
+	for _, seg := range l.Segments() {
+		if seg == active || seg.IsDeleted() || seg.IsEmpty() {
+			continue
+		}
+		infos = append(infos, SegmentInfo{
+			BaseOffset: seg.BaseOffset,
+			LastOffset: seg.LastOffset(),
+			Size:       seg.Position(),
+			Path:       seg.logPath(),
+		})
+	}
+	return infos
+}
+
+// segmentOpened counts a reader positioning on the given segment as a cold
+// open if it's not the active segment. Inactive segments are generally read
+// to replay history and are less likely to be in the page cache.
+func (l *commitLog) segmentOpened(seg *segment) {
+	if seg != nil && seg != l.activeSegment() {
+		atomic.AddInt64(&l.coldSegmentOpens, 1)
 	}
 }
 
@@ -662,20 +1381,42 @@ func (l *commitLog) IsConcurrencyControlEnabled() bool {
 }
 
 // checkAndPerformSplit determines if a new log segment should be rolled out
-// either because the active segment is full or MaxSegmentAge has passed since
-// the first message was written to it. It then performs the split if eligible,
-// returning any error resulting from the split. The returned bool indicates if
-// a split was performed.
+// either because the active segment is full or MaxSegmentAge, less the
+// segment's jitter, has passed since the first message was written to it. It
+// then performs the split if eligible, returning any error resulting from the
+// split. The returned bool indicates if a split was performed.
 func (l *commitLog) checkAndPerformSplit() (bool, error) {
+	return l.roll(func(activeSegment *segment) RollReason {
+		_, maxSegmentAge := l.segmentLimits()
+		return activeSegment.CheckSplit(maxSegmentAge - l.ageJitter(activeSegment, maxSegmentAge))
+	})
+}
+
+// Roll rolls out a new log segment regardless of the active segment's size
+// and age unless it's empty. The returned bool indicates if a segment was
+// rolled.
+func (l *commitLog) Roll() (bool, error) {
+	return l.roll(func(activeSegment *segment) RollReason {
+		if activeSegment.IsEmpty() {
+			return RollNone
+		}
+		return RollForced
+	})
+}
+
+// roll rolls out a new log segment if check returns a reason to roll the
+// active segment.
+func (l *commitLog) roll(check func(*segment) RollReason) (bool, error) {
 	// Do this in a loop because segment splitting may fail due to a competing
 	// thread performing the split at the same time. If this happens, we just
 	// retry the check on the new active segment.
 	for {
 		activeSegment := l.activeSegment()
-		if !activeSegment.CheckSplit(l.MaxSegmentAge) {
+		reason := check(activeSegment)
+		if reason == RollNone {
 			return false, nil
 		}
-		if err := l.split(activeSegment); err != nil {
+		if err := l.split(activeSegment, reason); err != nil {
 			// ErrSegmentExists indicates another thread has already performed
 			// the segment split, so reload the new active segment and check
 			// again.
@@ -689,10 +1430,40 @@ func (l *commitLog) checkAndPerformSplit() (bool, error) {
 	}
 }
 
-func (l *commitLog) split(oldActiveSegment *segment) error {
+// ageJitter returns how long before maxSegmentAge the segment is rolled,
+// which is less than MaxSegmentAgeJitter and maxSegmentAge. It's derived from
+// the log's path and the segment's base offset so that the segments of logs
+// created at the same time don't all roll at once, while staying the same
+// across restarts.
+func (l *commitLog) ageJitter(seg *segment, maxSegmentAge time.Duration) time.Duration {
+	jitter := l.MaxSegmentAgeJitter
+	if jitter <= 0 || maxSegmentAge <= 0 {
+		return 0
+	}
+	if jitter > maxSegmentAge {
+		jitter = maxSegmentAge
+	}
+	h := fnv.New64a()
+	h.Write([]byte(l.Path))                                // nolint: errcheck
+	h.Write([]byte(strconv.FormatInt(seg.BaseOffset, 10))) // nolint: errcheck
+	return time.Duration(h.Sum64() % uint64(jitter))
+}
+
+// SegmentRolls returns the number of segments rolled out for the given reason
+// since the log was opened.
+func (l *commitLog) SegmentRolls(reason RollReason) int64 {
+	if reason < 0 || reason >= numRollReasons {
+		return 0
+	}
+	return atomic.LoadInt64(&l.rolls[reason])
+}
+
+func (l *commitLog) split(oldActiveSegment *segment, reason RollReason) error {
 	offset := l.NewestOffset() + 1
-	l.Logger.Debugf("Appending new log segment for %s with base offset %d", l.Path, offset)
-	segment, err := newSegment(l.Path, offset, l.MaxSegmentBytes, true, "")
+	l.Logger.Debugf("Appending new log segment for %s with base offset %d (roll reason: %s)",
+		l.Path, offset, reason)
+	maxSegmentBytes, _ := l.segmentLimits()
+	segment, err := newSegment(l.Path, offset, maxSegmentBytes, true, "")
 	if err != nil {
 		return err
 	}
@@ -705,10 +1476,12 @@ func (l *commitLog) split(oldActiveSegment *segment) error {
 		segment.Delete() // nolint: errcheck
 		return ErrSegmentExists
 	}
+	l.preallocate(segment, maxSegmentBytes)
 	l.mu.Lock()
 	segments := append(l.segments, segment)
 	l.segments = segments
 	l.mu.Unlock()
+	atomic.AddInt64(&l.rolls[reason], 1)
 	return nil
 }
 
@@ -743,10 +1516,117 @@ func (l *commitLog) cleanerLoop() {
 
 // Clean applies retention and compaction rules against the log, if applicable.
 func (l *commitLog) Clean() error {
+	return l.CleanWithProgress(nil)
+}
+
+// CleanWithProgress applies retention and compaction rules against the log,
+// if applicable, calling progress each time compaction finishes rewriting a
+// segment. Cleans are serialized since concurrent ones would rewrite the same
+// segments.
+func (l *commitLog) CleanWithProgress(progress func(CompactionProgress)) error {
+	return l.cleanSegments(func(segments []*segment) ([]*segment, *leaderEpochCache, error) {
+		return l.clean(segments, progress)
+	})
+}
+
+// EnforceRetention applies the retention rules against the log, skipping
+// compaction, and returns the number of bytes reclaimed by deleting segments.
+// Like cleans, it waits for a clean in progress to finish first.
+func (l *commitLog) EnforceRetention() (int64, error) {
+	var reclaimed int64
+	err := l.cleanSegments(func(segments []*segment) ([]*segment, *leaderEpochCache, error) {
+		cleaned, err := l.deleteCleaner.Clean(segments)
+		if err != nil {
+			return nil, nil, err
+		}
+		// Segments are only deleted from the start of the log.
+		for _, seg := range segments[:len(segments)-len(cleaned)] {
+			reclaimed += seg.Position()
+		}
+		return cleaned, nil, nil
+	})
+	return reclaimed, err
+}
+
+// CleanSummary describes the effect of a log clean.
+type CleanSummary struct {
+	SegmentsBefore    int
+	SegmentsAfter     int
+	BytesReclaimed    int64 // Bytes removed by retention and compaction
+	KeysCompacted     int64 // Messages removed because a later one has the same key
+	TombstonesDropped int64 // Tombstones removed because their TTL elapsed
+	CompactEnabled    bool  // Whether compaction is enabled, otherwise only retention was applied
+	Duration          time.Duration
+}
+
+// CleanWithSummary applies retention and compaction rules against the log,
+// if applicable, like Clean and returns a summary of the clean. The duration
+// includes waiting for a clean already in progress to finish.
+func (l *commitLog) CleanWithSummary() (CleanSummary, error) {
+	var (
+		start   = time.Now()
+		summary = CleanSummary{CompactEnabled: l.compactEnabled()}
+	)
+	err := l.cleanSegments(func(segments []*segment) ([]*segment, *leaderEpochCache, error) {
+		var compaction CompactionProgress
+		cleaned, epochCache, err := l.clean(segments, func(progress CompactionProgress) {
+			compaction = progress
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		summary.SegmentsBefore = len(segments)
+		summary.SegmentsAfter = len(cleaned)
+		// Neither retention nor compaction touch the active segment, which
+		// may be appended to while cleaning, so it's left out of the bytes
+		// reclaimed.
+		summary.BytesReclaimed = sealedSize(segments) - sealedSize(cleaned)
+		summary.KeysCompacted = compaction.KeysCompacted
+		summary.TombstonesDropped = compaction.TombstonesDropped
+		return cleaned, epochCache, nil
+	})
+	summary.Duration = time.Since(start)
+	return summary, err
+}
+
+// sealedSize returns the number of bytes in the given segments, excluding the
+// last one.
+func sealedSize(segments []*segment) int64 {
+	var size int64
+	for i := 0; i < len(segments)-1; i++ {
+		size += segments[i].Position()
+	}
+	return size
+}
+
+// ReclaimableBytes estimates the number of bytes the retention rules would
+// reclaim from the log if it were cleaned now.
+func (l *commitLog) ReclaimableBytes() int64 {
+	l.mu.RLock()
+	segments := l.segments
+	l.mu.RUnlock()
+	return l.deleteCleaner.Reclaimable(segments)
+}
+
+// cleanSegments replaces the log's segments with the ones returned by the
+// given clean function and updates the leader epoch offset cache. Cleans are
+// serialized since concurrent ones would rewrite the same segments.
+func (l *commitLog) cleanSegments(
+	clean func([]*segment) ([]*segment, *leaderEpochCache, error)) error {
+
+	l.cleanMu.Lock()
+	defer l.cleanMu.Unlock()
+
+	select {
+	case <-l.closed:
+		return ErrCommitLogClosed
+	default:
+	}
+
 	l.mu.RLock()
 	oldSegments := l.segments
 	l.mu.RUnlock()
-	cleaned, epochCache, err := l.clean(oldSegments)
+	cleaned, epochCache, err := clean(oldSegments)
 	if err != nil {
 		return err
 	}
@@ -788,14 +1668,17 @@ func (l *commitLog) rebaseSegments(from, to []*segment, epochCache *leaderEpochC
 // clean returns the cleaned segments and, if compaction ran, a
 // *leaderEpochCache maintaining the start offset for each new leader epoch. If
 // compaction did not run, the leaderEpochCache will be nil.
-func (l *commitLog) clean(segments []*segment) ([]*segment, *leaderEpochCache, error) {
+func (l *commitLog) clean(segments []*segment, progress func(CompactionProgress)) (
+	[]*segment, *leaderEpochCache, error) {
+
 	cleaned, err := l.deleteCleaner.Clean(segments)
 	if err != nil {
 		return nil, nil, err
 	}
+	// Compaction would rewrite the segments held by snapshots.
 	var epochCache *leaderEpochCache
-	if l.Compact {
-		cleaned, epochCache, err = l.compactCleaner.Compact(l.HighWatermark(), cleaned)
+	if l.compactEnabled() && l.snapshotStart() == -1 {
+		cleaned, epochCache, err = l.compactCleaner.Compact(l.HighWatermark(), cleaned, progress)
 		if err != nil {
 			return nil, nil, err
 		}
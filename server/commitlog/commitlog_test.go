@@ -2,7 +2,11 @@ package commitlog
 
 import (
 	"context"
+	"errors"
+	"io"
+	"math"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -81,6 +85,71 @@ func TestAppendMessageSet(t *testing.T) {
 	}
 }
 
+// Ensure message sets created with NewMessageSet are written at their offsets
+// even if that leaves a gap in the log.
+func TestAppendNewMessageSet(t *testing.T) {
+	l, cleanup := setup(t)
+	defer l.Close()
+	defer cleanup()
+
+	set, err := NewMessageSet(5, msgs[:2])
+	require.NoError(t, err)
+	offsets, err := l.AppendMessageSet(set)
+	require.NoError(t, err)
+	require.Equal(t, []int64{5, 6}, offsets)
+
+	set, err = NewMessageSet(10, msgs[2:3])
+	require.NoError(t, err)
+	offsets, err = l.AppendMessageSet(set)
+	require.NoError(t, err)
+	require.Equal(t, []int64{10}, offsets)
+	require.Equal(t, int64(10), l.NewestOffset())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r, err := l.NewReader(5, true)
+	require.NoError(t, err)
+	headers := make([]byte, 28)
+	for i, expected := range []int64{5, 6, 10} {
+		msg, offset, _, _, err := r.ReadMessage(ctx, headers)
+		require.NoError(t, err)
+		require.Equal(t, expected, offset)
+		compareMessages(t, msgs[i], msg)
+	}
+}
+
+// Ensure Append with Optimistic Concurrency Control rejects messages whose
+// expected offset isn't the next offset and reports the offset to retry with.
+func TestAppendIncorrectOffset(t *testing.T) {
+	opts := Options{
+		Path:               tempDir(t),
+		MaxSegmentBytes:    6,
+		MaxLogBytes:        30,
+		ConcurrencyControl: true,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+
+	offsets, err := l.Append([]*Message{{Value: []byte("one"), Offset: 0}})
+	require.NoError(t, err)
+	require.Equal(t, []int64{0}, offsets)
+
+	_, err = l.Append([]*Message{{Value: []byte("two"), Offset: 0}})
+	require.True(t, errors.Is(err, ErrIncorrectOffset))
+	var incorrect *IncorrectOffsetError
+	require.True(t, errors.As(err, &incorrect))
+	require.Equal(t, int64(1), incorrect.ExpectedOffset)
+
+	// An expected offset of -1 skips the check.
+	offsets, err = l.Append([]*Message{{Value: []byte("two"), Offset: -1}})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, offsets)
+
+	offsets, err = l.Append([]*Message{{Value: []byte("three"), Offset: incorrect.ExpectedOffset + 1}})
+	require.NoError(t, err)
+	require.Equal(t, []int64{2}, offsets)
+}
+
 func TestCommitLogRecover(t *testing.T) {
 	for _, test := range segmentSizeTests {
 		t.Run(test.name, func(t *testing.T) {
@@ -142,6 +211,243 @@ func TestCommitLogRecover(t *testing.T) {
 	}
 }
 
+// Ensure a log opened with LazyLoad only opens the files of the segments it
+// accesses and loads a segment on demand when reading from it.
+func TestCommitLogLazyLoad(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 20,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+	appendEpochs(t, l, make([]uint64, 100)...)
+	require.Equal(t, 100, l.SegmentCount())
+	require.NoError(t, l.Close())
+
+	start := time.Now()
+	l, cleanup = setupWithOptions(t, opts)
+	defer cleanup()
+	eager := time.Since(start)
+	require.Equal(t, 100, l.SegmentsLoaded())
+	require.NoError(t, l.Close())
+
+	opts.LazyLoad = true
+	start = time.Now()
+	l, cleanup = setupWithOptions(t, opts)
+	defer cleanup()
+	defer l.Close()
+	t.Logf("Opened log with 100 segments in %s, %s with LazyLoad", eager, time.Since(start))
+
+	// Only the oldest segment, read to check the leader epochs, and the
+	// active segment are loaded.
+	require.Equal(t, 100, l.SegmentsTotal())
+	require.Equal(t, 2, l.SegmentsLoaded())
+	require.Equal(t, int64(0), l.OldestOffset())
+	require.Equal(t, int64(99), l.NewestOffset())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r, err := l.NewReader(50, true)
+	require.NoError(t, err)
+	msg, offset, _, _, err := r.ReadMessage(ctx, make([]byte, 28))
+	require.NoError(t, err)
+	require.Equal(t, int64(50), offset)
+	require.Equal(t, "50", string(msg.Value()))
+	require.True(t, l.Segments()[50].IsLoaded())
+	require.Less(t, l.SegmentsLoaded(), 100)
+
+	// A segment loaded on demand can become the active segment.
+	require.NoError(t, l.Truncate(90))
+	require.Equal(t, int64(89), l.NewestOffset())
+	appendEpochs(t, l, 0)
+	require.Equal(t, int64(90), l.NewestOffset())
+	require.True(t, l.Segments()[90].IsLoaded())
+}
+
+// Ensure age retention on a log opened with LazyLoad checks the segments
+// without loading them.
+func TestCommitLogLazyLoadAgeRetention(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 20,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+	appendEpochs(t, l, make([]uint64, 100)...)
+	require.NoError(t, l.Close())
+
+	opts.LazyLoad = true
+	opts.MaxLogAge = time.Hour
+	l, cleanup = setupWithOptions(t, opts)
+	defer cleanup()
+	defer l.Close()
+	require.Equal(t, 2, l.SegmentsLoaded())
+
+	require.NoError(t, l.Clean())
+	require.Equal(t, 100, l.SegmentCount())
+	require.Equal(t, 2, l.SegmentsLoaded())
+	require.Equal(t, int64(0), l.OldestOffset())
+}
+
+// Ensure retention doesn't delete a segment of a log opened with LazyLoad
+// which can't be read.
+func TestCommitLogLazyLoadRetentionUnreadableSegment(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 20,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+	appendEpochs(t, l, make([]uint64, 10)...)
+	require.NoError(t, l.Close())
+
+	opts.LazyLoad = true
+	l, cleanup = setupWithOptions(t, opts)
+	defer cleanup()
+	defer l.Close()
+	seg := l.Segments()[5]
+	require.False(t, seg.IsLoaded())
+	require.NoError(t, os.Remove(seg.logPath()))
+
+	opts.MaxLogAge = time.Nanosecond
+	require.NoError(t, l.SetRetention(opts))
+	require.Error(t, l.Clean())
+	require.Equal(t, 10, l.SegmentCount())
+	require.Equal(t, int64(0), l.OldestOffset())
+}
+
+// Ensure a log whose segment index was deleted can be reopened and the index
+// is rebuilt identically from the log file.
+func TestCommitLogRecoverMissingIndex(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 100,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+
+	numMsgs := 10
+	for i := 0; i < numMsgs; i++ {
+		_, err := l.Append([]*Message{{Value: []byte(strconv.Itoa(i))}})
+		require.NoError(t, err)
+	}
+	require.True(t, len(l.Segments()) > 2)
+	seg := l.Segments()[1]
+	offset := seg.BaseOffset + 1
+	expected, err := seg.findEntry(offset)
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+	indexPath := seg.indexPath()
+	index, err := os.ReadFile(indexPath)
+	require.NoError(t, err)
+
+	// Delete the index and reopen the log.
+	require.NoError(t, os.Remove(indexPath))
+	l, cleanup = setupWithOptions(t, opts)
+	defer cleanup()
+
+	seg = l.Segments()[1]
+	require.True(t, seg.indexRebuilt)
+	e, err := seg.findEntry(offset)
+	require.NoError(t, err)
+	require.Equal(t, expected, e)
+
+	// Ensure the messages can be read back.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r, err := l.NewReader(0, true)
+	require.NoError(t, err)
+	headers := make([]byte, 28)
+	for i := 0; i < numMsgs; i++ {
+		msg, msgOffset, _, _, err := r.ReadMessage(ctx, headers)
+		require.NoError(t, err)
+		require.Equal(t, int64(i), msgOffset)
+		require.Equal(t, []byte(strconv.Itoa(i)), msg.Value())
+	}
+
+	// Ensure the rebuilt index is the same as the original.
+	require.NoError(t, l.Close())
+	rebuilt, err := os.ReadFile(indexPath)
+	require.NoError(t, err)
+	require.Equal(t, index, rebuilt)
+}
+
+// Ensure a log whose leader epoch checkpoint file is corrupt, missing, or
+// inconsistent with the log can be reopened with the correct leader epoch
+// offsets, rebuilding them from the log if needed.
+func TestCommitLogRecoverCorruptLeaderEpochs(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 100,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+
+	for i := 0; i < 15; i++ {
+		_, err := l.Append([]*Message{{
+			Value:       []byte(strconv.Itoa(i)),
+			LeaderEpoch: uint64(i/5 + 1),
+		}})
+		require.NoError(t, err)
+	}
+	require.True(t, len(l.Segments()) > 2)
+	require.NoError(t, l.Close())
+
+	expected := []*epochOffset{{1, 0}, {2, 5}, {3, 10}}
+	file := filepath.Join(opts.Path, leaderEpochFileName)
+	tests := []struct {
+		name     string
+		contents []byte // Deletes the file if nil
+	}{
+		{"missing", nil},
+		{"empty", []byte{}},
+		{"garbage", []byte{0xde, 0xad, 0xbe, 0xef, '\n', 0x00}},
+		{"torn write", []byte("0\n3\n1 0\n2 5\n3")},
+		{"malformed entry", []byte("0\n3\n1 0\n2 5\n3 1\n")},
+		{"wrong latest epoch", []byte("0\n3\n1 0\n2 5\n7 12\n")},
+		{"missing latest epoch", []byte("0\n1\n1 0\n")},
+		{"wrong earliest epoch", []byte("0\n3\n4 0\n5 5\n6 10\n")},
+		{"beyond log end", []byte("0\n4\n1 0\n2 5\n3 10\n9 100\n")},
+	}
+	for _, test := range tests {
+		if test.contents == nil {
+			require.NoError(t, os.Remove(file))
+		} else {
+			require.NoError(t, os.WriteFile(file, test.contents, 0600))
+		}
+
+		log, err := New(opts)
+		require.NoError(t, err, test.name)
+		l = log.(*commitLog)
+		require.Equal(t, expected, l.leaderEpochCache.epochOffsets, test.name)
+		require.Equal(t, uint64(3), l.LastLeaderEpoch(), test.name)
+		require.Equal(t, int64(5), l.LastOffsetForLeaderEpoch(1), test.name)
+		require.Equal(t, int64(10), l.LastOffsetForLeaderEpoch(2), test.name)
+		require.Equal(t, int64(14), l.LastOffsetForLeaderEpoch(3), test.name)
+		require.NoError(t, l.Close())
+
+		// The repaired file is written.
+		f, err := os.Open(file)
+		require.NoError(t, err, test.name)
+		offsets, problems, err := readLeaderEpochOffsets(f)
+		f.Close()
+		require.NoError(t, err, test.name)
+		require.Empty(t, problems, test.name)
+		require.Equal(t, expected, offsets, test.name)
+	}
+
+	// Truncation uses the recovered epoch offsets.
+	require.NoError(t, os.WriteFile(file, []byte("garbage"), 0600))
+	log, err := New(opts)
+	require.NoError(t, err)
+	l = log.(*commitLog)
+	defer l.Close()
+	require.NoError(t, l.Truncate(l.LastOffsetForLeaderEpoch(1)))
+	require.Equal(t, int64(4), l.NewestOffset())
+	require.Equal(t, uint64(1), l.LastLeaderEpoch())
+	require.Equal(t, []*epochOffset{{1, 0}}, l.leaderEpochCache.epochOffsets)
+}
+
 func TestCommitLogRecoverHW(t *testing.T) {
 	opts := Options{
 		Path:            tempDir(t),
@@ -182,6 +488,80 @@ func BenchmarkCommitLog(b *testing.B) {
 	}
 }
 
+// Ensure pre-allocating segments doesn't change their size or the messages
+// read back, including after the log is reopened.
+func TestPreallocateSegments(t *testing.T) {
+	opts := Options{
+		Path:                    tempDir(t),
+		MaxSegmentBytes:         256,
+		PreallocateSegmentBytes: 1024,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+
+	numMsgs := 20
+	msgs := make([]*Message, numMsgs)
+	for i := 0; i < numMsgs; i++ {
+		msgs[i] = &Message{Value: []byte(strconv.Itoa(i))}
+		_, err := l.Append([]*Message{msgs[i]})
+		require.NoError(t, err)
+	}
+	require.Greater(t, len(l.Segments()), 1)
+
+	checkLog := func(l *commitLog) {
+		for _, seg := range l.Segments() {
+			info, err := os.Stat(seg.logPath())
+			require.NoError(t, err)
+			require.Equal(t, seg.Position(), info.Size())
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		r, err := l.NewReader(0, true)
+		require.NoError(t, err)
+		headers := make([]byte, 28)
+		for i, exp := range msgs {
+			msg, offset, _, _, err := r.ReadMessage(ctx, headers)
+			require.NoError(t, err)
+			require.Equal(t, int64(i), offset)
+			compareMessages(t, exp, msg)
+		}
+	}
+	checkLog(l)
+
+	require.NoError(t, l.Close())
+	l, cleanup = setupWithOptions(t, opts)
+	defer cleanup()
+	checkLog(l)
+}
+
+func BenchmarkCommitLogPreallocate(b *testing.B) {
+	benchmarkSequentialWrites(b, 1024*1024*64)
+}
+
+func BenchmarkCommitLogNoPreallocate(b *testing.B) {
+	benchmarkSequentialWrites(b, 0)
+}
+
+// benchmarkSequentialWrites appends 4KB messages one at a time, rolling 8MB
+// segments, with the given amount of space pre-allocated for each segment.
+func benchmarkSequentialWrites(b *testing.B, preallocateBytes int64) {
+	l, cleanup := setupWithOptions(b, Options{
+		Path:                    tempDir(b),
+		MaxSegmentBytes:         1024 * 1024 * 8,
+		PreallocateSegmentBytes: preallocateBytes,
+	})
+	defer cleanup()
+
+	msg := []*Message{{Value: make([]byte, 4096)}}
+	b.SetBytes(4096)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := l.Append(msg)
+		require.NoError(b, err)
+	}
+}
+
 func TestOffsets(t *testing.T) {
 	l, cleanup := setupWithOptions(t, Options{
 		Path:            tempDir(t),
@@ -204,6 +584,31 @@ func TestOffsets(t *testing.T) {
 	require.Equal(t, int64(4), l.NewestOffset())
 }
 
+// Ensure Size and SegmentCount reflect the segments in the log.
+func TestSizeAndSegmentCount(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 20,
+	})
+	defer l.Close()
+	defer cleanup()
+	require.Equal(t, int64(0), l.Size())
+	require.Equal(t, 1, l.SegmentCount())
+
+	for i := 0; i < 5; i++ {
+		_, err := l.Append([]*Message{{Value: []byte(strconv.Itoa(i))}})
+		require.NoError(t, err)
+	}
+
+	var size int64
+	for _, segment := range l.Segments() {
+		size += segment.Position()
+	}
+	require.True(t, size > 0)
+	require.Equal(t, size, l.Size())
+	require.Equal(t, 5, l.SegmentCount())
+}
+
 func TestDelete(t *testing.T) {
 	l, cleanup := setup(t)
 	defer cleanup()
@@ -227,12 +632,243 @@ func TestCleaner(t *testing.T) {
 	_, err = l.Append(msgs)
 	require.NoError(t, err)
 
+	// Only committed messages are deleted.
+	l.SetHighWatermark(l.NewestOffset())
+	require.NoError(t, l.Clean())
+
+	require.Equal(t, 1, len(l.Segments()))
+	for i, s := range l.Segments() {
+		require.NotEqual(t, s, segments[i])
+	}
+}
+
+// Ensure SetRetention rejects invalid limits and applies valid ones on the
+// next clean.
+func TestSetRetention(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 20,
+		MaxLogMessages:  100,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	for i := 0; i < 5; i++ {
+		_, err := l.Append([]*Message{{Value: []byte(strconv.Itoa(i))}})
+		require.NoError(t, err)
+	}
+	l.SetHighWatermark(l.NewestOffset())
+	require.NoError(t, l.Clean())
+	require.Equal(t, 5, l.SegmentCount())
+
+	require.Error(t, l.SetRetention(Options{MaxLogMessages: -1}))
+	require.Error(t, l.SetRetention(Options{MaxLogBytes: -1}))
+	require.Error(t, l.SetRetention(Options{MaxLogAge: -time.Second}))
+	require.NoError(t, l.Clean())
+	require.Equal(t, 5, l.SegmentCount())
+
+	require.NoError(t, l.SetRetention(Options{MaxLogMessages: 2}))
+	require.NoError(t, l.Clean())
+	require.Equal(t, 2, l.SegmentCount())
+	require.Equal(t, int64(3), l.OldestOffset())
+}
+
+// Ensure UpdateOptions rejects invalid settings, applies segment limits to
+// segments rolled afterwards, and applies retention limits on the next clean.
+func TestUpdateOptions(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 20,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	append := func(n int) {
+		for i := 0; i < n; i++ {
+			_, err := l.Append([]*Message{{Value: []byte(strconv.Itoa(i))}})
+			require.NoError(t, err)
+		}
+	}
+	append(3)
+	require.Equal(t, 3, l.SegmentCount())
+
+	require.Error(t, l.UpdateOptions(Options{MaxSegmentBytes: -1}))
+	require.Error(t, l.UpdateOptions(Options{MaxSegmentAge: -time.Second}))
+	require.Error(t, l.UpdateOptions(Options{MaxLogMessages: -1}))
+
+	// The full active segment is rolled, and the new segment uses the new
+	// limit.
+	require.NoError(t, l.UpdateOptions(Options{MaxSegmentBytes: 1000, MaxLogMessages: 2}))
+	append(3)
+	require.Equal(t, 4, l.SegmentCount())
+
+	// The retention limit is applied on the next clean.
+	l.SetHighWatermark(l.NewestOffset())
+	require.NoError(t, l.Clean())
+	require.Equal(t, 1, l.SegmentCount())
+	require.Equal(t, int64(3), l.Segments()[0].BaseOffset)
+}
+
+// Ensure a log idle for longer than MaxSegmentAge rolls its active segment at
+// most once, never rolls an empty segment, and writes a burst of messages
+// following the idle period to a single segment.
+func TestSegmentRollAfterIdlePeriod(t *testing.T) {
+	now := time.Now().UnixNano()
+	timestampBefore := timestamp
+	timestamp = func() int64 { return now }
+	defer func() {
+		timestamp = timestampBefore
+	}()
+
+	l, cleanup := setupWithOptions(t, Options{
+		Path:          tempDir(t),
+		MaxSegmentAge: time.Hour,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	burst := func(n int) {
+		for i := 0; i < n; i++ {
+			_, err := l.Append([]*Message{{Value: []byte("foo"), Timestamp: now}})
+			require.NoError(t, err)
+		}
+	}
+	burst(1)
+
+	// The cleaner rolls the segment once it's older than MaxSegmentAge, but
+	// not the new empty segment however long the log stays idle.
+	now += int64(90 * time.Minute)
+	rolled, err := l.checkAndPerformSplit()
+	require.NoError(t, err)
+	require.True(t, rolled)
+	now += int64(3 * time.Hour)
+	rolled, err = l.checkAndPerformSplit()
+	require.NoError(t, err)
+	require.False(t, rolled)
+
+	// The burst is written to the segment rolled while idle.
+	burst(10)
+	require.Equal(t, 2, l.SegmentCount())
+	require.Equal(t, int64(1), l.Segments()[1].BaseOffset)
+
+	// If the cleaner doesn't run during the idle period, the first write of
+	// the burst rolls the old segment and the rest follow it.
+	now += int64(2 * time.Hour)
+	burst(10)
+	require.Equal(t, 3, l.SegmentCount())
+	require.Equal(t, int64(11), l.Segments()[2].BaseOffset)
+	require.Equal(t, int64(2), l.SegmentRolls(RollAge))
+	require.Equal(t, int64(0), l.SegmentRolls(RollSize))
+
+	// The segment rolls once MaxSegmentAge passes since its first message.
+	now += int64(time.Hour) - 1
+	burst(1)
+	require.Equal(t, 3, l.SegmentCount())
+	now++
+	burst(1)
+	require.Equal(t, 4, l.SegmentCount())
+}
+
+// Ensure MaxSegmentAgeJitter rolls segments up to the jitter before
+// MaxSegmentAge, by an amount which differs between logs and segments.
+func TestSegmentRollJitter(t *testing.T) {
+	jitters := make(map[time.Duration]struct{})
+	for i := 0; i < 10; i++ {
+		l := &commitLog{Options: Options{
+			Path:                filepath.Join("data", strconv.Itoa(i)),
+			MaxSegmentAgeJitter: time.Minute,
+		}}
+		for _, offset := range []int64{0, 100} {
+			seg := &segment{BaseOffset: offset}
+			jitter := l.ageJitter(seg, time.Hour)
+			require.GreaterOrEqual(t, jitter, time.Duration(0))
+			require.Less(t, jitter, time.Minute)
+			require.Equal(t, jitter, l.ageJitter(seg, time.Hour))
+			jitters[jitter] = struct{}{}
+
+			// The jitter is capped at the max segment age and disabled
+			// along with it.
+			require.Less(t, l.ageJitter(seg, time.Second), time.Second)
+			require.Equal(t, time.Duration(0), l.ageJitter(seg, 0))
+		}
+	}
+	require.Greater(t, len(jitters), 1)
+}
+
+// Ensure Roll rolls the active segment unless it's empty and segment rolls
+// are counted by reason.
+func TestRoll(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 100,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	rolled, err := l.Roll()
+	require.NoError(t, err)
+	require.False(t, rolled)
+	require.Equal(t, 1, l.SegmentCount())
+
+	_, err = l.Append([]*Message{{Value: []byte("foo")}})
+	require.NoError(t, err)
+	rolled, err = l.Roll()
+	require.NoError(t, err)
+	require.True(t, rolled)
+	require.Equal(t, 2, l.SegmentCount())
+	require.Equal(t, int64(1), l.SegmentRolls(RollForced))
+
+	_, err = l.Append([]*Message{{Value: make([]byte, 100)}})
+	require.NoError(t, err)
+	_, err = l.Append([]*Message{{Value: []byte("foo")}})
+	require.NoError(t, err)
+	require.Equal(t, 3, l.SegmentCount())
+	require.Equal(t, int64(1), l.SegmentRolls(RollSize))
+	require.Equal(t, int64(1), l.SegmentRolls(RollForced))
+	require.Equal(t, int64(0), l.SegmentRolls(RollAge))
+}
+
+// Ensure retention limits never delete segments containing messages past the
+// HW or the retention floor.
+func TestRetentionFloor(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 20,
+		MaxLogMessages:  1,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	for i := 0; i < 5; i++ {
+		_, err := l.Append([]*Message{{Value: []byte(strconv.Itoa(i))}})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 5, l.SegmentCount())
+
+	// Nothing is committed, so nothing is deleted.
+	require.Equal(t, int64(-1), l.RetentionFloor())
+	require.Equal(t, int64(0), l.ReclaimableBytes())
+	require.NoError(t, l.Clean())
+	require.Equal(t, 5, l.SegmentCount())
+
+	// Segments are only deleted up to the HW.
+	l.SetHighWatermark(1)
+	require.Equal(t, int64(1), l.RetentionFloor())
+	require.NoError(t, l.Clean())
+	require.Equal(t, int64(2), l.Segments()[0].BaseOffset)
+
+	// The floor holds back retention even though the messages are committed.
+	l.SetHighWatermark(4)
+	l.SetRetentionFloor(2)
+	require.Equal(t, int64(2), l.RetentionFloor())
 	require.NoError(t, l.Clean())
+	require.Equal(t, int64(3), l.Segments()[0].BaseOffset)
 
-	require.Equal(t, 1, len(l.Segments()))
-	for i, s := range l.Segments() {
-		require.NotEqual(t, s, segments[i])
-	}
+	l.SetRetentionFloor(math.MaxInt64)
+	require.Equal(t, int64(4), l.RetentionFloor())
+	require.NoError(t, l.Clean())
+	require.Equal(t, 1, l.SegmentCount())
+	require.Equal(t, int64(4), l.Segments()[0].BaseOffset)
 }
 
 // Ensure Clean deletes leader epoch offsets from the cache when segments are
@@ -286,6 +922,7 @@ func TestCleanerDeleteLeaderEpochOffsets(t *testing.T) {
 	require.Equal(t, int64(10), l.LastOffsetForLeaderEpoch(2))
 	require.Equal(t, int64(14), l.LastOffsetForLeaderEpoch(3))
 
+	l.SetHighWatermark(l.NewestOffset())
 	// Force a clean.
 	require.NoError(t, l.Clean())
 
@@ -371,6 +1008,98 @@ func TestCleanerReplaceLeaderEpochOffsets(t *testing.T) {
 	require.Equal(t, int64(14), l.LastOffsetForLeaderEpoch(3))
 }
 
+// Ensure CleanWithProgress reports the progress of compaction after each
+// segment is rewritten.
+func TestCleanWithProgress(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 6,
+		Compact:         true,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	for _, key := range []string{"foo", "bar", "baz"} {
+		for i := 0; i < 5; i++ {
+			_, err := l.Append([]*Message{{
+				Key:       []byte(key),
+				Value:     []byte(strconv.Itoa(i)),
+				Timestamp: time.Now().UnixNano(),
+			}})
+			require.NoError(t, err)
+		}
+	}
+	l.SetHighWatermark(l.NewestOffset())
+	require.Equal(t, 15, len(l.Segments()))
+	sizeBefore := l.Size()
+
+	var reports []CompactionProgress
+	require.NoError(t, l.CleanWithProgress(func(progress CompactionProgress) {
+		reports = append(reports, progress)
+	}))
+
+	// Every segment but the active one is rewritten, removing all but the
+	// latest message for each key.
+	require.Len(t, reports, 14)
+	for i, report := range reports {
+		require.Equal(t, i+1, report.SegmentsProcessed)
+	}
+	last := reports[len(reports)-1]
+	require.Equal(t, int64(12), last.KeysCompacted)
+	require.Equal(t, sizeBefore-l.Size(), last.BytesReclaimed)
+	require.Equal(t, 3, len(l.Segments()))
+}
+
+// Ensure CleanWithSummary reports the segments and bytes removed by retention
+// and compaction, and that logs aren't cleaned once closed.
+func TestCleanWithSummary(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 6,
+		MaxLogMessages:  12,
+		Compact:         true,
+	})
+	defer cleanup()
+
+	for _, key := range []string{"foo", "bar", "baz"} {
+		for i := 0; i < 5; i++ {
+			_, err := l.Append([]*Message{{
+				Key:       []byte(key),
+				Value:     []byte(strconv.Itoa(i)),
+				Timestamp: time.Now().UnixNano(),
+			}})
+			require.NoError(t, err)
+		}
+	}
+	l.SetHighWatermark(l.NewestOffset())
+	sizeBefore := l.Size()
+
+	// Retention deletes the first 3 segments, and compaction removes all but
+	// the latest message for each key from the rest.
+	summary, err := l.CleanWithSummary()
+	require.NoError(t, err)
+	require.True(t, summary.CompactEnabled)
+	require.Equal(t, 15, summary.SegmentsBefore)
+	require.Equal(t, 3, summary.SegmentsAfter)
+	require.Equal(t, int64(9), summary.KeysCompacted)
+	require.Equal(t, sizeBefore-l.Size(), summary.BytesReclaimed)
+	require.Greater(t, summary.Duration, time.Duration(0))
+
+	// Without compaction, only retention is applied.
+	require.NoError(t, l.UpdateOptions(Options{MaxLogMessages: 1}))
+	summary, err = l.CleanWithSummary()
+	require.NoError(t, err)
+	require.False(t, summary.CompactEnabled)
+	require.Equal(t, 3, summary.SegmentsBefore)
+	require.Equal(t, 1, summary.SegmentsAfter)
+	require.Equal(t, int64(0), summary.KeysCompacted)
+	require.Greater(t, summary.BytesReclaimed, int64(0))
+
+	require.NoError(t, l.Close())
+	_, err = l.CleanWithSummary()
+	require.Equal(t, ErrCommitLogClosed, err)
+}
+
 // Ensure EarliestOffsetAfterTimestamp returns the earliest offset whose
 // timestamp is greater than or equal to the given timestamp.
 func TestEarliestOffsetAfterTimestamp(t *testing.T) {
@@ -496,6 +1225,58 @@ func TestLatestOffsetBeforeTimestamp(t *testing.T) {
 	require.Equal(t, int64(2), offset)
 }
 
+// Ensure timestamp lookups work when messages are appended with timestamps out
+// of order and that the messages keep their original timestamps.
+func TestOffsetForTimestampOutOfOrder(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 100,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+
+	// Append some messages with timestamps out of order. Index timestamps are
+	// the latest timestamp so far: 0, 30, 30, 30, 50, 50, 60, 90, 90, 90.
+	timestamps := []int64{0, 30, 10, 20, 50, 40, 60, 90, 70, 80}
+	for i, timestamp := range timestamps {
+		_, err := l.Append([]*Message{{Value: []byte(strconv.Itoa(i)), Timestamp: timestamp}})
+		require.NoError(t, err)
+	}
+
+	// Messages keep the timestamps they were appended with.
+	r, err := l.NewReader(0, true)
+	require.NoError(t, err)
+	headers := make([]byte, 28)
+	for i, expected := range timestamps {
+		_, offset, timestamp, _, err := r.ReadMessage(context.Background(), headers)
+		require.NoError(t, err)
+		require.Equal(t, int64(i), offset)
+		require.Equal(t, expected, timestamp)
+	}
+
+	// The earliest offset after a timestamp is the first one indexed at or
+	// after it, even if later messages have earlier timestamps.
+	offset, err := l.EarliestOffsetAfterTimestamp(20)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), offset)
+
+	offset, err = l.EarliestOffsetAfterTimestamp(40)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), offset)
+
+	offset, err = l.EarliestOffsetAfterTimestamp(70)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), offset)
+
+	offset, err = l.LatestOffsetBeforeTimestamp(45)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), offset)
+
+	offset, err = l.LatestOffsetBeforeTimestamp(85)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), offset)
+}
+
 // Ensure Truncate removes log entries up to the given offset and that the
 // leader epoch cache is also truncated.
 func TestTruncate(t *testing.T) {
@@ -551,6 +1332,146 @@ func TestTruncate(t *testing.T) {
 	require.Equal(t, int64(5), l.LastOffsetForLeaderEpoch(1))
 }
 
+// Ensure TruncateAndStartAt empties the log and assigns the next message the
+// new start offset, including after the log is reopened.
+func TestTruncateAndStartAt(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 6,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+
+	for i := 0; i < 5; i++ {
+		_, err := l.Append([]*Message{{
+			Value:       []byte(strconv.Itoa(i)),
+			Timestamp:   time.Now().UnixNano(),
+			LeaderEpoch: 1,
+		}})
+		require.NoError(t, err)
+	}
+	l.SetHighWatermark(4)
+
+	require.NoError(t, l.TruncateAndStartAt(10))
+	require.Equal(t, 1, l.SegmentCount())
+	require.Equal(t, int64(10), l.LogStartOffset())
+	require.Equal(t, int64(9), l.NewestOffset())
+	require.Equal(t, int64(9), l.HighWatermark())
+	require.Equal(t, uint64(1), l.LastLeaderEpoch())
+
+	offsets, err := l.Append([]*Message{{
+		Value:       []byte("10"),
+		Timestamp:   time.Now().UnixNano(),
+		LeaderEpoch: 2,
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []int64{10}, offsets)
+	require.Equal(t, uint64(2), l.LastLeaderEpoch())
+
+	// Close the log and reopen, then ensure it still starts at the offset.
+	require.NoError(t, l.Close())
+	l, cleanup = setupWithOptions(t, opts)
+	defer cleanup()
+	defer l.Close()
+	require.Equal(t, int64(10), l.LogStartOffset())
+	require.Equal(t, int64(10), l.OldestOffset())
+	require.Equal(t, int64(10), l.NewestOffset())
+}
+
+// Ensure DeleteBefore deletes the segments before the offset, hides the
+// messages before it in the segment containing it from readers, and that the
+// log start offset is recovered when the log is reopened.
+func TestDeleteBefore(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 100,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+	for i := 0; i < 10; i++ {
+		_, err := l.Append([]*Message{{
+			Value:       []byte(strconv.Itoa(i)),
+			Timestamp:   time.Now().UnixNano(),
+			LeaderEpoch: uint64(1 + i/5),
+		}})
+		require.NoError(t, err)
+	}
+	l.SetHighWatermark(9)
+	require.Equal(t, 4, l.SegmentCount())
+	require.Equal(t, int64(3), l.Segments()[1].BaseOffset)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	headers := make([]byte, 28)
+	hidden, err := l.NewReader(3, false)
+	require.NoError(t, err)
+
+	require.NoError(t, l.DeleteBefore(4))
+	require.Equal(t, 3, l.SegmentCount())
+	require.Equal(t, int64(3), l.Segments()[0].BaseOffset)
+	require.Equal(t, int64(4), l.LogStartOffset())
+	require.Equal(t, int64(4), l.OldestOffset())
+	require.Equal(t, int64(9), l.NewestOffset())
+
+	// Readers positioned before the log start offset are told where it is.
+	_, _, _, _, err = hidden.ReadMessage(ctx, headers)
+	require.True(t, errors.Is(err, ErrOffsetOutOfRange))
+	var outOfRange *OffsetOutOfRangeError
+	require.True(t, errors.As(err, &outOfRange))
+	require.Equal(t, int64(4), outOfRange.LogStartOffset)
+	_, err = l.NewReader(3, true)
+	require.True(t, errors.Is(err, ErrOffsetOutOfRange))
+
+	r, err := l.NewReader(4, false)
+	require.NoError(t, err)
+	msg, offset, _, _, err := r.ReadMessage(ctx, headers)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), offset)
+	require.Equal(t, "4", string(msg.Value()))
+
+	// Reverse readers stop at the log start offset.
+	rr, err := l.NewReverseReader(5, false)
+	require.NoError(t, err)
+	_, offset, _, _, err = rr.ReadMessage(ctx, headers)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), offset)
+	_, offset, _, _, err = rr.ReadMessage(ctx, headers)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), offset)
+	_, _, _, _, err = rr.ReadMessage(ctx, headers)
+	require.Equal(t, io.EOF, err)
+
+	// Offsets which aren't past the log start offset are a no-op.
+	require.NoError(t, l.DeleteBefore(2))
+	require.Equal(t, int64(4), l.LogStartOffset())
+
+	// Close the log and reopen, then ensure it still starts at the offset.
+	require.NoError(t, l.Close())
+	l, cleanup = setupWithOptions(t, opts)
+	defer cleanup()
+	defer l.Close()
+	require.Equal(t, int64(4), l.LogStartOffset())
+	require.Equal(t, int64(4), l.OldestOffset())
+	_, err = l.NewReader(3, false)
+	require.True(t, errors.Is(err, ErrOffsetOutOfRange))
+
+	// Deleting past the newest offset starts the log at the offset.
+	require.NoError(t, l.DeleteBefore(12))
+	require.Equal(t, 1, l.SegmentCount())
+	require.Equal(t, int64(12), l.LogStartOffset())
+	require.Equal(t, int64(11), l.NewestOffset())
+	require.Equal(t, int64(11), l.HighWatermark())
+	require.Equal(t, int64(-1), l.OldestOffset())
+	offsets, err := l.Append([]*Message{{
+		Value:       []byte("12"),
+		Timestamp:   time.Now().UnixNano(),
+		LeaderEpoch: 2,
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []int64{12}, offsets)
+	require.Equal(t, uint64(2), l.LastLeaderEpoch())
+}
+
 // Ensure NotifyLEO returns a closed channel when the given offset is not the
 // current log end offset.
 func TestNotifyLEOMismatch(t *testing.T) {
@@ -766,6 +1687,149 @@ func TestSetReadonlyWakeHWEqualsLEO(t *testing.T) {
 	require.Equal(t, ErrCommitLogReadonly, err)
 }
 
+// Ensure when SetReadonlyAt is called on a log, Append succeeds until the
+// newest offset reaches the target and returns ErrCommitLogReadonly after.
+func TestSetReadonlyAtAppend(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 256,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	l.SetReadonlyAt(6)
+	require.False(t, l.IsReadonly())
+	target, ok := l.ReadonlyTarget()
+	require.True(t, ok)
+	require.Equal(t, int64(6), target)
+
+	_, err := l.Append(msgs)
+	require.NoError(t, err)
+	require.False(t, l.IsReadonly())
+
+	// The batch reaching the target is appended in its entirety.
+	_, err = l.Append(msgs)
+	require.NoError(t, err)
+	require.Equal(t, int64(9), l.NewestOffset())
+	require.True(t, l.IsReadonly())
+	_, ok = l.ReadonlyTarget()
+	require.False(t, ok)
+
+	_, err = l.Append(msgs)
+	require.Equal(t, ErrCommitLogReadonly, err)
+}
+
+// Ensure when SetReadonlyAt is called with a target the newest offset has
+// already reached, the log becomes readonly immediately, and that SetReadonly
+// clears a pending target.
+func TestSetReadonlyAtReached(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 256,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	_, err := l.Append(msgs)
+	require.NoError(t, err)
+
+	l.SetReadonlyAt(2)
+	require.True(t, l.IsReadonly())
+	_, ok := l.ReadonlyTarget()
+	require.False(t, ok)
+
+	// A readonly log becomes writable until it reaches a new target.
+	l.SetReadonlyAt(100)
+	require.False(t, l.IsReadonly())
+	_, ok = l.ReadonlyTarget()
+	require.True(t, ok)
+
+	l.SetReadonly(false)
+	_, ok = l.ReadonlyTarget()
+	require.False(t, ok)
+	_, err = l.Append(msgs)
+	require.NoError(t, err)
+	require.False(t, l.IsReadonly())
+}
+
+// Ensure when AppendMessageSet reaches the readonly target, committed readers
+// waiting for the HW to advance receive ErrCommitLogReadonly once the HW is
+// caught up to the LEO.
+func TestSetReadonlyAtAppendMessageSet(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 256,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	l.SetReadonlyAt(4)
+	r, err := l.NewReader(0, false)
+	require.NoError(t, err)
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		set, _, err := newMessageSetFromProto(0, 0, msgs, false)
+		require.NoError(t, err)
+		_, err = l.AppendMessageSet(set)
+		require.NoError(t, err)
+		require.True(t, l.IsReadonly())
+		l.SetHighWatermark(4)
+	}()
+
+	headers := make([]byte, 28)
+	for range msgs {
+		_, _, _, _, err := r.ReadMessage(context.Background(), headers)
+		require.NoError(t, err)
+	}
+	_, _, _, _, err = r.ReadMessage(context.Background(), headers)
+	require.Equal(t, ErrCommitLogReadonly, err)
+}
+
+// Ensure a log opened with the ReadOnly option serves committed messages up to
+// the recovered HW, rejects appends, and becomes writable once upgraded.
+func TestReadOnlyUpgrade(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 256,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+	_, err := l.Append(msgs)
+	require.NoError(t, err)
+	l.SetHighWatermark(2)
+	require.NoError(t, l.Close())
+
+	opts.ReadOnly = true
+	l, cleanup = setupWithOptions(t, opts)
+	defer cleanup()
+	require.True(t, l.IsReadonly())
+	require.Equal(t, int64(2), l.HighWatermark())
+
+	_, err = l.Append(msgs)
+	require.Equal(t, ErrCommitLogReadonly, err)
+
+	r, err := l.NewReader(0, false)
+	require.NoError(t, err)
+	headers := make([]byte, 28)
+	for i := 0; i < 3; i++ {
+		_, offset, _, _, err := r.ReadMessage(context.Background(), headers)
+		require.NoError(t, err)
+		require.Equal(t, int64(i), offset)
+	}
+
+	require.NoError(t, l.Upgrade())
+	require.False(t, l.IsReadonly())
+	offsets, err := l.Append(msgs[:1])
+	require.NoError(t, err)
+	require.Equal(t, []int64{int64(len(msgs))}, offsets)
+
+	// Upgrading again does nothing.
+	require.NoError(t, l.Upgrade())
+	require.NoError(t, l.Close())
+	require.Equal(t, ErrCommitLogClosed, l.Upgrade())
+}
+
 func setup(t require.TestingT) (*commitLog, func()) {
 	opts := Options{
 		Path:            tempDir(t),
@@ -793,3 +1857,54 @@ func tempDir(t require.TestingT) string {
 func remove(t require.TestingT, path string) {
 	require.NoError(t, os.RemoveAll(path))
 }
+
+// Ensure messages are only considered expired once their TTL has elapsed
+// since their timestamp.
+func TestMessageExpired(t *testing.T) {
+	ttl := map[string][]byte{TTLHeader: []byte("100")}
+	require.False(t, Expired(ttl, 1000, 1050))
+	require.False(t, Expired(ttl, 1000, 1100))
+	require.True(t, Expired(ttl, 1000, 1101))
+
+	// Messages without a valid TTL never expire.
+	require.False(t, Expired(nil, 1000, 5000))
+	require.False(t, Expired(map[string][]byte{TTLHeader: []byte("foo")}, 1000, 5000))
+
+	// A TTL overflowing the expiration time never elapses.
+	huge := map[string][]byte{TTLHeader: []byte(strconv.FormatInt(math.MaxInt64, 10))}
+	require.False(t, Expired(huge, 1000, math.MaxInt64))
+
+	_, err := ParseTTL([]byte("0"))
+	require.Error(t, err)
+	_, err = ParseTTL([]byte("-5"))
+	require.Error(t, err)
+	parsed, err := ParseTTL([]byte("100"))
+	require.NoError(t, err)
+	require.Equal(t, int64(100), parsed)
+}
+
+// Ensure observers are notified when the HW advances, messages are appended,
+// and the log becomes readonly or writable, but not once unregistered.
+func TestObserve(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{Path: tempDir(t)})
+	defer cleanup()
+
+	var changes []LogChange
+	unobserve := l.Observe(func(change LogChange) {
+		changes = append(changes, change)
+	})
+
+	_, err := l.Append([]*Message{{Value: []byte("foo")}})
+	require.NoError(t, err)
+	l.SetHighWatermark(0)
+	l.SetHighWatermark(0)
+	l.SetReadonly(true)
+	l.SetReadonly(true)
+	l.SetReadonlyAt(5)
+	require.Equal(t, []LogChange{LEOChanged, HWChanged, ReadonlyChanged, ReadonlyChanged}, changes)
+
+	unobserve()
+	_, err = l.Append([]*Message{{Value: []byte("bar")}})
+	require.NoError(t, err)
+	require.Len(t, changes, 4)
+}
@@ -1,10 +1,12 @@
 package commitlog
 
 import (
+	"os"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/liftbridge-io/liftbridge/server/logger"
 )
@@ -14,13 +16,20 @@ const defaultCompactMaxGoroutines = 10
 // compactCleanerOptions contains configuration settings for the
 // compactCleaner.
 type compactCleanerOptions struct {
-	Logger        logger.Logger
-	Name          string
-	MaxGoroutines int
+	Logger             logger.Logger
+	Name               string
+	MaxGoroutines      int
+	Recorder           AllocationRecorder
+	Cipher             *logCipher                 // Decrypts messages to read their keys, optional
+	CompactionProgress func(processed, total int) // Called each time a segment is rewritten, optional
+	TombstoneTTL       time.Duration              // Time tombstones are retained for, negative to retain them indefinitely
+	BloomFilter        bool                       // Keep a bloom filter of the keys in compacted segments, see loadBlooms
 }
 
 // compactCleaner implements the compaction policy which replaces segments with
-// compacted ones, i.e. retaining only the last message for a given key.
+// compacted ones, i.e. retaining only the last message for a given key. A
+// tombstone, i.e. a keyed message with an empty value, is removed entirely once
+// it's older than the TombstoneTTL.
 type compactCleaner struct {
 	compactCleanerOptions
 }
@@ -35,14 +44,47 @@ func newCompactCleaner(opts compactCleanerOptions) *compactCleaner {
 	return &compactCleaner{opts}
 }
 
+// recordScan reports the message set allocated by a segment scan during
+// compaction to the AllocationRecorder, if there is one.
+func (c *compactCleaner) recordScan(ms messageSet) {
+	if c.Recorder != nil {
+		c.Recorder.RecordAllocation(AllocSiteCompaction, len(ms))
+	}
+}
+
+// message returns the message in the message set. Encrypted messages are
+// decrypted to read their key and value, but the message set itself is left
+// as is.
+func (c *compactCleaner) message(ms messageSet) (SerializedMessage, error) {
+	m := ms.Message()
+	if c.Cipher != nil {
+		var err error
+		m, err = c.Cipher.decrypt(ms.Offset(), m)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// isExpiredTombstone indicates if the message is a tombstone older than the
+// TombstoneTTL as of the given time in Unix nanoseconds.
+func (c *compactCleaner) isExpiredTombstone(m SerializedMessage, timestamp, now int64) bool {
+	if c.TombstoneTTL < 0 || m.Key() == nil || len(m.Value()) > 0 {
+		return false
+	}
+	return time.Duration(now-timestamp) >= c.TombstoneTTL
+}
+
 // Compact performs log compaction by rewriting segments such that they contain
 // only the last message for a given key. Compaction is applied to all segments
 // up to but excluding the active (last) segment or the provided HW, whichever
 // comes first. This returns the compacted segments and a leaderEpochCache
 // containing the earliest offsets for each leader epoch or nil if nothing was
-// compacted.
-func (c *compactCleaner) Compact(hw int64, segments []*segment) ([]*segment,
-	*leaderEpochCache, error) {
+// compacted. If progress is not nil, it's called after each segment is
+// rewritten.
+func (c *compactCleaner) Compact(hw int64, segments []*segment,
+	progress func(CompactionProgress)) ([]*segment, *leaderEpochCache, error) {
 
 	if len(segments) <= 1 {
 		return segments, nil, nil
@@ -50,19 +92,31 @@ func (c *compactCleaner) Compact(hw int64, segments []*segment) ([]*segment,
 
 	c.Logger.Debugf("Compacting log %s", c.Name)
 	before := time.Now()
-	compacted, epochCache, removed, err := c.compact(hw, segments)
+	compacted, epochCache, status, err := c.compact(hw, segments, progress)
 	if err == nil {
 		c.Logger.Debugf("Finished compacting log %s\n"+
 			"\tMessages Removed: %d\n"+
+			"\tTombstones Dropped: %d\n"+
 			"\tSegments: %d -> %d\n"+
+			"\tSegments Skipped: %d\n"+
 			"\tDuration: %s",
-			c.Name, removed, len(segments), len(compacted), time.Since(before))
+			c.Name, status.KeysCompacted+status.TombstonesDropped, status.TombstonesDropped,
+			len(segments), len(compacted), status.SegmentsSkipped, time.Since(before))
 	}
 
 	return compacted, epochCache, errors.Wrap(err, "failed to compact log")
 
 }
 
+// CompactionProgress reports how far a log compaction has gotten.
+type CompactionProgress struct {
+	SegmentsProcessed int   // Number of segments rewritten
+	BytesReclaimed    int64 // Bytes removed from the rewritten segments
+	KeysCompacted     int64 // Messages removed because a later one has the same key
+	TombstonesDropped int64 // Tombstones removed because their TTL elapsed
+	SegmentsSkipped   int   // Number of segments left as is because their bloom filter ruled out later messages for their keys
+}
+
 type keyOffset struct {
 	sync.RWMutex
 	offset int64
@@ -82,8 +136,26 @@ func (k *keyOffset) get() int64 {
 	return k.offset
 }
 
-func (c *compactCleaner) compact(hw int64, segments []*segment) ([]*segment,
-	*leaderEpochCache, int, error) {
+// epochStart is the offset of the first message of a leader epoch retained in
+// a compacted segment.
+type epochStart struct {
+	epoch  uint64
+	offset int64
+}
+
+// cleanedSegment is the result of compacting a segment.
+type cleanedSegment struct {
+	segment    *segment // nil if no messages were retained
+	removed    int
+	tombstones int // Expired tombstones dropped, not included in removed
+	epochs     []epochStart
+	kept       int64    // Tombstones retained
+	keyHashes  []uint64 // Hashes of the retained keys, only set if BloomFilter is
+	belowHW    bool     // Whether every retained message precedes the HW
+}
+
+func (c *compactCleaner) compact(hw int64, segments []*segment,
+	progress func(CompactionProgress)) ([]*segment, *leaderEpochCache, CompactionProgress, error) {
 
 	// Compact messages up to the last segment or HW, whichever is first, by
 	// scanning keys and retaining only the latest.
@@ -91,21 +163,78 @@ func (c *compactCleaner) compact(hw int64, segments []*segment) ([]*segment,
 	var (
 		compacted  = make([]*segment, 0, len(segments))
 		epochCache = newLeaderEpochCacheNoFile(c.Name, c.Logger)
-		removed    = 0
-		keyOffsets = c.scanKeys(hw, segments)
+		toClean    = segments[:len(segments)-1]
+		results    = make([]*cleanedSegment, len(toClean))
+		blooms     = c.loadBlooms(hw, toClean)
+		keyOffsets = c.scanKeys(hw, segments, blooms)
+		rewrites   = len(toClean)
+		mu         sync.Mutex
+		status     CompactionProgress
+		group      errgroup.Group
 	)
 
-	// Write new segments. Skip the last segment since we will not compact it.
+	// Segments whose bloom filter is still set weren't affected by any later
+	// message, so they're kept as is.
+	for i, bloom := range blooms {
+		if bloom != nil {
+			results[i] = &cleanedSegment{segment: toClean[i], epochs: bloom.epochs}
+			status.SegmentsSkipped++
+			rewrites--
+		}
+	}
+
+	// Write new segments in parallel. Skip the last segment since we will not
+	// compact it.
 	// TODO: Join segments that are below the bytes limit.
-	for _, seg := range segments[:len(segments)-1] {
-		cleaned, msgsRemoved, err := c.cleanSegment(seg, keyOffsets, hw, epochCache)
-		if err != nil {
-			return nil, nil, 0, err
+	group.SetLimit(c.MaxGoroutines)
+	for i, seg := range toClean {
+		if results[i] != nil {
+			continue
 		}
-		if cleaned != nil {
-			compacted = append(compacted, cleaned)
+		group.Go(func() error {
+			size := seg.Position()
+			result, err := c.cleanSegment(seg, keyOffsets, hw)
+			if err != nil {
+				return err
+			}
+			results[i] = result
+			if result.segment != nil {
+				size -= result.segment.Position()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			status.SegmentsProcessed++
+			status.BytesReclaimed += size
+			status.KeysCompacted += int64(result.removed)
+			status.TombstonesDropped += int64(result.tombstones)
+			if progress != nil {
+				progress(status)
+			}
+			if c.CompactionProgress != nil {
+				c.CompactionProgress(status.SegmentsProcessed, rewrites)
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, nil, status, err
+	}
+
+	// Merge the compacted segments in base-offset order. Each segment only
+	// knows where leader epochs start within it, so the start offset of each
+	// new leader epoch is determined across segments here.
+	for _, result := range results {
+		if result.segment != nil {
+			compacted = append(compacted, result.segment)
+		}
+		for _, start := range result.epochs {
+			if start.epoch > epochCache.LastLeaderEpoch() {
+				if err := epochCache.Assign(start.epoch, start.offset); err != nil {
+					return nil, nil, status, err
+				}
+			}
 		}
-		removed += msgsRemoved
 	}
 
 	// Add the last segment back in to the compacted list.
@@ -115,32 +244,42 @@ func (c *compactCleaner) compact(hw int64, segments []*segment) ([]*segment,
 	// Maintain start offset for each new leader epoch for the last segment.
 	ss := newSegmentScanner(last)
 	for ms, _, err := ss.Scan(); err == nil; ms, _, err = ss.Scan() {
+		c.recordScan(ms)
 		leaderEpoch := ms.LeaderEpoch()
 		if leaderEpoch > epochCache.LastLeaderEpoch() {
 			if err := epochCache.Assign(leaderEpoch, ms.Offset()); err != nil {
-				return nil, nil, 0, err
+				return nil, nil, status, err
 			}
 		}
 	}
 
-	return compacted, epochCache, removed, nil
+	return compacted, epochCache, status, nil
 }
 
-func (c *compactCleaner) cleanSegment(seg *segment, keyOffsets *sync.Map, hw int64,
-	epochCache *leaderEpochCache) (*segment, int, error) {
+// cleanSegment rewrites the segment such that it retains only the latest
+// message for each key up to the HW, dropping it if it's an expired tombstone.
+// It's safe to clean multiple segments concurrently.
+func (c *compactCleaner) cleanSegment(seg *segment, keyOffsets *sync.Map, hw int64) (
+	*cleanedSegment, error) {
 
 	cleaned, err := seg.Cleaned()
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	var (
-		ss      = newSegmentScanner(seg)
-		removed = 0
+		ss     = newSegmentScanner(seg)
+		result = &cleanedSegment{belowHW: true}
+		now    = time.Now().UnixNano()
 	)
-	for ms, _, err := ss.Scan(); err == nil; ms, _, err = ss.Scan() {
+	for ms, indexed, err := ss.Scan(); err == nil; ms, indexed, err = ss.Scan() {
+		c.recordScan(ms)
+		m, err := c.message(ms)
+		if err != nil {
+			return nil, err
+		}
 		var (
+			key          = m.Key()
 			offset       = ms.Offset()
-			key          = ms.Message().Key()
 			leaderEpoch  = ms.LeaderEpoch()
 			latest, ok   = keyOffsets.Load(string(key))
 			latestOffset int64
@@ -149,39 +288,156 @@ func (c *compactCleaner) cleanSegment(seg *segment, keyOffsets *sync.Map, hw int
 			latestOffset = latest.(*keyOffset).get()
 		}
 
+		// Drop the last message for a key if it's an expired tombstone.
+		if key != nil && offset == latestOffset && offset < hw &&
+			c.isExpiredTombstone(m, ms.Timestamp(), now) {
+			result.tombstones++
+			continue
+		}
+
 		// Retain all messages with no keys and last message for each key.
 		// Also retain all messages after the HW.
 		if key == nil || offset == latestOffset || offset >= hw {
 			entries := entriesForMessageSet(cleaned.Position(), ms)
+			// Keep the indexed timestamp rather than the message's so that
+			// index timestamps still never decrease.
+			entries[0].Timestamp = indexed.Timestamp
 			if err := cleaned.WriteMessageSet(ms, entries); err != nil {
-				return nil, removed, err
+				return nil, err
 			}
 			// Maintain start offset for each new leader epoch.
-			if leaderEpoch > epochCache.LastLeaderEpoch() {
-				if err := epochCache.Assign(leaderEpoch, offset); err != nil {
-					return nil, removed, err
+			if n := len(result.epochs); n == 0 || leaderEpoch > result.epochs[n-1].epoch {
+				result.epochs = append(result.epochs, epochStart{epoch: leaderEpoch, offset: offset})
+			}
+			if offset >= hw {
+				result.belowHW = false
+			}
+			if key != nil {
+				if len(m.Value()) == 0 {
+					result.kept++
+				}
+				if c.BloomFilter {
+					result.keyHashes = append(result.keyHashes, hashKey(key))
 				}
 			}
 		} else {
-			removed++
+			result.removed++
 		}
 	}
 
 	if cleaned.IsEmpty() {
 		// If the new segment is empty, remove it along with the old one.
-		return nil, removed, cleanupEmptySegment(cleaned, seg)
+		return result, cleanupEmptySegment(cleaned, seg)
 	}
 	// Otherwise replace the old segment with the compacted one.
 	if err = cleaned.Replace(seg); err != nil {
-		return nil, removed, err
+		return nil, err
+	}
+	result.segment = cleaned
+	if c.BloomFilter && result.belowHW {
+		c.writeBloom(result)
+	}
+	return result, nil
+}
+
+// writeBloom writes the bloom filter sidecar file for the compacted segment.
+// Since the filter is only an optimization, failures are logged and the
+// segment is compacted in full the next time.
+func (c *compactCleaner) writeBloom(result *cleanedSegment) {
+	seg := result.segment
+	bloom := &segmentBloom{
+		position:   seg.Position(),
+		lastOffset: seg.LastOffset(),
+		tombstones: result.kept,
+		epochs:     result.epochs,
+		filter:     newBloomFilter(result.keyHashes),
+	}
+	if err := writeSegmentBloom(seg.bloomPath(), bloom); err != nil {
+		c.Logger.Warnf("Failed to write bloom filter for segment with base offset %d of log %s: %v",
+			seg.BaseOffset, c.Name, err)
 	}
-	return cleaned, removed, nil
 }
 
-func (c *compactCleaner) scanKeys(hw int64, segments []*segment) *sync.Map {
+// loadBlooms returns the bloom filters of the segments which can be skipped
+// during compaction, or nil for the ones which need to be compacted. Only
+// segments compacted while BloomFilter was set, and whose messages all
+// preceded the HW, have a bloom filter. Each key they retained was the latest
+// one in the log at the time, so they only need to be compacted again if a
+// later message may have one of their keys. Since a retained key can't appear
+// in more than one of these segments, scanning the keys of the segments
+// without a bloom filter is enough to find the affected ones. A false positive
+// only causes a segment to be compacted needlessly. Segments with tombstones
+// are always compacted so the tombstones are dropped once they expire.
+func (c *compactCleaner) loadBlooms(hw int64, segments []*segment) []*segmentBloom {
+	blooms := make([]*segmentBloom, len(segments))
+	if !c.BloomFilter {
+		return blooms
+	}
+	for i, seg := range segments {
+		if seg.LastOffset() >= hw {
+			continue
+		}
+		bloom, err := readSegmentBloom(seg.bloomPath())
+		if err != nil {
+			if !os.IsNotExist(err) {
+				c.Logger.Warnf("Failed to read bloom filter for segment with base offset %d of log %s: %v",
+					seg.BaseOffset, c.Name, err)
+			}
+			continue
+		}
+		if !bloom.matches(seg) || (bloom.tombstones > 0 && c.TombstoneTTL >= 0) {
+			continue
+		}
+		blooms[i] = bloom
+	}
+	return blooms
+}
+
+// scanKeys returns the latest offset of each key in the segments up to the
+// HW. Segments with a bloom filter are only scanned if it may contain one of
+// the keys found in the other segments, in which case their bloom filter is
+// cleared so they're compacted.
+func (c *compactCleaner) scanKeys(hw int64, segments []*segment, blooms []*segmentBloom) *sync.Map {
+	var (
+		keyOffsets = new(sync.Map)
+		unfiltered = make([]*segment, 0, len(segments))
+		filtered   []*segment
+	)
+	for i, seg := range segments {
+		if i < len(blooms) && blooms[i] != nil {
+			continue
+		}
+		unfiltered = append(unfiltered, seg)
+	}
+	c.scanSegmentKeys(hw, unfiltered, keyOffsets)
+	if len(unfiltered) == len(segments) {
+		return keyOffsets
+	}
+
+	var keyHashes []uint64
+	keyOffsets.Range(func(key, _ interface{}) bool {
+		keyHashes = append(keyHashes, hashKey([]byte(key.(string))))
+		return true
+	})
+	for i, bloom := range blooms {
+		if bloom == nil {
+			continue
+		}
+		for _, hash := range keyHashes {
+			if bloom.filter.mayContain(hash) {
+				filtered = append(filtered, segments[i])
+				blooms[i] = nil
+				break
+			}
+		}
+	}
+	c.scanSegmentKeys(hw, filtered, keyOffsets)
+	return keyOffsets
+}
+
+func (c *compactCleaner) scanSegmentKeys(hw int64, segments []*segment, keyOffsets *sync.Map) {
 	var (
 		wg            sync.WaitGroup
-		keyOffsets    = new(sync.Map)
 		numGoroutines = c.MaxGoroutines
 		segmentC      = make(chan *segment, len(segments))
 	)
@@ -200,7 +456,6 @@ func (c *compactCleaner) scanKeys(hw int64, segments []*segment) *sync.Map {
 	close(segmentC)
 
 	wg.Wait()
-	return keyOffsets
 }
 
 func (c *compactCleaner) scanSegments(hw int64, ch <-chan *segment, wg *sync.WaitGroup, keyOffsets *sync.Map) {
@@ -208,12 +463,21 @@ LOOP:
 	for seg := range ch {
 		ss := newSegmentScanner(seg)
 		for ms, _, err := ss.Scan(); err == nil; ms, _, err = ss.Scan() {
+			c.recordScan(ms)
 			offset := ms.Offset()
 			if offset > hw {
 				break LOOP
 			}
+			m, err := c.message(ms)
+			if err != nil {
+				// cleanSegment fails on the same message, which aborts
+				// compaction, so there's nothing to record.
+				c.Logger.Errorf("Failed to read key of message at offset %d in log %s: %v",
+					offset, c.Name, err)
+				continue
+			}
 			curr, loaded := keyOffsets.LoadOrStore(
-				string(ms.Message().Key()), &keyOffset{offset: offset})
+				string(m.Key()), &keyOffset{offset: offset})
 			if loaded {
 				curr.(*keyOffset).set(offset)
 			}
@@ -3,8 +3,13 @@ package commitlog
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
+	"path/filepath"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -23,7 +28,7 @@ type expectedMsg struct {
 func TestCompactCleanerNoSegments(t *testing.T) {
 	opts := compactCleanerOptions{Name: "foo", Logger: noopLogger()}
 	cleaner := newCompactCleaner(opts)
-	segments, epochCache, err := cleaner.Compact(0, nil)
+	segments, epochCache, err := cleaner.Compact(0, nil, nil)
 	require.NoError(t, err)
 	require.Nil(t, segments)
 	require.Nil(t, epochCache)
@@ -37,7 +42,7 @@ func TestCompactCleanerOneSegment(t *testing.T) {
 	defer remove(t, dir)
 
 	expected := []*segment{createSegment(t, dir, 0, 100)}
-	actual, epochCache, err := cleaner.Compact(0, expected)
+	actual, epochCache, err := cleaner.Compact(0, expected, nil)
 	require.NoError(t, err)
 	require.Equal(t, expected, actual)
 	require.Nil(t, epochCache)
@@ -237,6 +242,99 @@ func TestCompactCleanerTruncateConcurrent(t *testing.T) {
 	require.Equal(t, int64(-1), l.OldestOffset())
 }
 
+// compactedMsg is a message read from a compacted segment.
+type compactedMsg struct {
+	offset      int64
+	leaderEpoch uint64
+	key         string
+	value       string
+}
+
+// compactSegments writes the same messages spanning several segments and
+// leader epochs to a new log, compacts it with the given number of goroutines,
+// and returns the compacted messages, the resulting leader epoch offsets, and
+// the reported progress.
+func compactSegments(t *testing.T, maxGoroutines int) ([]compactedMsg, []epochOffset, [][2]int) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 256,
+	})
+	defer cleanup()
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		msg := &Message{
+			Value:       []byte(strconv.Itoa(i)),
+			LeaderEpoch: uint64(i/60 + 1),
+		}
+		// Leave some messages without a key.
+		if i%7 != 0 {
+			msg.Key = []byte(fmt.Sprintf("key-%d", rng.Intn(20)))
+		}
+		_, err := l.Append([]*Message{msg})
+		require.NoError(t, err)
+	}
+
+	var (
+		mu       sync.Mutex
+		progress [][2]int
+		cleaner  = newCompactCleaner(compactCleanerOptions{
+			Name:          "foo",
+			Logger:        noopLogger(),
+			MaxGoroutines: maxGoroutines,
+			CompactionProgress: func(processed, total int) {
+				mu.Lock()
+				progress = append(progress, [2]int{processed, total})
+				mu.Unlock()
+			},
+		})
+	)
+	segments, epochCache, err := cleaner.Compact(400, l.segments, nil)
+	require.NoError(t, err)
+
+	var msgs []compactedMsg
+	for _, seg := range segments {
+		ss := newSegmentScanner(seg)
+		for ms, _, err := ss.Scan(); err == nil; ms, _, err = ss.Scan() {
+			m := ms.Message()
+			msgs = append(msgs, compactedMsg{
+				offset:      ms.Offset(),
+				leaderEpoch: ms.LeaderEpoch(),
+				key:         string(m.Key()),
+				value:       string(m.Value()),
+			})
+		}
+	}
+	epochs := make([]epochOffset, len(epochCache.epochOffsets))
+	for i, epoch := range epochCache.epochOffsets {
+		epochs[i] = *epoch
+	}
+	return msgs, epochs, progress
+}
+
+// Ensure compacting segments in parallel produces the same segments and leader
+// epoch offsets as compacting them sequentially and reports progress for each
+// segment.
+func TestCompactCleanerParallel(t *testing.T) {
+	expectedMsgs, expectedEpochs, progress := compactSegments(t, 1)
+	require.NotEmpty(t, expectedMsgs)
+	require.Len(t, expectedEpochs, 9)
+	require.NotEmpty(t, progress)
+	total := progress[0][1]
+	require.Greater(t, total, 4)
+	for i, p := range progress {
+		require.Equal(t, [2]int{i + 1, total}, p)
+	}
+
+	for _, maxGoroutines := range []int{2, 4, 16} {
+		msgs, epochs, progress := compactSegments(t, maxGoroutines)
+		require.Equal(t, expectedMsgs, msgs)
+		require.Equal(t, expectedEpochs, epochs)
+		require.Len(t, progress, total)
+		require.Equal(t, [2]int{total, total}, progress[total-1])
+	}
+}
+
 func BenchmarkClean1GBSegments(b *testing.B) {
 	benchmarkClean(b, 1024*1024*1024)
 }
@@ -297,6 +395,253 @@ func benchmarkClean(b *testing.B, segmentSize int64) {
 	}
 }
 
+// BenchmarkCompactParallel compares compacting a log of 100 segments with
+// 10K messages each sequentially and with 4 goroutines.
+func BenchmarkCompactParallel(b *testing.B) {
+	for _, maxGoroutines := range []int{1, 4} {
+		b.Run(fmt.Sprintf("goroutines=%d", maxGoroutines), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				// Each append goes to a new segment since the active segment
+				// is always full.
+				opts := Options{
+					Path:                 tempDir(b),
+					MaxSegmentBytes:      1,
+					Compact:              true,
+					CompactMaxGoroutines: maxGoroutines,
+				}
+				l, cleanup := setupWithOptions(b, opts)
+
+				value := make([]byte, 64)
+				for j := 0; j < 100; j++ {
+					msgs := make([]*Message, 10000)
+					for k := range msgs {
+						msgs[k] = &Message{
+							Key:   []byte(strconv.Itoa(rand.Intn(1000))),
+							Value: value,
+						}
+					}
+					offsets, err := l.Append(msgs)
+					require.NoError(b, err)
+					l.SetHighWatermark(offsets[len(offsets)-1])
+				}
+
+				b.StartTimer()
+				require.NoError(b, l.Clean())
+				b.StopTimer()
+				cleanup()
+			}
+		})
+	}
+}
+
+// Ensure Compact drops a tombstone, along with the messages it deletes, once
+// it's older than the TombstoneTTL and retains it until then.
+func TestCompactCleanerTombstoneTTL(t *testing.T) {
+	tests := []struct {
+		name    string
+		ttl     time.Duration
+		dropped bool
+	}{
+		{"zero", 0, true},
+		{"not elapsed", time.Hour, false},
+		{"indefinite", -1, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := Options{
+				Path:            tempDir(t),
+				MaxSegmentBytes: 100,
+				Compact:         true,
+				TombstoneTTL:    test.ttl,
+			}
+			l, cleanup := setupWithOptions(t, opts)
+			defer cleanup()
+
+			appendTombstone(t, l)
+			dropped := cleanTombstones(t, l)
+			keys := compactedKeys(l)
+			if test.dropped {
+				require.Equal(t, int64(1), dropped)
+				require.NotContains(t, keys, "foo")
+			} else {
+				require.Equal(t, int64(0), dropped)
+				require.Equal(t, 1, keys["foo"])
+			}
+			require.Equal(t, 1, keys["bar"])
+		})
+	}
+}
+
+// Ensure Compact retains a tombstone until its TTL elapses.
+func TestCompactCleanerTombstoneTTLElapsed(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 100,
+		Compact:         true,
+		TombstoneTTL:    100 * time.Millisecond,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+
+	appendTombstone(t, l)
+	require.Equal(t, int64(0), cleanTombstones(t, l))
+	require.Equal(t, 1, compactedKeys(l)["foo"])
+
+	time.Sleep(opts.TombstoneTTL)
+	require.Equal(t, int64(1), cleanTombstones(t, l))
+	require.NotContains(t, compactedKeys(l), "foo")
+}
+
+// Ensure a key which is published, tombstoned, and compacted twice disappears
+// from a full log read once the tombstone's TTL elapses, and that the leader
+// epoch cache starts each epoch at its first retained message.
+func TestCompactCleanerTombstoneDeleted(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 100,
+		Compact:         true,
+		TombstoneTTL:    100 * time.Millisecond,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+
+	// The tombstone is the first message of leader epoch 2.
+	entries := []struct {
+		keyValue
+		leaderEpoch uint64
+	}{
+		{keyValue{[]byte("foo"), []byte("first")}, 1},
+		{keyValue{[]byte("bar"), []byte("first")}, 1},
+		{keyValue{[]byte("foo"), []byte("second")}, 1},
+		{keyValue{[]byte("foo"), nil}, 2},
+		{keyValue{[]byte("baz"), []byte("first")}, 2},
+		{keyValue{[]byte("qux"), []byte("first")}, 2},
+		{keyValue{[]byte("baz"), []byte("third")}, 3},
+		{keyValue{[]byte("baz"), []byte("fourth")}, 3},
+		{keyValue{[]byte("baz"), []byte("fifth")}, 3},
+	}
+	for _, entry := range entries {
+		offsets, err := l.Append([]*Message{{
+			Key:         entry.key,
+			Value:       entry.value,
+			Timestamp:   time.Now().UnixNano(),
+			LeaderEpoch: entry.leaderEpoch,
+		}})
+		require.NoError(t, err)
+		l.SetHighWatermark(offsets[0])
+	}
+
+	// The first compaction removes the earlier values but retains the
+	// tombstone.
+	require.NoError(t, l.Clean())
+	msgs := readAll(t, l)
+	foo := msgs[:0:0]
+	for _, msg := range msgs {
+		if msg.key == "foo" {
+			foo = append(foo, msg)
+		}
+	}
+	require.Equal(t, []compactedMsg{{offset: 3, leaderEpoch: 2, key: "foo"}}, foo)
+	requireEpochStarts(t, l, msgs)
+
+	// The second compaction removes the tombstone once its TTL elapses.
+	time.Sleep(opts.TombstoneTTL)
+	require.NoError(t, l.Clean())
+	msgs = readAll(t, l)
+	for _, msg := range msgs {
+		require.NotEqual(t, "foo", msg.key)
+	}
+	require.Equal(t, compactedMsg{offset: 1, leaderEpoch: 1, key: "bar", value: "first"}, msgs[0])
+	requireEpochStarts(t, l, msgs)
+	require.Equal(t, epochOffset{leaderEpoch: 2, startOffset: 5}, *l.leaderEpochCache.epochOffsets[1])
+}
+
+// readAll reads every message in the log.
+func readAll(t *testing.T, l *commitLog) []compactedMsg {
+	r, err := l.NewReader(0, true)
+	require.NoError(t, err)
+	var (
+		msgs    []compactedMsg
+		headers = make([]byte, 28)
+		newest  = l.NewestOffset()
+	)
+	for offset := int64(-1); offset < newest; {
+		msg, o, _, leaderEpoch, err := r.ReadMessage(context.Background(), headers)
+		require.NoError(t, err)
+		offset = o
+		msgs = append(msgs, compactedMsg{
+			offset:      offset,
+			leaderEpoch: leaderEpoch,
+			key:         string(msg.Key()),
+			value:       string(msg.Value()),
+		})
+	}
+	return msgs
+}
+
+// requireEpochStarts asserts the leader epoch cache starts each leader epoch
+// at the first of the given messages in it.
+func requireEpochStarts(t *testing.T, l *commitLog, msgs []compactedMsg) {
+	var expected []epochOffset
+	for _, msg := range msgs {
+		if n := len(expected); n == 0 || msg.leaderEpoch > expected[n-1].leaderEpoch {
+			expected = append(expected, epochOffset{leaderEpoch: msg.leaderEpoch, startOffset: msg.offset})
+		}
+	}
+	actual := make([]epochOffset, len(l.leaderEpochCache.epochOffsets))
+	for i, epoch := range l.leaderEpochCache.epochOffsets {
+		actual[i] = *epoch
+	}
+	require.Equal(t, expected, actual)
+}
+
+// appendTombstone appends a message for the key foo followed by a tombstone
+// for it and enough other messages for the tombstone to be compacted.
+func appendTombstone(t *testing.T, l *commitLog) {
+	entries := []keyValue{
+		{[]byte("foo"), []byte("first")},
+		{[]byte("bar"), []byte("first")},
+		{[]byte("foo"), nil},
+	}
+	for i := 0; i < 5; i++ {
+		entries = append(entries, keyValue{[]byte("baz"), []byte(strconv.Itoa(i))})
+	}
+	for _, entry := range entries {
+		offsets, err := l.Append([]*Message{{
+			Key:       entry.key,
+			Value:     entry.value,
+			Timestamp: time.Now().UnixNano(),
+		}})
+		require.NoError(t, err)
+		l.SetHighWatermark(offsets[0])
+	}
+}
+
+// cleanTombstones forces a compaction and returns the number of tombstones it
+// dropped.
+func cleanTombstones(t *testing.T, l *commitLog) int64 {
+	var dropped int64
+	require.NoError(t, l.CleanWithProgress(func(progress CompactionProgress) {
+		dropped = progress.TombstonesDropped
+	}))
+	return dropped
+}
+
+// compactedKeys returns the number of messages for each key in the log.
+func compactedKeys(l *commitLog) map[string]int {
+	keys := make(map[string]int)
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, seg := range l.segments {
+		ss := newSegmentScanner(seg)
+		for ms, _, err := ss.Scan(); err == nil; ms, _, err = ss.Scan() {
+			keys[string(ms.Message().Key())]++
+		}
+	}
+	return keys
+}
+
 func appendToLog(t *testing.T, l *commitLog, entries []keyValue, commit bool) {
 	for _, entry := range entries {
 		msg := &Message{
@@ -310,3 +655,120 @@ func appendToLog(t *testing.T, l *commitLog, entries []keyValue, commit bool) {
 		}
 	}
 }
+
+// keyedRound returns n messages with keys drawn from the given number of keys
+// with the prefix, leaving some of them without a key.
+func keyedRound(rng *rand.Rand, prefix string, n, keys int) []keyValue {
+	entries := make([]keyValue, n)
+	for i := range entries {
+		entries[i].value = []byte(strconv.Itoa(i))
+		if i%7 != 0 {
+			entries[i].key = []byte(fmt.Sprintf("%s-%d", prefix, rng.Intn(keys)))
+		}
+	}
+	return entries
+}
+
+// compactRounds appends each round of messages to a new log with the given
+// options, in a new leader epoch, and compacts the log after each one. It
+// calls beforeClean, if set, before each compaction and returns the messages
+// in the log along with the progress last reported by each compaction.
+func compactRounds(t *testing.T, opts Options, rounds [][]keyValue,
+	beforeClean func(*commitLog)) ([]compactedMsg, []CompactionProgress) {
+
+	opts.Path = tempDir(t)
+	opts.MaxSegmentBytes = 256
+	opts.Compact = true
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+
+	var progress []CompactionProgress
+	for i, entries := range rounds {
+		for _, entry := range entries {
+			offsets, err := l.Append([]*Message{{
+				Key:         entry.key,
+				Value:       entry.value,
+				LeaderEpoch: uint64(i + 1),
+			}})
+			require.NoError(t, err)
+			l.SetHighWatermark(offsets[0])
+		}
+		if beforeClean != nil {
+			beforeClean(l)
+		}
+		var last CompactionProgress
+		require.NoError(t, l.CleanWithProgress(func(p CompactionProgress) {
+			last = p
+		}))
+		progress = append(progress, last)
+	}
+	msgs := readAll(t, l)
+	requireEpochStarts(t, l, msgs)
+	return msgs, progress
+}
+
+// bloomRounds returns rounds of messages where the second one only has new
+// keys and the third one updates keys from the first.
+func bloomRounds() [][]keyValue {
+	rng := rand.New(rand.NewSource(1))
+	return [][]keyValue{
+		keyedRound(rng, "foo", 200, 50),
+		keyedRound(rng, "bar", 200, 50),
+		keyedRound(rng, "foo", 20, 50),
+	}
+}
+
+// Ensure compacting with bloom filters produces the same log as compacting
+// without them while skipping the segments which only have keys with no later
+// messages.
+func TestCompactCleanerBloomFilter(t *testing.T) {
+	expected, progress := compactRounds(t, Options{}, bloomRounds(), nil)
+	for _, p := range progress {
+		require.Zero(t, p.SegmentsSkipped)
+	}
+
+	var blooms []int
+	msgs, progress := compactRounds(t, Options{CompactBloomFilter: true}, bloomRounds(),
+		func(l *commitLog) {
+			files, err := filepath.Glob(filepath.Join(l.Path, "*"+bloomSuffix))
+			require.NoError(t, err)
+			blooms = append(blooms, len(files))
+		})
+	require.Equal(t, expected, msgs)
+
+	// The segments compacted in the first round are skipped in the second
+	// one, while some of them are compacted again in the third one since it
+	// updates their keys.
+	require.Equal(t, 0, blooms[0])
+	require.Greater(t, blooms[1], 0)
+	require.Zero(t, progress[0].SegmentsSkipped)
+	require.Greater(t, progress[1].SegmentsSkipped, 0)
+	require.LessOrEqual(t, progress[1].SegmentsSkipped, blooms[1])
+	require.Greater(t, progress[2].SegmentsProcessed, 0)
+	require.Less(t, progress[2].SegmentsSkipped, blooms[2])
+}
+
+// Ensure false positives from bloom filters only cause segments to be
+// compacted needlessly.
+func TestCompactCleanerBloomFilterFalsePositives(t *testing.T) {
+	expected, _ := compactRounds(t, Options{}, bloomRounds(), nil)
+
+	// Set every bit of the bloom filters so they may contain any key.
+	msgs, progress := compactRounds(t, Options{CompactBloomFilter: true}, bloomRounds(),
+		func(l *commitLog) {
+			files, err := filepath.Glob(filepath.Join(l.Path, "*"+bloomSuffix))
+			require.NoError(t, err)
+			for _, file := range files {
+				bloom, err := readSegmentBloom(file)
+				require.NoError(t, err)
+				for i := range bloom.filter.bits {
+					bloom.filter.bits[i] = math.MaxUint64
+				}
+				require.NoError(t, writeSegmentBloom(file, bloom))
+			}
+		})
+	require.Equal(t, expected, msgs)
+	for _, p := range progress {
+		require.Zero(t, p.SegmentsSkipped)
+	}
+}
@@ -1,6 +1,7 @@
 package commitlog
 
 import (
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -23,23 +24,51 @@ type deleteCleanerOptions struct {
 	}
 	Logger logger.Logger
 	Name   string
+	Cipher *logCipher // Decrypts messages to read their TTLs, optional
+}
+
+// validate returns an error if any of the retention limits are negative.
+func (o deleteCleanerOptions) validate() error {
+	if o.Retention.Bytes < 0 {
+		return errors.Errorf("invalid retention bytes %d", o.Retention.Bytes)
+	}
+	if o.Retention.Messages < 0 {
+		return errors.Errorf("invalid retention messages %d", o.Retention.Messages)
+	}
+	if o.Retention.Age < 0 {
+		return errors.Errorf("invalid retention age %s", o.Retention.Age)
+	}
+	return nil
 }
 
 // deleteCleaner implements the delete cleanup policy which deletes old log
 // segments based on the retention policy.
 type deleteCleaner struct {
+	mu    sync.RWMutex
+	floor func() int64 // Returns the last offset which can be deleted, optional
 	deleteCleanerOptions
 }
 
 // newDeleteCleaner returns a new cleaner which enforces log retention
 // policies by deleting segments.
 func newDeleteCleaner(opts deleteCleanerOptions) *deleteCleaner {
-	return &deleteCleaner{opts}
+	return &deleteCleaner{deleteCleanerOptions: opts}
+}
+
+// setOptions replaces the cleaner's options. If a clean is in progress, this
+// blocks until it completes, and the new options are used by the next one.
+func (c *deleteCleaner) setOptions(opts deleteCleanerOptions) {
+	c.mu.Lock()
+	c.deleteCleanerOptions = opts
+	c.mu.Unlock()
 }
 
 // Clean will enforce the log retention policy by deleting old segments.
 // Deletion only occurs at the segment granularity.
 func (c *deleteCleaner) Clean(segments []*segment) ([]*segment, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	var err error
 	if len(segments) == 0 || c.noRetentionLimits() {
 		return segments, nil
@@ -80,112 +109,194 @@ func (c *deleteCleaner) noRetentionLimits() bool {
 }
 
 func (c *deleteCleaner) applyMessagesLimit(segments []*segment) ([]*segment, error) {
+	n, err := c.messagesLimitIndex(segments)
+	if err != nil {
+		return nil, err
+	}
+	return c.deleteOldest(segments, n)
+}
+
+// messagesLimitIndex returns the number of oldest segments which exceed the
+// messages retention limit.
+func (c *deleteCleaner) messagesLimitIndex(segments []*segment) (int, error) {
 	// We must retain at least the active segment.
 	if len(segments) <= 1 {
-		return segments, nil
+		return 0, nil
 	}
 
 	// We start at the most recent segment and work our way backwards until we
 	// meet the retention size.
-	var (
-		lastSeg         = segments[len(segments)-1]
-		cleanedSegments = []*segment{lastSeg}
-		totalMessages   = lastSeg.MessageCount()
-	)
-
-	var i int
-	for i = len(segments) - 2; i > -1; i-- {
-		s := segments[i]
-		totalMessages += s.MessageCount()
-		if totalMessages > c.Retention.Messages {
-			break
-		}
-		cleanedSegments = append([]*segment{s}, cleanedSegments...)
-	}
-	if i > -1 {
-		// Collect segments to delete
-		toDelete := make([]*segment, 0, i+1)
-		for ; i > -1; i-- {
-			toDelete = append(toDelete, segments[i])
+	var totalMessages int64
+	for i := len(segments) - 1; i > -1; i-- {
+		if err := segments[i].summarize(); err != nil {
+			return 0, err
 		}
-		// Delete segments using mark-then-delete for consistency
-		if err := c.deleteSegments(toDelete); err != nil {
-			return nil, err
+		totalMessages += segments[i].MessageCount()
+		if i < len(segments)-1 && totalMessages > c.Retention.Messages {
+			return i + 1, nil
 		}
 	}
-
-	return cleanedSegments, nil
+	return 0, nil
 }
 
 func (c *deleteCleaner) applyBytesLimit(segments []*segment) ([]*segment, error) {
+	n, err := c.bytesLimitIndex(segments)
+	if err != nil {
+		return nil, err
+	}
+	return c.deleteOldest(segments, n)
+}
+
+// bytesLimitIndex returns the number of oldest segments which exceed the bytes
+// retention limit.
+func (c *deleteCleaner) bytesLimitIndex(segments []*segment) (int, error) {
 	// We must retain at least the active segment.
 	if len(segments) <= 1 {
-		return segments, nil
+		return 0, nil
 	}
 
 	// We start at the most recent segment and work our way backwards until we
 	// meet the retention size.
-	var (
-		lastSeg         = segments[len(segments)-1]
-		cleanedSegments = []*segment{lastSeg}
-		totalBytes      = lastSeg.Position()
-	)
-
-	var i int
-	for i = len(segments) - 2; i > -1; i-- {
-		s := segments[i]
-		totalBytes += s.Position()
-		if totalBytes > c.Retention.Bytes {
-			break
-		}
-		cleanedSegments = append([]*segment{s}, cleanedSegments...)
-	}
-	if i > -1 {
-		// Collect segments to delete
-		toDelete := make([]*segment, 0, i+1)
-		for ; i > -1; i-- {
-			toDelete = append(toDelete, segments[i])
+	var totalBytes int64
+	for i := len(segments) - 1; i > -1; i-- {
+		if err := segments[i].summarize(); err != nil {
+			return 0, err
 		}
-		// Delete segments using mark-then-delete for consistency
-		if err := c.deleteSegments(toDelete); err != nil {
-			return nil, err
+		totalBytes += segments[i].Position()
+		if i < len(segments)-1 && totalBytes > c.Retention.Bytes {
+			return i + 1, nil
 		}
 	}
-
-	return cleanedSegments, nil
+	return 0, nil
 }
 
 func (c *deleteCleaner) applyAgeLimit(segments []*segment) ([]*segment, error) {
+	n, err := c.ageLimitIndex(segments)
+	if err != nil {
+		return nil, err
+	}
+	return c.deleteOldest(segments, n)
+}
+
+// ageLimitIndex returns the number of oldest segments which exceed the age
+// retention limit. Segments opened lazily are summarized rather than loaded
+// since only their last write time is needed.
+func (c *deleteCleaner) ageLimitIndex(segments []*segment) (int, error) {
 	// We must retain at least the active segment.
 	if len(segments) <= 1 {
-		return segments, nil
+		return 0, nil
 	}
 
-	var (
-		ttl      = computeTTL(c.Retention.Age)
-		idx      int
-		toDelete []*segment
-	)
-
-	// Collect all segments whose last-written timestamp is less than the TTL
+	// Count the segments whose last-written timestamp is less than the TTL
 	// with the exception of the active (last) segment.
+	ttl := computeTTL(c.Retention.Age)
 	for i, seg := range segments {
-		if i != len(segments)-1 && seg.lastWriteTime < ttl {
-			toDelete = append(toDelete, seg)
-		} else {
-			idx = i
+		if i == len(segments)-1 {
+			return i, nil
+		}
+		if err := seg.summarize(); err != nil {
+			return 0, err
+		}
+		if seg.LastWriteTime() >= ttl {
+			return i, nil
+		}
+	}
+	return 0, nil
+}
+
+// Reclaimable estimates the number of bytes a clean of the given segments
+// would reclaim under the current retention policy. Since it doesn't read the
+// segments, messages whose TTL hasn't elapsed are not taken into account. It
+// returns 0 if a segment it needs to check can't be read.
+func (c *deleteCleaner) Reclaimable(segments []*segment) int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	// Each limit deletes a run of the oldest segments, so a clean deletes the
+	// longest of them.
+	var n int
+	limits := []func([]*segment) (int, error){}
+	if c.Retention.Age > 0 {
+		limits = append(limits, c.ageLimitIndex)
+	}
+	if c.Retention.Messages > 0 {
+		limits = append(limits, c.messagesLimitIndex)
+	}
+	if c.Retention.Bytes > 0 {
+		limits = append(limits, c.bytesLimitIndex)
+	}
+	for _, limitIndex := range limits {
+		i, err := limitIndex(segments)
+		if err != nil {
+			return 0
+		}
+		if i > n {
+			n = i
+		}
+	}
+
+	n, err := c.floorIndex(segments, n)
+	if err != nil {
+		return 0
+	}
+	var bytes int64
+	for _, seg := range segments[:n] {
+		bytes += seg.Position()
+	}
+	return bytes
+}
+
+// floorIndex limits the number of oldest segments to delete to those which
+// only contain messages up to the cleaner's floor, so segments containing
+// messages past it are retained regardless of the retention limits.
+func (c *deleteCleaner) floorIndex(segments []*segment, n int) (int, error) {
+	if c.floor == nil {
+		return n, nil
+	}
+	floor := c.floor()
+	for i := 0; i < n; i++ {
+		if err := segments[i].summarize(); err != nil {
+			return 0, err
+		}
+		if segments[i].NextOffset()-1 > floor {
+			return i, nil
+		}
+	}
+	return n, nil
+}
+
+// deleteOldest deletes up to n of the oldest segments and returns the
+// remaining ones. Deletion stops at the first segment containing a message
+// past the cleaner's floor or still containing a message whose TTL hasn't
+// elapsed, so such messages outlive the retention limits, and the log stays
+// contiguous.
+func (c *deleteCleaner) deleteOldest(segments []*segment, n int) ([]*segment, error) {
+	n, err := c.floorIndex(segments, n)
+	if err != nil {
+		return nil, err
+	}
+	now := timestamp()
+	for i := 0; i < n; i++ {
+		expiresAt, err := segments[i].latestExpiration(c.Cipher)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read message TTLs in segment %d",
+				segments[i].BaseOffset)
+		}
+		if expiresAt >= now {
+			n = i
 			break
 		}
 	}
+	if n == 0 {
+		return segments, nil
+	}
 
 	// Delete segments using mark-then-delete for consistency
-	if len(toDelete) > 0 {
-		if err := c.deleteSegments(toDelete); err != nil {
-			return nil, err
-		}
+	if err := c.deleteSegments(segments[:n]); err != nil {
+		return nil, err
 	}
 
-	return segments[idx:], nil
+	return segments[n:], nil
 }
 
 // deleteSegments deletes the given segments using a mark-then-delete approach.
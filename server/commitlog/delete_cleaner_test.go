@@ -1,6 +1,7 @@
 package commitlog
 
 import (
+	"strconv"
 	"testing"
 	"time"
 
@@ -184,6 +185,33 @@ func TestDeleteCleanerBytesMessages(t *testing.T) {
 	}
 }
 
+// Ensure Reclaimable estimates the bytes of the segments Clean deletes under
+// the strictest retention limit without deleting them.
+func TestDeleteCleanerReclaimable(t *testing.T) {
+	opts := deleteCleanerOptions{Name: "foo", Logger: noopLogger()}
+	cleaner := newDeleteCleaner(opts)
+	dir := tempDir(t)
+	defer remove(t, dir)
+
+	segs := make([]*segment, 20)
+	for i := 0; i < 20; i++ {
+		segs[i] = createSegment(t, dir, int64(i), 20)
+		writeToSegment(t, segs[i], int64(i), []byte("blah"))
+	}
+	size := segs[0].Position()
+	require.Equal(t, int64(0), cleaner.Reclaimable(segs))
+
+	opts.Retention.Messages = 15
+	opts.Retention.Bytes = 240
+	cleaner.setOptions(opts)
+	require.Equal(t, 15*size, cleaner.Reclaimable(segs))
+
+	actual, err := cleaner.Clean(segs)
+	require.NoError(t, err)
+	require.Len(t, actual, 5)
+	require.Equal(t, int64(0), cleaner.Reclaimable(actual))
+}
+
 // Ensure Clean deletes segments to maintain the message age limit.
 func TestDeleteCleanerAge(t *testing.T) {
 	computeTTLBefore := computeTTL
@@ -424,3 +452,38 @@ func TestDeleteSegmentsPartialFailure(t *testing.T) {
 	// No error expected in this case since files exist and are deletable
 	require.NoError(t, err)
 }
+
+// Ensure Clean doesn't delete segments which still contain messages whose TTL
+// hasn't elapsed, nor any segments after them.
+func TestDeleteCleanerRetainsUnexpiredMessages(t *testing.T) {
+	opts := deleteCleanerOptions{Name: "foo", Logger: noopLogger()}
+	opts.Retention.Messages = 1
+	cleaner := newDeleteCleaner(opts)
+	dir := tempDir(t)
+	defer remove(t, dir)
+
+	now := time.Now().UnixNano()
+	ttls := []string{"", strconv.Itoa(int(time.Millisecond)), strconv.Itoa(int(time.Hour)), "", ""}
+	segs := make([]*segment, len(ttls))
+	for i, ttl := range ttls {
+		segs[i] = createSegment(t, dir, int64(i), 1024)
+		msg := &Message{Timestamp: now - int64(time.Second), Value: []byte("blah")}
+		if ttl != "" {
+			msg.Headers = map[string][]byte{TTLHeader: []byte(ttl)}
+		}
+		ms, entries, err := newMessageSetFromProto(int64(i), 0, []*Message{msg}, false)
+		require.NoError(t, err)
+		require.NoError(t, segs[i].WriteMessageSet(ms, entries))
+	}
+
+	// The first two segments can be deleted since the second one's message
+	// has expired, but the third one's message hasn't.
+	actual, err := cleaner.Clean(segs)
+	require.NoError(t, err)
+	require.Len(t, actual, 3)
+	for i := 0; i < 3; i++ {
+		require.Equal(t, int64(i+2), actual[i].BaseOffset)
+	}
+	require.True(t, segs[1].IsDeleted())
+	require.False(t, segs[2].IsDeleted())
+}
@@ -0,0 +1,224 @@
+package commitlog
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"hash/crc32"
+	"sync"
+
+	"github.com/google/tink/go/kwp/subtle"
+	"github.com/pkg/errors"
+)
+
+const (
+	// encryptedMagicByte is the magic byte of a message whose contents are
+	// encrypted. Plaintext messages use a magic byte of 0 or 1, so this lets
+	// encrypted and plaintext messages coexist in a log, e.g. if encryption is
+	// enabled on a log which already has data.
+	encryptedMagicByte int8 = -1
+
+	// encryptedHeaderLen is the size of the CRC, magic byte, attributes, and
+	// wrapped key length which precede the wrapped key in an encrypted
+	// message.
+	encryptedHeaderLen = 7
+
+	// dataKeyLen is the length of the AES-256 data keys used to encrypt
+	// messages.
+	dataKeyLen = 32
+
+	// maxCachedDataKeys bounds the number of unwrapped data keys kept for
+	// decryption.
+	maxCachedDataKeys = 1024
+)
+
+// ErrDecryptionFailed is returned when an encrypted message cannot be
+// decrypted, either because it was encrypted with a different master key or
+// because it was tampered with.
+var ErrDecryptionFailed = errors.New("failed to decrypt message")
+
+// dataKey is a data key used to encrypt the messages appended to a segment.
+type dataKey struct {
+	wrapped []byte
+	aead    cipher.AEAD
+}
+
+// logCipher encrypts and decrypts message payloads using AES-GCM. Each
+// segment has its own data key which is wrapped by the master key and stored
+// alongside each message the data key encrypted. Since the messages are
+// self-describing, they can be replicated to and read by any server with the
+// same master key regardless of how its segments are laid out.
+//
+// An encrypted message is framed like a plaintext one so that its CRC can be
+// checked without decrypting it:
+//
+// | bytes 0-3 |   byte 4   |   byte 5   |  byte 6  | n bytes     | nonce | ciphertext and tag |
+// |-----------|------------|------------|----------|-------------|-------|--------------------|
+// |    CRC    | magic (-1) | attributes | key size | wrapped key | nonce | ciphertext and tag |
+//
+// The message offset is used as additional authenticated data so that an
+// encrypted message cannot be moved to a different offset.
+type logCipher struct {
+	kwp  *subtle.KWP
+	mu   sync.RWMutex
+	keys map[string]cipher.AEAD // Unwrapped data keys by wrapped key
+}
+
+// newLogCipher returns a logCipher which wraps data keys with the given master
+// key, which must be 16 or 32 bytes.
+func newLogCipher(masterKey []byte) (*logCipher, error) {
+	kwp, err := subtle.NewKWP(masterKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid encryption key")
+	}
+	return &logCipher{kwp: kwp, keys: make(map[string]cipher.AEAD)}, nil
+}
+
+// newDataKey generates a new data key and wraps it with the master key.
+func (c *logCipher) newDataKey() (*dataKey, error) {
+	key := make([]byte, dataKeyLen)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := c.kwp.Wrap(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) > 255 {
+		return nil, errors.New("wrapped data key is too large")
+	}
+	c.cacheKey(wrapped, aead)
+	return &dataKey{wrapped: wrapped, aead: aead}, nil
+}
+
+// encryptMessageSet returns a message set with the payload of each message in
+// the given message set encrypted with the data key, along with the index
+// entries for it starting at basePos. Message set headers, i.e. the offset,
+// timestamp, leader epoch, and size, are left in plaintext.
+func (c *logCipher) encryptMessageSet(key *dataKey, ms messageSet, basePos int64) (
+	messageSet, []*entry, error) {
+
+	encrypted := make(messageSet, 0, len(ms))
+	for len(ms) > 0 {
+		var (
+			size    = ms.Size()
+			payload = ms[msgSetHeaderLen : msgSetHeaderLen+size]
+		)
+		m, err := c.encrypt(key, ms.Offset(), payload)
+		if err != nil {
+			return nil, nil, err
+		}
+		header := len(encrypted)
+		encrypted = append(encrypted, ms[:msgSetHeaderLen]...)
+		encoding.PutUint32(encrypted[header+sizePos:], uint32(len(m)))
+		encrypted = append(encrypted, m...)
+		ms = ms[msgSetHeaderLen+size:]
+	}
+	return encrypted, entriesForMessageSet(basePos, encrypted), nil
+}
+
+// encrypt returns the encrypted form of the message at the given offset.
+func (c *logCipher) encrypt(key *dataKey, offset int64, m []byte) (SerializedMessage, error) {
+	var (
+		nonceSize = key.aead.NonceSize()
+		nonceEnd  = encryptedHeaderLen + len(key.wrapped) + nonceSize
+		buf       = make([]byte, nonceEnd, nonceEnd+len(m)+key.aead.Overhead())
+	)
+	buf[4] = 0xff // encryptedMagicByte
+	buf[6] = byte(len(key.wrapped))
+	copy(buf[encryptedHeaderLen:], key.wrapped)
+	nonce := buf[nonceEnd-nonceSize : nonceEnd]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	buf = key.aead.Seal(buf, nonce, m, offsetAAD(offset))
+	encoding.PutUint32(buf, crc32.Checksum(buf[4:], crc32cTable))
+	return buf, nil
+}
+
+// decrypt returns the plaintext form of the message at the given offset. If
+// the message is not encrypted, it's returned as is.
+func (c *logCipher) decrypt(offset int64, m SerializedMessage) (SerializedMessage, error) {
+	if !isEncrypted(m) {
+		return m, nil
+	}
+	if len(m) < encryptedHeaderLen {
+		return nil, ErrDecryptionFailed
+	}
+	keyEnd := encryptedHeaderLen + int(m[6])
+	if len(m) < keyEnd {
+		return nil, ErrDecryptionFailed
+	}
+	aead, err := c.unwrap(m[encryptedHeaderLen:keyEnd])
+	if err != nil {
+		return nil, err
+	}
+	nonceEnd := keyEnd + aead.NonceSize()
+	if len(m) < nonceEnd {
+		return nil, ErrDecryptionFailed
+	}
+	plaintext, err := aead.Open(nil, m[keyEnd:nonceEnd], m[nonceEnd:], offsetAAD(offset))
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	return plaintext, nil
+}
+
+// unwrap returns the AEAD for the given wrapped data key.
+func (c *logCipher) unwrap(wrapped []byte) (cipher.AEAD, error) {
+	c.mu.RLock()
+	aead, ok := c.keys[string(wrapped)]
+	c.mu.RUnlock()
+	if ok {
+		return aead, nil
+	}
+	key, err := c.kwp.Unwrap(wrapped)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	aead, err = newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	c.cacheKey(wrapped, aead)
+	return aead, nil
+}
+
+func (c *logCipher) cacheKey(wrapped []byte, aead cipher.AEAD) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.keys) >= maxCachedDataKeys {
+		c.keys = make(map[string]cipher.AEAD)
+	}
+	c.keys[string(wrapped)] = aead
+}
+
+// isEncrypted indicates if the message was encrypted by a logCipher.
+func isEncrypted(m SerializedMessage) bool {
+	return len(m) > 4 && m.MagicByte() == encryptedMagicByte
+}
+
+// Encrypted indicates if the message's contents are encrypted at rest, such
+// as a message read from a message set replicated from an encrypted log, in
+// which case its key, value, and headers can't be read.
+func (m SerializedMessage) Encrypted() bool {
+	return isEncrypted(m)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func offsetAAD(offset int64) []byte {
+	aad := make([]byte, 8)
+	encoding.PutUint64(aad, uint64(offset))
+	return aad
+}
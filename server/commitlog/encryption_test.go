@@ -0,0 +1,290 @@
+package commitlog
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+var encryptionKey = []byte("0123456789abcdef0123456789abcdef")
+
+func encryptedOptions(t *testing.T, segmentSize int64) Options {
+	return Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: segmentSize,
+		Encrypt:         true,
+		EncryptionKey:   encryptionKey,
+	}
+}
+
+// Ensure New returns an error if encryption is enabled without a key or with
+// an invalid one.
+func TestNewCommitLogEncryptionKey(t *testing.T) {
+	dir := tempDir(t)
+	defer remove(t, dir)
+
+	_, err := New(Options{Path: dir, Encrypt: true})
+	require.Error(t, err)
+
+	_, err = New(Options{Path: dir, Encrypt: true, EncryptionKey: []byte("foo")})
+	require.Error(t, err)
+}
+
+// Ensure messages appended to an encrypted log are stored encrypted and are
+// decrypted when read, including after the log is recovered.
+func TestEncryptedCommitLogRecover(t *testing.T) {
+	for _, test := range segmentSizeTests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := encryptedOptions(t, test.segmentSize)
+			l, cleanup := setupWithOptions(t, opts)
+			defer cleanup()
+
+			numMsgs := 10
+			msgs := make([]*Message, numMsgs)
+			for i := 0; i < numMsgs; i++ {
+				msgs[i] = &Message{
+					Key:         []byte("key-" + strconv.Itoa(i)),
+					Value:       []byte("secret-" + strconv.Itoa(i)),
+					Timestamp:   int64(i),
+					LeaderEpoch: 1,
+					Headers:     headers,
+				}
+			}
+			for _, msg := range msgs {
+				_, err := l.Append([]*Message{msg})
+				require.NoError(t, err)
+			}
+			require.NoError(t, l.Close())
+
+			// Neither keys nor values should appear in the segments.
+			files, err := filepath.Glob(filepath.Join(opts.Path, "*"+logSuffix))
+			require.NoError(t, err)
+			require.NotEmpty(t, files)
+			for _, file := range files {
+				data, err := os.ReadFile(file)
+				require.NoError(t, err)
+				require.False(t, bytes.Contains(data, []byte("secret-")))
+				require.False(t, bytes.Contains(data, []byte("key-")))
+			}
+
+			// Reopen the log and ensure the messages are read back, with the
+			// index and offsets recovered from the plaintext headers.
+			l, cleanup = setupWithOptions(t, opts)
+			defer cleanup()
+			require.Equal(t, int64(numMsgs-1), l.NewestOffset())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			r, err := l.NewReader(0, true)
+			require.NoError(t, err)
+			headersBuf := make([]byte, 28)
+			for i, exp := range msgs {
+				msg, offset, timestamp, leaderEpoch, err := r.ReadMessage(ctx, headersBuf)
+				require.NoError(t, err)
+				compareMessages(t, exp, msg)
+				require.Equal(t, []byte("bar"), msg.Headers()["foo"])
+				require.Equal(t, int64(i), offset)
+				require.Equal(t, exp.Timestamp, timestamp)
+				require.Equal(t, exp.LeaderEpoch, leaderEpoch)
+				require.Equal(t, uint32(len(msg)), encoding.Uint32(headersBuf[sizePos:]))
+			}
+
+			// Reverse reads are decrypted as well.
+			rr, err := l.NewReverseReaderFromEnd(true)
+			require.NoError(t, err)
+			for i := numMsgs - 1; i >= 0; i-- {
+				msg, offset, _, _, err := rr.ReadMessage(ctx, headersBuf)
+				require.NoError(t, err)
+				require.Equal(t, int64(i), offset)
+				compareMessages(t, msgs[i], msg)
+			}
+		})
+	}
+}
+
+// Ensure reading an encrypted log with a different master key fails rather
+// than returning ciphertext.
+func TestEncryptedCommitLogWrongKey(t *testing.T) {
+	opts := encryptedOptions(t, 100)
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+	_, err := l.Append([]*Message{{Value: []byte("secret")}})
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+
+	opts.EncryptionKey = []byte("fedcba9876543210fedcba9876543210")
+	l, cleanup = setupWithOptions(t, opts)
+	defer cleanup()
+
+	r, err := l.NewReader(0, true)
+	require.NoError(t, err)
+	_, _, _, _, err = r.ReadMessage(context.Background(), make([]byte, 28))
+	require.Error(t, err)
+	require.Equal(t, ErrDecryptionFailed, errors.Cause(err))
+}
+
+// Ensure encrypted and plaintext messages can be read from the same log, e.g.
+// when encryption is enabled on an existing log.
+func TestEncryptedCommitLogMixed(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 1024,
+		EncryptionKey:   encryptionKey,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+	_, err := l.Append([]*Message{{Value: []byte("plaintext")}})
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+
+	opts.Encrypt = true
+	l, cleanup = setupWithOptions(t, opts)
+	defer cleanup()
+	_, err = l.Append([]*Message{{Value: []byte("ciphertext")}})
+	require.NoError(t, err)
+
+	r, err := l.NewReader(0, true)
+	require.NoError(t, err)
+	headersBuf := make([]byte, 28)
+	msg, _, _, _, err := r.ReadMessage(context.Background(), headersBuf)
+	require.NoError(t, err)
+	require.Equal(t, []byte("plaintext"), msg.Value())
+	msg, _, _, _, err = r.ReadMessage(context.Background(), headersBuf)
+	require.NoError(t, err)
+	require.Equal(t, []byte("ciphertext"), msg.Value())
+}
+
+// Ensure Truncate works on encrypted segments and messages before the
+// truncation point remain readable.
+func TestEncryptedTruncate(t *testing.T) {
+	l, cleanup := setupWithOptions(t, encryptedOptions(t, 200))
+	defer cleanup()
+
+	for i := 0; i < 10; i++ {
+		_, err := l.Append([]*Message{{
+			Value:       []byte(strconv.Itoa(i)),
+			LeaderEpoch: uint64(i/5 + 1),
+		}})
+		require.NoError(t, err)
+	}
+	require.Greater(t, len(l.Segments()), 1)
+
+	require.NoError(t, l.Truncate(7))
+	require.Equal(t, int64(6), l.NewestOffset())
+	require.Equal(t, uint64(2), l.LastLeaderEpoch())
+
+	// Appends after truncation are readable along with the retained messages.
+	_, err := l.Append([]*Message{{Value: []byte("7"), LeaderEpoch: 3}})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r, err := l.NewReader(0, true)
+	require.NoError(t, err)
+	headersBuf := make([]byte, 28)
+	for i := 0; i < 8; i++ {
+		msg, offset, _, _, err := r.ReadMessage(ctx, headersBuf)
+		require.NoError(t, err)
+		require.Equal(t, int64(i), offset)
+		require.Equal(t, []byte(strconv.Itoa(i)), msg.Value())
+	}
+}
+
+// Ensure compaction retains only the latest message for each key in
+// encrypted segments and that the retained messages stay encrypted.
+func TestEncryptedCompactCleaner(t *testing.T) {
+	opts := encryptedOptions(t, 100)
+	opts.Compact = true
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+
+	entries := []keyValue{
+		{[]byte("foo"), []byte("first")},
+		{[]byte("bar"), []byte("first")},
+		{[]byte("foo"), []byte("second")},
+		{[]byte("foo"), []byte("third")},
+		{[]byte("bar"), []byte("second")},
+		{[]byte("baz"), []byte("first")},
+		{[]byte("baz"), []byte("second")},
+		{[]byte("qux"), []byte("first")},
+		{[]byte("foo"), []byte("fourth")},
+		{[]byte("baz"), []byte("third")},
+	}
+	appendToLog(t, l, entries, true)
+
+	// Force a compaction.
+	require.NoError(t, l.Clean())
+
+	expected := []*expectedMsg{
+		{Offset: 4, Msg: &Message{Key: []byte("bar"), Value: []byte("second")}},
+		{Offset: 7, Msg: &Message{Key: []byte("qux"), Value: []byte("first")}},
+		{Offset: 8, Msg: &Message{Key: []byte("foo"), Value: []byte("fourth")}},
+		// This one is present because it's in the active segment.
+		{Offset: 9, Msg: &Message{Key: []byte("baz"), Value: []byte("third")}},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r, err := l.NewReader(0, true)
+	require.NoError(t, err)
+	headersBuf := make([]byte, 28)
+	for _, exp := range expected {
+		raw, offset, _, _, err := r.ReadRawMessage(ctx, headersBuf)
+		require.NoError(t, err)
+		require.Equal(t, exp.Offset, offset)
+		require.True(t, isEncrypted(raw))
+		msg, err := l.cipher.decrypt(offset, raw)
+		require.NoError(t, err)
+		compareMessages(t, exp.Msg, msg)
+	}
+}
+
+// Ensure raw messages read from an encrypted log can be appended to another
+// log with the same master key, as is done by replication, without being
+// re-encrypted.
+func TestEncryptedReplication(t *testing.T) {
+	leader, cleanupLeader := setupWithOptions(t, encryptedOptions(t, 100))
+	defer cleanupLeader()
+	// The follower doesn't encrypt new messages itself but needs the key to
+	// read the replicated ones.
+	followerOpts := encryptedOptions(t, 100)
+	followerOpts.Encrypt = false
+	follower, cleanupFollower := setupWithOptions(t, followerOpts)
+	defer cleanupFollower()
+
+	_, err := leader.Append(msgs)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r, err := leader.NewReader(0, true)
+	require.NoError(t, err)
+	headersBuf := make([]byte, 28)
+	for i := range msgs {
+		raw, offset, _, _, err := r.ReadRawMessage(ctx, headersBuf)
+		require.NoError(t, err)
+		require.Equal(t, int64(i), offset)
+		require.True(t, isEncrypted(raw))
+		set := append(append([]byte{}, headersBuf...), raw...)
+		offsets, err := follower.AppendMessageSet(set)
+		require.NoError(t, err)
+		require.Equal(t, []int64{offset}, offsets)
+	}
+
+	r, err = follower.NewReader(0, true)
+	require.NoError(t, err)
+	for i, exp := range msgs {
+		raw, _, _, _, err := r.ReadRawMessage(ctx, headersBuf)
+		require.NoError(t, err)
+		require.True(t, isEncrypted(raw))
+		msg, err := follower.cipher.decrypt(int64(i), raw)
+		require.NoError(t, err)
+		compareMessages(t, exp, msg)
+	}
+}
@@ -8,7 +8,9 @@ type CommitLog interface {
 
 	// NewReader creates a new Reader starting at the given offset. If
 	// uncommitted is true, the Reader will read uncommitted messages from the
-	// log. Otherwise, it will only return committed messages.
+	// log. Otherwise, it will only return committed messages. An
+	// OffsetOutOfRangeError is returned if the offset is before the log
+	// start offset advanced by DeleteBefore.
 	NewReader(offset int64, uncommitted bool) (*Reader, error)
 
 	// NewReverseReader creates a new ReverseReader starting at the given
@@ -20,14 +22,80 @@ type CommitLog interface {
 	// Truncate removes all messages from the log starting at the given offset.
 	Truncate(offset int64) error
 
+	// TruncateAndStartAt removes all messages from the log and starts it at
+	// the given offset, so the next message appended is assigned that offset.
+	TruncateAndStartAt(offset int64) error
+
 	// NewestOffset returns the offset of the last message in the log or -1 if
 	// empty.
 	NewestOffset() int64
 
 	// OldestOffset returns the offset of the first message in the log or -1 if
-	// empty.
+	// empty. Messages before the log start offset are not included.
 	OldestOffset() int64
 
+	// LogStartOffset returns the base offset of the log's first segment or,
+	// if it's after it, the offset messages were deleted before with
+	// DeleteBefore. This is the offset the log starts at even if it's empty.
+	LogStartOffset() int64
+
+	// DeleteBefore advances the log start offset to the given offset,
+	// deleting the segments before it and hiding the messages before it in
+	// the segment containing it. If the offset is past the newest offset,
+	// all messages are removed and the log starts at the offset. The log
+	// start offset is checkpointed to disk.
+	DeleteBefore(offset int64) error
+
+	// Size returns the number of bytes in the log across all segments. This
+	// does not include the size of the segment indexes.
+	Size() int64
+
+	// SegmentCount returns the number of segments in the log.
+	SegmentCount() int
+
+	// SegmentsTotal returns the number of segments in the log, whether or
+	// not they're loaded. It's the same as SegmentCount and is provided
+	// alongside SegmentsLoaded so the two can be reported together.
+	SegmentsTotal() int
+
+	// SegmentsLoaded returns the number of segments in the log whose files
+	// are open. This is less than SegmentsTotal if the log was opened with
+	// the LazyLoad option and some of its segments haven't been accessed
+	// since.
+	SegmentsLoaded() int
+
+	// Roll rolls out a new log segment regardless of the active segment's
+	// size and age unless it's empty. The returned bool indicates if a
+	// segment was rolled.
+	Roll() (bool, error)
+
+	// SegmentRolls returns the number of segments rolled out for the given
+	// reason since the log was opened.
+	SegmentRolls(reason RollReason) int64
+
+	// SetRetention updates the retention limits enforced by the log using the
+	// MaxLogBytes, MaxLogMessages, and MaxLogAge settings from the given
+	// Options. It returns an error without applying them if any are invalid.
+	// The new limits are applied the next time the log is cleaned.
+	SetRetention(opts Options) error
+
+	// SetRetentionFloor sets an offset the retention limits never delete
+	// past, in addition to the HW. Passing math.MaxInt64 removes the floor.
+	SetRetentionFloor(offset int64)
+
+	// RetentionFloor returns the last offset the retention limits can delete
+	// up to, which is the lesser of the HW and the floor set with
+	// SetRetentionFloor.
+	RetentionFloor() int64
+
+	// UpdateOptions updates the settings of the open log using the
+	// MaxSegmentBytes, MaxSegmentAge, MaxLogBytes, MaxLogMessages,
+	// MaxLogAge, and Compact settings from the given Options. It returns an
+	// error without applying them if any are invalid. Retention limits and
+	// compaction are applied the next time the log is cleaned, while segment
+	// limits only apply to segments rolled afterwards.
+	UpdateOptions(opts Options) error
+
 	// EarliestOffsetAfterTimestamp returns the earliest offset whose timestamp
 	// is greater than or equal to the given timestamp.
 	EarliestOffsetAfterTimestamp(timestamp int64) (int64, error)
@@ -48,6 +116,12 @@ type CommitLog interface {
 	// HighWatermark returns the high watermark for the log.
 	HighWatermark() int64
 
+	// Observe registers the function to be called with the changes made to
+	// the log. It's called synchronously once each change has been made, so
+	// it must not block. The returned function must be called to unregister
+	// it.
+	Observe(fn func(LogChange)) func()
+
 	// NewLeaderEpoch indicates the log is entering a new leader epoch.
 	NewLeaderEpoch(epoch uint64) error
 
@@ -74,6 +148,28 @@ type CommitLog interface {
 	// applicable.
 	Clean() error
 
+	// CleanWithProgress is like Clean but calls progress each time compaction
+	// finishes rewriting a segment. Only one clean runs at a time, so this
+	// waits for a clean in progress to finish first.
+	CleanWithProgress(progress func(CompactionProgress)) error
+
+	// CleanWithSummary is like Clean but returns a summary of the segments
+	// and bytes the clean removed.
+	CleanWithSummary() (CleanSummary, error)
+
+	// EnforceRetention applies the retention rules against the log without
+	// compacting it and returns the number of bytes reclaimed.
+	EnforceRetention() (int64, error)
+
+	// MergeSmallSegments coalesces runs of consecutive sealed segments whose
+	// combined size is less than targetBytes into a single segment each.
+	// Messages keep their offsets.
+	MergeSmallSegments(targetBytes int64) error
+
+	// ReclaimableBytes estimates the number of bytes the retention rules
+	// would reclaim from the log if it were cleaned now.
+	ReclaimableBytes() int64
+
 	// NotifyLEO registers and returns a channel which is closed when messages
 	// past the given log end offset are added to the log. If the given offset
 	// is no longer the log end offset, the channel is closed immediately.
@@ -92,9 +188,53 @@ type CommitLog interface {
 	// another log.
 	SetReadonly(readonly bool)
 
+	// SetReadonlyAt marks the log as readonly once its newest offset reaches
+	// the given offset, so that producers can be drained gracefully. Messages
+	// can be added with Append until then. If the newest offset is already at
+	// or past the target, the log becomes readonly immediately. SetReadonly
+	// clears a pending target.
+	SetReadonlyAt(offset int64)
+
+	// ReadonlyTarget returns the offset the log becomes readonly at once
+	// reached. The bool returned indicates if there is a pending target.
+	ReadonlyTarget() (int64, bool)
+
 	// IsReadonly indicates if the log is in readonly mode.
 	IsReadonly() bool
 
+	// BytesAppended returns the number of message bytes appended to the log
+	// since it was opened, not including message set headers. This is
+	// comparable to the size of the messages returned by readers.
+	BytesAppended() int64
+
+	// ColdSegmentOpens returns the number of times a reader started reading
+	// from a segment other than the active one, which approximates how often
+	// reads miss the page cache.
+	ColdSegmentOpens() int64
+
+	// SealedSegments returns the segments which are no longer written to,
+	// i.e. every segment but the active one, oldest first. Empty segments
+	// are skipped.
+	SealedSegments() []SegmentInfo
+
+	// Snapshot returns the sealed segments following the given offset, i.e.
+	// those a follower whose log ends at the offset is missing, for direct
+	// transfer if they add up to at least minBytes. It returns nil if they
+	// don't or if they can't be transferred now. Retention and compaction
+	// leave the segments alone until the snapshot is released.
+	Snapshot(after, minBytes int64) *Snapshot
+
+	// NewSnapshotWriter returns a SnapshotWriter staging the files of a
+	// snapshot transferred from another log until they're installed at the
+	// end of this one.
+	NewSnapshotWriter() (*SnapshotWriter, error)
+
+	// Upgrade makes a log opened with the ReadOnly option writable and
+	// starts cleaning it and checkpointing its HW as if it had been opened
+	// normally. This lets a log opened to read a paused partition be handed
+	// over when the partition is resumed instead of opening it again.
+	Upgrade() error
+
 	// IsConcurrencyControlEnabled indicates if the log should check for concurrency before appending messages
 	IsConcurrencyControlEnabled() bool
 
@@ -102,3 +242,30 @@ type CommitLog interface {
 	// checkpointing the high watermark to disk.
 	Close() error
 }
+
+// SegmentInfo describes a log segment.
+type SegmentInfo struct {
+	BaseOffset int64
+	LastOffset int64
+	Size       int64  // Size of the segment's log file in bytes
+	Path       string // Path of the segment's log file
+}
+
+// Allocation sites reported to an AllocationRecorder.
+const (
+	// AllocSiteMessageSet is reported when a message set is built for Append.
+	AllocSiteMessageSet = "message_set"
+
+	// AllocSiteCompaction is reported when a message is read from a segment
+	// during log compaction.
+	AllocSiteCompaction = "compaction"
+)
+
+// AllocationRecorder receives accounting of the bytes a log allocates at its
+// major allocation sites. It is called on hot paths, so implementations
+// should be cheap, e.g. by sampling, and must be safe for concurrent use.
+type AllocationRecorder interface {
+	// RecordAllocation records that the given number of bytes were allocated
+	// at the given site.
+	RecordAllocation(site string, bytes int)
+}
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/dustin/go-humanize/english"
@@ -36,6 +37,7 @@ type leaderEpochCache struct {
 	checkpointFile string
 	name           string
 	log            logger.Logger
+	corrupt        bool // Set if the checkpoint file couldn't be read completely
 }
 
 func newLeaderEpochCacheNoFile(name string, log logger.Logger) *leaderEpochCache {
@@ -46,28 +48,39 @@ func newLeaderEpochCacheNoFile(name string, log logger.Logger) *leaderEpochCache
 	}
 }
 
-func newLeaderEpochCache(name, path string, log logger.Logger) (*leaderEpochCache, error) {
-	var (
-		file   = filepath.Join(path, leaderEpochFileName)
-		epochs = []*epochOffset{}
-	)
-	if _, err := os.Stat(file); err == nil {
-		// Load epoch offsets from file.
-		f, err := os.Open(file)
-		if err != nil {
-			return nil, pkgErrors.Wrap(err, "failed to open leader epoch offsets file")
-		}
-		epochs, err = readLeaderEpochOffsets(f)
-		if err != nil {
-			return nil, pkgErrors.Wrap(err, "failed to read leader epoch offsets file")
-		}
-	}
-	return &leaderEpochCache{
-		epochOffsets:   epochs,
-		checkpointFile: file,
+// newLeaderEpochCache returns a leaderEpochCache checkpointed to a file in the
+// given directory, loading the epoch offsets from it if it exists. A file which
+// can't be read completely doesn't fail the load. Instead, whatever could be
+// read is loaded and the cache is marked corrupt so the log rebuilds it.
+func newLeaderEpochCache(name, path string, log logger.Logger) *leaderEpochCache {
+	l := &leaderEpochCache{
+		epochOffsets:   []*epochOffset{},
+		checkpointFile: filepath.Join(path, leaderEpochFileName),
 		log:            log,
 		name:           name,
-	}, nil
+	}
+	f, err := os.Open(l.checkpointFile)
+	if os.IsNotExist(err) {
+		return l
+	}
+	if err != nil {
+		log.Warnf("Failed to open leader epoch offsets file for log %s: %v", name, err)
+		l.corrupt = true
+		return l
+	}
+	defer f.Close()
+	epochs, problems, err := readLeaderEpochOffsets(f)
+	if err != nil {
+		log.Warnf("Failed to read leader epoch offsets file for log %s: %v", name, err)
+		l.corrupt = true
+		return l
+	}
+	for _, problem := range problems {
+		log.Warnf("Skipped malformed entry in leader epoch offsets file for log %s: %s", name, problem)
+	}
+	l.epochOffsets = epochs
+	l.corrupt = len(problems) > 0
+	return l
 }
 
 // Assign the given leader epoch to the given offset. Once assigned, an epoch
@@ -197,6 +210,52 @@ func (l *leaderEpochCache) Replace(from *leaderEpochCache) error {
 	return l.flush()
 }
 
+// HasEpochAt indicates if the cache agrees that the message at the given
+// offset is in the given leader epoch. Since the log enters a new leader epoch
+// at its newest offset, the message at the start offset of an epoch may also
+// be in one of the previous epochs.
+func (l *leaderEpochCache) HasEpochAt(offset int64, epoch uint64) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	i := sort.Search(len(l.epochOffsets), func(i int) bool {
+		return l.epochOffsets[i].startOffset > offset
+	}) - 1
+	for ; i >= 0 && l.epochOffsets[i].startOffset == offset; i-- {
+		if l.epochOffsets[i].leaderEpoch == epoch {
+			return true
+		}
+	}
+	if i < 0 {
+		return epoch == 0
+	}
+	return l.epochOffsets[i].leaderEpoch == epoch
+}
+
+// EpochOffsetsBetween returns the leader epoch offsets of the messages from
+// the start offset up to and including the end offset. The first is the epoch
+// the message at the start offset is in, which may have started before it.
+func (l *leaderEpochCache) EpochOffsetsBetween(start, end int64) []EpochOffset {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	i := sort.Search(len(l.epochOffsets), func(i int) bool {
+		return l.epochOffsets[i].startOffset > start
+	}) - 1
+	if i < 0 {
+		i = 0
+	}
+	var epochs []EpochOffset
+	for _, epoch := range l.epochOffsets[i:] {
+		if epoch.startOffset > end {
+			break
+		}
+		epochs = append(epochs, EpochOffset{
+			LeaderEpoch: epoch.leaderEpoch,
+			StartOffset: epoch.startOffset,
+		})
+	}
+	return epochs
+}
+
 func (l *leaderEpochCache) earliestOffset() int64 {
 	if len(l.epochOffsets) == 0 {
 		return -1
@@ -303,60 +362,88 @@ func (l *leaderEpochCache) epochChangeMsg(newEpoch, lastEpoch uint64, newOffset,
 // leader_epoch start_offset
 // leader_epoch start_offset
 // ...
-func readLeaderEpochOffsets(file io.Reader) ([]*epochOffset, error) {
+//
+// An error is only returned if the header can't be read. Malformed entries,
+// such as a line cut short by a torn write or an entry whose leader epoch or
+// start offset is less than the previous one's, are skipped, and they are
+// described along with a wrong number of entries in the returned problems.
+func readLeaderEpochOffsets(file io.Reader) ([]*epochOffset, []string, error) {
 	scanner := bufio.NewScanner(file)
-	scanner.Split(bufio.ScanWords)
 	if !scanner.Scan() {
-		return nil, errors.New("missing version")
+		return nil, nil, errors.New("missing version")
 	}
-	version, err := strconv.Atoi(scanner.Text())
+	version, err := strconv.Atoi(strings.TrimSpace(scanner.Text()))
 	if err != nil {
-		return nil, pkgErrors.Wrap(err, "invalid file version value")
+		return nil, nil, pkgErrors.Wrap(err, "invalid file version value")
 	}
 	if version > leaderEpochFileV0 {
-		return nil, fmt.Errorf("unknown version: %d", version)
+		return nil, nil, fmt.Errorf("unknown version: %d", version)
 	}
 	if !scanner.Scan() {
-		return nil, errors.New("missing number of entries")
+		return nil, nil, errors.New("missing number of entries")
 	}
-	numEntries, err := strconv.Atoi(scanner.Text())
+	numEntries, err := strconv.Atoi(strings.TrimSpace(scanner.Text()))
 	if err != nil {
-		return nil, pkgErrors.Wrap(err, "invalid entries count value")
+		return nil, nil, pkgErrors.Wrap(err, "invalid entries count value")
 	}
 
 	var (
-		epochs       = make(map[uint64]int64)
 		epochOffsets = []*epochOffset{}
+		problems     []string
+		line         = 2
 	)
-
 	for scanner.Scan() {
-		leaderEpoch, err := strconv.ParseInt(scanner.Text(), 10, 64)
-		if err != nil {
-			return nil, pkgErrors.Wrap(err, "invalid leader epoch value")
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
 		}
-		if !scanner.Scan() {
-			return nil, errors.New("missing start offset for epoch")
+		epoch, err := parseEpochOffset(fields)
+		if err == nil && len(epochOffsets) > 0 {
+			last := epochOffsets[len(epochOffsets)-1]
+			if epoch.leaderEpoch <= last.leaderEpoch {
+				err = fmt.Errorf("leader epoch %d is not greater than previous leader epoch %d",
+					epoch.leaderEpoch, last.leaderEpoch)
+			} else if epoch.startOffset < last.startOffset {
+				err = fmt.Errorf("start offset %d is less than previous start offset %d",
+					epoch.startOffset, last.startOffset)
+			}
 		}
-		startOffset, err := strconv.ParseInt(scanner.Text(), 10, 64)
 		if err != nil {
-			return nil, pkgErrors.Wrap(err, "invalid epoch start offset value")
+			problems = append(problems, fmt.Sprintf("line %d: %v", line, err))
+			continue
 		}
-
-		if _, ok := epochs[uint64(leaderEpoch)]; ok {
-			// Duplicate entry.
-			return nil, fmt.Errorf("duplicate leader epoch %d", leaderEpoch)
-		}
-		epochs[uint64(leaderEpoch)] = startOffset
-		epochOffsets = append(epochOffsets, &epochOffset{
-			leaderEpoch: uint64(leaderEpoch),
-			startOffset: startOffset,
-		})
+		epochOffsets = append(epochOffsets, epoch)
+	}
+	if err := scanner.Err(); err != nil {
+		problems = append(problems, fmt.Sprintf("line %d: %v", line+1, err))
 	}
 
 	if numEntries != len(epochOffsets) {
-		return nil, fmt.Errorf("expected %s, got %d",
-			english.Plural(numEntries, "entry", ""), len(epochOffsets))
+		problems = append(problems, fmt.Sprintf("expected %s, got %d",
+			english.Plural(numEntries, "entry", ""), len(epochOffsets)))
 	}
 
-	return epochOffsets, nil
+	return epochOffsets, problems, nil
+}
+
+// parseEpochOffset parses the fields of an entry of the leader epoch
+// checkpoint file.
+func parseEpochOffset(fields []string) (*epochOffset, error) {
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("expected leader epoch and start offset, got %d %s",
+			len(fields), english.PluralWord(len(fields), "value", ""))
+	}
+	leaderEpoch, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "invalid leader epoch value")
+	}
+	startOffset, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return nil, pkgErrors.Wrap(err, "invalid epoch start offset value")
+	}
+	if startOffset < -1 {
+		return nil, fmt.Errorf("invalid epoch start offset value %d", startOffset)
+	}
+	return &epochOffset{leaderEpoch: leaderEpoch, startOffset: startOffset}, nil
 }
@@ -3,6 +3,7 @@ package commitlog
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -13,8 +14,7 @@ func TestLeaderEpochCache(t *testing.T) {
 	dir := tempDir(t)
 	defer remove(t, dir)
 
-	l, err := newLeaderEpochCache("foo", dir, noopLogger())
-	require.NoError(t, err)
+	l := newLeaderEpochCache("foo", dir, noopLogger())
 
 	require.Equal(t, int64(-1), l.LastOffsetForLeaderEpoch(0))
 	require.Equal(t, uint64(0), l.LastLeaderEpoch())
@@ -67,14 +67,12 @@ func TestLeaderEpochCacheRebase(t *testing.T) {
 	dir2 := tempDir(t)
 	defer remove(t, dir2)
 
-	l1, err := newLeaderEpochCache("foo", dir1, noopLogger())
-	require.NoError(t, err)
+	l1 := newLeaderEpochCache("foo", dir1, noopLogger())
 	require.NoError(t, l1.Assign(3, 15))
 	require.NoError(t, l1.Assign(4, 30))
 	require.NoError(t, l1.Assign(5, 40))
 
-	l2, err := newLeaderEpochCache("foo", dir2, noopLogger())
-	require.NoError(t, err)
+	l2 := newLeaderEpochCache("foo", dir2, noopLogger())
 	require.NoError(t, l2.Assign(1, 0))
 	require.NoError(t, l2.Assign(2, 10))
 
@@ -93,14 +91,12 @@ func TestLeaderEpochCacheReplace(t *testing.T) {
 	dir2 := tempDir(t)
 	defer remove(t, dir2)
 
-	l1, err := newLeaderEpochCache("foo", dir1, noopLogger())
-	require.NoError(t, err)
+	l1 := newLeaderEpochCache("foo", dir1, noopLogger())
 	require.NoError(t, l1.Assign(3, 15))
 	require.NoError(t, l1.Assign(4, 30))
 	require.NoError(t, l1.Assign(5, 40))
 
-	l2, err := newLeaderEpochCache("foo", dir2, noopLogger())
-	require.NoError(t, err)
+	l2 := newLeaderEpochCache("foo", dir2, noopLogger())
 	require.NoError(t, l2.Assign(3, 17))
 	require.NoError(t, l2.Assign(4, 33))
 
@@ -118,8 +114,7 @@ func TestReadLeaderEpochOffsets(t *testing.T) {
 	dir := tempDir(t)
 	defer remove(t, dir)
 
-	l, err := newLeaderEpochCache("foo", dir, noopLogger())
-	require.NoError(t, err)
+	l := newLeaderEpochCache("foo", dir, noopLogger())
 
 	require.NoError(t, l.Assign(1, 0))
 	require.NoError(t, l.Assign(2, 10))
@@ -138,7 +133,100 @@ func TestReadLeaderEpochOffsets(t *testing.T) {
 	f, err := os.Open(filepath.Join(dir, leaderEpochFileName))
 	require.NoError(t, err)
 
-	offsets, err := readLeaderEpochOffsets(f)
+	defer f.Close()
+
+	offsets, problems, err := readLeaderEpochOffsets(f)
 	require.NoError(t, err)
+	require.Empty(t, problems)
 	require.Equal(t, expected, offsets)
 }
+
+// Ensure readLeaderEpochOffsets skips malformed entries, reporting them, and
+// only fails if the header can't be read.
+func TestReadLeaderEpochOffsetsMalformed(t *testing.T) {
+	read := func(contents string) ([]*epochOffset, []string, error) {
+		return readLeaderEpochOffsets(strings.NewReader(contents))
+	}
+
+	for _, contents := range []string{"", "garbage", "0\n", "0\nfoo\n", "1\n0\n"} {
+		_, _, err := read(contents)
+		require.Error(t, err, contents)
+	}
+
+	// A line cut short by a torn write.
+	offsets, problems, err := read("0\n3\n1 0\n2 10\n3")
+	require.NoError(t, err)
+	require.Equal(t, []*epochOffset{{1, 0}, {2, 10}}, offsets)
+	require.Len(t, problems, 2)
+	require.Contains(t, problems[0], "line 5")
+	require.Equal(t, "expected 3 entries, got 2", problems[1])
+
+	// Garbage, duplicate epochs, and decreasing offsets are skipped.
+	offsets, problems, err = read("0\n5\n1 0\nfoo bar\n1 5\n2 10\n3 5\n-1 20\n4 -5\n3 15\n")
+	require.NoError(t, err)
+	require.Equal(t, []*epochOffset{{1, 0}, {2, 10}, {3, 15}}, offsets)
+	require.Len(t, problems, 6)
+
+	// Blank lines are ignored.
+	offsets, problems, err = read("0\n1\n\n1 0\n\n")
+	require.NoError(t, err)
+	require.Equal(t, []*epochOffset{{1, 0}}, offsets)
+	require.Empty(t, problems)
+}
+
+// Ensure loading a leader epoch checkpoint file which can't be read completely
+// marks the cache corrupt rather than failing.
+func TestLeaderEpochCacheCorruptFile(t *testing.T) {
+	dir := tempDir(t)
+	defer remove(t, dir)
+
+	l := newLeaderEpochCache("foo", dir, noopLogger())
+	require.False(t, l.corrupt)
+	require.NoError(t, l.Assign(1, 0))
+	require.NoError(t, l.Assign(2, 10))
+
+	l = newLeaderEpochCache("foo", dir, noopLogger())
+	require.False(t, l.corrupt)
+	require.Equal(t, uint64(2), l.LastLeaderEpoch())
+
+	file := filepath.Join(dir, leaderEpochFileName)
+	require.NoError(t, os.WriteFile(file, []byte("0\n2\n1 0\n2 1"), 0600))
+	l = newLeaderEpochCache("foo", dir, noopLogger())
+	require.False(t, l.corrupt)
+	require.Equal(t, int64(1), l.LastOffsetForLeaderEpoch(1))
+
+	require.NoError(t, os.WriteFile(file, []byte("0\n2\n1 0\n2"), 0600))
+	l = newLeaderEpochCache("foo", dir, noopLogger())
+	require.True(t, l.corrupt)
+	require.Equal(t, uint64(1), l.LastLeaderEpoch())
+
+	require.NoError(t, os.WriteFile(file, []byte{0xde, 0xad, 0xbe, 0xef}, 0600))
+	l = newLeaderEpochCache("foo", dir, noopLogger())
+	require.True(t, l.corrupt)
+	require.Empty(t, l.epochOffsets)
+}
+
+// Ensure HasEpochAt checks the leader epoch of an offset, allowing for the
+// message at the start offset of an epoch to be in a previous epoch.
+func TestLeaderEpochCacheHasEpochAt(t *testing.T) {
+	l := newLeaderEpochCacheNoFile("foo", noopLogger())
+	require.True(t, l.HasEpochAt(0, 0))
+	require.False(t, l.HasEpochAt(0, 1))
+
+	require.NoError(t, l.Assign(1, 0))
+	require.NoError(t, l.Assign(2, 9))
+	require.NoError(t, l.Assign(3, 9))
+	require.NoError(t, l.Assign(4, 20))
+
+	require.True(t, l.HasEpochAt(0, 1))
+	require.False(t, l.HasEpochAt(0, 2))
+	require.True(t, l.HasEpochAt(5, 1))
+	require.True(t, l.HasEpochAt(9, 1))
+	require.True(t, l.HasEpochAt(9, 2))
+	require.True(t, l.HasEpochAt(9, 3))
+	require.False(t, l.HasEpochAt(9, 4))
+	require.True(t, l.HasEpochAt(10, 3))
+	require.False(t, l.HasEpochAt(10, 2))
+	require.True(t, l.HasEpochAt(25, 4))
+	require.False(t, l.HasEpochAt(25, 5))
+}
@@ -0,0 +1,157 @@
+package commitlog
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// MergeSmallSegments coalesces runs of consecutive sealed segments whose
+// combined size is less than targetBytes into a single segment each, which
+// reduces the number of open files of logs with many small segments, e.g.
+// because of a short MaxSegmentAge. Messages keep their offsets, so each
+// merged segment keeps the base offset of the earliest segment it replaces.
+// The active segment and segments held by snapshots are never merged. Like
+// cleans, it waits for a clean in progress to finish first.
+func (l *commitLog) MergeSmallSegments(targetBytes int64) error {
+	if targetBytes <= 0 {
+		return errors.New("target bytes must be positive")
+	}
+	return l.cleanSegments(func(segments []*segment) ([]*segment, *leaderEpochCache, error) {
+		return l.mergeSegments(segments, targetBytes)
+	})
+}
+
+// mergeSegments returns the segments with each group of consecutive sealed
+// segments below targetBytes replaced by a merged segment, along with a
+// leaderEpochCache rebuilt from them, or nil if nothing was merged.
+func (l *commitLog) mergeSegments(segments []*segment, targetBytes int64) (
+	[]*segment, *leaderEpochCache, error) {
+
+	groups := groupSmallSegments(segments[:len(segments)-1], targetBytes, l.snapshotStart())
+	if len(groups) == len(segments)-1 {
+		return segments, nil, nil
+	}
+
+	var (
+		merged     = make([]*segment, 0, len(groups)+1)
+		epochCache = newLeaderEpochCacheNoFile(l.Name, l.Logger)
+	)
+	for _, group := range groups {
+		seg := group[0]
+		if len(group) > 1 {
+			var err error
+			if seg, err = mergeSegmentGroup(group); err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to merge segments starting at offset %d",
+					group[0].BaseOffset)
+			}
+			l.Logger.Debugf("Merged %d segments of log %s into segment with base offset %d",
+				len(group), l.Name, seg.BaseOffset)
+		}
+		merged = append(merged, seg)
+		if err := scanLeaderEpochs(seg, epochCache); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Epochs starting in the active segment are taken from the current cache
+	// since the active segment is still being written to and a new leader
+	// epoch may not have any messages yet.
+	active := segments[len(segments)-1]
+	if err := epochCache.Rebase(l.leaderEpochCache, active.BaseOffset); err != nil {
+		return nil, nil, err
+	}
+	return append(merged, active), epochCache, nil
+}
+
+// groupSmallSegments splits the segments into groups of consecutive segments
+// whose combined size is less than targetBytes. Segments at or after the
+// given snapshot start, if not -1, are left in groups of their own since
+// snapshots refer to their files.
+func groupSmallSegments(segments []*segment, targetBytes, snapshotStart int64) [][]*segment {
+	var (
+		groups [][]*segment
+		size   int64
+	)
+	for _, seg := range segments {
+		// Since held segments are at the end of the log, a segment which
+		// isn't held never follows one which is.
+		held := snapshotStart != -1 && seg.BaseOffset >= snapshotStart
+		if n := len(groups); n > 0 && !held && size+seg.Position() < targetBytes {
+			groups[n-1] = append(groups[n-1], seg)
+			size += seg.Position()
+			continue
+		}
+		groups = append(groups, []*segment{seg})
+		size = seg.Position()
+	}
+	return groups
+}
+
+// mergeSegmentGroup writes the messages of the segments to a new segment
+// which replaces the first of them, then deletes the others. The returned
+// segment has the base offset of the first segment.
+func mergeSegmentGroup(group []*segment) (*segment, error) {
+	first := group[0]
+	merged, err := first.Cleaned()
+	if err != nil {
+		return nil, err
+	}
+	for _, seg := range group {
+		if err := copySegment(seg, merged); err != nil {
+			merged.Delete() // nolint: errcheck
+			return nil, err
+		}
+	}
+	if err := merged.Replace(first); err != nil {
+		return nil, err
+	}
+	// Set the replaced flag on the other segments before deleting them since
+	// they may still be read.
+	for _, seg := range group[1:] {
+		seg.Lock()
+		seg.replaced = true
+		seg.Unlock()
+		if err := seg.Delete(); err != nil {
+			return nil, err
+		}
+	}
+	return merged, nil
+}
+
+// copySegment appends the messages of the segment to the given one.
+func copySegment(seg, to *segment) error {
+	ss := newSegmentScanner(seg)
+	ms, indexed, err := ss.Scan()
+	for ; err == nil; ms, indexed, err = ss.Scan() {
+		entries := entriesForMessageSet(to.Position(), ms)
+		// Keep the indexed timestamp rather than the message's so that index
+		// timestamps still never decrease.
+		entries[0].Timestamp = indexed.Timestamp
+		if err := to.WriteMessageSet(ms, entries); err != nil {
+			return err
+		}
+	}
+	if err != io.EOF {
+		return errors.Wrapf(err, "failed to scan segment with base offset %d", seg.BaseOffset)
+	}
+	return nil
+}
+
+// scanLeaderEpochs assigns the start offset of each new leader epoch in the
+// segment to the given leaderEpochCache.
+func scanLeaderEpochs(seg *segment, epochCache *leaderEpochCache) error {
+	ss := newSegmentScanner(seg)
+	ms, _, err := ss.Scan()
+	for ; err == nil; ms, _, err = ss.Scan() {
+		if leaderEpoch := ms.LeaderEpoch(); leaderEpoch > epochCache.LastLeaderEpoch() {
+			if err := epochCache.Assign(leaderEpoch, ms.Offset()); err != nil {
+				return err
+			}
+		}
+	}
+	if err != io.EOF {
+		return errors.Wrapf(err, "failed to scan segment with base offset %d", seg.BaseOffset)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package commitlog
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Ensure MergeSmallSegments coalesces small sealed segments into one segment
+// which keeps the messages in offset order, the earliest base offset and the
+// leader epoch start offsets, including after the log is reopened.
+func TestMergeSmallSegments(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 1024,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+
+	// Each message fills a segment.
+	value := bytes.Repeat([]byte("a"), 1024)
+	for i := 0; i < 20; i++ {
+		_, err := l.Append([]*Message{{
+			Value:       value,
+			Timestamp:   int64(i),
+			LeaderEpoch: uint64(i/10 + 1),
+		}})
+		require.NoError(t, err)
+	}
+	// Roll the full active segment so that all 20 are sealed.
+	require.NoError(t, l.UpdateOptions(Options{MaxSegmentBytes: 100 * 1024}))
+	split, err := l.checkAndPerformSplit()
+	require.NoError(t, err)
+	require.True(t, split)
+	require.Len(t, l.SealedSegments(), 20)
+	before := readAll(t, l)
+	require.Len(t, before, 20)
+
+	require.Error(t, l.MergeSmallSegments(0))
+	require.NoError(t, l.MergeSmallSegments(100*1024))
+
+	sealed := l.SealedSegments()
+	require.Len(t, sealed, 1)
+	require.Equal(t, int64(0), sealed[0].BaseOffset)
+	require.Equal(t, int64(19), sealed[0].LastOffset)
+	require.Equal(t, 2, l.SegmentCount())
+	msgs := readAll(t, l)
+	require.Equal(t, before, msgs)
+	requireEpochStarts(t, l, msgs)
+
+	// Merging again does nothing since there's a single sealed segment.
+	require.NoError(t, l.MergeSmallSegments(100*1024))
+	require.Equal(t, 2, l.SegmentCount())
+
+	require.NoError(t, l.Close())
+	reopened, err := New(opts)
+	require.NoError(t, err)
+	defer reopened.Close()
+	require.Equal(t, 2, reopened.SegmentCount())
+	require.Equal(t, before, readAll(t, reopened.(*commitLog)))
+}
+
+// Ensure segments are only merged while their combined size is below the
+// target, and the active segment is never merged.
+func TestMergeSmallSegmentsTarget(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 1024,
+	})
+	defer cleanup()
+
+	value := bytes.Repeat([]byte("a"), 1024)
+	for i := 0; i < 10; i++ {
+		_, err := l.Append([]*Message{{Value: value, Timestamp: int64(i)}})
+		require.NoError(t, err)
+	}
+	require.Len(t, l.SealedSegments(), 9)
+	size := l.SealedSegments()[0].Size
+
+	// Groups of three segments fit below the target.
+	require.NoError(t, l.MergeSmallSegments(3*size+1))
+	sealed := l.SealedSegments()
+	require.Len(t, sealed, 3)
+	for i, info := range sealed {
+		require.Equal(t, int64(3*i), info.BaseOffset)
+		require.Equal(t, int64(3*i+2), info.LastOffset)
+	}
+	require.Equal(t, 4, l.SegmentCount())
+	require.Len(t, readAll(t, l), 10)
+}
@@ -2,13 +2,56 @@ package commitlog
 
 import (
 	"errors"
+	"fmt"
 	"hash/crc32"
+	"math"
+	"strconv"
 
 	client "github.com/liftbridge-io/liftbridge-api/v2/go"
 )
 
 var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
 
+// TTLHeader is the message header containing the message's time-to-live as a
+// decimal number of nanoseconds. A message expires once its TTL has elapsed
+// since its timestamp, after which readers skip it. Expired messages are
+// otherwise left in the log until retention removes them.
+const TTLHeader = "liftbridge-ttl"
+
+// ParseTTL parses the given TTLHeader value, which must be a positive number
+// of nanoseconds.
+func ParseTTL(value []byte) (int64, error) {
+	ttl, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil || ttl <= 0 {
+		return 0, fmt.Errorf("invalid %s header %q", TTLHeader, value)
+	}
+	return ttl, nil
+}
+
+// expiration returns the time in Unix nanoseconds at which a message with the
+// given headers and timestamp expires or -1 if it doesn't have a valid TTL.
+func expiration(headers map[string][]byte, timestamp int64) int64 {
+	value, ok := headers[TTLHeader]
+	if !ok {
+		return -1
+	}
+	ttl, err := ParseTTL(value)
+	if err != nil {
+		return -1
+	}
+	if ttl > math.MaxInt64-timestamp {
+		return math.MaxInt64
+	}
+	return timestamp + ttl
+}
+
+// Expired indicates if a message with the given headers and timestamp has
+// expired as of the given time in Unix nanoseconds.
+func Expired(headers map[string][]byte, timestamp, now int64) bool {
+	expiresAt := expiration(headers, timestamp)
+	return expiresAt >= 0 && expiresAt < now
+}
+
 // Message is the object that gets serialized and written to the log.
 type Message struct {
 	Crc        int32
@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"hash/crc32"
+	"io"
 
 	"github.com/pkg/errors"
 )
@@ -48,6 +49,79 @@ func entriesForMessageSet(basePos int64, ms []byte) []*entry {
 	return entries
 }
 
+// MessageSetMessages returns the messages in the given message set, such as one
+// received from a partition leader to replicate, in order. The messages
+// reference the message set's buffer.
+func MessageSetMessages(ms []byte) []SerializedMessage {
+	var msgs []SerializedMessage
+	for len(ms) > msgSetHeaderLen {
+		m := messageSet(ms)
+		msgs = append(msgs, m.Message())
+		ms = ms[msgSetHeaderLen+m.Size():]
+	}
+	return msgs
+}
+
+// MessageSetPayloadSize returns the total size of the messages in the given
+// message set, excluding their message set headers.
+func MessageSetPayloadSize(ms []byte) int64 {
+	var size int64
+	for len(ms) > msgSetHeaderLen {
+		m := messageSet(ms)
+		size += int64(m.Size())
+		ms = ms[msgSetHeaderLen+m.Size():]
+	}
+	return size
+}
+
+// ReadMessageSetEntry reads the next message of a message set, such as a copy
+// of a segment's log file, from the given reader. It returns the message along
+// with its message set headers, which can be appended to other entries to
+// build a message set, and its offset. It returns io.EOF once the end of the
+// message set is reached or an error if the message is corrupted.
+func ReadMessageSetEntry(r io.Reader) ([]byte, int64, error) {
+	headers := make([]byte, msgSetHeaderLen)
+	if _, err := io.ReadFull(r, headers); err != nil {
+		return nil, 0, err
+	}
+	var (
+		m     = messageSet(headers)
+		entry = make([]byte, msgSetHeaderLen+int(m.Size()))
+	)
+	copy(entry, headers)
+	if _, err := io.ReadFull(r, entry[msgSetHeaderLen:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, 0, errors.Wrapf(err, "failed to read message at offset %d", m.Offset())
+	}
+	msg := SerializedMessage(entry[msgSetHeaderLen:])
+	if crc, c := msg.Crc(), crc32.Checksum(msg[4:], crc32cTable); crc != c {
+		return nil, 0, fmt.Errorf("corrupted message at offset %d, expected CRC: 0x%08x, got: 0x%08x",
+			m.Offset(), crc, c)
+	}
+	return entry, m.Offset(), nil
+}
+
+// SetMessageSetLeaderEpoch sets the leader epoch of each message in the given
+// message set.
+func SetMessageSetLeaderEpoch(ms []byte, epoch uint64) {
+	for len(ms) > msgSetHeaderLen {
+		m := messageSet(ms)
+		encoding.PutUint64(ms[leaderEpochPos:], epoch)
+		ms = ms[msgSetHeaderLen+m.Size():]
+	}
+}
+
+// NewMessageSet serializes the given messages into a message set which can be
+// written with AppendMessageSet. The messages are assigned consecutive offsets
+// starting at baseOffset. This allows writing messages at the offsets they have
+// in another log.
+func NewMessageSet(baseOffset int64, msgs []*Message) ([]byte, error) {
+	ms, _, err := newMessageSetFromProto(baseOffset, 0, msgs, false)
+	return ms, err
+}
+
 func newMessageSetFromProto(baseOffset, basePos int64, msgs []*Message, concurrencyControl bool) (
 	messageSet, []*entry, error) {
 
@@ -73,9 +147,9 @@ func newMessageSetFromProto(baseOffset, basePos int64, msgs []*Message, concurre
 		)
 
 		// Check expected offset for concurrency in case of Optimistic Concurrency Control
-		if concurrencyControl && m.Offset != -1 {
-			if offset != m.Offset {
-				return nil, nil, ErrIncorrectOffset
+		if concurrencyControl {
+			if err := checkExpectedOffset(m, offset); err != nil {
+				return nil, nil, err
 			}
 		}
 
@@ -110,6 +184,16 @@ func newMessageSetFromProto(baseOffset, basePos int64, msgs []*Message, concurre
 	return buf.Bytes(), entries, nil
 }
 
+// checkExpectedOffset returns an IncorrectOffsetError if the message expects
+// to be written at an offset other than the given one. An expected offset of
+// -1 matches any offset.
+func checkExpectedOffset(m *Message, offset int64) error {
+	if m.Offset != -1 && m.Offset != offset {
+		return &IncorrectOffsetError{ExpectedOffset: offset}
+	}
+	return nil
+}
+
 // readMessage reads a single message from the reader or blocks until one is
 // available. It returns the Message in addition to its offset, timestamp, and
 // leader epoch. This may return uncommitted messages if the reader was created
@@ -129,14 +213,18 @@ func readMessage(ctx context.Context, reader contextReader, headersBuf []byte) (
 		return nil, 0, 0, 0, errors.Wrap(err, "failed to ready message payload")
 	}
 	m := SerializedMessage(buf)
-	// Check the CRC on the message.
+	checkCrc(m)
+	return m, offset, timestamp, leaderEpoch, nil
+}
+
+// checkCrc checks the CRC on the given message read from the log.
+func checkCrc(m SerializedMessage) {
 	crc := m.Crc()
 	if c := crc32.Checksum(m[4:], crc32cTable); crc != c {
 		// If the CRC doesn't match, data on disk is corrupted which means the
 		// server is in an unrecoverable state.
 		panic(fmt.Errorf("Read corrupted data, expected CRC: 0x%08x, got: 0x%08x", crc, c))
 	}
-	return m, offset, timestamp, leaderEpoch, nil
 }
 
 func (ms messageSet) Offset() int64 {
@@ -0,0 +1,58 @@
+package commitlog
+
+import "sync"
+
+// LogChange is a set of changes made to a log which are reported to the
+// functions registered with Observe.
+type LogChange uint8
+
+const (
+	// HWChanged is reported when the high watermark advances.
+	HWChanged LogChange = 1 << iota
+
+	// LEOChanged is reported when messages are appended to the log.
+	LEOChanged
+
+	// ReadonlyChanged is reported when the log becomes readonly or writable.
+	ReadonlyChanged
+)
+
+// logObserver is a function registered with Observe.
+type logObserver struct {
+	fn func(LogChange)
+}
+
+// logObservers are the functions notified of changes to a log. They're kept
+// apart from the HW waiters so that observing a log has no effect on readers.
+type logObservers struct {
+	mu        sync.RWMutex
+	observers map[*logObserver]struct{}
+}
+
+// Observe registers the function to be called with the changes made to the
+// log. It's called synchronously once each change has been made, without
+// holding the log's locks, so it must not block. The returned function must be
+// called to unregister it.
+func (l *commitLog) Observe(fn func(LogChange)) func() {
+	o := &logObserver{fn: fn}
+	l.observers.mu.Lock()
+	if l.observers.observers == nil {
+		l.observers.observers = make(map[*logObserver]struct{})
+	}
+	l.observers.observers[o] = struct{}{}
+	l.observers.mu.Unlock()
+	return func() {
+		l.observers.mu.Lock()
+		delete(l.observers.observers, o)
+		l.observers.mu.Unlock()
+	}
+}
+
+// notifyObservers calls the registered observers with the given changes.
+func (l *commitLog) notifyObservers(change LogChange) {
+	l.observers.mu.RLock()
+	defer l.observers.mu.RUnlock()
+	for o := range l.observers.observers {
+		o.fn(change)
+	}
+}
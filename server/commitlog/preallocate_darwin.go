@@ -0,0 +1,25 @@
+//go:build darwin
+
+package commitlog
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// preallocate reserves size bytes of disk space for the file using
+// F_PREALLOCATE. Contiguous space is requested first, falling back to
+// non-contiguous space. The file size is left unchanged.
+func preallocate(f *os.File, size int64) error {
+	store := &unix.Fstore_t{
+		Flags:   unix.F_ALLOCATECONTIG,
+		Posmode: unix.F_PEOFPOSMODE,
+		Length:  size,
+	}
+	if err := unix.FcntlFstore(f.Fd(), unix.F_PREALLOCATE, store); err == nil {
+		return nil
+	}
+	store.Flags = unix.F_ALLOCATEALL
+	return unix.FcntlFstore(f.Fd(), unix.F_PREALLOCATE, store)
+}
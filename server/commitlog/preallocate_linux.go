@@ -0,0 +1,15 @@
+//go:build linux
+
+package commitlog
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// preallocate reserves size bytes of disk space for the file using
+// fallocate(2). The file size is left unchanged.
+func preallocate(f *os.File, size int64) error {
+	return unix.Fallocate(int(f.Fd()), unix.FALLOC_FL_KEEP_SIZE, 0, size)
+}
@@ -0,0 +1,38 @@
+package commitlog
+
+import (
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// allocatedBytes returns the disk space allocated for the file.
+func allocatedBytes(t *testing.T, path string) int64 {
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	return info.Sys().(*syscall.Stat_t).Blocks * 512
+}
+
+// Ensure pre-allocating a segment reserves disk space without changing its
+// size and the space left unused is released once the segment is sealed.
+func TestSegmentPreallocate(t *testing.T) {
+	dir := tempDir(t)
+	defer remove(t, dir)
+
+	s, err := newSegment(dir, 0, 1024*1024, true, "")
+	require.NoError(t, err)
+	defer s.Close()
+
+	if err := s.Preallocate(1024 * 1024); err != nil {
+		t.Skipf("Filesystem doesn't support pre-allocation: %v", err)
+	}
+	require.GreaterOrEqual(t, allocatedBytes(t, s.logPath()), int64(1024*1024))
+	info, err := os.Stat(s.logPath())
+	require.NoError(t, err)
+	require.Equal(t, int64(0), info.Size())
+
+	s.Seal()
+	require.Less(t, allocatedBytes(t, s.logPath()), int64(1024*1024))
+}
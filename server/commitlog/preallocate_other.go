@@ -0,0 +1,11 @@
+//go:build !linux && !darwin
+
+package commitlog
+
+import "os"
+
+// preallocate is a no-op since pre-allocation isn't supported on this
+// platform.
+func preallocate(f *os.File, size int64) error {
+	return nil
+}
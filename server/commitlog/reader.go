@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"io"
+	"slices"
 	"sync"
+	"sync/atomic"
 
 	pkgErrors "github.com/pkg/errors"
 )
@@ -21,12 +23,18 @@ type MessageReader interface {
 
 type contextReader interface {
 	Read(context.Context, []byte) (int, error)
+	// readAvailable reads the data which can be read without waiting.
+	readAvailable([]byte) (int, error)
 }
 
+// readAheadSize is the minimum number of bytes ReadMessageSet reads from the
+// log at a time.
+const readAheadSize = 64 * 1024
+
 // Reader reads messages atomically from a CommitLog. Readers should not be
 // used concurrently.
 type Reader struct {
-	ctxReader   contextReader
+	ctxReader   *bufferedReader
 	offset      int64
 	log         *commitLog
 	uncommitted bool
@@ -34,8 +42,13 @@ type Reader struct {
 
 // NewReader creates a new Reader starting at the given offset. If uncommitted
 // is true, the Reader will read uncommitted messages from the log. Otherwise,
-// it will only return committed messages.
+// it will only return committed messages. An OffsetOutOfRangeError is
+// returned if the offset is before the log start offset advanced by
+// DeleteBefore.
 func (l *commitLog) NewReader(offset int64, uncommitted bool) (*Reader, error) {
+	if start := atomic.LoadInt64(&l.logStart); offset < start {
+		return nil, &OffsetOutOfRangeError{Offset: offset, LogStartOffset: start}
+	}
 	var (
 		ctxReader contextReader
 		err       error
@@ -46,7 +59,7 @@ func (l *commitLog) NewReader(offset int64, uncommitted bool) (*Reader, error) {
 		ctxReader, err = l.newReaderCommitted(offset)
 	}
 	return &Reader{
-		ctxReader:   ctxReader,
+		ctxReader:   &bufferedReader{contextReader: ctxReader},
 		offset:      offset,
 		log:         l,
 		uncommitted: uncommitted,
@@ -61,42 +74,196 @@ func (l *commitLog) NewReader(offset int64, uncommitted bool) (*Reader, error) {
 // ReadMessage should not be called concurrently, and the headersBuf slice
 // should have a capacity of at least 28.
 //
+// If the message is encrypted, it's decrypted and the size in headersBuf is
+// updated to the size of the decrypted message.
+//
 // TODO: Should this just return a MessageSet directly instead of a Message and
 // the MessageSet header values?
 func (r *Reader) ReadMessage(ctx context.Context, headersBuf []byte) (SerializedMessage, int64, int64, uint64, error) {
+	msg, offset, timestamp, leaderEpoch, err := r.ReadRawMessage(ctx, headersBuf)
+	if err != nil || r.log.cipher == nil {
+		return msg, offset, timestamp, leaderEpoch, err
+	}
+	msg, err = r.log.cipher.decrypt(offset, msg)
+	if err != nil {
+		return nil, 0, 0, 0, pkgErrors.Wrapf(err, "failed to read message at offset %d", offset)
+	}
+	encoding.PutUint32(headersBuf[sizePos:], uint32(len(msg)))
+	return msg, offset, timestamp, leaderEpoch, nil
+}
+
+// ReadRawMessage behaves like ReadMessage but returns messages as they are
+// stored in the log, i.e. encrypted messages are not decrypted. This is used
+// for replication so that followers store the same bytes as the leader.
+func (r *Reader) ReadRawMessage(ctx context.Context, headersBuf []byte) (SerializedMessage, int64, int64, uint64, error) {
 RETRY:
 	msg, offset, timestamp, leaderEpoch, err := readMessage(ctx, r.ctxReader, headersBuf)
 	if err != nil {
-		if r.log.IsDeleted() {
-			// The log was deleted while we were trying to read.
-			return nil, 0, 0, 0, ErrCommitLogDeleted
-		} else if r.log.IsClosed() {
-			// The log was closed while we were trying to read.
-			return nil, 0, 0, 0, ErrCommitLogClosed
-		} else if pkgErrors.Cause(err) == ErrCommitLogReadonly && r.log.IsReadonly() {
-			// The log was set to readonly while we were trying to read.
-			return nil, 0, 0, 0, ErrCommitLogReadonly
-		} else if pkgErrors.Cause(err) == ErrSegmentReplaced {
-			// ErrSegmentReplaced indicates we attempted to read from a log
-			// segment that was replaced due to compaction, so reinitialize the
-			// contextReader and try again to read from the new segment.
-			if r.uncommitted {
-				r.ctxReader, err = r.log.newReaderUncommitted(r.offset)
-			} else {
-				r.ctxReader, err = r.log.newReaderCommitted(r.offset)
-			}
-			if err != nil {
-				return nil, 0, 0, 0, pkgErrors.Wrap(err, "failed to reinitialize reader")
-			}
+		retry, err := r.handleReadError(err)
+		if retry {
 			goto RETRY
-		} else {
-			return nil, 0, 0, 0, err
 		}
+		return nil, 0, 0, 0, err
+	}
+	// Messages before the log start offset were deleted while reading.
+	if start := atomic.LoadInt64(&r.log.logStart); offset < start {
+		return nil, 0, 0, 0, &OffsetOutOfRangeError{Offset: offset, LogStartOffset: start}
 	}
 	r.offset = offset + 1
 	return msg, offset, timestamp, leaderEpoch, err
 }
 
+// ReadMessageSet reads a batch of messages from the underlying CommitLog as a
+// single message set of up to maxBytes or blocks until at least one message is
+// available. It returns the message set in addition to the offsets of its
+// first and last messages. Like ReadRawMessage, messages are returned as they
+// are stored in the log, so the message set can be written to another log
+// with AppendMessageSet or sent to a follower as is.
+//
+// Messages are never split. The message set always contains at least one
+// message, so it exceeds maxBytes if the first message is larger than
+// maxBytes. Only the first message is waited for. The rest of the batch is
+// made up of messages which are already available, i.e. up to the log end
+// offset or, if the reader was not created with the uncommitted flag set, up
+// to the HW. These are read from the log in bulk rather than one message at a
+// time, which makes this suited to replication and high-throughput consumers.
+//
+// ReadMessageSet should not be called concurrently but can be interleaved
+// with calls to ReadMessage and ReadRawMessage.
+func (r *Reader) ReadMessageSet(ctx context.Context, maxBytes int64) ([]byte, int64, int64, error) {
+	headersBuf := make([]byte, msgSetHeaderLen)
+	msg, first, _, _, err := r.ReadRawMessage(ctx, headersBuf)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	var (
+		ms     = append(headersBuf, msg...)
+		last   = first
+		newest = r.log.HighWatermark
+	)
+	if r.uncommitted {
+		newest = r.log.NewestOffset
+	}
+	for int64(len(ms)) < maxBytes && r.offset <= newest() {
+		// Read ahead as much as is available and fits in the message set.
+		n := len(ms)
+		ms = grow(ms, int(min(maxBytes-int64(n), max(int64(n), readAheadSize))))
+		read, err := r.ctxReader.readAvailable(ms[n:])
+		if err != nil {
+			ms = ms[:n]
+			if retry, _ := r.handleReadError(err); retry {
+				continue
+			}
+			// Return the messages read so far. The error will be returned
+			// by the next read.
+			break
+		}
+		ms = ms[:n+read]
+
+		// Keep the complete messages and buffer the rest for the next read.
+		end := n
+		for len(ms)-end >= msgSetHeaderLen {
+			size := msgSetHeaderLen + int(messageSet(ms[end:]).Size())
+			if len(ms)-end < size {
+				break
+			}
+			checkCrc(SerializedMessage(ms[end+msgSetHeaderLen : end+size]))
+			last = messageSet(ms[end:]).Offset()
+			end += size
+		}
+		r.ctxReader.unread(ms[end:])
+		ms = ms[:end]
+		r.offset = last + 1
+		if end == n {
+			// The next message doesn't fit in the message set.
+			break
+		}
+	}
+	return ms, first, last, nil
+}
+
+// handleReadError maps an error returned while reading from the log to the
+// error to return to the caller. If the segment being read was replaced due to
+// compaction, the reader is reinitialized at its current offset and true is
+// returned to indicate the read should be retried.
+func (r *Reader) handleReadError(err error) (bool, error) {
+	if r.log.IsDeleted() {
+		// The log was deleted while we were trying to read.
+		return false, ErrCommitLogDeleted
+	} else if r.log.IsClosed() {
+		// The log was closed while we were trying to read.
+		return false, ErrCommitLogClosed
+	} else if pkgErrors.Cause(err) == ErrCommitLogReadonly && r.log.IsReadonly() {
+		// The log was set to readonly while we were trying to read.
+		return false, ErrCommitLogReadonly
+	} else if pkgErrors.Cause(err) == ErrSegmentReplaced {
+		// ErrSegmentReplaced indicates we attempted to read from a log
+		// segment that was replaced due to compaction, so reinitialize the
+		// contextReader and try again to read from the new segment.
+		var ctxReader contextReader
+		if r.uncommitted {
+			ctxReader, err = r.log.newReaderUncommitted(r.offset)
+		} else {
+			ctxReader, err = r.log.newReaderCommitted(r.offset)
+		}
+		if err != nil {
+			return false, pkgErrors.Wrap(err, "failed to reinitialize reader")
+		}
+		r.ctxReader = &bufferedReader{contextReader: ctxReader}
+		return true, nil
+	}
+	return false, err
+}
+
+// grow extends the length of b by n bytes, reallocating it if needed.
+func grow(b []byte, n int) []byte {
+	return slices.Grow(b, n)[:len(b)+n]
+}
+
+// bufferedReader is a contextReader which returns data that was read ahead
+// from the underlying contextReader but not consumed before reading from it.
+type bufferedReader struct {
+	contextReader
+	buf []byte
+}
+
+func (b *bufferedReader) Read(ctx context.Context, p []byte) (int, error) {
+	n := b.readBuffered(p)
+	if n == len(p) {
+		return n, nil
+	}
+	m, err := b.contextReader.Read(ctx, p[n:])
+	return n + m, err
+}
+
+func (b *bufferedReader) readAvailable(p []byte) (int, error) {
+	n := b.readBuffered(p)
+	if n == len(p) {
+		return n, nil
+	}
+	m, err := b.contextReader.readAvailable(p[n:])
+	return n + m, err
+}
+
+func (b *bufferedReader) readBuffered(p []byte) int {
+	n := copy(p, b.buf)
+	b.buf = b.buf[n:]
+	if len(b.buf) == 0 {
+		b.buf = nil
+	}
+	return n
+}
+
+// unread returns the given data, which must have been read from b, to be read
+// again.
+func (b *bufferedReader) unread(p []byte) {
+	if len(p) == 0 {
+		return
+	}
+	b.buf = append(slices.Clone(p), b.buf...)
+}
+
 type uncommittedReader struct {
 	cl  *commitLog
 	seg *segment
@@ -135,6 +302,7 @@ LOOP:
 			// Check if there are more segments.
 			nextSeg := findSegmentByBaseOffset(segments, r.seg.BaseOffset+1)
 			if nextSeg != nil {
+				r.cl.segmentOpened(nextSeg)
 				r.seg = nextSeg
 				r.pos = 0
 				continue
@@ -166,6 +334,7 @@ LOOP:
 			segments = r.cl.Segments()
 			nextSeg = findSegmentByBaseOffset(segments, r.seg.BaseOffset+1)
 		}
+		r.cl.segmentOpened(nextSeg)
 		r.seg = nextSeg
 		r.pos = 0
 		waiting = false
@@ -174,6 +343,34 @@ LOOP:
 	return n, err
 }
 
+func (r *uncommittedReader) readAvailable(p []byte) (n int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var readSize int
+	for n < len(p) {
+		readSize, err = r.seg.ReadAt(p[n:], r.pos)
+		n += readSize
+		r.pos += int64(readSize)
+		if err == io.EOF {
+			// We hit the end of the segment, so move to the next one if
+			// there is one.
+			nextSeg := findSegmentByBaseOffset(r.cl.Segments(), r.seg.BaseOffset+1)
+			if nextSeg == nil {
+				return n, nil
+			}
+			r.cl.segmentOpened(nextSeg)
+			r.seg = nextSeg
+			r.pos = 0
+			continue
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
 func (r *uncommittedReader) waitForData(ctx context.Context, seg *segment) bool {
 	wait := seg.WaitForData(r, r.pos)
 	select {
@@ -203,6 +400,7 @@ func (l *commitLog) newReaderUncommitted(offset int64) (contextReader, error) {
 		}
 		position = e.Position
 	}
+	l.segmentOpened(seg)
 	return &uncommittedReader{
 		cl:  l,
 		seg: seg,
@@ -252,6 +450,7 @@ func (r *committedReader) Read(ctx context.Context, p []byte) (n int, err error)
 		if r.seg == nil {
 			return 0, ErrSegmentNotFound
 		}
+		r.cl.segmentOpened(r.seg)
 		entry, err := r.seg.findEntry(offset)
 		if err != nil {
 			return 0, err
@@ -294,6 +493,7 @@ LOOP:
 				err = errors.New("no segment to consume")
 				break
 			}
+			r.cl.segmentOpened(nextSeg)
 			r.seg = nextSeg
 			r.pos = 0
 			continue
@@ -323,6 +523,60 @@ LOOP:
 	return n, err
 }
 
+func (r *committedReader) readAvailable(p []byte) (n int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.seg == nil {
+		// Nothing has been committed past the reader offset.
+		return 0, nil
+	}
+
+	var readSize int
+	for n < len(p) {
+		buf := p[n:]
+		if r.seg == r.hwSeg {
+			if r.pos >= r.hwPos {
+				// We hit the HW, so sync the latest without waiting for it
+				// to update.
+				hw := r.cl.HighWatermark()
+				if hw == r.hw {
+					return n, nil
+				}
+				segments := r.cl.Segments()
+				hwIdx, hwPos, err := getHWPos(segments, hw)
+				if err != nil {
+					return n, err
+				}
+				r.hw = hw
+				r.hwPos = hwPos
+				r.hwSeg = segments[hwIdx]
+				continue
+			}
+			// If we're reading from the HW segment, read up to the HW pos.
+			buf = buf[:min(int64(len(buf)), r.hwPos-r.pos)]
+		}
+		readSize, err = r.seg.ReadAt(buf, r.pos)
+		n += readSize
+		r.pos += int64(readSize)
+		if err == io.EOF {
+			// We hit the end of the segment, so jump to the next one.
+			nextSeg := findSegmentByBaseOffset(r.cl.Segments(), r.seg.BaseOffset+1)
+			if nextSeg == nil {
+				return n, nil
+			}
+			r.cl.segmentOpened(nextSeg)
+			r.seg = nextSeg
+			r.pos = 0
+			continue
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
 func (r *committedReader) waitForHW(ctx context.Context, hw int64) error {
 	wait := r.cl.waitForHW(r, hw)
 	select {
@@ -381,6 +635,7 @@ func (l *commitLog) newReaderCommitted(offset int64) (contextReader, error) {
 		}
 		position = entry.Position
 	}
+	l.segmentOpened(seg)
 	return &committedReader{
 		cl:    l,
 		seg:   seg,
@@ -532,9 +787,18 @@ func (r *ReverseReader) ReadMessage(ctx context.Context, headersBuf []byte) (
 		if r.stopOffset >= 0 && offset < r.stopOffset {
 			return nil, 0, 0, 0, io.EOF
 		}
+		// Messages before the log start offset are hidden.
+		if offset < atomic.LoadInt64(&r.log.logStart) {
+			return nil, 0, 0, 0, io.EOF
+		}
 
 		// Extract message from message set
 		msg := msgSet.Message()
+		if r.log.cipher != nil {
+			if msg, err = r.log.cipher.decrypt(offset, msg); err != nil {
+				return nil, 0, 0, 0, pkgErrors.Wrapf(err, "failed to read message at offset %d", offset)
+			}
+		}
 		return msg, offset, msgSet.Timestamp(), msgSet.LeaderEpoch(), nil
 	}
 }
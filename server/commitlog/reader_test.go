@@ -1,6 +1,7 @@
 package commitlog
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"strconv"
@@ -844,3 +845,365 @@ func TestReverseReaderLogClosed(t *testing.T) {
 	_, _, _, _, err = r.ReadMessage(context.Background(), headers)
 	require.Equal(t, ErrCommitLogClosed, err)
 }
+
+// Ensures readers positioned on or moving to a segment other than the active
+// one are counted as cold segment opens while readers at the tail are not, and
+// that the bytes appended match the size of the messages read back.
+func TestReaderColdSegmentOpens(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 6,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	numMsgs := 3
+	for i := 0; i < numMsgs; i++ {
+		_, err := l.Append([]*Message{{Value: []byte(strconv.Itoa(i))}})
+		require.NoError(t, err)
+	}
+	l.SetHighWatermark(int64(numMsgs - 1))
+	require.Equal(t, numMsgs, l.SegmentCount())
+	require.Equal(t, int64(0), l.ColdSegmentOpens())
+
+	// Reading the newest message only touches the active segment.
+	headers := make([]byte, 28)
+	r, err := l.NewReader(int64(numMsgs-1), false)
+	require.NoError(t, err)
+	_, _, _, _, err = r.ReadMessage(context.Background(), headers)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), l.ColdSegmentOpens())
+
+	// Replaying from the start opens each inactive segment once and reads
+	// as many message bytes as were appended.
+	r, err = l.NewReader(0, true)
+	require.NoError(t, err)
+	var bytesRead int64
+	for i := 0; i < numMsgs; i++ {
+		msg, offset, _, _, err := r.ReadMessage(context.Background(), headers)
+		require.NoError(t, err)
+		require.Equal(t, int64(i), offset)
+		bytesRead += int64(len(msg))
+	}
+	require.Equal(t, int64(numMsgs-1), l.ColdSegmentOpens())
+	require.Equal(t, l.BytesAppended(), bytesRead)
+}
+
+// Ensure a large number of committed readers blocked waiting for the HW are
+// all woken as it advances and read every committed message.
+func TestReaderCommittedManyWaitForHW(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 100,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	var (
+		numReaders = 10000
+		numMsgs    = 5
+		errC       = make(chan error, numReaders)
+		ctx, done  = context.WithCancel(context.Background())
+	)
+	defer done()
+	for i := 0; i < numReaders; i++ {
+		r, err := l.NewReader(0, false)
+		require.NoError(t, err)
+		go func() {
+			headers := make([]byte, 28)
+			for i := 0; i < numMsgs; i++ {
+				_, offset, _, _, err := r.ReadMessage(ctx, headers)
+				if err != nil {
+					errC <- err
+					return
+				}
+				if offset != int64(i) {
+					errC <- errors.Errorf("expected offset %d, got %d", i, offset)
+					return
+				}
+			}
+			errC <- nil
+		}()
+	}
+
+	// Advance the HW one message at a time while the readers are blocked.
+	for i := 0; i < numMsgs; i++ {
+		_, err := l.Append([]*Message{{Value: []byte(strconv.Itoa(i))}})
+		require.NoError(t, err)
+		l.SetHighWatermark(int64(i))
+	}
+
+	timeout := time.After(30 * time.Second)
+	for i := 0; i < numReaders; i++ {
+		select {
+		case err := <-errC:
+			require.NoError(t, err)
+		case <-timeout:
+			t.Fatalf("Only %d of %d readers read all messages", i, numReaders)
+		}
+	}
+
+	// All waiters should have been removed.
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	require.Empty(t, l.hwWaiters)
+}
+
+// Ensure ReadMessageSet returns the available messages across segments as a
+// single message set which can be appended to another log.
+func TestReaderReadMessageSet(t *testing.T) {
+	for _, test := range segmentSizeTests {
+		t.Run(test.name, func(t *testing.T) {
+			l, cleanup := setupWithOptions(t, Options{
+				Path:            tempDir(t),
+				MaxSegmentBytes: test.segmentSize,
+			})
+			defer l.Close()
+			defer cleanup()
+
+			numMsgs := 10
+			msgs := make([]*Message, numMsgs)
+			for i := 0; i < numMsgs; i++ {
+				msgs[i] = &Message{
+					Value:       []byte(strconv.Itoa(i)),
+					Timestamp:   int64(i),
+					LeaderEpoch: 42,
+				}
+			}
+			_, err := l.Append(msgs)
+			require.NoError(t, err)
+			r, err := l.NewReader(0, true)
+			require.NoError(t, err)
+
+			ms, first, last, err := r.ReadMessageSet(context.Background(), 1024)
+			require.NoError(t, err)
+			require.Equal(t, int64(0), first)
+			require.Equal(t, int64(9), last)
+			read := MessageSetMessages(ms)
+			require.Len(t, read, numMsgs)
+			for i, msg := range msgs {
+				compareMessages(t, msg, read[i])
+			}
+
+			// The message set can be replicated to another log as is.
+			replica, cleanupReplica := setupWithOptions(t, Options{
+				Path:            tempDir(t),
+				MaxSegmentBytes: test.segmentSize,
+			})
+			defer replica.Close()
+			defer cleanupReplica()
+			offsets, err := replica.AppendMessageSet(ms)
+			require.NoError(t, err)
+			require.Equal(t, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, offsets)
+		})
+	}
+}
+
+// Ensure ReadMessageSet doesn't split messages to fit in maxBytes and that
+// messages left out of a message set are returned by the next read.
+func TestReaderReadMessageSetMaxBytes(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 100,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	numMsgs := 10
+	msgs := make([]*Message, numMsgs)
+	for i := 0; i < numMsgs; i++ {
+		msgs[i] = &Message{Value: []byte(strconv.Itoa(i))}
+	}
+	_, err := l.Append(msgs)
+	require.NoError(t, err)
+	r, err := l.NewReader(0, true)
+	require.NoError(t, err)
+
+	// Find the size of a message set entry.
+	ms, first, last, err := r.ReadMessageSet(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), first)
+	require.Equal(t, int64(0), last)
+	entrySize := int64(len(ms))
+
+	ms, first, last, err = r.ReadMessageSet(context.Background(), 3*entrySize+1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(3), last)
+	require.Equal(t, 3*entrySize, int64(len(ms)))
+
+	// Reads can be interleaved with ReadMessage.
+	headers := make([]byte, 28)
+	msg, offset, _, _, err := r.ReadMessage(context.Background(), headers)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), offset)
+	compareMessages(t, msgs[4], msg)
+
+	ms, first, last, err = r.ReadMessageSet(context.Background(), 2*entrySize)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), first)
+	require.Equal(t, int64(6), last)
+	compareMessages(t, msgs[6], MessageSetMessages(ms)[1])
+
+	msg, offset, _, _, err = r.ReadMessage(context.Background(), headers)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), offset)
+	compareMessages(t, msgs[7], msg)
+}
+
+// Ensure ReadMessageSet on a committed reader stops at the HW and blocks until
+// the HW is advanced.
+func TestReaderReadMessageSetCommitted(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 30,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	numMsgs := 10
+	msgs := make([]*Message, numMsgs)
+	for i := 0; i < numMsgs; i++ {
+		msgs[i] = &Message{Value: []byte(strconv.Itoa(i))}
+	}
+	_, err := l.Append(msgs)
+	require.NoError(t, err)
+	l.SetHighWatermark(4)
+	r, err := l.NewReader(0, false)
+	require.NoError(t, err)
+
+	ms, first, last, err := r.ReadMessageSet(context.Background(), 1024)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), first)
+	require.Equal(t, int64(4), last)
+	require.Len(t, MessageSetMessages(ms), 5)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, _, err = r.ReadMessageSet(ctx, 1024)
+	require.Equal(t, io.EOF, errors.Cause(err))
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		l.SetHighWatermark(9)
+	}()
+
+	r, err = l.NewReader(5, false)
+	require.NoError(t, err)
+	ms, first, last, err = r.ReadMessageSet(context.Background(), 1024)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), first)
+	require.Equal(t, int64(9), last)
+	read := MessageSetMessages(ms)
+	for i, msg := range msgs[5:] {
+		compareMessages(t, msg, read[i])
+	}
+}
+
+// Ensure ReadMessageSet on an uncommitted reader blocks at the log end offset
+// until new messages are written.
+func TestReaderReadMessageSetWaitForData(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 30,
+	})
+	defer l.Close()
+	defer cleanup()
+
+	_, err := l.Append([]*Message{{Value: []byte("0")}})
+	require.NoError(t, err)
+	r, err := l.NewReader(0, true)
+	require.NoError(t, err)
+
+	_, first, last, err := r.ReadMessageSet(context.Background(), 1024)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), first)
+	require.Equal(t, int64(0), last)
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		l.Append([]*Message{{Value: []byte("1")}, {Value: []byte("2")}})
+	}()
+
+	ms, first, last, err := r.ReadMessageSet(context.Background(), 1024)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.True(t, last >= first)
+	require.Equal(t, []byte("1"), MessageSetMessages(ms)[0].Value())
+}
+
+// setupReplicationBenchmark returns a log with messages to replicate and the
+// total size of their payloads.
+func setupReplicationBenchmark(b *testing.B) (*commitLog, int64, func()) {
+	l, cleanup := setupWithOptions(b, Options{
+		Path:            tempDir(b),
+		MaxSegmentBytes: 1024 * 1024,
+	})
+	msgs := make([]*Message, 100)
+	for i := range msgs {
+		msgs[i] = &Message{Value: make([]byte, 256), Headers: headers}
+	}
+	var size int64
+	for i := 0; i < 100; i++ {
+		_, err := l.Append(msgs)
+		require.NoError(b, err)
+	}
+	r, err := l.NewReader(0, true)
+	require.NoError(b, err)
+	for r.offset <= l.NewestOffset() {
+		ms, _, _, err := r.ReadMessageSet(context.Background(), 1024*1024)
+		require.NoError(b, err)
+		size += int64(len(ms))
+	}
+	return l, size, cleanup
+}
+
+// BenchmarkReplicateReadRawMessage reads the log into replication batches one
+// message at a time as the replicator did before ReadMessageSet.
+func BenchmarkReplicateReadRawMessage(b *testing.B) {
+	l, size, cleanup := setupReplicationBenchmark(b)
+	defer cleanup()
+	b.SetBytes(size)
+	b.ResetTimer()
+
+	var (
+		buf     bytes.Buffer
+		headers = make([]byte, 28)
+	)
+	for i := 0; i < b.N; i++ {
+		r, err := l.NewReader(0, true)
+		require.NoError(b, err)
+		for offset := int64(-1); offset < l.NewestOffset(); {
+			buf.Reset()
+			for offset < l.NewestOffset() && buf.Len() < 1024*1024 {
+				var msg SerializedMessage
+				msg, offset, _, _, err = r.ReadRawMessage(context.Background(), headers)
+				require.NoError(b, err)
+				buf.Write(headers)
+				buf.Write(msg)
+			}
+		}
+	}
+}
+
+// BenchmarkReplicateReadMessageSet reads the log into replication batches with
+// ReadMessageSet.
+func BenchmarkReplicateReadMessageSet(b *testing.B) {
+	l, size, cleanup := setupReplicationBenchmark(b)
+	defer cleanup()
+	b.SetBytes(size)
+	b.ResetTimer()
+
+	var buf bytes.Buffer
+	for i := 0; i < b.N; i++ {
+		r, err := l.NewReader(0, true)
+		require.NoError(b, err)
+		for offset := int64(-1); offset < l.NewestOffset(); {
+			buf.Reset()
+			var ms []byte
+			ms, _, offset, err = r.ReadMessageSet(context.Background(), 1024*1024)
+			require.NoError(b, err)
+			buf.Write(ms)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package commitlog
 
 import (
+	"encoding/binary"
 	"fmt"
 	"io"
 	"os"
@@ -10,6 +11,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
 )
 
 const (
@@ -18,6 +21,7 @@ const (
 	cleanedSuffix   = ".cleaned"
 	truncatedSuffix = ".truncated"
 	indexSuffix     = ".index"
+	bloomSuffix     = ".bloom"
 )
 
 var (
@@ -69,13 +73,39 @@ type segment struct {
 	sealed         bool
 	closed         bool
 	replaced       bool
-	deleted        bool // marked for deletion, excluded from read path
+	deleted        bool     // marked for deletion, excluded from read path
+	dataKey        *dataKey // encrypts messages appended to the segment, if the log is encrypted
+	expiresAt      int64    // latest expiration of a message with a TTL, cached once the segment is sealed
+	expiresAtKnown bool
+	indexRebuilt   bool  // set if the index was rebuilt from the log when the segment was opened
+	preallocated   bool  // set if disk space was reserved past the written data, released once sealed
+	unloaded       bool  // set if the segment was opened lazily and its files haven't been opened yet
+	summarized     bool  // set if the end of an unloaded segment was read by summarize
+	entries        int64 // number of index entries of an unloaded segment read by summarize
 
 	sync.RWMutex
 }
 
 func newSegment(path string, baseOffset, maxBytes int64, isNew bool, suffix string) (*segment, error) {
-	s := &segment{
+	s := newLazySegment(path, baseOffset, maxBytes, suffix)
+	// If this is a new segment, ensure the file doesn't already exist.
+	if isNew && exists(s.logPath()) {
+		return nil, ErrSegmentExists
+	}
+	s.unloaded = false
+	if err := s.open(); err != nil {
+		if s.log == nil {
+			return nil, err
+		}
+		return s, err
+	}
+	return s, nil
+}
+
+// newLazySegment returns a segment for an existing log file whose files are
+// opened the first time it's accessed rather than up front.
+func newLazySegment(path string, baseOffset, maxBytes int64, suffix string) *segment {
+	return &segment{
 		maxBytes:    maxBytes,
 		BaseOffset:  baseOffset,
 		firstOffset: -1,
@@ -83,25 +113,151 @@ func newSegment(path string, baseOffset, maxBytes int64, isNew bool, suffix stri
 		path:        path,
 		suffix:      suffix,
 		waiters:     make(map[interface{}]chan struct{}),
+		unloaded:    true,
 	}
-	// If this is a new segment, ensure the file doesn't already exist.
-	if isNew && exists(s.logPath()) {
-		return nil, ErrSegmentExists
-	}
+}
+
+// open opens the segment's log file and sets up its index.
+func (s *segment) open() error {
 	log, err := os.OpenFile(s.logPath(), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
 	if err != nil {
-		return nil, errors.Wrap(err, "open file failed")
+		return errors.Wrap(err, "open file failed")
 	}
 	info, err := log.Stat()
 	if err != nil {
-		return nil, errors.Wrap(err, "stat file failed")
+		log.Close()
+		return errors.Wrap(err, "stat file failed")
 	}
 	s.log = log
 	s.position = info.Size()
 	s.writer = log
 	s.reader = log
-	err = s.setupIndex()
-	return s, err
+	return s.setupIndex()
+}
+
+// load opens the files of a segment opened lazily if they weren't opened
+// yet. A segment which fails to load reads as empty, and reading its messages
+// returns the error.
+func (s *segment) load() error {
+	s.RLock()
+	unloaded := s.unloaded
+	s.RUnlock()
+	if !unloaded {
+		return nil
+	}
+	s.Lock()
+	defer s.Unlock()
+	if !s.unloaded || s.closed {
+		return nil
+	}
+	if err := s.open(); err != nil {
+		s.unload()
+		return errors.Wrapf(err, "failed to load segment with base offset %d", s.BaseOffset)
+	}
+	s.unloaded = false
+	return nil
+}
+
+// unload closes whatever files of the segment were opened by a failed load
+// and resets it to read as empty. Must be called within the scope of the
+// segment mutex.
+func (s *segment) unload() {
+	if s.log != nil {
+		s.log.Close()
+	}
+	if s.Index != nil {
+		s.Index.Close()
+	}
+	s.log, s.writer, s.reader, s.Index = nil, nil, nil, nil
+	s.position = 0
+	s.firstOffset, s.lastOffset = -1, -1
+	s.firstWriteTime, s.lastWriteTime = 0, 0
+	s.summarized = false
+	s.entries = 0
+}
+
+// summarize reads the size, last offset, last write time, and message count
+// of a segment opened lazily from the end of its files without loading it,
+// which is all retention needs to know about the segment. If the index
+// doesn't end where the log does, e.g. because it wasn't shrunk when the
+// segment was sealed or it needs to be rebuilt, the segment is loaded
+// instead. An error means nothing is known about the segment, so retention
+// must not assume it's empty or old.
+func (s *segment) summarize() error {
+	s.RLock()
+	done := !s.unloaded || s.summarized
+	s.RUnlock()
+	if done {
+		return nil
+	}
+	s.Lock()
+	if !s.unloaded || s.summarized || s.closed {
+		s.Unlock()
+		return nil
+	}
+	last, size, entries, err := readSegmentEnd(s.logPath(), s.indexPath(), s.BaseOffset)
+	if err != nil {
+		s.Unlock()
+		return errors.Wrapf(err, "failed to summarize segment with base offset %d", s.BaseOffset)
+	}
+	if last != nil || size == 0 {
+		s.position = size
+		s.entries = entries
+		if last != nil {
+			s.lastOffset = last.Offset
+			s.lastWriteTime = last.Timestamp
+		}
+		s.summarized = true
+		s.Unlock()
+		return nil
+	}
+	s.Unlock()
+	return s.load()
+}
+
+// readSegmentEnd returns the last entry of the index at indexPath, the size
+// of the log at logPath, and the number of index entries. The entry is nil if
+// the index doesn't end with the entry of the message at the end of the log.
+func readSegmentEnd(logPath, indexPath string, baseOffset int64) (*entry, int64, int64, error) {
+	logInfo, err := os.Stat(logPath)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	index, err := os.Open(indexPath)
+	if os.IsNotExist(err) {
+		return nil, logInfo.Size(), 0, nil
+	}
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	defer index.Close()
+	indexInfo, err := index.Stat()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	indexSize := indexInfo.Size()
+	if indexSize == 0 || indexSize%entryWidth != 0 {
+		return nil, logInfo.Size(), 0, nil
+	}
+	var rel relEntry
+	if err := binary.Read(io.NewSectionReader(index, indexSize-entryWidth, entryWidth),
+		proto.Encoding, &rel); err != nil {
+		return nil, 0, 0, err
+	}
+	last := &entry{}
+	rel.fill(last, baseOffset)
+	if last.Size == 0 || indexEnd(last) != logInfo.Size() {
+		return nil, logInfo.Size(), 0, nil
+	}
+	return last, logInfo.Size(), indexSize / entryWidth, nil
+}
+
+// IsLoaded indicates if the segment's files are open, which is the case
+// unless it was opened lazily and hasn't been accessed since.
+func (s *segment) IsLoaded() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return !s.unloaded
 }
 
 // setupIndex creates and initializes an index.
@@ -109,7 +265,8 @@ func newSegment(path string, baseOffset, maxBytes int64, isNew bool, suffix stri
 // - Initialize index position
 // - Initialize firstOffset/lastOffset
 // - Initialize firstWriteTime/lastWriteTime
-// If the index is corrupt, it will attempt to rebuild it from the log file.
+// If the index is corrupt or doesn't end where the log file does, e.g. because
+// it was deleted or truncated, it will attempt to rebuild it from the log file.
 func (s *segment) setupIndex() (err error) {
 	s.Index, err = newIndex(options{
 		path:       s.indexPath(),
@@ -119,20 +276,21 @@ func (s *segment) setupIndex() (err error) {
 		return err
 	}
 	lastEntry, err := s.Index.InitializePosition()
-	if err != nil {
-		if err == errIndexCorrupt {
-			// Index is corrupt, attempt to rebuild from log file
-			if rebuildErr := s.rebuildIndex(); rebuildErr != nil {
-				return errors.Wrap(rebuildErr, "failed to rebuild corrupt index")
-			}
-			// Re-initialize after rebuild
-			lastEntry, err = s.Index.InitializePosition()
-			if err != nil {
-				return errors.Wrap(err, "failed to initialize rebuilt index")
-			}
-		} else {
-			return err
+	if err != nil && err != errIndexCorrupt {
+		return err
+	}
+	if err == errIndexCorrupt || indexEnd(lastEntry) != s.position {
+		// Index is corrupt or missing entries, attempt to rebuild from log
+		// file
+		if rebuildErr := s.rebuildIndex(); rebuildErr != nil {
+			return errors.Wrap(rebuildErr, "failed to rebuild index")
 		}
+		// Re-initialize after rebuild
+		lastEntry, err = s.Index.InitializePosition()
+		if err != nil {
+			return errors.Wrap(err, "failed to initialize rebuilt index")
+		}
+		s.indexRebuilt = true
 	}
 	// If lastEntry is nil, the index is empty.
 	if lastEntry != nil {
@@ -149,8 +307,18 @@ func (s *segment) setupIndex() (err error) {
 	return nil
 }
 
-// rebuildIndex rebuilds the index by scanning the log file.
-// This is called when a corrupt index is detected.
+// indexEnd returns the log file position following the message of the given
+// last index entry, or 0 if the index is empty.
+func indexEnd(lastEntry *entry) int64 {
+	if lastEntry == nil {
+		return 0
+	}
+	return lastEntry.Position + int64(lastEntry.Size)
+}
+
+// rebuildIndex rebuilds the index by scanning the log file. The entries are
+// the same as those written when the messages were appended. This is called
+// when a corrupt, missing, or truncated index is detected.
 func (s *segment) rebuildIndex() error {
 	// Close and remove the corrupt index
 	if s.Index != nil {
@@ -185,8 +353,11 @@ func (s *segment) rebuildIndex() error {
 	}
 
 	// Scan the log file and rebuild index entries
-	var pos int64
-	headerBuf := make([]byte, msgSetHeaderLen)
+	var (
+		pos          int64
+		maxTimestamp int64
+		headerBuf    = make([]byte, msgSetHeaderLen)
+	)
 
 	for pos < s.position {
 		// Read message set header
@@ -220,6 +391,12 @@ func (s *segment) rebuildIndex() error {
 			break
 		}
 
+		// Index timestamps never decrease, see clampIndexTimestamps.
+		if pos > 0 && timestamp < maxTimestamp {
+			timestamp = maxTimestamp
+		}
+		maxTimestamp = timestamp
+
 		// Create index entry
 		e := &entry{
 			Offset:      offset,
@@ -246,22 +423,68 @@ func (s *segment) rebuildIndex() error {
 	return nil
 }
 
+// RollReason is the reason a new log segment was rolled out.
+type RollReason int
+
+const (
+	// RollNone indicates the segment shouldn't be rolled.
+	RollNone RollReason = iota
+
+	// RollSize indicates the segment reached MaxSegmentBytes.
+	RollSize
+
+	// RollAge indicates MaxSegmentAge passed since the segment's first
+	// message was written.
+	RollAge
+
+	// RollForced indicates the segment was rolled by calling Roll.
+	RollForced
+
+	numRollReasons
+)
+
+// String returns the name of the roll reason used in logs.
+func (r RollReason) String() string {
+	switch r {
+	case RollNone:
+		return "none"
+	case RollSize:
+		return "size"
+	case RollAge:
+		return "age"
+	case RollForced:
+		return "forced"
+	default:
+		return fmt.Sprintf("RollReason(%d)", int(r))
+	}
+}
+
 // CheckSplit determines if a new log segment should be rolled out either
-// because this segment is full or LogRollTime has passed since the first
-// message was written to the segment.
-func (s *segment) CheckSplit(logRollTime time.Duration) bool {
+// because this segment is full or logRollTime has passed since the first
+// message was written to the segment, and returns the reason. The age is
+// measured from the timestamp of the first message rather than when the
+// segment was created, so a segment rolled out while the log is idle isn't
+// rolled again as soon as it's written to. An empty segment is never rolled.
+func (s *segment) CheckSplit(logRollTime time.Duration) RollReason {
+	s.load() // nolint: errcheck
 	s.RLock()
 	defer s.RUnlock()
+	if s.position == 0 {
+		return RollNone
+	}
 	if s.position >= s.maxBytes {
-		return true
+		return RollSize
 	}
-	if logRollTime == 0 || s.firstWriteTime == 0 {
-		// Don't roll a new segment if there have been no writes to the segment
-		// or LogRollTime is disabled.
-		return false
+	if logRollTime <= 0 || s.firstWriteTime == 0 {
+		// Don't roll a new segment if LogRollTime is disabled or there's no
+		// first write time to measure from.
+		return RollNone
 	}
 	// Check if LogRollTime has passed since first write.
-	return timestamp()-s.firstWriteTime >= int64(logRollTime)
+	if timestamp()-s.firstWriteTime >= int64(logRollTime) {
+		return RollAge
+	}
+	return RollNone
 }
 
 // Seal a segment from being written to. This is called on the former active
@@ -280,10 +503,45 @@ func (s *segment) seal() {
 	s.sealed = true
 	// Notify any readers waiting for data.
 	s.notifyWaiters()
-	s.Index.Shrink() // nolint: errcheck
+	if s.unloaded {
+		return
+	}
+	s.Index.Shrink()        // nolint: errcheck
+	s.releasePreallocated() // nolint: errcheck
+}
+
+// Preallocate reserves size bytes of disk space for the segment's log file to
+// reduce fragmentation. This is only a hint to the filesystem and doesn't
+// change the segment's size. Any space left unused is released when the
+// segment is sealed.
+func (s *segment) Preallocate(size int64) error {
+	if err := s.load(); err != nil {
+		return err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.sealed || s.closed {
+		return nil
+	}
+	if err := preallocate(s.log, size); err != nil {
+		return err
+	}
+	s.preallocated = true
+	return nil
+}
+
+// releasePreallocated truncates the log file to the written data, freeing any
+// disk space reserved past it by Preallocate.
+func (s *segment) releasePreallocated() error {
+	if !s.preallocated || s.closed {
+		return nil
+	}
+	s.preallocated = false
+	return s.log.Truncate(s.position)
 }
 
 func (s *segment) NextOffset() int64 {
+	s.summarize() // nolint: errcheck
 	s.RLock()
 	defer s.RUnlock()
 	// If the segment hasn't been written to, the next offset should be the
@@ -295,42 +553,110 @@ func (s *segment) NextOffset() int64 {
 }
 
 func (s *segment) FirstOffset() int64 {
+	s.load() // nolint: errcheck
 	s.RLock()
 	defer s.RUnlock()
 	return s.firstOffset
 }
 
 func (s *segment) FirstWriteTime() int64 {
+	s.load() // nolint: errcheck
 	s.RLock()
 	defer s.RUnlock()
 	return s.firstWriteTime
 }
 
+func (s *segment) LastWriteTime() int64 {
+	s.summarize() // nolint: errcheck
+	s.RLock()
+	defer s.RUnlock()
+	return s.lastWriteTime
+}
+
 func (s *segment) LastOffset() int64 {
+	s.summarize() // nolint: errcheck
 	s.RLock()
 	defer s.RUnlock()
 	return s.lastOffset
 }
 
 func (s *segment) Position() int64 {
+	s.summarize() // nolint: errcheck
 	s.RLock()
 	defer s.RUnlock()
 	return s.position
 }
 
 func (s *segment) IsEmpty() bool {
+	s.load() // nolint: errcheck
 	s.RLock()
 	defer s.RUnlock()
 	return s.firstOffset == -1
 }
 
 func (s *segment) MessageCount() int64 {
+	s.summarize() // nolint: errcheck
 	s.RLock()
 	defer s.RUnlock()
+	if s.unloaded {
+		return s.entries
+	}
 	return s.Index.CountEntries()
 }
 
+// latestExpiration returns the time in Unix nanoseconds at which the last of
+// the segment's messages with a TTL expires or -1 if none of them have one.
+// Encrypted messages are decrypted with the given cipher to read their TTL.
+// Since this scans the whole segment, the result is cached once the segment is
+// sealed.
+func (s *segment) latestExpiration(cipher *logCipher) (int64, error) {
+	s.RLock()
+	if s.expiresAtKnown {
+		defer s.RUnlock()
+		return s.expiresAt, nil
+	}
+	sealed := s.sealed
+	s.RUnlock()
+	if err := s.load(); err != nil {
+		return 0, err
+	}
+
+	var (
+		expiresAt = int64(-1)
+		ss        = newSegmentScanner(s)
+	)
+	for {
+		ms, _, err := ss.Scan()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		m := ms.Message()
+		if cipher != nil {
+			if m, err = cipher.decrypt(ms.Offset(), m); err != nil {
+				return 0, err
+			}
+		}
+		if exp := expiration(m.Headers(), ms.Timestamp()); exp > expiresAt {
+			expiresAt = exp
+		}
+	}
+
+	if sealed {
+		s.Lock()
+		s.expiresAt = expiresAt
+		s.expiresAtKnown = true
+		s.Unlock()
+	}
+	return expiresAt, nil
+}
+
 func (s *segment) WriteMessageSet(ms []byte, entries []*entry) error {
+	if err := s.load(); err != nil {
+		return err
+	}
 	s.Lock()
 	defer s.Unlock()
 	if _, err := s.write(ms, entries); err != nil {
@@ -363,6 +689,9 @@ func (s *segment) write(p []byte, entries []*entry) (n int, err error) {
 }
 
 func (s *segment) ReadAt(p []byte, off int64) (n int, err error) {
+	if err := s.load(); err != nil {
+		return 0, err
+	}
 	s.RLock()
 	defer s.RUnlock()
 	if s.closed {
@@ -382,6 +711,7 @@ func (s *segment) notifyWaiters() {
 }
 
 func (s *segment) WaitForLEO(waiter interface{}, expectedLEO, actualLEO int64) <-chan struct{} {
+	s.load() // nolint: errcheck
 	s.Lock()
 	defer s.Unlock()
 	// Check expected LEO against last known LEO and against the current
@@ -397,6 +727,7 @@ func (s *segment) WaitForLEO(waiter interface{}, expectedLEO, actualLEO int64) <
 	return s.waitForData(waiter, s.position)
 }
 func (s *segment) WaitForData(waiter interface{}, pos int64) <-chan struct{} {
+	s.load() // nolint: errcheck
 	s.Lock()
 	ch := s.waitForData(waiter, pos)
 	s.Unlock()
@@ -437,6 +768,14 @@ func (s *segment) close() error {
 	if s.closed {
 		return nil
 	}
+	if s.unloaded {
+		s.closed = true
+		s.seal()
+		return nil
+	}
+	if err := s.releasePreallocated(); err != nil {
+		return err
+	}
 	if err := s.log.Close(); err != nil {
 		return err
 	}
@@ -476,6 +815,10 @@ func (s *segment) Replace(old *segment) error {
 	if err := os.Rename(s.indexPath(), old.indexPath()); err != nil {
 		return err
 	}
+	// The old segment's bloom filter no longer describes its contents.
+	if err := os.Remove(old.bloomPath()); err != nil && !os.IsNotExist(err) {
+		return err
+	}
 	s.suffix = ""
 	log, err := os.OpenFile(s.logPath(), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
 	if err != nil {
@@ -492,6 +835,9 @@ func (s *segment) Replace(old *segment) error {
 // findEntry returns the first entry whose offset is greater than or equal to
 // the given offset.
 func (s *segment) findEntry(offset int64) (*entry, error) {
+	if err := s.load(); err != nil {
+		return nil, err
+	}
 	s.RLock()
 	defer s.RUnlock()
 	var (
@@ -519,6 +865,9 @@ func (s *segment) findEntry(offset int64) (*entry, error) {
 // findEntryByTimestamp returns the first entry whose timestamp is greater than
 // or equal to the given timestamp.
 func (s *segment) findEntryByTimestamp(timestamp int64) (*entry, error) {
+	if err := s.load(); err != nil {
+		return nil, err
+	}
 	s.RLock()
 	defer s.RUnlock()
 	var (
@@ -543,23 +892,27 @@ func (s *segment) findEntryByTimestamp(timestamp int64) (*entry, error) {
 	return entry, err
 }
 
-// Delete closes the segment and then deletes its log and index files.
+// Delete closes the segment and then deletes its log, index, and bloom filter
+// files.
 func (s *segment) Delete() error {
 	if err := s.Close(); err != nil {
 		return err
 	}
 	s.Lock()
 	defer s.Unlock()
-	if exists(s.log.Name()) {
-		if err := os.Remove(s.log.Name()); err != nil {
+	if exists(s.logPath()) {
+		if err := os.Remove(s.logPath()); err != nil {
 			return err
 		}
 	}
-	if exists(s.Index.Name()) {
-		if err := os.Remove(s.Index.Name()); err != nil {
+	if exists(s.indexPath()) {
+		if err := os.Remove(s.indexPath()); err != nil {
 			return err
 		}
 	}
+	if err := os.Remove(s.bloomPath()); err != nil && !os.IsNotExist(err) {
+		return err
+	}
 	return nil
 }
 
@@ -580,17 +933,24 @@ func (s *segment) IsDeleted() bool {
 }
 
 type segmentScanner struct {
-	s  *segment
-	is *indexScanner
+	s   *segment
+	is  *indexScanner
+	err error // set if the segment failed to load
 }
 
 func newSegmentScanner(segment *segment) *segmentScanner {
+	if err := segment.load(); err != nil {
+		return &segmentScanner{s: segment, err: err}
+	}
 	return &segmentScanner{s: segment, is: newIndexScanner(segment.Index)}
 }
 
 // Scan should be called repeatedly to iterate over the messages in the
 // segment, it will return io.EOF when there are no more messages.
 func (s *segmentScanner) Scan() (messageSet, *entry, error) {
+	if s.err != nil {
+		return nil, nil, s.err
+	}
 	entry, err := s.is.Scan()
 	if err != nil {
 		return nil, nil, err
@@ -614,6 +974,7 @@ func (s *segmentScanner) Scan() (messageSet, *entry, error) {
 type reverseSegmentScanner struct {
 	s   *segment
 	ris *reverseIndexScanner
+	err error // set if the segment failed to load
 }
 
 // newReverseSegmentScanner creates a scanner that iterates from the given
@@ -621,6 +982,9 @@ type reverseSegmentScanner struct {
 func newReverseSegmentScanner(segment *segment, startOffset int64) *reverseSegmentScanner {
 	// Convert log offset to index entry offset
 	entryOffset := startOffset - segment.BaseOffset
+	if err := segment.load(); err != nil {
+		return &reverseSegmentScanner{s: segment, err: err}
+	}
 	return &reverseSegmentScanner{
 		s:   segment,
 		ris: newReverseIndexScanner(segment.Index, entryOffset),
@@ -630,6 +994,9 @@ func newReverseSegmentScanner(segment *segment, startOffset int64) *reverseSegme
 // newReverseSegmentScannerFromEnd creates a scanner that starts at the last
 // message in the segment and iterates backwards.
 func newReverseSegmentScannerFromEnd(segment *segment) *reverseSegmentScanner {
+	if err := segment.load(); err != nil {
+		return &reverseSegmentScanner{s: segment, err: err}
+	}
 	return &reverseSegmentScanner{
 		s:   segment,
 		ris: newReverseIndexScannerFromEnd(segment.Index),
@@ -639,6 +1006,9 @@ func newReverseSegmentScannerFromEnd(segment *segment) *reverseSegmentScanner {
 // Scan reads the current message and moves to the previous one.
 // Returns io.EOF when there are no more messages.
 func (s *reverseSegmentScanner) Scan() (messageSet, *entry, error) {
+	if s.err != nil {
+		return nil, nil, s.err
+	}
 	entry, err := s.ris.Scan()
 	if err != nil {
 		return nil, nil, err
@@ -664,3 +1034,7 @@ func (s *segment) logPath() string {
 func (s *segment) indexPath() string {
 	return filepath.Join(s.path, fmt.Sprintf(fileFormat, s.BaseOffset, indexSuffix+s.suffix))
 }
+
+func (s *segment) bloomPath() string {
+	return filepath.Join(s.path, fmt.Sprintf(fileFormat, s.BaseOffset, bloomSuffix+s.suffix))
+}
@@ -9,32 +9,32 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-// Ensure CheckSplit returns false when the segment has not been written to and
-// true when the log segment is full.
+// Ensure CheckSplit returns RollNone when the segment has not been written to
+// and RollSize when the log segment is full.
 func TestSegmentCheckSplitFull(t *testing.T) {
 	dir := tempDir(t)
 	defer remove(t, dir)
 
 	s := createSegment(t, dir, 0, 10)
-	require.False(t, s.CheckSplit(1))
+	require.Equal(t, RollNone, s.CheckSplit(1))
 
 	_, err := s.write(make([]byte, 10), []*entry{{}})
 	require.NoError(t, err)
-	require.True(t, s.CheckSplit(1))
+	require.Equal(t, RollSize, s.CheckSplit(1))
 }
 
-// Ensure CheckSplit returns false when LogRollTime is 0 and the segment is not
-// full.
+// Ensure CheckSplit returns RollNone when LogRollTime is 0 and the segment is
+// not full.
 func TestSegmentCheckSplitLogRollTimeZero(t *testing.T) {
 	dir := tempDir(t)
 	defer remove(t, dir)
 
 	s := createSegment(t, dir, 0, 10)
-	require.False(t, s.CheckSplit(0))
+	require.Equal(t, RollNone, s.CheckSplit(0))
 }
 
-// Ensure CheckSplit returns false when the segment is not full and LogRollTime
-// has not been exceeded.
+// Ensure CheckSplit returns RollNone when the segment is not full and
+// LogRollTime has not been exceeded.
 func TestSegmentCheckSplitNotFull(t *testing.T) {
 	dir := tempDir(t)
 	defer remove(t, dir)
@@ -50,11 +50,11 @@ func TestSegmentCheckSplitNotFull(t *testing.T) {
 	_, err := s.write(make([]byte, 5), []*entry{{}})
 	require.NoError(t, err)
 	s.firstWriteTime = 1
-	require.False(t, s.CheckSplit(5))
+	require.Equal(t, RollNone, s.CheckSplit(5))
 }
 
-// Ensure CheckSplit returns true when the segment is not full but LogRollTime
-// has been exceeded.
+// Ensure CheckSplit returns RollAge when the segment is not full but
+// LogRollTime has been exceeded.
 func TestSegmentCheckSplitLogRollTimeExceeded(t *testing.T) {
 	dir := tempDir(t)
 	defer remove(t, dir)
@@ -70,7 +70,7 @@ func TestSegmentCheckSplitLogRollTimeExceeded(t *testing.T) {
 	_, err := s.write(make([]byte, 5), []*entry{{}})
 	require.NoError(t, err)
 	s.firstWriteTime = 1
-	require.True(t, s.CheckSplit(1))
+	require.Equal(t, RollAge, s.CheckSplit(1))
 }
 
 type mockContextReader struct{}
@@ -274,6 +274,31 @@ func TestSegmentCorruptIndexRecoveryEmptyLog(t *testing.T) {
 	require.True(t, s2.IsEmpty())
 }
 
+// Ensure the index is rebuilt when it's missing entries for the end of the log.
+func TestSegmentIndexMissingEntries(t *testing.T) {
+	dir := tempDir(t)
+	defer remove(t, dir)
+
+	s := createSegment(t, dir, 0, 1024)
+	for i := 0; i < 3; i++ {
+		writeToSegment(t, s, int64(i), []byte("test data"))
+	}
+	expected, err := s.findEntry(2)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	// Truncate the index to the first entry.
+	require.NoError(t, truncateIndexFile(s.indexPath(), 20))
+
+	s2, err := newSegment(dir, 0, 1024, false, "")
+	require.NoError(t, err)
+	require.True(t, s2.indexRebuilt)
+	require.Equal(t, int64(3), s2.MessageCount())
+	e, err := s2.findEntry(2)
+	require.NoError(t, err)
+	require.Equal(t, expected, e)
+}
+
 // Ensure index rebuild handles truncated index (less entries than log has).
 func TestSegmentCorruptIndexTruncated(t *testing.T) {
 	dir := tempDir(t)
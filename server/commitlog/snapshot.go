@@ -0,0 +1,444 @@
+package commitlog
+
+import (
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// snapshotDirName is the directory within the log's where the files of a
+// snapshot transferred from another log are staged until they're installed.
+const snapshotDirName = "snapshot.partial"
+
+var (
+	// ErrSnapshotReleased is returned when reading from a snapshot which was
+	// released.
+	ErrSnapshotReleased = errors.New("snapshot was released")
+
+	// ErrSnapshotChecksum is returned when the data written to a snapshot
+	// file doesn't match the checksum of the file it was transferred from.
+	ErrSnapshotChecksum = errors.New("snapshot file checksum mismatch")
+)
+
+// SnapshotFile identifies one of the files of a segment in a snapshot.
+type SnapshotFile uint8
+
+const (
+	// SnapshotLogFile is a segment's log file.
+	SnapshotLogFile SnapshotFile = iota
+
+	// SnapshotIndexFile is a segment's index file.
+	SnapshotIndexFile
+)
+
+func (f SnapshotFile) suffix() string {
+	if f == SnapshotIndexFile {
+		return indexSuffix
+	}
+	return logSuffix
+}
+
+// SnapshotSegment describes a sealed segment in a snapshot.
+type SnapshotSegment struct {
+	BaseOffset int64
+	LastOffset int64
+	LogSize    int64 // Size of the segment's log file in bytes
+	IndexSize  int64 // Size of the segment's index file in bytes
+}
+
+// size returns the size of the given file of the segment.
+func (s SnapshotSegment) size(file SnapshotFile) int64 {
+	if file == SnapshotIndexFile {
+		return s.IndexSize
+	}
+	return s.LogSize
+}
+
+// EpochOffset is the offset a leader epoch starts at.
+type EpochOffset struct {
+	LeaderEpoch uint64
+	StartOffset int64
+}
+
+// Snapshot is a set of sealed segments of a log offered to a follower for
+// direct transfer, along with the leader epochs of their messages. Retention
+// and compaction leave the log's segments from the snapshot's first one on
+// alone until the snapshot is released.
+type Snapshot struct {
+	Segments  []SnapshotSegment
+	Epochs    []EpochOffset
+	log       *commitLog
+	mu        sync.Mutex
+	files     map[string]*os.File
+	checksums map[string]uint32
+	released  bool
+}
+
+// Snapshot returns the log's sealed segments following the given offset,
+// i.e. those a follower whose log ends at the offset is missing, if they add
+// up to at least minBytes. It returns nil if they don't, if the follower is
+// also missing messages of the segment preceding them, or if the log is being
+// cleaned, in which case the follower should replicate messages instead. The
+// snapshot must be released once the transfer is done.
+func (l *commitLog) Snapshot(after, minBytes int64) *Snapshot {
+	// Don't wait for a clean in progress, which could be rewriting the
+	// segments, to finish. Holding the clean mutex also makes sure the
+	// segments aren't deleted before the snapshot is registered.
+	if !l.cleanMu.TryLock() {
+		return nil
+	}
+	defer l.cleanMu.Unlock()
+
+	l.mu.RLock()
+	segments := l.segments
+	l.mu.RUnlock()
+
+	var (
+		active   = l.activeSegment()
+		snapshot = &Snapshot{
+			log:       l,
+			files:     make(map[string]*os.File),
+			checksums: make(map[string]uint32),
+		}
+		size int64
+	)
+	for i, seg := range segments {
+		if seg == active {
+			break
+		}
+		if seg.BaseOffset <= after || seg.IsEmpty() {
+			continue
+		}
+		if len(snapshot.Segments) == 0 && i > 0 && segments[i-1].LastOffset() > after {
+			return nil
+		}
+		snapshot.Segments = append(snapshot.Segments, SnapshotSegment{
+			BaseOffset: seg.BaseOffset,
+			LastOffset: seg.LastOffset(),
+			LogSize:    seg.Position(),
+			IndexSize:  seg.Index.Position(),
+		})
+		size += seg.Position()
+	}
+	if len(snapshot.Segments) == 0 || size < minBytes {
+		return nil
+	}
+	snapshot.Epochs = l.leaderEpochCache.EpochOffsetsBetween(
+		snapshot.Segments[0].BaseOffset, snapshot.Segments[len(snapshot.Segments)-1].LastOffset)
+
+	l.snapshotsMu.Lock()
+	l.snapshots[snapshot] = struct{}{}
+	l.snapshotsMu.Unlock()
+	return snapshot
+}
+
+// snapshotStart returns the base offset of the first segment held by a
+// snapshot or -1 if no snapshot is held.
+func (l *commitLog) snapshotStart() int64 {
+	l.snapshotsMu.Lock()
+	defer l.snapshotsMu.Unlock()
+	start := int64(-1)
+	for snapshot := range l.snapshots {
+		if base := snapshot.Segments[0].BaseOffset; start == -1 || base < start {
+			start = base
+		}
+	}
+	return start
+}
+
+// Size returns the number of bytes in the snapshot's segments. This does not
+// include the size of the segment indexes.
+func (s *Snapshot) Size() int64 {
+	var size int64
+	for _, seg := range s.Segments {
+		size += seg.LogSize
+	}
+	return size
+}
+
+// segment returns the snapshot segment with the given base offset.
+func (s *Snapshot) segment(baseOffset int64) (SnapshotSegment, bool) {
+	for _, seg := range s.Segments {
+		if seg.BaseOffset == baseOffset {
+			return seg, true
+		}
+	}
+	return SnapshotSegment{}, false
+}
+
+// file returns the given file of the segment with the given base offset,
+// opening it if needed, and the file's size in the snapshot. Must be called
+// within the scope of the snapshot mutex.
+func (s *Snapshot) file(baseOffset int64, file SnapshotFile) (*os.File, int64, error) {
+	if s.released {
+		return nil, 0, ErrSnapshotReleased
+	}
+	seg, ok := s.segment(baseOffset)
+	if !ok {
+		return nil, 0, fmt.Errorf("no segment with base offset %d in snapshot", baseOffset)
+	}
+	path := filepath.Join(s.log.Path, fmt.Sprintf(fileFormat, baseOffset, file.suffix()))
+	f, ok := s.files[path]
+	if !ok {
+		var err error
+		f, err = os.Open(path)
+		if err != nil {
+			return nil, 0, err
+		}
+		s.files[path] = f
+	}
+	return f, seg.size(file), nil
+}
+
+// ReadAt reads the chunk of the given file of the segment with the given base
+// offset starting at the given position into p. Chunks end at the file's size
+// in the snapshot, in which case io.EOF is returned along with the number of
+// bytes read.
+func (s *Snapshot) ReadAt(baseOffset int64, file SnapshotFile, p []byte, pos int64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, size, err := s.file(baseOffset, file)
+	if err != nil {
+		return 0, err
+	}
+	if pos >= size {
+		return 0, io.EOF
+	}
+	if remaining := size - pos; int64(len(p)) >= remaining {
+		p = p[:remaining]
+		n, err := f.ReadAt(p, pos)
+		if err == nil {
+			err = io.EOF
+		}
+		return n, err
+	}
+	return f.ReadAt(p, pos)
+}
+
+// Checksum returns the CRC-32C checksum of the given file of the segment with
+// the given base offset, which the file a follower transferred it to must
+// match.
+func (s *Snapshot) Checksum(baseOffset int64, file SnapshotFile) (uint32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, size, err := s.file(baseOffset, file)
+	if err != nil {
+		return 0, err
+	}
+	if checksum, ok := s.checksums[f.Name()]; ok {
+		return checksum, nil
+	}
+	crc := crc32.New(crc32cTable)
+	if _, err := io.Copy(crc, io.NewSectionReader(f, 0, size)); err != nil {
+		return 0, err
+	}
+	s.checksums[f.Name()] = crc.Sum32()
+	return crc.Sum32(), nil
+}
+
+// Release closes the snapshot's files and lets retention and compaction
+// clean the segments again.
+func (s *Snapshot) Release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.released {
+		return
+	}
+	s.released = true
+	for _, f := range s.files {
+		f.Close()
+	}
+	s.log.snapshotsMu.Lock()
+	delete(s.log.snapshots, s)
+	s.log.snapshotsMu.Unlock()
+}
+
+// SnapshotWriter stages the files of a snapshot transferred from another
+// log in a directory within the log's until they're installed. Since the
+// directory is removed when the log is opened, a partially transferred
+// snapshot is discarded on restart.
+type SnapshotWriter struct {
+	log *commitLog
+	dir string
+}
+
+// NewSnapshotWriter returns a SnapshotWriter for the log, discarding any
+// files staged by a previous one.
+func (l *commitLog) NewSnapshotWriter() (*SnapshotWriter, error) {
+	w := &SnapshotWriter{log: l, dir: filepath.Join(l.Path, snapshotDirName)}
+	if err := w.Discard(); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(w.dir, 0755); err != nil {
+		return nil, errors.Wrap(err, "mkdir failed")
+	}
+	return w, nil
+}
+
+func (w *SnapshotWriter) path(baseOffset int64, file SnapshotFile) string {
+	return filepath.Join(w.dir, fmt.Sprintf(fileFormat, baseOffset, file.suffix()))
+}
+
+// Create creates the given file of the segment with the given base offset in
+// the staging directory, replacing any existing one.
+func (w *SnapshotWriter) Create(baseOffset int64, file SnapshotFile) (*SnapshotFileWriter, error) {
+	f, err := os.Create(w.path(baseOffset, file))
+	if err != nil {
+		return nil, errors.Wrap(err, "create file failed")
+	}
+	return &SnapshotFileWriter{file: f, crc: crc32.New(crc32cTable)}, nil
+}
+
+// Install adds the staged segments, which must follow the end of the log, to
+// the log and reopens it. The given leader epochs of their messages are added
+// to the log's leader epoch offsets and the HW is advanced to the given one,
+// provided the log contains it.
+func (w *SnapshotWriter) Install(segments []SnapshotSegment, epochs []EpochOffset, hw int64) error {
+	if len(segments) == 0 {
+		return errors.New("snapshot has no segments")
+	}
+	for _, seg := range segments {
+		for _, file := range []SnapshotFile{SnapshotLogFile, SnapshotIndexFile} {
+			info, err := os.Stat(w.path(seg.BaseOffset, file))
+			if err != nil {
+				return errors.Wrap(err, "stat file failed")
+			}
+			if info.Size() != seg.size(file) {
+				return fmt.Errorf("staged file %s has size %d, expected %d",
+					info.Name(), info.Size(), seg.size(file))
+			}
+		}
+	}
+	if err := w.log.installSnapshot(w, segments, epochs); err != nil {
+		return err
+	}
+	w.log.SetHighWatermark(min(hw, w.log.NewestOffset()))
+	w.log.notifyObservers(LEOChanged)
+	return w.Discard()
+}
+
+// Discard removes the staged files.
+func (w *SnapshotWriter) Discard() error {
+	return os.RemoveAll(w.dir)
+}
+
+// installSnapshot moves the staged segments into the log's directory and
+// reopens the log. The log's segments are closed and marked replaced so that
+// readers reopen on the new ones, and an empty active segment is deleted since
+// the first staged segment may have its base offset. Index files are moved
+// before log files and segments in order, so if this is interrupted, the log
+// is reopened with the segments which were moved.
+func (l *commitLog) installSnapshot(w *SnapshotWriter, segments []SnapshotSegment,
+	epochs []EpochOffset) error {
+
+	l.cleanMu.Lock()
+	defer l.cleanMu.Unlock()
+	l.appendMu.Lock()
+	defer l.appendMu.Unlock()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	active := l.activeSegment()
+	if next := active.NextOffset(); segments[0].BaseOffset < next {
+		return fmt.Errorf("snapshot starts at offset %d before the end of the log %d",
+			segments[0].BaseOffset, next)
+	}
+	existing := l.segments
+	if active.IsEmpty() {
+		active.Lock()
+		active.replaced = true
+		active.Unlock()
+		if err := active.Delete(); err != nil {
+			return err
+		}
+		existing = existing[:len(existing)-1]
+	}
+	for _, seg := range existing {
+		seg.Lock()
+		err := seg.close()
+		seg.replaced = true
+		seg.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, seg := range segments {
+		for _, file := range []SnapshotFile{SnapshotIndexFile, SnapshotLogFile} {
+			dst := filepath.Join(l.Path, fmt.Sprintf(fileFormat, seg.BaseOffset, file.suffix()))
+			if err := os.Rename(w.path(seg.BaseOffset, file), dst); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Reopening the log recovers the HW from its checkpoint, which may be
+	// behind the one in memory.
+	hw := l.hw
+	l.segments = nil
+	if err := l.open(); err != nil {
+		return err
+	}
+	l.hw = hw
+
+	start := segments[0].BaseOffset
+	if err := l.leaderEpochCache.ClearLatest(start); err != nil {
+		return err
+	}
+	for _, epoch := range epochs {
+		if epoch.LeaderEpoch <= l.leaderEpochCache.LastLeaderEpoch() {
+			continue
+		}
+		if err := l.leaderEpochCache.Assign(epoch.LeaderEpoch, max(epoch.StartOffset, start)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SnapshotFileWriter writes a file of a snapshot, computing its checksum.
+type SnapshotFileWriter struct {
+	file *os.File
+	crc  hash.Hash32
+	size int64
+}
+
+// Write appends p to the file.
+func (f *SnapshotFileWriter) Write(p []byte) (int, error) {
+	n, err := f.file.Write(p)
+	f.crc.Write(p[:n])
+	f.size += int64(n)
+	return n, err
+}
+
+// Size returns the number of bytes written to the file.
+func (f *SnapshotFileWriter) Size() int64 {
+	return f.size
+}
+
+// Commit syncs and closes the file. It returns ErrSnapshotChecksum if the
+// data written doesn't match the given CRC-32C checksum.
+func (f *SnapshotFileWriter) Commit(checksum uint32) error {
+	if err := f.file.Sync(); err != nil {
+		f.file.Close()
+		return err
+	}
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	if f.crc.Sum32() != checksum {
+		return ErrSnapshotChecksum
+	}
+	return nil
+}
+
+// Close closes the file without committing it.
+func (f *SnapshotFileWriter) Close() error {
+	return f.file.Close()
+}
@@ -0,0 +1,169 @@
+package commitlog
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// appendEpochs appends a message with its offset as value to the log for
+// each of the given leader epochs.
+func appendEpochs(t *testing.T, l *commitLog, epochs ...uint64) {
+	for _, epoch := range epochs {
+		offset := l.NewestOffset() + 1
+		_, err := l.Append([]*Message{{
+			Value:       []byte(strconv.FormatInt(offset, 10)),
+			LeaderEpoch: epoch,
+		}})
+		require.NoError(t, err)
+	}
+}
+
+// transferSnapshot copies the files of the snapshot to the writer in chunks
+// of the given size.
+func transferSnapshot(t *testing.T, snapshot *Snapshot, w *SnapshotWriter, chunkSize int) {
+	for _, seg := range snapshot.Segments {
+		for _, file := range []SnapshotFile{SnapshotLogFile, SnapshotIndexFile} {
+			fw, err := w.Create(seg.BaseOffset, file)
+			require.NoError(t, err)
+			buf := make([]byte, chunkSize)
+			for {
+				n, err := snapshot.ReadAt(seg.BaseOffset, file, buf, fw.Size())
+				_, werr := fw.Write(buf[:n])
+				require.NoError(t, werr)
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+			}
+			checksum, err := snapshot.Checksum(seg.BaseOffset, file)
+			require.NoError(t, err)
+			require.NoError(t, fw.Commit(checksum))
+		}
+	}
+}
+
+// Ensure the sealed segments following a follower's log can be transferred
+// to it and installed at the end of its log along with their leader epochs.
+func TestSnapshotInstall(t *testing.T) {
+	leader, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 20,
+	})
+	defer cleanup()
+	appendEpochs(t, leader, 1, 1, 1, 1, 1, 2, 2, 2, 2, 2)
+	leader.SetHighWatermark(9)
+	require.Equal(t, 10, leader.SegmentCount())
+
+	followerOpts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 20,
+	}
+	follower, cleanup := setupWithOptions(t, followerOpts)
+	defer cleanup()
+	appendEpochs(t, follower, 1, 1, 1)
+
+	// The active segment is never offered, and there must be enough bytes
+	// to transfer.
+	require.Nil(t, leader.Snapshot(2, leader.Size()))
+	snapshot := leader.Snapshot(2, 1)
+	require.NotNil(t, snapshot)
+	defer snapshot.Release()
+	require.Len(t, snapshot.Segments, 6)
+	require.Equal(t, int64(3), snapshot.Segments[0].BaseOffset)
+	require.Equal(t, int64(8), snapshot.Segments[5].LastOffset)
+	require.Equal(t, []EpochOffset{{1, 0}, {2, 5}}, snapshot.Epochs)
+
+	w, err := follower.NewSnapshotWriter()
+	require.NoError(t, err)
+	transferSnapshot(t, snapshot, w, 7)
+	require.NoError(t, w.Install(snapshot.Segments, snapshot.Epochs, 9))
+	require.Equal(t, int64(8), follower.NewestOffset())
+	require.Equal(t, int64(8), follower.HighWatermark())
+	require.Equal(t, uint64(2), follower.LastLeaderEpoch())
+	require.Equal(t, int64(5), follower.LastOffsetForLeaderEpoch(1))
+	_, err = os.Stat(filepath.Join(followerOpts.Path, snapshotDirName))
+	require.True(t, os.IsNotExist(err))
+
+	// Replication continues after the snapshot.
+	appendEpochs(t, follower, 2)
+	require.NoError(t, follower.Close())
+	follower, cleanup = setupWithOptions(t, followerOpts)
+	defer cleanup()
+	defer follower.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r, err := follower.NewReader(0, true)
+	require.NoError(t, err)
+	headers := make([]byte, 28)
+	for i := int64(0); i < 10; i++ {
+		msg, offset, _, leaderEpoch, err := r.ReadMessage(ctx, headers)
+		require.NoError(t, err)
+		require.Equal(t, i, offset)
+		require.Equal(t, strconv.FormatInt(i, 10), string(msg.Value()))
+		require.Equal(t, uint64(1+i/5), leaderEpoch)
+	}
+}
+
+// Ensure retention doesn't delete the segments held by a snapshot until it's
+// released.
+func TestSnapshotRetentionHold(t *testing.T) {
+	l, cleanup := setupWithOptions(t, Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 20,
+		MaxLogMessages:  1,
+	})
+	defer cleanup()
+	appendEpochs(t, l, 1, 1, 1, 1, 1)
+	l.SetHighWatermark(4)
+
+	snapshot := l.Snapshot(1, 1)
+	require.NotNil(t, snapshot)
+	require.Equal(t, int64(1), l.RetentionFloor())
+	require.NoError(t, l.Clean())
+	require.Equal(t, int64(2), l.Segments()[0].BaseOffset)
+	buf := make([]byte, 1024)
+	_, err := snapshot.ReadAt(2, SnapshotLogFile, buf, 0)
+	require.Equal(t, io.EOF, err)
+
+	snapshot.Release()
+	_, err = snapshot.ReadAt(2, SnapshotLogFile, buf, 0)
+	require.Equal(t, ErrSnapshotReleased, err)
+	require.NoError(t, l.Clean())
+	require.Equal(t, 1, l.SegmentCount())
+}
+
+// Ensure a snapshot file whose data doesn't match its checksum is rejected
+// and that a partially transferred snapshot is discarded when the log is
+// reopened.
+func TestSnapshotChecksumAndDiscard(t *testing.T) {
+	opts := Options{
+		Path:            tempDir(t),
+		MaxSegmentBytes: 20,
+	}
+	l, cleanup := setupWithOptions(t, opts)
+	defer cleanup()
+	appendEpochs(t, l, 1)
+
+	w, err := l.NewSnapshotWriter()
+	require.NoError(t, err)
+	fw, err := w.Create(5, SnapshotLogFile)
+	require.NoError(t, err)
+	_, err = fw.Write([]byte("foo"))
+	require.NoError(t, err)
+	require.Equal(t, ErrSnapshotChecksum, fw.Commit(42))
+	require.Error(t, w.Install([]SnapshotSegment{{BaseOffset: 5, LastOffset: 5, LogSize: 3}}, nil, 5))
+
+	require.NoError(t, l.Close())
+	l, cleanup = setupWithOptions(t, opts)
+	defer cleanup()
+	defer l.Close()
+	_, err = os.Stat(filepath.Join(opts.Path, snapshotDirName))
+	require.True(t, os.IsNotExist(err))
+	require.Equal(t, int64(0), l.NewestOffset())
+}
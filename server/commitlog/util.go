@@ -45,6 +45,10 @@ func findSegmentIndexByTimestamp(segments []*segment, timestamp int64) (int, err
 	idx := sort.Search(n, func(i int) bool {
 		// Read the first entry in the segment to determine the base timestamp.
 		var entry entry
+		if e := segments[i].load(); e != nil {
+			err = e
+			return true
+		}
 		if e := segments[i].Index.ReadEntryAtLogOffset(&entry, 0); e != nil {
 			err = e
 			return true
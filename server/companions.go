@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"google.golang.org/grpc/metadata"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// companionStreamsMetadataKey is the gRPC metadata key used to declare the
+	// companion streams to create along with a stream. Each value is a
+	// serialized CompanionStream. Since it's a binary key, gRPC takes care of
+	// encoding the values.
+	companionStreamsMetadataKey = "liftbridge-companion-streams-bin"
+
+	// deleteCompanionsMetadataKey is the gRPC metadata key used to also delete
+	// a stream's companions when deleting it since DeleteStreamRequest has no
+	// field for it.
+	deleteCompanionsMetadataKey = "liftbridge-delete-companions"
+
+	// companionStreamPlaceholder is replaced with the name of the primary
+	// stream in companion name and subject templates.
+	companionStreamPlaceholder = "{stream}"
+)
+
+// companionStreamsFromContext returns the companion streams declared in the
+// incoming gRPC metadata when creating the stream for the given request. The
+// companions use the same replication factor as the primary stream and link
+// to it. If a companion is declared as the dead letter queue, the primary
+// stream's config is updated to use it.
+func companionStreamsFromContext(ctx context.Context, req *client.CreateStreamRequest,
+	config *proto.StreamConfig, origin *proto.StreamOrigin) ([]*proto.Stream, error) {
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(companionStreamsMetadataKey)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	var (
+		companions = make([]*proto.Stream, 0, len(values))
+		names      = map[string]struct{}{req.Name: {}}
+	)
+	for _, value := range values {
+		spec := new(proto.CompanionStream)
+		if err := spec.Unmarshal([]byte(value)); err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", companionStreamsMetadataKey, err)
+		}
+		companion, err := newCompanionStream(req, spec, origin)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := names[companion.Name]; ok {
+			return nil, fmt.Errorf("companion stream %s is declared more than once", companion.Name)
+		}
+		names[companion.Name] = struct{}{}
+		if spec.DeadLetterQueue {
+			if config.DeadLetterQueue != "" {
+				return nil, fmt.Errorf("stream already has dead letter queue %s",
+					config.DeadLetterQueue)
+			}
+			config.DeadLetterQueue = companion.Name
+		}
+		companions = append(companions, companion)
+	}
+	return companions, nil
+}
+
+// newCompanionStream returns the companion stream declared by the given spec
+// for the stream being created by the given request.
+func newCompanionStream(req *client.CreateStreamRequest, spec *proto.CompanionStream,
+	origin *proto.StreamOrigin) (*proto.Stream, error) {
+
+	if !strings.Contains(spec.NameTemplate, companionStreamPlaceholder) {
+		return nil, fmt.Errorf("companion name template %q must contain %s",
+			spec.NameTemplate, companionStreamPlaceholder)
+	}
+	if spec.Partitions < 0 {
+		return nil, fmt.Errorf("companion stream partitions cannot be negative")
+	}
+	var (
+		name       = strings.ReplaceAll(spec.NameTemplate, companionStreamPlaceholder, req.Name)
+		subject    = name
+		partitions = spec.Partitions
+		config     = spec.Config
+	)
+	if spec.SubjectTemplate != "" {
+		subject = strings.ReplaceAll(spec.SubjectTemplate, companionStreamPlaceholder, req.Name)
+	}
+	if partitions == 0 {
+		partitions = req.Partitions
+	}
+	if config == nil {
+		config = new(proto.StreamConfig)
+	}
+	if isReservedStream(name) {
+		return nil, fmt.Errorf("companion stream %s is reserved", name)
+	}
+	if err := validateStreamSubject(subject, partitions); err != nil {
+		return nil, fmt.Errorf("companion stream %s subject is invalid: %v", name, err)
+	}
+
+	companion := &proto.Stream{
+		Name:       name,
+		Subject:    subject,
+		Partitions: make([]*proto.Partition, partitions),
+		Config:     config,
+		Primary:    req.Name,
+	}
+	for i := int32(0); i < partitions; i++ {
+		companion.Partitions[i] = &proto.Partition{
+			Subject:           subject,
+			Stream:            name,
+			ReplicationFactor: req.ReplicationFactor,
+			Id:                i,
+		}
+	}
+	if origin != nil {
+		companionOrigin := *origin
+		companionOrigin.Partitions = partitions
+		companionOrigin.Group = ""
+		companion.Origin = &companionOrigin
+	}
+	return companion, nil
+}
+
+// deleteCompanionsFromContext indicates if the incoming gRPC metadata asks to
+// delete a stream's companions along with it.
+func deleteCompanionsFromContext(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	values := md.Get(deleteCompanionsMetadataKey)
+	switch {
+	case len(values) == 0:
+		return false, nil
+	case len(values) > 1:
+		return false, fmt.Errorf("only one %s can be set", deleteCompanionsMetadataKey)
+	}
+	switch values[0] {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid %s %q", deleteCompanionsMetadataKey, values[0])
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// companionContext returns an incoming context declaring the given companion
+// streams.
+func companionContext(t *testing.T, companions ...*proto.CompanionStream) context.Context {
+	md := metadata.MD{}
+	for _, companion := range companions {
+		data, err := companion.Marshal()
+		require.NoError(t, err)
+		md.Append(companionStreamsMetadataKey, string(data))
+	}
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+// Ensure a stream declaring a dead letter queue companion is created along
+// with it, uses it as its dead letter queue, and is deleted along with it when
+// deleting with cascade.
+func TestCreateStreamWithCompanion(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	ctx := companionContext(t, &proto.CompanionStream{
+		NameTemplate:    "{stream}.dlq",
+		DeadLetterQueue: true,
+	})
+	_, err := s1.api.CreateStream(ctx, &client.CreateStreamRequest{
+		Name:       "foo",
+		Subject:    "foo",
+		Partitions: 2,
+	})
+	require.NoError(t, err)
+
+	primary := s1.metadata.GetStream("foo")
+	require.NotNil(t, primary)
+	companion := s1.metadata.GetStream("foo.dlq")
+	require.NotNil(t, companion)
+	require.Equal(t, "foo.dlq", primary.GetConfig().DeadLetterQueue)
+	require.Equal(t, []string{"foo.dlq"}, primary.GetCompanions())
+	require.Equal(t, "foo", companion.GetPrimary())
+	require.Equal(t, "foo.dlq", companion.GetSubject())
+	require.Len(t, companion.GetPartitions(), 2)
+	require.Equal(t, primary.GetCreationTime(), companion.GetCreationTime())
+
+	// The links are included in the stream's protobuf.
+	require.Equal(t, "foo", companion.Proto().Primary)
+	require.Equal(t, []string{"foo.dlq"}, primary.Proto().Companions)
+
+	ctx = metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(deleteCompanionsMetadataKey, "true"))
+	_, err = s1.api.DeleteStream(ctx, &client.DeleteStreamRequest{Name: "foo"})
+	require.NoError(t, err)
+	require.Nil(t, s1.metadata.GetStream("foo"))
+	require.Nil(t, s1.metadata.GetStream("foo.dlq"))
+}
+
+// Ensure deleting a stream without cascade leaves its companions but unlinks
+// them from it.
+func TestDeleteStreamWithoutCascade(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	ctx := companionContext(t, &proto.CompanionStream{
+		NameTemplate: "{stream}.overflow",
+		Partitions:   1,
+	})
+	_, err := s1.api.CreateStream(ctx, &client.CreateStreamRequest{
+		Name:       "foo",
+		Subject:    "foo",
+		Partitions: 3,
+	})
+	require.NoError(t, err)
+	companion := s1.metadata.GetStream("foo.overflow")
+	require.NotNil(t, companion)
+	require.Len(t, companion.GetPartitions(), 1)
+	require.Empty(t, s1.metadata.GetStream("foo").GetConfig().DeadLetterQueue)
+
+	_, err = s1.api.DeleteStream(context.Background(), &client.DeleteStreamRequest{Name: "foo"})
+	require.NoError(t, err)
+	require.Nil(t, s1.metadata.GetStream("foo"))
+	companion = s1.metadata.GetStream("foo.overflow")
+	require.NotNil(t, companion)
+	require.Empty(t, companion.GetPrimary())
+}
+
+// Ensure neither a stream nor its companions are created if one of the
+// companions already exists.
+func TestCreateStreamCompanionExists(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	_, err := s1.api.CreateStream(context.Background(), &client.CreateStreamRequest{
+		Name:    "foo.audit",
+		Subject: "foo.audit",
+	})
+	require.NoError(t, err)
+
+	ctx := companionContext(t,
+		&proto.CompanionStream{NameTemplate: "{stream}.dlq", DeadLetterQueue: true},
+		&proto.CompanionStream{NameTemplate: "{stream}.audit"},
+	)
+	_, err = s1.api.CreateStream(ctx, &client.CreateStreamRequest{
+		Name:    "foo",
+		Subject: "foo",
+	})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	require.Nil(t, s1.metadata.GetStream("foo"))
+	require.Nil(t, s1.metadata.GetStream("foo.dlq"))
+}
+
+// Ensure invalid companion declarations are rejected.
+func TestCompanionStreamsFromContextInvalid(t *testing.T) {
+	req := &client.CreateStreamRequest{Name: "foo", Subject: "foo", Partitions: 1}
+
+	for _, companions := range [][]*proto.CompanionStream{
+		{{NameTemplate: "dlq"}},
+		{{NameTemplate: "{stream}.dlq", Partitions: -1}},
+		{{NameTemplate: "{stream}.dlq"}, {NameTemplate: "{stream}.dlq"}},
+		{{NameTemplate: "{stream}.dlq", DeadLetterQueue: true},
+			{NameTemplate: "{stream}.dlq2", DeadLetterQueue: true}},
+	} {
+		_, err := companionStreamsFromContext(companionContext(t, companions...), req,
+			new(proto.StreamConfig), nil)
+		require.Error(t, err)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(companionStreamsMetadataKey, "\xff"))
+	_, err := companionStreamsFromContext(ctx, req, new(proto.StreamConfig), nil)
+	require.Error(t, err)
+}
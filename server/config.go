@@ -3,6 +3,7 @@ package server
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"fmt"
 	"net"
 	"os"
@@ -29,6 +30,27 @@ const (
 
 	// DefaultPort is the port to bind to if one is not specified.
 	DefaultPort = 9292
+
+	// LogFormatText writes human-readable log output.
+	LogFormatText = "text"
+
+	// LogFormatJSON writes each log statement as a JSON object.
+	LogFormatJSON = "json"
+
+	// LeaderPlacementLoad selects partition leaders by leadership load only.
+	LeaderPlacementLoad = "load"
+
+	// LeaderPlacementLatency selects partition leaders by leadership load and
+	// then by round-trip time to the other replicas.
+	LeaderPlacementLatency = "latency"
+
+	// MessageTimestampTypeLogAppendTime sets message timestamps to the time
+	// the partition leader appended them to the log.
+	MessageTimestampTypeLogAppendTime = "log_append_time"
+
+	// MessageTimestampTypeCreateTime keeps the timestamps publishers set on
+	// messages, falling back to the log append time for messages without one.
+	MessageTimestampTypeCreateTime = "create_time"
 )
 
 // Config setting defaults.
@@ -42,24 +64,58 @@ const (
 	defaultRaftSnapshots                  = 2
 	defaultRaftCacheSize                  = 512
 	defaultMetadataCacheMaxAge            = 2 * time.Minute
+	defaultDrainTimeout                   = 10 * time.Second
+	defaultSubscriptionHeartbeatInterval  = 30 * time.Second
+	defaultSubscriptionHeartbeatTimeout   = 30 * time.Second
+	defaultSubscriptionMaxPerPartition    = 0 // Unlimited
+	defaultSubscriptionMaxPerServer       = 0 // Unlimited
 	defaultBatchMaxMessages               = 1024
 	defaultBatchMaxTime                   = 0 // Disabled by default; set to enable waiting for batches
 	defaultReplicaFetchTimeout            = 3 * time.Second
+	defaultReplicaFetchPipelineDepth      = 4
+	defaultReplicaSnapshotMinBytes        = 1024 * 1024 * 1024 // 1GB
+	defaultISRExpansionMinCatchupTime     = 5 * time.Second
 	defaultMinInsyncReplicas              = 1
 	defaultRetentionMaxAge                = 7 * 24 * time.Hour
 	defaultCleanerInterval                = 5 * time.Minute
 	defaultMaxSegmentBytes                = 1024 * 1024 * 256 // 256MB
 	defaultMaxSegmentAge                  = defaultRetentionMaxAge
+	defaultCompactDeleteRetention         = 24 * time.Hour
 	defaultActivityStreamPublishTimeout   = 5 * time.Second
 	defaultActivityStreamPublishAckPolicy = client.AckPolicy_ALL
+	defaultActivityStreamRetentionMaxAge  = defaultRetentionMaxAge
 	defaultCursorsStreamReplicationFactor = maxReplicationFactor
 	defaultCursorsStreamAutoPauseTime     = time.Minute
 	defaultConcurrencyControl             = false
 	defaultEncryption                     = false
+	defaultAutoResumeOnPublish            = true
+	defaultPauseDrainTimeout              = 30 * time.Second
+	defaultPartitionEventsMaxRate         = 10
+	defaultCorrelationIndexTTL            = 10 * time.Minute
+	defaultCorrelationIndexScanDepth      = 10000
 	defaultGroupsConsumerTimeout          = 15 * time.Second
 	defaultGroupsCoordinatorTimeout       = 15 * time.Second
 	defaultTelemetryEnabled               = true
 	defaultTelemetryIntervalSeconds       = 86400 // 24 hours
+	defaultAllocationSampleRate           = 64
+	defaultExportMaxBytesPerSecond        = 10 * 1024 * 1024 // 10MB
+	defaultBackupInterval                 = time.Minute
+	defaultBackupMaxBytesPerSecond        = 10 * 1024 * 1024 // 10MB
+	defaultRTTProbeInterval               = 10 * time.Second
+	defaultSkewReportInterval             = 30 * time.Second
+	defaultSkewThreshold                  = 2.0
+	defaultSkewMinRate                    = 10
+	defaultDiskEmergencyCheckInterval     = 10 * time.Second
+	defaultDiskEmergencyMinInterval       = time.Minute
+	defaultLeaderLoadTolerance            = 1
+	defaultStreamTTLCheckInterval         = 30 * time.Second
+	defaultScheduledOpCheckInterval       = time.Second
+	defaultRequestDeduplicationWindow     = 5 * time.Minute
+	defaultReplicaRepairGracePeriod       = 10 * time.Minute
+	defaultReplicaRepairMaxPerInterval    = 1
+	defaultPropagateMaxInflight           = 256
+	defaultPropagateMaxRetries            = 10
+	defaultWebSocketListenAddress         = "0.0.0.0:9293"
 )
 
 // Config setting key names.
@@ -67,13 +123,22 @@ const (
 	configListen              = "listen"
 	configHost                = "host"
 	configPort                = "port"
+	configListeners           = "listeners"
+	configQuotas              = "quotas"
 	configDataDir             = "data.dir"
 	configMetadataCacheMaxAge = "metadata.cache.max.age"
+	configDrainTimeout        = "drain.timeout"
+
+	configSubscriptionHeartbeatInterval = "subscription.heartbeat.interval"
+	configSubscriptionHeartbeatTimeout  = "subscription.heartbeat.timeout"
+	configSubscriptionMaxPerPartition   = "subscription.max.per.partition"
+	configSubscriptionMaxPerServer      = "subscription.max.per.server"
 
 	configLoggingLevel    = "logging.level"
 	configLoggingRecovery = "logging.recovery"
 	configLoggingRaft     = "logging.raft"
 	configLoggingNATS     = "logging.nats"
+	configLoggingFormat   = "logging.format"
 
 	configBatchMaxMessages = "batch.max.messages"
 	configBatchMaxTime     = "batch.max.time"
@@ -98,18 +163,38 @@ const (
 	configStreamsRetentionMaxBytes             = "streams.retention.max.bytes"
 	configStreamsRetentionMaxMessages          = "streams.retention.max.messages"
 	configStreamsRetentionMaxAge               = "streams.retention.max.age"
+	configStreamsRetentionFollowerFloor        = "streams.retention.follower.floor"
 	configStreamsCleanerInterval               = "streams.cleaner.interval"
 	configStreamsSegmentMaxBytes               = "streams.segment.max.bytes"
 	configStreamsSegmentMaxAge                 = "streams.segment.max.age"
+	configStreamsSegmentMaxAgeJitter           = "streams.segment.max.age.jitter"
+	configStreamsSegmentPreallocateBytes       = "streams.segment.preallocate.bytes"
 	configStreamsCompactEnabled                = "streams.compact.enabled"
 	configStreamsCompactMaxGoroutines          = "streams.compact.max.goroutines"
+	configStreamsCompactDeleteRetention        = "streams.compact.delete.retention.ms"
+	configStreamsCompactBloomFilter            = "streams.compact.bloom.filter"
 	configStreamsAutoPauseTime                 = "streams.auto.pause.time"
 	configStreamsAutoPauseDisableIfSubscribers = "streams.auto.pause.disable.if.subscribers"
+	configStreamsAutoResumeOnPublish           = "streams.auto.resume.on.publish"
+	configStreamsMaxMessageBytes               = "streams.max.message.bytes"
 	configStreamsConcurrencyControl            = "streams.concurrency.control"
 	configStreamsEncryption                    = "streams.encryption"
+	configStreamsSegmentEncryption             = "streams.segment.encryption.enabled"
+	configStreamsSegmentEncryptionKey          = "streams.segment.encryption.key"
+	configStreamsExclusiveSubjects             = "streams.exclusive.subjects"
+	configStreamsPublishDirect                 = "streams.publish.direct"
+	configStreamsCreateWaitForISR              = "streams.create.wait.for.isr"
+	configStreamsPauseDrainTimeout             = "streams.pause.drain.timeout"
+	configStreamsPartitionEventsMaxRate        = "streams.partition.events.max.rate"
+	configStreamsMessageTimestampType          = "streams.message.timestamp.type"
+	configStreamsMessageTimestampMaxDifference = "streams.message.timestamp.max.difference"
+	configStreamsCorrelationIndexSize          = "streams.correlation.index.size"
+	configStreamsCorrelationIndexTTL           = "streams.correlation.index.ttl"
+	configStreamsCorrelationIndexScanDepth     = "streams.correlation.index.scan.depth"
 
 	configClusteringServerID                = "clustering.server.id"
 	configClusteringNamespace               = "clustering.namespace"
+	configClusteringSubjectPrefix           = "clustering.subject.prefix"
 	configClusteringRaftSnapshotRetain      = "clustering.raft.snapshot.retain"
 	configClusteringRaftSnapshotThreshold   = "clustering.raft.snapshot.threshold"
 	configClusteringRaftCacheSize           = "clustering.raft.cache.size"
@@ -120,12 +205,36 @@ const (
 	configClusteringReplicaMaxLeaderTimeout = "clustering.replica.max.leader.timeout"
 	configClusteringReplicaMaxIdleWait      = "clustering.replica.max.idle.wait"
 	configClusteringReplicaFetchTimeout     = "clustering.replica.fetch.timeout"
+	configClusteringReplicaFetchDepth       = "clustering.replica.fetch.pipeline.depth"
+	configClusteringReplicaSnapshotMinBytes = "clustering.replica.snapshot.min.bytes"
+	configClusteringLeaderDisconnectTimeout = "clustering.leader.disconnect.timeout"
+	configClusteringLeaderLeaseDuration     = "clustering.leader.lease.duration"
 	configClusteringMinInsyncReplicas       = "clustering.min.insync.replicas"
+	configClusteringISRExpansionCatchupTime = "clustering.isr.expansion.min.catchup.time"
 	configClusteringReplicationMaxBytes     = "clustering.replication.max.bytes"
+	configClusteringThrottleReplicaRate     = "clustering.replication.throttle.replica.bytes.per.second"
+	configClusteringThrottleBrokerRate      = "clustering.replication.throttle.broker.bytes.per.second"
+	configClusteringThrottleLag             = "clustering.replication.throttle.lag.threshold"
+	configClusteringAuthKeys                = "clustering.auth.keys"
+	configClusteringAuthStrict              = "clustering.auth.strict"
+	configClusteringRTTProbeInterval        = "clustering.rtt.probe.interval"
+	configClusteringLeaderPlacement         = "clustering.leader.placement"
+	configClusteringLeaderLoadTolerance     = "clustering.leader.load.tolerance"
+	configClusteringExcludeObservers        = "clustering.placement.exclude.observers"
+	configClusteringStreamTTLCheckInterval  = "clustering.stream.ttl.check.interval"
+	configClusteringScheduledOpInterval     = "clustering.scheduled.operation.check.interval"
+	configClusteringRequestDedupWindow      = "clustering.request.deduplication.window"
+	configClusteringRepairInterval          = "clustering.replica.repair.interval"
+	configClusteringRepairGracePeriod       = "clustering.replica.repair.grace.period"
+	configClusteringRepairMaxPerInterval    = "clustering.replica.repair.max.per.interval"
+	configClusteringPropagateMaxInflight    = "clustering.propagate.max.inflight"
+	configClusteringPropagateMaxRetries     = "clustering.propagate.max.retries"
 
 	configActivityStreamEnabled          = "activity.stream.enabled"
 	configActivityStreamPublishTimeout   = "activity.stream.publish.timeout"
 	configActivityStreamPublishAckPolicy = "activity.stream.publish.ack.policy"
+	configActivityStreamRetentionMaxAge  = "activity.stream.retention.max.age"
+	configActivityStreamPartitionEvents  = "activity.stream.partition.events"
 
 	configCursorsStreamPartitions        = "cursors.stream.partitions"
 	configCursorsStreamReplicationFactor = "cursors.stream.replication.factor"
@@ -136,18 +245,52 @@ const (
 
 	configTelemetryEnabled         = "telemetry.enabled"
 	configTelemetryIntervalSeconds = "telemetry.interval.seconds"
+
+	configDiagnosticsAllocationSampleRate = "diagnostics.allocation.sample.rate"
+
+	configExportMaxBytesPerSecond = "export.max.bytes.per.second"
+
+	configBackupDir               = "backup.dir"
+	configBackupInterval          = "backup.interval"
+	configBackupMaxBytesPerSecond = "backup.max.bytes.per.second"
+
+	configSkewReportInterval = "skew.report.interval"
+	configSkewThreshold      = "skew.threshold"
+	configSkewMinRate        = "skew.min.rate"
+
+	configTracingOTLPEndpoint = "tracing.otlp.endpoint"
+
+	configDiskEmergencyThreshold     = "disk.emergency.threshold"
+	configDiskEmergencyCheckInterval = "disk.emergency.check.interval"
+	configDiskEmergencyMinInterval   = "disk.emergency.min.interval"
+	configDiskEmergencyBudget        = "disk.emergency.budget"
+
+	configAuthEnabled         = "auth.enabled"
+	configAuthBackend         = "auth.backend"
+	configAuthCredentialsFile = "auth.credentials.file"
+
+	configWebSocketEnabled       = "websocket.enabled"
+	configWebSocketListenAddress = "websocket.listen.address"
 )
 
 var configKeys = map[string]struct{}{
 	configListen:                               {},
 	configHost:                                 {},
 	configPort:                                 {},
+	configListeners:                            {},
+	configQuotas:                               {},
 	configDataDir:                              {},
 	configMetadataCacheMaxAge:                  {},
+	configDrainTimeout:                         {},
+	configSubscriptionHeartbeatInterval:        {},
+	configSubscriptionHeartbeatTimeout:         {},
+	configSubscriptionMaxPerPartition:          {},
+	configSubscriptionMaxPerServer:             {},
 	configLoggingLevel:                         {},
 	configLoggingRecovery:                      {},
 	configLoggingRaft:                          {},
 	configLoggingNATS:                          {},
+	configLoggingFormat:                        {},
 	configBatchMaxMessages:                     {},
 	configBatchMaxTime:                         {},
 	configTLSKey:                               {},
@@ -168,17 +311,37 @@ var configKeys = map[string]struct{}{
 	configStreamsRetentionMaxBytes:             {},
 	configStreamsRetentionMaxMessages:          {},
 	configStreamsRetentionMaxAge:               {},
+	configStreamsRetentionFollowerFloor:        {},
 	configStreamsCleanerInterval:               {},
 	configStreamsSegmentMaxBytes:               {},
 	configStreamsSegmentMaxAge:                 {},
+	configStreamsSegmentMaxAgeJitter:           {},
+	configStreamsSegmentPreallocateBytes:       {},
 	configStreamsCompactEnabled:                {},
 	configStreamsConcurrencyControl:            {},
 	configStreamsEncryption:                    {},
+	configStreamsSegmentEncryption:             {},
+	configStreamsSegmentEncryptionKey:          {},
+	configStreamsExclusiveSubjects:             {},
+	configStreamsPublishDirect:                 {},
+	configStreamsCreateWaitForISR:              {},
+	configStreamsPauseDrainTimeout:             {},
+	configStreamsPartitionEventsMaxRate:        {},
+	configStreamsMessageTimestampType:          {},
+	configStreamsMessageTimestampMaxDifference: {},
+	configStreamsCorrelationIndexSize:          {},
+	configStreamsCorrelationIndexTTL:           {},
+	configStreamsCorrelationIndexScanDepth:     {},
 	configStreamsCompactMaxGoroutines:          {},
+	configStreamsCompactDeleteRetention:        {},
+	configStreamsCompactBloomFilter:            {},
 	configStreamsAutoPauseTime:                 {},
 	configStreamsAutoPauseDisableIfSubscribers: {},
+	configStreamsAutoResumeOnPublish:           {},
+	configStreamsMaxMessageBytes:               {},
 	configClusteringServerID:                   {},
 	configClusteringNamespace:                  {},
+	configClusteringSubjectPrefix:              {},
 	configClusteringRaftSnapshotRetain:         {},
 	configClusteringRaftSnapshotThreshold:      {},
 	configClusteringRaftCacheSize:              {},
@@ -189,11 +352,35 @@ var configKeys = map[string]struct{}{
 	configClusteringReplicaMaxLeaderTimeout:    {},
 	configClusteringReplicaMaxIdleWait:         {},
 	configClusteringReplicaFetchTimeout:        {},
+	configClusteringReplicaFetchDepth:          {},
+	configClusteringReplicaSnapshotMinBytes:    {},
+	configClusteringLeaderDisconnectTimeout:    {},
+	configClusteringLeaderLeaseDuration:        {},
 	configClusteringMinInsyncReplicas:          {},
+	configClusteringISRExpansionCatchupTime:    {},
 	configClusteringReplicationMaxBytes:        {},
+	configClusteringThrottleReplicaRate:        {},
+	configClusteringThrottleBrokerRate:         {},
+	configClusteringThrottleLag:                {},
+	configClusteringAuthKeys:                   {},
+	configClusteringAuthStrict:                 {},
+	configClusteringRTTProbeInterval:           {},
+	configClusteringLeaderPlacement:            {},
+	configClusteringLeaderLoadTolerance:        {},
+	configClusteringExcludeObservers:           {},
+	configClusteringStreamTTLCheckInterval:     {},
+	configClusteringScheduledOpInterval:        {},
+	configClusteringRequestDedupWindow:         {},
+	configClusteringRepairInterval:             {},
+	configClusteringRepairGracePeriod:          {},
+	configClusteringRepairMaxPerInterval:       {},
+	configClusteringPropagateMaxInflight:       {},
+	configClusteringPropagateMaxRetries:        {},
 	configActivityStreamEnabled:                {},
 	configActivityStreamPublishTimeout:         {},
 	configActivityStreamPublishAckPolicy:       {},
+	configActivityStreamRetentionMaxAge:        {},
+	configActivityStreamPartitionEvents:        {},
 	configCursorsStreamPartitions:              {},
 	configCursorsStreamReplicationFactor:       {},
 	configCursorsStreamAutoPauseTime:           {},
@@ -201,6 +388,24 @@ var configKeys = map[string]struct{}{
 	configGroupsCoordinatorTimeout:             {},
 	configTelemetryEnabled:                     {},
 	configTelemetryIntervalSeconds:             {},
+	configDiagnosticsAllocationSampleRate:      {},
+	configExportMaxBytesPerSecond:              {},
+	configBackupDir:                            {},
+	configBackupInterval:                       {},
+	configBackupMaxBytesPerSecond:              {},
+	configSkewReportInterval:                   {},
+	configSkewThreshold:                        {},
+	configSkewMinRate:                          {},
+	configTracingOTLPEndpoint:                  {},
+	configDiskEmergencyThreshold:               {},
+	configDiskEmergencyCheckInterval:           {},
+	configDiskEmergencyMinInterval:             {},
+	configDiskEmergencyBudget:                  {},
+	configAuthEnabled:                          {},
+	configAuthBackend:                          {},
+	configAuthCredentialsFile:                  {},
+	configWebSocketEnabled:                     {},
+	configWebSocketListenAddress:               {},
 }
 
 // StreamsConfig contains settings for controlling the message log for streams.
@@ -208,16 +413,35 @@ type StreamsConfig struct {
 	RetentionMaxBytes             int64
 	RetentionMaxMessages          int64
 	RetentionMaxAge               time.Duration
+	RetentionFollowerFloor        bool
 	CleanerInterval               time.Duration
 	SegmentMaxBytes               int64
 	SegmentMaxAge                 time.Duration
+	SegmentMaxAgeJitter           time.Duration // Max time segments roll before SegmentMaxAge so partitions don't roll at once
+	SegmentPreallocateBytes       int64
 	Compact                       bool
 	CompactMaxGoroutines          int
+	CompactDeleteRetention        time.Duration
+	CompactBloomFilter            bool
 	AutoPauseTime                 time.Duration
 	AutoPauseDisableIfSubscribers bool
+	AutoResumeOnPublish           bool
+	MaxMessageBytes               int64
 	MinISR                        int
 	ConcurrencyControl            bool
 	Encryption                    bool
+	SegmentEncryption             bool
+	SegmentEncryptionKey          []byte
+	ExclusiveSubjects             bool
+	PublishDirect                 bool
+	CreateWaitForISR              bool
+	PauseDrainTimeout             time.Duration
+	PartitionEventsMaxRate        int // Max events per second sent to each WatchPartition watcher
+	MessageTimestampType          string
+	MessageTimestampMaxDifference time.Duration
+	CorrelationIndexSize          int           // Correlation IDs indexed per partition, disabled if 0
+	CorrelationIndexTTL           time.Duration // How long a correlation ID stays indexed
+	CorrelationIndexScanDepth     int64         // Messages at the end of the log indexed when a leader starts
 }
 
 // RetentionString returns a human-readable string representation of the
@@ -304,6 +528,14 @@ func (l *StreamsConfig) ApplyOverrides(c *proto.StreamConfig) {
 		l.AutoPauseDisableIfSubscribers = autoPauseDisableIfSubscribers.Value
 	}
 
+	if autoResumeOnPublish := c.AutoResumeOnPublish; autoResumeOnPublish != nil {
+		l.AutoResumeOnPublish = autoResumeOnPublish.Value
+	}
+
+	if maxMessageBytes := c.MaxMessageBytes; maxMessageBytes != nil {
+		l.MaxMessageBytes = maxMessageBytes.Value
+	}
+
 	if minISR := c.MinIsr; minISR != nil {
 		l.MinISR = int(minISR.Value)
 	}
@@ -315,24 +547,106 @@ func (l *StreamsConfig) ApplyOverrides(c *proto.StreamConfig) {
 	if encryption := c.Encryption; encryption != nil {
 		l.Encryption = encryption.Value
 	}
+
+	if segmentEncryption := c.SegmentEncryption; segmentEncryption != nil {
+		l.SegmentEncryption = segmentEncryption.Value
+	}
+
+	if timestampType := c.MessageTimestampType; timestampType != "" {
+		l.MessageTimestampType = timestampType
+	}
+
+	if maxDifference := c.MessageTimestampMaxDifference; maxDifference != nil {
+		l.MessageTimestampMaxDifference = time.Duration(maxDifference.Value) * time.Millisecond
+	}
+}
+
+// StreamConfig returns the given stream configuration with every setting
+// ApplyOverrides reads set to its value in the StreamsConfig, i.e. the
+// effective configuration of a stream whose StreamsConfig was resolved from
+// the given one. Settings without a server default, such as the stream's dead
+// letter queue and TTL, are copied as is.
+func (l *StreamsConfig) StreamConfig(c *proto.StreamConfig) *proto.StreamConfig {
+	config := &proto.StreamConfig{
+		RetentionMaxBytes:             &proto.NullableInt64{Value: l.RetentionMaxBytes},
+		RetentionMaxMessages:          &proto.NullableInt64{Value: l.RetentionMaxMessages},
+		RetentionMaxAge:               &proto.NullableInt64{Value: l.RetentionMaxAge.Milliseconds()},
+		CleanerInterval:               &proto.NullableInt64{Value: l.CleanerInterval.Milliseconds()},
+		SegmentMaxBytes:               &proto.NullableInt64{Value: l.SegmentMaxBytes},
+		SegmentMaxAge:                 &proto.NullableInt64{Value: l.SegmentMaxAge.Milliseconds()},
+		CompactMaxGoroutines:          &proto.NullableInt32{Value: int32(l.CompactMaxGoroutines)},
+		CompactEnabled:                &proto.NullableBool{Value: l.Compact},
+		AutoPauseTime:                 &proto.NullableInt64{Value: l.AutoPauseTime.Milliseconds()},
+		AutoPauseDisableIfSubscribers: &proto.NullableBool{Value: l.AutoPauseDisableIfSubscribers},
+		MinIsr:                        &proto.NullableInt32{Value: int32(l.MinISR)},
+		OptimisticConcurrencyControl:  &proto.NullableBool{Value: l.ConcurrencyControl},
+		Encryption:                    &proto.NullableBool{Value: l.Encryption},
+		SegmentEncryption:             &proto.NullableBool{Value: l.SegmentEncryption},
+		MessageTimestampType:          l.MessageTimestampType,
+		MessageTimestampMaxDifference: &proto.NullableInt64{Value: l.MessageTimestampMaxDifference.Milliseconds()},
+		AutoResumeOnPublish:           &proto.NullableBool{Value: l.AutoResumeOnPublish},
+		MaxMessageBytes:               &proto.NullableInt64{Value: l.MaxMessageBytes},
+	}
+	if c != nil {
+		config.DeadLetterQueue = c.DeadLetterQueue
+		config.MirrorSource = c.MirrorSource
+		config.RestoreSource = c.RestoreSource
+		config.Ttl = c.Ttl
+	}
+	return config
 }
 
 // ClusteringConfig contains settings for controlling cluster behavior.
 type ClusteringConfig struct {
-	ServerID                string
-	Namespace               string
-	RaftSnapshots           int
-	RaftSnapshotThreshold   uint64
-	RaftCacheSize           int
-	RaftBootstrapSeed       bool
-	RaftBootstrapPeers      []string
-	RaftMaxQuorumSize       uint
-	ReplicaMaxLagTime       time.Duration
-	ReplicaMaxLeaderTimeout time.Duration
-	ReplicaFetchTimeout     time.Duration
-	ReplicaMaxIdleWait      time.Duration
-	MinISR                  int
-	ReplicationMaxBytes     int64
+	ServerID                   string
+	Namespace                  string
+	SubjectPrefix              string
+	RaftSnapshots              int
+	RaftSnapshotThreshold      uint64
+	RaftCacheSize              int
+	RaftBootstrapSeed          bool
+	RaftBootstrapPeers         []string
+	RaftMaxQuorumSize          uint
+	ReplicaMaxLagTime          time.Duration
+	ReplicaMaxLeaderTimeout    time.Duration
+	ReplicaFetchTimeout        time.Duration
+	ReplicaFetchPipelineDepth  int
+	ReplicaSnapshotMinBytes    int64
+	ReplicaMaxIdleWait         time.Duration
+	LeaderDisconnectTimeout    time.Duration
+	LeaderLeaseDuration        time.Duration
+	MinISR                     int
+	ISRExpansionMinCatchupTime time.Duration
+	ReplicationMaxBytes        int64
+	ReplicationThrottle        ReplicationThrottleConfig
+	ReplicaRepair              ReplicaRepairConfig
+	AuthKeys                   []string
+	AuthStrict                 bool
+	RTTProbeInterval           time.Duration
+	LeaderPlacement            string
+	LeaderLoadTolerance        int
+	ExcludeObservers           bool
+	StreamTTLCheckInterval     time.Duration
+	ScheduledOpCheckInterval   time.Duration
+	RequestDeduplicationWindow time.Duration
+	PropagateMaxInflight       int // Requests forwarded to the metadata leader at once, unlimited if 0
+	PropagateMaxRetries        int // Retries of a request forwarded to the metadata leader
+}
+
+// ReplicationThrottleConfig contains settings for limiting the rate at which a
+// partition leader sends messages to followers which are catching up.
+type ReplicationThrottleConfig struct {
+	ReplicaRate  int64 // Bytes per second per follower, unlimited if 0
+	BrokerRate   int64 // Bytes per second for all throttled followers, unlimited if 0
+	LagThreshold int64 // Messages a follower outside the ISR may lag before it's throttled
+}
+
+// ReplicaRepairConfig contains settings for replacing the replicas of
+// partitions hosted on brokers which have been lost.
+type ReplicaRepairConfig struct {
+	Interval       time.Duration // How often partitions are checked, disabled if 0
+	GracePeriod    time.Duration // How long a broker is unavailable before it's considered lost
+	MaxPerInterval int           // Replicas replaced per check
 }
 
 // ActivityStreamConfig contains settings for controlling activity stream
@@ -341,6 +655,8 @@ type ActivityStreamConfig struct {
 	Enabled          bool
 	PublishTimeout   time.Duration
 	PublishAckPolicy client.AckPolicy
+	RetentionMaxAge  time.Duration
+	PartitionEvents  bool
 }
 
 // CursorsStreamConfig contains settings for controlling cursors stream
@@ -363,36 +679,123 @@ type TelemetryConfig struct {
 	IntervalSeconds int
 }
 
+// DiagnosticsConfig contains settings for controlling the diagnostics a server
+// collects about itself.
+type DiagnosticsConfig struct {
+	AllocationSampleRate int
+}
+
+// ExportConfig contains settings for controlling message exports.
+type ExportConfig struct {
+	MaxBytesPerSecond int64
+}
+
+// BackupConfig contains settings for controlling continuous partition
+// backups.
+type BackupConfig struct {
+	Dir               string
+	Interval          time.Duration
+	MaxBytesPerSecond int64
+}
+
+// SkewConfig contains settings for controlling partition skew detection.
+type SkewConfig struct {
+	ReportInterval time.Duration
+	Threshold      float64
+	MinRate        int64
+}
+
+// TracingConfig contains settings for controlling distributed tracing.
+type TracingConfig struct {
+	OTLPEndpoint string // Spans are sent to the global tracer provider if empty
+}
+
+// DiskConfig contains settings for controlling emergency retention when the
+// disk holding the data directory runs low on space.
+type DiskConfig struct {
+	EmergencyThreshold     float64 // Fraction of the disk used, 0 disables
+	EmergencyCheckInterval time.Duration
+	EmergencyMinInterval   time.Duration
+	EmergencyBudget        int64 // Bytes reclaimed per emergency clean, 0 is unlimited
+}
+
+// AuthConfig contains settings for authenticating clients with SASL
+// credentials.
+type AuthConfig struct {
+	Enabled         bool
+	Backend         string
+	CredentialsFile string // YAML mapping of username to credential
+}
+
+// WebSocketConfig contains settings for the WebSocket server browser-based
+// clients subscribe to streams through.
+type WebSocketConfig struct {
+	Enabled       bool
+	ListenAddress string // host:port to bind to
+}
+
+// ListenerConfig is a named listener clients can connect to in addition to
+// the server's default listen address, e.g. to reach the cluster from outside
+// the network its brokers advertise their default addresses in.
+type ListenerConfig struct {
+	Name   string
+	Listen HostPort // Address to bind to
+	Host   string   // Host advertised to clients, the listen host if empty
+	Port   int      // Port advertised to clients, the bound port if 0
+}
+
+// ProducerQuota limits the rate at which a producer can publish.
+type ProducerQuota struct {
+	BytesPerSecond int64
+}
+
 // Config contains all settings for a Liftbridge Server.
 type Config struct {
-	Listen               HostPort
-	Host                 string
-	Port                 int
-	LogLevel             uint32
-	LogRecovery          bool
-	LogRaft              bool
-	LogNATS              bool
-	LogSilent            bool
-	DataDir              string
-	BatchMaxMessages     int
-	BatchMaxTime         time.Duration
-	MetadataCacheMaxAge  time.Duration
-	TLSKey               string
-	TLSCert              string
-	TLSClientAuth        bool
-	TLSClientAuthCA      string
-	TLSClientAuthz       bool
-	TLSClientAuthzModel  string
-	TLSClientAuthzPolicy string
-	NATS                 nats.Options
-	EmbeddedNATS         bool
-	EmbeddedNATSConfig   string
-	Streams              StreamsConfig
-	Clustering           ClusteringConfig
-	ActivityStream       ActivityStreamConfig
-	CursorsStream        CursorsStreamConfig
-	Groups               GroupsConfig
-	Telemetry            TelemetryConfig
+	Listen                        HostPort
+	Host                          string
+	Port                          int
+	Listeners                     []ListenerConfig
+	Quotas                        map[string]ProducerQuota // Keyed by producer ID
+	LogLevel                      uint32
+	LogRecovery                   bool
+	LogRaft                       bool
+	LogNATS                       bool
+	LogSilent                     bool
+	LogFormat                     string
+	DataDir                       string
+	BatchMaxMessages              int
+	BatchMaxTime                  time.Duration
+	MetadataCacheMaxAge           time.Duration
+	DrainTimeout                  time.Duration
+	SubscriptionHeartbeatInterval time.Duration
+	SubscriptionHeartbeatTimeout  time.Duration
+	SubscriptionMaxPerPartition   int
+	SubscriptionMaxPerServer      int
+	TLSKey                        string
+	TLSCert                       string
+	TLSClientAuth                 bool
+	TLSClientAuthCA               string
+	TLSClientAuthz                bool
+	TLSClientAuthzModel           string
+	TLSClientAuthzPolicy          string
+	NATS                          nats.Options
+	EmbeddedNATS                  bool
+	EmbeddedNATSConfig            string
+	Streams                       StreamsConfig
+	Clustering                    ClusteringConfig
+	ActivityStream                ActivityStreamConfig
+	CursorsStream                 CursorsStreamConfig
+	Groups                        GroupsConfig
+	Telemetry                     TelemetryConfig
+	Diagnostics                   DiagnosticsConfig
+	Export                        ExportConfig
+	Backup                        BackupConfig
+	Skew                          SkewConfig
+	Tracing                       TracingConfig
+	Disk                          DiskConfig
+	Auth                          AuthConfig
+	WebSocket                     WebSocketConfig
+	ConfigFile                    string
 }
 
 // NewDefaultConfig creates a new Config with default settings.
@@ -402,9 +805,15 @@ func NewDefaultConfig() *Config {
 		Port: DefaultPort,
 	}
 	config.LogLevel = uint32(log.InfoLevel)
+	config.LogFormat = LogFormatText
 	config.BatchMaxMessages = defaultBatchMaxMessages
 	// BatchMaxTime defaults to 0 (no wait)
 	config.MetadataCacheMaxAge = defaultMetadataCacheMaxAge
+	config.DrainTimeout = defaultDrainTimeout
+	config.SubscriptionHeartbeatInterval = defaultSubscriptionHeartbeatInterval
+	config.SubscriptionHeartbeatTimeout = defaultSubscriptionHeartbeatTimeout
+	config.SubscriptionMaxPerPartition = defaultSubscriptionMaxPerPartition
+	config.SubscriptionMaxPerServer = defaultSubscriptionMaxPerServer
 	config.NATS.Servers = []string{nats.DefaultURL}
 	config.Clustering.ServerID = nuid.Next()
 	config.Clustering.Namespace = DefaultNamespace
@@ -412,24 +821,56 @@ func NewDefaultConfig() *Config {
 	config.Clustering.ReplicaMaxLeaderTimeout = defaultReplicaMaxLeaderTimeout
 	config.Clustering.ReplicaMaxIdleWait = defaultReplicaMaxIdleWait
 	config.Clustering.ReplicaFetchTimeout = defaultReplicaFetchTimeout
+	config.Clustering.ReplicaFetchPipelineDepth = defaultReplicaFetchPipelineDepth
+	config.Clustering.ReplicaSnapshotMinBytes = defaultReplicaSnapshotMinBytes
 	config.Clustering.RaftSnapshots = defaultRaftSnapshots
 	config.Clustering.RaftCacheSize = defaultRaftCacheSize
 	config.Clustering.MinISR = defaultMinInsyncReplicas
+	config.Clustering.ISRExpansionMinCatchupTime = defaultISRExpansionMinCatchupTime
 	config.Clustering.ReplicationMaxBytes = defaultReplicationMaxBytes
+	config.Clustering.RTTProbeInterval = defaultRTTProbeInterval
+	config.Clustering.StreamTTLCheckInterval = defaultStreamTTLCheckInterval
+	config.Clustering.ScheduledOpCheckInterval = defaultScheduledOpCheckInterval
+	config.Clustering.RequestDeduplicationWindow = defaultRequestDeduplicationWindow
+	config.Clustering.ReplicaRepair.GracePeriod = defaultReplicaRepairGracePeriod
+	config.Clustering.ReplicaRepair.MaxPerInterval = defaultReplicaRepairMaxPerInterval
+	config.Clustering.PropagateMaxInflight = defaultPropagateMaxInflight
+	config.Clustering.PropagateMaxRetries = defaultPropagateMaxRetries
+	config.Clustering.LeaderPlacement = LeaderPlacementLoad
+	config.Clustering.LeaderLoadTolerance = defaultLeaderLoadTolerance
 	config.Streams.SegmentMaxBytes = defaultMaxSegmentBytes
 	config.Streams.SegmentMaxAge = defaultMaxSegmentAge
+	config.Streams.CompactDeleteRetention = defaultCompactDeleteRetention
 	config.Streams.RetentionMaxAge = defaultRetentionMaxAge
 	config.Streams.CleanerInterval = defaultCleanerInterval
 	config.Streams.ConcurrencyControl = defaultConcurrencyControl
 	config.Streams.Encryption = defaultEncryption
+	config.Streams.AutoResumeOnPublish = defaultAutoResumeOnPublish
+	config.Streams.PauseDrainTimeout = defaultPauseDrainTimeout
+	config.Streams.PartitionEventsMaxRate = defaultPartitionEventsMaxRate
+	config.Streams.CorrelationIndexTTL = defaultCorrelationIndexTTL
+	config.Streams.CorrelationIndexScanDepth = defaultCorrelationIndexScanDepth
+	config.Streams.MessageTimestampType = MessageTimestampTypeLogAppendTime
 	config.ActivityStream.PublishTimeout = defaultActivityStreamPublishTimeout
 	config.ActivityStream.PublishAckPolicy = defaultActivityStreamPublishAckPolicy
+	config.ActivityStream.RetentionMaxAge = defaultActivityStreamRetentionMaxAge
 	config.CursorsStream.ReplicationFactor = defaultCursorsStreamReplicationFactor
 	config.CursorsStream.AutoPauseTime = defaultCursorsStreamAutoPauseTime
 	config.Groups.ConsumerTimeout = defaultGroupsConsumerTimeout
 	config.Groups.CoordinatorTimeout = defaultGroupsCoordinatorTimeout
 	config.Telemetry.Enabled = defaultTelemetryEnabled
 	config.Telemetry.IntervalSeconds = defaultTelemetryIntervalSeconds
+	config.Diagnostics.AllocationSampleRate = defaultAllocationSampleRate
+	config.Export.MaxBytesPerSecond = defaultExportMaxBytesPerSecond
+	config.Backup.Interval = defaultBackupInterval
+	config.Backup.MaxBytesPerSecond = defaultBackupMaxBytesPerSecond
+	config.Skew.ReportInterval = defaultSkewReportInterval
+	config.Skew.Threshold = defaultSkewThreshold
+	config.Skew.MinRate = defaultSkewMinRate
+	config.Disk.EmergencyCheckInterval = defaultDiskEmergencyCheckInterval
+	config.Disk.EmergencyMinInterval = defaultDiskEmergencyMinInterval
+	config.Auth.Backend = AuthBackendFile
+	config.WebSocket.ListenAddress = defaultWebSocketListenAddress
 	return config
 }
 
@@ -508,6 +949,7 @@ func NewConfig(configFile string) (*Config, error) { // nolint: gocyclo
 	if configFile == "" {
 		return config, nil
 	}
+	config.ConfigFile = configFile
 
 	// Expect a yaml config file.
 	v.SetConfigFile(configFile)
@@ -550,6 +992,22 @@ func NewConfig(configFile string) (*Config, error) { // nolint: gocyclo
 		config.Host = v.GetString(configHost)
 	}
 
+	if v.IsSet(configListeners) {
+		listeners, err := parseListeners(v)
+		if err != nil {
+			return nil, err
+		}
+		config.Listeners = listeners
+	}
+
+	if v.IsSet(configQuotas) {
+		quotas, err := parseQuotas(v)
+		if err != nil {
+			return nil, err
+		}
+		config.Quotas = quotas
+	}
+
 	if v.IsSet(configLoggingLevel) {
 		level := v.GetString(configLoggingLevel)
 		levelInt, err := GetLogLevel(level)
@@ -572,6 +1030,14 @@ func NewConfig(configFile string) (*Config, error) { // nolint: gocyclo
 		config.LogNATS = v.GetBool(configLoggingNATS)
 	}
 
+	if v.IsSet(configLoggingFormat) {
+		format := strings.ToLower(v.GetString(configLoggingFormat))
+		if format != LogFormatText && format != LogFormatJSON {
+			return nil, fmt.Errorf("Invalid %s setting %q", configLoggingFormat, format)
+		}
+		config.LogFormat = format
+	}
+
 	if v.IsSet(configDataDir) {
 		config.DataDir = v.GetString(configDataDir)
 	}
@@ -588,6 +1054,46 @@ func NewConfig(configFile string) (*Config, error) { // nolint: gocyclo
 		config.MetadataCacheMaxAge = v.GetDuration(configMetadataCacheMaxAge)
 	}
 
+	if v.IsSet(configDrainTimeout) {
+		config.DrainTimeout = v.GetDuration(configDrainTimeout)
+		if config.DrainTimeout < 0 {
+			return nil, fmt.Errorf("Invalid %s setting %s, must not be negative",
+				configDrainTimeout, config.DrainTimeout)
+		}
+	}
+
+	if v.IsSet(configSubscriptionHeartbeatInterval) {
+		config.SubscriptionHeartbeatInterval = v.GetDuration(configSubscriptionHeartbeatInterval)
+		if config.SubscriptionHeartbeatInterval < 0 {
+			return nil, fmt.Errorf("Invalid %s setting %s, must not be negative",
+				configSubscriptionHeartbeatInterval, config.SubscriptionHeartbeatInterval)
+		}
+	}
+
+	if v.IsSet(configSubscriptionHeartbeatTimeout) {
+		config.SubscriptionHeartbeatTimeout = v.GetDuration(configSubscriptionHeartbeatTimeout)
+		if config.SubscriptionHeartbeatTimeout < 0 {
+			return nil, fmt.Errorf("Invalid %s setting %s, must not be negative",
+				configSubscriptionHeartbeatTimeout, config.SubscriptionHeartbeatTimeout)
+		}
+	}
+
+	if v.IsSet(configSubscriptionMaxPerPartition) {
+		config.SubscriptionMaxPerPartition = v.GetInt(configSubscriptionMaxPerPartition)
+		if config.SubscriptionMaxPerPartition < 0 {
+			return nil, fmt.Errorf("Invalid %s setting %d, must not be negative",
+				configSubscriptionMaxPerPartition, config.SubscriptionMaxPerPartition)
+		}
+	}
+
+	if v.IsSet(configSubscriptionMaxPerServer) {
+		config.SubscriptionMaxPerServer = v.GetInt(configSubscriptionMaxPerServer)
+		if config.SubscriptionMaxPerServer < 0 {
+			return nil, fmt.Errorf("Invalid %s setting %d, must not be negative",
+				configSubscriptionMaxPerServer, config.SubscriptionMaxPerServer)
+		}
+	}
+
 	if v.IsSet(configTLSKey) {
 		config.TLSKey = v.GetString(configTLSKey)
 	}
@@ -634,6 +1140,28 @@ func NewConfig(configFile string) (*Config, error) { // nolint: gocyclo
 		return nil, err
 	}
 	parseTelemetryConfig(config, v)
+	if err := parseDiagnosticsConfig(config, v); err != nil {
+		return nil, err
+	}
+	if err := parseExportConfig(config, v); err != nil {
+		return nil, err
+	}
+	if err := parseBackupConfig(config, v); err != nil {
+		return nil, err
+	}
+	if err := parseSkewConfig(config, v); err != nil {
+		return nil, err
+	}
+	parseTracingConfig(config, v)
+	if err := parseDiskConfig(config, v); err != nil {
+		return nil, err
+	}
+	if err := parseAuthConfig(config, v); err != nil {
+		return nil, err
+	}
+	if err := parseWebSocketConfig(config, v); err != nil {
+		return nil, err
+	}
 
 	// If SegmentMaxAge is not set, default it to the retention time.
 	if config.Streams.SegmentMaxAge == 0 {
@@ -722,6 +1250,10 @@ func parseStreamsConfig(config *Config, v *viper.Viper) error {
 		config.Streams.RetentionMaxAge = v.GetDuration(configStreamsRetentionMaxAge)
 	}
 
+	if v.IsSet(configStreamsRetentionFollowerFloor) {
+		config.Streams.RetentionFollowerFloor = v.GetBool(configStreamsRetentionFollowerFloor)
+	}
+
 	if v.IsSet(configStreamsCleanerInterval) {
 		config.Streams.CleanerInterval = v.GetDuration(configStreamsCleanerInterval)
 	}
@@ -734,6 +1266,18 @@ func parseStreamsConfig(config *Config, v *viper.Viper) error {
 		config.Streams.SegmentMaxAge = v.GetDuration(configStreamsSegmentMaxAge)
 	}
 
+	if v.IsSet(configStreamsSegmentMaxAgeJitter) {
+		jitter := v.GetDuration(configStreamsSegmentMaxAgeJitter)
+		if jitter < 0 {
+			return fmt.Errorf("Invalid %s setting: cannot be negative", configStreamsSegmentMaxAgeJitter)
+		}
+		config.Streams.SegmentMaxAgeJitter = jitter
+	}
+
+	if v.IsSet(configStreamsSegmentPreallocateBytes) {
+		config.Streams.SegmentPreallocateBytes = v.GetInt64(configStreamsSegmentPreallocateBytes)
+	}
+
 	if v.IsSet(configStreamsCompactEnabled) {
 		config.Streams.Compact = v.GetBool(configStreamsCompactEnabled)
 	}
@@ -742,6 +1286,15 @@ func parseStreamsConfig(config *Config, v *viper.Viper) error {
 		config.Streams.CompactMaxGoroutines = v.GetInt(configStreamsCompactMaxGoroutines)
 	}
 
+	if v.IsSet(configStreamsCompactDeleteRetention) {
+		config.Streams.CompactDeleteRetention = time.Duration(
+			v.GetInt64(configStreamsCompactDeleteRetention)) * time.Millisecond
+	}
+
+	if v.IsSet(configStreamsCompactBloomFilter) {
+		config.Streams.CompactBloomFilter = v.GetBool(configStreamsCompactBloomFilter)
+	}
+
 	if v.IsSet(configStreamsAutoPauseTime) {
 		config.Streams.AutoPauseTime = v.GetDuration(configStreamsAutoPauseTime)
 	}
@@ -749,12 +1302,102 @@ func parseStreamsConfig(config *Config, v *viper.Viper) error {
 	if v.IsSet(configStreamsAutoPauseDisableIfSubscribers) {
 		config.Streams.AutoPauseDisableIfSubscribers = v.GetBool(configStreamsAutoPauseDisableIfSubscribers)
 	}
+
+	if v.IsSet(configStreamsAutoResumeOnPublish) {
+		config.Streams.AutoResumeOnPublish = v.GetBool(configStreamsAutoResumeOnPublish)
+	}
+
+	if v.IsSet(configStreamsMaxMessageBytes) {
+		maxBytes := v.GetInt64(configStreamsMaxMessageBytes)
+		if maxBytes < 0 {
+			return fmt.Errorf("Invalid %s setting: cannot be negative", configStreamsMaxMessageBytes)
+		}
+		config.Streams.MaxMessageBytes = maxBytes
+	}
 	if v.IsSet(configStreamsConcurrencyControl) {
 		config.Streams.ConcurrencyControl = v.GetBool(configStreamsConcurrencyControl)
 	}
 	if v.IsSet(configStreamsEncryption) {
 		config.Streams.Encryption = v.GetBool(configStreamsEncryption)
 	}
+	if v.IsSet(configStreamsSegmentEncryption) {
+		config.Streams.SegmentEncryption = v.GetBool(configStreamsSegmentEncryption)
+	}
+	if v.IsSet(configStreamsSegmentEncryptionKey) {
+		key, err := base64.StdEncoding.DecodeString(v.GetString(configStreamsSegmentEncryptionKey))
+		if err != nil {
+			return fmt.Errorf("Invalid %s setting: %v", configStreamsSegmentEncryptionKey, err)
+		}
+		if len(key) != 16 && len(key) != 32 {
+			return fmt.Errorf("Invalid %s setting: key must be 16 or 32 bytes, got %d",
+				configStreamsSegmentEncryptionKey, len(key))
+		}
+		config.Streams.SegmentEncryptionKey = key
+	}
+	if v.IsSet(configStreamsExclusiveSubjects) {
+		config.Streams.ExclusiveSubjects = v.GetBool(configStreamsExclusiveSubjects)
+	}
+	if v.IsSet(configStreamsPublishDirect) {
+		config.Streams.PublishDirect = v.GetBool(configStreamsPublishDirect)
+	}
+	if v.IsSet(configStreamsCreateWaitForISR) {
+		config.Streams.CreateWaitForISR = v.GetBool(configStreamsCreateWaitForISR)
+	}
+	if v.IsSet(configStreamsPauseDrainTimeout) {
+		timeout := v.GetDuration(configStreamsPauseDrainTimeout)
+		if timeout < 0 {
+			return fmt.Errorf("Invalid %s setting: cannot be negative", configStreamsPauseDrainTimeout)
+		}
+		config.Streams.PauseDrainTimeout = timeout
+	}
+	if v.IsSet(configStreamsPartitionEventsMaxRate) {
+		rate := v.GetInt(configStreamsPartitionEventsMaxRate)
+		if rate <= 0 {
+			return fmt.Errorf("Invalid %s setting: must be positive", configStreamsPartitionEventsMaxRate)
+		}
+		config.Streams.PartitionEventsMaxRate = rate
+	}
+
+	if v.IsSet(configStreamsMessageTimestampType) {
+		timestampType := strings.ToLower(v.GetString(configStreamsMessageTimestampType))
+		if !isValidMessageTimestampType(timestampType) {
+			return fmt.Errorf("Invalid %s setting %q", configStreamsMessageTimestampType, timestampType)
+		}
+		config.Streams.MessageTimestampType = timestampType
+	}
+	if v.IsSet(configStreamsMessageTimestampMaxDifference) {
+		maxDifference := v.GetDuration(configStreamsMessageTimestampMaxDifference)
+		if maxDifference < 0 {
+			return fmt.Errorf("Invalid %s setting: cannot be negative",
+				configStreamsMessageTimestampMaxDifference)
+		}
+		config.Streams.MessageTimestampMaxDifference = maxDifference
+	}
+	if v.IsSet(configStreamsCorrelationIndexSize) {
+		size := v.GetInt(configStreamsCorrelationIndexSize)
+		if size < 0 {
+			return fmt.Errorf("Invalid %s setting: cannot be negative", configStreamsCorrelationIndexSize)
+		}
+		config.Streams.CorrelationIndexSize = size
+	}
+	if v.IsSet(configStreamsCorrelationIndexTTL) {
+		ttl := v.GetDuration(configStreamsCorrelationIndexTTL)
+		if ttl <= 0 {
+			return fmt.Errorf("Invalid %s setting: must be positive", configStreamsCorrelationIndexTTL)
+		}
+		config.Streams.CorrelationIndexTTL = ttl
+	}
+	if v.IsSet(configStreamsCorrelationIndexScanDepth) {
+		depth := v.GetInt64(configStreamsCorrelationIndexScanDepth)
+		if depth < 0 {
+			return fmt.Errorf("Invalid %s setting: cannot be negative", configStreamsCorrelationIndexScanDepth)
+		}
+		config.Streams.CorrelationIndexScanDepth = depth
+	}
+	if config.Streams.SegmentEncryption && len(config.Streams.SegmentEncryptionKey) == 0 {
+		return fmt.Errorf("%s requires %s to be set",
+			configStreamsSegmentEncryption, configStreamsSegmentEncryptionKey)
+	}
 	return nil
 }
 
@@ -769,6 +1412,14 @@ func parseClusteringConfig(config *Config, v *viper.Viper) error { // nolint: go
 		config.Clustering.Namespace = v.GetString(configClusteringNamespace)
 	}
 
+	if v.IsSet(configClusteringSubjectPrefix) {
+		prefix := v.GetString(configClusteringSubjectPrefix)
+		if !isValidSubjectPrefix(prefix) {
+			return fmt.Errorf("Invalid %s setting %q", configClusteringSubjectPrefix, prefix)
+		}
+		config.Clustering.SubjectPrefix = prefix
+	}
+
 	if v.IsSet(configClusteringRaftSnapshotRetain) {
 		config.Clustering.RaftSnapshots = v.GetInt(configClusteringRaftSnapshotRetain)
 	}
@@ -801,6 +1452,24 @@ func parseClusteringConfig(config *Config, v *viper.Viper) error { // nolint: go
 		config.Clustering.ReplicaMaxLeaderTimeout = v.GetDuration(configClusteringReplicaMaxLeaderTimeout)
 	}
 
+	if v.IsSet(configClusteringLeaderDisconnectTimeout) {
+		timeout := v.GetDuration(configClusteringLeaderDisconnectTimeout)
+		if timeout < 0 || timeout >= config.Clustering.ReplicaMaxLeaderTimeout {
+			return fmt.Errorf("Invalid %s setting %s, must be less than %s",
+				configClusteringLeaderDisconnectTimeout, timeout, configClusteringReplicaMaxLeaderTimeout)
+		}
+		config.Clustering.LeaderDisconnectTimeout = timeout
+	}
+
+	if v.IsSet(configClusteringLeaderLeaseDuration) {
+		lease := v.GetDuration(configClusteringLeaderLeaseDuration)
+		if lease < 0 || lease >= config.Clustering.ReplicaMaxLeaderTimeout {
+			return fmt.Errorf("Invalid %s setting %s, must be less than %s",
+				configClusteringLeaderLeaseDuration, lease, configClusteringReplicaMaxLeaderTimeout)
+		}
+		config.Clustering.LeaderLeaseDuration = lease
+	}
+
 	if v.IsSet(configClusteringReplicaMaxIdleWait) {
 		config.Clustering.ReplicaMaxIdleWait = v.GetDuration(configClusteringReplicaMaxIdleWait)
 	}
@@ -809,14 +1478,174 @@ func parseClusteringConfig(config *Config, v *viper.Viper) error { // nolint: go
 		config.Clustering.ReplicaFetchTimeout = v.GetDuration(configClusteringReplicaFetchTimeout)
 	}
 
+	if v.IsSet(configClusteringReplicaFetchDepth) {
+		depth := v.GetInt(configClusteringReplicaFetchDepth)
+		if depth < 1 {
+			return fmt.Errorf("Invalid %s setting %d", configClusteringReplicaFetchDepth, depth)
+		}
+		config.Clustering.ReplicaFetchPipelineDepth = depth
+	}
+
+	if v.IsSet(configClusteringReplicaSnapshotMinBytes) {
+		minBytes := v.GetInt64(configClusteringReplicaSnapshotMinBytes)
+		if minBytes < 0 {
+			return fmt.Errorf("Invalid %s setting %d", configClusteringReplicaSnapshotMinBytes, minBytes)
+		}
+		config.Clustering.ReplicaSnapshotMinBytes = minBytes
+	}
+
 	if v.IsSet(configClusteringMinInsyncReplicas) {
 		config.Clustering.MinISR = v.GetInt(configClusteringMinInsyncReplicas)
 	}
 
+	if v.IsSet(configClusteringISRExpansionCatchupTime) {
+		catchupTime := v.GetDuration(configClusteringISRExpansionCatchupTime)
+		if catchupTime < 0 {
+			return fmt.Errorf("Invalid %s setting %s", configClusteringISRExpansionCatchupTime, catchupTime)
+		}
+		config.Clustering.ISRExpansionMinCatchupTime = catchupTime
+	}
+
 	if v.IsSet(configClusteringReplicationMaxBytes) {
 		config.Clustering.ReplicationMaxBytes = v.GetInt64(configClusteringReplicationMaxBytes)
 	}
 
+	if v.IsSet(configClusteringThrottleReplicaRate) {
+		rate := v.GetInt64(configClusteringThrottleReplicaRate)
+		if rate < 0 {
+			return fmt.Errorf("Invalid %s setting %d", configClusteringThrottleReplicaRate, rate)
+		}
+		config.Clustering.ReplicationThrottle.ReplicaRate = rate
+	}
+
+	if v.IsSet(configClusteringThrottleBrokerRate) {
+		rate := v.GetInt64(configClusteringThrottleBrokerRate)
+		if rate < 0 {
+			return fmt.Errorf("Invalid %s setting %d", configClusteringThrottleBrokerRate, rate)
+		}
+		config.Clustering.ReplicationThrottle.BrokerRate = rate
+	}
+
+	if v.IsSet(configClusteringThrottleLag) {
+		lag := v.GetInt64(configClusteringThrottleLag)
+		if lag < 0 {
+			return fmt.Errorf("Invalid %s setting %d", configClusteringThrottleLag, lag)
+		}
+		config.Clustering.ReplicationThrottle.LagThreshold = lag
+	}
+
+	if v.IsSet(configClusteringAuthKeys) {
+		keys := v.GetStringSlice(configClusteringAuthKeys)
+		if len(keys) > maxClusterAuthKeys {
+			return fmt.Errorf("Invalid %s setting: at most %d keys may be active",
+				configClusteringAuthKeys, maxClusterAuthKeys)
+		}
+		for _, key := range keys {
+			if key == "" {
+				return fmt.Errorf("Invalid %s setting: keys must not be empty",
+					configClusteringAuthKeys)
+			}
+		}
+		config.Clustering.AuthKeys = keys
+	}
+
+	if v.IsSet(configClusteringAuthStrict) {
+		config.Clustering.AuthStrict = v.GetBool(configClusteringAuthStrict)
+	}
+
+	if v.IsSet(configClusteringRTTProbeInterval) {
+		config.Clustering.RTTProbeInterval = v.GetDuration(configClusteringRTTProbeInterval)
+	}
+
+	if v.IsSet(configClusteringLeaderPlacement) {
+		placement := strings.ToLower(v.GetString(configClusteringLeaderPlacement))
+		if placement != LeaderPlacementLoad && placement != LeaderPlacementLatency {
+			return fmt.Errorf("Invalid %s setting %q", configClusteringLeaderPlacement, placement)
+		}
+		config.Clustering.LeaderPlacement = placement
+	}
+
+	if v.IsSet(configClusteringLeaderLoadTolerance) {
+		tolerance := v.GetInt(configClusteringLeaderLoadTolerance)
+		if tolerance < 0 {
+			return fmt.Errorf("Invalid %s setting %d", configClusteringLeaderLoadTolerance, tolerance)
+		}
+		config.Clustering.LeaderLoadTolerance = tolerance
+	}
+
+	if v.IsSet(configClusteringExcludeObservers) {
+		config.Clustering.ExcludeObservers = v.GetBool(configClusteringExcludeObservers)
+	}
+
+	if v.IsSet(configClusteringStreamTTLCheckInterval) {
+		interval := v.GetDuration(configClusteringStreamTTLCheckInterval)
+		if interval < 0 {
+			return fmt.Errorf("Invalid %s setting %s", configClusteringStreamTTLCheckInterval, interval)
+		}
+		config.Clustering.StreamTTLCheckInterval = interval
+	}
+
+	if v.IsSet(configClusteringScheduledOpInterval) {
+		interval := v.GetDuration(configClusteringScheduledOpInterval)
+		if interval < 0 {
+			return fmt.Errorf("Invalid %s setting %s", configClusteringScheduledOpInterval, interval)
+		}
+		config.Clustering.ScheduledOpCheckInterval = interval
+	}
+
+	if v.IsSet(configClusteringRequestDedupWindow) {
+		window := v.GetDuration(configClusteringRequestDedupWindow)
+		if window < 0 {
+			return fmt.Errorf("Invalid %s setting %s", configClusteringRequestDedupWindow, window)
+		}
+		config.Clustering.RequestDeduplicationWindow = window
+	}
+
+	if v.IsSet(configClusteringRepairInterval) {
+		interval := v.GetDuration(configClusteringRepairInterval)
+		if interval < 0 {
+			return fmt.Errorf("Invalid %s setting %s", configClusteringRepairInterval, interval)
+		}
+		config.Clustering.ReplicaRepair.Interval = interval
+	}
+
+	if v.IsSet(configClusteringRepairGracePeriod) {
+		grace := v.GetDuration(configClusteringRepairGracePeriod)
+		if grace < 0 {
+			return fmt.Errorf("Invalid %s setting %s", configClusteringRepairGracePeriod, grace)
+		}
+		config.Clustering.ReplicaRepair.GracePeriod = grace
+	}
+
+	if v.IsSet(configClusteringRepairMaxPerInterval) {
+		max := v.GetInt(configClusteringRepairMaxPerInterval)
+		if max <= 0 {
+			return fmt.Errorf("Invalid %s setting %d", configClusteringRepairMaxPerInterval, max)
+		}
+		config.Clustering.ReplicaRepair.MaxPerInterval = max
+	}
+
+	if v.IsSet(configClusteringPropagateMaxInflight) {
+		max := v.GetInt(configClusteringPropagateMaxInflight)
+		if max < 0 {
+			return fmt.Errorf("Invalid %s setting %d", configClusteringPropagateMaxInflight, max)
+		}
+		config.Clustering.PropagateMaxInflight = max
+	}
+
+	if v.IsSet(configClusteringPropagateMaxRetries) {
+		retries := v.GetInt(configClusteringPropagateMaxRetries)
+		if retries < 0 {
+			return fmt.Errorf("Invalid %s setting %d", configClusteringPropagateMaxRetries, retries)
+		}
+		config.Clustering.PropagateMaxRetries = retries
+	}
+
+	if config.Clustering.AuthStrict && len(config.Clustering.AuthKeys) == 0 {
+		return fmt.Errorf("Invalid %s setting: %s must be set",
+			configClusteringAuthStrict, configClusteringAuthKeys)
+	}
+
 	return nil
 }
 
@@ -840,6 +1669,14 @@ func parseActivityStreamConfig(config *Config, v *viper.Viper) error { // nolint
 		config.ActivityStream.PublishAckPolicy = ackPolicy
 	}
 
+	if v.IsSet(configActivityStreamRetentionMaxAge) {
+		config.ActivityStream.RetentionMaxAge = v.GetDuration(configActivityStreamRetentionMaxAge)
+	}
+
+	if v.IsSet(configActivityStreamPartitionEvents) {
+		config.ActivityStream.PartitionEvents = v.GetBool(configActivityStreamPartitionEvents)
+	}
+
 	return nil
 }
 
@@ -887,6 +1724,174 @@ func parseTelemetryConfig(config *Config, v *viper.Viper) {
 	}
 }
 
+// parseDiagnosticsConfig parses the `diagnostics` section of a config file and
+// populates the given Config.
+func parseDiagnosticsConfig(config *Config, v *viper.Viper) error {
+	if v.IsSet(configDiagnosticsAllocationSampleRate) {
+		rate := v.GetInt(configDiagnosticsAllocationSampleRate)
+		if rate < 0 {
+			return fmt.Errorf("Invalid %s setting %d", configDiagnosticsAllocationSampleRate, rate)
+		}
+		config.Diagnostics.AllocationSampleRate = rate
+	}
+
+	return nil
+}
+
+// parseExportConfig parses the `export` section of a config file and populates
+// the given Config.
+func parseExportConfig(config *Config, v *viper.Viper) error {
+	if v.IsSet(configExportMaxBytesPerSecond) {
+		rate := v.GetInt64(configExportMaxBytesPerSecond)
+		if rate < 0 {
+			return fmt.Errorf("Invalid %s setting %d", configExportMaxBytesPerSecond, rate)
+		}
+		config.Export.MaxBytesPerSecond = rate
+	}
+
+	return nil
+}
+
+// parseBackupConfig parses the `backup` section of a config file and populates
+// the given Config.
+func parseBackupConfig(config *Config, v *viper.Viper) error {
+	if v.IsSet(configBackupDir) {
+		config.Backup.Dir = v.GetString(configBackupDir)
+	}
+	if v.IsSet(configBackupInterval) {
+		interval := v.GetDuration(configBackupInterval)
+		if interval <= 0 {
+			return fmt.Errorf("Invalid %s setting %s", configBackupInterval, interval)
+		}
+		config.Backup.Interval = interval
+	}
+	if v.IsSet(configBackupMaxBytesPerSecond) {
+		rate := v.GetInt64(configBackupMaxBytesPerSecond)
+		if rate < 0 {
+			return fmt.Errorf("Invalid %s setting %d", configBackupMaxBytesPerSecond, rate)
+		}
+		config.Backup.MaxBytesPerSecond = rate
+	}
+
+	return nil
+}
+
+// parseSkewConfig parses the `skew` section of a config file and populates the
+// given Config.
+func parseSkewConfig(config *Config, v *viper.Viper) error {
+	if v.IsSet(configSkewReportInterval) {
+		config.Skew.ReportInterval = v.GetDuration(configSkewReportInterval)
+	}
+
+	if v.IsSet(configSkewThreshold) {
+		threshold := v.GetFloat64(configSkewThreshold)
+		if threshold < 1 {
+			return fmt.Errorf("Invalid %s setting %v, must be at least 1", configSkewThreshold, threshold)
+		}
+		config.Skew.Threshold = threshold
+	}
+
+	if v.IsSet(configSkewMinRate) {
+		rate := v.GetInt64(configSkewMinRate)
+		if rate < 0 {
+			return fmt.Errorf("Invalid %s setting %d", configSkewMinRate, rate)
+		}
+		config.Skew.MinRate = rate
+	}
+
+	return nil
+}
+
+// parseTracingConfig parses the `tracing` section of a config file and
+// populates the given Config.
+func parseTracingConfig(config *Config, v *viper.Viper) {
+	if v.IsSet(configTracingOTLPEndpoint) {
+		config.Tracing.OTLPEndpoint = v.GetString(configTracingOTLPEndpoint)
+	}
+}
+
+// parseDiskConfig parses the `disk` section of a config file and populates
+// the given Config.
+func parseDiskConfig(config *Config, v *viper.Viper) error {
+	if v.IsSet(configDiskEmergencyThreshold) {
+		threshold := v.GetFloat64(configDiskEmergencyThreshold)
+		if threshold < 0 || threshold > 1 {
+			return fmt.Errorf("Invalid %s setting %v, must be between 0 and 1", configDiskEmergencyThreshold, threshold)
+		}
+		config.Disk.EmergencyThreshold = threshold
+	}
+
+	if v.IsSet(configDiskEmergencyCheckInterval) {
+		interval := v.GetDuration(configDiskEmergencyCheckInterval)
+		if interval <= 0 {
+			return fmt.Errorf("Invalid %s setting %s, must be positive", configDiskEmergencyCheckInterval, interval)
+		}
+		config.Disk.EmergencyCheckInterval = interval
+	}
+
+	if v.IsSet(configDiskEmergencyMinInterval) {
+		interval := v.GetDuration(configDiskEmergencyMinInterval)
+		if interval < 0 {
+			return fmt.Errorf("Invalid %s setting: cannot be negative", configDiskEmergencyMinInterval)
+		}
+		config.Disk.EmergencyMinInterval = interval
+	}
+
+	if v.IsSet(configDiskEmergencyBudget) {
+		budget := v.GetInt64(configDiskEmergencyBudget)
+		if budget < 0 {
+			return fmt.Errorf("Invalid %s setting: cannot be negative", configDiskEmergencyBudget)
+		}
+		config.Disk.EmergencyBudget = budget
+	}
+
+	return nil
+}
+
+// parseAuthConfig parses the `auth` section of a config file and populates
+// the given Config.
+func parseAuthConfig(config *Config, v *viper.Viper) error {
+	if v.IsSet(configAuthEnabled) {
+		config.Auth.Enabled = v.GetBool(configAuthEnabled)
+	}
+
+	if v.IsSet(configAuthBackend) {
+		backend := strings.ToLower(v.GetString(configAuthBackend))
+		if backend != AuthBackendFile {
+			return fmt.Errorf("Invalid %s setting %q, must be %q", configAuthBackend, backend, AuthBackendFile)
+		}
+		config.Auth.Backend = backend
+	}
+
+	if v.IsSet(configAuthCredentialsFile) {
+		config.Auth.CredentialsFile = v.GetString(configAuthCredentialsFile)
+	}
+
+	if config.Auth.Enabled && config.Auth.Backend == AuthBackendFile && config.Auth.CredentialsFile == "" {
+		return fmt.Errorf("Invalid %s setting: %s must be set", configAuthEnabled, configAuthCredentialsFile)
+	}
+
+	return nil
+}
+
+// parseWebSocketConfig parses the `websocket` section of a config file and
+// populates the given Config.
+func parseWebSocketConfig(config *Config, v *viper.Viper) error {
+	if v.IsSet(configWebSocketEnabled) {
+		config.WebSocket.Enabled = v.GetBool(configWebSocketEnabled)
+	}
+
+	if v.IsSet(configWebSocketListenAddress) {
+		address := v.GetString(configWebSocketListenAddress)
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			return fmt.Errorf("Invalid %s setting %q: %v", configWebSocketListenAddress, address, err)
+		}
+		config.WebSocket.ListenAddress = address
+	}
+
+	return nil
+}
+
 // HostPort is simple struct to hold parsed listen/addr strings.
 type HostPort struct {
 	Host string
@@ -915,6 +1920,111 @@ func parseListen(v *viper.Viper) (*HostPort, error) {
 	return hp, nil
 }
 
+// listenerKeys are the settings of each entry of the `listeners` option.
+var listenerKeys = map[string]struct{}{
+	"name":       {},
+	configListen: {},
+	configHost:   {},
+	configPort:   {},
+}
+
+// parseListeners will parse the `listeners` option containing the named
+// listeners clients can connect to. Each listener must have a unique name and
+// a listen address.
+func parseListeners(v *viper.Viper) ([]ListenerConfig, error) {
+	entries, ok := v.Get(configListeners).([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Invalid %s setting, expected a list", configListeners)
+	}
+	listeners := make([]ListenerConfig, len(entries))
+	names := make(map[string]struct{}, len(entries))
+	for i, entry := range entries {
+		settings, ok := entry.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Invalid %s entry %v", configListeners, entry)
+		}
+		lv := viper.New()
+		if err := lv.MergeConfigMap(settings); err != nil {
+			return nil, fmt.Errorf("Invalid %s entry %v: %v", configListeners, entry, err)
+		}
+		for _, setting := range lv.AllKeys() {
+			if _, ok := listenerKeys[setting]; !ok {
+				return nil, fmt.Errorf("Unknown %s setting %q", configListeners, setting)
+			}
+		}
+
+		name := lv.GetString("name")
+		if name == "" {
+			return nil, fmt.Errorf("Listener name must be set in %s", configListeners)
+		}
+		if _, ok := names[name]; ok {
+			return nil, fmt.Errorf("Duplicate listener %q in %s", name, configListeners)
+		}
+		names[name] = struct{}{}
+		if !lv.IsSet(configListen) {
+			return nil, fmt.Errorf("Listener %q must set %s", name, configListen)
+		}
+		listen, err := parseListen(lv)
+		if err != nil {
+			return nil, err
+		}
+		listeners[i] = ListenerConfig{
+			Name:   name,
+			Listen: *listen,
+			Host:   lv.GetString(configHost),
+			Port:   lv.GetInt(configPort),
+		}
+	}
+	return listeners, nil
+}
+
+// quotaKeys are the settings of each entry of the `quotas` option.
+var quotaKeys = map[string]struct{}{
+	"producer":         {},
+	"bytes.per.second": {},
+}
+
+// parseQuotas will parse the `quotas` option containing the byte rate quotas
+// of producers. Each quota must name a unique producer ID and set a positive
+// rate. A list is used rather than a map since map keys are case-insensitive.
+func parseQuotas(v *viper.Viper) (map[string]ProducerQuota, error) {
+	entries, ok := v.Get(configQuotas).([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Invalid %s setting, expected a list", configQuotas)
+	}
+	quotas := make(map[string]ProducerQuota, len(entries))
+	for _, entry := range entries {
+		settings, ok := entry.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Invalid %s entry %v", configQuotas, entry)
+		}
+		qv := viper.New()
+		if err := qv.MergeConfigMap(settings); err != nil {
+			return nil, fmt.Errorf("Invalid %s entry %v: %v", configQuotas, entry, err)
+		}
+		for _, setting := range qv.AllKeys() {
+			if _, ok := quotaKeys[setting]; !ok {
+				return nil, fmt.Errorf("Unknown %s setting %q", configQuotas, setting)
+			}
+		}
+
+		producer := qv.GetString("producer")
+		if producer == "" {
+			return nil, fmt.Errorf("Quota producer must be set in %s", configQuotas)
+		}
+		if _, ok := quotas[producer]; ok {
+			return nil, fmt.Errorf("Duplicate quota for producer %q in %s", producer, configQuotas)
+		}
+		rate := qv.GetInt64("bytes.per.second")
+		if rate <= 0 {
+			return nil, fmt.Errorf("Quota for producer %q must set a positive bytes.per.second in %s",
+				producer, configQuotas)
+		}
+		quotas[producer] = ProducerQuota{BytesPerSecond: rate}
+	}
+	return quotas, nil
+}
+
 // parseAckPolicy will parse the activity stream's `ack.policy` option
 // containing the ack policy to use when publishing activity events.
 func parseAckPolicy(v *viper.Viper) (client.AckPolicy, error) {
@@ -26,23 +26,49 @@ func TestNewConfigFromFile(t *testing.T) {
 	require.True(t, config.LogRecovery)
 	require.True(t, config.LogRaft)
 	require.True(t, config.LogNATS)
+	require.Equal(t, LogFormatJSON, config.LogFormat)
 	require.Equal(t, "/foo", config.DataDir)
 	require.Equal(t, 10, config.BatchMaxMessages)
 	require.Equal(t, time.Second, config.BatchMaxTime)
 	require.Equal(t, time.Minute, config.MetadataCacheMaxAge)
+	require.Equal(t, 30*time.Second, config.DrainTimeout)
+	require.Equal(t, time.Minute, config.SubscriptionHeartbeatInterval)
+	require.Equal(t, 15*time.Second, config.SubscriptionHeartbeatTimeout)
+	require.Equal(t, 100, config.SubscriptionMaxPerPartition)
+	require.Equal(t, 1000, config.SubscriptionMaxPerServer)
 
 	require.Equal(t, int64(1024), config.Streams.RetentionMaxBytes)
 	require.Equal(t, int64(100), config.Streams.RetentionMaxMessages)
 	require.Equal(t, time.Hour, config.Streams.RetentionMaxAge)
+	require.True(t, config.Streams.RetentionFollowerFloor)
 	require.Equal(t, time.Minute, config.Streams.CleanerInterval)
 	require.Equal(t, int64(64), config.Streams.SegmentMaxBytes)
 	require.Equal(t, time.Minute, config.Streams.SegmentMaxAge)
+	require.Equal(t, 10*time.Second, config.Streams.SegmentMaxAgeJitter)
+	require.Equal(t, int64(32), config.Streams.SegmentPreallocateBytes)
 	require.True(t, config.Streams.Compact)
 	require.Equal(t, 2, config.Streams.CompactMaxGoroutines)
+	require.Equal(t, time.Hour, config.Streams.CompactDeleteRetention)
+	require.True(t, config.Streams.CompactBloomFilter)
 	require.Equal(t, false, config.Streams.ConcurrencyControl)
+	require.True(t, config.Streams.SegmentEncryption)
+	require.Equal(t, []byte("0123456789abcdef0123456789abcdef"), config.Streams.SegmentEncryptionKey)
+	require.True(t, config.Streams.ExclusiveSubjects)
+	require.True(t, config.Streams.PublishDirect)
+	require.True(t, config.Streams.CreateWaitForISR)
+	require.False(t, config.Streams.AutoResumeOnPublish)
+	require.Equal(t, int64(32), config.Streams.MaxMessageBytes)
+	require.Equal(t, 10*time.Second, config.Streams.PauseDrainTimeout)
+	require.Equal(t, 5, config.Streams.PartitionEventsMaxRate)
+	require.Equal(t, MessageTimestampTypeCreateTime, config.Streams.MessageTimestampType)
+	require.Equal(t, time.Hour, config.Streams.MessageTimestampMaxDifference)
+	require.Equal(t, 5000, config.Streams.CorrelationIndexSize)
+	require.Equal(t, 5*time.Minute, config.Streams.CorrelationIndexTTL)
+	require.Equal(t, int64(2000), config.Streams.CorrelationIndexScanDepth)
 
 	require.Equal(t, "foo", config.Clustering.ServerID)
 	require.Equal(t, "bar", config.Clustering.Namespace)
+	require.Equal(t, "_lift.bar", config.Clustering.SubjectPrefix)
 	require.Equal(t, 10, config.Clustering.RaftSnapshots)
 	require.Equal(t, uint64(100), config.Clustering.RaftSnapshotThreshold)
 	require.Equal(t, 5, config.Clustering.RaftCacheSize)
@@ -51,12 +77,36 @@ func TestNewConfigFromFile(t *testing.T) {
 	require.Equal(t, 30*time.Second, config.Clustering.ReplicaMaxLeaderTimeout)
 	require.Equal(t, 2*time.Second, config.Clustering.ReplicaMaxIdleWait)
 	require.Equal(t, 3*time.Second, config.Clustering.ReplicaFetchTimeout)
+	require.Equal(t, 8, config.Clustering.ReplicaFetchPipelineDepth)
+	require.Equal(t, int64(1048576), config.Clustering.ReplicaSnapshotMinBytes)
+	require.Equal(t, 20*time.Second, config.Clustering.LeaderDisconnectTimeout)
+	require.Equal(t, 10*time.Second, config.Clustering.LeaderLeaseDuration)
 	require.Equal(t, 1, config.Clustering.MinISR)
+	require.Equal(t, 10*time.Second, config.Clustering.ISRExpansionMinCatchupTime)
 	require.Equal(t, int64(1024), config.Clustering.ReplicationMaxBytes)
+	require.Equal(t, int64(1048576), config.Clustering.ReplicationThrottle.ReplicaRate)
+	require.Equal(t, int64(4194304), config.Clustering.ReplicationThrottle.BrokerRate)
+	require.Equal(t, int64(1000), config.Clustering.ReplicationThrottle.LagThreshold)
+	require.Equal(t, time.Minute, config.Clustering.ReplicaRepair.Interval)
+	require.Equal(t, 15*time.Minute, config.Clustering.ReplicaRepair.GracePeriod)
+	require.Equal(t, 2, config.Clustering.ReplicaRepair.MaxPerInterval)
+	require.Equal(t, []string{"key1", "key2"}, config.Clustering.AuthKeys)
+	require.True(t, config.Clustering.AuthStrict)
+	require.Equal(t, 5*time.Second, config.Clustering.RTTProbeInterval)
+	require.Equal(t, LeaderPlacementLatency, config.Clustering.LeaderPlacement)
+	require.Equal(t, 2, config.Clustering.LeaderLoadTolerance)
+	require.True(t, config.Clustering.ExcludeObservers)
+	require.Equal(t, 10*time.Second, config.Clustering.StreamTTLCheckInterval)
+	require.Equal(t, 5*time.Second, config.Clustering.ScheduledOpCheckInterval)
+	require.Equal(t, 2*time.Minute, config.Clustering.RequestDeduplicationWindow)
+	require.Equal(t, 64, config.Clustering.PropagateMaxInflight)
+	require.Equal(t, 3, config.Clustering.PropagateMaxRetries)
 
 	require.Equal(t, true, config.ActivityStream.Enabled)
 	require.Equal(t, time.Minute, config.ActivityStream.PublishTimeout)
 	require.Equal(t, client.AckPolicy_LEADER, config.ActivityStream.PublishAckPolicy)
+	require.Equal(t, 30*24*time.Hour, config.ActivityStream.RetentionMaxAge)
+	require.Equal(t, true, config.ActivityStream.PartitionEvents)
 
 	require.True(t, config.EmbeddedNATS)
 	require.Equal(t, "nats.conf", config.EmbeddedNATSConfig)
@@ -70,6 +120,32 @@ func TestNewConfigFromFile(t *testing.T) {
 
 	require.Equal(t, time.Minute, config.Groups.ConsumerTimeout)
 	require.Equal(t, 2*time.Minute, config.Groups.CoordinatorTimeout)
+
+	require.Equal(t, 16, config.Diagnostics.AllocationSampleRate)
+
+	require.Equal(t, int64(1048576), config.Export.MaxBytesPerSecond)
+
+	require.Equal(t, "/tmp/liftbridge-backup", config.Backup.Dir)
+	require.Equal(t, 30*time.Second, config.Backup.Interval)
+	require.Equal(t, int64(2097152), config.Backup.MaxBytesPerSecond)
+
+	require.Equal(t, 15*time.Second, config.Skew.ReportInterval)
+	require.Equal(t, 3.5, config.Skew.Threshold)
+	require.Equal(t, int64(100), config.Skew.MinRate)
+
+	require.Equal(t, "http://localhost:4317", config.Tracing.OTLPEndpoint)
+
+	require.Equal(t, 0.9, config.Disk.EmergencyThreshold)
+	require.Equal(t, 5*time.Second, config.Disk.EmergencyCheckInterval)
+	require.Equal(t, 30*time.Second, config.Disk.EmergencyMinInterval)
+	require.Equal(t, int64(1073741824), config.Disk.EmergencyBudget)
+
+	require.True(t, config.Auth.Enabled)
+	require.Equal(t, AuthBackendFile, config.Auth.Backend)
+	require.Equal(t, "credentials.yaml", config.Auth.CredentialsFile)
+
+	require.True(t, config.WebSocket.Enabled)
+	require.Equal(t, "0.0.0.0:9393", config.WebSocket.ListenAddress)
 }
 
 // Ensure that default config is loaded.
@@ -113,6 +189,38 @@ func TestNewConfigListen(t *testing.T) {
 	require.Equal(t, int(4333), config.Port)
 }
 
+// Ensure parsing named listeners.
+func TestNewConfigListeners(t *testing.T) {
+	config, err := NewConfig("configs/listeners.yaml")
+	require.NoError(t, err)
+	require.Equal(t, []ListenerConfig{
+		{Name: "internal", Listen: HostPort{Host: "0.0.0.0", Port: 9293}},
+		{Name: "external", Listen: HostPort{Host: "0.0.0.0", Port: 9294}, Host: "liftbridge.example.com", Port: 443},
+	}, config.Listeners)
+}
+
+// Ensure an error is returned when listener names aren't unique.
+func TestNewConfigInvalidListeners(t *testing.T) {
+	_, err := NewConfig("configs/invalid-listeners.yaml")
+	require.Error(t, err)
+}
+
+// Ensure parsing producer quotas.
+func TestNewConfigQuotas(t *testing.T) {
+	config, err := NewConfig("configs/quotas.yaml")
+	require.NoError(t, err)
+	require.Equal(t, map[string]ProducerQuota{
+		"orders-service":  {BytesPerSecond: 1048576},
+		"Billing.Service": {BytesPerSecond: 10240},
+	}, config.Quotas)
+}
+
+// Ensure an error is returned when a producer has more than one quota.
+func TestNewConfigInvalidQuotas(t *testing.T) {
+	_, err := NewConfig("configs/invalid-quotas.yaml")
+	require.Error(t, err)
+}
+
 // Ensure parsing TLS config.
 func TestNewConfigTLS(t *testing.T) {
 	config, err := NewConfig("configs/tls.yaml")
@@ -160,6 +268,27 @@ func TestNewConfigInvalidClusteringSetting(t *testing.T) {
 	require.Error(t, err)
 }
 
+// Ensure an error is returned when strict cluster authentication is enabled
+// without any keys.
+func TestNewConfigClusterAuthStrictNoKeys(t *testing.T) {
+	_, err := NewConfig("configs/cluster-auth-strict-no-keys.yaml")
+	require.Error(t, err)
+}
+
+// Ensure an error is returned when segment encryption is enabled without a
+// key.
+func TestNewConfigSegmentEncryptionNoKey(t *testing.T) {
+	_, err := NewConfig("configs/segment-encryption-no-key.yaml")
+	require.Error(t, err)
+}
+
+// Ensure an error is returned when client authentication is enabled without a
+// credentials file.
+func TestNewConfigAuthNoCredentials(t *testing.T) {
+	_, err := NewConfig("configs/auth-no-credentials.yaml")
+	require.Error(t, err)
+}
+
 // Ensure an error is returned when there is an unknown setting in the file.
 func TestNewConfigUnknownSetting(t *testing.T) {
 	_, err := NewConfig("configs/unknown-setting.yaml")
@@ -181,8 +310,13 @@ func TestStreamsConfigApplyOverrides(t *testing.T) {
 		CompactMaxGoroutines:          &proto.NullableInt32{Value: 10},
 		AutoPauseTime:                 &proto.NullableInt64{Value: 1000000},
 		AutoPauseDisableIfSubscribers: &proto.NullableBool{Value: true},
+		AutoResumeOnPublish:           &proto.NullableBool{Value: true},
+		MaxMessageBytes:               &proto.NullableInt64{Value: 512},
 		MinIsr:                        &proto.NullableInt32{Value: 11},
 		OptimisticConcurrencyControl:  &proto.NullableBool{Value: true},
+		SegmentEncryption:             &proto.NullableBool{Value: true},
+		MessageTimestampType:          MessageTimestampTypeCreateTime,
+		MessageTimestampMaxDifference: &proto.NullableInt64{Value: 1000000},
 	}
 	streamConfig := StreamsConfig{}
 
@@ -200,8 +334,13 @@ func TestStreamsConfigApplyOverrides(t *testing.T) {
 	require.Equal(t, 10, streamConfig.CompactMaxGoroutines)
 	require.Equal(t, s, streamConfig.AutoPauseTime)
 	require.True(t, streamConfig.AutoPauseDisableIfSubscribers)
+	require.True(t, streamConfig.AutoResumeOnPublish)
+	require.Equal(t, int64(512), streamConfig.MaxMessageBytes)
 	require.Equal(t, 11, streamConfig.MinISR)
 	require.Equal(t, true, streamConfig.ConcurrencyControl)
+	require.True(t, streamConfig.SegmentEncryption)
+	require.Equal(t, MessageTimestampTypeCreateTime, streamConfig.MessageTimestampType)
+	require.Equal(t, s, streamConfig.MessageTimestampMaxDifference)
 }
 
 // Ensure default stream configs are always present. This should be the case
@@ -285,3 +424,36 @@ func TestStreamsConfigApplyOverridesCompactEnabled(t *testing.T) {
 	// compact.enabled is set to true)
 	require.Equal(t, true, streamConfig2.Compact)
 }
+
+// Ensure StreamConfig sets every setting ApplyOverrides reads so that
+// applying it to an empty StreamsConfig yields the original.
+func TestStreamsConfigStreamConfig(t *testing.T) {
+	streamConfig := StreamsConfig{
+		RetentionMaxBytes:             2048,
+		RetentionMaxMessages:          1000,
+		RetentionMaxAge:               time.Hour,
+		CleanerInterval:               time.Minute,
+		SegmentMaxBytes:               1024,
+		SegmentMaxAge:                 2 * time.Hour,
+		Compact:                       true,
+		CompactMaxGoroutines:          10,
+		AutoPauseTime:                 time.Second,
+		AutoPauseDisableIfSubscribers: true,
+		AutoResumeOnPublish:           true,
+		MaxMessageBytes:               512,
+		MinISR:                        2,
+		ConcurrencyControl:            true,
+		Encryption:                    true,
+		SegmentEncryption:             true,
+		MessageTimestampType:          MessageTimestampTypeCreateTime,
+		MessageTimestampMaxDifference: 3 * time.Second,
+	}
+	ttl := &proto.NullableInt64{Value: 60000}
+	config := streamConfig.StreamConfig(&proto.StreamConfig{DeadLetterQueue: "foo-dlq", Ttl: ttl})
+	require.Equal(t, "foo-dlq", config.DeadLetterQueue)
+	require.Equal(t, ttl, config.Ttl)
+
+	var applied StreamsConfig
+	applied.ApplyOverrides(config)
+	require.Equal(t, streamConfig, applied)
+}
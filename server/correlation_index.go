@@ -0,0 +1,366 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	atomic_file "github.com/natefinch/atomic"
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// publishJournaledMetadataKey is the gRPC metadata key used to choose if a
+	// publish is journaled, in which case its correlation ID is stored with
+	// the message so the partition leader can index it and the leader epoch
+	// the message was written in is returned in the leaderEpochHeader
+	// response header.
+	publishJournaledMetadataKey = "liftbridge-publish-journaled"
+
+	// leaderEpochHeader is the Publish response header containing the leader
+	// epoch a journaled message was written in. Along with the offset of the
+	// ack, it identifies the message even if the leader changes.
+	leaderEpochHeader = "liftbridge-leader-epoch"
+
+	// correlationIDHeader is the message header the correlation ID of a
+	// journaled message is stored in since the correlation ID isn't otherwise
+	// written to the log. This lets a new leader rebuild its correlation index
+	// from the log.
+	correlationIDHeader = "liftbridge-correlation-id"
+
+	// ackLeaderEpochHeader is the NATS header of acks containing the leader
+	// epoch the acked message was written in since Ack has no field for it.
+	ackLeaderEpochHeader = "Liftbridge-Leader-Epoch"
+
+	correlationIndexFileName = "correlation-index"
+)
+
+// correlationEntry records where the message with a correlation ID was
+// written.
+type correlationEntry struct {
+	Offset      int64  `json:"offset"`
+	LeaderEpoch uint64 `json:"leaderEpoch"`
+	Timestamp   int64  `json:"timestamp"` // Unix nanoseconds the message was received
+}
+
+// correlationIndexCheckpoint is the on-disk representation of a
+// correlationIndex.
+type correlationIndexCheckpoint struct {
+	Offset  int64                       `json:"offset"`
+	Entries map[string]correlationEntry `json:"entries"`
+}
+
+// correlationRef is a correlation ID in the order it was indexed. The offset
+// tells if the ID was indexed again for a later message.
+type correlationRef struct {
+	id     string
+	offset int64
+}
+
+// correlationIndex remembers the offsets and leader epochs of the most
+// recently written journaled messages by correlation ID so that a client
+// which didn't get an ack can check if its message was written before
+// retrying it. It's maintained by the partition leader and bounded by both the
+// number of IDs and how long they're kept.
+type correlationIndex struct {
+	mu     sync.Mutex
+	ttl    time.Duration
+	ids    map[string]correlationEntry
+	ring   []correlationRef // Indexed IDs, evicted oldest first once full
+	next   int
+	offset int64 // Newest log offset reflected in the index
+}
+
+func newCorrelationIndex(size int, ttl time.Duration) *correlationIndex {
+	return &correlationIndex{
+		ttl:    ttl,
+		ids:    make(map[string]correlationEntry, size),
+		ring:   make([]correlationRef, size),
+		offset: -1,
+	}
+}
+
+// add indexes the message with the given correlation ID, evicting the oldest
+// ID if the index is full.
+func (c *correlationIndex) add(id string, entry correlationEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if evicted := c.ring[c.next]; evicted.id != "" {
+		if existing, ok := c.ids[evicted.id]; ok && existing.Offset == evicted.offset {
+			delete(c.ids, evicted.id)
+		}
+	}
+	c.ring[c.next] = correlationRef{id: id, offset: entry.Offset}
+	c.ids[id] = entry
+	c.next = (c.next + 1) % len(c.ring)
+	if entry.Offset > c.offset {
+		c.offset = entry.Offset
+	}
+}
+
+// lookup returns where the message with the given correlation ID was written.
+// The bool returned is false if the ID isn't indexed or was indexed longer
+// than the TTL before the given time.
+func (c *correlationIndex) lookup(id string, now time.Time) (correlationEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.ids[id]
+	if !ok {
+		return entry, false
+	}
+	if now.Sub(time.Unix(0, entry.Timestamp)) > c.ttl {
+		delete(c.ids, id)
+		return entry, false
+	}
+	return entry, true
+}
+
+// checkpoint atomically writes the index to the given file.
+func (c *correlationIndex) checkpoint(file string) error {
+	c.mu.Lock()
+	data, err := json.Marshal(&correlationIndexCheckpoint{
+		Offset:  c.offset,
+		Entries: c.ids,
+	})
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return atomic_file.WriteFile(file, bytes.NewReader(data))
+}
+
+// indexBatch adds the journaled messages of the batch appended to the log at
+// the given offsets in the given leader epoch to the index.
+func (c *correlationIndex) indexBatch(batch []*commitlog.Message, offsets []int64, leaderEpoch uint64) {
+	for i, msg := range batch {
+		if id, ok := msg.Headers[correlationIDHeader]; ok {
+			c.add(string(id), correlationEntry{
+				Offset:      offsets[i],
+				LeaderEpoch: leaderEpoch,
+				Timestamp:   msg.Timestamp,
+			})
+		}
+	}
+}
+
+// loadCorrelationIndex rebuilds the correlation index from the checkpoint file
+// written when the partition was last closed and the messages at the end of
+// the log. Like the producer state, the checkpoint is only used if it doesn't
+// reflect messages past the log's high watermark since those may have been
+// truncated and replaced. At most scanDepth messages are read from the end of
+// the log.
+func loadCorrelationIndex(file string, log commitlog.CommitLog, size int, ttl time.Duration,
+	scanDepth int64) (*correlationIndex, error) {
+
+	index := newCorrelationIndex(size, ttl)
+	data, err := os.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		checkpoint := new(correlationIndexCheckpoint)
+		if err := json.Unmarshal(data, checkpoint); err != nil {
+			return nil, errors.Wrap(err, "invalid correlation index checkpoint")
+		}
+		if checkpoint.Offset <= log.HighWatermark() {
+			// Index the entries in the order they were written so the
+			// oldest are evicted first.
+			ids := make([]string, 0, len(checkpoint.Entries))
+			for id := range checkpoint.Entries {
+				ids = append(ids, id)
+			}
+			sort.Slice(ids, func(i, j int) bool {
+				return checkpoint.Entries[ids[i]].Offset < checkpoint.Entries[ids[j]].Offset
+			})
+			for _, id := range ids {
+				index.add(id, checkpoint.Entries[id])
+			}
+			index.offset = checkpoint.Offset
+		}
+	}
+
+	newest := log.NewestOffset()
+	start := index.offset + 1
+	if tail := newest - scanDepth + 1; start < tail {
+		start = tail
+	}
+	if oldest := log.OldestOffset(); start < oldest {
+		start = oldest
+	}
+	if newest < 0 || start > newest {
+		return index, nil
+	}
+
+	reader, err := log.NewReader(start, true)
+	if err != nil {
+		return nil, err
+	}
+	headersBuf := make([]byte, 28)
+	for offset := int64(-1); offset < newest; {
+		var (
+			msg         commitlog.SerializedMessage
+			timestamp   int64
+			leaderEpoch uint64
+		)
+		msg, offset, timestamp, leaderEpoch, err = reader.ReadMessage(context.Background(), headersBuf)
+		if err != nil {
+			return nil, err
+		}
+		if id, ok := msg.Headers()[correlationIDHeader]; ok {
+			index.add(string(id), correlationEntry{
+				Offset:      offset,
+				LeaderEpoch: leaderEpoch,
+				Timestamp:   timestamp,
+			})
+		}
+	}
+	return index, nil
+}
+
+// correlationIndexFile returns the path of the partition's correlation index
+// checkpoint.
+func (p *partition) correlationIndexFile() string {
+	return filepath.Join(p.srv.partitionDir(p.Stream, p.Id), correlationIndexFileName)
+}
+
+// loadCorrelationIndex rebuilds the partition's correlation index when the
+// server becomes its leader, if enabled. The index is left empty if it can't
+// be rebuilt since it's only used to answer lookups. Must be called within the
+// scope of the partition mutex.
+func (p *partition) loadCorrelationIndex() {
+	config := p.srv.config.Streams
+	if config.CorrelationIndexSize == 0 {
+		return
+	}
+	index, err := loadCorrelationIndex(p.correlationIndexFile(), p.log, config.CorrelationIndexSize,
+		config.CorrelationIndexTTL, config.CorrelationIndexScanDepth)
+	if err != nil {
+		p.srv.logger.Errorf("Failed to load correlation index for partition %s: %v", p, err)
+		index = newCorrelationIndex(config.CorrelationIndexSize, config.CorrelationIndexTTL)
+	}
+	p.correlations = index
+}
+
+// checkpointCorrelationIndex writes the partition's correlation index to disk
+// so it can be loaded when the partition is reopened without scanning the log.
+// Must be called within the scope of the partition mutex.
+func (p *partition) checkpointCorrelationIndex() {
+	if p.correlations == nil {
+		return
+	}
+	if err := p.correlations.checkpoint(p.correlationIndexFile()); err != nil {
+		p.srv.logger.Errorf("Failed to checkpoint correlation index for partition %s: %v", p, err)
+	}
+}
+
+// CheckMessageExists reports if a message was written to the partition,
+// identified either by its correlation ID, if the message was journaled and is
+// still indexed, or by the offset and leader epoch it was acked with. Only the
+// partition leader can answer since the index is kept by the leader and
+// followers may not have replicated the message yet.
+func (p *partition) CheckMessageExists(req *proto.CheckMessageExistsRequest) (
+	*proto.CheckMessageExistsResponse, *status.Status) {
+
+	p.mu.RLock()
+	leading, correlations := p.isLeading, p.correlations
+	p.mu.RUnlock()
+	if !leading {
+		return nil, status.New(codes.FailedPrecondition, ErrNotPartitionLeader.Error())
+	}
+
+	resp := new(proto.CheckMessageExistsResponse)
+	if req.CorrelationId != "" {
+		if correlations == nil {
+			return nil, status.New(codes.FailedPrecondition, "Correlation index is disabled")
+		}
+		entry, ok := correlations.lookup(req.CorrelationId, time.Now())
+		if !ok {
+			return resp, nil
+		}
+		resp.Exists = true
+		resp.Offset = entry.Offset
+		resp.LeaderEpoch = entry.LeaderEpoch
+	} else {
+		leaderEpoch, ok, err := p.messageLeaderEpoch(req.Offset)
+		if err != nil {
+			return nil, status.New(codes.Internal, err.Error())
+		}
+		if !ok || leaderEpoch != req.LeaderEpoch {
+			return resp, nil
+		}
+		resp.Exists = true
+		resp.Offset = req.Offset
+		resp.LeaderEpoch = leaderEpoch
+	}
+	resp.Committed = resp.Offset <= p.log.HighWatermark()
+	return resp, nil
+}
+
+// messageLeaderEpoch returns the leader epoch the message at the given offset
+// was written in. The bool returned is false if the log doesn't contain the
+// offset.
+func (p *partition) messageLeaderEpoch(offset int64) (uint64, bool, error) {
+	if offset < p.log.OldestOffset() || offset > p.log.NewestOffset() {
+		return 0, false, nil
+	}
+	reader, err := p.log.NewReader(offset, true)
+	if err != nil {
+		return 0, false, err
+	}
+	_, readOffset, _, leaderEpoch, err := reader.ReadMessage(context.Background(), make([]byte, 28))
+	if err != nil {
+		return 0, false, err
+	}
+	// The message may have been removed by compaction.
+	return leaderEpoch, readOffset == offset, nil
+}
+
+// publishJournaledFromContext indicates if the incoming gRPC metadata of the
+// given context requests a journaled publish.
+func publishJournaledFromContext(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	values := md.Get(publishJournaledMetadataKey)
+	switch len(values) {
+	case 0:
+		return false, nil
+	case 1:
+		journaled, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return false, fmt.Errorf("invalid %s: %v", publishJournaledMetadataKey, err)
+		}
+		return journaled, nil
+	default:
+		return false, fmt.Errorf("only one %s can be set", publishJournaledMetadataKey)
+	}
+}
+
+// ackLeaderEpoch returns the leader epoch set in the given ack headers by the
+// partition leader. The bool returned is false if it isn't set, which happens
+// if the NATS server doesn't support headers.
+func ackLeaderEpoch(header nats.Header) (uint64, bool) {
+	if header == nil {
+		return 0, false
+	}
+	epoch, err := strconv.ParseUint(header.Get(ackLeaderEpochHeader), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return epoch, true
+}
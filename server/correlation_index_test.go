@@ -0,0 +1,241 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure the correlation index evicts the oldest IDs once full, unless they
+// were indexed again since, and forgets IDs older than the TTL.
+func TestCorrelationIndexEviction(t *testing.T) {
+	now := time.Now()
+	index := newCorrelationIndex(2, time.Minute)
+	entry := func(offset int64) correlationEntry {
+		return correlationEntry{Offset: offset, LeaderEpoch: 1, Timestamp: now.UnixNano()}
+	}
+
+	index.add("a", entry(0))
+	index.add("b", entry(1))
+	index.add("a", entry(2))
+	// Evicts the first ref to a, which was indexed again, so a is kept.
+	index.add("c", entry(3))
+	_, ok := index.lookup("b", now)
+	require.False(t, ok)
+	a, ok := index.lookup("a", now)
+	require.True(t, ok)
+	require.Equal(t, int64(2), a.Offset)
+	c, ok := index.lookup("c", now)
+	require.True(t, ok)
+	require.Equal(t, int64(3), c.Offset)
+	require.Equal(t, int64(3), index.offset)
+
+	// Evicts a.
+	index.add("d", entry(4))
+	_, ok = index.lookup("a", now)
+	require.False(t, ok)
+
+	_, ok = index.lookup("d", now.Add(time.Minute))
+	require.True(t, ok)
+	_, ok = index.lookup("d", now.Add(time.Minute+time.Second))
+	require.False(t, ok)
+}
+
+// Ensure the correlation index is rebuilt from its checkpoint and the end of
+// the log, that the checkpoint is only used if it doesn't reflect messages
+// past the high watermark, and that at most the scan depth is read.
+func TestLoadCorrelationIndex(t *testing.T) {
+	dir := t.TempDir()
+	log, err := commitlog.New(commitlog.Options{Path: filepath.Join(dir, "log")})
+	require.NoError(t, err)
+	defer log.Close()
+
+	journaledMsg := func(id string) *commitlog.Message {
+		return &commitlog.Message{
+			Value:     []byte("foo"),
+			Timestamp: time.Now().UnixNano(),
+			Headers:   map[string][]byte{correlationIDHeader: []byte(id)},
+		}
+	}
+	_, err = log.Append([]*commitlog.Message{
+		journaledMsg("a"),
+		{Value: []byte("bar"), Timestamp: time.Now().UnixNano()},
+		journaledMsg("b"),
+		journaledMsg("c"),
+	})
+	require.NoError(t, err)
+	log.SetHighWatermark(3)
+
+	file := filepath.Join(dir, correlationIndexFileName)
+	index, err := loadCorrelationIndex(file, log, 10, time.Minute, 2)
+	require.NoError(t, err)
+	_, ok := index.lookup("a", time.Now())
+	require.False(t, ok)
+	b, ok := index.lookup("b", time.Now())
+	require.True(t, ok)
+	require.Equal(t, int64(2), b.Offset)
+	require.Equal(t, log.LastLeaderEpoch(), b.LeaderEpoch)
+
+	index, err = loadCorrelationIndex(file, log, 10, time.Minute, 10)
+	require.NoError(t, err)
+	a, ok := index.lookup("a", time.Now())
+	require.True(t, ok)
+	require.Equal(t, int64(0), a.Offset)
+
+	// Checkpoint the index, then append more messages which should be
+	// scanned on top of it.
+	require.NoError(t, index.checkpoint(file))
+	_, err = log.Append([]*commitlog.Message{journaledMsg("d")})
+	require.NoError(t, err)
+	log.SetHighWatermark(4)
+
+	index, err = loadCorrelationIndex(file, log, 10, time.Minute, 1)
+	require.NoError(t, err)
+	for i, id := range []string{"a", "b", "c", "d"} {
+		entry, ok := index.lookup(id, time.Now())
+		require.True(t, ok, id)
+		require.Equal(t, int64([]int{0, 2, 3, 4}[i]), entry.Offset)
+	}
+
+	// A checkpoint past the high watermark is ignored and the index is
+	// rebuilt from the log instead.
+	stale := newCorrelationIndex(10, time.Minute)
+	stale.add("e", correlationEntry{Offset: 10, Timestamp: time.Now().UnixNano()})
+	require.NoError(t, stale.checkpoint(file))
+
+	index, err = loadCorrelationIndex(file, log, 10, time.Minute, 10)
+	require.NoError(t, err)
+	_, ok = index.lookup("e", time.Now())
+	require.False(t, ok)
+	_, ok = index.lookup("d", time.Now())
+	require.True(t, ok)
+}
+
+// Ensure a journaled publish returns the leader epoch its message was written
+// in and that CheckMessageExists finds the message by correlation ID and by
+// offset and leader epoch, including after the server restarts.
+func TestCheckMessageExists(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Streams.CorrelationIndexSize = 100
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	lc, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	require.NoError(t, lc.CreateStream(context.Background(), "foo", "foo"))
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	api := client.NewAPIClient(conn)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), publishJournaledMetadataKey, "true")
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// A journaled publish needs a correlation ID.
+	_, err = api.Publish(ctx, &client.PublishRequest{Stream: "foo", Value: []byte("hello")})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	epoch := s1.metadata.GetPartition("foo", 0).log.LastLeaderEpoch()
+	for i := 0; i < 3; i++ {
+		var header metadata.MD
+		resp, err := api.Publish(ctx, &client.PublishRequest{
+			Stream:        "foo",
+			Value:         []byte("hello"),
+			AckPolicy:     client.AckPolicy_ALL,
+			CorrelationId: fmt.Sprintf("msg-%d", i),
+		}, grpc.Header(&header))
+		require.NoError(t, err)
+		require.Equal(t, int64(i), resp.Ack.Offset)
+		require.Equal(t, []string{strconv.FormatUint(epoch, 10)}, header.Get(leaderEpochHeader))
+	}
+
+	checkExists := func(s *Server) {
+		conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", s.config.Port), grpc.WithInsecure())
+		require.NoError(t, err)
+		defer conn.Close()
+		admin := proto.NewAdminAPIClient(conn)
+
+		resp, err := admin.CheckMessageExists(context.Background(), &proto.CheckMessageExistsRequest{
+			Stream:        "foo",
+			CorrelationId: "msg-1",
+		})
+		require.NoError(t, err)
+		require.True(t, resp.Exists)
+		require.Equal(t, int64(1), resp.Offset)
+		require.Equal(t, epoch, resp.LeaderEpoch)
+		require.True(t, resp.Committed)
+
+		resp, err = admin.CheckMessageExists(context.Background(), &proto.CheckMessageExistsRequest{
+			Stream:        "foo",
+			CorrelationId: "msg-3",
+		})
+		require.NoError(t, err)
+		require.False(t, resp.Exists)
+
+		resp, err = admin.CheckMessageExists(context.Background(), &proto.CheckMessageExistsRequest{
+			Stream:      "foo",
+			Offset:      2,
+			LeaderEpoch: epoch,
+		})
+		require.NoError(t, err)
+		require.True(t, resp.Exists)
+		require.True(t, resp.Committed)
+
+		resp, err = admin.CheckMessageExists(context.Background(), &proto.CheckMessageExistsRequest{
+			Stream:      "foo",
+			Offset:      2,
+			LeaderEpoch: epoch + 1,
+		})
+		require.NoError(t, err)
+		require.False(t, resp.Exists)
+
+		_, err = admin.CheckMessageExists(context.Background(), &proto.CheckMessageExistsRequest{
+			Stream:    "foo",
+			Partition: 1,
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	}
+	checkExists(s1)
+
+	// Restart the server, which loads the index from its checkpoint.
+	s1.Stop()
+	file := filepath.Join(s1.partitionDir("foo", 0), correlationIndexFileName)
+	require.FileExists(t, file)
+	s1 = runServerWithConfig(t, s1.config)
+	defer s1.Stop()
+	getPartitionLeader(t, 10*time.Second, "foo", 0, s1)
+	checkExists(s1)
+
+	// Restart the server without the checkpoint, which rebuilds the index
+	// from the log.
+	s1.Stop()
+	require.NoError(t, os.Remove(file))
+	s1 = runServerWithConfig(t, s1.config)
+	defer s1.Stop()
+	getPartitionLeader(t, 10*time.Second, "foo", 0, s1)
+	checkExists(s1)
+}
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// waitForISRMetadataKey is the gRPC metadata key used to make CreateStream
+	// wait for every replica of the new partitions to join their ISR, rather
+	// than just for their leaders, since CreateStreamRequest has no field for
+	// it.
+	waitForISRMetadataKey = "liftbridge-wait-for-isr"
+
+	// unconfirmedReplicasHeader is the CreateStream response header set to
+	// the replicas which hadn't joined the ISR of the new partitions when the
+	// wait for them timed out. Each value is a serialized UnconfirmedReplicas.
+	// Since it's a binary header, gRPC takes care of encoding the values.
+	unconfirmedReplicasHeader = "liftbridge-unconfirmed-replicas-bin"
+
+	// isrWaitInterval is how often the replicas which haven't confirmed a new
+	// partition are asked for their status again.
+	isrWaitInterval = 100 * time.Millisecond
+
+	// isrWaitDeadlineMargin is how long before the request deadline the wait
+	// for the ISR of new partitions stops so the response can still be sent.
+	isrWaitDeadlineMargin = 250 * time.Millisecond
+)
+
+// waitForISRFromContext indicates if the incoming gRPC metadata of the given
+// context requests waiting for the ISR of new partitions, defaulting to the
+// given value if it's not set.
+func waitForISRFromContext(ctx context.Context, defaultWait bool) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return defaultWait, nil
+	}
+	values := md.Get(waitForISRMetadataKey)
+	switch len(values) {
+	case 0:
+		return defaultWait, nil
+	case 1:
+		wait, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return false, fmt.Errorf("invalid %s: %v", waitForISRMetadataKey, err)
+		}
+		return wait, nil
+	default:
+		return false, fmt.Errorf("only one %s can be set", waitForISRMetadataKey)
+	}
+}
+
+// isrWaitContext returns a context for creating streams and waiting for the
+// ISR of their partitions which is done shortly before the given context's
+// deadline, so the replicas which haven't confirmed can still be returned, or
+// once the default propagate timeout elapses if it has no deadline.
+func isrWaitContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if ok {
+		deadline = deadline.Add(-isrWaitDeadlineMargin)
+	} else {
+		deadline = time.Now().Add(defaultPropagateTimeout)
+	}
+	return context.WithDeadline(ctx, deadline)
+}
+
+// waitForStreamsISR waits for every replica of the partitions of the given
+// streams to create the partition and join its ISR until the context is done.
+// Replicas are asked for their status over their partition status inboxes. It
+// returns the replicas which hadn't confirmed by then, if any. If this server
+// hasn't applied the creation of a partition by then, its replicas aren't
+// known and the partition is returned without any.
+func (m *metadataAPI) waitForStreamsISR(ctx context.Context, streams []*proto.Stream) []*proto.UnconfirmedReplicas {
+	var pending []*proto.UnconfirmedReplicas
+	for _, stream := range streams {
+		for _, partition := range stream.Partitions {
+			pending = append(pending, &proto.UnconfirmedReplicas{
+				Stream:    stream.Name,
+				Partition: partition.Id,
+			})
+		}
+	}
+
+	ticker := time.NewTicker(isrWaitInterval)
+	defer ticker.Stop()
+	for {
+		remaining := pending[:0]
+		for _, unconfirmed := range pending {
+			if !m.confirmReplicas(ctx, unconfirmed) {
+				remaining = append(remaining, unconfirmed)
+			}
+		}
+		pending = remaining
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return pending
+		}
+	}
+}
+
+// confirmReplicas removes the replicas which have created the given partition
+// and are in its ISR from the given unconfirmed replicas, filling them in with
+// the partition's replicas first if they aren't known yet. It indicates if all
+// of them have confirmed.
+func (m *metadataAPI) confirmReplicas(ctx context.Context, unconfirmed *proto.UnconfirmedReplicas) bool {
+	partition := m.GetPartition(unconfirmed.Stream, unconfirmed.Partition)
+	if partition == nil {
+		// The creation hasn't been applied on this server yet.
+		return false
+	}
+	if unconfirmed.Replicas == nil {
+		unconfirmed.Replicas = partition.GetReplicas()
+		sort.Strings(unconfirmed.Replicas)
+	}
+
+	var (
+		confirmed = make([]bool, len(unconfirmed.Replicas))
+		wg        sync.WaitGroup
+	)
+	for i, replica := range unconfirmed.Replicas {
+		wg.Add(1)
+		go func(i int, replica string) {
+			defer wg.Done()
+			var st *proto.PartitionReplicaStatus
+			if replica == m.config.Clustering.ServerID {
+				st = m.localPartitionStatus(unconfirmed.Stream, unconfirmed.Partition)
+			} else {
+				st = m.fetchReplicaStatus(ctx, replica, unconfirmed.Stream, unconfirmed.Partition)
+			}
+			confirmed[i] = replicaJoinedISR(st, partition)
+		}(i, replica)
+	}
+	wg.Wait()
+
+	remaining := []string{}
+	for i, replica := range unconfirmed.Replicas {
+		if !confirmed[i] {
+			remaining = append(remaining, replica)
+		}
+	}
+	unconfirmed.Replicas = remaining
+	return len(remaining) == 0
+}
+
+// replicaJoinedISR indicates if the replica reporting the given status has
+// started the partition as its leader or a follower and is in its ISR.
+func replicaJoinedISR(st *proto.PartitionReplicaStatus, partition *partition) bool {
+	if st.Error != "" {
+		return false
+	}
+	switch st.State {
+	case proto.PartitionState_PARTITION_STATE_LEADER, proto.PartitionState_PARTITION_STATE_FOLLOWER:
+		return partition.inISR(st.Broker)
+	default:
+		return false
+	}
+}
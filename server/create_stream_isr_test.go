@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure the wait for the ISR is parsed from the request metadata and
+// defaults to the configured value.
+func TestWaitForISRFromContext(t *testing.T) {
+	wait, err := waitForISRFromContext(context.Background(), true)
+	require.NoError(t, err)
+	require.True(t, wait)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		waitForISRMetadataKey, "false"))
+	wait, err = waitForISRFromContext(ctx, true)
+	require.NoError(t, err)
+	require.False(t, wait)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		waitForISRMetadataKey, "yes"))
+	_, err = waitForISRFromContext(ctx, false)
+	require.Error(t, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		waitForISRMetadataKey, "true", waitForISRMetadataKey, "true"))
+	_, err = waitForISRFromContext(ctx, false)
+	require.Error(t, err)
+}
+
+// Ensure CreateStream waiting for the ISR returns once every replica has
+// joined the ISR, even if one of them is briefly unavailable, and returns the
+// replicas which didn't join in time when the request deadline is reached.
+func TestCreateStreamWaitForISR(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	var (
+		configs []*Config
+		servers []*Server
+	)
+	for i, id := range []string{"a", "b", "c"} {
+		config := getTestConfig(id, i == 0, 5050+i)
+		config.EmbeddedNATS = false
+		configs = append(configs, config)
+		servers = append(servers, runServerWithConfig(t, config))
+	}
+	defer func() {
+		for _, s := range servers {
+			s.Stop()
+		}
+	}()
+	leader := getMetadataLeader(t, 10*time.Second, servers...)
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", leader.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	api := client.NewAPIClient(conn)
+
+	// create creates a stream with a partition on every server and returns
+	// the unconfirmed replicas in the response headers.
+	create := func(name string, timeout time.Duration, wait bool) ([]*proto.UnconfirmedReplicas, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		if wait {
+			ctx = metadata.AppendToOutgoingContext(ctx, waitForISRMetadataKey, "true")
+		}
+		var header metadata.MD
+		_, err := api.CreateStream(ctx, &client.CreateStreamRequest{
+			Name:              name,
+			Subject:           name,
+			ReplicationFactor: 3,
+		}, grpc.Header(&header))
+		var unconfirmed []*proto.UnconfirmedReplicas
+		for _, value := range header.Get(unconfirmedReplicasHeader) {
+			replicas := &proto.UnconfirmedReplicas{}
+			require.NoError(t, replicas.Unmarshal([]byte(value)))
+			unconfirmed = append(unconfirmed, replicas)
+		}
+		return unconfirmed, err
+	}
+
+	// partitionLeader returns the leader of the stream's partition.
+	partitionLeader := func(name string) string {
+		leader, _ := leader.metadata.GetPartition(name, 0).GetLeader()
+		return leader
+	}
+
+	// All replicas are available.
+	unconfirmed, err := create("foo", 10*time.Second, true)
+	require.NoError(t, err)
+	require.Empty(t, unconfirmed)
+	for _, s := range servers {
+		st := s.metadata.localPartitionStatus("foo", 0)
+		require.Contains(t, []proto.PartitionState{
+			proto.PartitionState_PARTITION_STATE_LEADER,
+			proto.PartitionState_PARTITION_STATE_FOLLOWER,
+		}, st.State)
+	}
+
+	// Stop a follower which isn't the metadata leader. Partition leaders are
+	// balanced in the order servers joined, so it won't lead the next
+	// partition.
+	require.Equal(t, "a", leader.config.Clustering.ServerID)
+	require.Equal(t, "a", partitionLeader("foo"))
+	servers[2].Stop()
+
+	// Waiting for the ISR times out but the stream still exists.
+	unconfirmed, err = create("bar", 2*time.Second, true)
+	require.NoError(t, err)
+	require.Equal(t, []*proto.UnconfirmedReplicas{
+		{Stream: "bar", Partition: 0, Replicas: []string{"c"}},
+	}, unconfirmed)
+	require.NotNil(t, leader.metadata.GetStream("bar"))
+	require.Equal(t, "b", partitionLeader("bar"))
+
+	// Waiting for the ISR returns once the follower is back, even though it
+	// leads the partition.
+	type result struct {
+		unconfirmed []*proto.UnconfirmedReplicas
+		err         error
+	}
+	done := make(chan result, 1)
+	go func() {
+		unconfirmed, err := create("baz", 20*time.Second, true)
+		done <- result{unconfirmed, err}
+	}()
+	time.Sleep(time.Second)
+	select {
+	case <-done:
+		t.Fatal("CreateStream returned before the follower restarted")
+	default:
+	}
+	servers[2] = runServerWithConfig(t, configs[2])
+
+	select {
+	case r := <-done:
+		require.NoError(t, r.err)
+		require.Empty(t, r.unconfirmed)
+	case <-time.After(20 * time.Second):
+		t.Fatal("CreateStream didn't return")
+	}
+	require.Equal(t, "c", partitionLeader("baz"))
+	require.ElementsMatch(t, []string{"a", "b", "c"}, leader.metadata.GetPartition("baz", 0).GetISR())
+}
@@ -8,7 +8,9 @@ import (
 	"time"
 
 	lru "github.com/hashicorp/golang-lru"
+	"github.com/nats-io/nats.go"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	client "github.com/liftbridge-io/liftbridge-api/v2/go"
@@ -229,7 +231,9 @@ func (c *cursorManager) getLatestCursorOffset(ctx context.Context, cursorKey []b
 	// Use reverse subscription to find the latest cursor value efficiently.
 	// By reading from newest to oldest, the first matching key is the latest
 	// cursor value, allowing early exit instead of scanning all messages.
-	ctx, cancel := context.WithCancel(ctx)
+	// The subscription mustn't inherit options, such as message filters, from
+	// the metadata of the request the lookup is made for.
+	ctx, cancel := context.WithCancel(metadata.NewIncomingContext(ctx, nil))
 	defer cancel()
 	sub, err := c.api.SubscribeInternal(ctx, &client.SubscribeRequest{
 		Stream:        cursorsStream,
@@ -274,3 +278,127 @@ func (c *cursorManager) getLatestCursorOffset(ctx context.Context, cursorKey []b
 		}
 	}
 }
+
+// FetchCursor returns the latest partition offset for the given cursor like
+// GetCursor, asking the leader of the cursor's partition of the cursors stream
+// for it if this server isn't the leader. This returns -1 if the cursor
+// doesn't exist.
+func (c *cursorManager) FetchCursor(ctx context.Context, streamName, cursorID string, partitionID int32) (
+	int64, *status.Status) {
+
+	return c.routeCursorRequest(ctx, &proto.CursorRequest{
+		Cursor: &proto.Cursor{Stream: streamName, Partition: partitionID, CursorId: cursorID},
+	})
+}
+
+// CommitCursor stores a cursor position like SetCursor, asking the leader of
+// the cursor's partition of the cursors stream to store it if this server
+// isn't the leader.
+func (c *cursorManager) CommitCursor(ctx context.Context, streamName, cursorID string, partitionID int32,
+	offset int64) *status.Status {
+
+	_, st := c.routeCursorRequest(ctx, &proto.CursorRequest{
+		Cursor: &proto.Cursor{Stream: streamName, Partition: partitionID, CursorId: cursorID, Offset: offset},
+		Set:    true,
+	})
+	return st
+}
+
+// routeCursorRequest performs the given cursor request on this server if it
+// leads the cursor's partition of the cursors stream. Otherwise, it sends the
+// request to the partition leader's cursor inbox.
+func (c *cursorManager) routeCursorRequest(ctx context.Context, req *proto.CursorRequest) (int64, *status.Status) {
+	cursorKey := c.getCursorKey(req.Cursor.CursorId, req.Cursor.Stream, req.Cursor.Partition)
+	leader, st := c.getCursorsPartitionLeader(cursorKey)
+	if st != nil {
+		return 0, st
+	}
+	if leader == c.config.Clustering.ServerID {
+		return c.applyCursorRequest(ctx, req)
+	}
+	if leader == "" {
+		return 0, status.New(codes.Unavailable, "Cursors partition has no leader")
+	}
+
+	data, err := proto.MarshalCursorRequest(req)
+	if err != nil {
+		panic(err)
+	}
+	ctx, cancel := ensureTimeout(ctx, defaultCursorTimeout)
+	defer cancel()
+	msg, err := c.ncRaft.RequestWithContext(ctx, c.getCursorInbox(leader), c.auth.sign(data))
+	if err != nil {
+		return 0, status.Newf(codes.Unavailable, "Failed to reach cursors partition leader %s: %v", leader, err)
+	}
+	if !c.auth.verify(msg.Subject, msg.Data) {
+		return 0, status.New(codes.Internal, "Unauthenticated cursor response")
+	}
+	resp, err := proto.UnmarshalCursorResponse(msg.Data)
+	if err != nil {
+		return 0, status.Newf(codes.Internal, "Invalid cursor response: %v", err)
+	}
+	if resp.Error != nil {
+		return 0, status.New(codes.Code(resp.Error.Code), resp.Error.Msg)
+	}
+	return resp.Offset, nil
+}
+
+// applyCursorRequest fetches or sets the cursor of the given request on this
+// server, which must lead the cursor's partition of the cursors stream.
+func (c *cursorManager) applyCursorRequest(ctx context.Context, req *proto.CursorRequest) (int64, *status.Status) {
+	cursor := req.Cursor
+	if req.Set {
+		return cursor.Offset, c.SetCursor(ctx, cursor.Stream, cursor.CursorId, cursor.Partition, cursor.Offset)
+	}
+	return c.GetCursor(ctx, cursor.Stream, cursor.CursorId, cursor.Partition)
+}
+
+// getCursorsPartitionLeader returns the leader of the cursors partition the
+// given cursor key maps to, or an empty string if it has none.
+func (c *cursorManager) getCursorsPartitionLeader(cursorKey []byte) (string, *status.Status) {
+	stream := c.metadata.GetStream(cursorsStream)
+	if stream == nil {
+		return "", status.New(codes.FailedPrecondition, "Cursors stream does not exist")
+	}
+	var (
+		cursorsPartitionID = int32(hasher(cursorKey) % uint32(len(stream.GetPartitions())))
+		cursorsPartition   = stream.GetPartition(cursorsPartitionID)
+	)
+	if cursorsPartition == nil {
+		return "", status.Newf(codes.Internal, "Cursors partition %d does not exist", cursorsPartitionID)
+	}
+	leader, _ := cursorsPartition.GetLeader()
+	return leader, nil
+}
+
+// handleCursorRequest is a NATS handler used to process cursor requests sent
+// by servers which don't lead the cursor's partition of the cursors stream,
+// e.g. to commit the cursors of subscriptions they serve.
+func (s *Server) handleCursorRequest(m *nats.Msg) {
+	if !s.auth.verify(m.Subject, m.Data) {
+		return
+	}
+	req, err := proto.UnmarshalCursorRequest(m.Data)
+	if err != nil || req.Cursor == nil {
+		s.logger.Warnf("Dropping invalid cursor request: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultCursorTimeout)
+	defer cancel()
+	resp := &proto.CursorResponse{}
+	offset, st := s.cursors.applyCursorRequest(ctx, req)
+	if st != nil {
+		resp.Error = &proto.Error{Code: uint32(st.Code()), Msg: st.Message()}
+	} else {
+		resp.Offset = offset
+	}
+
+	data, err := proto.MarshalCursorResponse(resp)
+	if err != nil {
+		panic(err)
+	}
+	if err := m.Respond(s.auth.sign(data)); err != nil {
+		s.logger.Errorf("Failed to respond to cursor request: %v", err)
+	}
+}
@@ -0,0 +1,314 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"path"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// cursorOffsetMapper translates the offset of an exported cursor to an offset
+// in the partition it's imported to. It's given the state of the source
+// partition recorded in the document and the offset range of the target
+// partition.
+type cursorOffsetMapper func(offset int64, source *proto.ExportedPartition, oldest, newest int64) (int64, error)
+
+// cursorOffsetMappers contains the offset mappings which can be used when
+// importing cursors.
+var cursorOffsetMappers = map[proto.CursorOffsetMapping]cursorOffsetMapper{
+	// Identity keeps offsets as is, e.g. when the target stream has the same
+	// messages as the source stream.
+	proto.CursorOffsetMapping_CURSOR_OFFSET_IDENTITY: func(offset int64, _ *proto.ExportedPartition, _, _ int64) (int64, error) {
+		return offset, nil
+	},
+	// Align oldest keeps the distance from the oldest offset, e.g. when the
+	// retained messages of the source stream were copied to the target stream
+	// and renumbered from its start.
+	proto.CursorOffsetMapping_CURSOR_OFFSET_ALIGN_OLDEST: func(offset int64, source *proto.ExportedPartition, oldest, _ int64) (int64, error) {
+		if source == nil {
+			return 0, errSourcePartitionUnknown
+		}
+		return offset - source.OldestOffset + oldest, nil
+	},
+	// Align newest keeps the distance from the newest offset, e.g. when the
+	// target stream has the same recent messages as the source stream but
+	// fewer or more older ones.
+	proto.CursorOffsetMapping_CURSOR_OFFSET_ALIGN_NEWEST: func(offset int64, source *proto.ExportedPartition, _, newest int64) (int64, error) {
+		if source == nil {
+			return 0, errSourcePartitionUnknown
+		}
+		return offset - source.NewestOffset + newest, nil
+	},
+}
+
+// errSourcePartitionUnknown is returned by offset mappers which need the state
+// of the source partition when the document doesn't have it.
+var errSourcePartitionUnknown = errors.New("source partition not in document")
+
+// exportCursors returns the cursors matching the given pattern and stream,
+// which are empty to match all of them. The cursors are read from this
+// server's replicas of the cursors partitions and the state of their streams
+// from its replicas of the stream partitions.
+func (s *Server) exportCursors(ctx context.Context, pattern, streamName string) (
+	*proto.CursorDocument, *status.Status) {
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, status.Newf(codes.InvalidArgument, "Invalid cursor ID pattern: %v", err)
+	}
+	stream := s.metadata.GetStream(cursorsStream)
+	if stream == nil {
+		return nil, status.New(codes.FailedPrecondition, "Cursors stream does not exist")
+	}
+
+	latest := make(map[string]*proto.Cursor)
+	for _, partition := range stream.GetPartitions() {
+		if !partition.IsReplica(s.config.Clustering.ServerID) {
+			return nil, status.Newf(codes.FailedPrecondition,
+				"Server not replica for cursors partition %d", partition.Id)
+		}
+		if err := s.readCursors(ctx, partition, latest); err != nil {
+			return nil, status.Newf(codes.Internal,
+				"Failed to read cursors partition %d: %v", partition.Id, err)
+		}
+	}
+
+	doc := &proto.CursorDocument{
+		Namespace:  s.config.Clustering.Namespace,
+		ExportedAt: time.Now().UnixNano(),
+	}
+	streams := make(map[string]struct{})
+	for _, cursor := range latest {
+		if streamName != "" && cursor.Stream != streamName {
+			continue
+		}
+		if pattern != "" {
+			if ok, _ := path.Match(pattern, cursor.CursorId); !ok {
+				continue
+			}
+		}
+		doc.Cursors = append(doc.Cursors, &proto.ExportedCursor{
+			CursorId:  cursor.CursorId,
+			Stream:    cursor.Stream,
+			Partition: cursor.Partition,
+			Offset:    cursor.Offset,
+		})
+		streams[cursor.Stream] = struct{}{}
+	}
+	sort.Slice(doc.Cursors, func(i, j int) bool {
+		a, b := doc.Cursors[i], doc.Cursors[j]
+		if a.Stream != b.Stream {
+			return a.Stream < b.Stream
+		}
+		if a.Partition != b.Partition {
+			return a.Partition < b.Partition
+		}
+		return a.CursorId < b.CursorId
+	})
+
+	for name := range streams {
+		// Cursors of deleted streams are exported without their stream.
+		stream := s.metadata.GetStream(name)
+		if stream == nil {
+			continue
+		}
+		exported := &proto.ExportedStream{
+			Name:              name,
+			CreationTimestamp: stream.GetCreationTime().UnixNano(),
+		}
+		for _, partition := range stream.GetPartitions() {
+			if !partition.IsReplica(s.config.Clustering.ServerID) {
+				return nil, status.Newf(codes.FailedPrecondition,
+					"Server not replica for partition %d of stream %s", partition.Id, name)
+			}
+			metadata := &proto.Partition{}
+			if err := metadata.Unmarshal(partition.Marshal()); err != nil {
+				panic(err)
+			}
+			exported.Partitions = append(exported.Partitions, &proto.ExportedPartition{
+				Id:           partition.Id,
+				Epoch:        metadata.Epoch,
+				LeaderEpoch:  metadata.LeaderEpoch,
+				OldestOffset: partition.log.OldestOffset(),
+				NewestOffset: partition.log.HighWatermark(),
+			})
+		}
+		sort.Slice(exported.Partitions, func(i, j int) bool {
+			return exported.Partitions[i].Id < exported.Partitions[j].Id
+		})
+		doc.Streams = append(doc.Streams, exported)
+	}
+	sort.Slice(doc.Streams, func(i, j int) bool {
+		return doc.Streams[i].Name < doc.Streams[j].Name
+	})
+
+	return doc, nil
+}
+
+// readCursors reads the committed messages of the given cursors partition and
+// adds the latest value of each cursor to the map.
+func (s *Server) readCursors(ctx context.Context, partition *partition,
+	latest map[string]*proto.Cursor) error {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	sub, err := s.api.SubscribeInternal(ctx, &client.SubscribeRequest{
+		Stream:         cursorsStream,
+		Partition:      partition.Id,
+		StartPosition:  client.StartPosition_EARLIEST,
+		StopPosition:   client.StopPosition_STOP_LATEST,
+		ReadISRReplica: true,
+	})
+	if err != nil {
+		// Empty partitions can't be subscribed to until a stop offset.
+		if status.Code(err) == codes.ResourceExhausted {
+			return nil
+		}
+		return err
+	}
+	defer sub.Close()
+	var (
+		msgC = sub.Messages()
+		errC = sub.Errors()
+	)
+	for {
+		select {
+		case msg := <-msgC:
+			cursor := new(proto.Cursor)
+			if err := cursor.Unmarshal(msg.Value); err != nil {
+				s.logger.Errorf("Invalid cursor message in cursors stream: %v", err)
+				continue
+			}
+			latest[string(msg.Key)] = cursor
+		case err := <-errC:
+			// ResourceExhausted means the stop offset was reached.
+			if err.Code() == codes.ResourceExhausted {
+				return nil
+			}
+			return err.Err()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// importCursors sets the cursors of the given document. Every cursor is
+// resolved to its target partition and its offset translated before any is
+// set, so the import fails without changes if a target partition doesn't
+// exist or, unless clamped, an offset is out of its range. Cursors are never
+// moved backwards unless forced, so importing the same document again leaves
+// them unchanged.
+func (s *Server) importCursors(ctx context.Context, req *proto.ImportCursorsRequest) (
+	[]*proto.ImportedCursor, *status.Status) {
+
+	if req.Document == nil {
+		return nil, status.New(codes.InvalidArgument, "No document provided")
+	}
+	mapper, ok := cursorOffsetMappers[req.OffsetMapping]
+	if !ok {
+		return nil, status.Newf(codes.InvalidArgument, "Unknown offset mapping %s", req.OffsetMapping)
+	}
+	targets := make(map[string]string, len(req.StreamMappings))
+	for _, mapping := range req.StreamMappings {
+		if mapping.Source == "" || mapping.Target == "" {
+			return nil, status.New(codes.InvalidArgument, "Stream mappings need a source and a target")
+		}
+		if _, ok := targets[mapping.Source]; ok {
+			return nil, status.Newf(codes.InvalidArgument, "Stream %s mapped more than once", mapping.Source)
+		}
+		targets[mapping.Source] = mapping.Target
+	}
+	sources := make(map[string]map[int32]*proto.ExportedPartition, len(req.Document.Streams))
+	for _, stream := range req.Document.Streams {
+		partitions := make(map[int32]*proto.ExportedPartition, len(stream.Partitions))
+		for _, partition := range stream.Partitions {
+			partitions[partition.Id] = partition
+		}
+		sources[stream.Name] = partitions
+	}
+
+	imports := make([]*proto.ImportedCursor, 0, len(req.Document.Cursors))
+	for _, cursor := range req.Document.Cursors {
+		if cursor.CursorId == "" {
+			return nil, status.New(codes.InvalidArgument, "Cursor without ID in document")
+		}
+		target, ok := targets[cursor.Stream]
+		if !ok {
+			target = cursor.Stream
+		}
+		partition := s.metadata.GetPartition(target, cursor.Partition)
+		if partition == nil {
+			return nil, status.Newf(codes.InvalidArgument,
+				"Partition %d of stream %s does not exist", cursor.Partition, target)
+		}
+		if !partition.IsReplica(s.config.Clustering.ServerID) {
+			return nil, status.Newf(codes.FailedPrecondition,
+				"Server not replica for partition %d of stream %s", cursor.Partition, target)
+		}
+
+		// Cursors hold the offset of the last message processed, so -1 just
+		// before the oldest offset means nothing was processed.
+		var (
+			newest = partition.log.HighWatermark()
+			oldest = partition.log.OldestOffset() - 1
+		)
+		if newest < oldest {
+			newest = oldest
+		}
+		offset, err := mapper(cursor.Offset, sources[cursor.Stream][cursor.Partition], oldest+1, newest)
+		if err != nil {
+			return nil, status.Newf(codes.InvalidArgument, "Failed to map offset of cursor %s "+
+				"[stream=%s, partition=%d]: %v", cursor.CursorId, cursor.Stream, cursor.Partition, err)
+		}
+		imported := &proto.ImportedCursor{
+			CursorId:     cursor.CursorId,
+			Stream:       target,
+			Partition:    cursor.Partition,
+			SourceOffset: cursor.Offset,
+			Offset:       offset,
+		}
+		if offset < oldest || offset > newest {
+			if !req.Clamp {
+				return nil, status.Newf(codes.InvalidArgument, "Offset %d of cursor %s is outside of "+
+					"partition %d of stream %s [%d, %d]", offset, cursor.CursorId, cursor.Partition,
+					target, oldest, newest)
+			}
+			if offset < oldest {
+				imported.Offset = oldest
+			} else {
+				imported.Offset = newest
+			}
+			imported.Clamped = true
+		}
+		imports = append(imports, imported)
+	}
+
+	for _, imported := range imports {
+		previous, st := s.cursors.GetCursor(ctx, imported.Stream, imported.CursorId, imported.Partition)
+		if st != nil {
+			return nil, st
+		}
+		imported.PreviousOffset = previous
+		switch {
+		case previous == imported.Offset:
+			imported.Result = proto.CursorImportResult_CURSOR_UNCHANGED
+			continue
+		case previous > imported.Offset && !req.Force:
+			imported.Result = proto.CursorImportResult_CURSOR_AHEAD
+			continue
+		}
+		st = s.cursors.SetCursor(ctx, imported.Stream, imported.CursorId, imported.Partition, imported.Offset)
+		if st != nil {
+			return nil, st
+		}
+		imported.Result = proto.CursorImportResult_CURSOR_SET
+	}
+
+	return imports, nil
+}
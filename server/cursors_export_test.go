@@ -0,0 +1,221 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure the offset mappers translate offsets relative to the source and
+// target partitions.
+func TestCursorOffsetMappers(t *testing.T) {
+	source := &proto.ExportedPartition{OldestOffset: 10, NewestOffset: 19}
+
+	identity := cursorOffsetMappers[proto.CursorOffsetMapping_CURSOR_OFFSET_IDENTITY]
+	offset, err := identity(15, nil, 0, 4)
+	require.NoError(t, err)
+	require.Equal(t, int64(15), offset)
+
+	oldest := cursorOffsetMappers[proto.CursorOffsetMapping_CURSOR_OFFSET_ALIGN_OLDEST]
+	offset, err = oldest(15, source, 0, 4)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), offset)
+	_, err = oldest(15, nil, 0, 4)
+	require.Equal(t, errSourcePartitionUnknown, err)
+
+	newest := cursorOffsetMappers[proto.CursorOffsetMapping_CURSOR_OFFSET_ALIGN_NEWEST]
+	offset, err = newest(17, source, 0, 4)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), offset)
+	_, err = newest(17, nil, 0, 4)
+	require.Equal(t, errSourcePartitionUnknown, err)
+}
+
+// Ensure cursors exported from one cluster can be imported into another with
+// a copy of the stream and consumers resume where they left off.
+func TestExportImportCursors(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use a central NATS server shared by both clusters.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	// Configure the source cluster.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.EmbeddedNATS = false
+	s1Config.CursorsStream.Partitions = 2
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	// Configure the target cluster.
+	s2Config := getTestConfig("b", true, 5051)
+	s2Config.EmbeddedNATS = false
+	s2Config.Clustering.Namespace = "target"
+	s2Config.CursorsStream.Partitions = 1
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+	getMetadataLeader(t, 10*time.Second, s2)
+
+	source, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer source.Close()
+
+	target, err := lift.Connect([]string{"localhost:5051"})
+	require.NoError(t, err)
+	defer target.Close()
+
+	ctx := context.Background()
+	require.NoError(t, source.CreateStream(ctx, "foo", "foo"))
+	require.NoError(t, source.CreateStream(ctx, "bar", "bar"))
+	for i := 0; i < 10; i++ {
+		_, err := source.Publish(ctx, "foo", []byte(fmt.Sprintf("msg-%d", i)), lift.AckPolicyAll())
+		require.NoError(t, err)
+	}
+	require.NoError(t, source.SetCursor(ctx, "abc", "foo", 0, 2))
+	require.NoError(t, source.SetCursor(ctx, "abc", "foo", 0, 4))
+	require.NoError(t, source.SetCursor(ctx, "__group:orders", "foo", 0, 7))
+	require.NoError(t, source.SetCursor(ctx, "xyz", "foo", 0, 1))
+	require.NoError(t, source.SetCursor(ctx, "abc", "bar", 0, 0))
+
+	// Export the cursors of foo matching the pattern.
+	_, err = s1.api.ExportCursors(ctx, &proto.ExportCursorsRequest{CursorIdPattern: "["})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	resp, err := s1.api.ExportCursors(ctx, &proto.ExportCursorsRequest{
+		CursorIdPattern: "[_a]*",
+		Stream:          "foo",
+	})
+	require.NoError(t, err)
+	doc := resp.Document
+	require.Equal(t, "liftbridge-default", doc.Namespace)
+	require.Equal(t, []*proto.ExportedCursor{
+		{CursorId: "__group:orders", Stream: "foo", Partition: 0, Offset: 7},
+		{CursorId: "abc", Stream: "foo", Partition: 0, Offset: 4},
+	}, doc.Cursors)
+	require.Len(t, doc.Streams, 1)
+	require.Equal(t, "foo", doc.Streams[0].Name)
+	require.Equal(t, s1.metadata.GetStream("foo").GetCreationTime().UnixNano(), doc.Streams[0].CreationTimestamp)
+	require.Len(t, doc.Streams[0].Partitions, 1)
+	require.Equal(t, int64(0), doc.Streams[0].Partitions[0].OldestOffset)
+	require.Equal(t, int64(9), doc.Streams[0].Partitions[0].NewestOffset)
+	require.NotZero(t, doc.Streams[0].Partitions[0].Epoch)
+
+	// Importing fails without changes if a target partition doesn't exist.
+	_, err = s2.api.ImportCursors(ctx, &proto.ImportCursorsRequest{Document: doc})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Clone foo in the target cluster with a mirror, which keeps the offsets.
+	mirrorCtx := metadata.AppendToOutgoingContext(ctx, mirrorSourceAddressesMetadataKey, "localhost:5050")
+	require.NoError(t, target.CreateStream(mirrorCtx, "foo", "foo"))
+	require.Eventually(t, func() bool {
+		partition := s2.metadata.GetPartition("foo", 0)
+		return partition != nil && partition.log.HighWatermark() == 9
+	}, 10*time.Second, 50*time.Millisecond)
+
+	imported, err := s2.api.ImportCursors(ctx, &proto.ImportCursorsRequest{Document: doc})
+	require.NoError(t, err)
+	require.Len(t, imported.Cursors, 2)
+	for _, cursor := range imported.Cursors {
+		require.Equal(t, proto.CursorImportResult_CURSOR_SET, cursor.Result)
+		require.Equal(t, int64(-1), cursor.PreviousOffset)
+		require.Equal(t, cursor.SourceOffset, cursor.Offset)
+		require.False(t, cursor.Clamped)
+	}
+
+	// Consumers resume after their cursor.
+	resume := func(cursorID, stream string, expected string) {
+		offset, err := target.FetchCursor(ctx, cursorID, stream, 0)
+		require.NoError(t, err)
+		msgs := make(chan *lift.Message, 1)
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		err = target.Subscribe(subCtx, stream, func(msg *lift.Message, err error) {
+			require.NoError(t, err)
+			select {
+			case msgs <- msg:
+			default:
+			}
+		}, lift.StartAtOffset(offset+1))
+		require.NoError(t, err)
+		select {
+		case msg := <-msgs:
+			require.Equal(t, expected, string(msg.Value()))
+		case <-time.After(5 * time.Second):
+			t.Fatal("Did not receive expected message")
+		}
+	}
+	resume("abc", "foo", "msg-5")
+	resume("__group:orders", "foo", "msg-8")
+
+	// Importing again leaves the cursors unchanged.
+	imported, err = s2.api.ImportCursors(ctx, &proto.ImportCursorsRequest{Document: doc})
+	require.NoError(t, err)
+	for _, cursor := range imported.Cursors {
+		require.Equal(t, proto.CursorImportResult_CURSOR_UNCHANGED, cursor.Result)
+	}
+
+	// Cursors which moved on are only moved backwards when forced.
+	require.NoError(t, target.SetCursor(ctx, "abc", "foo", 0, 6))
+	imported, err = s2.api.ImportCursors(ctx, &proto.ImportCursorsRequest{Document: doc})
+	require.NoError(t, err)
+	require.Equal(t, proto.CursorImportResult_CURSOR_AHEAD, imported.Cursors[1].Result)
+	require.Equal(t, int64(6), imported.Cursors[1].PreviousOffset)
+	resume("abc", "foo", "msg-7")
+	imported, err = s2.api.ImportCursors(ctx, &proto.ImportCursorsRequest{Document: doc, Force: true})
+	require.NoError(t, err)
+	require.Equal(t, proto.CursorImportResult_CURSOR_SET, imported.Cursors[1].Result)
+	resume("abc", "foo", "msg-5")
+
+	// Copy the last five messages of foo to another stream, renumbering them.
+	require.NoError(t, target.CreateStream(ctx, "baz", "baz"))
+	for i := 5; i < 10; i++ {
+		_, err := target.Publish(ctx, "baz", []byte(fmt.Sprintf("msg-%d", i)), lift.AckPolicyAll())
+		require.NoError(t, err)
+	}
+	mappings := []*proto.StreamMapping{{Source: "foo", Target: "baz"}}
+
+	// Without translation, offsets past the end of the copy are rejected or
+	// clamped.
+	_, err = s2.api.ImportCursors(ctx, &proto.ImportCursorsRequest{
+		Document:       doc,
+		StreamMappings: mappings,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	offset, err := target.FetchCursor(ctx, "__group:orders", "baz", 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), offset)
+	imported, err = s2.api.ImportCursors(ctx, &proto.ImportCursorsRequest{
+		Document:       doc,
+		StreamMappings: mappings,
+		Clamp:          true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "baz", imported.Cursors[0].Stream)
+	require.True(t, imported.Cursors[0].Clamped)
+	require.Equal(t, int64(7), imported.Cursors[0].SourceOffset)
+	require.Equal(t, int64(4), imported.Cursors[0].Offset)
+	require.False(t, imported.Cursors[1].Clamped)
+
+	// Aligning the newest offsets resumes consumers at the same messages.
+	imported, err = s2.api.ImportCursors(ctx, &proto.ImportCursorsRequest{
+		Document:       doc,
+		StreamMappings: mappings,
+		OffsetMapping:  proto.CursorOffsetMapping_CURSOR_OFFSET_ALIGN_NEWEST,
+		Force:          true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), imported.Cursors[0].Offset)
+	require.Equal(t, int64(-1), imported.Cursors[1].Offset)
+	resume("__group:orders", "baz", "msg-8")
+	resume("abc", "baz", "msg-5")
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// deadLetterQueueMetadataKey is the gRPC metadata key used to set the
+	// dead letter queue of a stream when creating it since
+	// CreateStreamRequest has no field for it.
+	deadLetterQueueMetadataKey = "liftbridge-dead-letter-queue"
+
+	// These headers are added to messages moved to a dead letter queue to
+	// record where they came from and why. Timestamps are in nanoseconds
+	// since the epoch.
+	deadLetterStreamHeader    = "liftbridge-dlq-stream"
+	deadLetterPartitionHeader = "liftbridge-dlq-partition"
+	deadLetterOffsetHeader    = "liftbridge-dlq-offset"
+	deadLetterTimestampHeader = "liftbridge-dlq-timestamp"
+	deadLetterReasonHeader    = "liftbridge-dlq-reason"
+
+	defaultNackReadTimeout    = 5 * time.Second
+	defaultNackPublishTimeout = 5 * time.Second
+)
+
+// deadLetterQueueFromContext returns the dead letter queue set in the incoming
+// gRPC metadata when creating the given stream, if any.
+func deadLetterQueueFromContext(ctx context.Context, stream string) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	values := md.Get(deadLetterQueueMetadataKey)
+	switch {
+	case len(values) == 0:
+		return "", nil
+	case len(values) > 1:
+		return "", fmt.Errorf("only one %s can be set", deadLetterQueueMetadataKey)
+	case values[0] == "":
+		return "", fmt.Errorf("%s cannot be empty", deadLetterQueueMetadataKey)
+	case values[0] == stream:
+		return "", fmt.Errorf("stream cannot be its own dead letter queue")
+	}
+	return values[0], nil
+}
+
+// deadLetterHeaders returns the headers to publish a message moved to a dead
+// letter queue with. These are the message's original headers, less those the
+// server sets when publishing, along with the message's origin and the reason
+// it was nacked.
+func deadLetterHeaders(msg *client.Message, reason string) map[string][]byte {
+	headers := make(map[string][]byte, len(msg.Headers)+5)
+	for name, value := range msg.Headers {
+		switch name {
+		case ingestIDHeader, ingestSourceHeader, producerIDHeader, producerSequenceHeader:
+			// These are specific to the original publish. In particular, the
+			// producer sequence would be out of order in the dead letter
+			// queue.
+			continue
+		}
+		headers[name] = value
+	}
+	headers[deadLetterStreamHeader] = []byte(msg.Stream)
+	headers[deadLetterPartitionHeader] = []byte(strconv.FormatInt(int64(msg.Partition), 10))
+	headers[deadLetterOffsetHeader] = []byte(strconv.FormatInt(msg.Offset, 10))
+	headers[deadLetterTimestampHeader] = []byte(strconv.FormatInt(msg.Timestamp, 10))
+	headers[deadLetterReasonHeader] = []byte(reason)
+	return headers
+}
+
+// readMessage reads the committed message at the given offset of a partition
+// led by this server.
+func (a *apiServer) readMessage(ctx context.Context, p *partition, offset int64) (*client.Message, *status.Status) {
+	if !p.IsLeader() {
+		return nil, status.New(codes.FailedPrecondition, "Server not partition leader")
+	}
+	if offset < p.log.OldestOffset() || offset > p.log.HighWatermark() {
+		return nil, status.Newf(codes.OutOfRange, "No committed message at offset %d", offset)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultNackReadTimeout)
+	defer cancel()
+	sub, err := a.SubscribeInternal(ctx, &client.SubscribeRequest{
+		Stream:        p.Stream,
+		Partition:     p.Id,
+		StartPosition: client.StartPosition_OFFSET,
+		StartOffset:   offset,
+	})
+	if err != nil {
+		return nil, status.Convert(err)
+	}
+	defer sub.Close()
+
+	select {
+	case msg := <-sub.Messages():
+		// The message may have been removed by compaction, in which case the
+		// subscription starts at the next one.
+		if msg.Offset != offset {
+			return nil, status.Newf(codes.NotFound, "Message at offset %d no longer exists", offset)
+		}
+		return msg, nil
+	case st := <-sub.Errors():
+		return nil, st
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err())
+	}
+}
+
+// moveToDeadLetterQueue publishes the message at the given offset of a
+// partition to its stream's dead letter queue and returns the offset it was
+// written at in the dead letter queue. The message keeps its key, value, and
+// headers, and headers are added recording where it came from and why it was
+// moved.
+func (a *apiServer) moveToDeadLetterQueue(ctx context.Context, req *proto.NackMessageRequest) (
+	int64, *status.Status) {
+
+	stream := a.metadata.GetStream(req.Stream)
+	if stream == nil {
+		return 0, status.Newf(codes.NotFound, "No such stream: %s", req.Stream)
+	}
+	partition := stream.GetPartition(req.Partition)
+	if partition == nil {
+		return 0, status.Newf(codes.NotFound, "No such partition: %d", req.Partition)
+	}
+	dlq := stream.GetConfig().GetDeadLetterQueue()
+	if dlq == "" {
+		return 0, status.Newf(codes.FailedPrecondition, "Stream %s has no dead letter queue", req.Stream)
+	}
+	if a.metadata.GetStream(dlq) == nil {
+		return 0, status.Newf(codes.NotFound, "Dead letter queue %s does not exist", dlq)
+	}
+
+	msg, st := a.readMessage(ctx, partition, req.Offset)
+	if st != nil {
+		return 0, st
+	}
+
+	// Publish only waits for the ack if the context has a deadline.
+	ctx, cancel := ensureTimeout(ctx, defaultNackPublishTimeout)
+	defer cancel()
+
+	resp, err := a.Publish(ctx, &client.PublishRequest{
+		Stream:    dlq,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   deadLetterHeaders(msg, req.Reason),
+		AckPolicy: client.AckPolicy_ALL,
+	})
+	if err != nil {
+		return 0, status.Convert(err)
+	}
+	if resp.Ack == nil {
+		return 0, status.New(codes.Internal, "No ack received from dead letter queue")
+	}
+	return resp.Ack.Offset, nil
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// publishDirectMetadataKey is the gRPC metadata key used to choose if a
+	// publish is appended directly to the partition rather than published on
+	// its NATS subject since PublishRequest has no field for it.
+	publishDirectMetadataKey = "liftbridge-publish-direct"
+
+	// publishAsyncErrorNotLeader is the PublishAsync error code of direct
+	// publishes sent to a server which doesn't lead the partition. Like the
+	// exclusive producer code, it's past the codes the enum defines.
+	publishAsyncErrorNotLeader = client.PublishAsyncError_Code(102)
+)
+
+// ErrNotPartitionLeader is returned when publishing directly to or watching a
+// partition this server doesn't lead.
+var ErrNotPartitionLeader = errors.New("server not partition leader")
+
+// publishDirectFromContext indicates if the incoming gRPC metadata of the
+// given context requests a direct publish, defaulting to the given value if
+// it's not set.
+func publishDirectFromContext(ctx context.Context, defaultDirect bool) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return defaultDirect, nil
+	}
+	values := md.Get(publishDirectMetadataKey)
+	switch len(values) {
+	case 0:
+		return defaultDirect, nil
+	case 1:
+		direct, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return false, fmt.Errorf("invalid %s: %v", publishDirectMetadataKey, err)
+		}
+		return direct, nil
+	default:
+		return false, fmt.Errorf("only one %s can be set", publishDirectMetadataKey)
+	}
+}
+
+// getDirectPublishPartition returns the partition to append a direct publish
+// to, which this server must lead.
+func (a *apiServer) getDirectPublishPartition(req *client.PublishRequest) (*partition, *client.PublishAsyncError) {
+	partition := a.metadata.GetPartition(req.Stream, req.Partition)
+	if partition == nil {
+		return nil, &client.PublishAsyncError{
+			Code:    client.PublishAsyncError_NOT_FOUND,
+			Message: fmt.Sprintf("no such partition: %d", req.Partition),
+		}
+	}
+	if !partition.IsLeader() {
+		return nil, &client.PublishAsyncError{
+			Code:    publishAsyncErrorNotLeader,
+			Message: ErrNotPartitionLeader.Error(),
+		}
+	}
+	return partition, nil
+}
+
+// appendDirect hands a message published in direct mode to the leader's
+// message processing loop, as if it had been received on the given NATS
+// subject, so it goes through the same checks, batching, and acks. Other
+// streams whose subjects match don't receive it.
+func (p *partition) appendDirect(subject string, data []byte) error {
+	p.mu.RLock()
+	var (
+		recvChan = p.recvChan
+		stop     = p.stopLeader
+	)
+	p.mu.RUnlock()
+	if recvChan == nil {
+		return ErrNotPartitionLeader
+	}
+	select {
+	case recvChan <- &nats.Msg{Subject: subject, Data: data}:
+		return nil
+	case <-stop:
+		return ErrNotPartitionLeader
+	}
+}
@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure publishDirectFromContext parses the direct publish flag from the
+// request metadata and falls back to the default.
+func TestPublishDirectFromContext(t *testing.T) {
+	direct, err := publishDirectFromContext(context.Background(), false)
+	require.NoError(t, err)
+	require.False(t, direct)
+	direct, err = publishDirectFromContext(context.Background(), true)
+	require.NoError(t, err)
+	require.True(t, direct)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		publishDirectMetadataKey, "true"))
+	direct, err = publishDirectFromContext(ctx, false)
+	require.NoError(t, err)
+	require.True(t, direct)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		publishDirectMetadataKey, "false"))
+	direct, err = publishDirectFromContext(ctx, true)
+	require.NoError(t, err)
+	require.False(t, direct)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		publishDirectMetadataKey, "foo"))
+	_, err = publishDirectFromContext(ctx, false)
+	require.Error(t, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		publishDirectMetadataKey, "true", publishDirectMetadataKey, "false"))
+	_, err = publishDirectFromContext(ctx, false)
+	require.Error(t, err)
+}
+
+// Ensure messages published directly are only appended to the partition they
+// are published to, not to streams on overlapping subjects, and are acked
+// like messages published through NATS.
+func TestPublishDirect(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	lc, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	// Both streams receive the messages published on the foo subject.
+	require.NoError(t, lc.CreateStream(context.Background(), "foo", "foo"))
+	require.NoError(t, lc.CreateStream(context.Background(), "foo", "foo-audit"))
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	api := client.NewAPIClient(conn)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), publishDirectMetadataKey, "true")
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	pubResp, err := api.Publish(ctx, &client.PublishRequest{
+		Stream:    "foo",
+		Value:     []byte("direct-0"),
+		AckPolicy: client.AckPolicy_ALL,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "foo", pubResp.Ack.Stream)
+	require.Equal(t, int64(0), pubResp.Ack.Offset)
+
+	// PublishAsync can publish directly too.
+	async, err := api.PublishAsync(ctx)
+	require.NoError(t, err)
+	require.NoError(t, async.Send(&client.PublishRequest{
+		Stream:        "foo",
+		Value:         []byte("direct-1"),
+		AckPolicy:     client.AckPolicy_ALL,
+		CorrelationId: "1",
+	}))
+	resp, err := async.Recv()
+	require.NoError(t, err)
+	require.Nil(t, resp.AsyncError)
+	require.Equal(t, "1", resp.CorrelationId)
+	require.Equal(t, "foo", resp.Ack.Stream)
+	require.Equal(t, int64(1), resp.Ack.Offset)
+	require.NoError(t, async.CloseSend())
+
+	// Messages published through NATS still reach both streams.
+	natsCtx, natsCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer natsCancel()
+	_, err = lc.Publish(natsCtx, "foo", []byte("nats-2"), lift.AckPolicyAll())
+	require.NoError(t, err)
+
+	foo := s1.metadata.GetPartition("foo", 0)
+	audit := s1.metadata.GetPartition("foo-audit", 0)
+	require.Eventually(t, func() bool {
+		return audit.log.HighWatermark() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, int64(2), foo.log.HighWatermark())
+
+	values := func(stream string, count int) []string {
+		msgs := make(chan *lift.Message, count)
+		subCtx, subCancel := context.WithCancel(context.Background())
+		defer subCancel()
+		err := lc.Subscribe(subCtx, stream, func(msg *lift.Message, err error) {
+			require.NoError(t, err)
+			msgs <- msg
+		}, lift.StartAtEarliestReceived())
+		require.NoError(t, err)
+		var values []string
+		for i := 0; i < count; i++ {
+			select {
+			case msg := <-msgs:
+				require.Equal(t, "foo", msg.Subject())
+				values = append(values, string(msg.Value()))
+			case <-time.After(5 * time.Second):
+				t.Fatal("Did not receive expected message")
+			}
+		}
+		return values
+	}
+	require.Equal(t, []string{"direct-0", "direct-1", "nats-2"}, values("foo", 3))
+	require.Equal(t, []string{"nats-2"}, values("foo-audit", 1))
+}
+
+// Ensure direct publishes honor optimistic concurrency control like publishes
+// through NATS and can be enabled for all publishes.
+func TestPublishDirectConcurrencyControl(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Streams.PublishDirect = true
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	lc, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	require.NoError(t, lc.CreateStream(context.Background(), "foo", "foo",
+		lift.OptimisticConcurrencyControl(true)))
+	require.NoError(t, lc.CreateStream(context.Background(), "foo", "foo-audit"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ack, err := lc.Publish(ctx, "foo", []byte("hello"), lift.AckPolicyLeader(), lift.ExpectedOffset(0))
+	require.NoError(t, err)
+	require.Equal(t, int64(0), ack.Offset())
+
+	_, err = lc.Publish(ctx, "foo", []byte("hello"), lift.AckPolicyLeader(), lift.ExpectedOffset(0))
+	require.Error(t, err)
+	require.Equal(t, "incorrect expected offset", status.Convert(err).Message())
+
+	ack, err = lc.Publish(ctx, "foo", []byte("world"), lift.AckPolicyLeader(), lift.ExpectedOffset(1))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), ack.Offset())
+
+	// Nothing was published on NATS.
+	require.Equal(t, int64(-1), s1.metadata.GetPartition("foo-audit", 0).log.NewestOffset())
+}
+
+// Ensure direct publishes to a server which doesn't lead the partition are
+// rejected.
+func TestPublishDirectNotLeader(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure servers.
+	var servers []*Server
+	for i, id := range []string{"a", "b"} {
+		config := getTestConfig(id, i == 0, 5050+i)
+		s := runServerWithConfig(t, config)
+		defer s.Stop()
+		servers = append(servers, s)
+	}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	lc, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	require.NoError(t, lc.CreateStream(context.Background(), "foo", "foo",
+		lift.ReplicationFactor(2)))
+	leader := getPartitionLeader(t, 10*time.Second, "foo", 0, servers...)
+	follower := servers[0]
+	if follower == leader {
+		follower = servers[1]
+	}
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", follower.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx := metadata.AppendToOutgoingContext(context.Background(), publishDirectMetadataKey, "true")
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	_, err = client.NewAPIClient(conn).Publish(ctx, &client.PublishRequest{
+		Stream:    "foo",
+		Value:     []byte("hello"),
+		AckPolicy: client.AckPolicy_ALL,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Equal(t, ErrNotPartitionLeader.Error(), status.Convert(err).Message())
+}
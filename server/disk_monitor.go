@@ -0,0 +1,234 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// emergencyLargestStreams is the number of streams listed when emergency
+// retention can't bring disk usage below the threshold.
+const emergencyLargestStreams = 5
+
+// errDiskUsageUnsupported is returned by statfs on platforms where the disk
+// usage can't be determined.
+var errDiskUsageUnsupported = errors.New("disk usage not supported on this platform")
+
+// statfs returns the usage of the disk holding the given path. This variable
+// exists for mocking purposes.
+var statfs = diskUsageOf
+
+// diskUsage is the size and free space, in bytes, of a disk.
+type diskUsage struct {
+	Total int64
+	Free  int64 // Space available to unprivileged users
+}
+
+// usedFraction returns the fraction of the disk which isn't free.
+func (d diskUsage) usedFraction() float64 {
+	if d.Total <= 0 {
+		return 0
+	}
+	return float64(d.Total-d.Free) / float64(d.Total)
+}
+
+// reclaimedPartition is the space reclaimed from a partition by emergency
+// retention.
+type reclaimedPartition struct {
+	Stream    string
+	Partition int32
+	Estimated int64 // Bytes the retention rules were expected to reclaim
+	Reclaimed int64
+}
+
+// streamUsage is the disk space used by the partitions of a stream on this
+// server.
+type streamUsage struct {
+	Stream string
+	Bytes  int64
+}
+
+// emergencyRetentionReport describes the outcome of an emergency retention
+// run.
+type emergencyRetentionReport struct {
+	UsageBefore    float64
+	UsageAfter     float64
+	Partitions     []*reclaimedPartition // In the order they were cleaned
+	Reclaimed      int64
+	LargestStreams []*streamUsage // Set if usage is still above the threshold
+}
+
+// emergencyRetention rate-limits emergency retention runs.
+type emergencyRetention struct {
+	mu      sync.Mutex
+	lastRun time.Time
+}
+
+// tryStart indicates if an emergency retention run may start now, recording
+// it as the last run if so. Runs are at least minInterval apart.
+func (e *emergencyRetention) tryStart(now time.Time, minInterval time.Duration) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.lastRun.IsZero() && now.Sub(e.lastRun) < minInterval {
+		return false
+	}
+	e.lastRun = now
+	return true
+}
+
+// diskMonitorLoop periodically checks the usage of the disk holding the data
+// directory and applies emergency retention when it crosses the threshold.
+func (s *Server) diskMonitorLoop() {
+	ticker := time.NewTicker(s.config.Disk.EmergencyCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-ticker.C:
+		}
+		s.checkDiskUsage()
+	}
+}
+
+// checkDiskUsage applies retention immediately across the partitions on this
+// server if the usage of the disk holding the data directory is at or above
+// the emergency threshold, rather than waiting for their cleaner interval. It
+// returns nil if retention wasn't applied, either because usage is below the
+// threshold or because it was applied too recently.
+func (s *Server) checkDiskUsage() *emergencyRetentionReport {
+	threshold := s.config.Disk.EmergencyThreshold
+	usage, err := statfs(s.config.DataDir)
+	if err != nil {
+		s.logger.Debugf("Failed to check disk usage of %s: %v", s.config.DataDir, err)
+		return nil
+	}
+	if usage.usedFraction() < threshold {
+		return nil
+	}
+	if !s.emergencyRetention.tryStart(time.Now(), s.config.Disk.EmergencyMinInterval) {
+		return nil
+	}
+
+	s.logger.Warnf("Disk usage of %s is %s, at or above the emergency threshold of %s, applying retention",
+		s.config.DataDir, formatPercent(usage.usedFraction()), formatPercent(threshold))
+	report := s.applyEmergencyRetention(usage, threshold)
+	s.logger.Warnf("Emergency retention reclaimed %d bytes from %d partitions, disk usage of %s is now %s",
+		report.Reclaimed, len(report.Partitions), s.config.DataDir, formatPercent(report.UsageAfter))
+
+	if len(report.LargestStreams) > 0 {
+		streams := make([]string, len(report.LargestStreams))
+		for i, stream := range report.LargestStreams {
+			streams[i] = fmt.Sprintf("%s (%d bytes)", stream.Stream, stream.Bytes)
+		}
+		s.logger.Errorf("Disk usage of %s is still %s after emergency retention, largest streams: %s",
+			s.config.DataDir, formatPercent(report.UsageAfter), strings.Join(streams, ", "))
+	}
+	return report
+}
+
+// applyEmergencyRetention applies retention to the partitions on this server
+// in order of the bytes it's estimated to reclaim from them, largest first,
+// until disk usage drops below the threshold or the emergency budget is
+// spent. Partitions retention wouldn't reclaim anything from are skipped, and
+// compaction isn't run since rewriting segments takes more disk space and
+// I/O. If usage is still at or above the threshold, the report lists the
+// streams using the most space on this server.
+func (s *Server) applyEmergencyRetention(usage diskUsage, threshold float64) *emergencyRetentionReport {
+	report := &emergencyRetentionReport{
+		UsageBefore: usage.usedFraction(),
+		UsageAfter:  usage.usedFraction(),
+	}
+
+	type candidate struct {
+		*partition
+		estimated int64
+	}
+	var candidates []candidate
+	for _, partition := range s.localPartitions() {
+		if estimated := partition.log.ReclaimableBytes(); estimated > 0 {
+			candidates = append(candidates, candidate{partition, estimated})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].estimated != candidates[j].estimated {
+			return candidates[i].estimated > candidates[j].estimated
+		}
+		if candidates[i].Stream != candidates[j].Stream {
+			return candidates[i].Stream < candidates[j].Stream
+		}
+		return candidates[i].Id < candidates[j].Id
+	})
+
+	budget := s.config.Disk.EmergencyBudget
+	for _, c := range candidates {
+		if report.UsageAfter < threshold || (budget > 0 && report.Reclaimed >= budget) {
+			break
+		}
+		reclaimed, err := c.log.EnforceRetention()
+		if err != nil {
+			s.logger.Errorf("Failed to apply emergency retention to partition %s: %v", c.partition, err)
+		}
+		report.Partitions = append(report.Partitions, &reclaimedPartition{
+			Stream:    c.Stream,
+			Partition: c.Id,
+			Estimated: c.estimated,
+			Reclaimed: reclaimed,
+		})
+		report.Reclaimed += reclaimed
+		if usage, err := statfs(s.config.DataDir); err == nil {
+			report.UsageAfter = usage.usedFraction()
+		}
+	}
+
+	if report.UsageAfter >= threshold {
+		report.LargestStreams = s.largestStreams(emergencyLargestStreams)
+	}
+	return report
+}
+
+// localPartitions returns the partitions this server is a replica for which
+// aren't paused.
+func (s *Server) localPartitions() []*partition {
+	var partitions []*partition
+	for _, stream := range s.metadata.GetStreams() {
+		for _, partition := range stream.GetPartitions() {
+			if !partition.IsReplica(s.config.Clustering.ServerID) || partition.IsPaused() {
+				continue
+			}
+			partitions = append(partitions, partition)
+		}
+	}
+	return partitions
+}
+
+// largestStreams returns up to n of the streams using the most disk space on
+// this server, largest first.
+func (s *Server) largestStreams(n int) []*streamUsage {
+	sizes := make(map[string]int64)
+	for _, partition := range s.localPartitions() {
+		sizes[partition.Stream] += partition.log.Size()
+	}
+	streams := make([]*streamUsage, 0, len(sizes))
+	for stream, size := range sizes {
+		streams = append(streams, &streamUsage{Stream: stream, Bytes: size})
+	}
+	sort.Slice(streams, func(i, j int) bool {
+		if streams[i].Bytes != streams[j].Bytes {
+			return streams[i].Bytes > streams[j].Bytes
+		}
+		return streams[i].Stream < streams[j].Stream
+	})
+	if len(streams) > n {
+		streams = streams[:n]
+	}
+	return streams
+}
+
+// formatPercent formats the given fraction as a percentage.
+func formatPercent(fraction float64) string {
+	return fmt.Sprintf("%.1f%%", fraction*100)
+}
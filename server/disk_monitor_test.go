@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// Ensure emergency retentions are rate-limited.
+func TestEmergencyRetentionTryStart(t *testing.T) {
+	var e emergencyRetention
+	now := time.Now()
+	require.True(t, e.tryStart(now, time.Minute))
+	require.False(t, e.tryStart(now.Add(30*time.Second), time.Minute))
+	require.True(t, e.tryStart(now.Add(time.Minute), time.Minute))
+	require.True(t, e.tryStart(now.Add(time.Minute), 0))
+}
+
+// Ensure emergency retention cleans the partitions with the most reclaimable
+// bytes first until disk usage drops below the threshold and reports the
+// largest streams if retention can't free enough space.
+func TestEmergencyRetention(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server. Disk usage is checked manually.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Disk.EmergencyThreshold = 0.5
+	s1Config.Disk.EmergencyCheckInterval = time.Hour
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	// Each message gets its own segment, so retaining five messages leaves
+	// the rest of the segments reclaimable.
+	value := make([]byte, 100)
+	for stream, count := range map[string]int{"large": 30, "small": 10, "kept": 40} {
+		opts := []lift.StreamOption{lift.SegmentMaxBytes(1)}
+		if stream != "kept" {
+			opts = append(opts, lift.RetentionMaxMessages(5))
+		}
+		require.NoError(t, client.CreateStream(context.Background(), stream, stream, opts...))
+		for i := 0; i < count; i++ {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			_, err := client.Publish(ctx, stream, value, lift.AckPolicyAll())
+			cancel()
+			require.NoError(t, err)
+		}
+	}
+	large := s1.metadata.GetPartition("large", 0)
+	small := s1.metadata.GetPartition("small", 0)
+	kept := s1.metadata.GetPartition("kept", 0)
+	largeSize, smallSize, keptSize := large.log.Size(), small.log.Size(), kept.log.Size()
+	require.Equal(t, largeSize*25/30, large.log.ReclaimableBytes())
+	require.Equal(t, smallSize*5/10, small.log.ReclaimableBytes())
+	require.Equal(t, int64(0), kept.log.ReclaimableBytes())
+
+	// Simulate a disk where the partitions and a fixed amount of other data
+	// are the only things using space.
+	var otherBytes int64
+	total := 2 * (2*largeSize + smallSize + keptSize)
+	statfsBefore := statfs
+	statfs = func(path string) (diskUsage, error) {
+		used := atomic.LoadInt64(&otherBytes) + large.log.Size() + small.log.Size() + kept.log.Size()
+		return diskUsage{Total: total, Free: total - used}, nil
+	}
+	defer func() {
+		statfs = statfsBefore
+	}()
+
+	// Nothing is cleaned below the threshold.
+	require.Nil(t, s1.checkDiskUsage())
+
+	// Cleaning the large stream is enough to get below the threshold, so the
+	// small one is left alone.
+	atomic.StoreInt64(&otherBytes, largeSize)
+	report := s1.checkDiskUsage()
+	require.NotNil(t, report)
+	require.True(t, report.UsageBefore >= 0.5)
+	require.True(t, report.UsageAfter < 0.5)
+	require.Len(t, report.Partitions, 1)
+	require.Equal(t, &reclaimedPartition{
+		Stream:    "large",
+		Partition: 0,
+		Estimated: largeSize * 25 / 30,
+		Reclaimed: largeSize * 25 / 30,
+	}, report.Partitions[0])
+	require.Equal(t, largeSize*25/30, report.Reclaimed)
+	require.Nil(t, report.LargestStreams)
+	require.Equal(t, largeSize*5/30, large.log.Size())
+	require.Equal(t, smallSize, small.log.Size())
+
+	// Emergency retention doesn't run again within the minimum interval.
+	atomic.StoreInt64(&otherBytes, total)
+	require.Nil(t, s1.checkDiskUsage())
+
+	// With the large stream already cleaned, the small one is next, and since
+	// retention can't free enough space, the largest streams are reported.
+	s1.config.Disk.EmergencyMinInterval = 0
+	report = s1.checkDiskUsage()
+	require.NotNil(t, report)
+	require.Len(t, report.Partitions, 1)
+	require.Equal(t, "small", report.Partitions[0].Stream)
+	require.Equal(t, smallSize*5/10, report.Reclaimed)
+	require.True(t, report.UsageAfter >= 0.5)
+	require.Len(t, report.LargestStreams, 3)
+	require.Equal(t, &streamUsage{Stream: "kept", Bytes: keptSize}, report.LargestStreams[0])
+	require.Equal(t, "large", report.LargestStreams[1].Stream)
+	require.Equal(t, "small", report.LargestStreams[2].Stream)
+
+	// Nothing is left to reclaim.
+	report = s1.checkDiskUsage()
+	require.NotNil(t, report)
+	require.Empty(t, report.Partitions)
+	require.Equal(t, int64(0), report.Reclaimed)
+}
+
+// Ensure emergency retention stops cleaning partitions once the budget is
+// spent.
+func TestEmergencyRetentionBudget(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server. Disk usage is checked manually.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Disk.EmergencyThreshold = 0.5
+	s1Config.Disk.EmergencyCheckInterval = time.Hour
+	s1Config.Disk.EmergencyBudget = 1
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	for i, stream := range []string{"foo", "bar", "baz"} {
+		require.NoError(t, client.CreateStream(context.Background(), stream, stream,
+			lift.SegmentMaxBytes(1), lift.RetentionMaxMessages(1)))
+		for j := 0; j <= i+1; j++ {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			_, err := client.Publish(ctx, stream, []byte(fmt.Sprintf("msg-%d", j)), lift.AckPolicyAll())
+			cancel()
+			require.NoError(t, err)
+		}
+	}
+
+	statfsBefore := statfs
+	statfs = func(path string) (diskUsage, error) {
+		return diskUsage{Total: 100, Free: 10}, nil
+	}
+	defer func() {
+		statfs = statfsBefore
+	}()
+
+	// The budget is spent by the first partition.
+	report := s1.checkDiskUsage()
+	require.NotNil(t, report)
+	require.Len(t, report.Partitions, 1)
+	require.Equal(t, "baz", report.Partitions[0].Stream)
+	require.Equal(t, 0.9, report.UsageAfter)
+	require.Len(t, report.LargestStreams, 3)
+	require.Equal(t, 1, s1.metadata.GetPartition("baz", 0).log.SegmentCount())
+	require.Equal(t, 3, s1.metadata.GetPartition("bar", 0).log.SegmentCount())
+}
@@ -0,0 +1,9 @@
+//go:build !linux && !darwin && !freebsd
+
+package server
+
+// diskUsageOf returns errDiskUsageUnsupported since the disk usage can't be
+// determined on this platform.
+func diskUsageOf(path string) (diskUsage, error) {
+	return diskUsage{}, errDiskUsageUnsupported
+}
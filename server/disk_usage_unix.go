@@ -0,0 +1,17 @@
+//go:build linux || darwin || freebsd
+
+package server
+
+import "syscall"
+
+// diskUsageOf returns the usage of the disk holding the given path.
+func diskUsageOf(path string) (diskUsage, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return diskUsage{}, err
+	}
+	return diskUsage{
+		Total: int64(st.Blocks) * int64(st.Bsize),
+		Free:  int64(st.Bavail) * int64(st.Bsize),
+	}, nil
+}
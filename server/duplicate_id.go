@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// duplicateServerIDTTL is how long a server ID stays flagged as used by
+	// more than one server after it was last seen answering from two
+	// addresses. Surveys stop waiting once the expected number of servers
+	// have answered, so one which only heard from one of them doesn't mean
+	// the misconfiguration was resolved.
+	duplicateServerIDTTL = time.Minute
+
+	// joinServerIDCheckTimeout is how long the metadata leader waits for
+	// servers to answer when checking if the ID of a server asking to join is
+	// already in use. It leaves the joining server time to get a response
+	// before its request times out.
+	joinServerIDCheckTimeout = defaultJoinRaftGroupTimeout / 2
+)
+
+// ErrDuplicateServerID is returned when a server asks to join the cluster
+// with an ID which is already used by another server.
+var ErrDuplicateServerID = errors.New("duplicate server ID")
+
+// duplicateServerIDs tracks server IDs which were found to be used by more
+// than one server.
+type duplicateServerIDs struct {
+	mu       sync.Mutex
+	lastSeen map[string]time.Time
+}
+
+func newDuplicateServerIDs() *duplicateServerIDs {
+	return &duplicateServerIDs{lastSeen: make(map[string]time.Time)}
+}
+
+// flag records that the given ID was found to be used by more than one server
+// and returns false if it was already flagged.
+func (d *duplicateServerIDs) flag(id string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	lastSeen, ok := d.lastSeen[id]
+	d.lastSeen[id] = time.Now()
+	return !ok || time.Since(lastSeen) > duplicateServerIDTTL
+}
+
+// isFlagged indicates if the given ID was found to be used by more than one
+// server within the last duplicateServerIDTTL.
+func (d *duplicateServerIDs) isFlagged(id string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	lastSeen, ok := d.lastSeen[id]
+	if ok && time.Since(lastSeen) > duplicateServerIDTTL {
+		delete(d.lastSeen, id)
+		return false
+	}
+	return ok
+}
+
+// filter returns the given IDs which aren't flagged.
+func (d *duplicateServerIDs) filter(ids []string) []string {
+	filtered := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !d.isFlagged(id) {
+			filtered = append(filtered, id)
+		}
+	}
+	return filtered
+}
+
+// serverAddresses collects the connection addresses servers answering a
+// server info survey reported for each server ID.
+type serverAddresses map[string]map[HostPort]struct{}
+
+// add records that a server with the given ID answered from the given
+// address.
+func (a serverAddresses) add(id string, address HostPort) {
+	addresses, ok := a[id]
+	if !ok {
+		addresses = make(map[HostPort]struct{}, 1)
+		a[id] = addresses
+	}
+	addresses[address] = struct{}{}
+}
+
+// detectDuplicateServerIDs flags the server IDs which more than one address
+// answered a server info survey for, including our own, and logs an error the
+// first time each is found. New partitions aren't placed on flagged IDs.
+func (s *Server) detectDuplicateServerIDs(addresses serverAddresses) {
+	addresses.add(s.config.Clustering.ServerID, s.getConnectionAddress())
+	for id, hosts := range addresses {
+		if len(hosts) < 2 || !s.duplicateIDs.flag(id) {
+			continue
+		}
+		list := make([]string, 0, len(hosts))
+		for host := range hosts {
+			list = append(list, formatHostPort(host))
+		}
+		sort.Strings(list)
+		s.logger.Errorf("Servers at %s are all using server ID %s. Each server "+
+			"must be started with a unique %s. New partitions will not be placed "+
+			"on %s until this is resolved.",
+			strings.Join(list, ", "), id, configClusteringServerID, id)
+	}
+}
+
+// checkJoinServerID returns ErrDuplicateServerID if another server is already
+// using the ID of the server asking to join. Only members of the metadata Raft
+// group are surveyed for this since other servers using the ID would have to
+// be joining too.
+func (s *Server) checkJoinServerID(node *raft.Raft, req *proto.RaftJoinRequest) error {
+	// Servers which predate duplicate detection don't send their address.
+	if req.Host == "" {
+		return nil
+	}
+	address := HostPort{Host: req.Host, Port: int(req.Port)}
+
+	if req.NodeID == s.config.Clustering.ServerID {
+		if ours := s.getConnectionAddress(); address != ours {
+			return errors.Wrapf(ErrDuplicateServerID, "server at %s already uses ID %s",
+				formatHostPort(ours), req.NodeID)
+		}
+		return nil
+	}
+
+	future := node.GetConfiguration()
+	if err := future.Error(); err != nil {
+		return err
+	}
+	var (
+		servers = future.Configuration().Servers
+		member  = false
+	)
+	for _, server := range servers {
+		if string(server.ID) == req.NodeID {
+			member = true
+			break
+		}
+	}
+	if !member {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), joinServerIDCheckTimeout)
+	defer cancel()
+	brokers, st := s.metadata.fetchBrokerInfo(ctx, len(servers)-1)
+	if st != nil {
+		return st.Err()
+	}
+	for _, broker := range brokers {
+		if broker.id == req.NodeID && broker.address != address {
+			return errors.Wrapf(ErrDuplicateServerID, "server at %s already uses ID %s",
+				formatHostPort(broker.address), req.NodeID)
+		}
+	}
+	return nil
+}
+
+// formatHostPort returns the given address in host:port form.
+func formatHostPort(address HostPort) string {
+	return net.JoinHostPort(address.Host, strconv.Itoa(address.Port))
+}
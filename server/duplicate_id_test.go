@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// Ensure a server started with the ID of a server already in the cluster is
+// rejected when it asks to join and the cluster remains healthy.
+func TestDuplicateServerIDJoinRejected(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1, s2)
+
+	// Configure third server with the second server's ID.
+	raftJoinAttempts = 1
+	defer func() {
+		raftJoinAttempts = defaultRaftJoinAttempts
+	}()
+	s3Config := getTestConfig("b", false, 5052)
+	s3Config.DataDir = filepath.Join(storagePath, "b-duplicate")
+	s3 := New(s3Config)
+	err := s3.Start()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), ErrDuplicateServerID.Error())
+
+	// The cluster is unaffected.
+	require.Equal(t, s1, getMetadataLeader(t, 10*time.Second, s1, s2))
+	future := s1.getRaft().GetConfiguration()
+	require.NoError(t, future.Error())
+	require.Len(t, future.Configuration().Servers, 2)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+	require.NoError(t, client.CreateStream(context.Background(), "foo", "foo",
+		lift.ReplicationFactor(2)))
+	waitForISR(t, 10*time.Second, "foo", 0, 2, s1, s2)
+}
+
+// Ensure server IDs answering a survey from more than one address, including
+// our own, are flagged and excluded from partition placement.
+func TestDetectDuplicateServerIDs(t *testing.T) {
+	s := New(getTestConfig("a", true, 5050))
+
+	addresses := make(serverAddresses)
+	addresses.add("a", HostPort{Host: "10.0.0.2", Port: 9292})
+	addresses.add("b", HostPort{Host: "10.0.0.3", Port: 9292})
+	addresses.add("b", HostPort{Host: "10.0.0.4", Port: 9292})
+	addresses.add("c", HostPort{Host: "10.0.0.5", Port: 9292})
+	s.detectDuplicateServerIDs(addresses)
+
+	require.True(t, s.duplicateIDs.isFlagged("a"))
+	require.True(t, s.duplicateIDs.isFlagged("b"))
+	require.False(t, s.duplicateIDs.isFlagged("c"))
+	require.Equal(t, []string{"c", "d"}, s.duplicateIDs.filter([]string{"a", "b", "c", "d"}))
+
+	// A survey only hearing from one of the servers doesn't clear the flag.
+	addresses = make(serverAddresses)
+	addresses.add("b", HostPort{Host: "10.0.0.3", Port: 9292})
+	s.detectDuplicateServerIDs(addresses)
+	require.True(t, s.duplicateIDs.isFlagged("b"))
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// exclusiveProducerHeader and producerEpochHeader are the message headers
+	// used by exclusive producers since PublishRequest has no fields for
+	// them. The epoch is the decimal fencing token returned by the
+	// RegisterProducer admin API for the producer name.
+	exclusiveProducerHeader = "liftbridge-exclusive-producer"
+	producerEpochHeader     = "liftbridge-producer-epoch"
+
+	// ackProducerFenced and publishAsyncErrorProducerFenced are the ack and
+	// PublishAsync error codes of messages rejected because their exclusive
+	// producer has been fenced. Neither enum defines one, so values well past
+	// the defined codes are used. Clients unaware of them see an unknown
+	// error with the ErrProducerFenced message.
+	ackProducerFenced               = client.Ack_Error(100)
+	publishAsyncErrorProducerFenced = client.PublishAsyncError_Code(100)
+)
+
+// getProducerEpoch returns the exclusive producer name and fencing token of
+// the given message headers. The bool returned indicates if the message was
+// published by an exclusive producer.
+func getProducerEpoch(headers map[string][]byte) (string, uint64, bool, error) {
+	producer, ok := headers[exclusiveProducerHeader]
+	if !ok {
+		return "", 0, false, nil
+	}
+	if len(producer) == 0 {
+		return "", 0, false, fmt.Errorf("%s header is empty", exclusiveProducerHeader)
+	}
+	epoch, err := strconv.ParseUint(string(headers[producerEpochHeader]), 10, 64)
+	if err != nil || epoch == 0 {
+		return "", 0, false, fmt.Errorf("invalid %s header %q", producerEpochHeader,
+			headers[producerEpochHeader])
+	}
+	return string(producer), epoch, true, nil
+}
+
+// isProducerFenced indicates if a message carrying the given fencing token
+// must be rejected given the exclusive producer's registration, which is nil
+// if it has never been registered, and the newest token the partition leader
+// accepted for it. Tokens newer than the registration are accepted since the
+// registration may not have been applied on this server yet. Expiration is
+// checked against this server's clock.
+func isProducerFenced(registration *proto.ExclusiveProducer, accepted, epoch uint64,
+	now time.Time) bool {
+
+	if epoch < accepted {
+		return true
+	}
+	if registration == nil || epoch > registration.Epoch {
+		return false
+	}
+	if epoch < registration.Epoch || registration.Released {
+		return true
+	}
+	return registration.ExpiresAt > 0 && now.UnixNano() >= registration.ExpiresAt
+}
+
+// dropFencedMessages removes the messages published by fenced exclusive
+// producers from the batch and nacks them. The given map tracks the newest
+// fencing token accepted from each exclusive producer by this leader so that
+// a registration fences the previous holder as soon as the new holder
+// publishes, even if this server has yet to apply the registration.
+func (p *partition) dropFencedMessages(accepted map[string]uint64,
+	batch []*commitlog.Message) []*commitlog.Message {
+
+	var (
+		filtered = batch[:0]
+		stream   *stream
+		now      = time.Now()
+	)
+	for _, msg := range batch {
+		producer, epoch, ok, err := getProducerEpoch(msg.Headers)
+		if err != nil {
+			p.srv.logger.Errorf("Rejecting message received on partition %s: %v", p, err)
+			p.sendProducerNack(msg, client.Ack_UNKNOWN)
+			continue
+		}
+		if !ok {
+			filtered = append(filtered, msg)
+			continue
+		}
+
+		if stream == nil {
+			stream = p.srv.metadata.GetStream(p.Stream)
+		}
+		var registration *proto.ExclusiveProducer
+		if stream != nil {
+			registration = stream.GetExclusiveProducer(producer)
+		}
+		if isProducerFenced(registration, accepted[producer], epoch, now) {
+			p.srv.logger.Warnf("Rejecting message received on partition %s from "+
+				"exclusive producer %q with fenced epoch %d", p, producer, epoch)
+			p.sendProducerNack(msg, ackProducerFenced)
+			continue
+		}
+		accepted[producer] = epoch
+		filtered = append(filtered, msg)
+	}
+	return filtered
+}
+
+// sendProducerNack publishes an ack containing the given error for a message
+// rejected before being written, e.g. because of its exclusive producer
+// headers, to the specified AckInbox. If no AckInbox is set, this does
+// nothing.
+func (p *partition) sendProducerNack(msg *commitlog.Message, ackError client.Ack_Error) {
+	if msg.AckInbox == "" {
+		return
+	}
+	p.sendAck(&client.Ack{
+		Stream:             p.Stream,
+		PartitionSubject:   p.Subject,
+		MsgSubject:         string(msg.Headers["subject"]),
+		AckInbox:           msg.AckInbox,
+		CorrelationId:      msg.CorrelationID,
+		AckPolicy:          msg.AckPolicy,
+		ReceptionTimestamp: msg.Timestamp,
+		AckError:           ackError,
+	})
+}
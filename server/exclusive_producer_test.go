@@ -0,0 +1,286 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure getProducerEpoch parses the exclusive producer headers.
+func TestGetProducerEpoch(t *testing.T) {
+	_, _, ok, err := getProducerEpoch(map[string][]byte{})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	producer, epoch, ok, err := getProducerEpoch(map[string][]byte{
+		exclusiveProducerHeader: []byte("writer"),
+		producerEpochHeader:     []byte("42"),
+	})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "writer", producer)
+	require.Equal(t, uint64(42), epoch)
+
+	for _, headers := range []map[string][]byte{
+		{exclusiveProducerHeader: []byte(""), producerEpochHeader: []byte("1")},
+		{exclusiveProducerHeader: []byte("writer")},
+		{exclusiveProducerHeader: []byte("writer"), producerEpochHeader: []byte("0")},
+		{exclusiveProducerHeader: []byte("writer"), producerEpochHeader: []byte("-1")},
+		{exclusiveProducerHeader: []byte("writer"), producerEpochHeader: []byte("foo")},
+	} {
+		_, _, _, err := getProducerEpoch(headers)
+		require.Error(t, err)
+	}
+}
+
+// Ensure isProducerFenced rejects tokens older than the registration or the
+// newest accepted token, and the token of a released or expired registration.
+func TestIsProducerFenced(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name         string
+		registration *proto.ExclusiveProducer
+		accepted     uint64
+		epoch        uint64
+		fenced       bool
+	}{
+		{"unregistered", nil, 0, 5, false},
+		{"older than accepted", nil, 6, 5, true},
+		{"current", &proto.ExclusiveProducer{Epoch: 5}, 5, 5, false},
+		{"older than registration", &proto.ExclusiveProducer{Epoch: 6}, 0, 5, true},
+		{"newer than registration", &proto.ExclusiveProducer{Epoch: 5}, 5, 6, false},
+		{"released", &proto.ExclusiveProducer{Epoch: 5, Released: true}, 0, 5, true},
+		{"newer than released", &proto.ExclusiveProducer{Epoch: 5, Released: true}, 0, 6, false},
+		{"not expired", &proto.ExclusiveProducer{Epoch: 5, ExpiresAt: now.Add(time.Second).UnixNano()}, 0, 5, false},
+		{"expired", &proto.ExclusiveProducer{Epoch: 5, ExpiresAt: now.UnixNano()}, 0, 5, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.fenced,
+				isProducerFenced(test.registration, test.accepted, test.epoch, now))
+		})
+	}
+}
+
+// Ensure registering an exclusive producer again fences the previous holder
+// on every partition, that the registration survives a metadata leader
+// failover, and that it can be released explicitly or expire.
+func TestExclusiveProducerFencing(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server so the servers can be stopped.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.EmbeddedNATS = false
+	s1Config.Clustering.ReplicaMaxLeaderTimeout = time.Second
+	s1Config.Clustering.ReplicaMaxIdleWait = 500 * time.Millisecond
+	s1Config.Clustering.ReplicaFetchTimeout = 500 * time.Millisecond
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2Config.Clustering.ReplicaMaxLeaderTimeout = time.Second
+	s2Config.Clustering.ReplicaMaxIdleWait = 500 * time.Millisecond
+	s2Config.Clustering.ReplicaFetchTimeout = 500 * time.Millisecond
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	// Configure third server.
+	s3Config := getTestConfig("c", false, 5052)
+	s3Config.Clustering.ReplicaMaxLeaderTimeout = time.Second
+	s3Config.Clustering.ReplicaMaxIdleWait = 500 * time.Millisecond
+	s3Config.Clustering.ReplicaFetchTimeout = 500 * time.Millisecond
+	s3 := runServerWithConfig(t, s3Config)
+	defer s3.Stop()
+
+	servers := []*Server{s1, s2, s3}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	c, err := lift.Connect([]string{"localhost:5050", "localhost:5051", "localhost:5052"})
+	require.NoError(t, err)
+	defer c.Close()
+
+	const partitions = 3
+	require.NoError(t, c.CreateStream(context.Background(), "foo", "foo",
+		lift.Partitions(partitions), lift.ReplicationFactor(3)))
+	for i := int32(0); i < partitions; i++ {
+		waitForISR(t, 10*time.Second, "foo", i, 3, servers...)
+	}
+
+	admin := func(port int) proto.AdminAPIClient {
+		conn, err := grpc.Dial("localhost:"+strconv.Itoa(port), grpc.WithInsecure())
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return proto.NewAdminAPIClient(conn)
+	}
+	register := func(admin proto.AdminAPIClient, ttl time.Duration) uint64 {
+		resp, err := admin.RegisterProducer(context.Background(), &proto.RegisterProducerRequest{
+			Stream:   "foo",
+			Producer: "writer",
+			Ttl:      ttl.Milliseconds(),
+		})
+		require.NoError(t, err)
+		return resp.Epoch
+	}
+	publish := func(epoch uint64, partition int32) error {
+		_, err := c.Publish(context.Background(), "foo", []byte("hello"),
+			lift.ToPartition(partition), lift.AckPolicyLeader(),
+			lift.Header(exclusiveProducerHeader, []byte("writer")),
+			lift.Header(producerEpochHeader, []byte(strconv.FormatUint(epoch, 10))))
+		return err
+	}
+	// waitForRegistration waits for the servers to apply the registration.
+	waitForRegistration := func(epoch uint64, servers ...*Server) {
+		require.Eventually(t, func() bool {
+			for _, s := range servers {
+				producer := s.metadata.GetStream("foo").GetExclusiveProducer("writer")
+				if producer == nil || producer.Epoch != epoch {
+					return false
+				}
+			}
+			return true
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	// The first client registers and publishes to every partition.
+	first := register(admin(5050), 0)
+	for i := int32(0); i < partitions; i++ {
+		require.NoError(t, publish(first, i))
+	}
+
+	// A second client registers the same producer through another server,
+	// fencing the first.
+	second := register(admin(5051), 0)
+	require.Greater(t, second, first)
+	waitForRegistration(second, servers...)
+	for i := int32(0); i < partitions; i++ {
+		require.EqualError(t, publish(first, i), ErrProducerFenced.Error())
+		require.NoError(t, publish(second, i))
+	}
+
+	// Publish rejects the fenced producer with Aborted.
+	conn, err := grpc.Dial("localhost:5052", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = client.NewAPIClient(conn).Publish(ctx, &client.PublishRequest{
+		Stream:    "foo",
+		Value:     []byte("hello"),
+		AckPolicy: client.AckPolicy_LEADER,
+		Headers: map[string][]byte{
+			exclusiveProducerHeader: []byte("writer"),
+			producerEpochHeader:     []byte(strconv.FormatUint(first, 10)),
+		},
+	})
+	require.Equal(t, codes.Aborted, status.Code(err))
+
+	// The fenced producer can't release the registration.
+	_, err = admin(5050).ReleaseProducer(context.Background(), &proto.ReleaseProducerRequest{
+		Stream:   "foo",
+		Producer: "writer",
+		Epoch:    first,
+	})
+	require.Equal(t, codes.Aborted, status.Code(err))
+
+	// Kill the metadata leader. The registration survives the failover.
+	leader := getMetadataLeader(t, 10*time.Second, servers...)
+	var (
+		followers []*Server
+		port      int
+	)
+	for _, s := range servers {
+		if s != leader {
+			followers = append(followers, s)
+			port = s.config.Port
+		}
+	}
+	leader.Stop()
+	getMetadataLeader(t, 10*time.Second, followers...)
+	waitForRegistration(second, followers...)
+
+	// Publishes from the second client are accepted once the partitions have
+	// failed over and the first client remains fenced. Publish directly to
+	// the new partition leaders since the client's publish stream may still
+	// point at the stopped server.
+	publishTo := func(s *Server, epoch uint64, partition int32) error {
+		conn, err := grpc.Dial("localhost:"+strconv.Itoa(s.config.Port), grpc.WithInsecure())
+		require.NoError(t, err)
+		defer conn.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err = client.NewAPIClient(conn).Publish(ctx, &client.PublishRequest{
+			Stream:    "foo",
+			Partition: partition,
+			Value:     []byte("hello"),
+			AckPolicy: client.AckPolicy_LEADER,
+			Headers: map[string][]byte{
+				exclusiveProducerHeader: []byte("writer"),
+				producerEpochHeader:     []byte(strconv.FormatUint(epoch, 10)),
+			},
+		})
+		return err
+	}
+	partitionLeaders := make([]*Server, partitions)
+	for i := int32(0); i < partitions; i++ {
+		// Wait for the followers to agree on the new leader.
+		require.Eventually(t, func() bool {
+			var leaders []string
+			for _, s := range followers {
+				partitionLeader, _ := s.metadata.GetPartition("foo", i).GetLeader()
+				leaders = append(leaders, partitionLeader)
+			}
+			return leaders[0] != leader.config.Clustering.ServerID && leaders[0] == leaders[1]
+		}, 10*time.Second, 10*time.Millisecond)
+		partitionLeaders[i] = getPartitionLeader(t, 10*time.Second, "foo", i, followers...)
+		require.Eventually(t, func() bool {
+			return publishTo(partitionLeaders[i], second, i) == nil
+		}, 10*time.Second, 100*time.Millisecond)
+		require.Equal(t, codes.Aborted, status.Code(publishTo(partitionLeaders[i], first, i)))
+	}
+
+	// Releasing the registration fences its holder.
+	_, err = admin(port).ReleaseProducer(context.Background(), &proto.ReleaseProducerRequest{
+		Stream:   "foo",
+		Producer: "writer",
+		Epoch:    second,
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		for _, s := range followers {
+			if !s.metadata.GetStream("foo").GetExclusiveProducer("writer").Released {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+	for i := int32(0); i < partitions; i++ {
+		require.Equal(t, codes.Aborted, status.Code(publishTo(partitionLeaders[i], second, i)))
+	}
+
+	// Registrations expire after their TTL. Epochs keep increasing across
+	// the failover and release.
+	third := register(admin(port), 500*time.Millisecond)
+	require.Greater(t, third, second)
+	waitForRegistration(third, followers...)
+	require.NoError(t, publishTo(partitionLeaders[0], third, 0))
+	time.Sleep(time.Second)
+	for i := int32(0); i < partitions; i++ {
+		require.Equal(t, codes.Aborted, status.Code(publishTo(partitionLeaders[i], third, i)))
+	}
+}
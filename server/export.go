@@ -0,0 +1,456 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	defaultExportChunkSize = 1000
+	maxExportChunkSize     = 100000
+)
+
+// exportEncoder encodes exported messages into chunks which can each be
+// decoded on their own.
+type exportEncoder interface {
+	// Encode adds the message to the current chunk.
+	Encode(msg *client.Message) error
+
+	// Flush returns the current chunk and starts a new one.
+	Flush() ([]byte, error)
+}
+
+// exportEncoders maps each export format to a constructor for its encoder.
+var exportEncoders = map[proto.ExportFormat]func() exportEncoder{
+	proto.ExportFormat_JSONL:   newJSONLinesEncoder,
+	proto.ExportFormat_PARQUET: newParquetEncoder,
+}
+
+// exportRecord is the JSON representation of an exported message. Keys,
+// values, and header values are base64-encoded since they are binary.
+type exportRecord struct {
+	Stream    string            `json:"stream"`
+	Partition int32             `json:"partition"`
+	Offset    int64             `json:"offset"`
+	Timestamp int64             `json:"timestamp"`
+	Key       []byte            `json:"key"`
+	Value     []byte            `json:"value"`
+	Headers   map[string][]byte `json:"headers"`
+}
+
+// exportHeaders returns the given message headers, or an empty map if there
+// are none, so they are always exported as a JSON object.
+func exportHeaders(headers map[string][]byte) map[string][]byte {
+	if headers == nil {
+		return map[string][]byte{}
+	}
+	return headers
+}
+
+// jsonLinesEncoder is an exportEncoder which writes each message as a JSON
+// object on its own line.
+type jsonLinesEncoder struct {
+	buf bytes.Buffer
+	enc *json.Encoder
+}
+
+func newJSONLinesEncoder() exportEncoder {
+	e := new(jsonLinesEncoder)
+	e.enc = json.NewEncoder(&e.buf)
+	return e
+}
+
+// Encode adds the message as a line of the current chunk.
+func (e *jsonLinesEncoder) Encode(msg *client.Message) error {
+	return e.enc.Encode(&exportRecord{
+		Stream:    msg.Stream,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Timestamp: msg.Timestamp,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   exportHeaders(msg.Headers),
+	})
+}
+
+// Flush returns the lines encoded since the last flush.
+func (e *jsonLinesEncoder) Flush() ([]byte, error) {
+	data := make([]byte, e.buf.Len())
+	copy(data, e.buf.Bytes())
+	e.buf.Reset()
+	return data, nil
+}
+
+// exportOffsets maps partition IDs to the next offset to export from them.
+type exportOffsets map[int32]int64
+
+// decodeExportResumeToken returns the offsets recorded in the given resume
+// token. An empty token has none.
+func decodeExportResumeToken(token string) (exportOffsets, error) {
+	offsets := make(exportOffsets)
+	if token == "" {
+		return offsets, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	decoded := new(proto.ExportResumeToken)
+	if err := decoded.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	for _, partition := range decoded.Partitions {
+		offsets[partition.Partition] = partition.NextOffset
+	}
+	return offsets, nil
+}
+
+// token returns a resume token recording the offsets.
+func (o exportOffsets) token() (string, error) {
+	token := new(proto.ExportResumeToken)
+	for id, offset := range o {
+		token.Partitions = append(token.Partitions, &proto.ExportPartitionOffset{
+			Partition:  id,
+			NextOffset: offset,
+		})
+	}
+	sort.Slice(token.Partitions, func(i, j int) bool {
+		return token.Partitions[i].Partition < token.Partitions[j].Partition
+	})
+	data, err := token.Marshal()
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// byteRateLimiter is a token bucket limiting the rate at which bytes are sent.
+// It allows bursts of up to one second's worth of bytes.
+type byteRateLimiter struct {
+	mu        sync.Mutex
+	rate      int64 // Bytes per second, unlimited if 0
+	available float64
+	last      time.Time
+}
+
+func newByteRateLimiter(rate int64) *byteRateLimiter {
+	return &byteRateLimiter{rate: rate, available: float64(rate), last: time.Now()}
+}
+
+// wait blocks until n bytes can be sent or the context is done. Sends larger
+// than the burst size are allowed but wait for the bytes they borrowed to be
+// paid back.
+func (l *byteRateLimiter) wait(ctx context.Context, n int) error {
+	l.mu.Lock()
+	if l.rate == 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	l.refill()
+	l.available -= float64(n)
+	delay := l.debt()
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// take records that n bytes were sent without waiting for them to be
+// available. Bytes borrowed are paid back before delay returns 0.
+func (l *byteRateLimiter) take(n int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.rate == 0 {
+		return
+	}
+	l.refill()
+	l.available -= float64(n)
+}
+
+// reserve takes n bytes if they are available and returns 0. Otherwise, it
+// returns how long until they are without taking them. Sends larger than the
+// burst size are taken once the bucket is full, borrowing the difference.
+func (l *byteRateLimiter) reserve(n int) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.rate == 0 {
+		return 0
+	}
+	l.refill()
+	need := math.Min(float64(n), float64(l.rate))
+	if l.available >= need {
+		l.available -= float64(n)
+		return 0
+	}
+	return time.Duration((need - l.available) / float64(l.rate) * float64(time.Second))
+}
+
+// delay returns how long until borrowed bytes are paid back, or 0 if there
+// are none.
+func (l *byteRateLimiter) delay() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.rate == 0 {
+		return 0
+	}
+	l.refill()
+	return l.debt()
+}
+
+// limit returns the limiter's rate in bytes per second, 0 if unlimited.
+func (l *byteRateLimiter) limit() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rate
+}
+
+// setRate changes the limiter's rate. Bytes already borrowed are paid back at
+// the new rate. A rate of 0 removes the limit.
+func (l *byteRateLimiter) setRate(rate int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if rate == l.rate {
+		return
+	}
+	l.refill()
+	l.rate = rate
+	if l.rate == 0 {
+		l.available = 0
+	} else if l.available > float64(l.rate) {
+		l.available = float64(l.rate)
+	}
+}
+
+// refill adds the bytes accrued since the last call, up to the burst size.
+// The caller must hold the lock.
+func (l *byteRateLimiter) refill() {
+	now := time.Now()
+	l.available += now.Sub(l.last).Seconds() * float64(l.rate)
+	if l.available > float64(l.rate) {
+		l.available = float64(l.rate)
+	}
+	l.last = now
+}
+
+// debt returns how long until borrowed bytes are paid back at the current
+// rate. The caller must hold the lock.
+func (l *byteRateLimiter) debt() time.Duration {
+	if l.available >= 0 {
+		return 0
+	}
+	return time.Duration(-l.available / float64(l.rate) * float64(time.Second))
+}
+
+// exportPartitions returns the partitions of the stream to export in ID order.
+func exportPartitions(stream *stream, ids []int32) ([]*partition, *status.Status) {
+	var partitions []*partition
+	if len(ids) == 0 {
+		for _, partition := range stream.GetPartitions() {
+			partitions = append(partitions, partition)
+		}
+	} else {
+		for _, id := range ids {
+			partition := stream.GetPartition(id)
+			if partition == nil {
+				return nil, status.Newf(codes.NotFound, "No such partition: %d", id)
+			}
+			partitions = append(partitions, partition)
+		}
+	}
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].Id < partitions[j].Id
+	})
+	return partitions, nil
+}
+
+// exportRange returns the first and last offsets of the partition to export
+// given the request's bounds and the offset to resume from, if any. Only
+// committed messages are exported. The range is empty if the first offset is
+// after the last.
+func exportRange(p *partition, req *proto.ExportMessagesRequest, offsets exportOffsets) (
+	int64, int64, error) {
+
+	start, stop := p.log.OldestOffset(), p.log.HighWatermark()
+	if req.StartOffset != nil && req.StartOffset.Value > start {
+		start = req.StartOffset.Value
+	}
+	if req.StartTimestamp != nil {
+		offset, err := p.log.EarliestOffsetAfterTimestamp(req.StartTimestamp.Value)
+		if err != nil {
+			return 0, 0, err
+		}
+		if offset > start {
+			start = offset
+		}
+	}
+	if next, ok := offsets[p.Id]; ok && next > start {
+		start = next
+	}
+	if req.StopOffset != nil && req.StopOffset.Value < stop {
+		stop = req.StopOffset.Value
+	}
+	if req.StopTimestamp != nil {
+		// The last offset at or before the timestamp is the one before the
+		// first offset after it.
+		offset, err := p.log.EarliestOffsetAfterTimestamp(req.StopTimestamp.Value + 1)
+		if err != nil {
+			return 0, 0, err
+		}
+		if offset-1 < stop {
+			stop = offset - 1
+		}
+	}
+	return start, stop, nil
+}
+
+// exportMessages sends the messages of the partitions requested for export in
+// chunks encoded in the requested format. The resume token sent with each
+// chunk records the next offset to export from each partition exported so far.
+func (a *apiServer) exportMessages(req *proto.ExportMessagesRequest,
+	out proto.AdminAPI_ExportMessagesServer) *status.Status {
+
+	newEncoder, ok := exportEncoders[req.Format]
+	if !ok {
+		return status.Newf(codes.InvalidArgument, "Unknown export format %s", req.Format)
+	}
+	chunkSize := int(req.ChunkSize)
+	if chunkSize == 0 {
+		chunkSize = defaultExportChunkSize
+	}
+	offsets, err := decodeExportResumeToken(req.ResumeToken)
+	if err != nil {
+		return status.Newf(codes.InvalidArgument, "Invalid resume token: %v", err)
+	}
+	stream := a.metadata.GetStream(req.Stream)
+	if stream == nil {
+		return status.Newf(codes.NotFound, "No such stream: %s", req.Stream)
+	}
+	partitions, st := exportPartitions(stream, req.Partitions)
+	if st != nil {
+		return st
+	}
+
+	for _, partition := range partitions {
+		if st := a.exportPartition(out, partition, req, newEncoder(), chunkSize, offsets); st != nil {
+			return st
+		}
+	}
+	return nil
+}
+
+// exportPartition sends the messages of the partition in the range requested
+// for export, updating the partition's next offset to export as it goes.
+func (a *apiServer) exportPartition(out proto.AdminAPI_ExportMessagesServer, p *partition,
+	req *proto.ExportMessagesRequest, encoder exportEncoder, chunkSize int,
+	offsets exportOffsets) *status.Status {
+
+	if p.IsPaused() {
+		return status.Newf(codes.FailedPrecondition, "Partition %d is paused", p.Id)
+	}
+	leader := p.IsLeader()
+	if !leader && !p.inISR(a.config.Clustering.ServerID) {
+		return status.Newf(codes.FailedPrecondition, "Server not partition leader or in ISR for partition %d", p.Id)
+	}
+	start, stop, err := exportRange(p, req, offsets)
+	if err != nil {
+		return status.Newf(codes.Internal, "Failed to lookup offsets for partition %d: %v", p.Id, err)
+	}
+	if start > stop {
+		return nil
+	}
+
+	ctx := out.Context()
+	sub, e := a.SubscribeInternal(ctx, &client.SubscribeRequest{
+		Stream:         p.Stream,
+		Partition:      p.Id,
+		StartPosition:  client.StartPosition_OFFSET,
+		StartOffset:    start,
+		StopPosition:   client.StopPosition_STOP_OFFSET,
+		StopOffset:     stop,
+		ReadISRReplica: !leader,
+	})
+	if e != nil {
+		return status.Convert(e)
+	}
+	defer sub.Close()
+
+	var count int32
+	send := func(next int64) *status.Status {
+		data, err := encoder.Flush()
+		if err != nil {
+			return status.Newf(codes.Internal, "Failed to encode messages: %v", err)
+		}
+		offsets[p.Id] = next
+		token, err := offsets.token()
+		if err != nil {
+			return status.Newf(codes.Internal, "Failed to create resume token: %v", err)
+		}
+		if err := a.exportLimiter.wait(ctx, len(data)); err != nil {
+			return status.FromContextError(err)
+		}
+		if err := out.Send(&proto.ExportMessagesResponse{
+			Partition:   p.Id,
+			Messages:    count,
+			Data:        data,
+			ResumeToken: token,
+		}); err != nil {
+			return status.Convert(err)
+		}
+		count = 0
+		return nil
+	}
+
+	for {
+		select {
+		case msg := <-sub.Messages():
+			if err := encoder.Encode(msg); err != nil {
+				return status.Newf(codes.Internal, "Failed to encode message: %v", err)
+			}
+			count++
+			if msg.Offset >= stop {
+				return send(stop + 1)
+			}
+			if int(count) == chunkSize {
+				if st := send(msg.Offset + 1); st != nil {
+					return st
+				}
+			}
+		case st := <-sub.Errors():
+			if st.Code() != codes.ResourceExhausted {
+				return st
+			}
+			// The stop offset was reached without a message at it, which
+			// happens if the last messages were skipped or compacted.
+			if count == 0 {
+				offsets[p.Id] = stop + 1
+				return nil
+			}
+			return send(stop + 1)
+		case <-sub.Closed():
+			return status.Newf(codes.Unavailable, "Export of partition %d was interrupted", p.Id)
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err())
+		}
+	}
+}
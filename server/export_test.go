@@ -0,0 +1,388 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+)
+
+// compactReader reads Thrift structs serialized with the compact protocol.
+// It's only used to verify the Parquet files written for exports.
+type compactReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *compactReader) readVarint() uint64 {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	r.pos += n
+	return v
+}
+
+func (r *compactReader) readInt() int64 {
+	v := r.readVarint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (r *compactReader) readBinary() []byte {
+	n := int(r.readVarint())
+	v := r.buf[r.pos : r.pos+n]
+	r.pos += n
+	return v
+}
+
+// readStruct calls fn with the ID and type of each field of a struct, which
+// must read or skip the field's value.
+func (r *compactReader) readStruct(fn func(id int16, typ byte)) {
+	var last int16
+	for {
+		header := r.buf[r.pos]
+		r.pos++
+		if header == 0 {
+			return
+		}
+		typ := header & 0x0f
+		if delta := int16(header >> 4); delta != 0 {
+			last += delta
+		} else {
+			last = int16(r.readInt())
+		}
+		fn(last, typ)
+	}
+}
+
+// readList calls fn once for each element of a list, which must read or skip
+// the element.
+func (r *compactReader) readList(fn func(elemType byte)) {
+	header := r.buf[r.pos]
+	r.pos++
+	size := int(header >> 4)
+	if size == 15 {
+		size = int(r.readVarint())
+	}
+	for i := 0; i < size; i++ {
+		fn(header & 0x0f)
+	}
+}
+
+func (r *compactReader) skip(typ byte) {
+	switch typ {
+	case thriftI32, thriftI64:
+		r.readVarint()
+	case thriftBinary:
+		r.readBinary()
+	case thriftList:
+		r.readList(r.skip)
+	case thriftStruct:
+		r.readStruct(func(_ int16, typ byte) { r.skip(typ) })
+	default:
+		panic("unexpected thrift type")
+	}
+}
+
+// readParquetRecords decodes the messages in a Parquet file written by the
+// Parquet export encoder.
+func readParquetRecords(t *testing.T, data []byte) []*exportRecord {
+	require.True(t, bytes.HasPrefix(data, parquetMagic))
+	require.True(t, bytes.HasSuffix(data, parquetMagic))
+	footerLen := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	footer := &compactReader{buf: data[len(data)-8-footerLen : len(data)-8]}
+
+	var (
+		numRows     int64
+		pageOffsets = make(map[string]int64)
+		types       = make(map[string]int32)
+	)
+	footer.readStruct(func(id int16, typ byte) {
+		switch id {
+		case 2: // schema
+			footer.readList(func(byte) {
+				var (
+					name    string
+					colType int32 = -1
+				)
+				footer.readStruct(func(id int16, typ byte) {
+					switch id {
+					case 1:
+						colType = int32(footer.readInt())
+					case 4:
+						name = string(footer.readBinary())
+					default:
+						footer.skip(typ)
+					}
+				})
+				if colType != -1 {
+					types[name] = colType
+				}
+			})
+		case 3: // num_rows
+			numRows = footer.readInt()
+		case 4: // row_groups
+			footer.readList(func(byte) {
+				footer.readStruct(func(id int16, typ byte) {
+					if id != 1 {
+						footer.skip(typ)
+						return
+					}
+					footer.readList(func(byte) {
+						footer.readStruct(func(id int16, typ byte) {
+							if id != 3 {
+								footer.skip(typ)
+								return
+							}
+							var (
+								path   string
+								offset int64
+							)
+							footer.readStruct(func(id int16, typ byte) {
+								switch id {
+								case 3:
+									footer.readList(func(byte) { path = string(footer.readBinary()) })
+								case 5:
+									require.Equal(t, numRows, footer.readInt())
+								case 9:
+									offset = footer.readInt()
+								default:
+									footer.skip(typ)
+								}
+							})
+							pageOffsets[path] = offset
+						})
+					})
+				})
+			})
+		default:
+			footer.skip(typ)
+		}
+	})
+
+	records := make([]*exportRecord, numRows)
+	for i := range records {
+		records[i] = new(exportRecord)
+	}
+	require.Len(t, pageOffsets, 7)
+	for name, offset := range pageOffsets {
+		page := &compactReader{buf: data, pos: int(offset)}
+		var size int
+		page.readStruct(func(id int16, typ byte) {
+			if id == 2 {
+				size = int(page.readInt())
+				return
+			}
+			page.skip(typ)
+		})
+		values := data[page.pos : page.pos+size]
+		for _, record := range records {
+			switch types[name] {
+			case parquetInt32:
+				v := int32(binary.LittleEndian.Uint32(values))
+				values = values[4:]
+				require.Equal(t, "partition", name)
+				record.Partition = v
+			case parquetInt64:
+				v := int64(binary.LittleEndian.Uint64(values))
+				values = values[8:]
+				switch name {
+				case "offset":
+					record.Offset = v
+				case "timestamp":
+					record.Timestamp = v
+				}
+			case parquetByteArray:
+				n := binary.LittleEndian.Uint32(values)
+				v := values[4 : 4+n]
+				values = values[4+n:]
+				switch name {
+				case "stream":
+					record.Stream = string(v)
+				case "key":
+					record.Key = v
+				case "value":
+					record.Value = v
+				case "headers":
+					require.NoError(t, json.Unmarshal(v, &record.Headers))
+				}
+			}
+		}
+		require.Empty(t, values)
+	}
+	return records
+}
+
+func exportTestMessages() []*client.Message {
+	return []*client.Message{
+		{
+			Stream:    "foo",
+			Partition: 1,
+			Offset:    0,
+			Timestamp: 1000,
+			Key:       []byte{0x00, 0xff, 0x10},
+			Value:     []byte("hello"),
+			Headers:   map[string][]byte{"type": []byte("order")},
+		},
+		{
+			Stream:    "foo",
+			Partition: 1,
+			Offset:    1,
+			Timestamp: 2000,
+			Value:     []byte{},
+		},
+		{
+			Stream:    "foo",
+			Partition: 1,
+			Offset:    2,
+			Timestamp: 3000,
+			Key:       []byte("k"),
+			Value:     bytes.Repeat([]byte("x"), 300),
+		},
+	}
+}
+
+// Ensure the JSON lines encoder writes a line per message with binary fields
+// base64-encoded and resets between chunks.
+func TestJSONLinesEncoder(t *testing.T) {
+	encoder := newJSONLinesEncoder()
+	msgs := exportTestMessages()
+	for _, msg := range msgs {
+		require.NoError(t, encoder.Encode(msg))
+	}
+	data, err := encoder.Flush()
+	require.NoError(t, err)
+
+	// The binary key is base64-encoded.
+	require.Contains(t, string(data), `"key":"AP8Q"`)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, 1024*1024)
+	i := 0
+	for scanner.Scan() {
+		record := new(exportRecord)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), record))
+		msg := msgs[i]
+		require.Equal(t, msg.Stream, record.Stream)
+		require.Equal(t, msg.Partition, record.Partition)
+		require.Equal(t, msg.Offset, record.Offset)
+		require.Equal(t, msg.Timestamp, record.Timestamp)
+		require.Equal(t, string(msg.Key), string(record.Key))
+		require.Equal(t, string(msg.Value), string(record.Value))
+		require.Len(t, record.Headers, len(msg.Headers))
+		for name, value := range msg.Headers {
+			require.Equal(t, value, record.Headers[name])
+		}
+		i++
+	}
+	require.Equal(t, len(msgs), i)
+
+	// Flushing starts a new chunk.
+	data, err = encoder.Flush()
+	require.NoError(t, err)
+	require.Empty(t, data)
+}
+
+// Ensure the Parquet encoder writes complete files containing every field of
+// the messages encoded since the last flush.
+func TestParquetEncoder(t *testing.T) {
+	encoder := newParquetEncoder()
+	msgs := exportTestMessages()
+	for _, msg := range msgs {
+		require.NoError(t, encoder.Encode(msg))
+	}
+	data, err := encoder.Flush()
+	require.NoError(t, err)
+
+	records := readParquetRecords(t, data)
+	require.Len(t, records, len(msgs))
+	for i, record := range records {
+		msg := msgs[i]
+		require.Equal(t, msg.Stream, record.Stream)
+		require.Equal(t, msg.Partition, record.Partition)
+		require.Equal(t, msg.Offset, record.Offset)
+		require.Equal(t, msg.Timestamp, record.Timestamp)
+		require.Equal(t, string(msg.Key), string(record.Key))
+		require.Equal(t, string(msg.Value), string(record.Value))
+		require.Len(t, record.Headers, len(msg.Headers))
+		for name, value := range msg.Headers {
+			require.Equal(t, value, record.Headers[name])
+		}
+	}
+
+	// Each flush writes a new file.
+	require.NoError(t, encoder.Encode(msgs[2]))
+	data, err = encoder.Flush()
+	require.NoError(t, err)
+	records = readParquetRecords(t, data)
+	require.Len(t, records, 1)
+	require.Equal(t, int64(2), records[0].Offset)
+}
+
+// Ensure resume tokens round trip the offsets they record.
+func TestExportResumeToken(t *testing.T) {
+	offsets, err := decodeExportResumeToken("")
+	require.NoError(t, err)
+	require.Empty(t, offsets)
+
+	offsets = exportOffsets{0: 10, 3: 42}
+	token, err := offsets.token()
+	require.NoError(t, err)
+	decoded, err := decodeExportResumeToken(token)
+	require.NoError(t, err)
+	require.Equal(t, offsets, decoded)
+
+	_, err = decodeExportResumeToken("not a token!")
+	require.Error(t, err)
+}
+
+// Ensure the byte rate limiter allows a burst of its rate and then delays
+// sends until the bytes are paid back.
+func TestByteRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	unlimited := newByteRateLimiter(0)
+	require.NoError(t, unlimited.wait(ctx, 1024*1024*1024))
+
+	limiter := newByteRateLimiter(1000)
+	start := time.Now()
+	require.NoError(t, limiter.wait(ctx, 1000))
+	require.Less(t, time.Since(start), 50*time.Millisecond)
+
+	require.NoError(t, limiter.wait(ctx, 200))
+	require.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+
+	// Waits are interrupted when the context is done.
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	require.Error(t, limiter.wait(ctx, 5000))
+}
+
+// Ensure bytes taken from the byte rate limiter are paid back before its delay
+// returns 0 and that changing its rate applies to bytes already borrowed.
+func TestByteRateLimiterTake(t *testing.T) {
+	unlimited := newByteRateLimiter(0)
+	unlimited.take(1024 * 1024 * 1024)
+	require.Zero(t, unlimited.delay())
+
+	limiter := newByteRateLimiter(1000)
+	limiter.take(1000)
+	require.Zero(t, limiter.delay())
+	limiter.take(1000)
+	delay := limiter.delay()
+	require.Greater(t, delay, 900*time.Millisecond)
+	require.LessOrEqual(t, delay, time.Second)
+
+	// Doubling the rate halves the time to pay back the borrowed bytes.
+	limiter.setRate(2000)
+	require.Equal(t, int64(2000), limiter.limit())
+	delay = limiter.delay()
+	require.Greater(t, delay, 400*time.Millisecond)
+	require.LessOrEqual(t, delay, 500*time.Millisecond)
+
+	// Removing the limit forgives borrowed bytes.
+	limiter.setRate(0)
+	require.Zero(t, limiter.delay())
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"github.com/nats-io/nats.go"
+)
+
+// fetchResult is the outcome of a replication request sent by a follower,
+// tagged with the order in which the request was sent.
+type fetchResult struct {
+	seq  uint64
+	resp *nats.Msg
+	err  error
+}
+
+// fetchPipeline tracks the replication requests a follower has in flight to
+// the partition leader. Up to window requests are sent without waiting for a
+// response, and responses are handed back in the order the requests were sent
+// so that the messages they contain are appended to the log in order. The
+// window starts at one request, grows by one with each successful response up
+// to the pipeline depth, and drops back to one when a request fails since the
+// responses to the requests sent after it can no longer be applied.
+type fetchPipeline struct {
+	depth   int
+	window  int
+	sent    uint64
+	applied uint64
+	results chan fetchResult
+	pending map[uint64]fetchResult // Results received ahead of their turn
+}
+
+func newFetchPipeline(depth int) *fetchPipeline {
+	if depth < 1 {
+		depth = 1
+	}
+	return &fetchPipeline{
+		depth:   depth,
+		window:  1,
+		results: make(chan fetchResult, depth),
+		pending: make(map[uint64]fetchResult),
+	}
+}
+
+// inflight returns the number of requests awaiting a response.
+func (f *fetchPipeline) inflight() int {
+	return int(f.sent - f.applied)
+}
+
+// canSend indicates if another request can be sent without exceeding the
+// window.
+func (f *fetchPipeline) canSend() bool {
+	return f.inflight() < f.window
+}
+
+// send records a request as sent and waits for its response in the
+// background using the given function.
+func (f *fetchPipeline) send(wait func() (*nats.Msg, error)) {
+	f.sent++
+	var (
+		seq     = f.sent
+		results = f.results
+	)
+	go func() {
+		resp, err := wait()
+		// The channel has room for a result from every request sent since the
+		// last reset, so this never blocks.
+		results <- fetchResult{seq: seq, resp: resp, err: err}
+	}()
+}
+
+// next returns the result of the oldest request awaiting a response, waiting
+// for it if necessary. It returns false if the stop channel is closed first.
+func (f *fetchPipeline) next(stop <-chan struct{}) (fetchResult, bool) {
+	for {
+		if result, ok := f.pending[f.applied+1]; ok {
+			delete(f.pending, result.seq)
+			f.applied++
+			return result, true
+		}
+		select {
+		case <-stop:
+			return fetchResult{}, false
+		case result := <-f.results:
+			f.pending[result.seq] = result
+		}
+	}
+}
+
+// reset discards the requests in flight and shrinks the window to a single
+// request.
+func (f *fetchPipeline) reset() {
+	f.window = 1
+	f.applied = f.sent
+	f.results = make(chan fetchResult, f.depth)
+	f.pending = make(map[uint64]fetchResult)
+}
+
+// grow widens the window by one request, up to the pipeline depth.
+func (f *fetchPipeline) grow() {
+	if f.window < f.depth {
+		f.window++
+	}
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+)
+
+// Ensure fetchPipeline returns responses in the order requests were sent even
+// if they arrive out of order, and discards the requests in flight on reset.
+func TestFetchPipelineOrdersResponses(t *testing.T) {
+	pipeline := newFetchPipeline(3)
+	require.True(t, pipeline.canSend())
+	pipeline.grow()
+	pipeline.grow()
+	pipeline.grow()
+	require.Equal(t, 3, pipeline.window)
+
+	responses := make([]chan *nats.Msg, 3)
+	for i := range responses {
+		ch := make(chan *nats.Msg, 1)
+		responses[i] = ch
+		pipeline.send(func() (*nats.Msg, error) { return <-ch, nil })
+	}
+	require.False(t, pipeline.canSend())
+	require.Equal(t, 3, pipeline.inflight())
+
+	// Respond to the requests in reverse order.
+	for i := len(responses) - 1; i >= 0; i-- {
+		responses[i] <- &nats.Msg{Subject: string(rune('a' + i))}
+	}
+	stop := make(chan struct{})
+	for i := range responses {
+		result, ok := pipeline.next(stop)
+		require.True(t, ok)
+		require.NoError(t, result.err)
+		require.Equal(t, string(rune('a'+i)), result.resp.Subject)
+	}
+	require.Equal(t, 0, pipeline.inflight())
+
+	// A failed request shrinks the window and discards those after it.
+	block := make(chan struct{})
+	defer close(block)
+	pipeline.send(func() (*nats.Msg, error) { return nil, errors.New("timeout") })
+	pipeline.send(func() (*nats.Msg, error) { <-block; return nil, nil })
+	result, ok := pipeline.next(stop)
+	require.True(t, ok)
+	require.Error(t, result.err)
+	pipeline.reset()
+	require.Equal(t, 0, pipeline.inflight())
+	require.Equal(t, 1, pipeline.window)
+
+	// Stopping interrupts waiting for a response.
+	pipeline.send(func() (*nats.Msg, error) { <-block; return nil, nil })
+	close(stop)
+	_, ok = pipeline.next(stop)
+	require.False(t, ok)
+}
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// headerFilterMetadataKey is the gRPC metadata key used to pass a header
+	// filter to Subscribe since SubscribeRequest has no field for it. Each
+	// value is a single condition, either "name=value" to require a header
+	// with the exact value or "name" to require the header to be present.
+	headerFilterMetadataKey = "liftbridge-header-filter"
+
+	// maxHeaderFilterConditions is the maximum number of conditions a header
+	// filter can have.
+	maxHeaderFilterConditions = 32
+
+	// maxHeaderFilterBytes is the maximum combined size of a header filter's
+	// conditions.
+	maxHeaderFilterBytes = 4096
+
+	// valueFilterMetadataKey is the gRPC metadata key used to pass a regular
+	// expression to Subscribe which message values must match.
+	valueFilterMetadataKey = "liftbridge-value-filter"
+
+	// maxValueFilterBytes is the maximum size of a value filter's regular
+	// expression.
+	maxValueFilterBytes = 1024
+)
+
+// headerCondition is a single condition of a messageFilter.
+type headerCondition struct {
+	name  string
+	value []byte
+	// exists indicates the condition only requires the header to be present
+	// regardless of its value.
+	exists bool
+}
+
+// messageFilter selects the messages sent to a subscription based on their
+// headers and value. A message matches if it satisfies all of the filter's
+// header conditions and its value matches the filter's regular expression, if
+// set.
+type messageFilter struct {
+	conditions []headerCondition
+	expr       string
+	value      *regexp.Regexp
+}
+
+// messageFilterFromContext returns the message filter set in the incoming
+// gRPC metadata of the given context or nil if there is none.
+func messageFilterFromContext(ctx context.Context) (*messageFilter, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	var (
+		exprs      = md.Get(headerFilterMetadataKey)
+		valueExprs = md.Get(valueFilterMetadataKey)
+	)
+	if len(exprs) == 0 && len(valueExprs) == 0 {
+		return nil, nil
+	}
+	filter, err := parseHeaderFilter(exprs)
+	if err != nil {
+		return nil, err
+	}
+	switch len(valueExprs) {
+	case 0:
+	case 1:
+		if err := filter.setValueFilter(valueExprs[0]); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("only one %s can be set", valueFilterMetadataKey)
+	}
+	return filter, nil
+}
+
+// parseHeaderFilter parses a message filter from the given header conditions.
+func parseHeaderFilter(exprs []string) (*messageFilter, error) {
+	if len(exprs) > maxHeaderFilterConditions {
+		return nil, fmt.Errorf("header filter has %d conditions, maximum is %d",
+			len(exprs), maxHeaderFilterConditions)
+	}
+	size := 0
+	for _, expr := range exprs {
+		size += len(expr)
+	}
+	if size > maxHeaderFilterBytes {
+		return nil, fmt.Errorf("header filter is %d bytes, maximum is %d",
+			size, maxHeaderFilterBytes)
+	}
+
+	filter := &messageFilter{
+		conditions: make([]headerCondition, len(exprs)),
+		expr:       strings.Join(exprs, ","),
+	}
+	for i, expr := range exprs {
+		name, value, hasValue := strings.Cut(expr, "=")
+		if name == "" {
+			return nil, errors.New("header filter condition has no header name")
+		}
+		filter.conditions[i] = headerCondition{
+			name:   name,
+			value:  []byte(value),
+			exists: !hasValue,
+		}
+	}
+	return filter, nil
+}
+
+// setValueFilter compiles the regular expression message values must match.
+// The regular expression is compiled once here rather than for each message.
+func (f *messageFilter) setValueFilter(expr string) error {
+	if len(expr) > maxValueFilterBytes {
+		return fmt.Errorf("value filter is %d bytes, maximum is %d",
+			len(expr), maxValueFilterBytes)
+	}
+	value, err := regexp.Compile(expr)
+	if err != nil {
+		return fmt.Errorf("invalid value filter: %v", err)
+	}
+	f.value = value
+	return nil
+}
+
+// MatchesHeaders indicates if the given message headers satisfy all of the
+// filter's header conditions.
+func (f *messageFilter) MatchesHeaders(headers map[string][]byte) bool {
+	for _, cond := range f.conditions {
+		value, ok := headers[cond.name]
+		if !ok {
+			return false
+		}
+		if !cond.exists && !bytes.Equal(value, cond.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchesValue indicates if the given message value matches the filter's
+// regular expression. Values always match if the filter has none.
+func (f *messageFilter) MatchesValue(value []byte) bool {
+	return f.value == nil || f.value.Match(value)
+}
+
+// String returns the filter's header conditions separated by commas.
+func (f *messageFilter) String() string {
+	return f.expr
+}
+
+// ValueString returns the filter's regular expression for message values or
+// an empty string if there is none.
+func (f *messageFilter) ValueString() string {
+	if f.value == nil {
+		return ""
+	}
+	return f.value.String()
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+// Ensure header filters match messages satisfying all of their conditions.
+func TestHeaderFilterMatches(t *testing.T) {
+	filter, err := parseHeaderFilter([]string{"type=order", "region"})
+	require.NoError(t, err)
+	require.Equal(t, "type=order,region", filter.String())
+
+	require.True(t, filter.MatchesHeaders(map[string][]byte{
+		"type":   []byte("order"),
+		"region": []byte("eu"),
+	}))
+	require.True(t, filter.MatchesHeaders(map[string][]byte{
+		"type":   []byte("order"),
+		"region": nil,
+		"other":  []byte("foo"),
+	}))
+	require.False(t, filter.MatchesHeaders(map[string][]byte{
+		"type":   []byte("refund"),
+		"region": []byte("eu"),
+	}))
+	require.False(t, filter.MatchesHeaders(map[string][]byte{"type": []byte("order")}))
+	require.False(t, filter.MatchesHeaders(nil))
+
+	// Values can contain the separator and can be empty.
+	filter, err = parseHeaderFilter([]string{"query=a=b", "empty="})
+	require.NoError(t, err)
+	require.True(t, filter.MatchesHeaders(map[string][]byte{
+		"query": []byte("a=b"),
+		"empty": []byte(""),
+	}))
+	require.False(t, filter.MatchesHeaders(map[string][]byte{
+		"query": []byte("a=b"),
+		"empty": []byte("foo"),
+	}))
+}
+
+// Ensure invalid or oversized header filters are rejected.
+func TestParseHeaderFilterInvalid(t *testing.T) {
+	_, err := parseHeaderFilter([]string{"=foo"})
+	require.Error(t, err)
+
+	_, err = parseHeaderFilter([]string{""})
+	require.Error(t, err)
+
+	conditions := make([]string, maxHeaderFilterConditions+1)
+	for i := range conditions {
+		conditions[i] = "foo"
+	}
+	_, err = parseHeaderFilter(conditions)
+	require.Error(t, err)
+
+	_, err = parseHeaderFilter([]string{"foo=" + strings.Repeat("x", maxHeaderFilterBytes)})
+	require.Error(t, err)
+}
+
+// Ensure the message filter is read from the incoming gRPC metadata.
+func TestHeaderFilterFromContext(t *testing.T) {
+	filter, err := messageFilterFromContext(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, filter)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("foo", "bar"))
+	filter, err = messageFilterFromContext(ctx)
+	require.NoError(t, err)
+	require.Nil(t, filter)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		headerFilterMetadataKey, "type=order",
+		headerFilterMetadataKey, "region",
+	))
+	filter, err = messageFilterFromContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "type=order,region", filter.String())
+
+	require.Empty(t, filter.ValueString())
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		valueFilterMetadataKey, "^order-[0-9]+$",
+	))
+	filter, err = messageFilterFromContext(ctx)
+	require.NoError(t, err)
+	require.Empty(t, filter.String())
+	require.Equal(t, "^order-[0-9]+$", filter.ValueString())
+	require.True(t, filter.MatchesHeaders(nil))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		valueFilterMetadataKey, "foo",
+		valueFilterMetadataKey, "bar",
+	))
+	_, err = messageFilterFromContext(ctx)
+	require.Error(t, err)
+}
+
+// Ensure value filters match message values against their regular expression
+// and invalid or oversized ones are rejected.
+func TestMessageFilterMatchesValue(t *testing.T) {
+	filter, err := parseHeaderFilter([]string{"type=order"})
+	require.NoError(t, err)
+	require.True(t, filter.MatchesValue([]byte("anything")))
+
+	require.NoError(t, filter.setValueFilter("^order-[0-9]+$"))
+	require.True(t, filter.MatchesValue([]byte("order-42")))
+	require.False(t, filter.MatchesValue([]byte("order-foo")))
+	require.False(t, filter.MatchesValue(nil))
+
+	require.Error(t, filter.setValueFilter("order-("))
+	require.Error(t, filter.setValueFilter(strings.Repeat("x", maxValueFilterBytes+1)))
+}
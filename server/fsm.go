@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/dustin/go-humanize/english"
 	"github.com/hashicorp/raft"
@@ -138,12 +139,15 @@ func (s *Server) Apply(l *raft.Log) interface{} {
 func (s *Server) apply(log *proto.RaftLog, index uint64, recovered bool) (interface{}, error) {
 	switch log.Op {
 	case proto.Op_CREATE_STREAM:
+		streams := append([]*proto.Stream{log.CreateStreamOp.Stream}, log.CreateStreamOp.Companions...)
 		// Make sure to set the leader epoch on the partitions.
-		for _, partition := range log.CreateStreamOp.Stream.Partitions {
-			partition.LeaderEpoch = index
-			partition.Epoch = index
+		for _, stream := range streams {
+			for _, partition := range stream.Partitions {
+				partition.LeaderEpoch = index
+				partition.Epoch = index
+			}
 		}
-		if err := s.applyCreateStream(log.CreateStreamOp.Stream, recovered, index); err != nil {
+		if err := s.applyCreateStreams(streams, recovered, index); err != nil {
 			return nil, err
 		}
 	case proto.Op_SHRINK_ISR:
@@ -175,9 +179,11 @@ func (s *Server) apply(log *proto.RaftLog, index uint64, recovered bool) (interf
 		}
 	case proto.Op_DELETE_STREAM:
 		var (
-			stream = log.DeleteStreamOp.Stream
+			stream       = log.DeleteStreamOp.Stream
+			cascade      = log.DeleteStreamOp.Cascade
+			ttlDeletedAt = log.DeleteStreamOp.TtlDeletedAt
 		)
-		if err := s.applyDeleteStream(stream, recovered, index); err != nil {
+		if err := s.applyDeleteStream(stream, cascade, recovered, ttlDeletedAt, index); err != nil {
 			return nil, err
 		}
 	case proto.Op_PAUSE_STREAM:
@@ -186,7 +192,7 @@ func (s *Server) apply(log *proto.RaftLog, index uint64, recovered bool) (interf
 			partitions = log.PauseStreamOp.Partitions
 			resumeAll  = log.PauseStreamOp.ResumeAll
 		)
-		if err := s.applyPauseStream(stream, partitions, resumeAll); err != nil {
+		if err := s.applyPauseStream(stream, partitions, resumeAll, index); err != nil {
 			return nil, err
 		}
 	case proto.Op_SET_STREAM_READONLY:
@@ -194,8 +200,96 @@ func (s *Server) apply(log *proto.RaftLog, index uint64, recovered bool) (interf
 			stream     = log.SetStreamReadonlyOp.Stream
 			partitions = log.SetStreamReadonlyOp.Partitions
 			readonly   = log.SetStreamReadonlyOp.Readonly
+			target     = log.SetStreamReadonlyOp.TargetOffset
+		)
+		if err := s.applySetStreamReadonly(stream, partitions, readonly, target); err != nil {
+			return nil, err
+		}
+	case proto.Op_UPDATE_STREAM_OWNER:
+		var (
+			stream = log.UpdateStreamOwnerOp.Stream
+			owner  = log.UpdateStreamOwnerOp.Owner
+		)
+		if err := s.applyUpdateStreamOwner(stream, owner); err != nil {
+			return nil, err
+		}
+	case proto.Op_UPDATE_STREAM_CONFIG:
+		var (
+			stream = log.UpdateStreamConfigOp.Stream
+			config = log.UpdateStreamConfigOp.Config
 		)
-		if err := s.applySetStreamReadonly(stream, partitions, readonly); err != nil {
+		if err := s.applyUpdateStreamConfig(stream, config, recovered); err != nil {
+			return nil, err
+		}
+	case proto.Op_SET_STREAM_TAGS:
+		var (
+			stream = log.SetStreamTagsOp.Stream
+			tags   = log.SetStreamTagsOp.Tags
+		)
+		if err := s.applySetStreamTags(stream, tags, recovered); err != nil {
+			return nil, err
+		}
+	case proto.Op_DELETE_PARTITION_DATA:
+		var (
+			stream    = log.DeletePartitionDataOp.Stream
+			partition = log.DeletePartitionDataOp.Partition
+			offset    = log.DeletePartitionDataOp.Offset
+		)
+		if err := s.applyDeletePartitionData(stream, partition, offset, recovered); err != nil {
+			return nil, err
+		}
+	case proto.Op_DELETE_PARTITION:
+		var (
+			stream    = log.DeletePartitionOp.Stream
+			partition = log.DeletePartitionOp.Partition
+		)
+		if err := s.applyDeletePartition(stream, partition, recovered, index); err != nil {
+			return nil, err
+		}
+	case proto.Op_SET_STREAM_ACL:
+		var (
+			stream = log.SetStreamACLOp.Stream
+			acl    = log.SetStreamACLOp.Acl
+		)
+		if err := s.applySetStreamACL(stream, acl, recovered); err != nil {
+			return nil, err
+		}
+	case proto.Op_REPLACE_REPLICA:
+		var (
+			stream      = log.ReplaceReplicaOp.Stream
+			partition   = log.ReplaceReplicaOp.Partition
+			replica     = log.ReplaceReplicaOp.Replica
+			replacement = log.ReplaceReplicaOp.Replacement
+		)
+		if err := s.applyReplaceReplica(stream, replica, replacement, partition, index); err != nil {
+			return nil, err
+		}
+	case proto.Op_SET_PRODUCER_QUOTA:
+		var (
+			producerID     = log.SetProducerQuotaOp.Quota.ProducerID
+			bytesPerSecond = log.SetProducerQuotaOp.Quota.BytesPerSecond
+		)
+		s.applySetProducerQuota(producerID, bytesPerSecond)
+	case proto.Op_DELETE_PRODUCER_QUOTA:
+		s.applyDeleteProducerQuota(log.DeleteProducerQuotaOp.ProducerID)
+	case proto.Op_REPORT_PARTITION_SKEW:
+		s.applyReportPartitionSkew(log.ReportPartitionSkewOp.Skew)
+	case proto.Op_REGISTER_PRODUCER:
+		var (
+			stream    = log.RegisterProducerOp.Stream
+			producer  = log.RegisterProducerOp.Producer
+			expiresAt = log.RegisterProducerOp.ExpiresAt
+		)
+		if err := s.applyRegisterProducer(stream, producer, expiresAt, index, recovered); err != nil {
+			return nil, err
+		}
+	case proto.Op_RELEASE_PRODUCER:
+		var (
+			stream   = log.ReleaseProducerOp.Stream
+			producer = log.ReleaseProducerOp.Producer
+			epoch    = log.ReleaseProducerOp.Epoch
+		)
+		if err := s.applyReleaseProducer(stream, producer, epoch, recovered); err != nil {
 			return nil, err
 		}
 	case proto.Op_RESUME_STREAM:
@@ -239,7 +333,22 @@ func (s *Server) apply(log *proto.RaftLog, index uint64, recovered bool) (interf
 			return nil, err
 		}
 	case proto.Op_PUBLISH_ACTIVITY:
-		s.activity.SetLastPublishedRaftIndex(log.PublishActivityOp.RaftIndex)
+		s.activity.SetLastPublished(log.PublishActivityOp.RaftIndex, log.PublishActivityOp.ActivityEpoch)
+	case proto.Op_SET_SERVER_REMOVED:
+		s.metadata.markServerRemoved(log.SetServerRemovedOp.Server, log.SetServerRemovedOp.Removed)
+	case proto.Op_SCHEDULE_OPERATION:
+		s.applyScheduleOperation(log.ScheduleOperationOp.Operation, index)
+	case proto.Op_CANCEL_SCHEDULED_OPERATION:
+		s.applyCancelScheduledOperation(log.CancelScheduledOperationOp.Id, index)
+	case proto.Op_EXECUTE_SCHEDULED_OPERATION:
+		var (
+			id         = log.ExecuteScheduledOperationOp.Id
+			executedAt = log.ExecuteScheduledOperationOp.ExecutedAt
+			executedBy = log.ExecuteScheduledOperationOp.ExecutedBy
+		)
+		if err := s.applyExecuteScheduledOperation(id, executedAt, executedBy, index, recovered); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("Unknown Raft operation: %s", log.Op)
 	}
@@ -274,6 +383,9 @@ func (s *Server) finishedRecovery(epoch uint64) (int, int, error) {
 		// output.
 		s.logger.Silent(false)
 	}
+	if err := s.metadata.RemoveTombstonedPartitions(); err != nil {
+		return 0, 0, errors.Wrap(err, "failed to delete tombstoned partitions")
+	}
 	recoveredStreams := make(map[string]struct{})
 	for _, stream := range s.metadata.GetStreams() {
 		if stream.IsTombstoned() {
@@ -355,23 +467,7 @@ func (s *Server) Snapshot() (raft.FSMSnapshot, error) {
 		protoGroups  = make([]*proto.ConsumerGroup, len(groups))
 	)
 	for i, stream := range streams {
-		var (
-			partitions  = stream.GetPartitions()
-			protoStream = &proto.Stream{
-				Name:       stream.GetName(),
-				Subject:    stream.GetSubject(),
-				Config:     stream.GetConfig(),
-				Partitions: make([]*proto.Partition, len(partitions)),
-			}
-		)
-		creationTime := stream.GetCreationTime()
-		if !creationTime.IsZero() {
-			protoStream.CreationTimestamp = creationTime.UnixNano()
-		}
-		for j, partition := range partitions {
-			protoStream.Partitions[j] = partition.Partition
-		}
-		protoStreams[i] = protoStream
+		protoStreams[i] = stream.Proto()
 	}
 	for i, group := range groups {
 		coordinator, epoch := group.GetCoordinator()
@@ -390,9 +486,15 @@ func (s *Server) Snapshot() (raft.FSMSnapshot, error) {
 			Members:     protoMembers,
 		}
 	}
+	activityIndex, activityEpoch := s.activity.LastPublished()
 	return &fsmSnapshot{&proto.MetadataSnapshot{
-		Streams: protoStreams,
-		Groups:  protoGroups,
+		Streams:             protoStreams,
+		Groups:              protoGroups,
+		ActivityRaftIndex:   activityIndex,
+		ActivityEpoch:       activityEpoch,
+		RemovedServers:      s.metadata.GetRemovedServers(),
+		ScheduledOperations: s.metadata.GetScheduledOperations("", true),
+		ProducerQuotas:      s.metadata.quotas.runtimeQuotas(),
 	}}, nil
 }
 
@@ -436,6 +538,16 @@ func (s *Server) Restore(snapshot io.ReadCloser) error {
 			return err
 		}
 	}
+	for _, server := range snap.RemovedServers {
+		s.metadata.markServerRemoved(server, true)
+	}
+	for _, op := range snap.ScheduledOperations {
+		s.metadata.addScheduledOperation(op)
+	}
+	for _, quota := range snap.ProducerQuotas {
+		s.metadata.quotas.set(quota.ProducerID, quota.BytesPerSecond)
+	}
+	s.activity.SetLastPublished(snap.ActivityRaftIndex, snap.ActivityEpoch)
 	s.logger.Debugf("fsm: Finished restoring Raft state from snapshot, recovered %s",
 		english.Plural(len(snap.Streams), "stream", ""))
 	return nil
@@ -457,6 +569,38 @@ func (s *Server) applyCreateStream(protoStream *proto.Stream, recovered bool, ep
 		return errors.Wrap(err, "failed to add stream to metadata store")
 	}
 	s.logger.Debugf("fsm: Created stream %s", stream)
+	s.metadata.postStreamEvent(&proto.MetadataEvent{
+		Type:   proto.MetadataEventType_STREAM_CREATED,
+		Index:  epoch,
+		Stream: protoStream.Name,
+	}, stream)
+	if origin := protoStream.Origin; origin != nil {
+		s.logger.Infof("fsm: Stream %s created by %q on broker %s "+
+			"[application=%s, version=%s, partitions=%d, replicationFactor=%d]",
+			protoStream.Name, origin.CreatedBy, origin.Broker, origin.ApplicationName,
+			origin.ApplicationVersion, origin.Partitions, origin.ReplicationFactor)
+	}
+	return nil
+}
+
+// applyCreateStreams adds the given streams, i.e. a stream and its companions,
+// to the metadata store. If one of them can't be added, those already added
+// are removed again so that either all of the streams exist or none do.
+func (s *Server) applyCreateStreams(protoStreams []*proto.Stream, recovered bool, epoch uint64) error {
+	for i, protoStream := range protoStreams {
+		if err := s.applyCreateStream(protoStream, recovered, epoch); err != nil {
+			for _, created := range protoStreams[:i] {
+				stream := s.metadata.GetStream(created.Name)
+				if stream == nil {
+					continue
+				}
+				if err := s.metadata.RemoveStream(stream, recovered, epoch); err != nil {
+					s.logger.Errorf("fsm: Failed to remove stream %s: %v", created.Name, err)
+				}
+			}
+			return err
+		}
+	}
 	return nil
 }
 
@@ -464,9 +608,13 @@ func (s *Server) applyCreateStream(protoStream *proto.Stream, recovered bool, ep
 // partition epoch. If the partition epoch is greater than or equal to the
 // specified epoch, this does nothing.
 func (s *Server) applyShrinkISR(stream, replica string, partitionID int32, epoch uint64) error {
+	changed := s.getChangedPartition(stream, partitionID, epoch)
 	if err := s.metadata.RemoveFromISR(stream, replica, partitionID, epoch); err != nil {
 		return errors.Wrap(err, "failed to shrink ISR")
 	}
+	if changed != nil {
+		s.metadata.postPartitionEvent(proto.MetadataEventType_ISR_CHANGED, changed, epoch)
+	}
 
 	s.logger.Warnf("fsm: Removed replica %s from ISR for partition [stream=%s, partition=%d]",
 		replica, stream, partitionID)
@@ -477,9 +625,13 @@ func (s *Server) applyShrinkISR(stream, replica string, partitionID int32, epoch
 // partition epoch. If the partition epoch is greater than or equal to the
 // specified epoch, this does nothing.
 func (s *Server) applyExpandISR(stream, replica string, partitionID int32, epoch uint64) error {
+	changed := s.getChangedPartition(stream, partitionID, epoch)
 	if err := s.metadata.AddToISR(stream, replica, partitionID, epoch); err != nil {
 		return errors.Wrap(err, "failed to expand ISR")
 	}
+	if changed != nil {
+		s.metadata.postPartitionEvent(proto.MetadataEventType_ISR_CHANGED, changed, epoch)
+	}
 
 	s.logger.Infof("fsm: Added replica %s to ISR for partition [stream=%s, partition=%d]",
 		replica, stream, partitionID)
@@ -490,22 +642,67 @@ func (s *Server) applyExpandISR(stream, replica string, partitionID int32, epoch
 // and updates the partition epoch. If the partition epoch is greater than or
 // equal to the specified epoch, this does nothing.
 func (s *Server) applyChangePartitionLeader(stream, leader string, partitionID int32, epoch uint64) error {
+	changed := s.getChangedPartition(stream, partitionID, epoch)
 	if err := s.metadata.ChangeLeader(stream, leader, partitionID, epoch); err != nil {
 		return errors.Wrap(err, "failed to change partition leader")
 	}
+	if changed != nil {
+		s.metadata.postPartitionEvent(proto.MetadataEventType_LEADER_CHANGED, changed, epoch)
+	}
 
 	s.logger.Debugf("fsm: Changed leader for partition [stream=%s, partition=%d] to %s",
 		stream, partitionID, leader)
 	return nil
 }
 
+// applyReplaceReplica replaces the given replica of the partition with the
+// replacement and updates the partition epoch. If the partition epoch is
+// greater than or equal to the specified epoch, this does nothing.
+func (s *Server) applyReplaceReplica(stream, replica, replacement string, partitionID int32,
+	epoch uint64) error {
+
+	changed := s.getChangedPartition(stream, partitionID, epoch)
+	if err := s.metadata.ReplaceReplica(stream, replica, replacement, partitionID, epoch); err != nil {
+		return errors.Wrap(err, "failed to replace replica")
+	}
+	if changed != nil {
+		s.metadata.postPartitionEvent(proto.MetadataEventType_ISR_CHANGED, changed, epoch)
+	}
+
+	s.logger.Infof("fsm: Replaced replica %s with %s for partition [stream=%s, partition=%d]",
+		replica, replacement, stream, partitionID)
+	return nil
+}
+
+// getChangedPartition returns the given partition if an operation with the
+// given epoch changes it rather than being ignored as outdated, e.g. when it's
+// replayed. Otherwise, it returns nil.
+func (s *Server) getChangedPartition(stream string, partitionID int32, epoch uint64) *partition {
+	partition := s.metadata.GetPartition(stream, partitionID)
+	if partition == nil || partition.GetEpoch() >= epoch {
+		return nil
+	}
+	return partition
+}
+
 // applyDeleteStream deletes the given stream partition. If this operation is
 // being applied during recovery, this will only mark the stream with a
 // tombstone. Tombstoned streams will be deleted after the recovery process
-// completes.
-func (s *Server) applyDeleteStream(streamName string, recovered bool, epoch uint64) error {
+// completes. Deleting a stream which doesn't exist is a no-op during recovery.
+// If cascade is set, the stream's companions are deleted along with it.
+// Otherwise, they are unlinked from it. If the stream is being deleted because
+// its TTL expired, ttlDeletedAt is the time it was found expired.
+func (s *Server) applyDeleteStream(streamName string, cascade, recovered bool, ttlDeletedAt int64,
+	epoch uint64) error {
+
 	stream := s.metadata.GetStream(streamName)
 	if stream == nil {
+		if recovered {
+			// The stream was already deleted, e.g. the snapshot being
+			// replayed on top of was taken after this operation.
+			s.logger.Debugf("fsm: Stream %s already deleted", streamName)
+			return nil
+		}
 		return ErrStreamNotFound
 	}
 
@@ -513,16 +710,49 @@ func (s *Server) applyDeleteStream(streamName string, recovered bool, epoch uint
 	if err != nil {
 		return errors.Wrap(err, "failed to delete stream")
 	}
+	s.skew.removeStream(streamName)
+
+	if ttlDeletedAt != 0 {
+		s.logger.Infof("fsm: Deleted stream %s since its TTL expired at %s", streamName,
+			time.Unix(0, ttlDeletedAt).UTC().Format(time.RFC3339Nano))
+	} else {
+		s.logger.Debugf("fsm: Deleted stream %s", streamName)
+	}
+	s.metadata.postStreamEvent(&proto.MetadataEvent{
+		Type:         proto.MetadataEventType_STREAM_DELETED,
+		Index:        epoch,
+		Stream:       streamName,
+		TtlDeletedAt: ttlDeletedAt,
+	}, nil)
 
-	s.logger.Debugf("fsm: Deleted stream %s", streamName)
+	if primary := s.metadata.GetStream(stream.GetPrimary()); primary != nil {
+		primary.unlinkCompanion(streamName)
+	}
+	for _, name := range stream.GetCompanions() {
+		companion := s.metadata.GetStream(name)
+		if companion == nil || companion.IsTombstoned() || companion.GetPrimary() != streamName {
+			continue
+		}
+		if !cascade {
+			companion.unlinkCompanion(streamName)
+			continue
+		}
+		if err := s.applyDeleteStream(name, cascade, recovered, ttlDeletedAt, epoch); err != nil {
+			return errors.Wrapf(err, "failed to delete companion stream %s", name)
+		}
+	}
 	return nil
 }
 
 // applyPauseStream pauses the given stream partitions.
-func (s *Server) applyPauseStream(stream string, partitions []int32, resumeAll bool) error {
-	if err := s.metadata.PausePartitions(stream, partitions, resumeAll); err != nil {
+func (s *Server) applyPauseStream(stream string, partitions []int32, resumeAll bool, epoch uint64) error {
+	paused, err := s.metadata.PausePartitions(stream, partitions, resumeAll)
+	if err != nil {
 		return errors.Wrap(err, "failed to pause stream")
 	}
+	for _, partition := range paused {
+		s.metadata.postPartitionEvent(proto.MetadataEventType_PARTITION_PAUSED, partition, epoch)
+	}
 
 	s.logger.Debugf("fsm: Paused stream %s", stream)
 	return nil
@@ -544,13 +774,191 @@ func (s *Server) applyResumeStream(streamName string, partitionIDs []int32, reco
 }
 
 // applySetStreamReadonly changes the stream partitions readonly flag in the
-// metadata store.
-func (s *Server) applySetStreamReadonly(streamName string, partitions []int32, readonly bool) error {
-	if err := s.metadata.SetReadonly(streamName, partitions, readonly); err != nil {
+// metadata store. If a target offset is given, the partitions become readonly
+// once they reach it.
+func (s *Server) applySetStreamReadonly(streamName string, partitions []int32, readonly bool,
+	target *proto.NullableInt64) error {
+
+	if err := s.metadata.SetReadonly(streamName, partitions, readonly, target); err != nil {
 		return errors.Wrap(err, "failed to set stream readonly flag")
 	}
 
-	s.logger.Debugf("fsm: Set stream %s readonly flag as %v", streamName, readonly)
+	if readonly && target != nil {
+		s.logger.Debugf("fsm: Set stream %s readonly at offset %d", streamName, target.Value)
+	} else {
+		s.logger.Debugf("fsm: Set stream %s readonly flag as %v", streamName, readonly)
+	}
+	return nil
+}
+
+// applyUpdateStreamOwner changes the owner of the given stream. An error is
+// returned if the stream does not exist.
+func (s *Server) applyUpdateStreamOwner(streamName, owner string) error {
+	if err := s.metadata.SetStreamOwner(streamName, owner); err != nil {
+		return errors.Wrap(err, "failed to update stream owner")
+	}
+
+	s.logger.Infof("fsm: Changed owner of stream %s to %q", streamName, owner)
+	return nil
+}
+
+// applyUpdateStreamConfig changes the configuration of the given stream and
+// applies it to the stream's partitions on this server. Updating a stream
+// which doesn't exist is a no-op during recovery.
+func (s *Server) applyUpdateStreamConfig(streamName string, config *proto.StreamConfig, recovered bool) error {
+	err := s.metadata.SetStreamConfig(streamName, config)
+	if err == ErrStreamNotFound && recovered {
+		s.logger.Debugf("fsm: Stream %s already deleted", streamName)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to update stream config")
+	}
+
+	s.logger.Infof("fsm: Updated config of stream %s", streamName)
+	return nil
+}
+
+// applySetStreamTags replaces the tags of the given stream. Setting the tags
+// of a stream which doesn't exist is a no-op during recovery.
+func (s *Server) applySetStreamTags(streamName string, tags []*proto.StreamTag, recovered bool) error {
+	err := s.metadata.SetTags(streamName, tags)
+	if err == ErrStreamNotFound && recovered {
+		s.logger.Debugf("fsm: Stream %s already deleted", streamName)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to set stream tags")
+	}
+
+	s.logger.Infof("fsm: Set %d tags on stream %s", len(tags), streamName)
+	return nil
+}
+
+// applyDeletePartitionData deletes the messages of the given partition before
+// the given offset by advancing the log start offset of this server's replica.
+// Deleting the messages of a partition which doesn't exist is a no-op during
+// recovery.
+func (s *Server) applyDeletePartitionData(streamName string, id int32, offset int64, recovered bool) error {
+	partition := s.metadata.GetPartition(streamName, id)
+	if partition == nil {
+		if recovered {
+			s.logger.Debugf("fsm: Partition %d of stream %s already deleted", id, streamName)
+			return nil
+		}
+		return ErrPartitionNotFound
+	}
+	if err := partition.DeleteBefore(offset); err != nil {
+		return errors.Wrap(err, "failed to delete partition data")
+	}
+
+	s.logger.Infof("fsm: Deleted messages before offset %d of partition %s", offset, partition)
+	return nil
+}
+
+// applyDeletePartition deletes the given stream partition and its data.
+// Deleting a partition which doesn't exist is a no-op during recovery.
+func (s *Server) applyDeletePartition(streamName string, id int32, recovered bool, epoch uint64) error {
+	stream := s.metadata.GetStream(streamName)
+	if stream == nil || stream.GetPartition(id) == nil {
+		if recovered {
+			s.logger.Debugf("fsm: Partition %d of stream %s already deleted", id, streamName)
+			return nil
+		}
+		if stream == nil {
+			return ErrStreamNotFound
+		}
+		return ErrPartitionNotFound
+	}
+	partition := stream.GetPartition(id)
+
+	if err := s.metadata.RemovePartition(stream, id, recovered, epoch); err != nil {
+		return errors.Wrap(err, "failed to delete partition")
+	}
+	s.skew.removePartition(streamName, id)
+
+	s.logger.Infof("fsm: Deleted partition %s", partition)
+	s.metadata.postPartitionEvent(proto.MetadataEventType_PARTITION_DELETED, partition, epoch)
+	return nil
+}
+
+// applySetStreamACL replaces the access control list of the given stream.
+// Setting the ACL of a stream which doesn't exist is a no-op during recovery.
+func (s *Server) applySetStreamACL(streamName string, acl *proto.StreamACL, recovered bool) error {
+	err := s.metadata.SetACL(streamName, acl)
+	if err == ErrStreamNotFound && recovered {
+		s.logger.Debugf("fsm: Stream %s already deleted", streamName)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to set stream ACL")
+	}
+
+	s.logger.Infof("fsm: Set ACL on stream %s", streamName)
+	return nil
+}
+
+// applySetProducerQuota sets the byte rate quota of the given producer.
+func (s *Server) applySetProducerQuota(producerID string, bytesPerSecond int64) {
+	s.metadata.quotas.set(producerID, bytesPerSecond)
+	s.logger.Infof("fsm: Set quota of producer %s to %d bytes per second", producerID, bytesPerSecond)
+}
+
+// applyDeleteProducerQuota removes the quota of the given producer.
+func (s *Server) applyDeleteProducerQuota(producerID string) {
+	s.metadata.quotas.remove(producerID)
+	s.logger.Infof("fsm: Deleted quota of producer %s", producerID)
+}
+
+// applyReportPartitionSkew records whether the given stream is skewed and, if
+// so, its hot partition.
+func (s *Server) applyReportPartitionSkew(skew *proto.StreamSkew) {
+	s.skew.setReported(skew)
+
+	s.logger.Debugf("fsm: Reported partition skew of stream %s as %v", skew.Stream, skew.Skewed)
+}
+
+// applyRegisterProducer registers the exclusive producer on the given stream
+// using the Raft index of the operation as its epoch, fencing the previous
+// registration. Registering on a stream which doesn't exist is a no-op during
+// recovery.
+func (s *Server) applyRegisterProducer(streamName, producer string, expiresAt int64,
+	epoch uint64, recovered bool) error {
+
+	err := s.metadata.RegisterExclusiveProducer(streamName, producer, epoch, expiresAt)
+	if err == ErrStreamNotFound && recovered {
+		s.logger.Debugf("fsm: Stream %s already deleted", streamName)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to register exclusive producer")
+	}
+
+	s.logger.Debugf("fsm: Registered exclusive producer %q on stream %s with epoch %d",
+		producer, streamName, epoch)
+	return nil
+}
+
+// applyReleaseProducer releases the registration of the exclusive producer on
+// the given stream if it still has the given epoch. Releasing a registration
+// which has been replaced since is a no-op, as is releasing on a stream which
+// doesn't exist during recovery.
+func (s *Server) applyReleaseProducer(streamName, producer string, epoch uint64, recovered bool) error {
+	err := s.metadata.ReleaseExclusiveProducer(streamName, producer, epoch)
+	switch {
+	case err == ErrStreamNotFound && recovered:
+		s.logger.Debugf("fsm: Stream %s already deleted", streamName)
+		return nil
+	case err == ErrProducerFenced || err == ErrProducerNotRegistered:
+		s.logger.Debugf("fsm: Exclusive producer %q on stream %s not released: %v",
+			producer, streamName, err)
+		return nil
+	case err != nil:
+		return errors.Wrap(err, "failed to release exclusive producer")
+	}
+
+	s.logger.Debugf("fsm: Released exclusive producer %q on stream %s with epoch %d",
+		producer, streamName, epoch)
 	return nil
 }
 
@@ -610,3 +1018,96 @@ func (s *Server) applyChangeConsumerGroupCoordinator(groupID, coordinator string
 	s.logger.Debugf("fsm: Changed coordinator for consumer group %s to %s", groupID, coordinator)
 	return nil
 }
+
+// applyScheduleOperation adds the given scheduled operation to the metadata
+// store. Its ID is the index of the entry in the Raft log.
+func (s *Server) applyScheduleOperation(op *proto.ScheduledOperation, index uint64) {
+	op.Id = index
+	op.State = proto.ScheduledOperationState_SCHEDULED_OPERATION_PENDING
+	s.metadata.addScheduledOperation(op)
+
+	s.logger.Infof("fsm: Scheduled operation %d to %s stream %s at %s", op.Id, op.Type, op.Stream,
+		time.Unix(0, op.ExecuteAt).UTC().Format(time.RFC3339Nano))
+}
+
+// applyCancelScheduledOperation cancels the given scheduled operation. This
+// does nothing if it's no longer pending.
+func (s *Server) applyCancelScheduledOperation(id uint64, index uint64) {
+	canceled := s.metadata.finishScheduledOperation(id, func(op *proto.ScheduledOperation) {
+		op.State = proto.ScheduledOperationState_SCHEDULED_OPERATION_CANCELED
+		op.ExecutedIndex = index
+	})
+	if canceled == nil {
+		s.logger.Debugf("fsm: Scheduled operation %d already finished", id)
+		return
+	}
+	s.logger.Infof("fsm: Canceled scheduled operation %d", id)
+}
+
+// applyExecuteScheduledOperation applies the given pending scheduled operation
+// to its stream and marks it executed. If the stream or partitions no longer
+// exist, the operation is marked failed instead. This does nothing if the
+// operation is no longer pending, so it's executed at most once.
+func (s *Server) applyExecuteScheduledOperation(id uint64, executedAt int64, executedBy string,
+	index uint64, recovered bool) error {
+
+	op := s.metadata.getScheduledOperation(id)
+	if op == nil || op.State != proto.ScheduledOperationState_SCHEDULED_OPERATION_PENDING {
+		s.logger.Debugf("fsm: Scheduled operation %d already finished", id)
+		return nil
+	}
+
+	state := proto.ScheduledOperationState_SCHEDULED_OPERATION_SUCCEEDED
+	partitions, failure := s.metadata.scheduledOperationPartitions(op)
+	if failure != nil {
+		state = proto.ScheduledOperationState_SCHEDULED_OPERATION_FAILED
+	} else if err := s.runScheduledOperation(op, partitions, index, recovered); err != nil {
+		return errors.Wrapf(err, "failed to execute scheduled operation %d", id)
+	}
+
+	op = s.metadata.finishScheduledOperation(id, func(op *proto.ScheduledOperation) {
+		op.State = state
+		op.ExecutedIndex = index
+		op.ExecutedAt = executedAt
+		op.ExecutedBy = executedBy
+		if failure != nil {
+			op.Error = failure.Error()
+		} else {
+			op.Partitions = partitions
+		}
+	})
+
+	if failure != nil {
+		s.logger.Warnf("fsm: Failed to execute scheduled operation %d to %s stream %s: %v",
+			id, op.Type, op.Stream, failure)
+	} else {
+		s.logger.Infof("fsm: Executed scheduled operation %d to %s stream %s on %s", id, op.Type,
+			op.Stream, executedBy)
+	}
+	s.metadata.postStreamEvent(&proto.MetadataEvent{
+		Type:               proto.MetadataEventType_SCHEDULED_OPERATION_EXECUTED,
+		Index:              index,
+		Stream:             op.Stream,
+		ScheduledOperation: op,
+	}, nil)
+	return nil
+}
+
+// runScheduledOperation applies the stream operation of the given scheduled
+// operation to the given partitions of its stream.
+func (s *Server) runScheduledOperation(op *proto.ScheduledOperation, partitions []int32, index uint64,
+	recovered bool) error {
+
+	switch op.Type {
+	case proto.ScheduledOperationType_SCHEDULED_PAUSE_STREAM:
+		return s.applyPauseStream(op.Stream, partitions, false, index)
+	case proto.ScheduledOperationType_SCHEDULED_RESUME_STREAM:
+		return s.applyResumeStream(op.Stream, partitions, recovered)
+	case proto.ScheduledOperationType_SCHEDULED_SET_STREAM_READONLY:
+		return s.applySetStreamReadonly(op.Stream, partitions, op.Readonly, nil)
+	case proto.ScheduledOperationType_SCHEDULED_DELETE_STREAM:
+		return s.applyDeleteStream(op.Stream, false, recovered, 0, index)
+	default:
+		return fmt.Errorf("unknown scheduled operation type %s", op.Type)
+	}
+}
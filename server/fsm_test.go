@@ -9,6 +9,7 @@ import (
 	"time"
 
 	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -119,3 +120,140 @@ func TestTombstoneStreamsOnRestart(t *testing.T) {
 	_, err = os.Stat(filepath.Join(s1Config.DataDir, "streams", "foo"))
 	require.True(t, os.IsNotExist(err))
 }
+
+// copyDir recursively copies the src directory to dst.
+func copyDir(t *testing.T, src, dst string) {
+	err := filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode())
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, data, info.Mode())
+	})
+	require.NoError(t, err)
+}
+
+func waitForNewestOffset(t *testing.T, timeout time.Duration, name string, partitionID int32, offset int64, servers ...*Server) {
+	deadline := time.Now().Add(timeout)
+LOOP:
+	for time.Now().Before(deadline) {
+		for _, s := range servers {
+			partition := s.metadata.GetPartition(name, partitionID)
+			if partition == nil || partition.log.NewestOffset() != offset {
+				time.Sleep(15 * time.Millisecond)
+				continue LOOP
+			}
+		}
+		return
+	}
+	stackFatalf(t, "Cluster did not reach newest offset %d for [name=%s, partition=%d]", offset, name, partitionID)
+}
+
+// Ensure a broker restored from a backup of its data directory taken before
+// the cluster moved on recovers cleanly and re-replicates the data it's
+// missing.
+func TestRestoreFromBackup(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	configs := make([]*Config, 3)
+	servers := make([]*Server, 3)
+	for i, id := range []string{"a", "b", "c"} {
+		configs[i] = getTestConfig(id, i == 0, 5050+i)
+		configs[i].EmbeddedNATS = false
+		configs[i].Clustering.ReplicaMaxLagTime = time.Second
+		configs[i].Clustering.ReplicaFetchTimeout = 100 * time.Millisecond
+		configs[i].Clustering.ReplicaMaxIdleWait = 500 * time.Millisecond
+		configs[i].Clustering.ReplicaMaxLeaderTimeout = time.Second
+		servers[i] = runServerWithConfig(t, configs[i])
+		defer func(i int) { servers[i].Stop() }(i)
+	}
+
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	client, err := lift.Connect([]string{"localhost:5050", "localhost:5051", "localhost:5052"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.CreateStream(context.Background(), "foo", "foo", lift.ReplicationFactor(3)))
+	waitForISR(t, 10*time.Second, "foo", 0, 3, servers...)
+	for i := 0; i < 5; i++ {
+		_, err = client.Publish(context.Background(), "foo", []byte(strconv.Itoa(i)), lift.AckPolicyAll())
+		require.NoError(t, err)
+	}
+
+	// Back up the data directory of a follower which isn't the metadata
+	// leader.
+	metadataLeader := getMetadataLeader(t, 10*time.Second, servers...)
+	partitionLeader := getPartitionLeader(t, 10*time.Second, "foo", 0, servers...)
+	restored := -1
+	for i, s := range servers {
+		if s != metadataLeader && s != partitionLeader {
+			restored = i
+			break
+		}
+	}
+	require.NotEqual(t, -1, restored)
+	servers[restored].Stop()
+	backupDir := configs[restored].DataDir + "-backup"
+	copyDir(t, configs[restored].DataDir, backupDir)
+	servers[restored] = runServerWithConfig(t, configs[restored])
+	waitForISR(t, 10*time.Second, "foo", 0, 3, servers...)
+
+	// Roll the cluster forward by publishing more messages and deleting and
+	// recreating a stream.
+	for i := 5; i < 10; i++ {
+		_, err = client.Publish(context.Background(), "foo", []byte(strconv.Itoa(i)), lift.AckPolicyAll())
+		require.NoError(t, err)
+	}
+	require.NoError(t, client.CreateStream(context.Background(), "bar", "bar", lift.ReplicationFactor(3)))
+	_, err = client.Publish(context.Background(), "bar", []byte("old"), lift.AckPolicyAll())
+	require.NoError(t, err)
+	require.NoError(t, client.DeleteStream(context.Background(), "bar"))
+	require.NoError(t, client.CreateStream(context.Background(), "bar", "bar", lift.ReplicationFactor(3)))
+	waitForISR(t, 10*time.Second, "bar", 0, 3, servers...)
+	_, err = client.Publish(context.Background(), "bar", []byte("new"), lift.AckPolicyAll())
+	require.NoError(t, err)
+
+	// Restore the backup once the remaining brokers have taken over any
+	// partitions the restored broker was leading.
+	servers[restored].Stop()
+	var others []*Server
+	for i, s := range servers {
+		if i != restored {
+			others = append(others, s)
+		}
+	}
+	getPartitionLeader(t, 10*time.Second, "bar", 0, others...)
+	require.NoError(t, os.RemoveAll(configs[restored].DataDir))
+	require.NoError(t, os.Rename(backupDir, configs[restored].DataDir))
+	servers[restored] = runServerWithConfig(t, configs[restored])
+
+	// The restored broker should rejoin the ISRs and catch up.
+	waitForISR(t, 10*time.Second, "foo", 0, 3, servers...)
+	waitForISR(t, 10*time.Second, "bar", 0, 3, servers...)
+	waitForNewestOffset(t, 10*time.Second, "foo", 0, 9, servers...)
+	waitForNewestOffset(t, 10*time.Second, "bar", 0, 0, servers...)
+
+	// Only the recreated stream's message should be present.
+	partition := servers[restored].metadata.GetPartition("bar", 0)
+	reader, err := partition.log.NewReader(0, true)
+	require.NoError(t, err)
+	msg, _, _, _, err := reader.ReadMessage(context.Background(), make([]byte, 28))
+	require.NoError(t, err)
+	require.Equal(t, []byte("new"), msg.Value())
+}
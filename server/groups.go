@@ -20,7 +20,9 @@ type partitionAssignments map[string][]int32
 
 type groupMemberExpiredHandler func(groupID, consumerID string) error
 
-type getStreamPartitions func(stream string) int32
+// getStreamPartitions returns the IDs of a stream's partitions in ascending
+// order.
+type getStreamPartitions func(stream string) []int32
 
 // consumer represents a member of a consumer group.
 type consumer struct {
@@ -321,6 +323,25 @@ func (c *consumerGroup) StreamDeleted(stream string, epoch uint64) error {
 	return nil
 }
 
+// StreamPartitionDeleted is called whenever a partition of a stream is
+// deleted. The stream's partitions are reassigned to its subscribers.
+func (c *consumerGroup) StreamPartitionDeleted(stream string, epoch uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if epoch < c.epoch {
+		return fmt.Errorf("proposed group epoch %d is less than current epoch %d",
+			epoch, c.epoch)
+	}
+
+	if _, ok := c.subscribers[stream]; !ok {
+		return nil
+	}
+	c.balanceAssignmentsForStream(stream)
+	c.epoch = epoch
+	c.debugLogAssignments()
+	return nil
+}
+
 // GetAssignments returns the partition assignments for the given consumer
 // along with the group epoch. It returns an error if the consumer is not
 // a member of the group, if this server is not the group coordinator, or the
@@ -399,12 +420,16 @@ func (c *consumerGroup) consumerExpired(consumerID string) func() {
 		if err := c.memberExpiredHandler(c.id, consumerID); err != nil {
 			c.logger.Errorf("Failed to remove consumer %s from consumer group %s: %v",
 				consumerID, c.id, err.Error())
-			// Reset the timer so we can try again later.
-			timer := c.startMemberTimer(consumerID)
+			// Reset the timer so we can try again later unless the consumer
+			// has since left the group or coordination moved to another
+			// server, in which case there is nothing left to retry.
 			c.mu.Lock()
-			consumer := c.members[consumerID]
-			consumer.timer = timer
-			c.mu.Unlock()
+			defer c.mu.Unlock()
+			consumer, ok := c.members[consumerID]
+			if !ok || c.coordinator != c.serverID {
+				return
+			}
+			consumer.timer = c.startMemberTimer(consumerID)
 		}
 	}
 }
@@ -474,7 +499,7 @@ func (c *consumerGroup) balanceAssignmentsForStream(streamName string) {
 
 	// Assign each partition to the consumer with the least amount of
 	// assignments.
-	for partition := int32(0); partition < c.getStreamPartitions(streamName); partition++ {
+	for _, partition := range c.getStreamPartitions(streamName) {
 		minConsumer := subscribers.Peek()
 		c.assignPartition(streamName, partition, minConsumer)
 	}
@@ -4,6 +4,7 @@ import (
 	"container/heap"
 	"context"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -656,8 +657,8 @@ func TestConsumerGroupConsumerTimeout(t *testing.T) {
 		return nil
 	}
 
-	getPartitions := func(stream string) int32 {
-		return 1
+	getPartitions := func(stream string) []int32 {
+		return []int32{0}
 	}
 
 	group := newConsumerGroup("a", time.Millisecond, protoGroup, false,
@@ -674,6 +675,63 @@ func TestConsumerGroupConsumerTimeout(t *testing.T) {
 	}
 }
 
+// Ensure a consumer's liveness timer is not restarted after its removal fails
+// if the consumer has since left the group or this server is no longer the
+// group coordinator.
+func TestConsumerGroupConsumerTimeoutNoRetry(t *testing.T) {
+	getPartitions := func(stream string) []int32 {
+		return []int32{0}
+	}
+
+	var (
+		group  *consumerGroup
+		called int32
+		ch     = make(chan struct{}, 1)
+	)
+	handler := func(groupID, consumerID string) error {
+		atomic.AddInt32(&called, 1)
+		_, err := group.RemoveMember(consumerID, 2)
+		require.NoError(t, err)
+		ch <- struct{}{}
+		return errors.New("error")
+	}
+	group = newConsumerGroup("a", time.Millisecond, &proto.ConsumerGroup{Id: "my-group", Coordinator: "a"},
+		false, noopLogger(), handler, getPartitions)
+	defer group.Close()
+
+	require.NoError(t, group.AddMember("cons1", []string{"foo"}, 1))
+	select {
+	case <-ch:
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler not called")
+	}
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, int32(1), atomic.LoadInt32(&called))
+
+	// Now move coordination to another server when the consumer expires.
+	var moved *consumerGroup
+	atomic.StoreInt32(&called, 0)
+	handler = func(groupID, consumerID string) error {
+		atomic.AddInt32(&called, 1)
+		require.NoError(t, moved.SetCoordinator("b", 3))
+		ch <- struct{}{}
+		return errors.New("error")
+	}
+	moved = newConsumerGroup("a", time.Millisecond, &proto.ConsumerGroup{Id: "my-group", Coordinator: "a"},
+		false, noopLogger(), handler, getPartitions)
+	defer moved.Close()
+
+	require.NoError(t, moved.AddMember("cons1", []string{"foo"}, 1))
+	select {
+	case <-ch:
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler not called")
+	}
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, int32(1), atomic.LoadInt32(&called))
+	require.True(t, moved.IsMember("cons1"))
+}
+
 // Ensure when StartRecovered is called on a group that is not being recovered
 // it returns false. When the group is being recovered and it's the
 // coordinator, member timers are started and true is returned.
@@ -701,8 +759,8 @@ func TestConsumerGroupStartRecovered(t *testing.T) {
 		return nil
 	}
 
-	getPartitions := func(stream string) int32 {
-		return 1
+	getPartitions := func(stream string) []int32 {
+		return []int32{0}
 	}
 
 	group := newConsumerGroup("a", time.Minute, protoGroup, false,
@@ -757,8 +815,8 @@ func TestConsumerGroupSetCoordinator(t *testing.T) {
 		return nil
 	}
 
-	getPartitions := func(stream string) int32 {
-		return 1
+	getPartitions := func(stream string) []int32 {
+		return []int32{0}
 	}
 
 	group := newConsumerGroup("a", time.Millisecond, protoGroup, false,
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// subscriptionHeartbeatsMetadataKey is the Subscribe request metadata key
+	// subscribers set to "true" to receive heartbeats. Heartbeats are opt-in
+	// since clients which don't know about them would hand them to
+	// applications as messages.
+	subscriptionHeartbeatsMetadataKey = "liftbridge-subscription-heartbeats"
+
+	// messageTypeHeader is the header reserved to tell messages the server
+	// sends to subscribers apart from messages read from the partition. It's
+	// only set on the former.
+	messageTypeHeader = "liftbridge-message-type"
+)
+
+// messageType is the value of the messageTypeHeader.
+type messageType string
+
+// messageTypeHeartbeat marks heartbeats sent to subscribers.
+const messageTypeHeartbeat messageType = "heartbeat"
+
+// subscriptionHeartbeatsFromContext indicates if the incoming gRPC metadata
+// asks for subscription heartbeats.
+func subscriptionHeartbeatsFromContext(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	values := md.Get(subscriptionHeartbeatsMetadataKey)
+	switch {
+	case len(values) == 0:
+		return false, nil
+	case len(values) > 1:
+		return false, fmt.Errorf("only one %s can be set", subscriptionHeartbeatsMetadataKey)
+	}
+	switch values[0] {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid %s %q", subscriptionHeartbeatsMetadataKey, values[0])
+}
+
+// serveSubscription sends the subscription's messages, and heartbeats if
+// requested, to the subscriber until the subscription ends. Sends happen on
+// a separate goroutine so that, if one blocks for longer than the heartbeat
+// timeout because the subscriber stopped receiving, the subscription can be
+// given up on. Returning ends the RPC, which unblocks the send.
+func (a *apiServer) serveSubscription(out client.API_SubscribeServer, sub *subscription,
+	req *client.SubscribeRequest, heartbeats bool) error {
+
+	var (
+		sendStart int64 // Unix nanoseconds the current send started, 0 if none
+		errC      = make(chan error, 1)
+		timeout   = a.config.SubscriptionHeartbeatTimeout
+		checkC    <-chan time.Time
+	)
+	go func() {
+		errC <- a.sendSubscription(out, sub, req, heartbeats, &sendStart)
+	}()
+
+	if timeout > 0 {
+		ticker := time.NewTicker(timeout / 2)
+		defer ticker.Stop()
+		checkC = ticker.C
+	}
+
+	for {
+		select {
+		case err := <-errC:
+			return err
+		case now := <-checkC:
+			start := atomic.LoadInt64(&sendStart)
+			if start == 0 || now.Sub(time.Unix(0, start)) < timeout {
+				continue
+			}
+			a.logger.Warnf("api: Closing subscription to partition "+
+				"[stream=%s, partition=%d]: send blocked for more than %s",
+				req.Stream, req.Partition, timeout)
+			return status.Error(codes.DeadlineExceeded, "Subscriber stopped receiving messages")
+		}
+	}
+}
+
+// sendSubscription sends the subscription's messages to the subscriber, along
+// with a heartbeat every heartbeat interval if heartbeats is set, until the
+// subscription ends. The start time of each send is stored in sendStart.
+func (a *apiServer) sendSubscription(out client.API_SubscribeServer, sub *subscription,
+	req *client.SubscribeRequest, heartbeats bool, sendStart *int64) error {
+
+	var (
+		msgC       = sub.Messages()
+		errC       = sub.Errors()
+		closedC    = sub.Closed()
+		heartbeatC <-chan time.Time
+	)
+	if interval := a.config.SubscriptionHeartbeatInterval; heartbeats && interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		heartbeatC = ticker.C
+	}
+
+	send := func(m *client.Message) error {
+		atomic.StoreInt64(sendStart, time.Now().UnixNano())
+		defer atomic.StoreInt64(sendStart, 0)
+		return out.Send(m)
+	}
+
+	for {
+		select {
+		case <-out.Context().Done():
+			return nil
+		case <-closedC:
+			return nil
+		case m := <-msgC:
+			if err := send(m); err != nil {
+				return err
+			}
+			atomic.StoreInt64(&sub.delivered, m.Offset)
+		case <-heartbeatC:
+			if err := send(newHeartbeat(sub, req)); err != nil {
+				return err
+			}
+		case err := <-errC:
+			return err.Err()
+		}
+	}
+}
+
+// newHeartbeat returns a heartbeat to send to the given subscription. Its
+// offset is that of the last message sent or filtered on the subscription so
+// that clients tracking the offset to resume from aren't thrown off.
+func newHeartbeat(sub *subscription, req *client.SubscribeRequest) *client.Message {
+	return &client.Message{
+		Offset:    atomic.LoadInt64(&sub.lastOffset),
+		Timestamp: time.Now().UnixNano(),
+		Stream:    req.Stream,
+		Partition: req.Partition,
+		Headers:   map[string][]byte{messageTypeHeader: []byte(messageTypeHeartbeat)},
+	}
+}
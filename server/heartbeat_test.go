@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// heartbeatContext returns a context asking for subscription heartbeats.
+func heartbeatContext(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, subscriptionHeartbeatsMetadataKey, "true")
+}
+
+// Ensure subscribers asking for heartbeats receive them and they're counted as
+// active subscriptions.
+func TestSubscriptionHeartbeat(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.SubscriptionHeartbeatInterval = 50 * time.Millisecond
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	apiClient := client.NewAPIClient(conn)
+
+	_, err = apiClient.CreateStream(context.Background(), &client.CreateStreamRequest{
+		Name:    "foo",
+		Subject: "foo",
+	})
+	require.NoError(t, err)
+	waitForPartition(t, 10*time.Second, "foo", 0, s1)
+
+	ctx, cancel := context.WithCancel(heartbeatContext(context.Background()))
+	defer cancel()
+	stream, err := apiClient.Subscribe(ctx, &client.SubscribeRequest{Stream: "foo"})
+	require.NoError(t, err)
+
+	// The first message signals the subscription was created.
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	msg, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, []byte(messageTypeHeartbeat), msg.Headers[messageTypeHeader])
+	require.Equal(t, "foo", msg.Stream)
+	require.Equal(t, int64(-1), msg.Offset)
+
+	resp, err := proto.NewAdminAPIClient(conn).FetchBrokerStats(context.Background(),
+		&proto.FetchBrokerStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), resp.ActiveSubscriptions)
+
+	cancel()
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&s1.activeSubscriptions) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// Ensure subscriptions whose subscriber stops receiving without closing them
+// are closed once sending to them blocks for longer than the heartbeat
+// timeout.
+func TestSubscriptionHeartbeatTimeout(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.SubscriptionHeartbeatInterval = 100 * time.Millisecond
+	s1Config.SubscriptionHeartbeatTimeout = 200 * time.Millisecond
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	c, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer c.Close()
+	require.NoError(t, c.CreateStream(context.Background(), "foo", "foo"))
+
+	// Fix the flow control window so that sends block once the subscriber
+	// stops receiving rather than the window growing.
+	const window = 64 * 1024
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure(),
+		grpc.WithInitialWindowSize(window), grpc.WithInitialConnWindowSize(window))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(heartbeatContext(context.Background()))
+	defer cancel()
+	stream, err := client.NewAPIClient(conn).Subscribe(ctx, &client.SubscribeRequest{Stream: "foo"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), atomic.LoadInt64(&s1.activeSubscriptions))
+
+	// Stop receiving and publish more than the window.
+	value := make([]byte, 16*1024)
+	for i := 0; i < 4*window/len(value); i++ {
+		_, err := c.Publish(context.Background(), "foo", value, lift.AckPolicyLeader())
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&s1.activeSubscriptions) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
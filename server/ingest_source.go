@@ -0,0 +1,101 @@
+package server
+
+import (
+	"sync/atomic"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// ingestSourceHeader is the message header the partition leader stamps
+	// on every message it appends with how the message entered the
+	// partition. Followers keep the leader's tag, so subscribers see the
+	// same provenance whichever replica they read from.
+	ingestSourceHeader = "liftbridge-ingest-source"
+
+	// ingestSourceAPI tags messages published through the Liftbridge API.
+	ingestSourceAPI = "api"
+
+	// ingestSourceNATS tags messages published directly to the stream's
+	// NATS subject.
+	ingestSourceNATS = "nats"
+
+	// ingestSourceMirror tags messages copied from the source partition of a
+	// mirror stream.
+	ingestSourceMirror = "mirror"
+)
+
+// ingestCounters counts the messages appended to a partition's log by ingest
+// source. The fields are accessed atomically.
+type ingestCounters struct {
+	api         int64
+	nats        int64
+	mirror      int64
+	replication int64 // Messages replicated from the leader
+}
+
+// mark counts a message appended with the given ingest source. Sources the
+// server doesn't know of, such as those of messages written by a newer
+// leader, are only counted in the replication total.
+func (c *ingestCounters) mark(source string) {
+	switch source {
+	case ingestSourceAPI:
+		atomic.AddInt64(&c.api, 1)
+	case ingestSourceNATS:
+		atomic.AddInt64(&c.nats, 1)
+	case ingestSourceMirror:
+		atomic.AddInt64(&c.mirror, 1)
+	}
+}
+
+// snapshot returns the current counts.
+func (c *ingestCounters) snapshot() *proto.IngestSources {
+	return &proto.IngestSources{
+		Api:         atomic.LoadInt64(&c.api),
+		Nats:        atomic.LoadInt64(&c.nats),
+		Mirror:      atomic.LoadInt64(&c.mirror),
+		Replication: atomic.LoadInt64(&c.replication),
+	}
+}
+
+// stampIngestSources tags each message in the batch the leader is about to
+// append with its ingest source. Messages published through the API are
+// stamped with an ingest ID, so anything else received on the subject was
+// published directly to NATS. Any tag set by the publisher is overwritten.
+func stampIngestSources(batch []*commitlog.Message) {
+	for _, msg := range batch {
+		source := ingestSourceNATS
+		if _, ok := msg.Headers[ingestIDHeader]; ok {
+			source = ingestSourceAPI
+		}
+		msg.Headers[ingestSourceHeader] = []byte(source)
+	}
+}
+
+// markIngestSources counts the messages of a batch the leader appended by the
+// ingest source they were stamped with.
+func (p *partition) markIngestSources(batch []*commitlog.Message) {
+	for _, msg := range batch {
+		p.metrics.ingest.mark(string(msg.Headers[ingestSourceHeader]))
+	}
+}
+
+// markReplicatedIngestSources counts the messages of a message set the
+// follower replicated from the leader by the ingest source the leader stamped
+// them with, as well as in the replication total. Messages encrypted at rest
+// by the leader's log are only counted in the total.
+func (p *partition) markReplicatedIngestSources(ms []byte) {
+	msgs := commitlog.MessageSetMessages(ms)
+	for _, msg := range msgs {
+		if !msg.Encrypted() {
+			p.metrics.ingest.mark(string(msg.Headers()[ingestSourceHeader]))
+		}
+	}
+	atomic.AddInt64(&p.metrics.ingest.replication, int64(len(msgs)))
+}
+
+// ingestSources returns the partition's ingest source counts.
+func (p *partition) ingestSources() *proto.IngestSources {
+	return p.metrics.ingest.snapshot()
+}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure the leader tags messages with how they entered the partition and
+// counts them by source, and that followers keep the leader's tags while
+// counting them separately.
+func TestIngestSources(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	// Configure servers.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.EmbeddedNATS = false
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+	servers := []*Server{s1, s2}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	lc, err := lift.Connect([]string{"localhost:5050", "localhost:5051"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	name := "foo"
+	require.NoError(t, lc.CreateStream(context.Background(), name, name, lift.ReplicationFactor(2)))
+	waitForPartition(t, 5*time.Second, name, 0, servers...)
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+	follower := s1
+	if follower == leader {
+		follower = s2
+	}
+
+	// Publish two messages through the API.
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		_, err := lc.Publish(ctx, name, []byte("api"), lift.AckPolicyAll())
+		cancel()
+		require.NoError(t, err)
+	}
+
+	// Publish three messages directly to the subject, one of them claiming
+	// to come from the API.
+	nc, err := nats.Connect(nats.DefaultURL)
+	require.NoError(t, err)
+	defer nc.Close()
+	spoofed, err := proto.MarshalPublish(&client.Message{
+		Value:   []byte("nats"),
+		Headers: map[string][]byte{ingestSourceHeader: []byte(ingestSourceAPI)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, nc.Publish(name, []byte("nats")))
+	require.NoError(t, nc.Publish(name, spoofed))
+	require.NoError(t, nc.Publish(name, []byte("nats")))
+	require.NoError(t, nc.Flush())
+	waitForHW(t, 10*time.Second, name, 0, 4, servers...)
+
+	// Both replicas have the leader's tags.
+	expected := []string{ingestSourceAPI, ingestSourceAPI, ingestSourceNATS, ingestSourceNATS, ingestSourceNATS}
+	for _, s := range servers {
+		reader, err := s.metadata.GetPartition(name, 0).log.NewReader(0, true)
+		require.NoError(t, err)
+		headers := make([]byte, 28)
+		for i, source := range expected {
+			msg, offset, _, _, err := reader.ReadMessage(context.Background(), headers)
+			require.NoError(t, err)
+			require.Equal(t, int64(i), offset)
+			require.Equal(t, source, string(msg.Headers()[ingestSourceHeader]))
+		}
+	}
+
+	// The follower also counts the messages as replicated.
+	require.Equal(t, &proto.IngestSources{Api: 2, Nats: 3},
+		leader.metadata.GetPartition(name, 0).Stats().IngestSources)
+	require.Eventually(t, func() bool {
+		return follower.metadata.GetPartition(name, 0).Stats().IngestSources.Replication == 5
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, &proto.IngestSources{Api: 2, Nats: 3, Replication: 5},
+		follower.metadata.GetPartition(name, 0).Stats().IngestSources)
+
+	// The counts are included in DescribeStreams.
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", follower.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	resp, err := proto.NewAdminAPIClient(conn).DescribeStreams(context.Background(),
+		&proto.DescribeStreamsRequest{Streams: []string{name}})
+	require.NoError(t, err)
+	require.Equal(t, []*proto.PartitionIngestSources{{
+		Stream:    name,
+		Partition: 0,
+		Sources:   &proto.IngestSources{Api: 2, Nats: 3, Replication: 5},
+	}}, resp.IngestSources)
+}
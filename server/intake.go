@@ -0,0 +1,216 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nuid"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+)
+
+const (
+	// ingestIDHeader is the message header the server stamps on messages
+	// published through the API with an ID unique to each publish. A
+	// partition leader receiving the same ID more than once means its subject
+	// is being consumed by more than one NATS subscription.
+	ingestIDHeader = "liftbridge-ingest-id"
+
+	// ingestIDWindow is the number of most recent ingest IDs a partition
+	// leader remembers to detect duplicate intake.
+	ingestIDWindow = 1024
+)
+
+// intakeSubscription is the NATS subscription a partition leader receives
+// published messages on.
+type intakeSubscription struct {
+	owner   *partition
+	sub     *nats.Subscription
+	nc      *nats.Conn
+	subject string
+	group   string
+	handler nats.MsgHandler
+}
+
+// intakeRegistry tracks the intake subscription of each partition led by the
+// server. A partition must only ever have one since each would append every
+// message published to the partition's subject. Partitions are replaced when
+// they are resumed, so the subscriptions are tracked by stream and partition
+// ID rather than on the partition itself. Creating an intake subscription
+// always tears down the existing one for the partition first.
+type intakeRegistry struct {
+	mu   sync.Mutex
+	subs map[string]*intakeSubscription
+	srv  *Server
+}
+
+func newIntakeRegistry(srv *Server) *intakeRegistry {
+	return &intakeRegistry{
+		subs: make(map[string]*intakeSubscription),
+		srv:  srv,
+	}
+}
+
+// partitionKey returns a key identifying the partition with the given ID in
+// the given stream.
+func partitionKey(stream string, partition int32) string {
+	return fmt.Sprintf("%s:%d", stream, partition)
+}
+
+// subscribe creates the given partition's intake subscription on the given
+// NATS subject and queue group. If the partition already has one, it's torn
+// down first.
+func (r *intakeRegistry) subscribe(nc *nats.Conn, p *partition, subject, group string,
+	handler nats.MsgHandler) error {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := partitionKey(p.Stream, p.Id)
+	if existing, ok := r.subs[key]; ok {
+		r.srv.logger.Errorf("Partition %s already has an intake subscription, replacing it", p)
+		if err := existing.sub.Unsubscribe(); err != nil && err != nats.ErrConnectionClosed {
+			return err
+		}
+		delete(r.subs, key)
+	}
+
+	sub, err := nc.QueueSubscribe(subject, group, handler)
+	if err != nil {
+		return err
+	}
+	sub.SetPendingLimits(-1, -1)
+	r.subs[key] = &intakeSubscription{
+		owner:   p,
+		sub:     sub,
+		nc:      nc,
+		subject: subject,
+		group:   group,
+		handler: handler,
+	}
+	return nil
+}
+
+// unsubscribe tears down the given partition's intake subscription. This is a
+// no-op if the subscription was replaced by another partition's.
+func (r *intakeRegistry) unsubscribe(p *partition) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := partitionKey(p.Stream, p.Id)
+	existing, ok := r.subs[key]
+	if !ok || existing.owner != p {
+		return nil
+	}
+	delete(r.subs, key)
+	return existing.sub.Unsubscribe()
+}
+
+// repair recreates the given partition's intake subscription after duplicate
+// intake was detected. This is a no-op if the subscription was replaced by
+// another partition's.
+func (r *intakeRegistry) repair(p *partition) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.subs[partitionKey(p.Stream, p.Id)]
+	if !ok || existing.owner != p {
+		return nil
+	}
+	if err := existing.sub.Unsubscribe(); err != nil {
+		return err
+	}
+	sub, err := existing.nc.QueueSubscribe(existing.subject, existing.group, existing.handler)
+	if err != nil {
+		return err
+	}
+	sub.SetPendingLimits(-1, -1)
+	existing.sub = sub
+	return existing.nc.Flush()
+}
+
+// count returns the number of intake subscriptions. This is for unit testing
+// purposes only.
+func (r *intakeRegistry) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.subs)
+}
+
+// ingestIDs remembers the most recent ingest IDs received by a partition
+// leader.
+type ingestIDs struct {
+	ids      map[string]struct{}
+	ring     []string
+	next     int
+	repaired bool // Whether the intake subscription was repaired
+}
+
+func newIngestIDs(size int) *ingestIDs {
+	return &ingestIDs{
+		ids:  make(map[string]struct{}, size),
+		ring: make([]string, size),
+	}
+}
+
+// add records the given ingest ID and returns false if it was already
+// recorded.
+func (i *ingestIDs) add(id string) bool {
+	if _, ok := i.ids[id]; ok {
+		return false
+	}
+	if evicted := i.ring[i.next]; evicted != "" {
+		delete(i.ids, evicted)
+	}
+	i.ring[i.next] = id
+	i.ids[id] = struct{}{}
+	i.next = (i.next + 1) % len(i.ring)
+	return true
+}
+
+// withIngestID returns a copy of the given message headers with a new ingest
+// ID set.
+func withIngestID(headers map[string][]byte) map[string][]byte {
+	stamped := make(map[string][]byte, len(headers)+1)
+	for name, value := range headers {
+		stamped[name] = value
+	}
+	stamped[ingestIDHeader] = []byte(nuid.Next())
+	return stamped
+}
+
+// dropDuplicateIntake removes messages from the batch whose ingest ID was
+// already received, which happens if the partition's subject is consumed by
+// more than one NATS subscription. Since this should never happen, the
+// duplicates are counted and the partition's intake subscription is recreated
+// the first time they are detected.
+func (p *partition) dropDuplicateIntake(received *ingestIDs, batch []*commitlog.Message) []*commitlog.Message {
+	var (
+		deduped    = batch[:0]
+		duplicates int64
+	)
+	for _, msg := range batch {
+		id, ok := msg.Headers[ingestIDHeader]
+		if ok && !received.add(string(id)) {
+			duplicates++
+			continue
+		}
+		deduped = append(deduped, msg)
+	}
+	if duplicates == 0 {
+		return deduped
+	}
+
+	atomic.AddInt64(&p.duplicatesDropped, duplicates)
+	p.srv.logger.Errorf("Dropped %d messages received more than once on partition %s, "+
+		"its subject may have multiple intake subscriptions", duplicates, p)
+	if !received.repaired {
+		received.repaired = true
+		if err := p.srv.intake.repair(p); err != nil {
+			p.srv.logger.Errorf("Failed to repair intake subscription for partition %s: %v", p, err)
+		}
+	}
+	return deduped
+}
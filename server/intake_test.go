@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure creating an intake subscription for a partition which already has
+// one replaces it and that a replaced partition can't tear down its
+// replacement's subscription.
+func TestIntakeRegistryReplacesSubscription(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Start Liftbridge server.
+	server := createServer()
+	require.NoError(t, server.Start())
+	defer server.Stop()
+
+	var (
+		old         = &partition{Partition: &proto.Partition{Stream: "foo", Subject: "foo"}, srv: server}
+		replacement = &partition{Partition: &proto.Partition{Stream: "foo", Subject: "foo"}, srv: server}
+		oldRecv     int32
+		newRecv     int32
+	)
+	require.NoError(t, server.intake.subscribe(server.nc, old, "foo", "", func(*nats.Msg) {
+		atomic.AddInt32(&oldRecv, 1)
+	}))
+	require.NoError(t, server.intake.subscribe(server.nc, replacement, "foo", "", func(*nats.Msg) {
+		atomic.AddInt32(&newRecv, 1)
+	}))
+	require.Equal(t, 1, server.intake.count())
+
+	// The old partition stopping must not affect the replacement.
+	require.NoError(t, server.intake.unsubscribe(old))
+	require.Equal(t, 1, server.intake.count())
+
+	require.NoError(t, server.nc.Publish("foo", []byte("hello")))
+	require.NoError(t, server.nc.Flush())
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) && atomic.LoadInt32(&newRecv) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&newRecv))
+	require.Equal(t, int32(0), atomic.LoadInt32(&oldRecv))
+
+	require.NoError(t, server.intake.unsubscribe(replacement))
+	require.Equal(t, 0, server.intake.count())
+}
+
+// Ensure the partition leader drops messages it receives more than once and
+// counts them.
+func TestPartitionDropsDuplicateIntake(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Start Liftbridge server.
+	server := createServer()
+	require.NoError(t, server.Start())
+	defer server.Stop()
+
+	p, err := server.newPartition(&proto.Partition{
+		Subject:  "foo",
+		Stream:   "foo",
+		Replicas: []string{"a"},
+		Leader:   "a",
+		Isr:      []string{"a"},
+	}, false, nil)
+	require.NoError(t, err)
+	defer p.Close()
+	require.NoError(t, p.becomeLeader(1))
+
+	publish := func(value string, headers map[string][]byte) {
+		data, err := proto.MarshalPublish(&client.Message{Value: []byte(value), Headers: headers})
+		require.NoError(t, err)
+		require.NoError(t, server.nc.Publish("foo", data))
+	}
+	stamped := withIngestID(nil)
+	publish("first", stamped)
+	publish("first", stamped)
+	publish("second", withIngestID(nil))
+	// Messages without an ingest ID are never dropped.
+	publish("third", nil)
+	publish("third", nil)
+	require.NoError(t, server.nc.Flush())
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) && p.log.NewestOffset() < 3 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, int64(3), p.log.NewestOffset())
+	require.Equal(t, int64(1), p.Stats().DuplicatesDropped)
+}
+
+// Ensure rapid pause, resume, and leader change cycles under publish load
+// never leave a partition with more than one intake subscription, which would
+// write each message more than once.
+func TestPartitionPauseResumeLeaderChangeNoDuplicateIntake(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	require.NoError(t, client.CreateStream(context.Background(), "foo", name))
+
+	// Publish continuously, numbering each message. Publishing resumes the
+	// stream if it's paused. Errors are expected while the partition is
+	// transitioning.
+	var (
+		done = make(chan struct{})
+		wg   sync.WaitGroup
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			client.Publish(ctx, name, []byte("foo"),
+				lift.Header("seq", []byte(strconv.Itoa(i))))
+			cancel()
+		}
+	}()
+
+	for i := 0; i < 20; i++ {
+		require.NoError(t, client.PauseStream(context.Background(), name))
+		time.Sleep(10 * time.Millisecond)
+		partition := s1.metadata.GetPartition(name, 0)
+		if !partition.IsPaused() {
+			leader, epoch := partition.GetLeader()
+			require.NoError(t, partition.SetLeader(leader, epoch+1))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(done)
+	wg.Wait()
+
+	// Make sure the stream is resumed.
+	_, err = client.Publish(context.Background(), name, []byte("foo"),
+		lift.Header("seq", []byte("last")))
+	require.NoError(t, err)
+
+	partition := s1.metadata.GetPartition(name, 0)
+	require.LessOrEqual(t, s1.intake.count(), 1)
+	require.Equal(t, int64(0), partition.Stats().DuplicatesDropped)
+
+	// Each numbered message must appear at most once in the log.
+	newest := partition.log.NewestOffset()
+	require.GreaterOrEqual(t, newest, int64(0))
+	reader, err := partition.log.NewReader(0, true)
+	require.NoError(t, err)
+	var (
+		seen       = make(map[string]int64)
+		headersBuf = make([]byte, 28)
+		msg        commitlog.SerializedMessage
+	)
+	for offset := int64(-1); offset < newest; {
+		msg, offset, _, _, err = reader.ReadMessage(context.Background(), headersBuf)
+		require.NoError(t, err)
+		seq := string(msg.Headers()["seq"])
+		if previous, ok := seen[seq]; ok {
+			t.Fatalf("Message %s written at offsets %d and %d", seq, previous, offset)
+		}
+		seen[seq] = offset
+	}
+}
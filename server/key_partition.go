@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// partitionByKeyMetadataKey is the gRPC metadata key used to have the
+	// server choose the partition a message is published to from its key
+	// since PublishRequest has no field for it. The partition in the request
+	// is ignored.
+	partitionByKeyMetadataKey = "liftbridge-partition-by-key"
+
+	// keyPartitionHeader and partitionCountHeader are the Publish response
+	// headers set, when the server chose the partition from the key, to the
+	// partition the message was published to and the stream's partition
+	// count used to choose it. Clients can compare the count with their own
+	// to detect streams which were repartitioned.
+	keyPartitionHeader   = "liftbridge-key-partition"
+	partitionCountHeader = "liftbridge-partition-count"
+)
+
+// partitionByKeyFromContext indicates if the incoming gRPC metadata of the
+// given context requests the server to choose the partition from the key.
+func partitionByKeyFromContext(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	values := md.Get(partitionByKeyMetadataKey)
+	switch len(values) {
+	case 0:
+		return false, nil
+	case 1:
+		byKey, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return false, fmt.Errorf("invalid %s: %v", partitionByKeyMetadataKey, err)
+		}
+		return byKey, nil
+	default:
+		return false, fmt.Errorf("only one %s can be set", partitionByKeyMetadataKey)
+	}
+}
+
+// partitionForKey returns the partition of a stream with the given number of
+// partitions a message with the given key belongs to. This is the CRC-32
+// (IEEE) checksum of the key modulo the partition count, where a missing key
+// is treated as empty and so maps to partition 0. It's the same as the Go
+// client's key partitioner and must not change since clients in other
+// languages rely on it.
+func partitionForKey(key []byte, partitions int) int32 {
+	return int32(hasher(key) % uint32(partitions))
+}
+
+// assignKeyPartition sets the partition of the publish request to the one its
+// key belongs to given the stream's current partition count, which is
+// returned.
+func (a *apiServer) assignKeyPartition(req *client.PublishRequest) (int, *client.PublishAsyncError) {
+	if req.Stream == "" {
+		return 0, &client.PublishAsyncError{
+			Code:    client.PublishAsyncError_BAD_REQUEST,
+			Message: "no stream provided",
+		}
+	}
+	stream := a.metadata.GetStream(req.Stream)
+	if stream == nil {
+		return 0, &client.PublishAsyncError{
+			Code:    client.PublishAsyncError_NOT_FOUND,
+			Message: fmt.Sprintf("no such stream: %s", req.Stream),
+		}
+	}
+	partitions := len(stream.GetPartitions())
+	req.Partition = partitionForKey(req.Key, partitions)
+	return partitions, nil
+}
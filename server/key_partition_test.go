@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// documentedKeyPartition implements the documented key partitioning from
+// scratch, as a client in another language would: the bitwise CRC-32 (IEEE)
+// checksum of the key modulo the partition count.
+func documentedKeyPartition(key []byte, partitions int) int32 {
+	crc := ^uint32(0)
+	for _, b := range key {
+		crc ^= uint32(b)
+		for i := 0; i < 8; i++ {
+			if crc&1 == 1 {
+				crc = crc>>1 ^ 0xedb88320
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return int32(^crc % uint32(partitions))
+}
+
+// Ensure partitionByKeyFromContext parses the partition by key flag from the
+// request metadata.
+func TestPartitionByKeyFromContext(t *testing.T) {
+	byKey, err := partitionByKeyFromContext(context.Background())
+	require.NoError(t, err)
+	require.False(t, byKey)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		partitionByKeyMetadataKey, "true"))
+	byKey, err = partitionByKeyFromContext(ctx)
+	require.NoError(t, err)
+	require.True(t, byKey)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		partitionByKeyMetadataKey, "foo"))
+	_, err = partitionByKeyFromContext(ctx)
+	require.Error(t, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		partitionByKeyMetadataKey, "true", partitionByKeyMetadataKey, "false"))
+	_, err = partitionByKeyFromContext(ctx)
+	require.Error(t, err)
+}
+
+// Ensure partitionForKey matches the documented hash and maps missing keys to
+// partition 0.
+func TestPartitionForKey(t *testing.T) {
+	for partitions := 1; partitions <= 16; partitions++ {
+		for i := 0; i < 100; i++ {
+			key := []byte(fmt.Sprintf("key-%d", i))
+			require.Equal(t, documentedKeyPartition(key, partitions), partitionForKey(key, partitions))
+		}
+		require.Equal(t, int32(0), partitionForKey(nil, partitions))
+		require.Equal(t, int32(0), partitionForKey([]byte{}, partitions))
+	}
+	// Known values which must never change.
+	require.Equal(t, int32(2), partitionForKey([]byte("foo"), 3))
+	require.Equal(t, int32(0), partitionForKey([]byte("baz"), 3))
+	require.Equal(t, int32(1), partitionForKey([]byte("qux"), 3))
+}
+
+// Ensure messages published with the partition by key flag are published to
+// the partition the Go client and the documented hash choose for their key,
+// that the partition count is returned, and that publishes to streams which
+// don't exist are rejected.
+func TestPublishPartitionByKey(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	lc, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	require.NoError(t, lc.CreateStream(context.Background(), "foo", "foo", lift.Partitions(3)))
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	api := client.NewAPIClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	byKeyCtx := metadata.AppendToOutgoingContext(ctx, partitionByKeyMetadataKey, "true")
+
+	for i := 0; i < 20; i++ {
+		var (
+			key       = []byte(fmt.Sprintf("key-%d", i))
+			expected  = documentedKeyPartition(key, 3)
+			partition = s1.metadata.GetPartition("foo", expected)
+			hw        = partition.log.HighWatermark()
+		)
+
+		// The Go client partitions by key itself.
+		_, err := lc.Publish(ctx, "foo", []byte("go"), lift.Key(key), lift.PartitionByKey(),
+			lift.AckPolicyAll())
+		require.NoError(t, err)
+		require.Equal(t, hw+1, partition.log.HighWatermark())
+
+		// The partition in the request is ignored.
+		var header metadata.MD
+		resp, err := api.Publish(byKeyCtx, &client.PublishRequest{
+			Stream:    "foo",
+			Partition: 5,
+			Key:       key,
+			Value:     []byte("server"),
+			AckPolicy: client.AckPolicy_ALL,
+		}, grpc.Header(&header))
+		require.NoError(t, err)
+		require.Equal(t, hw+2, resp.Ack.Offset)
+		require.Equal(t, hw+2, partition.log.HighWatermark())
+		require.Equal(t, []string{strconv.Itoa(int(expected))}, header.Get(keyPartitionHeader))
+		require.Equal(t, []string{"3"}, header.Get(partitionCountHeader))
+	}
+
+	// PublishAsync can partition by key too.
+	partition := s1.metadata.GetPartition("foo", 2)
+	hw := partition.log.HighWatermark()
+	async, err := api.PublishAsync(byKeyCtx)
+	require.NoError(t, err)
+	require.NoError(t, async.Send(&client.PublishRequest{
+		Stream:        "foo",
+		Key:           []byte("bar"),
+		Value:         []byte("async"),
+		AckPolicy:     client.AckPolicy_ALL,
+		CorrelationId: "1",
+	}))
+	asyncResp, err := async.Recv()
+	require.NoError(t, err)
+	require.Nil(t, asyncResp.AsyncError)
+	require.Equal(t, hw+1, asyncResp.Ack.Offset)
+	require.Equal(t, hw+1, partition.log.HighWatermark())
+
+	// Streams which don't exist aren't published to.
+	require.NoError(t, async.Send(&client.PublishRequest{
+		Stream:        "bar",
+		Key:           []byte("bar"),
+		Value:         []byte("async"),
+		AckPolicy:     client.AckPolicy_ALL,
+		CorrelationId: "2",
+	}))
+	asyncResp, err = async.Recv()
+	require.NoError(t, err)
+	require.Equal(t, client.PublishAsyncError_NOT_FOUND, asyncResp.AsyncError.Code)
+	require.NoError(t, async.CloseSend())
+
+	_, err = api.Publish(byKeyCtx, &client.PublishRequest{
+		Stream: "bar",
+		Key:    []byte("bar"),
+		Value:  []byte("server"),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+)
+
+const (
+	// ackLeaderUnavailable and publishAsyncErrorLeaderUnavailable are the ack
+	// and PublishAsync error codes of messages rejected because the partition
+	// leader has lost its NATS connection. Like the exclusive producer codes,
+	// they are past the codes the enums define.
+	ackLeaderUnavailable               = client.Ack_Error(101)
+	publishAsyncErrorLeaderUnavailable = client.PublishAsyncError_Code(101)
+)
+
+// ErrLeaderUnavailable is returned when publishing to a partition whose leader
+// has lost its NATS connection for longer than the leader disconnect timeout.
+var ErrLeaderUnavailable = errors.New("partition leader is disconnected from NATS")
+
+// leaderDisconnectTimeout returns how long the replication NATS connection can
+// be down before the partitions this server leads stop accepting publishes. It
+// defaults to half of ReplicaMaxLeaderTimeout so that publishes stop before
+// followers report the leader and force an election.
+func (s *Server) leaderDisconnectTimeout() time.Duration {
+	if timeout := s.config.Clustering.LeaderDisconnectTimeout; timeout > 0 {
+		return timeout
+	}
+	return s.config.Clustering.ReplicaMaxLeaderTimeout / 2
+}
+
+// isLeaderFenced indicates if the replication NATS connection has been down for
+// longer than the leader disconnect timeout, in which case the partitions this
+// server leads can't replicate or be reached by followers and reject
+// publishes. The fence remains after reconnecting until the server has checked
+// which partitions it still leads.
+func (s *Server) isLeaderFenced() bool {
+	since := atomic.LoadInt64(&s.replDownSince)
+	return since != 0 && time.Since(time.Unix(0, since)) >= s.leaderDisconnectTimeout()
+}
+
+// isReplicationConn indicates if the given NATS connection is the one used for
+// stream replication.
+func isReplicationConn(nc *nats.Conn) bool {
+	return strings.HasSuffix(nc.Opts.Name, "."+replicationConnName)
+}
+
+// replicationDisconnected records when the replication NATS connection went
+// down.
+func (s *Server) replicationDisconnected() {
+	atomic.CompareAndSwapInt64(&s.replDownSince, 0, time.Now().UnixNano())
+}
+
+// replicationReconnected lifts the leader fence once the replication NATS
+// connection is back. If the partitions this server leads were fenced, it
+// first waits for the metadata FSM to catch up with the changes made while it
+// was disconnected and steps down from any partitions it no longer leads.
+func (s *Server) replicationReconnected() {
+	since := atomic.LoadInt64(&s.replDownSince)
+	if since == 0 {
+		return
+	}
+	if !s.isLeaderFenced() {
+		atomic.CompareAndSwapInt64(&s.replDownSince, since, 0)
+		return
+	}
+	s.startGoroutine(func() {
+		s.reconcileLeadership()
+		// Leave the fence up if the connection went down again meanwhile.
+		if atomic.CompareAndSwapInt64(&s.replDownSince, since, 0) {
+			s.logger.Infof("Replication connection to NATS restored, accepting publishes")
+		}
+	})
+}
+
+// reconcileLeadership steps down from the partitions this server is leading
+// but which the metadata FSM has assigned to another leader. It's called
+// after the server was fenced so that it doesn't resume accepting publishes
+// for partitions whose leadership moved while it was disconnected.
+func (s *Server) reconcileLeadership() {
+	s.waitForMetadataSync(s.config.Clustering.ReplicaMaxLeaderTimeout)
+	for _, stream := range s.metadata.GetStreams() {
+		for _, partition := range stream.GetPartitions() {
+			if !partition.IsLeader() {
+				continue
+			}
+			if leader, epoch := partition.GetLeader(); leader != s.config.Clustering.ServerID {
+				s.logger.Warnf("Stepping down as leader for partition %s, leader is %s in epoch %d",
+					partition, leader, epoch)
+				if err := partition.stepDown(); err != nil {
+					s.logger.Errorf("Failed to step down as leader for partition %s: %v",
+						partition, err)
+				}
+			}
+		}
+	}
+}
+
+// waitForMetadataSync waits up to the given timeout for the metadata FSM to
+// apply the Raft log up to the commit index. The metadata leader applies a
+// barrier, while followers wait for the commit index learned from the leader.
+func (s *Server) waitForMetadataSync(timeout time.Duration) {
+	select {
+	case <-s.raftInitialized:
+	case <-s.shutdownCh:
+		return
+	}
+	raft := s.getRaft()
+	if raft == nil {
+		return
+	}
+	if raft.isLeader() {
+		if err := raft.Barrier(timeout).Error(); err != nil {
+			s.logger.Warnf("Failed to apply Raft barrier: %v", err)
+		}
+		return
+	}
+	deadline := time.Now().Add(timeout)
+	for raft.AppliedIndex() < raft.getCommitIndex() {
+		if time.Now().After(deadline) {
+			s.logger.Warnf("Timed out waiting for metadata to sync")
+			return
+		}
+		select {
+		case <-time.After(10 * time.Millisecond):
+		case <-s.shutdownCh:
+			return
+		}
+	}
+}
+
+// stepDown stops the partition leading if the server is no longer its leader
+// and starts following the current leader, if a replica.
+func (p *partition) stepDown() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.isLeading || p.Leader == p.srv.config.Clustering.ServerID {
+		return nil
+	}
+	if err := p.stopLeadingOrFollowing(); err != nil {
+		return err
+	}
+	return p.startLeadingOrFollowing()
+}
+
+// dropFencedLeaderMessages nacks the messages in the batch and returns an
+// empty batch if the server is fenced from leading.
+func (p *partition) dropFencedLeaderMessages(batch []*commitlog.Message) []*commitlog.Message {
+	if !p.srv.isLeaderFenced() {
+		return batch
+	}
+	p.srv.logger.Warnf("Rejecting %d messages received on partition %s while disconnected from NATS",
+		len(batch), p)
+	for _, msg := range batch {
+		p.sendProducerNack(msg, ackLeaderUnavailable)
+	}
+	return batch[:0]
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// publishToServer publishes a message through the given server's API and
+// waits for the leader's ack.
+func publishToServer(s *Server, stream string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := s.api.Publish(ctx, &client.PublishRequest{
+		Stream:    stream,
+		Value:     []byte("hello"),
+		AckPolicy: client.AckPolicy_LEADER,
+	})
+	return err
+}
+
+// Ensure a partition leader rejects publishes with Unavailable once its
+// replication connection has been down for the leader disconnect timeout and
+// resumes accepting them on reconnecting if it's still the leader.
+func TestLeaderFencedWhileDisconnected(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Clustering.LeaderDisconnectTimeout = 500 * time.Millisecond
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	require.NoError(t, client.CreateStream(context.Background(), "foo", name))
+	require.NoError(t, publishToServer(s1, name))
+
+	// Sever the replication connection.
+	disconnected := time.Now()
+	s1.natsDisconnectedHandler(s1.ncRepl)
+
+	var rejected time.Duration
+	for rejected == 0 {
+		err := publishToServer(s1, name)
+		if err == nil {
+			require.Less(t, time.Since(disconnected), time.Second, "Publishes were not rejected")
+			time.Sleep(10 * time.Millisecond)
+			continue
+		}
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Contains(t, err.Error(), ErrLeaderUnavailable.Error())
+		rejected = time.Since(disconnected)
+	}
+	require.GreaterOrEqual(t, rejected, s1Config.Clustering.LeaderDisconnectTimeout)
+
+	// Once reconnected, the server is still the leader per the metadata so it
+	// resumes accepting publishes.
+	s1.natsReconnectedHandler(s1.ncRepl)
+	deadline := time.Now().Add(5 * time.Second)
+	for s1.isLeaderFenced() {
+		if time.Now().After(deadline) {
+			t.Fatal("Leader fence was not lifted")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.True(t, s1.metadata.GetPartition(name, 0).IsLeader())
+	require.NoError(t, publishToServer(s1, name))
+}
+
+// Ensure a fenced partition leader whose partition was assigned another leader
+// while it was disconnected doesn't resume leading on reconnecting.
+func TestLeaderFencedLeadershipMoved(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Clustering.LeaderDisconnectTimeout = 200 * time.Millisecond
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2Config.Clustering.LeaderDisconnectTimeout = 200 * time.Millisecond
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	servers := []*Server{s1, s2}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	client, err := lift.Connect([]string{"localhost:5050", "localhost:5051"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	require.NoError(t, client.CreateStream(context.Background(), "foo", name,
+		lift.ReplicationFactor(2)))
+	waitForISR(t, 10*time.Second, name, 0, 2, servers...)
+
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+	follower := s1
+	if leader == s1 {
+		follower = s2
+	}
+
+	// Sever the leader's replication connection and wait for it to be fenced.
+	leader.natsDisconnectedHandler(leader.ncRepl)
+	time.Sleep(leader.config.Clustering.LeaderDisconnectTimeout)
+	require.True(t, leader.isLeaderFenced())
+	err = publishToServer(leader, name)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Move leadership while the leader is fenced.
+	st := follower.metadata.TransferLeader(context.Background(), name, 0,
+		follower.config.Clustering.ServerID)
+	require.Nil(t, st)
+	require.Equal(t, follower, getPartitionLeader(t, 10*time.Second, name, 0, servers...))
+
+	// Once reconnected, the old leader has stepped down and the fence is
+	// lifted.
+	leader.natsReconnectedHandler(leader.ncRepl)
+	deadline := time.Now().Add(5 * time.Second)
+	for leader.isLeaderFenced() {
+		if time.Now().After(deadline) {
+			t.Fatal("Leader fence was not lifted")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.False(t, leader.metadata.GetPartition(name, 0).IsLeader())
+	require.True(t, follower.metadata.GetPartition(name, 0).IsLeader())
+	require.NoError(t, publishToServer(follower, name))
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// ErrLeaderLeaseExpired is returned when publishing to a partition whose
+// leader stepped down because it didn't commit anything for the leader lease
+// duration.
+var ErrLeaderLeaseExpired = errors.New("partition leader lease expired")
+
+// renewLeaderLease extends the lease of the partition leader. It's called when
+// the leader commits messages and when a follower in the ISR fetches from it,
+// which confirms the messages the follower has replicated are committed, so an
+// idle leader keeps its lease as long as its followers keep fetching.
+func (p *partition) renewLeaderLease() {
+	atomic.StoreInt64(&p.leaseRenewed, time.Now().UnixNano())
+}
+
+// leaderLeaseRemaining returns how long the partition leader's lease has left
+// at the given time. The lease doesn't expire while the leader is the only
+// replica in the ISR since no other replica can be elected in its place.
+func (p *partition) leaderLeaseRemaining(now time.Time) time.Duration {
+	if p.ISRSize() <= 1 {
+		p.renewLeaderLease()
+	}
+	renewed := time.Unix(0, atomic.LoadInt64(&p.leaseRenewed))
+	return p.srv.config.Clustering.LeaderLeaseDuration - now.Sub(renewed)
+}
+
+// leaderLeaseLoop is a long-running loop which steps the partition leader down
+// once its lease expires, i.e. it hasn't committed anything or heard from its
+// ISR for the leader lease duration, since a new leader may have been elected
+// without it knowing. It runs until the stop channel is closed.
+func (p *partition) leaderLeaseLoop(stop <-chan struct{}) {
+	timer := time.NewTimer(p.srv.config.Clustering.LeaderLeaseDuration)
+	defer timer.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-timer.C:
+		}
+
+		remaining := p.leaderLeaseRemaining(time.Now())
+		if remaining > 0 {
+			timer.Reset(remaining)
+			continue
+		}
+		p.expireLeaderLease(stop)
+		return
+	}
+}
+
+// expireLeaderLease stops the partition leading, if it's still leading in the
+// epoch the given stop channel belongs to, and rejects publishes until a
+// leader is elected.
+func (p *partition) expireLeaderLease(stop <-chan struct{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.isLeading || p.stopLeader != stop {
+		return
+	}
+	p.srv.logger.Warnf("Leader lease for partition %s expired after nothing was committed for %s, "+
+		"stepping down", p, p.srv.config.Clustering.LeaderLeaseDuration)
+	if err := p.stopLeading(); err != nil {
+		p.srv.logger.Errorf("Failed to step down as leader for partition %s: %v", p, err)
+		return
+	}
+	p.leaseExpired = true
+}
+
+// IsLeaderLeaseExpired indicates if this server stepped down as the partition
+// leader because its lease expired and hasn't been assigned a new role since.
+func (p *partition) IsLeaderLeaseExpired() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.leaseExpired
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getLeaderLeaseTestConfig returns the config of a server whose partition
+// leaders hold a one-second lease which their idle followers renew.
+func getLeaderLeaseTestConfig(id string, bootstrap bool, port int) *Config {
+	config := getTestConfig(id, bootstrap, port)
+	config.Clustering.LeaderLeaseDuration = time.Second
+	config.Clustering.ReplicaMaxIdleWait = 200 * time.Millisecond
+	// Neither shrink the ISR nor elect a new leader during the test.
+	config.Clustering.ReplicaMaxLagTime = 30 * time.Second
+	config.Clustering.ReplicaMaxLeaderTimeout = 30 * time.Second
+	return config
+}
+
+// Ensure a partition leader keeps its lease while idle as long as its
+// followers fetch from it, and steps down and rejects publishes with
+// Unavailable once nothing is committed for the lease duration, even though no
+// new leader has been elected.
+func TestLeaderLeaseExpired(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getLeaderLeaseTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getLeaderLeaseTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	servers := []*Server{s1, s2}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	client, err := lift.Connect([]string{"localhost:5050", "localhost:5051"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	require.NoError(t, client.CreateStream(context.Background(), "foo", name,
+		lift.ReplicationFactor(2)))
+	waitForISR(t, 10*time.Second, name, 0, 2, servers...)
+
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+	require.NoError(t, publishToServer(leader, name))
+
+	// The idle follower keeps renewing the lease.
+	time.Sleep(2 * leader.config.Clustering.LeaderLeaseDuration)
+	partition := leader.metadata.GetPartition(name, 0)
+	require.True(t, partition.IsLeader())
+	require.NoError(t, publishToServer(leader, name))
+
+	// Stop committing by dropping the follower's replication requests.
+	paused := time.Now()
+	partition.pauseReplication()
+
+	var rejected time.Duration
+	for rejected == 0 {
+		err := publishToServer(leader, name)
+		// A publish in flight when the leader steps down isn't acked.
+		if err == nil || err == context.DeadlineExceeded {
+			require.Less(t, time.Since(paused), 10*time.Second, "Publishes were not rejected")
+			continue
+		}
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Contains(t, err.Error(), ErrLeaderLeaseExpired.Error())
+		rejected = time.Since(paused)
+	}
+	// The lease was last renewed by a fetch up to the max idle wait before
+	// replication was paused.
+	require.GreaterOrEqual(t, rejected,
+		leader.config.Clustering.LeaderLeaseDuration-leader.config.Clustering.ReplicaMaxIdleWait)
+
+	// The old leader stepped down although it's still the leader per the
+	// metadata.
+	require.False(t, partition.IsLeader())
+	partitionLeader, _ := partition.GetLeader()
+	require.Equal(t, leader.config.Clustering.ServerID, partitionLeader)
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// listenerMetadataKey is the FetchMetadata request metadata key clients can
+// use to select the named listener whose addresses are returned for each
+// broker since FetchMetadataRequest has no field for it.
+const listenerMetadataKey = "liftbridge-listener"
+
+// namedListener is a listener configured in addition to the server's default
+// listener. Clients connecting through it are handed the addresses brokers
+// advertise for the listener with the same name.
+type namedListener struct {
+	ListenerConfig
+	listener net.Listener
+	addr     *net.TCPAddr // Address bound to
+}
+
+// advertisedAddress returns the address advertised to clients for the
+// listener. Like the default listener, the host falls back to the listen host
+// and the port to the one bound to.
+func (l *namedListener) advertisedAddress() HostPort {
+	address := HostPort{Host: l.Host, Port: l.Port}
+	if address.Host == "" {
+		address.Host = l.Listen.Host
+	}
+	if address.Host == "" {
+		address.Host = defaultConnectionAddress
+	}
+	if address.Port == 0 {
+		address.Port = l.addr.Port
+	}
+	return address
+}
+
+// accepts indicates if connections to the given local address were accepted
+// by the listener.
+func (l *namedListener) accepts(local *net.TCPAddr) bool {
+	return l.addr.Port == local.Port &&
+		(l.addr.IP.IsUnspecified() || l.addr.IP.Equal(local.IP))
+}
+
+// startNamedListeners binds the named listeners in the server's config.
+func (s *Server) startNamedListeners() error {
+	for _, config := range s.config.Listeners {
+		hp := net.JoinHostPort(config.Listen.Host, strconv.Itoa(config.Listen.Port))
+		l, err := net.Listen("tcp", hp)
+		if err != nil {
+			return errors.Wrapf(err, "failed starting listener %s", config.Name)
+		}
+		listener := &namedListener{
+			ListenerConfig: config,
+			listener:       l,
+			addr:           l.Addr().(*net.TCPAddr),
+		}
+		s.namedListeners = append(s.namedListeners, listener)
+		s.logger.Infof("Starting listener %s on %s...", config.Name,
+			net.JoinHostPort(config.Listen.Host, strconv.Itoa(listener.addr.Port)))
+	}
+	return nil
+}
+
+// advertisedListeners returns the addresses advertised for the server's named
+// listeners.
+func (s *Server) advertisedListeners() []*proto.AdvertisedListener {
+	listeners := make([]*proto.AdvertisedListener, len(s.namedListeners))
+	for i, l := range s.namedListeners {
+		address := l.advertisedAddress()
+		listeners[i] = &proto.AdvertisedListener{
+			Name: l.Name,
+			Host: address.Host,
+			Port: int32(address.Port),
+		}
+	}
+	return listeners
+}
+
+// clientListener returns the name of the listener whose addresses should be
+// returned to the client making the request. It's set with the
+// liftbridge-listener request metadata or, if that isn't set, inferred from
+// the listener the client connected through. An empty name is the default
+// listener.
+func (s *Server) clientListener(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(listenerMetadataKey)
+	switch len(values) {
+	case 0:
+	case 1:
+		if values[0] == "" {
+			return "", nil
+		}
+		for _, l := range s.namedListeners {
+			if l.Name == values[0] {
+				return l.Name, nil
+			}
+		}
+		return "", fmt.Errorf("unknown listener %q", values[0])
+	default:
+		return "", fmt.Errorf("only one %s can be set", listenerMetadataKey)
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	addr, ok := p.LocalAddr.(*net.TCPAddr)
+	if !ok {
+		return "", nil
+	}
+	for _, l := range s.namedListeners {
+		if l.accepts(addr) {
+			return l.Name, nil
+		}
+	}
+	return "", nil
+}
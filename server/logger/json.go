@@ -0,0 +1,165 @@
+package logger
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Keys which are always present in JSON log output. Fields extracted from the
+// message with one of these names are only available in the fields map.
+const (
+	jsonKeyTimestamp = "timestamp"
+	jsonKeyLevel     = "level"
+	jsonKeyMsg       = "msg"
+	jsonKeyFields    = "fields"
+)
+
+// NewJSONLogger returns a new Logger instance backed by Logrus which writes
+// each log statement as a single-line JSON object. Key-value pairs embedded in
+// the message, e.g. "[stream=foo, partition=0]", are extracted into the fields
+// map and promoted to top-level keys so they can be indexed without parsing
+// the message.
+func NewJSONLogger(level uint32) Logger {
+	l := log.New()
+	l.SetLevel(log.Level(level))
+	l.Formatter = &jsonFormatter{}
+	return &logger{Logger: l}
+}
+
+// jsonFormatter is a Logrus formatter which produces a JSON object containing
+// the timestamp, level, message, and fields of a log entry.
+type jsonFormatter struct{}
+
+// Format renders a single log entry.
+func (f *jsonFormatter) Format(entry *log.Entry) ([]byte, error) {
+	fields := extractFields(entry.Message)
+	for key, value := range entry.Data {
+		if err, ok := value.(error); ok {
+			value = err.Error()
+		}
+		fields[key] = value
+	}
+
+	data := make(map[string]interface{}, len(fields)+4)
+	for key, value := range fields {
+		data[key] = value
+	}
+	data[jsonKeyTimestamp] = entry.Time.Format(time.RFC3339Nano)
+	data[jsonKeyLevel] = entry.Level.String()
+	data[jsonKeyMsg] = entry.Message
+	data[jsonKeyFields] = fields
+
+	serialized, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return append(serialized, '\n'), nil
+}
+
+// extractFields parses the key-value pairs from any bracketed lists in the
+// message, e.g. "api: Publish [stream=foo, partition=0]". A bracketed list is
+// only treated as fields if every comma-separated element is of the form
+// key=value. Integer and boolean values are converted so they are indexed as
+// such. Later occurrences of a key overwrite earlier ones.
+func extractFields(msg string) map[string]interface{} {
+	fields := make(map[string]interface{})
+	for i := 0; i < len(msg); i++ {
+		if msg[i] != '[' {
+			continue
+		}
+		end := matchingBracket(msg, i)
+		if end < 0 {
+			break
+		}
+		if pairs, ok := parsePairs(msg[i+1 : end]); ok {
+			for key, value := range pairs {
+				fields[key] = value
+			}
+			i = end
+		}
+	}
+	return fields
+}
+
+// matchingBracket returns the index of the ']' which closes the '[' at start,
+// or -1 if it is not closed.
+func matchingBracket(s string, start int) int {
+	depth := 0
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parsePairs parses a list of the form "k1=v1, k2=v2". Commas inside nested
+// brackets are not treated as separators. It returns false if any element is
+// not a key-value pair.
+func parsePairs(s string) (map[string]interface{}, bool) {
+	var (
+		pairs = make(map[string]interface{})
+		depth = 0
+		start = 0
+	)
+	for i := 0; i <= len(s); i++ {
+		if i < len(s) {
+			switch s[i] {
+			case '[':
+				depth++
+				continue
+			case ']':
+				depth--
+				continue
+			case ',':
+				if depth > 0 {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+		key, value, ok := parsePair(strings.TrimSpace(s[start:i]))
+		if !ok {
+			return nil, false
+		}
+		pairs[key] = value
+		start = i + 1
+	}
+	return pairs, true
+}
+
+func parsePair(s string) (string, interface{}, bool) {
+	idx := strings.IndexByte(s, '=')
+	if idx <= 0 {
+		return "", nil, false
+	}
+	key := s[:idx]
+	for _, r := range key {
+		if !(r == '_' || r == '.' || r == '-' ||
+			(r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')) {
+			return "", nil, false
+		}
+	}
+	value := s[idx+1:]
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return key, i, true
+	}
+	switch value {
+	case "true":
+		return key, true, true
+	case "false":
+		return key, false, true
+	}
+	return key, value, true
+}
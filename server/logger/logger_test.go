@@ -2,6 +2,7 @@ package logger
 
 import (
 	"bytes"
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -174,6 +175,103 @@ func TestNoopNATSLoggerDoesNotLog(t *testing.T) {
 	}
 }
 
+func TestJSONLogger(t *testing.T) {
+	l := NewJSONLogger(uint32(log.DebugLevel)).(*logger)
+
+	var buf bytes.Buffer
+	l.Logger.SetOutput(&buf)
+
+	l.Prefix("[test] ")
+	l.Debugf("api: Publish [stream=%s, partition=%d]", "foo", 2)
+	l.Info("plain message")
+	l.Errorf("api: PauseStream [name=%s, partitions=%v, resumeAll=%v]", "bar", []int32{0, 1}, true)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 log lines, got %d: %s", len(lines), buf.String())
+	}
+
+	entries := make([]map[string]interface{}, len(lines))
+	for i, line := range lines {
+		if err := json.Unmarshal([]byte(line), &entries[i]); err != nil {
+			t.Fatalf("failed to parse log line %q: %v", line, err)
+		}
+		for _, key := range []string{"timestamp", "level", "msg", "fields"} {
+			if _, ok := entries[i][key]; !ok {
+				t.Errorf("expected %q in log line %q", key, line)
+			}
+		}
+		if _, ok := entries[i]["fields"].(map[string]interface{}); !ok {
+			t.Errorf("expected fields to be an object in log line %q", line)
+		}
+	}
+
+	entry := entries[0]
+	if entry["level"] != "debug" {
+		t.Errorf("expected debug level, got %v", entry["level"])
+	}
+	if entry["msg"] != "[test] api: Publish [stream=foo, partition=2]" {
+		t.Errorf("unexpected msg %v", entry["msg"])
+	}
+	if entry["stream"] != "foo" {
+		t.Errorf("expected stream to be promoted, got %v", entry["stream"])
+	}
+	if entry["partition"] != float64(2) {
+		t.Errorf("expected numeric partition to be promoted, got %v", entry["partition"])
+	}
+	fields := entry["fields"].(map[string]interface{})
+	if fields["stream"] != "foo" || fields["partition"] != float64(2) {
+		t.Errorf("unexpected fields %v", fields)
+	}
+
+	entry = entries[1]
+	if entry["level"] != "info" {
+		t.Errorf("expected info level, got %v", entry["level"])
+	}
+	if fields := entry["fields"].(map[string]interface{}); len(fields) != 0 {
+		t.Errorf("expected no fields, got %v", fields)
+	}
+
+	entry = entries[2]
+	if entry["level"] != "error" {
+		t.Errorf("expected error level, got %v", entry["level"])
+	}
+	if entry["name"] != "bar" || entry["partitions"] != "[0 1]" || entry["resumeAll"] != true {
+		t.Errorf("unexpected promoted fields %v", entry)
+	}
+}
+
+func TestExtractFields(t *testing.T) {
+	fields := extractFields("Partition [subject=foo, stream=bar, partition=0] lost [leader]")
+	if len(fields) != 3 || fields["subject"] != "foo" || fields["stream"] != "bar" ||
+		fields["partition"] != int64(0) {
+		t.Errorf("unexpected fields %v", fields)
+	}
+
+	// Lists which aren't entirely key-value pairs are ignored.
+	for _, msg := range []string{"no fields", "[]", "[a=b, c]", "[unclosed=true", "[a b=c]"} {
+		if fields := extractFields(msg); len(fields) != 0 {
+			t.Errorf("expected no fields for %q, got %v", msg, fields)
+		}
+	}
+
+	// Reserved keys are only available in the fields map.
+	l := NewJSONLogger(uint32(log.DebugLevel)).(*logger)
+	var buf bytes.Buffer
+	l.Logger.SetOutput(&buf)
+	l.Infof("override [level=%s, msg=%s]", "bogus", "bogus")
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("failed to parse log line: %v", err)
+	}
+	if entry["level"] != "info" || entry["msg"] != "override [level=bogus, msg=bogus]" {
+		t.Errorf("reserved keys overwritten: %v", entry)
+	}
+	if entry["fields"].(map[string]interface{})["level"] != "bogus" {
+		t.Errorf("expected level in fields, got %v", entry["fields"])
+	}
+}
+
 // Ensure interfaces are implemented
 var _ Logger = (*logger)(nil)
 var _ gnatsd.Logger = (*natsLogger)(nil)
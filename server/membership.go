@@ -0,0 +1,325 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// ErrServerRemoved is returned when a server which was removed from the
+// metadata Raft group asks to join it again.
+var ErrServerRemoved = errors.New("server was removed from the cluster")
+
+// raftContactTracker records when the metadata leader last heard from the
+// servers it's failing to heartbeat. Raft doesn't expose per-follower contact
+// times, so they're tracked from its heartbeat observations. Servers without
+// an entry are being heartbeated successfully.
+type raftContactTracker struct {
+	mu           sync.RWMutex
+	lastContact  map[raft.ServerID]time.Time
+	observations chan raft.Observation
+	observer     *raft.Observer
+}
+
+// newRaftContactTracker returns a raftContactTracker observing the given Raft
+// node. It must be closed when the node is shut down.
+func newRaftContactTracker(node *raft.Raft) *raftContactTracker {
+	t := &raftContactTracker{
+		lastContact:  make(map[raft.ServerID]time.Time),
+		observations: make(chan raft.Observation, 64),
+	}
+	t.observer = raft.NewObserver(t.observations, false, nil)
+	node.RegisterObserver(t.observer)
+	go t.run()
+	return t
+}
+
+// run records heartbeat observations until the tracker is closed.
+func (t *raftContactTracker) run() {
+	for observation := range t.observations {
+		t.mu.Lock()
+		switch o := observation.Data.(type) {
+		case raft.FailedHeartbeatObservation:
+			t.lastContact[o.PeerID] = o.LastContact
+		case raft.ResumedHeartbeatObservation:
+			delete(t.lastContact, o.PeerID)
+		case raft.PeerObservation:
+			if o.Removed {
+				delete(t.lastContact, o.Peer.ID)
+			}
+		case raft.LeaderObservation:
+			// Failures recorded under a previous leadership are stale, and
+			// a new leader only reports servers which keep failing.
+			t.lastContact = make(map[raft.ServerID]time.Time)
+		}
+		t.mu.Unlock()
+	}
+}
+
+// getLastContact returns the last time the leader heard from the given
+// server, which is now if it's being heartbeated successfully.
+func (t *raftContactTracker) getLastContact(id raft.ServerID) time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if lastContact, ok := t.lastContact[id]; ok {
+		return lastContact
+	}
+	return time.Now()
+}
+
+// close stops observing the Raft node. Once the observer is deregistered, no
+// observation is being sent, so the channel can be closed safely.
+func (t *raftContactTracker) close(node *raft.Raft) {
+	node.DeregisterObserver(t.observer)
+	close(t.observations)
+}
+
+// AddRaftServer adds the given server to the metadata Raft group as a voter,
+// or as a non-voting observer if observer is set, regardless of the max
+// quorum size. Existing members have their suffrage changed. If the server
+// was removed, it's allowed to join the cluster again. This must be called
+// on the metadata leader.
+func (m *metadataAPI) AddRaftServer(ctx context.Context, serverID string, observer bool) *status.Status {
+	if !m.IsLeader() {
+		return status.New(codes.FailedPrecondition, "Server not metadata leader")
+	}
+
+	if m.IsServerRemoved(serverID) {
+		if st := m.setServerRemoved(ctx, serverID, false); st != nil {
+			return st
+		}
+	}
+
+	node := m.getRaft()
+	suffrage, ok, err := raftSuffrage(node, serverID)
+	if err != nil {
+		return status.New(codes.Internal, err.Error())
+	}
+
+	var (
+		id      = raft.ServerID(serverID)
+		addr    = raft.ServerAddress(serverID)
+		timeout = time.Until(computeDeadline(ctx))
+		future  raft.IndexFuture
+	)
+	switch {
+	case observer && ok && suffrage != raft.Nonvoter:
+		// AddNonvoter leaves the suffrage of voters unchanged.
+		m.logger.Infof("Demoting server %s to non-voter in metadata Raft group", serverID)
+		future = node.DemoteVoter(id, 0, timeout)
+	case observer:
+		m.logger.Infof("Adding server %s to metadata Raft group as non-voter", serverID)
+		future = node.AddNonvoter(id, addr, 0, timeout)
+	default:
+		m.logger.Infof("Adding server %s to metadata Raft group as voter", serverID)
+		future = node.AddVoter(id, addr, 0, timeout)
+	}
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to add server to metadata Raft group: %v", err)
+	}
+	return nil
+}
+
+// RemoveRaftServer removes the given server from the metadata Raft group. This
+// fails if removing a voter would leave fewer voters than the current quorum
+// or, unless force is set, if the server hosts partition replicas. The server
+// is marked removed first so that it can't join the cluster again on its own.
+// This must be called on the metadata leader.
+func (m *metadataAPI) RemoveRaftServer(ctx context.Context, serverID string, force bool) *status.Status {
+	if !m.IsLeader() {
+		return status.New(codes.FailedPrecondition, "Server not metadata leader")
+	}
+
+	node := m.getRaft()
+	future := node.GetConfiguration()
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to get cluster configuration: %v", err)
+	}
+	var (
+		voters   = 0
+		suffrage raft.ServerSuffrage
+		found    = false
+	)
+	for _, server := range future.Configuration().Servers {
+		if server.Suffrage == raft.Voter {
+			voters++
+		}
+		if string(server.ID) == serverID {
+			suffrage = server.Suffrage
+			found = true
+		}
+	}
+	if !found {
+		return status.Newf(codes.NotFound, "Server %s not in metadata Raft group", serverID)
+	}
+	if suffrage == raft.Voter && voters-1 < voters/2+1 {
+		return status.Newf(codes.FailedPrecondition,
+			"Removing server %s would leave %d voters, below quorum of %d", serverID, voters-1, voters/2+1)
+	}
+	if !force {
+		if replicas := m.countHostedReplicas(serverID); replicas > 0 {
+			return status.Newf(codes.FailedPrecondition,
+				"Server %s hosts %d partition replicas", serverID, replicas)
+		}
+	}
+
+	if st := m.setServerRemoved(ctx, serverID, true); st != nil {
+		return st
+	}
+
+	m.logger.Infof("Removing server %s from metadata Raft group", serverID)
+	removeFuture := node.RemoveServer(raft.ServerID(serverID), 0, time.Until(computeDeadline(ctx)))
+	if err := removeFuture.Error(); err != nil {
+		// Let the server join again since it's still a member.
+		if st := m.setServerRemoved(ctx, serverID, false); st != nil {
+			m.logger.Errorf("Failed to unmark server %s as removed: %v", serverID, st.Err())
+		}
+		return status.Newf(codes.Internal, "Failed to remove server from metadata Raft group: %v", err)
+	}
+	return nil
+}
+
+// ListRaftServers returns the members of the metadata Raft group along with
+// their suffrage and the last time the leader heard from them. This must be
+// called on the metadata leader.
+func (m *metadataAPI) ListRaftServers() ([]*proto.RaftServer, *status.Status) {
+	if !m.IsLeader() {
+		return nil, status.New(codes.FailedPrecondition, "Server not metadata leader")
+	}
+
+	node := m.getRaft()
+	future := node.GetConfiguration()
+	if err := future.Error(); err != nil {
+		return nil, status.Newf(codes.Internal, "Failed to get cluster configuration: %v", err)
+	}
+	var (
+		servers = future.Configuration().Servers
+		leader  = raft.ServerID(m.config.Clustering.ServerID)
+		list    = make([]*proto.RaftServer, len(servers))
+	)
+	for i, server := range servers {
+		lastContact := time.Now()
+		if server.ID != leader {
+			lastContact = node.contacts.getLastContact(server.ID)
+		}
+		list[i] = &proto.RaftServer{
+			ServerId:    string(server.ID),
+			Suffrage:    protoRaftSuffrage(server.Suffrage),
+			Leader:      server.ID == leader,
+			LastContact: lastContact.UnixNano(),
+		}
+	}
+	return list, nil
+}
+
+// setServerRemoved replicates whether the given server is removed from the
+// metadata Raft group through Raft.
+func (m *metadataAPI) setServerRemoved(ctx context.Context, serverID string, removed bool) *status.Status {
+	op := &proto.RaftLog{
+		Op: proto.Op_SET_SERVER_REMOVED,
+		SetServerRemovedOp: &proto.SetServerRemovedOp{
+			Server:  serverID,
+			Removed: removed,
+		},
+	}
+
+	// Older servers are unable to apply the operation.
+	if err := m.assertMinVersion(ctx, opProtocolVersions[op.Op]); err != nil {
+		code := codes.Internal
+		if errors.Cause(err) == ErrProtocolVersion {
+			code = codes.FailedPrecondition
+		}
+		return status.New(code, err.Error())
+	}
+
+	future, err := m.getRaft().applyOperation(ctx, op, nil)
+	if err != nil {
+		return status.Newf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to mark server removed: %v", err.Error())
+	}
+	return nil
+}
+
+// countHostedReplicas returns the number of partition replicas the given
+// server hosts.
+func (m *metadataAPI) countHostedReplicas(serverID string) int {
+	count := 0
+	for _, stream := range m.GetStreams() {
+		for _, partition := range stream.GetPartitions() {
+			for _, replica := range partition.GetReplicas() {
+				if replica == serverID {
+					count++
+				}
+			}
+		}
+	}
+	return count
+}
+
+// markServerRemoved records whether the given server is removed from the
+// metadata Raft group.
+func (m *metadataAPI) markServerRemoved(serverID string, removed bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if removed {
+		m.removedServers[serverID] = struct{}{}
+	} else {
+		delete(m.removedServers, serverID)
+	}
+}
+
+// IsServerRemoved indicates if the given server was removed from the metadata
+// Raft group and may not join it again on its own.
+func (m *metadataAPI) IsServerRemoved(serverID string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.removedServers[serverID]
+	return ok
+}
+
+// GetRemovedServers returns the servers removed from the metadata Raft group.
+func (m *metadataAPI) GetRemovedServers() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	servers := make([]string, 0, len(m.removedServers))
+	for server := range m.removedServers {
+		servers = append(servers, server)
+	}
+	return servers
+}
+
+// raftSuffrage returns the suffrage of the given server in the Raft group and
+// whether it's a member.
+func raftSuffrage(node *raftNode, serverID string) (raft.ServerSuffrage, bool, error) {
+	future := node.GetConfiguration()
+	if err := future.Error(); err != nil {
+		return 0, false, errors.Wrap(err, "failed to get cluster configuration")
+	}
+	for _, server := range future.Configuration().Servers {
+		if string(server.ID) == serverID {
+			return server.Suffrage, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// protoRaftSuffrage converts a Raft suffrage to its protobuf equivalent.
+func protoRaftSuffrage(suffrage raft.ServerSuffrage) proto.RaftSuffrage {
+	switch suffrage {
+	case raft.Nonvoter:
+		return proto.RaftSuffrage_NONVOTER
+	case raft.Staging:
+		return proto.RaftSuffrage_STAGING
+	default:
+		return proto.RaftSuffrage_VOTER
+	}
+}
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure servers can be removed from and added back to the metadata Raft
+// group, removals which would lose quorum or orphan replicas are rejected,
+// and removed servers can't join again on their own.
+func TestRaftMembership(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	// Configure third server.
+	s3Config := getTestConfig("c", false, 5052)
+	s3 := runServerWithConfig(t, s3Config)
+	defer s3.Stop()
+
+	servers := []*Server{s1, s2, s3}
+	leader := getMetadataLeader(t, 10*time.Second, servers...)
+	var followers []*Server
+	for _, s := range servers {
+		if s != leader {
+			followers = append(followers, s)
+		}
+	}
+
+	ctx := context.Background()
+	list, err := leader.api.ListRaftServers(ctx, &proto.ListRaftServersRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Servers, 3)
+	for _, server := range list.Servers {
+		require.Equal(t, proto.RaftSuffrage_VOTER, server.Suffrage)
+		require.Equal(t, server.ServerId == leader.config.Clustering.ServerID, server.Leader)
+		require.NotZero(t, server.LastContact)
+	}
+
+	// Only the metadata leader handles membership changes.
+	_, err = followers[0].api.ListRaftServers(ctx, &proto.ListRaftServersRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+	require.NoError(t, client.CreateStream(ctx, "foo", "foo", lift.ReplicationFactor(3)))
+	waitForISR(t, 10*time.Second, "foo", 0, 3, servers...)
+
+	// Servers hosting replicas are only removed when forced.
+	removed := followers[0]
+	removedID := removed.config.Clustering.ServerID
+	_, err = leader.api.RemoveRaftServer(ctx, &proto.RemoveRaftServerRequest{ServerId: removedID})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = leader.api.RemoveRaftServer(ctx, &proto.RemoveRaftServerRequest{
+		ServerId: removedID,
+		Force:    true,
+	})
+	require.NoError(t, err)
+	require.True(t, leader.metadata.IsServerRemoved(removedID))
+
+	list, err = leader.api.ListRaftServers(ctx, &proto.ListRaftServersRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Servers, 2)
+
+	// Removing another voter would leave a single voter, below quorum.
+	_, err = leader.api.RemoveRaftServer(ctx, &proto.RemoveRaftServerRequest{
+		ServerId: followers[1].config.Clustering.ServerID,
+		Force:    true,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The removed server can't join again with fresh state.
+	removed.Stop()
+	require.NoError(t, os.RemoveAll(removed.config.DataDir))
+	raftJoinAttempts = 1
+	defer func() {
+		raftJoinAttempts = defaultRaftJoinAttempts
+	}()
+	removed = New(removed.config)
+	require.Error(t, removed.Start())
+	removed.Stop()
+
+	// Added back as an observer, it's not removed anymore.
+	_, err = leader.api.AddRaftServer(ctx, &proto.AddRaftServerRequest{
+		ServerId: removedID,
+		Observer: true,
+	})
+	require.NoError(t, err)
+	require.False(t, leader.metadata.IsServerRemoved(removedID))
+
+	list, err = leader.api.ListRaftServers(ctx, &proto.ListRaftServersRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Servers, 3)
+	for _, server := range list.Servers {
+		if server.ServerId == removedID {
+			require.Equal(t, proto.RaftSuffrage_NONVOTER, server.Suffrage)
+		}
+	}
+}
+
+// Ensure observers are only excluded from replica placement when configured.
+func TestGetClusterServerIDsExcludeObservers(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1, s2)
+	_, err := s1.api.AddRaftServer(context.Background(), &proto.AddRaftServerRequest{
+		ServerId: "b",
+		Observer: true,
+	})
+	require.NoError(t, err)
+
+	ids, err := s1.metadata.getClusterServerIDs(false)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"a", "b"}, ids)
+
+	ids, err = s1.metadata.getClusterServerIDs(true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, ids)
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"google.golang.org/grpc/metadata"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// maxMessageBytesMetadataKey is the gRPC metadata key used to set a stream's
+// max message size, in bytes, when creating it since CreateStreamRequest has
+// no field for it.
+const maxMessageBytesMetadataKey = "liftbridge-max-message-bytes"
+
+// maxMessageBytesFromContext returns the max message size set in the incoming
+// gRPC metadata, if any.
+func maxMessageBytesFromContext(ctx context.Context) (*proto.NullableInt64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(maxMessageBytesMetadataKey)
+	switch {
+	case len(values) == 0:
+		return nil, nil
+	case len(values) > 1:
+		return nil, fmt.Errorf("only one %s can be set", maxMessageBytesMetadataKey)
+	}
+	maxBytes, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil || maxBytes < 0 {
+		return nil, fmt.Errorf("invalid %s %q", maxMessageBytesMetadataKey, values[0])
+	}
+	return &proto.NullableInt64{Value: maxBytes}, nil
+}
+
+// validateMaxMessageBytes returns an error if a message of the max message
+// size could exceed a segment of a stream with the given configuration.
+func validateMaxMessageBytes(config *StreamsConfig) error {
+	if config.MaxMessageBytes > 0 && config.SegmentMaxBytes > 0 &&
+		config.MaxMessageBytes > config.SegmentMaxBytes {
+		return fmt.Errorf("max message size (%d bytes) exceeds max segment size (%d bytes)",
+			config.MaxMessageBytes, config.SegmentMaxBytes)
+	}
+	return nil
+}
+
+// messageTooLargeError returns the error of a message of the given size
+// rejected by a partition with the given max message size.
+func messageTooLargeError(size int, maxBytes int64) *client.PublishAsyncError {
+	return &client.PublishAsyncError{
+		Code: client.PublishAsyncError_BAD_REQUEST,
+		Message: fmt.Sprintf("message of %d bytes exceeds the max message size of %d bytes",
+			size, maxBytes),
+	}
+}
+
+// ensureMessageSize returns an error if the given message, as published to
+// the partition, exceeds the partition's max message size.
+func (a *apiServer) ensureMessageSize(stream string, partitionID int32, data []byte) *client.PublishAsyncError {
+	partition := a.metadata.GetPartition(stream, partitionID)
+	if partition == nil {
+		return nil
+	}
+	if maxBytes := partition.maxMessageBytes; maxBytes > 0 && int64(len(data)) > maxBytes {
+		return messageTooLargeError(len(data), maxBytes)
+	}
+	return nil
+}
+
+// maxIngestBytes returns the size of the largest message, as received from
+// NATS, the partition accepts. This is the smaller of its max message size
+// and the max replication size.
+func (p *partition) maxIngestBytes() int64 {
+	maxBytes := p.srv.config.Clustering.ReplicationMaxBytes
+	if p.maxMessageBytes > 0 && p.maxMessageBytes < maxBytes {
+		maxBytes = p.maxMessageBytes
+	}
+	return maxBytes
+}
@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure maxMessageBytesFromContext parses the max message size from the
+// request metadata.
+func TestMaxMessageBytesFromContext(t *testing.T) {
+	maxBytes, err := maxMessageBytesFromContext(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, maxBytes)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		maxMessageBytesMetadataKey, "1024"))
+	maxBytes, err = maxMessageBytesFromContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1024), maxBytes.Value)
+
+	for _, value := range []string{"-1", "1KB"} {
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			maxMessageBytesMetadataKey, value))
+		_, err = maxMessageBytesFromContext(ctx)
+		require.Error(t, err)
+	}
+}
+
+// Ensure messages larger than the stream's max message size are rejected
+// when published through the API or received on the stream's subject, and
+// streams can't be created with a max message size larger than a segment.
+func TestPublishMaxMessageBytes(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Streams.MaxMessageBytes = 1024
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	lc, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	apiClient := client.NewAPIClient(conn)
+
+	// The max message size can't exceed the max segment size.
+	err = lc.CreateStream(context.Background(), "foo", "foo", lift.SegmentMaxBytes(512))
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), maxMessageBytesMetadataKey, "512")
+	require.NoError(t, lc.CreateStream(ctx, "foo", "foo", lift.SegmentMaxBytes(512)))
+	require.NoError(t, lc.CreateStream(context.Background(), "bar", "bar"))
+
+	publish := func(stream string, size int) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err := apiClient.Publish(ctx, &client.PublishRequest{
+			Stream: stream,
+			Value:  make([]byte, size),
+		})
+		return err
+	}
+
+	// Messages within the limit are accepted.
+	require.NoError(t, publish("foo", 256))
+	require.NoError(t, publish("bar", 768))
+
+	// Larger messages fail fast with the limit in the error.
+	err = publish("foo", 768)
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), "max message size of 512 bytes")
+	err = publish("bar", 2048)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "max message size of 1024 bytes")
+
+	// The same goes for PublishAsync.
+	pubCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = lc.Publish(pubCtx, "foo", make([]byte, 768))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "max message size of 512 bytes")
+
+	// Messages received on the subject are nacked by the leader, which keeps
+	// processing messages.
+	resp, err := apiClient.PublishToSubject(pubCtx, &client.PublishToSubjectRequest{
+		Subject:   "foo",
+		Value:     make([]byte, 768),
+		AckPolicy: client.AckPolicy_LEADER,
+	})
+	require.NoError(t, err)
+	require.Equal(t, client.Ack_TOO_LARGE, resp.Ack.AckError)
+	require.NoError(t, publish("foo", 256))
+
+	partition := s1.metadata.GetPartition("foo", 0)
+	require.Equal(t, int64(1), partition.log.NewestOffset())
+}
+
+// Ensure a follower replicates a message larger than the leader's max
+// replication size, such as one written before the limit was lowered, rather
+// than stalling at its offset.
+func TestReplicateOversizedMessage(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	// Configure servers.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.EmbeddedNATS = false
+	s1Config.Clustering.ReplicationMaxBytes = 4096
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+	s2Config := getTestConfig("b", false, 5051)
+	s2Config.Clustering.ReplicationMaxBytes = 4096
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+	s3Config := getTestConfig("c", false, 5052)
+	s3Config.Clustering.ReplicationMaxBytes = 4096
+	s3 := runServerWithConfig(t, s3Config)
+	defer s3.Stop()
+	servers := []*Server{s1, s2, s3}
+	configs := map[*Server]*Config{s1: s1Config, s2: s2Config, s3: s3Config}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	lc, err := lift.Connect([]string{"localhost:5050", "localhost:5051", "localhost:5052"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	name := "foo"
+	require.NoError(t, lc.CreateStream(context.Background(), name, name, lift.ReplicationFactor(3)))
+	waitForPartition(t, 5*time.Second, name, 0, servers...)
+
+	// Stop a follower, other than the metadata leader, and publish a message
+	// to the leader which fits the current limit.
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+	follower := s2
+	if follower == leader {
+		follower = s3
+	}
+	follower.Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = leader.api.Publish(ctx, &client.PublishRequest{
+		Stream:    name,
+		Value:     make([]byte, 2048),
+		AckPolicy: client.AckPolicy_LEADER,
+	})
+	require.NoError(t, err)
+
+	// Lower the leader's max replication size below the message's size and
+	// restart the follower, which still catches up.
+	leader.config.Clustering.ReplicationMaxBytes = 1024
+	follower = runServerWithConfig(t, configs[follower])
+	defer follower.Stop()
+	require.Eventually(t, func() bool {
+		partition := follower.metadata.GetPartition(name, 0)
+		return partition != nil && partition.log.NewestOffset() == 0
+	}, 10*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// createTimeHeader is the message header publishers set the time they
+	// created a message with, in nanoseconds since the epoch. Streams using
+	// create_time timestamps use it as the message's timestamp.
+	createTimeHeader = "liftbridge-create-time"
+
+	// messageTimestampTypeMetadataKey and
+	// messageTimestampMaxDifferenceMetadataKey are the gRPC metadata keys used
+	// to set a stream's message timestamp settings when creating it since
+	// CreateStreamRequest has no fields for them. The max difference is in
+	// milliseconds.
+	messageTimestampTypeMetadataKey          = "liftbridge-message-timestamp-type"
+	messageTimestampMaxDifferenceMetadataKey = "liftbridge-message-timestamp-max-difference"
+
+	// ackInvalidTimestamp is the ack error code of messages rejected because
+	// of their create time. Like the exclusive producer code, it's past the
+	// codes the enum defines. It's reported to PublishAsync callers as a bad
+	// request.
+	ackInvalidTimestamp = client.Ack_Error(102)
+)
+
+// ErrInvalidTimestamp is returned when publishing a message whose create time
+// is malformed or too far from the partition leader's clock.
+var ErrInvalidTimestamp = errors.New("invalid message create time")
+
+// isValidMessageTimestampType indicates if the given string is a supported
+// message timestamp type.
+func isValidMessageTimestampType(timestampType string) bool {
+	return timestampType == MessageTimestampTypeLogAppendTime ||
+		timestampType == MessageTimestampTypeCreateTime
+}
+
+// messageTimestampConfigFromContext sets the message timestamp settings given
+// in the incoming gRPC metadata, if any, on the given stream config.
+func messageTimestampConfigFromContext(ctx context.Context, config *proto.StreamConfig) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	if values := md.Get(messageTimestampTypeMetadataKey); len(values) > 0 {
+		if len(values) > 1 {
+			return fmt.Errorf("only one %s can be set", messageTimestampTypeMetadataKey)
+		}
+		timestampType := strings.ToLower(values[0])
+		if !isValidMessageTimestampType(timestampType) {
+			return fmt.Errorf("invalid %s %q", messageTimestampTypeMetadataKey, values[0])
+		}
+		config.MessageTimestampType = timestampType
+	}
+	if values := md.Get(messageTimestampMaxDifferenceMetadataKey); len(values) > 0 {
+		if len(values) > 1 {
+			return fmt.Errorf("only one %s can be set", messageTimestampMaxDifferenceMetadataKey)
+		}
+		maxDifference, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil || maxDifference < 0 {
+			return fmt.Errorf("invalid %s %q", messageTimestampMaxDifferenceMetadataKey, values[0])
+		}
+		config.MessageTimestampMaxDifference = &proto.NullableInt64{Value: maxDifference}
+	}
+	return nil
+}
+
+// assignCreateTimes sets the timestamp of each message in the batch to the
+// create time its publisher set, if the partition uses create_time
+// timestamps. Messages without a create time keep their log append time.
+// Messages with a malformed create time, or one further from the log append
+// time than the max difference allows, are nacked and removed from the
+// returned batch.
+func (p *partition) assignCreateTimes(batch []*commitlog.Message) []*commitlog.Message {
+	if p.timestampType != MessageTimestampTypeCreateTime {
+		return batch
+	}
+	filtered := batch[:0]
+	for _, msg := range batch {
+		value, ok := msg.Headers[createTimeHeader]
+		if !ok {
+			filtered = append(filtered, msg)
+			continue
+		}
+		createTime, err := strconv.ParseInt(string(value), 10, 64)
+		if err != nil || !p.isCreateTimeWithinMaxDifference(createTime, msg.Timestamp) {
+			p.srv.logger.Warnf("Rejecting message received on partition %s with "+
+				"invalid create time %q", p, value)
+			p.sendProducerNack(msg, ackInvalidTimestamp)
+			continue
+		}
+		msg.Timestamp = createTime
+		delete(msg.Headers, createTimeHeader)
+		filtered = append(filtered, msg)
+	}
+	return filtered
+}
+
+// isCreateTimeWithinMaxDifference indicates if the given create time is
+// within the partition's max timestamp difference of the log append time. A
+// max difference of zero means there is no limit.
+func (p *partition) isCreateTimeWithinMaxDifference(createTime, appendTime int64) bool {
+	if p.timestampMaxDifference <= 0 {
+		return true
+	}
+	difference := time.Duration(appendTime - createTime)
+	if difference < 0 {
+		difference = -difference
+	}
+	return difference <= p.timestampMaxDifference
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure streams using create_time timestamps keep the create times set by
+// publishers, even out of order, while timestamp lookups still work, and
+// reject create times too far from the log append time.
+func TestMessageTimestampCreateTime(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		messageTimestampTypeMetadataKey, MessageTimestampTypeCreateTime,
+		messageTimestampMaxDifferenceMetadataKey, strconv.FormatInt(time.Hour.Milliseconds(), 10),
+	))
+	_, err := s1.api.CreateStream(ctx, &client.CreateStreamRequest{
+		Name:    "foo",
+		Subject: "foo",
+	})
+	require.NoError(t, err)
+	waitForPartition(t, 10*time.Second, "foo", 0, s1)
+
+	publish := func(createTime int64) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err := s1.api.Publish(ctx, &client.PublishRequest{
+			Stream:    "foo",
+			Value:     []byte("hello"),
+			AckPolicy: client.AckPolicy_LEADER,
+			Headers: map[string][]byte{
+				createTimeHeader: []byte(strconv.FormatInt(createTime, 10)),
+			},
+		})
+		return err
+	}
+
+	// Publish messages with create times out of order.
+	now := time.Now()
+	createTimes := []int64{
+		now.Add(-30 * time.Minute).UnixNano(),
+		now.Add(-50 * time.Minute).UnixNano(),
+		now.Add(-10 * time.Minute).UnixNano(),
+		now.Add(-40 * time.Minute).UnixNano(),
+	}
+	for _, createTime := range createTimes {
+		require.NoError(t, publish(createTime))
+	}
+
+	// Create times too far from the log append time are rejected.
+	err = publish(now.Add(-2 * time.Hour).UnixNano())
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = publish(now.Add(2 * time.Hour).UnixNano())
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Messages keep their create times.
+	partition := s1.metadata.GetPartition("foo", 0)
+	require.Equal(t, int64(len(createTimes)-1), partition.log.NewestOffset())
+	r, err := partition.log.NewReader(0, true)
+	require.NoError(t, err)
+	headers := make([]byte, 28)
+	for i, createTime := range createTimes {
+		msg, offset, timestamp, _, err := r.ReadMessage(context.Background(), headers)
+		require.NoError(t, err)
+		require.Equal(t, int64(i), offset)
+		require.Equal(t, createTime, timestamp)
+		require.NotContains(t, msg.Headers(), createTimeHeader)
+	}
+
+	// Timestamp lookups use the latest create time so far.
+	offset, err := partition.log.EarliestOffsetAfterTimestamp(createTimes[1])
+	require.NoError(t, err)
+	require.Equal(t, int64(0), offset)
+	offset, err = partition.log.EarliestOffsetAfterTimestamp(now.Add(-20 * time.Minute).UnixNano())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), offset)
+	offset, err = partition.log.LatestOffsetBeforeTimestamp(now.Add(-20 * time.Minute).UnixNano())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), offset)
+}
+
+// Ensure message timestamp settings are parsed from the gRPC metadata and
+// invalid ones are rejected.
+func TestMessageTimestampConfigFromContext(t *testing.T) {
+	for _, md := range []metadata.MD{
+		metadata.Pairs(messageTimestampTypeMetadataKey, "ingest_time"),
+		metadata.Pairs(messageTimestampMaxDifferenceMetadataKey, "-1"),
+		metadata.Pairs(messageTimestampMaxDifferenceMetadataKey, "1h"),
+		metadata.Pairs(
+			messageTimestampTypeMetadataKey, MessageTimestampTypeCreateTime,
+			messageTimestampTypeMetadataKey, MessageTimestampTypeLogAppendTime,
+		),
+	} {
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		require.Error(t, messageTimestampConfigFromContext(ctx, new(proto.StreamConfig)))
+	}
+
+	config := new(proto.StreamConfig)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		messageTimestampTypeMetadataKey, "CREATE_TIME",
+		messageTimestampMaxDifferenceMetadataKey, "1000",
+	))
+	require.NoError(t, messageTimestampConfigFromContext(ctx, config))
+	require.Equal(t, MessageTimestampTypeCreateTime, config.MessageTimestampType)
+	require.Equal(t, int64(1000), config.MessageTimestampMaxDifference.Value)
+}
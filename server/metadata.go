@@ -7,20 +7,27 @@ import (
 	"path/filepath"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/hashicorp/raft"
+	"github.com/nats-io/nats.go"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	client "github.com/liftbridge-io/liftbridge-api/v2/go"
 
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
 	proto "github.com/liftbridge-io/liftbridge/server/protocol"
 )
 
 const (
 	defaultPropagateTimeout             = 5 * time.Second
 	defaultFetchBrokerInfoTimeout       = 3 * time.Second
+	partitionStatusTimeout              = time.Second
+	defaultPartitionCleanTimeout        = time.Minute
 	maxReplicationFactor          int32 = -1
 )
 
@@ -29,6 +36,15 @@ var (
 	// stream that already exists.
 	ErrStreamExists = errors.New("stream already exists")
 
+	// ErrInvalidSubject is returned by CreateStream when the stream's subject
+	// is not a valid NATS subject for its partitions.
+	ErrInvalidSubject = errors.New("invalid stream subject")
+
+	// ErrSubjectOverlap is returned by CreateStream when exclusive subjects
+	// are enabled and the stream's partition subjects overlap those of an
+	// existing stream.
+	ErrSubjectOverlap = errors.New("stream subject overlaps an existing stream")
+
 	// ErrStreamNotFound is returned by DeleteStream/PauseStream when
 	// attempting to delete/pause a stream that does not exist.
 	ErrStreamNotFound = errors.New("stream does not exist")
@@ -60,22 +76,119 @@ var (
 	// ErrGroupEpoch is returned by GetConsumerGroupAssignments when the
 	// client-provided group epoch differs from the server-side group epoch.
 	ErrGroupEpoch = errors.New("client-provided group epoch differs from broker group epoch")
+
+	// ErrReplicaNotInISR is returned by TransferLeader when the broker
+	// leadership is being transferred to is not in the partition's ISR.
+	ErrReplicaNotInISR = errors.New("replica is not in the partition ISR")
+
+	// ErrProtocolVersion is returned when applying a Raft operation which
+	// requires a newer protocol version than some servers in the cluster
+	// implement, e.g. during a rolling upgrade.
+	ErrProtocolVersion = errors.New("not all servers support the operation")
+
+	// ErrProducerFenced is returned when publishing or releasing with the
+	// fencing token of an exclusive producer which has since been replaced,
+	// released, or has expired.
+	ErrProducerFenced = errors.New("exclusive producer has been fenced")
+
+	// ErrProducerNotRegistered is returned by ReleaseProducer when the
+	// exclusive producer is not registered on the stream.
+	ErrProducerNotRegistered = errors.New("exclusive producer is not registered")
+
+	// ErrScheduledOperationNotFound is returned by CancelScheduledOperation
+	// when the scheduled operation does not exist.
+	ErrScheduledOperationNotFound = errors.New("scheduled operation does not exist")
+
+	// ErrScheduledOperationNotPending is returned by CancelScheduledOperation
+	// when the scheduled operation was already executed or canceled.
+	ErrScheduledOperationNotPending = errors.New("scheduled operation is not pending")
+
+	// ErrInvalidStreamTags is returned by CreateStream and SetStreamTags when
+	// the stream's tags exceed the limits or aren't valid.
+	ErrInvalidStreamTags = errors.New("invalid stream tags")
+
+	// ErrPartitionPaused is returned by DeletePartitionData when the
+	// partition is paused since its log is closed.
+	ErrPartitionPaused = errors.New("partition is paused")
+
+	// ErrInvalidStreamACL is returned by SetStreamACL when the stream's access
+	// control list isn't valid.
+	ErrInvalidStreamACL = errors.New("invalid stream ACL")
+
+	// ErrLastPartition is returned by DeletePartition when attempting to
+	// delete the only partition of a stream, which requires deleting the
+	// stream instead.
+	ErrLastPartition = errors.New("cannot delete the last partition of a stream")
 )
 
+// tombstonedPartition is a partition removed while the Raft log was being
+// recovered, whose data is deleted once recovery completes.
+type tombstonedPartition struct {
+	stream *stream
+	id     int32
+}
+
+// brokerInfo is the connection information reported by a broker.
+type brokerInfo struct {
+	id        string
+	address   HostPort            // Address of the default listener
+	listeners map[string]HostPort // Addresses of the named listeners
+	draining  bool                // Set while the broker is gracefully stopping
+}
+
+// connectionAddress returns the address clients connecting through the given
+// listener should use to reach the broker. Brokers without the listener, e.g.
+// because they haven't been configured with it yet, are reached through their
+// default address.
+func (b *brokerInfo) connectionAddress(listener string) HostPort {
+	if address, ok := b.listeners[listener]; ok {
+		return address
+	}
+	return b.address
+}
+
+// newBrokerInfo creates a brokerInfo from a broker's default address and the
+// addresses of its named listeners.
+func newBrokerInfo(id string, address HostPort, listeners []*proto.AdvertisedListener) *brokerInfo {
+	info := &brokerInfo{id: id, address: address}
+	if len(listeners) > 0 {
+		info.listeners = make(map[string]HostPort, len(listeners))
+		for _, l := range listeners {
+			info.listeners[l.Name] = HostPort{Host: l.Host, Port: int(l.Port)}
+		}
+	}
+	return info
+}
+
 // metadataAPI is the internal API for interacting with cluster data. All
 // stream access should go through the exported methods of the metadataAPI.
 type metadataAPI struct {
 	*Server
-	streams            map[string]*stream
-	mu                 sync.RWMutex
-	partitionFailovers map[*partition]*failoverStatus
-	cachedBrokers      []*client.Broker
-	cachedServerIDs    map[string]struct{}
-	lastCached         time.Time
-	consumerGroupsMu   sync.RWMutex
-	consumerGroups     map[string]*consumerGroup
-	groupFailovers     map[*consumerGroup]*failoverStatus
-	stats              struct {
+	streams              map[string]*stream
+	mu                   sync.RWMutex
+	partitionFailovers   map[*partition]*failoverStatus
+	cachedBrokers        []*brokerInfo
+	cachedServerIDs      map[string]struct{}
+	lastCached           time.Time
+	brokerVersions       map[string]uint32 // Protocol version last reported by each broker
+	lastVersionSurvey    time.Time
+	consumerGroupsMu     sync.RWMutex
+	consumerGroups       map[string]*consumerGroup
+	groupFailovers       map[*consumerGroup]*failoverStatus
+	removedServers       map[string]struct{} // Servers removed from the metadata Raft group
+	scheduledOps         map[uint64]*proto.ScheduledOperation
+	startedMu            sync.Mutex
+	startedWaiters       map[string]map[chan struct{}]struct{}
+	events               *metadataEventBus
+	createRequests       *requestDeduplicator
+	quotas               *producerQuotas
+	replicaRepair        *replicaRepairer
+	reassignments        *reassignmentTracker
+	tombstonedPartitions []*tombstonedPartition // Partitions removed during Raft recovery
+	propagation          *propagationLimiter
+	staleRemoved         int64 // Stale entries removed by sweep
+	limitViolations      int64 // Times a structure was found over its entry limit
+	stats                struct {
 		sync.RWMutex
 		brokerLeaderLoad      map[string]int
 		brokerPartitionLoad   map[string]int
@@ -90,6 +203,16 @@ func newMetadataAPI(s *Server) *metadataAPI {
 		partitionFailovers: make(map[*partition]*failoverStatus),
 		consumerGroups:     make(map[string]*consumerGroup),
 		groupFailovers:     make(map[*consumerGroup]*failoverStatus),
+		startedWaiters:     make(map[string]map[chan struct{}]struct{}),
+		brokerVersions:     make(map[string]uint32),
+		removedServers:     make(map[string]struct{}),
+		scheduledOps:       make(map[uint64]*proto.ScheduledOperation),
+		events:             newMetadataEventBus(),
+		createRequests:     newRequestDeduplicator(s.config.Clustering.RequestDeduplicationWindow),
+		quotas:             newProducerQuotas(s.config.Quotas),
+		replicaRepair:      newReplicaRepairer(),
+		reassignments:      newReassignmentTracker(),
+		propagation:        newPropagationLimiter(s.config.Clustering.PropagateMaxInflight),
 	}
 	m.stats.brokerLeaderLoad = make(map[string]int)
 	m.stats.brokerPartitionLoad = make(map[string]int)
@@ -123,15 +246,18 @@ func (m *metadataAPI) BrokerLeaderCounts() map[string]int {
 
 // FetchMetadata retrieves the cluster metadata for the given request. If the
 // request specifies streams, it will only return metadata for those particular
-// streams. If not, it will return metadata for all streams.
-func (m *metadataAPI) FetchMetadata(ctx context.Context, req *client.FetchMetadataRequest) (
-	*client.FetchMetadataResponse, *status.Status) {
+// streams. If not, it will return metadata for all streams, or only those on
+// the given page if it's not nil, along with the token of the next page. Broker
+// addresses are those advertised for the given listener, or the default
+// listener if empty.
+func (m *metadataAPI) FetchMetadata(ctx context.Context, req *client.FetchMetadataRequest,
+	listener string, page *metadataPage) (*client.FetchMetadataResponse, string, *status.Status) {
 
-	resp := m.createMetadataResponse(req.Streams, req.Groups)
+	resp, nextPageToken := m.createMetadataResponse(req.Streams, req.Groups, page)
 
-	servers, err := m.getClusterServerIDs()
+	servers, err := m.getClusterServerIDs(false)
 	if err != nil {
-		return nil, status.New(codes.Internal, err.Error())
+		return nil, "", status.New(codes.Internal, err.Error())
 	}
 
 	serverIDs := make(map[string]struct{}, len(servers))
@@ -140,15 +266,14 @@ func (m *metadataAPI) FetchMetadata(ctx context.Context, req *client.FetchMetada
 	}
 
 	// Check if we can use cached broker info.
-	if cached, ok := m.brokerCache(serverIDs); ok {
-		resp.Brokers = cached
-	} else {
+	brokers, ok := m.brokerCache(serverIDs)
+	if !ok {
 		// Query broker info from peers.
-		brokers, err := m.fetchBrokerInfo(ctx, len(servers)-1)
-		if err != nil {
-			return nil, err
+		var st *status.Status
+		brokers, st = m.fetchBrokerInfo(ctx, len(servers)-1)
+		if st != nil {
+			return nil, "", st
 		}
-		resp.Brokers = brokers
 
 		// Update the cache.
 		m.mu.Lock()
@@ -158,7 +283,25 @@ func (m *metadataAPI) FetchMetadata(ctx context.Context, req *client.FetchMetada
 		m.mu.Unlock()
 	}
 
-	return resp, nil
+	// The broker load changes as streams are created and leaders change, so
+	// it's always taken from the current counts rather than the cache.
+	var (
+		partitionCounts = m.BrokerPartitionCounts()
+		leaderCounts    = m.BrokerLeaderCounts()
+	)
+	resp.Brokers = make([]*client.Broker, len(brokers))
+	for i, broker := range brokers {
+		address := broker.connectionAddress(listener)
+		resp.Brokers[i] = &client.Broker{
+			Id:             broker.id,
+			Host:           address.Host,
+			Port:           int32(address.Port),
+			PartitionCount: int32(partitionCounts[broker.id]),
+			LeaderCount:    int32(leaderCounts[broker.id]),
+		}
+	}
+
+	return resp, nextPageToken, nil
 }
 
 // FetchPartitionMetadata retrieves the metadata for the partition leader. This
@@ -180,7 +323,7 @@ func (m *metadataAPI) FetchPartitionMetadata(ctx context.Context, req *client.Fe
 // brokerCache checks if the cache of broker metadata is clean and, if it is
 // and it's not past the metadata cache max age, returns the cached broker
 // list. The bool returned indicates if the cached data is returned or not.
-func (m *metadataAPI) brokerCache(serverIDs map[string]struct{}) ([]*client.Broker, bool) {
+func (m *metadataAPI) brokerCache(serverIDs map[string]struct{}) ([]*brokerInfo, bool) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 	serversChanged := false
@@ -203,22 +346,24 @@ func (m *metadataAPI) brokerCache(serverIDs map[string]struct{}) ([]*client.Brok
 	return nil, false
 }
 
-// fetchBrokerInfo retrieves the broker metadata for the cluster. The numPeers
-// argument is the expected number of peers to get a response from.
-func (m *metadataAPI) fetchBrokerInfo(ctx context.Context, numPeers int) ([]*client.Broker, *status.Status) {
-	// Brokers load data
-	partitionCountMap := m.BrokerPartitionCounts()
-	partitionLeaderCountMap := m.BrokerLeaderCounts()
+// fetchBrokerInfo retrieves the broker addresses for the cluster. The
+// numPeers argument is the expected number of peers to get a response from.
+// The returned brokers do not include load counts. The protocol versions
+// reported by the brokers are recorded for minClusterVersion.
+func (m *metadataAPI) fetchBrokerInfo(ctx context.Context, numPeers int) (
+	brokers []*brokerInfo, st *status.Status) {
+
+	ctx, span := m.tracer.Start(ctx, "metadata.fetchBrokerInfo",
+		trace.WithAttributes(expectedPeersAttribute.Int(numPeers)))
+	defer func() {
+		span.SetAttributes(brokersAttribute.Int(len(brokers)))
+		endSpan(span, st)
+	}()
 
 	// Add ourselves.
-	connectionAddress := m.getConnectionAddress()
-	brokers := []*client.Broker{{
-		Id:             m.config.Clustering.ServerID,
-		Host:           connectionAddress.Host,
-		Port:           int32(connectionAddress.Port),
-		PartitionCount: int32(partitionCountMap[m.config.Clustering.ServerID]),
-		LeaderCount:    int32(partitionLeaderCountMap[m.config.Clustering.ServerID]),
-	}}
+	self := newBrokerInfo(m.config.Clustering.ServerID, m.getConnectionAddress(), m.advertisedListeners())
+	self.draining = m.isDraining()
+	brokers = []*brokerInfo{self}
 
 	// Make sure there is a deadline on the request.
 	ctx, cancel := ensureTimeout(ctx, defaultFetchBrokerInfoTimeout)
@@ -233,49 +378,133 @@ func (m *metadataAPI) fetchBrokerInfo(ctx context.Context, numPeers int) ([]*cli
 	defer sub.Unsubscribe()
 
 	// Survey the cluster.
+	address := m.getConnectionAddress()
 	queryReq, err := proto.MarshalServerInfoRequest(&proto.ServerInfoRequest{
-		Id: m.config.Clustering.ServerID,
+		Id:   m.config.Clustering.ServerID,
+		Host: address.Host,
+		Port: int32(address.Port),
 	})
 	if err != nil {
 		panic(err)
 	}
-	if err := m.ncRaft.PublishRequest(m.getServerInfoInbox(), inbox, queryReq); err != nil {
+	if err := m.ncRaft.PublishRequest(m.getServerInfoInbox(), inbox, m.auth.sign(queryReq)); err != nil {
 		return nil, status.New(codes.Internal, err.Error())
 	}
 
 	// Gather responses.
+	var (
+		versions  = make(map[string]uint32, numPeers)
+		addresses = make(serverAddresses, numPeers)
+	)
 	for i := 0; i < numPeers; i++ {
 		msg, err := sub.NextMsgWithContext(ctx)
 		if err != nil {
 			break
 		}
+		if !m.auth.verify(msg.Subject, msg.Data) {
+			continue
+		}
 		queryResp, err := proto.UnmarshalServerInfoResponse(msg.Data)
 		if err != nil {
 			m.logger.Warnf("Received invalid server info response: %v", err)
 			continue
 		}
-		brokers = append(brokers, &client.Broker{
-			Id:             queryResp.Id,
-			Host:           queryResp.Host,
-			Port:           queryResp.Port,
-			PartitionCount: int32(partitionCountMap[queryResp.Id]),
-			LeaderCount:    int32(partitionLeaderCountMap[queryResp.Id]),
-		})
+		broker := newBrokerInfo(queryResp.Id,
+			HostPort{Host: queryResp.Host, Port: int(queryResp.Port)}, queryResp.Listeners)
+		broker.draining = queryResp.Draining
+		brokers = append(brokers, broker)
+		versions[queryResp.Id] = queryResp.ProtocolVersion
+		addresses.add(queryResp.Id, HostPort{Host: queryResp.Host, Port: int(queryResp.Port)})
 	}
+	m.detectDuplicateServerIDs(addresses)
+
+	m.mu.Lock()
+	for id, version := range versions {
+		m.brokerVersions[id] = version
+	}
+	m.lastVersionSurvey = time.Now()
+	m.mu.Unlock()
 
 	return brokers, nil
 }
 
+// minClusterVersion returns the oldest protocol version implemented by the
+// servers in the cluster. Servers which have never reported a version are
+// assumed to predate protocol versioning.
+func (m *metadataAPI) minClusterVersion() (uint32, error) {
+	servers, err := m.getClusterServerIDs(false)
+	if err != nil {
+		return 0, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	minVersion := m.protocolVersion
+	for _, id := range servers {
+		if id == m.config.Clustering.ServerID {
+			continue
+		}
+		if version := m.brokerVersions[id]; version < minVersion {
+			minVersion = version
+		}
+	}
+	return minVersion, nil
+}
+
+// assertMinVersion returns ErrProtocolVersion if any server in the cluster
+// implements a protocol version older than the required one. The cluster is
+// surveyed again if the recorded versions are older than the metadata cache
+// max age or don't meet the requirement, since servers may have been upgraded
+// in the meantime.
+func (m *metadataAPI) assertMinVersion(ctx context.Context, required uint32) error {
+	if required == 0 {
+		return nil
+	}
+	minVersion, err := m.minClusterVersion()
+	if err != nil {
+		return err
+	}
+	m.mu.RLock()
+	stale := time.Since(m.lastVersionSurvey) > m.config.MetadataCacheMaxAge
+	m.mu.RUnlock()
+	if minVersion >= required && !stale {
+		return nil
+	}
+
+	servers, err := m.getClusterServerIDs(false)
+	if err != nil {
+		return err
+	}
+	if _, st := m.fetchBrokerInfo(ctx, len(servers)-1); st != nil {
+		return st.Err()
+	}
+	minVersion, err = m.minClusterVersion()
+	if err != nil {
+		return err
+	}
+	if minVersion < required {
+		return errors.Wrapf(ErrProtocolVersion,
+			"operation requires protocol version %d, cluster minimum is %d", required, minVersion)
+	}
+	return nil
+}
+
 // createMetadataResponse creates a FetchMetadataResponse and populates it with
 // stream and group metadata. If the provided list of stream names is empty, it
-// will populate metadata for all streams. Otherwise, it populates only the
-// specified streams.
-func (m *metadataAPI) createMetadataResponse(streams, groups []string) *client.FetchMetadataResponse {
+// will populate metadata for all streams, or only those on the given page if
+// it's not nil, and return the token of the next page. Otherwise, it populates
+// only the specified streams.
+func (m *metadataAPI) createMetadataResponse(streams, groups []string, page *metadataPage) (
+	*client.FetchMetadataResponse, string) {
+
 	// If no stream names were provided, fetch metadata for all streams.
+	var nextPageToken string
 	if len(streams) == 0 {
 		for _, stream := range m.GetStreams() {
 			streams = append(streams, stream.GetName())
 		}
+		if page != nil {
+			streams, nextPageToken = page.paginate(streams)
+		}
 	}
 
 	streamMetadata := make([]*client.StreamMetadata, len(streams))
@@ -324,7 +553,7 @@ func (m *metadataAPI) createMetadataResponse(streams, groups []string) *client.F
 	return &client.FetchMetadataResponse{
 		StreamMetadata: streamMetadata,
 		GroupMetadata:  groupMetadata,
-	}
+	}, nextPageToken
 }
 
 // CreateStream creates a new stream if this server is the metadata leader. If
@@ -332,8 +561,13 @@ func (m *metadataAPI) createMetadataResponse(streams, groups []string) *client.F
 // response. This operation is replicated by Raft. The metadata leader will
 // select replicationFactor nodes to participate and a leader for each
 // partition.  If successful, this will return once the partitions have been
-// replicated to the cluster and the partition leaders have started.
-func (m *metadataAPI) CreateStream(ctx context.Context, req *proto.CreateStreamOp) *status.Status {
+// replicated to the cluster and the partition leaders have started. Companion
+// streams in the request are created along with the stream.
+func (m *metadataAPI) CreateStream(ctx context.Context, req *proto.CreateStreamOp) (st *status.Status) {
+	ctx, span := m.tracer.Start(ctx, "metadata.CreateStream",
+		trace.WithAttributes(streamAttribute.String(req.Stream.Name)))
+	defer func() { endSpan(span, st) }()
+
 	// Forward the request if we're not the leader.
 	if !m.IsLeader() {
 		isLeader, st := m.propagateCreateStream(ctx, req)
@@ -346,26 +580,55 @@ func (m *metadataAPI) CreateStream(ctx context.Context, req *proto.CreateStreamO
 		}
 	}
 
-	if len(req.Stream.Partitions) == 0 {
-		return status.New(codes.InvalidArgument, "no partitions provided")
+	// A retry of a request which is in progress or completed recently gets
+	// the outcome of the original request rather than being performed again.
+	if req.RequestID != "" && m.createRequests.enabled() {
+		entry, first := m.createRequests.begin(req.RequestID)
+		if !first {
+			return m.createRequests.wait(ctx, entry)
+		}
+		defer func() { m.createRequests.complete(entry, st) }()
 	}
 
-	for _, partition := range req.Stream.Partitions {
-		// Select replicationFactor nodes to participate in the partition.
-		replicas, st := m.getPartitionReplicas(partition.ReplicationFactor)
-		if st != nil {
-			return st
+	// Companion streams are created in the same operation as the stream so
+	// that either all of them exist afterwards or none do.
+	streams := append([]*proto.Stream{req.Stream}, req.Companions...)
+	creationTimestamp := time.Now().UnixNano()
+	for _, stream := range streams {
+		if len(stream.Partitions) == 0 {
+			return status.New(codes.InvalidArgument, "no partitions provided")
 		}
+		if err := validatePartitionSubjects(stream.Partitions); err != nil {
+			return status.Newf(codes.InvalidArgument, "%s", err.Error())
+		}
+
+		for _, partition := range stream.Partitions {
+			// Select replicationFactor nodes to participate in the partition.
+			replicas, st := m.getPartitionReplicas(partition.ReplicationFactor, partition.PreferredReplicas)
+			if st != nil {
+				return st
+			}
 
-		// Select a leader for the partition.
-		leader := m.selectPartitionLeader(replicas)
+			// Select a leader for the partition.
+			leader := m.selectPartitionLeader(replicas)
 
-		partition.Replicas = replicas
-		partition.Isr = replicas
-		partition.Leader = leader
-	}
+			partition.Replicas = replicas
+			partition.Isr = replicas
+			partition.Leader = leader
+			span.AddEvent("partition assigned", trace.WithAttributes(
+				streamAttribute.String(stream.Name),
+				partitionAttribute.Int64(int64(partition.Id)),
+				leaderAttribute.String(leader),
+			))
+		}
 
-	req.Stream.CreationTimestamp = time.Now().UnixNano()
+		stream.CreationTimestamp = creationTimestamp
+		if stream.Origin == nil {
+			// Streams created internally, e.g. the activity stream, only
+			// record the broker which created them.
+			stream.Origin = &proto.StreamOrigin{Broker: m.config.Clustering.ServerID}
+		}
+	}
 
 	// Replicate stream create through Raft.
 	op := &proto.RaftLog{
@@ -373,12 +636,28 @@ func (m *metadataAPI) CreateStream(ctx context.Context, req *proto.CreateStreamO
 		CreateStreamOp: req,
 	}
 
+	// Start watching for the partitions to be started before replicating so
+	// a notification can't be missed.
+	started := make([]map[int32]chan struct{}, len(streams))
+	for i, stream := range streams {
+		ids := make([]int32, len(stream.Partitions))
+		for j, partition := range stream.Partitions {
+			ids[j] = partition.Id
+		}
+		var stopWatching func()
+		started[i], stopWatching = m.watchPartitionsStarted(stream.Name, ids)
+		defer stopWatching()
+	}
+
 	// Wait on result of replication.
 	future, err := m.getRaft().applyOperation(ctx, op, m.checkCreateStreamPreconditions)
 	if err != nil {
 		code := codes.FailedPrecondition
-		if err == ErrStreamExists {
+		switch errors.Cause(err) {
+		case ErrStreamExists:
 			code = codes.AlreadyExists
+		case ErrInvalidSubject, ErrInvalidStreamTags:
+			code = codes.InvalidArgument
 		}
 		return status.Newf(code, "%s", err.Error())
 	}
@@ -388,12 +667,14 @@ func (m *metadataAPI) CreateStream(ctx context.Context, req *proto.CreateStreamO
 
 	// Wait for leaders to create partitions (best effort).
 	var wg sync.WaitGroup
-	wg.Add(len(req.Stream.Partitions))
-	for _, partition := range req.Stream.Partitions {
-		m.startGoroutineWithArgs(func(args ...interface{}) {
-			m.waitForPartitionLeader(ctx, args[0].(*proto.Partition))
-			wg.Done()
-		}, partition)
+	for i, stream := range streams {
+		wg.Add(len(stream.Partitions))
+		for _, partition := range stream.Partitions {
+			m.startGoroutineWithArgs(func(args ...interface{}) {
+				m.waitForPartitionLeader(ctx, args[0].(*proto.Partition), args[1].(chan struct{}))
+				wg.Done()
+			}, partition, started[i][partition.Id])
+		}
 	}
 	wg.Wait()
 
@@ -442,8 +723,15 @@ func (m *metadataAPI) DeleteStream(ctx context.Context, req *proto.DeleteStreamO
 // PauseStream pauses a stream if this server is the metadata leader. If it is
 // not, it will forward the request to the leader and return the response. This
 // operation is replicated by Raft. If successful, this will return once the
-// stream has been paused.
+// stream has been paused. If the request asks to drain the partitions, this
+// first waits for their subscribers as described by drainBeforePause.
 func (m *metadataAPI) PauseStream(ctx context.Context, req *proto.PauseStreamOp) *status.Status {
+	// Let subscribers drain the partitions this server leads first. If the
+	// request is forwarded, the metadata leader does the same for its own.
+	if req.DrainBeforePause {
+		m.drainBeforePause(ctx, req)
+	}
+
 	// Forward the request if we're not the leader.
 	if !m.IsLeader() {
 		isLeader, st := m.propagatePauseStream(ctx, req)
@@ -503,6 +791,11 @@ func (m *metadataAPI) ResumeStream(ctx context.Context, req *proto.ResumeStreamO
 		ResumeStreamOp: req,
 	}
 
+	// Start watching for the partitions to be started before replicating so
+	// a notification can't be missed.
+	started, stopWatching := m.watchPartitionsStarted(req.Stream, req.Partitions)
+	defer stopWatching()
+
 	// Wait on result of replication.
 	future, err := m.getRaft().applyOperation(ctx, op, m.checkResumeStreamPreconditions)
 	if err != nil {
@@ -522,9 +815,9 @@ func (m *metadataAPI) ResumeStream(ctx context.Context, req *proto.ResumeStreamO
 	for _, partitionID := range req.Partitions {
 		partition := m.GetPartition(req.Stream, partitionID)
 		m.startGoroutineWithArgs(func(args ...interface{}) {
-			m.waitForPartitionLeader(ctx, args[0].(*proto.Partition))
+			m.waitForPartitionLeader(ctx, args[0].(*proto.Partition), args[1].(chan struct{}))
 			wg.Done()
-		}, partition.Partition)
+		}, partition.Partition, started[partitionID])
 	}
 	wg.Wait()
 
@@ -697,6 +990,130 @@ func (m *metadataAPI) newPartitionFailoverHandler(p *partition) failoverHandler
 	}
 }
 
+// TransferLeader hands off leadership of the given partition to the target
+// broker if this server is the metadata leader. If it is not, it will forward
+// the request to the leader and return the response. The target must be a
+// member of the partition's ISR. Unlike electNewPartitionLeader, which reacts
+// to an unresponsive leader, this is used to proactively move leadership, e.g.
+// off of a broker that is about to be restarted. This operation is replicated
+// by Raft.
+func (m *metadataAPI) TransferLeader(ctx context.Context, stream string, partitionID int32,
+	targetBroker string) *status.Status {
+
+	req := &proto.ChangeLeaderOp{
+		Stream:    stream,
+		Partition: partitionID,
+		Leader:    targetBroker,
+	}
+
+	// Forward the request if we're not the leader.
+	if !m.IsLeader() {
+		isLeader, st := m.propagateTransferLeader(ctx, req)
+		if st != nil {
+			return st
+		}
+		// If we have since become leader, continue on with the request.
+		if !isLeader {
+			return nil
+		}
+	}
+
+	// Verify the partition exists.
+	partition := m.GetPartition(stream, partitionID)
+	if partition == nil {
+		return status.New(codes.NotFound, fmt.Sprintf("No such partition [stream=%s, partition=%d]",
+			stream, partitionID))
+	}
+
+	// If the target is already the leader, there is nothing to do. Applying
+	// the change anyway would bump the leader epoch and cause the leader to
+	// restart its partition loop.
+	if leader, _ := partition.GetLeader(); leader == targetBroker {
+		return nil
+	}
+
+	// Replicate leader change through Raft.
+	op := &proto.RaftLog{
+		Op:             proto.Op_CHANGE_LEADER,
+		ChangeLeaderOp: req,
+	}
+
+	// Wait on result of replication.
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkTransferLeaderPreconditions)
+	if err != nil {
+		code := codes.FailedPrecondition
+		if err == ErrStreamNotFound || err == ErrPartitionNotFound {
+			code = codes.NotFound
+		}
+		return status.Newf(code, "%s", err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to replicate leader change: %v", err.Error())
+	}
+
+	return nil
+}
+
+// WaitForLeaderTransfer blocks until the target broker reports that it has
+// assumed leadership of the given partition. It returns a DeadlineExceeded
+// status if this does not happen before the Context deadline, or
+// defaultPropagateTimeout if the Context has no deadline.
+func (m *metadataAPI) WaitForLeaderTransfer(ctx context.Context, stream string, partitionID int32,
+	targetBroker string) *status.Status {
+
+	ctx, cancel := ensureTimeout(ctx, defaultPropagateTimeout)
+	defer cancel()
+
+	req, err := proto.MarshalPartitionStatusRequest(&proto.PartitionStatusRequest{
+		Stream:    stream,
+		Partition: partitionID,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	req = m.auth.sign(req)
+
+	inbox := m.getPartitionStatusInbox(targetBroker)
+	for {
+		if m.isPartitionLeader(ctx, inbox, req, targetBroker, stream, partitionID) {
+			return nil
+		}
+		select {
+		case <-time.After(100 * time.Millisecond):
+		case <-ctx.Done():
+			return status.Newf(codes.DeadlineExceeded,
+				"Timed out waiting for %s to assume leadership of partition [stream=%s, partition=%d]",
+				targetBroker, stream, partitionID)
+		}
+	}
+}
+
+// isPartitionLeader indicates if the given broker is currently leading the
+// partition. If the broker is this server, the partition is checked locally.
+// Otherwise, a status request is sent to the broker's partition status inbox.
+func (m *metadataAPI) isPartitionLeader(ctx context.Context, inbox string, req []byte,
+	broker, stream string, partitionID int32) bool {
+
+	if broker == m.config.Clustering.ServerID {
+		partition := m.GetPartition(stream, partitionID)
+		return partition != nil && partition.IsLeader()
+	}
+
+	resp, err := m.ncRaft.RequestWithContext(ctx, inbox, req)
+	if err != nil || !m.auth.verify(resp.Subject, resp.Data) {
+		return false
+	}
+	statusResp, err := proto.UnmarshalPartitionStatusResponse(resp.Data)
+	if err != nil {
+		m.logger.Warnf(
+			"Invalid status response for partition [stream=%s, partition=%d] from %s: %v",
+			stream, partitionID, broker, err)
+		return false
+	}
+	return statusResp.Exists && statusResp.IsLeader
+}
+
 // SetStreamReadonly sets a stream's readonly flag if this server is the
 // metadata leader. If it is not, it will forward the request to the leader and
 // return the response. This operation is replicated by Raft. If successful,
@@ -714,6 +1131,17 @@ func (m *metadataAPI) SetStreamReadonly(ctx context.Context, req *proto.SetStrea
 		}
 	}
 
+	// Older servers would ignore the target offset.
+	if req.TargetOffset != nil {
+		if err := m.assertMinVersion(ctx, readonlyTargetProtocolVersion); err != nil {
+			code := codes.Internal
+			if errors.Cause(err) == ErrProtocolVersion {
+				code = codes.FailedPrecondition
+			}
+			return status.New(code, err.Error())
+		}
+	}
+
 	// Replicate the stream readonly flag through Raft.
 	op := &proto.RaftLog{
 		Op:                  proto.Op_SET_STREAM_READONLY,
@@ -736,71 +1164,60 @@ func (m *metadataAPI) SetStreamReadonly(ctx context.Context, req *proto.SetStrea
 	return nil
 }
 
-// JoinConsumerGroup adds a consumer to a consumer group if this server is the
-// metadata leader. The group is created first if it does not yet exist. If
-// this server is not the metadata leader, it will forward the request to the
-// leader and return the response. This operation is replicated by Raft. If
-// successful, this will return once the consumer has been added to the group.
-// Returns the group coordinator ID and coordinator epoch on success.
-func (m *metadataAPI) JoinConsumerGroup(ctx context.Context, req *proto.JoinConsumerGroupOp) (
-	string, uint64, *status.Status) {
-
+// UpdateStreamOwner sets the owner of a stream if this server is the metadata
+// leader. If it is not, it will forward the request to the leader and return
+// the response. This operation is replicated by Raft.
+func (m *metadataAPI) UpdateStreamOwner(ctx context.Context, req *proto.UpdateStreamOwnerOp) *status.Status {
 	// Forward the request if we're not the leader.
 	if !m.IsLeader() {
-		resp, isLeader, st := m.propagateJoinConsumerGroup(ctx, req)
+		isLeader, st := m.propagateUpdateStreamOwner(ctx, req)
 		if st != nil {
-			return "", 0, st
+			return st
 		}
 		// If we have since become leader, continue on with the request.
 		if !isLeader {
-			return resp.Coordinator, resp.Epoch, nil
+			return nil
 		}
 	}
 
-	// Check if group exists. If it doesn't, create it with the member.
-	m.consumerGroupsMu.RLock()
-	group, ok := m.consumerGroups[req.GroupId]
-	m.consumerGroupsMu.RUnlock()
-	if !ok {
-		coordinator, err := m.createConsumerGroup(ctx, req)
-		if err != nil {
-			return "", 0, status.New(codes.FailedPrecondition, err.Error())
-		}
-		// The coordinator epoch for a new group is always 0.
-		return coordinator, 0, nil
+	// Replicate the owner change through Raft.
+	op := &proto.RaftLog{
+		Op:                  proto.Op_UPDATE_STREAM_OWNER,
+		UpdateStreamOwnerOp: req,
 	}
 
-	// If the group already existed, replicate the join request through Raft.
-	op := &proto.RaftLog{
-		Op:                  proto.Op_JOIN_CONSUMER_GROUP,
-		JoinConsumerGroupOp: req,
+	// Older servers are unable to apply the operation.
+	if err := m.assertMinVersion(ctx, opProtocolVersions[op.Op]); err != nil {
+		code := codes.Internal
+		if errors.Cause(err) == ErrProtocolVersion {
+			code = codes.FailedPrecondition
+		}
+		return status.New(code, err.Error())
 	}
 
 	// Wait on result of replication.
-	future, err := m.getRaft().applyOperation(ctx, op, m.checkJoinConsumerGroupPreconditions)
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkUpdateStreamOwnerPreconditions)
 	if err != nil {
 		code := codes.FailedPrecondition
-		if err == ErrConsumerGroupNotFound {
+		if err == ErrStreamNotFound {
 			code = codes.NotFound
 		}
-		return "", 0, status.New(code, err.Error())
+		return status.Newf(code, "%s", err.Error())
 	}
 	if err := future.Error(); err != nil {
-		return "", 0, status.Newf(codes.Internal, "Failed to join consumer group: %v", err.Error())
+		return status.Newf(codes.Internal, "Failed to update stream owner: %v", err.Error())
 	}
 
-	coordinator, epoch := group.GetCoordinator()
-	return coordinator, epoch, nil
+	return nil
 }
 
-// LeaveConsumerGroup removes a consumer from a consumer group. If this is the
-// last member of the group, the group will be deleted. This operation is
-// replicated by Raft. If successful, this will return once the consumer has
-// been removed from the group.
-func (m *metadataAPI) LeaveConsumerGroup(ctx context.Context, req *proto.LeaveConsumerGroupOp) *status.Status {
+// SetStreamTags replaces the tags of a stream if this server is the metadata
+// leader. If it is not, it will forward the request to the leader and return
+// the response. This operation is replicated by Raft.
+func (m *metadataAPI) SetStreamTags(ctx context.Context, req *proto.SetStreamTagsOp) *status.Status {
 	// Forward the request if we're not the leader.
 	if !m.IsLeader() {
-		isLeader, st := m.propagateLeaveConsumerGroup(ctx, req)
+		isLeader, st := m.propagateSetStreamTags(ctx, req)
 		if st != nil {
 			return st
 		}
@@ -810,37 +1227,47 @@ func (m *metadataAPI) LeaveConsumerGroup(ctx context.Context, req *proto.LeaveCo
 		}
 	}
 
-	// Replicate the leave request through Raft.
+	// Replicate the tags change through Raft.
 	op := &proto.RaftLog{
-		Op:                   proto.Op_LEAVE_CONSUMER_GROUP,
-		LeaveConsumerGroupOp: req,
+		Op:              proto.Op_SET_STREAM_TAGS,
+		SetStreamTagsOp: req,
+	}
+
+	// Older servers are unable to apply the operation.
+	if err := m.assertMinVersion(ctx, opProtocolVersions[op.Op]); err != nil {
+		code := codes.Internal
+		if errors.Cause(err) == ErrProtocolVersion {
+			code = codes.FailedPrecondition
+		}
+		return status.New(code, err.Error())
 	}
 
 	// Wait on result of replication.
-	future, err := m.getRaft().applyOperation(ctx, op, m.checkLeaveConsumerGroupPreconditions)
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkSetStreamTagsPreconditions)
 	if err != nil {
 		code := codes.FailedPrecondition
-		if err == ErrConsumerGroupNotFound {
+		switch errors.Cause(err) {
+		case ErrStreamNotFound:
 			code = codes.NotFound
+		case ErrInvalidStreamTags:
+			code = codes.InvalidArgument
 		}
-		return status.New(code, err.Error())
+		return status.Newf(code, "%s", err.Error())
 	}
 	if err := future.Error(); err != nil {
-		return status.Newf(codes.Internal, "Failed to set stream readonly flag: %v", err.Error())
+		return status.Newf(codes.Internal, "Failed to set stream tags: %v", err.Error())
 	}
 
 	return nil
 }
 
-// ReportGroupCoordinator marks the consumer group coordinator as unresponsive
-// with respect to the specified member if this server is the metadata leader.
-// If it is not, it will forward the request to the leader and return the
-// response. If a quorum of members report the coordinator within a bounded
-// period, the metadata leader will select a new group coordinator.
-func (m *metadataAPI) ReportGroupCoordinator(ctx context.Context, req *proto.ReportConsumerGroupCoordinatorOp) *status.Status {
+// SetStreamACL replaces the access control list of a stream if this server is
+// the metadata leader. If it is not, it will forward the request to the leader
+// and return the response. This operation is replicated by Raft.
+func (m *metadataAPI) SetStreamACL(ctx context.Context, req *proto.SetStreamACLOp) *status.Status {
 	// Forward the request if we're not the leader.
 	if !m.IsLeader() {
-		isLeader, st := m.propagateReportGroupCoordinator(ctx, req)
+		isLeader, st := m.propagateSetStreamACL(ctx, req)
 		if st != nil {
 			return st
 		}
@@ -850,26 +1277,536 @@ func (m *metadataAPI) ReportGroupCoordinator(ctx context.Context, req *proto.Rep
 		}
 	}
 
-	// Verify the group exists.
-	group := m.GetConsumerGroup(req.GroupId)
-	if group == nil {
-		return status.New(codes.FailedPrecondition, fmt.Sprintf("No such consumer group %s", req.GroupId))
+	// Replicate the ACL change through Raft.
+	op := &proto.RaftLog{
+		Op:             proto.Op_SET_STREAM_ACL,
+		SetStreamACLOp: req,
 	}
 
-	// Check the group epoch.
-	coordinator, epoch := group.GetCoordinator()
-	if req.Coordinator != coordinator || req.Epoch != epoch {
-		return status.New(
-			codes.FailedPrecondition,
-			fmt.Sprintf("Coordinator generation mismatch, current coordinator: %s epoch: %d, got coordinator: %s epoch: %d",
-				coordinator, epoch, req.Coordinator, req.Epoch))
+	// Older servers are unable to apply the operation.
+	if err := m.assertMinVersion(ctx, opProtocolVersions[op.Op]); err != nil {
+		code := codes.Internal
+		if errors.Cause(err) == ErrProtocolVersion {
+			code = codes.FailedPrecondition
+		}
+		return status.New(code, err.Error())
 	}
 
-	// Ensure the consumer is actually a member.
-	if !group.IsMember(req.ConsumerId) {
-		return status.New(
-			codes.FailedPrecondition,
-			fmt.Sprintf("Consumer %s is not a member of consumer group %s", req.ConsumerId, req.GroupId))
+	// Wait on result of replication.
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkSetStreamACLPreconditions)
+	if err != nil {
+		code := codes.FailedPrecondition
+		switch errors.Cause(err) {
+		case ErrStreamNotFound:
+			code = codes.NotFound
+		case ErrInvalidStreamACL:
+			code = codes.InvalidArgument
+		}
+		return status.Newf(code, "%s", err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to set stream ACL: %v", err.Error())
+	}
+
+	return nil
+}
+
+// DeletePartitionData deletes the messages of a partition before an offset by
+// advancing the log start offset on each of its replicas if this server is
+// the metadata leader. If it is not, it will forward the request to the leader
+// and return the response. This operation is replicated by Raft. An OutOfRange
+// status is returned if the offset is past the partition leader's HW + 1
+// since only committed messages can be deleted.
+func (m *metadataAPI) DeletePartitionData(ctx context.Context, req *proto.DeletePartitionDataOp) *status.Status {
+	// Forward the request if we're not the leader.
+	if !m.IsLeader() {
+		isLeader, st := m.propagateDeletePartitionData(ctx, req)
+		if st != nil {
+			return st
+		}
+		// If we have since become leader, continue on with the request.
+		if !isLeader {
+			return nil
+		}
+	}
+
+	// Replicate the deletion through Raft.
+	op := &proto.RaftLog{
+		Op:                    proto.Op_DELETE_PARTITION_DATA,
+		DeletePartitionDataOp: req,
+	}
+
+	// Older servers are unable to apply the operation.
+	if err := m.assertMinVersion(ctx, opProtocolVersions[op.Op]); err != nil {
+		code := codes.Internal
+		if errors.Cause(err) == ErrProtocolVersion {
+			code = codes.FailedPrecondition
+		}
+		return status.New(code, err.Error())
+	}
+
+	if st := m.checkDeletePartitionDataOffset(ctx, req); st != nil {
+		return st
+	}
+
+	// Wait on result of replication.
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkDeletePartitionDataPreconditions)
+	if err != nil {
+		code := codes.FailedPrecondition
+		switch errors.Cause(err) {
+		case ErrStreamNotFound, ErrPartitionNotFound:
+			code = codes.NotFound
+		}
+		return status.Newf(code, "%s", err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to delete partition data: %v", err.Error())
+	}
+
+	return nil
+}
+
+// DeletePartition deletes a single partition of a stream and its data if this
+// server is the metadata leader. If it is not, it will forward the request to
+// the leader and return the response. This operation is replicated by Raft.
+// If successful, this will return once the partition has been deleted from
+// the cluster.
+func (m *metadataAPI) DeletePartition(ctx context.Context, req *proto.DeletePartitionOp) *status.Status {
+	// Forward the request if we're not the leader.
+	if !m.IsLeader() {
+		isLeader, st := m.propagateDeletePartition(ctx, req)
+		if st != nil {
+			return st
+		}
+		// If we have since become leader, continue on with the request.
+		if !isLeader {
+			return nil
+		}
+	}
+
+	// Replicate partition deletion through Raft.
+	op := &proto.RaftLog{
+		Op:                proto.Op_DELETE_PARTITION,
+		DeletePartitionOp: req,
+	}
+
+	// Older servers are unable to apply the operation.
+	if err := m.assertMinVersion(ctx, opProtocolVersions[op.Op]); err != nil {
+		code := codes.Internal
+		if errors.Cause(err) == ErrProtocolVersion {
+			code = codes.FailedPrecondition
+		}
+		return status.New(code, err.Error())
+	}
+
+	// Wait on result of deletion.
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkDeletePartitionPreconditions)
+	if err != nil {
+		code := codes.FailedPrecondition
+		switch errors.Cause(err) {
+		case ErrStreamNotFound, ErrPartitionNotFound:
+			code = codes.NotFound
+		}
+		return status.Newf(code, "%s", err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to delete partition: %v", err.Error())
+	}
+
+	return nil
+}
+
+// checkDeletePartitionDataOffset asks the partition leader for its HW and
+// returns an OutOfRange status if the offset messages are being deleted before
+// is past the HW + 1, i.e. if uncommitted messages would be deleted.
+func (m *metadataAPI) checkDeletePartitionDataOffset(ctx context.Context,
+	req *proto.DeletePartitionDataOp) *status.Status {
+
+	partition := m.GetPartition(req.Stream, req.Partition)
+	if partition == nil {
+		return status.Newf(codes.NotFound, "No such partition [stream=%s, partition=%d]",
+			req.Stream, req.Partition)
+	}
+	leader, _ := partition.GetLeader()
+	if leader == "" {
+		return status.Newf(codes.Unavailable, "Partition [stream=%s, partition=%d] has no leader",
+			req.Stream, req.Partition)
+	}
+	st := m.localPartitionStatus(req.Stream, req.Partition)
+	if leader != m.config.Clustering.ServerID {
+		st = m.fetchReplicaStatus(ctx, leader, req.Stream, req.Partition)
+	}
+	if st.Error != "" {
+		return status.Newf(codes.Unavailable, "Failed to fetch status of partition leader %s: %s",
+			leader, st.Error)
+	}
+	if !st.IsLeader {
+		return status.Newf(codes.Unavailable, "Broker %s is not leading partition [stream=%s, partition=%d]",
+			leader, req.Stream, req.Partition)
+	}
+	if req.Offset > st.HighWatermark+1 {
+		return status.Newf(codes.OutOfRange,
+			"Offset %d is past the high watermark %d of partition [stream=%s, partition=%d]",
+			req.Offset, st.HighWatermark, req.Stream, req.Partition)
+	}
+	return nil
+}
+
+// UpdateStreamConfig changes the configuration of a stream if this server is
+// the metadata leader. If it is not, it will forward the request to the leader
+// and return the response. This operation is replicated by Raft. If
+// successful, this will return once the change has been applied.
+func (m *metadataAPI) UpdateStreamConfig(ctx context.Context, req *proto.UpdateStreamConfigOp) *status.Status {
+	if err := validateStreamConfigUpdate(req.Config); err != nil {
+		return status.New(codes.InvalidArgument, err.Error())
+	}
+
+	// Forward the request if we're not the leader.
+	if !m.IsLeader() {
+		isLeader, st := m.propagateUpdateStreamConfig(ctx, req)
+		if st != nil {
+			return st
+		}
+		// If we have since become leader, continue on with the request.
+		if !isLeader {
+			return nil
+		}
+	}
+
+	// Replicate the config change through Raft.
+	op := &proto.RaftLog{
+		Op:                   proto.Op_UPDATE_STREAM_CONFIG,
+		UpdateStreamConfigOp: req,
+	}
+
+	// Older servers are unable to apply the operation.
+	if err := m.assertMinVersion(ctx, opProtocolVersions[op.Op]); err != nil {
+		code := codes.Internal
+		if errors.Cause(err) == ErrProtocolVersion {
+			code = codes.FailedPrecondition
+		}
+		return status.New(code, err.Error())
+	}
+
+	// Wait on result of replication.
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkUpdateStreamConfigPreconditions)
+	if err != nil {
+		code := codes.FailedPrecondition
+		if err == ErrStreamNotFound {
+			code = codes.NotFound
+		}
+		return status.Newf(code, "%s", err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to update stream config: %v", err.Error())
+	}
+
+	return nil
+}
+
+// validateStreamConfigUpdate returns an error if the given config doesn't
+// change any settings, changes settings which can't be changed once the
+// stream is created, or has invalid values. Only the retention, segment, and
+// compaction settings can be changed.
+func validateStreamConfigUpdate(config *proto.StreamConfig) error {
+	if config == nil {
+		return errors.New("no config provided")
+	}
+	limits := []struct {
+		setting string
+		value   *proto.NullableInt64
+	}{
+		{configStreamsRetentionMaxBytes, config.RetentionMaxBytes},
+		{configStreamsRetentionMaxMessages, config.RetentionMaxMessages},
+		{configStreamsRetentionMaxAge, config.RetentionMaxAge},
+		{configStreamsSegmentMaxBytes, config.SegmentMaxBytes},
+		{configStreamsSegmentMaxAge, config.SegmentMaxAge},
+	}
+	for _, limit := range limits {
+		if limit.value != nil && limit.value.Value < 0 {
+			return fmt.Errorf("invalid %s %d", limit.setting, limit.value.Value)
+		}
+	}
+
+	// Clear the settings which can be changed to check for others.
+	others := *config
+	others.RetentionMaxBytes = nil
+	others.RetentionMaxMessages = nil
+	others.RetentionMaxAge = nil
+	others.SegmentMaxBytes = nil
+	others.SegmentMaxAge = nil
+	others.CompactEnabled = nil
+	if others.Size() > 0 {
+		return errors.New("only retention, segment, and compaction settings can be updated")
+	}
+	if config.Size() == 0 {
+		return errors.New("no settings provided")
+	}
+	return nil
+}
+
+// RegisterProducer registers an exclusive producer on a stream if this server
+// is the metadata leader. If it is not, it will forward the request to the
+// leader and return the response. This operation is replicated by Raft. The
+// Raft index of the operation is returned as the registration's epoch, which
+// fences publishes carrying an older epoch for the producer name.
+func (m *metadataAPI) RegisterProducer(ctx context.Context, req *proto.RegisterProducerOp) (
+	uint64, *status.Status) {
+
+	// Forward the request if we're not the leader.
+	if !m.IsLeader() {
+		resp, isLeader, st := m.propagateRegisterProducer(ctx, req)
+		if st != nil {
+			return 0, st
+		}
+		// If we have since become leader, continue on with the request.
+		if !isLeader {
+			return resp.Epoch, nil
+		}
+	}
+
+	// The expiration is computed by the leader so every server applies the
+	// same registration.
+	if req.Ttl > 0 {
+		req.ExpiresAt = time.Now().Add(time.Duration(req.Ttl) * time.Millisecond).UnixNano()
+	}
+
+	// Replicate the registration through Raft.
+	op := &proto.RaftLog{
+		Op:                 proto.Op_REGISTER_PRODUCER,
+		RegisterProducerOp: req,
+	}
+
+	// Older servers are unable to apply the operation.
+	if err := m.assertMinVersion(ctx, opProtocolVersions[op.Op]); err != nil {
+		code := codes.Internal
+		if errors.Cause(err) == ErrProtocolVersion {
+			code = codes.FailedPrecondition
+		}
+		return 0, status.New(code, err.Error())
+	}
+
+	// Wait on result of replication.
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkRegisterProducerPreconditions)
+	if err != nil {
+		code := codes.FailedPrecondition
+		if err == ErrStreamNotFound {
+			code = codes.NotFound
+		}
+		return 0, status.Newf(code, "%s", err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return 0, status.Newf(codes.Internal, "Failed to register producer: %v", err.Error())
+	}
+
+	return future.Index(), nil
+}
+
+// ReleaseProducer releases the registration of an exclusive producer if this
+// server is the metadata leader. If it is not, it will forward the request to
+// the leader and return the response. This operation is replicated by Raft.
+// An Aborted status is returned if the producer has been registered again
+// since it was registered with the given epoch.
+func (m *metadataAPI) ReleaseProducer(ctx context.Context, req *proto.ReleaseProducerOp) *status.Status {
+	// Forward the request if we're not the leader.
+	if !m.IsLeader() {
+		isLeader, st := m.propagateReleaseProducer(ctx, req)
+		if st != nil {
+			return st
+		}
+		// If we have since become leader, continue on with the request.
+		if !isLeader {
+			return nil
+		}
+	}
+
+	// Replicate the release through Raft.
+	op := &proto.RaftLog{
+		Op:                proto.Op_RELEASE_PRODUCER,
+		ReleaseProducerOp: req,
+	}
+
+	// Older servers are unable to apply the operation.
+	if err := m.assertMinVersion(ctx, opProtocolVersions[op.Op]); err != nil {
+		code := codes.Internal
+		if errors.Cause(err) == ErrProtocolVersion {
+			code = codes.FailedPrecondition
+		}
+		return status.New(code, err.Error())
+	}
+
+	// Wait on result of replication.
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkReleaseProducerPreconditions)
+	if err != nil {
+		code := codes.FailedPrecondition
+		switch err {
+		case ErrStreamNotFound, ErrProducerNotRegistered:
+			code = codes.NotFound
+		case ErrProducerFenced:
+			code = codes.Aborted
+		}
+		return status.Newf(code, "%s", err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to release producer: %v", err.Error())
+	}
+
+	return nil
+}
+
+// ReportPartitionSkew records a change in the skew of a stream's partition
+// load detected by the metadata leader. This operation is replicated by Raft
+// so that every server knows which streams are skewed and the change is
+// published to the activity stream.
+func (m *metadataAPI) ReportPartitionSkew(ctx context.Context, skew *proto.StreamSkew) error {
+	op := &proto.RaftLog{
+		Op:                    proto.Op_REPORT_PARTITION_SKEW,
+		ReportPartitionSkewOp: &proto.ReportPartitionSkewOp{Skew: skew},
+	}
+	if err := m.assertMinVersion(ctx, opProtocolVersions[op.Op]); err != nil {
+		return err
+	}
+	future, err := m.getRaft().applyOperation(ctx, op, nil)
+	if err != nil {
+		return err
+	}
+	return future.Error()
+}
+
+// JoinConsumerGroup adds a consumer to a consumer group if this server is the
+// metadata leader. The group is created first if it does not yet exist. If
+// this server is not the metadata leader, it will forward the request to the
+// leader and return the response. This operation is replicated by Raft. If
+// successful, this will return once the consumer has been added to the group.
+// Returns the group coordinator ID and coordinator epoch on success.
+func (m *metadataAPI) JoinConsumerGroup(ctx context.Context, req *proto.JoinConsumerGroupOp) (
+	string, uint64, *status.Status) {
+
+	// Forward the request if we're not the leader.
+	if !m.IsLeader() {
+		resp, isLeader, st := m.propagateJoinConsumerGroup(ctx, req)
+		if st != nil {
+			return "", 0, st
+		}
+		// If we have since become leader, continue on with the request.
+		if !isLeader {
+			return resp.Coordinator, resp.Epoch, nil
+		}
+	}
+
+	// Check if group exists. If it doesn't, create it with the member.
+	m.consumerGroupsMu.RLock()
+	group, ok := m.consumerGroups[req.GroupId]
+	m.consumerGroupsMu.RUnlock()
+	if !ok {
+		coordinator, err := m.createConsumerGroup(ctx, req)
+		if err != nil {
+			return "", 0, status.New(codes.FailedPrecondition, err.Error())
+		}
+		// The coordinator epoch for a new group is always 0.
+		return coordinator, 0, nil
+	}
+
+	// If the group already existed, replicate the join request through Raft.
+	op := &proto.RaftLog{
+		Op:                  proto.Op_JOIN_CONSUMER_GROUP,
+		JoinConsumerGroupOp: req,
+	}
+
+	// Wait on result of replication.
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkJoinConsumerGroupPreconditions)
+	if err != nil {
+		code := codes.FailedPrecondition
+		if err == ErrConsumerGroupNotFound {
+			code = codes.NotFound
+		}
+		return "", 0, status.New(code, err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return "", 0, status.Newf(codes.Internal, "Failed to join consumer group: %v", err.Error())
+	}
+
+	coordinator, epoch := group.GetCoordinator()
+	return coordinator, epoch, nil
+}
+
+// LeaveConsumerGroup removes a consumer from a consumer group. If this is the
+// last member of the group, the group will be deleted. This operation is
+// replicated by Raft. If successful, this will return once the consumer has
+// been removed from the group.
+func (m *metadataAPI) LeaveConsumerGroup(ctx context.Context, req *proto.LeaveConsumerGroupOp) *status.Status {
+	// Forward the request if we're not the leader.
+	if !m.IsLeader() {
+		isLeader, st := m.propagateLeaveConsumerGroup(ctx, req)
+		if st != nil {
+			return st
+		}
+		// If we have since become leader, continue on with the request.
+		if !isLeader {
+			return nil
+		}
+	}
+
+	// Replicate the leave request through Raft.
+	op := &proto.RaftLog{
+		Op:                   proto.Op_LEAVE_CONSUMER_GROUP,
+		LeaveConsumerGroupOp: req,
+	}
+
+	// Wait on result of replication.
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkLeaveConsumerGroupPreconditions)
+	if err != nil {
+		code := codes.FailedPrecondition
+		if err == ErrConsumerGroupNotFound {
+			code = codes.NotFound
+		}
+		return status.New(code, err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to set stream readonly flag: %v", err.Error())
+	}
+
+	return nil
+}
+
+// ReportGroupCoordinator marks the consumer group coordinator as unresponsive
+// with respect to the specified member if this server is the metadata leader.
+// If it is not, it will forward the request to the leader and return the
+// response. If a quorum of members report the coordinator within a bounded
+// period, the metadata leader will select a new group coordinator.
+func (m *metadataAPI) ReportGroupCoordinator(ctx context.Context, req *proto.ReportConsumerGroupCoordinatorOp) *status.Status {
+	// Forward the request if we're not the leader.
+	if !m.IsLeader() {
+		isLeader, st := m.propagateReportGroupCoordinator(ctx, req)
+		if st != nil {
+			return st
+		}
+		// If we have since become leader, continue on with the request.
+		if !isLeader {
+			return nil
+		}
+	}
+
+	// Verify the group exists.
+	group := m.GetConsumerGroup(req.GroupId)
+	if group == nil {
+		return status.New(codes.FailedPrecondition, fmt.Sprintf("No such consumer group %s", req.GroupId))
+	}
+
+	// Check the group epoch.
+	coordinator, epoch := group.GetCoordinator()
+	if req.Coordinator != coordinator || req.Epoch != epoch {
+		return status.New(
+			codes.FailedPrecondition,
+			fmt.Sprintf("Coordinator generation mismatch, current coordinator: %s epoch: %d, got coordinator: %s epoch: %d",
+				coordinator, epoch, req.Coordinator, req.Epoch))
+	}
+
+	// Ensure the consumer is actually a member.
+	if !group.IsMember(req.ConsumerId) {
+		return status.New(
+			codes.FailedPrecondition,
+			fmt.Sprintf("Consumer %s is not a member of consumer group %s", req.ConsumerId, req.GroupId))
 	}
 
 	m.consumerGroupsMu.Lock()
@@ -909,7 +1846,7 @@ func (m *metadataAPI) newGroupFailoverHandler(g *consumerGroup) failoverHandler
 // will return an error. This will also return an error if the group being
 // created already exists. This returns the group coordinator on success.
 func (m *metadataAPI) createConsumerGroup(ctx context.Context, req *proto.JoinConsumerGroupOp) (string, error) {
-	brokers, err := m.getClusterServerIDs()
+	brokers, err := m.getClusterServerIDs(false)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to select group coordinator")
 	}
@@ -940,17 +1877,24 @@ func (m *metadataAPI) createConsumerGroup(ctx context.Context, req *proto.JoinCo
 }
 
 // AddConsumerGroup adds the given consumer group to the metadata store. It
-// returns an error if a consumer group with the same ID already exists.
+// returns an error if a consumer group with the same ID already exists unless
+// the group is recovered, in which case the existing group is returned.
 func (m *metadataAPI) AddConsumerGroup(protoGroup *proto.ConsumerGroup, recovered bool) (*consumerGroup, error) {
 	m.consumerGroupsMu.Lock()
 	defer m.consumerGroupsMu.Unlock()
 
-	if _, ok := m.consumerGroups[protoGroup.Id]; ok {
+	if existing, ok := m.consumerGroups[protoGroup.Id]; ok {
+		// The group may have been created by this same operation already
+		// if it's being applied during recovery, e.g. on top of a snapshot
+		// restored from a backup.
+		if recovered {
+			return existing, nil
+		}
 		return nil, ErrConsumerGroupExists
 	}
 
 	group := newConsumerGroup(m.config.Clustering.ServerID, m.config.Groups.ConsumerTimeout,
-		protoGroup, recovered, m.logger, m.removeConsumerGroupMember, m.countStreamPartitions)
+		protoGroup, recovered, m.logger, m.removeConsumerGroupMember, m.streamPartitionIDs)
 	m.consumerGroups[protoGroup.Id] = group
 	coordinator, _ := group.GetCoordinator()
 
@@ -976,14 +1920,14 @@ func (m *metadataAPI) removeConsumerGroupMember(groupID, consumerID string) erro
 	return nil
 }
 
-// countStreamPartitions returns the number of partitions for the stream or 0
-// if the stream does not exist.
-func (m *metadataAPI) countStreamPartitions(streamName string) int32 {
+// streamPartitionIDs returns the IDs of the stream's partitions in ascending
+// order or nil if the stream does not exist.
+func (m *metadataAPI) streamPartitionIDs(streamName string) []int32 {
 	stream := m.GetStream(streamName)
 	if stream == nil {
-		return 0
+		return nil
 	}
-	return int32(len(stream.GetPartitions()))
+	return stream.GetPartitionIDs()
 }
 
 // removeConsumerGroup removes the given consumer group from the metadata
@@ -995,6 +1939,10 @@ func (m *metadataAPI) removeConsumerGroup(groupID string) {
 	}
 	group.Close()
 	delete(m.consumerGroups, groupID)
+	if failover, ok := m.groupFailovers[group]; ok {
+		failover.cancel()
+		delete(m.groupFailovers, group)
+	}
 	coordinator, _ := group.GetCoordinator()
 
 	// Update broker load counts.
@@ -1065,7 +2013,8 @@ func (m *metadataAPI) GetConsumerGroupAssignments(groupID, consumerID string, ep
 // AddStream adds the given stream and its partitions to the metadata store. It
 // returns an error if a stream with the same name or any partitions with the
 // same ID for the stream already exist. If the stream is recovered, this will
-// not start the partitions until recovery completes. Partitions will also not
+// not start the partitions until recovery completes, and the existing stream
+// is returned if it has the same creation timestamp. Partitions will also not
 // be started if they are currently paused.
 func (m *metadataAPI) AddStream(protoStream *proto.Stream, recovered bool, epoch uint64) (*stream, error) {
 	if len(protoStream.Partitions) == 0 {
@@ -1080,26 +2029,37 @@ func (m *metadataAPI) AddStream(protoStream *proto.Stream, recovered bool, epoch
 		if !recovered {
 			return nil, ErrStreamExists
 		}
-		// If this operation is being applied during recovery, check if this
-		// stream is tombstoned, i.e. was marked for deletion previously. If it
-		// is, un-tombstone it by closing the existing stream and then
-		// recreating it, leaving the existing data intact.
-		if !existing.IsTombstoned() {
-			// This is an invalid state because it means the stream already
-			// exists.
-			return nil, ErrStreamExists
-		}
-		// Un-tombstone by closing the existing stream and removing it from
-		// the streams store so that it can be recreated.
+		// If this operation is being applied during recovery, the stream may
+		// have been created by this same operation already, e.g. when a
+		// snapshot restored from a backup is newer than the log being
+		// replayed. In that case, there's nothing to do.
+		sameStream := existing.GetCreationTime().UnixNano() == protoStream.CreationTimestamp
+		if !existing.IsTombstoned() && sameStream {
+			return existing, nil
+		}
+		// Otherwise, the stream is either tombstoned, i.e. was marked for
+		// deletion previously, or is a different incarnation of the stream
+		// which this operation supersedes. Replace it by closing the existing
+		// stream and then recreating it. The existing data is left intact if
+		// it belongs to the recreated stream and quarantined otherwise.
 		if err := existing.Close(); err != nil {
 			return nil, err
 		}
+		if !existing.IsTombstoned() {
+			// Tombstoned streams were already uncounted when tombstoned.
+			m.uncountStreamLoad(existing)
+		}
 		m.removeStream(existing, epoch)
 	}
 
 	config := protoStream.GetConfig()
 	creationTime := time.Unix(0, protoStream.CreationTimestamp)
-	stream := newStream(protoStream.Name, protoStream.Subject, config, creationTime, m.config)
+	stream := newStream(protoStream.Name, protoStream.Subject, config, creationTime,
+		protoStream.Origin, m.config)
+	stream.setExclusiveProducers(protoStream.Producers)
+	stream.setCompanionLinks(protoStream.Primary, protoStream.Companions)
+	stream.SetTags(protoStream.Tags)
+	stream.SetACL(protoStream.Acl)
 	m.streams[protoStream.Name] = stream
 
 	for _, partition := range protoStream.Partitions {
@@ -1109,9 +2069,13 @@ func (m *metadataAPI) AddStream(protoStream *proto.Stream, recovered bool, epoch
 		}
 	}
 
-	// Update broker load counts.
+	// Update broker load counts. Paused partitions, e.g. those recovered from
+	// a snapshot, are counted when they are resumed.
 	m.stats.Lock()
 	for _, partition := range stream.GetPartitions() {
+		if partition.IsPaused() {
+			continue
+		}
 		for _, broker := range partition.Replicas {
 			m.stats.brokerPartitionLoad[broker]++
 		}
@@ -1129,6 +2093,13 @@ func (m *metadataAPI) addPartition(stream *stream, protoPartition *proto.Partiti
 			protoPartition.Id, protoPartition.Stream)
 	}
 
+	// Make sure any existing data on disk belongs to this stream before
+	// recovering it.
+	creationTimestamp := stream.GetCreationTime().UnixNano()
+	if err := m.preparePartitionDir(protoPartition, creationTimestamp, recovered); err != nil {
+		return err
+	}
+
 	// This will initialize/recover the durable commit log.
 	partition, err := m.newPartition(protoPartition, recovered, config)
 	if err != nil {
@@ -1214,6 +2185,7 @@ func (m *metadataAPI) RemoveFromISR(streamName, replica string, partitionID int3
 	}
 
 	partition.SetEpoch(epoch)
+	m.reassignments.update(partition)
 	return nil
 }
 
@@ -1236,6 +2208,7 @@ func (m *metadataAPI) AddToISR(streamName, replica string, partitionID int32, ep
 	}
 
 	partition.SetEpoch(epoch)
+	m.reassignments.update(partition)
 	return nil
 }
 
@@ -1259,6 +2232,12 @@ func (m *metadataAPI) ChangeLeader(streamName, leader string, partitionID int32,
 	}
 
 	partition.SetEpoch(epoch)
+	m.reassignments.update(partition)
+
+	// Paused partitions don't count towards broker load.
+	if partition.IsPaused() {
+		return nil
+	}
 
 	// Update broker load counts.
 	m.stats.Lock()
@@ -1271,6 +2250,53 @@ func (m *metadataAPI) ChangeLeader(streamName, leader string, partitionID int32,
 	return nil
 }
 
+// ReplaceReplica replaces the given replica of the partition with the
+// replacement and restarts the partition in a new leader epoch so the leader
+// replicates to the replacement, if the given epoch is greater than the
+// partition epoch.
+func (m *metadataAPI) ReplaceReplica(streamName, replica, replacement string, partitionID int32,
+	epoch uint64) error {
+
+	partition := m.GetPartition(streamName, partitionID)
+	if partition == nil {
+		return fmt.Errorf("No such partition [stream=%s, partition=%d]", streamName, partitionID)
+	}
+
+	// Idempotency check.
+	if partition.GetEpoch() >= epoch {
+		return nil
+	}
+
+	if err := partition.ReplaceReplica(replica, replacement); err != nil {
+		return err
+	}
+
+	// Replicators are fixed for a leader epoch, so start a new one with the
+	// same leader.
+	leader, _ := partition.GetLeader()
+	if err := partition.SetLeader(leader, epoch); err != nil {
+		return errors.Wrap(err, "failed to restart partition")
+	}
+
+	partition.SetEpoch(epoch)
+	m.reassignments.started(partition, time.Now())
+
+	// Paused partitions don't count towards broker load.
+	if partition.IsPaused() {
+		return nil
+	}
+
+	// Update broker load counts.
+	m.stats.Lock()
+	if m.stats.brokerPartitionLoad[replica] > 0 {
+		m.stats.brokerPartitionLoad[replica]--
+	}
+	m.stats.brokerPartitionLoad[replacement]++
+	m.stats.Unlock()
+
+	return nil
+}
+
 // ChangeGroupCoordinator changes the consumer group's coordinator to the given
 // broker if the given epoch is greater than the current epoch.
 func (m *metadataAPI) ChangeGroupCoordinator(groupID, coordinator string, newEpoch uint64) error {
@@ -1301,16 +2327,19 @@ func (m *metadataAPI) ChangeGroupCoordinator(groupID, coordinator string, newEpo
 }
 
 // PausePartitions pauses the given partitions for the stream. If the list of
-// partitions is empty, this pauses all partitions.
-func (m *metadataAPI) PausePartitions(streamName string, partitions []int32, resumeAll bool) error {
+// partitions is empty, this pauses all partitions. It returns the partitions
+// which weren't already paused.
+func (m *metadataAPI) PausePartitions(streamName string, partitions []int32, resumeAll bool) (
+	[]*partition, error) {
+
 	stream := m.GetStream(streamName)
 	if stream == nil {
-		return ErrStreamNotFound
+		return nil, ErrStreamNotFound
 	}
 
 	paused, err := stream.Pause(partitions, resumeAll)
 	if err != nil {
-		return errors.Wrap(err, "failed to pause stream")
+		return nil, errors.Wrap(err, "failed to pause stream")
 	}
 
 	// Update broker load counts.
@@ -1327,18 +2356,21 @@ func (m *metadataAPI) PausePartitions(streamName string, partitions []int32, res
 	}
 	m.stats.Unlock()
 
-	return nil
+	return paused, nil
 }
 
 // SetReadonly changes the stream partitions' readonly flag in the metadata
-// store.
-func (m *metadataAPI) SetReadonly(streamName string, partitions []int32, readonly bool) error {
+// store. If a target offset is given, the partitions become readonly once they
+// reach it.
+func (m *metadataAPI) SetReadonly(streamName string, partitions []int32, readonly bool,
+	target *proto.NullableInt64) error {
+
 	stream := m.GetStream(streamName)
 	if stream == nil {
 		return ErrStreamNotFound
 	}
 
-	err := stream.SetReadonly(partitions, readonly)
+	err := stream.SetReadonly(partitions, readonly, target)
 	if err != nil {
 		return errors.Wrap(err, "failed to set stream as readonly")
 	}
@@ -1346,6 +2378,132 @@ func (m *metadataAPI) SetReadonly(streamName string, partitions []int32, readonl
 	return nil
 }
 
+// SetStreamOwner changes the owner recorded in the stream's origin in the
+// metadata store.
+func (m *metadataAPI) SetStreamOwner(streamName, owner string) error {
+	stream := m.GetStream(streamName)
+	if stream == nil {
+		return ErrStreamNotFound
+	}
+	stream.SetOwner(owner)
+	return nil
+}
+
+// SetTags replaces the tags of the stream in the metadata store.
+func (m *metadataAPI) SetTags(streamName string, tags []*proto.StreamTag) error {
+	stream := m.GetStream(streamName)
+	if stream == nil {
+		return ErrStreamNotFound
+	}
+	stream.SetTags(tags)
+	return nil
+}
+
+// SetACL replaces the access control list of the stream in the metadata store.
+func (m *metadataAPI) SetACL(streamName string, acl *proto.StreamACL) error {
+	stream := m.GetStream(streamName)
+	if stream == nil {
+		return ErrStreamNotFound
+	}
+	stream.SetACL(acl)
+	return nil
+}
+
+// SetStreamConfig changes the settings of the stream's configuration in the
+// metadata store which are set in the given config and applies the resulting
+// retention, segment, and compaction settings to the logs of the stream's
+// partitions on this server.
+func (m *metadataAPI) SetStreamConfig(streamName string, update *proto.StreamConfig) error {
+	stream := m.GetStream(streamName)
+	if stream == nil {
+		return ErrStreamNotFound
+	}
+	streamsConfig := m.getStreamsConfig(stream.UpdateConfig(update))
+	for _, partition := range stream.GetPartitions() {
+		err := partition.log.UpdateOptions(commitlog.Options{
+			MaxSegmentBytes: streamsConfig.SegmentMaxBytes,
+			MaxSegmentAge:   streamsConfig.SegmentMaxAge,
+			MaxLogBytes:     streamsConfig.RetentionMaxBytes,
+			MaxLogMessages:  streamsConfig.RetentionMaxMessages,
+			MaxLogAge:       streamsConfig.RetentionMaxAge,
+			Compact:         streamsConfig.Compact,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to update log options for partition %s", partition)
+		}
+	}
+	return nil
+}
+
+// RegisterExclusiveProducer registers the exclusive producer on the stream
+// in the metadata store with the given epoch.
+func (m *metadataAPI) RegisterExclusiveProducer(streamName, producer string, epoch uint64,
+	expiresAt int64) error {
+
+	stream := m.GetStream(streamName)
+	if stream == nil {
+		return ErrStreamNotFound
+	}
+	stream.RegisterExclusiveProducer(producer, epoch, expiresAt)
+	return nil
+}
+
+// ReleaseExclusiveProducer releases the registration of the exclusive
+// producer on the stream in the metadata store if it has the given epoch.
+func (m *metadataAPI) ReleaseExclusiveProducer(streamName, producer string, epoch uint64) error {
+	stream := m.GetStream(streamName)
+	if stream == nil {
+		return ErrStreamNotFound
+	}
+	return stream.ReleaseExclusiveProducer(producer, epoch)
+}
+
+// UpdateRetentionConfig applies the MaxLogBytes, MaxLogMessages, and
+// MaxLogAge settings from the given Options to every partition on this server
+// and to partitions created or resumed later. Streams which override a
+// retention setting keep their override. Each log enforces its new limits the
+// next time its cleaner runs.
+func (m *metadataAPI) UpdateRetentionConfig(opts commitlog.Options) error {
+	if opts.MaxLogBytes < 0 {
+		return fmt.Errorf("invalid %s %d", configStreamsRetentionMaxBytes, opts.MaxLogBytes)
+	}
+	if opts.MaxLogMessages < 0 {
+		return fmt.Errorf("invalid %s %d", configStreamsRetentionMaxMessages, opts.MaxLogMessages)
+	}
+	if opts.MaxLogAge < 0 {
+		return fmt.Errorf("invalid %s %s", configStreamsRetentionMaxAge, opts.MaxLogAge)
+	}
+
+	// Hold the lock so partitions aren't created with the old settings while
+	// they are being updated.
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.config.Streams.RetentionMaxBytes = opts.MaxLogBytes
+	m.config.Streams.RetentionMaxMessages = opts.MaxLogMessages
+	m.config.Streams.RetentionMaxAge = opts.MaxLogAge
+
+	for _, stream := range m.getStreams() {
+		streamsConfig := &StreamsConfig{
+			RetentionMaxBytes:    opts.MaxLogBytes,
+			RetentionMaxMessages: opts.MaxLogMessages,
+			RetentionMaxAge:      opts.MaxLogAge,
+		}
+		streamsConfig.ApplyOverrides(stream.GetConfig())
+		for _, partition := range stream.GetPartitions() {
+			err := partition.log.SetRetention(commitlog.Options{
+				MaxLogBytes:    streamsConfig.RetentionMaxBytes,
+				MaxLogMessages: streamsConfig.RetentionMaxMessages,
+				MaxLogAge:      streamsConfig.RetentionMaxAge,
+			})
+			if err != nil {
+				return errors.Wrapf(err, "failed to update retention for partition %s", partition)
+			}
+		}
+	}
+	return nil
+}
+
 // GetStreams returns all streams from the metadata store.
 func (m *metadataAPI) GetStreams() []*stream {
 	m.mu.RLock()
@@ -1361,6 +2519,19 @@ func (m *metadataAPI) GetStream(name string) *stream {
 	return m.streams[name]
 }
 
+// GetStreamConfig returns the effective configuration of the stream with the
+// given name, with the server's defaults filled in for settings it wasn't
+// created with. This is read from the local metadata, so it can be called on
+// any server, not just the metadata leader.
+func (m *metadataAPI) GetStreamConfig(ctx context.Context, name string) (*proto.StreamConfig, *status.Status) {
+	stream := m.GetStream(name)
+	if stream == nil {
+		return nil, status.Newf(codes.NotFound, "No such stream: %s", name)
+	}
+	config := stream.GetConfig()
+	return m.getStreamsConfig(config).StreamConfig(config), nil
+}
+
 // GetPartition returns the stream partition for the given stream and partition
 // ID. It returns nil if no such partition exists.
 func (m *metadataAPI) GetPartition(streamName string, id int32) *partition {
@@ -1407,6 +2578,10 @@ func (m *metadataAPI) Reset() error {
 		}
 	}
 	m.streams = make(map[string]*stream)
+	m.removedServers = make(map[string]struct{})
+	m.scheduledOps = make(map[uint64]*proto.ScheduledOperation)
+	m.quotas.reset()
+	m.reassignments.reset()
 	m.consumerGroupsMu.Lock()
 	defer m.consumerGroupsMu.Unlock()
 	for _, group := range m.getConsumerGroups() {
@@ -1432,42 +2607,121 @@ func (m *metadataAPI) resetFailovers() {
 	m.groupFailovers = make(map[*consumerGroup]*failoverStatus)
 }
 
-// RemoveStream closes the stream, removes it from the metadata store, and
-// deletes the associated on-disk data for it. However, if this operation is
-// being applied during Raft recovery, this will only mark the stream with a
-// tombstone. Tombstoned streams will be deleted after the recovery process
-// completes.
-func (m *metadataAPI) RemoveStream(stream *stream, recovered bool, epoch uint64) error {
+// RemoveStream closes the stream, removes it from the metadata store, and
+// deletes the associated on-disk data for it. However, if this operation is
+// being applied during Raft recovery, this will only mark the stream with a
+// tombstone. Tombstoned streams will be deleted after the recovery process
+// completes.
+func (m *metadataAPI) RemoveStream(stream *stream, recovered bool, epoch uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// If this operation is being applied during recovery, only tombstone the
+	// stream. We don't want to delete streams until recovery finishes to avoid
+	// deleting potentially valid data, e.g. in the case of a stream being
+	// deleted, recreated, and then published to. In this scenario, the
+	// recreate will un-tombstone the stream.
+	if recovered {
+		stream.Tombstone()
+	} else {
+		if err := m.deleteStream(stream, epoch); err != nil {
+			return err
+		}
+	}
+
+	m.uncountStreamLoad(stream)
+	return nil
+}
+
+// uncountStreamLoad removes the stream's partitions from the broker load
+// counts.
+func (m *metadataAPI) uncountStreamLoad(stream *stream) {
+	for _, partition := range stream.GetPartitions() {
+		m.uncountPartitionLoad(partition)
+	}
+}
+
+// uncountPartitionLoad removes the partition from the broker load counts.
+// Paused partitions were already uncounted when they were paused.
+func (m *metadataAPI) uncountPartitionLoad(partition *partition) {
+	if partition.IsPaused() {
+		return
+	}
+	m.stats.Lock()
+	defer m.stats.Unlock()
+	for _, broker := range partition.Replicas {
+		if m.stats.brokerPartitionLoad[broker] > 0 {
+			m.stats.brokerPartitionLoad[broker]--
+		}
+	}
+	if m.stats.brokerLeaderLoad[partition.Leader] > 0 {
+		m.stats.brokerLeaderLoad[partition.Leader]--
+	}
+}
+
+// RemovePartition closes the stream partition, removes it from the stream,
+// and deletes its on-disk data. However, if this operation is being applied
+// during Raft recovery, the data is only deleted once recovery completes, and
+// only if the partition wasn't recreated by then, similar to how tombstoned
+// streams are handled.
+func (m *metadataAPI) RemovePartition(stream *stream, id int32, recovered bool, epoch uint64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	partition := stream.GetPartition(id)
+	if partition == nil {
+		return ErrPartitionNotFound
+	}
+	if recovered {
+		if err := partition.Close(); err != nil {
+			return errors.Wrap(err, "failed to close partition")
+		}
+		m.tombstonedPartitions = append(m.tombstonedPartitions, &tombstonedPartition{
+			stream: stream,
+			id:     id,
+		})
+	} else if err := partition.Delete(); err != nil {
+		// This removes the partition's data directory along with its log.
+		return errors.Wrap(err, "failed to delete partition")
+	}
+
+	m.uncountPartitionLoad(partition)
+	stream.RemovePartition(id)
+	if failover, ok := m.partitionFailovers[partition]; ok {
+		failover.cancel()
+		delete(m.partitionFailovers, partition)
+	}
+	m.reassignments.remove(stream.GetName(), id)
+	m.startGoroutine(func() {
+		m.consumerGroupsMu.RLock()
+		for _, group := range m.consumerGroups {
+			group.StreamPartitionDeleted(stream.GetName(), epoch) // nolint: errcheck
+		}
+		m.consumerGroupsMu.RUnlock()
+	})
+	return nil
+}
+
+// RemoveTombstonedPartitions deletes the on-disk data of the partitions
+// removed during Raft recovery. Partitions whose stream was since deleted or
+// recreated, or which were recreated themselves, are skipped since the data
+// is either already deleted or belongs to the new incarnation.
+func (m *metadataAPI) RemoveTombstonedPartitions() error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	// If this operation is being applied during recovery, only tombstone the
-	// stream. We don't want to delete streams until recovery finishes to avoid
-	// deleting potentially valid data, e.g. in the case of a stream being
-	// deleted, recreated, and then published to. In this scenario, the
-	// recreate will un-tombstone the stream.
-	if recovered {
-		stream.Tombstone()
-	} else {
-		if err := m.deleteStream(stream, epoch); err != nil {
-			return err
-		}
-	}
-
-	// Update broker load counts.
-	m.stats.Lock()
-	for _, partition := range stream.GetPartitions() {
-		for _, broker := range partition.Replicas {
-			if m.stats.brokerPartitionLoad[broker] > 0 {
-				m.stats.brokerPartitionLoad[broker]--
-			}
+	tombstoned := m.tombstonedPartitions
+	m.tombstonedPartitions = nil
+	for _, t := range tombstoned {
+		name := t.stream.GetName()
+		if m.streams[name] != t.stream || t.stream.GetPartition(t.id) != nil {
+			continue
 		}
-		if m.stats.brokerLeaderLoad[partition.Leader] > 0 {
-			m.stats.brokerLeaderLoad[partition.Leader]--
+		if err := os.RemoveAll(m.partitionDir(name, t.id)); err != nil {
+			return errors.Wrapf(err, "failed to delete data directory of partition %d of stream %s",
+				t.id, name)
 		}
 	}
-	m.stats.Unlock()
-
 	return nil
 }
 
@@ -1490,6 +2744,7 @@ func (m *metadataAPI) LostLeadership() {
 	m.consumerGroupsMu.Lock()
 	defer m.consumerGroupsMu.Unlock()
 	m.resetFailovers()
+	m.replicaRepair.reset()
 }
 
 // deleteStream deletes the stream and the associated on-disk data for it.
@@ -1521,6 +2776,7 @@ func (m *metadataAPI) removeStream(stream *stream, epoch uint64) {
 			failover.cancel()
 			delete(m.partitionFailovers, partition)
 		}
+		m.reassignments.remove(partition.Stream, partition.Id)
 	}
 	m.startGoroutine(func() {
 		m.consumerGroupsMu.RLock()
@@ -1541,13 +2797,17 @@ func (m *metadataAPI) getStreams() []*stream {
 
 // getPartitionReplicas selects replicationFactor replicas to participate in
 // the stream partition. Replicas are selected based on the amount of partition
-// load they have.
-func (m *metadataAPI) getPartitionReplicas(replicationFactor int32) ([]string, *status.Status) {
-	ids, err := m.getClusterServerIDs()
+// load they have, unless preferred replicas are given, in which case those in
+// the cluster are selected and only the others are replaced based on load.
+func (m *metadataAPI) getPartitionReplicas(replicationFactor int32, preferred []string) ([]string, *status.Status) {
+	ids, err := m.getClusterServerIDs(m.config.Clustering.ExcludeObservers)
 	if err != nil {
 		return nil, status.New(codes.Internal, err.Error())
 	}
 
+	// Don't place partitions on IDs used by more than one server.
+	ids = m.duplicateIDs.filter(ids)
+
 	if replicationFactor == maxReplicationFactor {
 		replicationFactor = int32(len(ids))
 	}
@@ -1558,6 +2818,10 @@ func (m *metadataAPI) getPartitionReplicas(replicationFactor int32) ([]string, *
 		return nil, status.Newf(codes.InvalidArgument, "Invalid replicationFactor %d, cluster size %d",
 			replicationFactor, len(ids))
 	}
+	if len(preferred) > 0 && int32(len(preferred)) != replicationFactor {
+		return nil, status.Newf(codes.InvalidArgument, "Invalid preferred replicas %v for replicationFactor %d",
+			preferred, replicationFactor)
+	}
 
 	// Order servers by partition load.
 	m.stats.RLock()
@@ -1566,21 +2830,29 @@ func (m *metadataAPI) getPartitionReplicas(replicationFactor int32) ([]string, *
 	})
 	m.stats.RUnlock()
 
+	if len(preferred) > 0 {
+		return selectPreferredReplicas(ids, preferred), nil
+	}
 	return ids[:replicationFactor], nil
 }
 
-// getClusterServerIDs returns a list of all the broker IDs in the cluster.
-func (m *metadataAPI) getClusterServerIDs() ([]string, error) {
+// getClusterServerIDs returns a list of all the broker IDs in the cluster. If
+// excludeObservers is set, servers which are non-voting members of the
+// metadata Raft group are left out.
+func (m *metadataAPI) getClusterServerIDs(excludeObservers bool) ([]string, error) {
 	future := m.getRaft().GetConfiguration()
 	if err := future.Error(); err != nil {
 		return nil, errors.Wrap(err, "failed to get cluster configuration")
 	}
 	var (
 		servers = future.Configuration().Servers
-		ids     = make([]string, len(servers))
+		ids     = make([]string, 0, len(servers))
 	)
-	for i, server := range servers {
-		ids[i] = string(server.ID)
+	for _, server := range servers {
+		if excludeObservers && server.Suffrage == raft.Nonvoter {
+			continue
+		}
+		ids = append(ids, string(server.ID))
 	}
 	return ids, nil
 }
@@ -1588,7 +2860,14 @@ func (m *metadataAPI) getClusterServerIDs() ([]string, error) {
 // electNewPartitionLeader selects a new leader for the given partition,
 // applies this update to the Raft group, and notifies the replica set. This
 // will fail if the current broker is not the metadata leader.
-func (m *metadataAPI) electNewPartitionLeader(ctx context.Context, partition *partition) *status.Status {
+func (m *metadataAPI) electNewPartitionLeader(ctx context.Context, partition *partition) (st *status.Status) {
+	ctx, span := m.tracer.Start(ctx, "metadata.electNewPartitionLeader",
+		trace.WithAttributes(
+			streamAttribute.String(partition.Stream),
+			partitionAttribute.Int64(int64(partition.Id)),
+		))
+	defer func() { endSpan(span, st) }()
+
 	isr := partition.GetISR()
 	// TODO: add support for "unclean" leader elections.
 	if len(isr) <= 1 {
@@ -1609,8 +2888,13 @@ func (m *metadataAPI) electNewPartitionLeader(ctx context.Context, partition *pa
 		return status.New(codes.FailedPrecondition, "No ISR candidates")
 	}
 
-	// Select a new leader.
-	leader = m.selectPartitionLeader(candidates)
+	// Select a new leader among the candidates whose logs are the furthest
+	// along. The ISR recovered after an outage can include replicas which
+	// were behind, e.g. because they lost unflushed writes, and these must
+	// not be elected over ones that aren't.
+	span.SetAttributes(previousLeaderAttribute.String(leader))
+	leader = m.selectPartitionLeader(m.mostCaughtUpReplicas(ctx, partition, candidates))
+	span.SetAttributes(leaderAttribute.String(leader))
 
 	// Replicate leader change through Raft.
 	op := &proto.RaftLog{
@@ -1638,7 +2922,7 @@ func (m *metadataAPI) electNewPartitionLeader(ctx context.Context, partition *pa
 // group and applies this update to the Raft group. This will fail if the
 // current broker is not the metadata leader.
 func (m *metadataAPI) electNewGroupCoordinator(ctx context.Context, group *consumerGroup) *status.Status {
-	brokers, err := m.getClusterServerIDs()
+	brokers, err := m.getClusterServerIDs(false)
 	if err != nil {
 		return status.New(codes.Internal, err.Error())
 	}
@@ -1769,6 +3053,19 @@ func (m *metadataAPI) propagateReportLeader(ctx context.Context, req *proto.Repo
 	return isLeader, status
 }
 
+// propagateTransferLeader forwards a TransferLeader request to the metadata
+// leader. The bool indicates if this server has since become leader and the
+// request should be performed locally. A Status is returned if the propagated
+// request failed.
+func (m *metadataAPI) propagateTransferLeader(ctx context.Context, req *proto.ChangeLeaderOp) (bool, *status.Status) {
+	propagate := &proto.PropagatedRequest{
+		Op:             proto.Op_CHANGE_LEADER,
+		ChangeLeaderOp: req,
+	}
+	_, isLeader, status := m.propagateRequest(ctx, propagate)
+	return isLeader, status
+}
+
 // propagateSetStreamReadonly forwards a SetStreamReadonly request to the
 // metadata leader. The bool indicates if this server has since become leader
 // and the request should be performed locally. A Status is returned if the
@@ -1782,6 +3079,121 @@ func (m *metadataAPI) propagateSetStreamReadonly(ctx context.Context, req *proto
 	return isLeader, status
 }
 
+// propagateUpdateStreamOwner forwards an UpdateStreamOwner request to the
+// metadata leader. The bool indicates if this server has since become leader
+// and the request should be performed locally. A Status is returned if the
+// propagated request failed.
+func (m *metadataAPI) propagateUpdateStreamOwner(ctx context.Context, req *proto.UpdateStreamOwnerOp) (bool, *status.Status) {
+	propagate := &proto.PropagatedRequest{
+		Op:                  proto.Op_UPDATE_STREAM_OWNER,
+		UpdateStreamOwnerOp: req,
+	}
+	_, isLeader, status := m.propagateRequest(ctx, propagate)
+	return isLeader, status
+}
+
+// propagateSetStreamTags forwards a SetStreamTags request to the metadata
+// leader. The bool indicates if this server has since become leader and the
+// request should be performed locally. A Status is returned if the propagated
+// request failed.
+func (m *metadataAPI) propagateSetStreamTags(ctx context.Context, req *proto.SetStreamTagsOp) (bool, *status.Status) {
+	propagate := &proto.PropagatedRequest{
+		Op:              proto.Op_SET_STREAM_TAGS,
+		SetStreamTagsOp: req,
+	}
+	_, isLeader, status := m.propagateRequest(ctx, propagate)
+	return isLeader, status
+}
+
+// propagateSetStreamACL forwards a SetStreamACL request to the metadata
+// leader. The bool indicates if this server has since become leader and the
+// request should be performed locally. A Status is returned if the propagated
+// request failed.
+func (m *metadataAPI) propagateSetStreamACL(ctx context.Context, req *proto.SetStreamACLOp) (bool, *status.Status) {
+	propagate := &proto.PropagatedRequest{
+		Op:             proto.Op_SET_STREAM_ACL,
+		SetStreamACLOp: req,
+	}
+	_, isLeader, status := m.propagateRequest(ctx, propagate)
+	return isLeader, status
+}
+
+// propagateDeletePartitionData forwards a DeletePartitionData request to the
+// metadata leader. The bool indicates if this server has since become leader
+// and the request should be performed locally. A Status is returned if the
+// propagated request failed.
+func (m *metadataAPI) propagateDeletePartitionData(ctx context.Context, req *proto.DeletePartitionDataOp) (
+	bool, *status.Status) {
+
+	propagate := &proto.PropagatedRequest{
+		Op:                    proto.Op_DELETE_PARTITION_DATA,
+		DeletePartitionDataOp: req,
+	}
+	_, isLeader, status := m.propagateRequest(ctx, propagate)
+	return isLeader, status
+}
+
+// propagateDeletePartition forwards a DeletePartition request to the metadata
+// leader. The bool indicates if this server has since become leader and the
+// request should be performed locally. A Status is returned if the propagated
+// request failed.
+func (m *metadataAPI) propagateDeletePartition(ctx context.Context, req *proto.DeletePartitionOp) (
+	bool, *status.Status) {
+
+	propagate := &proto.PropagatedRequest{
+		Op:                proto.Op_DELETE_PARTITION,
+		DeletePartitionOp: req,
+	}
+	_, isLeader, status := m.propagateRequest(ctx, propagate)
+	return isLeader, status
+}
+
+// propagateUpdateStreamConfig forwards an UpdateStreamConfig request to the
+// metadata leader. The bool indicates if this server has since become leader
+// and the request should be performed locally. A Status is returned if the
+// propagated request failed.
+func (m *metadataAPI) propagateUpdateStreamConfig(ctx context.Context, req *proto.UpdateStreamConfigOp) (bool, *status.Status) {
+	propagate := &proto.PropagatedRequest{
+		Op:                   proto.Op_UPDATE_STREAM_CONFIG,
+		UpdateStreamConfigOp: req,
+	}
+	_, isLeader, status := m.propagateRequest(ctx, propagate)
+	return isLeader, status
+}
+
+// propagateRegisterProducer forwards a RegisterProducer request to the
+// metadata leader. The bool indicates if this server has since become leader
+// and the request should be performed locally. A Status is returned if the
+// propagated request failed.
+func (m *metadataAPI) propagateRegisterProducer(ctx context.Context, req *proto.RegisterProducerOp) (
+	*proto.PropagatedResponse_RegisterProducerResponse, bool, *status.Status) {
+	propagate := &proto.PropagatedRequest{
+		Op:                 proto.Op_REGISTER_PRODUCER,
+		RegisterProducerOp: req,
+	}
+	resp, isLeader, status := m.propagateRequest(ctx, propagate)
+	if status != nil {
+		return nil, false, status
+	}
+	if isLeader {
+		return nil, true, nil
+	}
+	return resp.RegisterProducerResp, isLeader, status
+}
+
+// propagateReleaseProducer forwards a ReleaseProducer request to the metadata
+// leader. The bool indicates if this server has since become leader and the
+// request should be performed locally. A Status is returned if the propagated
+// request failed.
+func (m *metadataAPI) propagateReleaseProducer(ctx context.Context, req *proto.ReleaseProducerOp) (bool, *status.Status) {
+	propagate := &proto.PropagatedRequest{
+		Op:                proto.Op_RELEASE_PRODUCER,
+		ReleaseProducerOp: req,
+	}
+	_, isLeader, status := m.propagateRequest(ctx, propagate)
+	return isLeader, status
+}
+
 // propagateJoinConsumerGroup forwards a JoinConsumerGroup request to the
 // metadata leader. The bool indicates if this server has since become leader
 // and the request should be performed locally. A Status is returned if the
@@ -1833,35 +3245,73 @@ func (m *metadataAPI) propagateReportGroupCoordinator(ctx context.Context, req *
 // propagateRequest forwards a metadata request to the metadata leader. The
 // bool indicates if this server has since become leader and the request should
 // be performed locally. A Status is returned if the propagated request failed.
-func (m *metadataAPI) propagateRequest(ctx context.Context, req *proto.PropagatedRequest) (*proto.PropagatedResponse, bool, *status.Status) {
-	// Check if there is currently a metadata leader.
-	isLeader, err := m.waitForMetadataLeader(ctx)
-	if err != nil {
-		return nil, false, status.New(codes.Internal, err.Error())
-	}
-	// This server has since become metadata leader, so the request should be
-	// performed locally.
-	if isLeader {
-		return nil, true, nil
+// If no server answers the request because the leader went away, e.g. during
+// an election, it's retried with a backoff once there is a leader, up to the
+// configured number of retries or until the context is done.
+func (m *metadataAPI) propagateRequest(ctx context.Context, req *proto.PropagatedRequest) (
+	_ *proto.PropagatedResponse, _ bool, st *status.Status) {
+
+	ctx, span := m.tracer.Start(ctx, "metadata.propagateRequest",
+		trace.WithAttributes(opAttribute.String(req.Op.String())))
+	defer func() { endSpan(span, st) }()
+
+	ctx, cancel := ensureTimeout(ctx, defaultPropagateTimeout)
+	defer cancel()
+
+	release, st := m.propagation.acquire(ctx)
+	if st != nil {
+		return nil, false, st
 	}
+	defer release()
 
 	data, err := proto.MarshalPropagatedRequest(req)
 	if err != nil {
 		panic(err)
 	}
 
-	ctx, cancel := ensureTimeout(ctx, defaultPropagateTimeout)
-	defer cancel()
+	var (
+		resp    *nats.Msg
+		backoff = propagateMinBackoff
+	)
+	for retries := 0; ; retries++ {
+		// Check if there is currently a metadata leader.
+		isLeader, err := m.waitForMetadataLeader(ctx)
+		if err != nil {
+			return nil, false, m.propagation.fail(status.New(codes.Unavailable, err.Error()))
+		}
+		// This server has since become metadata leader, so the request should
+		// be performed locally.
+		if isLeader {
+			return nil, true, nil
+		}
+		span.SetAttributes(metadataLeaderAttribute.String(string(m.getRaft().Leader())))
 
-	resp, err := m.nc.RequestWithContext(ctx, m.getPropagateInbox(), data)
-	if err != nil {
-		return nil, false, status.New(codes.Internal, err.Error())
+		atomic.AddInt64(&m.propagation.attempts, 1)
+		resp, err = m.nc.RequestWithContext(ctx, m.getPropagateInbox(), m.auth.sign(data))
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, nats.ErrNoResponders) || retries >= m.config.Clustering.PropagateMaxRetries {
+			return nil, false, m.propagation.fail(propagateErrorStatus(err))
+		}
+		m.logger.Debugf("metadata: No response from metadata leader to propagated %s request, retrying in %s",
+			req.Op, backoff)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, false, m.propagation.fail(propagateErrorStatus(ctx.Err()))
+		}
+		backoff = nextPropagateBackoff(backoff)
+		atomic.AddInt64(&m.propagation.retries, 1)
+	}
+	if !m.auth.verify(resp.Subject, resp.Data) {
+		return nil, false, m.propagation.fail(status.New(codes.Internal, "unauthenticated response"))
 	}
 
 	r, err := proto.UnmarshalPropagatedResponse(resp.Data)
 	if err != nil {
 		m.logger.Errorf("metadata: Invalid response for propagated request: %v", err)
-		return nil, false, status.New(codes.Internal, "invalid response")
+		return nil, false, m.propagation.fail(status.New(codes.Internal, "invalid response"))
 	}
 	if r.Error != nil {
 		return nil, false, status.New(codes.Code(r.Error.Code), r.Error.Msg)
@@ -1894,14 +3344,21 @@ func (m *metadataAPI) waitForMetadataLeader(ctx context.Context) (bool, error) {
 }
 
 // waitForPartitionLeader does a best-effort wait for the leader of the given
-// partition to create and start the partition.
-func (m *metadataAPI) waitForPartitionLeader(ctx context.Context, partition *proto.Partition) {
+// partition to create and start the partition. Remote leaders announce when
+// they have started a partition, so this waits for the given channel, which
+// is closed on that announcement, until the context is done. If the deadline
+// is reached without an announcement, e.g. because it was lost, the leader's
+// status is requested once as a fallback.
+func (m *metadataAPI) waitForPartitionLeader(ctx context.Context, partition *proto.Partition,
+	started <-chan struct{}) {
+
 	ctx, cancel := ensureTimeout(ctx, defaultPropagateTimeout)
 	defer cancel()
 
 	if partition.Leader == m.config.Clustering.ServerID {
-		// If we're the partition leader, there's no need to make a status
-		// request. We can just apply a Raft barrier since the FSM is local.
+		// If we're the partition leader, there's no need to wait for the
+		// notification. We can just apply a Raft barrier since the FSM is
+		// local.
 		deadline, _ := ctx.Deadline()
 		if err := m.getRaft().Barrier(time.Until(deadline)).Error(); err != nil {
 			m.logger.Warnf("Failed to apply Raft barrier: %v", err)
@@ -1909,6 +3366,24 @@ func (m *metadataAPI) waitForPartitionLeader(ctx context.Context, partition *pro
 		return
 	}
 
+	select {
+	case <-started:
+		return
+	case <-ctx.Done():
+	}
+	if ctx.Err() != context.DeadlineExceeded {
+		return
+	}
+
+	if !m.partitionLeaderStarted(partition) {
+		m.logger.Warnf("Timed out waiting for leader %s to start partition %s",
+			partition.Leader, partition)
+	}
+}
+
+// partitionLeaderStarted requests the status of the given partition from its
+// leader and indicates if the leader has started the partition.
+func (m *metadataAPI) partitionLeaderStarted(partition *proto.Partition) bool {
 	req, err := proto.MarshalPartitionStatusRequest(&proto.PartitionStatusRequest{
 		Stream:    partition.Stream,
 		Partition: partition.Id,
@@ -1917,52 +3392,135 @@ func (m *metadataAPI) waitForPartitionLeader(ctx context.Context, partition *pro
 		panic(err)
 	}
 
-	inbox := m.getPartitionStatusInbox(partition.Leader)
-	for i := 0; i < 5; i++ {
-		resp, err := m.ncRaft.RequestWithContext(ctx, inbox, req)
-		if err != nil {
-			m.logger.Warnf(
-				"Failed to get status for partition %s from leader %s: %v",
-				partition, partition.Leader, err)
-			select {
-			case <-time.After(100 * time.Millisecond):
-				continue
-			case <-ctx.Done():
-				return
+	resp, err := m.ncRaft.Request(m.getPartitionStatusInbox(partition.Leader),
+		m.auth.sign(req), partitionStatusTimeout)
+	if err != nil {
+		m.logger.Warnf(
+			"Failed to get status for partition %s from leader %s: %v",
+			partition, partition.Leader, err)
+		return false
+	}
+	if !m.auth.verify(resp.Subject, resp.Data) {
+		return false
+	}
+	statusResp, err := proto.UnmarshalPartitionStatusResponse(resp.Data)
+	if err != nil {
+		m.logger.Warnf(
+			"Invalid status response for partition %s from leader %s: %v",
+			partition, partition.Leader, err)
+		return false
+	}
+	return statusResp.Exists && statusResp.IsLeader
+}
+
+// watchPartitionsStarted registers to be notified when the given partitions of
+// the stream are started by their leaders. It returns a channel for each
+// partition ID which is closed once the partition's leader announces it has
+// started the partition, along with a function which must be called to stop
+// watching.
+func (m *metadataAPI) watchPartitionsStarted(stream string, partitions []int32) (
+	map[int32]chan struct{}, func()) {
+
+	m.startedMu.Lock()
+	defer m.startedMu.Unlock()
+
+	started := make(map[int32]chan struct{}, len(partitions))
+	for _, id := range partitions {
+		var (
+			key     = partitionKey(stream, id)
+			c       = make(chan struct{})
+			waiters = m.startedWaiters[key]
+		)
+		if waiters == nil {
+			waiters = make(map[chan struct{}]struct{})
+			m.startedWaiters[key] = waiters
+		}
+		waiters[c] = struct{}{}
+		started[id] = c
+	}
+
+	stop := func() {
+		m.startedMu.Lock()
+		defer m.startedMu.Unlock()
+		for id, c := range started {
+			key := partitionKey(stream, id)
+			if waiters, ok := m.startedWaiters[key]; ok {
+				delete(waiters, c)
+				if len(waiters) == 0 {
+					delete(m.startedWaiters, key)
+				}
 			}
 		}
-		statusResp, err := proto.UnmarshalPartitionStatusResponse(resp.Data)
-		if err != nil {
-			m.logger.Warnf(
-				"Invalid status response for partition %s from leader %s: %v",
-				partition, partition.Leader, err)
-			select {
-			case <-time.After(100 * time.Millisecond):
-				continue
-			case <-ctx.Done():
-				return
-			}
+	}
+	return started, stop
+}
+
+// partitionStarted notifies anything watching the given partition that its
+// leader has started it.
+func (m *metadataAPI) partitionStarted(stream string, partition int32) {
+	key := partitionKey(stream, partition)
+	m.startedMu.Lock()
+	defer m.startedMu.Unlock()
+	for c := range m.startedWaiters[key] {
+		close(c)
+	}
+	delete(m.startedWaiters, key)
+}
+
+// checkCreateStreamPreconditions checks if the stream to be created, or any of
+// its companions, already exists. If so, it returns ErrStreamExists. It also
+// returns an error if a stream's subject is invalid or, when exclusive subjects
+// are enabled, overlaps the subject of an existing stream. Otherwise, it
+// returns nil.
+func (m *metadataAPI) checkCreateStreamPreconditions(op *proto.RaftLog) error {
+	streams := append([]*proto.Stream{op.CreateStreamOp.Stream}, op.CreateStreamOp.Companions...)
+	for _, stream := range streams {
+		partitions := stream.Partitions
+		if existing := m.GetStream(partitions[0].Stream); existing != nil {
+			return ErrStreamExists
+		}
+		if err := validatePartitionSubjects(partitions); err != nil {
+			return err
+		}
+		if err := validateStreamTags(stream.Tags); err != nil {
+			return err
 		}
-		if !statusResp.Exists || !statusResp.IsLeader {
-			// The leader hasn't finished creating the partition, so wait a bit
-			// and retry.
-			select {
-			case <-time.After(100 * time.Millisecond):
-				continue
-			case <-ctx.Done():
-				return
+		if m.config.Streams.ExclusiveSubjects {
+			if err := m.checkSubjectOverlap(partitions); err != nil {
+				return err
 			}
 		}
-		break
 	}
+	return nil
 }
 
-// checkCreateStreamPreconditions checks if the stream to be created already
-// exists. If it does, it returns ErrStreamExists. Otherwise, it returns nil.
-func (m *metadataAPI) checkCreateStreamPreconditions(op *proto.RaftLog) error {
-	partitions := op.CreateStreamOp.Stream.Partitions
-	if stream := m.GetStream(partitions[0].Stream); stream != nil {
-		return ErrStreamExists
+// validatePartitionSubjects returns an error wrapping ErrInvalidSubject if the
+// given partitions' subjects are not valid for a stream with that many
+// partitions.
+func validatePartitionSubjects(partitions []*proto.Partition) error {
+	for _, partition := range partitions {
+		if err := validateStreamSubject(partition.Subject, int32(len(partitions))); err != nil {
+			return errors.Wrap(ErrInvalidSubject, err.Error())
+		}
+	}
+	return nil
+}
+
+// checkSubjectOverlap returns an error wrapping ErrSubjectOverlap if any of
+// the given partitions would receive messages published to the subject of an
+// existing stream's partition, or vice versa.
+func (m *metadataAPI) checkSubjectOverlap(partitions []*proto.Partition) error {
+	for _, existing := range m.GetStreams() {
+		for _, existingPartition := range existing.GetPartitions() {
+			existingSubject := existingPartition.getSubject()
+			for _, partition := range partitions {
+				subject := partitionSubject(partition.Subject, partition.Id)
+				if subjectsOverlap(subject, existingSubject) {
+					return errors.Wrapf(ErrSubjectOverlap, "subject %q overlaps subject %q of stream %s",
+						subject, existingSubject, existing.GetName())
+				}
+			}
+		}
 	}
 	return nil
 }
@@ -2010,6 +3568,116 @@ func (m *metadataAPI) checkSetStreamReadonlyPreconditions(op *proto.RaftLog) err
 	return nil
 }
 
+// checkUpdateStreamOwnerPreconditions checks if the stream whose owner is
+// being updated exists. If it doesn't, it returns ErrStreamNotFound.
+// Otherwise, it returns nil.
+func (m *metadataAPI) checkUpdateStreamOwnerPreconditions(op *proto.RaftLog) error {
+	if stream := m.GetStream(op.UpdateStreamOwnerOp.Stream); stream == nil {
+		return ErrStreamNotFound
+	}
+	return nil
+}
+
+// checkUpdateStreamConfigPreconditions checks if the stream whose config is
+// being updated exists. If it doesn't, it returns ErrStreamNotFound.
+// Otherwise, it returns nil.
+func (m *metadataAPI) checkUpdateStreamConfigPreconditions(op *proto.RaftLog) error {
+	if stream := m.GetStream(op.UpdateStreamConfigOp.Stream); stream == nil {
+		return ErrStreamNotFound
+	}
+	return nil
+}
+
+// checkSetStreamTagsPreconditions checks if the stream whose tags are being
+// set exists and the tags are valid. If the stream doesn't exist, it returns
+// ErrStreamNotFound. If the tags aren't valid, it returns an error wrapping
+// ErrInvalidStreamTags. Otherwise, it returns nil.
+func (m *metadataAPI) checkSetStreamTagsPreconditions(op *proto.RaftLog) error {
+	if stream := m.GetStream(op.SetStreamTagsOp.Stream); stream == nil {
+		return ErrStreamNotFound
+	}
+	return validateStreamTags(op.SetStreamTagsOp.Tags)
+}
+
+// checkSetStreamACLPreconditions checks if the stream whose ACL is being set
+// exists and the ACL is valid. If the stream doesn't exist, it returns
+// ErrStreamNotFound. If the ACL isn't valid, it returns an error wrapping
+// ErrInvalidStreamACL. Otherwise, it returns nil.
+func (m *metadataAPI) checkSetStreamACLPreconditions(op *proto.RaftLog) error {
+	if stream := m.GetStream(op.SetStreamACLOp.Stream); stream == nil {
+		return ErrStreamNotFound
+	}
+	return validateStreamACL(op.SetStreamACLOp.Acl)
+}
+
+// checkDeletePartitionDataPreconditions checks if the partition whose messages
+// are being deleted exists and isn't paused. If the stream doesn't exist, it
+// returns ErrStreamNotFound. If the partition doesn't exist, it returns
+// ErrPartitionNotFound. If it's paused, it returns ErrPartitionPaused.
+// Otherwise, it returns nil.
+func (m *metadataAPI) checkDeletePartitionDataPreconditions(op *proto.RaftLog) error {
+	stream := m.GetStream(op.DeletePartitionDataOp.Stream)
+	if stream == nil {
+		return ErrStreamNotFound
+	}
+	partition := stream.GetPartition(op.DeletePartitionDataOp.Partition)
+	if partition == nil {
+		return ErrPartitionNotFound
+	}
+	if partition.IsPaused() {
+		return ErrPartitionPaused
+	}
+	return nil
+}
+
+// checkDeletePartitionPreconditions checks if the partition being deleted
+// exists. If it doesn't, it returns ErrStreamNotFound or ErrPartitionNotFound.
+// If it's the stream's only partition, it returns ErrLastPartition.
+// Otherwise, it returns nil.
+func (m *metadataAPI) checkDeletePartitionPreconditions(op *proto.RaftLog) error {
+	stream := m.GetStream(op.DeletePartitionOp.Stream)
+	if stream == nil {
+		return ErrStreamNotFound
+	}
+	if stream.GetPartition(op.DeletePartitionOp.Partition) == nil {
+		return ErrPartitionNotFound
+	}
+	if len(stream.GetPartitions()) == 1 {
+		return ErrLastPartition
+	}
+	return nil
+}
+
+// checkRegisterProducerPreconditions checks if the stream the exclusive
+// producer is being registered on exists. If it doesn't, it returns
+// ErrStreamNotFound. Otherwise, it returns nil.
+func (m *metadataAPI) checkRegisterProducerPreconditions(op *proto.RaftLog) error {
+	if stream := m.GetStream(op.RegisterProducerOp.Stream); stream == nil {
+		return ErrStreamNotFound
+	}
+	return nil
+}
+
+// checkReleaseProducerPreconditions checks if the exclusive producer being
+// released is registered on the stream with the given epoch. If the stream
+// does not exist, it returns ErrStreamNotFound. If the producer isn't
+// registered, it returns ErrProducerNotRegistered. If it was registered again
+// since, it returns ErrProducerFenced. Otherwise, it returns nil.
+func (m *metadataAPI) checkReleaseProducerPreconditions(op *proto.RaftLog) error {
+	stream := m.GetStream(op.ReleaseProducerOp.Stream)
+	if stream == nil {
+		return ErrStreamNotFound
+	}
+	producer := stream.GetExclusiveProducer(op.ReleaseProducerOp.Producer)
+	if producer == nil {
+		return ErrProducerNotRegistered
+	}
+	if producer.Epoch != op.ReleaseProducerOp.Epoch {
+		return ErrProducerFenced
+	}
+	return nil
+}
+
 // checkResumeStreamPreconditions checks if the stream and partitions to be
 // resumed exist. If the stream does not exist, it returns ErrStreamNotFound.
 // If any partitions do not exist, it returns ErrPartitionNotFound. Otherwise,
@@ -2051,6 +3719,22 @@ func (m *metadataAPI) checkChangeLeaderPreconditions(op *proto.RaftLog) error {
 	return m.partitionExists(op.ChangeLeaderOp.Stream, op.ChangeLeaderOp.Partition)
 }
 
+// checkTransferLeaderPreconditions checks if the partition whose leadership
+// is being transferred exists and if the new leader is in the partition's ISR.
+// If the stream doesn't exist, it returns ErrStreamNotFound. If the partition
+// doesn't exist, it returns ErrPartitionNotFound. If the new leader is not in
+// the ISR, it returns ErrReplicaNotInISR. Otherwise, it returns nil.
+func (m *metadataAPI) checkTransferLeaderPreconditions(op *proto.RaftLog) error {
+	if err := m.checkChangeLeaderPreconditions(op); err != nil {
+		return err
+	}
+	partition := m.GetPartition(op.ChangeLeaderOp.Stream, op.ChangeLeaderOp.Partition)
+	if !partition.inISR(op.ChangeLeaderOp.Leader) {
+		return ErrReplicaNotInISR
+	}
+	return nil
+}
+
 // checkCreateConsumerGroupPreconditions checks if the group to be created
 // already exists. If it does, it returns ErrConsumerGroupExists. If any of the
 // initial members' requested streams do not exist, returns ErrStreamNotFound.
@@ -2131,15 +3815,24 @@ func (m *metadataAPI) partitionExists(streamName string, partitionID int32) erro
 
 // selectPartitionLeader selects a replica from the list of replicas to act as
 // leader by attempting to select the replica with the least partition
-// leadership load.
+// leadership load. If latency-aware placement is enabled, the replica with the
+// lowest median RTT to the other replicas is selected from those within the
+// configured load tolerance.
 func (m *metadataAPI) selectPartitionLeader(replicas []string) string {
 	// Order servers by leader load.
 	m.stats.RLock()
 	sort.SliceStable(replicas, func(i, j int) bool {
 		return m.stats.brokerLeaderLoad[replicas[i]] < m.stats.brokerLeaderLoad[replicas[j]]
 	})
+	loads := make(map[string]int, len(replicas))
+	for _, replica := range replicas {
+		loads[replica] = m.stats.brokerLeaderLoad[replica]
+	}
 	m.stats.RUnlock()
 
+	if m.config.Clustering.LeaderPlacement == LeaderPlacementLatency {
+		return m.selectLeaderByLatency(replicas, loads)
+	}
 	return replicas[0]
 }
 
@@ -2199,7 +3892,7 @@ func getPartitionMetadata(partitionID int32, partition *partition) *client.Parti
 		HighWatermark:              partition.log.HighWatermark(),
 		NewestOffset:               partition.log.NewestOffset(),
 		Paused:                     partition.GetPaused(),
-		Readonly:                   partition.GetReadonly(),
+		Readonly:                   partition.IsReadonly(),
 		MessagesReceivedTimestamps: eventTimestampsToProto(partition.MessagesReceivedTimestamps()),
 		PauseTimestamps:            eventTimestampsToProto(partition.PauseTimestamps()),
 		ReadonlyTimestamps:         eventTimestampsToProto(partition.ReadonlyTimestamps()),
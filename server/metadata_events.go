@@ -0,0 +1,134 @@
+package server
+
+import (
+	"strings"
+	"sync"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// metadataWatchBufferSize is the number of metadata events buffered for a
+// watcher. Watchers which fall further behind than this are dropped so that
+// applying metadata changes never blocks on them.
+const metadataWatchBufferSize = 1024
+
+// metadataWatcher receives the metadata events for the streams matching its
+// prefixes.
+type metadataWatcher struct {
+	prefixes []string
+	events   chan *proto.MetadataEvent
+	dropped  chan struct{} // Closed when the watcher fell behind
+}
+
+// matches indicates if the watcher is interested in events for the given
+// stream.
+func (w *metadataWatcher) matches(stream string) bool {
+	if len(w.prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range w.prefixes {
+		if strings.HasPrefix(stream, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// metadataEventBus fans out the metadata changes applied by the FSM to the
+// watchers. Events are delivered to each watcher in the order they're posted,
+// which is the order of the Raft log.
+type metadataEventBus struct {
+	mu       sync.RWMutex
+	watchers map[*metadataWatcher]struct{}
+}
+
+func newMetadataEventBus() *metadataEventBus {
+	return &metadataEventBus{watchers: make(map[*metadataWatcher]struct{})}
+}
+
+// watch registers a watcher for the streams starting with one of the given
+// prefixes, or all streams if there are none. The returned function must be
+// called to unregister it.
+func (b *metadataEventBus) watch(prefixes []string) (*metadataWatcher, func()) {
+	w := &metadataWatcher{
+		prefixes: prefixes,
+		events:   make(chan *proto.MetadataEvent, metadataWatchBufferSize),
+		dropped:  make(chan struct{}),
+	}
+	b.mu.Lock()
+	b.watchers[w] = struct{}{}
+	b.mu.Unlock()
+	return w, func() {
+		b.mu.Lock()
+		delete(b.watchers, w)
+		b.mu.Unlock()
+	}
+}
+
+// hasWatchers indicates if there are any watchers. This lets callers skip
+// building events nobody will receive.
+func (b *metadataEventBus) hasWatchers() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.watchers) > 0
+}
+
+// post delivers the event to the watchers interested in its stream. Watchers
+// whose buffer is full are dropped rather than blocking the caller.
+func (b *metadataEventBus) post(event *proto.MetadataEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for w := range b.watchers {
+		if !w.matches(event.Stream) {
+			continue
+		}
+		select {
+		case w.events <- event:
+		default:
+			delete(b.watchers, w)
+			close(w.dropped)
+		}
+	}
+}
+
+// postStreamEvent posts the given stream event. The stream's metadata is
+// added to it if the stream is given.
+func (m *metadataAPI) postStreamEvent(event *proto.MetadataEvent, stream *stream) {
+	if !m.events.hasWatchers() {
+		return
+	}
+	if stream != nil {
+		// Copy the metadata since the FSM keeps changing it.
+		data, err := stream.Proto().Marshal()
+		if err != nil {
+			panic(err)
+		}
+		event.StreamMetadata = &proto.Stream{}
+		if err := event.StreamMetadata.Unmarshal(data); err != nil {
+			panic(err)
+		}
+	}
+	m.events.post(event)
+}
+
+// postPartitionEvent posts an event for the given partition along with its
+// current metadata.
+func (m *metadataAPI) postPartitionEvent(eventType proto.MetadataEventType, partition *partition,
+	index uint64) {
+
+	if !m.events.hasWatchers() {
+		return
+	}
+	// Copy the metadata since the FSM keeps changing it.
+	metadata := &proto.Partition{}
+	if err := metadata.Unmarshal(partition.Marshal()); err != nil {
+		panic(err)
+	}
+	m.events.post(&proto.MetadataEvent{
+		Type:              eventType,
+		Index:             index,
+		Stream:            partition.Stream,
+		Partition:         partition.Id,
+		PartitionMetadata: metadata,
+	})
+}
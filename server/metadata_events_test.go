@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure the metadata event bus only delivers events to the watchers
+// interested in their stream and drops watchers which fall behind.
+func TestMetadataEventBus(t *testing.T) {
+	bus := newMetadataEventBus()
+	require.False(t, bus.hasWatchers())
+
+	all, unwatchAll := bus.watch(nil)
+	foo, unwatchFoo := bus.watch([]string{"foo", "baz"})
+	require.True(t, bus.hasWatchers())
+
+	bus.post(&proto.MetadataEvent{Stream: "foo-1"})
+	bus.post(&proto.MetadataEvent{Stream: "bar"})
+	bus.post(&proto.MetadataEvent{Stream: "baz"})
+
+	require.Len(t, all.events, 3)
+	require.Len(t, foo.events, 2)
+	require.Equal(t, "foo-1", (<-foo.events).Stream)
+	require.Equal(t, "baz", (<-foo.events).Stream)
+
+	// Fill the buffer of the first watcher.
+	for i := len(all.events); i < metadataWatchBufferSize; i++ {
+		bus.post(&proto.MetadataEvent{Stream: "bar"})
+	}
+	select {
+	case <-all.dropped:
+		t.Fatal("Watcher dropped before falling behind")
+	default:
+	}
+	bus.post(&proto.MetadataEvent{Stream: "bar"})
+	select {
+	case <-all.dropped:
+	default:
+		t.Fatal("Expected watcher to be dropped")
+	}
+
+	// Unwatching a dropped watcher is a no-op.
+	unwatchAll()
+	unwatchFoo()
+	require.False(t, bus.hasWatchers())
+}
+
+// Ensure WatchMetadata sends the metadata changes for the watched streams in
+// the order they're applied.
+func TestWatchMetadata(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure servers with short timeouts so the partition leader fails
+	// over quickly.
+	var servers []*Server
+	for i, id := range []string{"a", "b", "c"} {
+		config := getTestConfig(id, i == 0, 5050+i)
+		config.Clustering.ReplicaMaxLeaderTimeout = time.Second
+		config.Clustering.ReplicaMaxIdleWait = 500 * time.Millisecond
+		config.Clustering.ReplicaFetchTimeout = 500 * time.Millisecond
+		s := runServerWithConfig(t, config)
+		defer s.Stop()
+		servers = append(servers, s)
+	}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	// Watch the foo streams on every server.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watches := make(map[*Server]proto.AdminAPI_WatchMetadataClient, len(servers))
+	for _, s := range servers {
+		conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", s.config.Port), grpc.WithInsecure())
+		require.NoError(t, err)
+		defer conn.Close()
+		watch, err := proto.NewAdminAPIClient(conn).WatchMetadata(ctx, &proto.WatchMetadataRequest{
+			StreamPrefixes: []string{"foo"},
+		})
+		require.NoError(t, err)
+		watches[s] = watch
+	}
+	for _, s := range servers {
+		require.Eventually(t, s.metadata.events.hasWatchers, 5*time.Second, 10*time.Millisecond)
+	}
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.CreateStream(context.Background(), "bar", "bar"))
+	require.NoError(t, client.CreateStream(context.Background(), "foo", "foo",
+		lift.Partitions(2), lift.ReplicationFactor(3)))
+	waitForISR(t, 10*time.Second, "foo", 0, 3, servers...)
+	require.NoError(t, client.PauseStream(context.Background(), "foo", lift.PausePartitions(1)))
+
+	// Kill the leader of the partition which isn't paused.
+	leader := getPartitionLeader(t, 10*time.Second, "foo", 0, servers...)
+	leader.Stop()
+	var survivors []*Server
+	for _, s := range servers {
+		if s != leader {
+			survivors = append(survivors, s)
+		}
+	}
+
+	for _, s := range survivors {
+		var events []*proto.MetadataEvent
+		for {
+			event, err := watches[s].Recv()
+			require.NoError(t, err)
+			require.Equal(t, "foo", event.Stream)
+			if event.Type == proto.MetadataEventType_ISR_CHANGED {
+				continue
+			}
+			events = append(events, event)
+			if event.Type == proto.MetadataEventType_LEADER_CHANGED {
+				break
+			}
+		}
+		require.Len(t, events, 3)
+
+		require.Equal(t, proto.MetadataEventType_STREAM_CREATED, events[0].Type)
+		require.Equal(t, "foo", events[0].StreamMetadata.Name)
+		require.Len(t, events[0].StreamMetadata.Partitions, 2)
+
+		require.Equal(t, proto.MetadataEventType_PARTITION_PAUSED, events[1].Type)
+		require.Equal(t, int32(1), events[1].Partition)
+		require.True(t, events[1].PartitionMetadata.Paused)
+
+		require.Equal(t, proto.MetadataEventType_LEADER_CHANGED, events[2].Type)
+		require.Equal(t, int32(0), events[2].Partition)
+		require.NotEqual(t, leader.config.Clustering.ServerID, events[2].PartitionMetadata.Leader)
+
+		require.True(t, events[0].Index < events[1].Index)
+		require.True(t, events[1].Index < events[2].Index)
+	}
+}
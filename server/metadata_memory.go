@@ -0,0 +1,241 @@
+package server
+
+import (
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// metadataSweepInterval is how often the metadata structures are checked for
+// entries which should have been removed along with what they refer to.
+const metadataSweepInterval = time.Minute
+
+// Sizes used to estimate the memory held by the metadata structures.
+const (
+	pointerSize = int64(unsafe.Sizeof(uintptr(0)))
+	stringSize  = int64(unsafe.Sizeof(""))
+	intSize     = int64(unsafe.Sizeof(int(0)))
+	chanSize    = 96 // Size of the runtime's channel header.
+)
+
+// mapEntryBytes estimates the bytes a map entry with the given key and value
+// sizes occupies. Maps store a control byte for each slot and grow once they
+// are 7/8 full, so the slots are assumed to be at that load.
+func mapEntryBytes(key, value int64) int64 {
+	return (key + value + 1) * 8 / 7
+}
+
+// metadataStructures accumulates the stats of each metadata structure.
+type metadataStructures []*proto.MetadataStructureStats
+
+func (s *metadataStructures) add(name string, entries, bytes, maxEntries int64) {
+	*s = append(*s, &proto.MetadataStructureStats{
+		Name:        name,
+		Entries:     entries,
+		ApproxBytes: bytes,
+		MaxEntries:  maxEntries,
+	})
+}
+
+// memoryStats returns the entry counts and estimated sizes of the metadata
+// structures. The estimates cover the maps and the structs they hold, not the
+// partition logs or anything else the structs refer to.
+func (m *metadataAPI) memoryStats() *proto.MetadataMemoryStatsResponse {
+	var structures metadataStructures
+
+	m.mu.RLock()
+	var (
+		streamBytes    int64
+		partitions     int64
+		partitionBytes int64
+	)
+	for name, stream := range m.streams {
+		streamBytes += mapEntryBytes(stringSize, pointerSize) + int64(len(name)) +
+			int64(unsafe.Sizeof(*stream)) + int64(len(stream.GetSubject())) +
+			int64(stream.GetConfig().Size())
+		for _, partition := range stream.GetPartitions() {
+			partitions++
+			partitionBytes += partition.approxBytes()
+		}
+	}
+	structures.add("streams", int64(len(m.streams)), streamBytes, 0)
+	structures.add("partitions", partitions, partitionBytes, 0)
+
+	var reportBytes int64
+	for _, failover := range m.partitionFailovers {
+		reportBytes += mapEntryBytes(pointerSize, pointerSize) + failover.approxBytes()
+	}
+	structures.add("leaderReports", int64(len(m.partitionFailovers)), reportBytes, partitions)
+
+	var brokerBytes int64
+	for _, broker := range m.cachedBrokers {
+		brokerBytes += pointerSize + broker.approxBytes()
+	}
+	structures.add("cachedBrokers", int64(len(m.cachedBrokers)), brokerBytes,
+		int64(len(m.cachedServerIDs)))
+
+	var versionBytes int64
+	for id := range m.brokerVersions {
+		versionBytes += mapEntryBytes(stringSize, 4) + int64(len(id))
+	}
+	structures.add("brokerVersions", int64(len(m.brokerVersions)), versionBytes, 0)
+	m.mu.RUnlock()
+
+	m.consumerGroupsMu.RLock()
+	var groupBytes int64
+	for id, group := range m.consumerGroups {
+		groupBytes += mapEntryBytes(stringSize, pointerSize) + int64(len(id)) +
+			int64(unsafe.Sizeof(*group))
+	}
+	structures.add("consumerGroups", int64(len(m.consumerGroups)), groupBytes, 0)
+
+	var groupReportBytes int64
+	for _, failover := range m.groupFailovers {
+		groupReportBytes += mapEntryBytes(pointerSize, pointerSize) + failover.approxBytes()
+	}
+	structures.add("groupFailovers", int64(len(m.groupFailovers)), groupReportBytes,
+		int64(len(m.consumerGroups)))
+	m.consumerGroupsMu.RUnlock()
+
+	m.startedMu.Lock()
+	var waiters, waiterBytes int64
+	for id, chans := range m.startedWaiters {
+		waiters += int64(len(chans))
+		waiterBytes += mapEntryBytes(stringSize, pointerSize) + int64(len(id)) +
+			int64(len(chans))*(mapEntryBytes(pointerSize, 0)+chanSize)
+	}
+	structures.add("startedWaiters", waiters, waiterBytes, 0)
+	m.startedMu.Unlock()
+
+	m.stats.RLock()
+	var loadEntries, loadBytes int64
+	for _, load := range []map[string]int{
+		m.stats.brokerLeaderLoad,
+		m.stats.brokerPartitionLoad,
+		m.stats.brokerCoordinatorLoad,
+	} {
+		for id := range load {
+			loadEntries++
+			loadBytes += mapEntryBytes(stringSize, intSize) + int64(len(id))
+		}
+	}
+	structures.add("loadCounts", loadEntries, loadBytes, 0)
+	m.stats.RUnlock()
+
+	var total int64
+	for _, structure := range structures {
+		total += structure.ApproxBytes
+	}
+	return &proto.MetadataMemoryStatsResponse{
+		BrokerId:            m.config.Clustering.ServerID,
+		Structures:          structures,
+		ApproxTotalBytes:    total,
+		StaleEntriesRemoved: atomic.LoadInt64(&m.staleRemoved),
+		LimitViolations:     atomic.LoadInt64(&m.limitViolations),
+	}
+}
+
+// sweep removes leader reports for partitions which are no longer in the
+// metadata store and coordinator reports for consumer groups which no longer
+// exist. These should be removed along with the partition or group, so any
+// found indicate a leak and are logged. It returns the number of entries
+// removed.
+func (m *metadataAPI) sweep() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.consumerGroupsMu.Lock()
+	defer m.consumerGroupsMu.Unlock()
+
+	partitions := 0
+	for _, stream := range m.streams {
+		partitions += len(stream.GetPartitions())
+	}
+	if len(m.partitionFailovers) > partitions {
+		atomic.AddInt64(&m.limitViolations, 1)
+		m.logger.Errorf("metadata: %d leader reports exceed the %d partitions in the metadata store",
+			len(m.partitionFailovers), partitions)
+	}
+	if len(m.groupFailovers) > len(m.consumerGroups) {
+		atomic.AddInt64(&m.limitViolations, 1)
+		m.logger.Errorf("metadata: %d coordinator reports exceed the %d consumer groups in the metadata store",
+			len(m.groupFailovers), len(m.consumerGroups))
+	}
+
+	removed := 0
+	for partition, failover := range m.partitionFailovers {
+		stream := m.streams[partition.Stream]
+		if stream != nil && stream.GetPartition(partition.Id) == partition {
+			continue
+		}
+		m.logger.Warnf("metadata: Removing leader report for deleted partition [stream=%s, partition=%d]",
+			partition.Stream, partition.Id)
+		failover.cancel()
+		delete(m.partitionFailovers, partition)
+		removed++
+	}
+	for group, failover := range m.groupFailovers {
+		if m.consumerGroups[group.GetID()] == group {
+			continue
+		}
+		m.logger.Warnf("metadata: Removing coordinator report for deleted consumer group %s", group.GetID())
+		failover.cancel()
+		delete(m.groupFailovers, group)
+		removed++
+	}
+	atomic.AddInt64(&m.staleRemoved, int64(removed))
+	return removed
+}
+
+// metadataSweepLoop periodically sweeps the metadata structures until the
+// server shuts down.
+func (s *Server) metadataSweepLoop() {
+	ticker := time.NewTicker(metadataSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-ticker.C:
+			s.metadata.sweep()
+		}
+	}
+}
+
+// approxBytes estimates the memory held by the partition's metadata, i.e. its
+// struct, replica sets, and protobuf, excluding its log.
+func (p *partition) approxBytes() int64 {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	bytes := mapEntryBytes(4, pointerSize) + int64(unsafe.Sizeof(*p)) + int64(p.Partition.Size())
+	for id := range p.replicas {
+		bytes += mapEntryBytes(stringSize, 0) + int64(len(id))
+	}
+	for id := range p.isr {
+		bytes += mapEntryBytes(stringSize, pointerSize) + int64(len(id)) +
+			int64(unsafe.Sizeof(replica{}))
+	}
+	return bytes
+}
+
+// approxBytes estimates the memory held by the failover and its witnesses.
+func (f *failoverStatus) approxBytes() int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	bytes := int64(unsafe.Sizeof(*f))
+	for id := range f.witnesses {
+		bytes += mapEntryBytes(stringSize, 0) + int64(len(id))
+	}
+	return bytes
+}
+
+// approxBytes estimates the memory held by the broker info.
+func (b *brokerInfo) approxBytes() int64 {
+	bytes := int64(unsafe.Sizeof(*b)) + int64(len(b.id)) + int64(len(b.address.Host))
+	for name, address := range b.listeners {
+		bytes += mapEntryBytes(stringSize, int64(unsafe.Sizeof(address))) +
+			int64(len(name)) + int64(len(address.Host))
+	}
+	return bytes
+}
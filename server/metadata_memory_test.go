@@ -0,0 +1,97 @@
+package server
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// getStructureStats returns the stats of the named structure or nil if it
+// wasn't reported.
+func getStructureStats(resp *proto.MetadataMemoryStatsResponse, name string) *proto.MetadataStructureStats {
+	for _, structure := range resp.Structures {
+		if structure.Name == name {
+			return structure
+		}
+	}
+	return nil
+}
+
+// Ensure sweep removes leader reports for partitions which are no longer in
+// the metadata store and counts the reports exceeding the partitions as a
+// limit violation.
+func TestMetadataSweepRemovesStaleLeaderReports(t *testing.T) {
+	defer cleanupStorage(t)
+
+	server := New(getTestConfig("a", true, 0))
+	metadata := newMetadataAPI(server)
+	defer metadata.Reset()
+
+	stream, err := metadata.AddStream(&proto.Stream{
+		Name:    "foo",
+		Subject: "foo",
+		Partitions: []*proto.Partition{
+			{
+				Stream:  "foo",
+				Subject: "foo",
+				Id:      0,
+			},
+		},
+	}, false, 0)
+	require.NoError(t, err)
+
+	// Report the live partition along with two which have been deleted.
+	live := stream.GetPartition(0)
+	metadata.partitionFailovers[live] = newFailoverStatus(nil)
+	for _, name := range []string{"foo", "bar"} {
+		stale := &partition{Partition: &proto.Partition{Stream: name, Id: 0}}
+		metadata.partitionFailovers[stale] = newFailoverStatus(nil)
+	}
+
+	resp := metadata.memoryStats()
+	reports := getStructureStats(resp, "leaderReports")
+	require.NotNil(t, reports)
+	require.Equal(t, int64(3), reports.Entries)
+	require.Equal(t, int64(1), reports.MaxEntries)
+
+	require.Equal(t, 2, metadata.sweep())
+	require.Len(t, metadata.partitionFailovers, 1)
+	require.Contains(t, metadata.partitionFailovers, live)
+
+	resp = metadata.memoryStats()
+	require.Equal(t, int64(1), getStructureStats(resp, "leaderReports").Entries)
+	require.Equal(t, int64(2), resp.StaleEntriesRemoved)
+	require.Equal(t, int64(1), resp.LimitViolations)
+
+	// Sweeping again finds nothing.
+	require.Equal(t, 0, metadata.sweep())
+	require.Equal(t, int64(1), metadata.memoryStats().LimitViolations)
+}
+
+// Ensure the map entry estimate is within a factor of two of the heap used by
+// a map of the size of the load counts of a large cluster.
+func TestMapEntryBytesEstimate(t *testing.T) {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	var estimate int64
+	load := make(map[string]int)
+	for i := 0; i < 50000; i++ {
+		id := fmt.Sprintf("broker-%d", i)
+		load[id] = i
+		estimate += mapEntryBytes(stringSize, intSize) + int64(len(id))
+	}
+
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(load)
+
+	used := int64(after.HeapAlloc) - int64(before.HeapAlloc)
+	require.Greater(t, estimate, used/2)
+	require.Less(t, estimate, used*2)
+}
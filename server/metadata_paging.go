@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// metadataPageSizeMetadataKey is the gRPC metadata key used to limit the
+	// number of streams FetchMetadata returns since FetchMetadataRequest has
+	// no field for it. Streams are then returned in name order, one page at a
+	// time.
+	metadataPageSizeMetadataKey = "liftbridge-metadata-page-size"
+
+	// metadataPageTokenMetadataKey is the gRPC metadata key used to fetch the
+	// page of streams following the one whose next page token is given.
+	metadataPageTokenMetadataKey = "liftbridge-metadata-page-token"
+
+	// nextPageTokenHeader is the FetchMetadata response header set to the
+	// token of the next page of streams since FetchMetadataResponse has no
+	// field for it. It's not set on the last page.
+	nextPageTokenHeader = "liftbridge-metadata-next-page-token"
+)
+
+// metadataPage is a page of streams requested from FetchMetadata. Pages hold
+// up to size streams whose names come after the given one.
+type metadataPage struct {
+	size  int
+	after string
+}
+
+// metadataPageFromContext returns the page of streams requested in the
+// incoming gRPC metadata or nil if FetchMetadata isn't paginated.
+func metadataPageFromContext(ctx context.Context) (*metadataPage, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	var (
+		sizes  = md.Get(metadataPageSizeMetadataKey)
+		tokens = md.Get(metadataPageTokenMetadataKey)
+	)
+	if len(sizes) == 0 {
+		if len(tokens) > 0 {
+			return nil, fmt.Errorf("%s requires %s", metadataPageTokenMetadataKey, metadataPageSizeMetadataKey)
+		}
+		return nil, nil
+	}
+	size, err := strconv.Atoi(sizes[0])
+	if err != nil || size <= 0 {
+		return nil, fmt.Errorf("invalid %s %q", metadataPageSizeMetadataKey, sizes[0])
+	}
+	page := &metadataPage{size: size}
+	if len(tokens) > 0 && tokens[0] != "" {
+		after, err := base64.RawURLEncoding.DecodeString(tokens[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q", metadataPageTokenMetadataKey, tokens[0])
+		}
+		page.after = string(after)
+	}
+	return page, nil
+}
+
+// pageToken returns the token of the page of streams following the given
+// stream. The token holds the stream's name, so the server doesn't keep any
+// state between pages.
+func pageToken(lastStream string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(lastStream))
+}
+
+// paginate returns the given stream names which are on the page, in name
+// order, along with the token of the next page or an empty string if this is
+// the last one. Since the page starts after the last stream of the previous
+// one, it doesn't matter if that stream has since been deleted.
+func (p *metadataPage) paginate(names []string) ([]string, string) {
+	sort.Strings(names)
+	start := sort.Search(len(names), func(i int) bool {
+		return names[i] > p.after
+	})
+	names = names[start:]
+	if len(names) <= p.size {
+		return names, ""
+	}
+	names = names[:p.size]
+	return names, pageToken(names[len(names)-1])
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the requested page is parsed from the request metadata and pages
+// start after the stream in the token.
+func TestMetadataPageFromContext(t *testing.T) {
+	page, err := metadataPageFromContext(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, page)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		metadataPageSizeMetadataKey, "2"))
+	page, err = metadataPageFromContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &metadataPage{size: 2}, page)
+
+	names, token := page.paginate([]string{"d", "b", "a", "c"})
+	require.Equal(t, []string{"a", "b"}, names)
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		metadataPageSizeMetadataKey, "2",
+		metadataPageTokenMetadataKey, token))
+	page, err = metadataPageFromContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &metadataPage{size: 2, after: "b"}, page)
+
+	names, token = page.paginate([]string{"d", "c", "a"})
+	require.Equal(t, []string{"c", "d"}, names)
+	require.Empty(t, token)
+
+	for _, md := range []metadata.MD{
+		metadata.Pairs(metadataPageSizeMetadataKey, "0"),
+		metadata.Pairs(metadataPageSizeMetadataKey, "foo"),
+		metadata.Pairs(metadataPageTokenMetadataKey, token),
+		metadata.Pairs(metadataPageSizeMetadataKey, "2", metadataPageTokenMetadataKey, "!"),
+	} {
+		_, err := metadataPageFromContext(metadata.NewIncomingContext(context.Background(), md))
+		require.Error(t, err)
+	}
+}
+
+// Ensure FetchMetadata returns every stream exactly once when fetched in
+// pages, and that a page token whose stream was deleted still returns the
+// following streams.
+func TestFetchMetadataPagination(t *testing.T) {
+	defer cleanupStorage(t)
+
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	apiClient := client.NewAPIClient(conn)
+
+	numStreams := 200
+	for i := 0; i < numStreams; i++ {
+		name := fmt.Sprintf("stream-%03d", i)
+		_, err := apiClient.CreateStream(context.Background(), &client.CreateStreamRequest{
+			Name:    name,
+			Subject: name,
+		})
+		require.NoError(t, err)
+	}
+
+	// fetch returns the names of the streams on the page following the given
+	// token along with the next page token.
+	fetch := func(token string) ([]string, string) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(),
+			metadataPageSizeMetadataKey, "10")
+		if token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, metadataPageTokenMetadataKey, token)
+		}
+		var header metadata.MD
+		resp, err := apiClient.FetchMetadata(ctx, &client.FetchMetadataRequest{}, grpc.Header(&header))
+		require.NoError(t, err)
+		var names []string
+		for _, stream := range resp.StreamMetadata {
+			names = append(names, stream.Name)
+		}
+		var next string
+		if tokens := header.Get(nextPageTokenHeader); len(tokens) > 0 {
+			next = tokens[0]
+		}
+		return names, next
+	}
+
+	var (
+		seen  = make(map[string]struct{})
+		pages int
+		token string
+	)
+	for {
+		names, next := fetch(token)
+		pages++
+		require.Len(t, names, 10)
+		for _, name := range names {
+			_, ok := seen[name]
+			require.False(t, ok, "stream %s returned twice", name)
+			seen[name] = struct{}{}
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	require.Equal(t, 20, pages)
+	require.Len(t, seen, numStreams)
+
+	// Delete the last stream of the first page and the first stream of the
+	// second.
+	first, token := fetch("")
+	for _, name := range []string{first[len(first)-1], "stream-010"} {
+		_, err := apiClient.DeleteStream(context.Background(), &client.DeleteStreamRequest{Name: name})
+		require.NoError(t, err)
+	}
+	names, next := fetch(token)
+	require.Len(t, names, 10)
+	require.Equal(t, "stream-011", names[0])
+	require.NotEmpty(t, next)
+
+	// A token past the last stream returns an empty last page.
+	names, next = fetch(pageToken("stream-999"))
+	require.Empty(t, names)
+	require.Empty(t, next)
+
+	// A token requires a page size.
+	ctx := metadata.AppendToOutgoingContext(context.Background(), metadataPageTokenMetadataKey, token)
+	_, err = apiClient.FetchMetadata(ctx, &client.FetchMetadataRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
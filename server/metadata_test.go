@@ -2,13 +2,16 @@ package server
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 	"time"
 
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
 	client "github.com/liftbridge-io/liftbridge-api/v2/go"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
 	proto "github.com/liftbridge-io/liftbridge/server/protocol"
 )
 
@@ -31,6 +34,44 @@ func TestMetadataCreateStreamNoPartitions(t *testing.T) {
 	require.Equal(t, codes.InvalidArgument, status.Code())
 }
 
+// Ensure watchers are notified only when the partitions they watch are started
+// and are cleaned up when they stop watching.
+func TestMetadataWatchPartitionsStarted(t *testing.T) {
+	defer cleanupStorage(t)
+
+	server := New(getTestConfig("a", true, 0))
+	metadata := newMetadataAPI(server)
+	defer metadata.Reset()
+
+	started, stop := metadata.watchPartitionsStarted("foo", []int32{0, 1})
+	other, stopOther := metadata.watchPartitionsStarted("foo", []int32{1})
+
+	metadata.partitionStarted("foo", 1)
+	metadata.partitionStarted("bar", 0)
+
+	select {
+	case <-started[1]:
+	default:
+		t.Fatal("Expected partition 1 to be started")
+	}
+	select {
+	case <-other[1]:
+	default:
+		t.Fatal("Expected partition 1 to be started")
+	}
+	select {
+	case <-started[0]:
+		t.Fatal("Expected partition 0 not to be started")
+	default:
+	}
+
+	stopOther()
+	stop()
+	metadata.startedMu.Lock()
+	require.Empty(t, metadata.startedWaiters)
+	metadata.startedMu.Unlock()
+}
+
 // Ensure AddStream returns an error if the stream doesn't have any partitions.
 func TestMetadataAddStreamNoPartitions(t *testing.T) {
 	defer cleanupStorage(t)
@@ -485,26 +526,43 @@ func TestMetadataGetPartitionReplicas(t *testing.T) {
 	// b = 3
 	// c = 1
 
-	_, status := metadata.getPartitionReplicas(0)
+	_, status := metadata.getPartitionReplicas(0, nil)
 	require.NotNil(t, status)
 
-	replicas, status := metadata.getPartitionReplicas(-1)
+	replicas, status := metadata.getPartitionReplicas(-1, nil)
 	require.Nil(t, status)
 	require.Equal(t, []string{"c", "b", "a"}, replicas)
 
-	replicas, status = metadata.getPartitionReplicas(1)
+	replicas, status = metadata.getPartitionReplicas(1, nil)
 	require.Nil(t, status)
 	require.Equal(t, []string{"c"}, replicas)
 
-	replicas, status = metadata.getPartitionReplicas(2)
+	replicas, status = metadata.getPartitionReplicas(2, nil)
 	require.Nil(t, status)
 	require.Equal(t, []string{"c", "b"}, replicas)
 
-	replicas, status = metadata.getPartitionReplicas(3)
+	replicas, status = metadata.getPartitionReplicas(3, nil)
 	require.Nil(t, status)
 	require.Equal(t, []string{"c", "b", "a"}, replicas)
 
-	_, status = metadata.getPartitionReplicas(4)
+	_, status = metadata.getPartitionReplicas(4, nil)
+	require.NotNil(t, status)
+
+	// Preferred replicas are selected regardless of load, and those missing
+	// from the cluster are replaced based on load.
+	replicas, status = metadata.getPartitionReplicas(2, []string{"a", "b"})
+	require.Nil(t, status)
+	require.Equal(t, []string{"a", "b"}, replicas)
+
+	replicas, status = metadata.getPartitionReplicas(2, []string{"a", "z"})
+	require.Nil(t, status)
+	require.Equal(t, []string{"a", "c"}, replicas)
+
+	replicas, status = metadata.getPartitionReplicas(-1, []string{"b", "a", "c"})
+	require.Nil(t, status)
+	require.Equal(t, []string{"b", "a", "c"}, replicas)
+
+	_, status = metadata.getPartitionReplicas(2, []string{"a"})
 	require.NotNil(t, status)
 }
 
@@ -595,3 +653,154 @@ func TestMetadataSelectPartitionLeader(t *testing.T) {
 	leader = metadata.selectPartitionLeader(replicas)
 	require.Equal(t, "a", leader)
 }
+
+// Ensure AddStream returns the existing stream if the same stream is added
+// again during recovery and that existing partition data is adopted when the
+// stream is recovered from scratch.
+func TestMetadataAddStreamRecoveredIdempotent(t *testing.T) {
+	defer cleanupStorage(t)
+
+	server := New(getTestConfig("a", true, 0))
+	metadata := newMetadataAPI(server)
+	defer metadata.Reset()
+
+	protoStream := &proto.Stream{
+		Name:              "foo",
+		Subject:           "foo",
+		CreationTimestamp: 1,
+		Partitions:        []*proto.Partition{{Stream: "foo", Subject: "foo", Id: 0}},
+	}
+	stream, err := metadata.AddStream(protoStream, false, 0)
+	require.NoError(t, err)
+	_, err = stream.GetPartition(0).log.Append([]*commitlog.Message{
+		{Value: []byte("hello")},
+		{Value: []byte("world")},
+	})
+	require.NoError(t, err)
+
+	// Re-applying the operation returns the existing stream.
+	existing, err := metadata.AddStream(protoStream, true, 1)
+	require.NoError(t, err)
+	require.Same(t, stream, existing)
+
+	// Recovering the stream from scratch adopts the data on disk.
+	require.NoError(t, metadata.Reset())
+	stream, err = metadata.AddStream(protoStream, true, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stream.GetPartition(0).log.NewestOffset())
+
+	manifest, err := readPartitionManifest(server.partitionDir("foo", 0))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), manifest.CreationTimestamp)
+}
+
+// Ensure partition data belonging to a previous incarnation of a stream is
+// quarantined when the stream is recreated and that data belonging to a later
+// incarnation is left alone while an older operation is replayed.
+func TestMetadataAddStreamRecoveredQuarantinesStaleData(t *testing.T) {
+	defer cleanupStorage(t)
+
+	config := getTestConfig("a", true, 0)
+	server := New(config)
+	metadata := newMetadataAPI(server)
+	defer metadata.Reset()
+
+	newStream := func(creationTimestamp int64) *proto.Stream {
+		return &proto.Stream{
+			Name:              "foo",
+			Subject:           "foo",
+			CreationTimestamp: creationTimestamp,
+			Partitions:        []*proto.Partition{{Stream: "foo", Subject: "foo", Id: 0}},
+		}
+	}
+
+	stream, err := metadata.AddStream(newStream(1), false, 0)
+	require.NoError(t, err)
+	_, err = stream.GetPartition(0).log.Append([]*commitlog.Message{{Value: []byte("old")}})
+	require.NoError(t, err)
+	require.NoError(t, metadata.Reset())
+
+	// Recreating the stream with a newer creation timestamp quarantines the
+	// old data.
+	stream, err = metadata.AddStream(newStream(2), true, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), stream.GetPartition(0).log.NewestOffset())
+	quarantined, err := filepath.Glob(filepath.Join(config.DataDir, quarantineDir, "foo", "0-*"))
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+	manifest, err := readPartitionManifest(quarantined[0])
+	require.NoError(t, err)
+	require.Equal(t, int64(1), manifest.CreationTimestamp)
+
+	_, err = stream.GetPartition(0).log.Append([]*commitlog.Message{{Value: []byte("new")}})
+	require.NoError(t, err)
+	require.NoError(t, metadata.Reset())
+
+	// Replaying the old operation followed by the new one leaves the new
+	// data intact.
+	_, err = metadata.AddStream(newStream(1), true, 2)
+	require.NoError(t, err)
+	stream, err = metadata.AddStream(newStream(2), true, 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), stream.GetPartition(0).log.NewestOffset())
+
+	quarantined, err = filepath.Glob(filepath.Join(config.DataDir, quarantineDir, "foo", "0-*"))
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+}
+
+// Ensure operations requiring a newer protocol version are rejected while a
+// server in the cluster implements an older version and are applied once
+// every server has been upgraded.
+func TestMetadataAssertMinVersionMixedCluster(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server to advertise a protocol version older than
+	// UpdateStreamOwner requires.
+	required := opProtocolVersions[proto.Op_UPDATE_STREAM_OWNER]
+	require.NotZero(t, required)
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := New(s2Config)
+	s2.protocolVersion = required - 1
+	require.NoError(t, s2.Start())
+	defer func() { s2.Stop() }()
+
+	servers := []*Server{s1, s2}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	c, err := lift.Connect([]string{"localhost:5050", "localhost:5051"})
+	require.NoError(t, err)
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	require.NoError(t, c.CreateStream(ctx, "foo", "foo", lift.ReplicationFactor(2)))
+	waitForISR(t, 10*time.Second, "foo", 0, 2, servers...)
+
+	leader := getMetadataLeader(t, 10*time.Second, servers...)
+	st := leader.metadata.UpdateStreamOwner(ctx, &proto.UpdateStreamOwnerOp{Stream: "foo", Owner: "billing"})
+	require.NotNil(t, st)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+	minVersion, err := leader.metadata.minClusterVersion()
+	require.NoError(t, err)
+	require.Equal(t, required-1, minVersion)
+	require.NotEqual(t, "billing", leader.metadata.GetStream("foo").GetOrigin().GetOwner())
+
+	// Upgrade the second server.
+	s2.Stop()
+	s2 = runServerWithConfig(t, s2Config)
+	servers = []*Server{s1, s2}
+	waitForISR(t, 10*time.Second, "foo", 0, 2, servers...)
+
+	leader = getMetadataLeader(t, 10*time.Second, servers...)
+	st = leader.metadata.UpdateStreamOwner(ctx, &proto.UpdateStreamOwnerOp{Stream: "foo", Owner: "billing"})
+	require.Nil(t, st)
+	minVersion, err = leader.metadata.minClusterVersion()
+	require.NoError(t, err)
+	require.Equal(t, currentProtocolVersion, minVersion)
+}
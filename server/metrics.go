@@ -0,0 +1,162 @@
+package server
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// meterTickInterval is how often a rateMeter folds the events marked
+	// since the last tick into its moving average.
+	meterTickInterval = 5 * time.Second
+
+	// meterWindow is the period the rateMeter moving average approximates.
+	meterWindow = time.Minute
+)
+
+// meterAlpha is the EWMA smoothing factor for a meterWindow average updated
+// every meterTickInterval.
+var meterAlpha = 1 - math.Exp(-meterTickInterval.Seconds()/meterWindow.Seconds())
+
+// rateMeter tracks a cumulative count of events along with an exponentially
+// weighted moving average of their per-second rate over roughly the last
+// minute. Rather than ticking on a timer, the average is brought up to date
+// lazily whenever the meter is marked or read, so idle meters cost nothing.
+type rateMeter struct {
+	mu        sync.Mutex
+	count     int64
+	uncounted int64
+	rate      float64
+	lastTick  time.Time
+}
+
+func newRateMeter() *rateMeter {
+	return &rateMeter{lastTick: time.Now()}
+}
+
+// mark records n events.
+func (m *rateMeter) mark(n int64) {
+	m.mu.Lock()
+	m.tick(time.Now())
+	m.count += n
+	m.uncounted += n
+	m.mu.Unlock()
+}
+
+// snapshot returns the cumulative count and the per-second moving average.
+func (m *rateMeter) snapshot() (int64, float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tick(time.Now())
+	return m.count, m.rate
+}
+
+// tick folds any elapsed intervals into the moving average. Events marked
+// since the last tick are attributed to the first elapsed interval and any
+// further intervals are treated as idle. Must be called with the lock held.
+func (m *rateMeter) tick(now time.Time) {
+	elapsed := int64(now.Sub(m.lastTick) / meterTickInterval)
+	if elapsed <= 0 {
+		return
+	}
+	instant := float64(m.uncounted) / meterTickInterval.Seconds()
+	m.rate += meterAlpha * (instant - m.rate)
+	m.uncounted = 0
+	// Decay the average for the idle intervals. After enough of them the
+	// rate is indistinguishable from zero.
+	if idle := elapsed - 1; idle > 0 {
+		m.rate *= math.Pow(1-meterAlpha, float64(idle))
+	}
+	m.lastTick = m.lastTick.Add(time.Duration(elapsed) * meterTickInterval)
+}
+
+// partitionMetrics tracks the throughput of a partition on this server.
+// Messages and bytes in count data appended to the local log, whether
+// published to the leader or replicated to a follower. Bytes out counts data
+// read from the log and sent to subscribers or followers. Messages in are
+// also broken down by ingest source.
+//
+// The bytes read counters track every message read from the log's segments,
+// including those filtered out of a subscription, to measure read
+// amplification. They are updated on every read, so they're plain atomic
+// counters rather than rate meters.
+type partitionMetrics struct {
+	tailBytesRead        int64 // Atomic bytes read by subscriptions of messages committed after they started
+	historicalBytesRead  int64 // Atomic bytes read by subscriptions of messages committed before they started
+	replicationBytesRead int64 // Atomic bytes read to replicate to followers
+	messagesIn           *rateMeter
+	bytesIn              *rateMeter
+	bytesOut             *rateMeter
+	ingest               ingestCounters
+}
+
+func newPartitionMetrics() *partitionMetrics {
+	return &partitionMetrics{
+		messagesIn: newRateMeter(),
+		bytesIn:    newRateMeter(),
+		bytesOut:   newRateMeter(),
+	}
+}
+
+// markSubscriptionRead records bytes read from the log for a subscription.
+// Reads of messages at or past tailOffset, the offset following the high
+// watermark when the subscription started, are tail reads. Anything before it
+// is a replay of history.
+func (m *partitionMetrics) markSubscriptionRead(offset, tailOffset int64, bytes int) {
+	if offset >= tailOffset {
+		atomic.AddInt64(&m.tailBytesRead, int64(bytes))
+	} else {
+		atomic.AddInt64(&m.historicalBytesRead, int64(bytes))
+	}
+}
+
+// readAmplification returns a snapshot of the bytes read from the log by
+// source along with the given bytes appended to the log and its cold segment
+// opens.
+func (m *partitionMetrics) readAmplification(bytesAppended, coldSegmentOpens int64) *proto.ReadAmplification {
+	amp := &proto.ReadAmplification{
+		BytesAppended:        bytesAppended,
+		TailBytesRead:        atomic.LoadInt64(&m.tailBytesRead),
+		HistoricalBytesRead:  atomic.LoadInt64(&m.historicalBytesRead),
+		ReplicationBytesRead: atomic.LoadInt64(&m.replicationBytesRead),
+		ColdSegmentOpens:     coldSegmentOpens,
+	}
+	setReadAmplificationFactor(amp)
+	return amp
+}
+
+// sumReadAmplification adds up the read amplification of the given
+// partitions, ignoring any which weren't reported, and computes the factor of
+// the totals.
+func sumReadAmplification(loads []*proto.PartitionLoad) *proto.ReadAmplification {
+	sum := &proto.ReadAmplification{}
+	for _, load := range loads {
+		amp := load.ReadAmplification
+		if amp == nil {
+			continue
+		}
+		sum.BytesAppended += amp.BytesAppended
+		sum.TailBytesRead += amp.TailBytesRead
+		sum.HistoricalBytesRead += amp.HistoricalBytesRead
+		sum.ReplicationBytesRead += amp.ReplicationBytesRead
+		sum.ColdSegmentOpens += amp.ColdSegmentOpens
+	}
+	setReadAmplificationFactor(sum)
+	return sum
+}
+
+// setReadAmplificationFactor sets the factor of the given read amplification
+// to the total bytes read divided by the bytes appended. It's left at 0 if
+// nothing was appended.
+func setReadAmplificationFactor(amp *proto.ReadAmplification) {
+	if amp.BytesAppended == 0 {
+		amp.Factor = 0
+		return
+	}
+	read := amp.TailBytesRead + amp.HistoricalBytesRead + amp.ReplicationBytesRead
+	amp.Factor = float64(read) / float64(amp.BytesAppended)
+}
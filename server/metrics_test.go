@@ -0,0 +1,73 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure rateMeter converges on a steady rate and decays when idle.
+func TestRateMeter(t *testing.T) {
+	m := newRateMeter()
+	start := m.lastTick
+
+	// Mark 100 events per second for 10 minutes.
+	for i := 0; i < 600; i++ {
+		m.tick(start.Add(time.Duration(i) * time.Second))
+		m.count += 100
+		m.uncounted += 100
+	}
+	m.tick(start.Add(600 * time.Second))
+	require.Equal(t, int64(60000), m.count)
+	require.InDelta(t, 100, m.rate, 1)
+
+	// After 10 idle minutes the rate should have decayed to nearly zero but
+	// the count is retained.
+	m.tick(start.Add(1200 * time.Second))
+	require.Equal(t, int64(60000), m.count)
+	require.InDelta(t, 0, m.rate, 0.1)
+}
+
+// Ensure snapshot does not fold events into the rate until an interval has
+// elapsed.
+func TestRateMeterSnapshot(t *testing.T) {
+	m := newRateMeter()
+	m.mark(5)
+	m.mark(10)
+	count, rate := m.snapshot()
+	require.Equal(t, int64(15), count)
+	require.Equal(t, float64(0), rate)
+}
+
+// Ensure subscription reads are split into tail and historical reads and the
+// read amplification factor is computed over the totals of a stream's
+// partitions.
+func TestReadAmplification(t *testing.T) {
+	m := newPartitionMetrics()
+	m.markSubscriptionRead(4, 5, 10)
+	m.markSubscriptionRead(5, 5, 20)
+	m.markSubscriptionRead(6, 5, 20)
+	m.replicationBytesRead = 30
+	amp := m.readAmplification(40, 2)
+	require.Equal(t, int64(40), amp.TailBytesRead)
+	require.Equal(t, int64(10), amp.HistoricalBytesRead)
+	require.Equal(t, int64(30), amp.ReplicationBytesRead)
+	require.Equal(t, int64(2), amp.ColdSegmentOpens)
+	require.Equal(t, float64(2), amp.Factor)
+
+	// Nothing appended leaves the factor at 0.
+	require.Equal(t, float64(0), m.readAmplification(0, 0).Factor)
+
+	sum := sumReadAmplification([]*proto.PartitionLoad{
+		{Partition: 0, ReadAmplification: amp},
+		{Partition: 1, ReadAmplification: &proto.ReadAmplification{BytesAppended: 60, TailBytesRead: 20}},
+		{Partition: 2},
+	})
+	require.Equal(t, int64(100), sum.BytesAppended)
+	require.Equal(t, int64(60), sum.TailBytesRead)
+	require.Equal(t, int64(2), sum.ColdSegmentOpens)
+	require.Equal(t, float64(1), sum.Factor)
+}
@@ -0,0 +1,300 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// mirrorSourceAddressesMetadataKey, mirrorSourceStreamMetadataKey, and
+	// mirrorSourceStartOffsetMetadataKey are the gRPC metadata keys used to
+	// make a stream a mirror of a stream in another cluster when creating it
+	// since CreateStreamRequest has no field for it. Addresses may be given
+	// as multiple values or comma-separated. The source stream defaults to
+	// the name of the stream being created and the start offset to 0.
+	mirrorSourceAddressesMetadataKey   = "liftbridge-mirror-source-addresses"
+	mirrorSourceStreamMetadataKey      = "liftbridge-mirror-source-stream"
+	mirrorSourceStartOffsetMetadataKey = "liftbridge-mirror-source-start-offset"
+
+	// minMirrorRetryBackoff and maxMirrorRetryBackoff bound how long a mirror
+	// waits before subscribing to its source partition again after the
+	// subscription fails. The wait doubles with each consecutive failure.
+	minMirrorRetryBackoff = 500 * time.Millisecond
+	maxMirrorRetryBackoff = 10 * time.Second
+
+	// mirrorLagInterval is how often a mirror fetches the source partition's
+	// HW to compute its lag.
+	mirrorLagInterval = time.Second
+
+	// mirrorConnectTimeout bounds how long a mirror waits to connect to the
+	// source cluster.
+	mirrorConnectTimeout = 10 * time.Second
+)
+
+// errMirrorStopped is returned when a message is received from the source
+// partition after the mirror has stopped.
+var errMirrorStopped = errors.New("mirror stopped")
+
+// mirrorSourceFromContext returns the mirror source set in the incoming gRPC
+// metadata when creating the given stream, if any.
+func mirrorSourceFromContext(ctx context.Context, stream string) (*proto.MirrorSource, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	var addresses []string
+	for _, value := range md.Get(mirrorSourceAddressesMetadataKey) {
+		for _, addr := range strings.Split(value, ",") {
+			if addr = strings.TrimSpace(addr); addr != "" {
+				addresses = append(addresses, addr)
+			}
+		}
+	}
+	var (
+		streams      = md.Get(mirrorSourceStreamMetadataKey)
+		startOffsets = md.Get(mirrorSourceStartOffsetMetadataKey)
+	)
+	switch {
+	case len(addresses) == 0 && len(streams) == 0 && len(startOffsets) == 0:
+		return nil, nil
+	case len(addresses) == 0:
+		return nil, fmt.Errorf("%s must be set", mirrorSourceAddressesMetadataKey)
+	case len(streams) > 1:
+		return nil, fmt.Errorf("only one %s can be set", mirrorSourceStreamMetadataKey)
+	case len(streams) == 1 && streams[0] == "":
+		return nil, fmt.Errorf("%s cannot be empty", mirrorSourceStreamMetadataKey)
+	case len(startOffsets) > 1:
+		return nil, fmt.Errorf("only one %s can be set", mirrorSourceStartOffsetMetadataKey)
+	}
+	source := &proto.MirrorSource{Addresses: addresses, Stream: stream}
+	if len(streams) == 1 {
+		source.Stream = streams[0]
+	}
+	if len(startOffsets) == 1 {
+		startOffset, err := strconv.ParseInt(startOffsets[0], 10, 64)
+		if err != nil || startOffset < 0 {
+			return nil, fmt.Errorf("%s must be a non-negative integer", mirrorSourceStartOffsetMetadataKey)
+		}
+		source.StartOffset = startOffset
+	}
+	return source, nil
+}
+
+// computeMirrorRetryBackoff returns how long to wait before retrying a failed
+// mirror subscription given the previous wait, or 0 if there was none.
+func computeMirrorRetryBackoff(previousBackoff time.Duration) time.Duration {
+	if previousBackoff == 0 {
+		return minMirrorRetryBackoff
+	}
+	backoff := previousBackoff * 2
+	if backoff > maxMirrorRetryBackoff {
+		backoff = maxMirrorRetryBackoff
+	}
+	return backoff
+}
+
+// mirrorLoop is a long-running loop run by the leader of a mirror partition
+// instead of consuming messages from NATS. It copies the messages of the
+// source partition into the log, preserving their offsets, until the stop
+// channel is closed. The Liftbridge client resubscribes if the source
+// partition's leader fails over. If it gives up or the source cluster is
+// unreachable, the subscription is retried with exponential backoff, which is
+// reset once messages are copied again.
+func (p *partition) mirrorLoop(stop <-chan struct{}, leaderEpoch uint64) {
+	var (
+		mu      sync.Mutex
+		stopped bool
+	)
+	// Messages are appended on the client's dispatch goroutine, so ensure
+	// none are appended once the loop returns.
+	appendMsg := func(msg *lift.Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if stopped {
+			return errMirrorStopped
+		}
+		return p.appendMirrored(msg, leaderEpoch)
+	}
+	defer func() {
+		mu.Lock()
+		stopped = true
+		mu.Unlock()
+		// The lag is only known while leading.
+		atomic.StoreInt64(&p.mirrorLag, -1)
+	}()
+
+	var backoff time.Duration
+	for {
+		newest := p.log.NewestOffset()
+		err := p.mirror(stop, appendMsg)
+		if err == nil {
+			return
+		}
+		p.srv.logger.Errorf("Failed to mirror partition %s from stream %s: %v",
+			p, p.mirrorSource.Stream, err)
+		if p.log.NewestOffset() > newest {
+			backoff = 0
+		}
+		backoff = computeMirrorRetryBackoff(backoff)
+		select {
+		case <-stop:
+			return
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// mirror subscribes to the source partition starting after the newest offset
+// in the log, or at the mirror source's start offset if it's later, and
+// appends the messages it receives. It periodically updates the mirror's lag
+// behind the source partition's HW. It returns nil once the stop channel is
+// closed or an error if the subscription fails.
+func (p *partition) mirror(stop <-chan struct{}, appendMsg func(*lift.Message) error) error {
+	connectCtx, cancelConnect := context.WithTimeout(context.Background(), mirrorConnectTimeout)
+	c, err := lift.ConnectCtx(connectCtx, p.mirrorSource.Addresses)
+	cancelConnect()
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to source cluster")
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errC := make(chan error, 1)
+	fail := func(err error) {
+		select {
+		case errC <- err:
+		default:
+		}
+	}
+	err = c.Subscribe(ctx, p.mirrorSource.Stream, func(msg *lift.Message, err error) {
+		if err != nil {
+			fail(err)
+			return
+		}
+		if err := appendMsg(msg); err != nil {
+			fail(err)
+			cancel()
+		}
+	}, lift.Partition(p.Id), lift.StartAtOffset(p.mirrorStartOffset()))
+	if err != nil {
+		return errors.Wrap(err, "failed to subscribe to source partition")
+	}
+
+	ticker := time.NewTicker(mirrorLagInterval)
+	defer ticker.Stop()
+	p.updateMirrorLag(ctx, c)
+	for {
+		select {
+		case <-stop:
+			return nil
+		case err := <-errC:
+			return err
+		case <-ticker.C:
+			p.updateMirrorLag(ctx, c)
+		}
+	}
+}
+
+// mirrorStartOffset returns the offset in the source partition to resume
+// copying from.
+func (p *partition) mirrorStartOffset() int64 {
+	offset := p.log.NewestOffset() + 1
+	if offset < p.mirrorSource.StartOffset {
+		offset = p.mirrorSource.StartOffset
+	}
+	return offset
+}
+
+// updateMirrorLag sets the mirror's lag to the number of committed messages
+// in the source partition it has yet to copy. Messages before the mirror
+// source's start offset aren't counted. The lag is left unchanged if the
+// source partition's HW can't be fetched.
+func (p *partition) updateMirrorLag(ctx context.Context, c lift.Client) {
+	ctx, cancel := context.WithTimeout(ctx, mirrorLagInterval)
+	defer cancel()
+	info, err := c.FetchPartitionMetadata(ctx, p.mirrorSource.Stream, p.Id)
+	if err != nil {
+		p.srv.logger.Debugf("Failed to fetch metadata of source partition of mirror %s: %v", p, err)
+		return
+	}
+	lag := info.HighWatermark() - p.mirrorStartOffset() + 1
+	if lag < 0 {
+		lag = 0
+	}
+	atomic.StoreInt64(&p.mirrorLag, lag)
+}
+
+// MirrorLag returns the number of committed messages in the source partition
+// the mirror has yet to copy, or -1 if the partition isn't a mirror, this
+// server isn't its leader, or the lag isn't known yet.
+func (p *partition) MirrorLag() int64 {
+	return atomic.LoadInt64(&p.mirrorLag)
+}
+
+// appendMirrored writes a message received from the source partition to the
+// log at the offset it has in the source partition. Messages the log already
+// contains are skipped. The HW advances as the ISR replicates the message.
+// Since only committed messages are received from the source partition, it
+// never passes the source partition's HW.
+func (p *partition) appendMirrored(msg *lift.Message, leaderEpoch uint64) error {
+	if msg.Offset() <= p.log.NewestOffset() {
+		return nil
+	}
+
+	p.mu.Lock()
+	p.messagesReceivedTimestamps.update()
+	p.mu.Unlock()
+
+	m := &commitlog.Message{
+		MagicByte:   1,
+		Key:         msg.Key(),
+		Value:       msg.Value(),
+		Headers:     msg.Headers(),
+		Timestamp:   msg.Timestamp().UnixNano(),
+		LeaderEpoch: leaderEpoch,
+	}
+	if m.Headers == nil {
+		m.Headers = make(map[string][]byte, 1)
+	}
+	m.Headers[ingestSourceHeader] = []byte(ingestSourceMirror)
+	if p.encryptionHandler != nil {
+		value, err := p.encryptionHandler.Seal(m.Value)
+		if err != nil {
+			return errors.Wrap(err, "failed to encrypt message")
+		}
+		m.Value = value
+	}
+
+	ms, err := commitlog.NewMessageSet(msg.Offset(), []*commitlog.Message{m})
+	if err != nil {
+		return errors.Wrap(err, "failed to create message set")
+	}
+	offsets, err := p.log.AppendMessageSet(ms)
+	if err != nil {
+		return errors.Wrap(err, "failed to append to log")
+	}
+
+	p.metrics.messagesIn.mark(1)
+	p.metrics.bytesIn.mark(int64(len(m.Value)))
+	p.metrics.ingest.mark(ingestSourceMirror)
+	if p.keys != nil {
+		p.keys.addBatch([]*commitlog.Message{m})
+	}
+
+	// Update this replica's latest offset so the HW is advanced.
+	p.updateISRLatestOffset(p.srv.config.Clustering.ServerID, offsets[len(offsets)-1])
+	return nil
+}
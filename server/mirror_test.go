@@ -0,0 +1,298 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure mirrorSourceFromContext parses the mirror source addresses and
+// stream, defaulting the stream to the one being created.
+func TestMirrorSourceFromContext(t *testing.T) {
+	source, err := mirrorSourceFromContext(context.Background(), "foo")
+	require.NoError(t, err)
+	require.Nil(t, source)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		mirrorSourceAddressesMetadataKey, "a:9292, b:9292",
+		mirrorSourceAddressesMetadataKey, "c:9292"))
+	source, err = mirrorSourceFromContext(ctx, "foo")
+	require.NoError(t, err)
+	require.Equal(t, &proto.MirrorSource{Addresses: []string{"a:9292", "b:9292", "c:9292"}, Stream: "foo"}, source)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		mirrorSourceAddressesMetadataKey, "a:9292",
+		mirrorSourceStreamMetadataKey, "bar"))
+	source, err = mirrorSourceFromContext(ctx, "foo")
+	require.NoError(t, err)
+	require.Equal(t, "bar", source.Stream)
+
+	// The source stream requires addresses.
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		mirrorSourceStreamMetadataKey, "bar"))
+	_, err = mirrorSourceFromContext(ctx, "foo")
+	require.Error(t, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		mirrorSourceAddressesMetadataKey, "a:9292",
+		mirrorSourceStreamMetadataKey, ""))
+	_, err = mirrorSourceFromContext(ctx, "foo")
+	require.Error(t, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		mirrorSourceAddressesMetadataKey, "a:9292",
+		mirrorSourceStartOffsetMetadataKey, "42"))
+	source, err = mirrorSourceFromContext(ctx, "foo")
+	require.NoError(t, err)
+	require.Equal(t, int64(42), source.StartOffset)
+
+	// The start offset requires addresses.
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		mirrorSourceStartOffsetMetadataKey, "42"))
+	_, err = mirrorSourceFromContext(ctx, "foo")
+	require.Error(t, err)
+
+	for _, startOffset := range []string{"-1", "foo"} {
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			mirrorSourceAddressesMetadataKey, "a:9292",
+			mirrorSourceStartOffsetMetadataKey, startOffset))
+		_, err = mirrorSourceFromContext(ctx, "foo")
+		require.Error(t, err)
+	}
+}
+
+// Ensure computeMirrorRetryBackoff doubles the backoff time and caps it at
+// the max backoff.
+func TestComputeMirrorRetryBackoff(t *testing.T) {
+	var backoff time.Duration
+	backoff = computeMirrorRetryBackoff(backoff)
+	require.Equal(t, 500*time.Millisecond, backoff)
+	backoff = computeMirrorRetryBackoff(backoff)
+	require.Equal(t, time.Second, backoff)
+	backoff = computeMirrorRetryBackoff(backoff)
+	require.Equal(t, 2*time.Second, backoff)
+	backoff = computeMirrorRetryBackoff(backoff)
+	require.Equal(t, 4*time.Second, backoff)
+	backoff = computeMirrorRetryBackoff(backoff)
+	require.Equal(t, 8*time.Second, backoff)
+	backoff = computeMirrorRetryBackoff(backoff)
+	require.Equal(t, 10*time.Second, backoff)
+	backoff = computeMirrorRetryBackoff(backoff)
+	require.Equal(t, 10*time.Second, backoff)
+}
+
+// Ensure a mirror stream copies its source stream from another cluster with
+// the same offsets, rejects publishes, and catches up after the source
+// cluster restarts.
+func TestMirrorStream(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use a central NATS server shared by both clusters.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	// Configure the primary cluster.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.EmbeddedNATS = false
+	s1 := runServerWithConfig(t, s1Config)
+	defer func() { s1.Stop() }()
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	// Configure the DR cluster.
+	s2Config := getTestConfig("b", true, 5051)
+	s2Config.EmbeddedNATS = false
+	s2Config.Clustering.Namespace = "dr"
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+	getMetadataLeader(t, 10*time.Second, s2)
+
+	primary, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer primary.Close()
+
+	dr, err := lift.Connect([]string{"localhost:5051"})
+	require.NoError(t, err)
+	defer dr.Close()
+
+	require.NoError(t, primary.CreateStream(context.Background(), "foo", "foo"))
+	publish := func(from, to int) {
+		for i := from; i < to; i++ {
+			_, err := primary.Publish(context.Background(), "foo", []byte(fmt.Sprintf("msg-%d", i)),
+				lift.Key([]byte("key")), lift.AckPolicyAll())
+			require.NoError(t, err)
+		}
+	}
+	publish(0, 5)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		mirrorSourceAddressesMetadataKey, "localhost:5050",
+		mirrorSourceStreamMetadataKey, "foo")
+	require.NoError(t, dr.CreateStream(ctx, "bar", "bar"))
+
+	msgs := make(chan *lift.Message, 10)
+	subCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err = dr.Subscribe(subCtx, "bar", func(msg *lift.Message, err error) {
+		require.NoError(t, err)
+		msgs <- msg
+	}, lift.StartAtEarliestReceived())
+	require.NoError(t, err)
+
+	receive := func(from, to int) {
+		for i := from; i < to; i++ {
+			select {
+			case msg := <-msgs:
+				require.Equal(t, int64(i), msg.Offset())
+				require.Equal(t, []byte(fmt.Sprintf("msg-%d", i)), msg.Value())
+				require.Equal(t, []byte("key"), msg.Key())
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Did not receive mirrored message %d", i)
+			}
+		}
+	}
+	receive(0, 5)
+
+	// Publishes to the mirror are rejected.
+	conn, err := grpc.Dial("localhost:5051", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = client.NewAPIClient(conn).Publish(context.Background(), &client.PublishRequest{
+		Stream:    "bar",
+		Value:     []byte("hello"),
+		AckPolicy: client.AckPolicy_LEADER,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	publish(5, 8)
+	receive(5, 8)
+
+	// Restart the primary cluster. The mirror catches up once it's back.
+	s1.Stop()
+	s1 = runServerWithConfig(t, s1Config)
+	getMetadataLeader(t, 10*time.Second, s1)
+	waitForPartition(t, 10*time.Second, "foo", 0, s1)
+	publish(8, 10)
+	receive(8, 10)
+
+	// Mirrors are deleted like any other stream.
+	cancel()
+	require.NoError(t, dr.DeleteStream(context.Background(), "bar"))
+	require.Nil(t, s2.metadata.GetStream("bar"))
+}
+
+// Ensure a mirror created with a start offset only copies the source
+// partition from that offset, copies new messages within 5 seconds, and
+// reports its lag in the FetchPartitionMetadata headers.
+func TestMirrorStreamStartOffset(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use a central NATS server shared by both clusters.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	// Configure the primary cluster.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.EmbeddedNATS = false
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	// Configure the DR cluster.
+	s2Config := getTestConfig("b", true, 5051)
+	s2Config.EmbeddedNATS = false
+	s2Config.Clustering.Namespace = "dr"
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+	getMetadataLeader(t, 10*time.Second, s2)
+
+	primary, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer primary.Close()
+
+	dr, err := lift.Connect([]string{"localhost:5051"})
+	require.NoError(t, err)
+	defer dr.Close()
+
+	require.NoError(t, primary.CreateStream(context.Background(), "foo", "foo"))
+	publish := func(from, to int) {
+		for i := from; i < to; i++ {
+			_, err := primary.Publish(context.Background(), "foo", []byte(fmt.Sprintf("msg-%d", i)),
+				lift.AckPolicyAll())
+			require.NoError(t, err)
+		}
+	}
+	publish(0, 10)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		mirrorSourceAddressesMetadataKey, "localhost:5050",
+		mirrorSourceStreamMetadataKey, "foo",
+		mirrorSourceStartOffsetMetadataKey, "5")
+	require.NoError(t, dr.CreateStream(ctx, "bar", "bar"))
+
+	msgs := make(chan *lift.Message, 10)
+	subCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err = dr.Subscribe(subCtx, "bar", func(msg *lift.Message, err error) {
+		require.NoError(t, err)
+		msgs <- msg
+	}, lift.StartAtEarliestReceived())
+	require.NoError(t, err)
+
+	receive := func(from, to int) {
+		for i := from; i < to; i++ {
+			select {
+			case msg := <-msgs:
+				require.Equal(t, int64(i), msg.Offset())
+				require.Equal(t, []byte(fmt.Sprintf("msg-%d", i)), msg.Value())
+			case <-time.After(5 * time.Second):
+				t.Fatalf("Did not receive mirrored message %d", i)
+			}
+		}
+	}
+	receive(5, 10)
+	publish(10, 12)
+	receive(10, 12)
+
+	// The mirror has caught up with the source partition.
+	conn, err := grpc.Dial("localhost:5051", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	apiClient := client.NewAPIClient(conn)
+	waitForMirrorLag := func(expected string) {
+		var header metadata.MD
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			_, err := apiClient.FetchPartitionMetadata(context.Background(),
+				&client.FetchPartitionMetadataRequest{Stream: "bar"}, grpc.Header(&header))
+			require.NoError(t, err)
+			if values := header.Get(mirrorLagHeader); len(values) == 1 && values[0] == expected {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("Mirror lag is %v, expected %s", header.Get(mirrorLagHeader), expected)
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+	waitForMirrorLag("0")
+
+	// The lag isn't reported for streams which aren't mirrors.
+	require.NoError(t, dr.CreateStream(context.Background(), "baz", "baz"))
+	var header metadata.MD
+	_, err = apiClient.FetchPartitionMetadata(context.Background(),
+		&client.FetchPartitionMetadataRequest{Stream: "baz"}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Empty(t, header.Get(mirrorLagHeader))
+}
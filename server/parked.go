@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+)
+
+// gapStartHeader is set on the first message a resumed subscription receives
+// if retention removed the messages it was due to receive while the partition
+// was paused. Its value is the offset of the first removed message, so the
+// messages from it up to the offset of the message carrying the header were
+// not delivered.
+const gapStartHeader = "liftbridge-gap-start"
+
+// parkedSubscription is a subscription which was reading the partition when
+// it was paused. It's re-attached to the partition replacing this one when it
+// is resumed so that subscribers aren't disconnected by the pause.
+type parkedSubscription struct {
+	ctx        context.Context
+	sub        *subscription
+	stopOffset int64
+}
+
+// end ends the parked subscription with the given status.
+func (ps *parkedSubscription) end(st *status.Status) {
+	select {
+	case ps.sub.errors <- st:
+	case <-ps.sub.closed:
+	case <-ps.ctx.Done():
+	}
+}
+
+// park parks the subscription until the partition is resumed. If the
+// partition was resumed while the subscription's reader was being closed, it
+// is re-attached to the resumed partition right away, and if the partition
+// was closed or deleted, the subscription is ended.
+func (p *partition) park(ctx context.Context, sub *subscription, stopOffset int64) {
+	ps := &parkedSubscription{ctx: ctx, sub: sub, stopOffset: stopOffset}
+	p.mu.Lock()
+	resumed, st := p.resumedAs, p.parkClosed
+	if resumed == nil && st == nil {
+		p.parked = append(p.parked, ps)
+	}
+	p.mu.Unlock()
+
+	switch {
+	case resumed != nil:
+		resumed.unpark([]*parkedSubscription{ps})
+	case st != nil:
+		ps.end(st)
+	default:
+		p.srv.logger.Debugf("Parked subscription to paused partition %s at offset %d", p, sub.next)
+	}
+}
+
+// resume records the partition replacing this one once it's resumed and
+// re-attaches the parked subscriptions to it.
+func (p *partition) resume(resumed *partition) {
+	p.mu.Lock()
+	p.resumedAs = resumed
+	parked := p.parked
+	p.parked = nil
+	p.mu.Unlock()
+
+	resumed.unpark(parked)
+}
+
+// endParked ends the parked subscriptions with the given status since the
+// partition won't be resumed, along with any parked later. Must be called
+// within the scope of the partition mutex.
+func (p *partition) endParked(st *status.Status) {
+	p.parkClosed = st
+	for _, ps := range p.parked {
+		p.srv.startGoroutine(func() { ps.end(st) })
+	}
+	p.parked = nil
+}
+
+// unpark re-attaches the parked subscriptions to the partition, which
+// replaced the partition they were parked on, at the offset they were due to
+// read next. Subscriptions which were closed while parked are dropped.
+func (p *partition) unpark(parked []*parkedSubscription) {
+	for _, ps := range parked {
+		select {
+		case <-ps.sub.closed:
+			continue
+		case <-ps.ctx.Done():
+			continue
+		default:
+		}
+		if st := p.reattach(ps); st != nil {
+			p.srv.startGoroutine(func() { ps.end(st) })
+		}
+	}
+}
+
+// reattach starts reading the partition for the parked subscription. If
+// retention removed the offset the subscription was due to read next, it
+// starts at the oldest offset instead and the gap is reported with the next
+// message sent.
+func (p *partition) reattach(ps *parkedSubscription) *status.Status {
+	sub := ps.sub
+	if sub.groupID != "" {
+		p.consumersMu.Lock()
+		defer p.consumersMu.Unlock()
+		if existing, ok := p.consumers[sub.groupID]; ok && existing.groupEpoch > sub.groupEpoch {
+			return status.New(codes.FailedPrecondition,
+				"Consumer is not currently assigned this partition")
+		}
+	}
+
+	if oldest := p.log.OldestOffset(); sub.next < oldest {
+		if ps.stopOffset != waitForNewMessages && ps.stopOffset < oldest {
+			return status.New(codes.ResourceExhausted, "Stop offset reached")
+		}
+		p.srv.logger.Warnf("Offsets %d to %d of partition %s were removed while paused, "+
+			"resuming subscription at %d", sub.next, oldest-1, p, oldest)
+		sub.gapStart = sub.next
+		sub.next = oldest
+	}
+
+	reader, err := p.log.NewReader(sub.next, false)
+	if err != nil {
+		return status.New(codes.Internal, fmt.Sprintf("Failed to create stream reader: %v", err))
+	}
+	if sub.groupID != "" {
+		p.consumers[sub.groupID] = &groupMember{
+			consumerID: sub.consumerID,
+			groupEpoch: sub.groupEpoch,
+			sub:        sub,
+		}
+	}
+	p.srv.startGoroutine(p.newSubscribeLoop(ps.ctx, sub, reader, ps.stopOffset, false))
+	return nil
+}
+
+// parkedStatus returns the status ending subscriptions parked on a partition
+// which is closed or deleted rather than resumed.
+func parkedStatus(deleted bool) *status.Status {
+	if deleted {
+		return status.New(codes.NotFound, commitlog.ErrCommitLogDeleted.Error())
+	}
+	return status.New(codes.FailedPrecondition, commitlog.ErrCommitLogClosed.Error())
+}
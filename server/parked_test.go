@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure a subscription keeps receiving messages on the same subscription
+// when the partition is paused and then resumed by a publish.
+func TestSubscribeResumedAfterPause(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	require.NoError(t, client.CreateStream(context.Background(), "foo", name))
+
+	var (
+		received = make(chan *lift.Message)
+		errored  = make(chan error, 1)
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err = client.Subscribe(ctx, name, func(msg *lift.Message, err error) {
+		if err != nil {
+			errored <- err
+			return
+		}
+		received <- msg
+	}, lift.StartAtEarliestReceived())
+	require.NoError(t, err)
+
+	publish := func(from, to int) {
+		for i := from; i < to; i++ {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			_, err := client.Publish(ctx, name, []byte(strconv.Itoa(i)))
+			cancel()
+			require.NoError(t, err)
+		}
+	}
+	expect := func(from, to int) {
+		for i := from; i < to; i++ {
+			select {
+			case msg := <-received:
+				require.Equal(t, int64(i), msg.Offset())
+				require.Equal(t, []byte(strconv.Itoa(i)), msg.Value())
+				require.NotContains(t, msg.Headers(), gapStartHeader)
+			case err := <-errored:
+				t.Fatalf("Subscription ended: %v", err)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("Did not receive message %d", i)
+			}
+		}
+	}
+
+	publish(0, 5)
+	expect(0, 5)
+
+	require.NoError(t, client.PauseStream(context.Background(), name))
+	checkPartitionPaused(t, 5*time.Second, name, 0, true, s1)
+
+	// Publishing resumes the partition.
+	publish(5, 10)
+	checkPartitionPaused(t, 5*time.Second, name, 0, false, s1)
+	expect(5, 10)
+}
+
+// Ensure subscriptions parked on a paused partition end with a NotFound error
+// if the partition is deleted rather than resumed.
+func TestSubscribePausedPartitionDeleted(t *testing.T) {
+	defer cleanupStorage(t)
+
+	server := New(getTestConfig("a", true, 0))
+	api := &apiServer{Server: server}
+	stream, err := server.metadata.AddStream(&proto.Stream{
+		Name:       "foo",
+		Subject:    "foo",
+		Partitions: []*proto.Partition{{Stream: "foo", Id: 0}},
+	}, true, 0)
+	require.NoError(t, err)
+
+	req := &client.SubscribeRequest{StartPosition: client.StartPosition_NEW_ONLY}
+	sub, st := api.subscribe(context.Background(), stream.GetPartition(0), req, nil, false)
+	require.Nil(t, st)
+	defer sub.Close()
+
+	_, err = stream.Pause(nil, true)
+	require.NoError(t, err)
+	require.NoError(t, stream.Delete())
+
+	select {
+	case st := <-sub.Errors():
+		require.Equal(t, codes.NotFound, st.Code())
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive expected status")
+	}
+}
+
+// Ensure a parked subscription is re-attached at the oldest offset and the gap
+// is reported with the next message if retention removed the offset it was due
+// to read next while the partition was paused.
+func TestReattachParkedSubscriptionRetentionGap(t *testing.T) {
+	defer cleanupStorage(t)
+
+	config := getTestConfig("a", true, 0)
+	config.Streams.SegmentMaxBytes = 1
+	config.Streams.RetentionMaxMessages = 2
+	server := New(config)
+	stream, err := server.metadata.AddStream(&proto.Stream{
+		Name:       "foo",
+		Subject:    "foo",
+		Partitions: []*proto.Partition{{Stream: "foo", Id: 0}},
+	}, true, 0)
+	require.NoError(t, err)
+	partition := stream.GetPartition(0)
+	defer partition.Close()
+
+	for i := 0; i < 10; i++ {
+		_, err := partition.log.Append([]*commitlog.Message{{
+			Value:     []byte(strconv.Itoa(i)),
+			Timestamp: time.Now().UnixNano(),
+		}})
+		require.NoError(t, err)
+	}
+	partition.log.SetHighWatermark(9)
+	require.NoError(t, partition.log.Clean())
+	require.Equal(t, int64(8), partition.log.OldestOffset())
+
+	sub := &subscription{
+		closed:     make(chan struct{}),
+		msgs:       make(chan *client.Message),
+		errors:     make(chan *status.Status),
+		lastOffset: -1,
+		next:       3,
+		gapStart:   -1,
+	}
+	defer sub.Close()
+	st := partition.reattach(&parkedSubscription{
+		ctx:        context.Background(),
+		sub:        sub,
+		stopOffset: waitForNewMessages,
+	})
+	require.Nil(t, st)
+
+	for _, expected := range []int64{8, 9} {
+		select {
+		case msg := <-sub.Messages():
+			require.Equal(t, expected, msg.Offset)
+			if expected == 8 {
+				require.Equal(t, []byte("3"), msg.Headers[gapStartHeader])
+			} else {
+				require.NotContains(t, msg.Headers, gapStartHeader)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Did not receive message %d", expected)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+)
+
+// This is a minimal Parquet writer for exporting messages. Each chunk is a
+// complete Parquet file with a single row group in which every column is
+// REQUIRED and stored as one uncompressed, PLAIN-encoded data page. The file
+// metadata is serialized with the Thrift compact protocol as described in
+// https://github.com/apache/parquet-format.
+
+var parquetMagic = []byte("PAR1")
+
+// Parquet physical types.
+const (
+	parquetInt32     int32 = 1
+	parquetInt64     int32 = 2
+	parquetByteArray int32 = 6
+)
+
+// Parquet converted types. noConvertedType indicates a column has none.
+const (
+	noConvertedType int32 = -1
+	parquetUTF8     int32 = 0
+	parquetJSON     int32 = 19
+)
+
+// Other Parquet enum values used by the writer.
+const (
+	parquetFormatVersion int32 = 1
+	parquetRequired      int32 = 0 // FieldRepetitionType
+	parquetPlain         int32 = 0 // Encoding
+	parquetRLE           int32 = 3 // Encoding
+	parquetDataPage      int32 = 0 // PageType
+	parquetUncompressed  int32 = 0 // CompressionCodec
+)
+
+// Thrift compact protocol types.
+const (
+	thriftI32    byte = 5
+	thriftI64    byte = 6
+	thriftBinary byte = 8
+	thriftList   byte = 9
+	thriftStruct byte = 12
+)
+
+// parquetColumn is a column of a Parquet file being written along with its
+// PLAIN-encoded values.
+type parquetColumn struct {
+	name          string
+	typ           int32
+	convertedType int32
+	values        bytes.Buffer
+}
+
+func (c *parquetColumn) appendInt32(v int32) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], uint32(v))
+	c.values.Write(buf[:])
+}
+
+func (c *parquetColumn) appendInt64(v int64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(v))
+	c.values.Write(buf[:])
+}
+
+func (c *parquetColumn) appendByteArray(v []byte) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], uint32(len(v)))
+	c.values.Write(buf[:])
+	c.values.Write(v)
+}
+
+// parquetEncoder is an exportEncoder which writes messages as Parquet files
+// with the columns stream, partition, offset, timestamp, key, value, and
+// headers. Keys and values are binary, so messages without a key have an
+// empty one, and headers are a JSON object mapping names to base64-encoded
+// values.
+type parquetEncoder struct {
+	stream    parquetColumn
+	partition parquetColumn
+	offset    parquetColumn
+	timestamp parquetColumn
+	key       parquetColumn
+	value     parquetColumn
+	headers   parquetColumn
+	rows      int64
+}
+
+func newParquetEncoder() exportEncoder {
+	return &parquetEncoder{
+		stream:    parquetColumn{name: "stream", typ: parquetByteArray, convertedType: parquetUTF8},
+		partition: parquetColumn{name: "partition", typ: parquetInt32, convertedType: noConvertedType},
+		offset:    parquetColumn{name: "offset", typ: parquetInt64, convertedType: noConvertedType},
+		timestamp: parquetColumn{name: "timestamp", typ: parquetInt64, convertedType: noConvertedType},
+		key:       parquetColumn{name: "key", typ: parquetByteArray, convertedType: noConvertedType},
+		value:     parquetColumn{name: "value", typ: parquetByteArray, convertedType: noConvertedType},
+		headers:   parquetColumn{name: "headers", typ: parquetByteArray, convertedType: parquetJSON},
+	}
+}
+
+func (e *parquetEncoder) columns() []*parquetColumn {
+	return []*parquetColumn{&e.stream, &e.partition, &e.offset, &e.timestamp, &e.key, &e.value, &e.headers}
+}
+
+// Encode adds the message as a row of the current file.
+func (e *parquetEncoder) Encode(msg *client.Message) error {
+	headers, err := json.Marshal(exportHeaders(msg.Headers))
+	if err != nil {
+		return err
+	}
+	e.stream.appendByteArray([]byte(msg.Stream))
+	e.partition.appendInt32(msg.Partition)
+	e.offset.appendInt64(msg.Offset)
+	e.timestamp.appendInt64(msg.Timestamp)
+	e.key.appendByteArray(msg.Key)
+	e.value.appendByteArray(msg.Value)
+	e.headers.appendByteArray(headers)
+	e.rows++
+	return nil
+}
+
+// Flush returns the rows encoded since the last flush as a Parquet file.
+func (e *parquetEncoder) Flush() ([]byte, error) {
+	var (
+		file    bytes.Buffer
+		columns = e.columns()
+		chunks  = make([]parquetColumnChunk, len(columns))
+		total   int64
+	)
+	file.Write(parquetMagic)
+	for i, column := range columns {
+		var page compactWriter
+		page.beginStruct()
+		page.i32Field(1, parquetDataPage)
+		page.i32Field(2, int32(column.values.Len()))
+		page.i32Field(3, int32(column.values.Len()))
+		page.structField(5)
+		page.i32Field(1, int32(e.rows))
+		page.i32Field(2, parquetPlain)
+		page.i32Field(3, parquetRLE)
+		page.i32Field(4, parquetRLE)
+		page.endStruct()
+		page.endStruct()
+
+		size := int64(len(page.buf) + column.values.Len())
+		chunks[i] = parquetColumnChunk{column: column, offset: int64(file.Len()), size: size}
+		total += size
+		file.Write(page.buf)
+		file.Write(column.values.Bytes())
+		column.values.Reset()
+	}
+
+	footer := e.fileMetadata(chunks, total)
+	file.Write(footer)
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(footer)))
+	file.Write(length[:])
+	file.Write(parquetMagic)
+	e.rows = 0
+	return file.Bytes(), nil
+}
+
+// parquetColumnChunk records where a column's data page was written.
+type parquetColumnChunk struct {
+	column *parquetColumn
+	offset int64
+	size   int64
+}
+
+// fileMetadata returns the serialized FileMetaData for a file containing a
+// single row group made up of the given column chunks.
+func (e *parquetEncoder) fileMetadata(chunks []parquetColumnChunk, totalSize int64) []byte {
+	var w compactWriter
+	w.beginStruct()
+	w.i32Field(1, parquetFormatVersion)
+
+	// The schema is flattened depth-first, starting with the root.
+	w.listField(2, thriftStruct, len(chunks)+1)
+	w.beginStruct()
+	w.binaryField(4, []byte("schema"))
+	w.i32Field(5, int32(len(chunks)))
+	w.endStruct()
+	for _, chunk := range chunks {
+		w.beginStruct()
+		w.i32Field(1, chunk.column.typ)
+		w.i32Field(3, parquetRequired)
+		w.binaryField(4, []byte(chunk.column.name))
+		if chunk.column.convertedType != noConvertedType {
+			w.i32Field(6, chunk.column.convertedType)
+		}
+		w.endStruct()
+	}
+
+	w.i64Field(3, e.rows)
+
+	w.listField(4, thriftStruct, 1)
+	w.beginStruct()
+	w.listField(1, thriftStruct, len(chunks))
+	for _, chunk := range chunks {
+		w.beginStruct()
+		w.i64Field(2, chunk.offset)
+		w.structField(3)
+		w.i32Field(1, chunk.column.typ)
+		w.listField(2, thriftI32, 1)
+		w.writeVarint(zigzag(int64(parquetPlain)))
+		w.listField(3, thriftBinary, 1)
+		w.writeBinary([]byte(chunk.column.name))
+		w.i32Field(4, parquetUncompressed)
+		w.i64Field(5, e.rows)
+		w.i64Field(6, chunk.size)
+		w.i64Field(7, chunk.size)
+		w.i64Field(9, chunk.offset)
+		w.endStruct()
+		w.endStruct()
+	}
+	w.i64Field(2, totalSize)
+	w.i64Field(3, e.rows)
+	w.endStruct()
+
+	w.binaryField(6, []byte("liftbridge"))
+	w.endStruct()
+	return w.buf
+}
+
+// compactWriter serializes Thrift structs using the compact protocol.
+type compactWriter struct {
+	buf    []byte
+	fields []int16 // Last field ID written in each open struct
+}
+
+func (w *compactWriter) beginStruct() {
+	w.fields = append(w.fields, 0)
+}
+
+func (w *compactWriter) endStruct() {
+	w.buf = append(w.buf, 0)
+	w.fields = w.fields[:len(w.fields)-1]
+}
+
+func (w *compactWriter) fieldHeader(id int16, typ byte) {
+	last := &w.fields[len(w.fields)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.writeVarint(zigzag(int64(id)))
+	}
+	*last = id
+}
+
+func (w *compactWriter) i32Field(id int16, v int32) {
+	w.fieldHeader(id, thriftI32)
+	w.writeVarint(zigzag(int64(v)))
+}
+
+func (w *compactWriter) i64Field(id int16, v int64) {
+	w.fieldHeader(id, thriftI64)
+	w.writeVarint(zigzag(v))
+}
+
+func (w *compactWriter) binaryField(id int16, v []byte) {
+	w.fieldHeader(id, thriftBinary)
+	w.writeBinary(v)
+}
+
+// structField writes the header of a struct field and begins the struct,
+// which must be ended with endStruct.
+func (w *compactWriter) structField(id int16) {
+	w.fieldHeader(id, thriftStruct)
+	w.beginStruct()
+}
+
+// listField writes the header of a list field. The list's elements must be
+// written after it.
+func (w *compactWriter) listField(id int16, elemType byte, size int) {
+	w.fieldHeader(id, thriftList)
+	if size < 15 {
+		w.buf = append(w.buf, byte(size)<<4|elemType)
+		return
+	}
+	w.buf = append(w.buf, 0xf0|elemType)
+	w.writeVarint(uint64(size))
+}
+
+func (w *compactWriter) writeBinary(v []byte) {
+	w.writeVarint(uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+func (w *compactWriter) writeVarint(v uint64) {
+	w.buf = binary.AppendUvarint(w.buf, v)
+}
+
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
@@ -3,10 +3,12 @@ package server
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
-	"path/filepath"
+	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/Workiva/go-datastructures/queue"
@@ -29,12 +31,30 @@ const recvChannelSize = 64 * 1024
 // for mocking purposes.
 var timestamp = func() int64 { return time.Now().UnixNano() }
 
+// errCompactionInProgress is returned when triggering compaction of a
+// partition which is already being compacted.
+var errCompactionInProgress = errors.New("compaction already in progress")
+
+// errTruncatedToLeaderStart is returned by handleReplicationResponse when the
+// follower's log was truncated to restart at the start of the leader's log.
+var errTruncatedToLeaderStart = errors.New("log truncated to the leader's log start offset")
+
 // subscription tracks state for a partition subscription.
 type subscription struct {
-	mu     sync.Mutex
-	closed chan struct{}
-	msgs   chan *client.Message
-	errors chan *status.Status
+	mu         sync.Mutex
+	closed     chan struct{}
+	msgs       chan *client.Message
+	errors     chan *status.Status
+	groupID    string
+	consumerID string
+	groupEpoch uint64
+	filter     *messageFilter // Only send messages matching this filter if set
+	lastOffset int64          // Offset of the last message sent or filtered, accessed atomically
+	delivered  int64          // Offset of the last message sent to a gRPC subscriber, accessed atomically
+	sent       int64          // Messages sent, accessed atomically
+	filtered   int64          // Messages skipped by the filter, accessed atomically
+	next       int64          // Offset to read next, used to resume the subscription after a pause
+	gapStart   int64          // First offset removed while the subscription was parked or -1
 }
 
 func (s *subscription) Close() {
@@ -60,6 +80,22 @@ func (s *subscription) Closed() <-chan struct{} {
 	return s.closed
 }
 
+// Stats returns the subscription's message counts.
+func (s *subscription) Stats() *proto.SubscriptionStats {
+	stats := &proto.SubscriptionStats{
+		GroupId:          s.groupID,
+		ConsumerId:       s.consumerID,
+		LastOffset:       atomic.LoadInt64(&s.lastOffset),
+		MessagesSent:     atomic.LoadInt64(&s.sent),
+		MessagesFiltered: atomic.LoadInt64(&s.filtered),
+	}
+	if s.filter != nil {
+		stats.HeaderFilter = s.filter.String()
+		stats.ValueFilter = s.filter.ValueString()
+	}
+	return stats
+}
+
 // replica tracks the latest log offset for a particular partition replica.
 type replica struct {
 	mu     sync.RWMutex
@@ -122,9 +158,9 @@ type groupMember struct {
 type partition struct {
 	mu                            sync.RWMutex
 	closeMu                       sync.Mutex
-	sub                           *nats.Subscription // Subscription to partition NATS subject
 	leaderReplSub                 *nats.Subscription // Subscription for replication requests from followers
 	leaderOffsetSub               *nats.Subscription // Subscription for leader epoch offset requests from followers
+	leaderSnapshotSub             *nats.Subscription // Subscription for snapshot chunk requests from followers
 	log                           commitlog.CommitLog
 	srv                           *Server
 	isLeading                     bool
@@ -139,20 +175,46 @@ type partition struct {
 	recovered                     bool
 	stopFollower                  chan struct{}
 	stopLeader                    chan struct{}
+	recvChan                      chan *nats.Msg // Feeds the leader's message processing loop
 	notify                        chan struct{}
 	belowMinISR                   bool
 	pause                         bool // Pause replication on the leader (for unit testing)
 	shutdown                      sync.WaitGroup
 	paused                        bool
+	parked                        []*parkedSubscription // Subscriptions waiting for the partition to be resumed
+	resumedAs                     *partition            // Partition replacing this one once it's resumed
+	parkClosed                    *status.Status        // Ends subscriptions parked once the partition is closed
 	autoPauseTime                 time.Duration
 	autoPauseDisableIfSubscribers bool
-	subscriberCount               int64
-	messagesReceivedTimestamps    EventTimestamps // First and latest time a message was received on this partition
-	pauseTimestamps               EventTimestamps // First and latest time this partition was paused or resumed
-	readonlyTimestamps            EventTimestamps // First and latest time this partition had its read-only status changed
+	maxMessageBytes               int64 // Largest message accepted, 0 for no limit
+	subscriptions                 map[*subscription]struct{}
+	watchersMu                    sync.Mutex
+	watchers                      map[*partitionWatcher]struct{} // Watchers of the partition while this server leads it
+	messagesReceivedTimestamps    EventTimestamps                // First and latest time a message was received on this partition
+	pauseTimestamps               EventTimestamps                // First and latest time this partition was paused or resumed
+	readonlyTimestamps            EventTimestamps                // First and latest time this partition had its read-only status changed
+	leaderTimestamps              EventTimestamps                // First and latest time this partition's leader changed
 	encryptionHandler             encryption.Codec
+	pausedLog                     commitlog.CommitLog // Opened readonly while the partition is paused and being read
+	pausedReaders                 int                 // Subscriptions reading pausedLog
+	replaced                      bool                // Set once the partition is resumed, which replaces it
 	consumersMu                   sync.Mutex
 	consumers                     map[string]*groupMember // Maps consumer groups to consumers
+	allocations                   *partitionAllocations   // Sampled allocation accounting
+	metrics                       *partitionMetrics
+	mirrorSource                  *proto.MirrorSource  // Set if the partition mirrors a partition in another cluster
+	restoreSource                 *proto.RestoreSource // Set if the partition was restored from a backup
+	restoring                     int32                // Set while the leader restores the partition from a backup, accessed atomically
+	timestampType                 string
+	timestampMaxDifference        time.Duration // Max difference between create and log append times, zero if unlimited
+	keys                          *keySketch
+	correlations                  *correlationIndex
+	duplicatesDropped             int64 // Messages received more than once from NATS, accessed atomically
+	expiredMessages               int64 // Expired messages skipped by subscriptions, accessed atomically
+	compacting                    int32 // Set while a triggered compaction runs, accessed atomically
+	mirrorLag                     int64 // Messages the mirror has yet to copy from the source partition, -1 if unknown, accessed atomically
+	leaseRenewed                  int64 // Unix nanoseconds the leader lease was last renewed, accessed atomically
+	leaseExpired                  bool  // Set once the leader stepped down because its lease expired
 	*proto.Partition
 }
 
@@ -164,45 +226,31 @@ type partition struct {
 // A partitioned stream maps to separate NATS subjects: subject, subject.1,
 // subject.2, etc.
 func (s *Server) newPartition(protoPartition *proto.Partition, recovered bool, config *proto.StreamConfig) (*partition, error) {
-	streamsConfig := &StreamsConfig{
-		SegmentMaxBytes:               s.config.Streams.SegmentMaxBytes,
-		SegmentMaxAge:                 s.config.Streams.SegmentMaxAge,
-		RetentionMaxBytes:             s.config.Streams.RetentionMaxBytes,
-		RetentionMaxMessages:          s.config.Streams.RetentionMaxMessages,
-		RetentionMaxAge:               s.config.Streams.RetentionMaxAge,
-		CleanerInterval:               s.config.Streams.CleanerInterval,
-		Compact:                       s.config.Streams.Compact,
-		CompactMaxGoroutines:          s.config.Streams.CompactMaxGoroutines,
-		AutoPauseTime:                 s.config.Streams.AutoPauseTime,
-		AutoPauseDisableIfSubscribers: s.config.Streams.AutoPauseDisableIfSubscribers,
-		MinISR:                        s.config.Clustering.MinISR,
-		Encryption:                    s.config.Streams.Encryption,
-	}
-	streamsConfig.ApplyOverrides(config)
-	var (
-		file = filepath.Join(s.config.DataDir, "streams", protoPartition.Stream,
-			strconv.FormatInt(int64(protoPartition.Id), 10))
-		name = fmt.Sprintf("[subject=%s, stream=%s, partition=%d]",
-			protoPartition.Subject, protoPartition.Stream, protoPartition.Id)
+	return s.newPartitionWithLog(protoPartition, recovered, config, nil)
+}
 
-		log, err = commitlog.New(commitlog.Options{
-			Name:                 name,
-			Path:                 file,
-			MaxSegmentBytes:      streamsConfig.SegmentMaxBytes,
-			MaxSegmentAge:        streamsConfig.SegmentMaxAge,
-			MaxLogBytes:          streamsConfig.RetentionMaxBytes,
-			MaxLogMessages:       streamsConfig.RetentionMaxMessages,
-			MaxLogAge:            streamsConfig.RetentionMaxAge,
-			CleanerInterval:      streamsConfig.CleanerInterval,
-			Compact:              streamsConfig.Compact,
-			CompactMaxGoroutines: streamsConfig.CompactMaxGoroutines,
-			Logger:               s.logger,
-			ConcurrencyControl:   streamsConfig.ConcurrencyControl,
-		})
-	)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create commit log")
+// newPartitionWithLog creates a new stream partition like newPartition. If a
+// commit log is given, it is upgraded and used instead of opening the
+// partition's commit log again.
+func (s *Server) newPartitionWithLog(protoPartition *proto.Partition, recovered bool,
+	config *proto.StreamConfig, log commitlog.CommitLog) (*partition, error) {
+
+	streamsConfig := s.getStreamsConfig(config)
+	if log == nil {
+		var err error
+		log, err = s.openPartitionLog(protoPartition, streamsConfig, false)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := log.Upgrade(); err != nil {
+		return nil, errors.Wrap(err, "failed to upgrade commit log")
 	}
+	// Restore the readonly state, including a pending target, when the
+	// partition is recovered from a snapshot or resumed.
+	if protoPartition.Readonly {
+		setLogReadonly(log, true, protoPartition.ReadonlyTarget)
+	}
+	allocations := s.allocations.forPartition(protoPartition.Stream, protoPartition.Id)
 
 	replicas := make(map[string]struct{}, len(protoPartition.Replicas))
 	for _, replica := range protoPartition.Replicas {
@@ -231,7 +279,21 @@ func (s *Server) newPartition(protoPartition *proto.Partition, recovered bool, c
 		recovered:                     recovered,
 		autoPauseTime:                 streamsConfig.AutoPauseTime,
 		autoPauseDisableIfSubscribers: streamsConfig.AutoPauseDisableIfSubscribers,
+		maxMessageBytes:               streamsConfig.MaxMessageBytes,
 		consumers:                     make(map[string]*groupMember),
+		subscriptions:                 make(map[*subscription]struct{}),
+		watchers:                      make(map[*partitionWatcher]struct{}),
+		allocations:                   allocations,
+		metrics:                       newPartitionMetrics(),
+		mirrorSource:                  config.GetMirrorSource(),
+		mirrorLag:                     -1,
+		restoreSource:                 config.GetRestoreSource(),
+		timestampType:                 streamsConfig.MessageTimestampType,
+		timestampMaxDifference:        streamsConfig.MessageTimestampMaxDifference,
+	}
+
+	if s.config.Skew.ReportInterval > 0 {
+		st.keys = newKeySketch(keySketchCapacity, keySketchSampleRate)
 	}
 
 	if streamsConfig.Encryption {
@@ -249,15 +311,89 @@ func (s *Server) newPartition(protoPartition *proto.Partition, recovered bool, c
 	return st, nil
 }
 
+// getStreamsConfig returns the server's streams configuration with the given
+// stream's overrides applied.
+func (s *Server) getStreamsConfig(config *proto.StreamConfig) *StreamsConfig {
+	streamsConfig := &StreamsConfig{
+		SegmentMaxBytes:               s.config.Streams.SegmentMaxBytes,
+		SegmentMaxAge:                 s.config.Streams.SegmentMaxAge,
+		RetentionMaxBytes:             s.config.Streams.RetentionMaxBytes,
+		RetentionMaxMessages:          s.config.Streams.RetentionMaxMessages,
+		RetentionMaxAge:               s.config.Streams.RetentionMaxAge,
+		CleanerInterval:               s.config.Streams.CleanerInterval,
+		Compact:                       s.config.Streams.Compact,
+		CompactMaxGoroutines:          s.config.Streams.CompactMaxGoroutines,
+		CompactDeleteRetention:        s.config.Streams.CompactDeleteRetention,
+		AutoPauseTime:                 s.config.Streams.AutoPauseTime,
+		AutoPauseDisableIfSubscribers: s.config.Streams.AutoPauseDisableIfSubscribers,
+		AutoResumeOnPublish:           s.config.Streams.AutoResumeOnPublish,
+		MaxMessageBytes:               s.config.Streams.MaxMessageBytes,
+		MinISR:                        s.config.Clustering.MinISR,
+		Encryption:                    s.config.Streams.Encryption,
+		SegmentEncryption:             s.config.Streams.SegmentEncryption,
+		SegmentEncryptionKey:          s.config.Streams.SegmentEncryptionKey,
+		MessageTimestampType:          s.config.Streams.MessageTimestampType,
+		MessageTimestampMaxDifference: s.config.Streams.MessageTimestampMaxDifference,
+	}
+	streamsConfig.ApplyOverrides(config)
+	return streamsConfig
+}
+
+// openPartitionLog initializes or recovers the commit log backing the given
+// partition. If readOnly is set, the log is opened in readonly mode without
+// cleaning it or checkpointing its HW.
+func (s *Server) openPartitionLog(protoPartition *proto.Partition, streamsConfig *StreamsConfig,
+	readOnly bool) (commitlog.CommitLog, error) {
+
+	var (
+		file = s.partitionDir(protoPartition.Stream, protoPartition.Id)
+		name = fmt.Sprintf("[subject=%s, stream=%s, partition=%d]",
+			protoPartition.Subject, protoPartition.Stream, protoPartition.Id)
+		allocations = s.allocations.forPartition(protoPartition.Stream, protoPartition.Id)
+
+		log, err = commitlog.New(commitlog.Options{
+			Name:                    name,
+			Path:                    file,
+			MaxSegmentBytes:         streamsConfig.SegmentMaxBytes,
+			MaxSegmentAge:           streamsConfig.SegmentMaxAge,
+			MaxSegmentAgeJitter:     s.config.Streams.SegmentMaxAgeJitter,
+			MaxLogBytes:             streamsConfig.RetentionMaxBytes,
+			MaxLogMessages:          streamsConfig.RetentionMaxMessages,
+			MaxLogAge:               streamsConfig.RetentionMaxAge,
+			CleanerInterval:         streamsConfig.CleanerInterval,
+			Compact:                 streamsConfig.Compact,
+			CompactMaxGoroutines:    streamsConfig.CompactMaxGoroutines,
+			TombstoneTTL:            streamsConfig.CompactDeleteRetention,
+			CompactBloomFilter:      s.config.Streams.CompactBloomFilter,
+			Logger:                  s.logger,
+			ConcurrencyControl:      streamsConfig.ConcurrencyControl,
+			AllocationRecorder:      allocations,
+			Encrypt:                 streamsConfig.SegmentEncryption,
+			EncryptionKey:           streamsConfig.SegmentEncryptionKey,
+			ReadOnly:                readOnly,
+			PreallocateSegmentBytes: s.config.Streams.SegmentPreallocateBytes,
+		})
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create commit log")
+	}
+	return log, nil
+}
+
 // replacePartition creates a new stream partition to replace another one. The
 // old partition's events timestamps are kept.
 func (s *Server) replacePartition(oldPartition *partition, recovered bool, config *proto.StreamConfig) (*partition, error) {
-	st, err := s.newPartition(oldPartition.Partition, recovered, config)
+	// If the old partition's log is open for paused reads, take it over rather
+	// than opening the log a second time.
+	st, err := s.newPartitionWithLog(oldPartition.Partition, recovered, config,
+		oldPartition.takePausedLog())
 
 	if err == nil {
 		st.messagesReceivedTimestamps = oldPartition.MessagesReceivedTimestamps()
 		st.pauseTimestamps = oldPartition.PauseTimestamps()
 		st.readonlyTimestamps = oldPartition.ReadonlyTimestamps()
+		st.leaderTimestamps = oldPartition.LeaderTimestamps()
+		oldPartition.resume(st)
 	}
 
 	return st, err
@@ -282,10 +418,18 @@ func (p *partition) close() error {
 		return err
 	}
 
+	wasLeading := p.isLeading
 	if err := p.stopLeadingOrFollowing(); err != nil {
 		return err
 	}
 
+	// Persist the correlation index so the next leader on this server doesn't
+	// have to rebuild it from the log.
+	if wasLeading {
+		p.checkpointCorrelationIndex()
+	}
+
+	p.endWatchers()
 	p.isClosed = true
 	return nil
 }
@@ -295,6 +439,10 @@ func (p *partition) Close() error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
+	p.endParked(parkedStatus(false))
+	if err := p.closePausedLog(false); err != nil {
+		return err
+	}
 	return p.close()
 }
 
@@ -307,10 +455,45 @@ func (p *partition) Pause() error {
 	p.paused = true
 	p.Paused = true // Also set the protobuf value (used for snapshotting)
 	p.pauseTimestamps.update()
+	p.notifyWatchers(proto.PartitionEventType_PAUSED_CHANGED)
 
 	return p.close()
 }
 
+// DeleteBefore deletes the messages of the partition's log before the given
+// offset by advancing its log start offset. Subscribers reading messages before
+// it receive an OutOfRange status. This is a no-op if the partition is paused
+// since its log is closed.
+func (p *partition) DeleteBefore(offset int64) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.paused {
+		p.srv.logger.Warnf("Not deleting messages before offset %d of paused partition %s", offset, p)
+		return nil
+	}
+	return p.log.DeleteBefore(offset)
+}
+
+// MergeSegments coalesces runs of consecutive segments of the partition's log
+// whose combined size is below targetBytes and returns the number of segments
+// before and after. This is a no-op if the partition is paused since its log
+// is closed.
+func (p *partition) MergeSegments(targetBytes int64) (int, int, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	before := p.log.SegmentCount()
+	if p.paused {
+		p.srv.logger.Warnf("Not merging segments of paused partition %s", p)
+		return before, before, nil
+	}
+	if err := p.log.MergeSmallSegments(targetBytes); err != nil {
+		return before, p.log.SegmentCount(), err
+	}
+	return before, p.log.SegmentCount(), nil
+}
+
 // IsPaused indicates if the partition is currently paused.
 func (p *partition) IsPaused() bool {
 	p.mu.RLock()
@@ -318,24 +501,66 @@ func (p *partition) IsPaused() bool {
 	return p.paused
 }
 
+// TriggerCompaction cleans the partition's log in the background rather than
+// waiting for the cleaner interval, which compacts it if compaction is enabled
+// for the stream. Progress is called each time compaction finishes rewriting a
+// segment, and the returned channel receives the result of the clean. Only one
+// triggered compaction runs at a time, so this returns
+// errCompactionInProgress if one is already running.
+func (p *partition) TriggerCompaction(progress func(commitlog.CompactionProgress)) (<-chan error, error) {
+	if !atomic.CompareAndSwapInt32(&p.compacting, 0, 1) {
+		return nil, errCompactionInProgress
+	}
+	done := make(chan error, 1)
+	go func() {
+		err := p.log.CleanWithProgress(progress)
+		atomic.StoreInt32(&p.compacting, 0)
+		done <- err
+	}()
+	return done, nil
+}
+
 // SetReadonly enables or disables readonly for the partition. When enabled,
 // new messages cannot be written to the log and consumers will not block once
-// they reach the end of the log. This does not affect replication.
-func (p *partition) SetReadonly(readonly bool) {
-	p.log.SetReadonly(readonly)
+// they reach the end of the log. This does not affect replication. If a target
+// offset is given when enabling readonly, the partition keeps accepting new
+// messages until its newest offset reaches the target.
+func (p *partition) SetReadonly(readonly bool, target *proto.NullableInt64) {
+	if !readonly {
+		target = nil
+	}
+	setLogReadonly(p.log, readonly, target)
 
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	p.Readonly = readonly // Also set the protobuf value (used for snapshotting)
+	// Also set the protobuf values (used for snapshotting).
+	p.Readonly = readonly
+	p.Partition.ReadonlyTarget = target
 	p.readonlyTimestamps.update()
 }
 
+// setLogReadonly sets the readonly flag on the given commit log or, if a
+// target offset is given, marks it readonly once the target is reached.
+func setLogReadonly(log commitlog.CommitLog, readonly bool, target *proto.NullableInt64) {
+	if readonly && target != nil {
+		log.SetReadonlyAt(target.Value)
+	} else {
+		log.SetReadonly(readonly)
+	}
+}
+
 // IsReadonly indicates if the partition is currently readonly.
 func (p *partition) IsReadonly() bool {
 	return p.log.IsReadonly()
 }
 
+// ReadonlyTarget returns the offset the partition becomes readonly at once
+// reached. The bool returned indicates if there is a pending target.
+func (p *partition) ReadonlyTarget() (int64, bool) {
+	return p.log.ReadonlyTarget()
+}
+
 // GetGroupConsumer returns the consumer for the given group or nil if no
 // consumer is subscribed.
 func (p *partition) GetGroupConsumer(groupID string) *groupMember {
@@ -351,9 +576,15 @@ func (p *partition) Delete() error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
+	p.endParked(parkedStatus(true))
+	if err := p.closePausedLog(true); err != nil {
+		return err
+	}
 	if err := p.log.Delete(); err != nil {
 		return err
 	}
+	p.srv.allocations.remove(p.Stream, p.Id)
+	p.endWatchers()
 
 	return p.stopLeadingOrFollowing()
 }
@@ -363,8 +594,9 @@ func (p *partition) Delete() error {
 // channel is closed, the context is canceled, or an error is returned
 // asynchronously on the status channel. If the subscriber is part of a
 // consumer group, this will ensure only one member of the group is subscribed
-// to the partition at a time.
-func (p *partition) Subscribe(ctx context.Context, req *client.SubscribeRequest) (
+// to the partition at a time. If a filter is provided, only messages matching
+// it are sent.
+func (p *partition) Subscribe(ctx context.Context, req *client.SubscribeRequest, filter *messageFilter) (
 	*subscription, *status.Status) {
 
 	var (
@@ -399,12 +631,12 @@ func (p *partition) Subscribe(ctx context.Context, req *client.SubscribeRequest)
 		}
 	}
 
-	startOffset, st := p.getStartOffset(req)
+	startOffset, st := p.getStartOffset(p.log, req)
 	if st != nil {
 		return nil, st
 	}
 
-	stopOffset, st := p.getStopOffset(req)
+	stopOffset, st := p.getStopOffset(p.log, req)
 	if st != nil {
 		return nil, st
 	}
@@ -434,20 +666,28 @@ func (p *partition) Subscribe(ctx context.Context, req *client.SubscribeRequest)
 	} else {
 		reader, err = p.log.NewReader(startOffset, false)
 	}
+	if errors.Is(err, commitlog.ErrOffsetOutOfRange) {
+		return nil, status.New(codes.OutOfRange, err.Error())
+	}
 	if err != nil {
 		return nil, status.New(
 			codes.Internal, fmt.Sprintf("Failed to create stream reader: %v", err))
 	}
 
-	cancel := make(chan struct{})
-	p.srv.startGoroutine(p.newSubscribeLoop(ctx, groupID, consumerID, reader,
-		stopOffset, ch, errCh, cancel, req.Reverse))
-
 	sub := &subscription{
-		closed: cancel,
-		msgs:   ch,
-		errors: errCh,
-	}
+		closed:     make(chan struct{}),
+		msgs:       ch,
+		errors:     errCh,
+		groupID:    groupID,
+		consumerID: consumerID,
+		groupEpoch: groupEpoch,
+		filter:     filter,
+		lastOffset: -1,
+		delivered:  -1,
+		next:       startOffset,
+		gapStart:   -1,
+	}
+	p.srv.startGoroutine(p.newSubscribeLoop(ctx, sub, reader, stopOffset, req.Reverse))
 
 	if groupID != "" {
 		p.consumers[groupID] = &groupMember{
@@ -462,16 +702,50 @@ func (p *partition) Subscribe(ctx context.Context, req *client.SubscribeRequest)
 
 // newSubscribeLoop returns a function to be called in a goroutine which starts
 // the subscription loop.
-func (p *partition) newSubscribeLoop(ctx context.Context, groupID, consumerID string,
-	reader commitlog.MessageReader, stopOffset int64, ch chan<- *client.Message, errCh chan<- *status.Status,
-	cancel <-chan struct{}, reverse bool) func() {
+func (p *partition) newSubscribeLoop(ctx context.Context, sub *subscription,
+	reader commitlog.MessageReader, stopOffset int64, reverse bool) func() {
+
+	var (
+		ch         = sub.msgs
+		errCh      = sub.errors
+		cancel     = sub.closed
+		tailOffset = p.log.HighWatermark() + 1 // Messages committed after subscribing
+	)
 
 	return func() {
-		// Update the active subscriber count.
-		p.increaseSubscriberCount()
-		defer p.decreaseSubscriberCount()
-		if groupID != "" {
-			defer p.removeGroupSubscriber(groupID, consumerID)
+		// Track the active subscription.
+		p.addSubscription(sub)
+		defer p.removeSubscription(sub)
+		if sub.groupID != "" {
+			defer p.removeGroupSubscriber(sub.groupID, sub.consumerID)
+		}
+
+		// skip records a message which didn't match the filter or expired by
+		// incrementing the given counter. Skipped messages still count as
+		// processed so the stop offset is honored. Since the reader only
+		// blocks once it reaches the end of the log, this also checks for
+		// cancellation in case a long run of messages is being skipped. It
+		// returns true if the subscription should end.
+		skip := func(offset int64, counter *int64) bool {
+			atomic.AddInt64(counter, 1)
+			atomic.StoreInt64(&sub.lastOffset, offset)
+			sub.next = offset + 1
+			if offset == stopOffset {
+				s := status.New(codes.ResourceExhausted, "Stop offset reached")
+				select {
+				case errCh <- s:
+				case <-cancel:
+				}
+				return true
+			}
+			select {
+			case <-cancel:
+				return true
+			case <-ctx.Done():
+				return true
+			default:
+			}
+			return false
 		}
 
 		headersBuf := make([]byte, 28)
@@ -486,14 +760,25 @@ func (p *partition) newSubscribeLoop(ctx context.Context, groupID, consumerID st
 					s = status.New(codes.NotFound, err.Error())
 				} else if err == commitlog.ErrCommitLogClosed {
 					// Partition was closed while subscribed (likely paused).
+					// Subscriptions to a paused partition are parked until
+					// it's resumed, except reverse ones which can't resume.
 					code := codes.Internal
 					if p.IsPaused() {
+						if !reverse {
+							p.park(ctx, sub, stopOffset)
+							return
+						}
 						code = codes.FailedPrecondition
 					}
 					s = status.New(code, err.Error())
 				} else if err == commitlog.ErrCommitLogReadonly {
 					// Partition was set to readonly while subscribed.
 					s = status.New(codes.ResourceExhausted, "End of readonly partition")
+				} else if errors.Is(err, commitlog.ErrOffsetOutOfRange) {
+					// Messages were deleted from the start of the partition
+					// while subscribed. The subscriber can resume at the log
+					// start offset.
+					s = status.New(codes.OutOfRange, err.Error())
 				} else {
 					s = status.Convert(err)
 				}
@@ -504,10 +789,30 @@ func (p *partition) newSubscribeLoop(ctx context.Context, groupID, consumerID st
 				}
 				return
 			}
-			msgValue := m.Value()
-
+			p.metrics.markSubscriptionRead(offset, tailOffset, len(m))
 			headers := m.Headers()
 
+			// Expired messages are left in the log for retention to remove
+			// but never delivered.
+			if commitlog.Expired(headers, timestamp, time.Now().UnixNano()) {
+				if skip(offset, &p.expiredMessages) {
+					return
+				}
+				continue
+			}
+
+			// Check headers before decrypting the value so messages which
+			// don't match aren't decrypted needlessly.
+			if sub.filter != nil && !sub.filter.MatchesHeaders(headers) {
+				if skip(offset, &sub.filtered) {
+					return
+				}
+				continue
+			}
+
+			msgValue := m.Value()
+			allocated := len(m)
+
 			// Data decryption
 			if p.encryptionHandler != nil {
 				// Decryption of data on server side
@@ -523,6 +828,24 @@ func (p *partition) newSubscribeLoop(ctx context.Context, groupID, consumerID st
 				}
 
 				msgValue = decryptedMsg
+				allocated += len(decryptedMsg)
+			}
+
+			if sub.filter != nil && !sub.filter.MatchesValue(msgValue) {
+				if skip(offset, &sub.filtered) {
+					return
+				}
+				continue
+			}
+
+			p.allocations.RecordAllocation(allocSiteSubscribe, allocated)
+			p.metrics.bytesOut.mark(int64(len(m)))
+
+			// Let the subscriber know if messages were removed by retention
+			// while the subscription was parked.
+			if sub.gapStart >= 0 {
+				headers[gapStartHeader] = []byte(strconv.FormatInt(sub.gapStart, 10))
+				sub.gapStart = -1
 			}
 
 			var (
@@ -543,6 +866,9 @@ func (p *partition) newSubscribeLoop(ctx context.Context, groupID, consumerID st
 			case <-cancel:
 				return
 			}
+			atomic.AddInt64(&sub.sent, 1)
+			atomic.StoreInt64(&sub.lastOffset, offset)
+			sub.next = offset + 1
 			if offset == stopOffset {
 				s := status.New(codes.ResourceExhausted, "Stop offset reached")
 
@@ -568,24 +894,28 @@ func (p *partition) removeGroupSubscriber(groupID, consumerID string) {
 	}
 }
 
-func (p *partition) getStartOffset(req *client.SubscribeRequest) (int64, *status.Status) {
+func (p *partition) getStartOffset(log commitlog.CommitLog, req *client.SubscribeRequest) (int64, *status.Status) {
 	var startOffset int64
 	switch req.StartPosition {
 	case client.StartPosition_OFFSET:
 		startOffset = req.StartOffset
 	case client.StartPosition_TIMESTAMP:
-		offset, err := p.log.EarliestOffsetAfterTimestamp(req.StartTimestamp)
+		offset, err := log.EarliestOffsetAfterTimestamp(req.StartTimestamp)
 		if err != nil {
 			return startOffset, status.New(
 				codes.Internal, fmt.Sprintf("Failed to lookup offset for timestamp: %v", err))
 		}
 		startOffset = offset
+		// Messages before the log start offset were deleted.
+		if logStart := log.LogStartOffset(); startOffset < logStart {
+			startOffset = logStart
+		}
 	case client.StartPosition_EARLIEST:
-		startOffset = p.log.OldestOffset()
+		startOffset = log.OldestOffset()
 	case client.StartPosition_LATEST:
-		startOffset = p.log.NewestOffset()
+		startOffset = log.NewestOffset()
 	case client.StartPosition_NEW_ONLY:
-		startOffset = p.log.NewestOffset() + 1
+		startOffset = log.NewestOffset() + 1
 	default:
 		return startOffset, status.New(
 			codes.InvalidArgument,
@@ -600,25 +930,25 @@ func (p *partition) getStartOffset(req *client.SubscribeRequest) (int64, *status
 	return startOffset, nil
 }
 
-func (p *partition) getStopOffset(req *client.SubscribeRequest) (int64, *status.Status) {
+func (p *partition) getStopOffset(log commitlog.CommitLog, req *client.SubscribeRequest) (int64, *status.Status) {
 	var stopOffset int64
 	switch req.StopPosition {
 	case client.StopPosition_STOP_ON_CANCEL:
 		stopOffset = waitForNewMessages
-		if p.log.IsReadonly() {
-			stopOffset = p.log.NewestOffset()
+		if log.IsReadonly() {
+			stopOffset = log.NewestOffset()
 		}
 	case client.StopPosition_STOP_OFFSET:
 		stopOffset = req.StopOffset
 	case client.StopPosition_STOP_TIMESTAMP:
 		var err error
-		stopOffset, err = p.log.LatestOffsetBeforeTimestamp(req.StopTimestamp)
+		stopOffset, err = log.LatestOffsetBeforeTimestamp(req.StopTimestamp)
 		if err != nil {
 			return stopOffset, status.New(
 				codes.Internal, fmt.Sprintf("Failed to lookup offset for timestamp: %v", err))
 		}
 	case client.StopPosition_STOP_LATEST:
-		stopOffset = p.log.NewestOffset()
+		stopOffset = log.NewestOffset()
 		if stopOffset == -1 {
 			return stopOffset, status.New(codes.ResourceExhausted, "Stream is empty")
 		}
@@ -631,29 +961,24 @@ func (p *partition) getStopOffset(req *client.SubscribeRequest) (int64, *status.
 	return stopOffset, nil
 }
 
-// increaseSubscriberCount increases the number of subscribers. Partitions with
-// a subscriber count greater than zero will not be auto-paused if the
-// partition is idle, and the corresponding configuration option is set.
-func (p *partition) increaseSubscriberCount() {
+// addSubscription adds the subscription to the partition's active
+// subscriptions. Partitions with active subscriptions will not be auto-paused
+// if the partition is idle, and the corresponding configuration option is
+// set.
+func (p *partition) addSubscription(sub *subscription) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	p.subscriberCount++
+	p.subscriptions[sub] = struct{}{}
 }
 
-// decreaseSubscriberCount decreases the number of subscribers. Partitions with
-// a subscriber count greater than zero will not be auto-paused if the
-// partition is idle, and the corresponding configuration option is set.
-func (p *partition) decreaseSubscriberCount() {
+// removeSubscription removes the subscription from the partition's active
+// subscriptions.
+func (p *partition) removeSubscription(sub *subscription) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	p.subscriberCount--
-	if p.subscriberCount < 0 {
-		p.subscriberCount = 0
-		p.srv.logger.Errorf("Negative partition subscriber count for partition %s: %d",
-			p, p.subscriberCount)
-	}
+	delete(p.subscriptions, sub)
 }
 
 // MessagesReceivedTimestamps returns the first and latest times a message was
@@ -683,6 +1008,15 @@ func (p *partition) ReadonlyTimestamps() EventTimestamps {
 	return p.readonlyTimestamps
 }
 
+// LeaderTimestamps returns the first and latest time this partition's leader
+// changed. The leader the partition was first assigned counts as a change.
+func (p *partition) LeaderTimestamps() EventTimestamps {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.leaderTimestamps
+}
+
 // Notify is used to short circuit the sleep backoff a partition uses when it
 // has replicated to the end of the leader's log (i.e. the log end offset).
 // When a follower reaches the end of the log, it starts to sleep in between
@@ -715,6 +1049,14 @@ func (p *partition) SetLeader(leader string, epoch uint64) error {
 		return fmt.Errorf("proposed leader epoch %d is less than current epoch %d",
 			epoch, p.LeaderEpoch)
 	}
+	if leader != p.Leader || epoch != p.LeaderEpoch || p.leaderTimestamps.latestTime.IsZero() {
+		p.leaderTimestamps.update()
+	}
+	if leader != p.srv.config.Clustering.ServerID {
+		p.endWatchers()
+	} else if epoch != p.LeaderEpoch {
+		p.notifyWatchers(proto.PartitionEventType_LEADER_EPOCH_CHANGED)
+	}
 	p.Leader = leader
 	p.LeaderEpoch = epoch
 
@@ -829,31 +1171,32 @@ func (p *partition) becomeLeader(epoch uint64) error {
 	}
 	rep.updateLatestOffset(p.log.NewestOffset())
 
-	// Start message processing loop.
-	recvChan := make(chan *nats.Msg, recvChannelSize)
+	// Rebuild the idempotent producer state from the log since the previous
+	// leader may have written messages this server hasn't seen as leader.
+	producers, err := loadProducerState(p.producerStateFile(), p.log)
+	if err != nil {
+		return errors.Wrap(err, "failed to load producer state")
+	}
+	p.loadCorrelationIndex()
+
 	p.stopLeader = make(chan struct{})
-	p.srv.startGoroutineWithArgsWG(func(args ...interface{}) {
-		stop := args[0].(chan struct{})
-		p.messageProcessingLoop(recvChan, stop, epoch)
-	}, p.shutdown, p.stopLeader)
 
 	// Start replicating to followers.
 	p.startReplicating(epoch, p.stopLeader)
 
-	// Subscribe to the NATS subject and begin sequencing messages.
-	// TODO: This should be drained on shutdown.
-	sub, err := p.srv.nc.QueueSubscribe(p.getSubject(), p.Group, func(m *nats.Msg) {
-		recvChan <- m
-	})
-	if err != nil {
-		return errors.Wrap(err, "failed to subscribe to NATS")
+	if p.restoreTarget() > p.log.NewestOffset() {
+		// Copy the backed up messages into the log before consuming any
+		// new ones.
+		atomic.StoreInt32(&p.restoring, 1)
+		p.srv.startGoroutineWithArgsWG(func(args ...interface{}) {
+			p.restoreLoop(args[0].(chan struct{}), epoch, producers)
+		}, &p.shutdown, p.stopLeader)
+	} else if err := p.startConsuming(epoch, producers); err != nil {
+		return err
 	}
-	sub.SetPendingLimits(-1, -1)
-	p.sub = sub
-	p.srv.nc.Flush()
 
 	// Subscribe to the partition replication subject.
-	sub, err = p.srv.ncRepl.Subscribe(p.getReplicationRequestInbox(), p.handleReplicationRequest)
+	sub, err := p.srv.ncRepl.Subscribe(p.getReplicationRequestInbox(), p.handleReplicationRequest)
 	if err != nil {
 		return errors.Wrap(err, "failed to subscribe to replication inbox")
 	}
@@ -867,6 +1210,14 @@ func (p *partition) becomeLeader(epoch uint64) error {
 	}
 	sub.SetPendingLimits(-1, -1)
 	p.leaderOffsetSub = sub
+
+	// Also subscribe to snapshot chunk requests subject.
+	sub, err = p.srv.ncRepl.Subscribe(p.getSnapshotChunkInbox(), p.handleSnapshotChunkRequest)
+	if err != nil {
+		return errors.Wrap(err, "failed to subscribe to snapshot inbox")
+	}
+	sub.SetPendingLimits(-1, -1)
+	p.leaderSnapshotSub = sub
 	p.srv.ncRepl.Flush()
 
 	// Start auto-pause timer if enabled.
@@ -876,6 +1227,20 @@ func (p *partition) becomeLeader(epoch uint64) error {
 		})
 	}
 
+	// Start checkpointing the producer state.
+	p.srv.startGoroutineWithArgs(func(args ...interface{}) {
+		p.producerStateCheckpointLoop(args[0].(*producerState), args[1].(chan struct{}))
+	}, producers, p.stopLeader)
+
+	// Start the leader lease timer if enabled.
+	p.leaseExpired = false
+	p.renewLeaderLease()
+	if p.srv.config.Clustering.LeaderLeaseDuration > 0 {
+		p.srv.startGoroutineWithArgs(func(args ...interface{}) {
+			p.leaderLeaseLoop(args[0].(chan struct{}))
+		}, p.stopLeader)
+	}
+
 	p.isLeading = true
 	p.isFollowing = false
 
@@ -884,6 +1249,42 @@ func (p *partition) becomeLeader(epoch uint64) error {
 		p.srv.cursors.BecomePartitionLeader()
 	}
 
+	p.sendPartitionStarted(epoch)
+
+	return nil
+}
+
+// startConsuming starts appending messages to the log as the leader, either
+// by copying the source partition if the partition is a mirror or by
+// consuming messages from NATS. Must be called within the scope of the
+// partition mutex.
+func (p *partition) startConsuming(epoch uint64, producers *producerState) error {
+	if p.mirrorSource != nil {
+		// Mirrors copy their source partition rather than consuming from
+		// NATS.
+		p.srv.startGoroutineWithArgsWG(func(args ...interface{}) {
+			p.mirrorLoop(args[0].(chan struct{}), epoch)
+		}, &p.shutdown, p.stopLeader)
+		return nil
+	}
+
+	// Start message processing loop.
+	recvChan := make(chan *nats.Msg, recvChannelSize)
+	p.recvChan = recvChan
+	p.srv.startGoroutineWithArgsWG(func(args ...interface{}) {
+		stop := args[0].(chan struct{})
+		p.messageProcessingLoop(recvChan, stop, epoch, producers)
+	}, &p.shutdown, p.stopLeader)
+
+	// Subscribe to the NATS subject and begin sequencing messages.
+	// TODO: This should be drained on shutdown.
+	err := p.srv.intake.subscribe(p.srv.nc, p, p.getSubject(), p.Group, func(m *nats.Msg) {
+		recvChan <- m
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to subscribe to NATS")
+	}
+	p.srv.nc.Flush()
 	return nil
 }
 
@@ -893,7 +1294,7 @@ func (p *partition) becomeLeader(epoch uint64) error {
 // scope of the partition mutex.
 func (p *partition) stopLeading() error {
 	// Unsubscribe from NATS subject.
-	if err := p.sub.Unsubscribe(); err != nil {
+	if err := p.srv.intake.unsubscribe(p); err != nil {
 		return err
 	}
 
@@ -907,7 +1308,13 @@ func (p *partition) stopLeading() error {
 		return err
 	}
 
+	// Unsubscribe from snapshot chunk subject.
+	if err := p.leaderSnapshotSub.Unsubscribe(); err != nil {
+		return err
+	}
+
 	// Stop processing messages and replicating.
+	p.recvChan = nil
 	close(p.stopLeader)
 
 	// Wait for loops to shutdown. Release mutex while we wait to avoid
@@ -917,6 +1324,7 @@ func (p *partition) stopLeading() error {
 	p.mu.Lock()
 
 	p.commitQueue.Dispose()
+	p.log.SetRetentionFloor(math.MaxInt64)
 	p.isLeading = false
 
 	return nil
@@ -943,6 +1351,7 @@ func (p *partition) becomeFollower() error {
 
 	p.isFollowing = true
 	p.isLeading = false
+	p.leaseExpired = false
 
 	return nil
 }
@@ -1018,25 +1427,35 @@ func (p *partition) handleReplicationRequest(msg *nats.Msg) {
 	replicator.request(replicationRequest{req, msg, received})
 }
 
-// handleReplicationResponse is a NATS handler that's invoked when a follower
-// receives a replication response from the leader. This response will contain
-// the leader epoch, leader HW, and (optionally) messages to replicate.
-func (p *partition) handleReplicationResponse(msg *nats.Msg) int {
+// handleReplicationResponse is invoked when a follower receives a replication
+// response from the leader. This response will contain the leader epoch,
+// leader HW, and (optionally) messages to replicate. It returns the number of
+// messages replicated and an error if the messages don't follow on from the
+// end of the log, which happens if the response to an earlier pipelined
+// request was lost. The leader may instead offer a snapshot of the sealed
+// segments the follower is missing.
+func (p *partition) handleReplicationResponse(msg *nats.Msg) (int, error) {
 	leaderEpoch, hw, data, err := proto.UnmarshalReplicationResponse(msg.Data)
 	if err != nil {
+		if outOfRange, err := proto.UnmarshalReplicationOffsetOutOfRange(msg.Data); err == nil {
+			return 0, p.handleReplicationOffsetOutOfRange(outOfRange)
+		}
+		if offer, err := proto.UnmarshalReplicationSnapshotOffer(msg.Data); err == nil {
+			return 0, p.handleReplicationSnapshotOffer(offer)
+		}
 		p.srv.logger.Warnf("Invalid replication response for partition %s: %s", p, err)
-		return 0
+		return 0, nil
 	}
 
 	p.mu.RLock()
 	if !p.isFollowing {
 		p.mu.RUnlock()
-		return 0
+		return 0, nil
 	}
 
 	if p.LeaderEpoch != leaderEpoch {
 		p.mu.RUnlock()
-		return 0
+		return 0, nil
 	}
 	p.mu.RUnlock()
 
@@ -1044,37 +1463,75 @@ func (p *partition) handleReplicationResponse(msg *nats.Msg) int {
 	p.log.SetHighWatermark(hw)
 
 	if len(data) == 0 {
-		return 0
+		return 0, nil
 	}
 
 	// We should have at least 28 bytes for headers.
 	if len(data) <= 28 {
 		p.srv.logger.Warnf("Invalid replication response for partition %s", p)
-		return 0
+		return 0, nil
 	}
-	offset := int64(proto.Encoding.Uint64(data[:8]))
-	if offset < p.log.NewestOffset()+1 {
-		return 0
+	var (
+		offset = int64(proto.Encoding.Uint64(data[:8]))
+		next   = p.log.NewestOffset() + 1
+	)
+	if offset < next {
+		return 0, nil
+	}
+	if offset > next {
+		return 0, fmt.Errorf("replication response starts at offset %d, expected %d", offset, next)
 	}
 	offsets, err := p.log.AppendMessageSet(data)
 	if err != nil {
 		panic(fmt.Errorf("Failed to replicate data to log %s: %v", p, err))
 	}
-	return len(offsets)
+	p.metrics.messagesIn.mark(int64(len(offsets)))
+	p.metrics.bytesIn.mark(int64(len(data)))
+	p.markReplicatedIngestSources(data)
+	return len(offsets), nil
+}
+
+// handleReplicationOffsetOutOfRange is invoked when a follower receives a
+// response from the leader indicating its log ends before the start of the
+// leader's log, e.g. because the leader deleted the messages it's missing due
+// to retention while it was offline. Since the follower can't catch up on
+// them, it truncates its entire log to restart at the leader's log start
+// offset and returns errTruncatedToLeaderStart. Responses to requests sent
+// before the truncation are ignored.
+func (p *partition) handleReplicationOffsetOutOfRange(resp *proto.ReplicationOffsetOutOfRange) error {
+	p.mu.RLock()
+	if !p.isFollowing || p.LeaderEpoch != resp.LeaderEpoch {
+		p.mu.RUnlock()
+		return nil
+	}
+	p.mu.RUnlock()
+
+	newest := p.log.NewestOffset()
+	if resp.LogStartOffset <= newest+1 {
+		return nil
+	}
+	p.srv.logger.Warnf("Log for partition %s ends at offset %d before the leader's log start "+
+		"offset %d, truncating it to restart replicating from the leader's log start",
+		p, newest, resp.LogStartOffset)
+	if err := p.log.TruncateAndStartAt(resp.LogStartOffset); err != nil {
+		panic(fmt.Errorf("Failed to truncate log %s: %v", p, err))
+	}
+	p.log.SetHighWatermark(resp.HighWatermark)
+	return errTruncatedToLeaderStart
 }
 
 // getReplicationRequestInbox returns the NATS subject to send replication
 // requests to.
 func (p *partition) getReplicationRequestInbox() string {
 	return fmt.Sprintf("%s.%s.%d.replicate",
-		p.srv.config.Clustering.Namespace, p.Stream, p.Id)
+		p.srv.getSubjectPrefix(), p.Stream, p.Id)
 }
 
 // getLeaderOffsetRequestInbox returns the NATS subject to send leader epoch
 // offset requests to.
 func (p *partition) getLeaderOffsetRequestInbox() string {
 	return fmt.Sprintf("%s.%s.%d.offset",
-		p.srv.config.Clustering.Namespace, p.Stream, p.Id)
+		p.srv.getSubjectPrefix(), p.Stream, p.Id)
 }
 
 // autoPauseLoop is a long-running loop the leader runs to check if the
@@ -1091,7 +1548,7 @@ func (p *partition) autoPauseLoop(stop <-chan struct{}) {
 
 		p.mu.RLock()
 		latestReceivedElapsed := time.Since(p.messagesReceivedTimestamps.latestTime)
-		subsAllowPausing := !p.autoPauseDisableIfSubscribers || p.subscriberCount == 0
+		subsAllowPausing := !p.autoPauseDisableIfSubscribers || len(p.subscriptions) == 0
 		p.mu.RUnlock()
 
 		if latestReceivedElapsed > p.autoPauseTime && subsAllowPausing {
@@ -1124,15 +1581,21 @@ func (p *partition) requestPause() error {
 // written to the write-ahead log, a marker is written to the commit queue to
 // indicate it's pending commit. Once the ISR has replicated the message, the
 // leader commits it by removing it from the queue and sending an
-// acknowledgement to the client.
+// acknowledgement to the client. Messages retried by idempotent producers are
+// discarded using the given producer state and acked with their original
+// offsets. Messages published by fenced exclusive producers are rejected.
 func (p *partition) messageProcessingLoop(recvChan <-chan *nats.Msg, stop <-chan struct{},
-	leaderEpoch uint64) {
+	leaderEpoch uint64, producers *producerState) {
 
 	var (
 		msg       *nats.Msg
 		batchSize = p.srv.config.BatchMaxMessages
 		batchWait = p.srv.config.BatchMaxTime
+		maxBytes  = p.maxIngestBytes()
 		msgBatch  = make([]*commitlog.Message, 0, batchSize)
+		bytesIn   int64 // NATS payload bytes of the messages in msgBatch
+		received  = newIngestIDs(ingestIDWindow)
+		epochs    = make(map[string]uint64) // Newest fencing token accepted from each exclusive producer
 	)
 	// If Concurrency Control is enabled, then the message will be appended one by one.
 	// This is to ensure no conflict between each message.
@@ -1142,6 +1605,7 @@ func (p *partition) messageProcessingLoop(recvChan <-chan *nats.Msg, stop <-chan
 
 	for {
 		msgBatch = msgBatch[:0]
+		bytesIn = 0
 		select {
 		case <-stop:
 			return
@@ -1178,12 +1642,14 @@ func (p *partition) messageProcessingLoop(recvChan <-chan *nats.Msg, stop <-chan
 			m.Value = encryptedValue
 		}
 
-		// Reject messages that are larger than the max replication size.
-		if int64(len(msg.Data)) > p.srv.config.Clustering.ReplicationMaxBytes {
-			p.sendTooLargeNack(m)
+		// Reject messages that are larger than the max message or
+		// replication size.
+		if int64(len(msg.Data)) > maxBytes {
+			p.sendTooLargeNack(m, len(msg.Data), maxBytes)
 			continue
 		}
 		msgBatch = append(msgBatch, m)
+		bytesIn += int64(len(msg.Data))
 		remaining := batchSize - 1
 
 		// Fill the batch up to the max batch size or until timeout.
@@ -1221,11 +1687,12 @@ func (p *partition) messageProcessingLoop(recvChan <-chan *nats.Msg, stop <-chan
 					}
 					m.Value = encryptedValue
 				}
-				if int64(len(msg.Data)) > p.srv.config.Clustering.ReplicationMaxBytes {
-					p.sendTooLargeNack(m)
+				if int64(len(msg.Data)) > maxBytes {
+					p.sendTooLargeNack(m, len(msg.Data), maxBytes)
 					continue batchLoop
 				}
 				msgBatch = append(msgBatch, m)
+				bytesIn += int64(len(msg.Data))
 				remaining--
 			default:
 				// No more immediately available messages
@@ -1257,11 +1724,12 @@ func (p *partition) messageProcessingLoop(recvChan <-chan *nats.Msg, stop <-chan
 						}
 						m.Value = encryptedValue
 					}
-					if int64(len(msg.Data)) > p.srv.config.Clustering.ReplicationMaxBytes {
-						p.sendTooLargeNack(m)
+					if int64(len(msg.Data)) > maxBytes {
+						p.sendTooLargeNack(m, len(msg.Data), maxBytes)
 						continue batchLoop
 					}
 					msgBatch = append(msgBatch, m)
+					bytesIn += int64(len(msg.Data))
 					remaining--
 				case <-batchTimerC:
 					// Batch timeout reached, dispatch what we have
@@ -1280,17 +1748,46 @@ func (p *partition) messageProcessingLoop(recvChan <-chan *nats.Msg, stop <-chan
 			batchTimer.Stop()
 		}
 
+		// Drop messages received more than once from NATS.
+		msgBatch = p.dropDuplicateIntake(received, msgBatch)
+
+		// Reject messages published by fenced exclusive producers.
+		msgBatch = p.dropFencedMessages(epochs, msgBatch)
+
+		// Reject messages if disconnected from NATS for too long to
+		// replicate them.
+		msgBatch = p.dropFencedLeaderMessages(msgBatch)
+
+		// Use the create times publishers set if the stream is configured
+		// to.
+		msgBatch = p.assignCreateTimes(msgBatch)
+
+		// Record how the messages entered the partition.
+		stampIngestSources(msgBatch)
+
+		// Discard messages retried by idempotent producers.
+		var duplicates []duplicateMessage
+		msgBatch, duplicates = p.dedupeBatch(producers, msgBatch)
+		if len(msgBatch) == 0 {
+			p.ackDuplicates(duplicates, nil)
+			continue
+		}
+
 		// Write uncommitted messages to log.
 		offsets, err := p.log.Append(msgBatch)
 		if err != nil {
 
 			// AckErr should be dispatched if ErrIncorrectOffset is raised.
-			if errors.Is(err, commitlog.ErrIncorrectOffset) {
+			// The ack's offset is the one the message should be retried
+			// with.
+			var incorrectOffset *commitlog.IncorrectOffsetError
+			if errors.As(err, &incorrectOffset) {
 				msg := msgBatch[0]
 				ack := &client.Ack{
 					Stream:             p.Stream,
 					PartitionSubject:   p.Subject,
 					MsgSubject:         string(msg.Headers["subject"]),
+					Offset:             incorrectOffset.ExpectedOffset,
 					AckInbox:           msg.AckInbox,
 					CorrelationId:      msg.CorrelationID,
 					AckPolicy:          msg.AckPolicy,
@@ -1304,6 +1801,11 @@ func (p *partition) messageProcessingLoop(recvChan <-chan *nats.Msg, stop <-chan
 			continue
 		}
 
+		recordProducerSequences(producers, msgBatch, offsets)
+		if p.correlations != nil {
+			p.correlations.indexBatch(msgBatch, offsets, leaderEpoch)
+		}
+
 		// Track if we can use the fast path (RF=1 with no AckPolicy_ALL messages).
 		useFastPath := p.ReplicationFactor == 1
 		for i, msg := range msgBatch {
@@ -1312,6 +1814,12 @@ func (p *partition) messageProcessingLoop(recvChan <-chan *nats.Msg, stop <-chan
 			}
 			p.processPendingMessage(offsets[i], msg)
 		}
+		p.metrics.messagesIn.mark(int64(len(msgBatch)))
+		p.metrics.bytesIn.mark(bytesIn)
+		p.markIngestSources(msgBatch)
+		if p.keys != nil {
+			p.keys.addBatch(msgBatch)
+		}
 
 		// Fast path for RF=1: update high watermark once per batch instead of
 		// going through the commit queue. This avoids queue overhead when there's
@@ -1325,6 +1833,8 @@ func (p *partition) messageProcessingLoop(recvChan <-chan *nats.Msg, stop <-chan
 			p.srv.config.Clustering.ServerID,
 			offsets[len(offsets)-1],
 		)
+
+		p.ackDuplicates(duplicates, offsets)
 	}
 }
 
@@ -1374,7 +1884,7 @@ func (p *partition) startReplicating(epoch uint64, stop chan struct{}) {
 	p.commitQueue = queue.New(100)
 	p.srv.startGoroutineWG(func() {
 		p.commitLoop(stop)
-	}, p.shutdown)
+	}, &p.shutdown)
 
 	p.replicators = make(map[string]*replicator, len(p.replicas)-1)
 	for replica := range p.replicas {
@@ -1386,8 +1896,28 @@ func (p *partition) startReplicating(epoch uint64, stop chan struct{}) {
 		p.replicators[replica] = r
 		p.srv.api.startGoroutineWithArgsWG(func(args ...interface{}) {
 			args[0].(*replicator).start(stop)
-		}, p.shutdown, r)
+		}, &p.shutdown, r)
 	}
+	p.updateRetentionFloor()
+}
+
+// updateRetentionFloor limits the leader's retention to the messages every
+// follower has fetched if streams.retention.follower.floor is enabled. This
+// includes followers which have fallen out of the ISR, and followers which
+// haven't sent a replication request in the current leader epoch hold back
+// retention entirely. The replicators don't change while leading, so this
+// doesn't need the partition mutex.
+func (p *partition) updateRetentionFloor() {
+	if !p.srv.config.Streams.RetentionFollowerFloor {
+		return
+	}
+	floor := int64(math.MaxInt64)
+	for _, replicator := range p.replicators {
+		if fetched := replicator.getLastFetched(); fetched < floor {
+			floor = fetched
+		}
+	}
+	p.log.SetRetentionFloor(floor)
 }
 
 // commitLoop is a long-running loop which checks to see if messages in the
@@ -1433,6 +1963,10 @@ func (p *partition) commitLoop(stop chan struct{}) {
 			})
 		)
 
+		// Committing messages renews the leader lease.
+		if minLatest > p.log.HighWatermark() {
+			p.renewLeaderLease()
+		}
 		p.log.SetHighWatermark(minLatest)
 
 		// An error here indicates the queue was disposed as a result of the
@@ -1467,18 +2001,25 @@ func (p *partition) sendAck(ack *client.Ack) {
 	if err != nil {
 		panic(err)
 	}
-	if err := p.srv.ncAcks.Publish(ack.AckInbox, data); err != nil {
+	msg := nats.NewMsg(ack.AckInbox)
+	msg.Data = data
+	// Tell the publisher which leader epoch the message was written in, if
+	// the NATS server supports headers, since Ack has no field for it.
+	if p.srv.ncAcks.HeadersSupported() {
+		msg.Header.Set(ackLeaderEpochHeader, strconv.FormatUint(p.log.LastLeaderEpoch(), 10))
+	}
+	if err := p.srv.ncAcks.PublishMsg(msg); err != nil {
 		p.srv.logger.Errorf("Error sending ack for partition %s: %v", p, err)
 	}
 }
 
 // sendTooLargeNack publishes an ack containing an error indicating the message
-// exceeded the max replication size to the specified AckInbox. If no AckInbox
-// is set, this does nothing.
-func (p *partition) sendTooLargeNack(msg *commitlog.Message) {
+// exceeded the max message or replication size to the specified AckInbox. If
+// no AckInbox is set, this does nothing.
+func (p *partition) sendTooLargeNack(msg *commitlog.Message, size int, maxBytes int64) {
 	p.srv.logger.Errorf(
-		"Rejecting message received on partition %s that exceeds clustering.replication.max.bytes (%d)",
-		p, p.srv.config.Clustering.ReplicationMaxBytes)
+		"Rejecting message of %d bytes received on partition %s that exceeds the max message size (%d)",
+		size, p, maxBytes)
 	if msg.AckInbox == "" {
 		return
 	}
@@ -1503,9 +2044,16 @@ func (p *partition) sendTooLargeNack(msg *commitlog.Message) {
 
 // replicationRequestLoop is a long-running loop which sends replication
 // requests to the partition leader, handles replicating messages, and checks
-// the health of the leader.
+// the health of the leader. While there is data to replicate, up to
+// ReplicaFetchPipelineDepth requests are kept in flight so that the follower
+// isn't limited to one batch per round trip to the leader.
 func (p *partition) replicationRequestLoop(leader string, epoch uint64, stop <-chan struct{}) {
-	leaderLastSeen := time.Now()
+	var (
+		leaderLastSeen = time.Now()
+		pipeline       = newFetchPipeline(p.srv.config.Clustering.ReplicaFetchPipelineDepth)
+		idle           bool
+		decline        bool // Decline snapshots once a transfer failed
+	)
 	for {
 		select {
 		case <-stop:
@@ -1513,10 +2061,58 @@ func (p *partition) replicationRequestLoop(leader string, epoch uint64, stop <-c
 		default:
 		}
 
-		replicated, err := p.sendReplicationRequest(epoch)
+		// Fill the window unless we're caught up with the leader. The first
+		// request tells the leader where our log ends, and the rest ask it to
+		// carry on from where its previous response ended.
+		for !idle && pipeline.canSend() {
+			wait, err := p.sendReplicationRequest(epoch, pipeline.inflight() > 0, decline)
+			if err != nil {
+				p.srv.logger.Errorf(
+					"Error sending replication request for partition %s: %v", p, err)
+				break
+			}
+			pipeline.send(wait)
+		}
+
+		// If we are caught up with the leader, wait for data.
+		if pipeline.inflight() == 0 {
+			wait := p.computeReplicaFetchSleep()
+			select {
+			case <-stop:
+				return
+			case <-time.After(wait):
+				// Check in with leader to maintain health status.
+			case <-p.notify:
+				// Leader has signalled more data is available.
+			}
+			idle = false
+			continue
+		}
+
+		result, ok := pipeline.next(stop)
+		if !ok {
+			return
+		}
+		replicated, err := 0, result.err
+		if err == nil {
+			replicated, err = p.handleReplicationResponse(result.resp)
+		}
+		if err == errTruncatedToLeaderStart || err == errSnapshotInstalled || err == errSnapshotFailed {
+			// Discard the responses to the requests in flight, which were
+			// sent before the truncation or snapshot, and resume replicating
+			// from the new end of the log right away.
+			if err == errSnapshotFailed {
+				decline = true
+			}
+			pipeline.reset()
+			leaderLastSeen = time.Now()
+			idle = false
+			continue
+		}
 		if err != nil {
 			p.srv.logger.Errorf(
-				"Error sending replication request for partition %s: %v", p, err)
+				"Replication request for partition %s failed: %v", p, err)
+			pipeline.reset()
 
 			// Check if the loop has since been stopped. This is possible, for
 			// example, if another leader was since elected.
@@ -1527,28 +2123,15 @@ func (p *partition) replicationRequestLoop(leader string, epoch uint64, stop <-c
 			}
 		} else {
 			leaderLastSeen = time.Now()
+			pipeline.grow()
 		}
 
 		// Check if leader has exceeded max leader timeout.
 		p.checkLeaderHealth(leader, epoch, leaderLastSeen)
 
-		// If there is more data, continue replicating.
-		if replicated > 0 {
-			continue
-		}
-
-		// If we are caught up with the leader, wait for data.
-		wait := p.computeReplicaFetchSleep()
-		select {
-		case <-stop:
-			return
-		case <-time.After(wait):
-			// Check in with leader to maintain health status.
-			continue
-		case <-p.notify:
-			// Leader has signalled more data is available.
-			continue
-		}
+		// If there is more data, continue replicating. Otherwise wait for the
+		// requests in flight and then for data.
+		idle = replicated == 0
 	}
 }
 
@@ -1585,27 +2168,38 @@ func (p *partition) computeReplicaFetchSleep() time.Duration {
 }
 
 // sendReplicationRequest sends a replication request to the partition leader
-// and processes the response. It returns an int indicating the number of
-// messages that were replicated. Zero (without an error) indicates the
-// follower is caught up with the leader.
-func (p *partition) sendReplicationRequest(leaderEpoch uint64) (int, error) {
+// and returns a function which waits for the response. A pipelined request is
+// sent before the response to the previous request arrives, so the leader
+// replicates from where that response ends rather than from the end of the
+// follower's log. Requests are published in the order they are sent so that
+// the leader handles them in that order. If declineSnapshot is set, the
+// leader replicates messages rather than offering a snapshot.
+func (p *partition) sendReplicationRequest(leaderEpoch uint64, pipelined, declineSnapshot bool) (
+	func() (*nats.Msg, error), error) {
+
 	data, err := proto.MarshalReplicationRequest(&proto.ReplicationRequest{
-		ReplicaID:   p.srv.config.Clustering.ServerID,
-		Offset:      p.log.NewestOffset(),
-		LeaderEpoch: leaderEpoch,
+		ReplicaID:       p.srv.config.Clustering.ServerID,
+		Offset:          p.log.NewestOffset(),
+		LeaderEpoch:     leaderEpoch,
+		Pipelined:       pipelined,
+		DeclineSnapshot: declineSnapshot,
 	})
 	if err != nil {
 		panic(err)
 	}
-	resp, err := p.srv.ncRepl.Request(
-		p.getReplicationRequestInbox(),
-		data,
-		p.srv.config.Clustering.ReplicaFetchTimeout,
-	)
+	inbox := p.srv.getReplicationReplyInbox()
+	sub, err := p.srv.ncRepl.SubscribeSync(inbox)
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+	if err := p.srv.ncRepl.PublishRequest(p.getReplicationRequestInbox(), inbox, data); err != nil {
+		sub.Unsubscribe()
+		return nil, err
 	}
-	return p.handleReplicationResponse(resp), nil
+	return func() (*nats.Msg, error) {
+		defer sub.Unsubscribe()
+		return sub.NextMsg(p.srv.config.Clustering.ReplicaFetchTimeout)
+	}, nil
 }
 
 // truncateUncommitted truncates the log up to the start offset of the first
@@ -1725,6 +2319,8 @@ func (p *partition) RemoveFromISR(replica string) error {
 		p.belowMinISR = true
 	}
 
+	p.notifyWatchers(proto.PartitionEventType_ISR_UPDATED)
+
 	// We may need to commit messages since the ISR shrank.
 	if p.isLeading {
 		select {
@@ -1760,6 +2356,51 @@ func (p *partition) AddToISR(rep string) error {
 		p.belowMinISR = false
 	}
 
+	p.notifyWatchers(proto.PartitionEventType_ISR_UPDATED)
+	return nil
+}
+
+// ReplaceReplica replaces the given replica with the replacement in the
+// partition's replicas, removing it from the ISR. The replacement joins the ISR
+// once it has caught up with the leader. If this server is the replica being
+// replaced, it stops following the partition. It returns an error if the
+// replica isn't a partition replica or the replacement already is.
+func (p *partition) ReplaceReplica(replica, replacement string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.inReplicas(replica) {
+		return fmt.Errorf("%s not a replica", replica)
+	}
+	if p.inReplicas(replacement) {
+		return fmt.Errorf("%s already a replica", replacement)
+	}
+	delete(p.replicas, replica)
+	p.replicas[replacement] = struct{}{}
+	_, wasInISR := p.isr[replica]
+	delete(p.isr, replica)
+
+	// Also update the replicas and ISR on the protobuf so this state is
+	// persisted.
+	replicas := make([]string, len(p.Replicas))
+	for i, id := range p.Replicas {
+		if id == replica {
+			id = replacement
+		}
+		replicas[i] = id
+	}
+	p.Replicas = replicas
+	p.Isr = make([]string, 0, len(p.isr))
+	for replica := range p.isr {
+		p.Isr = append(p.Isr, replica)
+	}
+
+	if wasInISR {
+		p.notifyWatchers(proto.PartitionEventType_ISR_UPDATED)
+	}
+
+	if replica == p.srv.config.Clustering.ServerID {
+		return p.stopLeadingOrFollowing()
+	}
 	return nil
 }
 
@@ -1799,6 +2440,24 @@ func (p *partition) ISRSize() int {
 	return size
 }
 
+// MinISR returns the minimum number of in-sync replicas needed to commit
+// messages, which is the stream's override if set or the cluster default.
+func (p *partition) MinISR() int {
+	return p.minISR
+}
+
+// IsBelowMinISR indicates if the ISR has shrunk below the minimum ISR size, in
+// which case messages can't be committed.
+func (p *partition) IsBelowMinISR() bool {
+	return p.ISRSize() < p.minISR
+}
+
+// ExpiredMessageCount returns the number of messages subscriptions on this
+// server skipped because their TTL elapsed.
+func (p *partition) ExpiredMessageCount() int64 {
+	return atomic.LoadInt64(&p.expiredMessages)
+}
+
 // GetISR returns the in-sync replicas set.
 func (p *partition) GetISR() []string {
 	p.mu.RLock()
@@ -1822,6 +2481,105 @@ func (p *partition) GetReplicas() []string {
 	return replicas
 }
 
+// IsReplica indicates if the given broker is a replica for the partition.
+func (p *partition) IsReplica(replica string) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	_, ok := p.replicas[replica]
+	return ok
+}
+
+// readAmplification returns a snapshot of the bytes read from the partition's
+// log on this server compared to the bytes appended to it.
+func (p *partition) readAmplification() *proto.ReadAmplification {
+	return p.metrics.readAmplification(p.log.BytesAppended(), p.log.ColdSegmentOpens())
+}
+
+// Stats returns a snapshot of the partition's log, replication, and throughput
+// metrics. Follower replication lag is only included if this server is the
+// partition leader since it's tracked by the leader's replicators.
+func (p *partition) Stats() *proto.PartitionStats {
+	var (
+		messagesIn, messagesInRate = p.metrics.messagesIn.snapshot()
+		bytesIn, bytesInRate       = p.metrics.bytesIn.snapshot()
+		bytesOut, bytesOutRate     = p.metrics.bytesOut.snapshot()
+		newestOffset               = p.log.NewestOffset()
+		stats                      = &proto.PartitionStats{
+			Stream:            p.Stream,
+			Partition:         p.Id,
+			LogStartOffset:    p.log.OldestOffset(),
+			LogEndOffset:      newestOffset,
+			HighWatermark:     p.log.HighWatermark(),
+			SizeBytes:         p.log.Size(),
+			SegmentCount:      int32(p.log.SegmentCount()),
+			MessagesIn:        messagesIn,
+			MessagesInRate:    int64(messagesInRate),
+			BytesIn:           bytesIn,
+			BytesInRate:       int64(bytesInRate),
+			BytesOut:          bytesOut,
+			BytesOutRate:      int64(bytesOutRate),
+			DuplicatesDropped: atomic.LoadInt64(&p.duplicatesDropped),
+			ReadAmplification: p.readAmplification(),
+			IngestSources:     p.ingestSources(),
+			SegmentRolls: &proto.SegmentRolls{
+				MaxBytes: p.log.SegmentRolls(commitlog.RollSize),
+				MaxAge:   p.log.SegmentRolls(commitlog.RollAge),
+				Forced:   p.log.SegmentRolls(commitlog.RollForced),
+			},
+		}
+	)
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	stats.Leader = p.Leader
+	stats.LeaderEpoch = p.LeaderEpoch
+	stats.IsLeader = p.isLeading
+	stats.Isr = make([]string, 0, len(p.isr))
+	for replica := range p.isr {
+		stats.Isr = append(stats.Isr, replica)
+	}
+	sort.Strings(stats.Isr)
+
+	stats.Subscriptions = make([]*proto.SubscriptionStats, 0, len(p.subscriptions))
+	for sub := range p.subscriptions {
+		stats.Subscriptions = append(stats.Subscriptions, sub.Stats())
+	}
+	sort.Slice(stats.Subscriptions, func(i, j int) bool {
+		if stats.Subscriptions[i].GroupId != stats.Subscriptions[j].GroupId {
+			return stats.Subscriptions[i].GroupId < stats.Subscriptions[j].GroupId
+		}
+		return stats.Subscriptions[i].ConsumerId < stats.Subscriptions[j].ConsumerId
+	})
+
+	if p.isLeading {
+		stats.ReplicaLag = make([]*proto.ReplicaLag, 0, len(p.replicators))
+		for replica, replicator := range p.replicators {
+			var (
+				lastFetched             = replicator.getLastFetched()
+				_, inISR                = p.isr[replica]
+				throttled, throttleTime = replicator.getThrottleStats()
+			)
+			stats.ReplicaLag = append(stats.ReplicaLag, &proto.ReplicaLag{
+				Replica:           replica,
+				LastFetchedOffset: lastFetched,
+				Lag:               newestOffset - lastFetched,
+				InIsr:             inISR,
+				Throttled:         throttled,
+				ThrottleTime:      int64(throttleTime),
+			})
+		}
+		sort.Slice(stats.ReplicaLag, func(i, j int) bool {
+			return stats.ReplicaLag[i].Replica < stats.ReplicaLag[j].Replica
+		})
+		if p.keys != nil {
+			stats.TopKeys = p.keys.top(skewTopKeys)
+		}
+	}
+
+	return stats
+}
+
 // updateISRLatestOffset updates the given replica's latest log offset. When a
 // replica's latest log offset increases, we check to see if anything in the
 // commit queue can be committed.
@@ -1833,6 +2591,9 @@ func (p *partition) updateISRLatestOffset(replica string, offset int64) {
 		// Replica is not currently in ISR.
 		return
 	}
+	if replica != p.srv.config.Clustering.ServerID {
+		p.renewLeaderLease()
+	}
 	if rep.updateLatestOffset(offset) {
 		// If offset updated, we may need to commit messages.
 		select {
@@ -1852,12 +2613,30 @@ func (p *partition) sendPartitionNotification(replica string) {
 	if err != nil {
 		panic(err)
 	}
-	if err := p.srv.ncRepl.Publish(p.srv.getPartitionNotificationInbox(replica), req); err != nil {
+	if err := p.srv.ncRepl.Publish(p.srv.getPartitionNotificationInbox(replica), p.srv.auth.sign(req)); err != nil {
 		p.srv.logger.Errorf("Error sending new data notification to replica %s for partition %s: %v",
 			replica, p, err)
 	}
 }
 
+// sendPartitionStarted announces that this server has finished starting the
+// partition as leader so that brokers waiting on it, e.g. to complete a
+// CreateStream request, don't need to poll the partition's status.
+func (p *partition) sendPartitionStarted(epoch uint64) {
+	req, err := proto.MarshalPartitionStarted(&proto.PartitionStarted{
+		Stream:      p.Stream,
+		Partition:   p.Id,
+		Leader:      p.srv.config.Clustering.ServerID,
+		LeaderEpoch: epoch,
+	})
+	if err != nil {
+		panic(err)
+	}
+	if err := p.srv.ncRaft.Publish(p.srv.getPartitionStartedInbox(), p.srv.auth.sign(req)); err != nil {
+		p.srv.logger.Errorf("Error sending started notification for partition %s: %v", p, err)
+	}
+}
+
 // pauseReplication stops replication on the leader. This is for unit testing
 // purposes only.
 func (p *partition) pauseReplication() {
@@ -1870,10 +2649,7 @@ func (p *partition) pauseReplication() {
 // to. A partitioned stream maps to separate NATS subjects: subject, subject.1,
 // subject.2, etc.
 func (p *partition) getSubject() string {
-	if p.Id == 0 {
-		return p.Subject
-	}
-	return fmt.Sprintf("%s.%d", p.Subject, p.Id)
+	return partitionSubject(p.Subject, p.Id)
 }
 
 // getMessage converts the given payload into a client Message if it is one.
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Clean applies the retention and compaction rules to the partition's log
+// immediately rather than waiting for the cleaner interval and returns a
+// summary of the clean. Cleans of a log are serialized, so this waits for a
+// clean in progress, e.g. by the cleaner loop, to finish first. The log isn't
+// cleaned if the partition is paused since it's closed.
+func (p *partition) Clean() *proto.PartitionCleanResult {
+	p.mu.RLock()
+	result := &proto.PartitionCleanResult{
+		Broker:   p.srv.config.Clustering.ServerID,
+		IsLeader: p.isLeading,
+		Paused:   p.paused,
+	}
+	p.mu.RUnlock()
+	if result.Paused {
+		return result
+	}
+
+	summary, err := p.log.CleanWithSummary()
+	if err != nil {
+		p.srv.logger.Errorf("Failed to clean partition %s: %v", p, err)
+		result.Error = err.Error()
+	}
+	result.SegmentsBefore = int32(summary.SegmentsBefore)
+	result.SegmentsAfter = int32(summary.SegmentsAfter)
+	result.BytesReclaimed = summary.BytesReclaimed
+	result.KeysCompacted = summary.KeysCompacted
+	result.TombstonesDropped = summary.TombstonesDropped
+	result.CompactEnabled = summary.CompactEnabled
+	result.Duration = summary.Duration.Nanoseconds()
+	return result
+}
+
+// localPartitionClean cleans this server's replica of the given partition.
+func (m *metadataAPI) localPartitionClean(streamName string, id int32) *proto.PartitionCleanResult {
+	partition := m.GetPartition(streamName, id)
+	if partition == nil {
+		return &proto.PartitionCleanResult{
+			Broker: m.config.Clustering.ServerID,
+			Error:  "partition not found",
+		}
+	}
+	return partition.Clean()
+}
+
+// CleanPartition cleans the given partition's log on its leader and, if
+// requested, on each of its followers. Replicas are asked to clean their logs
+// over their partition clean inboxes in parallel. A replica which can't be
+// reached or fails to clean its log is reported with the error rather than
+// failing the request. The leader's result comes first, followed by the
+// followers' sorted by ID.
+func (m *metadataAPI) CleanPartition(ctx context.Context, req *proto.CleanPartitionRequest) (
+	*proto.CleanPartitionResponse, *status.Status) {
+
+	partition := m.GetPartition(req.Stream, req.Partition)
+	if partition == nil {
+		return nil, status.Newf(codes.NotFound, "No such partition [stream=%s, partition=%d]",
+			req.Stream, req.Partition)
+	}
+	leader, _ := partition.GetLeader()
+	if leader == "" {
+		return nil, status.Newf(codes.Unavailable, "Partition %d has no leader", req.Partition)
+	}
+
+	replicas := []string{leader}
+	if req.IncludeFollowers {
+		followers := partition.GetReplicas()
+		sort.Strings(followers)
+		for _, follower := range followers {
+			if follower != leader {
+				replicas = append(replicas, follower)
+			}
+		}
+	}
+
+	var (
+		resp = &proto.CleanPartitionResponse{
+			Replicas: make([]*proto.PartitionCleanResult, len(replicas)),
+		}
+		wg sync.WaitGroup
+	)
+	for i, replica := range replicas {
+		wg.Add(1)
+		go func(i int, replica string) {
+			defer wg.Done()
+			if replica == m.config.Clustering.ServerID {
+				resp.Replicas[i] = m.localPartitionClean(req.Stream, req.Partition)
+			} else {
+				resp.Replicas[i] = m.cleanReplicaPartition(ctx, replica, req.Stream, req.Partition)
+			}
+		}(i, replica)
+	}
+	wg.Wait()
+
+	return resp, nil
+}
+
+// cleanReplicaPartition requests the given replica to clean its log of the
+// given partition.
+func (m *metadataAPI) cleanReplicaPartition(ctx context.Context, replica, stream string,
+	partitionID int32) *proto.PartitionCleanResult {
+
+	failed := func(err string) *proto.PartitionCleanResult {
+		return &proto.PartitionCleanResult{Broker: replica, Error: err}
+	}
+
+	req, err := proto.MarshalPartitionCleanRequest(&proto.PartitionCleanRequest{
+		Stream:    stream,
+		Partition: partitionID,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	ctx, cancel := ensureTimeout(ctx, defaultPartitionCleanTimeout)
+	defer cancel()
+	resp, err := m.ncRaft.RequestWithContext(ctx, m.getPartitionCleanInbox(replica), m.auth.sign(req))
+	if err != nil {
+		return failed(err.Error())
+	}
+	if !m.auth.verify(resp.Subject, resp.Data) {
+		return failed("unauthenticated clean response")
+	}
+	cleanResp, err := proto.UnmarshalPartitionCleanResponse(resp.Data)
+	if err != nil {
+		return failed(err.Error())
+	}
+	if cleanResp.Result == nil {
+		return failed("broker didn't report clean result")
+	}
+	return cleanResp.Result
+}
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// partitionWatcher collects the changes made to a partition led by this
+// server until they're sent to the client watching it.
+type partitionWatcher struct {
+	changes uint32        // Bitmask of the PartitionEventTypes not yet sent, accessed atomically
+	notify  chan struct{} // Signaled when a change is added
+	ended   chan struct{} // Closed once the server stops leading the partition
+}
+
+// add records the change and wakes up the watcher. It never blocks.
+func (w *partitionWatcher) add(change proto.PartitionEventType) {
+	atomic.OrUint32(&w.changes, 1<<uint(change))
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+// take returns the changes recorded since it was last called, in the order of
+// their type.
+func (w *partitionWatcher) take() []proto.PartitionEventType {
+	var (
+		changes = atomic.SwapUint32(&w.changes, 0)
+		types   []proto.PartitionEventType
+	)
+	for change := uint(0); changes>>change != 0; change++ {
+		if changes&(1<<change) != 0 {
+			types = append(types, proto.PartitionEventType(change))
+		}
+	}
+	return types
+}
+
+// Watch registers a watcher for the changes made to the partition while this
+// server leads it. It returns ErrNotPartitionLeader if this server isn't the
+// partition leader. The returned function must be called to unregister the
+// watcher.
+func (p *partition) Watch() (*partitionWatcher, func(), error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if !p.isLeading {
+		return nil, nil, ErrNotPartitionLeader
+	}
+	w := &partitionWatcher{
+		notify: make(chan struct{}, 1),
+		ended:  make(chan struct{}),
+	}
+	unobserve := p.log.Observe(func(change commitlog.LogChange) {
+		if change&commitlog.HWChanged != 0 {
+			w.add(proto.PartitionEventType_HW_ADVANCED)
+		}
+		if change&commitlog.LEOChanged != 0 {
+			w.add(proto.PartitionEventType_LEO_ADVANCED)
+		}
+		if change&commitlog.ReadonlyChanged != 0 {
+			w.add(proto.PartitionEventType_READONLY_CHANGED)
+		}
+	})
+	p.watchersMu.Lock()
+	p.watchers[w] = struct{}{}
+	p.watchersMu.Unlock()
+	return w, func() {
+		unobserve()
+		p.watchersMu.Lock()
+		delete(p.watchers, w)
+		p.watchersMu.Unlock()
+	}, nil
+}
+
+// notifyWatchers records the change for the partition's watchers.
+func (p *partition) notifyWatchers(change proto.PartitionEventType) {
+	p.watchersMu.Lock()
+	defer p.watchersMu.Unlock()
+	for w := range p.watchers {
+		w.add(change)
+	}
+}
+
+// endWatchers ends the partition's watchers since the server no longer leads
+// the partition. Must be called within the scope of the partition mutex.
+func (p *partition) endWatchers() {
+	p.watchersMu.Lock()
+	defer p.watchersMu.Unlock()
+	for w := range p.watchers {
+		close(w.ended)
+		delete(p.watchers, w)
+	}
+}
+
+// event returns a PartitionEvent reporting the given changes along with the
+// partition's current state.
+func (p *partition) event(changes []proto.PartitionEventType) *proto.PartitionEvent {
+	_, epoch := p.GetLeader()
+	return &proto.PartitionEvent{
+		Changes:       changes,
+		Stream:        p.Stream,
+		Partition:     p.Id,
+		Timestamp:     time.Now().UnixNano(),
+		HighWatermark: p.log.HighWatermark(),
+		NewestOffset:  p.log.NewestOffset(),
+		Isr:           p.GetISR(),
+		Readonly:      p.IsReadonly(),
+		Paused:        p.IsPaused(),
+		LeaderEpoch:   epoch,
+	}
+}
+
+// partitionEventInterval returns the minimum time between the events sent to a
+// watcher which asked for the given max number of events per second. Watchers
+// can't get more events than the server's limit.
+func (a *apiServer) partitionEventInterval(maxEventsPerSecond int32) time.Duration {
+	rate := a.config.Streams.PartitionEventsMaxRate
+	if rate <= 0 {
+		rate = defaultPartitionEventsMaxRate
+	}
+	if maxEventsPerSecond > 0 && int(maxEventsPerSecond) < rate {
+		rate = int(maxEventsPerSecond)
+	}
+	return time.Second / time.Duration(rate)
+}
+
+// sendPartitionEvents sends the changes recorded by the watcher until the
+// client goes away or the server stops leading the partition, in which case
+// the changes made before then are sent and FailedPrecondition is returned so
+// the client watches the new leader. Changes made within the interval
+// following an event are coalesced into the next one.
+func (a *apiServer) sendPartitionEvents(out proto.AdminAPI_WatchPartitionServer, p *partition,
+	w *partitionWatcher, interval time.Duration) error {
+
+	var (
+		ctx  = out.Context()
+		last time.Time
+	)
+	for {
+		select {
+		case <-w.notify:
+		case <-w.ended:
+			if changes := w.take(); len(changes) > 0 {
+				if err := out.Send(p.event(changes)); err != nil {
+					return err
+				}
+			}
+			return status.Error(codes.FailedPrecondition, "Server no longer partition leader")
+		case <-ctx.Done():
+			return nil
+		case <-a.shutdownCh:
+			return status.Error(codes.Unavailable, "Server is shutting down")
+		}
+
+		// Wait for the interval to elapse since the last event, collecting the
+		// changes made in the meantime.
+		if wait := interval - time.Since(last); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-w.ended:
+				// Send the changes made so far before ending.
+			case <-ctx.Done():
+				timer.Stop()
+				return nil
+			case <-a.shutdownCh:
+				timer.Stop()
+				return status.Error(codes.Unavailable, "Server is shutting down")
+			}
+			timer.Stop()
+		}
+
+		changes := w.take()
+		if len(changes) == 0 {
+			continue
+		}
+		if err := out.Send(p.event(changes)); err != nil {
+			return err
+		}
+		last = time.Now()
+	}
+}
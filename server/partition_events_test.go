@@ -0,0 +1,191 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure partition watchers coalesce the changes recorded until they're taken
+// and return them in type order.
+func TestPartitionWatcher(t *testing.T) {
+	w := &partitionWatcher{notify: make(chan struct{}, 1)}
+	require.Empty(t, w.take())
+
+	w.add(proto.PartitionEventType_LEADER_EPOCH_CHANGED)
+	w.add(proto.PartitionEventType_HW_ADVANCED)
+	w.add(proto.PartitionEventType_LEADER_EPOCH_CHANGED)
+	require.Len(t, w.notify, 1)
+	require.Equal(t, []proto.PartitionEventType{
+		proto.PartitionEventType_HW_ADVANCED,
+		proto.PartitionEventType_LEADER_EPOCH_CHANGED,
+	}, w.take())
+	require.Empty(t, w.take())
+}
+
+// recvPartitionEvent receives partition events until one reports the given
+// change and returns it along with the number of events received.
+func recvPartitionEvent(t *testing.T, watch proto.AdminAPI_WatchPartitionClient,
+	change proto.PartitionEventType, matches func(*proto.PartitionEvent) bool) (*proto.PartitionEvent, int) {
+
+	for received := 1; ; received++ {
+		event, err := watch.Recv()
+		require.NoError(t, err)
+		for _, c := range event.Changes {
+			if c == change && matches(event) {
+				return event, received
+			}
+		}
+	}
+}
+
+// isrContains indicates if the ISR contains the given replica.
+func isrContains(replica string, isr []string) bool {
+	for _, r := range isr {
+		if r == replica {
+			return true
+		}
+	}
+	return false
+}
+
+// Ensure WatchPartition sends coalesced partition events from the partition
+// leader and ends with FailedPrecondition once leadership moves.
+func TestWatchPartition(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure servers with a short max lag time so the ISR shrinks quickly.
+	var servers []*Server
+	for i, id := range []string{"a", "b", "c"} {
+		config := getTestConfig(id, i == 0, 5050+i)
+		config.Clustering.ReplicaMaxLagTime = time.Second
+		config.Clustering.ReplicaFetchTimeout = 100 * time.Millisecond
+		config.Clustering.ReplicaMaxIdleWait = 500 * time.Millisecond
+		config.Clustering.ReplicaMaxLeaderTimeout = time.Second
+		config.Streams.PartitionEventsMaxRate = 10
+		s := runServerWithConfig(t, config)
+		defer s.Stop()
+		servers = append(servers, s)
+	}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	client, err := lift.Connect([]string{"localhost:5050", "localhost:5051", "localhost:5052"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	require.NoError(t, client.CreateStream(context.Background(), name, name, lift.ReplicationFactor(3)))
+	waitForISR(t, 10*time.Second, name, 0, 3, servers...)
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+
+	conns := make(map[*Server]*grpc.ClientConn)
+	for _, s := range servers {
+		conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", s.config.Port), grpc.WithInsecure())
+		require.NoError(t, err)
+		defer conn.Close()
+		conns[s] = conn
+	}
+	watch := func(s *Server, maxEventsPerSecond int32) proto.AdminAPI_WatchPartitionClient {
+		w, err := proto.NewAdminAPIClient(conns[s]).WatchPartition(context.Background(),
+			&proto.WatchPartitionRequest{
+				Stream:             name,
+				MaxEventsPerSecond: maxEventsPerSecond,
+			})
+		require.NoError(t, err)
+		return w
+	}
+
+	// Only the partition leader can be watched.
+	var followers []*Server
+	for _, s := range servers {
+		if s != leader {
+			followers = append(followers, s)
+		}
+	}
+	_, err = watch(followers[0], 0).Recv()
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	bar, err := proto.NewAdminAPIClient(conns[leader]).WatchPartition(context.Background(),
+		&proto.WatchPartitionRequest{Stream: "bar"})
+	require.NoError(t, err)
+	_, err = bar.Recv()
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	w := watch(leader, 5)
+	partition := leader.metadata.GetPartition(name, 0)
+	require.Eventually(t, func() bool {
+		partition.watchersMu.Lock()
+		defer partition.watchersMu.Unlock()
+		return len(partition.watchers) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Changes made in quick succession are coalesced.
+	var (
+		numMessages = 100
+		start       = time.Now()
+	)
+	for i := 0; i < numMessages; i++ {
+		_, err := client.Publish(context.Background(), name, []byte("hello"), lift.AckPolicyAll())
+		require.NoError(t, err)
+	}
+	event, received := recvPartitionEvent(t, w, proto.PartitionEventType_HW_ADVANCED,
+		func(event *proto.PartitionEvent) bool {
+			return event.HighWatermark == int64(numMessages-1)
+		})
+	require.Equal(t, int64(numMessages-1), event.NewestOffset)
+	require.LessOrEqual(t, received, int(time.Since(start)/(200*time.Millisecond))+1)
+	require.Less(t, received, numMessages)
+
+	require.NoError(t, client.SetStreamReadonly(context.Background(), name))
+	event, _ = recvPartitionEvent(t, w, proto.PartitionEventType_READONLY_CHANGED,
+		func(event *proto.PartitionEvent) bool { return event.Readonly })
+	require.Equal(t, name, event.Stream)
+
+	// Stopping a follower shrinks the ISR.
+	followers[1].Stop()
+	recvPartitionEvent(t, w, proto.PartitionEventType_ISR_UPDATED,
+		func(event *proto.PartitionEvent) bool {
+			return len(event.Isr) == 2 &&
+				isrContains(followers[0].config.Clustering.ServerID, event.Isr) &&
+				!isrContains(followers[1].config.Clustering.ServerID, event.Isr)
+		})
+
+	// Moving leadership ends the watch.
+	_, err = proto.NewAdminAPIClient(conns[leader]).TransferLeader(context.Background(),
+		&proto.TransferLeaderRequest{
+			Stream:                name,
+			TargetBroker:          followers[0].config.Clustering.ServerID,
+			WaitForLeaderTransfer: true,
+		})
+	require.NoError(t, err)
+	for {
+		_, err = w.Recv()
+		if err != nil {
+			break
+		}
+	}
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The new leader can be watched and reports its new leader epoch along
+	// with any other change.
+	w = watch(followers[0], 0)
+	partition = followers[0].metadata.GetPartition(name, 0)
+	require.Eventually(t, func() bool {
+		partition.watchersMu.Lock()
+		defer partition.watchersMu.Unlock()
+		return len(partition.watchers) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, client.SetStreamReadonly(context.Background(), name, lift.Readonly(false)))
+	event, _ = recvPartitionEvent(t, w, proto.PartitionEventType_READONLY_CHANGED,
+		func(event *proto.PartitionEvent) bool { return !event.Readonly })
+	_, epoch := partition.GetLeader()
+	require.Equal(t, epoch, event.LeaderEpoch)
+}
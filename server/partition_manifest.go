@@ -0,0 +1,119 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	atomic_file "github.com/natefinch/atomic"
+	"github.com/pkg/errors"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	partitionManifestFileName = "partition-manifest"
+	quarantineDir             = "quarantine"
+)
+
+// partitionManifest identifies the stream a partition directory belongs to.
+// Streams can be deleted and recreated with the same name, so the stream's
+// creation timestamp is used to tell incarnations apart.
+type partitionManifest struct {
+	Stream            string `json:"stream"`
+	Partition         int32  `json:"partition"`
+	CreationTimestamp int64  `json:"creationTimestamp"`
+}
+
+// partitionDir returns the directory containing the given partition's data.
+func (s *Server) partitionDir(stream string, id int32) string {
+	return filepath.Join(s.config.DataDir, "streams", stream, strconv.FormatInt(int64(id), 10))
+}
+
+// readPartitionManifest reads the manifest in the given partition directory.
+// It returns nil if there is no manifest.
+func readPartitionManifest(dir string) (*partitionManifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, partitionManifestFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	manifest := new(partitionManifest)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Wrap(err, "invalid partition manifest")
+	}
+	return manifest, nil
+}
+
+// writePartitionManifest atomically writes the manifest to the given partition
+// directory, creating the directory if needed.
+func writePartitionManifest(dir string, manifest *partitionManifest) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	return atomic_file.WriteFile(filepath.Join(dir, partitionManifestFileName), bytes.NewReader(data))
+}
+
+// preparePartitionDir makes sure any existing data in the partition's
+// directory belongs to the stream incarnation with the given creation
+// timestamp before the partition's commit log is opened. This is what makes
+// re-applying a CreateStream operation safe when the data is already on disk,
+// e.g. when a broker is restored from a backup of its data directory:
+//
+//   - If the manifest matches, or there is no manifest because the data
+//     predates manifests, the directory is adopted as is.
+//   - If the manifest is newer and the operation is being recovered, the
+//     directory belongs to a later incarnation of the stream which the Raft
+//     log replay will recreate, so it's left alone.
+//   - Otherwise the directory belongs to an incarnation of the stream which
+//     has since been deleted, so it's moved to the quarantine directory
+//     rather than served as the new stream's data.
+func (s *Server) preparePartitionDir(protoPartition *proto.Partition, creationTimestamp int64,
+	recovered bool) error {
+
+	var (
+		dir      = s.partitionDir(protoPartition.Stream, protoPartition.Id)
+		expected = &partitionManifest{
+			Stream:            protoPartition.Stream,
+			Partition:         protoPartition.Id,
+			CreationTimestamp: creationTimestamp,
+		}
+	)
+	manifest, err := readPartitionManifest(dir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read manifest for partition %d of stream %s",
+			protoPartition.Id, protoPartition.Stream)
+	}
+
+	switch {
+	case manifest == nil, manifest.CreationTimestamp == creationTimestamp:
+	case recovered && manifest.CreationTimestamp > creationTimestamp:
+		return nil
+	default:
+		quarantined := filepath.Join(s.config.DataDir, quarantineDir, protoPartition.Stream,
+			fmt.Sprintf("%d-%d", protoPartition.Id, time.Now().UnixNano()))
+		if err := os.MkdirAll(filepath.Dir(quarantined), 0755); err != nil {
+			return err
+		}
+		if err := os.Rename(dir, quarantined); err != nil {
+			return errors.Wrapf(err, "failed to quarantine partition %d of stream %s",
+				protoPartition.Id, protoPartition.Stream)
+		}
+		s.logger.Warnf("Moved data for partition %d of stream %s created at %d to %s, "+
+			"it does not belong to the stream created at %d",
+			protoPartition.Id, protoPartition.Stream, manifest.CreationTimestamp,
+			quarantined, creationTimestamp)
+	}
+
+	return writePartitionManifest(dir, expected)
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// replicaStatus returns this server's view of the partition. The given
+// tombstone flag indicates if the partition's stream is being deleted.
+func (p *partition) replicaStatus(tombstoned bool) *proto.PartitionReplicaStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	st := &proto.PartitionReplicaStatus{
+		Broker:      p.srv.config.Clustering.ServerID,
+		IsLeader:    p.isLeading,
+		Readonly:    p.log.IsReadonly(),
+		Leader:      p.Leader,
+		LeaderEpoch: p.LeaderEpoch,
+		// Used by the controller to prefer the most caught up replica when
+		// electing a new leader.
+		LogEndOffset:   p.log.NewestOffset() + 1,
+		LogLeaderEpoch: p.log.LastLeaderEpoch(),
+		HighWatermark:  p.log.HighWatermark(),
+		LogStartOffset: p.log.LogStartOffset(),
+		SizeBytes:      p.log.Size(),
+	}
+	if changed := p.leaderTimestamps.latestTime; !changed.IsZero() {
+		st.LeaderChangedAt = changed.UnixNano()
+	}
+	switch {
+	case tombstoned:
+		st.State = proto.PartitionState_PARTITION_STATE_TOMBSTONED
+	case p.paused:
+		st.State = proto.PartitionState_PARTITION_STATE_PAUSED
+	case p.recovered:
+		st.State = proto.PartitionState_PARTITION_STATE_RECOVERING
+	case !p.isLeading && !p.isFollowing:
+		st.State = proto.PartitionState_PARTITION_STATE_CREATING
+	case st.Readonly:
+		st.State = proto.PartitionState_PARTITION_STATE_READONLY
+	case p.isLeading:
+		st.State = proto.PartitionState_PARTITION_STATE_LEADER
+	default:
+		st.State = proto.PartitionState_PARTITION_STATE_FOLLOWER
+	}
+	return st
+}
+
+// localPartitionStatus returns this server's view of the given partition.
+func (m *metadataAPI) localPartitionStatus(streamName string, id int32) *proto.PartitionReplicaStatus {
+	stream := m.GetStream(streamName)
+	if stream == nil {
+		return &proto.PartitionReplicaStatus{Broker: m.config.Clustering.ServerID}
+	}
+	partition := stream.GetPartition(id)
+	if partition == nil {
+		return &proto.PartitionReplicaStatus{Broker: m.config.Clustering.ServerID}
+	}
+	return partition.replicaStatus(stream.IsTombstoned())
+}
+
+// FetchPartitionStatus returns the status of the given partition on this
+// server or, if requested, on each of its replicas. Replicas are asked for
+// their status over their partition status inboxes in parallel. A replica
+// which can't be reached is reported with the error rather than failing the
+// request.
+func (m *metadataAPI) FetchPartitionStatus(ctx context.Context, req *proto.FetchPartitionStatusRequest) (
+	*proto.FetchPartitionStatusResponse, *status.Status) {
+
+	local := m.localPartitionStatus(req.Stream, req.Partition)
+	if !req.AllReplicas {
+		return &proto.FetchPartitionStatusResponse{
+			Replicas: []*proto.PartitionReplicaStatus{local},
+		}, nil
+	}
+
+	partition := m.GetPartition(req.Stream, req.Partition)
+	if partition == nil {
+		return nil, status.Newf(codes.NotFound, "No such partition [stream=%s, partition=%d]",
+			req.Stream, req.Partition)
+	}
+
+	var (
+		replicas = partition.GetReplicas()
+		resp     = &proto.FetchPartitionStatusResponse{
+			Replicas: make([]*proto.PartitionReplicaStatus, len(replicas)),
+		}
+		wg sync.WaitGroup
+	)
+	sort.Strings(replicas)
+	for i, replica := range replicas {
+		if replica == m.config.Clustering.ServerID {
+			resp.Replicas[i] = local
+			continue
+		}
+		wg.Add(1)
+		go func(i int, replica string) {
+			defer wg.Done()
+			resp.Replicas[i] = m.fetchReplicaStatus(ctx, replica, req.Stream, req.Partition)
+		}(i, replica)
+	}
+	wg.Wait()
+
+	return resp, nil
+}
+
+// fetchReplicaStatus requests the status of the given partition from the
+// given replica.
+func (m *metadataAPI) fetchReplicaStatus(ctx context.Context, replica, stream string,
+	partitionID int32) *proto.PartitionReplicaStatus {
+
+	failed := func(err string) *proto.PartitionReplicaStatus {
+		return &proto.PartitionReplicaStatus{Broker: replica, Error: err}
+	}
+
+	req, err := proto.MarshalPartitionStatusRequest(&proto.PartitionStatusRequest{
+		Stream:    stream,
+		Partition: partitionID,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, partitionStatusTimeout)
+	defer cancel()
+	resp, err := m.ncRaft.RequestWithContext(ctx, m.getPartitionStatusInbox(replica), m.auth.sign(req))
+	if err != nil {
+		return failed(err.Error())
+	}
+	if !m.auth.verify(resp.Subject, resp.Data) {
+		return failed("unauthenticated status response")
+	}
+	statusResp, err := proto.UnmarshalPartitionStatusResponse(resp.Data)
+	if err != nil {
+		return failed(err.Error())
+	}
+	if statusResp.Status == nil {
+		return failed("broker doesn't report partition status")
+	}
+	return statusResp.Status
+}
+
+// mostCaughtUpReplicas asks the given candidates for their view of the
+// partition in parallel and returns those whose logs are the furthest along,
+// i.e. with the highest leader epoch of their last message and, among those,
+// the highest log end offset, sorted by ID. Candidates which can't be reached
+// are excluded. If none of them can be, all candidates are returned.
+func (m *metadataAPI) mostCaughtUpReplicas(ctx context.Context, partition *partition,
+	candidates []string) []string {
+
+	var (
+		statuses = make([]*proto.PartitionReplicaStatus, len(candidates))
+		wg       sync.WaitGroup
+	)
+	for i, candidate := range candidates {
+		if candidate == m.config.Clustering.ServerID {
+			statuses[i] = m.localPartitionStatus(partition.Stream, partition.Id)
+			continue
+		}
+		wg.Add(1)
+		go func(i int, candidate string) {
+			defer wg.Done()
+			statuses[i] = m.fetchReplicaStatus(ctx, candidate, partition.Stream, partition.Id)
+		}(i, candidate)
+	}
+	wg.Wait()
+
+	return mostCaughtUp(statuses, candidates)
+}
+
+// mostCaughtUp returns the brokers of the given replica statuses with the
+// highest log leader epoch and log end offset, sorted by ID. Statuses with an
+// error are ignored. If all of them have one, the fallback brokers are
+// returned instead.
+func mostCaughtUp(statuses []*proto.PartitionReplicaStatus, fallback []string) []string {
+	var (
+		best []string
+		top  *proto.PartitionReplicaStatus
+	)
+	for _, st := range statuses {
+		if st.Error != "" {
+			continue
+		}
+		switch {
+		case top == nil, st.LogLeaderEpoch > top.LogLeaderEpoch,
+			st.LogLeaderEpoch == top.LogLeaderEpoch && st.LogEndOffset > top.LogEndOffset:
+			best = []string{st.Broker}
+			top = st
+		case st.LogLeaderEpoch == top.LogLeaderEpoch && st.LogEndOffset == top.LogEndOffset:
+			best = append(best, st.Broker)
+		}
+	}
+	if len(best) == 0 {
+		best = append([]string{}, fallback...)
+	}
+	sort.Strings(best)
+	return best
+}
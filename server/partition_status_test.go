@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure FetchPartitionStatus reports the partition's state on the responding
+// broker and, when requested, on each of its replicas.
+func TestFetchPartitionStatus(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	servers := []*Server{s1, s2}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	lc, err := lift.Connect([]string{"localhost:5050", "localhost:5051"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	name := "foo"
+	require.NoError(t, lc.CreateStream(context.Background(), name, name, lift.ReplicationFactor(2)))
+	waitForISR(t, 10*time.Second, name, 0, 2, servers...)
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+
+	admin := make(map[*Server]proto.AdminAPIClient)
+	for _, s := range servers {
+		conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", s.config.Port), grpc.WithInsecure())
+		require.NoError(t, err)
+		defer conn.Close()
+		admin[s] = proto.NewAdminAPIClient(conn)
+	}
+	fetch := func(s *Server, partition int32, allReplicas bool) []*proto.PartitionReplicaStatus {
+		resp, err := admin[s].FetchPartitionStatus(context.Background(), &proto.FetchPartitionStatusRequest{
+			Stream:      name,
+			Partition:   partition,
+			AllReplicas: allReplicas,
+		})
+		require.NoError(t, err)
+		return resp.Replicas
+	}
+	expectedState := func(s *Server) proto.PartitionState {
+		if s == leader {
+			return proto.PartitionState_PARTITION_STATE_LEADER
+		}
+		return proto.PartitionState_PARTITION_STATE_FOLLOWER
+	}
+
+	// Each broker reports its own view and role.
+	for _, s := range servers {
+		statuses := fetch(s, 0, false)
+		require.Len(t, statuses, 1)
+		st := statuses[0]
+		require.Equal(t, s.config.Clustering.ServerID, st.Broker)
+		require.Equal(t, expectedState(s), st.State)
+		require.Equal(t, s == leader, st.IsLeader)
+		require.Equal(t, leader.config.Clustering.ServerID, st.Leader)
+		require.True(t, st.LeaderChangedAt > 0)
+		require.Empty(t, st.Error)
+	}
+
+	// Any broker can aggregate the status of every replica.
+	for _, s := range servers {
+		statuses := fetch(s, 0, true)
+		require.Len(t, statuses, 2)
+		for i, replica := range servers {
+			require.Equal(t, replica.config.Clustering.ServerID, statuses[i].Broker)
+			require.Equal(t, expectedState(replica), statuses[i].State)
+			require.Empty(t, statuses[i].Error)
+		}
+	}
+
+	// Unknown partitions are reported as not found.
+	statuses := fetch(s1, 1, false)
+	require.Equal(t, proto.PartitionState_PARTITION_STATE_NOT_FOUND, statuses[0].State)
+	_, err = admin[s1].FetchPartitionStatus(context.Background(), &proto.FetchPartitionStatusRequest{
+		Stream:      name,
+		Partition:   1,
+		AllReplicas: true,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Readonly and paused partitions are reported as such on every replica.
+	require.NoError(t, lc.SetStreamReadonly(context.Background(), name))
+	require.Eventually(t, func() bool {
+		for _, st := range fetch(s1, 0, true) {
+			if st.State != proto.PartitionState_PARTITION_STATE_READONLY || !st.Readonly {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, lc.PauseStream(context.Background(), name))
+	require.Eventually(t, func() bool {
+		for _, st := range fetch(s1, 0, true) {
+			if st.State != proto.PartitionState_PARTITION_STATE_PAUSED {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// Ensure mostCaughtUp prefers the replicas with the highest log leader epoch
+// and log end offset, ignores unreachable ones, and falls back to every
+// candidate if none can be reached.
+func TestMostCaughtUp(t *testing.T) {
+	statuses := []*proto.PartitionReplicaStatus{
+		{Broker: "d", LogLeaderEpoch: 2, LogEndOffset: 10},
+		{Broker: "c", LogLeaderEpoch: 3, LogEndOffset: 5},
+		{Broker: "b", LogLeaderEpoch: 3, LogEndOffset: 5},
+		{Broker: "a", LogLeaderEpoch: 3, LogEndOffset: 4},
+		{Broker: "e", Error: "timeout"},
+	}
+	fallback := []string{"d", "c", "b", "a", "e"}
+	require.Equal(t, []string{"b", "c"}, mostCaughtUp(statuses, fallback))
+
+	statuses[3].LogEndOffset = 6
+	require.Equal(t, []string{"a"}, mostCaughtUp(statuses, fallback))
+
+	unreachable := []*proto.PartitionReplicaStatus{
+		{Broker: "b", Error: "timeout"},
+		{Broker: "a", Error: "timeout"},
+	}
+	require.Equal(t, []string{"a", "b"}, mostCaughtUp(unreachable, []string{"b", "a"}))
+}
@@ -2,6 +2,8 @@ package server
 
 import (
 	"context"
+	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
 	proto "github.com/liftbridge-io/liftbridge/server/protocol"
 )
 
@@ -564,6 +567,119 @@ func TestPartitionReplicationRequestLoopPreempt(t *testing.T) {
 	}
 }
 
+// Ensure pipelining replication requests hides the round-trip time to the
+// leader: with a high-latency leader, a follower keeping four requests in
+// flight catches up around four times faster than one sending a request at a
+// time.
+func TestPartitionReplicationRequestLoopPipelined(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Start Liftbridge server.
+	config := getTestConfig("a", true, 5050)
+	// Keep batches small so that catching up takes many requests.
+	config.Clustering.ReplicationMaxBytes = 1024
+	config.Clustering.ReplicaMaxIdleWait = time.Hour
+	server := runServerWithConfig(t, config)
+	defer server.Stop()
+
+	// Create NATS connection.
+	nc, err := nats.GetDefaultOptions().Connect()
+	require.NoError(t, err)
+	defer nc.Close()
+
+	const (
+		numMsgs = 800
+		latency = 20 * time.Millisecond
+	)
+
+	catchUp := func(depth int) time.Duration {
+		server.config.Clustering.ReplicaFetchPipelineDepth = depth
+		name := "foo-" + strconv.Itoa(depth)
+
+		// Set up the leader's log.
+		leader, err := server.newPartition(&proto.Partition{
+			Subject:     name + "-leader",
+			Stream:      name + "-leader",
+			Replicas:    []string{"a", "b"},
+			Leader:      "b",
+			Isr:         []string{"a", "b"},
+			LeaderEpoch: 1,
+		}, false, nil)
+		require.NoError(t, err)
+		defer leader.Close()
+		for i := 0; i < numMsgs; i++ {
+			_, err := leader.log.Append([]*commitlog.Message{
+				{Value: make([]byte, 64), LeaderEpoch: 1},
+			})
+			require.NoError(t, err)
+		}
+		leader.log.SetHighWatermark(numMsgs - 1)
+
+		follower, err := server.newPartition(&proto.Partition{
+			Subject:     name,
+			Stream:      name,
+			Replicas:    []string{"a", "b"},
+			Leader:      "b",
+			Isr:         []string{"a", "b"},
+			LeaderEpoch: 1,
+		}, false, nil)
+		require.NoError(t, err)
+		defer follower.Close()
+		follower.mu.Lock()
+		follower.isFollowing = true
+		follower.stopFollower = make(chan struct{})
+		follower.mu.Unlock()
+
+		stop := make(chan struct{})
+		defer close(stop)
+
+		// Set up mock leader which passes requests to a replicator for the
+		// leader's log after a delay, in the order they were received.
+		replicator := newReplicator(1, "a", leader)
+		go replicator.start(stop)
+		delayed := make(chan replicationRequest, 16)
+		go func() {
+			for {
+				select {
+				case req := <-delayed:
+					time.Sleep(time.Until(req.received.Add(latency)))
+					req.received = time.Now()
+					replicator.request(req)
+				case <-stop:
+					return
+				}
+			}
+		}()
+		sub, err := nc.Subscribe(follower.getReplicationRequestInbox(), func(msg *nats.Msg) {
+			req, err := proto.UnmarshalReplicationRequest(msg.Data)
+			if err != nil {
+				t.Errorf("Invalid replication request: %v", err)
+				return
+			}
+			delayed <- replicationRequest{req, msg, time.Now()}
+		})
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+		require.NoError(t, nc.Flush())
+
+		start := time.Now()
+		go follower.replicationRequestLoop("b", 1, stop)
+		deadline := start.Add(30 * time.Second)
+		for follower.log.NewestOffset() < numMsgs-1 {
+			if time.Now().After(deadline) {
+				t.Fatalf("Follower did not catch up with depth %d", depth)
+			}
+			time.Sleep(time.Millisecond)
+		}
+		return time.Since(start)
+	}
+
+	sequential := catchUp(1)
+	pipelined := catchUp(4)
+	require.Greater(t, sequential.Seconds()/pipelined.Seconds(), 3.0,
+		"sequential: %s, pipelined: %s", sequential, pipelined)
+}
+
 // Ensure that a new partition can be created with custom StreamConfig.
 func TestPartitionWithCustomConfigNoError(t *testing.T) {
 	defer cleanupStorage(t)
@@ -619,6 +735,9 @@ func TestPartitionAutoPause(t *testing.T) {
 			default:
 			}
 			nc.Publish(subject, nil)
+			// Don't publish faster than the server can keep up, otherwise
+			// it keeps the partition active draining the backlog.
+			time.Sleep(time.Millisecond)
 		}
 	}()
 
@@ -672,6 +791,9 @@ func TestPartitionAutoPauseDisableIfSubscribers(t *testing.T) {
 			default:
 			}
 			nc.Publish(subject, nil)
+			// Don't publish faster than the server can keep up, otherwise
+			// it keeps the partition active draining the backlog.
+			time.Sleep(time.Millisecond)
 		}
 	}()
 
@@ -696,6 +818,60 @@ func TestPartitionAutoPauseDisableIfSubscribers(t *testing.T) {
 	waitForPartition(t, time.Second, name, 0)
 }
 
+// Ensure partitions that are repeatedly auto paused and resumed by a publish
+// keep broker load counts accurate.
+func TestPartitionAutoPauseResumeLoadCounts(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Streams.AutoPauseTime = 500 * time.Millisecond
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Wait for server to elect itself leader.
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	err = client.CreateStream(context.Background(), "foo", name, lift.Partitions(2))
+	require.NoError(t, err)
+
+	// Partitions are marked paused before they stop leading, and the counts
+	// are only updated after, so wait for them.
+	checkLoad := func(expected int) {
+		id := s1.config.Clustering.ServerID
+		require.Eventually(t, func() bool {
+			return s1.metadata.BrokerPartitionCounts()[id] == expected &&
+				s1.metadata.BrokerLeaderCounts()[id] == expected
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	for i := 0; i < 3; i++ {
+		// Wait for both partitions to pause.
+		waitForPause(t, 5*time.Second, s1.metadata.GetPartition(name, 0))
+		waitForPause(t, 5*time.Second, s1.metadata.GetPartition(name, 1))
+		checkLoad(0)
+
+		// Publishing should resume the partition.
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		_, err = client.Publish(ctx, name, []byte("hello"), lift.ToPartition(0))
+		cancel()
+		require.NoError(t, err)
+		require.False(t, s1.metadata.GetPartition(name, 0).IsPaused())
+		require.True(t, s1.metadata.GetPartition(name, 1).IsPaused())
+		checkLoad(1)
+
+		// Pausing an already paused partition should not change the counts.
+		err = client.PauseStream(context.Background(), name, lift.PausePartitions(1))
+		require.NoError(t, err)
+		checkLoad(1)
+	}
+}
+
 // Ensure computeTick correctly computes the sleep time for the tick loop based
 // on the elapsed time.
 func TestComputeTick(t *testing.T) {
@@ -745,3 +921,79 @@ func TestPartitionBecomeLeaderNotInISR(t *testing.T) {
 	require.Contains(t, p.GetISR(), "a")
 	require.Contains(t, p.GetISR(), "b")
 }
+
+// Ensure the producer state is rebuilt from the log and that a checkpoint is
+// only used if it doesn't reflect messages past the high watermark.
+func TestLoadProducerState(t *testing.T) {
+	dir := t.TempDir()
+	log, err := commitlog.New(commitlog.Options{Path: filepath.Join(dir, "log")})
+	require.NoError(t, err)
+	defer log.Close()
+
+	producerMsg := func(producerID string, sequence int) *commitlog.Message {
+		return &commitlog.Message{
+			Value:     []byte("foo"),
+			Timestamp: time.Now().UnixNano(),
+			Headers: map[string][]byte{
+				producerIDHeader:       []byte(producerID),
+				producerSequenceHeader: []byte(strconv.Itoa(sequence)),
+			},
+		}
+	}
+	_, err = log.Append([]*commitlog.Message{
+		producerMsg("p1", 0),
+		producerMsg("p2", 0),
+		{Value: []byte("bar"), Timestamp: time.Now().UnixNano()},
+		producerMsg("p1", 1),
+	})
+	require.NoError(t, err)
+	log.SetHighWatermark(3)
+
+	file := filepath.Join(dir, producerStateFileName)
+	state, err := loadProducerState(file, log)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), state.lastSequence("p1"))
+	require.Equal(t, int64(0), state.lastSequence("p2"))
+	require.Equal(t, int64(-1), state.lastSequence("p3"))
+	offset, ok := state.sequenceOffset("p1", 1)
+	require.True(t, ok)
+	require.Equal(t, int64(3), offset)
+
+	// Checkpoint the state, then append more messages which should be
+	// replayed on top of it.
+	require.NoError(t, state.checkpoint(file))
+	_, err = log.Append([]*commitlog.Message{producerMsg("p2", 1)})
+	require.NoError(t, err)
+	log.SetHighWatermark(4)
+
+	state, err = loadProducerState(file, log)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), state.lastSequence("p1"))
+	require.Equal(t, int64(1), state.lastSequence("p2"))
+
+	// A checkpoint past the high watermark is ignored and the state is
+	// rebuilt from the log instead.
+	stale := newProducerState()
+	stale.record("p1", 10, 10)
+	require.NoError(t, stale.checkpoint(file))
+
+	state, err = loadProducerState(file, log)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), state.lastSequence("p1"))
+	require.Equal(t, int64(1), state.lastSequence("p2"))
+}
+
+// Ensure only the most recent sequences of a producer are remembered.
+func TestProducerStateWindow(t *testing.T) {
+	state := newProducerState()
+	for i := 0; i < producerSequenceWindow+3; i++ {
+		state.record("p1", int64(i), int64(i*2))
+	}
+	require.Equal(t, int64(producerSequenceWindow+2), state.lastSequence("p1"))
+
+	_, ok := state.sequenceOffset("p1", 2)
+	require.False(t, ok)
+	offset, ok := state.sequenceOffset("p1", 3)
+	require.True(t, ok)
+	require.Equal(t, int64(6), offset)
+}
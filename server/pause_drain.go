@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// drainBeforePauseMetadataKey is the gRPC metadata key used to request
+	// that subscribers drain the partitions before they're paused since
+	// PauseStreamRequest has no field for it.
+	drainBeforePauseMetadataKey = "liftbridge-drain-before-pause"
+
+	// consumerDrainPollInterval is how often WaitForConsumerDrain checks if
+	// the subscribers have drained the partition.
+	consumerDrainPollInterval = 10 * time.Millisecond
+)
+
+// ErrConsumerDrainTimeout is returned by WaitForConsumerDrain when the
+// subscribers haven't drained the partition in time.
+var ErrConsumerDrainTimeout = errors.New("timed out waiting for subscribers to drain partition")
+
+// drainBeforePauseFromContext indicates if the incoming gRPC metadata of the
+// given context requests draining the partitions before pausing them.
+func drainBeforePauseFromContext(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	values := md.Get(drainBeforePauseMetadataKey)
+	switch len(values) {
+	case 0:
+		return false, nil
+	case 1:
+		drain, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return false, fmt.Errorf("invalid %s: %v", drainBeforePauseMetadataKey, err)
+		}
+		return drain, nil
+	default:
+		return false, fmt.Errorf("only one %s can be set", drainBeforePauseMetadataKey)
+	}
+}
+
+// WaitForConsumerDrain waits until every message written to the partition is
+// committed and each active subscription has read up to the HW. It returns
+// ErrConsumerDrainTimeout if this doesn't happen within the timeout or the
+// context's error if it's done first.
+func (p *partition) WaitForConsumerDrain(ctx context.Context, timeout time.Duration) error {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(consumerDrainPollInterval)
+	defer ticker.Stop()
+	for !p.isConsumerDrained() {
+		select {
+		case <-ticker.C:
+		case <-deadline.C:
+			return ErrConsumerDrainTimeout
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// isConsumerDrained indicates if the partition's messages are all committed
+// and its active subscriptions have read up to the HW.
+func (p *partition) isConsumerDrained() bool {
+	hw := p.log.HighWatermark()
+	if p.log.NewestOffset() != hw {
+		return false
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for sub := range p.subscriptions {
+		if atomic.LoadInt64(&sub.lastOffset) < hw {
+			return false
+		}
+	}
+	return true
+}
+
+// drainBeforePause waits for the subscribers of the partitions to pause which
+// this server leads to drain them, sharing the configured drain timeout
+// between them. Partitions which don't drain in time are paused anyway.
+func (m *metadataAPI) drainBeforePause(ctx context.Context, req *proto.PauseStreamOp) {
+	stream := m.GetStream(req.Stream)
+	if stream == nil {
+		return
+	}
+	partitions := req.Partitions
+	if len(partitions) == 0 {
+		for _, partition := range stream.GetPartitions() {
+			partitions = append(partitions, partition.Id)
+		}
+	}
+	deadline := time.Now().Add(m.config.Streams.PauseDrainTimeout)
+	for _, id := range partitions {
+		partition := stream.GetPartition(id)
+		if partition == nil || !partition.IsLeader() || partition.IsPaused() {
+			continue
+		}
+		if err := partition.WaitForConsumerDrain(ctx, time.Until(deadline)); err != nil {
+			m.logger.Warnf("Pausing partition %s before its subscribers drained it: %v", partition, err)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+// Ensure drainBeforePauseFromContext parses the drain flag from the request
+// metadata.
+func TestDrainBeforePauseFromContext(t *testing.T) {
+	drain, err := drainBeforePauseFromContext(context.Background())
+	require.NoError(t, err)
+	require.False(t, drain)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		drainBeforePauseMetadataKey, "true"))
+	drain, err = drainBeforePauseFromContext(ctx)
+	require.NoError(t, err)
+	require.True(t, drain)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		drainBeforePauseMetadataKey, "foo"))
+	_, err = drainBeforePauseFromContext(ctx)
+	require.Error(t, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		drainBeforePauseMetadataKey, "true", drainBeforePauseMetadataKey, "true"))
+	_, err = drainBeforePauseFromContext(ctx)
+	require.Error(t, err)
+}
+
+// Ensure a drained pause waits for a slow subscriber to read every message
+// before pausing the partition, and a subscriber which doesn't keep up only
+// delays the pause until the drain timeout.
+func TestPauseStreamDrainBeforePause(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Streams.PauseDrainTimeout = time.Second
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	lc, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	const count = 20
+	for _, name := range []string{"foo", "bar"} {
+		require.NoError(t, lc.CreateStream(context.Background(), name, name))
+		for i := 0; i < count; i++ {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			_, err := lc.Publish(ctx, name, []byte(fmt.Sprintf("msg-%d", i)), lift.AckPolicyAll())
+			cancel()
+			require.NoError(t, err)
+		}
+	}
+	drainCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		drainBeforePauseMetadataKey, "true"))
+
+	subscribe := func(ctx context.Context, stream string) *subscription {
+		sub, err := s1.api.SubscribeInternal(ctx, &client.SubscribeRequest{
+			Stream:        stream,
+			StartPosition: client.StartPosition_EARLIEST,
+		})
+		require.NoError(t, err)
+		return sub
+	}
+
+	// Read slowly from a subscription to foo.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sub := subscribe(ctx, "foo")
+	defer sub.Close()
+	var received int64
+	go func() {
+		for {
+			select {
+			case <-sub.Messages():
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt64(&received, 1)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// Wait for the subscription to start reading.
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&received) > 0
+	}, 5*time.Second, 5*time.Millisecond)
+	_, err = s1.api.PauseStream(drainCtx, &client.PauseStreamRequest{Name: "foo"})
+	require.NoError(t, err)
+	require.True(t, s1.metadata.GetPartition("foo", 0).IsPaused())
+
+	// Every message was handed to the subscriber before the pause, so only
+	// the last one may still be processed.
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&received) == count
+	}, time.Second, 5*time.Millisecond)
+
+	// A subscriber which stops reading only delays the pause until the drain
+	// timeout.
+	stalled := subscribe(ctx, "bar")
+	defer stalled.Close()
+	<-stalled.Messages()
+	partition := s1.metadata.GetPartition("bar", 0)
+	require.Equal(t, ErrConsumerDrainTimeout,
+		partition.WaitForConsumerDrain(context.Background(), 50*time.Millisecond))
+
+	start := time.Now()
+	_, err = s1.api.PauseStream(drainCtx, &client.PauseStreamRequest{Name: "bar"})
+	require.NoError(t, err)
+	require.True(t, time.Since(start) >= time.Second)
+	require.True(t, s1.metadata.GetPartition("bar", 0).IsPaused())
+}
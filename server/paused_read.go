@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// readPausedMetadataKey is the gRPC metadata key used to read a paused
+// partition without resuming it since SubscribeRequest has no field for it.
+// It's ignored if the partition isn't paused or the subscription resumes it.
+const readPausedMetadataKey = "liftbridge-read-paused"
+
+// errPartitionNotPaused is returned when opening the commit log of a
+// partition for paused reads if the partition isn't paused or has been
+// resumed.
+var errPartitionNotPaused = errors.New("partition is not paused")
+
+// readPausedFromContext indicates if the incoming gRPC metadata of the given
+// context requests reading a paused partition without resuming it.
+func readPausedFromContext(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	values := md.Get(readPausedMetadataKey)
+	switch len(values) {
+	case 0:
+		return false, nil
+	case 1:
+		readPaused, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return false, fmt.Errorf("invalid %s: %v", readPausedMetadataKey, err)
+		}
+		return readPaused, nil
+	default:
+		return false, fmt.Errorf("only one %s can be set", readPausedMetadataKey)
+	}
+}
+
+// SubscribePaused sets up a subscription on the paused partition without
+// resuming it. The partition's commit log is opened in readonly mode, without
+// subscribing to NATS, starting the leader or follower loop, or cleaning the
+// log, and is closed again once the last subscription reading it ends.
+// Committed messages are read up to the HW stored when the partition was
+// paused, at which point the subscription ends. Consumer groups and reverse
+// subscriptions are not supported.
+func (p *partition) SubscribePaused(ctx context.Context, req *client.SubscribeRequest,
+	filter *messageFilter, config *proto.StreamConfig) (*subscription, *status.Status) {
+
+	if req.Consumer != nil && req.Consumer.GroupId != "" {
+		return nil, status.New(codes.InvalidArgument,
+			"Consumer groups not compatible with reading paused partitions")
+	}
+	if req.Reverse {
+		return nil, status.New(codes.InvalidArgument,
+			"Reverse not compatible with reading paused partitions")
+	}
+
+	log, err := p.acquirePausedLog(config)
+	if err == errPartitionNotPaused {
+		return nil, status.New(codes.FailedPrecondition, "Partition is not paused")
+	}
+	if err != nil {
+		return nil, status.New(
+			codes.Internal, fmt.Sprintf("Failed to open paused partition: %v", err))
+	}
+	// Release the log unless the subscription loop takes over doing so.
+	release := true
+	defer func() {
+		if release {
+			p.releasePausedLog()
+		}
+	}()
+
+	hw := log.HighWatermark()
+	if hw == -1 {
+		return nil, status.New(codes.ResourceExhausted, "Stream is empty")
+	}
+
+	startOffset, st := p.getStartOffset(log, req)
+	if st != nil {
+		return nil, st
+	}
+	if startOffset > hw {
+		return nil, status.New(codes.ResourceExhausted, fmt.Sprintf(
+			"Start offset is past the end of the paused partition: %d > %d", startOffset, hw))
+	}
+
+	stopOffset, st := p.getStopOffset(log, req)
+	if st != nil {
+		return nil, st
+	}
+	// Nothing is written to a paused partition, so bound the replay by the HW
+	// rather than waiting for new messages.
+	if stopOffset == waitForNewMessages || stopOffset > hw {
+		stopOffset = hw
+	}
+	if stopOffset < startOffset {
+		return nil, status.New(
+			codes.InvalidArgument, fmt.Sprintf("Stop offset is before start offset: %d < %d",
+				stopOffset, startOffset))
+	}
+
+	reader, err := log.NewReader(startOffset, false)
+	if err != nil {
+		return nil, status.New(
+			codes.Internal, fmt.Sprintf("Failed to create stream reader: %v", err))
+	}
+
+	sub := &subscription{
+		closed:     make(chan struct{}),
+		msgs:       make(chan *client.Message),
+		errors:     make(chan *status.Status),
+		filter:     filter,
+		lastOffset: -1,
+		delivered:  -1,
+		next:       startOffset,
+		gapStart:   -1,
+	}
+	loop := p.newSubscribeLoop(ctx, sub, reader, stopOffset, false)
+	release = false
+	p.srv.startGoroutine(func() {
+		defer p.releasePausedLog()
+		loop()
+	})
+
+	return sub, nil
+}
+
+// acquirePausedLog returns the partition's commit log opened in readonly mode
+// for a subscription reading the paused partition, opening it if no other
+// subscription is reading it. releasePausedLog must be called once the
+// subscription is done with it.
+func (p *partition) acquirePausedLog(config *proto.StreamConfig) (commitlog.CommitLog, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.paused || p.replaced {
+		return nil, errPartitionNotPaused
+	}
+	if p.pausedLog == nil {
+		log, err := p.srv.openPartitionLog(p.Partition, p.srv.getStreamsConfig(config), true)
+		if err != nil {
+			return nil, err
+		}
+		p.pausedLog = log
+	}
+	p.pausedReaders++
+	return p.pausedLog, nil
+}
+
+// releasePausedLog closes the commit log opened for paused reads once the last
+// subscription reading it is done, unless a resumed partition took it over.
+func (p *partition) releasePausedLog() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.pausedReaders--
+	if p.pausedReaders > 0 || p.pausedLog == nil {
+		return
+	}
+	if err := p.pausedLog.Close(); err != nil {
+		p.srv.logger.Errorf("Failed to close commit log of paused partition %s: %v", p, err)
+	}
+	p.pausedLog = nil
+}
+
+// takePausedLog marks the partition as replaced by a resumed partition and
+// returns its commit log if it's open for paused reads, in which case the
+// resumed partition takes it over instead of opening the log again.
+// Subscriptions reading it continue up to their stop offset.
+func (p *partition) takePausedLog() commitlog.CommitLog {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.replaced = true
+	log := p.pausedLog
+	p.pausedLog = nil
+	return log
+}
+
+// closePausedLog closes the commit log opened for paused reads, if any. If
+// deleted is true, the log is deleted so its subscriptions end with a not
+// found error. Must be called within the scope of the partition mutex.
+func (p *partition) closePausedLog(deleted bool) error {
+	if p.pausedLog == nil {
+		return nil
+	}
+	log := p.pausedLog
+	p.pausedLog = nil
+	if deleted {
+		return log.Delete()
+	}
+	return log.Close()
+}
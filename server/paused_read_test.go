@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+)
+
+// Ensure readPausedFromContext parses the liftbridge-read-paused metadata.
+func TestReadPausedFromContext(t *testing.T) {
+	readPaused, err := readPausedFromContext(context.Background())
+	require.NoError(t, err)
+	require.False(t, readPaused)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		readPausedMetadataKey, "true"))
+	readPaused, err = readPausedFromContext(ctx)
+	require.NoError(t, err)
+	require.True(t, readPaused)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		readPausedMetadataKey, "false"))
+	readPaused, err = readPausedFromContext(ctx)
+	require.NoError(t, err)
+	require.False(t, readPaused)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		readPausedMetadataKey, "yes please"))
+	_, err = readPausedFromContext(ctx)
+	require.Error(t, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		readPausedMetadataKey, "true", readPausedMetadataKey, "true"))
+	_, err = readPausedFromContext(ctx)
+	require.Error(t, err)
+}
+
+// getPausedLog returns the commit log opened for reading the given paused
+// partition, if any.
+func getPausedLog(p *partition) commitlog.CommitLog {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.pausedLog
+}
+
+// Ensure a paused partition can be read with liftbridge-read-paused without
+// resuming it, that the log is closed once the subscription ends, and that
+// resuming the partition while it's being read takes over the open log.
+func TestSubscribeReadPaused(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Wait for server to elect itself leader.
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	c, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer c.Close()
+
+	name := "foo"
+	require.NoError(t, c.CreateStream(context.Background(), name, name))
+	for i := 0; i < 5; i++ {
+		_, err := c.Publish(context.Background(), name, []byte(fmt.Sprintf("msg-%d", i)),
+			lift.AckPolicyAll())
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, c.PauseStream(context.Background(), name))
+	checkPartitionPaused(t, 5*time.Second, name, 0, true, s1)
+	paused := s1.metadata.GetPartition(name, 0)
+
+	// Messages published to the subject while paused aren't ingested.
+	require.NoError(t, s1.nc.Publish(name, []byte("ignored")))
+	require.NoError(t, s1.nc.Flush())
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	apiClient := client.NewAPIClient(conn)
+
+	subscribe := func(ctx context.Context) client.API_SubscribeClient {
+		ctx = metadata.AppendToOutgoingContext(ctx, readPausedMetadataKey, "true")
+		stream, err := apiClient.Subscribe(ctx, &client.SubscribeRequest{
+			Stream:        name,
+			StartPosition: client.StartPosition_EARLIEST,
+		})
+		require.NoError(t, err)
+		// The first message signals the subscription was created.
+		_, err = stream.Recv()
+		require.NoError(t, err)
+		return stream
+	}
+	receive := func(stream client.API_SubscribeClient, from, to int) {
+		for i := from; i < to; i++ {
+			msg, err := stream.Recv()
+			require.NoError(t, err)
+			require.Equal(t, int64(i), msg.Offset)
+			require.Equal(t, []byte(fmt.Sprintf("msg-%d", i)), msg.Value)
+		}
+	}
+
+	// Read the paused partition up to its HW.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := subscribe(ctx)
+	receive(stream, 0, 5)
+	_, err = stream.Recv()
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The partition is still paused and the log is closed once the
+	// subscription ends.
+	require.True(t, s1.metadata.GetPartition(name, 0).IsPaused())
+	require.Same(t, paused, s1.metadata.GetPartition(name, 0))
+	require.Eventually(t, func() bool {
+		return getPausedLog(paused) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Resume the partition while it's being read. Hold the log open since the
+	// subscription may have already read up to its stop offset.
+	pausedLog, err := paused.acquirePausedLog(nil)
+	require.NoError(t, err)
+	defer paused.releasePausedLog()
+	stream = subscribe(ctx)
+	receive(stream, 0, 1)
+	require.Same(t, pausedLog, getPausedLog(paused))
+	_, err = c.Publish(context.Background(), name, []byte("msg-5"), lift.AckPolicyAll())
+	require.NoError(t, err)
+	checkPartitionPaused(t, 5*time.Second, name, 0, false, s1)
+
+	// The resumed partition took over the log opened for reading.
+	resumed := s1.metadata.GetPartition(name, 0)
+	require.Same(t, pausedLog, resumed.log)
+	require.False(t, resumed.log.IsReadonly())
+	require.Nil(t, getPausedLog(paused))
+
+	// The paused read still ends at the HW it started with.
+	receive(stream, 1, 5)
+	_, err = stream.Recv()
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The resumed partition operates normally.
+	_, err = c.Publish(context.Background(), name, []byte("msg-6"), lift.AckPolicyAll())
+	require.NoError(t, err)
+	msgs := make(chan *lift.Message, 7)
+	err = c.Subscribe(ctx, name, func(msg *lift.Message, err error) {
+		require.NoError(t, err)
+		msgs <- msg
+	}, lift.StartAtEarliestReceived())
+	require.NoError(t, err)
+	for i := 0; i < 7; i++ {
+		select {
+		case msg := <-msgs:
+			require.Equal(t, int64(i), msg.Offset())
+			require.Equal(t, []byte(fmt.Sprintf("msg-%d", i)), msg.Value())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Did not receive message %d", i)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// preferredReplicasMetadataKey is the gRPC metadata key used to place the
+// partitions of a new stream on the given brokers rather than on the least
+// loaded ones, since CreateStreamRequest has no field for it. Each value is a
+// comma-separated list of broker IDs. A single value applies to every
+// partition, otherwise there must be one value per partition in partition
+// order.
+const preferredReplicasMetadataKey = "liftbridge-preferred-replicas"
+
+// preferredReplicasFromContext sets the preferred replicas of the given
+// partitions from the incoming gRPC metadata of the given context, if any.
+func preferredReplicasFromContext(ctx context.Context, partitions []*proto.Partition) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	values := md.Get(preferredReplicasMetadataKey)
+	if len(values) == 0 {
+		return nil
+	}
+	if len(values) != 1 && len(values) != len(partitions) {
+		return fmt.Errorf("%s must be set once or once per partition, got %d values for %d partitions",
+			preferredReplicasMetadataKey, len(values), len(partitions))
+	}
+	for i, partition := range partitions {
+		value := values[0]
+		if len(values) > 1 {
+			value = values[i]
+		}
+		replicas, err := parsePreferredReplicas(value)
+		if err != nil {
+			return err
+		}
+		partition.PreferredReplicas = replicas
+	}
+	return nil
+}
+
+// parsePreferredReplicas parses a comma-separated list of broker IDs, which
+// can't be empty or repeated.
+func parsePreferredReplicas(value string) ([]string, error) {
+	var (
+		replicas = strings.Split(value, ",")
+		seen     = make(map[string]struct{}, len(replicas))
+	)
+	for i, replica := range replicas {
+		replica = strings.TrimSpace(replica)
+		if replica == "" {
+			return nil, fmt.Errorf("%s can't contain an empty broker ID", preferredReplicasMetadataKey)
+		}
+		if _, ok := seen[replica]; ok {
+			return nil, fmt.Errorf("%s contains broker %s more than once", preferredReplicasMetadataKey, replica)
+		}
+		seen[replica] = struct{}{}
+		replicas[i] = replica
+	}
+	return replicas, nil
+}
+
+// selectPreferredReplicas returns the preferred replicas which are among the
+// given brokers, in the preferred order, followed by the first of the other
+// brokers in place of those which aren't. The brokers must be ordered by
+// partition load and include at least as many as there are preferred
+// replicas.
+func selectPreferredReplicas(ids, preferred []string) []string {
+	available := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		available[id] = struct{}{}
+	}
+	var (
+		replicas = make([]string, 0, len(preferred))
+		selected = make(map[string]struct{}, len(preferred))
+	)
+	for _, id := range preferred {
+		if _, ok := available[id]; ok {
+			replicas = append(replicas, id)
+			selected[id] = struct{}{}
+		}
+	}
+	for _, id := range ids {
+		if len(replicas) == len(preferred) {
+			break
+		}
+		if _, ok := selected[id]; !ok {
+			replicas = append(replicas, id)
+		}
+	}
+	return replicas
+}
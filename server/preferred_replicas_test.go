@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure preferredReplicasFromContext applies a single value to every
+// partition or one value to each partition, and rejects invalid lists.
+func TestPreferredReplicasFromContext(t *testing.T) {
+	parse := func(partitions int, values ...string) ([]*proto.Partition, error) {
+		md := metadata.MD{}
+		for _, value := range values {
+			md.Append(preferredReplicasMetadataKey, value)
+		}
+		ps := make([]*proto.Partition, partitions)
+		for i := range ps {
+			ps[i] = &proto.Partition{Id: int32(i)}
+		}
+		err := preferredReplicasFromContext(metadata.NewIncomingContext(context.Background(), md), ps)
+		return ps, err
+	}
+
+	partitions, err := parse(2)
+	require.NoError(t, err)
+	require.Nil(t, partitions[0].PreferredReplicas)
+
+	partitions, err = parse(2, "a, b")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, partitions[0].PreferredReplicas)
+	require.Equal(t, []string{"a", "b"}, partitions[1].PreferredReplicas)
+
+	partitions, err = parse(2, "a,b", "b,c")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, partitions[0].PreferredReplicas)
+	require.Equal(t, []string{"b", "c"}, partitions[1].PreferredReplicas)
+
+	_, err = parse(3, "a", "b")
+	require.Error(t, err)
+	_, err = parse(1, "a,,b")
+	require.Error(t, err)
+	_, err = parse(1, "a,b,a")
+	require.Error(t, err)
+}
+
+// Ensure the partitions of a stream created with preferred replicas are hosted
+// by exactly those brokers and are counted in their partition load, preferred
+// brokers missing from the cluster are replaced, and preferred replicas which
+// don't match the replication factor are rejected.
+func TestCreateStreamPreferredReplicas(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	var servers []*Server
+	for i, id := range []string{"a", "b", "c"} {
+		config := getTestConfig(id, i == 0, 5050+i)
+		config.EmbeddedNATS = false
+		s := runServerWithConfig(t, config)
+		defer s.Stop()
+		servers = append(servers, s)
+	}
+	leader := getMetadataLeader(t, 10*time.Second, servers...)
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", leader.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	api := client.NewAPIClient(conn)
+
+	create := func(name string, replicationFactor int32, preferred ...string) error {
+		ctx := context.Background()
+		for _, value := range preferred {
+			ctx = metadata.AppendToOutgoingContext(ctx, preferredReplicasMetadataKey, value)
+		}
+		_, err := api.CreateStream(ctx, &client.CreateStreamRequest{
+			Name:              name,
+			Subject:           name,
+			Partitions:        2,
+			ReplicationFactor: replicationFactor,
+		})
+		return err
+	}
+
+	before := leader.metadata.BrokerPartitionCounts()
+	require.NoError(t, create("foo", 2, "b,c"))
+	for _, id := range []int32{0, 1} {
+		waitForPartition(t, 10*time.Second, "foo", id, servers...)
+		for _, s := range servers {
+			partition := s.metadata.GetPartition("foo", id)
+			require.ElementsMatch(t, []string{"b", "c"}, partition.GetReplicas())
+		}
+	}
+	after := leader.metadata.BrokerPartitionCounts()
+	require.Equal(t, before["a"], after["a"])
+	require.Equal(t, before["b"]+2, after["b"])
+	require.Equal(t, before["c"]+2, after["c"])
+
+	// The partition preferring a missing broker is placed on the least
+	// loaded broker instead.
+	require.NoError(t, create("bar", 2, "c,z", "b,a"))
+	waitForPartition(t, 10*time.Second, "bar", 0, servers...)
+	waitForPartition(t, 10*time.Second, "bar", 1, servers...)
+	require.ElementsMatch(t, []string{"c", "a"}, leader.metadata.GetPartition("bar", 0).GetReplicas())
+	require.ElementsMatch(t, []string{"b", "a"}, leader.metadata.GetPartition("bar", 1).GetReplicas())
+	counts := leader.metadata.BrokerPartitionCounts()
+	require.Equal(t, after["a"]+2, counts["a"])
+	require.Equal(t, after["b"]+1, counts["b"])
+	require.Equal(t, after["c"]+1, counts["c"])
+
+	err = create("baz", 3, "a,b")
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,296 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// retryAfterTrailer is the gRPC trailer set on Publish requests rejected
+	// because the producer exceeded its quota. It contains the number of
+	// seconds to wait before publishing again.
+	retryAfterTrailer = "retry-after"
+
+	// publishAsyncErrorQuotaExceeded is the PublishAsync error code of
+	// messages rejected because the producer exceeded its quota. Like the
+	// exclusive producer code, it's past the codes the enum defines.
+	publishAsyncErrorQuotaExceeded = client.PublishAsyncError_Code(103)
+)
+
+// ErrQuotaExceeded is returned when publishing a message would exceed the
+// producer's quota.
+var ErrQuotaExceeded = errors.New("producer quota exceeded")
+
+// producerQuotas tracks the byte rate quotas of producers and the token
+// buckets enforcing them on this broker. Quotas come from the configuration
+// and can be set or deleted at runtime through Raft, which takes precedence
+// so every broker enforces the same quotas. Each broker has its own buckets,
+// so a producer's quota applies to what it publishes through each broker.
+type producerQuotas struct {
+	mu       sync.Mutex
+	config   map[string]ProducerQuota
+	runtime  map[string]int64 // Bytes per second set at runtime, 0 if deleted
+	limiters map[string]*byteRateLimiter
+}
+
+func newProducerQuotas(config map[string]ProducerQuota) *producerQuotas {
+	return &producerQuotas{
+		config:   config,
+		runtime:  make(map[string]int64),
+		limiters: make(map[string]*byteRateLimiter),
+	}
+}
+
+// set sets the quota of the given producer at runtime.
+func (q *producerQuotas) set(producerID string, bytesPerSecond int64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.runtime[producerID] = bytesPerSecond
+	q.syncLimiter(producerID)
+}
+
+// remove deletes the quota of the given producer at runtime, including a
+// quota from the configuration.
+func (q *producerQuotas) remove(producerID string) {
+	q.set(producerID, 0)
+}
+
+// reset forgets the quotas set or deleted at runtime.
+func (q *producerQuotas) reset() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.runtime = make(map[string]int64)
+	for producerID := range q.limiters {
+		q.syncLimiter(producerID)
+	}
+}
+
+// rate returns the quota of the given producer in bytes per second, 0 if it
+// has none. The caller must hold the lock.
+func (q *producerQuotas) rate(producerID string) int64 {
+	if rate, ok := q.runtime[producerID]; ok {
+		return rate
+	}
+	return q.config[producerID].BytesPerSecond
+}
+
+// syncLimiter updates the rate of the given producer's limiter, removing it
+// if the producer no longer has a quota. The caller must hold the lock.
+func (q *producerQuotas) syncLimiter(producerID string) {
+	limiter, ok := q.limiters[producerID]
+	if !ok {
+		return
+	}
+	if rate := q.rate(producerID); rate > 0 {
+		limiter.setRate(rate)
+	} else {
+		delete(q.limiters, producerID)
+	}
+}
+
+// reserve takes size bytes from the given producer's quota and returns 0 if
+// they're available. Otherwise, it returns how long until they are without
+// taking them. Producers without a quota are never limited.
+func (q *producerQuotas) reserve(producerID string, size int) time.Duration {
+	q.mu.Lock()
+	limiter, ok := q.limiters[producerID]
+	if !ok {
+		rate := q.rate(producerID)
+		if rate == 0 {
+			q.mu.Unlock()
+			return 0
+		}
+		limiter = newByteRateLimiter(rate)
+		q.limiters[producerID] = limiter
+	}
+	q.mu.Unlock()
+	return limiter.reserve(size)
+}
+
+// get returns the quotas enforced by this broker in producer ID order, or
+// only the given producer's quota if the ID isn't empty.
+func (q *producerQuotas) get(producerID string) []*proto.ProducerQuota {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var ids []string
+	if producerID != "" {
+		ids = []string{producerID}
+	} else {
+		for id := range q.config {
+			ids = append(ids, id)
+		}
+		for id := range q.runtime {
+			if _, ok := q.config[id]; !ok {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+	}
+	quotas := make([]*proto.ProducerQuota, 0, len(ids))
+	for _, id := range ids {
+		if rate := q.rate(id); rate > 0 {
+			quotas = append(quotas, &proto.ProducerQuota{ProducerID: id, BytesPerSecond: rate})
+		}
+	}
+	return quotas
+}
+
+// runtimeQuotas returns the quotas set or deleted at runtime, which are
+// stored in Raft snapshots.
+func (q *producerQuotas) runtimeQuotas() []*proto.ProducerQuota {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	quotas := make([]*proto.ProducerQuota, 0, len(q.runtime))
+	for id, rate := range q.runtime {
+		quotas = append(quotas, &proto.ProducerQuota{ProducerID: id, BytesPerSecond: rate})
+	}
+	return quotas
+}
+
+// ensureProducerQuota checks that publishing a message of the given size
+// doesn't exceed the quota of the producer set in its idempotent producer
+// headers. If it would, the message is rejected along with how long until
+// the producer can publish it.
+func (a *apiServer) ensureProducerQuota(headers map[string][]byte, size int) (
+	*client.PublishAsyncError, time.Duration) {
+
+	producerID, _, ok, _ := getProducerSequence(headers)
+	if !ok {
+		return nil, 0
+	}
+	retryAfter := a.metadata.quotas.reserve(producerID, size)
+	if retryAfter == 0 {
+		return nil, 0
+	}
+	return &client.PublishAsyncError{
+		Code: publishAsyncErrorQuotaExceeded,
+		Message: fmt.Sprintf("%s: producer %s can publish again in %s", ErrQuotaExceeded.Error(),
+			producerID, retryAfter),
+	}, retryAfter
+}
+
+// formatRetryAfter returns the value of the retry-after trailer for the given
+// delay, which is rounded up to whole seconds like the HTTP header.
+func formatRetryAfter(delay time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(delay.Seconds())), 10)
+}
+
+// SetProducerQuota sets the quota of a producer if this server is the
+// metadata leader. If it is not, it will forward the request to the leader and
+// return the response. This operation is replicated by Raft.
+func (m *metadataAPI) SetProducerQuota(ctx context.Context, req *proto.SetProducerQuotaOp) *status.Status {
+	// Forward the request if we're not the leader.
+	if !m.IsLeader() {
+		isLeader, st := m.propagateSetProducerQuota(ctx, req)
+		if st != nil {
+			return st
+		}
+		// If we have since become leader, continue on with the request.
+		if !isLeader {
+			return nil
+		}
+	}
+
+	op := &proto.RaftLog{
+		Op:                 proto.Op_SET_PRODUCER_QUOTA,
+		SetProducerQuotaOp: req,
+	}
+	return m.applyProducerQuotaOperation(ctx, op, "set")
+}
+
+// DeleteProducerQuota removes the quota of a producer if this server is the
+// metadata leader. If it is not, it will forward the request to the leader and
+// return the response. This operation is replicated by Raft.
+func (m *metadataAPI) DeleteProducerQuota(ctx context.Context, req *proto.DeleteProducerQuotaOp) *status.Status {
+	// Forward the request if we're not the leader.
+	if !m.IsLeader() {
+		isLeader, st := m.propagateDeleteProducerQuota(ctx, req)
+		if st != nil {
+			return st
+		}
+		// If we have since become leader, continue on with the request.
+		if !isLeader {
+			return nil
+		}
+	}
+
+	op := &proto.RaftLog{
+		Op:                    proto.Op_DELETE_PRODUCER_QUOTA,
+		DeleteProducerQuotaOp: req,
+	}
+	return m.applyProducerQuotaOperation(ctx, op, "delete")
+}
+
+// applyProducerQuotaOperation replicates a change to the producer quotas
+// through Raft and waits for it to be applied.
+func (m *metadataAPI) applyProducerQuotaOperation(ctx context.Context, op *proto.RaftLog,
+	action string) *status.Status {
+
+	// Older servers are unable to apply the operation.
+	if err := m.assertMinVersion(ctx, opProtocolVersions[op.Op]); err != nil {
+		code := codes.Internal
+		if errors.Cause(err) == ErrProtocolVersion {
+			code = codes.FailedPrecondition
+		}
+		return status.New(code, err.Error())
+	}
+
+	// Wait on result of replication.
+	future, err := m.getRaft().applyOperation(ctx, op, nil)
+	if err != nil {
+		return status.Newf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to %s producer quota: %v", action, err.Error())
+	}
+
+	return nil
+}
+
+// GetProducerQuotas returns the quotas enforced by this server, or only the
+// given producer's quota if the ID isn't empty.
+func (m *metadataAPI) GetProducerQuotas(producerID string) []*proto.ProducerQuota {
+	return m.quotas.get(producerID)
+}
+
+// propagateSetProducerQuota forwards a SetProducerQuota request to the
+// metadata leader. The bool indicates if this server has since become leader
+// and the request should be performed locally. A Status is returned if the
+// propagated request failed.
+func (m *metadataAPI) propagateSetProducerQuota(ctx context.Context, req *proto.SetProducerQuotaOp) (
+	bool, *status.Status) {
+
+	propagate := &proto.PropagatedRequest{
+		Op:                 proto.Op_SET_PRODUCER_QUOTA,
+		SetProducerQuotaOp: req,
+	}
+	_, isLeader, status := m.propagateRequest(ctx, propagate)
+	return isLeader, status
+}
+
+// propagateDeleteProducerQuota forwards a DeleteProducerQuota request to the
+// metadata leader. The bool indicates if this server has since become leader
+// and the request should be performed locally. A Status is returned if the
+// propagated request failed.
+func (m *metadataAPI) propagateDeleteProducerQuota(ctx context.Context, req *proto.DeleteProducerQuotaOp) (
+	bool, *status.Status) {
+
+	propagate := &proto.PropagatedRequest{
+		Op:                    proto.Op_DELETE_PRODUCER_QUOTA,
+		DeleteProducerQuotaOp: req,
+	}
+	_, isLeader, status := m.propagateRequest(ctx, propagate)
+	return isLeader, status
+}
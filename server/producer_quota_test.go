@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure quotas set at runtime take precedence over the configuration,
+// deleting a configured quota removes it, and resetting restores the
+// configured quotas.
+func TestProducerQuotas(t *testing.T) {
+	q := newProducerQuotas(map[string]ProducerQuota{"a": {BytesPerSecond: 100}})
+	require.Equal(t, []*proto.ProducerQuota{{ProducerID: "a", BytesPerSecond: 100}}, q.get(""))
+
+	q.set("a", 200)
+	q.set("b", 300)
+	require.Equal(t, []*proto.ProducerQuota{
+		{ProducerID: "a", BytesPerSecond: 200},
+		{ProducerID: "b", BytesPerSecond: 300},
+	}, q.get(""))
+	require.Equal(t, []*proto.ProducerQuota{{ProducerID: "b", BytesPerSecond: 300}}, q.get("b"))
+
+	q.remove("a")
+	require.Equal(t, []*proto.ProducerQuota{{ProducerID: "b", BytesPerSecond: 300}}, q.get(""))
+	require.Empty(t, q.get("a"))
+	require.Len(t, q.runtimeQuotas(), 2)
+
+	q.reset()
+	require.Equal(t, []*proto.ProducerQuota{{ProducerID: "a", BytesPerSecond: 100}}, q.get(""))
+	require.Empty(t, q.runtimeQuotas())
+}
+
+// Ensure bytes are only taken from a producer's quota while they're
+// available, producers without a quota aren't limited, and changing the
+// quota applies to the existing bucket.
+func TestProducerQuotasReserve(t *testing.T) {
+	q := newProducerQuotas(nil)
+	require.Equal(t, time.Duration(0), q.reserve("a", 1000))
+
+	q.set("a", 1000)
+	require.Equal(t, time.Duration(0), q.reserve("a", 600))
+	retryAfter := q.reserve("a", 600)
+	require.True(t, retryAfter > 100*time.Millisecond && retryAfter <= 200*time.Millisecond, retryAfter)
+
+	// The rejected bytes weren't taken.
+	require.Equal(t, time.Duration(0), q.reserve("a", 400))
+
+	// Messages larger than the burst size are accepted once the bucket is
+	// full.
+	q.set("a", 100)
+	time.Sleep(time.Second)
+	require.Equal(t, time.Duration(0), q.reserve("a", 1000))
+	require.NotEqual(t, time.Duration(0), q.reserve("a", 1))
+
+	q.remove("a")
+	require.Equal(t, time.Duration(0), q.reserve("a", 1000))
+}
+
+// Ensure a producer quota set through a follower is replicated to every
+// broker and publishing past it is rejected with ResourceExhausted and a
+// retry-after trailer, while the bytes within it are accepted.
+func TestProducerQuota(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.EmbeddedNATS = false
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	s2Config := getTestConfig("b", false, 5051)
+	s2Config.EmbeddedNATS = false
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	leader := getMetadataLeader(t, 10*time.Second, s1, s2)
+	follower := s1
+	if leader == s1 {
+		follower = s2
+	}
+
+	leaderConn, err := grpc.Dial(fmt.Sprintf("localhost:%d", leader.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer leaderConn.Close()
+	followerConn, err := grpc.Dial(fmt.Sprintf("localhost:%d", follower.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer followerConn.Close()
+
+	_, err = proto.NewAdminAPIClient(followerConn).SetProducerQuota(context.Background(),
+		&proto.SetProducerQuotaRequest{Quota: &proto.ProducerQuota{ProducerID: "p1", BytesPerSecond: 10240}})
+	require.NoError(t, err)
+	for _, conn := range []*grpc.ClientConn{leaderConn, followerConn} {
+		admin := proto.NewAdminAPIClient(conn)
+		require.Eventually(t, func() bool {
+			resp, err := admin.GetProducerQuotas(context.Background(), &proto.GetProducerQuotasRequest{})
+			return err == nil && len(resp.Quotas) == 1 && resp.Quotas[0].BytesPerSecond == 10240
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	api := client.NewAPIClient(leaderConn)
+	_, err = api.CreateStream(context.Background(), &client.CreateStreamRequest{
+		Name:    "foo",
+		Subject: "foo",
+	})
+	require.NoError(t, err)
+	waitForPartition(t, 10*time.Second, "foo", 0, s1, s2)
+
+	// Publish 100 KB over one second.
+	var (
+		value     = make([]byte, 1024)
+		sequence  = 0
+		exhausted = 0
+		start     = time.Now()
+	)
+	for i := 0; i < 100; i++ {
+		var trailer metadata.MD
+		_, err := api.Publish(context.Background(), &client.PublishRequest{
+			Stream: "foo",
+			Value:  value,
+			Headers: map[string][]byte{
+				producerIDHeader:       []byte("p1"),
+				producerSequenceHeader: []byte(strconv.Itoa(sequence)),
+			},
+			AckPolicy: client.AckPolicy_LEADER,
+		}, grpc.Trailer(&trailer))
+		if err == nil {
+			sequence++
+		} else {
+			require.Equal(t, codes.ResourceExhausted, status.Code(err), err)
+			require.Equal(t, []string{"1"}, trailer.Get(retryAfterTrailer))
+			exhausted++
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	elapsed := time.Since(start)
+
+	// At most one second's burst plus the bytes accrued while publishing
+	// were accepted, and every other message was rejected.
+	require.Equal(t, 100, sequence+exhausted)
+	require.GreaterOrEqual(t, sequence, 5)
+	require.LessOrEqual(t, float64(sequence*len(value)), 10240*(1+elapsed.Seconds()))
+
+	// Other producers aren't limited.
+	_, err = api.Publish(context.Background(), &client.PublishRequest{
+		Stream:    "foo",
+		Value:     value,
+		AckPolicy: client.AckPolicy_LEADER,
+	})
+	require.NoError(t, err)
+
+	// Deleting the quota lifts the limit.
+	_, err = proto.NewAdminAPIClient(leaderConn).DeleteProducerQuota(context.Background(),
+		&proto.DeleteProducerQuotaRequest{ProducerID: "p1"})
+	require.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		_, err := api.Publish(context.Background(), &client.PublishRequest{
+			Stream: "foo",
+			Value:  value,
+			Headers: map[string][]byte{
+				producerIDHeader:       []byte("p1"),
+				producerSequenceHeader: []byte(strconv.Itoa(sequence)),
+			},
+			AckPolicy: client.AckPolicy_LEADER,
+		})
+		require.NoError(t, err)
+		sequence++
+	}
+}
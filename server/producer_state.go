@@ -0,0 +1,377 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	atomic_file "github.com/natefinch/atomic"
+	"github.com/pkg/errors"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+)
+
+const (
+	// producerIDHeader and producerSequenceHeader are the message headers
+	// used by idempotent producers since PublishRequest has no fields for
+	// them. The sequence number is a decimal string which must start at 0 and
+	// increase by one with each message the producer publishes to the
+	// partition. Because the headers are stored with the message, the
+	// producer state is replicated along with the log and can be rebuilt by a
+	// new leader.
+	producerIDHeader       = "liftbridge-producer-id"
+	producerSequenceHeader = "liftbridge-producer-sequence"
+
+	producerStateFileName           = "producer-state"
+	producerStateCheckpointInterval = 5 * time.Second
+
+	// producerSequenceWindow is the number of most recent sequence numbers
+	// remembered for each producer so that retries of them can be acked with
+	// the offsets they were originally written at.
+	producerSequenceWindow = 5
+)
+
+// producerSequence records the log offset a producer's message was written
+// at.
+type producerSequence struct {
+	Sequence int64 `json:"sequence"`
+	Offset   int64 `json:"offset"`
+}
+
+// producerStateCheckpoint is the on-disk representation of a producerState.
+type producerStateCheckpoint struct {
+	Offset    int64                         `json:"offset"`
+	Producers map[string][]producerSequence `json:"producers"`
+}
+
+// producerState tracks the sequence numbers of the messages written to a
+// partition by each idempotent producer. It's maintained by the partition
+// leader and used to discard messages retried by a producer after they were
+// already written.
+type producerState struct {
+	mu        sync.Mutex
+	producers map[string][]producerSequence // Most recent sequences, oldest first
+	offset    int64                         // Newest log offset reflected in the state
+}
+
+func newProducerState() *producerState {
+	return &producerState{
+		producers: make(map[string][]producerSequence),
+		offset:    -1,
+	}
+}
+
+// getProducerSequence returns the producer ID and sequence number of the
+// given message headers. The bool returned indicates if the message was
+// published by an idempotent producer.
+func getProducerSequence(headers map[string][]byte) (string, int64, bool, error) {
+	producerID, ok := headers[producerIDHeader]
+	if !ok {
+		return "", 0, false, nil
+	}
+	if len(producerID) == 0 {
+		return "", 0, false, fmt.Errorf("%s header is empty", producerIDHeader)
+	}
+	sequence, err := strconv.ParseInt(string(headers[producerSequenceHeader]), 10, 64)
+	if err != nil || sequence < 0 {
+		return "", 0, false, fmt.Errorf("invalid %s header %q", producerSequenceHeader,
+			headers[producerSequenceHeader])
+	}
+	return string(producerID), sequence, true, nil
+}
+
+// lastSequence returns the last sequence number written by the given producer
+// or -1 if there is none.
+func (s *producerState) lastSequence(producerID string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sequences := s.producers[producerID]
+	if len(sequences) == 0 {
+		return -1
+	}
+	return sequences[len(sequences)-1].Sequence
+}
+
+// sequenceOffset returns the offset the given producer's message with the
+// given sequence number was written at. The bool returned indicates if the
+// sequence number is still remembered.
+func (s *producerState) sequenceOffset(producerID string, sequence int64) (int64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, seq := range s.producers[producerID] {
+		if seq.Sequence == sequence {
+			return seq.Offset, true
+		}
+	}
+	return 0, false
+}
+
+// record adds the message written at the given offset to the state.
+func (s *producerState) record(producerID string, sequence, offset int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sequences := append(s.producers[producerID], producerSequence{
+		Sequence: sequence,
+		Offset:   offset,
+	})
+	if len(sequences) > producerSequenceWindow {
+		sequences = sequences[len(sequences)-producerSequenceWindow:]
+	}
+	s.producers[producerID] = sequences
+	if offset > s.offset {
+		s.offset = offset
+	}
+}
+
+// reset replaces the state with the given one.
+func (s *producerState) reset(other *producerState) {
+	other.mu.Lock()
+	producers, offset := other.producers, other.offset
+	other.mu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.producers = producers
+	s.offset = offset
+}
+
+// checkpoint atomically writes the state to the given file.
+func (s *producerState) checkpoint(file string) error {
+	s.mu.Lock()
+	data, err := json.Marshal(&producerStateCheckpoint{
+		Offset:    s.offset,
+		Producers: s.producers,
+	})
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return atomic_file.WriteFile(file, bytes.NewReader(data))
+}
+
+// loadProducerState rebuilds the producer state from the checkpoint file and
+// the messages in the log. The checkpoint is only used if it doesn't reflect
+// messages past the log's high watermark since those may have been truncated
+// and replaced. Otherwise, the state is rebuilt from the start of the log.
+func loadProducerState(file string, log commitlog.CommitLog) (*producerState, error) {
+	state := newProducerState()
+	data, err := os.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		checkpoint := new(producerStateCheckpoint)
+		if err := json.Unmarshal(data, checkpoint); err != nil {
+			return nil, errors.Wrap(err, "invalid producer state checkpoint")
+		}
+		if checkpoint.Offset <= log.HighWatermark() {
+			state.offset = checkpoint.Offset
+			for producerID, sequences := range checkpoint.Producers {
+				state.producers[producerID] = sequences
+			}
+		}
+	}
+
+	start := state.offset + 1
+	if oldest := log.OldestOffset(); start < oldest {
+		start = oldest
+	}
+	newest := log.NewestOffset()
+	if newest < 0 || start > newest {
+		return state, nil
+	}
+
+	reader, err := log.NewReader(start, true)
+	if err != nil {
+		return nil, err
+	}
+	headersBuf := make([]byte, 28)
+	for offset := int64(-1); offset < newest; {
+		var msg commitlog.SerializedMessage
+		msg, offset, _, _, err = reader.ReadMessage(context.Background(), headersBuf)
+		if err != nil {
+			return nil, err
+		}
+		// Messages with invalid headers were never written, so there is
+		// nothing to record for them here.
+		producerID, sequence, ok, _ := getProducerSequence(msg.Headers())
+		if ok {
+			state.record(producerID, sequence, offset)
+		}
+	}
+	return state, nil
+}
+
+// producerStateFile returns the path of the partition's producer state
+// checkpoint.
+func (p *partition) producerStateFile() string {
+	return filepath.Join(p.srv.partitionDir(p.Stream, p.Id), producerStateFileName)
+}
+
+// producerStateCheckpointLoop periodically checkpoints the producer state to
+// disk until the given channel is closed.
+func (p *partition) producerStateCheckpointLoop(state *producerState, stop <-chan struct{}) {
+	ticker := time.NewTicker(producerStateCheckpointInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		if err := state.checkpoint(p.producerStateFile()); err != nil {
+			p.srv.logger.Errorf("Failed to checkpoint producer state for partition %s: %v", p, err)
+		}
+	}
+}
+
+// duplicateMessage is a message which was discarded because its producer
+// already published it.
+type duplicateMessage struct {
+	msg *commitlog.Message
+	// offset is the offset the message was originally written at or -1 if the
+	// original is in the same batch, in which case batchIndex is its position
+	// in the batch.
+	offset     int64
+	batchIndex int
+}
+
+// dedupeBatch removes messages from the batch which were retried by their
+// producer after already being written and rejects messages whose sequence
+// number is not the next one expected from their producer. It returns the
+// messages to append to the log along with the discarded duplicates, which
+// should be acked with ackDuplicates once the batch has been appended.
+func (p *partition) dedupeBatch(state *producerState, batch []*commitlog.Message) (
+	[]*commitlog.Message, []duplicateMessage) {
+
+	var (
+		deduped    = batch[:0]
+		duplicates []duplicateMessage
+		pending    map[string][]int64 // Sequences accepted from this batch, by producer
+	)
+	for _, msg := range batch {
+		producerID, sequence, ok, err := getProducerSequence(msg.Headers)
+		if err != nil {
+			p.srv.logger.Errorf("Rejecting message received on partition %s: %v", p, err)
+			p.sendSequenceNack(msg)
+			continue
+		}
+		if !ok {
+			deduped = append(deduped, msg)
+			continue
+		}
+
+		last := state.lastSequence(producerID)
+		if batchSequences := pending[producerID]; len(batchSequences) > 0 {
+			last = batchSequences[len(batchSequences)-1]
+		}
+
+		switch {
+		case sequence == last+1:
+			if pending == nil {
+				pending = make(map[string][]int64)
+			}
+			pending[producerID] = append(pending[producerID], sequence)
+			deduped = append(deduped, msg)
+		case sequence <= last:
+			if offset, ok := state.sequenceOffset(producerID, sequence); ok {
+				duplicates = append(duplicates, duplicateMessage{msg: msg, offset: offset})
+				continue
+			}
+			batchIndex := -1
+			for i, m := range deduped {
+				if id, seq, _, _ := getProducerSequence(m.Headers); id == producerID && seq == sequence {
+					batchIndex = i
+					break
+				}
+			}
+			if batchIndex >= 0 {
+				duplicates = append(duplicates, duplicateMessage{msg: msg, offset: -1, batchIndex: batchIndex})
+				continue
+			}
+			p.srv.logger.Errorf("Rejecting message received on partition %s from producer %s, "+
+				"sequence %d is too old to determine its offset", p, producerID, sequence)
+			p.sendSequenceNack(msg)
+		default:
+			p.srv.logger.Errorf("Rejecting message received on partition %s from producer %s, "+
+				"sequence %d is out of order (expected %d)", p, producerID, sequence, last+1)
+			p.sendSequenceNack(msg)
+		}
+	}
+	return deduped, duplicates
+}
+
+// recordProducerSequences adds the messages appended to the log at the given
+// offsets to the producer state.
+func recordProducerSequences(state *producerState, batch []*commitlog.Message, offsets []int64) {
+	for i, msg := range batch {
+		if producerID, sequence, ok, _ := getProducerSequence(msg.Headers); ok {
+			state.record(producerID, sequence, offsets[i])
+		}
+	}
+}
+
+// ackDuplicates acks the discarded duplicate messages with the offsets their
+// originals were written at. Like other messages, acks for messages with
+// AckPolicy ALL are not sent until the original is committed.
+func (p *partition) ackDuplicates(duplicates []duplicateMessage, offsets []int64) {
+	for _, dup := range duplicates {
+		if dup.msg.AckPolicy == client.AckPolicy_NONE {
+			continue
+		}
+		offset := dup.offset
+		if offset < 0 {
+			offset = offsets[dup.batchIndex]
+		}
+		ack := &client.Ack{
+			Stream:             p.Stream,
+			PartitionSubject:   p.Subject,
+			MsgSubject:         string(dup.msg.Headers["subject"]),
+			Offset:             offset,
+			AckInbox:           dup.msg.AckInbox,
+			CorrelationId:      dup.msg.CorrelationID,
+			AckPolicy:          dup.msg.AckPolicy,
+			ReceptionTimestamp: dup.msg.Timestamp,
+		}
+		if dup.msg.AckPolicy == client.AckPolicy_LEADER || offset <= p.log.HighWatermark() {
+			p.sendAck(ack)
+			continue
+		}
+		if err := p.commitQueue.Put(ack); err != nil {
+			p.srv.logger.Errorf("Failed to add message to commit queue for partition %s: %v", p, err)
+			continue
+		}
+		// The original may have been committed since the high watermark was
+		// checked, so make sure the commit loop looks at the queue again.
+		select {
+		case p.commitCheck <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// sendSequenceNack publishes an ack containing an error indicating the
+// message's producer sequence number was rejected to the specified AckInbox.
+// There is no dedicated error code for this, so UNKNOWN is used. If no
+// AckInbox is set, this does nothing.
+func (p *partition) sendSequenceNack(msg *commitlog.Message) {
+	if msg.AckInbox == "" {
+		return
+	}
+	p.sendAck(&client.Ack{
+		Stream:             p.Stream,
+		PartitionSubject:   p.Subject,
+		MsgSubject:         string(msg.Headers["subject"]),
+		AckInbox:           msg.AckInbox,
+		CorrelationId:      msg.CorrelationID,
+		AckPolicy:          msg.AckPolicy,
+		ReceptionTimestamp: msg.Timestamp,
+		AckError:           client.Ack_UNKNOWN,
+	})
+}
@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// propagateMinBackoff is how long a request forwarded to the metadata
+	// leader waits before it's first retried. The backoff doubles with each
+	// retry up to propagateMaxBackoff.
+	propagateMinBackoff = 100 * time.Millisecond
+
+	// propagateMaxBackoff is the longest a request forwarded to the metadata
+	// leader waits between retries.
+	propagateMaxBackoff = 2 * time.Second
+)
+
+// propagationLimiter limits the requests this server forwards to the
+// metadata leader at once and counts their attempts, retries and failures.
+// During a leader election every forwarded request fails or retries at the
+// same time, so without a limit a burst of client retries would pile up.
+type propagationLimiter struct {
+	slots    chan struct{} // Nil if unlimited
+	attempts int64         // Accessed atomically
+	retries  int64         // Accessed atomically
+	failures int64         // Accessed atomically
+	inflight int64         // Accessed atomically
+}
+
+func newPropagationLimiter(maxInflight int) *propagationLimiter {
+	l := &propagationLimiter{}
+	if maxInflight > 0 {
+		l.slots = make(chan struct{}, maxInflight)
+	}
+	return l
+}
+
+// acquire waits for a slot to forward a request until the context is done,
+// in which case it returns an Unavailable status. The returned function
+// releases the slot.
+func (l *propagationLimiter) acquire(ctx context.Context) (func(), *status.Status) {
+	atomic.AddInt64(&l.inflight, 1)
+	if l.slots == nil {
+		return func() { atomic.AddInt64(&l.inflight, -1) }, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return func() {
+			<-l.slots
+			atomic.AddInt64(&l.inflight, -1)
+		}, nil
+	case <-ctx.Done():
+		atomic.AddInt64(&l.inflight, -1)
+		atomic.AddInt64(&l.failures, 1)
+		return nil, status.New(codes.Unavailable, "Too many requests forwarded to the metadata leader")
+	}
+}
+
+// fail counts a request which failed without an answer from the metadata
+// leader and returns the given status.
+func (l *propagationLimiter) fail(st *status.Status) *status.Status {
+	atomic.AddInt64(&l.failures, 1)
+	return st
+}
+
+// stats returns the counts of the requests forwarded by this server.
+func (l *propagationLimiter) stats() *proto.PropagationStats {
+	return &proto.PropagationStats{
+		Attempts: atomic.LoadInt64(&l.attempts),
+		Retries:  atomic.LoadInt64(&l.retries),
+		Failures: atomic.LoadInt64(&l.failures),
+		Inflight: atomic.LoadInt64(&l.inflight),
+	}
+}
+
+// propagateErrorStatus returns the status of a request to the metadata leader
+// which failed with the given error. The leader going away or not answering
+// in time is Unavailable so clients know to retry.
+func propagateErrorStatus(err error) *status.Status {
+	if errors.Is(err, nats.ErrNoResponders) || errors.Is(err, nats.ErrTimeout) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return status.Newf(codes.Unavailable, "No response from metadata leader: %v", err)
+	}
+	return status.New(codes.Internal, err.Error())
+}
+
+// nextPropagateBackoff returns the backoff following the given one.
+func nextPropagateBackoff(backoff time.Duration) time.Duration {
+	if backoff *= 2; backoff > propagateMaxBackoff {
+		return propagateMaxBackoff
+	}
+	return backoff
+}
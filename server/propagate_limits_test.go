@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure the propagation limiter makes requests wait for a slot, fails them
+// with Unavailable if their context is done first, and counts the requests in
+// flight.
+func TestPropagationLimiter(t *testing.T) {
+	l := newPropagationLimiter(1)
+	release, st := l.acquire(context.Background())
+	require.Nil(t, st)
+	require.Equal(t, int64(1), l.stats().Inflight)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, st = l.acquire(ctx)
+	require.NotNil(t, st)
+	require.Equal(t, codes.Unavailable, st.Code())
+	require.Equal(t, int64(1), l.stats().Failures)
+
+	release()
+	release, st = l.acquire(context.Background())
+	require.Nil(t, st)
+	release()
+	require.Equal(t, int64(0), l.stats().Inflight)
+
+	// Without a limit, requests never wait.
+	l = newPropagationLimiter(0)
+	for i := 0; i < 10; i++ {
+		_, st := l.acquire(context.Background())
+		require.Nil(t, st)
+	}
+	require.Equal(t, int64(10), l.stats().Inflight)
+}
+
+// Ensure requests the metadata leader didn't answer fail with Unavailable and
+// the backoff between retries is capped.
+func TestPropagateErrorStatus(t *testing.T) {
+	require.Equal(t, codes.Unavailable, propagateErrorStatus(nats.ErrNoResponders).Code())
+	require.Equal(t, codes.Unavailable, propagateErrorStatus(nats.ErrTimeout).Code())
+	require.Equal(t, codes.Unavailable, propagateErrorStatus(context.DeadlineExceeded).Code())
+	require.Equal(t, codes.Internal, propagateErrorStatus(nats.ErrConnectionClosed).Code())
+
+	require.Equal(t, 2*propagateMinBackoff, nextPropagateBackoff(propagateMinBackoff))
+	require.Equal(t, propagateMaxBackoff, nextPropagateBackoff(propagateMaxBackoff))
+}
+
+// Ensure a CreateStream request sent to a follower while the metadata leader
+// is killed and restarted is forwarded to the new leader instead of failing.
+func TestCreateStreamPropagateLeaderFailover(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	var (
+		configs = make(map[*Server]*Config)
+		servers []*Server
+	)
+	for i, id := range []string{"a", "b", "c"} {
+		config := getTestConfig(id, i == 0, 5050+i)
+		config.EmbeddedNATS = false
+		s := runServerWithConfig(t, config)
+		defer func() { s.Stop() }()
+		configs[s] = config
+		servers = append(servers, s)
+	}
+	leader := getMetadataLeader(t, 10*time.Second, servers...)
+	var follower *Server
+	for _, s := range servers {
+		if s != leader {
+			follower = s
+			break
+		}
+	}
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", follower.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// Kill the leader and create the stream through the follower while a
+	// new leader is elected and the old one restarts.
+	leader.Stop()
+	errC := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+		defer cancel()
+		_, err := client.NewAPIClient(conn).CreateStream(ctx, &client.CreateStreamRequest{
+			Name:    "foo",
+			Subject: "foo",
+		})
+		errC <- err
+	}()
+	restarted := runServerWithConfig(t, configs[leader])
+	defer restarted.Stop()
+
+	select {
+	case err := <-errC:
+		require.NoError(t, err)
+	case <-time.After(30 * time.Second):
+		t.Fatal("CreateStream did not return")
+	}
+	var running []*Server
+	for _, s := range servers {
+		if s != leader {
+			running = append(running, s)
+		}
+	}
+	waitForPartition(t, 10*time.Second, "foo", 0, append(running, restarted)...)
+
+	resp, err := proto.NewAdminAPIClient(conn).FetchBrokerStats(context.Background(),
+		&proto.FetchBrokerStatsRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Propagation)
+	require.GreaterOrEqual(t, resp.Propagation.Attempts, int64(1))
+	require.Equal(t, int64(0), resp.Propagation.Inflight)
+}
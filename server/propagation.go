@@ -22,6 +22,9 @@ func (s *Server) getPropagateInbox() string {
 // a forwarded operation and loses leadership at the same time, the operation
 // will fail when it's proposed to the Raft cluster.
 func (s *Server) handlePropagatedRequest(m *nats.Msg) {
+	if !s.auth.verify(m.Subject, m.Data) {
+		return
+	}
 	var (
 		req, err = proto.UnmarshalPropagatedRequest(m.Data)
 		resp     *proto.PropagatedResponse
@@ -53,6 +56,32 @@ func (s *Server) handlePropagatedRequest(m *nats.Msg) {
 		resp = s.handleLeaveConsumerGroup(req)
 	case proto.Op_REPORT_CONSUMER_GROUP_COORDINATOR:
 		resp = s.handleReportConsumerGroupCoordinator(req)
+	case proto.Op_CHANGE_LEADER:
+		resp = s.handleTransferLeader(req)
+	case proto.Op_UPDATE_STREAM_OWNER:
+		resp = s.handleUpdateStreamOwner(req)
+	case proto.Op_REGISTER_PRODUCER:
+		resp = s.handleRegisterProducer(req)
+	case proto.Op_RELEASE_PRODUCER:
+		resp = s.handleReleaseProducer(req)
+	case proto.Op_SCHEDULE_OPERATION:
+		resp = s.handleScheduleOperation(req)
+	case proto.Op_CANCEL_SCHEDULED_OPERATION:
+		resp = s.handleCancelScheduledOperation(req)
+	case proto.Op_UPDATE_STREAM_CONFIG:
+		resp = s.handleUpdateStreamConfig(req)
+	case proto.Op_SET_STREAM_TAGS:
+		resp = s.handleSetStreamTags(req)
+	case proto.Op_DELETE_PARTITION_DATA:
+		resp = s.handleDeletePartitionData(req)
+	case proto.Op_SET_STREAM_ACL:
+		resp = s.handleSetStreamACL(req)
+	case proto.Op_DELETE_PARTITION:
+		resp = s.handleDeletePartition(req)
+	case proto.Op_SET_PRODUCER_QUOTA:
+		resp = s.handleSetProducerQuota(req)
+	case proto.Op_DELETE_PRODUCER_QUOTA:
+		resp = s.handleDeleteProducerQuota(req)
 	default:
 		s.logger.Warnf("Unknown propagated request operation: %s", req.Op)
 		return
@@ -61,7 +90,7 @@ func (s *Server) handlePropagatedRequest(m *nats.Msg) {
 	if err != nil {
 		panic(err)
 	}
-	if err := m.Respond(data); err != nil {
+	if err := m.Respond(s.auth.sign(data)); err != nil {
 		s.logger.Errorf("Failed to respond to propagated request: %v", err)
 	}
 }
@@ -116,6 +145,16 @@ func (s *Server) handleDeleteStream(req *proto.PropagatedRequest) *proto.Propaga
 	return resp
 }
 
+func (s *Server) handleDeletePartition(req *proto.PropagatedRequest) *proto.PropagatedResponse {
+	resp := &proto.PropagatedResponse{
+		Op: req.Op,
+	}
+	if err := s.metadata.DeletePartition(context.Background(), req.DeletePartitionOp); err != nil {
+		resp.Error = &proto.Error{Code: uint32(err.Code()), Msg: err.Message()}
+	}
+	return resp
+}
+
 func (s *Server) handlePauseStream(req *proto.PropagatedRequest) *proto.PropagatedResponse {
 	resp := &proto.PropagatedResponse{
 		Op: req.Op,
@@ -146,6 +185,126 @@ func (s *Server) handleSetStreamReadonly(req *proto.PropagatedRequest) *proto.Pr
 	return resp
 }
 
+func (s *Server) handleUpdateStreamOwner(req *proto.PropagatedRequest) *proto.PropagatedResponse {
+	resp := &proto.PropagatedResponse{
+		Op: req.Op,
+	}
+	if err := s.metadata.UpdateStreamOwner(context.Background(), req.UpdateStreamOwnerOp); err != nil {
+		resp.Error = &proto.Error{Code: uint32(err.Code()), Msg: err.Message()}
+	}
+	return resp
+}
+
+func (s *Server) handleRegisterProducer(req *proto.PropagatedRequest) *proto.PropagatedResponse {
+	resp := &proto.PropagatedResponse{
+		Op: req.Op,
+	}
+	epoch, err := s.metadata.RegisterProducer(context.Background(), req.RegisterProducerOp)
+	if err != nil {
+		resp.Error = &proto.Error{Code: uint32(err.Code()), Msg: err.Message()}
+	} else {
+		resp.RegisterProducerResp = &proto.PropagatedResponse_RegisterProducerResponse{
+			Epoch: epoch,
+		}
+	}
+	return resp
+}
+
+func (s *Server) handleReleaseProducer(req *proto.PropagatedRequest) *proto.PropagatedResponse {
+	resp := &proto.PropagatedResponse{
+		Op: req.Op,
+	}
+	if err := s.metadata.ReleaseProducer(context.Background(), req.ReleaseProducerOp); err != nil {
+		resp.Error = &proto.Error{Code: uint32(err.Code()), Msg: err.Message()}
+	}
+	return resp
+}
+
+func (s *Server) handleScheduleOperation(req *proto.PropagatedRequest) *proto.PropagatedResponse {
+	resp := &proto.PropagatedResponse{
+		Op: req.Op,
+	}
+	operation, err := s.metadata.ScheduleOperation(context.Background(), req.ScheduleOperationOp)
+	if err != nil {
+		resp.Error = &proto.Error{Code: uint32(err.Code()), Msg: err.Message()}
+	} else {
+		resp.ScheduleOperationResp = &proto.PropagatedResponse_ScheduleOperationResponse{
+			Operation: operation,
+		}
+	}
+	return resp
+}
+
+func (s *Server) handleCancelScheduledOperation(req *proto.PropagatedRequest) *proto.PropagatedResponse {
+	resp := &proto.PropagatedResponse{
+		Op: req.Op,
+	}
+	if err := s.metadata.CancelScheduledOperation(context.Background(), req.CancelScheduledOperationOp); err != nil {
+		resp.Error = &proto.Error{Code: uint32(err.Code()), Msg: err.Message()}
+	}
+	return resp
+}
+
+func (s *Server) handleUpdateStreamConfig(req *proto.PropagatedRequest) *proto.PropagatedResponse {
+	resp := &proto.PropagatedResponse{
+		Op: req.Op,
+	}
+	if err := s.metadata.UpdateStreamConfig(context.Background(), req.UpdateStreamConfigOp); err != nil {
+		resp.Error = &proto.Error{Code: uint32(err.Code()), Msg: err.Message()}
+	}
+	return resp
+}
+
+func (s *Server) handleSetStreamTags(req *proto.PropagatedRequest) *proto.PropagatedResponse {
+	resp := &proto.PropagatedResponse{
+		Op: req.Op,
+	}
+	if err := s.metadata.SetStreamTags(context.Background(), req.SetStreamTagsOp); err != nil {
+		resp.Error = &proto.Error{Code: uint32(err.Code()), Msg: err.Message()}
+	}
+	return resp
+}
+
+func (s *Server) handleSetStreamACL(req *proto.PropagatedRequest) *proto.PropagatedResponse {
+	resp := &proto.PropagatedResponse{
+		Op: req.Op,
+	}
+	if err := s.metadata.SetStreamACL(context.Background(), req.SetStreamACLOp); err != nil {
+		resp.Error = &proto.Error{Code: uint32(err.Code()), Msg: err.Message()}
+	}
+	return resp
+}
+
+func (s *Server) handleSetProducerQuota(req *proto.PropagatedRequest) *proto.PropagatedResponse {
+	resp := &proto.PropagatedResponse{
+		Op: req.Op,
+	}
+	if err := s.metadata.SetProducerQuota(context.Background(), req.SetProducerQuotaOp); err != nil {
+		resp.Error = &proto.Error{Code: uint32(err.Code()), Msg: err.Message()}
+	}
+	return resp
+}
+
+func (s *Server) handleDeleteProducerQuota(req *proto.PropagatedRequest) *proto.PropagatedResponse {
+	resp := &proto.PropagatedResponse{
+		Op: req.Op,
+	}
+	if err := s.metadata.DeleteProducerQuota(context.Background(), req.DeleteProducerQuotaOp); err != nil {
+		resp.Error = &proto.Error{Code: uint32(err.Code()), Msg: err.Message()}
+	}
+	return resp
+}
+
+func (s *Server) handleDeletePartitionData(req *proto.PropagatedRequest) *proto.PropagatedResponse {
+	resp := &proto.PropagatedResponse{
+		Op: req.Op,
+	}
+	if err := s.metadata.DeletePartitionData(context.Background(), req.DeletePartitionDataOp); err != nil {
+		resp.Error = &proto.Error{Code: uint32(err.Code()), Msg: err.Message()}
+	}
+	return resp
+}
+
 func (s *Server) handleJoinConsumerGroup(req *proto.PropagatedRequest) *proto.PropagatedResponse {
 	resp := &proto.PropagatedResponse{
 		Op: req.Op,
@@ -181,3 +340,14 @@ func (s *Server) handleReportConsumerGroupCoordinator(req *proto.PropagatedReque
 	}
 	return resp
 }
+
+func (s *Server) handleTransferLeader(req *proto.PropagatedRequest) *proto.PropagatedResponse {
+	resp := &proto.PropagatedResponse{
+		Op: req.Op,
+	}
+	op := req.ChangeLeaderOp
+	if err := s.metadata.TransferLeader(context.Background(), op.Stream, op.Partition, op.Leader); err != nil {
+		resp.Error = &proto.Error{Code: uint32(err.Code()), Msg: err.Message()}
+	}
+	return resp
+}
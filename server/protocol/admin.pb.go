@@ -0,0 +1,28069 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: server/protocol/admin.proto
+
+package protocol
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExportFormat is the encoding of the chunks of exported messages.
+type ExportFormat int32
+
+const (
+	ExportFormat_JSONL   ExportFormat = 0
+	ExportFormat_PARQUET ExportFormat = 1
+)
+
+var ExportFormat_name = map[int32]string{
+	0: "JSONL",
+	1: "PARQUET",
+}
+
+var ExportFormat_value = map[string]int32{
+	"JSONL":   0,
+	"PARQUET": 1,
+}
+
+func (x ExportFormat) String() string {
+	return proto.EnumName(ExportFormat_name, int32(x))
+}
+
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{0}
+}
+
+// RaftSuffrage determines whether a server in the metadata Raft group votes.
+type RaftSuffrage int32
+
+const (
+	RaftSuffrage_VOTER    RaftSuffrage = 0
+	RaftSuffrage_NONVOTER RaftSuffrage = 1
+	RaftSuffrage_STAGING  RaftSuffrage = 2
+)
+
+var RaftSuffrage_name = map[int32]string{
+	0: "VOTER",
+	1: "NONVOTER",
+	2: "STAGING",
+}
+
+var RaftSuffrage_value = map[string]int32{
+	"VOTER":    0,
+	"NONVOTER": 1,
+	"STAGING":  2,
+}
+
+func (x RaftSuffrage) String() string {
+	return proto.EnumName(RaftSuffrage_name, int32(x))
+}
+
+func (RaftSuffrage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{1}
+}
+
+// MetadataEventType is the kind of metadata change described by a
+// MetadataEvent.
+type MetadataEventType int32
+
+const (
+	MetadataEventType_STREAM_CREATED               MetadataEventType = 0
+	MetadataEventType_STREAM_DELETED               MetadataEventType = 1
+	MetadataEventType_LEADER_CHANGED               MetadataEventType = 2
+	MetadataEventType_ISR_CHANGED                  MetadataEventType = 3
+	MetadataEventType_PARTITION_PAUSED             MetadataEventType = 4
+	MetadataEventType_SCHEDULED_OPERATION_EXECUTED MetadataEventType = 5
+	MetadataEventType_PARTITION_DELETED            MetadataEventType = 6
+)
+
+var MetadataEventType_name = map[int32]string{
+	0: "STREAM_CREATED",
+	1: "STREAM_DELETED",
+	2: "LEADER_CHANGED",
+	3: "ISR_CHANGED",
+	4: "PARTITION_PAUSED",
+	5: "SCHEDULED_OPERATION_EXECUTED",
+	6: "PARTITION_DELETED",
+}
+
+var MetadataEventType_value = map[string]int32{
+	"STREAM_CREATED":               0,
+	"STREAM_DELETED":               1,
+	"LEADER_CHANGED":               2,
+	"ISR_CHANGED":                  3,
+	"PARTITION_PAUSED":             4,
+	"SCHEDULED_OPERATION_EXECUTED": 5,
+	"PARTITION_DELETED":            6,
+}
+
+func (x MetadataEventType) String() string {
+	return proto.EnumName(MetadataEventType_name, int32(x))
+}
+
+func (MetadataEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{2}
+}
+
+// PartitionEventType is a kind of partition change reported by a
+// PartitionEvent.
+type PartitionEventType int32
+
+const (
+	PartitionEventType_HW_ADVANCED          PartitionEventType = 0
+	PartitionEventType_LEO_ADVANCED         PartitionEventType = 1
+	PartitionEventType_ISR_UPDATED          PartitionEventType = 2
+	PartitionEventType_READONLY_CHANGED     PartitionEventType = 3
+	PartitionEventType_PAUSED_CHANGED       PartitionEventType = 4
+	PartitionEventType_LEADER_EPOCH_CHANGED PartitionEventType = 5
+)
+
+var PartitionEventType_name = map[int32]string{
+	0: "HW_ADVANCED",
+	1: "LEO_ADVANCED",
+	2: "ISR_UPDATED",
+	3: "READONLY_CHANGED",
+	4: "PAUSED_CHANGED",
+	5: "LEADER_EPOCH_CHANGED",
+}
+
+var PartitionEventType_value = map[string]int32{
+	"HW_ADVANCED":          0,
+	"LEO_ADVANCED":         1,
+	"ISR_UPDATED":          2,
+	"READONLY_CHANGED":     3,
+	"PAUSED_CHANGED":       4,
+	"LEADER_EPOCH_CHANGED": 5,
+}
+
+func (x PartitionEventType) String() string {
+	return proto.EnumName(PartitionEventType_name, int32(x))
+}
+
+func (PartitionEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{3}
+}
+
+// CursorOffsetMapping determines how the offsets of imported cursors are
+// translated to the target partitions.
+type CursorOffsetMapping int32
+
+const (
+	CursorOffsetMapping_CURSOR_OFFSET_IDENTITY     CursorOffsetMapping = 0
+	CursorOffsetMapping_CURSOR_OFFSET_ALIGN_OLDEST CursorOffsetMapping = 1
+	CursorOffsetMapping_CURSOR_OFFSET_ALIGN_NEWEST CursorOffsetMapping = 2
+)
+
+var CursorOffsetMapping_name = map[int32]string{
+	0: "CURSOR_OFFSET_IDENTITY",
+	1: "CURSOR_OFFSET_ALIGN_OLDEST",
+	2: "CURSOR_OFFSET_ALIGN_NEWEST",
+}
+
+var CursorOffsetMapping_value = map[string]int32{
+	"CURSOR_OFFSET_IDENTITY":     0,
+	"CURSOR_OFFSET_ALIGN_OLDEST": 1,
+	"CURSOR_OFFSET_ALIGN_NEWEST": 2,
+}
+
+func (x CursorOffsetMapping) String() string {
+	return proto.EnumName(CursorOffsetMapping_name, int32(x))
+}
+
+func (CursorOffsetMapping) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{4}
+}
+
+// CursorImportResult is the outcome of importing a cursor.
+type CursorImportResult int32
+
+const (
+	CursorImportResult_CURSOR_SET       CursorImportResult = 0
+	CursorImportResult_CURSOR_UNCHANGED CursorImportResult = 1
+	CursorImportResult_CURSOR_AHEAD     CursorImportResult = 2
+)
+
+var CursorImportResult_name = map[int32]string{
+	0: "CURSOR_SET",
+	1: "CURSOR_UNCHANGED",
+	2: "CURSOR_AHEAD",
+}
+
+var CursorImportResult_value = map[string]int32{
+	"CURSOR_SET":       0,
+	"CURSOR_UNCHANGED": 1,
+	"CURSOR_AHEAD":     2,
+}
+
+func (x CursorImportResult) String() string {
+	return proto.EnumName(CursorImportResult_name, int32(x))
+}
+
+func (CursorImportResult) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{5}
+}
+
+// TransferLeaderRequest is sent to hand off leadership of a partition to
+// another member of its ISR.
+type TransferLeaderRequest struct {
+	Stream                string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition             int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	TargetBroker          string   `protobuf:"bytes,3,opt,name=targetBroker,proto3" json:"targetBroker,omitempty"`
+	WaitForLeaderTransfer bool     `protobuf:"varint,4,opt,name=waitForLeaderTransfer,proto3" json:"waitForLeaderTransfer,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *TransferLeaderRequest) Reset()         { *m = TransferLeaderRequest{} }
+func (m *TransferLeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferLeaderRequest) ProtoMessage()    {}
+func (*TransferLeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{0}
+}
+func (m *TransferLeaderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLeaderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferLeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLeaderRequest.Merge(m, src)
+}
+func (m *TransferLeaderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferLeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLeaderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferLeaderRequest proto.InternalMessageInfo
+
+func (m *TransferLeaderRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *TransferLeaderRequest) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *TransferLeaderRequest) GetTargetBroker() string {
+	if m != nil {
+		return m.TargetBroker
+	}
+	return ""
+}
+
+func (m *TransferLeaderRequest) GetWaitForLeaderTransfer() bool {
+	if m != nil {
+		return m.WaitForLeaderTransfer
+	}
+	return false
+}
+
+// TransferLeaderResponse is sent by the server after a partition's leadership
+// has been transferred.
+type TransferLeaderResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransferLeaderResponse) Reset()         { *m = TransferLeaderResponse{} }
+func (m *TransferLeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*TransferLeaderResponse) ProtoMessage()    {}
+func (*TransferLeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{1}
+}
+func (m *TransferLeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferLeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLeaderResponse.Merge(m, src)
+}
+func (m *TransferLeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferLeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferLeaderResponse proto.InternalMessageInfo
+
+// AllocationStatsRequest is sent to retrieve the approximate allocation
+// accounting for the partitions hosted by a broker.
+type AllocationStatsRequest struct {
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AllocationStatsRequest) Reset()         { *m = AllocationStatsRequest{} }
+func (m *AllocationStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*AllocationStatsRequest) ProtoMessage()    {}
+func (*AllocationStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{2}
+}
+func (m *AllocationStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllocationStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocationStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllocationStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocationStatsRequest.Merge(m, src)
+}
+func (m *AllocationStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocationStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocationStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocationStatsRequest proto.InternalMessageInfo
+
+func (m *AllocationStatsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// PartitionAllocations contains the approximate number of bytes allocated on
+// behalf of a partition, broken down by allocation site. These values are
+// extrapolated from sampled allocations and are not exact.
+type PartitionAllocations struct {
+	Stream                string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition             int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	ApproxTotalBytes      int64    `protobuf:"varint,3,opt,name=approxTotalBytes,proto3" json:"approxTotalBytes,omitempty"`
+	ApproxMessageSetBytes int64    `protobuf:"varint,4,opt,name=approxMessageSetBytes,proto3" json:"approxMessageSetBytes,omitempty"`
+	ApproxSubscribeBytes  int64    `protobuf:"varint,5,opt,name=approxSubscribeBytes,proto3" json:"approxSubscribeBytes,omitempty"`
+	ApproxCompactionBytes int64    `protobuf:"varint,6,opt,name=approxCompactionBytes,proto3" json:"approxCompactionBytes,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *PartitionAllocations) Reset()         { *m = PartitionAllocations{} }
+func (m *PartitionAllocations) String() string { return proto.CompactTextString(m) }
+func (*PartitionAllocations) ProtoMessage()    {}
+func (*PartitionAllocations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{3}
+}
+func (m *PartitionAllocations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionAllocations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionAllocations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartitionAllocations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionAllocations.Merge(m, src)
+}
+func (m *PartitionAllocations) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionAllocations) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionAllocations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionAllocations proto.InternalMessageInfo
+
+func (m *PartitionAllocations) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *PartitionAllocations) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *PartitionAllocations) GetApproxTotalBytes() int64 {
+	if m != nil {
+		return m.ApproxTotalBytes
+	}
+	return 0
+}
+
+func (m *PartitionAllocations) GetApproxMessageSetBytes() int64 {
+	if m != nil {
+		return m.ApproxMessageSetBytes
+	}
+	return 0
+}
+
+func (m *PartitionAllocations) GetApproxSubscribeBytes() int64 {
+	if m != nil {
+		return m.ApproxSubscribeBytes
+	}
+	return 0
+}
+
+func (m *PartitionAllocations) GetApproxCompactionBytes() int64 {
+	if m != nil {
+		return m.ApproxCompactionBytes
+	}
+	return 0
+}
+
+// GCStats contains garbage collector statistics for a broker process. Pause
+// durations are in nanoseconds and are estimated from the runtime's pause
+// histogram.
+type GCStats struct {
+	Cycles               uint64   `protobuf:"varint,1,opt,name=cycles,proto3" json:"cycles,omitempty"`
+	HeapAllocBytes       uint64   `protobuf:"varint,2,opt,name=heapAllocBytes,proto3" json:"heapAllocBytes,omitempty"`
+	HeapObjectBytes      uint64   `protobuf:"varint,3,opt,name=heapObjectBytes,proto3" json:"heapObjectBytes,omitempty"`
+	PauseP50             int64    `protobuf:"varint,4,opt,name=pauseP50,proto3" json:"pauseP50,omitempty"`
+	PauseP99             int64    `protobuf:"varint,5,opt,name=pauseP99,proto3" json:"pauseP99,omitempty"`
+	PauseMax             int64    `protobuf:"varint,6,opt,name=pauseMax,proto3" json:"pauseMax,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCStats) Reset()         { *m = GCStats{} }
+func (m *GCStats) String() string { return proto.CompactTextString(m) }
+func (*GCStats) ProtoMessage()    {}
+func (*GCStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{4}
+}
+func (m *GCStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GCStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GCStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GCStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCStats.Merge(m, src)
+}
+func (m *GCStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *GCStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCStats proto.InternalMessageInfo
+
+func (m *GCStats) GetCycles() uint64 {
+	if m != nil {
+		return m.Cycles
+	}
+	return 0
+}
+
+func (m *GCStats) GetHeapAllocBytes() uint64 {
+	if m != nil {
+		return m.HeapAllocBytes
+	}
+	return 0
+}
+
+func (m *GCStats) GetHeapObjectBytes() uint64 {
+	if m != nil {
+		return m.HeapObjectBytes
+	}
+	return 0
+}
+
+func (m *GCStats) GetPauseP50() int64 {
+	if m != nil {
+		return m.PauseP50
+	}
+	return 0
+}
+
+func (m *GCStats) GetPauseP99() int64 {
+	if m != nil {
+		return m.PauseP99
+	}
+	return 0
+}
+
+func (m *GCStats) GetPauseMax() int64 {
+	if m != nil {
+		return m.PauseMax
+	}
+	return 0
+}
+
+// AllocationStatsResponse is sent by the server with its partitions ordered by
+// approximate bytes allocated, largest first, along with its GC statistics so
+// the two can be correlated.
+type AllocationStatsResponse struct {
+	BrokerId             string                  `protobuf:"bytes,1,opt,name=brokerId,proto3" json:"brokerId,omitempty"`
+	SampleRate           int32                   `protobuf:"varint,2,opt,name=sampleRate,proto3" json:"sampleRate,omitempty"`
+	ApproxTotalBytes     int64                   `protobuf:"varint,3,opt,name=approxTotalBytes,proto3" json:"approxTotalBytes,omitempty"`
+	Partitions           []*PartitionAllocations `protobuf:"bytes,4,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	Gc                   *GCStats                `protobuf:"bytes,5,opt,name=gc,proto3" json:"gc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *AllocationStatsResponse) Reset()         { *m = AllocationStatsResponse{} }
+func (m *AllocationStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*AllocationStatsResponse) ProtoMessage()    {}
+func (*AllocationStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{5}
+}
+func (m *AllocationStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllocationStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocationStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllocationStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocationStatsResponse.Merge(m, src)
+}
+func (m *AllocationStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocationStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocationStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocationStatsResponse proto.InternalMessageInfo
+
+func (m *AllocationStatsResponse) GetBrokerId() string {
+	if m != nil {
+		return m.BrokerId
+	}
+	return ""
+}
+
+func (m *AllocationStatsResponse) GetSampleRate() int32 {
+	if m != nil {
+		return m.SampleRate
+	}
+	return 0
+}
+
+func (m *AllocationStatsResponse) GetApproxTotalBytes() int64 {
+	if m != nil {
+		return m.ApproxTotalBytes
+	}
+	return 0
+}
+
+func (m *AllocationStatsResponse) GetPartitions() []*PartitionAllocations {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func (m *AllocationStatsResponse) GetGc() *GCStats {
+	if m != nil {
+		return m.Gc
+	}
+	return nil
+}
+
+// MetadataMemoryStatsRequest is sent to retrieve the sizes of a broker's
+// in-memory metadata structures.
+type MetadataMemoryStatsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MetadataMemoryStatsRequest) Reset()         { *m = MetadataMemoryStatsRequest{} }
+func (m *MetadataMemoryStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*MetadataMemoryStatsRequest) ProtoMessage()    {}
+func (*MetadataMemoryStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{6}
+}
+func (m *MetadataMemoryStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataMemoryStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataMemoryStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataMemoryStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataMemoryStatsRequest.Merge(m, src)
+}
+func (m *MetadataMemoryStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataMemoryStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataMemoryStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataMemoryStatsRequest proto.InternalMessageInfo
+
+// MetadataStructureStats describes the size of an in-memory metadata
+// structure.
+type MetadataStructureStats struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Entries              int64    `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	ApproxBytes          int64    `protobuf:"varint,3,opt,name=approxBytes,proto3" json:"approxBytes,omitempty"`
+	MaxEntries           int64    `protobuf:"varint,4,opt,name=maxEntries,proto3" json:"maxEntries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MetadataStructureStats) Reset()         { *m = MetadataStructureStats{} }
+func (m *MetadataStructureStats) String() string { return proto.CompactTextString(m) }
+func (*MetadataStructureStats) ProtoMessage()    {}
+func (*MetadataStructureStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{7}
+}
+func (m *MetadataStructureStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataStructureStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataStructureStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataStructureStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataStructureStats.Merge(m, src)
+}
+func (m *MetadataStructureStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataStructureStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataStructureStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataStructureStats proto.InternalMessageInfo
+
+func (m *MetadataStructureStats) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MetadataStructureStats) GetEntries() int64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+func (m *MetadataStructureStats) GetApproxBytes() int64 {
+	if m != nil {
+		return m.ApproxBytes
+	}
+	return 0
+}
+
+func (m *MetadataStructureStats) GetMaxEntries() int64 {
+	if m != nil {
+		return m.MaxEntries
+	}
+	return 0
+}
+
+// MetadataMemoryStatsResponse is sent by the server with the sizes of its
+// metadata structures.
+type MetadataMemoryStatsResponse struct {
+	BrokerId             string                    `protobuf:"bytes,1,opt,name=brokerId,proto3" json:"brokerId,omitempty"`
+	Structures           []*MetadataStructureStats `protobuf:"bytes,2,rep,name=structures,proto3" json:"structures,omitempty"`
+	ApproxTotalBytes     int64                     `protobuf:"varint,3,opt,name=approxTotalBytes,proto3" json:"approxTotalBytes,omitempty"`
+	StaleEntriesRemoved  int64                     `protobuf:"varint,4,opt,name=staleEntriesRemoved,proto3" json:"staleEntriesRemoved,omitempty"`
+	LimitViolations      int64                     `protobuf:"varint,5,opt,name=limitViolations,proto3" json:"limitViolations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *MetadataMemoryStatsResponse) Reset()         { *m = MetadataMemoryStatsResponse{} }
+func (m *MetadataMemoryStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*MetadataMemoryStatsResponse) ProtoMessage()    {}
+func (*MetadataMemoryStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{8}
+}
+func (m *MetadataMemoryStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataMemoryStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataMemoryStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataMemoryStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataMemoryStatsResponse.Merge(m, src)
+}
+func (m *MetadataMemoryStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataMemoryStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataMemoryStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataMemoryStatsResponse proto.InternalMessageInfo
+
+func (m *MetadataMemoryStatsResponse) GetBrokerId() string {
+	if m != nil {
+		return m.BrokerId
+	}
+	return ""
+}
+
+func (m *MetadataMemoryStatsResponse) GetStructures() []*MetadataStructureStats {
+	if m != nil {
+		return m.Structures
+	}
+	return nil
+}
+
+func (m *MetadataMemoryStatsResponse) GetApproxTotalBytes() int64 {
+	if m != nil {
+		return m.ApproxTotalBytes
+	}
+	return 0
+}
+
+func (m *MetadataMemoryStatsResponse) GetStaleEntriesRemoved() int64 {
+	if m != nil {
+		return m.StaleEntriesRemoved
+	}
+	return 0
+}
+
+func (m *MetadataMemoryStatsResponse) GetLimitViolations() int64 {
+	if m != nil {
+		return m.LimitViolations
+	}
+	return 0
+}
+
+// FetchBrokerStatsRequest is sent to retrieve metrics for the partitions a
+// broker is a replica of.
+type FetchBrokerStatsRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FetchBrokerStatsRequest) Reset()         { *m = FetchBrokerStatsRequest{} }
+func (m *FetchBrokerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchBrokerStatsRequest) ProtoMessage()    {}
+func (*FetchBrokerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{9}
+}
+func (m *FetchBrokerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchBrokerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchBrokerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchBrokerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchBrokerStatsRequest.Merge(m, src)
+}
+func (m *FetchBrokerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchBrokerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchBrokerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchBrokerStatsRequest proto.InternalMessageInfo
+
+func (m *FetchBrokerStatsRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+// ReplicaLag describes how far a follower is behind the partition leader as
+// tracked by the leader's replicator for that follower.
+type ReplicaLag struct {
+	Replica              string   `protobuf:"bytes,1,opt,name=replica,proto3" json:"replica,omitempty"`
+	LastFetchedOffset    int64    `protobuf:"varint,2,opt,name=lastFetchedOffset,proto3" json:"lastFetchedOffset,omitempty"`
+	Lag                  int64    `protobuf:"varint,3,opt,name=lag,proto3" json:"lag,omitempty"`
+	InIsr                bool     `protobuf:"varint,4,opt,name=inIsr,proto3" json:"inIsr,omitempty"`
+	Throttled            bool     `protobuf:"varint,5,opt,name=throttled,proto3" json:"throttled,omitempty"`
+	ThrottleTime         int64    `protobuf:"varint,6,opt,name=throttleTime,proto3" json:"throttleTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicaLag) Reset()         { *m = ReplicaLag{} }
+func (m *ReplicaLag) String() string { return proto.CompactTextString(m) }
+func (*ReplicaLag) ProtoMessage()    {}
+func (*ReplicaLag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{10}
+}
+func (m *ReplicaLag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicaLag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicaLag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicaLag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaLag.Merge(m, src)
+}
+func (m *ReplicaLag) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicaLag) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaLag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaLag proto.InternalMessageInfo
+
+func (m *ReplicaLag) GetReplica() string {
+	if m != nil {
+		return m.Replica
+	}
+	return ""
+}
+
+func (m *ReplicaLag) GetLastFetchedOffset() int64 {
+	if m != nil {
+		return m.LastFetchedOffset
+	}
+	return 0
+}
+
+func (m *ReplicaLag) GetLag() int64 {
+	if m != nil {
+		return m.Lag
+	}
+	return 0
+}
+
+func (m *ReplicaLag) GetInIsr() bool {
+	if m != nil {
+		return m.InIsr
+	}
+	return false
+}
+
+func (m *ReplicaLag) GetThrottled() bool {
+	if m != nil {
+		return m.Throttled
+	}
+	return false
+}
+
+func (m *ReplicaLag) GetThrottleTime() int64 {
+	if m != nil {
+		return m.ThrottleTime
+	}
+	return 0
+}
+
+// SubscriptionStats describes an active subscription to a partition.
+type SubscriptionStats struct {
+	GroupId              string   `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	ConsumerId           string   `protobuf:"bytes,2,opt,name=consumerId,proto3" json:"consumerId,omitempty"`
+	HeaderFilter         string   `protobuf:"bytes,3,opt,name=headerFilter,proto3" json:"headerFilter,omitempty"`
+	LastOffset           int64    `protobuf:"varint,4,opt,name=lastOffset,proto3" json:"lastOffset,omitempty"`
+	MessagesSent         int64    `protobuf:"varint,5,opt,name=messagesSent,proto3" json:"messagesSent,omitempty"`
+	MessagesFiltered     int64    `protobuf:"varint,6,opt,name=messagesFiltered,proto3" json:"messagesFiltered,omitempty"`
+	ValueFilter          string   `protobuf:"bytes,7,opt,name=valueFilter,proto3" json:"valueFilter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscriptionStats) Reset()         { *m = SubscriptionStats{} }
+func (m *SubscriptionStats) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionStats) ProtoMessage()    {}
+func (*SubscriptionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{11}
+}
+func (m *SubscriptionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriptionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscriptionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionStats.Merge(m, src)
+}
+func (m *SubscriptionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriptionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionStats proto.InternalMessageInfo
+
+func (m *SubscriptionStats) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *SubscriptionStats) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *SubscriptionStats) GetHeaderFilter() string {
+	if m != nil {
+		return m.HeaderFilter
+	}
+	return ""
+}
+
+func (m *SubscriptionStats) GetLastOffset() int64 {
+	if m != nil {
+		return m.LastOffset
+	}
+	return 0
+}
+
+func (m *SubscriptionStats) GetMessagesSent() int64 {
+	if m != nil {
+		return m.MessagesSent
+	}
+	return 0
+}
+
+func (m *SubscriptionStats) GetMessagesFiltered() int64 {
+	if m != nil {
+		return m.MessagesFiltered
+	}
+	return 0
+}
+
+func (m *SubscriptionStats) GetValueFilter() string {
+	if m != nil {
+		return m.ValueFilter
+	}
+	return ""
+}
+
+// IngestSources counts the messages appended to a partition's log by how they
+// entered the partition, as stamped by the leader in the
+// liftbridge-ingest-source header. Followers count replicated messages by the
+// source stamped by the leader as well as in replication.
+type IngestSources struct {
+	Api                  int64    `protobuf:"varint,1,opt,name=api,proto3" json:"api,omitempty"`
+	Nats                 int64    `protobuf:"varint,2,opt,name=nats,proto3" json:"nats,omitempty"`
+	Mirror               int64    `protobuf:"varint,3,opt,name=mirror,proto3" json:"mirror,omitempty"`
+	Replication          int64    `protobuf:"varint,4,opt,name=replication,proto3" json:"replication,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IngestSources) Reset()         { *m = IngestSources{} }
+func (m *IngestSources) String() string { return proto.CompactTextString(m) }
+func (*IngestSources) ProtoMessage()    {}
+func (*IngestSources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{12}
+}
+func (m *IngestSources) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IngestSources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IngestSources.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IngestSources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IngestSources.Merge(m, src)
+}
+func (m *IngestSources) XXX_Size() int {
+	return m.Size()
+}
+func (m *IngestSources) XXX_DiscardUnknown() {
+	xxx_messageInfo_IngestSources.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IngestSources proto.InternalMessageInfo
+
+func (m *IngestSources) GetApi() int64 {
+	if m != nil {
+		return m.Api
+	}
+	return 0
+}
+
+func (m *IngestSources) GetNats() int64 {
+	if m != nil {
+		return m.Nats
+	}
+	return 0
+}
+
+func (m *IngestSources) GetMirror() int64 {
+	if m != nil {
+		return m.Mirror
+	}
+	return 0
+}
+
+func (m *IngestSources) GetReplication() int64 {
+	if m != nil {
+		return m.Replication
+	}
+	return 0
+}
+
+// SegmentRolls counts the log segments a partition's log rolled by the reason
+// they were rolled.
+type SegmentRolls struct {
+	MaxBytes             int64    `protobuf:"varint,1,opt,name=maxBytes,proto3" json:"maxBytes,omitempty"`
+	MaxAge               int64    `protobuf:"varint,2,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	Forced               int64    `protobuf:"varint,3,opt,name=forced,proto3" json:"forced,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentRolls) Reset()         { *m = SegmentRolls{} }
+func (m *SegmentRolls) String() string { return proto.CompactTextString(m) }
+func (*SegmentRolls) ProtoMessage()    {}
+func (*SegmentRolls) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{13}
+}
+func (m *SegmentRolls) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SegmentRolls) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SegmentRolls.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SegmentRolls) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentRolls.Merge(m, src)
+}
+func (m *SegmentRolls) XXX_Size() int {
+	return m.Size()
+}
+func (m *SegmentRolls) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentRolls.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentRolls proto.InternalMessageInfo
+
+func (m *SegmentRolls) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *SegmentRolls) GetMaxAge() int64 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *SegmentRolls) GetForced() int64 {
+	if m != nil {
+		return m.Forced
+	}
+	return 0
+}
+
+// PartitionStats contains metrics for a single partition as seen by the
+// responding broker. Rates are per-second averages over roughly the last
+// minute, rounded down. Counts are since the partition was last started on
+// the broker.
+type PartitionStats struct {
+	Stream               string               `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32                `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Leader               string               `protobuf:"bytes,3,opt,name=leader,proto3" json:"leader,omitempty"`
+	LeaderEpoch          uint64               `protobuf:"varint,4,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+	IsLeader             bool                 `protobuf:"varint,5,opt,name=isLeader,proto3" json:"isLeader,omitempty"`
+	LogStartOffset       int64                `protobuf:"varint,6,opt,name=logStartOffset,proto3" json:"logStartOffset,omitempty"`
+	LogEndOffset         int64                `protobuf:"varint,7,opt,name=logEndOffset,proto3" json:"logEndOffset,omitempty"`
+	HighWatermark        int64                `protobuf:"varint,8,opt,name=highWatermark,proto3" json:"highWatermark,omitempty"`
+	SizeBytes            int64                `protobuf:"varint,9,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+	SegmentCount         int32                `protobuf:"varint,10,opt,name=segmentCount,proto3" json:"segmentCount,omitempty"`
+	Isr                  []string             `protobuf:"bytes,11,rep,name=isr,proto3" json:"isr,omitempty"`
+	MessagesIn           int64                `protobuf:"varint,12,opt,name=messagesIn,proto3" json:"messagesIn,omitempty"`
+	MessagesInRate       int64                `protobuf:"varint,13,opt,name=messagesInRate,proto3" json:"messagesInRate,omitempty"`
+	BytesIn              int64                `protobuf:"varint,14,opt,name=bytesIn,proto3" json:"bytesIn,omitempty"`
+	BytesInRate          int64                `protobuf:"varint,15,opt,name=bytesInRate,proto3" json:"bytesInRate,omitempty"`
+	BytesOut             int64                `protobuf:"varint,16,opt,name=bytesOut,proto3" json:"bytesOut,omitempty"`
+	BytesOutRate         int64                `protobuf:"varint,17,opt,name=bytesOutRate,proto3" json:"bytesOutRate,omitempty"`
+	ReplicaLag           []*ReplicaLag        `protobuf:"bytes,18,rep,name=replicaLag,proto3" json:"replicaLag,omitempty"`
+	Subscriptions        []*SubscriptionStats `protobuf:"bytes,19,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	DuplicatesDropped    int64                `protobuf:"varint,20,opt,name=duplicatesDropped,proto3" json:"duplicatesDropped,omitempty"`
+	TopKeys              []*KeyCount          `protobuf:"bytes,21,rep,name=topKeys,proto3" json:"topKeys,omitempty"`
+	ReadAmplification    *ReadAmplification   `protobuf:"bytes,22,opt,name=readAmplification,proto3" json:"readAmplification,omitempty"`
+	IngestSources        *IngestSources       `protobuf:"bytes,23,opt,name=ingestSources,proto3" json:"ingestSources,omitempty"`
+	SegmentRolls         *SegmentRolls        `protobuf:"bytes,24,opt,name=segmentRolls,proto3" json:"segmentRolls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PartitionStats) Reset()         { *m = PartitionStats{} }
+func (m *PartitionStats) String() string { return proto.CompactTextString(m) }
+func (*PartitionStats) ProtoMessage()    {}
+func (*PartitionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{14}
+}
+func (m *PartitionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartitionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionStats.Merge(m, src)
+}
+func (m *PartitionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionStats proto.InternalMessageInfo
+
+func (m *PartitionStats) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *PartitionStats) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetLeader() string {
+	if m != nil {
+		return m.Leader
+	}
+	return ""
+}
+
+func (m *PartitionStats) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetIsLeader() bool {
+	if m != nil {
+		return m.IsLeader
+	}
+	return false
+}
+
+func (m *PartitionStats) GetLogStartOffset() int64 {
+	if m != nil {
+		return m.LogStartOffset
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetLogEndOffset() int64 {
+	if m != nil {
+		return m.LogEndOffset
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetHighWatermark() int64 {
+	if m != nil {
+		return m.HighWatermark
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetSegmentCount() int32 {
+	if m != nil {
+		return m.SegmentCount
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetIsr() []string {
+	if m != nil {
+		return m.Isr
+	}
+	return nil
+}
+
+func (m *PartitionStats) GetMessagesIn() int64 {
+	if m != nil {
+		return m.MessagesIn
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetMessagesInRate() int64 {
+	if m != nil {
+		return m.MessagesInRate
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetBytesIn() int64 {
+	if m != nil {
+		return m.BytesIn
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetBytesInRate() int64 {
+	if m != nil {
+		return m.BytesInRate
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetBytesOut() int64 {
+	if m != nil {
+		return m.BytesOut
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetBytesOutRate() int64 {
+	if m != nil {
+		return m.BytesOutRate
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetReplicaLag() []*ReplicaLag {
+	if m != nil {
+		return m.ReplicaLag
+	}
+	return nil
+}
+
+func (m *PartitionStats) GetSubscriptions() []*SubscriptionStats {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+func (m *PartitionStats) GetDuplicatesDropped() int64 {
+	if m != nil {
+		return m.DuplicatesDropped
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetTopKeys() []*KeyCount {
+	if m != nil {
+		return m.TopKeys
+	}
+	return nil
+}
+
+func (m *PartitionStats) GetReadAmplification() *ReadAmplification {
+	if m != nil {
+		return m.ReadAmplification
+	}
+	return nil
+}
+
+func (m *PartitionStats) GetIngestSources() *IngestSources {
+	if m != nil {
+		return m.IngestSources
+	}
+	return nil
+}
+
+func (m *PartitionStats) GetSegmentRolls() *SegmentRolls {
+	if m != nil {
+		return m.SegmentRolls
+	}
+	return nil
+}
+
+// FetchBrokerStatsResponse is sent by the server with metrics for each
+// partition it is a replica of. Paused partitions are omitted.
+type FetchBrokerStatsResponse struct {
+	BrokerId               string            `protobuf:"bytes,1,opt,name=brokerId,proto3" json:"brokerId,omitempty"`
+	Partitions             []*PartitionStats `protobuf:"bytes,2,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	UnauthenticatedDropped int64             `protobuf:"varint,3,opt,name=unauthenticatedDropped,proto3" json:"unauthenticatedDropped,omitempty"`
+	ActiveSubscriptions    int64             `protobuf:"varint,4,opt,name=activeSubscriptions,proto3" json:"activeSubscriptions,omitempty"`
+	Propagation            *PropagationStats `protobuf:"bytes,5,opt,name=propagation,proto3" json:"propagation,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}          `json:"-"`
+	XXX_unrecognized       []byte            `json:"-"`
+	XXX_sizecache          int32             `json:"-"`
+}
+
+func (m *FetchBrokerStatsResponse) Reset()         { *m = FetchBrokerStatsResponse{} }
+func (m *FetchBrokerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*FetchBrokerStatsResponse) ProtoMessage()    {}
+func (*FetchBrokerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{15}
+}
+func (m *FetchBrokerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchBrokerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchBrokerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchBrokerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchBrokerStatsResponse.Merge(m, src)
+}
+func (m *FetchBrokerStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchBrokerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchBrokerStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchBrokerStatsResponse proto.InternalMessageInfo
+
+func (m *FetchBrokerStatsResponse) GetBrokerId() string {
+	if m != nil {
+		return m.BrokerId
+	}
+	return ""
+}
+
+func (m *FetchBrokerStatsResponse) GetPartitions() []*PartitionStats {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func (m *FetchBrokerStatsResponse) GetUnauthenticatedDropped() int64 {
+	if m != nil {
+		return m.UnauthenticatedDropped
+	}
+	return 0
+}
+
+func (m *FetchBrokerStatsResponse) GetActiveSubscriptions() int64 {
+	if m != nil {
+		return m.ActiveSubscriptions
+	}
+	return 0
+}
+
+func (m *FetchBrokerStatsResponse) GetPropagation() *PropagationStats {
+	if m != nil {
+		return m.Propagation
+	}
+	return nil
+}
+
+// PropagationStats counts the requests a broker forwarded to the metadata
+// leader. Errors returned by the leader itself aren't failures.
+type PropagationStats struct {
+	Attempts             int64    `protobuf:"varint,1,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Retries              int64    `protobuf:"varint,2,opt,name=retries,proto3" json:"retries,omitempty"`
+	Failures             int64    `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	Inflight             int64    `protobuf:"varint,4,opt,name=inflight,proto3" json:"inflight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PropagationStats) Reset()         { *m = PropagationStats{} }
+func (m *PropagationStats) String() string { return proto.CompactTextString(m) }
+func (*PropagationStats) ProtoMessage()    {}
+func (*PropagationStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{16}
+}
+func (m *PropagationStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PropagationStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PropagationStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PropagationStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PropagationStats.Merge(m, src)
+}
+func (m *PropagationStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PropagationStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PropagationStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PropagationStats proto.InternalMessageInfo
+
+func (m *PropagationStats) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *PropagationStats) GetRetries() int64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *PropagationStats) GetFailures() int64 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *PropagationStats) GetInflight() int64 {
+	if m != nil {
+		return m.Inflight
+	}
+	return 0
+}
+
+// FetchBrokerRTTsRequest is sent to retrieve the inter-broker round-trip time
+// matrix known to a broker.
+type FetchBrokerRTTsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FetchBrokerRTTsRequest) Reset()         { *m = FetchBrokerRTTsRequest{} }
+func (m *FetchBrokerRTTsRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchBrokerRTTsRequest) ProtoMessage()    {}
+func (*FetchBrokerRTTsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{17}
+}
+func (m *FetchBrokerRTTsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchBrokerRTTsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchBrokerRTTsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchBrokerRTTsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchBrokerRTTsRequest.Merge(m, src)
+}
+func (m *FetchBrokerRTTsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchBrokerRTTsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchBrokerRTTsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchBrokerRTTsRequest proto.InternalMessageInfo
+
+// PeerRTT is a round-trip time measured to another broker.
+type PeerRTT struct {
+	Peer                 string   `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	Rtt                  int64    `protobuf:"varint,2,opt,name=rtt,proto3" json:"rtt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerRTT) Reset()         { *m = PeerRTT{} }
+func (m *PeerRTT) String() string { return proto.CompactTextString(m) }
+func (*PeerRTT) ProtoMessage()    {}
+func (*PeerRTT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{18}
+}
+func (m *PeerRTT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerRTT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerRTT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerRTT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerRTT.Merge(m, src)
+}
+func (m *PeerRTT) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerRTT) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerRTT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerRTT proto.InternalMessageInfo
+
+func (m *PeerRTT) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *PeerRTT) GetRtt() int64 {
+	if m != nil {
+		return m.Rtt
+	}
+	return 0
+}
+
+// BrokerRTTs contains the round-trip times most recently measured by a broker
+// to each of its peers.
+type BrokerRTTs struct {
+	Broker               string     `protobuf:"bytes,1,opt,name=broker,proto3" json:"broker,omitempty"`
+	MeasuredAt           int64      `protobuf:"varint,2,opt,name=measuredAt,proto3" json:"measuredAt,omitempty"`
+	Peers                []*PeerRTT `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *BrokerRTTs) Reset()         { *m = BrokerRTTs{} }
+func (m *BrokerRTTs) String() string { return proto.CompactTextString(m) }
+func (*BrokerRTTs) ProtoMessage()    {}
+func (*BrokerRTTs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{19}
+}
+func (m *BrokerRTTs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BrokerRTTs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BrokerRTTs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BrokerRTTs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BrokerRTTs.Merge(m, src)
+}
+func (m *BrokerRTTs) XXX_Size() int {
+	return m.Size()
+}
+func (m *BrokerRTTs) XXX_DiscardUnknown() {
+	xxx_messageInfo_BrokerRTTs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BrokerRTTs proto.InternalMessageInfo
+
+func (m *BrokerRTTs) GetBroker() string {
+	if m != nil {
+		return m.Broker
+	}
+	return ""
+}
+
+func (m *BrokerRTTs) GetMeasuredAt() int64 {
+	if m != nil {
+		return m.MeasuredAt
+	}
+	return 0
+}
+
+func (m *BrokerRTTs) GetPeers() []*PeerRTT {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+// FetchBrokerRTTsResponse is sent by the server with a row of the RTT matrix
+// for each broker it has received measurements from.
+type FetchBrokerRTTsResponse struct {
+	BrokerId             string        `protobuf:"bytes,1,opt,name=brokerId,proto3" json:"brokerId,omitempty"`
+	Brokers              []*BrokerRTTs `protobuf:"bytes,2,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *FetchBrokerRTTsResponse) Reset()         { *m = FetchBrokerRTTsResponse{} }
+func (m *FetchBrokerRTTsResponse) String() string { return proto.CompactTextString(m) }
+func (*FetchBrokerRTTsResponse) ProtoMessage()    {}
+func (*FetchBrokerRTTsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{20}
+}
+func (m *FetchBrokerRTTsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchBrokerRTTsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchBrokerRTTsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchBrokerRTTsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchBrokerRTTsResponse.Merge(m, src)
+}
+func (m *FetchBrokerRTTsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchBrokerRTTsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchBrokerRTTsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchBrokerRTTsResponse proto.InternalMessageInfo
+
+func (m *FetchBrokerRTTsResponse) GetBrokerId() string {
+	if m != nil {
+		return m.BrokerId
+	}
+	return ""
+}
+
+func (m *FetchBrokerRTTsResponse) GetBrokers() []*BrokerRTTs {
+	if m != nil {
+		return m.Brokers
+	}
+	return nil
+}
+
+// DescribeStreamsRequest is sent to retrieve the full metadata of streams,
+// including their configuration and origin.
+type DescribeStreamsRequest struct {
+	Streams              []string `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeStreamsRequest) Reset()         { *m = DescribeStreamsRequest{} }
+func (m *DescribeStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeStreamsRequest) ProtoMessage()    {}
+func (*DescribeStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{21}
+}
+func (m *DescribeStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeStreamsRequest.Merge(m, src)
+}
+func (m *DescribeStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeStreamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeStreamsRequest proto.InternalMessageInfo
+
+func (m *DescribeStreamsRequest) GetStreams() []string {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+// PartitionIngestSources contains the ingest source counts of a partition as
+// seen by the responding broker.
+type PartitionIngestSources struct {
+	Stream               string         `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32          `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Sources              *IngestSources `protobuf:"bytes,3,opt,name=sources,proto3" json:"sources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PartitionIngestSources) Reset()         { *m = PartitionIngestSources{} }
+func (m *PartitionIngestSources) String() string { return proto.CompactTextString(m) }
+func (*PartitionIngestSources) ProtoMessage()    {}
+func (*PartitionIngestSources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{22}
+}
+func (m *PartitionIngestSources) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionIngestSources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionIngestSources.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartitionIngestSources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionIngestSources.Merge(m, src)
+}
+func (m *PartitionIngestSources) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionIngestSources) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionIngestSources.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionIngestSources proto.InternalMessageInfo
+
+func (m *PartitionIngestSources) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *PartitionIngestSources) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *PartitionIngestSources) GetSources() *IngestSources {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+// DescribeStreamsResponse is sent by the server with the requested streams.
+// Streams which don't exist are omitted.
+type DescribeStreamsResponse struct {
+	Streams              []*Stream                 `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	IngestSources        []*PartitionIngestSources `protobuf:"bytes,2,rep,name=ingestSources,proto3" json:"ingestSources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *DescribeStreamsResponse) Reset()         { *m = DescribeStreamsResponse{} }
+func (m *DescribeStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeStreamsResponse) ProtoMessage()    {}
+func (*DescribeStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{23}
+}
+func (m *DescribeStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeStreamsResponse.Merge(m, src)
+}
+func (m *DescribeStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeStreamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeStreamsResponse proto.InternalMessageInfo
+
+func (m *DescribeStreamsResponse) GetStreams() []*Stream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *DescribeStreamsResponse) GetIngestSources() []*PartitionIngestSources {
+	if m != nil {
+		return m.IngestSources
+	}
+	return nil
+}
+
+// GetStreamConfigRequest is sent to retrieve the effective configuration of a
+// stream.
+type GetStreamConfigRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStreamConfigRequest) Reset()         { *m = GetStreamConfigRequest{} }
+func (m *GetStreamConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStreamConfigRequest) ProtoMessage()    {}
+func (*GetStreamConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{24}
+}
+func (m *GetStreamConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetStreamConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetStreamConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetStreamConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStreamConfigRequest.Merge(m, src)
+}
+func (m *GetStreamConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStreamConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStreamConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStreamConfigRequest proto.InternalMessageInfo
+
+func (m *GetStreamConfigRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+// GetStreamConfigResponse is sent by the server with the effective
+// configuration of a stream. Settings the stream was created without are set
+// to the responding broker's defaults.
+type GetStreamConfigResponse struct {
+	Config               *StreamConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetStreamConfigResponse) Reset()         { *m = GetStreamConfigResponse{} }
+func (m *GetStreamConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStreamConfigResponse) ProtoMessage()    {}
+func (*GetStreamConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{25}
+}
+func (m *GetStreamConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetStreamConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetStreamConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetStreamConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStreamConfigResponse.Merge(m, src)
+}
+func (m *GetStreamConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStreamConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStreamConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStreamConfigResponse proto.InternalMessageInfo
+
+func (m *GetStreamConfigResponse) GetConfig() *StreamConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// UpdateStreamConfigRequest is sent to change the configuration of a stream.
+// Only the retention, segment, and compaction settings can be changed.
+type UpdateStreamConfigRequest struct {
+	Stream               string        `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Config               *StreamConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *UpdateStreamConfigRequest) Reset()         { *m = UpdateStreamConfigRequest{} }
+func (m *UpdateStreamConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateStreamConfigRequest) ProtoMessage()    {}
+func (*UpdateStreamConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{26}
+}
+func (m *UpdateStreamConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateStreamConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateStreamConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateStreamConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStreamConfigRequest.Merge(m, src)
+}
+func (m *UpdateStreamConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateStreamConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStreamConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateStreamConfigRequest proto.InternalMessageInfo
+
+func (m *UpdateStreamConfigRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *UpdateStreamConfigRequest) GetConfig() *StreamConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// UpdateStreamConfigResponse is sent by the server once the change has been
+// applied.
+type UpdateStreamConfigResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateStreamConfigResponse) Reset()         { *m = UpdateStreamConfigResponse{} }
+func (m *UpdateStreamConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateStreamConfigResponse) ProtoMessage()    {}
+func (*UpdateStreamConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{27}
+}
+func (m *UpdateStreamConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateStreamConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateStreamConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateStreamConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStreamConfigResponse.Merge(m, src)
+}
+func (m *UpdateStreamConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateStreamConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStreamConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateStreamConfigResponse proto.InternalMessageInfo
+
+// FetchPartitionStatusRequest is sent to retrieve the lifecycle state of a
+// partition.
+type FetchPartitionStatusRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	AllReplicas          bool     `protobuf:"varint,3,opt,name=allReplicas,proto3" json:"allReplicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FetchPartitionStatusRequest) Reset()         { *m = FetchPartitionStatusRequest{} }
+func (m *FetchPartitionStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchPartitionStatusRequest) ProtoMessage()    {}
+func (*FetchPartitionStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{28}
+}
+func (m *FetchPartitionStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchPartitionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchPartitionStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchPartitionStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchPartitionStatusRequest.Merge(m, src)
+}
+func (m *FetchPartitionStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchPartitionStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchPartitionStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchPartitionStatusRequest proto.InternalMessageInfo
+
+func (m *FetchPartitionStatusRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *FetchPartitionStatusRequest) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *FetchPartitionStatusRequest) GetAllReplicas() bool {
+	if m != nil {
+		return m.AllReplicas
+	}
+	return false
+}
+
+// FetchPartitionStatusResponse is sent by the server with the status of the
+// partition on the responding broker, or on each replica if requested.
+type FetchPartitionStatusResponse struct {
+	Replicas             []*PartitionReplicaStatus `protobuf:"bytes,1,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *FetchPartitionStatusResponse) Reset()         { *m = FetchPartitionStatusResponse{} }
+func (m *FetchPartitionStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*FetchPartitionStatusResponse) ProtoMessage()    {}
+func (*FetchPartitionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{29}
+}
+func (m *FetchPartitionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchPartitionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchPartitionStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchPartitionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchPartitionStatusResponse.Merge(m, src)
+}
+func (m *FetchPartitionStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchPartitionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchPartitionStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchPartitionStatusResponse proto.InternalMessageInfo
+
+func (m *FetchPartitionStatusResponse) GetReplicas() []*PartitionReplicaStatus {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+// ScheduleStreamOperationRequest is sent to schedule a stream operation to be
+// executed by the metadata leader at a later time.
+type ScheduleStreamOperationRequest struct {
+	Type                 ScheduledOperationType `protobuf:"varint,1,opt,name=type,proto3,enum=protocol.ScheduledOperationType" json:"type,omitempty"`
+	Stream               string                 `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partitions           []int32                `protobuf:"varint,3,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
+	Readonly             bool                   `protobuf:"varint,4,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	ExecuteAt            int64                  `protobuf:"varint,5,opt,name=executeAt,proto3" json:"executeAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ScheduleStreamOperationRequest) Reset()         { *m = ScheduleStreamOperationRequest{} }
+func (m *ScheduleStreamOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*ScheduleStreamOperationRequest) ProtoMessage()    {}
+func (*ScheduleStreamOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{30}
+}
+func (m *ScheduleStreamOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleStreamOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleStreamOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleStreamOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleStreamOperationRequest.Merge(m, src)
+}
+func (m *ScheduleStreamOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleStreamOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleStreamOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleStreamOperationRequest proto.InternalMessageInfo
+
+func (m *ScheduleStreamOperationRequest) GetType() ScheduledOperationType {
+	if m != nil {
+		return m.Type
+	}
+	return ScheduledOperationType_SCHEDULED_PAUSE_STREAM
+}
+
+func (m *ScheduleStreamOperationRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *ScheduleStreamOperationRequest) GetPartitions() []int32 {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func (m *ScheduleStreamOperationRequest) GetReadonly() bool {
+	if m != nil {
+		return m.Readonly
+	}
+	return false
+}
+
+func (m *ScheduleStreamOperationRequest) GetExecuteAt() int64 {
+	if m != nil {
+		return m.ExecuteAt
+	}
+	return 0
+}
+
+// ScheduleStreamOperationResponse is sent by the server with the scheduled
+// operation.
+type ScheduleStreamOperationResponse struct {
+	Operation            *ScheduledOperation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ScheduleStreamOperationResponse) Reset()         { *m = ScheduleStreamOperationResponse{} }
+func (m *ScheduleStreamOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*ScheduleStreamOperationResponse) ProtoMessage()    {}
+func (*ScheduleStreamOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{31}
+}
+func (m *ScheduleStreamOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleStreamOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleStreamOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleStreamOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleStreamOperationResponse.Merge(m, src)
+}
+func (m *ScheduleStreamOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleStreamOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleStreamOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleStreamOperationResponse proto.InternalMessageInfo
+
+func (m *ScheduleStreamOperationResponse) GetOperation() *ScheduledOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return nil
+}
+
+// ListScheduledOperationsRequest is sent to list scheduled operations.
+type ListScheduledOperationsRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	IncludeFinished      bool     `protobuf:"varint,2,opt,name=includeFinished,proto3" json:"includeFinished,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListScheduledOperationsRequest) Reset()         { *m = ListScheduledOperationsRequest{} }
+func (m *ListScheduledOperationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListScheduledOperationsRequest) ProtoMessage()    {}
+func (*ListScheduledOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{32}
+}
+func (m *ListScheduledOperationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListScheduledOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListScheduledOperationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListScheduledOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListScheduledOperationsRequest.Merge(m, src)
+}
+func (m *ListScheduledOperationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListScheduledOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListScheduledOperationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListScheduledOperationsRequest proto.InternalMessageInfo
+
+func (m *ListScheduledOperationsRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *ListScheduledOperationsRequest) GetIncludeFinished() bool {
+	if m != nil {
+		return m.IncludeFinished
+	}
+	return false
+}
+
+// ListScheduledOperationsResponse is sent by the server with the scheduled
+// operations ordered by ID.
+type ListScheduledOperationsResponse struct {
+	Operations           []*ScheduledOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListScheduledOperationsResponse) Reset()         { *m = ListScheduledOperationsResponse{} }
+func (m *ListScheduledOperationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListScheduledOperationsResponse) ProtoMessage()    {}
+func (*ListScheduledOperationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{33}
+}
+func (m *ListScheduledOperationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListScheduledOperationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListScheduledOperationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListScheduledOperationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListScheduledOperationsResponse.Merge(m, src)
+}
+func (m *ListScheduledOperationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListScheduledOperationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListScheduledOperationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListScheduledOperationsResponse proto.InternalMessageInfo
+
+func (m *ListScheduledOperationsResponse) GetOperations() []*ScheduledOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+// CancelScheduledOperationRequest is sent to cancel a pending scheduled
+// operation.
+type CancelScheduledOperationRequest struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelScheduledOperationRequest) Reset()         { *m = CancelScheduledOperationRequest{} }
+func (m *CancelScheduledOperationRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelScheduledOperationRequest) ProtoMessage()    {}
+func (*CancelScheduledOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{34}
+}
+func (m *CancelScheduledOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelScheduledOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelScheduledOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelScheduledOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelScheduledOperationRequest.Merge(m, src)
+}
+func (m *CancelScheduledOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelScheduledOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelScheduledOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelScheduledOperationRequest proto.InternalMessageInfo
+
+func (m *CancelScheduledOperationRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// CancelScheduledOperationResponse is sent by the server after canceling a
+// scheduled operation.
+type CancelScheduledOperationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelScheduledOperationResponse) Reset()         { *m = CancelScheduledOperationResponse{} }
+func (m *CancelScheduledOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelScheduledOperationResponse) ProtoMessage()    {}
+func (*CancelScheduledOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{35}
+}
+func (m *CancelScheduledOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelScheduledOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelScheduledOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelScheduledOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelScheduledOperationResponse.Merge(m, src)
+}
+func (m *CancelScheduledOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelScheduledOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelScheduledOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelScheduledOperationResponse proto.InternalMessageInfo
+
+// UpdateStreamOwnerRequest is sent to reassign ownership of a stream.
+type UpdateStreamOwnerRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateStreamOwnerRequest) Reset()         { *m = UpdateStreamOwnerRequest{} }
+func (m *UpdateStreamOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateStreamOwnerRequest) ProtoMessage()    {}
+func (*UpdateStreamOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{36}
+}
+func (m *UpdateStreamOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateStreamOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateStreamOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateStreamOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStreamOwnerRequest.Merge(m, src)
+}
+func (m *UpdateStreamOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateStreamOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStreamOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateStreamOwnerRequest proto.InternalMessageInfo
+
+func (m *UpdateStreamOwnerRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *UpdateStreamOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// UpdateStreamOwnerResponse is sent by the server after the owner of a stream
+// has been updated.
+type UpdateStreamOwnerResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateStreamOwnerResponse) Reset()         { *m = UpdateStreamOwnerResponse{} }
+func (m *UpdateStreamOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateStreamOwnerResponse) ProtoMessage()    {}
+func (*UpdateStreamOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{37}
+}
+func (m *UpdateStreamOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateStreamOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateStreamOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateStreamOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStreamOwnerResponse.Merge(m, src)
+}
+func (m *UpdateStreamOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateStreamOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStreamOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateStreamOwnerResponse proto.InternalMessageInfo
+
+// SetStreamTagsRequest is sent to replace the tags of a stream.
+type SetStreamTagsRequest struct {
+	Stream               string       `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Tags                 []*StreamTag `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SetStreamTagsRequest) Reset()         { *m = SetStreamTagsRequest{} }
+func (m *SetStreamTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetStreamTagsRequest) ProtoMessage()    {}
+func (*SetStreamTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{38}
+}
+func (m *SetStreamTagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetStreamTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetStreamTagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetStreamTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStreamTagsRequest.Merge(m, src)
+}
+func (m *SetStreamTagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetStreamTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStreamTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetStreamTagsRequest proto.InternalMessageInfo
+
+func (m *SetStreamTagsRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *SetStreamTagsRequest) GetTags() []*StreamTag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// SetStreamTagsResponse is sent by the server after the tags of a stream have
+// been replaced.
+type SetStreamTagsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetStreamTagsResponse) Reset()         { *m = SetStreamTagsResponse{} }
+func (m *SetStreamTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetStreamTagsResponse) ProtoMessage()    {}
+func (*SetStreamTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{39}
+}
+func (m *SetStreamTagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetStreamTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetStreamTagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetStreamTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStreamTagsResponse.Merge(m, src)
+}
+func (m *SetStreamTagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetStreamTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStreamTagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetStreamTagsResponse proto.InternalMessageInfo
+
+// SetStreamACLRequest is sent to replace the access control list of a stream.
+type SetStreamACLRequest struct {
+	Stream               string     `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Acl                  *StreamACL `protobuf:"bytes,2,opt,name=acl,proto3" json:"acl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetStreamACLRequest) Reset()         { *m = SetStreamACLRequest{} }
+func (m *SetStreamACLRequest) String() string { return proto.CompactTextString(m) }
+func (*SetStreamACLRequest) ProtoMessage()    {}
+func (*SetStreamACLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{40}
+}
+func (m *SetStreamACLRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetStreamACLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetStreamACLRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetStreamACLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStreamACLRequest.Merge(m, src)
+}
+func (m *SetStreamACLRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetStreamACLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStreamACLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetStreamACLRequest proto.InternalMessageInfo
+
+func (m *SetStreamACLRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *SetStreamACLRequest) GetAcl() *StreamACL {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+// SetStreamACLResponse is sent by the server after the access control list of
+// a stream has been replaced.
+type SetStreamACLResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetStreamACLResponse) Reset()         { *m = SetStreamACLResponse{} }
+func (m *SetStreamACLResponse) String() string { return proto.CompactTextString(m) }
+func (*SetStreamACLResponse) ProtoMessage()    {}
+func (*SetStreamACLResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{41}
+}
+func (m *SetStreamACLResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetStreamACLResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetStreamACLResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetStreamACLResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStreamACLResponse.Merge(m, src)
+}
+func (m *SetStreamACLResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetStreamACLResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStreamACLResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetStreamACLResponse proto.InternalMessageInfo
+
+// DeletePartitionDataRequest is sent to delete the messages of a partition
+// before the given offset ahead of retention.
+type DeletePartitionDataRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePartitionDataRequest) Reset()         { *m = DeletePartitionDataRequest{} }
+func (m *DeletePartitionDataRequest) String() string { return proto.CompactTextString(m) }
+func (*DeletePartitionDataRequest) ProtoMessage()    {}
+func (*DeletePartitionDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{42}
+}
+func (m *DeletePartitionDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletePartitionDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletePartitionDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeletePartitionDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePartitionDataRequest.Merge(m, src)
+}
+func (m *DeletePartitionDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletePartitionDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePartitionDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePartitionDataRequest proto.InternalMessageInfo
+
+func (m *DeletePartitionDataRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *DeletePartitionDataRequest) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *DeletePartitionDataRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+// DeletePartitionDataResponse is sent by the server after the log start
+// offset of a partition has been advanced.
+type DeletePartitionDataResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePartitionDataResponse) Reset()         { *m = DeletePartitionDataResponse{} }
+func (m *DeletePartitionDataResponse) String() string { return proto.CompactTextString(m) }
+func (*DeletePartitionDataResponse) ProtoMessage()    {}
+func (*DeletePartitionDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{43}
+}
+func (m *DeletePartitionDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletePartitionDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletePartitionDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeletePartitionDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePartitionDataResponse.Merge(m, src)
+}
+func (m *DeletePartitionDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletePartitionDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePartitionDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePartitionDataResponse proto.InternalMessageInfo
+
+// DeletePartitionRequest is sent to delete a single partition of a stream
+// along with its data. The last partition of a stream can't be deleted.
+type DeletePartitionRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePartitionRequest) Reset()         { *m = DeletePartitionRequest{} }
+func (m *DeletePartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*DeletePartitionRequest) ProtoMessage()    {}
+func (*DeletePartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{44}
+}
+func (m *DeletePartitionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletePartitionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeletePartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePartitionRequest.Merge(m, src)
+}
+func (m *DeletePartitionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletePartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePartitionRequest proto.InternalMessageInfo
+
+func (m *DeletePartitionRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *DeletePartitionRequest) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+// DeletePartitionResponse is sent by the server once the partition has been
+// deleted.
+type DeletePartitionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePartitionResponse) Reset()         { *m = DeletePartitionResponse{} }
+func (m *DeletePartitionResponse) String() string { return proto.CompactTextString(m) }
+func (*DeletePartitionResponse) ProtoMessage()    {}
+func (*DeletePartitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{45}
+}
+func (m *DeletePartitionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletePartitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletePartitionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeletePartitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePartitionResponse.Merge(m, src)
+}
+func (m *DeletePartitionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletePartitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePartitionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePartitionResponse proto.InternalMessageInfo
+
+// NackMessageRequest is sent by a consumer which failed to process a message
+// to move it to its stream's dead letter queue.
+type NackMessageRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CursorId             string   `protobuf:"bytes,5,opt,name=cursorId,proto3" json:"cursorId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NackMessageRequest) Reset()         { *m = NackMessageRequest{} }
+func (m *NackMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*NackMessageRequest) ProtoMessage()    {}
+func (*NackMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{46}
+}
+func (m *NackMessageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NackMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NackMessageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NackMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NackMessageRequest.Merge(m, src)
+}
+func (m *NackMessageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NackMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NackMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NackMessageRequest proto.InternalMessageInfo
+
+func (m *NackMessageRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *NackMessageRequest) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *NackMessageRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *NackMessageRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *NackMessageRequest) GetCursorId() string {
+	if m != nil {
+		return m.CursorId
+	}
+	return ""
+}
+
+// NackMessageResponse is sent by the server after a message has been moved to
+// the dead letter queue.
+type NackMessageResponse struct {
+	DeadLetterOffset     int64    `protobuf:"varint,1,opt,name=deadLetterOffset,proto3" json:"deadLetterOffset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NackMessageResponse) Reset()         { *m = NackMessageResponse{} }
+func (m *NackMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*NackMessageResponse) ProtoMessage()    {}
+func (*NackMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{47}
+}
+func (m *NackMessageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NackMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NackMessageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NackMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NackMessageResponse.Merge(m, src)
+}
+func (m *NackMessageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NackMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NackMessageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NackMessageResponse proto.InternalMessageInfo
+
+func (m *NackMessageResponse) GetDeadLetterOffset() int64 {
+	if m != nil {
+		return m.DeadLetterOffset
+	}
+	return 0
+}
+
+// ExportMessagesRequest is sent to export the messages of a stream. Offset and
+// timestamp bounds are inclusive and apply to each exported partition. Only
+// committed messages are exported.
+type ExportMessagesRequest struct {
+	Stream               string         `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partitions           []int32        `protobuf:"varint,2,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
+	StartOffset          *NullableInt64 `protobuf:"bytes,3,opt,name=startOffset,proto3" json:"startOffset,omitempty"`
+	StopOffset           *NullableInt64 `protobuf:"bytes,4,opt,name=stopOffset,proto3" json:"stopOffset,omitempty"`
+	StartTimestamp       *NullableInt64 `protobuf:"bytes,5,opt,name=startTimestamp,proto3" json:"startTimestamp,omitempty"`
+	StopTimestamp        *NullableInt64 `protobuf:"bytes,6,opt,name=stopTimestamp,proto3" json:"stopTimestamp,omitempty"`
+	Format               ExportFormat   `protobuf:"varint,7,opt,name=format,proto3,enum=protocol.ExportFormat" json:"format,omitempty"`
+	ChunkSize            int32          `protobuf:"varint,8,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
+	ResumeToken          string         `protobuf:"bytes,9,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ExportMessagesRequest) Reset()         { *m = ExportMessagesRequest{} }
+func (m *ExportMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportMessagesRequest) ProtoMessage()    {}
+func (*ExportMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{48}
+}
+func (m *ExportMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMessagesRequest.Merge(m, src)
+}
+func (m *ExportMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportMessagesRequest proto.InternalMessageInfo
+
+func (m *ExportMessagesRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *ExportMessagesRequest) GetPartitions() []int32 {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func (m *ExportMessagesRequest) GetStartOffset() *NullableInt64 {
+	if m != nil {
+		return m.StartOffset
+	}
+	return nil
+}
+
+func (m *ExportMessagesRequest) GetStopOffset() *NullableInt64 {
+	if m != nil {
+		return m.StopOffset
+	}
+	return nil
+}
+
+func (m *ExportMessagesRequest) GetStartTimestamp() *NullableInt64 {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return nil
+}
+
+func (m *ExportMessagesRequest) GetStopTimestamp() *NullableInt64 {
+	if m != nil {
+		return m.StopTimestamp
+	}
+	return nil
+}
+
+func (m *ExportMessagesRequest) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_JSONL
+}
+
+func (m *ExportMessagesRequest) GetChunkSize() int32 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+func (m *ExportMessagesRequest) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return ""
+}
+
+// ExportMessagesResponse is a chunk of exported messages from a single
+// partition. Each chunk can be decoded on its own.
+type ExportMessagesResponse struct {
+	Partition            int32    `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	Messages             int32    `protobuf:"varint,2,opt,name=messages,proto3" json:"messages,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	ResumeToken          string   `protobuf:"bytes,4,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportMessagesResponse) Reset()         { *m = ExportMessagesResponse{} }
+func (m *ExportMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportMessagesResponse) ProtoMessage()    {}
+func (*ExportMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{49}
+}
+func (m *ExportMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMessagesResponse.Merge(m, src)
+}
+func (m *ExportMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportMessagesResponse proto.InternalMessageInfo
+
+func (m *ExportMessagesResponse) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *ExportMessagesResponse) GetMessages() int32 {
+	if m != nil {
+		return m.Messages
+	}
+	return 0
+}
+
+func (m *ExportMessagesResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ExportMessagesResponse) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return ""
+}
+
+// ExportPartitionOffset records the next offset to export for a partition.
+type ExportPartitionOffset struct {
+	Partition            int32    `protobuf:"varint,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	NextOffset           int64    `protobuf:"varint,2,opt,name=nextOffset,proto3" json:"nextOffset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportPartitionOffset) Reset()         { *m = ExportPartitionOffset{} }
+func (m *ExportPartitionOffset) String() string { return proto.CompactTextString(m) }
+func (*ExportPartitionOffset) ProtoMessage()    {}
+func (*ExportPartitionOffset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{50}
+}
+func (m *ExportPartitionOffset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportPartitionOffset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportPartitionOffset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportPartitionOffset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportPartitionOffset.Merge(m, src)
+}
+func (m *ExportPartitionOffset) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportPartitionOffset) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportPartitionOffset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportPartitionOffset proto.InternalMessageInfo
+
+func (m *ExportPartitionOffset) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *ExportPartitionOffset) GetNextOffset() int64 {
+	if m != nil {
+		return m.NextOffset
+	}
+	return 0
+}
+
+// ExportResumeToken is the decoded form of an export resume token.
+type ExportResumeToken struct {
+	Partitions           []*ExportPartitionOffset `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ExportResumeToken) Reset()         { *m = ExportResumeToken{} }
+func (m *ExportResumeToken) String() string { return proto.CompactTextString(m) }
+func (*ExportResumeToken) ProtoMessage()    {}
+func (*ExportResumeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{51}
+}
+func (m *ExportResumeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportResumeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportResumeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportResumeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResumeToken.Merge(m, src)
+}
+func (m *ExportResumeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportResumeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResumeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResumeToken proto.InternalMessageInfo
+
+func (m *ExportResumeToken) GetPartitions() []*ExportPartitionOffset {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+// TriggerCompactionRequest is sent to compact a partition's log on the server
+// receiving the request.
+type TriggerCompactionRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerCompactionRequest) Reset()         { *m = TriggerCompactionRequest{} }
+func (m *TriggerCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*TriggerCompactionRequest) ProtoMessage()    {}
+func (*TriggerCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{52}
+}
+func (m *TriggerCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerCompactionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerCompactionRequest.Merge(m, src)
+}
+func (m *TriggerCompactionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerCompactionRequest proto.InternalMessageInfo
+
+func (m *TriggerCompactionRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *TriggerCompactionRequest) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+// TriggerCompactionResponse reports the progress of a triggered compaction.
+// The last response has done set.
+type TriggerCompactionResponse struct {
+	SegmentsProcessed    int32    `protobuf:"varint,1,opt,name=segmentsProcessed,proto3" json:"segmentsProcessed,omitempty"`
+	BytesReclaimed       int64    `protobuf:"varint,2,opt,name=bytesReclaimed,proto3" json:"bytesReclaimed,omitempty"`
+	KeysCompacted        int64    `protobuf:"varint,3,opt,name=keysCompacted,proto3" json:"keysCompacted,omitempty"`
+	Done                 bool     `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	TombstonesDropped    int64    `protobuf:"varint,5,opt,name=tombstonesDropped,proto3" json:"tombstonesDropped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerCompactionResponse) Reset()         { *m = TriggerCompactionResponse{} }
+func (m *TriggerCompactionResponse) String() string { return proto.CompactTextString(m) }
+func (*TriggerCompactionResponse) ProtoMessage()    {}
+func (*TriggerCompactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{53}
+}
+func (m *TriggerCompactionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerCompactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerCompactionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerCompactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerCompactionResponse.Merge(m, src)
+}
+func (m *TriggerCompactionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerCompactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerCompactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerCompactionResponse proto.InternalMessageInfo
+
+func (m *TriggerCompactionResponse) GetSegmentsProcessed() int32 {
+	if m != nil {
+		return m.SegmentsProcessed
+	}
+	return 0
+}
+
+func (m *TriggerCompactionResponse) GetBytesReclaimed() int64 {
+	if m != nil {
+		return m.BytesReclaimed
+	}
+	return 0
+}
+
+func (m *TriggerCompactionResponse) GetKeysCompacted() int64 {
+	if m != nil {
+		return m.KeysCompacted
+	}
+	return 0
+}
+
+func (m *TriggerCompactionResponse) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *TriggerCompactionResponse) GetTombstonesDropped() int64 {
+	if m != nil {
+		return m.TombstonesDropped
+	}
+	return 0
+}
+
+// CleanPartitionRequest is sent to apply the retention and compaction rules
+// to a partition's log immediately.
+type CleanPartitionRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	IncludeFollowers     bool     `protobuf:"varint,3,opt,name=includeFollowers,proto3" json:"includeFollowers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CleanPartitionRequest) Reset()         { *m = CleanPartitionRequest{} }
+func (m *CleanPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*CleanPartitionRequest) ProtoMessage()    {}
+func (*CleanPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{54}
+}
+func (m *CleanPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CleanPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CleanPartitionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CleanPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanPartitionRequest.Merge(m, src)
+}
+func (m *CleanPartitionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CleanPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanPartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CleanPartitionRequest proto.InternalMessageInfo
+
+func (m *CleanPartitionRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *CleanPartitionRequest) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *CleanPartitionRequest) GetIncludeFollowers() bool {
+	if m != nil {
+		return m.IncludeFollowers
+	}
+	return false
+}
+
+// CleanPartitionResponse is sent by the server with the result of the clean
+// on the partition leader followed by each follower, if requested.
+type CleanPartitionResponse struct {
+	Replicas             []*PartitionCleanResult `protobuf:"bytes,1,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CleanPartitionResponse) Reset()         { *m = CleanPartitionResponse{} }
+func (m *CleanPartitionResponse) String() string { return proto.CompactTextString(m) }
+func (*CleanPartitionResponse) ProtoMessage()    {}
+func (*CleanPartitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{55}
+}
+func (m *CleanPartitionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CleanPartitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CleanPartitionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CleanPartitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanPartitionResponse.Merge(m, src)
+}
+func (m *CleanPartitionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CleanPartitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanPartitionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CleanPartitionResponse proto.InternalMessageInfo
+
+func (m *CleanPartitionResponse) GetReplicas() []*PartitionCleanResult {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+// CheckMessageExistsRequest is sent to the partition leader to check if a
+// message was written, identified either by the correlation ID it was
+// published with in journaled mode or by the offset and leader epoch it was
+// acked with.
+type CheckMessageExistsRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	CorrelationId        string   `protobuf:"bytes,3,opt,name=correlationId,proto3" json:"correlationId,omitempty"`
+	Offset               int64    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	LeaderEpoch          uint64   `protobuf:"varint,5,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckMessageExistsRequest) Reset()         { *m = CheckMessageExistsRequest{} }
+func (m *CheckMessageExistsRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckMessageExistsRequest) ProtoMessage()    {}
+func (*CheckMessageExistsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{56}
+}
+func (m *CheckMessageExistsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckMessageExistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckMessageExistsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckMessageExistsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckMessageExistsRequest.Merge(m, src)
+}
+func (m *CheckMessageExistsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckMessageExistsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckMessageExistsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckMessageExistsRequest proto.InternalMessageInfo
+
+func (m *CheckMessageExistsRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *CheckMessageExistsRequest) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *CheckMessageExistsRequest) GetCorrelationId() string {
+	if m != nil {
+		return m.CorrelationId
+	}
+	return ""
+}
+
+func (m *CheckMessageExistsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *CheckMessageExistsRequest) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
+	}
+	return 0
+}
+
+// CheckMessageExistsResponse is sent by the partition leader with where the
+// message was written, if it was.
+type CheckMessageExistsResponse struct {
+	Exists               bool     `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	LeaderEpoch          uint64   `protobuf:"varint,3,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+	Committed            bool     `protobuf:"varint,4,opt,name=committed,proto3" json:"committed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckMessageExistsResponse) Reset()         { *m = CheckMessageExistsResponse{} }
+func (m *CheckMessageExistsResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckMessageExistsResponse) ProtoMessage()    {}
+func (*CheckMessageExistsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{57}
+}
+func (m *CheckMessageExistsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckMessageExistsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckMessageExistsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckMessageExistsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckMessageExistsResponse.Merge(m, src)
+}
+func (m *CheckMessageExistsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckMessageExistsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckMessageExistsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckMessageExistsResponse proto.InternalMessageInfo
+
+func (m *CheckMessageExistsResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
+}
+
+func (m *CheckMessageExistsResponse) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *CheckMessageExistsResponse) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
+	}
+	return 0
+}
+
+func (m *CheckMessageExistsResponse) GetCommitted() bool {
+	if m != nil {
+		return m.Committed
+	}
+	return false
+}
+
+// GetReassignmentStatusRequest is sent to retrieve the progress of the latest
+// reassignment of a partition's replicas.
+type GetReassignmentStatusRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReassignmentStatusRequest) Reset()         { *m = GetReassignmentStatusRequest{} }
+func (m *GetReassignmentStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReassignmentStatusRequest) ProtoMessage()    {}
+func (*GetReassignmentStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{58}
+}
+func (m *GetReassignmentStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReassignmentStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReassignmentStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReassignmentStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReassignmentStatusRequest.Merge(m, src)
+}
+func (m *GetReassignmentStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReassignmentStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReassignmentStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReassignmentStatusRequest proto.InternalMessageInfo
+
+func (m *GetReassignmentStatusRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *GetReassignmentStatusRequest) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+// GetReassignmentStatusResponse is sent by the server with the progress of
+// the partition's reassignment.
+type GetReassignmentStatusResponse struct {
+	TargetReplicas       []string `protobuf:"bytes,1,rep,name=targetReplicas,proto3" json:"targetReplicas,omitempty"`
+	CurrentIsr           []string `protobuf:"bytes,2,rep,name=currentIsr,proto3" json:"currentIsr,omitempty"`
+	BytesRemaining       int64    `protobuf:"varint,3,opt,name=bytesRemaining,proto3" json:"bytesRemaining,omitempty"`
+	StartedAt            int64    `protobuf:"varint,4,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	Phase                string   `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReassignmentStatusResponse) Reset()         { *m = GetReassignmentStatusResponse{} }
+func (m *GetReassignmentStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReassignmentStatusResponse) ProtoMessage()    {}
+func (*GetReassignmentStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{59}
+}
+func (m *GetReassignmentStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReassignmentStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReassignmentStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReassignmentStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReassignmentStatusResponse.Merge(m, src)
+}
+func (m *GetReassignmentStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReassignmentStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReassignmentStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReassignmentStatusResponse proto.InternalMessageInfo
+
+func (m *GetReassignmentStatusResponse) GetTargetReplicas() []string {
+	if m != nil {
+		return m.TargetReplicas
+	}
+	return nil
+}
+
+func (m *GetReassignmentStatusResponse) GetCurrentIsr() []string {
+	if m != nil {
+		return m.CurrentIsr
+	}
+	return nil
+}
+
+func (m *GetReassignmentStatusResponse) GetBytesRemaining() int64 {
+	if m != nil {
+		return m.BytesRemaining
+	}
+	return 0
+}
+
+func (m *GetReassignmentStatusResponse) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *GetReassignmentStatusResponse) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+// MergeSegmentsRequest is sent to coalesce the small segments of a partition's
+// log on the server receiving it.
+type MergeSegmentsRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	TargetBytes          int64    `protobuf:"varint,3,opt,name=targetBytes,proto3" json:"targetBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergeSegmentsRequest) Reset()         { *m = MergeSegmentsRequest{} }
+func (m *MergeSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeSegmentsRequest) ProtoMessage()    {}
+func (*MergeSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{60}
+}
+func (m *MergeSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeSegmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeSegmentsRequest.Merge(m, src)
+}
+func (m *MergeSegmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeSegmentsRequest proto.InternalMessageInfo
+
+func (m *MergeSegmentsRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *MergeSegmentsRequest) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *MergeSegmentsRequest) GetTargetBytes() int64 {
+	if m != nil {
+		return m.TargetBytes
+	}
+	return 0
+}
+
+// MergeSegmentsResponse is sent by the server once the segments are merged.
+type MergeSegmentsResponse struct {
+	SegmentsBefore       int32    `protobuf:"varint,1,opt,name=segmentsBefore,proto3" json:"segmentsBefore,omitempty"`
+	SegmentsAfter        int32    `protobuf:"varint,2,opt,name=segmentsAfter,proto3" json:"segmentsAfter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergeSegmentsResponse) Reset()         { *m = MergeSegmentsResponse{} }
+func (m *MergeSegmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*MergeSegmentsResponse) ProtoMessage()    {}
+func (*MergeSegmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{61}
+}
+func (m *MergeSegmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeSegmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeSegmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeSegmentsResponse.Merge(m, src)
+}
+func (m *MergeSegmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeSegmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeSegmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeSegmentsResponse proto.InternalMessageInfo
+
+func (m *MergeSegmentsResponse) GetSegmentsBefore() int32 {
+	if m != nil {
+		return m.SegmentsBefore
+	}
+	return 0
+}
+
+func (m *MergeSegmentsResponse) GetSegmentsAfter() int32 {
+	if m != nil {
+		return m.SegmentsAfter
+	}
+	return 0
+}
+
+// FetchStreamSkewRequest is sent to retrieve how evenly the load of streams is
+// spread across their partitions.
+type FetchStreamSkewRequest struct {
+	Streams              []string `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FetchStreamSkewRequest) Reset()         { *m = FetchStreamSkewRequest{} }
+func (m *FetchStreamSkewRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchStreamSkewRequest) ProtoMessage()    {}
+func (*FetchStreamSkewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{62}
+}
+func (m *FetchStreamSkewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchStreamSkewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchStreamSkewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchStreamSkewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchStreamSkewRequest.Merge(m, src)
+}
+func (m *FetchStreamSkewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchStreamSkewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchStreamSkewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchStreamSkewRequest proto.InternalMessageInfo
+
+func (m *FetchStreamSkewRequest) GetStreams() []string {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+// FetchStreamSkewResponse is sent by the server with the skew of each
+// requested stream, computed from the partition loads most recently reported
+// by their leaders.
+type FetchStreamSkewResponse struct {
+	Streams              []*StreamSkew `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *FetchStreamSkewResponse) Reset()         { *m = FetchStreamSkewResponse{} }
+func (m *FetchStreamSkewResponse) String() string { return proto.CompactTextString(m) }
+func (*FetchStreamSkewResponse) ProtoMessage()    {}
+func (*FetchStreamSkewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{63}
+}
+func (m *FetchStreamSkewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchStreamSkewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchStreamSkewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchStreamSkewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchStreamSkewResponse.Merge(m, src)
+}
+func (m *FetchStreamSkewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchStreamSkewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchStreamSkewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchStreamSkewResponse proto.InternalMessageInfo
+
+func (m *FetchStreamSkewResponse) GetStreams() []*StreamSkew {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+// RegisterProducerRequest is sent to register an exclusive producer on a
+// stream. The registration fences the previous holder of the producer name.
+type RegisterProducerRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Producer             string   `protobuf:"bytes,2,opt,name=producer,proto3" json:"producer,omitempty"`
+	Ttl                  int64    `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterProducerRequest) Reset()         { *m = RegisterProducerRequest{} }
+func (m *RegisterProducerRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterProducerRequest) ProtoMessage()    {}
+func (*RegisterProducerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{64}
+}
+func (m *RegisterProducerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterProducerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterProducerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterProducerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterProducerRequest.Merge(m, src)
+}
+func (m *RegisterProducerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterProducerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterProducerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterProducerRequest proto.InternalMessageInfo
+
+func (m *RegisterProducerRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *RegisterProducerRequest) GetProducer() string {
+	if m != nil {
+		return m.Producer
+	}
+	return ""
+}
+
+func (m *RegisterProducerRequest) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+// RegisterProducerResponse is sent by the server with the fencing token of the
+// registration.
+type RegisterProducerResponse struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterProducerResponse) Reset()         { *m = RegisterProducerResponse{} }
+func (m *RegisterProducerResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterProducerResponse) ProtoMessage()    {}
+func (*RegisterProducerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{65}
+}
+func (m *RegisterProducerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterProducerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterProducerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterProducerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterProducerResponse.Merge(m, src)
+}
+func (m *RegisterProducerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterProducerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterProducerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterProducerResponse proto.InternalMessageInfo
+
+func (m *RegisterProducerResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// ReleaseProducerRequest is sent to release the registration of an exclusive
+// producer.
+type ReleaseProducerRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Producer             string   `protobuf:"bytes,2,opt,name=producer,proto3" json:"producer,omitempty"`
+	Epoch                uint64   `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseProducerRequest) Reset()         { *m = ReleaseProducerRequest{} }
+func (m *ReleaseProducerRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseProducerRequest) ProtoMessage()    {}
+func (*ReleaseProducerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{66}
+}
+func (m *ReleaseProducerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseProducerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseProducerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseProducerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseProducerRequest.Merge(m, src)
+}
+func (m *ReleaseProducerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseProducerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseProducerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseProducerRequest proto.InternalMessageInfo
+
+func (m *ReleaseProducerRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *ReleaseProducerRequest) GetProducer() string {
+	if m != nil {
+		return m.Producer
+	}
+	return ""
+}
+
+func (m *ReleaseProducerRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// ReleaseProducerResponse is sent by the server after the registration of an
+// exclusive producer has been released.
+type ReleaseProducerResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseProducerResponse) Reset()         { *m = ReleaseProducerResponse{} }
+func (m *ReleaseProducerResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseProducerResponse) ProtoMessage()    {}
+func (*ReleaseProducerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{67}
+}
+func (m *ReleaseProducerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseProducerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseProducerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseProducerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseProducerResponse.Merge(m, src)
+}
+func (m *ReleaseProducerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseProducerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseProducerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseProducerResponse proto.InternalMessageInfo
+
+// SetProducerQuotaRequest is sent to set the quota of a producer.
+type SetProducerQuotaRequest struct {
+	Quota                *ProducerQuota `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetProducerQuotaRequest) Reset()         { *m = SetProducerQuotaRequest{} }
+func (m *SetProducerQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*SetProducerQuotaRequest) ProtoMessage()    {}
+func (*SetProducerQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{68}
+}
+func (m *SetProducerQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetProducerQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetProducerQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetProducerQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProducerQuotaRequest.Merge(m, src)
+}
+func (m *SetProducerQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetProducerQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProducerQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProducerQuotaRequest proto.InternalMessageInfo
+
+func (m *SetProducerQuotaRequest) GetQuota() *ProducerQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+// SetProducerQuotaResponse is sent by the server after the quota has been
+// set.
+type SetProducerQuotaResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetProducerQuotaResponse) Reset()         { *m = SetProducerQuotaResponse{} }
+func (m *SetProducerQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*SetProducerQuotaResponse) ProtoMessage()    {}
+func (*SetProducerQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{69}
+}
+func (m *SetProducerQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetProducerQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetProducerQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetProducerQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProducerQuotaResponse.Merge(m, src)
+}
+func (m *SetProducerQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetProducerQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProducerQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProducerQuotaResponse proto.InternalMessageInfo
+
+// GetProducerQuotasRequest is sent to retrieve the quotas enforced by a
+// broker.
+type GetProducerQuotasRequest struct {
+	ProducerID           string   `protobuf:"bytes,1,opt,name=producerID,proto3" json:"producerID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProducerQuotasRequest) Reset()         { *m = GetProducerQuotasRequest{} }
+func (m *GetProducerQuotasRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProducerQuotasRequest) ProtoMessage()    {}
+func (*GetProducerQuotasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{70}
+}
+func (m *GetProducerQuotasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetProducerQuotasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetProducerQuotasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetProducerQuotasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProducerQuotasRequest.Merge(m, src)
+}
+func (m *GetProducerQuotasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetProducerQuotasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProducerQuotasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProducerQuotasRequest proto.InternalMessageInfo
+
+func (m *GetProducerQuotasRequest) GetProducerID() string {
+	if m != nil {
+		return m.ProducerID
+	}
+	return ""
+}
+
+// GetProducerQuotasResponse is sent by the server with the quotas it enforces.
+type GetProducerQuotasResponse struct {
+	Quotas               []*ProducerQuota `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetProducerQuotasResponse) Reset()         { *m = GetProducerQuotasResponse{} }
+func (m *GetProducerQuotasResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProducerQuotasResponse) ProtoMessage()    {}
+func (*GetProducerQuotasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{71}
+}
+func (m *GetProducerQuotasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetProducerQuotasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetProducerQuotasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetProducerQuotasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProducerQuotasResponse.Merge(m, src)
+}
+func (m *GetProducerQuotasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetProducerQuotasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProducerQuotasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProducerQuotasResponse proto.InternalMessageInfo
+
+func (m *GetProducerQuotasResponse) GetQuotas() []*ProducerQuota {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+// DeleteProducerQuotaRequest is sent to remove the quota of a producer.
+type DeleteProducerQuotaRequest struct {
+	ProducerID           string   `protobuf:"bytes,1,opt,name=producerID,proto3" json:"producerID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteProducerQuotaRequest) Reset()         { *m = DeleteProducerQuotaRequest{} }
+func (m *DeleteProducerQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteProducerQuotaRequest) ProtoMessage()    {}
+func (*DeleteProducerQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{72}
+}
+func (m *DeleteProducerQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteProducerQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteProducerQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteProducerQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProducerQuotaRequest.Merge(m, src)
+}
+func (m *DeleteProducerQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteProducerQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProducerQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteProducerQuotaRequest proto.InternalMessageInfo
+
+func (m *DeleteProducerQuotaRequest) GetProducerID() string {
+	if m != nil {
+		return m.ProducerID
+	}
+	return ""
+}
+
+// DeleteProducerQuotaResponse is sent by the server after the quota has been
+// removed.
+type DeleteProducerQuotaResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteProducerQuotaResponse) Reset()         { *m = DeleteProducerQuotaResponse{} }
+func (m *DeleteProducerQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteProducerQuotaResponse) ProtoMessage()    {}
+func (*DeleteProducerQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{73}
+}
+func (m *DeleteProducerQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteProducerQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteProducerQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteProducerQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProducerQuotaResponse.Merge(m, src)
+}
+func (m *DeleteProducerQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteProducerQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProducerQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteProducerQuotaResponse proto.InternalMessageInfo
+
+// AddRaftServerRequest is sent to add a server to the metadata Raft group.
+type AddRaftServerRequest struct {
+	ServerId             string   `protobuf:"bytes,1,opt,name=serverId,proto3" json:"serverId,omitempty"`
+	Observer             bool     `protobuf:"varint,2,opt,name=observer,proto3" json:"observer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddRaftServerRequest) Reset()         { *m = AddRaftServerRequest{} }
+func (m *AddRaftServerRequest) String() string { return proto.CompactTextString(m) }
+func (*AddRaftServerRequest) ProtoMessage()    {}
+func (*AddRaftServerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{74}
+}
+func (m *AddRaftServerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddRaftServerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddRaftServerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddRaftServerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddRaftServerRequest.Merge(m, src)
+}
+func (m *AddRaftServerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddRaftServerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddRaftServerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddRaftServerRequest proto.InternalMessageInfo
+
+func (m *AddRaftServerRequest) GetServerId() string {
+	if m != nil {
+		return m.ServerId
+	}
+	return ""
+}
+
+func (m *AddRaftServerRequest) GetObserver() bool {
+	if m != nil {
+		return m.Observer
+	}
+	return false
+}
+
+// AddRaftServerResponse is sent by the server after the server has been added
+// to the metadata Raft group.
+type AddRaftServerResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddRaftServerResponse) Reset()         { *m = AddRaftServerResponse{} }
+func (m *AddRaftServerResponse) String() string { return proto.CompactTextString(m) }
+func (*AddRaftServerResponse) ProtoMessage()    {}
+func (*AddRaftServerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{75}
+}
+func (m *AddRaftServerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddRaftServerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddRaftServerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddRaftServerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddRaftServerResponse.Merge(m, src)
+}
+func (m *AddRaftServerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddRaftServerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddRaftServerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddRaftServerResponse proto.InternalMessageInfo
+
+// RemoveRaftServerRequest is sent to remove a server from the metadata Raft
+// group.
+type RemoveRaftServerRequest struct {
+	ServerId             string   `protobuf:"bytes,1,opt,name=serverId,proto3" json:"serverId,omitempty"`
+	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveRaftServerRequest) Reset()         { *m = RemoveRaftServerRequest{} }
+func (m *RemoveRaftServerRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveRaftServerRequest) ProtoMessage()    {}
+func (*RemoveRaftServerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{76}
+}
+func (m *RemoveRaftServerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveRaftServerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveRaftServerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveRaftServerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveRaftServerRequest.Merge(m, src)
+}
+func (m *RemoveRaftServerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveRaftServerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveRaftServerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveRaftServerRequest proto.InternalMessageInfo
+
+func (m *RemoveRaftServerRequest) GetServerId() string {
+	if m != nil {
+		return m.ServerId
+	}
+	return ""
+}
+
+func (m *RemoveRaftServerRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+// RemoveRaftServerResponse is sent by the server after the server has been
+// removed from the metadata Raft group.
+type RemoveRaftServerResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveRaftServerResponse) Reset()         { *m = RemoveRaftServerResponse{} }
+func (m *RemoveRaftServerResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveRaftServerResponse) ProtoMessage()    {}
+func (*RemoveRaftServerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{77}
+}
+func (m *RemoveRaftServerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveRaftServerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveRaftServerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveRaftServerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveRaftServerResponse.Merge(m, src)
+}
+func (m *RemoveRaftServerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveRaftServerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveRaftServerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveRaftServerResponse proto.InternalMessageInfo
+
+// ListRaftServersRequest is sent to retrieve the metadata Raft group's
+// configuration.
+type ListRaftServersRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRaftServersRequest) Reset()         { *m = ListRaftServersRequest{} }
+func (m *ListRaftServersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRaftServersRequest) ProtoMessage()    {}
+func (*ListRaftServersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{78}
+}
+func (m *ListRaftServersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListRaftServersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListRaftServersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListRaftServersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRaftServersRequest.Merge(m, src)
+}
+func (m *ListRaftServersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListRaftServersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRaftServersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRaftServersRequest proto.InternalMessageInfo
+
+// RaftServer is a member of the metadata Raft group.
+type RaftServer struct {
+	ServerId             string       `protobuf:"bytes,1,opt,name=serverId,proto3" json:"serverId,omitempty"`
+	Suffrage             RaftSuffrage `protobuf:"varint,2,opt,name=suffrage,proto3,enum=protocol.RaftSuffrage" json:"suffrage,omitempty"`
+	Leader               bool         `protobuf:"varint,3,opt,name=leader,proto3" json:"leader,omitempty"`
+	LastContact          int64        `protobuf:"varint,4,opt,name=lastContact,proto3" json:"lastContact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RaftServer) Reset()         { *m = RaftServer{} }
+func (m *RaftServer) String() string { return proto.CompactTextString(m) }
+func (*RaftServer) ProtoMessage()    {}
+func (*RaftServer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{79}
+}
+func (m *RaftServer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftServer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftServer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RaftServer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftServer.Merge(m, src)
+}
+func (m *RaftServer) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftServer) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftServer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftServer proto.InternalMessageInfo
+
+func (m *RaftServer) GetServerId() string {
+	if m != nil {
+		return m.ServerId
+	}
+	return ""
+}
+
+func (m *RaftServer) GetSuffrage() RaftSuffrage {
+	if m != nil {
+		return m.Suffrage
+	}
+	return RaftSuffrage_VOTER
+}
+
+func (m *RaftServer) GetLeader() bool {
+	if m != nil {
+		return m.Leader
+	}
+	return false
+}
+
+func (m *RaftServer) GetLastContact() int64 {
+	if m != nil {
+		return m.LastContact
+	}
+	return 0
+}
+
+// ListRaftServersResponse is sent by the metadata leader with the members of
+// the metadata Raft group.
+type ListRaftServersResponse struct {
+	Servers              []*RaftServer `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListRaftServersResponse) Reset()         { *m = ListRaftServersResponse{} }
+func (m *ListRaftServersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRaftServersResponse) ProtoMessage()    {}
+func (*ListRaftServersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{80}
+}
+func (m *ListRaftServersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListRaftServersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListRaftServersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListRaftServersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRaftServersResponse.Merge(m, src)
+}
+func (m *ListRaftServersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListRaftServersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRaftServersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRaftServersResponse proto.InternalMessageInfo
+
+func (m *ListRaftServersResponse) GetServers() []*RaftServer {
+	if m != nil {
+		return m.Servers
+	}
+	return nil
+}
+
+// FetchSubjectLayoutRequest is sent to retrieve the NATS subjects used by a
+// broker.
+type FetchSubjectLayoutRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FetchSubjectLayoutRequest) Reset()         { *m = FetchSubjectLayoutRequest{} }
+func (m *FetchSubjectLayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchSubjectLayoutRequest) ProtoMessage()    {}
+func (*FetchSubjectLayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{81}
+}
+func (m *FetchSubjectLayoutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchSubjectLayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchSubjectLayoutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchSubjectLayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchSubjectLayoutRequest.Merge(m, src)
+}
+func (m *FetchSubjectLayoutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchSubjectLayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchSubjectLayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchSubjectLayoutRequest proto.InternalMessageInfo
+
+// SubjectLayoutEntry is a NATS subject used by a broker. Tokens which vary,
+// such as server or partition IDs, are replaced by wildcards.
+type SubjectLayoutEntry struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubjectLayoutEntry) Reset()         { *m = SubjectLayoutEntry{} }
+func (m *SubjectLayoutEntry) String() string { return proto.CompactTextString(m) }
+func (*SubjectLayoutEntry) ProtoMessage()    {}
+func (*SubjectLayoutEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{82}
+}
+func (m *SubjectLayoutEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubjectLayoutEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubjectLayoutEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubjectLayoutEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubjectLayoutEntry.Merge(m, src)
+}
+func (m *SubjectLayoutEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubjectLayoutEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubjectLayoutEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubjectLayoutEntry proto.InternalMessageInfo
+
+func (m *SubjectLayoutEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SubjectLayoutEntry) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *SubjectLayoutEntry) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// FetchSubjectLayoutResponse is sent by the server with the NATS subjects it
+// uses.
+type FetchSubjectLayoutResponse struct {
+	BrokerId             string                `protobuf:"bytes,1,opt,name=brokerId,proto3" json:"brokerId,omitempty"`
+	Namespace            string                `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	SubjectPrefix        string                `protobuf:"bytes,3,opt,name=subjectPrefix,proto3" json:"subjectPrefix,omitempty"`
+	Subjects             []*SubjectLayoutEntry `protobuf:"bytes,4,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *FetchSubjectLayoutResponse) Reset()         { *m = FetchSubjectLayoutResponse{} }
+func (m *FetchSubjectLayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*FetchSubjectLayoutResponse) ProtoMessage()    {}
+func (*FetchSubjectLayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{83}
+}
+func (m *FetchSubjectLayoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchSubjectLayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchSubjectLayoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchSubjectLayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchSubjectLayoutResponse.Merge(m, src)
+}
+func (m *FetchSubjectLayoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchSubjectLayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchSubjectLayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchSubjectLayoutResponse proto.InternalMessageInfo
+
+func (m *FetchSubjectLayoutResponse) GetBrokerId() string {
+	if m != nil {
+		return m.BrokerId
+	}
+	return ""
+}
+
+func (m *FetchSubjectLayoutResponse) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *FetchSubjectLayoutResponse) GetSubjectPrefix() string {
+	if m != nil {
+		return m.SubjectPrefix
+	}
+	return ""
+}
+
+func (m *FetchSubjectLayoutResponse) GetSubjects() []*SubjectLayoutEntry {
+	if m != nil {
+		return m.Subjects
+	}
+	return nil
+}
+
+// WatchMetadataRequest is sent to watch for changes to the cluster metadata.
+type WatchMetadataRequest struct {
+	StreamPrefixes       []string `protobuf:"bytes,1,rep,name=streamPrefixes,proto3" json:"streamPrefixes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchMetadataRequest) Reset()         { *m = WatchMetadataRequest{} }
+func (m *WatchMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchMetadataRequest) ProtoMessage()    {}
+func (*WatchMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{84}
+}
+func (m *WatchMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchMetadataRequest.Merge(m, src)
+}
+func (m *WatchMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchMetadataRequest proto.InternalMessageInfo
+
+func (m *WatchMetadataRequest) GetStreamPrefixes() []string {
+	if m != nil {
+		return m.StreamPrefixes
+	}
+	return nil
+}
+
+// MetadataEvent is sent by the server when a change to the cluster metadata
+// has been applied.
+type MetadataEvent struct {
+	Type                 MetadataEventType   `protobuf:"varint,1,opt,name=type,proto3,enum=protocol.MetadataEventType" json:"type,omitempty"`
+	Index                uint64              `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Stream               string              `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32               `protobuf:"varint,4,opt,name=partition,proto3" json:"partition,omitempty"`
+	StreamMetadata       *Stream             `protobuf:"bytes,5,opt,name=streamMetadata,proto3" json:"streamMetadata,omitempty"`
+	PartitionMetadata    *Partition          `protobuf:"bytes,6,opt,name=partitionMetadata,proto3" json:"partitionMetadata,omitempty"`
+	TtlDeletedAt         int64               `protobuf:"varint,7,opt,name=ttlDeletedAt,proto3" json:"ttlDeletedAt,omitempty"`
+	ScheduledOperation   *ScheduledOperation `protobuf:"bytes,8,opt,name=scheduledOperation,proto3" json:"scheduledOperation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *MetadataEvent) Reset()         { *m = MetadataEvent{} }
+func (m *MetadataEvent) String() string { return proto.CompactTextString(m) }
+func (*MetadataEvent) ProtoMessage()    {}
+func (*MetadataEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{85}
+}
+func (m *MetadataEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataEvent.Merge(m, src)
+}
+func (m *MetadataEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataEvent proto.InternalMessageInfo
+
+func (m *MetadataEvent) GetType() MetadataEventType {
+	if m != nil {
+		return m.Type
+	}
+	return MetadataEventType_STREAM_CREATED
+}
+
+func (m *MetadataEvent) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MetadataEvent) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *MetadataEvent) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *MetadataEvent) GetStreamMetadata() *Stream {
+	if m != nil {
+		return m.StreamMetadata
+	}
+	return nil
+}
+
+func (m *MetadataEvent) GetPartitionMetadata() *Partition {
+	if m != nil {
+		return m.PartitionMetadata
+	}
+	return nil
+}
+
+func (m *MetadataEvent) GetTtlDeletedAt() int64 {
+	if m != nil {
+		return m.TtlDeletedAt
+	}
+	return 0
+}
+
+func (m *MetadataEvent) GetScheduledOperation() *ScheduledOperation {
+	if m != nil {
+		return m.ScheduledOperation
+	}
+	return nil
+}
+
+// WatchPartitionRequest is sent to the partition leader to watch for changes
+// to a partition.
+type WatchPartitionRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	MaxEventsPerSecond   int32    `protobuf:"varint,3,opt,name=maxEventsPerSecond,proto3" json:"maxEventsPerSecond,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchPartitionRequest) Reset()         { *m = WatchPartitionRequest{} }
+func (m *WatchPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchPartitionRequest) ProtoMessage()    {}
+func (*WatchPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{86}
+}
+func (m *WatchPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchPartitionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchPartitionRequest.Merge(m, src)
+}
+func (m *WatchPartitionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchPartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchPartitionRequest proto.InternalMessageInfo
+
+func (m *WatchPartitionRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *WatchPartitionRequest) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *WatchPartitionRequest) GetMaxEventsPerSecond() int32 {
+	if m != nil {
+		return m.MaxEventsPerSecond
+	}
+	return 0
+}
+
+// PartitionEvent is sent by the partition leader when a partition changes.
+// Changes made in quick succession are coalesced into one event, which
+// reports the partition's state once they were all made.
+type PartitionEvent struct {
+	Changes              []PartitionEventType `protobuf:"varint,1,rep,packed,name=changes,proto3,enum=protocol.PartitionEventType" json:"changes,omitempty"`
+	Stream               string               `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32                `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	Timestamp            int64                `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	HighWatermark        int64                `protobuf:"varint,5,opt,name=highWatermark,proto3" json:"highWatermark,omitempty"`
+	NewestOffset         int64                `protobuf:"varint,6,opt,name=newestOffset,proto3" json:"newestOffset,omitempty"`
+	Isr                  []string             `protobuf:"bytes,7,rep,name=isr,proto3" json:"isr,omitempty"`
+	Readonly             bool                 `protobuf:"varint,8,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	Paused               bool                 `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
+	LeaderEpoch          uint64               `protobuf:"varint,10,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PartitionEvent) Reset()         { *m = PartitionEvent{} }
+func (m *PartitionEvent) String() string { return proto.CompactTextString(m) }
+func (*PartitionEvent) ProtoMessage()    {}
+func (*PartitionEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{87}
+}
+func (m *PartitionEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartitionEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionEvent.Merge(m, src)
+}
+func (m *PartitionEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionEvent proto.InternalMessageInfo
+
+func (m *PartitionEvent) GetChanges() []PartitionEventType {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *PartitionEvent) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *PartitionEvent) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *PartitionEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PartitionEvent) GetHighWatermark() int64 {
+	if m != nil {
+		return m.HighWatermark
+	}
+	return 0
+}
+
+func (m *PartitionEvent) GetNewestOffset() int64 {
+	if m != nil {
+		return m.NewestOffset
+	}
+	return 0
+}
+
+func (m *PartitionEvent) GetIsr() []string {
+	if m != nil {
+		return m.Isr
+	}
+	return nil
+}
+
+func (m *PartitionEvent) GetReadonly() bool {
+	if m != nil {
+		return m.Readonly
+	}
+	return false
+}
+
+func (m *PartitionEvent) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *PartitionEvent) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
+	}
+	return 0
+}
+
+// ExportCursorsRequest is sent to export consumer cursors. Consumer groups
+// store their offsets as cursors identified by the group ID, so they're
+// exported too.
+type ExportCursorsRequest struct {
+	CursorIdPattern      string   `protobuf:"bytes,1,opt,name=cursorIdPattern,proto3" json:"cursorIdPattern,omitempty"`
+	Stream               string   `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportCursorsRequest) Reset()         { *m = ExportCursorsRequest{} }
+func (m *ExportCursorsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportCursorsRequest) ProtoMessage()    {}
+func (*ExportCursorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{88}
+}
+func (m *ExportCursorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportCursorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportCursorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportCursorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportCursorsRequest.Merge(m, src)
+}
+func (m *ExportCursorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportCursorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportCursorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportCursorsRequest proto.InternalMessageInfo
+
+func (m *ExportCursorsRequest) GetCursorIdPattern() string {
+	if m != nil {
+		return m.CursorIdPattern
+	}
+	return ""
+}
+
+func (m *ExportCursorsRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+// ExportedPartition is the state of a stream partition when its cursors were
+// exported.
+type ExportedPartition struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	LeaderEpoch          uint64   `protobuf:"varint,3,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+	OldestOffset         int64    `protobuf:"varint,4,opt,name=oldestOffset,proto3" json:"oldestOffset,omitempty"`
+	NewestOffset         int64    `protobuf:"varint,5,opt,name=newestOffset,proto3" json:"newestOffset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportedPartition) Reset()         { *m = ExportedPartition{} }
+func (m *ExportedPartition) String() string { return proto.CompactTextString(m) }
+func (*ExportedPartition) ProtoMessage()    {}
+func (*ExportedPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{89}
+}
+func (m *ExportedPartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportedPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportedPartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportedPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportedPartition.Merge(m, src)
+}
+func (m *ExportedPartition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportedPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportedPartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportedPartition proto.InternalMessageInfo
+
+func (m *ExportedPartition) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ExportedPartition) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ExportedPartition) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
+	}
+	return 0
+}
+
+func (m *ExportedPartition) GetOldestOffset() int64 {
+	if m != nil {
+		return m.OldestOffset
+	}
+	return 0
+}
+
+func (m *ExportedPartition) GetNewestOffset() int64 {
+	if m != nil {
+		return m.NewestOffset
+	}
+	return 0
+}
+
+// ExportedStream is a stream whose cursors were exported.
+type ExportedStream struct {
+	Name                 string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreationTimestamp    int64                `protobuf:"varint,2,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	Partitions           []*ExportedPartition `protobuf:"bytes,3,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ExportedStream) Reset()         { *m = ExportedStream{} }
+func (m *ExportedStream) String() string { return proto.CompactTextString(m) }
+func (*ExportedStream) ProtoMessage()    {}
+func (*ExportedStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{90}
+}
+func (m *ExportedStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportedStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportedStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportedStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportedStream.Merge(m, src)
+}
+func (m *ExportedStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportedStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportedStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportedStream proto.InternalMessageInfo
+
+func (m *ExportedStream) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExportedStream) GetCreationTimestamp() int64 {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return 0
+}
+
+func (m *ExportedStream) GetPartitions() []*ExportedPartition {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+// ExportedCursor is the position of a consumer in a stream partition.
+type ExportedCursor struct {
+	CursorId             string   `protobuf:"bytes,1,opt,name=cursorId,proto3" json:"cursorId,omitempty"`
+	Stream               string   `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset               int64    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportedCursor) Reset()         { *m = ExportedCursor{} }
+func (m *ExportedCursor) String() string { return proto.CompactTextString(m) }
+func (*ExportedCursor) ProtoMessage()    {}
+func (*ExportedCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{91}
+}
+func (m *ExportedCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportedCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportedCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportedCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportedCursor.Merge(m, src)
+}
+func (m *ExportedCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportedCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportedCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportedCursor proto.InternalMessageInfo
+
+func (m *ExportedCursor) GetCursorId() string {
+	if m != nil {
+		return m.CursorId
+	}
+	return ""
+}
+
+func (m *ExportedCursor) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *ExportedCursor) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *ExportedCursor) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+// CursorDocument is a snapshot of consumer cursors which can be imported into
+// another cluster.
+type CursorDocument struct {
+	Namespace            string            `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ExportedAt           int64             `protobuf:"varint,2,opt,name=exportedAt,proto3" json:"exportedAt,omitempty"`
+	Streams              []*ExportedStream `protobuf:"bytes,3,rep,name=streams,proto3" json:"streams,omitempty"`
+	Cursors              []*ExportedCursor `protobuf:"bytes,4,rep,name=cursors,proto3" json:"cursors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CursorDocument) Reset()         { *m = CursorDocument{} }
+func (m *CursorDocument) String() string { return proto.CompactTextString(m) }
+func (*CursorDocument) ProtoMessage()    {}
+func (*CursorDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{92}
+}
+func (m *CursorDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CursorDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CursorDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CursorDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CursorDocument.Merge(m, src)
+}
+func (m *CursorDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *CursorDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_CursorDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CursorDocument proto.InternalMessageInfo
+
+func (m *CursorDocument) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *CursorDocument) GetExportedAt() int64 {
+	if m != nil {
+		return m.ExportedAt
+	}
+	return 0
+}
+
+func (m *CursorDocument) GetStreams() []*ExportedStream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *CursorDocument) GetCursors() []*ExportedCursor {
+	if m != nil {
+		return m.Cursors
+	}
+	return nil
+}
+
+// ExportCursorsResponse is sent by the server with the exported cursors.
+type ExportCursorsResponse struct {
+	Document             *CursorDocument `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ExportCursorsResponse) Reset()         { *m = ExportCursorsResponse{} }
+func (m *ExportCursorsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportCursorsResponse) ProtoMessage()    {}
+func (*ExportCursorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{93}
+}
+func (m *ExportCursorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportCursorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportCursorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportCursorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportCursorsResponse.Merge(m, src)
+}
+func (m *ExportCursorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportCursorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportCursorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportCursorsResponse proto.InternalMessageInfo
+
+func (m *ExportCursorsResponse) GetDocument() *CursorDocument {
+	if m != nil {
+		return m.Document
+	}
+	return nil
+}
+
+// StreamMapping renames a stream of an imported document.
+type StreamMapping struct {
+	Source               string   `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target               string   `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamMapping) Reset()         { *m = StreamMapping{} }
+func (m *StreamMapping) String() string { return proto.CompactTextString(m) }
+func (*StreamMapping) ProtoMessage()    {}
+func (*StreamMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{94}
+}
+func (m *StreamMapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamMapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamMapping.Merge(m, src)
+}
+func (m *StreamMapping) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamMapping proto.InternalMessageInfo
+
+func (m *StreamMapping) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *StreamMapping) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+// ImportCursorsRequest is sent to import cursors exported with ExportCursors.
+type ImportCursorsRequest struct {
+	Document             *CursorDocument     `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	StreamMappings       []*StreamMapping    `protobuf:"bytes,2,rep,name=streamMappings,proto3" json:"streamMappings,omitempty"`
+	OffsetMapping        CursorOffsetMapping `protobuf:"varint,3,opt,name=offsetMapping,proto3,enum=protocol.CursorOffsetMapping" json:"offsetMapping,omitempty"`
+	Clamp                bool                `protobuf:"varint,4,opt,name=clamp,proto3" json:"clamp,omitempty"`
+	Force                bool                `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ImportCursorsRequest) Reset()         { *m = ImportCursorsRequest{} }
+func (m *ImportCursorsRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportCursorsRequest) ProtoMessage()    {}
+func (*ImportCursorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{95}
+}
+func (m *ImportCursorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportCursorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportCursorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportCursorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCursorsRequest.Merge(m, src)
+}
+func (m *ImportCursorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportCursorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCursorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCursorsRequest proto.InternalMessageInfo
+
+func (m *ImportCursorsRequest) GetDocument() *CursorDocument {
+	if m != nil {
+		return m.Document
+	}
+	return nil
+}
+
+func (m *ImportCursorsRequest) GetStreamMappings() []*StreamMapping {
+	if m != nil {
+		return m.StreamMappings
+	}
+	return nil
+}
+
+func (m *ImportCursorsRequest) GetOffsetMapping() CursorOffsetMapping {
+	if m != nil {
+		return m.OffsetMapping
+	}
+	return CursorOffsetMapping_CURSOR_OFFSET_IDENTITY
+}
+
+func (m *ImportCursorsRequest) GetClamp() bool {
+	if m != nil {
+		return m.Clamp
+	}
+	return false
+}
+
+func (m *ImportCursorsRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+// ImportedCursor reports how a cursor was imported.
+type ImportedCursor struct {
+	CursorId             string             `protobuf:"bytes,1,opt,name=cursorId,proto3" json:"cursorId,omitempty"`
+	Stream               string             `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32              `protobuf:"varint,3,opt,name=partition,proto3" json:"partition,omitempty"`
+	SourceOffset         int64              `protobuf:"varint,4,opt,name=sourceOffset,proto3" json:"sourceOffset,omitempty"`
+	Offset               int64              `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	PreviousOffset       int64              `protobuf:"varint,6,opt,name=previousOffset,proto3" json:"previousOffset,omitempty"`
+	Clamped              bool               `protobuf:"varint,7,opt,name=clamped,proto3" json:"clamped,omitempty"`
+	Result               CursorImportResult `protobuf:"varint,8,opt,name=result,proto3,enum=protocol.CursorImportResult" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ImportedCursor) Reset()         { *m = ImportedCursor{} }
+func (m *ImportedCursor) String() string { return proto.CompactTextString(m) }
+func (*ImportedCursor) ProtoMessage()    {}
+func (*ImportedCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{96}
+}
+func (m *ImportedCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportedCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportedCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportedCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportedCursor.Merge(m, src)
+}
+func (m *ImportedCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportedCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportedCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportedCursor proto.InternalMessageInfo
+
+func (m *ImportedCursor) GetCursorId() string {
+	if m != nil {
+		return m.CursorId
+	}
+	return ""
+}
+
+func (m *ImportedCursor) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *ImportedCursor) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *ImportedCursor) GetSourceOffset() int64 {
+	if m != nil {
+		return m.SourceOffset
+	}
+	return 0
+}
+
+func (m *ImportedCursor) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ImportedCursor) GetPreviousOffset() int64 {
+	if m != nil {
+		return m.PreviousOffset
+	}
+	return 0
+}
+
+func (m *ImportedCursor) GetClamped() bool {
+	if m != nil {
+		return m.Clamped
+	}
+	return false
+}
+
+func (m *ImportedCursor) GetResult() CursorImportResult {
+	if m != nil {
+		return m.Result
+	}
+	return CursorImportResult_CURSOR_SET
+}
+
+// ImportCursorsResponse is sent by the server with the outcome of importing
+// each cursor.
+type ImportCursorsResponse struct {
+	Cursors              []*ImportedCursor `protobuf:"bytes,1,rep,name=cursors,proto3" json:"cursors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImportCursorsResponse) Reset()         { *m = ImportCursorsResponse{} }
+func (m *ImportCursorsResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportCursorsResponse) ProtoMessage()    {}
+func (*ImportCursorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{97}
+}
+func (m *ImportCursorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportCursorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportCursorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ImportCursorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCursorsResponse.Merge(m, src)
+}
+func (m *ImportCursorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportCursorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCursorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCursorsResponse proto.InternalMessageInfo
+
+func (m *ImportCursorsResponse) GetCursors() []*ImportedCursor {
+	if m != nil {
+		return m.Cursors
+	}
+	return nil
+}
+
+// RestoreStreamsRequest is sent to restore streams from the backup sink.
+type RestoreStreamsRequest struct {
+	Streams              []string `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreStreamsRequest) Reset()         { *m = RestoreStreamsRequest{} }
+func (m *RestoreStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreStreamsRequest) ProtoMessage()    {}
+func (*RestoreStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{98}
+}
+func (m *RestoreStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreStreamsRequest.Merge(m, src)
+}
+func (m *RestoreStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreStreamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreStreamsRequest proto.InternalMessageInfo
+
+func (m *RestoreStreamsRequest) GetStreams() []string {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+// RestoredPartition reports the offset a partition is restored to.
+type RestoredPartition struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoredPartition) Reset()         { *m = RestoredPartition{} }
+func (m *RestoredPartition) String() string { return proto.CompactTextString(m) }
+func (*RestoredPartition) ProtoMessage()    {}
+func (*RestoredPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{99}
+}
+func (m *RestoredPartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoredPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoredPartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoredPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoredPartition.Merge(m, src)
+}
+func (m *RestoredPartition) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoredPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoredPartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoredPartition proto.InternalMessageInfo
+
+func (m *RestoredPartition) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *RestoredPartition) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *RestoredPartition) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+// RestoreStreamsResponse is sent by the server once the restored streams have
+// been created. Their partitions are restored in the background and are
+// complete once their HW reaches the reported offsets.
+type RestoreStreamsResponse struct {
+	Partitions           []*RestoredPartition `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RestoreStreamsResponse) Reset()         { *m = RestoreStreamsResponse{} }
+func (m *RestoreStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreStreamsResponse) ProtoMessage()    {}
+func (*RestoreStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6426cc41786d6dd2, []int{100}
+}
+func (m *RestoreStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreStreamsResponse.Merge(m, src)
+}
+func (m *RestoreStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreStreamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreStreamsResponse proto.InternalMessageInfo
+
+func (m *RestoreStreamsResponse) GetPartitions() []*RestoredPartition {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("protocol.ExportFormat", ExportFormat_name, ExportFormat_value)
+	proto.RegisterEnum("protocol.RaftSuffrage", RaftSuffrage_name, RaftSuffrage_value)
+	proto.RegisterEnum("protocol.MetadataEventType", MetadataEventType_name, MetadataEventType_value)
+	proto.RegisterEnum("protocol.PartitionEventType", PartitionEventType_name, PartitionEventType_value)
+	proto.RegisterEnum("protocol.CursorOffsetMapping", CursorOffsetMapping_name, CursorOffsetMapping_value)
+	proto.RegisterEnum("protocol.CursorImportResult", CursorImportResult_name, CursorImportResult_value)
+	proto.RegisterType((*TransferLeaderRequest)(nil), "protocol.TransferLeaderRequest")
+	proto.RegisterType((*TransferLeaderResponse)(nil), "protocol.TransferLeaderResponse")
+	proto.RegisterType((*AllocationStatsRequest)(nil), "protocol.AllocationStatsRequest")
+	proto.RegisterType((*PartitionAllocations)(nil), "protocol.PartitionAllocations")
+	proto.RegisterType((*GCStats)(nil), "protocol.GCStats")
+	proto.RegisterType((*AllocationStatsResponse)(nil), "protocol.AllocationStatsResponse")
+	proto.RegisterType((*MetadataMemoryStatsRequest)(nil), "protocol.MetadataMemoryStatsRequest")
+	proto.RegisterType((*MetadataStructureStats)(nil), "protocol.MetadataStructureStats")
+	proto.RegisterType((*MetadataMemoryStatsResponse)(nil), "protocol.MetadataMemoryStatsResponse")
+	proto.RegisterType((*FetchBrokerStatsRequest)(nil), "protocol.FetchBrokerStatsRequest")
+	proto.RegisterType((*ReplicaLag)(nil), "protocol.ReplicaLag")
+	proto.RegisterType((*SubscriptionStats)(nil), "protocol.SubscriptionStats")
+	proto.RegisterType((*IngestSources)(nil), "protocol.IngestSources")
+	proto.RegisterType((*SegmentRolls)(nil), "protocol.SegmentRolls")
+	proto.RegisterType((*PartitionStats)(nil), "protocol.PartitionStats")
+	proto.RegisterType((*FetchBrokerStatsResponse)(nil), "protocol.FetchBrokerStatsResponse")
+	proto.RegisterType((*PropagationStats)(nil), "protocol.PropagationStats")
+	proto.RegisterType((*FetchBrokerRTTsRequest)(nil), "protocol.FetchBrokerRTTsRequest")
+	proto.RegisterType((*PeerRTT)(nil), "protocol.PeerRTT")
+	proto.RegisterType((*BrokerRTTs)(nil), "protocol.BrokerRTTs")
+	proto.RegisterType((*FetchBrokerRTTsResponse)(nil), "protocol.FetchBrokerRTTsResponse")
+	proto.RegisterType((*DescribeStreamsRequest)(nil), "protocol.DescribeStreamsRequest")
+	proto.RegisterType((*PartitionIngestSources)(nil), "protocol.PartitionIngestSources")
+	proto.RegisterType((*DescribeStreamsResponse)(nil), "protocol.DescribeStreamsResponse")
+	proto.RegisterType((*GetStreamConfigRequest)(nil), "protocol.GetStreamConfigRequest")
+	proto.RegisterType((*GetStreamConfigResponse)(nil), "protocol.GetStreamConfigResponse")
+	proto.RegisterType((*UpdateStreamConfigRequest)(nil), "protocol.UpdateStreamConfigRequest")
+	proto.RegisterType((*UpdateStreamConfigResponse)(nil), "protocol.UpdateStreamConfigResponse")
+	proto.RegisterType((*FetchPartitionStatusRequest)(nil), "protocol.FetchPartitionStatusRequest")
+	proto.RegisterType((*FetchPartitionStatusResponse)(nil), "protocol.FetchPartitionStatusResponse")
+	proto.RegisterType((*ScheduleStreamOperationRequest)(nil), "protocol.ScheduleStreamOperationRequest")
+	proto.RegisterType((*ScheduleStreamOperationResponse)(nil), "protocol.ScheduleStreamOperationResponse")
+	proto.RegisterType((*ListScheduledOperationsRequest)(nil), "protocol.ListScheduledOperationsRequest")
+	proto.RegisterType((*ListScheduledOperationsResponse)(nil), "protocol.ListScheduledOperationsResponse")
+	proto.RegisterType((*CancelScheduledOperationRequest)(nil), "protocol.CancelScheduledOperationRequest")
+	proto.RegisterType((*CancelScheduledOperationResponse)(nil), "protocol.CancelScheduledOperationResponse")
+	proto.RegisterType((*UpdateStreamOwnerRequest)(nil), "protocol.UpdateStreamOwnerRequest")
+	proto.RegisterType((*UpdateStreamOwnerResponse)(nil), "protocol.UpdateStreamOwnerResponse")
+	proto.RegisterType((*SetStreamTagsRequest)(nil), "protocol.SetStreamTagsRequest")
+	proto.RegisterType((*SetStreamTagsResponse)(nil), "protocol.SetStreamTagsResponse")
+	proto.RegisterType((*SetStreamACLRequest)(nil), "protocol.SetStreamACLRequest")
+	proto.RegisterType((*SetStreamACLResponse)(nil), "protocol.SetStreamACLResponse")
+	proto.RegisterType((*DeletePartitionDataRequest)(nil), "protocol.DeletePartitionDataRequest")
+	proto.RegisterType((*DeletePartitionDataResponse)(nil), "protocol.DeletePartitionDataResponse")
+	proto.RegisterType((*DeletePartitionRequest)(nil), "protocol.DeletePartitionRequest")
+	proto.RegisterType((*DeletePartitionResponse)(nil), "protocol.DeletePartitionResponse")
+	proto.RegisterType((*NackMessageRequest)(nil), "protocol.NackMessageRequest")
+	proto.RegisterType((*NackMessageResponse)(nil), "protocol.NackMessageResponse")
+	proto.RegisterType((*ExportMessagesRequest)(nil), "protocol.ExportMessagesRequest")
+	proto.RegisterType((*ExportMessagesResponse)(nil), "protocol.ExportMessagesResponse")
+	proto.RegisterType((*ExportPartitionOffset)(nil), "protocol.ExportPartitionOffset")
+	proto.RegisterType((*ExportResumeToken)(nil), "protocol.ExportResumeToken")
+	proto.RegisterType((*TriggerCompactionRequest)(nil), "protocol.TriggerCompactionRequest")
+	proto.RegisterType((*TriggerCompactionResponse)(nil), "protocol.TriggerCompactionResponse")
+	proto.RegisterType((*CleanPartitionRequest)(nil), "protocol.CleanPartitionRequest")
+	proto.RegisterType((*CleanPartitionResponse)(nil), "protocol.CleanPartitionResponse")
+	proto.RegisterType((*CheckMessageExistsRequest)(nil), "protocol.CheckMessageExistsRequest")
+	proto.RegisterType((*CheckMessageExistsResponse)(nil), "protocol.CheckMessageExistsResponse")
+	proto.RegisterType((*GetReassignmentStatusRequest)(nil), "protocol.GetReassignmentStatusRequest")
+	proto.RegisterType((*GetReassignmentStatusResponse)(nil), "protocol.GetReassignmentStatusResponse")
+	proto.RegisterType((*MergeSegmentsRequest)(nil), "protocol.MergeSegmentsRequest")
+	proto.RegisterType((*MergeSegmentsResponse)(nil), "protocol.MergeSegmentsResponse")
+	proto.RegisterType((*FetchStreamSkewRequest)(nil), "protocol.FetchStreamSkewRequest")
+	proto.RegisterType((*FetchStreamSkewResponse)(nil), "protocol.FetchStreamSkewResponse")
+	proto.RegisterType((*RegisterProducerRequest)(nil), "protocol.RegisterProducerRequest")
+	proto.RegisterType((*RegisterProducerResponse)(nil), "protocol.RegisterProducerResponse")
+	proto.RegisterType((*ReleaseProducerRequest)(nil), "protocol.ReleaseProducerRequest")
+	proto.RegisterType((*ReleaseProducerResponse)(nil), "protocol.ReleaseProducerResponse")
+	proto.RegisterType((*SetProducerQuotaRequest)(nil), "protocol.SetProducerQuotaRequest")
+	proto.RegisterType((*SetProducerQuotaResponse)(nil), "protocol.SetProducerQuotaResponse")
+	proto.RegisterType((*GetProducerQuotasRequest)(nil), "protocol.GetProducerQuotasRequest")
+	proto.RegisterType((*GetProducerQuotasResponse)(nil), "protocol.GetProducerQuotasResponse")
+	proto.RegisterType((*DeleteProducerQuotaRequest)(nil), "protocol.DeleteProducerQuotaRequest")
+	proto.RegisterType((*DeleteProducerQuotaResponse)(nil), "protocol.DeleteProducerQuotaResponse")
+	proto.RegisterType((*AddRaftServerRequest)(nil), "protocol.AddRaftServerRequest")
+	proto.RegisterType((*AddRaftServerResponse)(nil), "protocol.AddRaftServerResponse")
+	proto.RegisterType((*RemoveRaftServerRequest)(nil), "protocol.RemoveRaftServerRequest")
+	proto.RegisterType((*RemoveRaftServerResponse)(nil), "protocol.RemoveRaftServerResponse")
+	proto.RegisterType((*ListRaftServersRequest)(nil), "protocol.ListRaftServersRequest")
+	proto.RegisterType((*RaftServer)(nil), "protocol.RaftServer")
+	proto.RegisterType((*ListRaftServersResponse)(nil), "protocol.ListRaftServersResponse")
+	proto.RegisterType((*FetchSubjectLayoutRequest)(nil), "protocol.FetchSubjectLayoutRequest")
+	proto.RegisterType((*SubjectLayoutEntry)(nil), "protocol.SubjectLayoutEntry")
+	proto.RegisterType((*FetchSubjectLayoutResponse)(nil), "protocol.FetchSubjectLayoutResponse")
+	proto.RegisterType((*WatchMetadataRequest)(nil), "protocol.WatchMetadataRequest")
+	proto.RegisterType((*MetadataEvent)(nil), "protocol.MetadataEvent")
+	proto.RegisterType((*WatchPartitionRequest)(nil), "protocol.WatchPartitionRequest")
+	proto.RegisterType((*PartitionEvent)(nil), "protocol.PartitionEvent")
+	proto.RegisterType((*ExportCursorsRequest)(nil), "protocol.ExportCursorsRequest")
+	proto.RegisterType((*ExportedPartition)(nil), "protocol.ExportedPartition")
+	proto.RegisterType((*ExportedStream)(nil), "protocol.ExportedStream")
+	proto.RegisterType((*ExportedCursor)(nil), "protocol.ExportedCursor")
+	proto.RegisterType((*CursorDocument)(nil), "protocol.CursorDocument")
+	proto.RegisterType((*ExportCursorsResponse)(nil), "protocol.ExportCursorsResponse")
+	proto.RegisterType((*StreamMapping)(nil), "protocol.StreamMapping")
+	proto.RegisterType((*ImportCursorsRequest)(nil), "protocol.ImportCursorsRequest")
+	proto.RegisterType((*ImportedCursor)(nil), "protocol.ImportedCursor")
+	proto.RegisterType((*ImportCursorsResponse)(nil), "protocol.ImportCursorsResponse")
+	proto.RegisterType((*RestoreStreamsRequest)(nil), "protocol.RestoreStreamsRequest")
+	proto.RegisterType((*RestoredPartition)(nil), "protocol.RestoredPartition")
+	proto.RegisterType((*RestoreStreamsResponse)(nil), "protocol.RestoreStreamsResponse")
+}
+
+func init() { proto.RegisterFile("server/protocol/admin.proto", fileDescriptor_6426cc41786d6dd2) }
+
+var fileDescriptor_6426cc41786d6dd2 = []byte{
+	// 4642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3b, 0x4d, 0x6f, 0x1b, 0xc9,
+	0x72, 0x1e, 0x4a, 0x94, 0xa8, 0x92, 0x44, 0x53, 0x6d, 0x89, 0xa2, 0xc7, 0x5e, 0x59, 0x9e, 0xf5,
+	0x7a, 0xfd, 0x8c, 0x8d, 0xed, 0x55, 0x9c, 0xcd, 0xee, 0xbe, 0xcd, 0xdb, 0x70, 0x45, 0xda, 0xe6,
+	0x5b, 0x7d, 0xbd, 0x21, 0xb5, 0x7e, 0x1b, 0xbc, 0x44, 0x18, 0x91, 0x2d, 0x6a, 0x9e, 0x87, 0x33,
+	0xdc, 0x99, 0xa6, 0x2d, 0x2d, 0x10, 0x20, 0x41, 0x2e, 0x39, 0xbc, 0x20, 0xb7, 0xc5, 0xbb, 0xe7,
+	0x90, 0x63, 0x10, 0x04, 0xc8, 0x31, 0x40, 0x90, 0x43, 0xde, 0x29, 0xc8, 0x35, 0xb7, 0x60, 0x93,
+	0x5b, 0x7e, 0x42, 0x80, 0x20, 0xe8, 0xaf, 0x99, 0xee, 0xf9, 0xa0, 0xb4, 0xb6, 0xdf, 0x8d, 0x55,
+	0x5d, 0xdd, 0x55, 0xd5, 0x55, 0x53, 0x5d, 0x5d, 0xd5, 0x84, 0x1b, 0x11, 0x0e, 0x5f, 0xe2, 0xf0,
+	0xe1, 0x38, 0x0c, 0x48, 0xd0, 0x0f, 0xbc, 0x87, 0xce, 0x60, 0xe4, 0xfa, 0x0f, 0x18, 0x88, 0x2a,
+	0x12, 0x6b, 0x6e, 0xa4, 0xc9, 0x5c, 0x9f, 0xe0, 0xd0, 0x77, 0x3c, 0x4e, 0x69, 0xfd, 0xad, 0x01,
+	0x6b, 0xbd, 0xd0, 0xf1, 0xa3, 0x13, 0x1c, 0xee, 0x60, 0x67, 0x80, 0x43, 0x1b, 0x7f, 0x33, 0xc1,
+	0x11, 0x41, 0x75, 0x98, 0x8b, 0x48, 0x88, 0x9d, 0x51, 0xc3, 0xd8, 0x34, 0xee, 0x2d, 0xd8, 0x02,
+	0x42, 0x37, 0x61, 0x61, 0xec, 0x84, 0xc4, 0x25, 0x6e, 0xe0, 0x37, 0x4a, 0x9b, 0xc6, 0xbd, 0xb2,
+	0x9d, 0x20, 0x90, 0x05, 0x4b, 0xc4, 0x09, 0x87, 0x98, 0x7c, 0x11, 0x06, 0x2f, 0x70, 0xd8, 0x98,
+	0x61, 0x73, 0x35, 0x1c, 0x7a, 0x0c, 0x6b, 0xaf, 0x1c, 0x97, 0x3c, 0x09, 0x04, 0x47, 0xc9, 0xbf,
+	0x31, 0xbb, 0x69, 0xdc, 0xab, 0xd8, 0xf9, 0x83, 0x56, 0x03, 0xea, 0x69, 0x41, 0xa3, 0x71, 0xe0,
+	0x47, 0xd8, 0x7a, 0x00, 0xf5, 0xa6, 0xe7, 0x05, 0x7d, 0x87, 0x4a, 0xd0, 0x25, 0x0e, 0x89, 0xa4,
+	0x0e, 0xab, 0x50, 0xf6, 0xdc, 0x91, 0x4b, 0x98, 0x0a, 0x65, 0x9b, 0x03, 0xd6, 0xaf, 0x4b, 0xb0,
+	0x7a, 0x20, 0x25, 0x4e, 0x66, 0x46, 0xaf, 0xa9, 0xf2, 0x7d, 0xa8, 0x39, 0xe3, 0x71, 0x18, 0x9c,
+	0xf5, 0x02, 0xe2, 0x78, 0x5f, 0x9c, 0x13, 0x1c, 0x31, 0xb5, 0x67, 0xec, 0x0c, 0x9e, 0xaa, 0xce,
+	0x71, 0xbb, 0x38, 0x8a, 0x9c, 0x21, 0xee, 0x62, 0xc2, 0x27, 0xcc, 0xb2, 0x09, 0xf9, 0x83, 0x68,
+	0x0b, 0x56, 0xf9, 0x40, 0x77, 0x72, 0x1c, 0xf5, 0x43, 0xf7, 0x18, 0xf3, 0x49, 0x65, 0x36, 0x29,
+	0x77, 0x2c, 0xe1, 0xb4, 0x1d, 0x8c, 0xc6, 0x4e, 0x9f, 0x4a, 0xca, 0x27, 0xcd, 0xa9, 0x9c, 0x52,
+	0x83, 0xd6, 0xbf, 0x1a, 0x30, 0xff, 0x74, 0x9b, 0xed, 0x21, 0xdd, 0x8d, 0xfe, 0x79, 0xdf, 0xc3,
+	0x11, 0xdb, 0x8d, 0x59, 0x5b, 0x40, 0xe8, 0x2e, 0x54, 0x4f, 0xb1, 0x33, 0x66, 0x1b, 0xc7, 0x97,
+	0x2c, 0xb1, 0xf1, 0x14, 0x16, 0xdd, 0x83, 0xab, 0x14, 0xb3, 0x7f, 0xfc, 0x4b, 0xdc, 0x27, 0xc9,
+	0xb6, 0xcc, 0xda, 0x69, 0x34, 0x32, 0xa1, 0x32, 0x76, 0x26, 0x11, 0x3e, 0xf8, 0xbd, 0x47, 0x62,
+	0x23, 0x62, 0x38, 0x19, 0xfb, 0xe4, 0x13, 0xa1, 0x6f, 0x0c, 0xc7, 0x63, 0xbb, 0xce, 0x99, 0x50,
+	0x2b, 0x86, 0xad, 0xff, 0x36, 0x60, 0x3d, 0xe3, 0x15, 0xdc, 0x61, 0xe8, 0xbc, 0x63, 0xe6, 0x8a,
+	0x9d, 0x81, 0xb0, 0x74, 0x0c, 0xa3, 0x0d, 0x80, 0xc8, 0x19, 0x8d, 0x3d, 0x6c, 0x3b, 0x04, 0x0b,
+	0x63, 0x2b, 0x98, 0x1f, 0x64, 0xed, 0x9f, 0x00, 0xc4, 0x6e, 0x42, 0x4d, 0x3c, 0x73, 0x6f, 0x71,
+	0x6b, 0xe3, 0x81, 0xfc, 0x14, 0x1f, 0xe4, 0xf9, 0xa0, 0xad, 0xcc, 0x40, 0xb7, 0xa1, 0x34, 0xec,
+	0x33, 0xad, 0x17, 0xb7, 0x56, 0x92, 0x79, 0xc2, 0x40, 0x76, 0x69, 0xd8, 0xb7, 0x6e, 0x82, 0xb9,
+	0x8b, 0x89, 0x33, 0x70, 0x88, 0xb3, 0x8b, 0x47, 0x41, 0x78, 0xae, 0xfa, 0xbf, 0xf5, 0x97, 0x06,
+	0xd4, 0xe5, 0x70, 0x97, 0x84, 0x93, 0x3e, 0x99, 0x84, 0x98, 0x5b, 0x17, 0xc1, 0xac, 0xef, 0x8c,
+	0xb0, 0xd0, 0x9f, 0xfd, 0x46, 0x0d, 0x98, 0xc7, 0x3e, 0x09, 0x5d, 0x61, 0xd2, 0x19, 0x5b, 0x82,
+	0x68, 0x13, 0x16, 0xb9, 0x76, 0xaa, 0xc2, 0x2a, 0x8a, 0xee, 0xdb, 0xc8, 0x39, 0x6b, 0x8b, 0xe9,
+	0xdc, 0x8a, 0x0a, 0xc6, 0xfa, 0x8b, 0x12, 0xdc, 0xc8, 0x95, 0xf4, 0x12, 0x36, 0xf9, 0x43, 0x80,
+	0x48, 0x4a, 0x4f, 0x45, 0xa3, 0xfb, 0xb8, 0x99, 0xec, 0x47, 0xbe, 0x86, 0xb6, 0x32, 0xe7, 0x07,
+	0x59, 0xed, 0x11, 0x5c, 0x8b, 0x88, 0xe3, 0x61, 0x21, 0xb9, 0x8d, 0x47, 0xc1, 0x4b, 0x3c, 0x10,
+	0x2a, 0xe5, 0x0d, 0x51, 0x4f, 0x67, 0x91, 0xe5, 0x2b, 0x37, 0xf0, 0xb8, 0x19, 0x85, 0xab, 0xa6,
+	0xd1, 0xd6, 0x87, 0xb0, 0xfe, 0x04, 0x93, 0xfe, 0x29, 0x8f, 0x84, 0x5a, 0xac, 0x2a, 0x08, 0x3e,
+	0xd6, 0x3f, 0x19, 0x00, 0x36, 0x1e, 0x7b, 0x6e, 0xdf, 0xd9, 0x71, 0x86, 0xd4, 0x46, 0x21, 0x87,
+	0x04, 0x9d, 0x04, 0xd1, 0x07, 0xb0, 0xe2, 0x39, 0x11, 0x61, 0xeb, 0xe3, 0xc1, 0xfe, 0xc9, 0x49,
+	0x84, 0x89, 0xb0, 0x63, 0x76, 0x00, 0xd5, 0x60, 0xc6, 0x73, 0x86, 0x62, 0x13, 0xe8, 0x4f, 0x1a,
+	0x2c, 0x5d, 0xbf, 0x13, 0xc9, 0x30, 0xcc, 0x01, 0x1a, 0xfb, 0xc8, 0x69, 0x18, 0x10, 0xe2, 0xe1,
+	0x01, 0xd3, 0xaa, 0x62, 0x27, 0x08, 0x16, 0xee, 0x05, 0xd0, 0x73, 0x47, 0x58, 0x7c, 0x85, 0x1a,
+	0x8e, 0x5a, 0x7e, 0x45, 0x04, 0xa7, 0x71, 0xfc, 0x2d, 0x52, 0x3d, 0x86, 0x61, 0x30, 0x19, 0xc7,
+	0xe6, 0x96, 0x20, 0xf5, 0xa4, 0x7e, 0xe0, 0x47, 0x93, 0x11, 0xf3, 0x85, 0x12, 0x1b, 0x54, 0x30,
+	0x94, 0xe7, 0x29, 0x3b, 0x00, 0x9e, 0xb8, 0x1e, 0x49, 0x8e, 0x18, 0x15, 0x47, 0xd7, 0xa0, 0x2a,
+	0x8b, 0x4d, 0x10, 0xde, 0x98, 0x60, 0xe8, 0x1a, 0x23, 0x1e, 0x64, 0xa3, 0x2e, 0xf6, 0x89, 0x30,
+	0x97, 0x86, 0xa3, 0x3e, 0x23, 0x61, 0xbe, 0x2a, 0x1e, 0x08, 0xfd, 0x32, 0x78, 0xfa, 0x7d, 0xbc,
+	0x74, 0xbc, 0x09, 0x16, 0x22, 0xcd, 0x33, 0x91, 0x54, 0x94, 0x15, 0xc0, 0x72, 0xc7, 0x1f, 0xe2,
+	0x88, 0x74, 0x83, 0x49, 0xd8, 0xc7, 0x11, 0x35, 0x80, 0x33, 0x76, 0x99, 0xf2, 0x33, 0x36, 0xfd,
+	0xc9, 0x3f, 0x49, 0x22, 0xbf, 0x3d, 0xf6, 0x9b, 0x7a, 0xc5, 0xc8, 0x0d, 0xc3, 0x20, 0x14, 0x96,
+	0x12, 0x10, 0x65, 0x28, 0xec, 0xce, 0x0e, 0x25, 0xae, 0xa1, 0x8a, 0xb2, 0xfe, 0x08, 0x96, 0xba,
+	0x78, 0x38, 0xc2, 0x3e, 0xb1, 0x03, 0xcf, 0x63, 0x41, 0x76, 0xe4, 0x88, 0xef, 0x97, 0x33, 0x8d,
+	0x61, 0xc6, 0xc5, 0x39, 0x6b, 0x0e, 0xb1, 0xe0, 0x2d, 0x20, 0x8a, 0x3f, 0x09, 0xc2, 0x3e, 0x1e,
+	0x48, 0xee, 0x1c, 0xb2, 0xfe, 0x79, 0x1e, 0xaa, 0x71, 0xf4, 0x8a, 0x4f, 0x8b, 0xd7, 0x38, 0x3b,
+	0xeb, 0x30, 0xe7, 0x31, 0xbb, 0x09, 0x2b, 0x0a, 0x88, 0xaa, 0xc7, 0x7f, 0xb5, 0xc7, 0x41, 0xff,
+	0x94, 0xa9, 0x37, 0x6b, 0xab, 0x28, 0xaa, 0x8e, 0x1b, 0xf1, 0x44, 0x40, 0xb8, 0x65, 0x0c, 0xd3,
+	0x13, 0xca, 0x0b, 0x86, 0x5d, 0xe2, 0x84, 0xd2, 0x03, 0xb8, 0xdd, 0x52, 0x58, 0xea, 0x05, 0x5e,
+	0x30, 0x6c, 0xfb, 0xf2, 0x63, 0x99, 0xe7, 0x5e, 0xa0, 0xe2, 0xd0, 0x1d, 0x58, 0x3e, 0x75, 0x87,
+	0xa7, 0xcf, 0x1d, 0x82, 0xc3, 0x91, 0x13, 0xbe, 0x68, 0x54, 0x18, 0x91, 0x8e, 0xa4, 0x5a, 0x46,
+	0xee, 0xb7, 0xe2, 0x58, 0x5e, 0x60, 0x14, 0x09, 0x82, 0xf2, 0x89, 0xb8, 0x29, 0xb6, 0x83, 0x89,
+	0x4f, 0x1a, 0xc0, 0xb6, 0x41, 0xc3, 0x51, 0x77, 0x70, 0xa3, 0xb0, 0xb1, 0xb8, 0x39, 0x73, 0x6f,
+	0xc1, 0xa6, 0x3f, 0x59, 0x44, 0x15, 0x7e, 0xd6, 0xf1, 0x1b, 0x4b, 0x22, 0xa2, 0xc6, 0x18, 0xaa,
+	0x65, 0x02, 0xb1, 0xd3, 0x6a, 0x99, 0x6b, 0xa9, 0x63, 0xe9, 0x97, 0x76, 0x4c, 0xc5, 0xe8, 0xf8,
+	0x8d, 0x2a, 0x8f, 0xea, 0x02, 0xa4, 0xbb, 0x2c, 0x7e, 0xb2, 0xe9, 0x57, 0xb9, 0x13, 0x29, 0x28,
+	0x16, 0x95, 0x29, 0xb8, 0x3f, 0x21, 0x8d, 0x1a, 0x77, 0x1a, 0x09, 0x53, 0xad, 0xe4, 0x6f, 0x36,
+	0x7d, 0x85, 0xef, 0x9e, 0x8a, 0x43, 0x8f, 0x01, 0xc2, 0x38, 0x76, 0x35, 0x10, 0x8b, 0xdc, 0xab,
+	0x49, 0xe4, 0x4e, 0xe2, 0x9a, 0xad, 0xd0, 0xa1, 0x26, 0x2c, 0x47, 0x4a, 0xc0, 0x88, 0x1a, 0xd7,
+	0xd8, 0xc4, 0x1b, 0xc9, 0xc4, 0x4c, 0x3c, 0xb1, 0xf5, 0x19, 0x34, 0x18, 0x0e, 0x26, 0xfc, 0x63,
+	0xc0, 0x51, 0x2b, 0x0c, 0xc6, 0x63, 0x3c, 0x68, 0xac, 0xf2, 0x60, 0x98, 0x19, 0x40, 0x1f, 0xc0,
+	0x3c, 0x09, 0xc6, 0x5f, 0xe2, 0xf3, 0xa8, 0xb1, 0xc6, 0x58, 0xa1, 0x84, 0xd5, 0x97, 0xf8, 0x9c,
+	0x59, 0xc8, 0x96, 0x24, 0xa8, 0x03, 0x2b, 0x21, 0x76, 0x06, 0xcd, 0xd1, 0xd8, 0x73, 0x4f, 0xe4,
+	0x17, 0x58, 0xdf, 0x34, 0x74, 0x11, 0xed, 0x34, 0x89, 0x9d, 0x9d, 0x85, 0xfe, 0x00, 0x96, 0x5d,
+	0x35, 0x2a, 0x34, 0xd6, 0xd9, 0x32, 0xeb, 0xc9, 0x32, 0x5a, 0xd0, 0xb0, 0x75, 0x6a, 0xf4, 0x69,
+	0xec, 0x58, 0xec, 0x1b, 0x6f, 0x34, 0xd8, 0xec, 0xba, 0xb2, 0x4f, 0xca, 0xa8, 0xad, 0xd1, 0xd2,
+	0x2c, 0xb8, 0x91, 0x3d, 0x8b, 0x2e, 0x71, 0x1a, 0x7f, 0xac, 0x65, 0x35, 0xfc, 0x34, 0x6e, 0xe4,
+	0x64, 0x35, 0xe2, 0x14, 0x4e, 0x68, 0xd1, 0x47, 0x50, 0x9f, 0xf8, 0xce, 0x84, 0x9c, 0x62, 0x9f,
+	0x30, 0x03, 0x0c, 0xa4, 0x65, 0x78, 0x78, 0x29, 0x18, 0xa5, 0x27, 0x32, 0x4d, 0x52, 0x5f, 0xe2,
+	0xae, 0xe6, 0x15, 0xe2, 0x44, 0xce, 0x19, 0x42, 0x9f, 0xc1, 0xe2, 0x38, 0x0c, 0xc6, 0xce, 0x90,
+	0x1b, 0x87, 0xa7, 0x50, 0xa6, 0x22, 0x64, 0x32, 0xc8, 0xc5, 0x54, 0xc9, 0xad, 0x3f, 0x33, 0xa0,
+	0x96, 0xa6, 0xa0, 0x5b, 0xe2, 0x10, 0x82, 0x47, 0x63, 0x12, 0xc7, 0x4f, 0x09, 0xf3, 0x43, 0x59,
+	0x4b, 0x9c, 0x04, 0x48, 0x67, 0x9d, 0x38, 0xae, 0xc7, 0x12, 0x17, 0xae, 0x64, 0x0c, 0xd3, 0x31,
+	0xd7, 0x3f, 0xf1, 0xdc, 0xe1, 0xa9, 0x3c, 0xa2, 0x62, 0x98, 0xde, 0x76, 0x14, 0xe3, 0xd8, 0xbd,
+	0x5e, 0x9c, 0xd3, 0x3d, 0x84, 0xf9, 0x03, 0xcc, 0x50, 0xf4, 0xc0, 0x18, 0x63, 0x1c, 0xca, 0x1c,
+	0x8e, 0xfe, 0xa6, 0x71, 0x24, 0x24, 0xf2, 0xdc, 0xa7, 0x3f, 0xad, 0x11, 0x40, 0xb2, 0x0a, 0x8d,
+	0xb8, 0xdc, 0x92, 0x32, 0x4e, 0x73, 0x88, 0x47, 0x1b, 0x27, 0x9a, 0x84, 0x78, 0xd0, 0x94, 0xd3,
+	0x15, 0x0c, 0x7a, 0x1f, 0xca, 0x74, 0x7d, 0xaa, 0xc5, 0x8c, 0x9e, 0x8e, 0x0a, 0x69, 0x6c, 0x3e,
+	0x6e, 0x61, 0x2d, 0xc5, 0xe1, 0x92, 0x5f, 0xc2, 0xab, 0x1e, 0xc0, 0x3c, 0xff, 0x2d, 0x5d, 0x4a,
+	0x09, 0x13, 0xca, 0x52, 0x92, 0xc8, 0xda, 0x82, 0x7a, 0x0b, 0xf3, 0x0b, 0x4f, 0x97, 0x9d, 0x34,
+	0x71, 0x22, 0xd5, 0x80, 0x79, 0x7e, 0xf6, 0x50, 0x3b, 0xd1, 0x68, 0x2a, 0x41, 0xeb, 0xcf, 0x0d,
+	0xa8, 0xc7, 0xee, 0xa9, 0x9f, 0xc6, 0xaf, 0x77, 0x7c, 0x7d, 0x08, 0xf3, 0x91, 0xf8, 0x70, 0x67,
+	0xa6, 0x7f, 0xb8, 0x92, 0xce, 0xfa, 0x2b, 0x03, 0xd6, 0x33, 0x82, 0x8b, 0xfd, 0xb9, 0xaf, 0x4b,
+	0xbe, 0xb8, 0x55, 0x53, 0xbe, 0x64, 0x36, 0x10, 0xeb, 0x82, 0x9e, 0xa4, 0x23, 0x47, 0x26, 0x2d,
+	0xce, 0xd7, 0x34, 0x15, 0x42, 0xac, 0x47, 0x50, 0x7f, 0x8a, 0x09, 0x5f, 0x7d, 0x3b, 0xf0, 0x4f,
+	0xdc, 0xe1, 0x45, 0x09, 0x69, 0x07, 0xd6, 0x33, 0x33, 0x84, 0x02, 0x0f, 0x60, 0xae, 0xcf, 0x30,
+	0x0d, 0x23, 0x13, 0x89, 0x54, 0x7a, 0x41, 0x65, 0xf5, 0xe1, 0xfa, 0xe1, 0x78, 0xe0, 0x10, 0xfc,
+	0x03, 0xf8, 0x2b, 0x4c, 0x4a, 0x97, 0x62, 0x72, 0x13, 0xcc, 0x3c, 0x26, 0xa2, 0x78, 0x30, 0x81,
+	0x1b, 0xcc, 0x5d, 0xb5, 0xb0, 0x35, 0x89, 0xde, 0xac, 0x0a, 0x42, 0xaf, 0x4b, 0x9e, 0x27, 0x4e,
+	0x37, 0xee, 0x1b, 0x15, 0x5b, 0x45, 0x59, 0xbf, 0x80, 0x9b, 0xf9, 0x6c, 0xc5, 0x4e, 0x7e, 0x06,
+	0x95, 0x50, 0x4e, 0x37, 0x0a, 0x2d, 0x2b, 0x96, 0x13, 0x73, 0xe3, 0x19, 0xd6, 0x6f, 0x0c, 0xd8,
+	0xe8, 0xd2, 0x64, 0x7f, 0xe2, 0x09, 0xad, 0xf7, 0xc7, 0x38, 0xe4, 0xa7, 0x90, 0x50, 0xec, 0x31,
+	0xcc, 0x92, 0xf3, 0x31, 0xbf, 0xff, 0x55, 0xd5, 0xc5, 0xe5, 0xbc, 0x41, 0x3c, 0xa5, 0x77, 0x3e,
+	0xc6, 0x36, 0xa3, 0x56, 0xb6, 0xa3, 0xa4, 0x6d, 0xc7, 0x86, 0x76, 0x26, 0xd0, 0x10, 0x51, 0xd6,
+	0x22, 0xbf, 0x49, 0xd5, 0x71, 0x06, 0x81, 0xef, 0x9d, 0x8b, 0xeb, 0x45, 0x0c, 0xd3, 0xad, 0xc4,
+	0x67, 0xb8, 0x3f, 0x21, 0xb8, 0x29, 0x13, 0xf1, 0x04, 0x61, 0xfd, 0x31, 0xdc, 0x2a, 0xd4, 0x44,
+	0xec, 0xd5, 0xa7, 0xb0, 0x10, 0x48, 0xa4, 0x70, 0xbc, 0x9b, 0xd3, 0xf4, 0xb1, 0x13, 0x72, 0xeb,
+	0x18, 0x36, 0x76, 0xdc, 0x88, 0x64, 0x89, 0x2e, 0xf4, 0x80, 0x7b, 0x70, 0xd5, 0xf5, 0xfb, 0xde,
+	0x64, 0x80, 0x9f, 0xb8, 0xbe, 0x1b, 0x9d, 0x62, 0x7e, 0x57, 0xa9, 0xd8, 0x69, 0xb4, 0x75, 0x04,
+	0xb7, 0x0a, 0x79, 0xc4, 0xe6, 0x86, 0x58, 0x26, 0x69, 0xf0, 0xe9, 0x3a, 0x28, 0xf4, 0xd6, 0x87,
+	0x70, 0x6b, 0xdb, 0xf1, 0xfb, 0xd8, 0xcb, 0xa1, 0x13, 0x5a, 0x54, 0xa1, 0xe4, 0x0e, 0x44, 0x21,
+	0xa7, 0xe4, 0x0e, 0x2c, 0x0b, 0x36, 0x8b, 0xa7, 0x88, 0x4f, 0xe3, 0x19, 0x34, 0xd4, 0x0f, 0x67,
+	0xff, 0x95, 0x7f, 0x71, 0x75, 0x70, 0x15, 0xca, 0x01, 0xa5, 0x13, 0xfe, 0xc1, 0x01, 0xeb, 0x06,
+	0x5c, 0xcf, 0x59, 0x49, 0xb0, 0x79, 0x0e, 0xab, 0x5d, 0x19, 0x4f, 0x7a, 0xce, 0xf0, 0xc2, 0x8d,
+	0x7f, 0x1f, 0x66, 0x89, 0x33, 0x94, 0x01, 0xef, 0x5a, 0xfa, 0xeb, 0xef, 0x39, 0x43, 0x9b, 0x11,
+	0x58, 0xeb, 0xb0, 0x96, 0x5a, 0x58, 0x70, 0xec, 0xc1, 0xb5, 0x78, 0xa0, 0xb9, 0xbd, 0x73, 0x11,
+	0xc3, 0xf7, 0x60, 0xc6, 0xe9, 0x7b, 0x22, 0xda, 0x64, 0xf8, 0xd1, 0x05, 0xe8, 0xb8, 0x55, 0x57,
+	0xf4, 0x60, 0xab, 0x0a, 0x6e, 0xbf, 0x04, 0xb3, 0x85, 0x3d, 0x4c, 0x70, 0xfc, 0xd9, 0xb6, 0x1c,
+	0xe2, 0xbc, 0x59, 0x80, 0xa9, 0xc3, 0x5c, 0xc0, 0xef, 0x2c, 0xe2, 0x62, 0xc6, 0x21, 0xeb, 0x1d,
+	0xb8, 0x91, 0xcb, 0x4b, 0x88, 0xb2, 0x07, 0xf5, 0xd4, 0xf0, 0x1b, 0x89, 0x61, 0x5d, 0x87, 0xf5,
+	0xcc, 0x7a, 0x82, 0xd5, 0x77, 0x06, 0xa0, 0x3d, 0xa7, 0xff, 0x42, 0xd4, 0x32, 0x7f, 0x2b, 0xea,
+	0x52, 0x7c, 0x88, 0x9d, 0x48, 0x5c, 0x80, 0x17, 0x6c, 0x01, 0xd1, 0x70, 0xd3, 0x9f, 0x84, 0x51,
+	0x40, 0x13, 0x8d, 0x32, 0x4f, 0x34, 0x24, 0x6c, 0x35, 0xe1, 0x9a, 0x26, 0x57, 0x7c, 0xf6, 0xd6,
+	0x06, 0xd8, 0x19, 0xec, 0x60, 0x42, 0x70, 0x28, 0xee, 0x83, 0x3c, 0xcd, 0xcb, 0xe0, 0xad, 0x7f,
+	0x98, 0x81, 0xb5, 0xf6, 0xd9, 0x38, 0x08, 0x89, 0x58, 0xe5, 0x42, 0x9f, 0xdd, 0xc8, 0xe4, 0xcc,
+	0x7a, 0x7c, 0xfc, 0x04, 0x16, 0x23, 0xe5, 0xba, 0x9a, 0x49, 0x26, 0xf6, 0x26, 0x9e, 0xe7, 0x1c,
+	0x7b, 0xb8, 0xe3, 0x93, 0x8f, 0x1e, 0xdb, 0x2a, 0x2d, 0xfa, 0x7d, 0x80, 0x88, 0x04, 0x63, 0xa5,
+	0xd4, 0x31, 0x65, 0xa6, 0x42, 0x8a, 0x3e, 0x87, 0x2a, 0x5b, 0x87, 0x16, 0x69, 0x22, 0xe2, 0x8c,
+	0xc6, 0x8d, 0xf2, 0xf4, 0xc9, 0x29, 0x72, 0x7a, 0x79, 0xa1, 0xcb, 0x25, 0xf3, 0xe7, 0xa6, 0xcf,
+	0xd7, 0xa9, 0xe9, 0x39, 0x7e, 0x12, 0x84, 0x23, 0x87, 0xdf, 0xbb, 0xab, 0xea, 0x39, 0xce, 0x37,
+	0xf7, 0x09, 0x1b, 0xb5, 0x05, 0x15, 0x75, 0x91, 0xfe, 0xe9, 0xc4, 0x7f, 0xd1, 0x75, 0xbf, 0xc5,
+	0xec, 0x16, 0x5e, 0xb6, 0x13, 0x04, 0x2f, 0x88, 0xd0, 0x12, 0x51, 0x2f, 0x78, 0x81, 0x7d, 0x76,
+	0x07, 0x5f, 0xb0, 0x55, 0x14, 0x2b, 0x86, 0xa6, 0xad, 0x26, 0x8c, 0xaf, 0x79, 0x9f, 0x91, 0xf6,
+	0x3e, 0x5a, 0x39, 0x11, 0x33, 0x84, 0x6b, 0xc6, 0x30, 0x4d, 0xc1, 0x69, 0xe9, 0x91, 0x59, 0x6c,
+	0xc9, 0x66, 0xbf, 0xd3, 0xa2, 0xcc, 0x66, 0x45, 0x39, 0x94, 0xfe, 0x13, 0x7f, 0x37, 0xc2, 0x26,
+	0xd3, 0x05, 0xd9, 0x00, 0xf0, 0xf1, 0x19, 0xd1, 0x4a, 0x7b, 0x0a, 0xc6, 0xea, 0xc1, 0x0a, 0x5f,
+	0xd6, 0x4e, 0x78, 0xa1, 0xcf, 0x35, 0xd7, 0xe3, 0x47, 0xcb, 0xad, 0xf4, 0x56, 0xa7, 0xe4, 0x50,
+	0x7d, 0xd3, 0x3a, 0x80, 0x46, 0x2f, 0x74, 0x87, 0x43, 0x1c, 0x26, 0xdd, 0x82, 0x37, 0x0b, 0x1b,
+	0xff, 0x61, 0xc0, 0xf5, 0x9c, 0x25, 0x85, 0x31, 0x3e, 0x80, 0x15, 0x71, 0x51, 0x8d, 0x0e, 0xc2,
+	0xa0, 0x8f, 0xa3, 0x08, 0x0f, 0xc4, 0x5e, 0x64, 0x07, 0x68, 0x15, 0x84, 0x55, 0x1c, 0x6c, 0xdc,
+	0xf7, 0x1c, 0x77, 0x24, 0x4e, 0xe1, 0x19, 0x3b, 0x85, 0xa5, 0x75, 0x9c, 0x17, 0xf8, 0x3c, 0x12,
+	0xfc, 0xe2, 0x2b, 0xa7, 0x8e, 0x64, 0xe6, 0x0c, 0x7c, 0x2c, 0x72, 0x14, 0xf6, 0x9b, 0xca, 0x43,
+	0x82, 0xd1, 0x71, 0x44, 0x02, 0x3f, 0x29, 0x25, 0xf0, 0x3c, 0x25, 0x3b, 0x60, 0x9d, 0xc3, 0xda,
+	0xb6, 0x87, 0x1d, 0xff, 0xed, 0x44, 0x58, 0x1a, 0x96, 0x64, 0x3a, 0x11, 0x78, 0x5e, 0xf0, 0x8a,
+	0xdf, 0xc0, 0xa8, 0x70, 0x19, 0xbc, 0xd5, 0x83, 0x7a, 0x9a, 0x75, 0x9c, 0x21, 0xa5, 0xb3, 0xc9,
+	0xbc, 0x36, 0x04, 0x9b, 0x4c, 0x5d, 0xc7, 0x23, 0x4a, 0x2e, 0xf9, 0xf7, 0x06, 0x5c, 0xdf, 0x3e,
+	0xc5, 0x71, 0xc4, 0x6c, 0x9f, 0xb9, 0x11, 0x79, 0xc3, 0xfc, 0xf8, 0x0e, 0x2c, 0xf7, 0x83, 0x30,
+	0xc4, 0xbc, 0x2c, 0xde, 0x19, 0x88, 0xea, 0x9f, 0x8e, 0x54, 0xa2, 0xfe, 0xac, 0x16, 0xf5, 0x53,
+	0xc5, 0xc1, 0x72, 0xa6, 0x38, 0x68, 0xfd, 0xca, 0x00, 0x33, 0x4f, 0x66, 0xb1, 0x1d, 0x75, 0x98,
+	0xc3, 0x0c, 0xc3, 0x84, 0xae, 0xd8, 0x02, 0x52, 0x18, 0x96, 0xa6, 0x31, 0x9c, 0xc9, 0x30, 0x64,
+	0xb1, 0x29, 0x18, 0x8d, 0x5c, 0x42, 0x44, 0xa7, 0xa0, 0x62, 0x27, 0x08, 0xab, 0x07, 0x37, 0x9f,
+	0x62, 0x62, 0x63, 0x27, 0x8a, 0xdc, 0xa1, 0x4f, 0x1d, 0xf8, 0x2d, 0x5c, 0x32, 0xac, 0x7f, 0x31,
+	0xe0, 0x9d, 0x82, 0x65, 0x85, 0x9e, 0x77, 0xa1, 0xca, 0x1b, 0xaf, 0xb6, 0x6a, 0xfc, 0x05, 0x3b,
+	0x85, 0x65, 0x15, 0xf7, 0x49, 0x18, 0x62, 0x9f, 0xd0, 0xf2, 0x7f, 0x89, 0xd1, 0x28, 0x18, 0xe5,
+	0x1b, 0x1b, 0x39, 0xae, 0xef, 0xfa, 0xb2, 0x6d, 0x90, 0xc2, 0x52, 0x79, 0xd9, 0x11, 0xc1, 0x4a,
+	0x08, 0xb3, 0xa2, 0x0a, 0x2a, 0x11, 0x34, 0x35, 0x1c, 0x9f, 0x3a, 0x11, 0x16, 0x27, 0x32, 0x07,
+	0x2c, 0x1f, 0x56, 0x77, 0x71, 0x48, 0x9b, 0x9d, 0xfc, 0xcb, 0x7e, 0xe3, 0x8b, 0x97, 0x68, 0x35,
+	0xab, 0x7d, 0x2a, 0x05, 0x65, 0x61, 0x58, 0x4b, 0xf1, 0x4b, 0x36, 0x4b, 0x46, 0x97, 0x2f, 0xf0,
+	0x49, 0x10, 0x62, 0x11, 0x73, 0x52, 0x58, 0xea, 0xbb, 0x12, 0xd3, 0x3c, 0x21, 0x22, 0xd3, 0x2d,
+	0xdb, 0x3a, 0x92, 0x96, 0x27, 0xd8, 0xfd, 0x8e, 0xa7, 0x83, 0xdd, 0x17, 0xf8, 0xd5, 0xc5, 0xe5,
+	0x89, 0x0e, 0xac, 0x67, 0xe6, 0xc4, 0x17, 0xeb, 0x54, 0x65, 0x60, 0x35, 0x9d, 0x86, 0x32, 0xf2,
+	0x78, 0xa9, 0x23, 0x58, 0xb7, 0xf1, 0xd0, 0x8d, 0x08, 0x0e, 0x0f, 0xc2, 0x60, 0x30, 0xe9, 0x5f,
+	0x9c, 0xb9, 0xd3, 0x66, 0xaa, 0x20, 0x15, 0xc9, 0x7b, 0x0c, 0xd3, 0xa2, 0x12, 0x21, 0x9e, 0x6c,
+	0x16, 0x11, 0xe2, 0x59, 0x8f, 0xa0, 0x91, 0x65, 0x20, 0x84, 0x5d, 0x85, 0x32, 0x66, 0x1f, 0x0a,
+	0xbf, 0x6e, 0x70, 0xc0, 0x3a, 0x86, 0xba, 0x8d, 0x3d, 0xec, 0x44, 0xf8, 0x6d, 0x48, 0x14, 0xf3,
+	0x98, 0x51, 0x79, 0x5c, 0x87, 0xf5, 0x0c, 0x8f, 0xf8, 0x32, 0xb3, 0xde, 0xc5, 0x44, 0xa2, 0x7f,
+	0x36, 0x09, 0x92, 0x14, 0xfc, 0x77, 0xa0, 0xfc, 0x0d, 0x85, 0x1b, 0x46, 0x3a, 0x7f, 0xd1, 0xc9,
+	0x39, 0x95, 0x65, 0x42, 0x23, 0xbb, 0x92, 0xe0, 0xf2, 0x29, 0x34, 0x9e, 0xa6, 0xc6, 0x62, 0x8f,
+	0xa6, 0x39, 0xa0, 0x18, 0xe8, 0xb4, 0x84, 0xaa, 0x0a, 0xc6, 0xda, 0x81, 0xeb, 0x39, 0x73, 0xc5,
+	0x9e, 0x3e, 0x84, 0x39, 0xc6, 0x5d, 0xda, 0xbf, 0x50, 0x48, 0x41, 0x66, 0x7d, 0x16, 0xdf, 0x3a,
+	0xf2, 0x54, 0xbe, 0x48, 0x96, 0xe4, 0x1e, 0x91, 0xab, 0xe6, 0x1e, 0xac, 0x36, 0x07, 0x03, 0xdb,
+	0x39, 0x21, 0x5d, 0xf6, 0xbc, 0x44, 0x2e, 0x6b, 0x42, 0x85, 0xbf, 0x37, 0x49, 0x0a, 0x7c, 0x12,
+	0xa6, 0x63, 0xc1, 0x31, 0x87, 0xc4, 0x45, 0x39, 0x86, 0xe9, 0x4d, 0x2d, 0xb5, 0x9e, 0x60, 0xf4,
+	0x25, 0xac, 0xf3, 0x26, 0xeb, 0x0f, 0xe3, 0xb5, 0x0a, 0x65, 0xd6, 0xa9, 0x12, 0x8c, 0x38, 0x40,
+	0x0d, 0x97, 0x5d, 0x4c, 0x30, 0x6a, 0x40, 0x9d, 0xde, 0xd1, 0x93, 0x91, 0xb8, 0xde, 0xfa, 0x1d,
+	0xed, 0xbf, 0xc6, 0xe8, 0xa9, 0x6c, 0xb7, 0xa0, 0x12, 0x4d, 0x4e, 0x4e, 0x42, 0x47, 0x34, 0xd2,
+	0xb4, 0x9c, 0x96, 0xad, 0x21, 0x46, 0xed, 0x98, 0x2e, 0xd5, 0x01, 0xab, 0x68, 0x1d, 0x30, 0x27,
+	0x22, 0xdb, 0x81, 0x4f, 0x9c, 0xbe, 0x8c, 0xa6, 0x2a, 0x8a, 0x86, 0x8b, 0x8c, 0xc8, 0x4a, 0xb8,
+	0xe0, 0xa8, 0x6c, 0xb8, 0x50, 0x94, 0x97, 0x44, 0xf4, 0x7e, 0xce, 0x23, 0xcf, 0x84, 0xbd, 0xca,
+	0xd8, 0x71, 0xce, 0x83, 0x09, 0x91, 0x1b, 0x30, 0x00, 0xa4, 0xe1, 0x69, 0xf3, 0xfb, 0xbc, 0xe8,
+	0xfd, 0x40, 0xc4, 0x29, 0xc5, 0xf7, 0x2a, 0x41, 0xaa, 0xcd, 0x00, 0xc7, 0xf5, 0x79, 0x71, 0xdc,
+	0xab, 0x28, 0xeb, 0x1f, 0x0d, 0x30, 0xf3, 0x64, 0xb8, 0x44, 0xe9, 0xf8, 0x26, 0x2c, 0x50, 0xf6,
+	0xd1, 0xd8, 0x11, 0x16, 0x5f, 0xb0, 0x13, 0x04, 0x8b, 0xd7, 0x7c, 0xc9, 0x83, 0x10, 0x9f, 0xb8,
+	0x67, 0x32, 0xd7, 0xd0, 0x90, 0xe8, 0x63, 0xa8, 0x08, 0x84, 0x7c, 0xa8, 0x71, 0x53, 0xeb, 0x36,
+	0xa5, 0xd4, 0xb7, 0x63, 0x6a, 0xeb, 0x27, 0xb0, 0xfa, 0xdc, 0x21, 0xfd, 0x53, 0xf9, 0x0a, 0x41,
+	0xfa, 0x27, 0x3d, 0x4f, 0x58, 0x1c, 0xe3, 0x1c, 0x70, 0x7c, 0xf8, 0xea, 0x58, 0xeb, 0x7f, 0x4b,
+	0xb0, 0x2c, 0xe7, 0xb6, 0x5f, 0x62, 0x9f, 0xa0, 0x87, 0x5a, 0x69, 0xee, 0x46, 0xf6, 0xa1, 0x03,
+	0x23, 0x53, 0xaa, 0x72, 0xac, 0x73, 0x3f, 0xc0, 0x67, 0xe2, 0x21, 0x0e, 0x07, 0x94, 0xb0, 0x3a,
+	0x53, 0x7c, 0x82, 0xce, 0xa6, 0x4f, 0xd0, 0x8f, 0xa5, 0xd8, 0x92, 0x99, 0xb8, 0x15, 0x66, 0x4b,
+	0xd1, 0x29, 0x3a, 0xd4, 0x84, 0x95, 0x78, 0x99, 0x78, 0xf2, 0x5c, 0xba, 0x68, 0x92, 0x24, 0xa7,
+	0x59, 0x6a, 0xf6, 0x9c, 0x80, 0x78, 0x3c, 0xf2, 0x0c, 0x9a, 0xfc, 0x62, 0x38, 0x63, 0x6b, 0x38,
+	0xb4, 0x03, 0x28, 0xca, 0xd4, 0xac, 0xd8, 0x7d, 0xf0, 0xa2, 0x92, 0x59, 0xce, 0x3c, 0xeb, 0x4f,
+	0x61, 0x8d, 0x59, 0xef, 0x2d, 0xa5, 0xeb, 0x0f, 0x00, 0xd1, 0x37, 0x2f, 0x2f, 0xd9, 0x1d, 0x05,
+	0x87, 0x5d, 0xdc, 0x0f, 0x7c, 0x9e, 0xdd, 0x96, 0xed, 0x9c, 0x11, 0xeb, 0xdf, 0x4a, 0x4a, 0x23,
+	0x9d, 0x5b, 0xff, 0x23, 0x98, 0xef, 0x9f, 0x3a, 0xfe, 0x50, 0x38, 0x4c, 0x55, 0x55, 0x4a, 0x27,
+	0x65, 0x1e, 0x20, 0x89, 0x0b, 0x4b, 0xb3, 0x9a, 0xc0, 0x33, 0x69, 0x81, 0xe9, 0xf3, 0x8e, 0xf8,
+	0xfe, 0x2e, 0x52, 0xb6, 0x18, 0x91, 0x6d, 0x7e, 0x97, 0xf3, 0x9a, 0xdf, 0x16, 0x2c, 0xf9, 0xf8,
+	0x15, 0x8e, 0xf4, 0x66, 0xbb, 0x86, 0x93, 0xed, 0xed, 0xf9, 0xa4, 0xbd, 0xad, 0x96, 0x84, 0x2b,
+	0xa9, 0x92, 0x70, 0x1d, 0xe6, 0xd8, 0x43, 0xae, 0x01, 0xbb, 0xc7, 0x57, 0x6c, 0x01, 0xa5, 0x13,
+	0x71, 0xc8, 0x66, 0xfe, 0x3f, 0x87, 0x55, 0x7e, 0xa3, 0xdd, 0x66, 0xf5, 0x9e, 0xf8, 0xf0, 0xbd,
+	0x07, 0x57, 0x65, 0x05, 0xe8, 0xc0, 0x21, 0x04, 0x87, 0xbe, 0xb0, 0x6b, 0x1a, 0x5d, 0xb4, 0x8f,
+	0xd6, 0xdf, 0x18, 0xf2, 0x76, 0x8d, 0x07, 0xb1, 0x1d, 0x94, 0xba, 0x6a, 0x99, 0xd6, 0x55, 0x93,
+	0xbc, 0xa4, 0xa4, 0xe4, 0x25, 0x97, 0xb8, 0x40, 0x58, 0xb0, 0x14, 0x78, 0x03, 0x9c, 0x7a, 0xb2,
+	0xa2, 0xe1, 0x32, 0xfb, 0x5c, 0xce, 0xee, 0xb3, 0xf5, 0xd7, 0x06, 0x54, 0xa5, 0x94, 0xfc, 0x3b,
+	0xcd, 0x8d, 0xd4, 0x1f, 0xc0, 0x4a, 0x3f, 0xc4, 0xbc, 0xba, 0x1f, 0x9b, 0x5f, 0xbc, 0x15, 0xca,
+	0x0c, 0xa0, 0x1f, 0x67, 0xaa, 0xfb, 0x5a, 0xa7, 0x3b, 0xb3, 0x2b, 0x5a, 0xf9, 0xe0, 0xdb, 0x44,
+	0x20, 0x6e, 0x13, 0xad, 0x3a, 0x67, 0xe8, 0xd5, 0xb9, 0xd7, 0xf4, 0xe2, 0x82, 0x9b, 0x22, 0x3d,
+	0x54, 0xaa, 0x9c, 0x69, 0x2b, 0xe8, 0x4f, 0x68, 0x7a, 0xae, 0x1f, 0x16, 0x46, 0xfa, 0xb0, 0xd8,
+	0x00, 0xc0, 0x42, 0xd8, 0xa4, 0x0b, 0x9a, 0x60, 0xd0, 0x56, 0x92, 0x87, 0xcf, 0xa4, 0x1b, 0xdf,
+	0xfa, 0xb6, 0x27, 0x9d, 0xba, 0x2d, 0x98, 0xe7, 0xea, 0xc9, 0x93, 0x25, 0x67, 0x0e, 0x17, 0xd2,
+	0x96, 0x84, 0xd6, 0xae, 0x2c, 0x10, 0xc5, 0x6e, 0x2c, 0xce, 0xc1, 0xc7, 0x50, 0x19, 0x08, 0x55,
+	0x44, 0xba, 0xaa, 0xac, 0xa6, 0xab, 0x6a, 0xc7, 0x94, 0xd6, 0xe7, 0xb0, 0xcc, 0xa5, 0xda, 0x75,
+	0xc6, 0x63, 0x7a, 0x53, 0xa3, 0xdb, 0xcc, 0x1a, 0x80, 0x71, 0x74, 0x63, 0x10, 0xc5, 0xf3, 0xcb,
+	0x92, 0xdc, 0x7e, 0x0e, 0x59, 0xff, 0x67, 0xc0, 0x6a, 0x67, 0x94, 0xf3, 0x5d, 0xbd, 0x96, 0x3c,
+	0xbc, 0xf4, 0xa8, 0xc8, 0x23, 0x8b, 0xf9, 0xeb, 0xe9, 0x43, 0x46, 0x8c, 0xdb, 0x29, 0x72, 0xb4,
+	0x0d, 0xcb, 0xdc, 0xc4, 0x02, 0xc3, 0x5c, 0xa2, 0xba, 0xf5, 0x4e, 0x9a, 0xf7, 0xbe, 0x4a, 0x64,
+	0xeb, 0x73, 0xe8, 0xb7, 0xda, 0xf7, 0x64, 0xdc, 0xab, 0xd8, 0x1c, 0x48, 0x72, 0xc7, 0xb2, 0x9a,
+	0x3b, 0x7e, 0x57, 0x82, 0x6a, 0x67, 0xa4, 0x1a, 0xeb, 0xb7, 0xe0, 0xc6, 0xf4, 0x9d, 0x10, 0xb3,
+	0x83, 0x1e, 0x04, 0x54, 0x9c, 0xe2, 0xea, 0x65, 0xad, 0x46, 0x71, 0x17, 0xaa, 0xe3, 0x10, 0xbf,
+	0x74, 0x83, 0x49, 0xa4, 0xbf, 0x79, 0xd2, 0xb1, 0x34, 0x47, 0x63, 0x7a, 0xe2, 0x01, 0x3b, 0x5d,
+	0x2b, 0xb6, 0x04, 0xd1, 0x63, 0x5a, 0x4c, 0xa7, 0xc5, 0x1f, 0x16, 0x8e, 0xb5, 0x73, 0x87, 0x6b,
+	0xcc, 0xf5, 0x17, 0x05, 0x22, 0x41, 0x6b, 0x7d, 0x09, 0x6b, 0x9d, 0x51, 0x9e, 0xa7, 0x2a, 0x6e,
+	0x6f, 0xa4, 0xdd, 0xbe, 0x33, 0xca, 0x77, 0xfb, 0x0f, 0x61, 0xcd, 0xc6, 0x11, 0x09, 0xc2, 0xcb,
+	0xf7, 0xf4, 0x1d, 0x58, 0x11, 0x53, 0x94, 0xa8, 0xfc, 0x76, 0x9b, 0x2a, 0x87, 0x50, 0x17, 0x2c,
+	0xd2, 0x0d, 0xfb, 0x1f, 0xe7, 0xd4, 0x56, 0xb5, 0x27, 0x40, 0x29, 0xc1, 0xd4, 0xc0, 0x78, 0xff,
+	0x2e, 0x2c, 0xa9, 0x75, 0x6e, 0xb4, 0x00, 0xe5, 0x9f, 0x76, 0xf7, 0xf7, 0x76, 0x6a, 0x57, 0xd0,
+	0x22, 0xcc, 0x1f, 0x34, 0xed, 0x9f, 0x1d, 0xb6, 0x7b, 0x35, 0xe3, 0xfe, 0x63, 0x58, 0x52, 0xef,
+	0x0e, 0x94, 0xee, 0xab, 0xfd, 0x5e, 0xdb, 0xae, 0x5d, 0x41, 0x4b, 0x50, 0xd9, 0xdb, 0xdf, 0xe3,
+	0x90, 0x41, 0x67, 0x75, 0x7b, 0xcd, 0xa7, 0x9d, 0xbd, 0xa7, 0xb5, 0xd2, 0xfd, 0xbf, 0x33, 0x60,
+	0x25, 0x93, 0x2f, 0x22, 0x04, 0xd5, 0x6e, 0xcf, 0x6e, 0x37, 0x77, 0x8f, 0xb6, 0xed, 0x76, 0xb3,
+	0xd7, 0x6e, 0xd5, 0xae, 0x28, 0xb8, 0x56, 0x7b, 0xa7, 0x4d, 0x71, 0x06, 0xc5, 0xed, 0xb4, 0x9b,
+	0xad, 0xb6, 0x7d, 0xb4, 0xfd, 0xac, 0xb9, 0xf7, 0xb4, 0xdd, 0xaa, 0x95, 0xd0, 0x55, 0x58, 0xec,
+	0x74, 0x13, 0xc4, 0x0c, 0x5a, 0x85, 0xda, 0x41, 0xd3, 0xee, 0x75, 0x7a, 0x9d, 0xfd, 0xbd, 0xa3,
+	0x83, 0xe6, 0x61, 0xb7, 0xdd, 0xaa, 0xcd, 0xa2, 0x4d, 0xb8, 0xd9, 0xdd, 0x7e, 0xd6, 0x6e, 0x1d,
+	0xee, 0xb4, 0x5b, 0x47, 0xfb, 0x07, 0x6d, 0xbb, 0xc9, 0xc6, 0xdb, 0x3f, 0x6f, 0x6f, 0x1f, 0xd2,
+	0xc5, 0xcb, 0x68, 0x0d, 0x56, 0x92, 0x79, 0x92, 0xe7, 0xdc, 0xfd, 0x5f, 0x19, 0x80, 0xb2, 0x09,
+	0x0e, 0x65, 0xfb, 0xec, 0xf9, 0x51, 0xb3, 0xf5, 0x55, 0x73, 0x6f, 0x9b, 0xc9, 0x5b, 0x83, 0xa5,
+	0x9d, 0xf6, 0x7e, 0x82, 0x31, 0xa4, 0x64, 0x87, 0x07, 0x2d, 0xa6, 0x52, 0x89, 0x4a, 0x66, 0xb7,
+	0x9b, 0xad, 0xfd, 0xbd, 0x9d, 0xaf, 0x15, 0x79, 0x11, 0x54, 0xb9, 0x94, 0x31, 0x6e, 0x16, 0x35,
+	0x60, 0x55, 0x28, 0xda, 0x3e, 0xd8, 0xdf, 0x7e, 0x16, 0x8f, 0x94, 0xef, 0x7f, 0x03, 0xd7, 0x72,
+	0x62, 0x08, 0x32, 0xa1, 0xbe, 0x7d, 0x68, 0x77, 0xf7, 0xed, 0xa3, 0xfd, 0x27, 0x4f, 0xba, 0xed,
+	0xde, 0x51, 0xa7, 0xd5, 0xde, 0xeb, 0x75, 0x7a, 0x5f, 0xd7, 0xae, 0xa0, 0x0d, 0x30, 0xf5, 0xb1,
+	0xe6, 0x4e, 0xe7, 0xe9, 0xde, 0xd1, 0xfe, 0x4e, 0xab, 0xdd, 0xed, 0xd5, 0x8c, 0xa2, 0xf1, 0xbd,
+	0xf6, 0x73, 0x3a, 0x5e, 0xba, 0xbf, 0x03, 0x28, 0xfb, 0xa5, 0xa1, 0x2a, 0x80, 0x98, 0xd5, 0x6d,
+	0xf7, 0x6a, 0x57, 0xa8, 0x72, 0x02, 0x3e, 0xdc, 0x93, 0xe2, 0x1a, 0x74, 0x57, 0x04, 0xb6, 0xf9,
+	0xac, 0xdd, 0x6c, 0xd5, 0x4a, 0x5b, 0xff, 0x73, 0x03, 0x2a, 0x4d, 0xfa, 0xa7, 0x90, 0xe6, 0x41,
+	0x07, 0x75, 0xa1, 0xaa, 0xff, 0x7b, 0x02, 0x29, 0x3d, 0x80, 0xdc, 0x3f, 0x80, 0x98, 0x9b, 0xc5,
+	0x04, 0xc2, 0xfd, 0xbf, 0x82, 0xab, 0xa9, 0x27, 0xf6, 0x48, 0x99, 0x94, 0xff, 0x9f, 0x0c, 0xf3,
+	0xf6, 0x14, 0x0a, 0xb1, 0xee, 0x31, 0x5c, 0xcb, 0x79, 0x2a, 0x8e, 0xee, 0x64, 0x6f, 0x42, 0xd9,
+	0x37, 0xef, 0xe6, 0x7b, 0x17, 0x50, 0x09, 0x1e, 0x5f, 0x43, 0x2d, 0xfd, 0xfa, 0x0d, 0x29, 0xa2,
+	0x15, 0xbc, 0xd2, 0x36, 0xad, 0x69, 0x24, 0xc9, 0xb6, 0xa4, 0x5e, 0x40, 0xa9, 0xdb, 0x92, 0xff,
+	0xac, 0xcb, 0xbc, 0x3d, 0x85, 0x22, 0x59, 0x37, 0xf5, 0x72, 0x48, 0x5d, 0x37, 0xff, 0x35, 0x94,
+	0x79, 0x7b, 0x0a, 0x45, 0xb2, 0x6e, 0xea, 0x41, 0x8f, 0xba, 0x6e, 0xfe, 0xeb, 0x20, 0xf3, 0xf6,
+	0x14, 0x0a, 0xb1, 0xee, 0x11, 0xa0, 0xec, 0xc3, 0x1b, 0xf4, 0x6e, 0x32, 0xb1, 0xf0, 0xed, 0x8f,
+	0x79, 0x67, 0x3a, 0x91, 0x60, 0x80, 0x61, 0x35, 0xef, 0x11, 0x0d, 0x7a, 0x2f, 0xb5, 0x97, 0xf9,
+	0x6f, 0x7b, 0xcc, 0xbb, 0x17, 0x91, 0x09, 0x36, 0x3e, 0xac, 0x17, 0x3c, 0x41, 0x41, 0xf7, 0xb2,
+	0x17, 0xce, 0xfc, 0xf7, 0x36, 0xe6, 0x8f, 0x2e, 0x41, 0x99, 0xf0, 0x2b, 0x78, 0x2f, 0xa2, 0xf2,
+	0x9b, 0xfe, 0x6c, 0xc5, 0xfc, 0xd1, 0x25, 0x28, 0x05, 0xbf, 0x6f, 0xa0, 0x51, 0xf4, 0x16, 0x04,
+	0x29, 0xcb, 0x5c, 0xf0, 0xc4, 0xc4, 0xbc, 0x7f, 0x19, 0x52, 0xc1, 0xf2, 0x17, 0xb0, 0x92, 0x79,
+	0x10, 0x82, 0xac, 0x7c, 0xa3, 0xab, 0xef, 0x4e, 0xcc, 0x77, 0xa7, 0xd2, 0x88, 0xd5, 0x0f, 0x60,
+	0x59, 0x7b, 0xf8, 0x81, 0x94, 0x6e, 0x57, 0xde, 0x53, 0x13, 0xf3, 0x56, 0xe1, 0xb8, 0x58, 0x71,
+	0x17, 0x96, 0xe2, 0x81, 0xe6, 0xf6, 0x0e, 0x7a, 0x27, 0x67, 0x42, 0xf2, 0x92, 0xc4, 0xdc, 0x28,
+	0x1a, 0x4e, 0x02, 0x5c, 0xce, 0x33, 0x0d, 0x35, 0xc0, 0x15, 0xbf, 0x18, 0x31, 0xdf, 0xbb, 0x80,
+	0x4a, 0x8d, 0x16, 0xda, 0xb0, 0x1e, 0x2d, 0xf2, 0x9e, 0x81, 0x98, 0xb7, 0xa7, 0x50, 0x88, 0x75,
+	0x7f, 0x0a, 0x8b, 0xca, 0xfb, 0x09, 0xa4, 0x64, 0x89, 0xd9, 0xe7, 0x1e, 0xe6, 0x3b, 0x05, 0xa3,
+	0x62, 0xad, 0x43, 0x79, 0x37, 0xdc, 0x95, 0xfd, 0xf4, 0x4c, 0x67, 0x3a, 0xf5, 0xc2, 0xc2, 0xdc,
+	0x2c, 0x26, 0xe0, 0x8b, 0x3e, 0x32, 0xd0, 0x9f, 0xc0, 0x4a, 0xa6, 0xbd, 0xac, 0x7a, 0x57, 0x51,
+	0x3b, 0xdb, 0x7c, 0x77, 0x2a, 0x4d, 0xbc, 0x7e, 0x17, 0xaa, 0x7a, 0xa3, 0x55, 0x15, 0x3b, 0xb7,
+	0xfb, 0x6b, 0x6e, 0x16, 0x13, 0x88, 0xbd, 0x38, 0x85, 0xb5, 0xdc, 0x6e, 0x1e, 0xba, 0xab, 0x45,
+	0xda, 0xc2, 0x2e, 0xa2, 0xf9, 0xfe, 0x85, 0x74, 0x49, 0x5c, 0xce, 0x36, 0x47, 0xd5, 0xb8, 0x5c,
+	0xd8, 0xee, 0x35, 0xef, 0x4c, 0x27, 0x4a, 0xbe, 0x3f, 0xad, 0xc7, 0xa6, 0x7e, 0x7f, 0x79, 0xcd,
+	0x3e, 0xf3, 0x56, 0xe1, 0x78, 0xea, 0x48, 0x4d, 0x7a, 0x5d, 0x99, 0x23, 0x35, 0xd3, 0x69, 0x33,
+	0x6f, 0x4f, 0xa1, 0x48, 0xb2, 0x80, 0x74, 0x1b, 0x4b, 0xcd, 0x02, 0x0a, 0x7a, 0x68, 0xa6, 0x35,
+	0x8d, 0x24, 0x11, 0x39, 0xd5, 0x8b, 0x52, 0x45, 0xce, 0x6f, 0x85, 0x99, 0xb7, 0xa7, 0x50, 0x24,
+	0x22, 0xa7, 0xdb, 0x4f, 0xaa, 0xc8, 0x05, 0x4d, 0x2e, 0xd3, 0x9a, 0x46, 0x92, 0x44, 0xe5, 0x4c,
+	0x07, 0x4a, 0xfd, 0x6e, 0x8a, 0x5a, 0x5b, 0xe6, 0xbb, 0x53, 0x69, 0x32, 0x41, 0x4f, 0x93, 0x3d,
+	0x1b, 0xf4, 0xf2, 0xc4, 0x7f, 0xef, 0x02, 0xaa, 0xc4, 0xf3, 0xb4, 0x46, 0x92, 0xea, 0x79, 0x79,
+	0x1d, 0x2b, 0xf3, 0x56, 0xe1, 0xb8, 0xea, 0x21, 0x7a, 0xd3, 0x48, 0xf7, 0x90, 0xdc, 0xee, 0x94,
+	0x69, 0x4d, 0x23, 0x49, 0x3c, 0x24, 0xd5, 0xc0, 0x51, 0x3d, 0x24, 0xbf, 0x1d, 0x65, 0xde, 0x9e,
+	0x42, 0x91, 0x7c, 0xdf, 0xd9, 0x4e, 0x8a, 0xfa, 0x7d, 0x17, 0xf6, 0x7a, 0xcc, 0x3b, 0xd3, 0x89,
+	0xe2, 0x23, 0x60, 0x59, 0x6b, 0x79, 0xa8, 0xbb, 0x9c, 0xd7, 0x0b, 0x31, 0xd7, 0x0b, 0x7a, 0x18,
+	0x8f, 0x0c, 0xb4, 0x0b, 0x55, 0xbd, 0x00, 0xaf, 0xc6, 0xd2, 0xdc, 0xd2, 0xbc, 0xd9, 0x28, 0x2a,
+	0x88, 0x3f, 0x32, 0xa8, 0x03, 0x68, 0x85, 0x33, 0x55, 0xb4, 0xbc, 0xc2, 0xb0, 0x79, 0xab, 0x70,
+	0x3c, 0x71, 0xa9, 0xce, 0xa8, 0x60, 0xc5, 0xce, 0x68, 0xfa, 0x8a, 0xf9, 0x95, 0x91, 0x2e, 0x54,
+	0xf5, 0x7a, 0x82, 0xaa, 0x72, 0x6e, 0xfd, 0xc3, 0xdc, 0x2c, 0x26, 0xe0, 0x8b, 0x7e, 0x51, 0xfb,
+	0xcd, 0xf7, 0x1b, 0xc6, 0xbf, 0x7f, 0xbf, 0x61, 0xfc, 0xe7, 0xf7, 0x1b, 0xc6, 0xaf, 0xff, 0x6b,
+	0xe3, 0xca, 0xf1, 0x1c, 0x9b, 0xf2, 0xbb, 0xff, 0x3f, 0x00, 0x62, 0x07, 0x1b, 0x71, 0x2a, 0x40,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminAPIClient is the client API for AdminAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminAPIClient interface {
+	// TransferLeader hands off leadership of a partition to the given broker.
+	// The broker must be a member of the partition's ISR.
+	TransferLeader(ctx context.Context, in *TransferLeaderRequest, opts ...grpc.CallOption) (*TransferLeaderResponse, error)
+	// AllocationStats returns the top partitions on the broker by approximate
+	// bytes allocated along with GC statistics. This is a debugging aid, the
+	// per-partition values are sampled and should be treated as estimates.
+	AllocationStats(ctx context.Context, in *AllocationStatsRequest, opts ...grpc.CallOption) (*AllocationStatsResponse, error)
+	// MetadataMemoryStats returns the entry counts and estimated sizes of the
+	// broker's in-memory metadata structures. This is a debugging aid for
+	// tracking metadata growth in large clusters.
+	MetadataMemoryStats(ctx context.Context, in *MetadataMemoryStatsRequest, opts ...grpc.CallOption) (*MetadataMemoryStatsResponse, error)
+	// FetchBrokerStats returns log, replication, and throughput metrics for
+	// the partitions the broker is a replica of.
+	FetchBrokerStats(ctx context.Context, in *FetchBrokerStatsRequest, opts ...grpc.CallOption) (*FetchBrokerStatsResponse, error)
+	// FetchBrokerRTTs returns the inter-broker round-trip times used for
+	// latency-aware leader placement.
+	FetchBrokerRTTs(ctx context.Context, in *FetchBrokerRTTsRequest, opts ...grpc.CallOption) (*FetchBrokerRTTsResponse, error)
+	// DescribeStreams returns stream metadata including the configuration
+	// and the origin recorded when each stream was created.
+	DescribeStreams(ctx context.Context, in *DescribeStreamsRequest, opts ...grpc.CallOption) (*DescribeStreamsResponse, error)
+	// GetStreamConfig returns the effective configuration of a stream. It's
+	// served from the responding broker's metadata, so any broker can answer.
+	GetStreamConfig(ctx context.Context, in *GetStreamConfigRequest, opts ...grpc.CallOption) (*GetStreamConfigResponse, error)
+	// UpdateStreamConfig changes the retention, segment, or compaction
+	// settings of a stream. The change is replicated through Raft and applied
+	// to the stream's partitions without restarting them.
+	UpdateStreamConfig(ctx context.Context, in *UpdateStreamConfigRequest, opts ...grpc.CallOption) (*UpdateStreamConfigResponse, error)
+	// FetchPartitionStatus returns the lifecycle state of a partition as seen
+	// by the responding broker, or by each of its replicas if requested.
+	FetchPartitionStatus(ctx context.Context, in *FetchPartitionStatusRequest, opts ...grpc.CallOption) (*FetchPartitionStatusResponse, error)
+	// ScheduleStreamOperation schedules a pause, resume, readonly change, or
+	// deletion of a stream to be executed by the metadata leader once its
+	// clock reaches the given time.
+	ScheduleStreamOperation(ctx context.Context, in *ScheduleStreamOperationRequest, opts ...grpc.CallOption) (*ScheduleStreamOperationResponse, error)
+	// ListScheduledOperations returns the scheduled stream operations.
+	ListScheduledOperations(ctx context.Context, in *ListScheduledOperationsRequest, opts ...grpc.CallOption) (*ListScheduledOperationsResponse, error)
+	// CancelScheduledOperation cancels a scheduled stream operation which
+	// hasn't been executed yet.
+	CancelScheduledOperation(ctx context.Context, in *CancelScheduledOperationRequest, opts ...grpc.CallOption) (*CancelScheduledOperationResponse, error)
+	// UpdateStreamOwner reassigns ownership of a stream.
+	UpdateStreamOwner(ctx context.Context, in *UpdateStreamOwnerRequest, opts ...grpc.CallOption) (*UpdateStreamOwnerResponse, error)
+	// SetStreamTags replaces the tags of a stream.
+	SetStreamTags(ctx context.Context, in *SetStreamTagsRequest, opts ...grpc.CallOption) (*SetStreamTagsResponse, error)
+	// SetStreamACL replaces the access control list restricting which
+	// principals can publish to and subscribe to a stream.
+	SetStreamACL(ctx context.Context, in *SetStreamACLRequest, opts ...grpc.CallOption) (*SetStreamACLResponse, error)
+	// DeletePartitionData deletes the messages of a partition before an
+	// offset by advancing its log start offset.
+	DeletePartitionData(ctx context.Context, in *DeletePartitionDataRequest, opts ...grpc.CallOption) (*DeletePartitionDataResponse, error)
+	// DeletePartition deletes a single partition of a stream and its data on
+	// every replica without affecting the stream's other partitions.
+	DeletePartition(ctx context.Context, in *DeletePartitionRequest, opts ...grpc.CallOption) (*DeletePartitionResponse, error)
+	// NackMessage moves a message the consumer failed to process to the
+	// stream's dead letter queue and advances the consumer's cursor past it.
+	NackMessage(ctx context.Context, in *NackMessageRequest, opts ...grpc.CallOption) (*NackMessageResponse, error)
+	// ExportMessages streams the messages of a stream encoded as JSON lines
+	// or Parquet for analytics. Exports are rate limited by the server and
+	// can be resumed with the token returned with each chunk.
+	ExportMessages(ctx context.Context, in *ExportMessagesRequest, opts ...grpc.CallOption) (AdminAPI_ExportMessagesClient, error)
+	// TriggerCompaction cleans a partition's log immediately rather than
+	// waiting for the cleaner interval, applying retention and compaction,
+	// and streams the compaction's progress until it finishes.
+	TriggerCompaction(ctx context.Context, in *TriggerCompactionRequest, opts ...grpc.CallOption) (AdminAPI_TriggerCompactionClient, error)
+	// CleanPartition applies the retention and compaction rules to a
+	// partition's log on its leader, and optionally its followers, immediately
+	// rather than waiting for the cleaner interval and returns a summary of
+	// each clean.
+	CleanPartition(ctx context.Context, in *CleanPartitionRequest, opts ...grpc.CallOption) (*CleanPartitionResponse, error)
+	// GetReassignmentStatus returns the progress of the latest reassignment
+	// of a partition's replicas, such as by replica repair, including the
+	// estimated bytes the new replicas have yet to replicate.
+	GetReassignmentStatus(ctx context.Context, in *GetReassignmentStatusRequest, opts ...grpc.CallOption) (*GetReassignmentStatusResponse, error)
+	// CheckMessageExists reports if a message was written to a partition so
+	// that a client which didn't receive an ack can check before retrying
+	// it. It must be sent to the partition leader.
+	CheckMessageExists(ctx context.Context, in *CheckMessageExistsRequest, opts ...grpc.CallOption) (*CheckMessageExistsResponse, error)
+	// MergeSegments coalesces runs of consecutive small segments of a
+	// partition's log into larger ones, preserving their messages and
+	// offsets, to reduce the number of files the log keeps open.
+	MergeSegments(ctx context.Context, in *MergeSegmentsRequest, opts ...grpc.CallOption) (*MergeSegmentsResponse, error)
+	// FetchStreamSkew reports the partition append rates of streams and
+	// flags streams whose load is concentrated on a hot partition, along
+	// with the keys most frequently published to it.
+	FetchStreamSkew(ctx context.Context, in *FetchStreamSkewRequest, opts ...grpc.CallOption) (*FetchStreamSkewResponse, error)
+	// RegisterProducer registers an exclusive producer on a stream and
+	// returns its fencing token. Publishes carrying an older token for the
+	// producer name are rejected by the partition leaders.
+	RegisterProducer(ctx context.Context, in *RegisterProducerRequest, opts ...grpc.CallOption) (*RegisterProducerResponse, error)
+	// ReleaseProducer releases the registration of an exclusive producer.
+	ReleaseProducer(ctx context.Context, in *ReleaseProducerRequest, opts ...grpc.CallOption) (*ReleaseProducerResponse, error)
+	// SetProducerQuota sets the byte rate quota of a producer on every
+	// broker. Publishes exceeding it are rejected with ResourceExhausted.
+	SetProducerQuota(ctx context.Context, in *SetProducerQuotaRequest, opts ...grpc.CallOption) (*SetProducerQuotaResponse, error)
+	// GetProducerQuotas returns the producer quotas enforced by the broker.
+	GetProducerQuotas(ctx context.Context, in *GetProducerQuotasRequest, opts ...grpc.CallOption) (*GetProducerQuotasResponse, error)
+	// DeleteProducerQuota removes the quota of a producer on every broker.
+	DeleteProducerQuota(ctx context.Context, in *DeleteProducerQuotaRequest, opts ...grpc.CallOption) (*DeleteProducerQuotaResponse, error)
+	// AddRaftServer adds a server to the metadata Raft group as a voter or a
+	// non-voting observer. It must be sent to the metadata leader.
+	AddRaftServer(ctx context.Context, in *AddRaftServerRequest, opts ...grpc.CallOption) (*AddRaftServerResponse, error)
+	// RemoveRaftServer removes a server from the metadata Raft group. It
+	// must be sent to the metadata leader.
+	RemoveRaftServer(ctx context.Context, in *RemoveRaftServerRequest, opts ...grpc.CallOption) (*RemoveRaftServerResponse, error)
+	// ListRaftServers returns the members of the metadata Raft group. It
+	// must be sent to the metadata leader.
+	ListRaftServers(ctx context.Context, in *ListRaftServersRequest, opts ...grpc.CallOption) (*ListRaftServersResponse, error)
+	// FetchSubjectLayout returns the fully resolved NATS subjects used by
+	// the broker for internal communication and the internal streams. This
+	// is a debugging aid for configuring NATS authorization.
+	FetchSubjectLayout(ctx context.Context, in *FetchSubjectLayoutRequest, opts ...grpc.CallOption) (*FetchSubjectLayoutResponse, error)
+	// WatchMetadata streams changes to the cluster metadata, such as streams
+	// being created or partition leaders changing, as the broker applies
+	// them. Watchers which fall behind are disconnected.
+	WatchMetadata(ctx context.Context, in *WatchMetadataRequest, opts ...grpc.CallOption) (AdminAPI_WatchMetadataClient, error)
+	// WatchPartition streams changes to a partition, such as its HW
+	// advancing or its ISR changing, from the partition leader. Changes are
+	// coalesced so watchers receive a limited number of events per second.
+	// The stream ends with FailedPrecondition once the server is no longer
+	// the partition leader.
+	WatchPartition(ctx context.Context, in *WatchPartitionRequest, opts ...grpc.CallOption) (AdminAPI_WatchPartitionClient, error)
+	// ExportCursors returns the consumer cursors matching a filter along with
+	// the state of their streams so they can be imported into another
+	// cluster.
+	ExportCursors(ctx context.Context, in *ExportCursorsRequest, opts ...grpc.CallOption) (*ExportCursorsResponse, error)
+	// ImportCursors sets the consumer cursors of a document returned by
+	// ExportCursors, translating their offsets to the target partitions. It
+	// never moves cursors backwards unless forced, so it can be retried.
+	ImportCursors(ctx context.Context, in *ImportCursorsRequest, opts ...grpc.CallOption) (*ImportCursorsResponse, error)
+	// RestoreStreams recreates streams which were deleted or lost from the
+	// backup sink, restoring each partition to the newest offset backed up.
+	RestoreStreams(ctx context.Context, in *RestoreStreamsRequest, opts ...grpc.CallOption) (*RestoreStreamsResponse, error)
+}
+
+type adminAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminAPIClient(cc *grpc.ClientConn) AdminAPIClient {
+	return &adminAPIClient{cc}
+}
+
+func (c *adminAPIClient) TransferLeader(ctx context.Context, in *TransferLeaderRequest, opts ...grpc.CallOption) (*TransferLeaderResponse, error) {
+	out := new(TransferLeaderResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/TransferLeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) AllocationStats(ctx context.Context, in *AllocationStatsRequest, opts ...grpc.CallOption) (*AllocationStatsResponse, error) {
+	out := new(AllocationStatsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/AllocationStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) MetadataMemoryStats(ctx context.Context, in *MetadataMemoryStatsRequest, opts ...grpc.CallOption) (*MetadataMemoryStatsResponse, error) {
+	out := new(MetadataMemoryStatsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/MetadataMemoryStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) FetchBrokerStats(ctx context.Context, in *FetchBrokerStatsRequest, opts ...grpc.CallOption) (*FetchBrokerStatsResponse, error) {
+	out := new(FetchBrokerStatsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/FetchBrokerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) FetchBrokerRTTs(ctx context.Context, in *FetchBrokerRTTsRequest, opts ...grpc.CallOption) (*FetchBrokerRTTsResponse, error) {
+	out := new(FetchBrokerRTTsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/FetchBrokerRTTs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) DescribeStreams(ctx context.Context, in *DescribeStreamsRequest, opts ...grpc.CallOption) (*DescribeStreamsResponse, error) {
+	out := new(DescribeStreamsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/DescribeStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) GetStreamConfig(ctx context.Context, in *GetStreamConfigRequest, opts ...grpc.CallOption) (*GetStreamConfigResponse, error) {
+	out := new(GetStreamConfigResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/GetStreamConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) UpdateStreamConfig(ctx context.Context, in *UpdateStreamConfigRequest, opts ...grpc.CallOption) (*UpdateStreamConfigResponse, error) {
+	out := new(UpdateStreamConfigResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/UpdateStreamConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) FetchPartitionStatus(ctx context.Context, in *FetchPartitionStatusRequest, opts ...grpc.CallOption) (*FetchPartitionStatusResponse, error) {
+	out := new(FetchPartitionStatusResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/FetchPartitionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) ScheduleStreamOperation(ctx context.Context, in *ScheduleStreamOperationRequest, opts ...grpc.CallOption) (*ScheduleStreamOperationResponse, error) {
+	out := new(ScheduleStreamOperationResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/ScheduleStreamOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) ListScheduledOperations(ctx context.Context, in *ListScheduledOperationsRequest, opts ...grpc.CallOption) (*ListScheduledOperationsResponse, error) {
+	out := new(ListScheduledOperationsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/ListScheduledOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) CancelScheduledOperation(ctx context.Context, in *CancelScheduledOperationRequest, opts ...grpc.CallOption) (*CancelScheduledOperationResponse, error) {
+	out := new(CancelScheduledOperationResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/CancelScheduledOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) UpdateStreamOwner(ctx context.Context, in *UpdateStreamOwnerRequest, opts ...grpc.CallOption) (*UpdateStreamOwnerResponse, error) {
+	out := new(UpdateStreamOwnerResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/UpdateStreamOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) SetStreamTags(ctx context.Context, in *SetStreamTagsRequest, opts ...grpc.CallOption) (*SetStreamTagsResponse, error) {
+	out := new(SetStreamTagsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/SetStreamTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) SetStreamACL(ctx context.Context, in *SetStreamACLRequest, opts ...grpc.CallOption) (*SetStreamACLResponse, error) {
+	out := new(SetStreamACLResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/SetStreamACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) DeletePartitionData(ctx context.Context, in *DeletePartitionDataRequest, opts ...grpc.CallOption) (*DeletePartitionDataResponse, error) {
+	out := new(DeletePartitionDataResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/DeletePartitionData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) DeletePartition(ctx context.Context, in *DeletePartitionRequest, opts ...grpc.CallOption) (*DeletePartitionResponse, error) {
+	out := new(DeletePartitionResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/DeletePartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) NackMessage(ctx context.Context, in *NackMessageRequest, opts ...grpc.CallOption) (*NackMessageResponse, error) {
+	out := new(NackMessageResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/NackMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) ExportMessages(ctx context.Context, in *ExportMessagesRequest, opts ...grpc.CallOption) (AdminAPI_ExportMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminAPI_serviceDesc.Streams[0], "/protocol.AdminAPI/ExportMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminAPIExportMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminAPI_ExportMessagesClient interface {
+	Recv() (*ExportMessagesResponse, error)
+	grpc.ClientStream
+}
+
+type adminAPIExportMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminAPIExportMessagesClient) Recv() (*ExportMessagesResponse, error) {
+	m := new(ExportMessagesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminAPIClient) TriggerCompaction(ctx context.Context, in *TriggerCompactionRequest, opts ...grpc.CallOption) (AdminAPI_TriggerCompactionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminAPI_serviceDesc.Streams[1], "/protocol.AdminAPI/TriggerCompaction", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminAPITriggerCompactionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminAPI_TriggerCompactionClient interface {
+	Recv() (*TriggerCompactionResponse, error)
+	grpc.ClientStream
+}
+
+type adminAPITriggerCompactionClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminAPITriggerCompactionClient) Recv() (*TriggerCompactionResponse, error) {
+	m := new(TriggerCompactionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminAPIClient) CleanPartition(ctx context.Context, in *CleanPartitionRequest, opts ...grpc.CallOption) (*CleanPartitionResponse, error) {
+	out := new(CleanPartitionResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/CleanPartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) GetReassignmentStatus(ctx context.Context, in *GetReassignmentStatusRequest, opts ...grpc.CallOption) (*GetReassignmentStatusResponse, error) {
+	out := new(GetReassignmentStatusResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/GetReassignmentStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) CheckMessageExists(ctx context.Context, in *CheckMessageExistsRequest, opts ...grpc.CallOption) (*CheckMessageExistsResponse, error) {
+	out := new(CheckMessageExistsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/CheckMessageExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) MergeSegments(ctx context.Context, in *MergeSegmentsRequest, opts ...grpc.CallOption) (*MergeSegmentsResponse, error) {
+	out := new(MergeSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/MergeSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) FetchStreamSkew(ctx context.Context, in *FetchStreamSkewRequest, opts ...grpc.CallOption) (*FetchStreamSkewResponse, error) {
+	out := new(FetchStreamSkewResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/FetchStreamSkew", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) RegisterProducer(ctx context.Context, in *RegisterProducerRequest, opts ...grpc.CallOption) (*RegisterProducerResponse, error) {
+	out := new(RegisterProducerResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/RegisterProducer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) ReleaseProducer(ctx context.Context, in *ReleaseProducerRequest, opts ...grpc.CallOption) (*ReleaseProducerResponse, error) {
+	out := new(ReleaseProducerResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/ReleaseProducer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) SetProducerQuota(ctx context.Context, in *SetProducerQuotaRequest, opts ...grpc.CallOption) (*SetProducerQuotaResponse, error) {
+	out := new(SetProducerQuotaResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/SetProducerQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) GetProducerQuotas(ctx context.Context, in *GetProducerQuotasRequest, opts ...grpc.CallOption) (*GetProducerQuotasResponse, error) {
+	out := new(GetProducerQuotasResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/GetProducerQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) DeleteProducerQuota(ctx context.Context, in *DeleteProducerQuotaRequest, opts ...grpc.CallOption) (*DeleteProducerQuotaResponse, error) {
+	out := new(DeleteProducerQuotaResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/DeleteProducerQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) AddRaftServer(ctx context.Context, in *AddRaftServerRequest, opts ...grpc.CallOption) (*AddRaftServerResponse, error) {
+	out := new(AddRaftServerResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/AddRaftServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) RemoveRaftServer(ctx context.Context, in *RemoveRaftServerRequest, opts ...grpc.CallOption) (*RemoveRaftServerResponse, error) {
+	out := new(RemoveRaftServerResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/RemoveRaftServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) ListRaftServers(ctx context.Context, in *ListRaftServersRequest, opts ...grpc.CallOption) (*ListRaftServersResponse, error) {
+	out := new(ListRaftServersResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/ListRaftServers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) FetchSubjectLayout(ctx context.Context, in *FetchSubjectLayoutRequest, opts ...grpc.CallOption) (*FetchSubjectLayoutResponse, error) {
+	out := new(FetchSubjectLayoutResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/FetchSubjectLayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) WatchMetadata(ctx context.Context, in *WatchMetadataRequest, opts ...grpc.CallOption) (AdminAPI_WatchMetadataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminAPI_serviceDesc.Streams[2], "/protocol.AdminAPI/WatchMetadata", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminAPIWatchMetadataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminAPI_WatchMetadataClient interface {
+	Recv() (*MetadataEvent, error)
+	grpc.ClientStream
+}
+
+type adminAPIWatchMetadataClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminAPIWatchMetadataClient) Recv() (*MetadataEvent, error) {
+	m := new(MetadataEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminAPIClient) WatchPartition(ctx context.Context, in *WatchPartitionRequest, opts ...grpc.CallOption) (AdminAPI_WatchPartitionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminAPI_serviceDesc.Streams[3], "/protocol.AdminAPI/WatchPartition", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminAPIWatchPartitionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminAPI_WatchPartitionClient interface {
+	Recv() (*PartitionEvent, error)
+	grpc.ClientStream
+}
+
+type adminAPIWatchPartitionClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminAPIWatchPartitionClient) Recv() (*PartitionEvent, error) {
+	m := new(PartitionEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminAPIClient) ExportCursors(ctx context.Context, in *ExportCursorsRequest, opts ...grpc.CallOption) (*ExportCursorsResponse, error) {
+	out := new(ExportCursorsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/ExportCursors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) ImportCursors(ctx context.Context, in *ImportCursorsRequest, opts ...grpc.CallOption) (*ImportCursorsResponse, error) {
+	out := new(ImportCursorsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/ImportCursors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminAPIClient) RestoreStreams(ctx context.Context, in *RestoreStreamsRequest, opts ...grpc.CallOption) (*RestoreStreamsResponse, error) {
+	out := new(RestoreStreamsResponse)
+	err := c.cc.Invoke(ctx, "/protocol.AdminAPI/RestoreStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminAPIServer is the server API for AdminAPI service.
+type AdminAPIServer interface {
+	// TransferLeader hands off leadership of a partition to the given broker.
+	// The broker must be a member of the partition's ISR.
+	TransferLeader(context.Context, *TransferLeaderRequest) (*TransferLeaderResponse, error)
+	// AllocationStats returns the top partitions on the broker by approximate
+	// bytes allocated along with GC statistics. This is a debugging aid, the
+	// per-partition values are sampled and should be treated as estimates.
+	AllocationStats(context.Context, *AllocationStatsRequest) (*AllocationStatsResponse, error)
+	// MetadataMemoryStats returns the entry counts and estimated sizes of the
+	// broker's in-memory metadata structures. This is a debugging aid for
+	// tracking metadata growth in large clusters.
+	MetadataMemoryStats(context.Context, *MetadataMemoryStatsRequest) (*MetadataMemoryStatsResponse, error)
+	// FetchBrokerStats returns log, replication, and throughput metrics for
+	// the partitions the broker is a replica of.
+	FetchBrokerStats(context.Context, *FetchBrokerStatsRequest) (*FetchBrokerStatsResponse, error)
+	// FetchBrokerRTTs returns the inter-broker round-trip times used for
+	// latency-aware leader placement.
+	FetchBrokerRTTs(context.Context, *FetchBrokerRTTsRequest) (*FetchBrokerRTTsResponse, error)
+	// DescribeStreams returns stream metadata including the configuration
+	// and the origin recorded when each stream was created.
+	DescribeStreams(context.Context, *DescribeStreamsRequest) (*DescribeStreamsResponse, error)
+	// GetStreamConfig returns the effective configuration of a stream. It's
+	// served from the responding broker's metadata, so any broker can answer.
+	GetStreamConfig(context.Context, *GetStreamConfigRequest) (*GetStreamConfigResponse, error)
+	// UpdateStreamConfig changes the retention, segment, or compaction
+	// settings of a stream. The change is replicated through Raft and applied
+	// to the stream's partitions without restarting them.
+	UpdateStreamConfig(context.Context, *UpdateStreamConfigRequest) (*UpdateStreamConfigResponse, error)
+	// FetchPartitionStatus returns the lifecycle state of a partition as seen
+	// by the responding broker, or by each of its replicas if requested.
+	FetchPartitionStatus(context.Context, *FetchPartitionStatusRequest) (*FetchPartitionStatusResponse, error)
+	// ScheduleStreamOperation schedules a pause, resume, readonly change, or
+	// deletion of a stream to be executed by the metadata leader once its
+	// clock reaches the given time.
+	ScheduleStreamOperation(context.Context, *ScheduleStreamOperationRequest) (*ScheduleStreamOperationResponse, error)
+	// ListScheduledOperations returns the scheduled stream operations.
+	ListScheduledOperations(context.Context, *ListScheduledOperationsRequest) (*ListScheduledOperationsResponse, error)
+	// CancelScheduledOperation cancels a scheduled stream operation which
+	// hasn't been executed yet.
+	CancelScheduledOperation(context.Context, *CancelScheduledOperationRequest) (*CancelScheduledOperationResponse, error)
+	// UpdateStreamOwner reassigns ownership of a stream.
+	UpdateStreamOwner(context.Context, *UpdateStreamOwnerRequest) (*UpdateStreamOwnerResponse, error)
+	// SetStreamTags replaces the tags of a stream.
+	SetStreamTags(context.Context, *SetStreamTagsRequest) (*SetStreamTagsResponse, error)
+	// SetStreamACL replaces the access control list restricting which
+	// principals can publish to and subscribe to a stream.
+	SetStreamACL(context.Context, *SetStreamACLRequest) (*SetStreamACLResponse, error)
+	// DeletePartitionData deletes the messages of a partition before an
+	// offset by advancing its log start offset.
+	DeletePartitionData(context.Context, *DeletePartitionDataRequest) (*DeletePartitionDataResponse, error)
+	// DeletePartition deletes a single partition of a stream and its data on
+	// every replica without affecting the stream's other partitions.
+	DeletePartition(context.Context, *DeletePartitionRequest) (*DeletePartitionResponse, error)
+	// NackMessage moves a message the consumer failed to process to the
+	// stream's dead letter queue and advances the consumer's cursor past it.
+	NackMessage(context.Context, *NackMessageRequest) (*NackMessageResponse, error)
+	// ExportMessages streams the messages of a stream encoded as JSON lines
+	// or Parquet for analytics. Exports are rate limited by the server and
+	// can be resumed with the token returned with each chunk.
+	ExportMessages(*ExportMessagesRequest, AdminAPI_ExportMessagesServer) error
+	// TriggerCompaction cleans a partition's log immediately rather than
+	// waiting for the cleaner interval, applying retention and compaction,
+	// and streams the compaction's progress until it finishes.
+	TriggerCompaction(*TriggerCompactionRequest, AdminAPI_TriggerCompactionServer) error
+	// CleanPartition applies the retention and compaction rules to a
+	// partition's log on its leader, and optionally its followers, immediately
+	// rather than waiting for the cleaner interval and returns a summary of
+	// each clean.
+	CleanPartition(context.Context, *CleanPartitionRequest) (*CleanPartitionResponse, error)
+	// GetReassignmentStatus returns the progress of the latest reassignment
+	// of a partition's replicas, such as by replica repair, including the
+	// estimated bytes the new replicas have yet to replicate.
+	GetReassignmentStatus(context.Context, *GetReassignmentStatusRequest) (*GetReassignmentStatusResponse, error)
+	// CheckMessageExists reports if a message was written to a partition so
+	// that a client which didn't receive an ack can check before retrying
+	// it. It must be sent to the partition leader.
+	CheckMessageExists(context.Context, *CheckMessageExistsRequest) (*CheckMessageExistsResponse, error)
+	// MergeSegments coalesces runs of consecutive small segments of a
+	// partition's log into larger ones, preserving their messages and
+	// offsets, to reduce the number of files the log keeps open.
+	MergeSegments(context.Context, *MergeSegmentsRequest) (*MergeSegmentsResponse, error)
+	// FetchStreamSkew reports the partition append rates of streams and
+	// flags streams whose load is concentrated on a hot partition, along
+	// with the keys most frequently published to it.
+	FetchStreamSkew(context.Context, *FetchStreamSkewRequest) (*FetchStreamSkewResponse, error)
+	// RegisterProducer registers an exclusive producer on a stream and
+	// returns its fencing token. Publishes carrying an older token for the
+	// producer name are rejected by the partition leaders.
+	RegisterProducer(context.Context, *RegisterProducerRequest) (*RegisterProducerResponse, error)
+	// ReleaseProducer releases the registration of an exclusive producer.
+	ReleaseProducer(context.Context, *ReleaseProducerRequest) (*ReleaseProducerResponse, error)
+	// SetProducerQuota sets the byte rate quota of a producer on every
+	// broker. Publishes exceeding it are rejected with ResourceExhausted.
+	SetProducerQuota(context.Context, *SetProducerQuotaRequest) (*SetProducerQuotaResponse, error)
+	// GetProducerQuotas returns the producer quotas enforced by the broker.
+	GetProducerQuotas(context.Context, *GetProducerQuotasRequest) (*GetProducerQuotasResponse, error)
+	// DeleteProducerQuota removes the quota of a producer on every broker.
+	DeleteProducerQuota(context.Context, *DeleteProducerQuotaRequest) (*DeleteProducerQuotaResponse, error)
+	// AddRaftServer adds a server to the metadata Raft group as a voter or a
+	// non-voting observer. It must be sent to the metadata leader.
+	AddRaftServer(context.Context, *AddRaftServerRequest) (*AddRaftServerResponse, error)
+	// RemoveRaftServer removes a server from the metadata Raft group. It
+	// must be sent to the metadata leader.
+	RemoveRaftServer(context.Context, *RemoveRaftServerRequest) (*RemoveRaftServerResponse, error)
+	// ListRaftServers returns the members of the metadata Raft group. It
+	// must be sent to the metadata leader.
+	ListRaftServers(context.Context, *ListRaftServersRequest) (*ListRaftServersResponse, error)
+	// FetchSubjectLayout returns the fully resolved NATS subjects used by
+	// the broker for internal communication and the internal streams. This
+	// is a debugging aid for configuring NATS authorization.
+	FetchSubjectLayout(context.Context, *FetchSubjectLayoutRequest) (*FetchSubjectLayoutResponse, error)
+	// WatchMetadata streams changes to the cluster metadata, such as streams
+	// being created or partition leaders changing, as the broker applies
+	// them. Watchers which fall behind are disconnected.
+	WatchMetadata(*WatchMetadataRequest, AdminAPI_WatchMetadataServer) error
+	// WatchPartition streams changes to a partition, such as its HW
+	// advancing or its ISR changing, from the partition leader. Changes are
+	// coalesced so watchers receive a limited number of events per second.
+	// The stream ends with FailedPrecondition once the server is no longer
+	// the partition leader.
+	WatchPartition(*WatchPartitionRequest, AdminAPI_WatchPartitionServer) error
+	// ExportCursors returns the consumer cursors matching a filter along with
+	// the state of their streams so they can be imported into another
+	// cluster.
+	ExportCursors(context.Context, *ExportCursorsRequest) (*ExportCursorsResponse, error)
+	// ImportCursors sets the consumer cursors of a document returned by
+	// ExportCursors, translating their offsets to the target partitions. It
+	// never moves cursors backwards unless forced, so it can be retried.
+	ImportCursors(context.Context, *ImportCursorsRequest) (*ImportCursorsResponse, error)
+	// RestoreStreams recreates streams which were deleted or lost from the
+	// backup sink, restoring each partition to the newest offset backed up.
+	RestoreStreams(context.Context, *RestoreStreamsRequest) (*RestoreStreamsResponse, error)
+}
+
+// UnimplementedAdminAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminAPIServer struct {
+}
+
+func (*UnimplementedAdminAPIServer) TransferLeader(ctx context.Context, req *TransferLeaderRequest) (*TransferLeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLeader not implemented")
+}
+func (*UnimplementedAdminAPIServer) AllocationStats(ctx context.Context, req *AllocationStatsRequest) (*AllocationStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocationStats not implemented")
+}
+func (*UnimplementedAdminAPIServer) MetadataMemoryStats(ctx context.Context, req *MetadataMemoryStatsRequest) (*MetadataMemoryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetadataMemoryStats not implemented")
+}
+func (*UnimplementedAdminAPIServer) FetchBrokerStats(ctx context.Context, req *FetchBrokerStatsRequest) (*FetchBrokerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchBrokerStats not implemented")
+}
+func (*UnimplementedAdminAPIServer) FetchBrokerRTTs(ctx context.Context, req *FetchBrokerRTTsRequest) (*FetchBrokerRTTsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchBrokerRTTs not implemented")
+}
+func (*UnimplementedAdminAPIServer) DescribeStreams(ctx context.Context, req *DescribeStreamsRequest) (*DescribeStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeStreams not implemented")
+}
+func (*UnimplementedAdminAPIServer) GetStreamConfig(ctx context.Context, req *GetStreamConfigRequest) (*GetStreamConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStreamConfig not implemented")
+}
+func (*UnimplementedAdminAPIServer) UpdateStreamConfig(ctx context.Context, req *UpdateStreamConfigRequest) (*UpdateStreamConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStreamConfig not implemented")
+}
+func (*UnimplementedAdminAPIServer) FetchPartitionStatus(ctx context.Context, req *FetchPartitionStatusRequest) (*FetchPartitionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchPartitionStatus not implemented")
+}
+func (*UnimplementedAdminAPIServer) ScheduleStreamOperation(ctx context.Context, req *ScheduleStreamOperationRequest) (*ScheduleStreamOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleStreamOperation not implemented")
+}
+func (*UnimplementedAdminAPIServer) ListScheduledOperations(ctx context.Context, req *ListScheduledOperationsRequest) (*ListScheduledOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledOperations not implemented")
+}
+func (*UnimplementedAdminAPIServer) CancelScheduledOperation(ctx context.Context, req *CancelScheduledOperationRequest) (*CancelScheduledOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledOperation not implemented")
+}
+func (*UnimplementedAdminAPIServer) UpdateStreamOwner(ctx context.Context, req *UpdateStreamOwnerRequest) (*UpdateStreamOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStreamOwner not implemented")
+}
+func (*UnimplementedAdminAPIServer) SetStreamTags(ctx context.Context, req *SetStreamTagsRequest) (*SetStreamTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStreamTags not implemented")
+}
+func (*UnimplementedAdminAPIServer) SetStreamACL(ctx context.Context, req *SetStreamACLRequest) (*SetStreamACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStreamACL not implemented")
+}
+func (*UnimplementedAdminAPIServer) DeletePartitionData(ctx context.Context, req *DeletePartitionDataRequest) (*DeletePartitionDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePartitionData not implemented")
+}
+func (*UnimplementedAdminAPIServer) DeletePartition(ctx context.Context, req *DeletePartitionRequest) (*DeletePartitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePartition not implemented")
+}
+func (*UnimplementedAdminAPIServer) NackMessage(ctx context.Context, req *NackMessageRequest) (*NackMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NackMessage not implemented")
+}
+func (*UnimplementedAdminAPIServer) ExportMessages(req *ExportMessagesRequest, srv AdminAPI_ExportMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMessages not implemented")
+}
+func (*UnimplementedAdminAPIServer) TriggerCompaction(req *TriggerCompactionRequest, srv AdminAPI_TriggerCompactionServer) error {
+	return status.Errorf(codes.Unimplemented, "method TriggerCompaction not implemented")
+}
+func (*UnimplementedAdminAPIServer) CleanPartition(ctx context.Context, req *CleanPartitionRequest) (*CleanPartitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanPartition not implemented")
+}
+func (*UnimplementedAdminAPIServer) GetReassignmentStatus(ctx context.Context, req *GetReassignmentStatusRequest) (*GetReassignmentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReassignmentStatus not implemented")
+}
+func (*UnimplementedAdminAPIServer) CheckMessageExists(ctx context.Context, req *CheckMessageExistsRequest) (*CheckMessageExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckMessageExists not implemented")
+}
+func (*UnimplementedAdminAPIServer) MergeSegments(ctx context.Context, req *MergeSegmentsRequest) (*MergeSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeSegments not implemented")
+}
+func (*UnimplementedAdminAPIServer) FetchStreamSkew(ctx context.Context, req *FetchStreamSkewRequest) (*FetchStreamSkewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchStreamSkew not implemented")
+}
+func (*UnimplementedAdminAPIServer) RegisterProducer(ctx context.Context, req *RegisterProducerRequest) (*RegisterProducerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterProducer not implemented")
+}
+func (*UnimplementedAdminAPIServer) ReleaseProducer(ctx context.Context, req *ReleaseProducerRequest) (*ReleaseProducerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseProducer not implemented")
+}
+func (*UnimplementedAdminAPIServer) SetProducerQuota(ctx context.Context, req *SetProducerQuotaRequest) (*SetProducerQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProducerQuota not implemented")
+}
+func (*UnimplementedAdminAPIServer) GetProducerQuotas(ctx context.Context, req *GetProducerQuotasRequest) (*GetProducerQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProducerQuotas not implemented")
+}
+func (*UnimplementedAdminAPIServer) DeleteProducerQuota(ctx context.Context, req *DeleteProducerQuotaRequest) (*DeleteProducerQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProducerQuota not implemented")
+}
+func (*UnimplementedAdminAPIServer) AddRaftServer(ctx context.Context, req *AddRaftServerRequest) (*AddRaftServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRaftServer not implemented")
+}
+func (*UnimplementedAdminAPIServer) RemoveRaftServer(ctx context.Context, req *RemoveRaftServerRequest) (*RemoveRaftServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRaftServer not implemented")
+}
+func (*UnimplementedAdminAPIServer) ListRaftServers(ctx context.Context, req *ListRaftServersRequest) (*ListRaftServersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRaftServers not implemented")
+}
+func (*UnimplementedAdminAPIServer) FetchSubjectLayout(ctx context.Context, req *FetchSubjectLayoutRequest) (*FetchSubjectLayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchSubjectLayout not implemented")
+}
+func (*UnimplementedAdminAPIServer) WatchMetadata(req *WatchMetadataRequest, srv AdminAPI_WatchMetadataServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMetadata not implemented")
+}
+func (*UnimplementedAdminAPIServer) WatchPartition(req *WatchPartitionRequest, srv AdminAPI_WatchPartitionServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPartition not implemented")
+}
+func (*UnimplementedAdminAPIServer) ExportCursors(ctx context.Context, req *ExportCursorsRequest) (*ExportCursorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCursors not implemented")
+}
+func (*UnimplementedAdminAPIServer) ImportCursors(ctx context.Context, req *ImportCursorsRequest) (*ImportCursorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCursors not implemented")
+}
+func (*UnimplementedAdminAPIServer) RestoreStreams(ctx context.Context, req *RestoreStreamsRequest) (*RestoreStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreStreams not implemented")
+}
+
+func RegisterAdminAPIServer(s *grpc.Server, srv AdminAPIServer) {
+	s.RegisterService(&_AdminAPI_serviceDesc, srv)
+}
+
+func _AdminAPI_TransferLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferLeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).TransferLeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/TransferLeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).TransferLeader(ctx, req.(*TransferLeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_AllocationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocationStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).AllocationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/AllocationStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).AllocationStats(ctx, req.(*AllocationStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_MetadataMemoryStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetadataMemoryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).MetadataMemoryStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/MetadataMemoryStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).MetadataMemoryStats(ctx, req.(*MetadataMemoryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_FetchBrokerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBrokerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).FetchBrokerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/FetchBrokerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).FetchBrokerStats(ctx, req.(*FetchBrokerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_FetchBrokerRTTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBrokerRTTsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).FetchBrokerRTTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/FetchBrokerRTTs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).FetchBrokerRTTs(ctx, req.(*FetchBrokerRTTsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_DescribeStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).DescribeStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/DescribeStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).DescribeStreams(ctx, req.(*DescribeStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_GetStreamConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStreamConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).GetStreamConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/GetStreamConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).GetStreamConfig(ctx, req.(*GetStreamConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_UpdateStreamConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStreamConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).UpdateStreamConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/UpdateStreamConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).UpdateStreamConfig(ctx, req.(*UpdateStreamConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_FetchPartitionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchPartitionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).FetchPartitionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/FetchPartitionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).FetchPartitionStatus(ctx, req.(*FetchPartitionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_ScheduleStreamOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleStreamOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).ScheduleStreamOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/ScheduleStreamOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).ScheduleStreamOperation(ctx, req.(*ScheduleStreamOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_ListScheduledOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).ListScheduledOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/ListScheduledOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).ListScheduledOperations(ctx, req.(*ListScheduledOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_CancelScheduledOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).CancelScheduledOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/CancelScheduledOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).CancelScheduledOperation(ctx, req.(*CancelScheduledOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_UpdateStreamOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStreamOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).UpdateStreamOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/UpdateStreamOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).UpdateStreamOwner(ctx, req.(*UpdateStreamOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_SetStreamTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStreamTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).SetStreamTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/SetStreamTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).SetStreamTags(ctx, req.(*SetStreamTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_SetStreamACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStreamACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).SetStreamACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/SetStreamACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).SetStreamACL(ctx, req.(*SetStreamACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_DeletePartitionData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePartitionDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).DeletePartitionData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/DeletePartitionData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).DeletePartitionData(ctx, req.(*DeletePartitionDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_DeletePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).DeletePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/DeletePartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).DeletePartition(ctx, req.(*DeletePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_NackMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NackMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).NackMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/NackMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).NackMessage(ctx, req.(*NackMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_ExportMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminAPIServer).ExportMessages(m, &adminAPIExportMessagesServer{stream})
+}
+
+type AdminAPI_ExportMessagesServer interface {
+	Send(*ExportMessagesResponse) error
+	grpc.ServerStream
+}
+
+type adminAPIExportMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminAPIExportMessagesServer) Send(m *ExportMessagesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminAPI_TriggerCompaction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TriggerCompactionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminAPIServer).TriggerCompaction(m, &adminAPITriggerCompactionServer{stream})
+}
+
+type AdminAPI_TriggerCompactionServer interface {
+	Send(*TriggerCompactionResponse) error
+	grpc.ServerStream
+}
+
+type adminAPITriggerCompactionServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminAPITriggerCompactionServer) Send(m *TriggerCompactionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminAPI_CleanPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).CleanPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/CleanPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).CleanPartition(ctx, req.(*CleanPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_GetReassignmentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReassignmentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).GetReassignmentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/GetReassignmentStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).GetReassignmentStatus(ctx, req.(*GetReassignmentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_CheckMessageExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckMessageExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).CheckMessageExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/CheckMessageExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).CheckMessageExists(ctx, req.(*CheckMessageExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_MergeSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).MergeSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/MergeSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).MergeSegments(ctx, req.(*MergeSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_FetchStreamSkew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchStreamSkewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).FetchStreamSkew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/FetchStreamSkew",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).FetchStreamSkew(ctx, req.(*FetchStreamSkewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_RegisterProducer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterProducerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).RegisterProducer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/RegisterProducer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).RegisterProducer(ctx, req.(*RegisterProducerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_ReleaseProducer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseProducerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).ReleaseProducer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/ReleaseProducer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).ReleaseProducer(ctx, req.(*ReleaseProducerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_SetProducerQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProducerQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).SetProducerQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/SetProducerQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).SetProducerQuota(ctx, req.(*SetProducerQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_GetProducerQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProducerQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).GetProducerQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/GetProducerQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).GetProducerQuotas(ctx, req.(*GetProducerQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_DeleteProducerQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProducerQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).DeleteProducerQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/DeleteProducerQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).DeleteProducerQuota(ctx, req.(*DeleteProducerQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_AddRaftServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRaftServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).AddRaftServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/AddRaftServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).AddRaftServer(ctx, req.(*AddRaftServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_RemoveRaftServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRaftServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).RemoveRaftServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/RemoveRaftServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).RemoveRaftServer(ctx, req.(*RemoveRaftServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_ListRaftServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRaftServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).ListRaftServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/ListRaftServers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).ListRaftServers(ctx, req.(*ListRaftServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_FetchSubjectLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchSubjectLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).FetchSubjectLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/FetchSubjectLayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).FetchSubjectLayout(ctx, req.(*FetchSubjectLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_WatchMetadata_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminAPIServer).WatchMetadata(m, &adminAPIWatchMetadataServer{stream})
+}
+
+type AdminAPI_WatchMetadataServer interface {
+	Send(*MetadataEvent) error
+	grpc.ServerStream
+}
+
+type adminAPIWatchMetadataServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminAPIWatchMetadataServer) Send(m *MetadataEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminAPI_WatchPartition_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPartitionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminAPIServer).WatchPartition(m, &adminAPIWatchPartitionServer{stream})
+}
+
+type AdminAPI_WatchPartitionServer interface {
+	Send(*PartitionEvent) error
+	grpc.ServerStream
+}
+
+type adminAPIWatchPartitionServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminAPIWatchPartitionServer) Send(m *PartitionEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminAPI_ExportCursors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCursorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).ExportCursors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/ExportCursors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).ExportCursors(ctx, req.(*ExportCursorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_ImportCursors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCursorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).ImportCursors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/ImportCursors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).ImportCursors(ctx, req.(*ImportCursorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminAPI_RestoreStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminAPIServer).RestoreStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protocol.AdminAPI/RestoreStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminAPIServer).RestoreStreams(ctx, req.(*RestoreStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AdminAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "protocol.AdminAPI",
+	HandlerType: (*AdminAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TransferLeader",
+			Handler:    _AdminAPI_TransferLeader_Handler,
+		},
+		{
+			MethodName: "AllocationStats",
+			Handler:    _AdminAPI_AllocationStats_Handler,
+		},
+		{
+			MethodName: "MetadataMemoryStats",
+			Handler:    _AdminAPI_MetadataMemoryStats_Handler,
+		},
+		{
+			MethodName: "FetchBrokerStats",
+			Handler:    _AdminAPI_FetchBrokerStats_Handler,
+		},
+		{
+			MethodName: "FetchBrokerRTTs",
+			Handler:    _AdminAPI_FetchBrokerRTTs_Handler,
+		},
+		{
+			MethodName: "DescribeStreams",
+			Handler:    _AdminAPI_DescribeStreams_Handler,
+		},
+		{
+			MethodName: "GetStreamConfig",
+			Handler:    _AdminAPI_GetStreamConfig_Handler,
+		},
+		{
+			MethodName: "UpdateStreamConfig",
+			Handler:    _AdminAPI_UpdateStreamConfig_Handler,
+		},
+		{
+			MethodName: "FetchPartitionStatus",
+			Handler:    _AdminAPI_FetchPartitionStatus_Handler,
+		},
+		{
+			MethodName: "ScheduleStreamOperation",
+			Handler:    _AdminAPI_ScheduleStreamOperation_Handler,
+		},
+		{
+			MethodName: "ListScheduledOperations",
+			Handler:    _AdminAPI_ListScheduledOperations_Handler,
+		},
+		{
+			MethodName: "CancelScheduledOperation",
+			Handler:    _AdminAPI_CancelScheduledOperation_Handler,
+		},
+		{
+			MethodName: "UpdateStreamOwner",
+			Handler:    _AdminAPI_UpdateStreamOwner_Handler,
+		},
+		{
+			MethodName: "SetStreamTags",
+			Handler:    _AdminAPI_SetStreamTags_Handler,
+		},
+		{
+			MethodName: "SetStreamACL",
+			Handler:    _AdminAPI_SetStreamACL_Handler,
+		},
+		{
+			MethodName: "DeletePartitionData",
+			Handler:    _AdminAPI_DeletePartitionData_Handler,
+		},
+		{
+			MethodName: "DeletePartition",
+			Handler:    _AdminAPI_DeletePartition_Handler,
+		},
+		{
+			MethodName: "NackMessage",
+			Handler:    _AdminAPI_NackMessage_Handler,
+		},
+		{
+			MethodName: "CleanPartition",
+			Handler:    _AdminAPI_CleanPartition_Handler,
+		},
+		{
+			MethodName: "GetReassignmentStatus",
+			Handler:    _AdminAPI_GetReassignmentStatus_Handler,
+		},
+		{
+			MethodName: "CheckMessageExists",
+			Handler:    _AdminAPI_CheckMessageExists_Handler,
+		},
+		{
+			MethodName: "MergeSegments",
+			Handler:    _AdminAPI_MergeSegments_Handler,
+		},
+		{
+			MethodName: "FetchStreamSkew",
+			Handler:    _AdminAPI_FetchStreamSkew_Handler,
+		},
+		{
+			MethodName: "RegisterProducer",
+			Handler:    _AdminAPI_RegisterProducer_Handler,
+		},
+		{
+			MethodName: "ReleaseProducer",
+			Handler:    _AdminAPI_ReleaseProducer_Handler,
+		},
+		{
+			MethodName: "SetProducerQuota",
+			Handler:    _AdminAPI_SetProducerQuota_Handler,
+		},
+		{
+			MethodName: "GetProducerQuotas",
+			Handler:    _AdminAPI_GetProducerQuotas_Handler,
+		},
+		{
+			MethodName: "DeleteProducerQuota",
+			Handler:    _AdminAPI_DeleteProducerQuota_Handler,
+		},
+		{
+			MethodName: "AddRaftServer",
+			Handler:    _AdminAPI_AddRaftServer_Handler,
+		},
+		{
+			MethodName: "RemoveRaftServer",
+			Handler:    _AdminAPI_RemoveRaftServer_Handler,
+		},
+		{
+			MethodName: "ListRaftServers",
+			Handler:    _AdminAPI_ListRaftServers_Handler,
+		},
+		{
+			MethodName: "FetchSubjectLayout",
+			Handler:    _AdminAPI_FetchSubjectLayout_Handler,
+		},
+		{
+			MethodName: "ExportCursors",
+			Handler:    _AdminAPI_ExportCursors_Handler,
+		},
+		{
+			MethodName: "ImportCursors",
+			Handler:    _AdminAPI_ImportCursors_Handler,
+		},
+		{
+			MethodName: "RestoreStreams",
+			Handler:    _AdminAPI_RestoreStreams_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportMessages",
+			Handler:       _AdminAPI_ExportMessages_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TriggerCompaction",
+			Handler:       _AdminAPI_TriggerCompaction_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchMetadata",
+			Handler:       _AdminAPI_WatchMetadata_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchPartition",
+			Handler:       _AdminAPI_WatchPartition_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "server/protocol/admin.proto",
+}
+
+func (m *TransferLeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WaitForLeaderTransfer {
+		i--
+		if m.WaitForLeaderTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TargetBroker) > 0 {
+		i -= len(m.TargetBroker)
+		copy(dAtA[i:], m.TargetBroker)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.TargetBroker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferLeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllocationStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocationStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocationStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionAllocations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionAllocations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionAllocations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ApproxCompactionBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ApproxCompactionBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ApproxSubscribeBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ApproxSubscribeBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ApproxMessageSetBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ApproxMessageSetBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ApproxTotalBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ApproxTotalBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GCStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GCStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GCStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PauseMax != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PauseMax))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PauseP99 != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PauseP99))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PauseP50 != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PauseP50))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HeapObjectBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.HeapObjectBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HeapAllocBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.HeapAllocBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cycles != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Cycles))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllocationStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocationStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocationStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Gc != nil {
+		{
+			size, err := m.Gc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ApproxTotalBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ApproxTotalBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SampleRate != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SampleRate))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BrokerId) > 0 {
+		i -= len(m.BrokerId)
+		copy(dAtA[i:], m.BrokerId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.BrokerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetadataMemoryStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataMemoryStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataMemoryStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetadataStructureStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataStructureStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataStructureStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxEntries != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MaxEntries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ApproxBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ApproxBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Entries != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Entries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetadataMemoryStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataMemoryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataMemoryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LimitViolations != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LimitViolations))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StaleEntriesRemoved != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StaleEntriesRemoved))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ApproxTotalBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ApproxTotalBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Structures) > 0 {
+		for iNdEx := len(m.Structures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Structures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BrokerId) > 0 {
+		i -= len(m.BrokerId)
+		copy(dAtA[i:], m.BrokerId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.BrokerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBrokerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBrokerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchBrokerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicaLag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicaLag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicaLag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ThrottleTime != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ThrottleTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Throttled {
+		i--
+		if m.Throttled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InIsr {
+		i--
+		if m.InIsr {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Lag != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Lag))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastFetchedOffset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LastFetchedOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Replica) > 0 {
+		i -= len(m.Replica)
+		copy(dAtA[i:], m.Replica)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Replica)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscriptionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ValueFilter) > 0 {
+		i -= len(m.ValueFilter)
+		copy(dAtA[i:], m.ValueFilter)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ValueFilter)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MessagesFiltered != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MessagesFiltered))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MessagesSent != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MessagesSent))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastOffset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LastOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.HeaderFilter) > 0 {
+		i -= len(m.HeaderFilter)
+		copy(dAtA[i:], m.HeaderFilter)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.HeaderFilter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IngestSources) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IngestSources) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IngestSources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Replication != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Replication))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Mirror != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Mirror))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nats != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Nats))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Api != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Api))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SegmentRolls) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SegmentRolls) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SegmentRolls) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Forced != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Forced))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxAge != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SegmentRolls != nil {
+		{
+			size, err := m.SegmentRolls.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.IngestSources != nil {
+		{
+			size, err := m.IngestSources.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.ReadAmplification != nil {
+		{
+			size, err := m.ReadAmplification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.TopKeys) > 0 {
+		for iNdEx := len(m.TopKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.DuplicatesDropped != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.DuplicatesDropped))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ReplicaLag) > 0 {
+		for iNdEx := len(m.ReplicaLag) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicaLag[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.BytesOutRate != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.BytesOutRate))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.BytesOut != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.BytesOut))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.BytesInRate != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.BytesInRate))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BytesIn != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.BytesIn))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MessagesInRate != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MessagesInRate))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MessagesIn != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MessagesIn))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Isr) > 0 {
+		for iNdEx := len(m.Isr) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Isr[iNdEx])
+			copy(dAtA[i:], m.Isr[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.Isr[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.SegmentCount != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SegmentCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HighWatermark != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.HighWatermark))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LogEndOffset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogEndOffset))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LogStartOffset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStartOffset))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsLeader {
+		i--
+		if m.IsLeader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Leader) > 0 {
+		i -= len(m.Leader)
+		copy(dAtA[i:], m.Leader)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Leader)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBrokerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBrokerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchBrokerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Propagation != nil {
+		{
+			size, err := m.Propagation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ActiveSubscriptions != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ActiveSubscriptions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UnauthenticatedDropped != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.UnauthenticatedDropped))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BrokerId) > 0 {
+		i -= len(m.BrokerId)
+		copy(dAtA[i:], m.BrokerId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.BrokerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PropagationStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PropagationStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PropagationStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Inflight != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Inflight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Failures != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Retries != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBrokerRTTsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBrokerRTTsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchBrokerRTTsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerRTT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerRTT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerRTT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Rtt != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Rtt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Peer) > 0 {
+		i -= len(m.Peer)
+		copy(dAtA[i:], m.Peer)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Peer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BrokerRTTs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BrokerRTTs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BrokerRTTs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Peers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MeasuredAt != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MeasuredAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Broker) > 0 {
+		i -= len(m.Broker)
+		copy(dAtA[i:], m.Broker)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Broker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBrokerRTTsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBrokerRTTsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchBrokerRTTsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Brokers) > 0 {
+		for iNdEx := len(m.Brokers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Brokers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BrokerId) > 0 {
+		i -= len(m.BrokerId)
+		copy(dAtA[i:], m.BrokerId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.BrokerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Streams[iNdEx])
+			copy(dAtA[i:], m.Streams[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.Streams[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionIngestSources) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionIngestSources) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionIngestSources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Sources != nil {
+		{
+			size, err := m.Sources.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IngestSources) > 0 {
+		for iNdEx := len(m.IngestSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IngestSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetStreamConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStreamConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStreamConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetStreamConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStreamConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStreamConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateStreamConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateStreamConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateStreamConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateStreamConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateStreamConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateStreamConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchPartitionStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchPartitionStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchPartitionStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AllReplicas {
+		i--
+		if m.AllReplicas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchPartitionStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchPartitionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchPartitionStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleStreamOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleStreamOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleStreamOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecuteAt != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ExecuteAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Readonly {
+		i--
+		if m.Readonly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Partitions) > 0 {
+		dAtA10 := make([]byte, len(m.Partitions)*10)
+		var j9 int
+		for _, num1 := range m.Partitions {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintAdmin(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleStreamOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleStreamOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleStreamOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Operation != nil {
+		{
+			size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListScheduledOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListScheduledOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListScheduledOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeFinished {
+		i--
+		if m.IncludeFinished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListScheduledOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListScheduledOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListScheduledOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelScheduledOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelScheduledOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelScheduledOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Id != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelScheduledOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelScheduledOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelScheduledOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateStreamOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateStreamOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateStreamOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateStreamOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateStreamOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateStreamOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetStreamTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetStreamTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetStreamTagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetStreamTagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetStreamTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetStreamTagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetStreamACLRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetStreamACLRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetStreamACLRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Acl != nil {
+		{
+			size, err := m.Acl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetStreamACLResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetStreamACLResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetStreamACLResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletePartitionDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePartitionDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePartitionDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Offset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletePartitionDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePartitionDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePartitionDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletePartitionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePartitionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePartitionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletePartitionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePartitionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePartitionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NackMessageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NackMessageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NackMessageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CursorId) > 0 {
+		i -= len(m.CursorId)
+		copy(dAtA[i:], m.CursorId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.CursorId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Offset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NackMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NackMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NackMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DeadLetterOffset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.DeadLetterOffset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ResumeToken) > 0 {
+		i -= len(m.ResumeToken)
+		copy(dAtA[i:], m.ResumeToken)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ResumeToken)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ChunkSize != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ChunkSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Format != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StopTimestamp != nil {
+		{
+			size, err := m.StopTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartTimestamp != nil {
+		{
+			size, err := m.StartTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StopOffset != nil {
+		{
+			size, err := m.StopOffset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartOffset != nil {
+		{
+			size, err := m.StartOffset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Partitions) > 0 {
+		dAtA18 := make([]byte, len(m.Partitions)*10)
+		var j17 int
+		for _, num1 := range m.Partitions {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintAdmin(dAtA, i, uint64(j17))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ResumeToken) > 0 {
+		i -= len(m.ResumeToken)
+		copy(dAtA[i:], m.ResumeToken)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ResumeToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Messages != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Messages))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportPartitionOffset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportPartitionOffset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportPartitionOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NextOffset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NextOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportResumeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportResumeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportResumeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerCompactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerCompactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerCompactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerCompactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerCompactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerCompactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TombstonesDropped != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TombstonesDropped))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Done {
+		i--
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.KeysCompacted != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.KeysCompacted))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BytesReclaimed != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.BytesReclaimed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SegmentsProcessed != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SegmentsProcessed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CleanPartitionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanPartitionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CleanPartitionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeFollowers {
+		i--
+		if m.IncludeFollowers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CleanPartitionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanPartitionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CleanPartitionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckMessageExistsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckMessageExistsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckMessageExistsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Offset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CorrelationId) > 0 {
+		i -= len(m.CorrelationId)
+		copy(dAtA[i:], m.CorrelationId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.CorrelationId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckMessageExistsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckMessageExistsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckMessageExistsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Committed {
+		i--
+		if m.Committed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Offset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Exists {
+		i--
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReassignmentStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReassignmentStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReassignmentStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReassignmentStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReassignmentStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReassignmentStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartedAt != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StartedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BytesRemaining != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.BytesRemaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CurrentIsr) > 0 {
+		for iNdEx := len(m.CurrentIsr) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrentIsr[iNdEx])
+			copy(dAtA[i:], m.CurrentIsr[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.CurrentIsr[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TargetReplicas) > 0 {
+		for iNdEx := len(m.TargetReplicas) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TargetReplicas[iNdEx])
+			copy(dAtA[i:], m.TargetReplicas[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.TargetReplicas[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeSegmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeSegmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeSegmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TargetBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TargetBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeSegmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeSegmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeSegmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SegmentsAfter != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SegmentsAfter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SegmentsBefore != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SegmentsBefore))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchStreamSkewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchStreamSkewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchStreamSkewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Streams[iNdEx])
+			copy(dAtA[i:], m.Streams[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.Streams[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchStreamSkewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchStreamSkewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchStreamSkewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterProducerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterProducerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterProducerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ttl != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Producer) > 0 {
+		i -= len(m.Producer)
+		copy(dAtA[i:], m.Producer)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Producer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterProducerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterProducerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterProducerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseProducerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseProducerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseProducerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Producer) > 0 {
+		i -= len(m.Producer)
+		copy(dAtA[i:], m.Producer)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Producer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseProducerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseProducerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseProducerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetProducerQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetProducerQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetProducerQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Quota != nil {
+		{
+			size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetProducerQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetProducerQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetProducerQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetProducerQuotasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetProducerQuotasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetProducerQuotasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ProducerID) > 0 {
+		i -= len(m.ProducerID)
+		copy(dAtA[i:], m.ProducerID)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProducerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetProducerQuotasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetProducerQuotasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetProducerQuotasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Quotas) > 0 {
+		for iNdEx := len(m.Quotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Quotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteProducerQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteProducerQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteProducerQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ProducerID) > 0 {
+		i -= len(m.ProducerID)
+		copy(dAtA[i:], m.ProducerID)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ProducerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteProducerQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteProducerQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteProducerQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddRaftServerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddRaftServerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddRaftServerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Observer {
+		i--
+		if m.Observer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ServerId) > 0 {
+		i -= len(m.ServerId)
+		copy(dAtA[i:], m.ServerId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ServerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddRaftServerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddRaftServerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddRaftServerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveRaftServerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRaftServerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveRaftServerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ServerId) > 0 {
+		i -= len(m.ServerId)
+		copy(dAtA[i:], m.ServerId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ServerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveRaftServerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveRaftServerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveRaftServerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListRaftServersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRaftServersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListRaftServersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RaftServer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftServer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftServer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastContact != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LastContact))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Leader {
+		i--
+		if m.Leader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Suffrage != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Suffrage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ServerId) > 0 {
+		i -= len(m.ServerId)
+		copy(dAtA[i:], m.ServerId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ServerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListRaftServersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRaftServersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListRaftServersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Servers) > 0 {
+		for iNdEx := len(m.Servers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Servers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchSubjectLayoutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchSubjectLayoutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchSubjectLayoutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubjectLayoutEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubjectLayoutEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubjectLayoutEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchSubjectLayoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchSubjectLayoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchSubjectLayoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Subjects) > 0 {
+		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SubjectPrefix) > 0 {
+		i -= len(m.SubjectPrefix)
+		copy(dAtA[i:], m.SubjectPrefix)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.SubjectPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BrokerId) > 0 {
+		i -= len(m.BrokerId)
+		copy(dAtA[i:], m.BrokerId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.BrokerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.StreamPrefixes) > 0 {
+		for iNdEx := len(m.StreamPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StreamPrefixes[iNdEx])
+			copy(dAtA[i:], m.StreamPrefixes[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.StreamPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetadataEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ScheduledOperation != nil {
+		{
+			size, err := m.ScheduledOperation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TtlDeletedAt != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TtlDeletedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PartitionMetadata != nil {
+		{
+			size, err := m.PartitionMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StreamMetadata != nil {
+		{
+			size, err := m.StreamMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchPartitionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchPartitionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchPartitionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxEventsPerSecond != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.MaxEventsPerSecond))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Readonly {
+		i--
+		if m.Readonly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Isr) > 0 {
+		for iNdEx := len(m.Isr) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Isr[iNdEx])
+			copy(dAtA[i:], m.Isr[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.Isr[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NewestOffset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NewestOffset))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HighWatermark != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.HighWatermark))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Changes) > 0 {
+		dAtA24 := make([]byte, len(m.Changes)*10)
+		var j23 int
+		for _, num := range m.Changes {
+			for num >= 1<<7 {
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA24[j23] = uint8(num)
+			j23++
+		}
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintAdmin(dAtA, i, uint64(j23))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportCursorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportCursorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportCursorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CursorIdPattern) > 0 {
+		i -= len(m.CursorIdPattern)
+		copy(dAtA[i:], m.CursorIdPattern)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.CursorIdPattern)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportedPartition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportedPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportedPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NewestOffset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NewestOffset))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OldestOffset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OldestOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportedStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportedStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportedStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CreationTimestamp != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.CreationTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportedCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportedCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportedCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Offset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CursorId) > 0 {
+		i -= len(m.CursorId)
+		copy(dAtA[i:], m.CursorId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.CursorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CursorDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CursorDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CursorDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cursors) > 0 {
+		for iNdEx := len(m.Cursors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cursors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ExportedAt != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ExportedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportCursorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportCursorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportCursorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Document != nil {
+		{
+			size, err := m.Document.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportCursorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportCursorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportCursorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Clamp {
+		i--
+		if m.Clamp {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OffsetMapping != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OffsetMapping))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StreamMappings) > 0 {
+		for iNdEx := len(m.StreamMappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StreamMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Document != nil {
+		{
+			size, err := m.Document.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportedCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportedCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportedCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Result != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Clamped {
+		i--
+		if m.Clamped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PreviousOffset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.PreviousOffset))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Offset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SourceOffset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SourceOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CursorId) > 0 {
+		i -= len(m.CursorId)
+		copy(dAtA[i:], m.CursorId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.CursorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportCursorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportCursorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportCursorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cursors) > 0 {
+		for iNdEx := len(m.Cursors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cursors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Streams[iNdEx])
+			copy(dAtA[i:], m.Streams[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.Streams[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoredPartition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoredPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoredPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Offset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Partition != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransferLeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	l = len(m.TargetBroker)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.WaitForLeaderTransfer {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransferLeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AllocationStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovAdmin(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionAllocations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.ApproxTotalBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.ApproxTotalBytes))
+	}
+	if m.ApproxMessageSetBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.ApproxMessageSetBytes))
+	}
+	if m.ApproxSubscribeBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.ApproxSubscribeBytes))
+	}
+	if m.ApproxCompactionBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.ApproxCompactionBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GCStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cycles != 0 {
+		n += 1 + sovAdmin(uint64(m.Cycles))
+	}
+	if m.HeapAllocBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.HeapAllocBytes))
+	}
+	if m.HeapObjectBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.HeapObjectBytes))
+	}
+	if m.PauseP50 != 0 {
+		n += 1 + sovAdmin(uint64(m.PauseP50))
+	}
+	if m.PauseP99 != 0 {
+		n += 1 + sovAdmin(uint64(m.PauseP99))
+	}
+	if m.PauseMax != 0 {
+		n += 1 + sovAdmin(uint64(m.PauseMax))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AllocationStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BrokerId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.SampleRate != 0 {
+		n += 1 + sovAdmin(uint64(m.SampleRate))
+	}
+	if m.ApproxTotalBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.ApproxTotalBytes))
+	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.Gc != nil {
+		l = m.Gc.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetadataMemoryStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetadataStructureStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Entries != 0 {
+		n += 1 + sovAdmin(uint64(m.Entries))
+	}
+	if m.ApproxBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.ApproxBytes))
+	}
+	if m.MaxEntries != 0 {
+		n += 1 + sovAdmin(uint64(m.MaxEntries))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetadataMemoryStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BrokerId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Structures) > 0 {
+		for _, e := range m.Structures {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.ApproxTotalBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.ApproxTotalBytes))
+	}
+	if m.StaleEntriesRemoved != 0 {
+		n += 1 + sovAdmin(uint64(m.StaleEntriesRemoved))
+	}
+	if m.LimitViolations != 0 {
+		n += 1 + sovAdmin(uint64(m.LimitViolations))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FetchBrokerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicaLag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Replica)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.LastFetchedOffset != 0 {
+		n += 1 + sovAdmin(uint64(m.LastFetchedOffset))
+	}
+	if m.Lag != 0 {
+		n += 1 + sovAdmin(uint64(m.Lag))
+	}
+	if m.InIsr {
+		n += 2
+	}
+	if m.Throttled {
+		n += 2
+	}
+	if m.ThrottleTime != 0 {
+		n += 1 + sovAdmin(uint64(m.ThrottleTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscriptionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.HeaderFilter)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.LastOffset != 0 {
+		n += 1 + sovAdmin(uint64(m.LastOffset))
+	}
+	if m.MessagesSent != 0 {
+		n += 1 + sovAdmin(uint64(m.MessagesSent))
+	}
+	if m.MessagesFiltered != 0 {
+		n += 1 + sovAdmin(uint64(m.MessagesFiltered))
+	}
+	l = len(m.ValueFilter)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IngestSources) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Api != 0 {
+		n += 1 + sovAdmin(uint64(m.Api))
+	}
+	if m.Nats != 0 {
+		n += 1 + sovAdmin(uint64(m.Nats))
+	}
+	if m.Mirror != 0 {
+		n += 1 + sovAdmin(uint64(m.Mirror))
+	}
+	if m.Replication != 0 {
+		n += 1 + sovAdmin(uint64(m.Replication))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SegmentRolls) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.MaxBytes))
+	}
+	if m.MaxAge != 0 {
+		n += 1 + sovAdmin(uint64(m.MaxAge))
+	}
+	if m.Forced != 0 {
+		n += 1 + sovAdmin(uint64(m.Forced))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	l = len(m.Leader)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovAdmin(uint64(m.LeaderEpoch))
+	}
+	if m.IsLeader {
+		n += 2
+	}
+	if m.LogStartOffset != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStartOffset))
+	}
+	if m.LogEndOffset != 0 {
+		n += 1 + sovAdmin(uint64(m.LogEndOffset))
+	}
+	if m.HighWatermark != 0 {
+		n += 1 + sovAdmin(uint64(m.HighWatermark))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.SizeBytes))
+	}
+	if m.SegmentCount != 0 {
+		n += 1 + sovAdmin(uint64(m.SegmentCount))
+	}
+	if len(m.Isr) > 0 {
+		for _, s := range m.Isr {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.MessagesIn != 0 {
+		n += 1 + sovAdmin(uint64(m.MessagesIn))
+	}
+	if m.MessagesInRate != 0 {
+		n += 1 + sovAdmin(uint64(m.MessagesInRate))
+	}
+	if m.BytesIn != 0 {
+		n += 1 + sovAdmin(uint64(m.BytesIn))
+	}
+	if m.BytesInRate != 0 {
+		n += 1 + sovAdmin(uint64(m.BytesInRate))
+	}
+	if m.BytesOut != 0 {
+		n += 2 + sovAdmin(uint64(m.BytesOut))
+	}
+	if m.BytesOutRate != 0 {
+		n += 2 + sovAdmin(uint64(m.BytesOutRate))
+	}
+	if len(m.ReplicaLag) > 0 {
+		for _, e := range m.ReplicaLag {
+			l = e.Size()
+			n += 2 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 2 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.DuplicatesDropped != 0 {
+		n += 2 + sovAdmin(uint64(m.DuplicatesDropped))
+	}
+	if len(m.TopKeys) > 0 {
+		for _, e := range m.TopKeys {
+			l = e.Size()
+			n += 2 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.ReadAmplification != nil {
+		l = m.ReadAmplification.Size()
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	if m.IngestSources != nil {
+		l = m.IngestSources.Size()
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	if m.SegmentRolls != nil {
+		l = m.SegmentRolls.Size()
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FetchBrokerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BrokerId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.UnauthenticatedDropped != 0 {
+		n += 1 + sovAdmin(uint64(m.UnauthenticatedDropped))
+	}
+	if m.ActiveSubscriptions != 0 {
+		n += 1 + sovAdmin(uint64(m.ActiveSubscriptions))
+	}
+	if m.Propagation != nil {
+		l = m.Propagation.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PropagationStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		n += 1 + sovAdmin(uint64(m.Attempts))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovAdmin(uint64(m.Retries))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovAdmin(uint64(m.Failures))
+	}
+	if m.Inflight != 0 {
+		n += 1 + sovAdmin(uint64(m.Inflight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FetchBrokerRTTsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerRTT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Peer)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Rtt != 0 {
+		n += 1 + sovAdmin(uint64(m.Rtt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BrokerRTTs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Broker)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.MeasuredAt != 0 {
+		n += 1 + sovAdmin(uint64(m.MeasuredAt))
+	}
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FetchBrokerRTTsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BrokerId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Brokers) > 0 {
+		for _, e := range m.Brokers {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, s := range m.Streams {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionIngestSources) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.Sources != nil {
+		l = m.Sources.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.IngestSources) > 0 {
+		for _, e := range m.IngestSources {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetStreamConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetStreamConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateStreamConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateStreamConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FetchPartitionStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.AllReplicas {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FetchPartitionStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Replicas) > 0 {
+		for _, e := range m.Replicas {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleStreamOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovAdmin(uint64(m.Type))
+	}
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Partitions) > 0 {
+		l = 0
+		for _, e := range m.Partitions {
+			l += sovAdmin(uint64(e))
+		}
+		n += 1 + sovAdmin(uint64(l)) + l
+	}
+	if m.Readonly {
+		n += 2
+	}
+	if m.ExecuteAt != 0 {
+		n += 1 + sovAdmin(uint64(m.ExecuteAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleStreamOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		l = m.Operation.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListScheduledOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.IncludeFinished {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListScheduledOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelScheduledOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAdmin(uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelScheduledOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateStreamOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateStreamOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetStreamTagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetStreamTagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetStreamACLRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Acl != nil {
+		l = m.Acl.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetStreamACLResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePartitionDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdmin(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePartitionDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePartitionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePartitionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NackMessageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdmin(uint64(m.Offset))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.CursorId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NackMessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeadLetterOffset != 0 {
+		n += 1 + sovAdmin(uint64(m.DeadLetterOffset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Partitions) > 0 {
+		l = 0
+		for _, e := range m.Partitions {
+			l += sovAdmin(uint64(e))
+		}
+		n += 1 + sovAdmin(uint64(l)) + l
+	}
+	if m.StartOffset != nil {
+		l = m.StartOffset.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.StopOffset != nil {
+		l = m.StopOffset.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.StartTimestamp != nil {
+		l = m.StartTimestamp.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.StopTimestamp != nil {
+		l = m.StopTimestamp.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovAdmin(uint64(m.Format))
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovAdmin(uint64(m.ChunkSize))
+	}
+	l = len(m.ResumeToken)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.Messages != 0 {
+		n += 1 + sovAdmin(uint64(m.Messages))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.ResumeToken)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportPartitionOffset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.NextOffset != 0 {
+		n += 1 + sovAdmin(uint64(m.NextOffset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportResumeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TriggerCompactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TriggerCompactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SegmentsProcessed != 0 {
+		n += 1 + sovAdmin(uint64(m.SegmentsProcessed))
+	}
+	if m.BytesReclaimed != 0 {
+		n += 1 + sovAdmin(uint64(m.BytesReclaimed))
+	}
+	if m.KeysCompacted != 0 {
+		n += 1 + sovAdmin(uint64(m.KeysCompacted))
+	}
+	if m.Done {
+		n += 2
+	}
+	if m.TombstonesDropped != 0 {
+		n += 1 + sovAdmin(uint64(m.TombstonesDropped))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CleanPartitionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.IncludeFollowers {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CleanPartitionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Replicas) > 0 {
+		for _, e := range m.Replicas {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckMessageExistsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	l = len(m.CorrelationId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdmin(uint64(m.Offset))
+	}
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovAdmin(uint64(m.LeaderEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckMessageExistsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exists {
+		n += 2
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdmin(uint64(m.Offset))
+	}
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovAdmin(uint64(m.LeaderEpoch))
+	}
+	if m.Committed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReassignmentStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReassignmentStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TargetReplicas) > 0 {
+		for _, s := range m.TargetReplicas {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.CurrentIsr) > 0 {
+		for _, s := range m.CurrentIsr {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.BytesRemaining != 0 {
+		n += 1 + sovAdmin(uint64(m.BytesRemaining))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovAdmin(uint64(m.StartedAt))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeSegmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.TargetBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.TargetBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeSegmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SegmentsBefore != 0 {
+		n += 1 + sovAdmin(uint64(m.SegmentsBefore))
+	}
+	if m.SegmentsAfter != 0 {
+		n += 1 + sovAdmin(uint64(m.SegmentsAfter))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FetchStreamSkewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, s := range m.Streams {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FetchStreamSkewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegisterProducerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Producer)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovAdmin(uint64(m.Ttl))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegisterProducerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovAdmin(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReleaseProducerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Producer)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovAdmin(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReleaseProducerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetProducerQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetProducerQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetProducerQuotasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProducerID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetProducerQuotasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for _, e := range m.Quotas {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteProducerQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProducerID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteProducerQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddRaftServerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Observer {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddRaftServerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveRaftServerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveRaftServerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRaftServersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftServer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Suffrage != 0 {
+		n += 1 + sovAdmin(uint64(m.Suffrage))
+	}
+	if m.Leader {
+		n += 2
+	}
+	if m.LastContact != 0 {
+		n += 1 + sovAdmin(uint64(m.LastContact))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRaftServersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Servers) > 0 {
+		for _, e := range m.Servers {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FetchSubjectLayoutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubjectLayoutEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FetchSubjectLayoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BrokerId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.SubjectPrefix)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Subjects) > 0 {
+		for _, e := range m.Subjects {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StreamPrefixes) > 0 {
+		for _, s := range m.StreamPrefixes {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetadataEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovAdmin(uint64(m.Type))
+	}
+	if m.Index != 0 {
+		n += 1 + sovAdmin(uint64(m.Index))
+	}
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.StreamMetadata != nil {
+		l = m.StreamMetadata.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.PartitionMetadata != nil {
+		l = m.PartitionMetadata.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.TtlDeletedAt != 0 {
+		n += 1 + sovAdmin(uint64(m.TtlDeletedAt))
+	}
+	if m.ScheduledOperation != nil {
+		l = m.ScheduledOperation.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchPartitionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.MaxEventsPerSecond != 0 {
+		n += 1 + sovAdmin(uint64(m.MaxEventsPerSecond))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		l = 0
+		for _, e := range m.Changes {
+			l += sovAdmin(uint64(e))
+		}
+		n += 1 + sovAdmin(uint64(l)) + l
+	}
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovAdmin(uint64(m.Timestamp))
+	}
+	if m.HighWatermark != 0 {
+		n += 1 + sovAdmin(uint64(m.HighWatermark))
+	}
+	if m.NewestOffset != 0 {
+		n += 1 + sovAdmin(uint64(m.NewestOffset))
+	}
+	if len(m.Isr) > 0 {
+		for _, s := range m.Isr {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.Readonly {
+		n += 2
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovAdmin(uint64(m.LeaderEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportCursorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CursorIdPattern)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportedPartition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAdmin(uint64(m.Id))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovAdmin(uint64(m.Epoch))
+	}
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovAdmin(uint64(m.LeaderEpoch))
+	}
+	if m.OldestOffset != 0 {
+		n += 1 + sovAdmin(uint64(m.OldestOffset))
+	}
+	if m.NewestOffset != 0 {
+		n += 1 + sovAdmin(uint64(m.NewestOffset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportedStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.CreationTimestamp != 0 {
+		n += 1 + sovAdmin(uint64(m.CreationTimestamp))
+	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportedCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CursorId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdmin(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CursorDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.ExportedAt != 0 {
+		n += 1 + sovAdmin(uint64(m.ExportedAt))
+	}
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.Cursors) > 0 {
+		for _, e := range m.Cursors {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportCursorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Document != nil {
+		l = m.Document.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportCursorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Document != nil {
+		l = m.Document.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.StreamMappings) > 0 {
+		for _, e := range m.StreamMappings {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.OffsetMapping != 0 {
+		n += 1 + sovAdmin(uint64(m.OffsetMapping))
+	}
+	if m.Clamp {
+		n += 2
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportedCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CursorId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.SourceOffset != 0 {
+		n += 1 + sovAdmin(uint64(m.SourceOffset))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdmin(uint64(m.Offset))
+	}
+	if m.PreviousOffset != 0 {
+		n += 1 + sovAdmin(uint64(m.PreviousOffset))
+	}
+	if m.Clamped {
+		n += 2
+	}
+	if m.Result != 0 {
+		n += 1 + sovAdmin(uint64(m.Result))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportCursorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cursors) > 0 {
+		for _, e := range m.Cursors {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, s := range m.Streams {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoredPartition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovAdmin(uint64(m.Partition))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdmin(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferLeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBroker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBroker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitForLeaderTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WaitForLeaderTransfer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferLeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferLeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocationStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocationStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocationStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionAllocations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionAllocations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionAllocations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproxTotalBytes", wireType)
+			}
+			m.ApproxTotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproxTotalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproxMessageSetBytes", wireType)
+			}
+			m.ApproxMessageSetBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproxMessageSetBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproxSubscribeBytes", wireType)
+			}
+			m.ApproxSubscribeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproxSubscribeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproxCompactionBytes", wireType)
+			}
+			m.ApproxCompactionBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproxCompactionBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cycles", wireType)
+			}
+			m.Cycles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cycles |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeapAllocBytes", wireType)
+			}
+			m.HeapAllocBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeapAllocBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeapObjectBytes", wireType)
+			}
+			m.HeapObjectBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeapObjectBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseP50", wireType)
+			}
+			m.PauseP50 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseP50 |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseP99", wireType)
+			}
+			m.PauseP99 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseP99 |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseMax", wireType)
+			}
+			m.PauseMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseMax |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocationStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocationStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocationStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrokerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleRate", wireType)
+			}
+			m.SampleRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleRate |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproxTotalBytes", wireType)
+			}
+			m.ApproxTotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproxTotalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &PartitionAllocations{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gc == nil {
+				m.Gc = &GCStats{}
+			}
+			if err := m.Gc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataMemoryStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataMemoryStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataMemoryStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataStructureStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataStructureStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataStructureStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			m.Entries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Entries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproxBytes", wireType)
+			}
+			m.ApproxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataMemoryStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataMemoryStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataMemoryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrokerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Structures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Structures = append(m.Structures, &MetadataStructureStats{})
+			if err := m.Structures[len(m.Structures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproxTotalBytes", wireType)
+			}
+			m.ApproxTotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproxTotalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleEntriesRemoved", wireType)
+			}
+			m.StaleEntriesRemoved = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StaleEntriesRemoved |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitViolations", wireType)
+			}
+			m.LimitViolations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitViolations |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBrokerStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBrokerStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBrokerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicaLag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicaLag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicaLag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replica = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFetchedOffset", wireType)
+			}
+			m.LastFetchedOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFetchedOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
+			}
+			m.Lag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InIsr", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InIsr = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Throttled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Throttled = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottleTime", wireType)
+			}
+			m.ThrottleTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThrottleTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriptionStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOffset", wireType)
+			}
+			m.LastOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesSent", wireType)
+			}
+			m.MessagesSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagesSent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesFiltered", wireType)
+			}
+			m.MessagesFiltered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagesFiltered |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IngestSources) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IngestSources: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IngestSources: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Api", wireType)
+			}
+			m.Api = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Api |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nats", wireType)
+			}
+			m.Nats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nats |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mirror", wireType)
+			}
+			m.Mirror = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mirror |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
+			}
+			m.Replication = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replication |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SegmentRolls) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SegmentRolls: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SegmentRolls: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			m.MaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAge |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forced", wireType)
+			}
+			m.Forced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Forced |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+			}
+			m.LeaderEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLeader", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLeader = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStartOffset", wireType)
+			}
+			m.LogStartOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStartOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogEndOffset", wireType)
+			}
+			m.LogEndOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogEndOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWatermark", wireType)
+			}
+			m.HighWatermark = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighWatermark |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentCount", wireType)
+			}
+			m.SegmentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Isr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Isr = append(m.Isr, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesIn", wireType)
+			}
+			m.MessagesIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagesIn |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesInRate", wireType)
+			}
+			m.MessagesInRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagesInRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesIn", wireType)
+			}
+			m.BytesIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesIn |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesInRate", wireType)
+			}
+			m.BytesInRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesInRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesOut", wireType)
+			}
+			m.BytesOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesOutRate", wireType)
+			}
+			m.BytesOutRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesOutRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaLag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicaLag = append(m.ReplicaLag, &ReplicaLag{})
+			if err := m.ReplicaLag[len(m.ReplicaLag)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, &SubscriptionStats{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicatesDropped", wireType)
+			}
+			m.DuplicatesDropped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DuplicatesDropped |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopKeys = append(m.TopKeys, &KeyCount{})
+			if err := m.TopKeys[len(m.TopKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadAmplification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadAmplification == nil {
+				m.ReadAmplification = &ReadAmplification{}
+			}
+			if err := m.ReadAmplification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngestSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IngestSources == nil {
+				m.IngestSources = &IngestSources{}
+			}
+			if err := m.IngestSources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentRolls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SegmentRolls == nil {
+				m.SegmentRolls = &SegmentRolls{}
+			}
+			if err := m.SegmentRolls.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBrokerStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBrokerStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBrokerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrokerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &PartitionStats{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnauthenticatedDropped", wireType)
+			}
+			m.UnauthenticatedDropped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnauthenticatedDropped |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSubscriptions", wireType)
+			}
+			m.ActiveSubscriptions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveSubscriptions |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Propagation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Propagation == nil {
+				m.Propagation = &PropagationStats{}
+			}
+			if err := m.Propagation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PropagationStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PropagationStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PropagationStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflight", wireType)
+			}
+			m.Inflight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inflight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBrokerRTTsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBrokerRTTsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBrokerRTTsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerRTT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerRTT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerRTT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rtt", wireType)
+			}
+			m.Rtt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rtt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BrokerRTTs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BrokerRTTs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BrokerRTTs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Broker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeasuredAt", wireType)
+			}
+			m.MeasuredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MeasuredAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, &PeerRTT{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBrokerRTTsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBrokerRTTsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBrokerRTTsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrokerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brokers = append(m.Brokers, &BrokerRTTs{})
+			if err := m.Brokers[len(m.Brokers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeStreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionIngestSources) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionIngestSources: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionIngestSources: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sources == nil {
+				m.Sources = &IngestSources{}
+			}
+			if err := m.Sources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, &Stream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngestSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IngestSources = append(m.IngestSources, &PartitionIngestSources{})
+			if err := m.IngestSources[len(m.IngestSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStreamConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStreamConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStreamConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStreamConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStreamConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStreamConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &StreamConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateStreamConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateStreamConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateStreamConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &StreamConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateStreamConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateStreamConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateStreamConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchPartitionStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchPartitionStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchPartitionStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllReplicas", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllReplicas = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchPartitionStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchPartitionStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchPartitionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, &PartitionReplicaStatus{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleStreamOperationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleStreamOperationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleStreamOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ScheduledOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Partitions = append(m.Partitions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Partitions) == 0 {
+					m.Partitions = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Partitions = append(m.Partitions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Readonly = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAt", wireType)
+			}
+			m.ExecuteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleStreamOperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleStreamOperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleStreamOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Operation == nil {
+				m.Operation = &ScheduledOperation{}
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListScheduledOperationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListScheduledOperationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListScheduledOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeFinished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeFinished = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListScheduledOperationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListScheduledOperationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListScheduledOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, &ScheduledOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelScheduledOperationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelScheduledOperationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelScheduledOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelScheduledOperationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelScheduledOperationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelScheduledOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateStreamOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateStreamOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateStreamOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateStreamOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateStreamOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateStreamOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetStreamTagsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetStreamTagsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetStreamTagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, &StreamTag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetStreamTagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetStreamTagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetStreamTagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetStreamACLRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetStreamACLRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetStreamACLRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acl == nil {
+				m.Acl = &StreamACL{}
+			}
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetStreamACLResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetStreamACLResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetStreamACLResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePartitionDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePartitionDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePartitionDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePartitionDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePartitionDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePartitionDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePartitionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePartitionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePartitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePartitionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePartitionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePartitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NackMessageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NackMessageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NackMessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CursorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CursorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NackMessageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NackMessageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NackMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterOffset", wireType)
+			}
+			m.DeadLetterOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadLetterOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Partitions = append(m.Partitions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Partitions) == 0 {
+					m.Partitions = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Partitions = append(m.Partitions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartOffset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartOffset == nil {
+				m.StartOffset = &NullableInt64{}
+			}
+			if err := m.StartOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopOffset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StopOffset == nil {
+				m.StopOffset = &NullableInt64{}
+			}
+			if err := m.StopOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTimestamp == nil {
+				m.StartTimestamp = &NullableInt64{}
+			}
+			if err := m.StartTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StopTimestamp == nil {
+				m.StopTimestamp = &NullableInt64{}
+			}
+			if err := m.StopTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= ExportFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			}
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			m.Messages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Messages |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResumeToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportPartitionOffset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportPartitionOffset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportPartitionOffset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOffset", wireType)
+			}
+			m.NextOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportResumeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportResumeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportResumeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &ExportPartitionOffset{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerCompactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerCompactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerCompactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerCompactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerCompactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerCompactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentsProcessed", wireType)
+			}
+			m.SegmentsProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentsProcessed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesReclaimed", wireType)
+			}
+			m.BytesReclaimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesReclaimed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysCompacted", wireType)
+			}
+			m.KeysCompacted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysCompacted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Done = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TombstonesDropped", wireType)
+			}
+			m.TombstonesDropped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TombstonesDropped |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CleanPartitionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CleanPartitionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CleanPartitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeFollowers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeFollowers = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CleanPartitionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CleanPartitionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CleanPartitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, &PartitionCleanResult{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckMessageExistsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckMessageExistsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckMessageExistsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrelationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+			}
+			m.LeaderEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckMessageExistsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckMessageExistsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckMessageExistsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exists = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+			}
+			m.LeaderEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Committed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReassignmentStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReassignmentStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReassignmentStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReassignmentStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReassignmentStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReassignmentStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetReplicas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetReplicas = append(m.TargetReplicas, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentIsr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentIsr = append(m.CurrentIsr, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRemaining", wireType)
+			}
+			m.BytesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeSegmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeSegmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeSegmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBytes", wireType)
+			}
+			m.TargetBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeSegmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeSegmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeSegmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentsBefore", wireType)
+			}
+			m.SegmentsBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentsBefore |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentsAfter", wireType)
+			}
+			m.SegmentsAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentsAfter |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchStreamSkewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchStreamSkewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchStreamSkewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchStreamSkewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchStreamSkewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchStreamSkewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, &StreamSkew{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterProducerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterProducerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterProducerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Producer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Producer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterProducerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterProducerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterProducerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseProducerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseProducerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseProducerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Producer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Producer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseProducerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseProducerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseProducerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetProducerQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetProducerQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetProducerQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &ProducerQuota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetProducerQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetProducerQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetProducerQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProducerQuotasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProducerQuotasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProducerQuotasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProducerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetProducerQuotasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetProducerQuotasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetProducerQuotasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quotas = append(m.Quotas, &ProducerQuota{})
+			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteProducerQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteProducerQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteProducerQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProducerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteProducerQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteProducerQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteProducerQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddRaftServerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddRaftServerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddRaftServerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Observer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddRaftServerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddRaftServerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddRaftServerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRaftServerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRaftServerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRaftServerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRaftServerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRaftServerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRaftServerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRaftServersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRaftServersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRaftServersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaftServer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftServer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftServer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffrage", wireType)
+			}
+			m.Suffrage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Suffrage |= RaftSuffrage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Leader = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastContact", wireType)
+			}
+			m.LastContact = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastContact |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRaftServersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRaftServersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRaftServersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Servers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Servers = append(m.Servers, &RaftServer{})
+			if err := m.Servers[len(m.Servers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchSubjectLayoutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchSubjectLayoutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchSubjectLayoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubjectLayoutEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubjectLayoutEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubjectLayoutEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchSubjectLayoutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchSubjectLayoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchSubjectLayoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrokerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subjects = append(m.Subjects, &SubjectLayoutEntry{})
+			if err := m.Subjects[len(m.Subjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamPrefixes = append(m.StreamPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= MetadataEventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StreamMetadata == nil {
+				m.StreamMetadata = &Stream{}
+			}
+			if err := m.StreamMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionMetadata == nil {
+				m.PartitionMetadata = &Partition{}
+			}
+			if err := m.PartitionMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TtlDeletedAt", wireType)
+			}
+			m.TtlDeletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TtlDeletedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledOperation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledOperation == nil {
+				m.ScheduledOperation = &ScheduledOperation{}
+			}
+			if err := m.ScheduledOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchPartitionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchPartitionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchPartitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEventsPerSecond", wireType)
+			}
+			m.MaxEventsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEventsPerSecond |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v PartitionEventType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PartitionEventType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Changes = append(m.Changes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Changes) == 0 {
+					m.Changes = make([]PartitionEventType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PartitionEventType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PartitionEventType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Changes = append(m.Changes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWatermark", wireType)
+			}
+			m.HighWatermark = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighWatermark |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewestOffset", wireType)
+			}
+			m.NewestOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewestOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Isr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Isr = append(m.Isr, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Readonly = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+			}
+			m.LeaderEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportCursorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportCursorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportCursorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CursorIdPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CursorIdPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportedPartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportedPartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportedPartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+			}
+			m.LeaderEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestOffset", wireType)
+			}
+			m.OldestOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewestOffset", wireType)
+			}
+			m.NewestOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewestOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportedStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportedStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportedStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+			}
+			m.CreationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &ExportedPartition{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportedCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportedCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportedCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CursorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CursorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CursorDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CursorDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CursorDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportedAt", wireType)
+			}
+			m.ExportedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExportedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, &ExportedStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursors = append(m.Cursors, &ExportedCursor{})
+			if err := m.Cursors[len(m.Cursors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportCursorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportCursorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportCursorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Document == nil {
+				m.Document = &CursorDocument{}
+			}
+			if err := m.Document.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportCursorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportCursorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportCursorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Document == nil {
+				m.Document = &CursorDocument{}
+			}
+			if err := m.Document.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamMappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StreamMappings = append(m.StreamMappings, &StreamMapping{})
+			if err := m.StreamMappings[len(m.StreamMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetMapping", wireType)
+			}
+			m.OffsetMapping = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetMapping |= CursorOffsetMapping(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clamp", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Clamp = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportedCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportedCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportedCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CursorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CursorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceOffset", wireType)
+			}
+			m.SourceOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOffset", wireType)
+			}
+			m.PreviousOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clamped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Clamped = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= CursorImportResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportCursorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportCursorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportCursorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursors = append(m.Cursors, &ImportedCursor{})
+			if err := m.Cursors[len(m.Cursors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreStreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoredPartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoredPartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoredPartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &RestoredPartition{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAdmin(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAdmin
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAdmin
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAdmin
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAdmin        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAdmin          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAdmin = fmt.Errorf("proto: unexpected end of group")
+)
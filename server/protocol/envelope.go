@@ -2,6 +2,8 @@ package protocol
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -37,6 +39,24 @@ const (
 	msgTypePartitionStatusResponse
 
 	msgTypePartitionNotification
+
+	msgTypePartitionStarted
+
+	msgTypePartitionLoadReport
+
+	msgTypeBootstrapProbe
+
+	msgTypeReplicationOffsetOutOfRange
+
+	msgTypeReplicationSnapshotOffer
+	msgTypeSnapshotChunkRequest
+	msgTypeSnapshotChunkResponse
+
+	msgTypeCursorRequest
+	msgTypeCursorResponse
+
+	msgTypePartitionCleanRequest
+	msgTypePartitionCleanResponse
 )
 
 const (
@@ -46,6 +66,18 @@ const (
 	// envelopeMinHeaderLen is the minimum length of the envelope header, i.e.
 	// without CRC-32C set.
 	envelopeMinHeaderLen = 8
+
+	// envelopeCRCLen is the length of the CRC-32C included in the header when
+	// the CRC flag is set.
+	envelopeCRCLen = 4
+
+	// envelopeHMACLen is the length of the HMAC-SHA256 included in the header
+	// when the HMAC flag is set.
+	envelopeHMACLen = sha256.Size
+
+	// Flag bit positions.
+	envelopeFlagCRC  = 0
+	envelopeFlagHMAC = 1
 )
 
 var (
@@ -60,6 +92,10 @@ var (
 	envelopeMagicNumberLen = len(envelopeMagicNumber)
 
 	crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+	// ErrEnvelopeAuthFailed is returned by VerifyEnvelope when an envelope's
+	// HMAC does not match any of the given keys.
+	ErrEnvelopeAuthFailed = errors.New("envelope HMAC mismatch")
 )
 
 // MarshalPublish serializes a protobuf publish message into the Liftbridge
@@ -134,6 +170,72 @@ func MarshalPartitionNotification(req *PartitionNotification) ([]byte, error) {
 	return marshalEnvelope(req, msgTypePartitionNotification)
 }
 
+// MarshalPartitionStarted serializes a PartitionStarted protobuf into the
+// Liftbridge envelope wire format.
+func MarshalPartitionStarted(req *PartitionStarted) ([]byte, error) {
+	return marshalEnvelope(req, msgTypePartitionStarted)
+}
+
+// MarshalPartitionLoadReport serializes a PartitionLoadReport protobuf into
+// the Liftbridge envelope wire format.
+func MarshalPartitionLoadReport(req *PartitionLoadReport) ([]byte, error) {
+	return marshalEnvelope(req, msgTypePartitionLoadReport)
+}
+
+// MarshalBootstrapProbe serializes a BootstrapProbe protobuf into the
+// Liftbridge envelope wire format.
+func MarshalBootstrapProbe(req *BootstrapProbe) ([]byte, error) {
+	return marshalEnvelope(req, msgTypeBootstrapProbe)
+}
+
+// MarshalReplicationOffsetOutOfRange serializes a ReplicationOffsetOutOfRange
+// protobuf into the Liftbridge envelope wire format.
+func MarshalReplicationOffsetOutOfRange(resp *ReplicationOffsetOutOfRange) ([]byte, error) {
+	return marshalEnvelope(resp, msgTypeReplicationOffsetOutOfRange)
+}
+
+// MarshalReplicationSnapshotOffer serializes a ReplicationSnapshotOffer
+// protobuf into the Liftbridge envelope wire format.
+func MarshalReplicationSnapshotOffer(resp *ReplicationSnapshotOffer) ([]byte, error) {
+	return marshalEnvelope(resp, msgTypeReplicationSnapshotOffer)
+}
+
+// MarshalSnapshotChunkRequest serializes a SnapshotChunkRequest protobuf into
+// the Liftbridge envelope wire format.
+func MarshalSnapshotChunkRequest(req *SnapshotChunkRequest) ([]byte, error) {
+	return marshalEnvelope(req, msgTypeSnapshotChunkRequest)
+}
+
+// MarshalSnapshotChunkResponse serializes a SnapshotChunkResponse protobuf
+// into the Liftbridge envelope wire format.
+func MarshalSnapshotChunkResponse(resp *SnapshotChunkResponse) ([]byte, error) {
+	return marshalEnvelope(resp, msgTypeSnapshotChunkResponse)
+}
+
+// MarshalCursorRequest serializes a CursorRequest protobuf into the Liftbridge
+// envelope wire format.
+func MarshalCursorRequest(req *CursorRequest) ([]byte, error) {
+	return marshalEnvelope(req, msgTypeCursorRequest)
+}
+
+// MarshalCursorResponse serializes a CursorResponse protobuf into the
+// Liftbridge envelope wire format.
+func MarshalCursorResponse(resp *CursorResponse) ([]byte, error) {
+	return marshalEnvelope(resp, msgTypeCursorResponse)
+}
+
+// MarshalPartitionCleanRequest serializes a PartitionCleanRequest protobuf
+// into the Liftbridge envelope wire format.
+func MarshalPartitionCleanRequest(req *PartitionCleanRequest) ([]byte, error) {
+	return marshalEnvelope(req, msgTypePartitionCleanRequest)
+}
+
+// MarshalPartitionCleanResponse serializes a PartitionCleanResponse protobuf
+// into the Liftbridge envelope wire format.
+func MarshalPartitionCleanResponse(resp *PartitionCleanResponse) ([]byte, error) {
+	return marshalEnvelope(resp, msgTypePartitionCleanResponse)
+}
+
 // MarshalRaftJoinRequest serializes a RaftJoinRequest protobuf into the
 // Liftbridge envelope wire format.
 func MarshalRaftJoinRequest(req *RaftJoinRequest) ([]byte, error) {
@@ -297,6 +399,116 @@ func UnmarshalPartitionNotification(data []byte) (*PartitionNotification, error)
 	return req, err
 }
 
+// UnmarshalPartitionStarted deserializes a Liftbridge PartitionStarted
+// envelope into a protobuf message.
+func UnmarshalPartitionStarted(data []byte) (*PartitionStarted, error) {
+	var (
+		req = new(PartitionStarted)
+		err = unmarshalEnvelope(data, req, msgTypePartitionStarted)
+	)
+	return req, err
+}
+
+// UnmarshalPartitionLoadReport deserializes a Liftbridge PartitionLoadReport
+// envelope into a protobuf message.
+func UnmarshalPartitionLoadReport(data []byte) (*PartitionLoadReport, error) {
+	var (
+		req = new(PartitionLoadReport)
+		err = unmarshalEnvelope(data, req, msgTypePartitionLoadReport)
+	)
+	return req, err
+}
+
+// UnmarshalBootstrapProbe deserializes a Liftbridge BootstrapProbe envelope
+// into a protobuf message.
+func UnmarshalBootstrapProbe(data []byte) (*BootstrapProbe, error) {
+	var (
+		req = new(BootstrapProbe)
+		err = unmarshalEnvelope(data, req, msgTypeBootstrapProbe)
+	)
+	return req, err
+}
+
+// UnmarshalReplicationOffsetOutOfRange deserializes a Liftbridge
+// ReplicationOffsetOutOfRange envelope into a protobuf message.
+func UnmarshalReplicationOffsetOutOfRange(data []byte) (*ReplicationOffsetOutOfRange, error) {
+	var (
+		resp = new(ReplicationOffsetOutOfRange)
+		err  = unmarshalEnvelope(data, resp, msgTypeReplicationOffsetOutOfRange)
+	)
+	return resp, err
+}
+
+// UnmarshalReplicationSnapshotOffer deserializes a Liftbridge
+// ReplicationSnapshotOffer envelope into a protobuf message.
+func UnmarshalReplicationSnapshotOffer(data []byte) (*ReplicationSnapshotOffer, error) {
+	var (
+		resp = new(ReplicationSnapshotOffer)
+		err  = unmarshalEnvelope(data, resp, msgTypeReplicationSnapshotOffer)
+	)
+	return resp, err
+}
+
+// UnmarshalSnapshotChunkRequest deserializes a Liftbridge SnapshotChunkRequest
+// envelope into a protobuf message.
+func UnmarshalSnapshotChunkRequest(data []byte) (*SnapshotChunkRequest, error) {
+	var (
+		req = new(SnapshotChunkRequest)
+		err = unmarshalEnvelope(data, req, msgTypeSnapshotChunkRequest)
+	)
+	return req, err
+}
+
+// UnmarshalSnapshotChunkResponse deserializes a Liftbridge
+// SnapshotChunkResponse envelope into a protobuf message.
+func UnmarshalSnapshotChunkResponse(data []byte) (*SnapshotChunkResponse, error) {
+	var (
+		resp = new(SnapshotChunkResponse)
+		err  = unmarshalEnvelope(data, resp, msgTypeSnapshotChunkResponse)
+	)
+	return resp, err
+}
+
+// UnmarshalCursorRequest deserializes a Liftbridge CursorRequest envelope into
+// a protobuf message.
+func UnmarshalCursorRequest(data []byte) (*CursorRequest, error) {
+	var (
+		req = new(CursorRequest)
+		err = unmarshalEnvelope(data, req, msgTypeCursorRequest)
+	)
+	return req, err
+}
+
+// UnmarshalCursorResponse deserializes a Liftbridge CursorResponse envelope
+// into a protobuf message.
+func UnmarshalCursorResponse(data []byte) (*CursorResponse, error) {
+	var (
+		resp = new(CursorResponse)
+		err  = unmarshalEnvelope(data, resp, msgTypeCursorResponse)
+	)
+	return resp, err
+}
+
+// UnmarshalPartitionCleanRequest deserializes a Liftbridge
+// PartitionCleanRequest envelope into a protobuf message.
+func UnmarshalPartitionCleanRequest(data []byte) (*PartitionCleanRequest, error) {
+	var (
+		req = new(PartitionCleanRequest)
+		err = unmarshalEnvelope(data, req, msgTypePartitionCleanRequest)
+	)
+	return req, err
+}
+
+// UnmarshalPartitionCleanResponse deserializes a Liftbridge
+// PartitionCleanResponse envelope into a protobuf message.
+func UnmarshalPartitionCleanResponse(data []byte) (*PartitionCleanResponse, error) {
+	var (
+		resp = new(PartitionCleanResponse)
+		err  = unmarshalEnvelope(data, resp, msgTypePartitionCleanResponse)
+	)
+	return resp, err
+}
+
 // UnmarshalLeaderEpochOffsetRequest deserializes a Liftbridge
 // LeaderEpochOffsetRequest envelope into a protobuf message.
 func UnmarshalLeaderEpochOffsetRequest(data []byte) (*LeaderEpochOffsetRequest, error) {
@@ -359,18 +571,12 @@ func unmarshalEnvelope(data []byte, msg pb.Message, msgType msgType) error {
 }
 
 func checkEnvelope(data []byte, expectedType msgType) ([]byte, error) {
-	if len(data) < envelopeMinHeaderLen {
-		return nil, errors.New("data missing envelope header")
-	}
-	if !bytes.Equal(data[:envelopeMagicNumberLen], envelopeMagicNumber) {
-		return nil, errors.New("unexpected envelope magic number")
-	}
-	if data[4] != envelopeProtoV0 {
-		return nil, fmt.Errorf("unknown envelope protocol: %v", data[4])
+	headerLen, err := checkEnvelopeHeader(data)
+	if err != nil {
+		return nil, err
 	}
 
 	var (
-		headerLen  = int(data[5])
 		flags      = data[6]
 		actualType = msgType(data[7])
 		payload    = data[headerLen:]
@@ -381,12 +587,8 @@ func checkEnvelope(data []byte, expectedType msgType) ([]byte, error) {
 	}
 
 	// Check CRC.
-	if hasBit(flags, 0) {
-		// Make sure there is a CRC present.
-		if headerLen != envelopeMinHeaderLen+4 {
-			return nil, errors.New("incorrect envelope header size")
-		}
-		crc := Encoding.Uint32(data[envelopeMinHeaderLen:headerLen])
+	if hasBit(flags, envelopeFlagCRC) {
+		crc := Encoding.Uint32(data[envelopeMinHeaderLen : envelopeMinHeaderLen+envelopeCRCLen])
 		if c := crc32.Checksum(payload, crc32cTable); c != crc {
 			return nil, fmt.Errorf("crc mismatch: expected %d, got %d", crc, c)
 		}
@@ -395,8 +597,122 @@ func checkEnvelope(data []byte, expectedType msgType) ([]byte, error) {
 	return payload, nil
 }
 
+// checkEnvelopeHeader validates the envelope magic number, version, and
+// header length and returns the header length.
+func checkEnvelopeHeader(data []byte) (int, error) {
+	if len(data) < envelopeMinHeaderLen {
+		return 0, errors.New("data missing envelope header")
+	}
+	if !bytes.Equal(data[:envelopeMagicNumberLen], envelopeMagicNumber) {
+		return 0, errors.New("unexpected envelope magic number")
+	}
+	if data[4] != envelopeProtoV0 {
+		return 0, fmt.Errorf("unknown envelope protocol: %v", data[4])
+	}
+
+	var (
+		headerLen = int(data[5])
+		flags     = data[6]
+	)
+	// Make sure the CRC and HMAC are present if their flags are set.
+	if hasBit(flags, envelopeFlagCRC) || hasBit(flags, envelopeFlagHMAC) {
+		if headerLen != envelopeHeaderLen(flags) {
+			return 0, errors.New("incorrect envelope header size")
+		}
+	}
+	if headerLen > len(data) {
+		return 0, errors.New("data missing envelope header")
+	}
+	return headerLen, nil
+}
+
+// envelopeHeaderLen returns the expected header length for the given flags.
+func envelopeHeaderLen(flags byte) int {
+	headerLen := envelopeMinHeaderLen
+	if hasBit(flags, envelopeFlagCRC) {
+		headerLen += envelopeCRCLen
+	}
+	if hasBit(flags, envelopeFlagHMAC) {
+		headerLen += envelopeHMACLen
+	}
+	return headerLen
+}
+
+// SignEnvelope returns a copy of the envelope with an HMAC-SHA256 of the
+// header and payload, computed with the given key, added to the header. This
+// is used to authenticate messages sent between brokers on internal subjects.
+// An error is returned if the envelope is invalid or is already signed.
+func SignEnvelope(data, key []byte) ([]byte, error) {
+	headerLen, err := checkEnvelopeHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	flags := data[6]
+	if hasBit(flags, envelopeFlagHMAC) {
+		return nil, errors.New("envelope already signed")
+	}
+
+	var (
+		payload      = data[headerLen:]
+		newFlags     = setBit(flags, envelopeFlagHMAC)
+		newHeaderLen = envelopeHeaderLen(newFlags)
+		buf          = make([]byte, newHeaderLen+len(payload))
+	)
+	if hasBit(flags, envelopeFlagCRC) {
+		copy(buf, data[:headerLen])
+	} else {
+		copy(buf, data[:envelopeMinHeaderLen])
+	}
+	buf[5] = byte(newHeaderLen)
+	buf[6] = newFlags
+	copy(buf[newHeaderLen:], payload)
+	macPos := newHeaderLen - envelopeHMACLen
+	copy(buf[macPos:newHeaderLen], computeEnvelopeHMAC(buf, macPos, newHeaderLen, key))
+	return buf, nil
+}
+
+// VerifyEnvelope checks the HMAC of a signed envelope against each of the
+// given keys. Multiple keys allow keys to be rotated without dropping
+// messages signed with the previous key. The returned bool indicates if the
+// envelope is signed. If it is signed but the HMAC does not match any key,
+// ErrEnvelopeAuthFailed is returned. Unsigned envelopes are not considered an
+// error, it is up to the caller to decide whether to accept them.
+func VerifyEnvelope(data []byte, keys [][]byte) (bool, error) {
+	headerLen, err := checkEnvelopeHeader(data)
+	if err != nil {
+		return false, err
+	}
+	if !hasBit(data[6], envelopeFlagHMAC) {
+		return false, nil
+	}
+	var (
+		macPos = headerLen - envelopeHMACLen
+		mac    = data[macPos:headerLen]
+	)
+	for _, key := range keys {
+		if hmac.Equal(mac, computeEnvelopeHMAC(data, macPos, headerLen, key)) {
+			return true, nil
+		}
+	}
+	return true, ErrEnvelopeAuthFailed
+}
+
+// computeEnvelopeHMAC computes the HMAC-SHA256 of the envelope header up to
+// the HMAC field along with the payload.
+func computeEnvelopeHMAC(data []byte, macPos, headerLen int, key []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data[:macPos])
+	h.Write(data[headerLen:])
+	return h.Sum(nil)
+}
+
 // hasBit checks if the given bit position is set on the provided byte.
 func hasBit(n byte, pos uint8) bool {
 	val := n & (1 << pos)
 	return (val > 0)
 }
+
+// setBit sets the given bit position on the provided byte.
+func setBit(n byte, pos uint8) byte {
+	return n | (1 << pos)
+}
@@ -240,6 +240,190 @@ func TestMarshalUnmarshalPartitionNotification(t *testing.T) {
 	require.Equal(t, req, unmarshaled)
 }
 
+// Ensure we can marshal a PartitionStarted and then unmarshal it.
+func TestMarshalUnmarshalPartitionStarted(t *testing.T) {
+	req := &PartitionStarted{
+		Stream:      "foo",
+		Partition:   2,
+		Leader:      "a",
+		LeaderEpoch: 3,
+	}
+	envelope, err := MarshalPartitionStarted(req)
+	require.NoError(t, err)
+
+	unmarshaled, err := UnmarshalPartitionStarted(envelope)
+	require.NoError(t, err)
+
+	require.Equal(t, req, unmarshaled)
+}
+
+// Ensure we can marshal a PartitionLoadReport and then unmarshal it.
+func TestMarshalUnmarshalPartitionLoadReport(t *testing.T) {
+	req := &PartitionLoadReport{
+		Id: "a",
+		Partitions: []*PartitionLoad{
+			{
+				Stream:         "foo",
+				Partition:      1,
+				MessagesInRate: 100,
+				TopKeys:        []*KeyCount{{Key: []byte("bar"), Count: 42}},
+			},
+		},
+	}
+	envelope, err := MarshalPartitionLoadReport(req)
+	require.NoError(t, err)
+
+	unmarshaled, err := UnmarshalPartitionLoadReport(envelope)
+	require.NoError(t, err)
+
+	require.Equal(t, req, unmarshaled)
+}
+
+// Ensure we can marshal a BootstrapProbe and then unmarshal it.
+func TestMarshalUnmarshalBootstrapProbe(t *testing.T) {
+	req := &BootstrapProbe{ServerID: "foo"}
+	envelope, err := MarshalBootstrapProbe(req)
+	require.NoError(t, err)
+
+	unmarshaled, err := UnmarshalBootstrapProbe(envelope)
+	require.NoError(t, err)
+
+	require.Equal(t, req, unmarshaled)
+}
+
+// Ensure we can marshal a ReplicationOffsetOutOfRange and then unmarshal it,
+// and that it isn't mistaken for a replication response.
+func TestMarshalUnmarshalReplicationOffsetOutOfRange(t *testing.T) {
+	resp := &ReplicationOffsetOutOfRange{
+		LeaderEpoch:    3,
+		LogStartOffset: 42,
+		HighWatermark:  50,
+	}
+	envelope, err := MarshalReplicationOffsetOutOfRange(resp)
+	require.NoError(t, err)
+
+	unmarshaled, err := UnmarshalReplicationOffsetOutOfRange(envelope)
+	require.NoError(t, err)
+	require.Equal(t, resp, unmarshaled)
+
+	_, _, _, err = UnmarshalReplicationResponse(envelope)
+	require.Error(t, err)
+}
+
+// Ensure we can marshal a ReplicationSnapshotOffer and then unmarshal it,
+// and that it isn't mistaken for a replication response.
+func TestMarshalUnmarshalReplicationSnapshotOffer(t *testing.T) {
+	resp := &ReplicationSnapshotOffer{
+		LeaderEpoch: 3,
+		SnapshotID:  "foo",
+		Segments: []*SnapshotSegment{
+			{BaseOffset: 10, LastOffset: 19, LogSize: 1024, IndexSize: 200},
+			{BaseOffset: 20, LastOffset: 29, LogSize: 2048, IndexSize: 200},
+		},
+		EpochOffsets:  []*EpochOffset{{LeaderEpoch: 2, StartOffset: 5}},
+		HighWatermark: 50,
+	}
+	envelope, err := MarshalReplicationSnapshotOffer(resp)
+	require.NoError(t, err)
+
+	unmarshaled, err := UnmarshalReplicationSnapshotOffer(envelope)
+	require.NoError(t, err)
+	require.Equal(t, resp, unmarshaled)
+
+	_, _, _, err = UnmarshalReplicationResponse(envelope)
+	require.Error(t, err)
+	_, err = UnmarshalReplicationOffsetOutOfRange(envelope)
+	require.Error(t, err)
+}
+
+// Ensure we can marshal a SnapshotChunkRequest and SnapshotChunkResponse and
+// then unmarshal them.
+func TestMarshalUnmarshalSnapshotChunk(t *testing.T) {
+	req := &SnapshotChunkRequest{
+		ReplicaID:  "a",
+		SnapshotID: "foo",
+		BaseOffset: 10,
+		File:       SnapshotFileType_SEGMENT_INDEX,
+		Position:   100,
+		MaxBytes:   1024,
+	}
+	envelope, err := MarshalSnapshotChunkRequest(req)
+	require.NoError(t, err)
+	unmarshaledReq, err := UnmarshalSnapshotChunkRequest(envelope)
+	require.NoError(t, err)
+	require.Equal(t, req, unmarshaledReq)
+
+	resp := &SnapshotChunkResponse{
+		Data:     []byte("hello"),
+		Last:     true,
+		Checksum: 42,
+	}
+	envelope, err = MarshalSnapshotChunkResponse(resp)
+	require.NoError(t, err)
+	unmarshaledResp, err := UnmarshalSnapshotChunkResponse(envelope)
+	require.NoError(t, err)
+	require.Equal(t, resp, unmarshaledResp)
+}
+
+// Ensure we can marshal a CursorRequest and CursorResponse and then unmarshal
+// them.
+func TestMarshalUnmarshalCursor(t *testing.T) {
+	req := &CursorRequest{
+		Cursor: &Cursor{
+			Stream:    "foo",
+			Partition: 1,
+			CursorId:  "bar",
+			Offset:    42,
+		},
+		Set: true,
+	}
+	envelope, err := MarshalCursorRequest(req)
+	require.NoError(t, err)
+	unmarshaledReq, err := UnmarshalCursorRequest(envelope)
+	require.NoError(t, err)
+	require.Equal(t, req, unmarshaledReq)
+
+	resp := &CursorResponse{
+		Offset: -1,
+		Error:  &Error{Code: 9, Msg: "not leader"},
+	}
+	envelope, err = MarshalCursorResponse(resp)
+	require.NoError(t, err)
+	unmarshaledResp, err := UnmarshalCursorResponse(envelope)
+	require.NoError(t, err)
+	require.Equal(t, resp, unmarshaledResp)
+}
+
+// Ensure we can marshal a PartitionCleanRequest and PartitionCleanResponse and
+// then unmarshal them.
+func TestMarshalUnmarshalPartitionClean(t *testing.T) {
+	req := &PartitionCleanRequest{
+		Stream:    "foo",
+		Partition: 1,
+	}
+	envelope, err := MarshalPartitionCleanRequest(req)
+	require.NoError(t, err)
+	unmarshaledReq, err := UnmarshalPartitionCleanRequest(envelope)
+	require.NoError(t, err)
+	require.Equal(t, req, unmarshaledReq)
+
+	resp := &PartitionCleanResponse{
+		Result: &PartitionCleanResult{
+			Broker:         "a",
+			SegmentsBefore: 3,
+			SegmentsAfter:  1,
+			BytesReclaimed: 1024,
+			KeysCompacted:  10,
+			CompactEnabled: true,
+		},
+	}
+	envelope, err = MarshalPartitionCleanResponse(resp)
+	require.NoError(t, err)
+	unmarshaledResp, err := UnmarshalPartitionCleanResponse(envelope)
+	require.NoError(t, err)
+	require.Equal(t, resp, unmarshaledResp)
+}
+
 // Ensure we can marshal a RaftJoinRequest and then unmarshal it.
 func TestMarshalUnmarshalRaftJoinRequest(t *testing.T) {
 	req := &RaftJoinRequest{
@@ -324,9 +508,92 @@ func TestUnmarshalEnvelopeMismatchedType(t *testing.T) {
 	require.Error(t, err)
 }
 
-func setBit(n byte, pos uint8) byte {
-	n |= (1 << pos)
-	return n
+// Ensure a signed envelope can be verified and unmarshaled.
+func TestSignVerifyEnvelope(t *testing.T) {
+	req := &PropagatedRequest{
+		Op: Op_SHRINK_ISR,
+		ShrinkISROp: &ShrinkISROp{
+			Stream:          "foo",
+			ReplicaToRemove: "b",
+			Leader:          "a",
+		},
+	}
+	envelope, err := MarshalPropagatedRequest(req)
+	require.NoError(t, err)
+
+	signed, err := SignEnvelope(envelope, []byte("key"))
+	require.NoError(t, err)
+	require.Len(t, signed, len(envelope)+envelopeHMACLen)
+
+	isSigned, err := VerifyEnvelope(signed, [][]byte{[]byte("key")})
+	require.NoError(t, err)
+	require.True(t, isSigned)
+
+	unmarshaled, err := UnmarshalPropagatedRequest(signed)
+	require.NoError(t, err)
+	require.Equal(t, req, unmarshaled)
+
+	// Signing twice is not allowed.
+	_, err = SignEnvelope(signed, []byte("key"))
+	require.Error(t, err)
+
+	// Unsigned envelopes are reported as such.
+	isSigned, err = VerifyEnvelope(envelope, [][]byte{[]byte("key")})
+	require.NoError(t, err)
+	require.False(t, isSigned)
+}
+
+// Ensure VerifyEnvelope accepts any of the given keys so keys can be rotated.
+func TestVerifyEnvelopeKeyRotation(t *testing.T) {
+	envelope, err := MarshalServerInfoResponse(&ServerInfoResponse{Id: "a"})
+	require.NoError(t, err)
+
+	signedOld, err := SignEnvelope(envelope, []byte("old"))
+	require.NoError(t, err)
+	signedNew, err := SignEnvelope(envelope, []byte("new"))
+	require.NoError(t, err)
+
+	keys := [][]byte{[]byte("new"), []byte("old")}
+	_, err = VerifyEnvelope(signedOld, keys)
+	require.NoError(t, err)
+	_, err = VerifyEnvelope(signedNew, keys)
+	require.NoError(t, err)
+
+	// Once the old key is retired, messages signed with it are rejected.
+	isSigned, err := VerifyEnvelope(signedOld, [][]byte{[]byte("new")})
+	require.True(t, isSigned)
+	require.Equal(t, ErrEnvelopeAuthFailed, err)
+}
+
+// Ensure VerifyEnvelope detects a tampered payload or header.
+func TestVerifyEnvelopeTampered(t *testing.T) {
+	envelope, err := MarshalServerInfoResponse(&ServerInfoResponse{Id: "a", Port: 1})
+	require.NoError(t, err)
+	signed, err := SignEnvelope(envelope, []byte("key"))
+	require.NoError(t, err)
+
+	tampered := append([]byte{}, signed...)
+	tampered[len(tampered)-1]++
+	_, err = VerifyEnvelope(tampered, [][]byte{[]byte("key")})
+	require.Equal(t, ErrEnvelopeAuthFailed, err)
+
+	// Changing the message type invalidates the HMAC.
+	tampered = append([]byte{}, signed...)
+	tampered[7] = byte(msgTypeServerInfoRequest)
+	_, err = VerifyEnvelope(tampered, [][]byte{[]byte("key")})
+	require.Equal(t, ErrEnvelopeAuthFailed, err)
+}
+
+// Ensure unmarshalEnvelope returns an error if the HMAC flag is set but no
+// HMAC is present.
+func TestUnmarshalEnvelopeMissingHMAC(t *testing.T) {
+	msg, err := MarshalPublish(new(client.Message))
+	require.NoError(t, err)
+	msg[6] = setBit(msg[6], envelopeFlagHMAC)
+	_, err = UnmarshalPublish(msg)
+	require.Error(t, err)
+	_, err = VerifyEnvelope(msg, [][]byte{[]byte("key")})
+	require.Error(t, err)
 }
 
 // Ensure UnmarshalReplicationResponse returns an error if the payload is too
@@ -4,6 +4,7 @@
 package protocol
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	io "io"
@@ -40,6 +41,22 @@ const (
 	Op_LEAVE_CONSUMER_GROUP              Op = 12
 	Op_REPORT_CONSUMER_GROUP_COORDINATOR Op = 13
 	Op_CHANGE_CONSUMER_GROUP_COORDINATOR Op = 14
+	Op_UPDATE_STREAM_OWNER               Op = 15
+	Op_REPORT_PARTITION_SKEW             Op = 16
+	Op_REGISTER_PRODUCER                 Op = 17
+	Op_RELEASE_PRODUCER                  Op = 18
+	Op_SET_SERVER_REMOVED                Op = 19
+	Op_SCHEDULE_OPERATION                Op = 20
+	Op_CANCEL_SCHEDULED_OPERATION        Op = 21
+	Op_EXECUTE_SCHEDULED_OPERATION       Op = 22
+	Op_UPDATE_STREAM_CONFIG              Op = 23
+	Op_SET_STREAM_TAGS                   Op = 24
+	Op_DELETE_PARTITION_DATA             Op = 25
+	Op_SET_STREAM_ACL                    Op = 26
+	Op_REPLACE_REPLICA                   Op = 27
+	Op_SET_PRODUCER_QUOTA                Op = 28
+	Op_DELETE_PRODUCER_QUOTA             Op = 29
+	Op_DELETE_PARTITION                  Op = 30
 )
 
 var Op_name = map[int32]string{
@@ -58,6 +75,22 @@ var Op_name = map[int32]string{
 	12: "LEAVE_CONSUMER_GROUP",
 	13: "REPORT_CONSUMER_GROUP_COORDINATOR",
 	14: "CHANGE_CONSUMER_GROUP_COORDINATOR",
+	15: "UPDATE_STREAM_OWNER",
+	16: "REPORT_PARTITION_SKEW",
+	17: "REGISTER_PRODUCER",
+	18: "RELEASE_PRODUCER",
+	19: "SET_SERVER_REMOVED",
+	20: "SCHEDULE_OPERATION",
+	21: "CANCEL_SCHEDULED_OPERATION",
+	22: "EXECUTE_SCHEDULED_OPERATION",
+	23: "UPDATE_STREAM_CONFIG",
+	24: "SET_STREAM_TAGS",
+	25: "DELETE_PARTITION_DATA",
+	26: "SET_STREAM_ACL",
+	27: "REPLACE_REPLICA",
+	28: "SET_PRODUCER_QUOTA",
+	29: "DELETE_PRODUCER_QUOTA",
+	30: "DELETE_PARTITION",
 }
 
 var Op_value = map[string]int32{
@@ -76,6 +109,22 @@ var Op_value = map[string]int32{
 	"LEAVE_CONSUMER_GROUP":              12,
 	"REPORT_CONSUMER_GROUP_COORDINATOR": 13,
 	"CHANGE_CONSUMER_GROUP_COORDINATOR": 14,
+	"UPDATE_STREAM_OWNER":               15,
+	"REPORT_PARTITION_SKEW":             16,
+	"REGISTER_PRODUCER":                 17,
+	"RELEASE_PRODUCER":                  18,
+	"SET_SERVER_REMOVED":                19,
+	"SCHEDULE_OPERATION":                20,
+	"CANCEL_SCHEDULED_OPERATION":        21,
+	"EXECUTE_SCHEDULED_OPERATION":       22,
+	"UPDATE_STREAM_CONFIG":              23,
+	"SET_STREAM_TAGS":                   24,
+	"DELETE_PARTITION_DATA":             25,
+	"SET_STREAM_ACL":                    26,
+	"REPLACE_REPLICA":                   27,
+	"SET_PRODUCER_QUOTA":                28,
+	"DELETE_PRODUCER_QUOTA":             29,
+	"DELETE_PARTITION":                  30,
 }
 
 func (x Op) String() string {
@@ -86,6 +135,140 @@ func (Op) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7d9410777bf851c3, []int{0}
 }
 
+type SnapshotFileType int32
+
+const (
+	SnapshotFileType_SEGMENT_LOG   SnapshotFileType = 0
+	SnapshotFileType_SEGMENT_INDEX SnapshotFileType = 1
+)
+
+var SnapshotFileType_name = map[int32]string{
+	0: "SEGMENT_LOG",
+	1: "SEGMENT_INDEX",
+}
+
+var SnapshotFileType_value = map[string]int32{
+	"SEGMENT_LOG":   0,
+	"SEGMENT_INDEX": 1,
+}
+
+func (x SnapshotFileType) String() string {
+	return proto.EnumName(SnapshotFileType_name, int32(x))
+}
+
+func (SnapshotFileType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{1}
+}
+
+// PartitionState is the lifecycle state of a partition on a broker.
+type PartitionState int32
+
+const (
+	PartitionState_PARTITION_STATE_NOT_FOUND  PartitionState = 0
+	PartitionState_PARTITION_STATE_CREATING   PartitionState = 1
+	PartitionState_PARTITION_STATE_LEADER     PartitionState = 2
+	PartitionState_PARTITION_STATE_FOLLOWER   PartitionState = 3
+	PartitionState_PARTITION_STATE_PAUSED     PartitionState = 4
+	PartitionState_PARTITION_STATE_READONLY   PartitionState = 5
+	PartitionState_PARTITION_STATE_RECOVERING PartitionState = 6
+	PartitionState_PARTITION_STATE_TOMBSTONED PartitionState = 7
+)
+
+var PartitionState_name = map[int32]string{
+	0: "PARTITION_STATE_NOT_FOUND",
+	1: "PARTITION_STATE_CREATING",
+	2: "PARTITION_STATE_LEADER",
+	3: "PARTITION_STATE_FOLLOWER",
+	4: "PARTITION_STATE_PAUSED",
+	5: "PARTITION_STATE_READONLY",
+	6: "PARTITION_STATE_RECOVERING",
+	7: "PARTITION_STATE_TOMBSTONED",
+}
+
+var PartitionState_value = map[string]int32{
+	"PARTITION_STATE_NOT_FOUND":  0,
+	"PARTITION_STATE_CREATING":   1,
+	"PARTITION_STATE_LEADER":     2,
+	"PARTITION_STATE_FOLLOWER":   3,
+	"PARTITION_STATE_PAUSED":     4,
+	"PARTITION_STATE_READONLY":   5,
+	"PARTITION_STATE_RECOVERING": 6,
+	"PARTITION_STATE_TOMBSTONED": 7,
+}
+
+func (x PartitionState) String() string {
+	return proto.EnumName(PartitionState_name, int32(x))
+}
+
+func (PartitionState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{2}
+}
+
+// ScheduledOperationType is the stream operation a ScheduledOperation
+// performs.
+type ScheduledOperationType int32
+
+const (
+	ScheduledOperationType_SCHEDULED_PAUSE_STREAM        ScheduledOperationType = 0
+	ScheduledOperationType_SCHEDULED_RESUME_STREAM       ScheduledOperationType = 1
+	ScheduledOperationType_SCHEDULED_SET_STREAM_READONLY ScheduledOperationType = 2
+	ScheduledOperationType_SCHEDULED_DELETE_STREAM       ScheduledOperationType = 3
+)
+
+var ScheduledOperationType_name = map[int32]string{
+	0: "SCHEDULED_PAUSE_STREAM",
+	1: "SCHEDULED_RESUME_STREAM",
+	2: "SCHEDULED_SET_STREAM_READONLY",
+	3: "SCHEDULED_DELETE_STREAM",
+}
+
+var ScheduledOperationType_value = map[string]int32{
+	"SCHEDULED_PAUSE_STREAM":        0,
+	"SCHEDULED_RESUME_STREAM":       1,
+	"SCHEDULED_SET_STREAM_READONLY": 2,
+	"SCHEDULED_DELETE_STREAM":       3,
+}
+
+func (x ScheduledOperationType) String() string {
+	return proto.EnumName(ScheduledOperationType_name, int32(x))
+}
+
+func (ScheduledOperationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{3}
+}
+
+// ScheduledOperationState is the lifecycle state of a ScheduledOperation.
+type ScheduledOperationState int32
+
+const (
+	ScheduledOperationState_SCHEDULED_OPERATION_PENDING   ScheduledOperationState = 0
+	ScheduledOperationState_SCHEDULED_OPERATION_SUCCEEDED ScheduledOperationState = 1
+	ScheduledOperationState_SCHEDULED_OPERATION_FAILED    ScheduledOperationState = 2
+	ScheduledOperationState_SCHEDULED_OPERATION_CANCELED  ScheduledOperationState = 3
+)
+
+var ScheduledOperationState_name = map[int32]string{
+	0: "SCHEDULED_OPERATION_PENDING",
+	1: "SCHEDULED_OPERATION_SUCCEEDED",
+	2: "SCHEDULED_OPERATION_FAILED",
+	3: "SCHEDULED_OPERATION_CANCELED",
+}
+
+var ScheduledOperationState_value = map[string]int32{
+	"SCHEDULED_OPERATION_PENDING":   0,
+	"SCHEDULED_OPERATION_SUCCEEDED": 1,
+	"SCHEDULED_OPERATION_FAILED":    2,
+	"SCHEDULED_OPERATION_CANCELED":  3,
+}
+
+func (x ScheduledOperationState) String() string {
+	return proto.EnumName(ScheduledOperationState_name, int32(x))
+}
+
+func (ScheduledOperationState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{4}
+}
+
 type ServerState struct {
 	ServerID             string   `protobuf:"bytes,1,opt,name=serverID,proto3" json:"serverID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -148,6 +331,22 @@ type RaftLog struct {
 	JoinConsumerGroupOp              *JoinConsumerGroupOp              `protobuf:"bytes,12,opt,name=joinConsumerGroupOp,proto3" json:"joinConsumerGroupOp,omitempty"`
 	LeaveConsumerGroupOp             *LeaveConsumerGroupOp             `protobuf:"bytes,13,opt,name=leaveConsumerGroupOp,proto3" json:"leaveConsumerGroupOp,omitempty"`
 	ChangeConsumerGroupCoordinatorOp *ChangeConsumerGroupCoordinatorOp `protobuf:"bytes,14,opt,name=changeConsumerGroupCoordinatorOp,proto3" json:"changeConsumerGroupCoordinatorOp,omitempty"`
+	UpdateStreamOwnerOp              *UpdateStreamOwnerOp              `protobuf:"bytes,15,opt,name=updateStreamOwnerOp,proto3" json:"updateStreamOwnerOp,omitempty"`
+	ReportPartitionSkewOp            *ReportPartitionSkewOp            `protobuf:"bytes,16,opt,name=reportPartitionSkewOp,proto3" json:"reportPartitionSkewOp,omitempty"`
+	RegisterProducerOp               *RegisterProducerOp               `protobuf:"bytes,17,opt,name=registerProducerOp,proto3" json:"registerProducerOp,omitempty"`
+	ReleaseProducerOp                *ReleaseProducerOp                `protobuf:"bytes,18,opt,name=releaseProducerOp,proto3" json:"releaseProducerOp,omitempty"`
+	SetServerRemovedOp               *SetServerRemovedOp               `protobuf:"bytes,19,opt,name=setServerRemovedOp,proto3" json:"setServerRemovedOp,omitempty"`
+	ScheduleOperationOp              *ScheduleOperationOp              `protobuf:"bytes,20,opt,name=scheduleOperationOp,proto3" json:"scheduleOperationOp,omitempty"`
+	CancelScheduledOperationOp       *CancelScheduledOperationOp       `protobuf:"bytes,21,opt,name=cancelScheduledOperationOp,proto3" json:"cancelScheduledOperationOp,omitempty"`
+	ExecuteScheduledOperationOp      *ExecuteScheduledOperationOp      `protobuf:"bytes,22,opt,name=executeScheduledOperationOp,proto3" json:"executeScheduledOperationOp,omitempty"`
+	UpdateStreamConfigOp             *UpdateStreamConfigOp             `protobuf:"bytes,23,opt,name=updateStreamConfigOp,proto3" json:"updateStreamConfigOp,omitempty"`
+	SetStreamTagsOp                  *SetStreamTagsOp                  `protobuf:"bytes,24,opt,name=setStreamTagsOp,proto3" json:"setStreamTagsOp,omitempty"`
+	DeletePartitionDataOp            *DeletePartitionDataOp            `protobuf:"bytes,25,opt,name=deletePartitionDataOp,proto3" json:"deletePartitionDataOp,omitempty"`
+	SetStreamACLOp                   *SetStreamACLOp                   `protobuf:"bytes,26,opt,name=setStreamACLOp,proto3" json:"setStreamACLOp,omitempty"`
+	ReplaceReplicaOp                 *ReplaceReplicaOp                 `protobuf:"bytes,27,opt,name=replaceReplicaOp,proto3" json:"replaceReplicaOp,omitempty"`
+	SetProducerQuotaOp               *SetProducerQuotaOp               `protobuf:"bytes,28,opt,name=setProducerQuotaOp,proto3" json:"setProducerQuotaOp,omitempty"`
+	DeleteProducerQuotaOp            *DeleteProducerQuotaOp            `protobuf:"bytes,29,opt,name=deleteProducerQuotaOp,proto3" json:"deleteProducerQuotaOp,omitempty"`
+	DeletePartitionOp                *DeletePartitionOp                `protobuf:"bytes,30,opt,name=deletePartitionOp,proto3" json:"deletePartitionOp,omitempty"`
 	XXX_NoUnkeyedLiteral             struct{}                          `json:"-"`
 	XXX_unrecognized                 []byte                            `json:"-"`
 	XXX_sizecache                    int32                             `json:"-"`
@@ -284,11 +483,125 @@ func (m *RaftLog) GetChangeConsumerGroupCoordinatorOp() *ChangeConsumerGroupCoor
 	return nil
 }
 
+func (m *RaftLog) GetUpdateStreamOwnerOp() *UpdateStreamOwnerOp {
+	if m != nil {
+		return m.UpdateStreamOwnerOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetReportPartitionSkewOp() *ReportPartitionSkewOp {
+	if m != nil {
+		return m.ReportPartitionSkewOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetRegisterProducerOp() *RegisterProducerOp {
+	if m != nil {
+		return m.RegisterProducerOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetReleaseProducerOp() *ReleaseProducerOp {
+	if m != nil {
+		return m.ReleaseProducerOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetSetServerRemovedOp() *SetServerRemovedOp {
+	if m != nil {
+		return m.SetServerRemovedOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetScheduleOperationOp() *ScheduleOperationOp {
+	if m != nil {
+		return m.ScheduleOperationOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetCancelScheduledOperationOp() *CancelScheduledOperationOp {
+	if m != nil {
+		return m.CancelScheduledOperationOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetExecuteScheduledOperationOp() *ExecuteScheduledOperationOp {
+	if m != nil {
+		return m.ExecuteScheduledOperationOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetUpdateStreamConfigOp() *UpdateStreamConfigOp {
+	if m != nil {
+		return m.UpdateStreamConfigOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetSetStreamTagsOp() *SetStreamTagsOp {
+	if m != nil {
+		return m.SetStreamTagsOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetDeletePartitionDataOp() *DeletePartitionDataOp {
+	if m != nil {
+		return m.DeletePartitionDataOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetSetStreamACLOp() *SetStreamACLOp {
+	if m != nil {
+		return m.SetStreamACLOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetReplaceReplicaOp() *ReplaceReplicaOp {
+	if m != nil {
+		return m.ReplaceReplicaOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetSetProducerQuotaOp() *SetProducerQuotaOp {
+	if m != nil {
+		return m.SetProducerQuotaOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetDeleteProducerQuotaOp() *DeleteProducerQuotaOp {
+	if m != nil {
+		return m.DeleteProducerQuotaOp
+	}
+	return nil
+}
+
+func (m *RaftLog) GetDeletePartitionOp() *DeletePartitionOp {
+	if m != nil {
+		return m.DeletePartitionOp
+	}
+	return nil
+}
+
 type CreateStreamOp struct {
-	Stream               *Stream  `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Stream               *Stream   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Companions           []*Stream `protobuf:"bytes,2,rep,name=companions,proto3" json:"companions,omitempty"`
+	RequestID            string    `protobuf:"bytes,3,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *CreateStreamOp) Reset()         { *m = CreateStreamOp{} }
@@ -331,29 +644,43 @@ func (m *CreateStreamOp) GetStream() *Stream {
 	return nil
 }
 
-type ShrinkISROp struct {
-	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
-	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
-	ReplicaToRemove      string   `protobuf:"bytes,3,opt,name=replicaToRemove,proto3" json:"replicaToRemove,omitempty"`
-	Leader               string   `protobuf:"bytes,4,opt,name=leader,proto3" json:"leader,omitempty"`
-	LeaderEpoch          uint64   `protobuf:"varint,5,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+func (m *CreateStreamOp) GetCompanions() []*Stream {
+	if m != nil {
+		return m.Companions
+	}
+	return nil
+}
+
+func (m *CreateStreamOp) GetRequestID() string {
+	if m != nil {
+		return m.RequestID
+	}
+	return ""
+}
+
+// SetServerRemovedOp records that a server was removed from the metadata Raft
+// group by an operator, which stops it from rejoining automatically, or clears
+// the record when it's added back.
+type SetServerRemovedOp struct {
+	Server               string   `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Removed              bool     `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShrinkISROp) Reset()         { *m = ShrinkISROp{} }
-func (m *ShrinkISROp) String() string { return proto.CompactTextString(m) }
-func (*ShrinkISROp) ProtoMessage()    {}
-func (*ShrinkISROp) Descriptor() ([]byte, []int) {
+func (m *SetServerRemovedOp) Reset()         { *m = SetServerRemovedOp{} }
+func (m *SetServerRemovedOp) String() string { return proto.CompactTextString(m) }
+func (*SetServerRemovedOp) ProtoMessage()    {}
+func (*SetServerRemovedOp) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7d9410777bf851c3, []int{3}
 }
-func (m *ShrinkISROp) XXX_Unmarshal(b []byte) error {
+func (m *SetServerRemovedOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ShrinkISROp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetServerRemovedOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ShrinkISROp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetServerRemovedOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -363,76 +690,152 @@ func (m *ShrinkISROp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ShrinkISROp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShrinkISROp.Merge(m, src)
+func (m *SetServerRemovedOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetServerRemovedOp.Merge(m, src)
 }
-func (m *ShrinkISROp) XXX_Size() int {
+func (m *SetServerRemovedOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *ShrinkISROp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShrinkISROp.DiscardUnknown(m)
+func (m *SetServerRemovedOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetServerRemovedOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShrinkISROp proto.InternalMessageInfo
+var xxx_messageInfo_SetServerRemovedOp proto.InternalMessageInfo
 
-func (m *ShrinkISROp) GetStream() string {
+func (m *SetServerRemovedOp) GetServer() string {
 	if m != nil {
-		return m.Stream
+		return m.Server
 	}
 	return ""
 }
 
-func (m *ShrinkISROp) GetPartition() int32 {
+func (m *SetServerRemovedOp) GetRemoved() bool {
 	if m != nil {
-		return m.Partition
+		return m.Removed
 	}
-	return 0
+	return false
 }
 
-func (m *ShrinkISROp) GetReplicaToRemove() string {
-	if m != nil {
-		return m.ReplicaToRemove
+// ScheduleOperationOp records a stream operation to be executed by the
+// metadata leader once it's due.
+type ScheduleOperationOp struct {
+	Operation            *ScheduledOperation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ScheduleOperationOp) Reset()         { *m = ScheduleOperationOp{} }
+func (m *ScheduleOperationOp) String() string { return proto.CompactTextString(m) }
+func (*ScheduleOperationOp) ProtoMessage()    {}
+func (*ScheduleOperationOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{4}
+}
+func (m *ScheduleOperationOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleOperationOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleOperationOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *ScheduleOperationOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleOperationOp.Merge(m, src)
+}
+func (m *ScheduleOperationOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleOperationOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleOperationOp.DiscardUnknown(m)
 }
 
-func (m *ShrinkISROp) GetLeader() string {
+var xxx_messageInfo_ScheduleOperationOp proto.InternalMessageInfo
+
+func (m *ScheduleOperationOp) GetOperation() *ScheduledOperation {
 	if m != nil {
-		return m.Leader
+		return m.Operation
 	}
-	return ""
+	return nil
 }
 
-func (m *ShrinkISROp) GetLeaderEpoch() uint64 {
+// CancelScheduledOperationOp cancels a pending scheduled operation.
+type CancelScheduledOperationOp struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelScheduledOperationOp) Reset()         { *m = CancelScheduledOperationOp{} }
+func (m *CancelScheduledOperationOp) String() string { return proto.CompactTextString(m) }
+func (*CancelScheduledOperationOp) ProtoMessage()    {}
+func (*CancelScheduledOperationOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{5}
+}
+func (m *CancelScheduledOperationOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelScheduledOperationOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelScheduledOperationOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelScheduledOperationOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelScheduledOperationOp.Merge(m, src)
+}
+func (m *CancelScheduledOperationOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelScheduledOperationOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelScheduledOperationOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelScheduledOperationOp proto.InternalMessageInfo
+
+func (m *CancelScheduledOperationOp) GetId() uint64 {
 	if m != nil {
-		return m.LeaderEpoch
+		return m.Id
 	}
 	return 0
 }
 
-type ExpandISROp struct {
-	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
-	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
-	ReplicaToAdd         string   `protobuf:"bytes,3,opt,name=replicaToAdd,proto3" json:"replicaToAdd,omitempty"`
-	Leader               string   `protobuf:"bytes,4,opt,name=leader,proto3" json:"leader,omitempty"`
-	LeaderEpoch          uint64   `protobuf:"varint,5,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+// ExecuteScheduledOperationOp executes a pending scheduled operation and
+// records the outcome in the same operation, so it's executed at most once.
+type ExecuteScheduledOperationOp struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutedAt           int64    `protobuf:"varint,2,opt,name=executedAt,proto3" json:"executedAt,omitempty"`
+	ExecutedBy           string   `protobuf:"bytes,3,opt,name=executedBy,proto3" json:"executedBy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ExpandISROp) Reset()         { *m = ExpandISROp{} }
-func (m *ExpandISROp) String() string { return proto.CompactTextString(m) }
-func (*ExpandISROp) ProtoMessage()    {}
-func (*ExpandISROp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{4}
+func (m *ExecuteScheduledOperationOp) Reset()         { *m = ExecuteScheduledOperationOp{} }
+func (m *ExecuteScheduledOperationOp) String() string { return proto.CompactTextString(m) }
+func (*ExecuteScheduledOperationOp) ProtoMessage()    {}
+func (*ExecuteScheduledOperationOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{6}
 }
-func (m *ExpandISROp) XXX_Unmarshal(b []byte) error {
+func (m *ExecuteScheduledOperationOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ExpandISROp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExecuteScheduledOperationOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ExpandISROp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExecuteScheduledOperationOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -442,72 +845,62 @@ func (m *ExpandISROp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ExpandISROp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExpandISROp.Merge(m, src)
+func (m *ExecuteScheduledOperationOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteScheduledOperationOp.Merge(m, src)
 }
-func (m *ExpandISROp) XXX_Size() int {
+func (m *ExecuteScheduledOperationOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *ExpandISROp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExpandISROp.DiscardUnknown(m)
+func (m *ExecuteScheduledOperationOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteScheduledOperationOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExpandISROp proto.InternalMessageInfo
+var xxx_messageInfo_ExecuteScheduledOperationOp proto.InternalMessageInfo
 
-func (m *ExpandISROp) GetStream() string {
+func (m *ExecuteScheduledOperationOp) GetId() uint64 {
 	if m != nil {
-		return m.Stream
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *ExpandISROp) GetPartition() int32 {
+func (m *ExecuteScheduledOperationOp) GetExecutedAt() int64 {
 	if m != nil {
-		return m.Partition
+		return m.ExecutedAt
 	}
 	return 0
 }
 
-func (m *ExpandISROp) GetReplicaToAdd() string {
+func (m *ExecuteScheduledOperationOp) GetExecutedBy() string {
 	if m != nil {
-		return m.ReplicaToAdd
+		return m.ExecutedBy
 	}
 	return ""
 }
 
-func (m *ExpandISROp) GetLeader() string {
-	if m != nil {
-		return m.Leader
-	}
-	return ""
-}
-
-func (m *ExpandISROp) GetLeaderEpoch() uint64 {
-	if m != nil {
-		return m.LeaderEpoch
-	}
-	return 0
-}
-
-type DeleteStreamOp struct {
-	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// UpdateStreamConfigOp changes the configuration of a stream. Settings set in
+// config replace the stream's current ones, while the others keep their
+// values.
+type UpdateStreamConfigOp struct {
+	Stream               string        `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Config               *StreamConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *DeleteStreamOp) Reset()         { *m = DeleteStreamOp{} }
-func (m *DeleteStreamOp) String() string { return proto.CompactTextString(m) }
-func (*DeleteStreamOp) ProtoMessage()    {}
-func (*DeleteStreamOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{5}
+func (m *UpdateStreamConfigOp) Reset()         { *m = UpdateStreamConfigOp{} }
+func (m *UpdateStreamConfigOp) String() string { return proto.CompactTextString(m) }
+func (*UpdateStreamConfigOp) ProtoMessage()    {}
+func (*UpdateStreamConfigOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{7}
 }
-func (m *DeleteStreamOp) XXX_Unmarshal(b []byte) error {
+func (m *UpdateStreamConfigOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteStreamOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateStreamConfigOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteStreamOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateStreamConfigOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -517,46 +910,55 @@ func (m *DeleteStreamOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *DeleteStreamOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteStreamOp.Merge(m, src)
+func (m *UpdateStreamConfigOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStreamConfigOp.Merge(m, src)
 }
-func (m *DeleteStreamOp) XXX_Size() int {
+func (m *UpdateStreamConfigOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteStreamOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteStreamOp.DiscardUnknown(m)
+func (m *UpdateStreamConfigOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStreamConfigOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteStreamOp proto.InternalMessageInfo
+var xxx_messageInfo_UpdateStreamConfigOp proto.InternalMessageInfo
 
-func (m *DeleteStreamOp) GetStream() string {
+func (m *UpdateStreamConfigOp) GetStream() string {
 	if m != nil {
 		return m.Stream
 	}
 	return ""
 }
 
-type PauseStreamOp struct {
+func (m *UpdateStreamConfigOp) GetConfig() *StreamConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// DeletePartitionDataOp advances the log start offset of a partition on each
+// of its replicas, deleting the messages before the offset.
+type DeletePartitionDataOp struct {
 	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
-	Partitions           []int32  `protobuf:"varint,2,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
-	ResumeAll            bool     `protobuf:"varint,3,opt,name=resumeAll,proto3" json:"resumeAll,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PauseStreamOp) Reset()         { *m = PauseStreamOp{} }
-func (m *PauseStreamOp) String() string { return proto.CompactTextString(m) }
-func (*PauseStreamOp) ProtoMessage()    {}
-func (*PauseStreamOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{6}
+func (m *DeletePartitionDataOp) Reset()         { *m = DeletePartitionDataOp{} }
+func (m *DeletePartitionDataOp) String() string { return proto.CompactTextString(m) }
+func (*DeletePartitionDataOp) ProtoMessage()    {}
+func (*DeletePartitionDataOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{8}
 }
-func (m *PauseStreamOp) XXX_Unmarshal(b []byte) error {
+func (m *DeletePartitionDataOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PauseStreamOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeletePartitionDataOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PauseStreamOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeletePartitionDataOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -566,59 +968,61 @@ func (m *PauseStreamOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *PauseStreamOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PauseStreamOp.Merge(m, src)
+func (m *DeletePartitionDataOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePartitionDataOp.Merge(m, src)
 }
-func (m *PauseStreamOp) XXX_Size() int {
+func (m *DeletePartitionDataOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *PauseStreamOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_PauseStreamOp.DiscardUnknown(m)
+func (m *DeletePartitionDataOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePartitionDataOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PauseStreamOp proto.InternalMessageInfo
+var xxx_messageInfo_DeletePartitionDataOp proto.InternalMessageInfo
 
-func (m *PauseStreamOp) GetStream() string {
+func (m *DeletePartitionDataOp) GetStream() string {
 	if m != nil {
 		return m.Stream
 	}
 	return ""
 }
 
-func (m *PauseStreamOp) GetPartitions() []int32 {
+func (m *DeletePartitionDataOp) GetPartition() int32 {
 	if m != nil {
-		return m.Partitions
+		return m.Partition
 	}
-	return nil
+	return 0
 }
 
-func (m *PauseStreamOp) GetResumeAll() bool {
+func (m *DeletePartitionDataOp) GetOffset() int64 {
 	if m != nil {
-		return m.ResumeAll
+		return m.Offset
 	}
-	return false
+	return 0
 }
 
-type ResumeStreamOp struct {
+// DeletePartitionOp deletes a single partition of a stream along with its data
+// on each of its replicas. The other partitions of the stream are unaffected.
+type DeletePartitionOp struct {
 	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
-	Partitions           []int32  `protobuf:"varint,2,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResumeStreamOp) Reset()         { *m = ResumeStreamOp{} }
-func (m *ResumeStreamOp) String() string { return proto.CompactTextString(m) }
-func (*ResumeStreamOp) ProtoMessage()    {}
-func (*ResumeStreamOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{7}
+func (m *DeletePartitionOp) Reset()         { *m = DeletePartitionOp{} }
+func (m *DeletePartitionOp) String() string { return proto.CompactTextString(m) }
+func (*DeletePartitionOp) ProtoMessage()    {}
+func (*DeletePartitionOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{9}
 }
-func (m *ResumeStreamOp) XXX_Unmarshal(b []byte) error {
+func (m *DeletePartitionOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResumeStreamOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeletePartitionOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ResumeStreamOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeletePartitionOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -628,55 +1032,53 @@ func (m *ResumeStreamOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ResumeStreamOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResumeStreamOp.Merge(m, src)
+func (m *DeletePartitionOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePartitionOp.Merge(m, src)
 }
-func (m *ResumeStreamOp) XXX_Size() int {
+func (m *DeletePartitionOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResumeStreamOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResumeStreamOp.DiscardUnknown(m)
+func (m *DeletePartitionOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePartitionOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResumeStreamOp proto.InternalMessageInfo
+var xxx_messageInfo_DeletePartitionOp proto.InternalMessageInfo
 
-func (m *ResumeStreamOp) GetStream() string {
+func (m *DeletePartitionOp) GetStream() string {
 	if m != nil {
 		return m.Stream
 	}
 	return ""
 }
 
-func (m *ResumeStreamOp) GetPartitions() []int32 {
+func (m *DeletePartitionOp) GetPartition() int32 {
 	if m != nil {
-		return m.Partitions
+		return m.Partition
 	}
-	return nil
+	return 0
 }
 
-type ReportLeaderOp struct {
-	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
-	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
-	Replica              string   `protobuf:"bytes,3,opt,name=replica,proto3" json:"replica,omitempty"`
-	Leader               string   `protobuf:"bytes,4,opt,name=leader,proto3" json:"leader,omitempty"`
-	LeaderEpoch          uint64   `protobuf:"varint,5,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// SetStreamTagsOp replaces the tags of a stream.
+type SetStreamTagsOp struct {
+	Stream               string       `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Tags                 []*StreamTag `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *ReportLeaderOp) Reset()         { *m = ReportLeaderOp{} }
-func (m *ReportLeaderOp) String() string { return proto.CompactTextString(m) }
-func (*ReportLeaderOp) ProtoMessage()    {}
-func (*ReportLeaderOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{8}
+func (m *SetStreamTagsOp) Reset()         { *m = SetStreamTagsOp{} }
+func (m *SetStreamTagsOp) String() string { return proto.CompactTextString(m) }
+func (*SetStreamTagsOp) ProtoMessage()    {}
+func (*SetStreamTagsOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{10}
 }
-func (m *ReportLeaderOp) XXX_Unmarshal(b []byte) error {
+func (m *SetStreamTagsOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReportLeaderOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetStreamTagsOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReportLeaderOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetStreamTagsOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -686,74 +1088,112 @@ func (m *ReportLeaderOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ReportLeaderOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReportLeaderOp.Merge(m, src)
+func (m *SetStreamTagsOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStreamTagsOp.Merge(m, src)
 }
-func (m *ReportLeaderOp) XXX_Size() int {
+func (m *SetStreamTagsOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReportLeaderOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReportLeaderOp.DiscardUnknown(m)
+func (m *SetStreamTagsOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStreamTagsOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReportLeaderOp proto.InternalMessageInfo
+var xxx_messageInfo_SetStreamTagsOp proto.InternalMessageInfo
 
-func (m *ReportLeaderOp) GetStream() string {
+func (m *SetStreamTagsOp) GetStream() string {
 	if m != nil {
 		return m.Stream
 	}
 	return ""
 }
 
-func (m *ReportLeaderOp) GetPartition() int32 {
+func (m *SetStreamTagsOp) GetTags() []*StreamTag {
 	if m != nil {
-		return m.Partition
+		return m.Tags
 	}
-	return 0
+	return nil
 }
 
-func (m *ReportLeaderOp) GetReplica() string {
-	if m != nil {
-		return m.Replica
+// SetStreamACLOp replaces the access control list of a stream.
+type SetStreamACLOp struct {
+	Stream               string     `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Acl                  *StreamACL `protobuf:"bytes,2,opt,name=acl,proto3" json:"acl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetStreamACLOp) Reset()         { *m = SetStreamACLOp{} }
+func (m *SetStreamACLOp) String() string { return proto.CompactTextString(m) }
+func (*SetStreamACLOp) ProtoMessage()    {}
+func (*SetStreamACLOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{11}
+}
+func (m *SetStreamACLOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetStreamACLOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetStreamACLOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *SetStreamACLOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStreamACLOp.Merge(m, src)
+}
+func (m *SetStreamACLOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetStreamACLOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStreamACLOp.DiscardUnknown(m)
 }
 
-func (m *ReportLeaderOp) GetLeader() string {
+var xxx_messageInfo_SetStreamACLOp proto.InternalMessageInfo
+
+func (m *SetStreamACLOp) GetStream() string {
 	if m != nil {
-		return m.Leader
+		return m.Stream
 	}
 	return ""
 }
 
-func (m *ReportLeaderOp) GetLeaderEpoch() uint64 {
+func (m *SetStreamACLOp) GetAcl() *StreamACL {
 	if m != nil {
-		return m.LeaderEpoch
+		return m.Acl
 	}
-	return 0
+	return nil
 }
 
-type ChangeLeaderOp struct {
+// ReplaceReplicaOp replaces a replica of a partition hosted on a lost broker
+// with a replica on another broker, which joins the ISR once it catches up.
+type ReplaceReplicaOp struct {
 	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
 	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
-	Leader               string   `protobuf:"bytes,3,opt,name=leader,proto3" json:"leader,omitempty"`
+	Replica              string   `protobuf:"bytes,3,opt,name=replica,proto3" json:"replica,omitempty"`
+	Replacement          string   `protobuf:"bytes,4,opt,name=replacement,proto3" json:"replacement,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChangeLeaderOp) Reset()         { *m = ChangeLeaderOp{} }
-func (m *ChangeLeaderOp) String() string { return proto.CompactTextString(m) }
-func (*ChangeLeaderOp) ProtoMessage()    {}
-func (*ChangeLeaderOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{9}
+func (m *ReplaceReplicaOp) Reset()         { *m = ReplaceReplicaOp{} }
+func (m *ReplaceReplicaOp) String() string { return proto.CompactTextString(m) }
+func (*ReplaceReplicaOp) ProtoMessage()    {}
+func (*ReplaceReplicaOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{12}
 }
-func (m *ChangeLeaderOp) XXX_Unmarshal(b []byte) error {
+func (m *ReplaceReplicaOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChangeLeaderOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReplaceReplicaOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChangeLeaderOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReplaceReplicaOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -763,61 +1203,68 @@ func (m *ChangeLeaderOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ChangeLeaderOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangeLeaderOp.Merge(m, src)
+func (m *ReplaceReplicaOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplaceReplicaOp.Merge(m, src)
 }
-func (m *ChangeLeaderOp) XXX_Size() int {
+func (m *ReplaceReplicaOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChangeLeaderOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangeLeaderOp.DiscardUnknown(m)
+func (m *ReplaceReplicaOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplaceReplicaOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangeLeaderOp proto.InternalMessageInfo
+var xxx_messageInfo_ReplaceReplicaOp proto.InternalMessageInfo
 
-func (m *ChangeLeaderOp) GetStream() string {
+func (m *ReplaceReplicaOp) GetStream() string {
 	if m != nil {
 		return m.Stream
 	}
 	return ""
 }
 
-func (m *ChangeLeaderOp) GetPartition() int32 {
+func (m *ReplaceReplicaOp) GetPartition() int32 {
 	if m != nil {
 		return m.Partition
 	}
 	return 0
 }
 
-func (m *ChangeLeaderOp) GetLeader() string {
+func (m *ReplaceReplicaOp) GetReplica() string {
 	if m != nil {
-		return m.Leader
+		return m.Replica
 	}
 	return ""
 }
 
-type ReportConsumerGroupCoordinatorOp struct {
-	GroupId              string   `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	ConsumerId           string   `protobuf:"bytes,2,opt,name=consumerId,proto3" json:"consumerId,omitempty"`
-	Coordinator          string   `protobuf:"bytes,3,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
-	Epoch                uint64   `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+func (m *ReplaceReplicaOp) GetReplacement() string {
+	if m != nil {
+		return m.Replacement
+	}
+	return ""
+}
+
+// ProducerQuota limits the rate at which a producer, identified by the
+// producer ID of the idempotent producer headers, can publish.
+type ProducerQuota struct {
+	ProducerID           string   `protobuf:"bytes,1,opt,name=producerID,proto3" json:"producerID,omitempty"`
+	BytesPerSecond       int64    `protobuf:"varint,2,opt,name=bytesPerSecond,proto3" json:"bytesPerSecond,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReportConsumerGroupCoordinatorOp) Reset()         { *m = ReportConsumerGroupCoordinatorOp{} }
-func (m *ReportConsumerGroupCoordinatorOp) String() string { return proto.CompactTextString(m) }
-func (*ReportConsumerGroupCoordinatorOp) ProtoMessage()    {}
-func (*ReportConsumerGroupCoordinatorOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{10}
+func (m *ProducerQuota) Reset()         { *m = ProducerQuota{} }
+func (m *ProducerQuota) String() string { return proto.CompactTextString(m) }
+func (*ProducerQuota) ProtoMessage()    {}
+func (*ProducerQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{13}
 }
-func (m *ReportConsumerGroupCoordinatorOp) XXX_Unmarshal(b []byte) error {
+func (m *ProducerQuota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReportConsumerGroupCoordinatorOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProducerQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReportConsumerGroupCoordinatorOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProducerQuota.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -827,66 +1274,101 @@ func (m *ReportConsumerGroupCoordinatorOp) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *ReportConsumerGroupCoordinatorOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReportConsumerGroupCoordinatorOp.Merge(m, src)
+func (m *ProducerQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProducerQuota.Merge(m, src)
 }
-func (m *ReportConsumerGroupCoordinatorOp) XXX_Size() int {
+func (m *ProducerQuota) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReportConsumerGroupCoordinatorOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReportConsumerGroupCoordinatorOp.DiscardUnknown(m)
+func (m *ProducerQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProducerQuota.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReportConsumerGroupCoordinatorOp proto.InternalMessageInfo
+var xxx_messageInfo_ProducerQuota proto.InternalMessageInfo
 
-func (m *ReportConsumerGroupCoordinatorOp) GetGroupId() string {
+func (m *ProducerQuota) GetProducerID() string {
 	if m != nil {
-		return m.GroupId
+		return m.ProducerID
 	}
 	return ""
 }
 
-func (m *ReportConsumerGroupCoordinatorOp) GetConsumerId() string {
+func (m *ProducerQuota) GetBytesPerSecond() int64 {
 	if m != nil {
-		return m.ConsumerId
+		return m.BytesPerSecond
 	}
-	return ""
+	return 0
 }
 
-func (m *ReportConsumerGroupCoordinatorOp) GetCoordinator() string {
-	if m != nil {
-		return m.Coordinator
+// SetProducerQuotaOp sets the quota of a producer.
+type SetProducerQuotaOp struct {
+	Quota                *ProducerQuota `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetProducerQuotaOp) Reset()         { *m = SetProducerQuotaOp{} }
+func (m *SetProducerQuotaOp) String() string { return proto.CompactTextString(m) }
+func (*SetProducerQuotaOp) ProtoMessage()    {}
+func (*SetProducerQuotaOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{14}
+}
+func (m *SetProducerQuotaOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetProducerQuotaOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetProducerQuotaOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *SetProducerQuotaOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProducerQuotaOp.Merge(m, src)
+}
+func (m *SetProducerQuotaOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetProducerQuotaOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProducerQuotaOp.DiscardUnknown(m)
 }
 
-func (m *ReportConsumerGroupCoordinatorOp) GetEpoch() uint64 {
+var xxx_messageInfo_SetProducerQuotaOp proto.InternalMessageInfo
+
+func (m *SetProducerQuotaOp) GetQuota() *ProducerQuota {
 	if m != nil {
-		return m.Epoch
+		return m.Quota
 	}
-	return 0
+	return nil
 }
 
-type ChangeConsumerGroupCoordinatorOp struct {
-	GroupId              string   `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	Coordinator          string   `protobuf:"bytes,2,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+// DeleteProducerQuotaOp removes the quota of a producer, including a quota
+// set in the brokers' configuration.
+type DeleteProducerQuotaOp struct {
+	ProducerID           string   `protobuf:"bytes,1,opt,name=producerID,proto3" json:"producerID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChangeConsumerGroupCoordinatorOp) Reset()         { *m = ChangeConsumerGroupCoordinatorOp{} }
-func (m *ChangeConsumerGroupCoordinatorOp) String() string { return proto.CompactTextString(m) }
-func (*ChangeConsumerGroupCoordinatorOp) ProtoMessage()    {}
-func (*ChangeConsumerGroupCoordinatorOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{11}
+func (m *DeleteProducerQuotaOp) Reset()         { *m = DeleteProducerQuotaOp{} }
+func (m *DeleteProducerQuotaOp) String() string { return proto.CompactTextString(m) }
+func (*DeleteProducerQuotaOp) ProtoMessage()    {}
+func (*DeleteProducerQuotaOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{15}
 }
-func (m *ChangeConsumerGroupCoordinatorOp) XXX_Unmarshal(b []byte) error {
+func (m *DeleteProducerQuotaOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChangeConsumerGroupCoordinatorOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteProducerQuotaOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChangeConsumerGroupCoordinatorOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteProducerQuotaOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -896,51 +1378,48 @@ func (m *ChangeConsumerGroupCoordinatorOp) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *ChangeConsumerGroupCoordinatorOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangeConsumerGroupCoordinatorOp.Merge(m, src)
+func (m *DeleteProducerQuotaOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProducerQuotaOp.Merge(m, src)
 }
-func (m *ChangeConsumerGroupCoordinatorOp) XXX_Size() int {
+func (m *DeleteProducerQuotaOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChangeConsumerGroupCoordinatorOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangeConsumerGroupCoordinatorOp.DiscardUnknown(m)
+func (m *DeleteProducerQuotaOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProducerQuotaOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangeConsumerGroupCoordinatorOp proto.InternalMessageInfo
-
-func (m *ChangeConsumerGroupCoordinatorOp) GetGroupId() string {
-	if m != nil {
-		return m.GroupId
-	}
-	return ""
-}
+var xxx_messageInfo_DeleteProducerQuotaOp proto.InternalMessageInfo
 
-func (m *ChangeConsumerGroupCoordinatorOp) GetCoordinator() string {
+func (m *DeleteProducerQuotaOp) GetProducerID() string {
 	if m != nil {
-		return m.Coordinator
+		return m.ProducerID
 	}
 	return ""
 }
 
-type PublishActivityOp struct {
-	RaftIndex            uint64   `protobuf:"varint,1,opt,name=raftIndex,proto3" json:"raftIndex,omitempty"`
+type ShrinkISROp struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	ReplicaToRemove      string   `protobuf:"bytes,3,opt,name=replicaToRemove,proto3" json:"replicaToRemove,omitempty"`
+	Leader               string   `protobuf:"bytes,4,opt,name=leader,proto3" json:"leader,omitempty"`
+	LeaderEpoch          uint64   `protobuf:"varint,5,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PublishActivityOp) Reset()         { *m = PublishActivityOp{} }
-func (m *PublishActivityOp) String() string { return proto.CompactTextString(m) }
-func (*PublishActivityOp) ProtoMessage()    {}
-func (*PublishActivityOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{12}
+func (m *ShrinkISROp) Reset()         { *m = ShrinkISROp{} }
+func (m *ShrinkISROp) String() string { return proto.CompactTextString(m) }
+func (*ShrinkISROp) ProtoMessage()    {}
+func (*ShrinkISROp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{16}
 }
-func (m *PublishActivityOp) XXX_Unmarshal(b []byte) error {
+func (m *ShrinkISROp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PublishActivityOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ShrinkISROp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PublishActivityOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ShrinkISROp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -950,46 +1429,76 @@ func (m *PublishActivityOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *PublishActivityOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PublishActivityOp.Merge(m, src)
+func (m *ShrinkISROp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShrinkISROp.Merge(m, src)
 }
-func (m *PublishActivityOp) XXX_Size() int {
+func (m *ShrinkISROp) XXX_Size() int {
 	return m.Size()
 }
-func (m *PublishActivityOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_PublishActivityOp.DiscardUnknown(m)
+func (m *ShrinkISROp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShrinkISROp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PublishActivityOp proto.InternalMessageInfo
+var xxx_messageInfo_ShrinkISROp proto.InternalMessageInfo
 
-func (m *PublishActivityOp) GetRaftIndex() uint64 {
+func (m *ShrinkISROp) GetStream() string {
 	if m != nil {
-		return m.RaftIndex
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *ShrinkISROp) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
 	}
 	return 0
 }
 
-type SetStreamReadonlyOp struct {
+func (m *ShrinkISROp) GetReplicaToRemove() string {
+	if m != nil {
+		return m.ReplicaToRemove
+	}
+	return ""
+}
+
+func (m *ShrinkISROp) GetLeader() string {
+	if m != nil {
+		return m.Leader
+	}
+	return ""
+}
+
+func (m *ShrinkISROp) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
+	}
+	return 0
+}
+
+type ExpandISROp struct {
 	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
-	Partitions           []int32  `protobuf:"varint,2,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
-	Readonly             bool     `protobuf:"varint,3,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	ReplicaToAdd         string   `protobuf:"bytes,3,opt,name=replicaToAdd,proto3" json:"replicaToAdd,omitempty"`
+	Leader               string   `protobuf:"bytes,4,opt,name=leader,proto3" json:"leader,omitempty"`
+	LeaderEpoch          uint64   `protobuf:"varint,5,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetStreamReadonlyOp) Reset()         { *m = SetStreamReadonlyOp{} }
-func (m *SetStreamReadonlyOp) String() string { return proto.CompactTextString(m) }
-func (*SetStreamReadonlyOp) ProtoMessage()    {}
-func (*SetStreamReadonlyOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{13}
+func (m *ExpandISROp) Reset()         { *m = ExpandISROp{} }
+func (m *ExpandISROp) String() string { return proto.CompactTextString(m) }
+func (*ExpandISROp) ProtoMessage()    {}
+func (*ExpandISROp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{17}
 }
-func (m *SetStreamReadonlyOp) XXX_Unmarshal(b []byte) error {
+func (m *ExpandISROp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetStreamReadonlyOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExpandISROp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetStreamReadonlyOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExpandISROp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -999,107 +1508,74 @@ func (m *SetStreamReadonlyOp) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *SetStreamReadonlyOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetStreamReadonlyOp.Merge(m, src)
+func (m *ExpandISROp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpandISROp.Merge(m, src)
 }
-func (m *SetStreamReadonlyOp) XXX_Size() int {
+func (m *ExpandISROp) XXX_Size() int {
 	return m.Size()
 }
-func (m *SetStreamReadonlyOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetStreamReadonlyOp.DiscardUnknown(m)
+func (m *ExpandISROp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpandISROp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetStreamReadonlyOp proto.InternalMessageInfo
+var xxx_messageInfo_ExpandISROp proto.InternalMessageInfo
 
-func (m *SetStreamReadonlyOp) GetStream() string {
+func (m *ExpandISROp) GetStream() string {
 	if m != nil {
 		return m.Stream
 	}
 	return ""
 }
 
-func (m *SetStreamReadonlyOp) GetPartitions() []int32 {
+func (m *ExpandISROp) GetPartition() int32 {
 	if m != nil {
-		return m.Partitions
+		return m.Partition
 	}
-	return nil
+	return 0
 }
 
-func (m *SetStreamReadonlyOp) GetReadonly() bool {
+func (m *ExpandISROp) GetReplicaToAdd() string {
 	if m != nil {
-		return m.Readonly
+		return m.ReplicaToAdd
 	}
-	return false
-}
-
-type CreateConsumerGroupOp struct {
-	ConsumerGroup        *ConsumerGroup `protobuf:"bytes,1,opt,name=consumerGroup,proto3" json:"consumerGroup,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	return ""
 }
 
-func (m *CreateConsumerGroupOp) Reset()         { *m = CreateConsumerGroupOp{} }
-func (m *CreateConsumerGroupOp) String() string { return proto.CompactTextString(m) }
-func (*CreateConsumerGroupOp) ProtoMessage()    {}
-func (*CreateConsumerGroupOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{14}
-}
-func (m *CreateConsumerGroupOp) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *CreateConsumerGroupOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_CreateConsumerGroupOp.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *ExpandISROp) GetLeader() string {
+	if m != nil {
+		return m.Leader
 	}
+	return ""
 }
-func (m *CreateConsumerGroupOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateConsumerGroupOp.Merge(m, src)
-}
-func (m *CreateConsumerGroupOp) XXX_Size() int {
-	return m.Size()
-}
-func (m *CreateConsumerGroupOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateConsumerGroupOp.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CreateConsumerGroupOp proto.InternalMessageInfo
 
-func (m *CreateConsumerGroupOp) GetConsumerGroup() *ConsumerGroup {
+func (m *ExpandISROp) GetLeaderEpoch() uint64 {
 	if m != nil {
-		return m.ConsumerGroup
+		return m.LeaderEpoch
 	}
-	return nil
+	return 0
 }
 
-type JoinConsumerGroupOp struct {
-	GroupId              string   `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	ConsumerId           string   `protobuf:"bytes,2,opt,name=consumerId,proto3" json:"consumerId,omitempty"`
-	Streams              []string `protobuf:"bytes,3,rep,name=streams,proto3" json:"streams,omitempty"`
+type DeleteStreamOp struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Cascade              bool     `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	TtlDeletedAt         int64    `protobuf:"varint,3,opt,name=ttlDeletedAt,proto3" json:"ttlDeletedAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JoinConsumerGroupOp) Reset()         { *m = JoinConsumerGroupOp{} }
-func (m *JoinConsumerGroupOp) String() string { return proto.CompactTextString(m) }
-func (*JoinConsumerGroupOp) ProtoMessage()    {}
-func (*JoinConsumerGroupOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{15}
+func (m *DeleteStreamOp) Reset()         { *m = DeleteStreamOp{} }
+func (m *DeleteStreamOp) String() string { return proto.CompactTextString(m) }
+func (*DeleteStreamOp) ProtoMessage()    {}
+func (*DeleteStreamOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{18}
 }
-func (m *JoinConsumerGroupOp) XXX_Unmarshal(b []byte) error {
+func (m *DeleteStreamOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JoinConsumerGroupOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteStreamOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JoinConsumerGroupOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteStreamOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1109,60 +1585,61 @@ func (m *JoinConsumerGroupOp) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *JoinConsumerGroupOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JoinConsumerGroupOp.Merge(m, src)
+func (m *DeleteStreamOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteStreamOp.Merge(m, src)
 }
-func (m *JoinConsumerGroupOp) XXX_Size() int {
+func (m *DeleteStreamOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *JoinConsumerGroupOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_JoinConsumerGroupOp.DiscardUnknown(m)
+func (m *DeleteStreamOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteStreamOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JoinConsumerGroupOp proto.InternalMessageInfo
+var xxx_messageInfo_DeleteStreamOp proto.InternalMessageInfo
 
-func (m *JoinConsumerGroupOp) GetGroupId() string {
+func (m *DeleteStreamOp) GetStream() string {
 	if m != nil {
-		return m.GroupId
+		return m.Stream
 	}
 	return ""
 }
 
-func (m *JoinConsumerGroupOp) GetConsumerId() string {
+func (m *DeleteStreamOp) GetCascade() bool {
 	if m != nil {
-		return m.ConsumerId
+		return m.Cascade
 	}
-	return ""
+	return false
 }
 
-func (m *JoinConsumerGroupOp) GetStreams() []string {
+func (m *DeleteStreamOp) GetTtlDeletedAt() int64 {
 	if m != nil {
-		return m.Streams
+		return m.TtlDeletedAt
 	}
-	return nil
+	return 0
 }
 
-type LeaveConsumerGroupOp struct {
-	GroupId              string   `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	ConsumerId           string   `protobuf:"bytes,2,opt,name=consumerId,proto3" json:"consumerId,omitempty"`
-	Expired              bool     `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+type PauseStreamOp struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partitions           []int32  `protobuf:"varint,2,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
+	ResumeAll            bool     `protobuf:"varint,3,opt,name=resumeAll,proto3" json:"resumeAll,omitempty"`
+	DrainBeforePause     bool     `protobuf:"varint,4,opt,name=drainBeforePause,proto3" json:"drainBeforePause,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LeaveConsumerGroupOp) Reset()         { *m = LeaveConsumerGroupOp{} }
-func (m *LeaveConsumerGroupOp) String() string { return proto.CompactTextString(m) }
-func (*LeaveConsumerGroupOp) ProtoMessage()    {}
-func (*LeaveConsumerGroupOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{16}
+func (m *PauseStreamOp) Reset()         { *m = PauseStreamOp{} }
+func (m *PauseStreamOp) String() string { return proto.CompactTextString(m) }
+func (*PauseStreamOp) ProtoMessage()    {}
+func (*PauseStreamOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{19}
 }
-func (m *LeaveConsumerGroupOp) XXX_Unmarshal(b []byte) error {
+func (m *PauseStreamOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LeaveConsumerGroupOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PauseStreamOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LeaveConsumerGroupOp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PauseStreamOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1172,58 +1649,66 @@ func (m *LeaveConsumerGroupOp) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *LeaveConsumerGroupOp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LeaveConsumerGroupOp.Merge(m, src)
+func (m *PauseStreamOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseStreamOp.Merge(m, src)
 }
-func (m *LeaveConsumerGroupOp) XXX_Size() int {
+func (m *PauseStreamOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *LeaveConsumerGroupOp) XXX_DiscardUnknown() {
-	xxx_messageInfo_LeaveConsumerGroupOp.DiscardUnknown(m)
+func (m *PauseStreamOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseStreamOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LeaveConsumerGroupOp proto.InternalMessageInfo
+var xxx_messageInfo_PauseStreamOp proto.InternalMessageInfo
 
-func (m *LeaveConsumerGroupOp) GetGroupId() string {
+func (m *PauseStreamOp) GetStream() string {
 	if m != nil {
-		return m.GroupId
+		return m.Stream
 	}
 	return ""
 }
 
-func (m *LeaveConsumerGroupOp) GetConsumerId() string {
+func (m *PauseStreamOp) GetPartitions() []int32 {
 	if m != nil {
-		return m.ConsumerId
+		return m.Partitions
 	}
-	return ""
+	return nil
 }
 
-func (m *LeaveConsumerGroupOp) GetExpired() bool {
+func (m *PauseStreamOp) GetResumeAll() bool {
 	if m != nil {
-		return m.Expired
+		return m.ResumeAll
 	}
 	return false
 }
 
-type NullableInt64 struct {
-	Value                int64    `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+func (m *PauseStreamOp) GetDrainBeforePause() bool {
+	if m != nil {
+		return m.DrainBeforePause
+	}
+	return false
+}
+
+type ResumeStreamOp struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partitions           []int32  `protobuf:"varint,2,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NullableInt64) Reset()         { *m = NullableInt64{} }
-func (m *NullableInt64) String() string { return proto.CompactTextString(m) }
-func (*NullableInt64) ProtoMessage()    {}
-func (*NullableInt64) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{17}
+func (m *ResumeStreamOp) Reset()         { *m = ResumeStreamOp{} }
+func (m *ResumeStreamOp) String() string { return proto.CompactTextString(m) }
+func (*ResumeStreamOp) ProtoMessage()    {}
+func (*ResumeStreamOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{20}
 }
-func (m *NullableInt64) XXX_Unmarshal(b []byte) error {
+func (m *ResumeStreamOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NullableInt64) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResumeStreamOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NullableInt64.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResumeStreamOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1233,44 +1718,55 @@ func (m *NullableInt64) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *NullableInt64) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NullableInt64.Merge(m, src)
+func (m *ResumeStreamOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeStreamOp.Merge(m, src)
 }
-func (m *NullableInt64) XXX_Size() int {
+func (m *ResumeStreamOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *NullableInt64) XXX_DiscardUnknown() {
-	xxx_messageInfo_NullableInt64.DiscardUnknown(m)
+func (m *ResumeStreamOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeStreamOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NullableInt64 proto.InternalMessageInfo
+var xxx_messageInfo_ResumeStreamOp proto.InternalMessageInfo
 
-func (m *NullableInt64) GetValue() int64 {
+func (m *ResumeStreamOp) GetStream() string {
 	if m != nil {
-		return m.Value
+		return m.Stream
 	}
-	return 0
+	return ""
 }
 
-type NullableInt32 struct {
-	Value                int32    `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+func (m *ResumeStreamOp) GetPartitions() []int32 {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+type ReportLeaderOp struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Replica              string   `protobuf:"bytes,3,opt,name=replica,proto3" json:"replica,omitempty"`
+	Leader               string   `protobuf:"bytes,4,opt,name=leader,proto3" json:"leader,omitempty"`
+	LeaderEpoch          uint64   `protobuf:"varint,5,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NullableInt32) Reset()         { *m = NullableInt32{} }
-func (m *NullableInt32) String() string { return proto.CompactTextString(m) }
-func (*NullableInt32) ProtoMessage()    {}
-func (*NullableInt32) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{18}
+func (m *ReportLeaderOp) Reset()         { *m = ReportLeaderOp{} }
+func (m *ReportLeaderOp) String() string { return proto.CompactTextString(m) }
+func (*ReportLeaderOp) ProtoMessage()    {}
+func (*ReportLeaderOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{21}
 }
-func (m *NullableInt32) XXX_Unmarshal(b []byte) error {
+func (m *ReportLeaderOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NullableInt32) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReportLeaderOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NullableInt32.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReportLeaderOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1280,44 +1776,74 @@ func (m *NullableInt32) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *NullableInt32) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NullableInt32.Merge(m, src)
+func (m *ReportLeaderOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportLeaderOp.Merge(m, src)
 }
-func (m *NullableInt32) XXX_Size() int {
+func (m *ReportLeaderOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *NullableInt32) XXX_DiscardUnknown() {
-	xxx_messageInfo_NullableInt32.DiscardUnknown(m)
+func (m *ReportLeaderOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportLeaderOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NullableInt32 proto.InternalMessageInfo
+var xxx_messageInfo_ReportLeaderOp proto.InternalMessageInfo
 
-func (m *NullableInt32) GetValue() int32 {
+func (m *ReportLeaderOp) GetStream() string {
 	if m != nil {
-		return m.Value
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *ReportLeaderOp) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
 	}
 	return 0
 }
 
-type NullableBool struct {
-	Value                bool     `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+func (m *ReportLeaderOp) GetReplica() string {
+	if m != nil {
+		return m.Replica
+	}
+	return ""
+}
+
+func (m *ReportLeaderOp) GetLeader() string {
+	if m != nil {
+		return m.Leader
+	}
+	return ""
+}
+
+func (m *ReportLeaderOp) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
+	}
+	return 0
+}
+
+type ChangeLeaderOp struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Leader               string   `protobuf:"bytes,3,opt,name=leader,proto3" json:"leader,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NullableBool) Reset()         { *m = NullableBool{} }
-func (m *NullableBool) String() string { return proto.CompactTextString(m) }
-func (*NullableBool) ProtoMessage()    {}
-func (*NullableBool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{19}
+func (m *ChangeLeaderOp) Reset()         { *m = ChangeLeaderOp{} }
+func (m *ChangeLeaderOp) String() string { return proto.CompactTextString(m) }
+func (*ChangeLeaderOp) ProtoMessage()    {}
+func (*ChangeLeaderOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{22}
 }
-func (m *NullableBool) XXX_Unmarshal(b []byte) error {
+func (m *ChangeLeaderOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NullableBool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChangeLeaderOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NullableBool.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChangeLeaderOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1327,56 +1853,61 @@ func (m *NullableBool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *NullableBool) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NullableBool.Merge(m, src)
+func (m *ChangeLeaderOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeLeaderOp.Merge(m, src)
 }
-func (m *NullableBool) XXX_Size() int {
+func (m *ChangeLeaderOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *NullableBool) XXX_DiscardUnknown() {
-	xxx_messageInfo_NullableBool.DiscardUnknown(m)
+func (m *ChangeLeaderOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeLeaderOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NullableBool proto.InternalMessageInfo
+var xxx_messageInfo_ChangeLeaderOp proto.InternalMessageInfo
 
-func (m *NullableBool) GetValue() bool {
+func (m *ChangeLeaderOp) GetStream() string {
 	if m != nil {
-		return m.Value
+		return m.Stream
 	}
-	return false
+	return ""
 }
 
-type StreamConfig struct {
-	RetentionMaxBytes             *NullableInt64 `protobuf:"bytes,1,opt,name=retentionMaxBytes,proto3" json:"retentionMaxBytes,omitempty"`
-	RetentionMaxMessages          *NullableInt64 `protobuf:"bytes,2,opt,name=retentionMaxMessages,proto3" json:"retentionMaxMessages,omitempty"`
-	RetentionMaxAge               *NullableInt64 `protobuf:"bytes,3,opt,name=retentionMaxAge,proto3" json:"retentionMaxAge,omitempty"`
-	CleanerInterval               *NullableInt64 `protobuf:"bytes,4,opt,name=cleanerInterval,proto3" json:"cleanerInterval,omitempty"`
-	SegmentMaxBytes               *NullableInt64 `protobuf:"bytes,5,opt,name=segmentMaxBytes,proto3" json:"segmentMaxBytes,omitempty"`
-	SegmentMaxAge                 *NullableInt64 `protobuf:"bytes,6,opt,name=segmentMaxAge,proto3" json:"segmentMaxAge,omitempty"`
-	CompactMaxGoroutines          *NullableInt32 `protobuf:"bytes,7,opt,name=compactMaxGoroutines,proto3" json:"compactMaxGoroutines,omitempty"`
-	CompactEnabled                *NullableBool  `protobuf:"bytes,8,opt,name=compactEnabled,proto3" json:"compactEnabled,omitempty"`
-	AutoPauseTime                 *NullableInt64 `protobuf:"bytes,9,opt,name=autoPauseTime,proto3" json:"autoPauseTime,omitempty"`
-	AutoPauseDisableIfSubscribers *NullableBool  `protobuf:"bytes,10,opt,name=autoPauseDisableIfSubscribers,proto3" json:"autoPauseDisableIfSubscribers,omitempty"`
-	MinIsr                        *NullableInt32 `protobuf:"bytes,11,opt,name=minIsr,proto3" json:"minIsr,omitempty"`
-	OptimisticConcurrencyControl  *NullableBool  `protobuf:"bytes,12,opt,name=optimisticConcurrencyControl,proto3" json:"optimisticConcurrencyControl,omitempty"`
-	Encryption                    *NullableBool  `protobuf:"bytes,13,opt,name=encryption,proto3" json:"encryption,omitempty"`
-	XXX_NoUnkeyedLiteral          struct{}       `json:"-"`
-	XXX_unrecognized              []byte         `json:"-"`
-	XXX_sizecache                 int32          `json:"-"`
+func (m *ChangeLeaderOp) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
 }
 
-func (m *StreamConfig) Reset()         { *m = StreamConfig{} }
-func (m *StreamConfig) String() string { return proto.CompactTextString(m) }
-func (*StreamConfig) ProtoMessage()    {}
-func (*StreamConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{20}
+func (m *ChangeLeaderOp) GetLeader() string {
+	if m != nil {
+		return m.Leader
+	}
+	return ""
 }
-func (m *StreamConfig) XXX_Unmarshal(b []byte) error {
+
+type ReportConsumerGroupCoordinatorOp struct {
+	GroupId              string   `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	ConsumerId           string   `protobuf:"bytes,2,opt,name=consumerId,proto3" json:"consumerId,omitempty"`
+	Coordinator          string   `protobuf:"bytes,3,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	Epoch                uint64   `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportConsumerGroupCoordinatorOp) Reset()         { *m = ReportConsumerGroupCoordinatorOp{} }
+func (m *ReportConsumerGroupCoordinatorOp) String() string { return proto.CompactTextString(m) }
+func (*ReportConsumerGroupCoordinatorOp) ProtoMessage()    {}
+func (*ReportConsumerGroupCoordinatorOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{23}
+}
+func (m *ReportConsumerGroupCoordinatorOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReportConsumerGroupCoordinatorOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamConfig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReportConsumerGroupCoordinatorOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1386,132 +1917,121 @@ func (m *StreamConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *StreamConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamConfig.Merge(m, src)
+func (m *ReportConsumerGroupCoordinatorOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportConsumerGroupCoordinatorOp.Merge(m, src)
 }
-func (m *StreamConfig) XXX_Size() int {
+func (m *ReportConsumerGroupCoordinatorOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamConfig.DiscardUnknown(m)
+func (m *ReportConsumerGroupCoordinatorOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportConsumerGroupCoordinatorOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamConfig proto.InternalMessageInfo
+var xxx_messageInfo_ReportConsumerGroupCoordinatorOp proto.InternalMessageInfo
 
-func (m *StreamConfig) GetRetentionMaxBytes() *NullableInt64 {
+func (m *ReportConsumerGroupCoordinatorOp) GetGroupId() string {
 	if m != nil {
-		return m.RetentionMaxBytes
+		return m.GroupId
 	}
-	return nil
+	return ""
 }
 
-func (m *StreamConfig) GetRetentionMaxMessages() *NullableInt64 {
+func (m *ReportConsumerGroupCoordinatorOp) GetConsumerId() string {
 	if m != nil {
-		return m.RetentionMaxMessages
+		return m.ConsumerId
 	}
-	return nil
+	return ""
 }
 
-func (m *StreamConfig) GetRetentionMaxAge() *NullableInt64 {
+func (m *ReportConsumerGroupCoordinatorOp) GetCoordinator() string {
 	if m != nil {
-		return m.RetentionMaxAge
+		return m.Coordinator
 	}
-	return nil
+	return ""
 }
 
-func (m *StreamConfig) GetCleanerInterval() *NullableInt64 {
+func (m *ReportConsumerGroupCoordinatorOp) GetEpoch() uint64 {
 	if m != nil {
-		return m.CleanerInterval
+		return m.Epoch
 	}
-	return nil
+	return 0
 }
 
-func (m *StreamConfig) GetSegmentMaxBytes() *NullableInt64 {
-	if m != nil {
-		return m.SegmentMaxBytes
-	}
-	return nil
+type ChangeConsumerGroupCoordinatorOp struct {
+	GroupId              string   `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Coordinator          string   `protobuf:"bytes,2,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StreamConfig) GetSegmentMaxAge() *NullableInt64 {
-	if m != nil {
-		return m.SegmentMaxAge
-	}
-	return nil
+func (m *ChangeConsumerGroupCoordinatorOp) Reset()         { *m = ChangeConsumerGroupCoordinatorOp{} }
+func (m *ChangeConsumerGroupCoordinatorOp) String() string { return proto.CompactTextString(m) }
+func (*ChangeConsumerGroupCoordinatorOp) ProtoMessage()    {}
+func (*ChangeConsumerGroupCoordinatorOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{24}
 }
-
-func (m *StreamConfig) GetCompactMaxGoroutines() *NullableInt32 {
-	if m != nil {
-		return m.CompactMaxGoroutines
-	}
-	return nil
+func (m *ChangeConsumerGroupCoordinatorOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *StreamConfig) GetCompactEnabled() *NullableBool {
-	if m != nil {
-		return m.CompactEnabled
+func (m *ChangeConsumerGroupCoordinatorOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeConsumerGroupCoordinatorOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *StreamConfig) GetAutoPauseTime() *NullableInt64 {
-	if m != nil {
-		return m.AutoPauseTime
-	}
-	return nil
+func (m *ChangeConsumerGroupCoordinatorOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeConsumerGroupCoordinatorOp.Merge(m, src)
 }
-
-func (m *StreamConfig) GetAutoPauseDisableIfSubscribers() *NullableBool {
-	if m != nil {
-		return m.AutoPauseDisableIfSubscribers
-	}
-	return nil
+func (m *ChangeConsumerGroupCoordinatorOp) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *StreamConfig) GetMinIsr() *NullableInt32 {
-	if m != nil {
-		return m.MinIsr
-	}
-	return nil
+func (m *ChangeConsumerGroupCoordinatorOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeConsumerGroupCoordinatorOp.DiscardUnknown(m)
 }
 
-func (m *StreamConfig) GetOptimisticConcurrencyControl() *NullableBool {
+var xxx_messageInfo_ChangeConsumerGroupCoordinatorOp proto.InternalMessageInfo
+
+func (m *ChangeConsumerGroupCoordinatorOp) GetGroupId() string {
 	if m != nil {
-		return m.OptimisticConcurrencyControl
+		return m.GroupId
 	}
-	return nil
+	return ""
 }
 
-func (m *StreamConfig) GetEncryption() *NullableBool {
+func (m *ChangeConsumerGroupCoordinatorOp) GetCoordinator() string {
 	if m != nil {
-		return m.Encryption
+		return m.Coordinator
 	}
-	return nil
+	return ""
 }
 
-type Stream struct {
-	Name                 string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Subject              string        `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
-	Partitions           []*Partition  `protobuf:"bytes,3,rep,name=partitions,proto3" json:"partitions,omitempty"`
-	Config               *StreamConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	CreationTimestamp    int64         `protobuf:"varint,5,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type UpdateStreamOwnerOp struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Stream) Reset()         { *m = Stream{} }
-func (m *Stream) String() string { return proto.CompactTextString(m) }
-func (*Stream) ProtoMessage()    {}
-func (*Stream) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{21}
+func (m *UpdateStreamOwnerOp) Reset()         { *m = UpdateStreamOwnerOp{} }
+func (m *UpdateStreamOwnerOp) String() string { return proto.CompactTextString(m) }
+func (*UpdateStreamOwnerOp) ProtoMessage()    {}
+func (*UpdateStreamOwnerOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{25}
 }
-func (m *Stream) XXX_Unmarshal(b []byte) error {
+func (m *UpdateStreamOwnerOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Stream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateStreamOwnerOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Stream.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateStreamOwnerOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1521,83 +2041,107 @@ func (m *Stream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Stream) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Stream.Merge(m, src)
+func (m *UpdateStreamOwnerOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStreamOwnerOp.Merge(m, src)
 }
-func (m *Stream) XXX_Size() int {
+func (m *UpdateStreamOwnerOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *Stream) XXX_DiscardUnknown() {
-	xxx_messageInfo_Stream.DiscardUnknown(m)
+func (m *UpdateStreamOwnerOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStreamOwnerOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Stream proto.InternalMessageInfo
+var xxx_messageInfo_UpdateStreamOwnerOp proto.InternalMessageInfo
 
-func (m *Stream) GetName() string {
+func (m *UpdateStreamOwnerOp) GetStream() string {
 	if m != nil {
-		return m.Name
+		return m.Stream
 	}
 	return ""
 }
 
-func (m *Stream) GetSubject() string {
+func (m *UpdateStreamOwnerOp) GetOwner() string {
 	if m != nil {
-		return m.Subject
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *Stream) GetPartitions() []*Partition {
-	if m != nil {
-		return m.Partitions
-	}
-	return nil
+// ReportPartitionSkewOp is applied by the metadata leader when a stream's
+// partitions become skewed, its hot partition changes, or it stops being
+// skewed.
+type ReportPartitionSkewOp struct {
+	Skew                 *StreamSkew `protobuf:"bytes,1,opt,name=skew,proto3" json:"skew,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *Stream) GetConfig() *StreamConfig {
-	if m != nil {
-		return m.Config
+func (m *ReportPartitionSkewOp) Reset()         { *m = ReportPartitionSkewOp{} }
+func (m *ReportPartitionSkewOp) String() string { return proto.CompactTextString(m) }
+func (*ReportPartitionSkewOp) ProtoMessage()    {}
+func (*ReportPartitionSkewOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{26}
+}
+func (m *ReportPartitionSkewOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportPartitionSkewOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportPartitionSkewOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *ReportPartitionSkewOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportPartitionSkewOp.Merge(m, src)
+}
+func (m *ReportPartitionSkewOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportPartitionSkewOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportPartitionSkewOp.DiscardUnknown(m)
 }
 
-func (m *Stream) GetCreationTimestamp() int64 {
+var xxx_messageInfo_ReportPartitionSkewOp proto.InternalMessageInfo
+
+func (m *ReportPartitionSkewOp) GetSkew() *StreamSkew {
 	if m != nil {
-		return m.CreationTimestamp
+		return m.Skew
 	}
-	return 0
+	return nil
 }
 
-type Partition struct {
-	Subject              string   `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
-	Stream               string   `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
-	Id                   int32    `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
-	Group                string   `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
-	ReplicationFactor    int32    `protobuf:"varint,5,opt,name=replicationFactor,proto3" json:"replicationFactor,omitempty"`
-	Replicas             []string `protobuf:"bytes,6,rep,name=replicas,proto3" json:"replicas,omitempty"`
-	Leader               string   `protobuf:"bytes,7,opt,name=leader,proto3" json:"leader,omitempty"`
-	Isr                  []string `protobuf:"bytes,8,rep,name=isr,proto3" json:"isr,omitempty"`
-	LeaderEpoch          uint64   `protobuf:"varint,9,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
-	Epoch                uint64   `protobuf:"varint,10,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Paused               bool     `protobuf:"varint,11,opt,name=paused,proto3" json:"paused,omitempty"`
-	Readonly             bool     `protobuf:"varint,12,opt,name=readonly,proto3" json:"readonly,omitempty"`
+// RegisterProducerOp registers an exclusive producer on a stream, fencing the
+// previous holder of the producer name. The Raft index of the operation is the
+// registration's epoch.
+type RegisterProducerOp struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Producer             string   `protobuf:"bytes,2,opt,name=producer,proto3" json:"producer,omitempty"`
+	Ttl                  int64    `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Partition) Reset()         { *m = Partition{} }
-func (m *Partition) String() string { return proto.CompactTextString(m) }
-func (*Partition) ProtoMessage()    {}
-func (*Partition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{22}
+func (m *RegisterProducerOp) Reset()         { *m = RegisterProducerOp{} }
+func (m *RegisterProducerOp) String() string { return proto.CompactTextString(m) }
+func (*RegisterProducerOp) ProtoMessage()    {}
+func (*RegisterProducerOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{27}
 }
-func (m *Partition) XXX_Unmarshal(b []byte) error {
+func (m *RegisterProducerOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Partition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RegisterProducerOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Partition.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RegisterProducerOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1607,122 +2151,69 @@ func (m *Partition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Partition) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Partition.Merge(m, src)
+func (m *RegisterProducerOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterProducerOp.Merge(m, src)
 }
-func (m *Partition) XXX_Size() int {
+func (m *RegisterProducerOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *Partition) XXX_DiscardUnknown() {
-	xxx_messageInfo_Partition.DiscardUnknown(m)
+func (m *RegisterProducerOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterProducerOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Partition proto.InternalMessageInfo
-
-func (m *Partition) GetSubject() string {
-	if m != nil {
-		return m.Subject
-	}
-	return ""
-}
+var xxx_messageInfo_RegisterProducerOp proto.InternalMessageInfo
 
-func (m *Partition) GetStream() string {
+func (m *RegisterProducerOp) GetStream() string {
 	if m != nil {
 		return m.Stream
 	}
 	return ""
 }
 
-func (m *Partition) GetId() int32 {
-	if m != nil {
-		return m.Id
-	}
-	return 0
-}
-
-func (m *Partition) GetGroup() string {
-	if m != nil {
-		return m.Group
-	}
-	return ""
-}
-
-func (m *Partition) GetReplicationFactor() int32 {
-	if m != nil {
-		return m.ReplicationFactor
-	}
-	return 0
-}
-
-func (m *Partition) GetReplicas() []string {
+func (m *RegisterProducerOp) GetProducer() string {
 	if m != nil {
-		return m.Replicas
-	}
-	return nil
-}
-
-func (m *Partition) GetLeader() string {
-	if m != nil {
-		return m.Leader
+		return m.Producer
 	}
 	return ""
 }
 
-func (m *Partition) GetIsr() []string {
-	if m != nil {
-		return m.Isr
-	}
-	return nil
-}
-
-func (m *Partition) GetLeaderEpoch() uint64 {
+func (m *RegisterProducerOp) GetTtl() int64 {
 	if m != nil {
-		return m.LeaderEpoch
+		return m.Ttl
 	}
 	return 0
 }
 
-func (m *Partition) GetEpoch() uint64 {
+func (m *RegisterProducerOp) GetExpiresAt() int64 {
 	if m != nil {
-		return m.Epoch
+		return m.ExpiresAt
 	}
 	return 0
 }
 
-func (m *Partition) GetPaused() bool {
-	if m != nil {
-		return m.Paused
-	}
-	return false
-}
-
-func (m *Partition) GetReadonly() bool {
-	if m != nil {
-		return m.Readonly
-	}
-	return false
-}
-
-type Consumer struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Streams              []string `protobuf:"bytes,2,rep,name=streams,proto3" json:"streams,omitempty"`
+// ReleaseProducerOp releases the registration of an exclusive producer if it
+// still has the given epoch.
+type ReleaseProducerOp struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Producer             string   `protobuf:"bytes,2,opt,name=producer,proto3" json:"producer,omitempty"`
+	Epoch                uint64   `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Consumer) Reset()         { *m = Consumer{} }
-func (m *Consumer) String() string { return proto.CompactTextString(m) }
-func (*Consumer) ProtoMessage()    {}
-func (*Consumer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{23}
+func (m *ReleaseProducerOp) Reset()         { *m = ReleaseProducerOp{} }
+func (m *ReleaseProducerOp) String() string { return proto.CompactTextString(m) }
+func (*ReleaseProducerOp) ProtoMessage()    {}
+func (*ReleaseProducerOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{28}
 }
-func (m *Consumer) XXX_Unmarshal(b []byte) error {
+func (m *ReleaseProducerOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Consumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReleaseProducerOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Consumer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReleaseProducerOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1732,54 +2223,59 @@ func (m *Consumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Consumer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Consumer.Merge(m, src)
+func (m *ReleaseProducerOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseProducerOp.Merge(m, src)
 }
-func (m *Consumer) XXX_Size() int {
+func (m *ReleaseProducerOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *Consumer) XXX_DiscardUnknown() {
-	xxx_messageInfo_Consumer.DiscardUnknown(m)
+func (m *ReleaseProducerOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseProducerOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Consumer proto.InternalMessageInfo
+var xxx_messageInfo_ReleaseProducerOp proto.InternalMessageInfo
 
-func (m *Consumer) GetId() string {
+func (m *ReleaseProducerOp) GetStream() string {
 	if m != nil {
-		return m.Id
+		return m.Stream
 	}
 	return ""
 }
 
-func (m *Consumer) GetStreams() []string {
+func (m *ReleaseProducerOp) GetProducer() string {
 	if m != nil {
-		return m.Streams
+		return m.Producer
 	}
-	return nil
+	return ""
 }
 
-type ConsumerGroup struct {
-	Id                   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Members              []*Consumer `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
-	Coordinator          string      `protobuf:"bytes,3,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
-	Epoch                uint64      `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *ReleaseProducerOp) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
 
-func (m *ConsumerGroup) Reset()         { *m = ConsumerGroup{} }
-func (m *ConsumerGroup) String() string { return proto.CompactTextString(m) }
-func (*ConsumerGroup) ProtoMessage()    {}
-func (*ConsumerGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{24}
+type PublishActivityOp struct {
+	RaftIndex            uint64   `protobuf:"varint,1,opt,name=raftIndex,proto3" json:"raftIndex,omitempty"`
+	ActivityEpoch        uint64   `protobuf:"varint,2,opt,name=activityEpoch,proto3" json:"activityEpoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ConsumerGroup) XXX_Unmarshal(b []byte) error {
+
+func (m *PublishActivityOp) Reset()         { *m = PublishActivityOp{} }
+func (m *PublishActivityOp) String() string { return proto.CompactTextString(m) }
+func (*PublishActivityOp) ProtoMessage()    {}
+func (*PublishActivityOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{29}
+}
+func (m *PublishActivityOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PublishActivityOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerGroup.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PublishActivityOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1789,67 +2285,54 @@ func (m *ConsumerGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *ConsumerGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerGroup.Merge(m, src)
+func (m *PublishActivityOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishActivityOp.Merge(m, src)
 }
-func (m *ConsumerGroup) XXX_Size() int {
+func (m *PublishActivityOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerGroup.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ConsumerGroup proto.InternalMessageInfo
-
-func (m *ConsumerGroup) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+func (m *PublishActivityOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishActivityOp.DiscardUnknown(m)
 }
 
-func (m *ConsumerGroup) GetMembers() []*Consumer {
-	if m != nil {
-		return m.Members
-	}
-	return nil
-}
+var xxx_messageInfo_PublishActivityOp proto.InternalMessageInfo
 
-func (m *ConsumerGroup) GetCoordinator() string {
+func (m *PublishActivityOp) GetRaftIndex() uint64 {
 	if m != nil {
-		return m.Coordinator
+		return m.RaftIndex
 	}
-	return ""
+	return 0
 }
 
-func (m *ConsumerGroup) GetEpoch() uint64 {
+func (m *PublishActivityOp) GetActivityEpoch() uint64 {
 	if m != nil {
-		return m.Epoch
+		return m.ActivityEpoch
 	}
 	return 0
 }
 
-// RaftJoinRequest is a request to join a Raft group.
-type RaftJoinRequest struct {
-	NodeID               string   `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	NodeAddr             string   `protobuf:"bytes,2,opt,name=nodeAddr,proto3" json:"nodeAddr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type SetStreamReadonlyOp struct {
+	Stream               string         `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partitions           []int32        `protobuf:"varint,2,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
+	Readonly             bool           `protobuf:"varint,3,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	TargetOffset         *NullableInt64 `protobuf:"bytes,4,opt,name=targetOffset,proto3" json:"targetOffset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *RaftJoinRequest) Reset()         { *m = RaftJoinRequest{} }
-func (m *RaftJoinRequest) String() string { return proto.CompactTextString(m) }
-func (*RaftJoinRequest) ProtoMessage()    {}
-func (*RaftJoinRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{25}
+func (m *SetStreamReadonlyOp) Reset()         { *m = SetStreamReadonlyOp{} }
+func (m *SetStreamReadonlyOp) String() string { return proto.CompactTextString(m) }
+func (*SetStreamReadonlyOp) ProtoMessage()    {}
+func (*SetStreamReadonlyOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{30}
 }
-func (m *RaftJoinRequest) XXX_Unmarshal(b []byte) error {
+func (m *SetStreamReadonlyOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RaftJoinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetStreamReadonlyOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RaftJoinRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetStreamReadonlyOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1859,52 +2342,65 @@ func (m *RaftJoinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *RaftJoinRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftJoinRequest.Merge(m, src)
+func (m *SetStreamReadonlyOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetStreamReadonlyOp.Merge(m, src)
 }
-func (m *RaftJoinRequest) XXX_Size() int {
+func (m *SetStreamReadonlyOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *RaftJoinRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftJoinRequest.DiscardUnknown(m)
+func (m *SetStreamReadonlyOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetStreamReadonlyOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RaftJoinRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetStreamReadonlyOp proto.InternalMessageInfo
 
-func (m *RaftJoinRequest) GetNodeID() string {
+func (m *SetStreamReadonlyOp) GetStream() string {
 	if m != nil {
-		return m.NodeID
+		return m.Stream
 	}
 	return ""
 }
 
-func (m *RaftJoinRequest) GetNodeAddr() string {
+func (m *SetStreamReadonlyOp) GetPartitions() []int32 {
 	if m != nil {
-		return m.NodeAddr
+		return m.Partitions
 	}
-	return ""
+	return nil
 }
 
-// RaftJoinResponse is a response to a RaftJoinRequest.
-type RaftJoinResponse struct {
-	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SetStreamReadonlyOp) GetReadonly() bool {
+	if m != nil {
+		return m.Readonly
+	}
+	return false
 }
 
-func (m *RaftJoinResponse) Reset()         { *m = RaftJoinResponse{} }
-func (m *RaftJoinResponse) String() string { return proto.CompactTextString(m) }
-func (*RaftJoinResponse) ProtoMessage()    {}
-func (*RaftJoinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{26}
+func (m *SetStreamReadonlyOp) GetTargetOffset() *NullableInt64 {
+	if m != nil {
+		return m.TargetOffset
+	}
+	return nil
 }
-func (m *RaftJoinResponse) XXX_Unmarshal(b []byte) error {
+
+type CreateConsumerGroupOp struct {
+	ConsumerGroup        *ConsumerGroup `protobuf:"bytes,1,opt,name=consumerGroup,proto3" json:"consumerGroup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CreateConsumerGroupOp) Reset()         { *m = CreateConsumerGroupOp{} }
+func (m *CreateConsumerGroupOp) String() string { return proto.CompactTextString(m) }
+func (*CreateConsumerGroupOp) ProtoMessage()    {}
+func (*CreateConsumerGroupOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{31}
+}
+func (m *CreateConsumerGroupOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RaftJoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateConsumerGroupOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RaftJoinResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateConsumerGroupOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1914,45 +2410,46 @@ func (m *RaftJoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *RaftJoinResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftJoinResponse.Merge(m, src)
+func (m *CreateConsumerGroupOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateConsumerGroupOp.Merge(m, src)
 }
-func (m *RaftJoinResponse) XXX_Size() int {
+func (m *CreateConsumerGroupOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *RaftJoinResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftJoinResponse.DiscardUnknown(m)
+func (m *CreateConsumerGroupOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateConsumerGroupOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RaftJoinResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateConsumerGroupOp proto.InternalMessageInfo
 
-func (m *RaftJoinResponse) GetError() string {
+func (m *CreateConsumerGroupOp) GetConsumerGroup() *ConsumerGroup {
 	if m != nil {
-		return m.Error
+		return m.ConsumerGroup
 	}
-	return ""
+	return nil
 }
 
-type MetadataSnapshot struct {
-	Streams              []*Stream        `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
-	Groups               []*ConsumerGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type JoinConsumerGroupOp struct {
+	GroupId              string   `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	ConsumerId           string   `protobuf:"bytes,2,opt,name=consumerId,proto3" json:"consumerId,omitempty"`
+	Streams              []string `protobuf:"bytes,3,rep,name=streams,proto3" json:"streams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MetadataSnapshot) Reset()         { *m = MetadataSnapshot{} }
-func (m *MetadataSnapshot) String() string { return proto.CompactTextString(m) }
-func (*MetadataSnapshot) ProtoMessage()    {}
-func (*MetadataSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{27}
+func (m *JoinConsumerGroupOp) Reset()         { *m = JoinConsumerGroupOp{} }
+func (m *JoinConsumerGroupOp) String() string { return proto.CompactTextString(m) }
+func (*JoinConsumerGroupOp) ProtoMessage()    {}
+func (*JoinConsumerGroupOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{32}
 }
-func (m *MetadataSnapshot) XXX_Unmarshal(b []byte) error {
+func (m *JoinConsumerGroupOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MetadataSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JoinConsumerGroupOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MetadataSnapshot.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JoinConsumerGroupOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1962,53 +2459,60 @@ func (m *MetadataSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MetadataSnapshot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MetadataSnapshot.Merge(m, src)
+func (m *JoinConsumerGroupOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinConsumerGroupOp.Merge(m, src)
 }
-func (m *MetadataSnapshot) XXX_Size() int {
+func (m *JoinConsumerGroupOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *MetadataSnapshot) XXX_DiscardUnknown() {
-	xxx_messageInfo_MetadataSnapshot.DiscardUnknown(m)
+func (m *JoinConsumerGroupOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinConsumerGroupOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MetadataSnapshot proto.InternalMessageInfo
+var xxx_messageInfo_JoinConsumerGroupOp proto.InternalMessageInfo
 
-func (m *MetadataSnapshot) GetStreams() []*Stream {
+func (m *JoinConsumerGroupOp) GetGroupId() string {
 	if m != nil {
-		return m.Streams
+		return m.GroupId
 	}
-	return nil
+	return ""
 }
 
-func (m *MetadataSnapshot) GetGroups() []*ConsumerGroup {
+func (m *JoinConsumerGroupOp) GetConsumerId() string {
 	if m != nil {
-		return m.Groups
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *JoinConsumerGroupOp) GetStreams() []string {
+	if m != nil {
+		return m.Streams
 	}
 	return nil
 }
 
-type ReplicationRequest struct {
-	ReplicaID            string   `protobuf:"bytes,1,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
-	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	LeaderEpoch          uint64   `protobuf:"varint,3,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+type LeaveConsumerGroupOp struct {
+	GroupId              string   `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	ConsumerId           string   `protobuf:"bytes,2,opt,name=consumerId,proto3" json:"consumerId,omitempty"`
+	Expired              bool     `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReplicationRequest) Reset()         { *m = ReplicationRequest{} }
-func (m *ReplicationRequest) String() string { return proto.CompactTextString(m) }
-func (*ReplicationRequest) ProtoMessage()    {}
-func (*ReplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{28}
+func (m *LeaveConsumerGroupOp) Reset()         { *m = LeaveConsumerGroupOp{} }
+func (m *LeaveConsumerGroupOp) String() string { return proto.CompactTextString(m) }
+func (*LeaveConsumerGroupOp) ProtoMessage()    {}
+func (*LeaveConsumerGroupOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{33}
 }
-func (m *ReplicationRequest) XXX_Unmarshal(b []byte) error {
+func (m *LeaveConsumerGroupOp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LeaveConsumerGroupOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReplicationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LeaveConsumerGroupOp.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2018,58 +2522,58 @@ func (m *ReplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ReplicationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplicationRequest.Merge(m, src)
+func (m *LeaveConsumerGroupOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveConsumerGroupOp.Merge(m, src)
 }
-func (m *ReplicationRequest) XXX_Size() int {
+func (m *LeaveConsumerGroupOp) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReplicationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplicationRequest.DiscardUnknown(m)
+func (m *LeaveConsumerGroupOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveConsumerGroupOp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplicationRequest proto.InternalMessageInfo
+var xxx_messageInfo_LeaveConsumerGroupOp proto.InternalMessageInfo
 
-func (m *ReplicationRequest) GetReplicaID() string {
+func (m *LeaveConsumerGroupOp) GetGroupId() string {
 	if m != nil {
-		return m.ReplicaID
+		return m.GroupId
 	}
 	return ""
 }
 
-func (m *ReplicationRequest) GetOffset() int64 {
+func (m *LeaveConsumerGroupOp) GetConsumerId() string {
 	if m != nil {
-		return m.Offset
+		return m.ConsumerId
 	}
-	return 0
+	return ""
 }
 
-func (m *ReplicationRequest) GetLeaderEpoch() uint64 {
+func (m *LeaveConsumerGroupOp) GetExpired() bool {
 	if m != nil {
-		return m.LeaderEpoch
+		return m.Expired
 	}
-	return 0
+	return false
 }
 
-type LeaderEpochOffsetRequest struct {
-	LeaderEpoch          uint64   `protobuf:"varint,1,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+type NullableInt64 struct {
+	Value                int64    `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LeaderEpochOffsetRequest) Reset()         { *m = LeaderEpochOffsetRequest{} }
-func (m *LeaderEpochOffsetRequest) String() string { return proto.CompactTextString(m) }
-func (*LeaderEpochOffsetRequest) ProtoMessage()    {}
-func (*LeaderEpochOffsetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{29}
+func (m *NullableInt64) Reset()         { *m = NullableInt64{} }
+func (m *NullableInt64) String() string { return proto.CompactTextString(m) }
+func (*NullableInt64) ProtoMessage()    {}
+func (*NullableInt64) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{34}
 }
-func (m *LeaderEpochOffsetRequest) XXX_Unmarshal(b []byte) error {
+func (m *NullableInt64) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LeaderEpochOffsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NullableInt64) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LeaderEpochOffsetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NullableInt64.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2079,44 +2583,44 @@ func (m *LeaderEpochOffsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *LeaderEpochOffsetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LeaderEpochOffsetRequest.Merge(m, src)
+func (m *NullableInt64) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NullableInt64.Merge(m, src)
 }
-func (m *LeaderEpochOffsetRequest) XXX_Size() int {
+func (m *NullableInt64) XXX_Size() int {
 	return m.Size()
 }
-func (m *LeaderEpochOffsetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LeaderEpochOffsetRequest.DiscardUnknown(m)
+func (m *NullableInt64) XXX_DiscardUnknown() {
+	xxx_messageInfo_NullableInt64.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LeaderEpochOffsetRequest proto.InternalMessageInfo
+var xxx_messageInfo_NullableInt64 proto.InternalMessageInfo
 
-func (m *LeaderEpochOffsetRequest) GetLeaderEpoch() uint64 {
+func (m *NullableInt64) GetValue() int64 {
 	if m != nil {
-		return m.LeaderEpoch
+		return m.Value
 	}
 	return 0
 }
 
-type LeaderEpochOffsetResponse struct {
-	EndOffset            int64    `protobuf:"varint,1,opt,name=endOffset,proto3" json:"endOffset,omitempty"`
+type NullableInt32 struct {
+	Value                int32    `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LeaderEpochOffsetResponse) Reset()         { *m = LeaderEpochOffsetResponse{} }
-func (m *LeaderEpochOffsetResponse) String() string { return proto.CompactTextString(m) }
-func (*LeaderEpochOffsetResponse) ProtoMessage()    {}
-func (*LeaderEpochOffsetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{30}
+func (m *NullableInt32) Reset()         { *m = NullableInt32{} }
+func (m *NullableInt32) String() string { return proto.CompactTextString(m) }
+func (*NullableInt32) ProtoMessage()    {}
+func (*NullableInt32) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{35}
 }
-func (m *LeaderEpochOffsetResponse) XXX_Unmarshal(b []byte) error {
+func (m *NullableInt32) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LeaderEpochOffsetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NullableInt32) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LeaderEpochOffsetResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NullableInt32.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2126,55 +2630,44 @@ func (m *LeaderEpochOffsetResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *LeaderEpochOffsetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LeaderEpochOffsetResponse.Merge(m, src)
+func (m *NullableInt32) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NullableInt32.Merge(m, src)
 }
-func (m *LeaderEpochOffsetResponse) XXX_Size() int {
+func (m *NullableInt32) XXX_Size() int {
 	return m.Size()
 }
-func (m *LeaderEpochOffsetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LeaderEpochOffsetResponse.DiscardUnknown(m)
+func (m *NullableInt32) XXX_DiscardUnknown() {
+	xxx_messageInfo_NullableInt32.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LeaderEpochOffsetResponse proto.InternalMessageInfo
+var xxx_messageInfo_NullableInt32 proto.InternalMessageInfo
 
-func (m *LeaderEpochOffsetResponse) GetEndOffset() int64 {
+func (m *NullableInt32) GetValue() int32 {
 	if m != nil {
-		return m.EndOffset
+		return m.Value
 	}
 	return 0
 }
 
-type PropagatedRequest struct {
-	Op                               Op                                `protobuf:"varint,1,opt,name=op,proto3,enum=protocol.Op" json:"op,omitempty"`
-	CreateStreamOp                   *CreateStreamOp                   `protobuf:"bytes,2,opt,name=createStreamOp,proto3" json:"createStreamOp,omitempty"`
-	ShrinkISROp                      *ShrinkISROp                      `protobuf:"bytes,3,opt,name=shrinkISROp,proto3" json:"shrinkISROp,omitempty"`
-	ReportLeaderOp                   *ReportLeaderOp                   `protobuf:"bytes,4,opt,name=reportLeaderOp,proto3" json:"reportLeaderOp,omitempty"`
-	ExpandISROp                      *ExpandISROp                      `protobuf:"bytes,5,opt,name=expandISROp,proto3" json:"expandISROp,omitempty"`
-	DeleteStreamOp                   *DeleteStreamOp                   `protobuf:"bytes,6,opt,name=deleteStreamOp,proto3" json:"deleteStreamOp,omitempty"`
-	PauseStreamOp                    *PauseStreamOp                    `protobuf:"bytes,7,opt,name=pauseStreamOp,proto3" json:"pauseStreamOp,omitempty"`
-	ResumeStreamOp                   *ResumeStreamOp                   `protobuf:"bytes,8,opt,name=resumeStreamOp,proto3" json:"resumeStreamOp,omitempty"`
-	SetStreamReadonlyOp              *SetStreamReadonlyOp              `protobuf:"bytes,9,opt,name=setStreamReadonlyOp,proto3" json:"setStreamReadonlyOp,omitempty"`
-	JoinConsumerGroupOp              *JoinConsumerGroupOp              `protobuf:"bytes,10,opt,name=joinConsumerGroupOp,proto3" json:"joinConsumerGroupOp,omitempty"`
-	LeaveConsumerGroupOp             *LeaveConsumerGroupOp             `protobuf:"bytes,11,opt,name=leaveConsumerGroupOp,proto3" json:"leaveConsumerGroupOp,omitempty"`
-	ReportConsumerGroupCoordinatorOp *ReportConsumerGroupCoordinatorOp `protobuf:"bytes,12,opt,name=reportConsumerGroupCoordinatorOp,proto3" json:"reportConsumerGroupCoordinatorOp,omitempty"`
-	XXX_NoUnkeyedLiteral             struct{}                          `json:"-"`
-	XXX_unrecognized                 []byte                            `json:"-"`
-	XXX_sizecache                    int32                             `json:"-"`
+type NullableBool struct {
+	Value                bool     `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PropagatedRequest) Reset()         { *m = PropagatedRequest{} }
-func (m *PropagatedRequest) String() string { return proto.CompactTextString(m) }
-func (*PropagatedRequest) ProtoMessage()    {}
-func (*PropagatedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{31}
+func (m *NullableBool) Reset()         { *m = NullableBool{} }
+func (m *NullableBool) String() string { return proto.CompactTextString(m) }
+func (*NullableBool) ProtoMessage()    {}
+func (*NullableBool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{36}
 }
-func (m *PropagatedRequest) XXX_Unmarshal(b []byte) error {
+func (m *NullableBool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PropagatedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NullableBool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PropagatedRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NullableBool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2184,252 +2677,262 @@ func (m *PropagatedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *PropagatedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PropagatedRequest.Merge(m, src)
+func (m *NullableBool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NullableBool.Merge(m, src)
 }
-func (m *PropagatedRequest) XXX_Size() int {
+func (m *NullableBool) XXX_Size() int {
 	return m.Size()
 }
-func (m *PropagatedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PropagatedRequest.DiscardUnknown(m)
+func (m *NullableBool) XXX_DiscardUnknown() {
+	xxx_messageInfo_NullableBool.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PropagatedRequest proto.InternalMessageInfo
+var xxx_messageInfo_NullableBool proto.InternalMessageInfo
 
-func (m *PropagatedRequest) GetOp() Op {
+func (m *NullableBool) GetValue() bool {
 	if m != nil {
-		return m.Op
+		return m.Value
 	}
-	return Op_CREATE_STREAM
+	return false
 }
 
-func (m *PropagatedRequest) GetCreateStreamOp() *CreateStreamOp {
+type StreamConfig struct {
+	RetentionMaxBytes             *NullableInt64 `protobuf:"bytes,1,opt,name=retentionMaxBytes,proto3" json:"retentionMaxBytes,omitempty"`
+	RetentionMaxMessages          *NullableInt64 `protobuf:"bytes,2,opt,name=retentionMaxMessages,proto3" json:"retentionMaxMessages,omitempty"`
+	RetentionMaxAge               *NullableInt64 `protobuf:"bytes,3,opt,name=retentionMaxAge,proto3" json:"retentionMaxAge,omitempty"`
+	CleanerInterval               *NullableInt64 `protobuf:"bytes,4,opt,name=cleanerInterval,proto3" json:"cleanerInterval,omitempty"`
+	SegmentMaxBytes               *NullableInt64 `protobuf:"bytes,5,opt,name=segmentMaxBytes,proto3" json:"segmentMaxBytes,omitempty"`
+	SegmentMaxAge                 *NullableInt64 `protobuf:"bytes,6,opt,name=segmentMaxAge,proto3" json:"segmentMaxAge,omitempty"`
+	CompactMaxGoroutines          *NullableInt32 `protobuf:"bytes,7,opt,name=compactMaxGoroutines,proto3" json:"compactMaxGoroutines,omitempty"`
+	CompactEnabled                *NullableBool  `protobuf:"bytes,8,opt,name=compactEnabled,proto3" json:"compactEnabled,omitempty"`
+	AutoPauseTime                 *NullableInt64 `protobuf:"bytes,9,opt,name=autoPauseTime,proto3" json:"autoPauseTime,omitempty"`
+	AutoPauseDisableIfSubscribers *NullableBool  `protobuf:"bytes,10,opt,name=autoPauseDisableIfSubscribers,proto3" json:"autoPauseDisableIfSubscribers,omitempty"`
+	MinIsr                        *NullableInt32 `protobuf:"bytes,11,opt,name=minIsr,proto3" json:"minIsr,omitempty"`
+	OptimisticConcurrencyControl  *NullableBool  `protobuf:"bytes,12,opt,name=optimisticConcurrencyControl,proto3" json:"optimisticConcurrencyControl,omitempty"`
+	Encryption                    *NullableBool  `protobuf:"bytes,13,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	SegmentEncryption             *NullableBool  `protobuf:"bytes,14,opt,name=segmentEncryption,proto3" json:"segmentEncryption,omitempty"`
+	DeadLetterQueue               string         `protobuf:"bytes,15,opt,name=deadLetterQueue,proto3" json:"deadLetterQueue,omitempty"`
+	MirrorSource                  *MirrorSource  `protobuf:"bytes,16,opt,name=mirrorSource,proto3" json:"mirrorSource,omitempty"`
+	MessageTimestampType          string         `protobuf:"bytes,17,opt,name=messageTimestampType,proto3" json:"messageTimestampType,omitempty"`
+	MessageTimestampMaxDifference *NullableInt64 `protobuf:"bytes,18,opt,name=messageTimestampMaxDifference,proto3" json:"messageTimestampMaxDifference,omitempty"`
+	Ttl                           *NullableInt64 `protobuf:"bytes,19,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	AutoResumeOnPublish           *NullableBool  `protobuf:"bytes,20,opt,name=autoResumeOnPublish,proto3" json:"autoResumeOnPublish,omitempty"`
+	MaxMessageBytes               *NullableInt64 `protobuf:"bytes,21,opt,name=maxMessageBytes,proto3" json:"maxMessageBytes,omitempty"`
+	RestoreSource                 *RestoreSource `protobuf:"bytes,22,opt,name=restoreSource,proto3" json:"restoreSource,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{}       `json:"-"`
+	XXX_unrecognized              []byte         `json:"-"`
+	XXX_sizecache                 int32          `json:"-"`
+}
+
+func (m *StreamConfig) Reset()         { *m = StreamConfig{} }
+func (m *StreamConfig) String() string { return proto.CompactTextString(m) }
+func (*StreamConfig) ProtoMessage()    {}
+func (*StreamConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{37}
+}
+func (m *StreamConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamConfig.Merge(m, src)
+}
+func (m *StreamConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamConfig proto.InternalMessageInfo
+
+func (m *StreamConfig) GetRetentionMaxBytes() *NullableInt64 {
 	if m != nil {
-		return m.CreateStreamOp
+		return m.RetentionMaxBytes
 	}
 	return nil
 }
 
-func (m *PropagatedRequest) GetShrinkISROp() *ShrinkISROp {
+func (m *StreamConfig) GetRetentionMaxMessages() *NullableInt64 {
 	if m != nil {
-		return m.ShrinkISROp
+		return m.RetentionMaxMessages
 	}
 	return nil
 }
 
-func (m *PropagatedRequest) GetReportLeaderOp() *ReportLeaderOp {
+func (m *StreamConfig) GetRetentionMaxAge() *NullableInt64 {
 	if m != nil {
-		return m.ReportLeaderOp
+		return m.RetentionMaxAge
 	}
 	return nil
 }
 
-func (m *PropagatedRequest) GetExpandISROp() *ExpandISROp {
+func (m *StreamConfig) GetCleanerInterval() *NullableInt64 {
 	if m != nil {
-		return m.ExpandISROp
+		return m.CleanerInterval
 	}
 	return nil
 }
 
-func (m *PropagatedRequest) GetDeleteStreamOp() *DeleteStreamOp {
+func (m *StreamConfig) GetSegmentMaxBytes() *NullableInt64 {
 	if m != nil {
-		return m.DeleteStreamOp
+		return m.SegmentMaxBytes
 	}
 	return nil
 }
 
-func (m *PropagatedRequest) GetPauseStreamOp() *PauseStreamOp {
+func (m *StreamConfig) GetSegmentMaxAge() *NullableInt64 {
 	if m != nil {
-		return m.PauseStreamOp
+		return m.SegmentMaxAge
 	}
 	return nil
 }
 
-func (m *PropagatedRequest) GetResumeStreamOp() *ResumeStreamOp {
+func (m *StreamConfig) GetCompactMaxGoroutines() *NullableInt32 {
 	if m != nil {
-		return m.ResumeStreamOp
+		return m.CompactMaxGoroutines
 	}
 	return nil
 }
 
-func (m *PropagatedRequest) GetSetStreamReadonlyOp() *SetStreamReadonlyOp {
+func (m *StreamConfig) GetCompactEnabled() *NullableBool {
 	if m != nil {
-		return m.SetStreamReadonlyOp
+		return m.CompactEnabled
 	}
 	return nil
 }
 
-func (m *PropagatedRequest) GetJoinConsumerGroupOp() *JoinConsumerGroupOp {
+func (m *StreamConfig) GetAutoPauseTime() *NullableInt64 {
 	if m != nil {
-		return m.JoinConsumerGroupOp
+		return m.AutoPauseTime
 	}
 	return nil
 }
 
-func (m *PropagatedRequest) GetLeaveConsumerGroupOp() *LeaveConsumerGroupOp {
+func (m *StreamConfig) GetAutoPauseDisableIfSubscribers() *NullableBool {
 	if m != nil {
-		return m.LeaveConsumerGroupOp
+		return m.AutoPauseDisableIfSubscribers
 	}
 	return nil
 }
 
-func (m *PropagatedRequest) GetReportConsumerGroupCoordinatorOp() *ReportConsumerGroupCoordinatorOp {
+func (m *StreamConfig) GetMinIsr() *NullableInt32 {
 	if m != nil {
-		return m.ReportConsumerGroupCoordinatorOp
+		return m.MinIsr
 	}
 	return nil
 }
 
-type Error struct {
-	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamConfig) GetOptimisticConcurrencyControl() *NullableBool {
+	if m != nil {
+		return m.OptimisticConcurrencyControl
+	}
+	return nil
 }
 
-func (m *Error) Reset()         { *m = Error{} }
-func (m *Error) String() string { return proto.CompactTextString(m) }
-func (*Error) ProtoMessage()    {}
-func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{32}
-}
-func (m *Error) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Error.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *StreamConfig) GetEncryption() *NullableBool {
+	if m != nil {
+		return m.Encryption
 	}
-}
-func (m *Error) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Error.Merge(m, src)
-}
-func (m *Error) XXX_Size() int {
-	return m.Size()
-}
-func (m *Error) XXX_DiscardUnknown() {
-	xxx_messageInfo_Error.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_Error proto.InternalMessageInfo
-
-func (m *Error) GetCode() uint32 {
+func (m *StreamConfig) GetSegmentEncryption() *NullableBool {
 	if m != nil {
-		return m.Code
+		return m.SegmentEncryption
 	}
-	return 0
+	return nil
 }
 
-func (m *Error) GetMsg() string {
+func (m *StreamConfig) GetDeadLetterQueue() string {
 	if m != nil {
-		return m.Msg
+		return m.DeadLetterQueue
 	}
 	return ""
 }
 
-type PropagatedResponse struct {
-	Op                    Op                                            `protobuf:"varint,1,opt,name=op,proto3,enum=protocol.Op" json:"op,omitempty"`
-	Error                 *Error                                        `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	JoinConsumerGroupResp *PropagatedResponse_JoinConsumerGroupResponse `protobuf:"bytes,11,opt,name=joinConsumerGroupResp,proto3" json:"joinConsumerGroupResp,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}                                      `json:"-"`
-	XXX_unrecognized      []byte                                        `json:"-"`
-	XXX_sizecache         int32                                         `json:"-"`
+func (m *StreamConfig) GetMirrorSource() *MirrorSource {
+	if m != nil {
+		return m.MirrorSource
+	}
+	return nil
 }
 
-func (m *PropagatedResponse) Reset()         { *m = PropagatedResponse{} }
-func (m *PropagatedResponse) String() string { return proto.CompactTextString(m) }
-func (*PropagatedResponse) ProtoMessage()    {}
-func (*PropagatedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{33}
-}
-func (m *PropagatedResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *PropagatedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_PropagatedResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *StreamConfig) GetMessageTimestampType() string {
+	if m != nil {
+		return m.MessageTimestampType
 	}
+	return ""
 }
-func (m *PropagatedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PropagatedResponse.Merge(m, src)
-}
-func (m *PropagatedResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *PropagatedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PropagatedResponse.DiscardUnknown(m)
+
+func (m *StreamConfig) GetMessageTimestampMaxDifference() *NullableInt64 {
+	if m != nil {
+		return m.MessageTimestampMaxDifference
+	}
+	return nil
 }
 
-var xxx_messageInfo_PropagatedResponse proto.InternalMessageInfo
+func (m *StreamConfig) GetTtl() *NullableInt64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
 
-func (m *PropagatedResponse) GetOp() Op {
+func (m *StreamConfig) GetAutoResumeOnPublish() *NullableBool {
 	if m != nil {
-		return m.Op
+		return m.AutoResumeOnPublish
 	}
-	return Op_CREATE_STREAM
+	return nil
 }
 
-func (m *PropagatedResponse) GetError() *Error {
+func (m *StreamConfig) GetMaxMessageBytes() *NullableInt64 {
 	if m != nil {
-		return m.Error
+		return m.MaxMessageBytes
 	}
 	return nil
 }
 
-func (m *PropagatedResponse) GetJoinConsumerGroupResp() *PropagatedResponse_JoinConsumerGroupResponse {
+func (m *StreamConfig) GetRestoreSource() *RestoreSource {
 	if m != nil {
-		return m.JoinConsumerGroupResp
+		return m.RestoreSource
 	}
 	return nil
 }
 
-// Reserving = 3 for createStreamResp if needed.
-// Reserving = 4 for shrinkISRResp if needed.
-// Reserving = 5 for reportLeaderResp if needed.
-// Reserving = 6 for expandISRResp if needed.
-// Reserving = 7 for deleteStreamResp if needed.
-// Reserving = 8 for pauseStreamResp if needed.
-// Reserving = 9 for resumeStreamResp if needed.
-// Reserving = 10 for setStreamReadonlyResp if needed.
-type PropagatedResponse_JoinConsumerGroupResponse struct {
-	Coordinator          string   `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
-	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+// RestoreSource records the backup a stream was restored from. The leader of
+// each partition copies the backed up messages into its log before it starts
+// consuming messages.
+type RestoreSource struct {
+	Offsets              []int64  `protobuf:"varint,1,rep,packed,name=offsets,proto3" json:"offsets,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PropagatedResponse_JoinConsumerGroupResponse) Reset() {
-	*m = PropagatedResponse_JoinConsumerGroupResponse{}
-}
-func (m *PropagatedResponse_JoinConsumerGroupResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*PropagatedResponse_JoinConsumerGroupResponse) ProtoMessage() {}
-func (*PropagatedResponse_JoinConsumerGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{33, 0}
+func (m *RestoreSource) Reset()         { *m = RestoreSource{} }
+func (m *RestoreSource) String() string { return proto.CompactTextString(m) }
+func (*RestoreSource) ProtoMessage()    {}
+func (*RestoreSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{38}
 }
-func (m *PropagatedResponse_JoinConsumerGroupResponse) XXX_Unmarshal(b []byte) error {
+func (m *RestoreSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PropagatedResponse_JoinConsumerGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RestoreSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PropagatedResponse_JoinConsumerGroupResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RestoreSource.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2439,51 +2942,49 @@ func (m *PropagatedResponse_JoinConsumerGroupResponse) XXX_Marshal(b []byte, det
 		return b[:n], nil
 	}
 }
-func (m *PropagatedResponse_JoinConsumerGroupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PropagatedResponse_JoinConsumerGroupResponse.Merge(m, src)
+func (m *RestoreSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreSource.Merge(m, src)
 }
-func (m *PropagatedResponse_JoinConsumerGroupResponse) XXX_Size() int {
+func (m *RestoreSource) XXX_Size() int {
 	return m.Size()
 }
-func (m *PropagatedResponse_JoinConsumerGroupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PropagatedResponse_JoinConsumerGroupResponse.DiscardUnknown(m)
+func (m *RestoreSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreSource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PropagatedResponse_JoinConsumerGroupResponse proto.InternalMessageInfo
-
-func (m *PropagatedResponse_JoinConsumerGroupResponse) GetCoordinator() string {
-	if m != nil {
-		return m.Coordinator
-	}
-	return ""
-}
+var xxx_messageInfo_RestoreSource proto.InternalMessageInfo
 
-func (m *PropagatedResponse_JoinConsumerGroupResponse) GetEpoch() uint64 {
+func (m *RestoreSource) GetOffsets() []int64 {
 	if m != nil {
-		return m.Epoch
+		return m.Offsets
 	}
-	return 0
+	return nil
 }
 
-type ServerInfoRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// MirrorSource is the stream in another Liftbridge cluster a mirror stream
+// copies. Each partition of the mirror copies the source partition with the
+// same ID.
+type MirrorSource struct {
+	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Stream               string   `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	StartOffset          int64    `protobuf:"varint,3,opt,name=startOffset,proto3" json:"startOffset,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ServerInfoRequest) Reset()         { *m = ServerInfoRequest{} }
-func (m *ServerInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*ServerInfoRequest) ProtoMessage()    {}
-func (*ServerInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{34}
+func (m *MirrorSource) Reset()         { *m = MirrorSource{} }
+func (m *MirrorSource) String() string { return proto.CompactTextString(m) }
+func (*MirrorSource) ProtoMessage()    {}
+func (*MirrorSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{39}
 }
-func (m *ServerInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *MirrorSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ServerInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MirrorSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ServerInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MirrorSource.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2493,46 +2994,67 @@ func (m *ServerInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ServerInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ServerInfoRequest.Merge(m, src)
+func (m *MirrorSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorSource.Merge(m, src)
 }
-func (m *ServerInfoRequest) XXX_Size() int {
+func (m *MirrorSource) XXX_Size() int {
 	return m.Size()
 }
-func (m *ServerInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ServerInfoRequest.DiscardUnknown(m)
+func (m *MirrorSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorSource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ServerInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_MirrorSource proto.InternalMessageInfo
 
-func (m *ServerInfoRequest) GetId() string {
+func (m *MirrorSource) GetAddresses() []string {
 	if m != nil {
-		return m.Id
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *MirrorSource) GetStream() string {
+	if m != nil {
+		return m.Stream
 	}
 	return ""
 }
 
-type ServerInfoResponse struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Host                 string   `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
-	Port                 int32    `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+func (m *MirrorSource) GetStartOffset() int64 {
+	if m != nil {
+		return m.StartOffset
+	}
+	return 0
+}
+
+// StreamOrigin records where a stream came from. It's populated by the server
+// when the stream is created rather than provided by the client.
+type StreamOrigin struct {
+	CreatedBy            string   `protobuf:"bytes,1,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Broker               string   `protobuf:"bytes,3,opt,name=broker,proto3" json:"broker,omitempty"`
+	ApplicationName      string   `protobuf:"bytes,4,opt,name=applicationName,proto3" json:"applicationName,omitempty"`
+	ApplicationVersion   string   `protobuf:"bytes,5,opt,name=applicationVersion,proto3" json:"applicationVersion,omitempty"`
+	Partitions           int32    `protobuf:"varint,6,opt,name=partitions,proto3" json:"partitions,omitempty"`
+	ReplicationFactor    int32    `protobuf:"varint,7,opt,name=replicationFactor,proto3" json:"replicationFactor,omitempty"`
+	Group                string   `protobuf:"bytes,8,opt,name=group,proto3" json:"group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ServerInfoResponse) Reset()         { *m = ServerInfoResponse{} }
-func (m *ServerInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*ServerInfoResponse) ProtoMessage()    {}
-func (*ServerInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{35}
+func (m *StreamOrigin) Reset()         { *m = StreamOrigin{} }
+func (m *StreamOrigin) String() string { return proto.CompactTextString(m) }
+func (*StreamOrigin) ProtoMessage()    {}
+func (*StreamOrigin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{40}
 }
-func (m *ServerInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *StreamOrigin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ServerInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamOrigin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ServerInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamOrigin.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2542,59 +3064,103 @@ func (m *ServerInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ServerInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ServerInfoResponse.Merge(m, src)
+func (m *StreamOrigin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrigin.Merge(m, src)
 }
-func (m *ServerInfoResponse) XXX_Size() int {
+func (m *StreamOrigin) XXX_Size() int {
 	return m.Size()
 }
-func (m *ServerInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ServerInfoResponse.DiscardUnknown(m)
+func (m *StreamOrigin) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrigin.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ServerInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamOrigin proto.InternalMessageInfo
 
-func (m *ServerInfoResponse) GetId() string {
+func (m *StreamOrigin) GetCreatedBy() string {
 	if m != nil {
-		return m.Id
+		return m.CreatedBy
 	}
 	return ""
 }
 
-func (m *ServerInfoResponse) GetHost() string {
+func (m *StreamOrigin) GetOwner() string {
 	if m != nil {
-		return m.Host
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *ServerInfoResponse) GetPort() int32 {
+func (m *StreamOrigin) GetBroker() string {
 	if m != nil {
-		return m.Port
+		return m.Broker
+	}
+	return ""
+}
+
+func (m *StreamOrigin) GetApplicationName() string {
+	if m != nil {
+		return m.ApplicationName
+	}
+	return ""
+}
+
+func (m *StreamOrigin) GetApplicationVersion() string {
+	if m != nil {
+		return m.ApplicationVersion
+	}
+	return ""
+}
+
+func (m *StreamOrigin) GetPartitions() int32 {
+	if m != nil {
+		return m.Partitions
 	}
 	return 0
 }
 
-type PartitionStatusRequest struct {
-	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
-	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StreamOrigin) GetReplicationFactor() int32 {
+	if m != nil {
+		return m.ReplicationFactor
+	}
+	return 0
 }
 
-func (m *PartitionStatusRequest) Reset()         { *m = PartitionStatusRequest{} }
-func (m *PartitionStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*PartitionStatusRequest) ProtoMessage()    {}
-func (*PartitionStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{36}
+func (m *StreamOrigin) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
 }
-func (m *PartitionStatusRequest) XXX_Unmarshal(b []byte) error {
+
+type Stream struct {
+	Name                 string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Subject              string               `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Partitions           []*Partition         `protobuf:"bytes,3,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	Config               *StreamConfig        `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	CreationTimestamp    int64                `protobuf:"varint,5,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	Origin               *StreamOrigin        `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	Producers            []*ExclusiveProducer `protobuf:"bytes,7,rep,name=producers,proto3" json:"producers,omitempty"`
+	Primary              string               `protobuf:"bytes,8,opt,name=primary,proto3" json:"primary,omitempty"`
+	Companions           []string             `protobuf:"bytes,9,rep,name=companions,proto3" json:"companions,omitempty"`
+	Tags                 []*StreamTag         `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	Acl                  *StreamACL           `protobuf:"bytes,11,opt,name=acl,proto3" json:"acl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Stream) Reset()         { *m = Stream{} }
+func (m *Stream) String() string { return proto.CompactTextString(m) }
+func (*Stream) ProtoMessage()    {}
+func (*Stream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{41}
+}
+func (m *Stream) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PartitionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Stream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PartitionStatusRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Stream.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2604,52 +3170,117 @@ func (m *PartitionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *PartitionStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PartitionStatusRequest.Merge(m, src)
+func (m *Stream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stream.Merge(m, src)
 }
-func (m *PartitionStatusRequest) XXX_Size() int {
+func (m *Stream) XXX_Size() int {
 	return m.Size()
 }
-func (m *PartitionStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PartitionStatusRequest.DiscardUnknown(m)
+func (m *Stream) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stream.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PartitionStatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_Stream proto.InternalMessageInfo
 
-func (m *PartitionStatusRequest) GetStream() string {
+func (m *Stream) GetName() string {
 	if m != nil {
-		return m.Stream
+		return m.Name
 	}
 	return ""
 }
 
-func (m *PartitionStatusRequest) GetPartition() int32 {
+func (m *Stream) GetSubject() string {
 	if m != nil {
-		return m.Partition
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *Stream) GetPartitions() []*Partition {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func (m *Stream) GetConfig() *StreamConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *Stream) GetCreationTimestamp() int64 {
+	if m != nil {
+		return m.CreationTimestamp
 	}
 	return 0
 }
 
-type PartitionStatusResponse struct {
-	Exists               bool     `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
-	IsLeader             bool     `protobuf:"varint,2,opt,name=isLeader,proto3" json:"isLeader,omitempty"`
+func (m *Stream) GetOrigin() *StreamOrigin {
+	if m != nil {
+		return m.Origin
+	}
+	return nil
+}
+
+func (m *Stream) GetProducers() []*ExclusiveProducer {
+	if m != nil {
+		return m.Producers
+	}
+	return nil
+}
+
+func (m *Stream) GetPrimary() string {
+	if m != nil {
+		return m.Primary
+	}
+	return ""
+}
+
+func (m *Stream) GetCompanions() []string {
+	if m != nil {
+		return m.Companions
+	}
+	return nil
+}
+
+func (m *Stream) GetTags() []*StreamTag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Stream) GetAcl() *StreamACL {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+// StreamTag is a key/value pair attached to a stream, e.g. its owning team or
+// environment.
+type StreamTag struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PartitionStatusResponse) Reset()         { *m = PartitionStatusResponse{} }
-func (m *PartitionStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*PartitionStatusResponse) ProtoMessage()    {}
-func (*PartitionStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{37}
+func (m *StreamTag) Reset()         { *m = StreamTag{} }
+func (m *StreamTag) String() string { return proto.CompactTextString(m) }
+func (*StreamTag) ProtoMessage()    {}
+func (*StreamTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{42}
 }
-func (m *PartitionStatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *StreamTag) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PartitionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PartitionStatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamTag.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2659,52 +3290,112 @@ func (m *PartitionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *PartitionStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PartitionStatusResponse.Merge(m, src)
+func (m *StreamTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamTag.Merge(m, src)
 }
-func (m *PartitionStatusResponse) XXX_Size() int {
+func (m *StreamTag) XXX_Size() int {
 	return m.Size()
 }
-func (m *PartitionStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PartitionStatusResponse.DiscardUnknown(m)
+func (m *StreamTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamTag.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PartitionStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_StreamTag proto.InternalMessageInfo
 
-func (m *PartitionStatusResponse) GetExists() bool {
+func (m *StreamTag) GetKey() string {
 	if m != nil {
-		return m.Exists
+		return m.Key
 	}
-	return false
+	return ""
 }
 
-func (m *PartitionStatusResponse) GetIsLeader() bool {
+func (m *StreamTag) GetValue() string {
 	if m != nil {
-		return m.IsLeader
+		return m.Value
 	}
-	return false
+	return ""
 }
 
-type PartitionNotification struct {
-	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
-	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+// StreamTags are the tags of a stream, returned in FetchMetadata response
+// headers.
+type StreamTags struct {
+	Stream               string       `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Tags                 []*StreamTag `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *StreamTags) Reset()         { *m = StreamTags{} }
+func (m *StreamTags) String() string { return proto.CompactTextString(m) }
+func (*StreamTags) ProtoMessage()    {}
+func (*StreamTags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{43}
+}
+func (m *StreamTags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamTags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamTags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamTags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamTags.Merge(m, src)
+}
+func (m *StreamTags) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamTags) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamTags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamTags proto.InternalMessageInfo
+
+func (m *StreamTags) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *StreamTags) GetTags() []*StreamTag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// StreamACL restricts which authenticated principals can publish to and
+// subscribe to a stream. An empty list leaves that access unrestricted and the
+// wildcard "*" allows any authenticated principal.
+type StreamACL struct {
+	ReadPrincipals       []string `protobuf:"bytes,1,rep,name=readPrincipals,proto3" json:"readPrincipals,omitempty"`
+	WritePrincipals      []string `protobuf:"bytes,2,rep,name=writePrincipals,proto3" json:"writePrincipals,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PartitionNotification) Reset()         { *m = PartitionNotification{} }
-func (m *PartitionNotification) String() string { return proto.CompactTextString(m) }
-func (*PartitionNotification) ProtoMessage()    {}
-func (*PartitionNotification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{38}
+func (m *StreamACL) Reset()         { *m = StreamACL{} }
+func (m *StreamACL) String() string { return proto.CompactTextString(m) }
+func (*StreamACL) ProtoMessage()    {}
+func (*StreamACL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{44}
 }
-func (m *PartitionNotification) XXX_Unmarshal(b []byte) error {
+func (m *StreamACL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PartitionNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamACL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PartitionNotification.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamACL.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2714,54 +3405,56 @@ func (m *PartitionNotification) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *PartitionNotification) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PartitionNotification.Merge(m, src)
+func (m *StreamACL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamACL.Merge(m, src)
 }
-func (m *PartitionNotification) XXX_Size() int {
+func (m *StreamACL) XXX_Size() int {
 	return m.Size()
 }
-func (m *PartitionNotification) XXX_DiscardUnknown() {
-	xxx_messageInfo_PartitionNotification.DiscardUnknown(m)
+func (m *StreamACL) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamACL.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PartitionNotification proto.InternalMessageInfo
+var xxx_messageInfo_StreamACL proto.InternalMessageInfo
 
-func (m *PartitionNotification) GetStream() string {
+func (m *StreamACL) GetReadPrincipals() []string {
 	if m != nil {
-		return m.Stream
+		return m.ReadPrincipals
 	}
-	return ""
+	return nil
 }
 
-func (m *PartitionNotification) GetPartition() int32 {
+func (m *StreamACL) GetWritePrincipals() []string {
 	if m != nil {
-		return m.Partition
+		return m.WritePrincipals
 	}
-	return 0
+	return nil
 }
 
-type Cursor struct {
+// UnconfirmedReplicas are the replicas of a partition which hadn't confirmed
+// they created the partition and joined its ISR when CreateStream stopped
+// waiting for them, returned in CreateStream response headers.
+type UnconfirmedReplicas struct {
 	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
 	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
-	CursorId             string   `protobuf:"bytes,3,opt,name=cursorId,proto3" json:"cursorId,omitempty"`
-	Offset               int64    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Replicas             []string `protobuf:"bytes,3,rep,name=replicas,proto3" json:"replicas,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Cursor) Reset()         { *m = Cursor{} }
-func (m *Cursor) String() string { return proto.CompactTextString(m) }
-func (*Cursor) ProtoMessage()    {}
-func (*Cursor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d9410777bf851c3, []int{39}
+func (m *UnconfirmedReplicas) Reset()         { *m = UnconfirmedReplicas{} }
+func (m *UnconfirmedReplicas) String() string { return proto.CompactTextString(m) }
+func (*UnconfirmedReplicas) ProtoMessage()    {}
+func (*UnconfirmedReplicas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{45}
 }
-func (m *Cursor) XXX_Unmarshal(b []byte) error {
+func (m *UnconfirmedReplicas) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Cursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UnconfirmedReplicas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Cursor.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UnconfirmedReplicas.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2771,3629 +3464,21556 @@ func (m *Cursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Cursor) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Cursor.Merge(m, src)
+func (m *UnconfirmedReplicas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnconfirmedReplicas.Merge(m, src)
 }
-func (m *Cursor) XXX_Size() int {
+func (m *UnconfirmedReplicas) XXX_Size() int {
 	return m.Size()
 }
-func (m *Cursor) XXX_DiscardUnknown() {
-	xxx_messageInfo_Cursor.DiscardUnknown(m)
+func (m *UnconfirmedReplicas) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnconfirmedReplicas.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Cursor proto.InternalMessageInfo
+var xxx_messageInfo_UnconfirmedReplicas proto.InternalMessageInfo
 
-func (m *Cursor) GetStream() string {
+func (m *UnconfirmedReplicas) GetStream() string {
 	if m != nil {
 		return m.Stream
 	}
 	return ""
 }
 
-func (m *Cursor) GetPartition() int32 {
+func (m *UnconfirmedReplicas) GetPartition() int32 {
 	if m != nil {
 		return m.Partition
 	}
 	return 0
 }
 
-func (m *Cursor) GetCursorId() string {
+func (m *UnconfirmedReplicas) GetReplicas() []string {
 	if m != nil {
-		return m.CursorId
+		return m.Replicas
 	}
-	return ""
+	return nil
 }
 
-func (m *Cursor) GetOffset() int64 {
-	if m != nil {
-		return m.Offset
-	}
-	return 0
+// CompanionStream declares a stream to create along with the stream it
+// accompanies, e.g. its dead letter queue.
+type CompanionStream struct {
+	NameTemplate         string        `protobuf:"bytes,1,opt,name=nameTemplate,proto3" json:"nameTemplate,omitempty"`
+	SubjectTemplate      string        `protobuf:"bytes,2,opt,name=subjectTemplate,proto3" json:"subjectTemplate,omitempty"`
+	Config               *StreamConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Partitions           int32         `protobuf:"varint,4,opt,name=partitions,proto3" json:"partitions,omitempty"`
+	DeadLetterQueue      bool          `protobuf:"varint,5,opt,name=deadLetterQueue,proto3" json:"deadLetterQueue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func init() {
-	proto.RegisterEnum("protocol.Op", Op_name, Op_value)
-	proto.RegisterType((*ServerState)(nil), "protocol.ServerState")
-	proto.RegisterType((*RaftLog)(nil), "protocol.RaftLog")
-	proto.RegisterType((*CreateStreamOp)(nil), "protocol.CreateStreamOp")
-	proto.RegisterType((*ShrinkISROp)(nil), "protocol.ShrinkISROp")
-	proto.RegisterType((*ExpandISROp)(nil), "protocol.ExpandISROp")
-	proto.RegisterType((*DeleteStreamOp)(nil), "protocol.DeleteStreamOp")
-	proto.RegisterType((*PauseStreamOp)(nil), "protocol.PauseStreamOp")
-	proto.RegisterType((*ResumeStreamOp)(nil), "protocol.ResumeStreamOp")
-	proto.RegisterType((*ReportLeaderOp)(nil), "protocol.ReportLeaderOp")
-	proto.RegisterType((*ChangeLeaderOp)(nil), "protocol.ChangeLeaderOp")
-	proto.RegisterType((*ReportConsumerGroupCoordinatorOp)(nil), "protocol.ReportConsumerGroupCoordinatorOp")
-	proto.RegisterType((*ChangeConsumerGroupCoordinatorOp)(nil), "protocol.ChangeConsumerGroupCoordinatorOp")
-	proto.RegisterType((*PublishActivityOp)(nil), "protocol.PublishActivityOp")
-	proto.RegisterType((*SetStreamReadonlyOp)(nil), "protocol.SetStreamReadonlyOp")
-	proto.RegisterType((*CreateConsumerGroupOp)(nil), "protocol.CreateConsumerGroupOp")
-	proto.RegisterType((*JoinConsumerGroupOp)(nil), "protocol.JoinConsumerGroupOp")
-	proto.RegisterType((*LeaveConsumerGroupOp)(nil), "protocol.LeaveConsumerGroupOp")
-	proto.RegisterType((*NullableInt64)(nil), "protocol.NullableInt64")
-	proto.RegisterType((*NullableInt32)(nil), "protocol.NullableInt32")
-	proto.RegisterType((*NullableBool)(nil), "protocol.NullableBool")
-	proto.RegisterType((*StreamConfig)(nil), "protocol.StreamConfig")
-	proto.RegisterType((*Stream)(nil), "protocol.Stream")
-	proto.RegisterType((*Partition)(nil), "protocol.Partition")
-	proto.RegisterType((*Consumer)(nil), "protocol.Consumer")
-	proto.RegisterType((*ConsumerGroup)(nil), "protocol.ConsumerGroup")
-	proto.RegisterType((*RaftJoinRequest)(nil), "protocol.RaftJoinRequest")
-	proto.RegisterType((*RaftJoinResponse)(nil), "protocol.RaftJoinResponse")
-	proto.RegisterType((*MetadataSnapshot)(nil), "protocol.MetadataSnapshot")
-	proto.RegisterType((*ReplicationRequest)(nil), "protocol.ReplicationRequest")
-	proto.RegisterType((*LeaderEpochOffsetRequest)(nil), "protocol.LeaderEpochOffsetRequest")
-	proto.RegisterType((*LeaderEpochOffsetResponse)(nil), "protocol.LeaderEpochOffsetResponse")
-	proto.RegisterType((*PropagatedRequest)(nil), "protocol.PropagatedRequest")
-	proto.RegisterType((*Error)(nil), "protocol.Error")
-	proto.RegisterType((*PropagatedResponse)(nil), "protocol.PropagatedResponse")
-	proto.RegisterType((*PropagatedResponse_JoinConsumerGroupResponse)(nil), "protocol.PropagatedResponse.JoinConsumerGroupResponse")
-	proto.RegisterType((*ServerInfoRequest)(nil), "protocol.ServerInfoRequest")
-	proto.RegisterType((*ServerInfoResponse)(nil), "protocol.ServerInfoResponse")
-	proto.RegisterType((*PartitionStatusRequest)(nil), "protocol.PartitionStatusRequest")
-	proto.RegisterType((*PartitionStatusResponse)(nil), "protocol.PartitionStatusResponse")
-	proto.RegisterType((*PartitionNotification)(nil), "protocol.PartitionNotification")
-	proto.RegisterType((*Cursor)(nil), "protocol.Cursor")
+func (m *CompanionStream) Reset()         { *m = CompanionStream{} }
+func (m *CompanionStream) String() string { return proto.CompactTextString(m) }
+func (*CompanionStream) ProtoMessage()    {}
+func (*CompanionStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{46}
 }
-
-func init() { proto.RegisterFile("server/protocol/internal.proto", fileDescriptor_7d9410777bf851c3) }
-
-var fileDescriptor_7d9410777bf851c3 = []byte{
-	// 1997 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x5f, 0x6f, 0x1b, 0x4b,
-	0x15, 0xbf, 0xb6, 0x63, 0xc7, 0x3e, 0x4e, 0x5c, 0x67, 0x9a, 0xa4, 0x6e, 0xe9, 0x0d, 0x61, 0xa1,
-	0x52, 0xa8, 0x2e, 0xad, 0x48, 0xaf, 0x8a, 0x40, 0x80, 0x70, 0x9d, 0xa5, 0xdd, 0x7b, 0x1d, 0x3b,
-	0x1a, 0x3b, 0x15, 0x17, 0xa1, 0x1b, 0x6d, 0x76, 0xc7, 0xce, 0x96, 0xf5, 0xce, 0x32, 0x3b, 0x8e,
-	0xd2, 0x0f, 0xc0, 0x37, 0xe0, 0x01, 0x10, 0x2f, 0x3c, 0xf1, 0x41, 0x78, 0x81, 0x37, 0x1e, 0x79,
-	0xbc, 0x2a, 0x5f, 0x80, 0x8f, 0x80, 0x66, 0x76, 0xf6, 0xbf, 0xed, 0x08, 0x87, 0x07, 0xa4, 0xfb,
-	0xb6, 0xe7, 0xcc, 0xef, 0xfc, 0x9b, 0x39, 0x73, 0xce, 0xd9, 0x81, 0x83, 0x80, 0xb0, 0x6b, 0xc2,
-	0x9e, 0xfb, 0x8c, 0x72, 0x6a, 0x51, 0xf7, 0xb9, 0xe3, 0x71, 0xc2, 0x3c, 0xd3, 0x7d, 0x26, 0x39,
-	0xa8, 0x1e, 0x2d, 0x68, 0xdf, 0x85, 0xe6, 0x48, 0x62, 0x47, 0xdc, 0xe4, 0x04, 0x3d, 0x82, 0x7a,
-	0x28, 0x6a, 0x9c, 0x74, 0x4a, 0x87, 0xa5, 0xa3, 0x06, 0x8e, 0x69, 0xed, 0xdf, 0x9b, 0xb0, 0x89,
-	0xcd, 0x09, 0xef, 0xd3, 0x29, 0x7a, 0x0c, 0x65, 0xea, 0x4b, 0x44, 0xeb, 0x78, 0xeb, 0x59, 0xa4,
-	0xed, 0xd9, 0xd0, 0xc7, 0x65, 0xea, 0xa3, 0x9f, 0x41, 0xcb, 0x62, 0xc4, 0xe4, 0x64, 0xc4, 0x19,
-	0x31, 0x67, 0x43, 0xbf, 0x53, 0x3e, 0x2c, 0x1d, 0x35, 0x8f, 0x3b, 0x09, 0xb2, 0x97, 0x59, 0xc7,
-	0x39, 0x3c, 0xfa, 0x01, 0x34, 0x83, 0x2b, 0xe6, 0x78, 0xbf, 0x36, 0x46, 0x78, 0xe8, 0x77, 0x2a,
-	0x52, 0x7c, 0x2f, 0x11, 0x1f, 0x25, 0x8b, 0x38, 0x8d, 0x94, 0xa6, 0xaf, 0x4c, 0x6f, 0x4a, 0xfa,
-	0xc4, 0xb4, 0x09, 0x1b, 0xfa, 0x9d, 0x8d, 0x82, 0xe9, 0xcc, 0x3a, 0xce, 0xe1, 0x85, 0x69, 0x72,
-	0xe3, 0x9b, 0x9e, 0x1d, 0x9a, 0xae, 0xe6, 0x4d, 0xeb, 0xc9, 0x22, 0x4e, 0x23, 0x85, 0x69, 0x9b,
-	0xb8, 0x24, 0x15, 0x75, 0x2d, 0x6f, 0xfa, 0x24, 0xb3, 0x8e, 0x73, 0x78, 0xf4, 0x13, 0xd8, 0xf6,
-	0xcd, 0x79, 0x90, 0x28, 0xd8, 0x94, 0x0a, 0x1e, 0x24, 0x0a, 0xce, 0xd2, 0xcb, 0x38, 0x8b, 0x16,
-	0x0e, 0x30, 0x12, 0xcc, 0x67, 0x89, 0x7c, 0x3d, 0xef, 0x00, 0xce, 0xac, 0xe3, 0x1c, 0x1e, 0x19,
-	0xb0, 0xe3, 0xcf, 0x2f, 0x5d, 0x27, 0xb8, 0xea, 0x5a, 0xdc, 0xb9, 0x76, 0xf8, 0xfb, 0xa1, 0xdf,
-	0x69, 0x48, 0x25, 0xdf, 0x48, 0x39, 0x91, 0x87, 0xe0, 0xa2, 0x14, 0x1a, 0xc2, 0xfd, 0x80, 0xf0,
-	0x50, 0x33, 0x26, 0xa6, 0x4d, 0x3d, 0x57, 0x28, 0x03, 0xa9, 0xec, 0xe3, 0xd4, 0x49, 0x16, 0x41,
-	0x78, 0x91, 0x24, 0x3a, 0x87, 0xbd, 0x30, 0x49, 0x7a, 0xd4, 0x13, 0x4e, 0xb3, 0xd7, 0x8c, 0xce,
-	0xfd, 0xa1, 0xdf, 0x69, 0x4a, 0x95, 0xdf, 0xcc, 0xe7, 0x56, 0x0e, 0x86, 0x17, 0x4b, 0x0b, 0x3f,
-	0xdf, 0x51, 0xc7, 0xcb, 0x2b, 0xdd, 0xca, 0xfb, 0xf9, 0x59, 0x11, 0x84, 0x17, 0x49, 0x22, 0x0c,
-	0xbb, 0x2e, 0x31, 0xaf, 0x0b, 0x6e, 0x6e, 0x4b, 0x8d, 0x07, 0x89, 0xc6, 0xfe, 0x02, 0x14, 0x5e,
-	0x28, 0x8b, 0xae, 0xe1, 0x30, 0xcc, 0xd2, 0xcc, 0x42, 0x8f, 0x52, 0x66, 0x3b, 0x9e, 0xc9, 0xa9,
-	0xc8, 0xf3, 0x96, 0xd4, 0xff, 0x34, 0x9f, 0xe7, 0xcb, 0x25, 0xf0, 0xad, 0x3a, 0xb5, 0x1f, 0x41,
-	0x2b, 0x7b, 0x51, 0xd1, 0x11, 0xd4, 0x02, 0xf9, 0x2d, 0x2f, 0x7f, 0xf3, 0xb8, 0x9d, 0x3a, 0xc9,
-	0xf0, 0xc4, 0xd4, 0xba, 0xf6, 0x97, 0x12, 0x34, 0x53, 0xd7, 0x14, 0xed, 0x67, 0x24, 0x1b, 0x11,
-	0x0e, 0x3d, 0x86, 0x86, 0x6f, 0x32, 0xee, 0x70, 0x87, 0x7a, 0xb2, 0x4e, 0x54, 0x71, 0xc2, 0x40,
-	0x47, 0x70, 0x8f, 0x11, 0xdf, 0x75, 0x2c, 0x73, 0x4c, 0x31, 0x99, 0xd1, 0x6b, 0x22, 0x8b, 0x41,
-	0x03, 0xe7, 0xd9, 0x42, 0xbf, 0x2b, 0xef, 0xb0, 0xbc, 0xf1, 0x0d, 0xac, 0x28, 0x74, 0x08, 0xcd,
-	0xf0, 0x4b, 0xf7, 0xa9, 0x75, 0x25, 0xef, 0xf3, 0x06, 0x4e, 0xb3, 0xb4, 0x3f, 0x97, 0xa0, 0x99,
-	0xba, 0xd5, 0x6b, 0x7a, 0xaa, 0xc1, 0x56, 0xec, 0x52, 0xd7, 0xb6, 0x95, 0x9b, 0x19, 0xde, 0x1d,
-	0x7c, 0x3c, 0x82, 0x56, 0xb6, 0x78, 0x2c, 0xf3, 0x52, 0x23, 0xb0, 0x9d, 0xa9, 0x12, 0x4b, 0xc3,
-	0x39, 0x00, 0x88, 0xbd, 0x0f, 0x3a, 0xe5, 0xc3, 0xca, 0x51, 0x15, 0xa7, 0x38, 0x22, 0xdc, 0xb0,
-	0x3c, 0x74, 0x5d, 0x57, 0x46, 0x53, 0xc7, 0x09, 0x43, 0x7b, 0x03, 0xad, 0x6c, 0x31, 0x59, 0xd7,
-	0x8e, 0xf6, 0xc7, 0x92, 0x50, 0xe5, 0x53, 0xc6, 0xe3, 0x1a, 0xbc, 0xde, 0x09, 0x74, 0x60, 0x53,
-	0xed, 0xb6, 0xda, 0xfc, 0x88, 0xbc, 0xc3, 0xbe, 0x7f, 0x09, 0xad, 0x6c, 0xbf, 0x58, 0xd3, 0xb7,
-	0xc4, 0x83, 0x4a, 0xda, 0x03, 0xed, 0x77, 0x25, 0x38, 0x0c, 0x83, 0x5f, 0x7e, 0x0d, 0x45, 0x60,
-	0x53, 0xc1, 0x35, 0x6c, 0x65, 0x33, 0x22, 0xc5, 0xde, 0x5a, 0x4a, 0xce, 0xb0, 0xa5, 0xd5, 0x06,
-	0x4e, 0x71, 0x44, 0x80, 0x56, 0xa2, 0x4a, 0xd9, 0x4e, 0xb3, 0xd0, 0x2e, 0x54, 0x89, 0x0c, 0x7e,
-	0x43, 0x06, 0x1f, 0x12, 0xda, 0x97, 0x70, 0x78, 0x5b, 0xf9, 0x58, 0xe1, 0x55, 0xce, 0x6a, 0xb9,
-	0x60, 0x55, 0xfb, 0x3e, 0xec, 0x14, 0xba, 0x88, 0x4c, 0x38, 0x73, 0xc2, 0x0d, 0xcf, 0x26, 0x37,
-	0x52, 0xe5, 0x06, 0x4e, 0x18, 0x9a, 0x03, 0xf7, 0x17, 0xf4, 0x8a, 0xb5, 0xb3, 0xfb, 0x11, 0xd4,
-	0x99, 0xd2, 0xa2, 0x92, 0x3b, 0xa6, 0xb5, 0xb7, 0xb0, 0xb7, 0xb0, 0x87, 0x88, 0x06, 0x6d, 0xa5,
-	0x59, 0xaa, 0x08, 0xa6, 0x1a, 0x74, 0x46, 0x02, 0x67, 0xd1, 0x22, 0x84, 0x05, 0x6d, 0xe4, 0x0e,
-	0xc7, 0xdb, 0x81, 0xcd, 0x30, 0xdc, 0xa0, 0x53, 0x39, 0xac, 0x08, 0x49, 0x45, 0x6a, 0xef, 0x60,
-	0x77, 0x51, 0x7f, 0xb9, 0x9b, 0x2d, 0x72, 0xe3, 0x3b, 0x8c, 0xd8, 0x6a, 0xbf, 0x22, 0x52, 0x7b,
-	0x02, 0xdb, 0x83, 0xb9, 0xeb, 0x9a, 0x97, 0x2e, 0x31, 0x3c, 0xfe, 0xf2, 0x53, 0x91, 0x53, 0xd7,
-	0xa6, 0x3b, 0x27, 0xd2, 0x44, 0x05, 0x87, 0x44, 0x0e, 0xf6, 0xe2, 0x38, 0x0b, 0xab, 0x46, 0xb0,
-	0xef, 0xc0, 0x56, 0x04, 0x7b, 0x45, 0xa9, 0x9b, 0x45, 0xd5, 0x23, 0xd4, 0x1f, 0x36, 0x61, 0x2b,
-	0xcc, 0x85, 0x1e, 0xf5, 0x26, 0xce, 0x14, 0xe9, 0xb0, 0xc3, 0x08, 0x27, 0x9e, 0x38, 0xdd, 0x53,
-	0xf3, 0xe6, 0xd5, 0x7b, 0x4e, 0x82, 0xe2, 0xf1, 0x64, 0xfc, 0xc4, 0x45, 0x09, 0xf4, 0x39, 0xec,
-	0xa6, 0x99, 0xa7, 0x24, 0x08, 0xcc, 0x29, 0x09, 0xd4, 0x00, 0xbb, 0x54, 0xd3, 0x42, 0x21, 0xd4,
-	0x15, 0xcd, 0x2b, 0xe1, 0x77, 0xa7, 0x44, 0x4d, 0xb2, 0x4b, 0xf5, 0xe4, 0xf1, 0x42, 0x85, 0xe5,
-	0x12, 0xd3, 0x23, 0xcc, 0x10, 0x23, 0xfc, 0xb5, 0xe9, 0xaa, 0x81, 0x76, 0xb9, 0x8a, 0x1c, 0x5e,
-	0xa8, 0x08, 0xc8, 0x74, 0x46, 0x3c, 0x1e, 0xef, 0x4b, 0xf5, 0x16, 0x15, 0x39, 0xbc, 0xc8, 0xfb,
-	0x84, 0x25, 0xc2, 0xa8, 0xad, 0x56, 0x90, 0x45, 0x8b, 0x4d, 0xb5, 0xe8, 0xcc, 0x37, 0x2d, 0xc1,
-	0x78, 0x4d, 0x19, 0x9d, 0x73, 0xc7, 0x23, 0x41, 0x71, 0xbc, 0xcd, 0xe4, 0x07, 0x5e, 0x28, 0x84,
-	0x7e, 0x0a, 0x2d, 0xc5, 0xd7, 0x3d, 0x81, 0xb5, 0xd5, 0x94, 0xbb, 0x5f, 0x54, 0x23, 0xf2, 0x07,
-	0xe7, 0xd0, 0x22, 0x16, 0x73, 0xce, 0xa9, 0xec, 0x91, 0x63, 0x67, 0x46, 0xd4, 0x7c, 0xbb, 0x3c,
-	0x96, 0x0c, 0x1a, 0xfd, 0x0a, 0x3e, 0x8e, 0x19, 0x27, 0x4e, 0x20, 0x71, 0x93, 0xd1, 0xfc, 0x32,
-	0xb0, 0x98, 0x73, 0x49, 0x58, 0xa0, 0x26, 0xdc, 0x65, 0xde, 0xac, 0x16, 0x46, 0xcf, 0xa1, 0x36,
-	0x73, 0x3c, 0x23, 0x60, 0x6a, 0xaa, 0x5d, 0xba, 0x37, 0x0a, 0x86, 0x7e, 0x09, 0x8f, 0xa9, 0xcf,
-	0x9d, 0x99, 0x13, 0x70, 0xc7, 0xea, 0x51, 0xcf, 0x9a, 0x33, 0x46, 0x3c, 0xeb, 0x7d, 0x8f, 0x7a,
-	0x9c, 0x51, 0x57, 0xcd, 0xb1, 0xcb, 0xbc, 0x59, 0x29, 0x8b, 0x5e, 0x02, 0x10, 0xcf, 0x62, 0xef,
-	0x7d, 0xd9, 0xd2, 0xb6, 0x57, 0x6a, 0x4a, 0x21, 0xb5, 0xbf, 0x97, 0xa0, 0x16, 0xde, 0x4d, 0x84,
-	0x60, 0xc3, 0x33, 0x67, 0x44, 0xd5, 0x1a, 0xf9, 0x2d, 0x8b, 0xd6, 0xfc, 0xf2, 0x1d, 0xb1, 0xb8,
-	0xaa, 0x32, 0x11, 0x89, 0x5e, 0x64, 0x6a, 0xb6, 0xa8, 0x68, 0xcd, 0xe3, 0xfb, 0xe9, 0x9f, 0x1f,
-	0xb5, 0x96, 0x29, 0xe4, 0xcf, 0xa0, 0x66, 0xc9, 0x12, 0xa0, 0x2e, 0xc6, 0x7e, 0x7e, 0x22, 0x0d,
-	0x0b, 0x04, 0x56, 0x28, 0xf4, 0x09, 0xec, 0xc8, 0x3f, 0x01, 0x87, 0x7a, 0xe2, 0x40, 0x03, 0x6e,
-	0xce, 0xc2, 0xbf, 0xbc, 0x0a, 0x2e, 0x2e, 0x68, 0x7f, 0x2d, 0x43, 0xe3, 0x2c, 0x3d, 0x61, 0x44,
-	0xae, 0x97, 0xb2, 0xae, 0x27, 0x6d, 0xa8, 0x9c, 0x69, 0x43, 0x2d, 0x28, 0x3b, 0x61, 0xc1, 0xac,
-	0xe2, 0xb2, 0x63, 0x8b, 0x6a, 0x26, 0x0b, 0xae, 0x1a, 0x44, 0x42, 0x42, 0xf8, 0xa4, 0x46, 0x15,
-	0x61, 0xe6, 0xe7, 0xa6, 0x25, 0xda, 0x66, 0x55, 0x0a, 0x15, 0x17, 0xc2, 0xd6, 0x25, 0x99, 0x41,
-	0xa7, 0x26, 0xcb, 0x7e, 0x4c, 0xa7, 0xe6, 0x8c, 0xcd, 0xcc, 0xa4, 0xd3, 0x86, 0x8a, 0x13, 0xb0,
-	0x4e, 0x5d, 0xc2, 0xc5, 0x67, 0x7e, 0xf6, 0x69, 0x14, 0x66, 0x9f, 0x64, 0x34, 0x80, 0xd4, 0x68,
-	0x20, 0x2c, 0xc8, 0xdf, 0x4e, 0x5b, 0xa6, 0x68, 0x1d, 0x2b, 0x2a, 0xd3, 0x50, 0xb7, 0x72, 0x0d,
-	0xf5, 0x53, 0xa8, 0x47, 0x8d, 0x48, 0xed, 0x48, 0xb8, 0x7d, 0x62, 0x47, 0x52, 0x3d, 0xac, 0x9c,
-	0xed, 0x61, 0xbf, 0x2d, 0xc1, 0x76, 0xa6, 0x7f, 0x15, 0x64, 0x3f, 0x81, 0xcd, 0x19, 0x99, 0xc9,
-	0x6b, 0x57, 0x96, 0xd9, 0x82, 0x8a, 0x9d, 0x18, 0x47, 0x90, 0xb5, 0x87, 0x21, 0x1d, 0xee, 0x61,
-	0x73, 0xc2, 0x45, 0xeb, 0xc6, 0xe4, 0x37, 0x73, 0x12, 0xc8, 0xe3, 0xf6, 0xa8, 0x4d, 0xe2, 0x57,
-	0x12, 0x45, 0x89, 0x4d, 0x10, 0x5f, 0x5d, 0xdb, 0x8e, 0xc6, 0x9e, 0x98, 0xd6, 0x8e, 0xa0, 0x9d,
-	0xa8, 0x09, 0x7c, 0xea, 0x05, 0x44, 0x1a, 0x64, 0x8c, 0x32, 0xa5, 0x26, 0x24, 0x34, 0x0a, 0xed,
-	0x53, 0xc2, 0x4d, 0xdb, 0xe4, 0xe6, 0xc8, 0x33, 0xfd, 0xe0, 0x8a, 0x72, 0xf4, 0x34, 0xd9, 0xa6,
-	0x92, 0x0c, 0xb5, 0xf8, 0xe7, 0x15, 0x01, 0x44, 0x15, 0x91, 0x79, 0x15, 0xed, 0xca, 0xd2, 0xf9,
-	0x44, 0xc1, 0x34, 0x17, 0x10, 0x4e, 0xd2, 0x2c, 0x0a, 0x52, 0xfe, 0x00, 0x48, 0x6e, 0x1c, 0x67,
-	0xc2, 0x10, 0x5b, 0x40, 0x27, 0x93, 0x80, 0x84, 0xb7, 0xb8, 0x82, 0x15, 0x95, 0xcf, 0xab, 0x4a,
-	0x71, 0xa6, 0xfe, 0x31, 0x74, 0xfa, 0x09, 0x39, 0x94, 0x62, 0x91, 0xcd, 0x9c, 0x74, 0xa9, 0x28,
-	0xfd, 0x43, 0x78, 0xb8, 0x40, 0x5a, 0xed, 0xe7, 0x63, 0x68, 0x10, 0xcf, 0x0e, 0x99, 0x6a, 0xfa,
-	0x48, 0x18, 0xda, 0x3f, 0x6b, 0xb0, 0x73, 0xc6, 0xa8, 0x6f, 0x4e, 0x4d, 0x4e, 0xec, 0x24, 0xcc,
-	0xff, 0xdf, 0xb7, 0x2c, 0x96, 0xf9, 0x2f, 0x2a, 0xbe, 0x65, 0x65, 0xff, 0x9b, 0x70, 0x0e, 0xff,
-	0xb5, 0x7e, 0xcb, 0x5a, 0xf2, 0x00, 0xd5, 0x58, 0xfb, 0x01, 0x6a, 0xc9, 0x4b, 0x11, 0xfc, 0xcf,
-	0x5f, 0x8a, 0x9a, 0x77, 0x7b, 0x29, 0x62, 0xb7, 0xfc, 0x4e, 0xaa, 0x99, 0xe0, 0x69, 0x3e, 0x8b,
-	0x56, 0xbd, 0x14, 0xdd, 0xa6, 0x53, 0xfb, 0x1e, 0x54, 0x75, 0x51, 0xbb, 0x44, 0xc7, 0xb7, 0xa8,
-	0x1d, 0x76, 0xfc, 0x6d, 0x2c, 0xbf, 0x45, 0xf3, 0x99, 0x05, 0x53, 0x55, 0x10, 0xc5, 0xa7, 0xf6,
-	0xa7, 0x32, 0xa0, 0xf4, 0x4d, 0x8c, 0xaf, 0xef, 0xaa, 0xab, 0xf8, 0x24, 0x2a, 0x96, 0xe1, 0x0d,
-	0xbc, 0x97, 0xca, 0x63, 0xc1, 0x56, 0xd5, 0x13, 0xb9, 0xb0, 0x57, 0xd8, 0x6d, 0x61, 0x41, 0xed,
-	0xeb, 0xcb, 0x54, 0x06, 0x16, 0x3c, 0x28, 0x1e, 0x5e, 0xb4, 0x82, 0x17, 0x2b, 0x7d, 0x34, 0x82,
-	0x87, 0x4b, 0x65, 0xf2, 0x1d, 0xa7, 0xb4, 0xa2, 0xe3, 0x94, 0xd3, 0x1d, 0xe7, 0xdb, 0xb0, 0x13,
-	0xbe, 0xca, 0x1b, 0xde, 0x84, 0x46, 0x75, 0x2a, 0xd7, 0xfc, 0xb4, 0x3e, 0xa0, 0x34, 0x48, 0x99,
-	0xcc, 0xb7, 0x48, 0x04, 0x1b, 0x57, 0x34, 0x88, 0x46, 0x2d, 0xf9, 0x2d, 0x78, 0xe2, 0x38, 0xd5,
-	0x58, 0x22, 0xbf, 0xb5, 0x01, 0xec, 0xc7, 0x73, 0xce, 0x88, 0x9b, 0x7c, 0x1e, 0xa4, 0x7a, 0xdd,
-	0x7f, 0xff, 0xe0, 0xa1, 0x9d, 0xc2, 0x83, 0x82, 0x3e, 0xe5, 0xe2, 0x3e, 0xd4, 0xc8, 0x8d, 0x13,
-	0xf0, 0x40, 0xfd, 0xd2, 0x29, 0x4a, 0x34, 0x4f, 0x27, 0x08, 0x6b, 0x97, 0xd4, 0x57, 0xc7, 0x31,
-	0xad, 0x9d, 0xc2, 0x5e, 0xac, 0x6e, 0x40, 0xb9, 0x33, 0x51, 0xbd, 0x6a, 0x4d, 0xef, 0x18, 0xd4,
-	0x7a, 0x73, 0x16, 0x50, 0xb6, 0xe6, 0x73, 0xce, 0x23, 0xa8, 0x5b, 0x52, 0xde, 0x88, 0x1e, 0xfa,
-	0x62, 0x3a, 0xd5, 0x18, 0x37, 0xd2, 0x8d, 0xf1, 0xe9, 0x57, 0x65, 0x28, 0x0f, 0x7d, 0xb4, 0x03,
-	0xdb, 0x3d, 0xac, 0x77, 0xc7, 0xfa, 0xc5, 0x68, 0x8c, 0xf5, 0xee, 0x69, 0xfb, 0x23, 0xd4, 0x02,
-	0x18, 0xbd, 0xc1, 0xc6, 0xe0, 0xf3, 0x0b, 0x63, 0x84, 0xdb, 0x25, 0x01, 0xc1, 0xfa, 0xd9, 0x10,
-	0x8f, 0x2f, 0xfa, 0x7a, 0xf7, 0x44, 0xc7, 0xed, 0xb2, 0x94, 0x7a, 0xd3, 0x1d, 0xbc, 0xd6, 0x23,
-	0x56, 0x45, 0x48, 0xe9, 0xbf, 0x38, 0xeb, 0x0e, 0x4e, 0xa4, 0xd4, 0x86, 0x80, 0x9c, 0xe8, 0x7d,
-	0x3d, 0x51, 0x5c, 0x45, 0x6d, 0xd8, 0x3a, 0xeb, 0x9e, 0x8f, 0x62, 0x4e, 0x2d, 0x54, 0x3d, 0x3a,
-	0x3f, 0x8d, 0x59, 0x9b, 0x68, 0x17, 0xda, 0x67, 0xe7, 0xaf, 0xfa, 0xc6, 0xe8, 0xcd, 0x45, 0xb7,
-	0x37, 0x36, 0xde, 0x1a, 0xe3, 0x2f, 0xda, 0x75, 0xf4, 0x00, 0xee, 0x8f, 0xf4, 0xb1, 0x42, 0x5d,
-	0x60, 0xbd, 0x7b, 0x32, 0x1c, 0xf4, 0xbf, 0x68, 0x37, 0xd0, 0x43, 0xd8, 0x53, 0xfe, 0xf7, 0x86,
-	0x03, 0xa1, 0x09, 0x5f, 0xbc, 0xc6, 0xc3, 0xf3, 0xb3, 0x36, 0x08, 0x99, 0xcf, 0x86, 0xc6, 0x20,
-	0xbf, 0xd0, 0x44, 0x1d, 0xd8, 0xed, 0xeb, 0xdd, 0xb7, 0x05, 0x91, 0x2d, 0xf4, 0x04, 0xbe, 0xa5,
-	0x42, 0xcd, 0x2e, 0x5d, 0xf4, 0x86, 0x43, 0x7c, 0x62, 0x0c, 0xba, 0xe3, 0x21, 0x6e, 0x6f, 0x0b,
-	0x98, 0x0a, 0x7f, 0x05, 0xac, 0xf5, 0xaa, 0xfd, 0xb7, 0x0f, 0x07, 0xa5, 0x7f, 0x7c, 0x38, 0x28,
-	0x7d, 0xf5, 0xe1, 0xa0, 0xf4, 0xfb, 0x7f, 0x1d, 0x7c, 0x74, 0x59, 0x93, 0x97, 0xfd, 0xc5, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x12, 0x70, 0xa0, 0xa5, 0x12, 0x1b, 0x00, 0x00,
+func (m *CompanionStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompanionStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompanionStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompanionStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompanionStream.Merge(m, src)
+}
+func (m *CompanionStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompanionStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompanionStream.DiscardUnknown(m)
 }
 
-func (m *ServerState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CompanionStream proto.InternalMessageInfo
+
+func (m *CompanionStream) GetNameTemplate() string {
+	if m != nil {
+		return m.NameTemplate
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ServerState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CompanionStream) GetSubjectTemplate() string {
+	if m != nil {
+		return m.SubjectTemplate
+	}
+	return ""
 }
 
-func (m *ServerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *CompanionStream) GetConfig() *StreamConfig {
+	if m != nil {
+		return m.Config
 	}
-	if len(m.ServerID) > 0 {
-		i -= len(m.ServerID)
-		copy(dAtA[i:], m.ServerID)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.ServerID)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *CompanionStream) GetPartitions() int32 {
+	if m != nil {
+		return m.Partitions
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RaftLog) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CompanionStream) GetDeadLetterQueue() bool {
+	if m != nil {
+		return m.DeadLetterQueue
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *RaftLog) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ExclusiveProducer is the registration of an exclusive producer on a stream.
+// Released registrations are kept so their epoch stays fenced.
+type ExclusiveProducer struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Released             bool     `protobuf:"varint,4,opt,name=released,proto3" json:"released,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RaftLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangeConsumerGroupCoordinatorOp != nil {
-		{
-			size, err := m.ChangeConsumerGroupCoordinatorOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.LeaveConsumerGroupOp != nil {
-		{
-			size, err := m.LeaveConsumerGroupOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.JoinConsumerGroupOp != nil {
-		{
-			size, err := m.JoinConsumerGroupOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.CreateConsumerGroupOp != nil {
-		{
-			size, err := m.CreateConsumerGroupOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.SetStreamReadonlyOp != nil {
-		{
-			size, err := m.SetStreamReadonlyOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.PublishActivityOp != nil {
-		{
-			size, err := m.PublishActivityOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.ResumeStreamOp != nil {
-		{
-			size, err := m.ResumeStreamOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.PauseStreamOp != nil {
-		{
-			size, err := m.PauseStreamOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.DeleteStreamOp != nil {
-		{
-			size, err := m.DeleteStreamOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.ExpandISROp != nil {
-		{
-			size, err := m.ExpandISROp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ChangeLeaderOp != nil {
-		{
-			size, err := m.ChangeLeaderOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
+func (m *ExclusiveProducer) Reset()         { *m = ExclusiveProducer{} }
+func (m *ExclusiveProducer) String() string { return proto.CompactTextString(m) }
+func (*ExclusiveProducer) ProtoMessage()    {}
+func (*ExclusiveProducer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{47}
+}
+func (m *ExclusiveProducer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExclusiveProducer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExclusiveProducer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.ShrinkISROp != nil {
-		{
-			size, err := m.ShrinkISROp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *ExclusiveProducer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExclusiveProducer.Merge(m, src)
+}
+func (m *ExclusiveProducer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExclusiveProducer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExclusiveProducer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExclusiveProducer proto.InternalMessageInfo
+
+func (m *ExclusiveProducer) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.CreateStreamOp != nil {
-		{
-			size, err := m.CreateStreamOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *ExclusiveProducer) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	if m.Op != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ExclusiveProducer) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CreateStreamOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExclusiveProducer) GetReleased() bool {
+	if m != nil {
+		return m.Released
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *CreateStreamOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Partition struct {
+	Subject              string         `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Stream               string         `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	Id                   int32          `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Group                string         `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	ReplicationFactor    int32          `protobuf:"varint,5,opt,name=replicationFactor,proto3" json:"replicationFactor,omitempty"`
+	Replicas             []string       `protobuf:"bytes,6,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	Leader               string         `protobuf:"bytes,7,opt,name=leader,proto3" json:"leader,omitempty"`
+	Isr                  []string       `protobuf:"bytes,8,rep,name=isr,proto3" json:"isr,omitempty"`
+	LeaderEpoch          uint64         `protobuf:"varint,9,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+	Epoch                uint64         `protobuf:"varint,10,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Paused               bool           `protobuf:"varint,11,opt,name=paused,proto3" json:"paused,omitempty"`
+	Readonly             bool           `protobuf:"varint,12,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	ReadonlyTarget       *NullableInt64 `protobuf:"bytes,13,opt,name=readonlyTarget,proto3" json:"readonlyTarget,omitempty"`
+	PreferredReplicas    []string       `protobuf:"bytes,14,rep,name=preferredReplicas,proto3" json:"preferredReplicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *CreateStreamOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Stream != nil {
-		{
-			size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
+func (m *Partition) Reset()         { *m = Partition{} }
+func (m *Partition) String() string { return proto.CompactTextString(m) }
+func (*Partition) ProtoMessage()    {}
+func (*Partition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{48}
+}
+func (m *Partition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Partition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Partition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Partition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Partition.Merge(m, src)
+}
+func (m *Partition) XXX_Size() int {
+	return m.Size()
+}
+func (m *Partition) XXX_DiscardUnknown() {
+	xxx_messageInfo_Partition.DiscardUnknown(m)
 }
 
-func (m *ShrinkISROp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Partition proto.InternalMessageInfo
+
+func (m *Partition) GetSubject() string {
+	if m != nil {
+		return m.Subject
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ShrinkISROp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Partition) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
 }
 
-func (m *ShrinkISROp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.LeaderEpoch != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Leader) > 0 {
-		i -= len(m.Leader)
-		copy(dAtA[i:], m.Leader)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Leader)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ReplicaToRemove) > 0 {
-		i -= len(m.ReplicaToRemove)
-		copy(dAtA[i:], m.ReplicaToRemove)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.ReplicaToRemove)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Partition != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Stream) > 0 {
-		i -= len(m.Stream)
-		copy(dAtA[i:], m.Stream)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
-		i--
-		dAtA[i] = 0xa
+func (m *Partition) GetId() int32 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ExpandISROp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Partition) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ExpandISROp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Partition) GetReplicationFactor() int32 {
+	if m != nil {
+		return m.ReplicationFactor
+	}
+	return 0
 }
 
-func (m *ExpandISROp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.LeaderEpoch != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Leader) > 0 {
-		i -= len(m.Leader)
-		copy(dAtA[i:], m.Leader)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Leader)))
-		i--
-		dAtA[i] = 0x22
+func (m *Partition) GetReplicas() []string {
+	if m != nil {
+		return m.Replicas
 	}
-	if len(m.ReplicaToAdd) > 0 {
-		i -= len(m.ReplicaToAdd)
-		copy(dAtA[i:], m.ReplicaToAdd)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.ReplicaToAdd)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *Partition) GetLeader() string {
+	if m != nil {
+		return m.Leader
 	}
-	if m.Partition != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *Partition) GetIsr() []string {
+	if m != nil {
+		return m.Isr
 	}
-	if len(m.Stream) > 0 {
-		i -= len(m.Stream)
-		copy(dAtA[i:], m.Stream)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Partition) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DeleteStreamOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Partition) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DeleteStreamOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Partition) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
 }
 
-func (m *DeleteStreamOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Partition) GetReadonly() bool {
+	if m != nil {
+		return m.Readonly
 	}
-	if len(m.Stream) > 0 {
-		i -= len(m.Stream)
-		copy(dAtA[i:], m.Stream)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *Partition) GetReadonlyTarget() *NullableInt64 {
+	if m != nil {
+		return m.ReadonlyTarget
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PauseStreamOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Partition) GetPreferredReplicas() []string {
+	if m != nil {
+		return m.PreferredReplicas
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PauseStreamOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Consumer struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Streams              []string `protobuf:"bytes,2,rep,name=streams,proto3" json:"streams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PauseStreamOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ResumeAll {
-		i--
-		if m.ResumeAll {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Partitions) > 0 {
-		dAtA16 := make([]byte, len(m.Partitions)*10)
-		var j15 int
-		for _, num1 := range m.Partitions {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j15++
-			}
-			dAtA16[j15] = uint8(num)
-			j15++
+func (m *Consumer) Reset()         { *m = Consumer{} }
+func (m *Consumer) String() string { return proto.CompactTextString(m) }
+func (*Consumer) ProtoMessage()    {}
+func (*Consumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{49}
+}
+func (m *Consumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Consumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Consumer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintInternal(dAtA, i, uint64(j15))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Stream) > 0 {
-		i -= len(m.Stream)
-		copy(dAtA[i:], m.Stream)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ResumeStreamOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Consumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Consumer.Merge(m, src)
 }
-
-func (m *ResumeStreamOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Consumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Consumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Consumer.DiscardUnknown(m)
 }
 
-func (m *ResumeStreamOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Partitions) > 0 {
-		dAtA18 := make([]byte, len(m.Partitions)*10)
-		var j17 int
-		for _, num1 := range m.Partitions {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j17++
-			}
-			dAtA18[j17] = uint8(num)
-			j17++
-		}
-		i -= j17
-		copy(dAtA[i:], dAtA18[:j17])
-		i = encodeVarintInternal(dAtA, i, uint64(j17))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Stream) > 0 {
-		i -= len(m.Stream)
-		copy(dAtA[i:], m.Stream)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_Consumer proto.InternalMessageInfo
+
+func (m *Consumer) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ReportLeaderOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Consumer) GetStreams() []string {
+	if m != nil {
+		return m.Streams
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ReportLeaderOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ConsumerGroup struct {
+	Id                   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Members              []*Consumer `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Coordinator          string      `protobuf:"bytes,3,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	Epoch                uint64      `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *ReportLeaderOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.LeaderEpoch != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Leader) > 0 {
-		i -= len(m.Leader)
-		copy(dAtA[i:], m.Leader)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Leader)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Replica) > 0 {
-		i -= len(m.Replica)
-		copy(dAtA[i:], m.Replica)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Replica)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Partition != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Stream) > 0 {
-		i -= len(m.Stream)
-		copy(dAtA[i:], m.Stream)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
-		i--
-		dAtA[i] = 0xa
+func (m *ConsumerGroup) Reset()         { *m = ConsumerGroup{} }
+func (m *ConsumerGroup) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroup) ProtoMessage()    {}
+func (*ConsumerGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{50}
+}
+func (m *ConsumerGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroup.Merge(m, src)
+}
+func (m *ConsumerGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroup.DiscardUnknown(m)
 }
 
-func (m *ChangeLeaderOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerGroup proto.InternalMessageInfo
+
+func (m *ConsumerGroup) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ChangeLeaderOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerGroup) GetMembers() []*Consumer {
+	if m != nil {
+		return m.Members
+	}
+	return nil
 }
 
-func (m *ChangeLeaderOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Leader) > 0 {
-		i -= len(m.Leader)
-		copy(dAtA[i:], m.Leader)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Leader)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Partition != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Stream) > 0 {
-		i -= len(m.Stream)
-		copy(dAtA[i:], m.Stream)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
-		i--
-		dAtA[i] = 0xa
+func (m *ConsumerGroup) GetCoordinator() string {
+	if m != nil {
+		return m.Coordinator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ReportConsumerGroupCoordinatorOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroup) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ReportConsumerGroupCoordinatorOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RaftJoinRequest is a request to join a Raft group.
+type RaftJoinRequest struct {
+	NodeID               string   `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	NodeAddr             string   `protobuf:"bytes,2,opt,name=nodeAddr,proto3" json:"nodeAddr,omitempty"`
+	Host                 string   `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Port                 int32    `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	SubjectPrefix        string   `protobuf:"bytes,5,opt,name=subjectPrefix,proto3" json:"subjectPrefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReportConsumerGroupCoordinatorOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RaftJoinRequest) Reset()         { *m = RaftJoinRequest{} }
+func (m *RaftJoinRequest) String() string { return proto.CompactTextString(m) }
+func (*RaftJoinRequest) ProtoMessage()    {}
+func (*RaftJoinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{51}
+}
+func (m *RaftJoinRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftJoinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftJoinRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Epoch != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *RaftJoinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftJoinRequest.Merge(m, src)
+}
+func (m *RaftJoinRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftJoinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftJoinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftJoinRequest proto.InternalMessageInfo
+
+func (m *RaftJoinRequest) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
 	}
-	if len(m.Coordinator) > 0 {
-		i -= len(m.Coordinator)
-		copy(dAtA[i:], m.Coordinator)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Coordinator)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *RaftJoinRequest) GetNodeAddr() string {
+	if m != nil {
+		return m.NodeAddr
 	}
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *RaftJoinRequest) GetHost() string {
+	if m != nil {
+		return m.Host
 	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.GroupId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *RaftJoinRequest) GetPort() int32 {
+	if m != nil {
+		return m.Port
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ChangeConsumerGroupCoordinatorOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RaftJoinRequest) GetSubjectPrefix() string {
+	if m != nil {
+		return m.SubjectPrefix
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ChangeConsumerGroupCoordinatorOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RaftJoinResponse is a response to a RaftJoinRequest.
+type RaftJoinResponse struct {
+	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChangeConsumerGroupCoordinatorOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RaftJoinResponse) Reset()         { *m = RaftJoinResponse{} }
+func (m *RaftJoinResponse) String() string { return proto.CompactTextString(m) }
+func (*RaftJoinResponse) ProtoMessage()    {}
+func (*RaftJoinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{52}
+}
+func (m *RaftJoinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftJoinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftJoinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Coordinator) > 0 {
-		i -= len(m.Coordinator)
-		copy(dAtA[i:], m.Coordinator)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Coordinator)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *RaftJoinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftJoinResponse.Merge(m, src)
+}
+func (m *RaftJoinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftJoinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftJoinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftJoinResponse proto.InternalMessageInfo
+
+func (m *RaftJoinResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.GroupId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+type MetadataSnapshot struct {
+	Streams              []*Stream             `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	Groups               []*ConsumerGroup      `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	ActivityRaftIndex    uint64                `protobuf:"varint,3,opt,name=activityRaftIndex,proto3" json:"activityRaftIndex,omitempty"`
+	ActivityEpoch        uint64                `protobuf:"varint,4,opt,name=activityEpoch,proto3" json:"activityEpoch,omitempty"`
+	RemovedServers       []string              `protobuf:"bytes,5,rep,name=removedServers,proto3" json:"removedServers,omitempty"`
+	ScheduledOperations  []*ScheduledOperation `protobuf:"bytes,6,rep,name=scheduledOperations,proto3" json:"scheduledOperations,omitempty"`
+	ProducerQuotas       []*ProducerQuota      `protobuf:"bytes,7,rep,name=producerQuotas,proto3" json:"producerQuotas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MetadataSnapshot) Reset()         { *m = MetadataSnapshot{} }
+func (m *MetadataSnapshot) String() string { return proto.CompactTextString(m) }
+func (*MetadataSnapshot) ProtoMessage()    {}
+func (*MetadataSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{53}
+}
+func (m *MetadataSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MetadataSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataSnapshot.Merge(m, src)
+}
+func (m *MetadataSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataSnapshot.DiscardUnknown(m)
 }
 
-func (m *PublishActivityOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MetadataSnapshot proto.InternalMessageInfo
+
+func (m *MetadataSnapshot) GetStreams() []*Stream {
+	if m != nil {
+		return m.Streams
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PublishActivityOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MetadataSnapshot) GetGroups() []*ConsumerGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
 }
 
-func (m *PublishActivityOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *MetadataSnapshot) GetActivityRaftIndex() uint64 {
+	if m != nil {
+		return m.ActivityRaftIndex
 	}
-	if m.RaftIndex != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.RaftIndex))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *MetadataSnapshot) GetActivityEpoch() uint64 {
+	if m != nil {
+		return m.ActivityEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SetStreamReadonlyOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MetadataSnapshot) GetRemovedServers() []string {
+	if m != nil {
+		return m.RemovedServers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SetStreamReadonlyOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MetadataSnapshot) GetScheduledOperations() []*ScheduledOperation {
+	if m != nil {
+		return m.ScheduledOperations
+	}
+	return nil
 }
 
-func (m *SetStreamReadonlyOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *MetadataSnapshot) GetProducerQuotas() []*ProducerQuota {
+	if m != nil {
+		return m.ProducerQuotas
 	}
-	if m.Readonly {
-		i--
-		if m.Readonly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Partitions) > 0 {
-		dAtA20 := make([]byte, len(m.Partitions)*10)
-		var j19 int
-		for _, num1 := range m.Partitions {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j19++
-			}
-			dAtA20[j19] = uint8(num)
-			j19++
+	return nil
+}
+
+type ReplicationRequest struct {
+	ReplicaID            string   `protobuf:"bytes,1,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	LeaderEpoch          uint64   `protobuf:"varint,3,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+	Pipelined            bool     `protobuf:"varint,4,opt,name=pipelined,proto3" json:"pipelined,omitempty"`
+	DeclineSnapshot      bool     `protobuf:"varint,5,opt,name=declineSnapshot,proto3" json:"declineSnapshot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicationRequest) Reset()         { *m = ReplicationRequest{} }
+func (m *ReplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplicationRequest) ProtoMessage()    {}
+func (*ReplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{54}
+}
+func (m *ReplicationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintInternal(dAtA, i, uint64(j19))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Stream) > 0 {
-		i -= len(m.Stream)
-		copy(dAtA[i:], m.Stream)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ReplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationRequest.Merge(m, src)
+}
+func (m *ReplicationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationRequest.DiscardUnknown(m)
 }
 
-func (m *CreateConsumerGroupOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReplicationRequest proto.InternalMessageInfo
+
+func (m *ReplicationRequest) GetReplicaID() string {
+	if m != nil {
+		return m.ReplicaID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateConsumerGroupOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReplicationRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
 }
 
-func (m *CreateConsumerGroupOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ReplicationRequest) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
 	}
-	if m.ConsumerGroup != nil {
-		{
-			size, err := m.ConsumerGroup.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ReplicationRequest) GetPipelined() bool {
+	if m != nil {
+		return m.Pipelined
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *JoinConsumerGroupOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReplicationRequest) GetDeclineSnapshot() bool {
+	if m != nil {
+		return m.DeclineSnapshot
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *JoinConsumerGroupOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ReplicationOffsetOutOfRange is sent by a partition leader in response to a
+// replication request for an offset before the start of its log, e.g. because
+// the messages were deleted by retention while the follower was offline.
+type ReplicationOffsetOutOfRange struct {
+	LeaderEpoch          uint64   `protobuf:"varint,1,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+	LogStartOffset       int64    `protobuf:"varint,2,opt,name=logStartOffset,proto3" json:"logStartOffset,omitempty"`
+	HighWatermark        int64    `protobuf:"varint,3,opt,name=highWatermark,proto3" json:"highWatermark,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JoinConsumerGroupOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Streams) > 0 {
-		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Streams[iNdEx])
-			copy(dAtA[i:], m.Streams[iNdEx])
-			i = encodeVarintInternal(dAtA, i, uint64(len(m.Streams[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *ReplicationOffsetOutOfRange) Reset()         { *m = ReplicationOffsetOutOfRange{} }
+func (m *ReplicationOffsetOutOfRange) String() string { return proto.CompactTextString(m) }
+func (*ReplicationOffsetOutOfRange) ProtoMessage()    {}
+func (*ReplicationOffsetOutOfRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{55}
+}
+func (m *ReplicationOffsetOutOfRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationOffsetOutOfRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationOffsetOutOfRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.GroupId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *ReplicationOffsetOutOfRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationOffsetOutOfRange.Merge(m, src)
+}
+func (m *ReplicationOffsetOutOfRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationOffsetOutOfRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationOffsetOutOfRange.DiscardUnknown(m)
 }
 
-func (m *LeaveConsumerGroupOp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReplicationOffsetOutOfRange proto.InternalMessageInfo
+
+func (m *ReplicationOffsetOutOfRange) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LeaveConsumerGroupOp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReplicationOffsetOutOfRange) GetLogStartOffset() int64 {
+	if m != nil {
+		return m.LogStartOffset
+	}
+	return 0
 }
 
-func (m *LeaveConsumerGroupOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ReplicationOffsetOutOfRange) GetHighWatermark() int64 {
+	if m != nil {
+		return m.HighWatermark
 	}
-	if m.Expired {
-		i--
-		if m.Expired {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return 0
+}
+
+// ReplicationSnapshotOffer is sent by a partition leader in response to a
+// replication request from a follower lagging by more than
+// replica.snapshot.min.bytes. It offers the leader's sealed segments following
+// the follower's log for direct transfer with SnapshotChunkRequests.
+type ReplicationSnapshotOffer struct {
+	LeaderEpoch          uint64             `protobuf:"varint,1,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+	SnapshotID           string             `protobuf:"bytes,2,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
+	Segments             []*SnapshotSegment `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
+	EpochOffsets         []*EpochOffset     `protobuf:"bytes,4,rep,name=epochOffsets,proto3" json:"epochOffsets,omitempty"`
+	HighWatermark        int64              `protobuf:"varint,5,opt,name=highWatermark,proto3" json:"highWatermark,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ReplicationSnapshotOffer) Reset()         { *m = ReplicationSnapshotOffer{} }
+func (m *ReplicationSnapshotOffer) String() string { return proto.CompactTextString(m) }
+func (*ReplicationSnapshotOffer) ProtoMessage()    {}
+func (*ReplicationSnapshotOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{56}
+}
+func (m *ReplicationSnapshotOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationSnapshotOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationSnapshotOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ReplicationSnapshotOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationSnapshotOffer.Merge(m, src)
+}
+func (m *ReplicationSnapshotOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationSnapshotOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationSnapshotOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationSnapshotOffer proto.InternalMessageInfo
+
+func (m *ReplicationSnapshotOffer) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
 	}
-	if len(m.GroupId) > 0 {
-		i -= len(m.GroupId)
-		copy(dAtA[i:], m.GroupId)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.GroupId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ReplicationSnapshotOffer) GetSnapshotID() string {
+	if m != nil {
+		return m.SnapshotID
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NullableInt64) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReplicationSnapshotOffer) GetSegments() []*SnapshotSegment {
+	if m != nil {
+		return m.Segments
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *NullableInt64) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReplicationSnapshotOffer) GetEpochOffsets() []*EpochOffset {
+	if m != nil {
+		return m.EpochOffsets
+	}
+	return nil
 }
 
-func (m *NullableInt64) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ReplicationSnapshotOffer) GetHighWatermark() int64 {
+	if m != nil {
+		return m.HighWatermark
 	}
-	if m.Value != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+// SnapshotSegment describes a sealed segment offered in a snapshot.
+type SnapshotSegment struct {
+	BaseOffset           int64    `protobuf:"varint,1,opt,name=baseOffset,proto3" json:"baseOffset,omitempty"`
+	LastOffset           int64    `protobuf:"varint,2,opt,name=lastOffset,proto3" json:"lastOffset,omitempty"`
+	LogSize              int64    `protobuf:"varint,3,opt,name=logSize,proto3" json:"logSize,omitempty"`
+	IndexSize            int64    `protobuf:"varint,4,opt,name=indexSize,proto3" json:"indexSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnapshotSegment) Reset()         { *m = SnapshotSegment{} }
+func (m *SnapshotSegment) String() string { return proto.CompactTextString(m) }
+func (*SnapshotSegment) ProtoMessage()    {}
+func (*SnapshotSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{57}
+}
+func (m *SnapshotSegment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotSegment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SnapshotSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotSegment.Merge(m, src)
+}
+func (m *SnapshotSegment) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotSegment.DiscardUnknown(m)
 }
 
-func (m *NullableInt32) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SnapshotSegment proto.InternalMessageInfo
+
+func (m *SnapshotSegment) GetBaseOffset() int64 {
+	if m != nil {
+		return m.BaseOffset
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *NullableInt32) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SnapshotSegment) GetLastOffset() int64 {
+	if m != nil {
+		return m.LastOffset
+	}
+	return 0
 }
 
-func (m *NullableInt32) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SnapshotSegment) GetLogSize() int64 {
+	if m != nil {
+		return m.LogSize
 	}
-	if m.Value != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *SnapshotSegment) GetIndexSize() int64 {
+	if m != nil {
+		return m.IndexSize
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *NullableBool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// EpochOffset is the offset a leader epoch starts at.
+type EpochOffset struct {
+	LeaderEpoch          uint64   `protobuf:"varint,1,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+	StartOffset          int64    `protobuf:"varint,2,opt,name=startOffset,proto3" json:"startOffset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EpochOffset) Reset()         { *m = EpochOffset{} }
+func (m *EpochOffset) String() string { return proto.CompactTextString(m) }
+func (*EpochOffset) ProtoMessage()    {}
+func (*EpochOffset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{58}
+}
+func (m *EpochOffset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochOffset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochOffset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EpochOffset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochOffset.Merge(m, src)
+}
+func (m *EpochOffset) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochOffset) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochOffset.DiscardUnknown(m)
 }
 
-func (m *NullableBool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EpochOffset proto.InternalMessageInfo
+
+func (m *EpochOffset) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
+	}
+	return 0
 }
 
-func (m *NullableBool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *EpochOffset) GetStartOffset() int64 {
+	if m != nil {
+		return m.StartOffset
 	}
-	if m.Value {
-		i--
-		if m.Value {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return 0
+}
+
+// SnapshotChunkRequest is sent by a follower to the partition leader to fetch
+// a chunk of one of the files of a segment in the snapshot it was offered.
+type SnapshotChunkRequest struct {
+	ReplicaID            string           `protobuf:"bytes,1,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	SnapshotID           string           `protobuf:"bytes,2,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
+	BaseOffset           int64            `protobuf:"varint,3,opt,name=baseOffset,proto3" json:"baseOffset,omitempty"`
+	File                 SnapshotFileType `protobuf:"varint,4,opt,name=file,proto3,enum=protocol.SnapshotFileType" json:"file,omitempty"`
+	Position             int64            `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	MaxBytes             int64            `protobuf:"varint,6,opt,name=maxBytes,proto3" json:"maxBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SnapshotChunkRequest) Reset()         { *m = SnapshotChunkRequest{} }
+func (m *SnapshotChunkRequest) String() string { return proto.CompactTextString(m) }
+func (*SnapshotChunkRequest) ProtoMessage()    {}
+func (*SnapshotChunkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{59}
+}
+func (m *SnapshotChunkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotChunkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotChunkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SnapshotChunkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotChunkRequest.Merge(m, src)
+}
+func (m *SnapshotChunkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotChunkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotChunkRequest.DiscardUnknown(m)
 }
 
-func (m *StreamConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SnapshotChunkRequest proto.InternalMessageInfo
+
+func (m *SnapshotChunkRequest) GetReplicaID() string {
+	if m != nil {
+		return m.ReplicaID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *StreamConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SnapshotChunkRequest) GetSnapshotID() string {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return ""
 }
 
-func (m *StreamConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SnapshotChunkRequest) GetBaseOffset() int64 {
+	if m != nil {
+		return m.BaseOffset
 	}
-	if m.Encryption != nil {
-		{
-			size, err := m.Encryption.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
+	return 0
+}
+
+func (m *SnapshotChunkRequest) GetFile() SnapshotFileType {
+	if m != nil {
+		return m.File
 	}
-	if m.OptimisticConcurrencyControl != nil {
-		{
-			size, err := m.OptimisticConcurrencyControl.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+	return SnapshotFileType_SEGMENT_LOG
+}
+
+func (m *SnapshotChunkRequest) GetPosition() int64 {
+	if m != nil {
+		return m.Position
 	}
-	if m.MinIsr != nil {
-		{
-			size, err := m.MinIsr.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+	return 0
+}
+
+func (m *SnapshotChunkRequest) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
 	}
-	if m.AutoPauseDisableIfSubscribers != nil {
-		{
-			size, err := m.AutoPauseDisableIfSubscribers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
+	return 0
+}
+
+type SnapshotChunkResponse struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Last                 bool     `protobuf:"varint,2,opt,name=last,proto3" json:"last,omitempty"`
+	Checksum             uint32   `protobuf:"varint,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnapshotChunkResponse) Reset()         { *m = SnapshotChunkResponse{} }
+func (m *SnapshotChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*SnapshotChunkResponse) ProtoMessage()    {}
+func (*SnapshotChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{60}
+}
+func (m *SnapshotChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x52
+		return b[:n], nil
 	}
-	if m.AutoPauseTime != nil {
-		{
-			size, err := m.AutoPauseTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.CompactEnabled != nil {
-		{
-			size, err := m.CompactEnabled.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.CompactMaxGoroutines != nil {
-		{
-			size, err := m.CompactMaxGoroutines.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.SegmentMaxAge != nil {
-		{
-			size, err := m.SegmentMaxAge.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.SegmentMaxBytes != nil {
-		{
-			size, err := m.SegmentMaxBytes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.CleanerInterval != nil {
-		{
-			size, err := m.CleanerInterval.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.RetentionMaxAge != nil {
-		{
-			size, err := m.RetentionMaxAge.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *SnapshotChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotChunkResponse.Merge(m, src)
+}
+func (m *SnapshotChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotChunkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotChunkResponse proto.InternalMessageInfo
+
+func (m *SnapshotChunkResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	if m.RetentionMaxMessages != nil {
-		{
-			size, err := m.RetentionMaxMessages.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *SnapshotChunkResponse) GetLast() bool {
+	if m != nil {
+		return m.Last
 	}
-	if m.RetentionMaxBytes != nil {
-		{
-			size, err := m.RetentionMaxBytes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *SnapshotChunkResponse) GetChecksum() uint32 {
+	if m != nil {
+		return m.Checksum
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Stream) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SnapshotChunkResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Stream) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type LeaderEpochOffsetRequest struct {
+	LeaderEpoch          uint64   `protobuf:"varint,1,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Stream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.CreationTimestamp != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.CreationTimestamp))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Config != nil {
-		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Partitions) > 0 {
-		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInternal(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *LeaderEpochOffsetRequest) Reset()         { *m = LeaderEpochOffsetRequest{} }
+func (m *LeaderEpochOffsetRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaderEpochOffsetRequest) ProtoMessage()    {}
+func (*LeaderEpochOffsetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{61}
+}
+func (m *LeaderEpochOffsetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaderEpochOffsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaderEpochOffsetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Subject) > 0 {
-		i -= len(m.Subject)
-		copy(dAtA[i:], m.Subject)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Subject)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *LeaderEpochOffsetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderEpochOffsetRequest.Merge(m, src)
+}
+func (m *LeaderEpochOffsetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaderEpochOffsetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderEpochOffsetRequest.DiscardUnknown(m)
 }
 
-func (m *Partition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LeaderEpochOffsetRequest proto.InternalMessageInfo
+
+func (m *LeaderEpochOffsetRequest) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Partition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type LeaderEpochOffsetResponse struct {
+	EndOffset            int64    `protobuf:"varint,1,opt,name=endOffset,proto3" json:"endOffset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Partition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Readonly {
-		i--
-		if m.Readonly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.Paused {
-		i--
-		if m.Paused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *LeaderEpochOffsetResponse) Reset()         { *m = LeaderEpochOffsetResponse{} }
+func (m *LeaderEpochOffsetResponse) String() string { return proto.CompactTextString(m) }
+func (*LeaderEpochOffsetResponse) ProtoMessage()    {}
+func (*LeaderEpochOffsetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{62}
+}
+func (m *LeaderEpochOffsetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaderEpochOffsetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaderEpochOffsetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Epoch != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x50
+		return b[:n], nil
 	}
-	if m.LeaderEpoch != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
-		i--
-		dAtA[i] = 0x48
+}
+func (m *LeaderEpochOffsetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderEpochOffsetResponse.Merge(m, src)
+}
+func (m *LeaderEpochOffsetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaderEpochOffsetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderEpochOffsetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaderEpochOffsetResponse proto.InternalMessageInfo
+
+func (m *LeaderEpochOffsetResponse) GetEndOffset() int64 {
+	if m != nil {
+		return m.EndOffset
 	}
-	if len(m.Isr) > 0 {
-		for iNdEx := len(m.Isr) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Isr[iNdEx])
-			copy(dAtA[i:], m.Isr[iNdEx])
-			i = encodeVarintInternal(dAtA, i, uint64(len(m.Isr[iNdEx])))
-			i--
-			dAtA[i] = 0x42
+	return 0
+}
+
+type PropagatedRequest struct {
+	Op                               Op                                `protobuf:"varint,1,opt,name=op,proto3,enum=protocol.Op" json:"op,omitempty"`
+	CreateStreamOp                   *CreateStreamOp                   `protobuf:"bytes,2,opt,name=createStreamOp,proto3" json:"createStreamOp,omitempty"`
+	ShrinkISROp                      *ShrinkISROp                      `protobuf:"bytes,3,opt,name=shrinkISROp,proto3" json:"shrinkISROp,omitempty"`
+	ReportLeaderOp                   *ReportLeaderOp                   `protobuf:"bytes,4,opt,name=reportLeaderOp,proto3" json:"reportLeaderOp,omitempty"`
+	ExpandISROp                      *ExpandISROp                      `protobuf:"bytes,5,opt,name=expandISROp,proto3" json:"expandISROp,omitempty"`
+	DeleteStreamOp                   *DeleteStreamOp                   `protobuf:"bytes,6,opt,name=deleteStreamOp,proto3" json:"deleteStreamOp,omitempty"`
+	PauseStreamOp                    *PauseStreamOp                    `protobuf:"bytes,7,opt,name=pauseStreamOp,proto3" json:"pauseStreamOp,omitempty"`
+	ResumeStreamOp                   *ResumeStreamOp                   `protobuf:"bytes,8,opt,name=resumeStreamOp,proto3" json:"resumeStreamOp,omitempty"`
+	SetStreamReadonlyOp              *SetStreamReadonlyOp              `protobuf:"bytes,9,opt,name=setStreamReadonlyOp,proto3" json:"setStreamReadonlyOp,omitempty"`
+	JoinConsumerGroupOp              *JoinConsumerGroupOp              `protobuf:"bytes,10,opt,name=joinConsumerGroupOp,proto3" json:"joinConsumerGroupOp,omitempty"`
+	LeaveConsumerGroupOp             *LeaveConsumerGroupOp             `protobuf:"bytes,11,opt,name=leaveConsumerGroupOp,proto3" json:"leaveConsumerGroupOp,omitempty"`
+	ReportConsumerGroupCoordinatorOp *ReportConsumerGroupCoordinatorOp `protobuf:"bytes,12,opt,name=reportConsumerGroupCoordinatorOp,proto3" json:"reportConsumerGroupCoordinatorOp,omitempty"`
+	ChangeLeaderOp                   *ChangeLeaderOp                   `protobuf:"bytes,13,opt,name=changeLeaderOp,proto3" json:"changeLeaderOp,omitempty"`
+	UpdateStreamOwnerOp              *UpdateStreamOwnerOp              `protobuf:"bytes,14,opt,name=updateStreamOwnerOp,proto3" json:"updateStreamOwnerOp,omitempty"`
+	RegisterProducerOp               *RegisterProducerOp               `protobuf:"bytes,15,opt,name=registerProducerOp,proto3" json:"registerProducerOp,omitempty"`
+	ReleaseProducerOp                *ReleaseProducerOp                `protobuf:"bytes,16,opt,name=releaseProducerOp,proto3" json:"releaseProducerOp,omitempty"`
+	ScheduleOperationOp              *ScheduleOperationOp              `protobuf:"bytes,17,opt,name=scheduleOperationOp,proto3" json:"scheduleOperationOp,omitempty"`
+	CancelScheduledOperationOp       *CancelScheduledOperationOp       `protobuf:"bytes,18,opt,name=cancelScheduledOperationOp,proto3" json:"cancelScheduledOperationOp,omitempty"`
+	UpdateStreamConfigOp             *UpdateStreamConfigOp             `protobuf:"bytes,19,opt,name=updateStreamConfigOp,proto3" json:"updateStreamConfigOp,omitempty"`
+	SetStreamTagsOp                  *SetStreamTagsOp                  `protobuf:"bytes,20,opt,name=setStreamTagsOp,proto3" json:"setStreamTagsOp,omitempty"`
+	DeletePartitionDataOp            *DeletePartitionDataOp            `protobuf:"bytes,21,opt,name=deletePartitionDataOp,proto3" json:"deletePartitionDataOp,omitempty"`
+	SetStreamACLOp                   *SetStreamACLOp                   `protobuf:"bytes,22,opt,name=setStreamACLOp,proto3" json:"setStreamACLOp,omitempty"`
+	SetProducerQuotaOp               *SetProducerQuotaOp               `protobuf:"bytes,23,opt,name=setProducerQuotaOp,proto3" json:"setProducerQuotaOp,omitempty"`
+	DeleteProducerQuotaOp            *DeleteProducerQuotaOp            `protobuf:"bytes,24,opt,name=deleteProducerQuotaOp,proto3" json:"deleteProducerQuotaOp,omitempty"`
+	DeletePartitionOp                *DeletePartitionOp                `protobuf:"bytes,25,opt,name=deletePartitionOp,proto3" json:"deletePartitionOp,omitempty"`
+	XXX_NoUnkeyedLiteral             struct{}                          `json:"-"`
+	XXX_unrecognized                 []byte                            `json:"-"`
+	XXX_sizecache                    int32                             `json:"-"`
+}
+
+func (m *PropagatedRequest) Reset()         { *m = PropagatedRequest{} }
+func (m *PropagatedRequest) String() string { return proto.CompactTextString(m) }
+func (*PropagatedRequest) ProtoMessage()    {}
+func (*PropagatedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{63}
+}
+func (m *PropagatedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PropagatedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PropagatedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Leader) > 0 {
-		i -= len(m.Leader)
-		copy(dAtA[i:], m.Leader)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Leader)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Replicas) > 0 {
-		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Replicas[iNdEx])
-			copy(dAtA[i:], m.Replicas[iNdEx])
-			i = encodeVarintInternal(dAtA, i, uint64(len(m.Replicas[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
+}
+func (m *PropagatedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PropagatedRequest.Merge(m, src)
+}
+func (m *PropagatedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PropagatedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PropagatedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PropagatedRequest proto.InternalMessageInfo
+
+func (m *PropagatedRequest) GetOp() Op {
+	if m != nil {
+		return m.Op
 	}
-	if m.ReplicationFactor != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.ReplicationFactor))
-		i--
-		dAtA[i] = 0x28
+	return Op_CREATE_STREAM
+}
+
+func (m *PropagatedRequest) GetCreateStreamOp() *CreateStreamOp {
+	if m != nil {
+		return m.CreateStreamOp
 	}
-	if len(m.Group) > 0 {
-		i -= len(m.Group)
-		copy(dAtA[i:], m.Group)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Group)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *PropagatedRequest) GetShrinkISROp() *ShrinkISROp {
+	if m != nil {
+		return m.ShrinkISROp
 	}
-	if m.Id != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *PropagatedRequest) GetReportLeaderOp() *ReportLeaderOp {
+	if m != nil {
+		return m.ReportLeaderOp
 	}
-	if len(m.Stream) > 0 {
-		i -= len(m.Stream)
-		copy(dAtA[i:], m.Stream)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *PropagatedRequest) GetExpandISROp() *ExpandISROp {
+	if m != nil {
+		return m.ExpandISROp
 	}
-	if len(m.Subject) > 0 {
-		i -= len(m.Subject)
-		copy(dAtA[i:], m.Subject)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Subject)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *PropagatedRequest) GetDeleteStreamOp() *DeleteStreamOp {
+	if m != nil {
+		return m.DeleteStreamOp
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Consumer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PropagatedRequest) GetPauseStreamOp() *PauseStreamOp {
+	if m != nil {
+		return m.PauseStreamOp
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Consumer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PropagatedRequest) GetResumeStreamOp() *ResumeStreamOp {
+	if m != nil {
+		return m.ResumeStreamOp
+	}
+	return nil
 }
 
-func (m *Consumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PropagatedRequest) GetSetStreamReadonlyOp() *SetStreamReadonlyOp {
+	if m != nil {
+		return m.SetStreamReadonlyOp
 	}
-	if len(m.Streams) > 0 {
-		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Streams[iNdEx])
-			copy(dAtA[i:], m.Streams[iNdEx])
-			i = encodeVarintInternal(dAtA, i, uint64(len(m.Streams[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	return nil
+}
+
+func (m *PropagatedRequest) GetJoinConsumerGroupOp() *JoinConsumerGroupOp {
+	if m != nil {
+		return m.JoinConsumerGroupOp
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *PropagatedRequest) GetLeaveConsumerGroupOp() *LeaveConsumerGroupOp {
+	if m != nil {
+		return m.LeaveConsumerGroupOp
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ConsumerGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PropagatedRequest) GetReportConsumerGroupCoordinatorOp() *ReportConsumerGroupCoordinatorOp {
+	if m != nil {
+		return m.ReportConsumerGroupCoordinatorOp
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ConsumerGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PropagatedRequest) GetChangeLeaderOp() *ChangeLeaderOp {
+	if m != nil {
+		return m.ChangeLeaderOp
+	}
+	return nil
 }
 
-func (m *ConsumerGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Epoch != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Coordinator) > 0 {
-		i -= len(m.Coordinator)
-		copy(dAtA[i:], m.Coordinator)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Coordinator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInternal(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *PropagatedRequest) GetUpdateStreamOwnerOp() *UpdateStreamOwnerOp {
+	if m != nil {
+		return m.UpdateStreamOwnerOp
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *RaftJoinRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PropagatedRequest) GetRegisterProducerOp() *RegisterProducerOp {
+	if m != nil {
+		return m.RegisterProducerOp
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RaftJoinRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PropagatedRequest) GetReleaseProducerOp() *ReleaseProducerOp {
+	if m != nil {
+		return m.ReleaseProducerOp
+	}
+	return nil
 }
 
-func (m *RaftJoinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.NodeAddr) > 0 {
-		i -= len(m.NodeAddr)
-		copy(dAtA[i:], m.NodeAddr)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.NodeAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.NodeID) > 0 {
-		i -= len(m.NodeID)
-		copy(dAtA[i:], m.NodeID)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.NodeID)))
-		i--
-		dAtA[i] = 0xa
+func (m *PropagatedRequest) GetScheduleOperationOp() *ScheduleOperationOp {
+	if m != nil {
+		return m.ScheduleOperationOp
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *RaftJoinResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PropagatedRequest) GetCancelScheduledOperationOp() *CancelScheduledOperationOp {
+	if m != nil {
+		return m.CancelScheduledOperationOp
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RaftJoinResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PropagatedRequest) GetUpdateStreamConfigOp() *UpdateStreamConfigOp {
+	if m != nil {
+		return m.UpdateStreamConfigOp
+	}
+	return nil
 }
 
-func (m *RaftJoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0xa
+func (m *PropagatedRequest) GetSetStreamTagsOp() *SetStreamTagsOp {
+	if m != nil {
+		return m.SetStreamTagsOp
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MetadataSnapshot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PropagatedRequest) GetDeletePartitionDataOp() *DeletePartitionDataOp {
+	if m != nil {
+		return m.DeletePartitionDataOp
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MetadataSnapshot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PropagatedRequest) GetSetStreamACLOp() *SetStreamACLOp {
+	if m != nil {
+		return m.SetStreamACLOp
+	}
+	return nil
 }
 
-func (m *MetadataSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInternal(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *PropagatedRequest) GetSetProducerQuotaOp() *SetProducerQuotaOp {
+	if m != nil {
+		return m.SetProducerQuotaOp
 	}
-	if len(m.Streams) > 0 {
-		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintInternal(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return nil
+}
+
+func (m *PropagatedRequest) GetDeleteProducerQuotaOp() *DeleteProducerQuotaOp {
+	if m != nil {
+		return m.DeleteProducerQuotaOp
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ReplicationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PropagatedRequest) GetDeletePartitionOp() *DeletePartitionOp {
+	if m != nil {
+		return m.DeletePartitionOp
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ReplicationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Error struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReplicationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.LeaderEpoch != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Offset != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ReplicaID) > 0 {
-		i -= len(m.ReplicaID)
-		copy(dAtA[i:], m.ReplicaID)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.ReplicaID)))
-		i--
-		dAtA[i] = 0xa
+func (m *Error) Reset()         { *m = Error{} }
+func (m *Error) String() string { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()    {}
+func (*Error) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{64}
+}
+func (m *Error) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Error.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Error) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Error.Merge(m, src)
+}
+func (m *Error) XXX_Size() int {
+	return m.Size()
+}
+func (m *Error) XXX_DiscardUnknown() {
+	xxx_messageInfo_Error.DiscardUnknown(m)
 }
 
-func (m *LeaderEpochOffsetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Error proto.InternalMessageInfo
+
+func (m *Error) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LeaderEpochOffsetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Error) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
 }
 
-func (m *LeaderEpochOffsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.LeaderEpoch != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
-		i--
-		dAtA[i] = 0x8
+type PropagatedResponse struct {
+	Op                    Op                                            `protobuf:"varint,1,opt,name=op,proto3,enum=protocol.Op" json:"op,omitempty"`
+	Error                 *Error                                        `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	JoinConsumerGroupResp *PropagatedResponse_JoinConsumerGroupResponse `protobuf:"bytes,11,opt,name=joinConsumerGroupResp,proto3" json:"joinConsumerGroupResp,omitempty"`
+	RegisterProducerResp  *PropagatedResponse_RegisterProducerResponse  `protobuf:"bytes,14,opt,name=registerProducerResp,proto3" json:"registerProducerResp,omitempty"`
+	ScheduleOperationResp *PropagatedResponse_ScheduleOperationResponse `protobuf:"bytes,15,opt,name=scheduleOperationResp,proto3" json:"scheduleOperationResp,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                                      `json:"-"`
+	XXX_unrecognized      []byte                                        `json:"-"`
+	XXX_sizecache         int32                                         `json:"-"`
+}
+
+func (m *PropagatedResponse) Reset()         { *m = PropagatedResponse{} }
+func (m *PropagatedResponse) String() string { return proto.CompactTextString(m) }
+func (*PropagatedResponse) ProtoMessage()    {}
+func (*PropagatedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{65}
+}
+func (m *PropagatedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PropagatedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PropagatedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PropagatedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PropagatedResponse.Merge(m, src)
+}
+func (m *PropagatedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PropagatedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PropagatedResponse.DiscardUnknown(m)
 }
 
-func (m *LeaderEpochOffsetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PropagatedResponse proto.InternalMessageInfo
+
+func (m *PropagatedResponse) GetOp() Op {
+	if m != nil {
+		return m.Op
 	}
-	return dAtA[:n], nil
+	return Op_CREATE_STREAM
 }
 
-func (m *LeaderEpochOffsetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PropagatedResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
 }
 
-func (m *LeaderEpochOffsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PropagatedResponse) GetJoinConsumerGroupResp() *PropagatedResponse_JoinConsumerGroupResponse {
+	if m != nil {
+		return m.JoinConsumerGroupResp
 	}
-	if m.EndOffset != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.EndOffset))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *PropagatedResponse) GetRegisterProducerResp() *PropagatedResponse_RegisterProducerResponse {
+	if m != nil {
+		return m.RegisterProducerResp
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PropagatedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PropagatedResponse) GetScheduleOperationResp() *PropagatedResponse_ScheduleOperationResponse {
+	if m != nil {
+		return m.ScheduleOperationResp
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PropagatedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reserving = 3 for createStreamResp if needed.
+// Reserving = 4 for shrinkISRResp if needed.
+// Reserving = 5 for reportLeaderResp if needed.
+// Reserving = 6 for expandISRResp if needed.
+// Reserving = 7 for deleteStreamResp if needed.
+// Reserving = 8 for pauseStreamResp if needed.
+// Reserving = 9 for resumeStreamResp if needed.
+// Reserving = 10 for setStreamReadonlyResp if needed.
+type PropagatedResponse_JoinConsumerGroupResponse struct {
+	Coordinator          string   `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PropagatedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ReportConsumerGroupCoordinatorOp != nil {
-		{
-			size, err := m.ReportConsumerGroupCoordinatorOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.LeaveConsumerGroupOp != nil {
-		{
-			size, err := m.LeaveConsumerGroupOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
+func (m *PropagatedResponse_JoinConsumerGroupResponse) Reset() {
+	*m = PropagatedResponse_JoinConsumerGroupResponse{}
+}
+func (m *PropagatedResponse_JoinConsumerGroupResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PropagatedResponse_JoinConsumerGroupResponse) ProtoMessage() {}
+func (*PropagatedResponse_JoinConsumerGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{65, 0}
+}
+func (m *PropagatedResponse_JoinConsumerGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PropagatedResponse_JoinConsumerGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PropagatedResponse_JoinConsumerGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x5a
+		return b[:n], nil
 	}
-	if m.JoinConsumerGroupOp != nil {
-		{
-			size, err := m.JoinConsumerGroupOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+}
+func (m *PropagatedResponse_JoinConsumerGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PropagatedResponse_JoinConsumerGroupResponse.Merge(m, src)
+}
+func (m *PropagatedResponse_JoinConsumerGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PropagatedResponse_JoinConsumerGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PropagatedResponse_JoinConsumerGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PropagatedResponse_JoinConsumerGroupResponse proto.InternalMessageInfo
+
+func (m *PropagatedResponse_JoinConsumerGroupResponse) GetCoordinator() string {
+	if m != nil {
+		return m.Coordinator
 	}
-	if m.SetStreamReadonlyOp != nil {
-		{
-			size, err := m.SetStreamReadonlyOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *PropagatedResponse_JoinConsumerGroupResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	if m.ResumeStreamOp != nil {
-		{
-			size, err := m.ResumeStreamOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
+	return 0
+}
+
+// Reserving = 12 for leaveConsumerGroupResp if needed.
+// Reserving = 13 for reportConsumerGroupCoordinatorResp if needed.
+type PropagatedResponse_RegisterProducerResponse struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PropagatedResponse_RegisterProducerResponse) Reset() {
+	*m = PropagatedResponse_RegisterProducerResponse{}
+}
+func (m *PropagatedResponse_RegisterProducerResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PropagatedResponse_RegisterProducerResponse) ProtoMessage() {}
+func (*PropagatedResponse_RegisterProducerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{65, 1}
+}
+func (m *PropagatedResponse_RegisterProducerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PropagatedResponse_RegisterProducerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PropagatedResponse_RegisterProducerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x42
+		return b[:n], nil
 	}
-	if m.PauseStreamOp != nil {
-		{
-			size, err := m.PauseStreamOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.DeleteStreamOp != nil {
-		{
-			size, err := m.DeleteStreamOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.ExpandISROp != nil {
-		{
-			size, err := m.ExpandISROp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ReportLeaderOp != nil {
-		{
-			size, err := m.ReportLeaderOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ShrinkISROp != nil {
-		{
-			size, err := m.ShrinkISROp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CreateStreamOp != nil {
-		{
-			size, err := m.CreateStreamOp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Op != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *PropagatedResponse_RegisterProducerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PropagatedResponse_RegisterProducerResponse.Merge(m, src)
+}
+func (m *PropagatedResponse_RegisterProducerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PropagatedResponse_RegisterProducerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PropagatedResponse_RegisterProducerResponse.DiscardUnknown(m)
 }
 
-func (m *Error) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PropagatedResponse_RegisterProducerResponse proto.InternalMessageInfo
+
+func (m *PropagatedResponse_RegisterProducerResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Error) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PropagatedResponse_ScheduleOperationResponse struct {
+	Operation            *ScheduledOperation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x8
+func (m *PropagatedResponse_ScheduleOperationResponse) Reset() {
+	*m = PropagatedResponse_ScheduleOperationResponse{}
+}
+func (m *PropagatedResponse_ScheduleOperationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*PropagatedResponse_ScheduleOperationResponse) ProtoMessage() {}
+func (*PropagatedResponse_ScheduleOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{65, 2}
+}
+func (m *PropagatedResponse_ScheduleOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PropagatedResponse_ScheduleOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PropagatedResponse_ScheduleOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PropagatedResponse_ScheduleOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PropagatedResponse_ScheduleOperationResponse.Merge(m, src)
+}
+func (m *PropagatedResponse_ScheduleOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PropagatedResponse_ScheduleOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PropagatedResponse_ScheduleOperationResponse.DiscardUnknown(m)
 }
 
-func (m *PropagatedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PropagatedResponse_ScheduleOperationResponse proto.InternalMessageInfo
+
+func (m *PropagatedResponse_ScheduleOperationResponse) GetOperation() *ScheduledOperation {
+	if m != nil {
+		return m.Operation
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PropagatedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ServerInfoRequest struct {
+	Id                   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rtts                 []*BrokerRTT `protobuf:"bytes,2,rep,name=rtts,proto3" json:"rtts,omitempty"`
+	Host                 string       `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Port                 int32        `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *PropagatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.JoinConsumerGroupResp != nil {
-		{
-			size, err := m.JoinConsumerGroupResp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.Error != nil {
-		{
-			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintInternal(dAtA, i, uint64(size))
+func (m *ServerInfoRequest) Reset()         { *m = ServerInfoRequest{} }
+func (m *ServerInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*ServerInfoRequest) ProtoMessage()    {}
+func (*ServerInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{66}
+}
+func (m *ServerInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServerInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServerInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Op != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ServerInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerInfoRequest.Merge(m, src)
+}
+func (m *ServerInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServerInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerInfoRequest.DiscardUnknown(m)
 }
 
-func (m *PropagatedResponse_JoinConsumerGroupResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ServerInfoRequest proto.InternalMessageInfo
+
+func (m *ServerInfoRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PropagatedResponse_JoinConsumerGroupResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ServerInfoRequest) GetRtts() []*BrokerRTT {
+	if m != nil {
+		return m.Rtts
+	}
+	return nil
 }
 
-func (m *PropagatedResponse_JoinConsumerGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Epoch != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x10
+func (m *ServerInfoRequest) GetHost() string {
+	if m != nil {
+		return m.Host
 	}
-	if len(m.Coordinator) > 0 {
-		i -= len(m.Coordinator)
-		copy(dAtA[i:], m.Coordinator)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Coordinator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ServerInfoRequest) GetPort() int32 {
+	if m != nil {
+		return m.Port
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ServerInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// BrokerRTT is a round-trip time measured from one broker to another.
+type BrokerRTT struct {
+	Broker               string   `protobuf:"bytes,1,opt,name=broker,proto3" json:"broker,omitempty"`
+	Rtt                  int64    `protobuf:"varint,2,opt,name=rtt,proto3" json:"rtt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ServerInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BrokerRTT) Reset()         { *m = BrokerRTT{} }
+func (m *BrokerRTT) String() string { return proto.CompactTextString(m) }
+func (*BrokerRTT) ProtoMessage()    {}
+func (*BrokerRTT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{67}
 }
-
-func (m *ServerInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *BrokerRTT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BrokerRTT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BrokerRTT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *BrokerRTT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BrokerRTT.Merge(m, src)
+}
+func (m *BrokerRTT) XXX_Size() int {
+	return m.Size()
+}
+func (m *BrokerRTT) XXX_DiscardUnknown() {
+	xxx_messageInfo_BrokerRTT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BrokerRTT proto.InternalMessageInfo
+
+func (m *BrokerRTT) GetBroker() string {
+	if m != nil {
+		return m.Broker
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ServerInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BrokerRTT) GetRtt() int64 {
+	if m != nil {
+		return m.Rtt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ServerInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ServerInfoResponse struct {
+	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Host                 string                `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port                 int32                 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	ProtocolVersion      uint32                `protobuf:"varint,4,opt,name=protocolVersion,proto3" json:"protocolVersion,omitempty"`
+	Listeners            []*AdvertisedListener `protobuf:"bytes,5,rep,name=listeners,proto3" json:"listeners,omitempty"`
+	Draining             bool                  `protobuf:"varint,6,opt,name=draining,proto3" json:"draining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *ServerInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Port != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Port))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Host) > 0 {
-		i -= len(m.Host)
-		copy(dAtA[i:], m.Host)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Host)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *ServerInfoResponse) Reset()         { *m = ServerInfoResponse{} }
+func (m *ServerInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*ServerInfoResponse) ProtoMessage()    {}
+func (*ServerInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{68}
+}
+func (m *ServerInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServerInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServerInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ServerInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerInfoResponse.Merge(m, src)
+}
+func (m *ServerInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServerInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerInfoResponse.DiscardUnknown(m)
 }
 
-func (m *PartitionStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ServerInfoResponse proto.InternalMessageInfo
+
+func (m *ServerInfoResponse) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PartitionStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ServerInfoResponse) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
 }
 
-func (m *PartitionStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ServerInfoResponse) GetPort() int32 {
+	if m != nil {
+		return m.Port
 	}
-	if m.Partition != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *ServerInfoResponse) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
 	}
-	if len(m.Stream) > 0 {
-		i -= len(m.Stream)
-		copy(dAtA[i:], m.Stream)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ServerInfoResponse) GetListeners() []*AdvertisedListener {
+	if m != nil {
+		return m.Listeners
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PartitionStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ServerInfoResponse) GetDraining() bool {
+	if m != nil {
+		return m.Draining
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *PartitionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AdvertisedListener is the address clients connecting through a broker's
+// named listener should use.
+type AdvertisedListener struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Host                 string   `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port                 int32    `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PartitionStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IsLeader {
-		i--
-		if m.IsLeader {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Exists {
-		i--
-		if m.Exists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *AdvertisedListener) Reset()         { *m = AdvertisedListener{} }
+func (m *AdvertisedListener) String() string { return proto.CompactTextString(m) }
+func (*AdvertisedListener) ProtoMessage()    {}
+func (*AdvertisedListener) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{69}
+}
+func (m *AdvertisedListener) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdvertisedListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdvertisedListener.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AdvertisedListener) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdvertisedListener.Merge(m, src)
+}
+func (m *AdvertisedListener) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdvertisedListener) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdvertisedListener.DiscardUnknown(m)
 }
 
-func (m *PartitionNotification) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AdvertisedListener proto.InternalMessageInfo
+
+func (m *AdvertisedListener) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PartitionNotification) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AdvertisedListener) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
 }
 
-func (m *PartitionNotification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *AdvertisedListener) GetPort() int32 {
+	if m != nil {
+		return m.Port
 	}
-	if m.Partition != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+type PartitionStatusRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartitionStatusRequest) Reset()         { *m = PartitionStatusRequest{} }
+func (m *PartitionStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*PartitionStatusRequest) ProtoMessage()    {}
+func (*PartitionStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{70}
+}
+func (m *PartitionStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Stream) > 0 {
-		i -= len(m.Stream)
-		copy(dAtA[i:], m.Stream)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *PartitionStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionStatusRequest.Merge(m, src)
+}
+func (m *PartitionStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionStatusRequest proto.InternalMessageInfo
+
+func (m *PartitionStatusRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Cursor) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PartitionStatusRequest) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Cursor) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PartitionStatusResponse struct {
+	Exists               bool                    `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	IsLeader             bool                    `protobuf:"varint,2,opt,name=isLeader,proto3" json:"isLeader,omitempty"`
+	Status               *PartitionReplicaStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *Cursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PartitionStatusResponse) Reset()         { *m = PartitionStatusResponse{} }
+func (m *PartitionStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*PartitionStatusResponse) ProtoMessage()    {}
+func (*PartitionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{71}
+}
+func (m *PartitionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Offset != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *PartitionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionStatusResponse.Merge(m, src)
+}
+func (m *PartitionStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionStatusResponse proto.InternalMessageInfo
+
+func (m *PartitionStatusResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
 	}
-	if len(m.CursorId) > 0 {
-		i -= len(m.CursorId)
-		copy(dAtA[i:], m.CursorId)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.CursorId)))
-		i--
-		dAtA[i] = 0x1a
+	return false
+}
+
+func (m *PartitionStatusResponse) GetIsLeader() bool {
+	if m != nil {
+		return m.IsLeader
 	}
-	if m.Partition != 0 {
-		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
-		i--
-		dAtA[i] = 0x10
+	return false
+}
+
+func (m *PartitionStatusResponse) GetStatus() *PartitionReplicaStatus {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.Stream) > 0 {
-		i -= len(m.Stream)
-		copy(dAtA[i:], m.Stream)
-		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// PartitionReplicaStatus is a broker's local view of a partition.
+type PartitionReplicaStatus struct {
+	Broker               string         `protobuf:"bytes,1,opt,name=broker,proto3" json:"broker,omitempty"`
+	State                PartitionState `protobuf:"varint,2,opt,name=state,proto3,enum=protocol.PartitionState" json:"state,omitempty"`
+	IsLeader             bool           `protobuf:"varint,3,opt,name=isLeader,proto3" json:"isLeader,omitempty"`
+	Readonly             bool           `protobuf:"varint,4,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	Leader               string         `protobuf:"bytes,5,opt,name=leader,proto3" json:"leader,omitempty"`
+	LeaderEpoch          uint64         `protobuf:"varint,6,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+	LeaderChangedAt      int64          `protobuf:"varint,7,opt,name=leaderChangedAt,proto3" json:"leaderChangedAt,omitempty"`
+	Error                string         `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	LogEndOffset         int64          `protobuf:"varint,9,opt,name=logEndOffset,proto3" json:"logEndOffset,omitempty"`
+	LogLeaderEpoch       uint64         `protobuf:"varint,10,opt,name=logLeaderEpoch,proto3" json:"logLeaderEpoch,omitempty"`
+	HighWatermark        int64          `protobuf:"varint,11,opt,name=highWatermark,proto3" json:"highWatermark,omitempty"`
+	LogStartOffset       int64          `protobuf:"varint,12,opt,name=logStartOffset,proto3" json:"logStartOffset,omitempty"`
+	SizeBytes            int64          `protobuf:"varint,13,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PartitionReplicaStatus) Reset()         { *m = PartitionReplicaStatus{} }
+func (m *PartitionReplicaStatus) String() string { return proto.CompactTextString(m) }
+func (*PartitionReplicaStatus) ProtoMessage()    {}
+func (*PartitionReplicaStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{72}
+}
+func (m *PartitionReplicaStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionReplicaStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionReplicaStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PartitionReplicaStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionReplicaStatus.Merge(m, src)
+}
+func (m *PartitionReplicaStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionReplicaStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionReplicaStatus.DiscardUnknown(m)
 }
 
-func encodeVarintInternal(dAtA []byte, offset int, v uint64) int {
-	offset -= sovInternal(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_PartitionReplicaStatus proto.InternalMessageInfo
+
+func (m *PartitionReplicaStatus) GetBroker() string {
+	if m != nil {
+		return m.Broker
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *ServerState) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *PartitionReplicaStatus) GetState() PartitionState {
+	if m != nil {
+		return m.State
 	}
-	var l int
-	_ = l
-	l = len(m.ServerID)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return PartitionState_PARTITION_STATE_NOT_FOUND
+}
+
+func (m *PartitionReplicaStatus) GetIsLeader() bool {
+	if m != nil {
+		return m.IsLeader
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return false
+}
+
+func (m *PartitionReplicaStatus) GetReadonly() bool {
+	if m != nil {
+		return m.Readonly
 	}
-	return n
+	return false
 }
 
-func (m *RaftLog) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PartitionReplicaStatus) GetLeader() string {
+	if m != nil {
+		return m.Leader
 	}
-	var l int
-	_ = l
-	if m.Op != 0 {
-		n += 1 + sovInternal(uint64(m.Op))
+	return ""
+}
+
+func (m *PartitionReplicaStatus) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
 	}
-	if m.CreateStreamOp != nil {
-		l = m.CreateStreamOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+func (m *PartitionReplicaStatus) GetLeaderChangedAt() int64 {
+	if m != nil {
+		return m.LeaderChangedAt
 	}
-	if m.ShrinkISROp != nil {
-		l = m.ShrinkISROp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+func (m *PartitionReplicaStatus) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if m.ChangeLeaderOp != nil {
-		l = m.ChangeLeaderOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return ""
+}
+
+func (m *PartitionReplicaStatus) GetLogEndOffset() int64 {
+	if m != nil {
+		return m.LogEndOffset
 	}
-	if m.ExpandISROp != nil {
-		l = m.ExpandISROp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+func (m *PartitionReplicaStatus) GetLogLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LogLeaderEpoch
 	}
-	if m.DeleteStreamOp != nil {
-		l = m.DeleteStreamOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+func (m *PartitionReplicaStatus) GetHighWatermark() int64 {
+	if m != nil {
+		return m.HighWatermark
 	}
-	if m.PauseStreamOp != nil {
-		l = m.PauseStreamOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.ResumeStreamOp != nil {
-		l = m.ResumeStreamOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.PublishActivityOp != nil {
-		l = m.PublishActivityOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.SetStreamReadonlyOp != nil {
-		l = m.SetStreamReadonlyOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.CreateConsumerGroupOp != nil {
-		l = m.CreateConsumerGroupOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.JoinConsumerGroupOp != nil {
-		l = m.JoinConsumerGroupOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.LeaveConsumerGroupOp != nil {
-		l = m.LeaveConsumerGroupOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.ChangeConsumerGroupCoordinatorOp != nil {
-		l = m.ChangeConsumerGroupCoordinatorOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return 0
 }
 
-func (m *CreateStreamOp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Stream != nil {
-		l = m.Stream.Size()
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *PartitionReplicaStatus) GetLogStartOffset() int64 {
+	if m != nil {
+		return m.LogStartOffset
 	}
-	return n
+	return 0
 }
 
-func (m *ShrinkISROp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Stream)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.Partition != 0 {
-		n += 1 + sovInternal(uint64(m.Partition))
-	}
-	l = len(m.ReplicaToRemove)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	l = len(m.Leader)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.LeaderEpoch != 0 {
-		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+func (m *PartitionReplicaStatus) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+// PartitionCleanRequest is sent to a replica of a partition to clean its log
+// immediately.
+type PartitionCleanRequest struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartitionCleanRequest) Reset()         { *m = PartitionCleanRequest{} }
+func (m *PartitionCleanRequest) String() string { return proto.CompactTextString(m) }
+func (*PartitionCleanRequest) ProtoMessage()    {}
+func (*PartitionCleanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{73}
+}
+func (m *PartitionCleanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionCleanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionCleanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *PartitionCleanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionCleanRequest.Merge(m, src)
+}
+func (m *PartitionCleanRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionCleanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionCleanRequest.DiscardUnknown(m)
 }
 
-func (m *ExpandISROp) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_PartitionCleanRequest proto.InternalMessageInfo
+
+func (m *PartitionCleanRequest) GetStream() string {
+	if m != nil {
+		return m.Stream
 	}
-	var l int
-	_ = l
-	l = len(m.Stream)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return ""
+}
+
+func (m *PartitionCleanRequest) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
 	}
-	if m.Partition != 0 {
-		n += 1 + sovInternal(uint64(m.Partition))
+	return 0
+}
+
+type PartitionCleanResponse struct {
+	Result               *PartitionCleanResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PartitionCleanResponse) Reset()         { *m = PartitionCleanResponse{} }
+func (m *PartitionCleanResponse) String() string { return proto.CompactTextString(m) }
+func (*PartitionCleanResponse) ProtoMessage()    {}
+func (*PartitionCleanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{74}
+}
+func (m *PartitionCleanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionCleanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionCleanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ReplicaToAdd)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+}
+func (m *PartitionCleanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionCleanResponse.Merge(m, src)
+}
+func (m *PartitionCleanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionCleanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionCleanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionCleanResponse proto.InternalMessageInfo
+
+func (m *PartitionCleanResponse) GetResult() *PartitionCleanResult {
+	if m != nil {
+		return m.Result
 	}
-	l = len(m.Leader)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return nil
+}
+
+// PartitionCleanResult summarizes the clean of a partition's log on a broker.
+type PartitionCleanResult struct {
+	Broker               string   `protobuf:"bytes,1,opt,name=broker,proto3" json:"broker,omitempty"`
+	IsLeader             bool     `protobuf:"varint,2,opt,name=isLeader,proto3" json:"isLeader,omitempty"`
+	SegmentsBefore       int32    `protobuf:"varint,3,opt,name=segmentsBefore,proto3" json:"segmentsBefore,omitempty"`
+	SegmentsAfter        int32    `protobuf:"varint,4,opt,name=segmentsAfter,proto3" json:"segmentsAfter,omitempty"`
+	BytesReclaimed       int64    `protobuf:"varint,5,opt,name=bytesReclaimed,proto3" json:"bytesReclaimed,omitempty"`
+	KeysCompacted        int64    `protobuf:"varint,6,opt,name=keysCompacted,proto3" json:"keysCompacted,omitempty"`
+	TombstonesDropped    int64    `protobuf:"varint,7,opt,name=tombstonesDropped,proto3" json:"tombstonesDropped,omitempty"`
+	CompactEnabled       bool     `protobuf:"varint,8,opt,name=compactEnabled,proto3" json:"compactEnabled,omitempty"`
+	Paused               bool     `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
+	Duration             int64    `protobuf:"varint,10,opt,name=duration,proto3" json:"duration,omitempty"`
+	Error                string   `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartitionCleanResult) Reset()         { *m = PartitionCleanResult{} }
+func (m *PartitionCleanResult) String() string { return proto.CompactTextString(m) }
+func (*PartitionCleanResult) ProtoMessage()    {}
+func (*PartitionCleanResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{75}
+}
+func (m *PartitionCleanResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionCleanResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionCleanResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.LeaderEpoch != 0 {
-		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+}
+func (m *PartitionCleanResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionCleanResult.Merge(m, src)
+}
+func (m *PartitionCleanResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionCleanResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionCleanResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionCleanResult proto.InternalMessageInfo
+
+func (m *PartitionCleanResult) GetBroker() string {
+	if m != nil {
+		return m.Broker
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *PartitionCleanResult) GetIsLeader() bool {
+	if m != nil {
+		return m.IsLeader
 	}
-	return n
+	return false
 }
 
-func (m *DeleteStreamOp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PartitionCleanResult) GetSegmentsBefore() int32 {
+	if m != nil {
+		return m.SegmentsBefore
 	}
-	var l int
-	_ = l
-	l = len(m.Stream)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+func (m *PartitionCleanResult) GetSegmentsAfter() int32 {
+	if m != nil {
+		return m.SegmentsAfter
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *PartitionCleanResult) GetBytesReclaimed() int64 {
+	if m != nil {
+		return m.BytesReclaimed
 	}
-	return n
+	return 0
 }
 
-func (m *PauseStreamOp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PartitionCleanResult) GetKeysCompacted() int64 {
+	if m != nil {
+		return m.KeysCompacted
 	}
-	var l int
-	_ = l
-	l = len(m.Stream)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+func (m *PartitionCleanResult) GetTombstonesDropped() int64 {
+	if m != nil {
+		return m.TombstonesDropped
 	}
-	if len(m.Partitions) > 0 {
-		l = 0
-		for _, e := range m.Partitions {
-			l += sovInternal(uint64(e))
-		}
-		n += 1 + sovInternal(uint64(l)) + l
+	return 0
+}
+
+func (m *PartitionCleanResult) GetCompactEnabled() bool {
+	if m != nil {
+		return m.CompactEnabled
 	}
-	if m.ResumeAll {
-		n += 2
+	return false
+}
+
+func (m *PartitionCleanResult) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return false
+}
+
+func (m *PartitionCleanResult) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
 	}
-	return n
+	return 0
 }
 
-func (m *ResumeStreamOp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PartitionCleanResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	var l int
-	_ = l
-	l = len(m.Stream)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if len(m.Partitions) > 0 {
-		l = 0
-		for _, e := range m.Partitions {
-			l += sovInternal(uint64(e))
+	return ""
+}
+
+type PartitionNotification struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartitionNotification) Reset()         { *m = PartitionNotification{} }
+func (m *PartitionNotification) String() string { return proto.CompactTextString(m) }
+func (*PartitionNotification) ProtoMessage()    {}
+func (*PartitionNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{76}
+}
+func (m *PartitionNotification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionNotification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovInternal(uint64(l)) + l
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return n
+}
+func (m *PartitionNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionNotification.Merge(m, src)
+}
+func (m *PartitionNotification) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionNotification.DiscardUnknown(m)
 }
 
-func (m *ReportLeaderOp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Stream)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.Partition != 0 {
-		n += 1 + sovInternal(uint64(m.Partition))
-	}
-	l = len(m.Replica)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	l = len(m.Leader)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.LeaderEpoch != 0 {
-		n += 1 + sovInternal(uint64(m.LeaderEpoch))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_PartitionNotification proto.InternalMessageInfo
+
+func (m *PartitionNotification) GetStream() string {
+	if m != nil {
+		return m.Stream
 	}
-	return n
+	return ""
 }
 
-func (m *ChangeLeaderOp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PartitionNotification) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
 	}
-	var l int
-	_ = l
-	l = len(m.Stream)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+// PartitionStarted is published by a partition leader once it has finished
+// starting the partition so that brokers waiting on it don't need to poll.
+type PartitionStarted struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	Leader               string   `protobuf:"bytes,3,opt,name=leader,proto3" json:"leader,omitempty"`
+	LeaderEpoch          uint64   `protobuf:"varint,4,opt,name=leaderEpoch,proto3" json:"leaderEpoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartitionStarted) Reset()         { *m = PartitionStarted{} }
+func (m *PartitionStarted) String() string { return proto.CompactTextString(m) }
+func (*PartitionStarted) ProtoMessage()    {}
+func (*PartitionStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{77}
+}
+func (m *PartitionStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Partition != 0 {
-		n += 1 + sovInternal(uint64(m.Partition))
+}
+func (m *PartitionStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionStarted.Merge(m, src)
+}
+func (m *PartitionStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionStarted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionStarted proto.InternalMessageInfo
+
+func (m *PartitionStarted) GetStream() string {
+	if m != nil {
+		return m.Stream
 	}
-	l = len(m.Leader)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return ""
+}
+
+func (m *PartitionStarted) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *PartitionStarted) GetLeader() string {
+	if m != nil {
+		return m.Leader
 	}
-	return n
+	return ""
 }
 
-func (m *ReportConsumerGroupCoordinatorOp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PartitionStarted) GetLeaderEpoch() uint64 {
+	if m != nil {
+		return m.LeaderEpoch
 	}
-	var l int
-	_ = l
-	l = len(m.GroupId)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+// KeyCount is the estimated number of recent messages published to a
+// partition with a key.
+type KeyCount struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeyCount) Reset()         { *m = KeyCount{} }
+func (m *KeyCount) String() string { return proto.CompactTextString(m) }
+func (*KeyCount) ProtoMessage()    {}
+func (*KeyCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{78}
+}
+func (m *KeyCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+}
+func (m *KeyCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyCount.Merge(m, src)
+}
+func (m *KeyCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyCount proto.InternalMessageInfo
+
+func (m *KeyCount) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	l = len(m.Coordinator)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return nil
+}
+
+func (m *KeyCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	if m.Epoch != 0 {
-		n += 1 + sovInternal(uint64(m.Epoch))
+	return 0
+}
+
+// ReadAmplification compares the message bytes read from a partition's log
+// segments to the message bytes appended to it. Reads are broken down by
+// source: subscriptions reading new messages at the tail of the log,
+// subscriptions replaying history, and the leader replicating to followers.
+// Counts are cumulative since the log was opened by the broker.
+type ReadAmplification struct {
+	BytesAppended        int64    `protobuf:"varint,1,opt,name=bytesAppended,proto3" json:"bytesAppended,omitempty"`
+	TailBytesRead        int64    `protobuf:"varint,2,opt,name=tailBytesRead,proto3" json:"tailBytesRead,omitempty"`
+	HistoricalBytesRead  int64    `protobuf:"varint,3,opt,name=historicalBytesRead,proto3" json:"historicalBytesRead,omitempty"`
+	ReplicationBytesRead int64    `protobuf:"varint,4,opt,name=replicationBytesRead,proto3" json:"replicationBytesRead,omitempty"`
+	ColdSegmentOpens     int64    `protobuf:"varint,5,opt,name=coldSegmentOpens,proto3" json:"coldSegmentOpens,omitempty"`
+	Factor               float64  `protobuf:"fixed64,6,opt,name=factor,proto3" json:"factor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadAmplification) Reset()         { *m = ReadAmplification{} }
+func (m *ReadAmplification) String() string { return proto.CompactTextString(m) }
+func (*ReadAmplification) ProtoMessage()    {}
+func (*ReadAmplification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{79}
+}
+func (m *ReadAmplification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadAmplification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadAmplification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *ReadAmplification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadAmplification.Merge(m, src)
+}
+func (m *ReadAmplification) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadAmplification) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadAmplification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadAmplification proto.InternalMessageInfo
+
+func (m *ReadAmplification) GetBytesAppended() int64 {
+	if m != nil {
+		return m.BytesAppended
 	}
-	return n
+	return 0
 }
 
-func (m *ChangeConsumerGroupCoordinatorOp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReadAmplification) GetTailBytesRead() int64 {
+	if m != nil {
+		return m.TailBytesRead
 	}
-	var l int
-	_ = l
-	l = len(m.GroupId)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+func (m *ReadAmplification) GetHistoricalBytesRead() int64 {
+	if m != nil {
+		return m.HistoricalBytesRead
 	}
-	l = len(m.Coordinator)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+func (m *ReadAmplification) GetReplicationBytesRead() int64 {
+	if m != nil {
+		return m.ReplicationBytesRead
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *ReadAmplification) GetColdSegmentOpens() int64 {
+	if m != nil {
+		return m.ColdSegmentOpens
 	}
-	return n
+	return 0
 }
 
-func (m *PublishActivityOp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReadAmplification) GetFactor() float64 {
+	if m != nil {
+		return m.Factor
 	}
-	var l int
-	_ = l
-	if m.RaftIndex != 0 {
-		n += 1 + sovInternal(uint64(m.RaftIndex))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return 0
 }
 
-func (m *SetStreamReadonlyOp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Stream)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if len(m.Partitions) > 0 {
-		l = 0
-		for _, e := range m.Partitions {
-			l += sovInternal(uint64(e))
+// PartitionLoad is the load of a partition as measured by its leader.
+type PartitionLoad struct {
+	Stream               string             `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32              `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	MessagesInRate       int64              `protobuf:"varint,3,opt,name=messagesInRate,proto3" json:"messagesInRate,omitempty"`
+	TopKeys              []*KeyCount        `protobuf:"bytes,4,rep,name=topKeys,proto3" json:"topKeys,omitempty"`
+	ReadAmplification    *ReadAmplification `protobuf:"bytes,5,opt,name=readAmplification,proto3" json:"readAmplification,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *PartitionLoad) Reset()         { *m = PartitionLoad{} }
+func (m *PartitionLoad) String() string { return proto.CompactTextString(m) }
+func (*PartitionLoad) ProtoMessage()    {}
+func (*PartitionLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{80}
+}
+func (m *PartitionLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovInternal(uint64(l)) + l
-	}
-	if m.Readonly {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return n
+}
+func (m *PartitionLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionLoad.Merge(m, src)
+}
+func (m *PartitionLoad) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionLoad.DiscardUnknown(m)
 }
 
-func (m *CreateConsumerGroupOp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ConsumerGroup != nil {
-		l = m.ConsumerGroup.Size()
-		n += 1 + l + sovInternal(uint64(l))
+var xxx_messageInfo_PartitionLoad proto.InternalMessageInfo
+
+func (m *PartitionLoad) GetStream() string {
+	if m != nil {
+		return m.Stream
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *PartitionLoad) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
 	}
-	return n
+	return 0
 }
 
-func (m *JoinConsumerGroupOp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PartitionLoad) GetMessagesInRate() int64 {
+	if m != nil {
+		return m.MessagesInRate
 	}
-	var l int
-	_ = l
-	l = len(m.GroupId)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+func (m *PartitionLoad) GetTopKeys() []*KeyCount {
+	if m != nil {
+		return m.TopKeys
 	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return nil
+}
+
+func (m *PartitionLoad) GetReadAmplification() *ReadAmplification {
+	if m != nil {
+		return m.ReadAmplification
 	}
-	if len(m.Streams) > 0 {
-		for _, s := range m.Streams {
-			l = len(s)
-			n += 1 + l + sovInternal(uint64(l))
+	return nil
+}
+
+// PartitionLoadReport is broadcast periodically by each broker with the load
+// of the partitions it leads.
+type PartitionLoadReport struct {
+	Id                   string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Partitions           []*PartitionLoad `protobuf:"bytes,2,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PartitionLoadReport) Reset()         { *m = PartitionLoadReport{} }
+func (m *PartitionLoadReport) String() string { return proto.CompactTextString(m) }
+func (*PartitionLoadReport) ProtoMessage()    {}
+func (*PartitionLoadReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{81}
+}
+func (m *PartitionLoadReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionLoadReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionLoadReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *PartitionLoadReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionLoadReport.Merge(m, src)
+}
+func (m *PartitionLoadReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionLoadReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionLoadReport.DiscardUnknown(m)
 }
 
-func (m *LeaveConsumerGroupOp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GroupId)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+var xxx_messageInfo_PartitionLoadReport proto.InternalMessageInfo
+
+func (m *PartitionLoadReport) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return ""
+}
+
+func (m *PartitionLoadReport) GetPartitions() []*PartitionLoad {
+	if m != nil {
+		return m.Partitions
 	}
-	if m.Expired {
-		n += 2
+	return nil
+}
+
+// BootstrapProbe is sent periodically by a server started with
+// raft.bootstrap.seed to detect other servers started with it.
+type BootstrapProbe struct {
+	ServerID             string   `protobuf:"bytes,1,opt,name=serverID,proto3" json:"serverID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BootstrapProbe) Reset()         { *m = BootstrapProbe{} }
+func (m *BootstrapProbe) String() string { return proto.CompactTextString(m) }
+func (*BootstrapProbe) ProtoMessage()    {}
+func (*BootstrapProbe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{82}
+}
+func (m *BootstrapProbe) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BootstrapProbe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BootstrapProbe.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *BootstrapProbe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BootstrapProbe.Merge(m, src)
+}
+func (m *BootstrapProbe) XXX_Size() int {
+	return m.Size()
+}
+func (m *BootstrapProbe) XXX_DiscardUnknown() {
+	xxx_messageInfo_BootstrapProbe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BootstrapProbe proto.InternalMessageInfo
+
+func (m *BootstrapProbe) GetServerID() string {
+	if m != nil {
+		return m.ServerID
 	}
-	return n
+	return ""
 }
 
-func (m *NullableInt64) Size() (n int) {
-	if m == nil {
-		return 0
+// StreamSkew describes how evenly a stream's load is spread across its
+// partitions. Rates are in messages per second.
+type StreamSkew struct {
+	Stream               string             `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	MeanRate             int64              `protobuf:"varint,2,opt,name=meanRate,proto3" json:"meanRate,omitempty"`
+	MaxRate              int64              `protobuf:"varint,3,opt,name=maxRate,proto3" json:"maxRate,omitempty"`
+	Variance             int64              `protobuf:"varint,4,opt,name=variance,proto3" json:"variance,omitempty"`
+	Skewed               bool               `protobuf:"varint,5,opt,name=skewed,proto3" json:"skewed,omitempty"`
+	HotPartition         int32              `protobuf:"varint,6,opt,name=hotPartition,proto3" json:"hotPartition,omitempty"`
+	TopKeys              []*KeyCount        `protobuf:"bytes,7,rep,name=topKeys,proto3" json:"topKeys,omitempty"`
+	Partitions           []*PartitionLoad   `protobuf:"bytes,8,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	ReadAmplification    *ReadAmplification `protobuf:"bytes,9,opt,name=readAmplification,proto3" json:"readAmplification,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *StreamSkew) Reset()         { *m = StreamSkew{} }
+func (m *StreamSkew) String() string { return proto.CompactTextString(m) }
+func (*StreamSkew) ProtoMessage()    {}
+func (*StreamSkew) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{83}
+}
+func (m *StreamSkew) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamSkew) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamSkew.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovInternal(uint64(m.Value))
+}
+func (m *StreamSkew) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamSkew.Merge(m, src)
+}
+func (m *StreamSkew) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamSkew) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamSkew.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamSkew proto.InternalMessageInfo
+
+func (m *StreamSkew) GetStream() string {
+	if m != nil {
+		return m.Stream
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *StreamSkew) GetMeanRate() int64 {
+	if m != nil {
+		return m.MeanRate
 	}
-	return n
+	return 0
 }
 
-func (m *NullableInt32) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StreamSkew) GetMaxRate() int64 {
+	if m != nil {
+		return m.MaxRate
 	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovInternal(uint64(m.Value))
+	return 0
+}
+
+func (m *StreamSkew) GetVariance() int64 {
+	if m != nil {
+		return m.Variance
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *StreamSkew) GetSkewed() bool {
+	if m != nil {
+		return m.Skewed
 	}
-	return n
+	return false
 }
 
-func (m *NullableBool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StreamSkew) GetHotPartition() int32 {
+	if m != nil {
+		return m.HotPartition
 	}
-	var l int
-	_ = l
-	if m.Value {
-		n += 2
+	return 0
+}
+
+func (m *StreamSkew) GetTopKeys() []*KeyCount {
+	if m != nil {
+		return m.TopKeys
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func (m *StreamSkew) GetPartitions() []*PartitionLoad {
+	if m != nil {
+		return m.Partitions
 	}
-	return n
+	return nil
 }
 
-func (m *StreamConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StreamSkew) GetReadAmplification() *ReadAmplification {
+	if m != nil {
+		return m.ReadAmplification
 	}
-	var l int
-	_ = l
-	if m.RetentionMaxBytes != nil {
-		l = m.RetentionMaxBytes.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return nil
+}
+
+type Cursor struct {
+	Stream               string   `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	CursorId             string   `protobuf:"bytes,3,opt,name=cursorId,proto3" json:"cursorId,omitempty"`
+	Offset               int64    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Cursor) Reset()         { *m = Cursor{} }
+func (m *Cursor) String() string { return proto.CompactTextString(m) }
+func (*Cursor) ProtoMessage()    {}
+func (*Cursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{84}
+}
+func (m *Cursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Cursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Cursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.RetentionMaxMessages != nil {
-		l = m.RetentionMaxMessages.Size()
-		n += 1 + l + sovInternal(uint64(l))
+}
+func (m *Cursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cursor.Merge(m, src)
+}
+func (m *Cursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Cursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cursor proto.InternalMessageInfo
+
+func (m *Cursor) GetStream() string {
+	if m != nil {
+		return m.Stream
 	}
-	if m.RetentionMaxAge != nil {
-		l = m.RetentionMaxAge.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return ""
+}
+
+func (m *Cursor) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
 	}
-	if m.CleanerInterval != nil {
-		l = m.CleanerInterval.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+func (m *Cursor) GetCursorId() string {
+	if m != nil {
+		return m.CursorId
 	}
-	if m.SegmentMaxBytes != nil {
-		l = m.SegmentMaxBytes.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return ""
+}
+
+func (m *Cursor) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
-	if m.SegmentMaxAge != nil {
-		l = m.SegmentMaxAge.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+// CursorRequest is sent to the leader of the cursors partition a cursor maps
+// to in order to fetch the cursor or, if set is true, to set it on behalf of
+// another server.
+type CursorRequest struct {
+	Cursor               *Cursor  `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Set                  bool     `protobuf:"varint,2,opt,name=set,proto3" json:"set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CursorRequest) Reset()         { *m = CursorRequest{} }
+func (m *CursorRequest) String() string { return proto.CompactTextString(m) }
+func (*CursorRequest) ProtoMessage()    {}
+func (*CursorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{85}
+}
+func (m *CursorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CursorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CursorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.CompactMaxGoroutines != nil {
-		l = m.CompactMaxGoroutines.Size()
-		n += 1 + l + sovInternal(uint64(l))
+}
+func (m *CursorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CursorRequest.Merge(m, src)
+}
+func (m *CursorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CursorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CursorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CursorRequest proto.InternalMessageInfo
+
+func (m *CursorRequest) GetCursor() *Cursor {
+	if m != nil {
+		return m.Cursor
 	}
-	if m.CompactEnabled != nil {
-		l = m.CompactEnabled.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return nil
+}
+
+func (m *CursorRequest) GetSet() bool {
+	if m != nil {
+		return m.Set
 	}
-	if m.AutoPauseTime != nil {
-		l = m.AutoPauseTime.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return false
+}
+
+type CursorResponse struct {
+	Offset               int64    `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Error                *Error   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CursorResponse) Reset()         { *m = CursorResponse{} }
+func (m *CursorResponse) String() string { return proto.CompactTextString(m) }
+func (*CursorResponse) ProtoMessage()    {}
+func (*CursorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{86}
+}
+func (m *CursorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CursorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CursorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.AutoPauseDisableIfSubscribers != nil {
-		l = m.AutoPauseDisableIfSubscribers.Size()
-		n += 1 + l + sovInternal(uint64(l))
+}
+func (m *CursorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CursorResponse.Merge(m, src)
+}
+func (m *CursorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CursorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CursorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CursorResponse proto.InternalMessageInfo
+
+func (m *CursorResponse) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
-	if m.MinIsr != nil {
-		l = m.MinIsr.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+func (m *CursorResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
 	}
-	if m.OptimisticConcurrencyControl != nil {
-		l = m.OptimisticConcurrencyControl.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return nil
+}
+
+// ScheduledOperation is a stream operation the metadata leader executes once
+// its clock reaches executeAt.
+type ScheduledOperation struct {
+	Id                   uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                 ScheduledOperationType  `protobuf:"varint,2,opt,name=type,proto3,enum=protocol.ScheduledOperationType" json:"type,omitempty"`
+	Stream               string                  `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+	Partitions           []int32                 `protobuf:"varint,4,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
+	Readonly             bool                    `protobuf:"varint,5,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	ExecuteAt            int64                   `protobuf:"varint,6,opt,name=executeAt,proto3" json:"executeAt,omitempty"`
+	CreatedBy            string                  `protobuf:"bytes,7,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt            int64                   `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	State                ScheduledOperationState `protobuf:"varint,9,opt,name=state,proto3,enum=protocol.ScheduledOperationState" json:"state,omitempty"`
+	ExecutedIndex        uint64                  `protobuf:"varint,10,opt,name=executedIndex,proto3" json:"executedIndex,omitempty"`
+	ExecutedAt           int64                   `protobuf:"varint,11,opt,name=executedAt,proto3" json:"executedAt,omitempty"`
+	ExecutedBy           string                  `protobuf:"bytes,12,opt,name=executedBy,proto3" json:"executedBy,omitempty"`
+	Error                string                  `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ScheduledOperation) Reset()         { *m = ScheduledOperation{} }
+func (m *ScheduledOperation) String() string { return proto.CompactTextString(m) }
+func (*ScheduledOperation) ProtoMessage()    {}
+func (*ScheduledOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9410777bf851c3, []int{87}
+}
+func (m *ScheduledOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Encryption != nil {
-		l = m.Encryption.Size()
-		n += 1 + l + sovInternal(uint64(l))
+}
+func (m *ScheduledOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledOperation.Merge(m, src)
+}
+func (m *ScheduledOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledOperation proto.InternalMessageInfo
+
+func (m *ScheduledOperation) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *ScheduledOperation) GetType() ScheduledOperationType {
+	if m != nil {
+		return m.Type
 	}
-	return n
+	return ScheduledOperationType_SCHEDULED_PAUSE_STREAM
 }
 
-func (m *Stream) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScheduledOperation) GetStream() string {
+	if m != nil {
+		return m.Stream
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return ""
+}
+
+func (m *ScheduledOperation) GetPartitions() []int32 {
+	if m != nil {
+		return m.Partitions
 	}
-	l = len(m.Subject)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	return nil
+}
+
+func (m *ScheduledOperation) GetReadonly() bool {
+	if m != nil {
+		return m.Readonly
 	}
-	if len(m.Partitions) > 0 {
-		for _, e := range m.Partitions {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
+	return false
+}
+
+func (m *ScheduledOperation) GetExecuteAt() int64 {
+	if m != nil {
+		return m.ExecuteAt
 	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	return 0
+}
+
+func (m *ScheduledOperation) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
 	}
-	if m.CreationTimestamp != 0 {
-		n += 1 + sovInternal(uint64(m.CreationTimestamp))
+	return ""
+}
+
+func (m *ScheduledOperation) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *ScheduledOperation) GetState() ScheduledOperationState {
+	if m != nil {
+		return m.State
 	}
-	return n
+	return ScheduledOperationState_SCHEDULED_OPERATION_PENDING
 }
 
-func (m *Partition) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScheduledOperation) GetExecutedIndex() uint64 {
+	if m != nil {
+		return m.ExecutedIndex
 	}
-	var l int
-	_ = l
-	l = len(m.Subject)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	l = len(m.Stream)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovInternal(uint64(m.Id))
-	}
-	l = len(m.Group)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.ReplicationFactor != 0 {
-		n += 1 + sovInternal(uint64(m.ReplicationFactor))
-	}
-	if len(m.Replicas) > 0 {
-		for _, s := range m.Replicas {
-			l = len(s)
-			n += 1 + l + sovInternal(uint64(l))
-		}
-	}
-	l = len(m.Leader)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if len(m.Isr) > 0 {
-		for _, s := range m.Isr {
-			l = len(s)
-			n += 1 + l + sovInternal(uint64(l))
-		}
-	}
-	if m.LeaderEpoch != 0 {
-		n += 1 + sovInternal(uint64(m.LeaderEpoch))
-	}
-	if m.Epoch != 0 {
-		n += 1 + sovInternal(uint64(m.Epoch))
-	}
-	if m.Paused {
-		n += 2
-	}
-	if m.Readonly {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return 0
 }
 
-func (m *Consumer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if len(m.Streams) > 0 {
-		for _, s := range m.Streams {
-			l = len(s)
-			n += 1 + l + sovInternal(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ScheduledOperation) GetExecutedAt() int64 {
+	if m != nil {
+		return m.ExecutedAt
 	}
-	return n
+	return 0
 }
 
-func (m *ConsumerGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
-	}
-	l = len(m.Coordinator)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.Epoch != 0 {
-		n += 1 + sovInternal(uint64(m.Epoch))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ScheduledOperation) GetExecutedBy() string {
+	if m != nil {
+		return m.ExecutedBy
 	}
-	return n
+	return ""
 }
 
-func (m *RaftJoinRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NodeID)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	l = len(m.NodeAddr)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ScheduledOperation) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return n
+	return ""
 }
 
-func (m *RaftJoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func init() {
+	proto.RegisterEnum("protocol.Op", Op_name, Op_value)
+	proto.RegisterEnum("protocol.SnapshotFileType", SnapshotFileType_name, SnapshotFileType_value)
+	proto.RegisterEnum("protocol.PartitionState", PartitionState_name, PartitionState_value)
+	proto.RegisterEnum("protocol.ScheduledOperationType", ScheduledOperationType_name, ScheduledOperationType_value)
+	proto.RegisterEnum("protocol.ScheduledOperationState", ScheduledOperationState_name, ScheduledOperationState_value)
+	proto.RegisterType((*ServerState)(nil), "protocol.ServerState")
+	proto.RegisterType((*RaftLog)(nil), "protocol.RaftLog")
+	proto.RegisterType((*CreateStreamOp)(nil), "protocol.CreateStreamOp")
+	proto.RegisterType((*SetServerRemovedOp)(nil), "protocol.SetServerRemovedOp")
+	proto.RegisterType((*ScheduleOperationOp)(nil), "protocol.ScheduleOperationOp")
+	proto.RegisterType((*CancelScheduledOperationOp)(nil), "protocol.CancelScheduledOperationOp")
+	proto.RegisterType((*ExecuteScheduledOperationOp)(nil), "protocol.ExecuteScheduledOperationOp")
+	proto.RegisterType((*UpdateStreamConfigOp)(nil), "protocol.UpdateStreamConfigOp")
+	proto.RegisterType((*DeletePartitionDataOp)(nil), "protocol.DeletePartitionDataOp")
+	proto.RegisterType((*DeletePartitionOp)(nil), "protocol.DeletePartitionOp")
+	proto.RegisterType((*SetStreamTagsOp)(nil), "protocol.SetStreamTagsOp")
+	proto.RegisterType((*SetStreamACLOp)(nil), "protocol.SetStreamACLOp")
+	proto.RegisterType((*ReplaceReplicaOp)(nil), "protocol.ReplaceReplicaOp")
+	proto.RegisterType((*ProducerQuota)(nil), "protocol.ProducerQuota")
+	proto.RegisterType((*SetProducerQuotaOp)(nil), "protocol.SetProducerQuotaOp")
+	proto.RegisterType((*DeleteProducerQuotaOp)(nil), "protocol.DeleteProducerQuotaOp")
+	proto.RegisterType((*ShrinkISROp)(nil), "protocol.ShrinkISROp")
+	proto.RegisterType((*ExpandISROp)(nil), "protocol.ExpandISROp")
+	proto.RegisterType((*DeleteStreamOp)(nil), "protocol.DeleteStreamOp")
+	proto.RegisterType((*PauseStreamOp)(nil), "protocol.PauseStreamOp")
+	proto.RegisterType((*ResumeStreamOp)(nil), "protocol.ResumeStreamOp")
+	proto.RegisterType((*ReportLeaderOp)(nil), "protocol.ReportLeaderOp")
+	proto.RegisterType((*ChangeLeaderOp)(nil), "protocol.ChangeLeaderOp")
+	proto.RegisterType((*ReportConsumerGroupCoordinatorOp)(nil), "protocol.ReportConsumerGroupCoordinatorOp")
+	proto.RegisterType((*ChangeConsumerGroupCoordinatorOp)(nil), "protocol.ChangeConsumerGroupCoordinatorOp")
+	proto.RegisterType((*UpdateStreamOwnerOp)(nil), "protocol.UpdateStreamOwnerOp")
+	proto.RegisterType((*ReportPartitionSkewOp)(nil), "protocol.ReportPartitionSkewOp")
+	proto.RegisterType((*RegisterProducerOp)(nil), "protocol.RegisterProducerOp")
+	proto.RegisterType((*ReleaseProducerOp)(nil), "protocol.ReleaseProducerOp")
+	proto.RegisterType((*PublishActivityOp)(nil), "protocol.PublishActivityOp")
+	proto.RegisterType((*SetStreamReadonlyOp)(nil), "protocol.SetStreamReadonlyOp")
+	proto.RegisterType((*CreateConsumerGroupOp)(nil), "protocol.CreateConsumerGroupOp")
+	proto.RegisterType((*JoinConsumerGroupOp)(nil), "protocol.JoinConsumerGroupOp")
+	proto.RegisterType((*LeaveConsumerGroupOp)(nil), "protocol.LeaveConsumerGroupOp")
+	proto.RegisterType((*NullableInt64)(nil), "protocol.NullableInt64")
+	proto.RegisterType((*NullableInt32)(nil), "protocol.NullableInt32")
+	proto.RegisterType((*NullableBool)(nil), "protocol.NullableBool")
+	proto.RegisterType((*StreamConfig)(nil), "protocol.StreamConfig")
+	proto.RegisterType((*RestoreSource)(nil), "protocol.RestoreSource")
+	proto.RegisterType((*MirrorSource)(nil), "protocol.MirrorSource")
+	proto.RegisterType((*StreamOrigin)(nil), "protocol.StreamOrigin")
+	proto.RegisterType((*Stream)(nil), "protocol.Stream")
+	proto.RegisterType((*StreamTag)(nil), "protocol.StreamTag")
+	proto.RegisterType((*StreamTags)(nil), "protocol.StreamTags")
+	proto.RegisterType((*StreamACL)(nil), "protocol.StreamACL")
+	proto.RegisterType((*UnconfirmedReplicas)(nil), "protocol.UnconfirmedReplicas")
+	proto.RegisterType((*CompanionStream)(nil), "protocol.CompanionStream")
+	proto.RegisterType((*ExclusiveProducer)(nil), "protocol.ExclusiveProducer")
+	proto.RegisterType((*Partition)(nil), "protocol.Partition")
+	proto.RegisterType((*Consumer)(nil), "protocol.Consumer")
+	proto.RegisterType((*ConsumerGroup)(nil), "protocol.ConsumerGroup")
+	proto.RegisterType((*RaftJoinRequest)(nil), "protocol.RaftJoinRequest")
+	proto.RegisterType((*RaftJoinResponse)(nil), "protocol.RaftJoinResponse")
+	proto.RegisterType((*MetadataSnapshot)(nil), "protocol.MetadataSnapshot")
+	proto.RegisterType((*ReplicationRequest)(nil), "protocol.ReplicationRequest")
+	proto.RegisterType((*ReplicationOffsetOutOfRange)(nil), "protocol.ReplicationOffsetOutOfRange")
+	proto.RegisterType((*ReplicationSnapshotOffer)(nil), "protocol.ReplicationSnapshotOffer")
+	proto.RegisterType((*SnapshotSegment)(nil), "protocol.SnapshotSegment")
+	proto.RegisterType((*EpochOffset)(nil), "protocol.EpochOffset")
+	proto.RegisterType((*SnapshotChunkRequest)(nil), "protocol.SnapshotChunkRequest")
+	proto.RegisterType((*SnapshotChunkResponse)(nil), "protocol.SnapshotChunkResponse")
+	proto.RegisterType((*LeaderEpochOffsetRequest)(nil), "protocol.LeaderEpochOffsetRequest")
+	proto.RegisterType((*LeaderEpochOffsetResponse)(nil), "protocol.LeaderEpochOffsetResponse")
+	proto.RegisterType((*PropagatedRequest)(nil), "protocol.PropagatedRequest")
+	proto.RegisterType((*Error)(nil), "protocol.Error")
+	proto.RegisterType((*PropagatedResponse)(nil), "protocol.PropagatedResponse")
+	proto.RegisterType((*PropagatedResponse_JoinConsumerGroupResponse)(nil), "protocol.PropagatedResponse.JoinConsumerGroupResponse")
+	proto.RegisterType((*PropagatedResponse_RegisterProducerResponse)(nil), "protocol.PropagatedResponse.RegisterProducerResponse")
+	proto.RegisterType((*PropagatedResponse_ScheduleOperationResponse)(nil), "protocol.PropagatedResponse.ScheduleOperationResponse")
+	proto.RegisterType((*ServerInfoRequest)(nil), "protocol.ServerInfoRequest")
+	proto.RegisterType((*BrokerRTT)(nil), "protocol.BrokerRTT")
+	proto.RegisterType((*ServerInfoResponse)(nil), "protocol.ServerInfoResponse")
+	proto.RegisterType((*AdvertisedListener)(nil), "protocol.AdvertisedListener")
+	proto.RegisterType((*PartitionStatusRequest)(nil), "protocol.PartitionStatusRequest")
+	proto.RegisterType((*PartitionStatusResponse)(nil), "protocol.PartitionStatusResponse")
+	proto.RegisterType((*PartitionReplicaStatus)(nil), "protocol.PartitionReplicaStatus")
+	proto.RegisterType((*PartitionCleanRequest)(nil), "protocol.PartitionCleanRequest")
+	proto.RegisterType((*PartitionCleanResponse)(nil), "protocol.PartitionCleanResponse")
+	proto.RegisterType((*PartitionCleanResult)(nil), "protocol.PartitionCleanResult")
+	proto.RegisterType((*PartitionNotification)(nil), "protocol.PartitionNotification")
+	proto.RegisterType((*PartitionStarted)(nil), "protocol.PartitionStarted")
+	proto.RegisterType((*KeyCount)(nil), "protocol.KeyCount")
+	proto.RegisterType((*ReadAmplification)(nil), "protocol.ReadAmplification")
+	proto.RegisterType((*PartitionLoad)(nil), "protocol.PartitionLoad")
+	proto.RegisterType((*PartitionLoadReport)(nil), "protocol.PartitionLoadReport")
+	proto.RegisterType((*BootstrapProbe)(nil), "protocol.BootstrapProbe")
+	proto.RegisterType((*StreamSkew)(nil), "protocol.StreamSkew")
+	proto.RegisterType((*Cursor)(nil), "protocol.Cursor")
+	proto.RegisterType((*CursorRequest)(nil), "protocol.CursorRequest")
+	proto.RegisterType((*CursorResponse)(nil), "protocol.CursorResponse")
+	proto.RegisterType((*ScheduledOperation)(nil), "protocol.ScheduledOperation")
 }
 
-func (m *MetadataSnapshot) Size() (n int) {
-	if m == nil {
-		return 0
+func init() { proto.RegisterFile("server/protocol/internal.proto", fileDescriptor_7d9410777bf851c3) }
+
+var fileDescriptor_7d9410777bf851c3 = []byte{
+	// 5148 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4b, 0x6c, 0xdc, 0x48,
+	0x76, 0xee, 0x9f, 0xa4, 0x7e, 0x92, 0x5a, 0x54, 0xe9, 0x63, 0x5a, 0xfe, 0xac, 0x96, 0x98, 0x99,
+	0xf5, 0x1a, 0xb3, 0xde, 0x85, 0x3d, 0xeb, 0xc9, 0x6e, 0xbe, 0xed, 0x6e, 0xda, 0xee, 0x75, 0xab,
+	0xa9, 0xa9, 0x6e, 0xd9, 0xb3, 0x41, 0x66, 0x04, 0xba, 0x59, 0x92, 0x38, 0xee, 0x26, 0x39, 0x24,
+	0xdb, 0x9f, 0x3d, 0x25, 0x41, 0x3e, 0x98, 0x00, 0x39, 0x2c, 0x92, 0xc3, 0x22, 0x97, 0x20, 0x97,
+	0xe4, 0x1e, 0xe4, 0x9a, 0x9c, 0x83, 0x1c, 0x92, 0x5c, 0x03, 0x24, 0x40, 0x30, 0x09, 0x72, 0xcc,
+	0x29, 0xc7, 0x1c, 0x82, 0xfa, 0x90, 0x2c, 0x16, 0xd9, 0x2d, 0xaf, 0xec, 0x00, 0x01, 0x72, 0x12,
+	0xeb, 0xd5, 0x7b, 0xaf, 0xaa, 0x5e, 0xbd, 0xf7, 0xaa, 0xde, 0xab, 0xd7, 0x82, 0x1b, 0x11, 0x09,
+	0x5f, 0x90, 0xf0, 0xbb, 0x41, 0xe8, 0xc7, 0xfe, 0xd8, 0x9f, 0x7c, 0xd7, 0xf5, 0x62, 0x12, 0x7a,
+	0xf6, 0xe4, 0x36, 0x83, 0xa0, 0x95, 0xa4, 0xc3, 0xf8, 0x36, 0xac, 0x0e, 0x19, 0xee, 0x30, 0xb6,
+	0x63, 0x82, 0xf6, 0x60, 0x85, 0x93, 0xf6, 0xba, 0x7a, 0x65, 0xbf, 0x72, 0xb3, 0x89, 0xd3, 0xb6,
+	0xf1, 0x15, 0x82, 0x65, 0x6c, 0x9f, 0xc4, 0x7d, 0xff, 0x14, 0x5d, 0x83, 0xaa, 0x1f, 0x30, 0x8c,
+	0xd6, 0x9d, 0xb5, 0xdb, 0x09, 0xb7, 0xdb, 0x56, 0x80, 0xab, 0x7e, 0x80, 0x7e, 0x0d, 0x5a, 0xe3,
+	0x90, 0xd8, 0x31, 0x19, 0xc6, 0x21, 0xb1, 0xa7, 0x56, 0xa0, 0x57, 0xf7, 0x2b, 0x37, 0x57, 0xef,
+	0xe8, 0x19, 0x66, 0x27, 0xd7, 0x8f, 0x15, 0x7c, 0xf4, 0x31, 0xac, 0x46, 0x67, 0xa1, 0xeb, 0x3d,
+	0xef, 0x0d, 0xb1, 0x15, 0xe8, 0x35, 0x46, 0xbe, 0x93, 0x91, 0x0f, 0xb3, 0x4e, 0x2c, 0x63, 0xb2,
+	0xa1, 0xcf, 0x6c, 0xef, 0x94, 0xf4, 0x89, 0xed, 0x90, 0xd0, 0x0a, 0xf4, 0x7a, 0x61, 0xe8, 0x5c,
+	0x3f, 0x56, 0xf0, 0xe9, 0xd0, 0xe4, 0x55, 0x60, 0x7b, 0x0e, 0x1f, 0xba, 0xa1, 0x0e, 0x6d, 0x66,
+	0x9d, 0x58, 0xc6, 0xa4, 0x43, 0x3b, 0x64, 0x42, 0xa4, 0x55, 0x2f, 0xa9, 0x43, 0x77, 0x73, 0xfd,
+	0x58, 0xc1, 0x47, 0xbf, 0x0c, 0xeb, 0x81, 0x3d, 0x8b, 0x32, 0x06, 0xcb, 0x8c, 0xc1, 0xe5, 0x8c,
+	0xc1, 0xa1, 0xdc, 0x8d, 0xf3, 0xd8, 0x74, 0x02, 0x21, 0x89, 0x66, 0xd3, 0x8c, 0x7e, 0x45, 0x9d,
+	0x00, 0xce, 0xf5, 0x63, 0x05, 0x1f, 0xf5, 0x60, 0x33, 0x98, 0x3d, 0x9b, 0xb8, 0xd1, 0x59, 0x7b,
+	0x1c, 0xbb, 0x2f, 0xdc, 0xf8, 0xb5, 0x15, 0xe8, 0x4d, 0xc6, 0xe4, 0xaa, 0x34, 0x09, 0x15, 0x05,
+	0x17, 0xa9, 0x90, 0x05, 0x5b, 0x11, 0x89, 0x39, 0x67, 0x4c, 0x6c, 0xc7, 0xf7, 0x26, 0x94, 0x19,
+	0x30, 0x66, 0xd7, 0xa5, 0x9d, 0x2c, 0x22, 0xe1, 0x32, 0x4a, 0x74, 0x04, 0x3b, 0x5c, 0x49, 0x3a,
+	0xbe, 0x47, 0x27, 0x1d, 0x3e, 0x0c, 0xfd, 0x59, 0x60, 0x05, 0xfa, 0x2a, 0x63, 0xf9, 0x0d, 0x55,
+	0xb7, 0x14, 0x34, 0x5c, 0x4e, 0x4d, 0xe7, 0xf9, 0x85, 0xef, 0x7a, 0x2a, 0xd3, 0x35, 0x75, 0x9e,
+	0x3f, 0x2a, 0x22, 0xe1, 0x32, 0x4a, 0x84, 0x61, 0x7b, 0x42, 0xec, 0x17, 0x85, 0x69, 0xae, 0x33,
+	0x8e, 0x37, 0x32, 0x8e, 0xfd, 0x12, 0x2c, 0x5c, 0x4a, 0x8b, 0x5e, 0xc0, 0x3e, 0xd7, 0xd2, 0x5c,
+	0x47, 0xc7, 0xf7, 0x43, 0xc7, 0xf5, 0xec, 0xd8, 0xa7, 0x7a, 0xde, 0x62, 0xfc, 0x6f, 0xa9, 0x7a,
+	0x3e, 0x9f, 0x02, 0x9f, 0xcb, 0x93, 0x0a, 0x67, 0x16, 0x38, 0x99, 0x61, 0xbe, 0xf4, 0x98, 0x49,
+	0x6d, 0xa8, 0xc2, 0x39, 0x2a, 0x22, 0xe1, 0x32, 0x4a, 0xba, 0x89, 0x21, 0x09, 0xfc, 0x30, 0x3e,
+	0xb4, 0xc3, 0xd8, 0x8d, 0x5d, 0xdf, 0x1b, 0x3e, 0x27, 0x2f, 0xad, 0x40, 0xd7, 0xd4, 0x4d, 0xc4,
+	0x65, 0x68, 0xb8, 0x9c, 0x1a, 0xf5, 0x01, 0x85, 0xe4, 0xd4, 0x8d, 0x62, 0x12, 0x1e, 0x86, 0xbe,
+	0x33, 0x1b, 0xb3, 0x69, 0x6e, 0x32, 0x9e, 0xd7, 0x64, 0x9e, 0x2a, 0x0e, 0x2e, 0xa1, 0xa3, 0x56,
+	0x10, 0x92, 0x09, 0xb1, 0x23, 0x22, 0x31, 0x43, 0xaa, 0x15, 0x60, 0x15, 0x05, 0x17, 0xa9, 0xe8,
+	0xc4, 0xa8, 0x2e, 0x33, 0x17, 0x8a, 0xc9, 0xd4, 0x7f, 0x41, 0x1c, 0x2b, 0xd0, 0xb7, 0xd4, 0x89,
+	0x0d, 0x0b, 0x38, 0xb8, 0x84, 0x8e, 0xd9, 0xd4, 0xf8, 0x8c, 0x38, 0xb3, 0x09, 0xb1, 0x02, 0x12,
+	0xda, 0x54, 0x02, 0x56, 0xa0, 0x6f, 0x17, 0x6c, 0xaa, 0x88, 0x84, 0xcb, 0x28, 0x91, 0x03, 0x7b,
+	0x63, 0xdb, 0x1b, 0x93, 0x49, 0x42, 0xe1, 0xc8, 0x7c, 0x77, 0x18, 0xdf, 0xf7, 0x24, 0x8d, 0x9a,
+	0x8b, 0x8b, 0x17, 0xf0, 0x41, 0xa7, 0x70, 0x95, 0xbc, 0x22, 0xe3, 0x59, 0x4c, 0x4a, 0x87, 0xd9,
+	0x65, 0xc3, 0xbc, 0x2f, 0x7b, 0xd8, 0xb9, 0xc8, 0x78, 0x11, 0x27, 0x6a, 0x7a, 0xb2, 0xd2, 0x75,
+	0x7c, 0xef, 0xc4, 0x3d, 0xb5, 0x02, 0xfd, 0xb2, 0x6a, 0x7a, 0x47, 0x25, 0x58, 0xb8, 0x94, 0x16,
+	0x75, 0x60, 0x23, 0xf5, 0x46, 0x23, 0xfb, 0x34, 0xb2, 0x02, 0x5d, 0x67, 0xec, 0xae, 0x94, 0xf8,
+	0x30, 0x8e, 0x80, 0x55, 0x0a, 0xaa, 0xf6, 0xdc, 0xd5, 0xa7, 0x8a, 0xdb, 0xb5, 0x63, 0xdb, 0x0a,
+	0xf4, 0x2b, 0xaa, 0xda, 0x77, 0xcb, 0xd0, 0x70, 0x39, 0x35, 0x75, 0xf8, 0xe9, 0x48, 0xed, 0x4e,
+	0xdf, 0x0a, 0xf4, 0x3d, 0xd5, 0xe1, 0x0f, 0x73, 0xfd, 0x58, 0xc1, 0x47, 0x0f, 0x40, 0x0b, 0x49,
+	0x30, 0xb1, 0xc7, 0x04, 0x93, 0x60, 0xe2, 0x8e, 0xe9, 0x9c, 0xae, 0x32, 0x1e, 0x7b, 0x39, 0x53,
+	0xcc, 0x61, 0xe0, 0x02, 0x8d, 0xd0, 0xf3, 0x44, 0xf1, 0x3f, 0x99, 0xf9, 0x6c, 0x75, 0xd7, 0x4a,
+	0xf4, 0x5c, 0xc1, 0xc1, 0x25, 0x74, 0x92, 0xb8, 0x14, 0x86, 0xd7, 0xe7, 0x88, 0x4b, 0xe1, 0x59,
+	0x4e, 0x4d, 0xed, 0x5a, 0x91, 0xa3, 0x15, 0xe8, 0x37, 0x54, 0xbb, 0xee, 0xaa, 0x28, 0xb8, 0x48,
+	0x65, 0xfc, 0x41, 0x05, 0x5a, 0xf9, 0x2b, 0x0c, 0xba, 0x09, 0x4b, 0x11, 0xfb, 0x66, 0xd7, 0xa2,
+	0xd5, 0x3b, 0x9a, 0xb4, 0x6c, 0x06, 0xc7, 0xa2, 0x1f, 0x7d, 0x0f, 0x60, 0xec, 0x4f, 0x03, 0xdb,
+	0x73, 0x7d, 0x2f, 0xd2, 0xab, 0xfb, 0xb5, 0x52, 0x6c, 0x09, 0x07, 0x5d, 0x83, 0x66, 0x48, 0xbe,
+	0x9c, 0x91, 0x28, 0xee, 0x75, 0xd9, 0x65, 0xa8, 0x89, 0x33, 0x80, 0xf1, 0x00, 0x50, 0xd1, 0x81,
+	0xa0, 0x5d, 0x58, 0xe2, 0x57, 0x37, 0x71, 0x91, 0x13, 0x2d, 0xa4, 0xc3, 0x72, 0xc8, 0x91, 0xd8,
+	0xad, 0x6c, 0x05, 0x27, 0x4d, 0xe3, 0x13, 0xd8, 0x2a, 0xf1, 0x1c, 0xe8, 0x87, 0xd0, 0xf4, 0x93,
+	0xa6, 0x5e, 0x29, 0x6c, 0x69, 0xc1, 0x10, 0x71, 0x86, 0x6e, 0x7c, 0x08, 0x7b, 0xf3, 0x9d, 0x06,
+	0x6a, 0x41, 0xd5, 0x75, 0x18, 0xcb, 0x3a, 0xae, 0xba, 0x8e, 0x31, 0x85, 0xab, 0x0b, 0x6c, 0x5f,
+	0x45, 0x47, 0x37, 0x00, 0x84, 0x37, 0x70, 0xda, 0x31, 0x5b, 0x4c, 0x0d, 0x4b, 0x10, 0xb9, 0xff,
+	0xfe, 0x6b, 0x21, 0x36, 0x09, 0x62, 0x7c, 0x0e, 0xdb, 0x65, 0x8e, 0x80, 0x49, 0x2e, 0xdb, 0xc9,
+	0x66, 0xba, 0x6f, 0xb7, 0x61, 0x69, 0xcc, 0x70, 0xc4, 0x75, 0x76, 0x57, 0xdd, 0x33, 0xce, 0x01,
+	0x0b, 0x2c, 0x83, 0xc0, 0x4e, 0xa9, 0x39, 0xcf, 0x1d, 0xe0, 0x1a, 0x34, 0x83, 0x04, 0x95, 0x8d,
+	0xd1, 0xc0, 0x19, 0x80, 0x52, 0xf9, 0x27, 0x27, 0x11, 0x89, 0xd9, 0x52, 0x6a, 0x58, 0xb4, 0x8c,
+	0x1e, 0x6c, 0x16, 0x74, 0xf6, 0x62, 0x43, 0x18, 0x18, 0x36, 0x14, 0x5f, 0x36, 0x97, 0xd1, 0xb7,
+	0xa0, 0x1e, 0xdb, 0xa7, 0x89, 0xfa, 0x6e, 0xa9, 0xa2, 0x18, 0xd9, 0xa7, 0x98, 0x21, 0x18, 0x16,
+	0xb4, 0xf2, 0x4e, 0x68, 0x2e, 0xcb, 0xf7, 0xa1, 0x66, 0x8f, 0x27, 0x42, 0xb8, 0x05, 0x8e, 0xed,
+	0x4e, 0x1f, 0xd3, 0x7e, 0xe3, 0x77, 0x2a, 0xa0, 0xa9, 0x2e, 0xe9, 0x82, 0x22, 0x65, 0xb6, 0xc0,
+	0x58, 0x08, 0xf5, 0x48, 0x9a, 0x68, 0x1f, 0x56, 0x85, 0x93, 0x9b, 0x12, 0x2f, 0x66, 0x41, 0x44,
+	0x13, 0xcb, 0x20, 0xe3, 0x29, 0xac, 0xe7, 0x1c, 0x0c, 0x55, 0xb7, 0x40, 0x00, 0xd2, 0xe8, 0x49,
+	0x82, 0xa0, 0x0f, 0xa0, 0xf5, 0xec, 0x75, 0x4c, 0xa2, 0x43, 0x12, 0x0e, 0xc9, 0xd8, 0xf7, 0x1c,
+	0xa1, 0xb2, 0x0a, 0xd4, 0xe8, 0x30, 0x73, 0x56, 0x9d, 0xd7, 0x77, 0xa0, 0xf1, 0x25, 0xfd, 0xd4,
+	0x2b, 0x85, 0x98, 0x40, 0xc6, 0xc4, 0x1c, 0xcb, 0xf8, 0x38, 0xd5, 0x3d, 0x85, 0xcf, 0x39, 0xb3,
+	0x34, 0xfe, 0xa2, 0x02, 0xab, 0x52, 0x74, 0x75, 0x41, 0xc1, 0xde, 0x84, 0x0d, 0x21, 0xc9, 0x91,
+	0xcf, 0x5d, 0x92, 0x10, 0xb0, 0x0a, 0xa6, 0xfc, 0x27, 0x2c, 0xf4, 0x12, 0x32, 0x16, 0x2d, 0xba,
+	0x01, 0xfc, 0xcb, 0x0c, 0xfc, 0xf1, 0x19, 0x0b, 0xc3, 0xea, 0x58, 0x06, 0x19, 0x7f, 0x56, 0x81,
+	0x55, 0x29, 0x18, 0xbb, 0xe0, 0x4c, 0x0d, 0x58, 0x4b, 0xa7, 0xd4, 0x76, 0x1c, 0x31, 0xcd, 0x1c,
+	0xec, 0x2d, 0xe6, 0x78, 0x02, 0xad, 0x7c, 0xcc, 0x37, 0x77, 0x96, 0x3a, 0x2c, 0x8f, 0xed, 0x68,
+	0x6c, 0x3b, 0x24, 0x71, 0xcb, 0xa2, 0x49, 0x67, 0x18, 0xc7, 0x13, 0xce, 0x86, 0x3a, 0x3a, 0x6e,
+	0xfd, 0x39, 0x98, 0xf1, 0xd3, 0x0a, 0xac, 0xe7, 0x62, 0xc3, 0xb9, 0xe3, 0xd0, 0xfd, 0x4f, 0x16,
+	0xcf, 0xad, 0xb7, 0x81, 0x25, 0x08, 0x3f, 0x6a, 0x68, 0x3c, 0xd0, 0x9e, 0x4c, 0xd8, 0x50, 0x2b,
+	0x38, 0x03, 0xa0, 0x5b, 0xa0, 0x39, 0xa1, 0xed, 0x7a, 0xf7, 0xc9, 0x89, 0x1f, 0x12, 0x36, 0x22,
+	0x93, 0xc9, 0x0a, 0x2e, 0xc0, 0x8d, 0x47, 0xd0, 0xca, 0x87, 0x9b, 0x17, 0x9d, 0x93, 0xf1, 0x27,
+	0x15, 0xca, 0x2a, 0xf0, 0xc3, 0x38, 0x8d, 0xd2, 0xdf, 0xb5, 0xbd, 0x5f, 0x7c, 0x8b, 0x3f, 0x87,
+	0x56, 0x3e, 0xa3, 0x70, 0x71, 0xf7, 0x2e, 0x66, 0x50, 0x93, 0x67, 0x60, 0xfc, 0x71, 0x05, 0xf6,
+	0xf9, 0xe2, 0x17, 0x04, 0x6a, 0x3a, 0x2c, 0x9f, 0x52, 0x68, 0xcf, 0x11, 0x63, 0x26, 0x4d, 0x2a,
+	0xdb, 0xb1, 0xa0, 0xeb, 0x71, 0x8f, 0xd3, 0xc4, 0x12, 0x84, 0x2e, 0x70, 0x9c, 0xb1, 0x12, 0x63,
+	0xcb, 0x20, 0xb4, 0x0d, 0x0d, 0xc2, 0x16, 0x5f, 0x67, 0x8b, 0xe7, 0x0d, 0xe3, 0x73, 0xd8, 0x3f,
+	0x2f, 0xc0, 0x5c, 0x30, 0x2b, 0x65, 0xd4, 0x6a, 0x61, 0x54, 0xa3, 0x03, 0x5b, 0x25, 0x51, 0xe5,
+	0x5c, 0xd9, 0x6e, 0x43, 0xc3, 0xa7, 0x28, 0x82, 0x15, 0x6f, 0x18, 0x6d, 0xd8, 0x29, 0x8d, 0x23,
+	0xd1, 0x4d, 0xa8, 0x47, 0xcf, 0xc9, 0x4b, 0xe1, 0x4c, 0xb7, 0xd5, 0xb3, 0x86, 0x62, 0x61, 0x86,
+	0x61, 0xbc, 0x02, 0x54, 0x0c, 0x1b, 0xe7, 0x4e, 0x63, 0x0f, 0x56, 0x12, 0x5f, 0x2a, 0x66, 0x92,
+	0xb6, 0x91, 0x06, 0xb5, 0x38, 0x9e, 0x08, 0xf3, 0xa5, 0x9f, 0x54, 0x21, 0xc8, 0xab, 0xc0, 0x0d,
+	0x49, 0xd4, 0xe6, 0x47, 0x4c, 0x0d, 0x67, 0x00, 0xe3, 0x33, 0xd8, 0x2c, 0xc4, 0x98, 0x17, 0x1a,
+	0x38, 0xdd, 0xc0, 0x9a, 0xbc, 0x81, 0x4f, 0x61, 0xb3, 0x90, 0xc8, 0x61, 0xd6, 0x6f, 0x9f, 0xc4,
+	0x3d, 0xcf, 0x21, 0xaf, 0xc4, 0x4d, 0x2b, 0x03, 0xa0, 0xf7, 0x60, 0xdd, 0x16, 0xb8, 0xdc, 0x1c,
+	0xaa, 0x0c, 0x23, 0x0f, 0x34, 0xfe, 0xbc, 0x02, 0x5b, 0x25, 0x59, 0x9d, 0x0b, 0x7b, 0xa4, 0x3d,
+	0x58, 0x09, 0x05, 0x17, 0xe1, 0x90, 0xd2, 0x36, 0xfa, 0x45, 0x58, 0x8b, 0xed, 0xf0, 0x94, 0xc4,
+	0x16, 0xbf, 0x19, 0xd5, 0xd5, 0xc3, 0x71, 0x30, 0x9b, 0x4c, 0xec, 0x67, 0x13, 0xd2, 0xf3, 0xe2,
+	0x7b, 0x1f, 0xe1, 0x1c, 0xb2, 0xf1, 0x04, 0x76, 0x4a, 0x53, 0x45, 0x34, 0x0f, 0x37, 0x96, 0x41,
+	0xc5, 0x33, 0x37, 0x47, 0x81, 0xf3, 0xd8, 0x86, 0x0b, 0x5b, 0x25, 0xd9, 0xa2, 0xb7, 0xb0, 0x51,
+	0x1d, 0x96, 0xb9, 0xac, 0x22, 0xbd, 0xb6, 0x5f, 0xa3, 0x94, 0xa2, 0x69, 0x7c, 0x01, 0xdb, 0x65,
+	0x69, 0xa4, 0xb7, 0x1b, 0x8b, 0xab, 0xa0, 0x23, 0x84, 0x9d, 0x34, 0x8d, 0xf7, 0x61, 0x3d, 0x27,
+	0x4d, 0xaa, 0x57, 0x2f, 0xec, 0xc9, 0x8c, 0xb0, 0x21, 0x6a, 0x98, 0x37, 0x14, 0xb4, 0xbb, 0x77,
+	0xf2, 0x68, 0x8d, 0x04, 0xed, 0x3d, 0x58, 0x4b, 0xd0, 0xee, 0xfb, 0xfe, 0x24, 0x8f, 0xb5, 0x92,
+	0x60, 0xfd, 0xc3, 0x2a, 0xac, 0xc9, 0x77, 0x6b, 0x64, 0xd2, 0xdc, 0x4c, 0x4c, 0x3c, 0xaa, 0x1a,
+	0x07, 0xf6, 0xab, 0xfb, 0xf4, 0xea, 0x54, 0xdc, 0x9e, 0xfc, 0xae, 0x17, 0x29, 0xd0, 0x63, 0xd8,
+	0x96, 0x81, 0x07, 0x24, 0x8a, 0xec, 0x53, 0x12, 0xe9, 0xd5, 0xc5, 0x9c, 0x4a, 0x89, 0x50, 0x1b,
+	0x36, 0x64, 0x78, 0xfb, 0x94, 0xe8, 0xb5, 0xc5, 0x7c, 0x54, 0x7c, 0xca, 0x62, 0x3c, 0x21, 0xb6,
+	0x47, 0xc2, 0x9e, 0x17, 0x93, 0xf0, 0x85, 0x3d, 0x39, 0x4f, 0x95, 0x55, 0x7c, 0xca, 0x22, 0x22,
+	0xa7, 0xf4, 0x6a, 0x9a, 0xca, 0xa5, 0x71, 0x0e, 0x0b, 0x05, 0x9f, 0xea, 0x7d, 0x06, 0xa2, 0xcb,
+	0x58, 0x5a, 0xcc, 0x20, 0x8f, 0x4d, 0x85, 0xca, 0x62, 0xd6, 0x31, 0x05, 0x3c, 0xf4, 0x43, 0x7f,
+	0x16, 0xbb, 0x1e, 0x89, 0xf4, 0xe5, 0x05, 0x5c, 0xee, 0xde, 0xc1, 0xa5, 0x44, 0xe8, 0x57, 0xa0,
+	0x25, 0xe0, 0xa6, 0x47, 0x71, 0x1d, 0x7d, 0x45, 0x0d, 0xba, 0x64, 0xfd, 0xc1, 0x0a, 0x36, 0x5d,
+	0x8b, 0x3d, 0x8b, 0x7d, 0x76, 0x15, 0x19, 0xb9, 0x53, 0xa2, 0x37, 0x17, 0xcc, 0x82, 0xae, 0x25,
+	0x87, 0x8d, 0x7e, 0x03, 0xae, 0xa7, 0x80, 0xae, 0x1b, 0x31, 0xbc, 0x93, 0xe1, 0xec, 0x59, 0x34,
+	0x0e, 0xdd, 0x67, 0x24, 0x8c, 0x74, 0x58, 0x38, 0x9b, 0xc5, 0xc4, 0xe8, 0xbb, 0xb0, 0x34, 0x75,
+	0xbd, 0x5e, 0x14, 0xea, 0xab, 0x0b, 0x66, 0x75, 0xf7, 0x0e, 0x16, 0x68, 0xe8, 0xd7, 0xe1, 0x9a,
+	0x1f, 0xc4, 0xee, 0xd4, 0x8d, 0x62, 0x77, 0xdc, 0xf1, 0xbd, 0xf1, 0x2c, 0x0c, 0x89, 0x37, 0x7e,
+	0xdd, 0xf1, 0xbd, 0x38, 0xf4, 0x27, 0xfa, 0xda, 0xc2, 0xd9, 0x2c, 0xa4, 0x45, 0xf7, 0x00, 0x88,
+	0x37, 0x0e, 0x5f, 0x07, 0xec, 0x5e, 0xb2, 0xbe, 0x90, 0x93, 0x84, 0x89, 0xba, 0xb0, 0x29, 0xf6,
+	0xdf, 0xcc, 0xc8, 0x5b, 0x0b, 0xc9, 0x8b, 0x04, 0x34, 0x52, 0x70, 0x88, 0xed, 0xf4, 0x49, 0x1c,
+	0xd3, 0x20, 0x85, 0xcc, 0x08, 0x4b, 0x2f, 0x37, 0xb1, 0x0a, 0x46, 0x3f, 0x84, 0xb5, 0xa9, 0x1b,
+	0x86, 0x7e, 0x38, 0xf4, 0x67, 0xe1, 0x98, 0xe8, 0x9a, 0x3a, 0xd4, 0x81, 0xd4, 0x8b, 0x73, 0xb8,
+	0xe8, 0x0e, 0x6c, 0x4f, 0xb9, 0xb9, 0xd2, 0xdd, 0x8d, 0x62, 0x7b, 0x1a, 0x8c, 0x5e, 0x07, 0x84,
+	0xa5, 0x88, 0x9b, 0xb8, 0xb4, 0x0f, 0x7d, 0x06, 0xd7, 0x55, 0xf8, 0x81, 0xfd, 0xaa, 0xeb, 0x9e,
+	0x9c, 0x10, 0x2a, 0x3f, 0xa2, 0xa3, 0x05, 0x7b, 0x77, 0xef, 0x23, 0xbc, 0x98, 0x1a, 0x7d, 0x9b,
+	0x5f, 0x07, 0xb6, 0x16, 0x33, 0xa1, 0x38, 0xe8, 0x11, 0x6c, 0x51, 0x7d, 0xe2, 0xb7, 0x69, 0xcb,
+	0x13, 0xc7, 0xb6, 0xbe, 0xad, 0x0a, 0x20, 0x27, 0xeb, 0x32, 0x12, 0xea, 0x24, 0xa6, 0xa9, 0xe7,
+	0xe2, 0x4e, 0x62, 0xe7, 0x1c, 0x27, 0xa1, 0xe0, 0x53, 0xc3, 0x0a, 0x49, 0x14, 0xfb, 0x21, 0x11,
+	0xfb, 0xb0, 0xab, 0x32, 0xc0, 0x72, 0x37, 0xce, 0x63, 0x1b, 0xdf, 0x86, 0xf5, 0x5c, 0x3f, 0x3d,
+	0x70, 0x78, 0x22, 0x83, 0xfa, 0xf1, 0xda, 0xcd, 0x1a, 0x4e, 0x9a, 0xc6, 0x09, 0xac, 0xc9, 0x5b,
+	0x4a, 0x2f, 0x27, 0xb6, 0xe3, 0x84, 0x24, 0x8a, 0x08, 0xc7, 0x6d, 0xe2, 0x0c, 0x20, 0x5d, 0x2f,
+	0xaa, 0xb9, 0xeb, 0xc5, 0x3e, 0xac, 0x46, 0xb1, 0x1d, 0x26, 0x37, 0x04, 0x7e, 0xfd, 0x92, 0x41,
+	0xc6, 0xcf, 0xaa, 0xc9, 0x21, 0x63, 0x85, 0xee, 0xa9, 0xeb, 0xd1, 0x81, 0xf8, 0x63, 0x11, 0xcd,
+	0x1b, 0xf1, 0xf3, 0x33, 0x03, 0x94, 0x5f, 0x35, 0xe9, 0xf0, 0xcf, 0x42, 0xff, 0x79, 0x76, 0x7d,
+	0xe7, 0x2d, 0xaa, 0xdf, 0x76, 0xc0, 0x62, 0x0c, 0xaa, 0xee, 0x03, 0x7b, 0x4a, 0x44, 0x84, 0xa1,
+	0x82, 0xd1, 0x6d, 0x40, 0x12, 0xe8, 0x09, 0x09, 0x23, 0x6a, 0x50, 0x0d, 0x86, 0x5c, 0xd2, 0xa3,
+	0xdc, 0x9b, 0x96, 0xd8, 0xe1, 0x2a, 0x41, 0xd0, 0x87, 0xf4, 0xa8, 0x4c, 0xa9, 0x1e, 0xd8, 0xe3,
+	0xd8, 0x0f, 0x99, 0x2f, 0x6e, 0xe0, 0x62, 0x07, 0x5d, 0x15, 0xbb, 0x22, 0x30, 0x37, 0xdb, 0xc4,
+	0xbc, 0x61, 0xfc, 0x4d, 0x0d, 0x96, 0xb8, 0x68, 0x10, 0x82, 0xba, 0x47, 0x67, 0xcf, 0xe5, 0xc1,
+	0xbe, 0xd9, 0xc5, 0x64, 0xf6, 0xec, 0x0b, 0x32, 0x8e, 0x85, 0x30, 0x92, 0x26, 0xba, 0x9b, 0x9b,
+	0x5c, 0x4d, 0x4d, 0x12, 0xa5, 0xf7, 0xf1, 0xdc, 0x8c, 0xb3, 0x04, 0x5b, 0xfd, 0x4d, 0x12, 0x6c,
+	0x74, 0x85, 0x6c, 0x5b, 0x5c, 0xdf, 0x4b, 0x8d, 0x8c, 0x09, 0xac, 0x86, 0x8b, 0x1d, 0x94, 0xbb,
+	0xcf, 0xf6, 0x57, 0x5f, 0x2a, 0xe7, 0xce, 0x77, 0x1f, 0x0b, 0x2c, 0xf4, 0x03, 0x68, 0x26, 0x57,
+	0x68, 0x7a, 0x86, 0xd5, 0xf2, 0x69, 0x62, 0xf3, 0xd5, 0x78, 0x32, 0x8b, 0xdc, 0x17, 0xe9, 0xe5,
+	0x1c, 0x67, 0xd8, 0x54, 0x2e, 0x41, 0xe8, 0x4e, 0xed, 0xf0, 0xb5, 0x10, 0x67, 0xd2, 0xe4, 0xd7,
+	0xaf, 0x34, 0xf7, 0xdb, 0x64, 0x4a, 0x2c, 0x41, 0xd2, 0xb4, 0x1a, 0x9c, 0x93, 0x56, 0x4b, 0x92,
+	0x65, 0xab, 0xe7, 0x24, 0xcb, 0xee, 0x42, 0x33, 0xa5, 0xa4, 0x11, 0xc8, 0x73, 0x92, 0x68, 0x34,
+	0xfd, 0xcc, 0x6e, 0x5d, 0x42, 0x97, 0x59, 0xc3, 0x38, 0x00, 0x48, 0x89, 0xa2, 0xb7, 0xcf, 0x00,
+	0x7e, 0x96, 0xcc, 0xa1, 0xdd, 0xe9, 0xd3, 0x2c, 0x18, 0xbd, 0xbd, 0x1f, 0x86, 0xae, 0x37, 0x76,
+	0x03, 0x7b, 0x92, 0x58, 0xb2, 0x02, 0xa5, 0x76, 0xf3, 0x32, 0x74, 0x63, 0x92, 0x81, 0xd8, 0x40,
+	0x4d, 0xac, 0x82, 0x8d, 0x53, 0xd8, 0x3a, 0xf2, 0x98, 0x46, 0x84, 0x53, 0xe2, 0x88, 0x94, 0x60,
+	0x74, 0xc1, 0x28, 0x9c, 0x05, 0x1b, 0x9c, 0x83, 0xb8, 0x6b, 0xa7, 0x6d, 0xe3, 0x9f, 0x2a, 0xb0,
+	0xd1, 0x49, 0xb6, 0x4a, 0x58, 0x85, 0x01, 0x6b, 0xd4, 0x12, 0x46, 0x64, 0x1a, 0x4c, 0xec, 0x38,
+	0xb1, 0x8e, 0x1c, 0x8c, 0x2e, 0x45, 0x98, 0x45, 0x8a, 0xc6, 0xc5, 0xad, 0x82, 0x25, 0x03, 0xa8,
+	0xbd, 0x91, 0x01, 0xe4, 0x5d, 0x40, 0xbd, 0xe0, 0x02, 0x4a, 0x0e, 0xd7, 0x06, 0xbb, 0x5e, 0xab,
+	0x60, 0xe3, 0x25, 0x6c, 0x16, 0x34, 0xba, 0xd4, 0xe4, 0xd3, 0x60, 0xb2, 0x2a, 0x05, 0x93, 0xf9,
+	0x48, 0xb6, 0xa6, 0x44, 0xb2, 0x5c, 0xa8, 0x2c, 0x92, 0x75, 0x44, 0xb6, 0x28, 0x6d, 0x1b, 0x7f,
+	0x59, 0x83, 0xe6, 0xa1, 0x9c, 0xa0, 0x49, 0x1c, 0x4a, 0x25, 0xef, 0x50, 0xe6, 0xb9, 0x77, 0xfe,
+	0x28, 0x50, 0x63, 0x4b, 0xa7, 0x8f, 0x02, 0xa9, 0x1f, 0xab, 0x4b, 0x7e, 0xac, 0xdc, 0x17, 0x36,
+	0xe6, 0xf9, 0x42, 0x59, 0x09, 0x96, 0xf2, 0x4a, 0x20, 0xa5, 0x69, 0x96, 0x73, 0x89, 0x22, 0x0d,
+	0x6a, 0x6e, 0x14, 0xea, 0x2b, 0x0c, 0x9d, 0x7e, 0xaa, 0xa9, 0xa3, 0x66, 0x21, 0x75, 0x94, 0xc9,
+	0x12, 0x64, 0x59, 0xee, 0xc2, 0x12, 0xab, 0xeb, 0x70, 0x98, 0x71, 0xaf, 0x60, 0xd1, 0xca, 0xc5,
+	0xc1, 0x6b, 0x4a, 0x1c, 0xfc, 0xab, 0xd0, 0x4a, 0xbe, 0x47, 0x2c, 0xc4, 0xd5, 0xd7, 0xd5, 0x53,
+	0x39, 0x7f, 0xac, 0x2b, 0xe8, 0x54, 0x40, 0x41, 0x48, 0x4e, 0x48, 0x18, 0x66, 0x26, 0xa4, 0xb7,
+	0xd8, 0x62, 0x8a, 0x1d, 0xc6, 0x47, 0xb0, 0x92, 0x44, 0x9c, 0xd2, 0xab, 0x4c, 0x93, 0x6d, 0x80,
+	0x14, 0xac, 0x56, 0xf3, 0xc1, 0xea, 0xef, 0x56, 0x60, 0x3d, 0x17, 0xa8, 0x16, 0x68, 0x3f, 0x84,
+	0xe5, 0x29, 0x99, 0xb2, 0xfb, 0x35, 0xf7, 0x2a, 0xa8, 0x18, 0x72, 0xe3, 0x04, 0xe5, 0xc2, 0xa9,
+	0xab, 0x3f, 0xaa, 0xc0, 0x06, 0x2d, 0x64, 0xa2, 0x41, 0x3a, 0xe6, 0xaf, 0x68, 0x54, 0xe8, 0x9e,
+	0xef, 0x90, 0x34, 0x25, 0x2e, 0x5a, 0x54, 0xe8, 0xf4, 0xab, 0xed, 0x38, 0x69, 0x5e, 0x25, 0x69,
+	0x53, 0xf3, 0x38, 0xf3, 0xa3, 0x58, 0x0c, 0xcc, 0xbe, 0x29, 0x2c, 0xf0, 0xc3, 0x58, 0xd8, 0x22,
+	0xfb, 0xa6, 0x69, 0x13, 0xa1, 0xc5, 0x87, 0x21, 0x39, 0x71, 0x5f, 0x89, 0x33, 0x3d, 0x0f, 0x34,
+	0x6e, 0x82, 0x96, 0x4d, 0x2a, 0x0a, 0x7c, 0x2f, 0xe2, 0xc6, 0x16, 0x86, 0x7e, 0xf2, 0x84, 0xc7,
+	0x1b, 0xc6, 0x7f, 0x57, 0x41, 0x3b, 0x20, 0xb1, 0xed, 0xd8, 0xb1, 0x3d, 0xf4, 0xec, 0x20, 0x3a,
+	0xf3, 0x63, 0x74, 0x2b, 0x13, 0x7b, 0x65, 0xce, 0x8b, 0x62, 0x82, 0x40, 0xc3, 0x0f, 0x66, 0x16,
+	0x89, 0x94, 0xe7, 0x26, 0x36, 0x04, 0x1a, 0xd5, 0x8e, 0x24, 0xc7, 0x83, 0xd3, 0xf4, 0x10, 0xcf,
+	0x26, 0x15, 0x3b, 0x8a, 0x69, 0xa2, 0x7a, 0x49, 0x9a, 0x88, 0x1f, 0x04, 0xec, 0xe1, 0x91, 0xbf,
+	0x5c, 0xd2, 0x70, 0x55, 0x1c, 0x04, 0x32, 0x14, 0x0d, 0xb2, 0xa2, 0x87, 0xec, 0x35, 0x90, 0xdb,
+	0xe5, 0x79, 0x0f, 0x91, 0x65, 0x84, 0xd4, 0x54, 0x02, 0xf9, 0x4d, 0x24, 0x39, 0xdb, 0xe7, 0xbe,
+	0xa8, 0x28, 0xe8, 0xc6, 0x5f, 0x55, 0x00, 0x09, 0x4b, 0x60, 0xa3, 0x08, 0x0d, 0x62, 0x89, 0x73,
+	0x06, 0x4d, 0x95, 0x28, 0x03, 0x48, 0x8f, 0x77, 0x55, 0xf9, 0xf1, 0x4e, 0x75, 0x12, 0xb5, 0xa2,
+	0x93, 0xa0, 0xe7, 0x95, 0x1b, 0x90, 0x89, 0xeb, 0xa5, 0xde, 0x33, 0x03, 0x70, 0x0f, 0x3f, 0xa6,
+	0xdf, 0x89, 0x26, 0x64, 0x1e, 0x3e, 0x07, 0x36, 0xfe, 0xb0, 0x02, 0x57, 0xa5, 0x69, 0xf3, 0xbb,
+	0xaf, 0x35, 0x8b, 0xad, 0x13, 0x4c, 0xd3, 0xb8, 0xea, 0x4c, 0x2a, 0xc5, 0x99, 0x7c, 0x00, 0xad,
+	0x89, 0x7f, 0x3a, 0x94, 0x2e, 0xd3, 0xe2, 0x01, 0x2b, 0x0f, 0xa5, 0xfb, 0x7f, 0xe6, 0x9e, 0x9e,
+	0x3d, 0xb5, 0x63, 0x12, 0x4e, 0xed, 0xf0, 0xb9, 0x38, 0x10, 0xf2, 0x40, 0xe3, 0xbf, 0x2a, 0xa0,
+	0x4b, 0xf3, 0x49, 0xe6, 0x69, 0xd1, 0x00, 0xe9, 0x0d, 0x26, 0x73, 0x03, 0x20, 0x12, 0x24, 0xbd,
+	0x6e, 0x92, 0xc7, 0xca, 0x20, 0xe8, 0xfb, 0xb0, 0x22, 0x82, 0xcd, 0xe4, 0xfa, 0x29, 0x17, 0x6c,
+	0x08, 0xbc, 0x21, 0xc7, 0xc0, 0x29, 0x2a, 0xfa, 0x01, 0xac, 0x31, 0x27, 0x61, 0x89, 0x90, 0xa4,
+	0xbe, 0x5f, 0x53, 0xca, 0xff, 0xb2, 0x5e, 0x9c, 0x43, 0x2d, 0x2e, 0xbb, 0x51, 0xb6, 0xec, 0xaf,
+	0x2a, 0xb0, 0xa1, 0x0c, 0x4f, 0xd7, 0xf2, 0xcc, 0x8e, 0x88, 0x10, 0x2a, 0xcf, 0xa6, 0x49, 0x10,
+	0xda, 0x3f, 0xb1, 0xa3, 0xbc, 0xd0, 0x25, 0x08, 0x75, 0xb9, 0x74, 0x0b, 0xdc, 0x9f, 0x10, 0x21,
+	0xea, 0xa4, 0x49, 0x95, 0xc7, 0xa5, 0x36, 0xc9, 0xfa, 0x44, 0x86, 0x39, 0x05, 0x18, 0x9f, 0xc0,
+	0xaa, 0xb4, 0x9c, 0x37, 0x10, 0xba, 0x12, 0x4b, 0x55, 0x8b, 0xb1, 0xd4, 0x3f, 0x57, 0x60, 0x3b,
+	0x59, 0x5e, 0xe7, 0x6c, 0xe6, 0x3d, 0x7f, 0x33, 0xf3, 0x38, 0x6f, 0x37, 0xf3, 0x12, 0xaa, 0x15,
+	0x24, 0x74, 0x1b, 0xea, 0x27, 0xee, 0x84, 0x2f, 0xb1, 0x25, 0xd7, 0xae, 0x24, 0x73, 0x79, 0xe0,
+	0x4e, 0x08, 0x8d, 0xea, 0x31, 0xc3, 0x63, 0xe9, 0x72, 0x3f, 0xe2, 0x77, 0x40, 0xbe, 0x4d, 0x69,
+	0x9b, 0xf6, 0x4d, 0x93, 0x0c, 0xda, 0x12, 0xef, 0x4b, 0xda, 0xc6, 0x97, 0xb0, 0xa3, 0xac, 0x4e,
+	0x78, 0x6a, 0x04, 0x75, 0xea, 0x8e, 0xd9, 0xca, 0xd6, 0x30, 0xfb, 0xa6, 0x30, 0xba, 0x49, 0xe2,
+	0x3d, 0x8f, 0x7d, 0x53, 0xe6, 0xe3, 0x33, 0x32, 0x7e, 0x1e, 0xcd, 0xa6, 0x6c, 0x19, 0xeb, 0x38,
+	0x6d, 0x67, 0xde, 0xbe, 0x2e, 0x7b, 0xfb, 0x5f, 0x02, 0xbd, 0x9f, 0x6d, 0x81, 0xd0, 0x3c, 0x21,
+	0xd4, 0x73, 0x77, 0xcc, 0xf8, 0x01, 0x5c, 0x29, 0xa1, 0x16, 0x93, 0xa6, 0xb7, 0x36, 0xcf, 0xc9,
+	0xa9, 0x5d, 0x06, 0x30, 0xfe, 0xa5, 0x05, 0x9b, 0x87, 0xa1, 0x1f, 0xd8, 0xa7, 0x34, 0xf0, 0xcd,
+	0xf6, 0xf1, 0xff, 0x6e, 0xe5, 0x6f, 0x98, 0x7b, 0x23, 0x2c, 0x56, 0xfe, 0xe6, 0xdf, 0x10, 0xb1,
+	0x82, 0xff, 0xff, 0xba, 0xf2, 0x77, 0x4e, 0xb9, 0x6e, 0xf3, 0xc2, 0xe5, 0xba, 0x73, 0xea, 0x6a,
+	0xe1, 0x9d, 0xd7, 0xd5, 0xae, 0xbe, 0x5d, 0x5d, 0x6d, 0x78, 0xce, 0xd3, 0xaa, 0xbe, 0xa6, 0xd6,
+	0xd5, 0x9e, 0xf7, 0x18, 0x8b, 0xcf, 0xe5, 0x59, 0x52, 0xa5, 0xbe, 0xfe, 0x73, 0x56, 0xa9, 0xcf,
+	0xa9, 0xcc, 0x6d, 0x5d, 0xb8, 0x32, 0xb7, 0xbc, 0x84, 0x76, 0xe3, 0x5d, 0x96, 0xd0, 0x6a, 0x17,
+	0x2a, 0xa1, 0x9d, 0x53, 0xf4, 0xba, 0xf9, 0xbf, 0x54, 0xf4, 0x8a, 0xde, 0x51, 0xd1, 0xeb, 0xbc,
+	0x5a, 0xd4, 0xad, 0x77, 0x5b, 0x8b, 0xba, 0xfd, 0xee, 0x6a, 0x51, 0x77, 0xde, 0x71, 0x2d, 0xea,
+	0xee, 0xcf, 0x59, 0x8b, 0x5a, 0x5e, 0x43, 0x7a, 0xf9, 0x5d, 0xd7, 0x90, 0xea, 0xef, 0xbe, 0x86,
+	0xf4, 0xca, 0x85, 0x6a, 0x48, 0xbf, 0x03, 0x0d, 0x33, 0x0c, 0x7d, 0x16, 0x47, 0x8e, 0x7d, 0x87,
+	0xa7, 0x59, 0xd6, 0x31, 0xfb, 0xa6, 0xe9, 0x84, 0x69, 0x74, 0x2a, 0x6e, 0x42, 0xf4, 0xd3, 0xf8,
+	0xcd, 0x06, 0x20, 0xf9, 0x38, 0x4e, 0xcf, 0xf0, 0x45, 0xe7, 0xf1, 0xfb, 0xc9, 0x95, 0x82, 0x1f,
+	0xc3, 0x1b, 0xd2, 0x61, 0x46, 0xc1, 0xe2, 0x8e, 0x81, 0x26, 0xb0, 0x53, 0x70, 0xb9, 0x74, 0x04,
+	0xe1, 0x5c, 0xef, 0xe5, 0x42, 0x23, 0x65, 0x06, 0x45, 0x0f, 0x9e, 0xf4, 0xe0, 0x72, 0xa6, 0xc8,
+	0x85, 0x6d, 0xd5, 0x65, 0xb0, 0xc1, 0xb8, 0xf3, 0xfa, 0xfe, 0xc2, 0xc1, 0x70, 0x09, 0x21, 0x1b,
+	0xab, 0x94, 0x25, 0x5d, 0x58, 0xc1, 0x05, 0xb0, 0xb1, 0x36, 0xde, 0x60, 0x61, 0xc3, 0x32, 0x4a,
+	0xbe, 0xb0, 0x52, 0xa6, 0x7b, 0x43, 0xb8, 0x32, 0x57, 0x18, 0x6a, 0xb6, 0xa2, 0xb2, 0x20, 0x5b,
+	0x21, 0xa7, 0xd6, 0xf6, 0xbe, 0x47, 0xc3, 0xa4, 0xf2, 0x45, 0x67, 0x14, 0x15, 0x99, 0xe2, 0x29,
+	0x5c, 0x99, 0x3b, 0xf5, 0xb7, 0xaa, 0xe6, 0x8d, 0x61, 0x93, 0x47, 0xe5, 0x3d, 0xef, 0xc4, 0x4f,
+	0x2e, 0x84, 0x6a, 0x0e, 0xe7, 0x5b, 0x50, 0x0f, 0xe3, 0xb8, 0x24, 0x2d, 0x7c, 0x9f, 0x3d, 0x88,
+	0xe0, 0xd1, 0x08, 0x33, 0x84, 0x37, 0x4d, 0x9f, 0x18, 0xdf, 0x87, 0x66, 0x4a, 0x2a, 0x3d, 0xb3,
+	0x54, 0x72, 0xcf, 0x2c, 0x1a, 0xd4, 0xc2, 0x38, 0x89, 0x48, 0xe8, 0xa7, 0xf1, 0xf7, 0x15, 0x40,
+	0xf2, 0x6c, 0xc5, 0xfa, 0xd5, 0xe9, 0x26, 0xb3, 0xa8, 0x96, 0xcc, 0xa2, 0x96, 0xcd, 0x82, 0x06,
+	0xda, 0xc9, 0x4a, 0x92, 0xa7, 0x99, 0x3a, 0xb3, 0x57, 0x15, 0x4c, 0x25, 0x3c, 0xa1, 0xdb, 0xe5,
+	0x25, 0x39, 0x8d, 0x9c, 0x84, 0xdb, 0xce, 0x0b, 0x12, 0xc6, 0x6e, 0x44, 0x9c, 0xbe, 0x40, 0xc2,
+	0x19, 0x3a, 0x0d, 0x0f, 0x58, 0x1d, 0x9d, 0xeb, 0x9d, 0xb2, 0x3b, 0xe4, 0x0a, 0x4e, 0xdb, 0xc6,
+	0x21, 0xa0, 0x22, 0x71, 0x69, 0x8e, 0xf6, 0x0d, 0xd7, 0x64, 0x0c, 0x60, 0x37, 0x2b, 0x8c, 0x8a,
+	0xed, 0x78, 0x16, 0x49, 0xe9, 0xb0, 0x0b, 0x94, 0x0f, 0xff, 0x7e, 0x05, 0x2e, 0x17, 0x18, 0x0a,
+	0xb9, 0xef, 0xc2, 0x12, 0x79, 0xe5, 0x46, 0x71, 0x24, 0x0a, 0x3c, 0x44, 0x8b, 0xae, 0xd8, 0x8d,
+	0xf8, 0xb5, 0x46, 0x04, 0x4a, 0x69, 0x1b, 0xfd, 0x02, 0x9d, 0x05, 0xe5, 0x22, 0xc2, 0x80, 0xfd,
+	0xb2, 0x07, 0x24, 0x1e, 0x43, 0x8a, 0xd1, 0x04, 0xbe, 0xf1, 0x77, 0x35, 0xd8, 0x2d, 0x47, 0x99,
+	0xab, 0x41, 0xb7, 0xa1, 0x11, 0xc5, 0x49, 0x6e, 0xbe, 0x25, 0x9f, 0x5b, 0xb9, 0x25, 0x11, 0xcc,
+	0xd1, 0x72, 0x13, 0xaf, 0x29, 0x13, 0x97, 0x53, 0xb5, 0x75, 0x25, 0x55, 0x9b, 0x25, 0x90, 0x1b,
+	0x8b, 0x2a, 0x0d, 0x97, 0x8a, 0xd1, 0xf7, 0x4d, 0xd8, 0xe0, 0x4d, 0x7e, 0x37, 0xa4, 0xb5, 0xa0,
+	0xcb, 0x4c, 0xdf, 0x55, 0x70, 0x16, 0x49, 0xae, 0x48, 0x91, 0x24, 0x7d, 0xab, 0x98, 0xf8, 0xa7,
+	0x66, 0x1a, 0xf1, 0x35, 0x79, 0x21, 0xa9, 0x0c, 0x13, 0x39, 0x1e, 0x29, 0x64, 0x14, 0xb9, 0x69,
+	0x05, 0x5a, 0x4c, 0x76, 0xac, 0x96, 0x24, 0x3b, 0x4a, 0x32, 0x46, 0x6b, 0xa5, 0x19, 0xa3, 0x6b,
+	0xd0, 0x8c, 0xdc, 0x9f, 0x88, 0x07, 0xe9, 0x75, 0x1e, 0x88, 0xa6, 0x00, 0xe3, 0x00, 0x76, 0xd2,
+	0x2d, 0xe8, 0x4c, 0x88, 0xed, 0xbd, 0x9d, 0x96, 0x1e, 0xc2, 0xae, 0xca, 0x4e, 0xe8, 0xe8, 0x3d,
+	0x58, 0xa2, 0x61, 0xd1, 0x24, 0xd6, 0x2b, 0xea, 0x2d, 0xad, 0x40, 0x31, 0x9b, 0xc4, 0x58, 0x60,
+	0x1b, 0xbf, 0x57, 0x83, 0xed, 0x32, 0x84, 0xb9, 0xba, 0xb6, 0x48, 0xe9, 0x3f, 0xa0, 0x17, 0x29,
+	0x9e, 0x8d, 0xe2, 0xd5, 0xb4, 0xc2, 0x64, 0x15, 0x28, 0xcb, 0x2a, 0x0b, 0x48, 0xfb, 0x24, 0x16,
+	0x85, 0xab, 0x0d, 0x9c, 0x07, 0xa6, 0x45, 0xe7, 0x98, 0x8c, 0x27, 0xb6, 0x3b, 0x25, 0x8e, 0x48,
+	0x77, 0x28, 0x50, 0xca, 0xed, 0x39, 0x79, 0x1d, 0x75, 0x78, 0x11, 0x0d, 0x71, 0x44, 0xe6, 0x23,
+	0x0f, 0xa4, 0x79, 0xe0, 0xd8, 0x9f, 0x3e, 0x8b, 0x62, 0xdf, 0x23, 0x51, 0x37, 0xf4, 0x83, 0x80,
+	0x38, 0x42, 0x07, 0x8b, 0x1d, 0x74, 0xec, 0x92, 0x12, 0x9e, 0x95, 0x42, 0xa9, 0x4e, 0xf6, 0xe0,
+	0xd1, 0x54, 0x1f, 0x3c, 0x9c, 0x99, 0x38, 0xa9, 0x80, 0x27, 0x62, 0x92, 0x76, 0xa6, 0xe1, 0xab,
+	0x72, 0xae, 0x44, 0xd6, 0x94, 0x81, 0x1f, 0xbb, 0x27, 0x22, 0xb9, 0x78, 0x41, 0x4d, 0xf9, 0xed,
+	0x0a, 0x68, 0xb2, 0xf1, 0x87, 0x31, 0x9f, 0xed, 0xbb, 0xab, 0xee, 0x55, 0xad, 0xbe, 0x5e, 0xcc,
+	0xe0, 0xdc, 0x81, 0x95, 0xc7, 0xe4, 0x75, 0xc7, 0x9f, 0x79, 0xb1, 0xfc, 0x80, 0xbb, 0x96, 0x3e,
+	0xe0, 0x8e, 0x69, 0x97, 0x38, 0xf9, 0x78, 0xc3, 0xf8, 0xaa, 0x4a, 0x6b, 0x47, 0x6d, 0xa7, 0x3d,
+	0x0d, 0x26, 0x99, 0x10, 0xde, 0x83, 0x75, 0xb6, 0xeb, 0xed, 0x20, 0x20, 0x9e, 0x43, 0x1c, 0x91,
+	0xf2, 0xc9, 0x03, 0x29, 0x56, 0x6c, 0xbb, 0x93, 0xfb, 0x5c, 0x3f, 0xec, 0xe4, 0x57, 0x0a, 0x79,
+	0x20, 0xfa, 0x1e, 0x6c, 0x9d, 0xb9, 0x51, 0xec, 0x87, 0xee, 0xd8, 0x96, 0x70, 0x79, 0x66, 0xae,
+	0xac, 0x8b, 0x96, 0xe0, 0x48, 0x2f, 0x6d, 0x19, 0x09, 0xcf, 0x4a, 0x96, 0xf6, 0xd1, 0x72, 0xf3,
+	0xb1, 0x3f, 0x71, 0x44, 0x9e, 0xd4, 0x0a, 0x88, 0x17, 0x09, 0xfd, 0x2d, 0xc0, 0xa9, 0x84, 0x4f,
+	0xf8, 0xbb, 0x1e, 0x55, 0xdd, 0x0a, 0x16, 0x2d, 0xe3, 0x3f, 0x58, 0x69, 0xbc, 0xd8, 0x87, 0xbe,
+	0x6f, 0x5f, 0x74, 0x07, 0x3f, 0x80, 0x96, 0x28, 0xe8, 0x89, 0x7a, 0x1e, 0xa6, 0x07, 0x05, 0x5f,
+	0xac, 0x02, 0xa5, 0x6f, 0x58, 0xb1, 0x1f, 0x3c, 0x26, 0xaf, 0x93, 0xe4, 0xb1, 0xf4, 0x86, 0x95,
+	0x6c, 0x24, 0x4e, 0x50, 0x78, 0xa0, 0xac, 0x6c, 0x94, 0xde, 0x50, 0xe3, 0x89, 0xc2, 0x5e, 0xe2,
+	0x22, 0x95, 0xf1, 0x39, 0x6c, 0xe5, 0xd6, 0xc9, 0xf3, 0x14, 0x85, 0x0b, 0xcf, 0xc7, 0x85, 0x72,
+	0x5b, 0x25, 0xcf, 0x24, 0xb3, 0x90, 0x50, 0x8d, 0x0f, 0xa1, 0x75, 0xdf, 0xf7, 0xe3, 0x28, 0x0e,
+	0xed, 0xe0, 0x30, 0xf4, 0x9f, 0x2d, 0xfe, 0x6f, 0x01, 0xff, 0x5e, 0x05, 0xc8, 0x8a, 0xa9, 0x17,
+	0xd5, 0x2d, 0x4f, 0x89, 0xcd, 0xe5, 0x59, 0x15, 0xc9, 0x56, 0xd1, 0xa6, 0x69, 0xed, 0xa9, 0xfd,
+	0x4a, 0x12, 0x75, 0xd2, 0xa4, 0x54, 0x2f, 0xec, 0xd0, 0xa5, 0xd1, 0xb7, 0xd0, 0x9f, 0xb4, 0xcd,
+	0x46, 0x7a, 0x4e, 0x5e, 0x0a, 0x4f, 0xb7, 0x82, 0x45, 0x8b, 0x9e, 0x7e, 0x67, 0x7e, 0x56, 0x08,
+	0x2e, 0x0a, 0x66, 0x72, 0x30, 0x79, 0xef, 0x96, 0xcf, 0xdf, 0xbb, 0xbc, 0x24, 0x57, 0xde, 0x58,
+	0x92, 0xe5, 0x9b, 0xde, 0xbc, 0xd0, 0xa6, 0x87, 0xb0, 0xd4, 0x99, 0x85, 0x91, 0x1f, 0x5e, 0xbc,
+	0xde, 0x61, 0xcc, 0xe8, 0x7b, 0xc9, 0x4f, 0x5f, 0xd2, 0xb6, 0xf4, 0x66, 0x55, 0xcf, 0xfd, 0xe0,
+	0xec, 0x31, 0xac, 0xf3, 0x31, 0x93, 0x73, 0xf8, 0x26, 0x2c, 0x71, 0xa2, 0xe2, 0x4f, 0x1f, 0x05,
+	0xa2, 0xe8, 0xa7, 0x0e, 0x2c, 0x79, 0x38, 0x58, 0xc1, 0xf4, 0x93, 0xfe, 0x3c, 0x2c, 0x61, 0x96,
+	0xdd, 0x14, 0x7d, 0x39, 0x25, 0x2d, 0x5a, 0x6f, 0x18, 0xcb, 0x1a, 0x7f, 0x5d, 0x03, 0x54, 0x0c,
+	0x63, 0x0a, 0x3f, 0x1e, 0xfc, 0x08, 0xea, 0x31, 0xad, 0x00, 0xe4, 0xb7, 0xbd, 0xfd, 0x45, 0x21,
+	0x10, 0x7f, 0x37, 0xa0, 0xd8, 0x92, 0x90, 0x6b, 0x0b, 0x6a, 0xd8, 0xeb, 0x0b, 0x6b, 0xd8, 0x1b,
+	0xca, 0x85, 0x90, 0xd5, 0x4e, 0xb0, 0x1f, 0x25, 0xb6, 0x63, 0x71, 0xec, 0x66, 0x80, 0x7c, 0x2d,
+	0xda, 0xb2, 0x5a, 0x8b, 0x96, 0xf5, 0xb6, 0x63, 0x76, 0xba, 0xd6, 0x70, 0x06, 0x40, 0x1f, 0x27,
+	0x57, 0xda, 0x26, 0x5b, 0xe4, 0x37, 0x17, 0x2d, 0x32, 0x77, 0xb7, 0x7d, 0x0f, 0xd6, 0xc5, 0x0c,
+	0x1c, 0xfe, 0xd6, 0xcb, 0x2f, 0x81, 0x79, 0xa0, 0xf2, 0xfb, 0xcb, 0xd5, 0x73, 0x7e, 0x7f, 0xb9,
+	0xa6, 0xfe, 0xfe, 0x32, 0x3b, 0xc3, 0xd7, 0xa5, 0x33, 0xfc, 0xd6, 0x7f, 0x36, 0xa0, 0x6a, 0x05,
+	0x68, 0x13, 0xd6, 0x3b, 0xd8, 0x6c, 0x8f, 0xcc, 0xe3, 0xe1, 0x08, 0x9b, 0xed, 0x03, 0xed, 0x12,
+	0x6a, 0x01, 0x0c, 0x1f, 0xe1, 0xde, 0xe0, 0xf1, 0x71, 0x6f, 0x88, 0xb5, 0x0a, 0x45, 0xc1, 0xe6,
+	0xa1, 0x85, 0x47, 0xc7, 0x7d, 0xb3, 0xdd, 0x35, 0xb1, 0x56, 0x65, 0x54, 0x8f, 0xda, 0x83, 0x87,
+	0x66, 0x02, 0xaa, 0x51, 0x2a, 0xf3, 0xd3, 0xc3, 0xf6, 0xa0, 0xcb, 0xa8, 0xea, 0x14, 0xa5, 0x6b,
+	0xf6, 0xcd, 0x8c, 0x71, 0x03, 0x69, 0xb0, 0x76, 0xd8, 0x3e, 0x1a, 0xa6, 0x90, 0x25, 0xce, 0x7a,
+	0x78, 0x74, 0x90, 0x82, 0x96, 0xd1, 0x36, 0x68, 0x87, 0x47, 0xf7, 0xfb, 0xbd, 0xe1, 0xa3, 0xe3,
+	0x76, 0x67, 0xd4, 0x7b, 0xd2, 0x1b, 0xfd, 0x58, 0x5b, 0x41, 0x97, 0x61, 0x6b, 0x68, 0x8e, 0x04,
+	0xd6, 0x31, 0x36, 0xdb, 0x5d, 0x6b, 0xd0, 0xff, 0xb1, 0xd6, 0x44, 0x57, 0x60, 0x47, 0xcc, 0xbf,
+	0x63, 0x0d, 0x28, 0x27, 0x7c, 0xfc, 0x10, 0x5b, 0x47, 0x87, 0x1a, 0x50, 0x9a, 0x1f, 0x59, 0xbd,
+	0x81, 0xda, 0xb1, 0x8a, 0x74, 0xd8, 0xee, 0x9b, 0xed, 0x27, 0x05, 0x92, 0x35, 0xf4, 0x3e, 0x7c,
+	0x53, 0x2c, 0x35, 0xdf, 0x75, 0xdc, 0xb1, 0x2c, 0xdc, 0xed, 0x0d, 0xda, 0x23, 0x0b, 0x6b, 0xeb,
+	0x14, 0x4d, 0x2c, 0x7f, 0x01, 0x5a, 0x8b, 0x4e, 0xe0, 0xe8, 0xb0, 0x9b, 0xc9, 0xf6, 0xd8, 0x7a,
+	0x3a, 0x30, 0xb1, 0xb6, 0x41, 0x27, 0x2d, 0x86, 0x39, 0x6c, 0xe3, 0x51, 0x6f, 0xd4, 0xb3, 0x06,
+	0xc7, 0xc3, 0xc7, 0xe6, 0x53, 0x4d, 0x43, 0x3b, 0xb0, 0x89, 0xcd, 0x87, 0xbd, 0xe1, 0xc8, 0xc4,
+	0xc7, 0x87, 0xd8, 0xea, 0x1e, 0x75, 0x4c, 0xac, 0x6d, 0x52, 0xa9, 0x60, 0xb3, 0x6f, 0xb6, 0x87,
+	0x66, 0x06, 0x45, 0x68, 0x17, 0x10, 0x93, 0x8a, 0x89, 0x9f, 0x98, 0xf8, 0x18, 0x9b, 0x07, 0xd6,
+	0x13, 0xb3, 0xab, 0x6d, 0x31, 0x78, 0xe7, 0x91, 0xd9, 0x3d, 0xea, 0x9b, 0xc7, 0xd6, 0xa1, 0x89,
+	0xdb, 0x74, 0x04, 0x6d, 0x1b, 0xdd, 0x80, 0xbd, 0x4e, 0x7b, 0xd0, 0x31, 0xfb, 0xc7, 0x49, 0x77,
+	0x57, 0xea, 0xdf, 0x41, 0xdf, 0x80, 0xab, 0xe6, 0xa7, 0x66, 0xe7, 0x68, 0x64, 0x96, 0x22, 0xec,
+	0x52, 0xc9, 0xe5, 0x57, 0xd4, 0xb1, 0x06, 0x0f, 0x7a, 0x0f, 0xb5, 0xcb, 0x68, 0x0b, 0x36, 0xa4,
+	0x0d, 0x1a, 0xb5, 0x1f, 0x0e, 0x35, 0x9d, 0xae, 0x53, 0xe8, 0x40, 0xb6, 0xce, 0x6e, 0x7b, 0xd4,
+	0xd6, 0xae, 0x20, 0x04, 0x2d, 0x09, 0xbf, 0xdd, 0xe9, 0x6b, 0x7b, 0x94, 0x07, 0x36, 0x0f, 0xfb,
+	0xed, 0x8e, 0x79, 0x4c, 0xff, 0xf6, 0x3a, 0x6d, 0xed, 0x6a, 0xb2, 0xc6, 0x64, 0xd5, 0xc7, 0x9f,
+	0x1c, 0x59, 0xa3, 0xb6, 0x76, 0x4d, 0xe6, 0x9d, 0xef, 0xba, 0x4e, 0x85, 0xa5, 0x0e, 0xab, 0xdd,
+	0xb8, 0x75, 0x0f, 0x34, 0xf5, 0x95, 0x12, 0x6d, 0xc0, 0xea, 0xd0, 0x7c, 0x78, 0x60, 0x0e, 0x46,
+	0xc7, 0x7d, 0xeb, 0xa1, 0x76, 0x89, 0x2a, 0x64, 0x02, 0xe8, 0x0d, 0xba, 0xe6, 0xa7, 0x5a, 0xe5,
+	0xd6, 0x6f, 0x55, 0xa1, 0x95, 0x0f, 0x4d, 0xd1, 0x75, 0xb8, 0x22, 0x6d, 0xdc, 0x88, 0xca, 0x63,
+	0x60, 0x8d, 0x8e, 0x1f, 0x58, 0x47, 0x83, 0xae, 0x76, 0x09, 0x5d, 0x03, 0x5d, 0xed, 0x66, 0x3a,
+	0xda, 0x1b, 0x3c, 0xd4, 0x2a, 0x68, 0x0f, 0x76, 0xd5, 0xde, 0xd4, 0xae, 0x4a, 0x28, 0x1f, 0x58,
+	0xfd, 0xbe, 0xf5, 0x94, 0x99, 0x58, 0x09, 0x25, 0xb3, 0xa7, 0xae, 0x56, 0x2f, 0xa3, 0x4c, 0xad,
+	0xa4, 0x41, 0x37, 0xbe, 0xd8, 0xdb, 0xb1, 0x9e, 0x98, 0x98, 0xce, 0x69, 0xa9, 0xac, 0x7f, 0x64,
+	0x1d, 0xdc, 0x1f, 0x8e, 0xac, 0x81, 0xd9, 0xd5, 0x96, 0x6f, 0xfd, 0xb4, 0x02, 0xbb, 0xe5, 0x0e,
+	0x9b, 0x4e, 0x2a, 0xd3, 0x95, 0x9c, 0x79, 0x5f, 0x42, 0x57, 0xe1, 0x72, 0xd6, 0x97, 0x37, 0xf4,
+	0x0a, 0xfa, 0x26, 0x5c, 0xcf, 0x3a, 0xcb, 0x8c, 0xbb, 0x9a, 0xa7, 0xcf, 0x7b, 0x93, 0xda, 0xad,
+	0x3f, 0xad, 0xc0, 0xe5, 0x39, 0xfe, 0x95, 0x2a, 0x72, 0x89, 0x02, 0x1f, 0x1f, 0x9a, 0x83, 0x2e,
+	0x5d, 0xf0, 0xa5, 0xfc, 0xe0, 0x19, 0xc2, 0xf0, 0xa8, 0xd3, 0x31, 0xcd, 0xae, 0xd9, 0xd5, 0x2a,
+	0x54, 0x26, 0x65, 0x28, 0x0f, 0xda, 0xbd, 0xbe, 0xd9, 0xd5, 0xaa, 0x68, 0x1f, 0xae, 0x95, 0xf5,
+	0x73, 0x03, 0x33, 0xbb, 0x5a, 0xed, 0xbe, 0xf6, 0xb7, 0x5f, 0xdf, 0xa8, 0xfc, 0xe3, 0xd7, 0x37,
+	0x2a, 0xff, 0xfa, 0xf5, 0x8d, 0xca, 0xcf, 0xfe, 0xed, 0xc6, 0xa5, 0x67, 0x4b, 0xec, 0x64, 0xb8,
+	0xfb, 0x3f, 0x03, 0x00, 0xf9, 0x62, 0x95, 0xcf, 0x39, 0x4a, 0x00, 0x00,
+}
+
+func (m *ServerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ServerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Streams) > 0 {
-		for _, e := range m.Streams {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
-	}
-	if len(m.Groups) > 0 {
-		for _, e := range m.Groups {
-			l = e.Size()
-			n += 1 + l + sovInternal(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.ServerID) > 0 {
+		i -= len(m.ServerID)
+		copy(dAtA[i:], m.ServerID)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ServerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ReplicationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RaftLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ReplicaID)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.Offset != 0 {
-		n += 1 + sovInternal(uint64(m.Offset))
-	}
-	if m.LeaderEpoch != 0 {
-		n += 1 + sovInternal(uint64(m.LeaderEpoch))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *LeaderEpochOffsetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DeletePartitionOp != nil {
+		{
+			size, err := m.DeletePartitionOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
 	}
-	var l int
-	_ = l
-	if m.LeaderEpoch != 0 {
-		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+	if m.DeleteProducerQuotaOp != nil {
+		{
+			size, err := m.DeleteProducerQuotaOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.SetProducerQuotaOp != nil {
+		{
+			size, err := m.SetProducerQuotaOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
 	}
-	return n
-}
-
-func (m *LeaderEpochOffsetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ReplaceReplicaOp != nil {
+		{
+			size, err := m.ReplaceReplicaOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	var l int
-	_ = l
-	if m.EndOffset != 0 {
-		n += 1 + sovInternal(uint64(m.EndOffset))
+	if m.SetStreamACLOp != nil {
+		{
+			size, err := m.SetStreamACLOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.DeletePartitionDataOp != nil {
+		{
+			size, err := m.DeletePartitionDataOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	return n
-}
-
-func (m *PropagatedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SetStreamTagsOp != nil {
+		{
+			size, err := m.SetStreamTagsOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	var l int
-	_ = l
-	if m.Op != 0 {
-		n += 1 + sovInternal(uint64(m.Op))
+	if m.UpdateStreamConfigOp != nil {
+		{
+			size, err := m.UpdateStreamConfigOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	if m.CreateStreamOp != nil {
-		l = m.CreateStreamOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.ExecuteScheduledOperationOp != nil {
+		{
+			size, err := m.ExecuteScheduledOperationOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if m.ShrinkISROp != nil {
-		l = m.ShrinkISROp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.CancelScheduledOperationOp != nil {
+		{
+			size, err := m.CancelScheduledOperationOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	if m.ReportLeaderOp != nil {
-		l = m.ReportLeaderOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.ScheduleOperationOp != nil {
+		{
+			size, err := m.ScheduleOperationOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	if m.ExpandISROp != nil {
-		l = m.ExpandISROp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.SetServerRemovedOp != nil {
+		{
+			size, err := m.SetServerRemovedOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.DeleteStreamOp != nil {
-		l = m.DeleteStreamOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.ReleaseProducerOp != nil {
+		{
+			size, err := m.ReleaseProducerOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.PauseStreamOp != nil {
-		l = m.PauseStreamOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.RegisterProducerOp != nil {
+		{
+			size, err := m.RegisterProducerOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.ResumeStreamOp != nil {
-		l = m.ResumeStreamOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.ReportPartitionSkewOp != nil {
+		{
+			size, err := m.ReportPartitionSkewOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.SetStreamReadonlyOp != nil {
-		l = m.SetStreamReadonlyOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.UpdateStreamOwnerOp != nil {
+		{
+			size, err := m.UpdateStreamOwnerOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.JoinConsumerGroupOp != nil {
-		l = m.JoinConsumerGroupOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.ChangeConsumerGroupCoordinatorOp != nil {
+		{
+			size, err := m.ChangeConsumerGroupCoordinatorOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
 	if m.LeaveConsumerGroupOp != nil {
-		l = m.LeaveConsumerGroupOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+		{
+			size, err := m.LeaveConsumerGroupOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.ReportConsumerGroupCoordinatorOp != nil {
-		l = m.ReportConsumerGroupCoordinatorOp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.JoinConsumerGroupOp != nil {
+		{
+			size, err := m.JoinConsumerGroupOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.CreateConsumerGroupOp != nil {
+		{
+			size, err := m.CreateConsumerGroupOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
-}
-
-func (m *Error) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SetStreamReadonlyOp != nil {
+		{
+			size, err := m.SetStreamReadonlyOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovInternal(uint64(m.Code))
+	if m.PublishActivityOp != nil {
+		{
+			size, err := m.PublishActivityOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if m.ResumeStreamOp != nil {
+		{
+			size, err := m.ResumeStreamOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.PauseStreamOp != nil {
+		{
+			size, err := m.PauseStreamOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *PropagatedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DeleteStreamOp != nil {
+		{
+			size, err := m.DeleteStreamOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	if m.Op != 0 {
-		n += 1 + sovInternal(uint64(m.Op))
+	if m.ExpandISROp != nil {
+		{
+			size, err := m.ExpandISROp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.ChangeLeaderOp != nil {
+		{
+			size, err := m.ChangeLeaderOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.JoinConsumerGroupResp != nil {
-		l = m.JoinConsumerGroupResp.Size()
-		n += 1 + l + sovInternal(uint64(l))
+	if m.ShrinkISROp != nil {
+		{
+			size, err := m.ShrinkISROp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.CreateStreamOp != nil {
+		{
+			size, err := m.CreateStreamOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Op != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PropagatedResponse_JoinConsumerGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateStreamOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateStreamOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateStreamOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Coordinator)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	if m.Epoch != 0 {
-		n += 1 + sovInternal(uint64(m.Epoch))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *ServerInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.RequestID) > 0 {
+		i -= len(m.RequestID)
+		copy(dAtA[i:], m.RequestID)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.RequestID)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if len(m.Companions) > 0 {
+		for iNdEx := len(m.Companions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Companions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Stream != nil {
+		{
+			size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ServerInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetServerRemovedOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetServerRemovedOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetServerRemovedOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
-	}
-	l = len(m.Host)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Port != 0 {
-		n += 1 + sovInternal(uint64(m.Port))
+	if m.Removed {
+		i--
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Server) > 0 {
+		i -= len(m.Server)
+		copy(dAtA[i:], m.Server)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Server)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PartitionStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScheduleOperationOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleOperationOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleOperationOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.Stream)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Partition != 0 {
-		n += 1 + sovInternal(uint64(m.Partition))
+	if m.Operation != nil {
+		{
+			size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelScheduledOperationOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelScheduledOperationOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelScheduledOperationOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Id != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PartitionStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteScheduledOperationOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteScheduledOperationOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteScheduledOperationOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Exists {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IsLeader {
-		n += 2
+	if len(m.ExecutedBy) > 0 {
+		i -= len(m.ExecutedBy)
+		copy(dAtA[i:], m.ExecutedBy)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ExecutedBy)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ExecutedAt != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ExecutedAt))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Id != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PartitionNotification) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateStreamConfigOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateStreamConfigOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateStreamConfigOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Stream)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Partition != 0 {
-		n += 1 + sovInternal(uint64(m.Partition))
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Cursor) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeletePartitionDataOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePartitionDataOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePartitionDataOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Stream)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Offset != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.Partition != 0 {
-		n += 1 + sovInternal(uint64(m.Partition))
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.CursorId)
-	if l > 0 {
-		n += 1 + l + sovInternal(uint64(l))
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Offset != 0 {
-		n += 1 + sovInternal(uint64(m.Offset))
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletePartitionOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletePartitionOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletePartitionOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Partition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovInternal(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SetStreamTagsOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozInternal(x uint64) (n int) {
-	return sovInternal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SetStreamTagsOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *ServerState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
+
+func (m *SetStreamTagsOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetStreamACLOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetStreamACLOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetStreamACLOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Acl != nil {
+		{
+			size, err := m.Acl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplaceReplicaOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplaceReplicaOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplaceReplicaOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Replacement) > 0 {
+		i -= len(m.Replacement)
+		copy(dAtA[i:], m.Replacement)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Replacement)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Replica) > 0 {
+		i -= len(m.Replica)
+		copy(dAtA[i:], m.Replica)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Replica)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Partition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProducerQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProducerQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProducerQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BytesPerSecond != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.BytesPerSecond))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProducerID) > 0 {
+		i -= len(m.ProducerID)
+		copy(dAtA[i:], m.ProducerID)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ProducerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetProducerQuotaOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetProducerQuotaOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetProducerQuotaOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Quota != nil {
+		{
+			size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteProducerQuotaOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteProducerQuotaOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteProducerQuotaOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ProducerID) > 0 {
+		i -= len(m.ProducerID)
+		copy(dAtA[i:], m.ProducerID)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ProducerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShrinkISROp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShrinkISROp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShrinkISROp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Leader) > 0 {
+		i -= len(m.Leader)
+		copy(dAtA[i:], m.Leader)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Leader)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReplicaToRemove) > 0 {
+		i -= len(m.ReplicaToRemove)
+		copy(dAtA[i:], m.ReplicaToRemove)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ReplicaToRemove)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Partition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpandISROp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpandISROp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpandISROp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Leader) > 0 {
+		i -= len(m.Leader)
+		copy(dAtA[i:], m.Leader)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Leader)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReplicaToAdd) > 0 {
+		i -= len(m.ReplicaToAdd)
+		copy(dAtA[i:], m.ReplicaToAdd)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ReplicaToAdd)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Partition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteStreamOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteStreamOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteStreamOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TtlDeletedAt != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.TtlDeletedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Cascade {
+		i--
+		if m.Cascade {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseStreamOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseStreamOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseStreamOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DrainBeforePause {
+		i--
+		if m.DrainBeforePause {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ResumeAll {
+		i--
+		if m.ResumeAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Partitions) > 0 {
+		dAtA36 := make([]byte, len(m.Partitions)*10)
+		var j35 int
+		for _, num1 := range m.Partitions {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j35++
+			}
+			dAtA36[j35] = uint8(num)
+			j35++
+		}
+		i -= j35
+		copy(dAtA[i:], dAtA36[:j35])
+		i = encodeVarintInternal(dAtA, i, uint64(j35))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResumeStreamOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeStreamOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeStreamOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Partitions) > 0 {
+		dAtA38 := make([]byte, len(m.Partitions)*10)
+		var j37 int
+		for _, num1 := range m.Partitions {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j37++
+			}
+			dAtA38[j37] = uint8(num)
+			j37++
+		}
+		i -= j37
+		copy(dAtA[i:], dAtA38[:j37])
+		i = encodeVarintInternal(dAtA, i, uint64(j37))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportLeaderOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportLeaderOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportLeaderOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Leader) > 0 {
+		i -= len(m.Leader)
+		copy(dAtA[i:], m.Leader)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Leader)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Replica) > 0 {
+		i -= len(m.Replica)
+		copy(dAtA[i:], m.Replica)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Replica)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Partition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeLeaderOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeLeaderOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeLeaderOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Leader) > 0 {
+		i -= len(m.Leader)
+		copy(dAtA[i:], m.Leader)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Leader)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Partition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportConsumerGroupCoordinatorOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportConsumerGroupCoordinatorOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportConsumerGroupCoordinatorOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeConsumerGroupCoordinatorOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeConsumerGroupCoordinatorOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeConsumerGroupCoordinatorOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateStreamOwnerOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateStreamOwnerOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateStreamOwnerOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportPartitionSkewOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportPartitionSkewOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportPartitionSkewOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Skew != nil {
+		{
+			size, err := m.Skew.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterProducerOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterProducerOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterProducerOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Ttl != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Producer) > 0 {
+		i -= len(m.Producer)
+		copy(dAtA[i:], m.Producer)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Producer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseProducerOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseProducerOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseProducerOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Producer) > 0 {
+		i -= len(m.Producer)
+		copy(dAtA[i:], m.Producer)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Producer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PublishActivityOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishActivityOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublishActivityOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ActivityEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ActivityEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RaftIndex != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.RaftIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetStreamReadonlyOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetStreamReadonlyOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetStreamReadonlyOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TargetOffset != nil {
+		{
+			size, err := m.TargetOffset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Readonly {
+		i--
+		if m.Readonly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Partitions) > 0 {
+		dAtA42 := make([]byte, len(m.Partitions)*10)
+		var j41 int
+		for _, num1 := range m.Partitions {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA42[j41] = uint8(num)
+			j41++
+		}
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintInternal(dAtA, i, uint64(j41))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateConsumerGroupOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateConsumerGroupOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateConsumerGroupOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ConsumerGroup != nil {
+		{
+			size, err := m.ConsumerGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JoinConsumerGroupOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinConsumerGroupOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinConsumerGroupOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Streams[iNdEx])
+			copy(dAtA[i:], m.Streams[iNdEx])
+			i = encodeVarintInternal(dAtA, i, uint64(len(m.Streams[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaveConsumerGroupOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaveConsumerGroupOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaveConsumerGroupOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NullableInt64) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NullableInt64) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NullableInt64) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Value != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NullableInt32) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NullableInt32) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NullableInt32) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Value != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NullableBool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NullableBool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NullableBool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Value {
+		i--
+		if m.Value {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RestoreSource != nil {
+		{
+			size, err := m.RestoreSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.MaxMessageBytes != nil {
+		{
+			size, err := m.MaxMessageBytes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.AutoResumeOnPublish != nil {
+		{
+			size, err := m.AutoResumeOnPublish.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Ttl != nil {
+		{
+			size, err := m.Ttl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MessageTimestampMaxDifference != nil {
+		{
+			size, err := m.MessageTimestampMaxDifference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.MessageTimestampType) > 0 {
+		i -= len(m.MessageTimestampType)
+		copy(dAtA[i:], m.MessageTimestampType)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.MessageTimestampType)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MirrorSource != nil {
+		{
+			size, err := m.MirrorSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.DeadLetterQueue) > 0 {
+		i -= len(m.DeadLetterQueue)
+		copy(dAtA[i:], m.DeadLetterQueue)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.DeadLetterQueue)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.SegmentEncryption != nil {
+		{
+			size, err := m.SegmentEncryption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Encryption != nil {
+		{
+			size, err := m.Encryption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.OptimisticConcurrencyControl != nil {
+		{
+			size, err := m.OptimisticConcurrencyControl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MinIsr != nil {
+		{
+			size, err := m.MinIsr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.AutoPauseDisableIfSubscribers != nil {
+		{
+			size, err := m.AutoPauseDisableIfSubscribers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.AutoPauseTime != nil {
+		{
+			size, err := m.AutoPauseTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.CompactEnabled != nil {
+		{
+			size, err := m.CompactEnabled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CompactMaxGoroutines != nil {
+		{
+			size, err := m.CompactMaxGoroutines.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SegmentMaxAge != nil {
+		{
+			size, err := m.SegmentMaxAge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SegmentMaxBytes != nil {
+		{
+			size, err := m.SegmentMaxBytes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CleanerInterval != nil {
+		{
+			size, err := m.CleanerInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RetentionMaxAge != nil {
+		{
+			size, err := m.RetentionMaxAge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RetentionMaxMessages != nil {
+		{
+			size, err := m.RetentionMaxMessages.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RetentionMaxBytes != nil {
+		{
+			size, err := m.RetentionMaxBytes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Offsets) > 0 {
+		dAtA65 := make([]byte, len(m.Offsets)*10)
+		var j64 int
+		for _, num1 := range m.Offsets {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA65[j64] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j64++
+			}
+			dAtA65[j64] = uint8(num)
+			j64++
+		}
+		i -= j64
+		copy(dAtA[i:], dAtA65[:j64])
+		i = encodeVarintInternal(dAtA, i, uint64(j64))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MirrorSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MirrorSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MirrorSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StartOffset != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.StartOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintInternal(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOrigin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrigin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ReplicationFactor != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ReplicationFactor))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Partitions != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partitions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ApplicationVersion) > 0 {
+		i -= len(m.ApplicationVersion)
+		copy(dAtA[i:], m.ApplicationVersion)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ApplicationVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ApplicationName) > 0 {
+		i -= len(m.ApplicationName)
+		copy(dAtA[i:], m.ApplicationName)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ApplicationName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Broker) > 0 {
+		i -= len(m.Broker)
+		copy(dAtA[i:], m.Broker)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Broker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Acl != nil {
+		{
+			size, err := m.Acl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Companions) > 0 {
+		for iNdEx := len(m.Companions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Companions[iNdEx])
+			copy(dAtA[i:], m.Companions[iNdEx])
+			i = encodeVarintInternal(dAtA, i, uint64(len(m.Companions[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Primary) > 0 {
+		i -= len(m.Primary)
+		copy(dAtA[i:], m.Primary)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Primary)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Producers) > 0 {
+		for iNdEx := len(m.Producers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Producers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Origin != nil {
+		{
+			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CreationTimestamp != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.CreationTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamTag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamTag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamTag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamTags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamTags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamTags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamACL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamACL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamACL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.WritePrincipals) > 0 {
+		for iNdEx := len(m.WritePrincipals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WritePrincipals[iNdEx])
+			copy(dAtA[i:], m.WritePrincipals[iNdEx])
+			i = encodeVarintInternal(dAtA, i, uint64(len(m.WritePrincipals[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ReadPrincipals) > 0 {
+		for iNdEx := len(m.ReadPrincipals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReadPrincipals[iNdEx])
+			copy(dAtA[i:], m.ReadPrincipals[iNdEx])
+			i = encodeVarintInternal(dAtA, i, uint64(len(m.ReadPrincipals[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnconfirmedReplicas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnconfirmedReplicas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnconfirmedReplicas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Replicas[iNdEx])
+			copy(dAtA[i:], m.Replicas[iNdEx])
+			i = encodeVarintInternal(dAtA, i, uint64(len(m.Replicas[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Partition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompanionStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompanionStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompanionStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DeadLetterQueue {
+		i--
+		if m.DeadLetterQueue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Partitions != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partitions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubjectTemplate) > 0 {
+		i -= len(m.SubjectTemplate)
+		copy(dAtA[i:], m.SubjectTemplate)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.SubjectTemplate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NameTemplate) > 0 {
+		i -= len(m.NameTemplate)
+		copy(dAtA[i:], m.NameTemplate)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.NameTemplate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExclusiveProducer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExclusiveProducer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExclusiveProducer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Released {
+		i--
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Partition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Partition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Partition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PreferredReplicas) > 0 {
+		for iNdEx := len(m.PreferredReplicas) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreferredReplicas[iNdEx])
+			copy(dAtA[i:], m.PreferredReplicas[iNdEx])
+			i = encodeVarintInternal(dAtA, i, uint64(len(m.PreferredReplicas[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.ReadonlyTarget != nil {
+		{
+			size, err := m.ReadonlyTarget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Readonly {
+		i--
+		if m.Readonly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Isr) > 0 {
+		for iNdEx := len(m.Isr) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Isr[iNdEx])
+			copy(dAtA[i:], m.Isr[iNdEx])
+			i = encodeVarintInternal(dAtA, i, uint64(len(m.Isr[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Leader) > 0 {
+		i -= len(m.Leader)
+		copy(dAtA[i:], m.Leader)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Leader)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Replicas[iNdEx])
+			copy(dAtA[i:], m.Replicas[iNdEx])
+			i = encodeVarintInternal(dAtA, i, uint64(len(m.Replicas[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ReplicationFactor != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ReplicationFactor))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Id != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Consumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Consumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Consumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Streams[iNdEx])
+			copy(dAtA[i:], m.Streams[iNdEx])
+			i = encodeVarintInternal(dAtA, i, uint64(len(m.Streams[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RaftJoinRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftJoinRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftJoinRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SubjectPrefix) > 0 {
+		i -= len(m.SubjectPrefix)
+		copy(dAtA[i:], m.SubjectPrefix)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.SubjectPrefix)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Port != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NodeAddr) > 0 {
+		i -= len(m.NodeAddr)
+		copy(dAtA[i:], m.NodeAddr)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.NodeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RaftJoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftJoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftJoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetadataSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ProducerQuotas) > 0 {
+		for iNdEx := len(m.ProducerQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProducerQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ScheduledOperations) > 0 {
+		for iNdEx := len(m.ScheduledOperations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RemovedServers) > 0 {
+		for iNdEx := len(m.RemovedServers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedServers[iNdEx])
+			copy(dAtA[i:], m.RemovedServers[iNdEx])
+			i = encodeVarintInternal(dAtA, i, uint64(len(m.RemovedServers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ActivityEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ActivityEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActivityRaftIndex != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ActivityRaftIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DeclineSnapshot {
+		i--
+		if m.DeclineSnapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Pipelined {
+		i--
+		if m.Pipelined {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Offset != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ReplicaID) > 0 {
+		i -= len(m.ReplicaID)
+		copy(dAtA[i:], m.ReplicaID)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ReplicaID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationOffsetOutOfRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationOffsetOutOfRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationOffsetOutOfRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HighWatermark != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.HighWatermark))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LogStartOffset != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LogStartOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicationSnapshotOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationSnapshotOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationSnapshotOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HighWatermark != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.HighWatermark))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EpochOffsets) > 0 {
+		for iNdEx := len(m.EpochOffsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochOffsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Segments) > 0 {
+		for iNdEx := len(m.Segments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Segments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SnapshotID) > 0 {
+		i -= len(m.SnapshotID)
+		copy(dAtA[i:], m.SnapshotID)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.SnapshotID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IndexSize != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.IndexSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LogSize != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LogSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastOffset != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LastOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BaseOffset != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.BaseOffset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochOffset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochOffset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StartOffset != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.StartOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotChunkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotChunkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotChunkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxBytes != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Position != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.File != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.File))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BaseOffset != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.BaseOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SnapshotID) > 0 {
+		i -= len(m.SnapshotID)
+		copy(dAtA[i:], m.SnapshotID)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.SnapshotID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReplicaID) > 0 {
+		i -= len(m.ReplicaID)
+		copy(dAtA[i:], m.ReplicaID)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ReplicaID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Checksum != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Checksum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Last {
+		i--
+		if m.Last {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaderEpochOffsetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaderEpochOffsetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaderEpochOffsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaderEpochOffsetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaderEpochOffsetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaderEpochOffsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EndOffset != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.EndOffset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PropagatedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PropagatedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PropagatedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DeletePartitionOp != nil {
+		{
+			size, err := m.DeletePartitionOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.DeleteProducerQuotaOp != nil {
+		{
+			size, err := m.DeleteProducerQuotaOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.SetProducerQuotaOp != nil {
+		{
+			size, err := m.SetProducerQuotaOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.SetStreamACLOp != nil {
+		{
+			size, err := m.SetStreamACLOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.DeletePartitionDataOp != nil {
+		{
+			size, err := m.DeletePartitionDataOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.SetStreamTagsOp != nil {
+		{
+			size, err := m.SetStreamTagsOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.UpdateStreamConfigOp != nil {
+		{
+			size, err := m.UpdateStreamConfigOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.CancelScheduledOperationOp != nil {
+		{
+			size, err := m.CancelScheduledOperationOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.ScheduleOperationOp != nil {
+		{
+			size, err := m.ScheduleOperationOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ReleaseProducerOp != nil {
+		{
+			size, err := m.ReleaseProducerOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.RegisterProducerOp != nil {
+		{
+			size, err := m.RegisterProducerOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.UpdateStreamOwnerOp != nil {
+		{
+			size, err := m.UpdateStreamOwnerOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ChangeLeaderOp != nil {
+		{
+			size, err := m.ChangeLeaderOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ReportConsumerGroupCoordinatorOp != nil {
+		{
+			size, err := m.ReportConsumerGroupCoordinatorOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.LeaveConsumerGroupOp != nil {
+		{
+			size, err := m.LeaveConsumerGroupOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.JoinConsumerGroupOp != nil {
+		{
+			size, err := m.JoinConsumerGroupOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.SetStreamReadonlyOp != nil {
+		{
+			size, err := m.SetStreamReadonlyOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ResumeStreamOp != nil {
+		{
+			size, err := m.ResumeStreamOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PauseStreamOp != nil {
+		{
+			size, err := m.PauseStreamOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DeleteStreamOp != nil {
+		{
+			size, err := m.DeleteStreamOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpandISROp != nil {
+		{
+			size, err := m.ExpandISROp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ReportLeaderOp != nil {
+		{
+			size, err := m.ReportLeaderOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ShrinkISROp != nil {
+		{
+			size, err := m.ShrinkISROp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreateStreamOp != nil {
+		{
+			size, err := m.CreateStreamOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Error) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Error) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PropagatedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PropagatedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PropagatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ScheduleOperationResp != nil {
+		{
+			size, err := m.ScheduleOperationResp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.RegisterProducerResp != nil {
+		{
+			size, err := m.RegisterProducerResp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.JoinConsumerGroupResp != nil {
+		{
+			size, err := m.JoinConsumerGroupResp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PropagatedResponse_JoinConsumerGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PropagatedResponse_JoinConsumerGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PropagatedResponse_JoinConsumerGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Coordinator) > 0 {
+		i -= len(m.Coordinator)
+		copy(dAtA[i:], m.Coordinator)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Coordinator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PropagatedResponse_RegisterProducerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PropagatedResponse_RegisterProducerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PropagatedResponse_RegisterProducerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PropagatedResponse_ScheduleOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PropagatedResponse_ScheduleOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PropagatedResponse_ScheduleOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Operation != nil {
+		{
+			size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServerInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServerInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServerInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Port != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rtts) > 0 {
+		for iNdEx := len(m.Rtts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rtts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BrokerRTT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BrokerRTT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BrokerRTT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Rtt != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Rtt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Broker) > 0 {
+		i -= len(m.Broker)
+		copy(dAtA[i:], m.Broker)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Broker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServerInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServerInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServerInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Draining {
+		i--
+		if m.Draining {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Listeners) > 0 {
+		for iNdEx := len(m.Listeners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listeners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Port != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdvertisedListener) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdvertisedListener) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdvertisedListener) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Port != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Host)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Partition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IsLeader {
+		i--
+		if m.IsLeader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Exists {
+		i--
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionReplicaStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionReplicaStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionReplicaStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.LogStartOffset != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LogStartOffset))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.HighWatermark != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.HighWatermark))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.LogLeaderEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LogLeaderEpoch))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LogEndOffset != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LogEndOffset))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.LeaderChangedAt != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderChangedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Leader) > 0 {
+		i -= len(m.Leader)
+		copy(dAtA[i:], m.Leader)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Leader)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Readonly {
+		i--
+		if m.Readonly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsLeader {
+		i--
+		if m.IsLeader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Broker) > 0 {
+		i -= len(m.Broker)
+		copy(dAtA[i:], m.Broker)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Broker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionCleanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionCleanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionCleanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Partition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionCleanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionCleanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionCleanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionCleanResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionCleanResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionCleanResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Duration != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CompactEnabled {
+		i--
+		if m.CompactEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TombstonesDropped != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.TombstonesDropped))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.KeysCompacted != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.KeysCompacted))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BytesReclaimed != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.BytesReclaimed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SegmentsAfter != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.SegmentsAfter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SegmentsBefore != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.SegmentsBefore))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsLeader {
+		i--
+		if m.IsLeader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Broker) > 0 {
+		i -= len(m.Broker)
+		copy(dAtA[i:], m.Broker)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Broker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionNotification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionNotification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionNotification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Partition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LeaderEpoch != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.LeaderEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Leader) > 0 {
+		i -= len(m.Leader)
+		copy(dAtA[i:], m.Leader)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Leader)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Partition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadAmplification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadAmplification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadAmplification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Factor != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Factor))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.ColdSegmentOpens != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ColdSegmentOpens))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReplicationBytesRead != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ReplicationBytesRead))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HistoricalBytesRead != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.HistoricalBytesRead))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TailBytesRead != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.TailBytesRead))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BytesAppended != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.BytesAppended))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionLoad) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionLoad) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReadAmplification != nil {
+		{
+			size, err := m.ReadAmplification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TopKeys) > 0 {
+		for iNdEx := len(m.TopKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MessagesInRate != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.MessagesInRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Partition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionLoadReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionLoadReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionLoadReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BootstrapProbe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BootstrapProbe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BootstrapProbe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ServerID) > 0 {
+		i -= len(m.ServerID)
+		copy(dAtA[i:], m.ServerID)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ServerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamSkew) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamSkew) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamSkew) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReadAmplification != nil {
+		{
+			size, err := m.ReadAmplification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TopKeys) > 0 {
+		for iNdEx := len(m.TopKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInternal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.HotPartition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.HotPartition))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Skewed {
+		i--
+		if m.Skewed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Variance != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Variance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxRate != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.MaxRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MeanRate != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.MeanRate))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Cursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Cursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Offset != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CursorId) > 0 {
+		i -= len(m.CursorId)
+		copy(dAtA[i:], m.CursorId)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.CursorId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Partition != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CursorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CursorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CursorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Set {
+		i--
+		if m.Set {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Cursor != nil {
+		{
+			size, err := m.Cursor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CursorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CursorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CursorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInternal(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Offset != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ExecutedBy) > 0 {
+		i -= len(m.ExecutedBy)
+		copy(dAtA[i:], m.ExecutedBy)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.ExecutedBy)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ExecutedAt != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ExecutedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ExecutedIndex != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ExecutedIndex))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.State != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.CreatedBy) > 0 {
+		i -= len(m.CreatedBy)
+		copy(dAtA[i:], m.CreatedBy)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.CreatedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExecuteAt != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.ExecuteAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Readonly {
+		i--
+		if m.Readonly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Partitions) > 0 {
+		dAtA107 := make([]byte, len(m.Partitions)*10)
+		var j106 int
+		for _, num1 := range m.Partitions {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA107[j106] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j106++
+			}
+			dAtA107[j106] = uint8(num)
+			j106++
+		}
+		i -= j106
+		copy(dAtA[i:], dAtA107[:j106])
+		i = encodeVarintInternal(dAtA, i, uint64(j106))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintInternal(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintInternal(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInternal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInternal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ServerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerID)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovInternal(uint64(m.Op))
+	}
+	if m.CreateStreamOp != nil {
+		l = m.CreateStreamOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ShrinkISROp != nil {
+		l = m.ShrinkISROp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ChangeLeaderOp != nil {
+		l = m.ChangeLeaderOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ExpandISROp != nil {
+		l = m.ExpandISROp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.DeleteStreamOp != nil {
+		l = m.DeleteStreamOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.PauseStreamOp != nil {
+		l = m.PauseStreamOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ResumeStreamOp != nil {
+		l = m.ResumeStreamOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.PublishActivityOp != nil {
+		l = m.PublishActivityOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.SetStreamReadonlyOp != nil {
+		l = m.SetStreamReadonlyOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.CreateConsumerGroupOp != nil {
+		l = m.CreateConsumerGroupOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.JoinConsumerGroupOp != nil {
+		l = m.JoinConsumerGroupOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.LeaveConsumerGroupOp != nil {
+		l = m.LeaveConsumerGroupOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ChangeConsumerGroupCoordinatorOp != nil {
+		l = m.ChangeConsumerGroupCoordinatorOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.UpdateStreamOwnerOp != nil {
+		l = m.UpdateStreamOwnerOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ReportPartitionSkewOp != nil {
+		l = m.ReportPartitionSkewOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.RegisterProducerOp != nil {
+		l = m.RegisterProducerOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.ReleaseProducerOp != nil {
+		l = m.ReleaseProducerOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.SetServerRemovedOp != nil {
+		l = m.SetServerRemovedOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.ScheduleOperationOp != nil {
+		l = m.ScheduleOperationOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.CancelScheduledOperationOp != nil {
+		l = m.CancelScheduledOperationOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.ExecuteScheduledOperationOp != nil {
+		l = m.ExecuteScheduledOperationOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.UpdateStreamConfigOp != nil {
+		l = m.UpdateStreamConfigOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.SetStreamTagsOp != nil {
+		l = m.SetStreamTagsOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.DeletePartitionDataOp != nil {
+		l = m.DeletePartitionDataOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.SetStreamACLOp != nil {
+		l = m.SetStreamACLOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.ReplaceReplicaOp != nil {
+		l = m.ReplaceReplicaOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.SetProducerQuotaOp != nil {
+		l = m.SetProducerQuotaOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.DeleteProducerQuotaOp != nil {
+		l = m.DeleteProducerQuotaOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.DeletePartitionOp != nil {
+		l = m.DeletePartitionOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateStreamOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stream != nil {
+		l = m.Stream.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Companions) > 0 {
+		for _, e := range m.Companions {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	l = len(m.RequestID)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetServerRemovedOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Server)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Removed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleOperationOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		l = m.Operation.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelScheduledOperationOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovInternal(uint64(m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExecuteScheduledOperationOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovInternal(uint64(m.Id))
+	}
+	if m.ExecutedAt != 0 {
+		n += 1 + sovInternal(uint64(m.ExecutedAt))
+	}
+	l = len(m.ExecutedBy)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateStreamConfigOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePartitionDataOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovInternal(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePartitionOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetStreamTagsOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetStreamACLOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Acl != nil {
+		l = m.Acl.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplaceReplicaOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	l = len(m.Replica)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Replacement)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProducerQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProducerID)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.BytesPerSecond != 0 {
+		n += 1 + sovInternal(uint64(m.BytesPerSecond))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetProducerQuotaOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteProducerQuotaOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProducerID)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShrinkISROp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	l = len(m.ReplicaToRemove)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Leader)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExpandISROp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	l = len(m.ReplicaToAdd)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Leader)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteStreamOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Cascade {
+		n += 2
+	}
+	if m.TtlDeletedAt != 0 {
+		n += 1 + sovInternal(uint64(m.TtlDeletedAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PauseStreamOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Partitions) > 0 {
+		l = 0
+		for _, e := range m.Partitions {
+			l += sovInternal(uint64(e))
+		}
+		n += 1 + sovInternal(uint64(l)) + l
+	}
+	if m.ResumeAll {
+		n += 2
+	}
+	if m.DrainBeforePause {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResumeStreamOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Partitions) > 0 {
+		l = 0
+		for _, e := range m.Partitions {
+			l += sovInternal(uint64(e))
+		}
+		n += 1 + sovInternal(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportLeaderOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	l = len(m.Replica)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Leader)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeLeaderOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	l = len(m.Leader)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportConsumerGroupCoordinatorOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovInternal(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeConsumerGroupCoordinatorOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateStreamOwnerOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportPartitionSkewOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Skew != nil {
+		l = m.Skew.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegisterProducerOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Producer)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovInternal(uint64(m.Ttl))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovInternal(uint64(m.ExpiresAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReleaseProducerOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Producer)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovInternal(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PublishActivityOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RaftIndex != 0 {
+		n += 1 + sovInternal(uint64(m.RaftIndex))
+	}
+	if m.ActivityEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.ActivityEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetStreamReadonlyOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Partitions) > 0 {
+		l = 0
+		for _, e := range m.Partitions {
+			l += sovInternal(uint64(e))
+		}
+		n += 1 + sovInternal(uint64(l)) + l
+	}
+	if m.Readonly {
+		n += 2
+	}
+	if m.TargetOffset != nil {
+		l = m.TargetOffset.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateConsumerGroupOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsumerGroup != nil {
+		l = m.ConsumerGroup.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JoinConsumerGroupOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Streams) > 0 {
+		for _, s := range m.Streams {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LeaveConsumerGroupOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Expired {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NullableInt64) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovInternal(uint64(m.Value))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NullableInt32) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovInternal(uint64(m.Value))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NullableBool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetentionMaxBytes != nil {
+		l = m.RetentionMaxBytes.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.RetentionMaxMessages != nil {
+		l = m.RetentionMaxMessages.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.RetentionMaxAge != nil {
+		l = m.RetentionMaxAge.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.CleanerInterval != nil {
+		l = m.CleanerInterval.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.SegmentMaxBytes != nil {
+		l = m.SegmentMaxBytes.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.SegmentMaxAge != nil {
+		l = m.SegmentMaxAge.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.CompactMaxGoroutines != nil {
+		l = m.CompactMaxGoroutines.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.CompactEnabled != nil {
+		l = m.CompactEnabled.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.AutoPauseTime != nil {
+		l = m.AutoPauseTime.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.AutoPauseDisableIfSubscribers != nil {
+		l = m.AutoPauseDisableIfSubscribers.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.MinIsr != nil {
+		l = m.MinIsr.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.OptimisticConcurrencyControl != nil {
+		l = m.OptimisticConcurrencyControl.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Encryption != nil {
+		l = m.Encryption.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.SegmentEncryption != nil {
+		l = m.SegmentEncryption.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.DeadLetterQueue)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.MirrorSource != nil {
+		l = m.MirrorSource.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	l = len(m.MessageTimestampType)
+	if l > 0 {
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.MessageTimestampMaxDifference != nil {
+		l = m.MessageTimestampMaxDifference.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.Ttl != nil {
+		l = m.Ttl.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.AutoResumeOnPublish != nil {
+		l = m.AutoResumeOnPublish.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.MaxMessageBytes != nil {
+		l = m.MaxMessageBytes.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.RestoreSource != nil {
+		l = m.RestoreSource.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offsets) > 0 {
+		l = 0
+		for _, e := range m.Offsets {
+			l += sovInternal(uint64(e))
+		}
+		n += 1 + sovInternal(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MirrorSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.StartOffset != 0 {
+		n += 1 + sovInternal(uint64(m.StartOffset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamOrigin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Broker)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.ApplicationName)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.ApplicationVersion)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partitions != 0 {
+		n += 1 + sovInternal(uint64(m.Partitions))
+	}
+	if m.ReplicationFactor != 0 {
+		n += 1 + sovInternal(uint64(m.ReplicationFactor))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Stream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.CreationTimestamp != 0 {
+		n += 1 + sovInternal(uint64(m.CreationTimestamp))
+	}
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Producers) > 0 {
+		for _, e := range m.Producers {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	l = len(m.Primary)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Companions) > 0 {
+		for _, s := range m.Companions {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.Acl != nil {
+		l = m.Acl.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamTag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamTags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamACL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReadPrincipals) > 0 {
+		for _, s := range m.ReadPrincipals {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if len(m.WritePrincipals) > 0 {
+		for _, s := range m.WritePrincipals {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnconfirmedReplicas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	if len(m.Replicas) > 0 {
+		for _, s := range m.Replicas {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompanionStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NameTemplate)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.SubjectTemplate)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partitions != 0 {
+		n += 1 + sovInternal(uint64(m.Partitions))
+	}
+	if m.DeadLetterQueue {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExclusiveProducer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovInternal(uint64(m.Epoch))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovInternal(uint64(m.ExpiresAt))
+	}
+	if m.Released {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Partition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovInternal(uint64(m.Id))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ReplicationFactor != 0 {
+		n += 1 + sovInternal(uint64(m.ReplicationFactor))
+	}
+	if len(m.Replicas) > 0 {
+		for _, s := range m.Replicas {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	l = len(m.Leader)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Isr) > 0 {
+		for _, s := range m.Isr {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovInternal(uint64(m.Epoch))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.Readonly {
+		n += 2
+	}
+	if m.ReadonlyTarget != nil {
+		l = m.ReadonlyTarget.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.PreferredReplicas) > 0 {
+		for _, s := range m.PreferredReplicas {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Consumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Streams) > 0 {
+		for _, s := range m.Streams {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConsumerGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovInternal(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftJoinRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.NodeAddr)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovInternal(uint64(m.Port))
+	}
+	l = len(m.SubjectPrefix)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftJoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetadataSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.ActivityRaftIndex != 0 {
+		n += 1 + sovInternal(uint64(m.ActivityRaftIndex))
+	}
+	if m.ActivityEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.ActivityEpoch))
+	}
+	if len(m.RemovedServers) > 0 {
+		for _, s := range m.RemovedServers {
+			l = len(s)
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if len(m.ScheduledOperations) > 0 {
+		for _, e := range m.ScheduledOperations {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if len(m.ProducerQuotas) > 0 {
+		for _, e := range m.ProducerQuotas {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReplicaID)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovInternal(uint64(m.Offset))
+	}
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+	}
+	if m.Pipelined {
+		n += 2
+	}
+	if m.DeclineSnapshot {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationOffsetOutOfRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+	}
+	if m.LogStartOffset != 0 {
+		n += 1 + sovInternal(uint64(m.LogStartOffset))
+	}
+	if m.HighWatermark != 0 {
+		n += 1 + sovInternal(uint64(m.HighWatermark))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicationSnapshotOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+	}
+	l = len(m.SnapshotID)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Segments) > 0 {
+		for _, e := range m.Segments {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if len(m.EpochOffsets) > 0 {
+		for _, e := range m.EpochOffsets {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.HighWatermark != 0 {
+		n += 1 + sovInternal(uint64(m.HighWatermark))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapshotSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseOffset != 0 {
+		n += 1 + sovInternal(uint64(m.BaseOffset))
+	}
+	if m.LastOffset != 0 {
+		n += 1 + sovInternal(uint64(m.LastOffset))
+	}
+	if m.LogSize != 0 {
+		n += 1 + sovInternal(uint64(m.LogSize))
+	}
+	if m.IndexSize != 0 {
+		n += 1 + sovInternal(uint64(m.IndexSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochOffset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+	}
+	if m.StartOffset != 0 {
+		n += 1 + sovInternal(uint64(m.StartOffset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapshotChunkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReplicaID)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.SnapshotID)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.BaseOffset != 0 {
+		n += 1 + sovInternal(uint64(m.BaseOffset))
+	}
+	if m.File != 0 {
+		n += 1 + sovInternal(uint64(m.File))
+	}
+	if m.Position != 0 {
+		n += 1 + sovInternal(uint64(m.Position))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovInternal(uint64(m.MaxBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapshotChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Last {
+		n += 2
+	}
+	if m.Checksum != 0 {
+		n += 1 + sovInternal(uint64(m.Checksum))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LeaderEpochOffsetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LeaderEpochOffsetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EndOffset != 0 {
+		n += 1 + sovInternal(uint64(m.EndOffset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PropagatedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovInternal(uint64(m.Op))
+	}
+	if m.CreateStreamOp != nil {
+		l = m.CreateStreamOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ShrinkISROp != nil {
+		l = m.ShrinkISROp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ReportLeaderOp != nil {
+		l = m.ReportLeaderOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ExpandISROp != nil {
+		l = m.ExpandISROp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.DeleteStreamOp != nil {
+		l = m.DeleteStreamOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.PauseStreamOp != nil {
+		l = m.PauseStreamOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ResumeStreamOp != nil {
+		l = m.ResumeStreamOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.SetStreamReadonlyOp != nil {
+		l = m.SetStreamReadonlyOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.JoinConsumerGroupOp != nil {
+		l = m.JoinConsumerGroupOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.LeaveConsumerGroupOp != nil {
+		l = m.LeaveConsumerGroupOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ReportConsumerGroupCoordinatorOp != nil {
+		l = m.ReportConsumerGroupCoordinatorOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ChangeLeaderOp != nil {
+		l = m.ChangeLeaderOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.UpdateStreamOwnerOp != nil {
+		l = m.UpdateStreamOwnerOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.RegisterProducerOp != nil {
+		l = m.RegisterProducerOp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ReleaseProducerOp != nil {
+		l = m.ReleaseProducerOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.ScheduleOperationOp != nil {
+		l = m.ScheduleOperationOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.CancelScheduledOperationOp != nil {
+		l = m.CancelScheduledOperationOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.UpdateStreamConfigOp != nil {
+		l = m.UpdateStreamConfigOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.SetStreamTagsOp != nil {
+		l = m.SetStreamTagsOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.DeletePartitionDataOp != nil {
+		l = m.DeletePartitionDataOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.SetStreamACLOp != nil {
+		l = m.SetStreamACLOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.SetProducerQuotaOp != nil {
+		l = m.SetProducerQuotaOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.DeleteProducerQuotaOp != nil {
+		l = m.DeleteProducerQuotaOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.DeletePartitionOp != nil {
+		l = m.DeletePartitionOp.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Error) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovInternal(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PropagatedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovInternal(uint64(m.Op))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.JoinConsumerGroupResp != nil {
+		l = m.JoinConsumerGroupResp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.RegisterProducerResp != nil {
+		l = m.RegisterProducerResp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ScheduleOperationResp != nil {
+		l = m.ScheduleOperationResp.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PropagatedResponse_JoinConsumerGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Coordinator)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovInternal(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PropagatedResponse_RegisterProducerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovInternal(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PropagatedResponse_ScheduleOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != nil {
+		l = m.Operation.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServerInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Rtts) > 0 {
+		for _, e := range m.Rtts {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovInternal(uint64(m.Port))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BrokerRTT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Broker)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Rtt != 0 {
+		n += 1 + sovInternal(uint64(m.Rtt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ServerInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovInternal(uint64(m.Port))
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovInternal(uint64(m.ProtocolVersion))
+	}
+	if len(m.Listeners) > 0 {
+		for _, e := range m.Listeners {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.Draining {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdvertisedListener) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovInternal(uint64(m.Port))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exists {
+		n += 2
+	}
+	if m.IsLeader {
+		n += 2
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionReplicaStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Broker)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovInternal(uint64(m.State))
+	}
+	if m.IsLeader {
+		n += 2
+	}
+	if m.Readonly {
+		n += 2
+	}
+	l = len(m.Leader)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+	}
+	if m.LeaderChangedAt != 0 {
+		n += 1 + sovInternal(uint64(m.LeaderChangedAt))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.LogEndOffset != 0 {
+		n += 1 + sovInternal(uint64(m.LogEndOffset))
+	}
+	if m.LogLeaderEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.LogLeaderEpoch))
+	}
+	if m.HighWatermark != 0 {
+		n += 1 + sovInternal(uint64(m.HighWatermark))
+	}
+	if m.LogStartOffset != 0 {
+		n += 1 + sovInternal(uint64(m.LogStartOffset))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovInternal(uint64(m.SizeBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionCleanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionCleanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionCleanResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Broker)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.IsLeader {
+		n += 2
+	}
+	if m.SegmentsBefore != 0 {
+		n += 1 + sovInternal(uint64(m.SegmentsBefore))
+	}
+	if m.SegmentsAfter != 0 {
+		n += 1 + sovInternal(uint64(m.SegmentsAfter))
+	}
+	if m.BytesReclaimed != 0 {
+		n += 1 + sovInternal(uint64(m.BytesReclaimed))
+	}
+	if m.KeysCompacted != 0 {
+		n += 1 + sovInternal(uint64(m.KeysCompacted))
+	}
+	if m.TombstonesDropped != 0 {
+		n += 1 + sovInternal(uint64(m.TombstonesDropped))
+	}
+	if m.CompactEnabled {
+		n += 2
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.Duration != 0 {
+		n += 1 + sovInternal(uint64(m.Duration))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionNotification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	l = len(m.Leader)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.LeaderEpoch != 0 {
+		n += 1 + sovInternal(uint64(m.LeaderEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KeyCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovInternal(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadAmplification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BytesAppended != 0 {
+		n += 1 + sovInternal(uint64(m.BytesAppended))
+	}
+	if m.TailBytesRead != 0 {
+		n += 1 + sovInternal(uint64(m.TailBytesRead))
+	}
+	if m.HistoricalBytesRead != 0 {
+		n += 1 + sovInternal(uint64(m.HistoricalBytesRead))
+	}
+	if m.ReplicationBytesRead != 0 {
+		n += 1 + sovInternal(uint64(m.ReplicationBytesRead))
+	}
+	if m.ColdSegmentOpens != 0 {
+		n += 1 + sovInternal(uint64(m.ColdSegmentOpens))
+	}
+	if m.Factor != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionLoad) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	if m.MessagesInRate != 0 {
+		n += 1 + sovInternal(uint64(m.MessagesInRate))
+	}
+	if len(m.TopKeys) > 0 {
+		for _, e := range m.TopKeys {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.ReadAmplification != nil {
+		l = m.ReadAmplification.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionLoadReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BootstrapProbe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerID)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamSkew) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.MeanRate != 0 {
+		n += 1 + sovInternal(uint64(m.MeanRate))
+	}
+	if m.MaxRate != 0 {
+		n += 1 + sovInternal(uint64(m.MaxRate))
+	}
+	if m.Variance != 0 {
+		n += 1 + sovInternal(uint64(m.Variance))
+	}
+	if m.Skewed {
+		n += 2
+	}
+	if m.HotPartition != 0 {
+		n += 1 + sovInternal(uint64(m.HotPartition))
+	}
+	if len(m.TopKeys) > 0 {
+		for _, e := range m.TopKeys {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovInternal(uint64(l))
+		}
+	}
+	if m.ReadAmplification != nil {
+		l = m.ReadAmplification.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Cursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovInternal(uint64(m.Partition))
+	}
+	l = len(m.CursorId)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovInternal(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CursorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cursor != nil {
+		l = m.Cursor.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.Set {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CursorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovInternal(uint64(m.Offset))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduledOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovInternal(uint64(m.Id))
+	}
+	if m.Type != 0 {
+		n += 1 + sovInternal(uint64(m.Type))
+	}
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if len(m.Partitions) > 0 {
+		l = 0
+		for _, e := range m.Partitions {
+			l += sovInternal(uint64(e))
+		}
+		n += 1 + sovInternal(uint64(l)) + l
+	}
+	if m.Readonly {
+		n += 2
+	}
+	if m.ExecuteAt != 0 {
+		n += 1 + sovInternal(uint64(m.ExecuteAt))
+	}
+	l = len(m.CreatedBy)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovInternal(uint64(m.CreatedAt))
+	}
+	if m.State != 0 {
+		n += 1 + sovInternal(uint64(m.State))
+	}
+	if m.ExecutedIndex != 0 {
+		n += 1 + sovInternal(uint64(m.ExecutedIndex))
+	}
+	if m.ExecutedAt != 0 {
+		n += 1 + sovInternal(uint64(m.ExecutedAt))
+	}
+	l = len(m.ExecutedBy)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovInternal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInternal(x uint64) (n int) {
+	return sovInternal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ServerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaftLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= Op(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateStreamOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateStreamOp == nil {
+				m.CreateStreamOp = &CreateStreamOp{}
+			}
+			if err := m.CreateStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShrinkISROp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShrinkISROp == nil {
+				m.ShrinkISROp = &ShrinkISROp{}
+			}
+			if err := m.ShrinkISROp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeLeaderOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangeLeaderOp == nil {
+				m.ChangeLeaderOp = &ChangeLeaderOp{}
+			}
+			if err := m.ChangeLeaderOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandISROp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpandISROp == nil {
+				m.ExpandISROp = &ExpandISROp{}
+			}
+			if err := m.ExpandISROp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteStreamOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteStreamOp == nil {
+				m.DeleteStreamOp = &DeleteStreamOp{}
+			}
+			if err := m.DeleteStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseStreamOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseStreamOp == nil {
+				m.PauseStreamOp = &PauseStreamOp{}
+			}
+			if err := m.PauseStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeStreamOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResumeStreamOp == nil {
+				m.ResumeStreamOp = &ResumeStreamOp{}
+			}
+			if err := m.ResumeStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishActivityOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublishActivityOp == nil {
+				m.PublishActivityOp = &PublishActivityOp{}
+			}
+			if err := m.PublishActivityOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetStreamReadonlyOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetStreamReadonlyOp == nil {
+				m.SetStreamReadonlyOp = &SetStreamReadonlyOp{}
+			}
+			if err := m.SetStreamReadonlyOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateConsumerGroupOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateConsumerGroupOp == nil {
+				m.CreateConsumerGroupOp = &CreateConsumerGroupOp{}
+			}
+			if err := m.CreateConsumerGroupOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinConsumerGroupOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JoinConsumerGroupOp == nil {
+				m.JoinConsumerGroupOp = &JoinConsumerGroupOp{}
+			}
+			if err := m.JoinConsumerGroupOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaveConsumerGroupOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LeaveConsumerGroupOp == nil {
+				m.LeaveConsumerGroupOp = &LeaveConsumerGroupOp{}
+			}
+			if err := m.LeaveConsumerGroupOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeConsumerGroupCoordinatorOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangeConsumerGroupCoordinatorOp == nil {
+				m.ChangeConsumerGroupCoordinatorOp = &ChangeConsumerGroupCoordinatorOp{}
+			}
+			if err := m.ChangeConsumerGroupCoordinatorOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStreamOwnerOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateStreamOwnerOp == nil {
+				m.UpdateStreamOwnerOp = &UpdateStreamOwnerOp{}
+			}
+			if err := m.UpdateStreamOwnerOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportPartitionSkewOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReportPartitionSkewOp == nil {
+				m.ReportPartitionSkewOp = &ReportPartitionSkewOp{}
+			}
+			if err := m.ReportPartitionSkewOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterProducerOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegisterProducerOp == nil {
+				m.RegisterProducerOp = &RegisterProducerOp{}
+			}
+			if err := m.RegisterProducerOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseProducerOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReleaseProducerOp == nil {
+				m.ReleaseProducerOp = &ReleaseProducerOp{}
+			}
+			if err := m.ReleaseProducerOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetServerRemovedOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetServerRemovedOp == nil {
+				m.SetServerRemovedOp = &SetServerRemovedOp{}
+			}
+			if err := m.SetServerRemovedOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleOperationOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleOperationOp == nil {
+				m.ScheduleOperationOp = &ScheduleOperationOp{}
+			}
+			if err := m.ScheduleOperationOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelScheduledOperationOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CancelScheduledOperationOp == nil {
+				m.CancelScheduledOperationOp = &CancelScheduledOperationOp{}
+			}
+			if err := m.CancelScheduledOperationOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteScheduledOperationOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecuteScheduledOperationOp == nil {
+				m.ExecuteScheduledOperationOp = &ExecuteScheduledOperationOp{}
+			}
+			if err := m.ExecuteScheduledOperationOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStreamConfigOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateStreamConfigOp == nil {
+				m.UpdateStreamConfigOp = &UpdateStreamConfigOp{}
+			}
+			if err := m.UpdateStreamConfigOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetStreamTagsOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetStreamTagsOp == nil {
+				m.SetStreamTagsOp = &SetStreamTagsOp{}
+			}
+			if err := m.SetStreamTagsOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePartitionDataOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletePartitionDataOp == nil {
+				m.DeletePartitionDataOp = &DeletePartitionDataOp{}
+			}
+			if err := m.DeletePartitionDataOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetStreamACLOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetStreamACLOp == nil {
+				m.SetStreamACLOp = &SetStreamACLOp{}
+			}
+			if err := m.SetStreamACLOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplaceReplicaOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReplaceReplicaOp == nil {
+				m.ReplaceReplicaOp = &ReplaceReplicaOp{}
+			}
+			if err := m.ReplaceReplicaOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetProducerQuotaOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetProducerQuotaOp == nil {
+				m.SetProducerQuotaOp = &SetProducerQuotaOp{}
+			}
+			if err := m.SetProducerQuotaOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteProducerQuotaOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteProducerQuotaOp == nil {
+				m.DeleteProducerQuotaOp = &DeleteProducerQuotaOp{}
+			}
+			if err := m.DeleteProducerQuotaOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePartitionOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletePartitionOp == nil {
+				m.DeletePartitionOp = &DeletePartitionOp{}
+			}
+			if err := m.DeletePartitionOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateStreamOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateStreamOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateStreamOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stream == nil {
+				m.Stream = &Stream{}
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Companions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Companions = append(m.Companions, &Stream{})
+			if err := m.Companions[len(m.Companions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetServerRemovedOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetServerRemovedOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetServerRemovedOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Removed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleOperationOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleOperationOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleOperationOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Operation == nil {
+				m.Operation = &ScheduledOperation{}
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelScheduledOperationOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelScheduledOperationOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelScheduledOperationOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteScheduledOperationOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteScheduledOperationOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteScheduledOperationOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			m.ExecutedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateStreamConfigOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateStreamConfigOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateStreamConfigOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &StreamConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePartitionDataOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePartitionDataOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePartitionDataOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePartitionOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePartitionOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePartitionOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetStreamTagsOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetStreamTagsOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetStreamTagsOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, &StreamTag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetStreamACLOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetStreamACLOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetStreamACLOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acl == nil {
+				m.Acl = &StreamACL{}
+			}
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplaceReplicaOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplaceReplicaOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplaceReplicaOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replica = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replacement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replacement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProducerQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProducerQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProducerQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProducerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesPerSecond", wireType)
+			}
+			m.BytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetProducerQuotaOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetProducerQuotaOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetProducerQuotaOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &ProducerQuota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteProducerQuotaOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteProducerQuotaOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteProducerQuotaOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProducerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShrinkISROp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShrinkISROp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShrinkISROp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicaToRemove = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+			}
+			m.LeaderEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpandISROp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpandISROp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpandISROp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaToAdd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicaToAdd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+			}
+			m.LeaderEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteStreamOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteStreamOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteStreamOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cascade = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TtlDeletedAt", wireType)
+			}
+			m.TtlDeletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TtlDeletedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseStreamOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseStreamOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseStreamOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Partitions = append(m.Partitions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthInternal
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Partitions) == 0 {
+					m.Partitions = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInternal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Partitions = append(m.Partitions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResumeAll = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainBeforePause", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DrainBeforePause = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeStreamOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeStreamOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeStreamOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Partitions = append(m.Partitions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthInternal
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Partitions) == 0 {
+					m.Partitions = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInternal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Partitions = append(m.Partitions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportLeaderOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportLeaderOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportLeaderOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replica = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+			}
+			m.LeaderEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeLeaderOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeLeaderOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeLeaderOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportConsumerGroupCoordinatorOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportConsumerGroupCoordinatorOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportConsumerGroupCoordinatorOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeConsumerGroupCoordinatorOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeConsumerGroupCoordinatorOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeConsumerGroupCoordinatorOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateStreamOwnerOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateStreamOwnerOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateStreamOwnerOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportPartitionSkewOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportPartitionSkewOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportPartitionSkewOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skew", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Skew == nil {
+				m.Skew = &StreamSkew{}
+			}
+			if err := m.Skew.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterProducerOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterProducerOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterProducerOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Producer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Producer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseProducerOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseProducerOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseProducerOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Producer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Producer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishActivityOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishActivityOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishActivityOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftIndex", wireType)
+			}
+			m.RaftIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RaftIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityEpoch", wireType)
+			}
+			m.ActivityEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivityEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetStreamReadonlyOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetStreamReadonlyOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetStreamReadonlyOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Partitions = append(m.Partitions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthInternal
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Partitions) == 0 {
+					m.Partitions = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInternal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Partitions = append(m.Partitions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Readonly = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetOffset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetOffset == nil {
+				m.TargetOffset = &NullableInt64{}
+			}
+			if err := m.TargetOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateConsumerGroupOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateConsumerGroupOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateConsumerGroupOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerGroup == nil {
+				m.ConsumerGroup = &ConsumerGroup{}
+			}
+			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinConsumerGroupOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinConsumerGroupOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinConsumerGroupOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaveConsumerGroupOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaveConsumerGroupOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaveConsumerGroupOp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NullableInt64) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NullableInt64: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NullableInt64: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NullableInt32) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NullableInt32: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NullableInt32: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NullableBool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NullableBool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NullableBool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionMaxBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionMaxBytes == nil {
+				m.RetentionMaxBytes = &NullableInt64{}
+			}
+			if err := m.RetentionMaxBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionMaxMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionMaxMessages == nil {
+				m.RetentionMaxMessages = &NullableInt64{}
+			}
+			if err := m.RetentionMaxMessages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionMaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionMaxAge == nil {
+				m.RetentionMaxAge = &NullableInt64{}
+			}
+			if err := m.RetentionMaxAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanerInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CleanerInterval == nil {
+				m.CleanerInterval = &NullableInt64{}
+			}
+			if err := m.CleanerInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentMaxBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SegmentMaxBytes == nil {
+				m.SegmentMaxBytes = &NullableInt64{}
+			}
+			if err := m.SegmentMaxBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentMaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SegmentMaxAge == nil {
+				m.SegmentMaxAge = &NullableInt64{}
+			}
+			if err := m.SegmentMaxAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactMaxGoroutines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompactMaxGoroutines == nil {
+				m.CompactMaxGoroutines = &NullableInt32{}
+			}
+			if err := m.CompactMaxGoroutines.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompactEnabled == nil {
+				m.CompactEnabled = &NullableBool{}
+			}
+			if err := m.CompactEnabled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPauseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoPauseTime == nil {
+				m.AutoPauseTime = &NullableInt64{}
+			}
+			if err := m.AutoPauseTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPauseDisableIfSubscribers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoPauseDisableIfSubscribers == nil {
+				m.AutoPauseDisableIfSubscribers = &NullableBool{}
+			}
+			if err := m.AutoPauseDisableIfSubscribers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIsr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinIsr == nil {
+				m.MinIsr = &NullableInt32{}
+			}
+			if err := m.MinIsr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticConcurrencyControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptimisticConcurrencyControl == nil {
+				m.OptimisticConcurrencyControl = &NullableBool{}
+			}
+			if err := m.OptimisticConcurrencyControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Encryption == nil {
+				m.Encryption = &NullableBool{}
+			}
+			if err := m.Encryption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentEncryption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SegmentEncryption == nil {
+				m.SegmentEncryption = &NullableBool{}
+			}
+			if err := m.SegmentEncryption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadLetterQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MirrorSource == nil {
+				m.MirrorSource = &MirrorSource{}
+			}
+			if err := m.MirrorSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTimestampType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageTimestampType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTimestampMaxDifference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MessageTimestampMaxDifference == nil {
+				m.MessageTimestampMaxDifference = &NullableInt64{}
+			}
+			if err := m.MessageTimestampMaxDifference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ttl == nil {
+				m.Ttl = &NullableInt64{}
+			}
+			if err := m.Ttl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoResumeOnPublish", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoResumeOnPublish == nil {
+				m.AutoResumeOnPublish = &NullableBool{}
+			}
+			if err := m.AutoResumeOnPublish.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessageBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxMessageBytes == nil {
+				m.MaxMessageBytes = &NullableInt64{}
+			}
+			if err := m.MaxMessageBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestoreSource == nil {
+				m.RestoreSource = &RestoreSource{}
+			}
+			if err := m.RestoreSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Offsets = append(m.Offsets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthInternal
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Offsets) == 0 {
+					m.Offsets = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInternal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Offsets = append(m.Offsets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MirrorSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MirrorSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MirrorSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartOffset", wireType)
+			}
+			m.StartOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Broker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			m.Partitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
+			}
+			m.ReplicationFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationFactor |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &Partition{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &StreamConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+			}
+			m.CreationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &StreamOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Producers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Producers = append(m.Producers, &ExclusiveProducer{})
+			if err := m.Producers[len(m.Producers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Primary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Companions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Companions = append(m.Companions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, &StreamTag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acl == nil {
+				m.Acl = &StreamACL{}
+			}
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamTag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamTag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamTag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamTags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamTags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamTags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, &StreamTag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamACL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamACL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamACL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPrincipals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadPrincipals = append(m.ReadPrincipals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WritePrincipals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WritePrincipals = append(m.WritePrincipals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnconfirmedReplicas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnconfirmedReplicas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnconfirmedReplicas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompanionStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompanionStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompanionStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &StreamConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			m.Partitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterQueue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeadLetterQueue = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExclusiveProducer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExclusiveProducer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExclusiveProducer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Released = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Partition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Partition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Partition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
+			}
+			m.ReplicationFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationFactor |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Isr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Isr = append(m.Isr, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+			}
+			m.LeaderEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Readonly = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadonlyTarget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadonlyTarget == nil {
+				m.ReadonlyTarget = &NullableInt64{}
+			}
+			if err := m.ReadonlyTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredReplicas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreferredReplicas = append(m.PreferredReplicas, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Consumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Consumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Consumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &Consumer{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaftJoinRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftJoinRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftJoinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaftJoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftJoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftJoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, &Stream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Groups = append(m.Groups, &ConsumerGroup{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ServerState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServerState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityRaftIndex", wireType)
+			}
+			m.ActivityRaftIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivityRaftIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityEpoch", wireType)
+			}
+			m.ActivityEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivityEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedServers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6421,7 +25041,75 @@ func (m *ServerState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerID = string(dAtA[iNdEx:postIndex])
+			m.RemovedServers = append(m.RemovedServers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledOperations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledOperations = append(m.ScheduledOperations, &ScheduledOperation{})
+			if err := m.ScheduledOperations[len(m.ScheduledOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProducerQuotas = append(m.ProducerQuotas, &ProducerQuota{})
+			if err := m.ProducerQuotas[len(m.ProducerQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6445,7 +25133,7 @@ func (m *ServerState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RaftLog) Unmarshal(dAtA []byte) error {
+func (m *ReplicationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6468,17 +25156,17 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RaftLog: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftLog: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaID", wireType)
 			}
-			m.Op = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6488,16 +25176,29 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= Op(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicaID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateStreamOp", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var msglen int
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6507,33 +25208,55 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Offset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.LeaderEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.CreateStreamOp == nil {
-				m.CreateStreamOp = &CreateStreamOp{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelined", wireType)
 			}
-			if err := m.CreateStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShrinkISROp", wireType)
+			m.Pipelined = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeclineSnapshot", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6543,33 +25266,68 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
+			m.DeclineSnapshot = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ShrinkISROp == nil {
-				m.ShrinkISROp = &ShrinkISROp{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationOffsetOutOfRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
 			}
-			if err := m.ShrinkISROp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeLeaderOp", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationOffsetOutOfRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationOffsetOutOfRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
 			}
-			var msglen int
+			m.LeaderEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6579,33 +25337,16 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChangeLeaderOp == nil {
-				m.ChangeLeaderOp = &ChangeLeaderOp{}
-			}
-			if err := m.ChangeLeaderOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpandISROp", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStartOffset", wireType)
 			}
-			var msglen int
+			m.LogStartOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6615,33 +25356,16 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LogStartOffset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExpandISROp == nil {
-				m.ExpandISROp = &ExpandISROp{}
-			}
-			if err := m.ExpandISROp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteStreamOp", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWatermark", wireType)
 			}
-			var msglen int
+			m.HighWatermark = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6651,33 +25375,67 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.HighWatermark |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteStreamOp == nil {
-				m.DeleteStreamOp = &DeleteStreamOp{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationSnapshotOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
 			}
-			if err := m.DeleteStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PauseStreamOp", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationSnapshotOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationSnapshotOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+			}
+			m.LeaderEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6687,33 +25445,16 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PauseStreamOp == nil {
-				m.PauseStreamOp = &PauseStreamOp{}
-			}
-			if err := m.PauseStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResumeStreamOp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6723,31 +25464,27 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResumeStreamOp == nil {
-				m.ResumeStreamOp = &ResumeStreamOp{}
-			}
-			if err := m.ResumeStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SnapshotID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublishActivityOp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6774,16 +25511,14 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PublishActivityOp == nil {
-				m.PublishActivityOp = &PublishActivityOp{}
-			}
-			if err := m.PublishActivityOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Segments = append(m.Segments, &SnapshotSegment{})
+			if err := m.Segments[len(m.Segments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetStreamReadonlyOp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochOffsets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6810,18 +25545,16 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SetStreamReadonlyOp == nil {
-				m.SetStreamReadonlyOp = &SetStreamReadonlyOp{}
-			}
-			if err := m.SetStreamReadonlyOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochOffsets = append(m.EpochOffsets, &EpochOffset{})
+			if err := m.EpochOffsets[len(m.EpochOffsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateConsumerGroupOp", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWatermark", wireType)
 			}
-			var msglen int
+			m.HighWatermark = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6831,33 +25564,67 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.HighWatermark |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateConsumerGroupOp == nil {
-				m.CreateConsumerGroupOp = &CreateConsumerGroupOp{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotSegment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
 			}
-			if err := m.CreateConsumerGroupOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinConsumerGroupOp", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotSegment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseOffset", wireType)
+			}
+			m.BaseOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6867,33 +25634,16 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.JoinConsumerGroupOp == nil {
-				m.JoinConsumerGroupOp = &JoinConsumerGroupOp{}
-			}
-			if err := m.JoinConsumerGroupOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				m.BaseOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaveConsumerGroupOp", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOffset", wireType)
 			}
-			var msglen int
+			m.LastOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6903,33 +25653,16 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastOffset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LeaveConsumerGroupOp == nil {
-				m.LeaveConsumerGroupOp = &LeaveConsumerGroupOp{}
-			}
-			if err := m.LeaveConsumerGroupOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeConsumerGroupCoordinatorOp", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogSize", wireType)
 			}
-			var msglen int
+			m.LogSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -6939,28 +25672,30 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LogSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChangeConsumerGroupCoordinatorOp == nil {
-				m.ChangeConsumerGroupCoordinatorOp = &ChangeConsumerGroupCoordinatorOp{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexSize", wireType)
 			}
-			if err := m.ChangeConsumerGroupCoordinatorOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.IndexSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -6983,7 +25718,7 @@ func (m *RaftLog) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateStreamOp) Unmarshal(dAtA []byte) error {
+func (m *EpochOffset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7006,17 +25741,17 @@ func (m *CreateStreamOp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateStreamOp: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochOffset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateStreamOp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochOffset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
 			}
-			var msglen int
+			m.LeaderEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7026,28 +25761,30 @@ func (m *CreateStreamOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stream == nil {
-				m.Stream = &Stream{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartOffset", wireType)
 			}
-			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StartOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -7070,7 +25807,7 @@ func (m *CreateStreamOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShrinkISROp) Unmarshal(dAtA []byte) error {
+func (m *SnapshotChunkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7093,15 +25830,15 @@ func (m *ShrinkISROp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShrinkISROp: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotChunkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShrinkISROp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7129,30 +25866,11 @@ func (m *ShrinkISROp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
+			m.ReplicaID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
-			}
-			m.Partition = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Partition |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaToRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7180,13 +25898,13 @@ func (m *ShrinkISROp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicaToRemove = string(dAtA[iNdEx:postIndex])
+			m.SnapshotID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseOffset", wireType)
 			}
-			var stringLen uint64
+			m.BaseOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7196,29 +25914,35 @@ func (m *ShrinkISROp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BaseOffset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.File = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.File |= SnapshotFileType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Leader = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
-			m.LeaderEpoch = 0
+			m.Position = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7228,7 +25952,26 @@ func (m *ShrinkISROp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				m.Position |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7255,7 +25998,7 @@ func (m *ShrinkISROp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExpandISROp) Unmarshal(dAtA []byte) error {
+func (m *SnapshotChunkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7278,17 +26021,17 @@ func (m *ExpandISROp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExpandISROp: wiretype end group for non-group")
+			return fmt.Errorf("proto: SnapshotChunkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExpandISROp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SnapshotChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7298,29 +26041,31 @@ func (m *ExpandISROp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
 			}
-			m.Partition = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7330,16 +26075,17 @@ func (m *ExpandISROp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partition |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Last = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaToAdd", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var stringLen uint64
+			m.Checksum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7349,27 +26095,14 @@ func (m *ExpandISROp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Checksum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReplicaToAdd = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7397,9 +26130,60 @@ func (m *ExpandISROp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leader = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaderEpochOffsetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaderEpochOffsetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaderEpochOffsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
 			}
@@ -7440,7 +26224,7 @@ func (m *ExpandISROp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteStreamOp) Unmarshal(dAtA []byte) error {
+func (m *LeaderEpochOffsetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7463,17 +26247,17 @@ func (m *DeleteStreamOp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteStreamOp: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaderEpochOffsetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteStreamOp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaderEpochOffsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndOffset", wireType)
 			}
-			var stringLen uint64
+			m.EndOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7483,24 +26267,11 @@ func (m *DeleteStreamOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EndOffset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -7523,7 +26294,7 @@ func (m *DeleteStreamOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PauseStreamOp) Unmarshal(dAtA []byte) error {
+func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7546,17 +26317,36 @@ func (m *PauseStreamOp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PauseStreamOp: wiretype end group for non-group")
+			return fmt.Errorf("proto: PropagatedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseStreamOp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PropagatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= Op(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateStreamOp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7566,105 +26356,69 @@ func (m *PauseStreamOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
+			if m.CreateStreamOp == nil {
+				m.CreateStreamOp = &CreateStreamOp{}
+			}
+			if err := m.CreateStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Partitions = append(m.Partitions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthInternal
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShrinkISROp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Partitions) == 0 {
-					m.Partitions = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowInternal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Partitions = append(m.Partitions, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAll", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShrinkISROp == nil {
+				m.ShrinkISROp = &ShrinkISROp{}
+			}
+			if err := m.ShrinkISROp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportLeaderOp", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7674,68 +26428,33 @@ func (m *PauseStreamOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ResumeAll = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResumeStreamOp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResumeStreamOp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResumeStreamOp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if m.ReportLeaderOp == nil {
+				m.ReportLeaderOp = &ReportLeaderOp{}
+			}
+			if err := m.ReportLeaderOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandISROp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7745,156 +26464,69 @@ func (m *ResumeStreamOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
+			if m.ExpandISROp == nil {
+				m.ExpandISROp = &ExpandISROp{}
+			}
+			if err := m.ExpandISROp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Partitions = append(m.Partitions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthInternal
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteStreamOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Partitions) == 0 {
-					m.Partitions = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowInternal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Partitions = append(m.Partitions, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReportLeaderOp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DeleteStreamOp == nil {
+				m.DeleteStreamOp = &DeleteStreamOp{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DeleteStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReportLeaderOp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportLeaderOp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseStreamOp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7904,29 +26536,33 @@ func (m *ReportLeaderOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
+			if m.PauseStreamOp == nil {
+				m.PauseStreamOp = &PauseStreamOp{}
+			}
+			if err := m.PauseStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeStreamOp", wireType)
 			}
-			m.Partition = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7936,16 +26572,33 @@ func (m *ReportLeaderOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partition |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResumeStreamOp == nil {
+				m.ResumeStreamOp = &ResumeStreamOp{}
+			}
+			if err := m.ResumeStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetStreamReadonlyOp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7955,29 +26608,33 @@ func (m *ReportLeaderOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Replica = string(dAtA[iNdEx:postIndex])
+			if m.SetStreamReadonlyOp == nil {
+				m.SetStreamReadonlyOp = &SetStreamReadonlyOp{}
+			}
+			if err := m.SetStreamReadonlyOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinConsumerGroupOp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -7987,29 +26644,33 @@ func (m *ReportLeaderOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leader = string(dAtA[iNdEx:postIndex])
+			if m.JoinConsumerGroupOp == nil {
+				m.JoinConsumerGroupOp = &JoinConsumerGroupOp{}
+			}
+			if err := m.JoinConsumerGroupOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaveConsumerGroupOp", wireType)
 			}
-			m.LeaderEpoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8019,67 +26680,33 @@ func (m *ReportLeaderOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChangeLeaderOp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.LeaveConsumerGroupOp == nil {
+				m.LeaveConsumerGroupOp = &LeaveConsumerGroupOp{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LeaveConsumerGroupOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeLeaderOp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeLeaderOp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportConsumerGroupCoordinatorOp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8089,29 +26716,33 @@ func (m *ChangeLeaderOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
+			if m.ReportConsumerGroupCoordinatorOp == nil {
+				m.ReportConsumerGroupCoordinatorOp = &ReportConsumerGroupCoordinatorOp{}
+			}
+			if err := m.ReportConsumerGroupCoordinatorOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeLeaderOp", wireType)
 			}
-			m.Partition = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8121,16 +26752,33 @@ func (m *ChangeLeaderOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partition |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangeLeaderOp == nil {
+				m.ChangeLeaderOp = &ChangeLeaderOp{}
+			}
+			if err := m.ChangeLeaderOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStreamOwnerOp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8140,80 +26788,33 @@ func (m *ChangeLeaderOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leader = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReportConsumerGroupCoordinatorOp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.UpdateStreamOwnerOp == nil {
+				m.UpdateStreamOwnerOp = &UpdateStreamOwnerOp{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.UpdateStreamOwnerOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReportConsumerGroupCoordinatorOp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportConsumerGroupCoordinatorOp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterProducerOp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8223,29 +26824,33 @@ func (m *ReportConsumerGroupCoordinatorOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
+			if m.RegisterProducerOp == nil {
+				m.RegisterProducerOp = &RegisterProducerOp{}
+			}
+			if err := m.RegisterProducerOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseProducerOp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8255,29 +26860,33 @@ func (m *ReportConsumerGroupCoordinatorOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			if m.ReleaseProducerOp == nil {
+				m.ReleaseProducerOp = &ReleaseProducerOp{}
+			}
+			if err := m.ReleaseProducerOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleOperationOp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8287,29 +26896,33 @@ func (m *ReportConsumerGroupCoordinatorOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			if m.ScheduleOperationOp == nil {
+				m.ScheduleOperationOp = &ScheduleOperationOp{}
+			}
+			if err := m.ScheduleOperationOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelScheduledOperationOp", wireType)
 			}
-			m.Epoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8319,67 +26932,33 @@ func (m *ReportConsumerGroupCoordinatorOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChangeConsumerGroupCoordinatorOp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.CancelScheduledOperationOp == nil {
+				m.CancelScheduledOperationOp = &CancelScheduledOperationOp{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeConsumerGroupCoordinatorOp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeConsumerGroupCoordinatorOp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.CancelScheduledOperationOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStreamConfigOp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8389,29 +26968,33 @@ func (m *ChangeConsumerGroupCoordinatorOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
+			if m.UpdateStreamConfigOp == nil {
+				m.UpdateStreamConfigOp = &UpdateStreamConfigOp{}
+			}
+			if err := m.UpdateStreamConfigOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetStreamTagsOp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8421,80 +27004,69 @@ func (m *ChangeConsumerGroupCoordinatorOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coordinator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
+			if m.SetStreamTagsOp == nil {
+				m.SetStreamTagsOp = &SetStreamTagsOp{}
+			}
+			if err := m.SetStreamTagsOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInternal
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePartitionDataOp", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PublishActivityOp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.DeletePartitionDataOp == nil {
+				m.DeletePartitionDataOp = &DeletePartitionDataOp{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PublishActivityOp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PublishActivityOp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftIndex", wireType)
+			if err := m.DeletePartitionDataOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RaftIndex = 0
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetStreamACLOp", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8504,67 +27076,33 @@ func (m *PublishActivityOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RaftIndex |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetStreamReadonlyOp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.SetStreamACLOp == nil {
+				m.SetStreamACLOp = &SetStreamACLOp{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SetStreamACLOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetStreamReadonlyOp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetStreamReadonlyOp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetProducerQuotaOp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8574,105 +27112,69 @@ func (m *SetStreamReadonlyOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
+			if m.SetProducerQuotaOp == nil {
+				m.SetProducerQuotaOp = &SetProducerQuotaOp{}
+			}
+			if err := m.SetProducerQuotaOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Partitions = append(m.Partitions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowInternal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthInternal
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthInternal
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteProducerQuotaOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Partitions) == 0 {
-					m.Partitions = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowInternal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Partitions = append(m.Partitions, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteProducerQuotaOp == nil {
+				m.DeleteProducerQuotaOp = &DeleteProducerQuotaOp{}
+			}
+			if err := m.DeleteProducerQuotaOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePartitionOp", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8682,12 +27184,28 @@ func (m *SetStreamReadonlyOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Readonly = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletePartitionOp == nil {
+				m.DeletePartitionOp = &DeletePartitionOp{}
+			}
+			if err := m.DeletePartitionOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -8710,7 +27228,7 @@ func (m *SetStreamReadonlyOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateConsumerGroupOp) Unmarshal(dAtA []byte) error {
+func (m *Error) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8733,17 +27251,36 @@ func (m *CreateConsumerGroupOp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateConsumerGroupOp: wiretype end group for non-group")
+			return fmt.Errorf("proto: Error: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateConsumerGroupOp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8753,27 +27290,23 @@ func (m *CreateConsumerGroupOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerGroup == nil {
-				m.ConsumerGroup = &ConsumerGroup{}
-			}
-			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8797,7 +27330,7 @@ func (m *CreateConsumerGroupOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JoinConsumerGroupOp) Unmarshal(dAtA []byte) error {
+func (m *PropagatedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8820,17 +27353,36 @@ func (m *JoinConsumerGroupOp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JoinConsumerGroupOp: wiretype end group for non-group")
+			return fmt.Errorf("proto: PropagatedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinConsumerGroupOp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PropagatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= Op(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8840,29 +27392,33 @@ func (m *JoinConsumerGroupOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinConsumerGroupResp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8872,29 +27428,33 @@ func (m *JoinConsumerGroupOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			if m.JoinConsumerGroupResp == nil {
+				m.JoinConsumerGroupResp = &PropagatedResponse_JoinConsumerGroupResponse{}
+			}
+			if err := m.JoinConsumerGroupResp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterProducerResp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -8904,23 +27464,63 @@ func (m *JoinConsumerGroupOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Streams = append(m.Streams, string(dAtA[iNdEx:postIndex]))
+			if m.RegisterProducerResp == nil {
+				m.RegisterProducerResp = &PropagatedResponse_RegisterProducerResponse{}
+			}
+			if err := m.RegisterProducerResp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleOperationResp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleOperationResp == nil {
+				m.ScheduleOperationResp = &PropagatedResponse_ScheduleOperationResponse{}
+			}
+			if err := m.ScheduleOperationResp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8944,7 +27544,7 @@ func (m *JoinConsumerGroupOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeaveConsumerGroupOp) Unmarshal(dAtA []byte) error {
+func (m *PropagatedResponse_JoinConsumerGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8967,15 +27567,15 @@ func (m *LeaveConsumerGroupOp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaveConsumerGroupOp: wiretype end group for non-group")
+			return fmt.Errorf("proto: JoinConsumerGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaveConsumerGroupOp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JoinConsumerGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9003,45 +27603,13 @@ func (m *LeaveConsumerGroupOp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupId = string(dAtA[iNdEx:postIndex])
+			m.Coordinator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var v int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9051,12 +27619,11 @@ func (m *LeaveConsumerGroupOp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Expired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -9079,7 +27646,7 @@ func (m *LeaveConsumerGroupOp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NullableInt64) Unmarshal(dAtA []byte) error {
+func (m *PropagatedResponse_RegisterProducerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9102,17 +27669,17 @@ func (m *NullableInt64) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NullableInt64: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterProducerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NullableInt64: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterProducerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.Value = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9122,7 +27689,7 @@ func (m *NullableInt64) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9149,7 +27716,7 @@ func (m *NullableInt64) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NullableInt32) Unmarshal(dAtA []byte) error {
+func (m *PropagatedResponse_ScheduleOperationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9172,17 +27739,17 @@ func (m *NullableInt32) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NullableInt32: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleOperationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NullableInt32: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			m.Value = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9192,11 +27759,28 @@ func (m *NullableInt32) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Operation == nil {
+				m.Operation = &ScheduledOperation{}
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -9219,7 +27803,7 @@ func (m *NullableInt32) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NullableBool) Unmarshal(dAtA []byte) error {
+func (m *ServerInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9242,17 +27826,115 @@ func (m *NullableBool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NullableBool: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServerInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NullableBool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServerInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rtts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rtts = append(m.Rtts, &BrokerRTT{})
+			if err := m.Rtts[len(m.Rtts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			var v int
+			m.Port = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9262,12 +27944,11 @@ func (m *NullableBool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Port |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Value = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -9290,7 +27971,7 @@ func (m *NullableBool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamConfig) Unmarshal(dAtA []byte) error {
+func (m *BrokerRTT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9313,17 +27994,17 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: BrokerRTT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BrokerRTT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetentionMaxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9333,33 +28014,29 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetentionMaxBytes == nil {
-				m.RetentionMaxBytes = &NullableInt64{}
-			}
-			if err := m.RetentionMaxBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Broker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetentionMaxMessages", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rtt", wireType)
 			}
-			var msglen int
+			m.Rtt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9369,69 +28046,67 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rtt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetentionMaxMessages == nil {
-				m.RetentionMaxMessages = &NullableInt64{}
-			}
-			if err := m.RetentionMaxMessages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetentionMaxAge", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServerInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetentionMaxAge == nil {
-				m.RetentionMaxAge = &NullableInt64{}
-			}
-			if err := m.RetentionMaxAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServerInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServerInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CleanerInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9441,33 +28116,29 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CleanerInterval == nil {
-				m.CleanerInterval = &NullableInt64{}
-			}
-			if err := m.CleanerInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SegmentMaxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9477,33 +28148,29 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SegmentMaxBytes == nil {
-				m.SegmentMaxBytes = &NullableInt64{}
-			}
-			if err := m.SegmentMaxBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SegmentMaxAge", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			var msglen int
+			m.Port = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9513,31 +28180,33 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Port |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SegmentMaxAge == nil {
-				m.SegmentMaxAge = &NullableInt64{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
 			}
-			if err := m.SegmentMaxAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactMaxGoroutines", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Listeners", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9564,18 +28233,16 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompactMaxGoroutines == nil {
-				m.CompactMaxGoroutines = &NullableInt32{}
-			}
-			if err := m.CompactMaxGoroutines.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Listeners = append(m.Listeners, &AdvertisedListener{})
+			if err := m.Listeners[len(m.Listeners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactEnabled", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Draining", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9585,33 +28252,68 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
+			m.Draining = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompactEnabled == nil {
-				m.CompactEnabled = &NullableBool{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdvertisedListener) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
 			}
-			if err := m.CompactEnabled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdvertisedListener: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdvertisedListener: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoPauseTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9621,33 +28323,29 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AutoPauseTime == nil {
-				m.AutoPauseTime = &NullableInt64{}
-			}
-			if err := m.AutoPauseTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoPauseDisableIfSubscribers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9657,33 +28355,29 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AutoPauseDisableIfSubscribers == nil {
-				m.AutoPauseDisableIfSubscribers = &NullableBool{}
-			}
-			if err := m.AutoPauseDisableIfSubscribers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinIsr", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			var msglen int
+			m.Port = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9693,33 +28387,67 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Port |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MinIsr == nil {
-				m.MinIsr = &NullableInt32{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
 			}
-			if err := m.MinIsr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticConcurrencyControl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9729,33 +28457,29 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OptimisticConcurrencyControl == nil {
-				m.OptimisticConcurrencyControl = &NullableBool{}
-			}
-			if err := m.OptimisticConcurrencyControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
 			}
-			var msglen int
+			m.Partition = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9765,28 +28489,11 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Partition |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Encryption == nil {
-				m.Encryption = &NullableBool{}
-			}
-			if err := m.Encryption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -9809,7 +28516,7 @@ func (m *StreamConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Stream) Unmarshal(dAtA []byte) error {
+func (m *PartitionStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9832,17 +28539,17 @@ func (m *Stream) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Stream: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Stream: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9852,29 +28559,17 @@ func (m *Stream) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Exists = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLeader", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -9884,61 +28579,15 @@ func (m *Stream) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsLeader = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Partitions = append(m.Partitions, &Partition{})
-			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9965,32 +28614,13 @@ func (m *Stream) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &StreamConfig{}
+			if m.Status == nil {
+				m.Status = &PartitionReplicaStatus{}
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
-			}
-			m.CreationTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -10013,7 +28643,7 @@ func (m *Stream) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Partition) Unmarshal(dAtA []byte) error {
+func (m *PartitionReplicaStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10036,15 +28666,15 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Partition: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionReplicaStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Partition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionReplicaStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10072,13 +28702,13 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.Broker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10088,29 +28718,16 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.State |= PartitionState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLeader", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10120,14 +28737,35 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsLeader = bool(v != 0)
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Readonly = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10155,13 +28793,13 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Leader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
 			}
-			m.ReplicationFactor = 0
+			m.LeaderEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10171,16 +28809,16 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReplicationFactor |= int32(b&0x7F) << shift
+				m.LeaderEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderChangedAt", wireType)
 			}
-			var stringLen uint64
+			m.LeaderChangedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10190,27 +28828,14 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LeaderChangedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Replicas = append(m.Replicas, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10238,13 +28863,13 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leader = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Isr", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogEndOffset", wireType)
 			}
-			var stringLen uint64
+			m.LogEndOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10254,29 +28879,16 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LogEndOffset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Isr = append(m.Isr, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLeaderEpoch", wireType)
 			}
-			m.LeaderEpoch = 0
+			m.LogLeaderEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10286,16 +28898,16 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				m.LogLeaderEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWatermark", wireType)
 			}
-			m.Epoch = 0
+			m.HighWatermark = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10305,16 +28917,16 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.HighWatermark |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStartOffset", wireType)
 			}
-			var v int
+			m.LogStartOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10324,17 +28936,16 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.LogStartOffset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Paused = bool(v != 0)
-		case 12:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			var v int
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10344,12 +28955,11 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Readonly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -10372,7 +28982,7 @@ func (m *Partition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Consumer) Unmarshal(dAtA []byte) error {
+func (m *PartitionCleanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10395,15 +29005,15 @@ func (m *Consumer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Consumer: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionCleanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Consumer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionCleanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10431,13 +29041,83 @@ func (m *Consumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Stream = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionCleanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionCleanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionCleanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10447,23 +29127,27 @@ func (m *Consumer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Streams = append(m.Streams, string(dAtA[iNdEx:postIndex]))
+			if m.Result == nil {
+				m.Result = &PartitionCleanResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10487,7 +29171,7 @@ func (m *Consumer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
+func (m *PartitionCleanResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10510,15 +29194,15 @@ func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionCleanResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionCleanResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Broker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10546,13 +29230,13 @@ func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Broker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLeader", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10562,31 +29246,17 @@ func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Members = append(m.Members, &Consumer{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsLeader = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentsBefore", wireType)
 			}
-			var stringLen uint64
+			m.SegmentsBefore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10596,29 +29266,16 @@ func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SegmentsBefore |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Coordinator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentsAfter", wireType)
 			}
-			m.Epoch = 0
+			m.SegmentsAfter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10628,67 +29285,73 @@ func (m *ConsumerGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.SegmentsAfter |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesReclaimed", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInternal
+			m.BytesReclaimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesReclaimed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysCompacted", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RaftJoinRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
+			m.KeysCompacted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysCompacted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TombstonesDropped", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TombstonesDropped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TombstonesDropped |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RaftJoinRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftJoinRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10698,29 +29361,17 @@ func (m *RaftJoinRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddr", wireType)
+			m.CompactEnabled = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10730,76 +29381,32 @@ func (m *RaftJoinRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RaftJoinResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Paused = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RaftJoinResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftJoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
@@ -10853,7 +29460,7 @@ func (m *RaftJoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetadataSnapshot) Unmarshal(dAtA []byte) error {
+func (m *PartitionNotification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10876,17 +29483,17 @@ func (m *MetadataSnapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetadataSnapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionNotification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetadataSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionNotification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10896,31 +29503,29 @@ func (m *MetadataSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Streams = append(m.Streams, &Stream{})
-			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
 			}
-			var msglen int
+			m.Partition = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -10930,26 +29535,11 @@ func (m *MetadataSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Partition |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Groups = append(m.Groups, &ConsumerGroup{})
-			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -10972,7 +29562,7 @@ func (m *MetadataSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicationRequest) Unmarshal(dAtA []byte) error {
+func (m *PartitionStarted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10995,15 +29585,15 @@ func (m *ReplicationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionStarted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionStarted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11031,13 +29621,13 @@ func (m *ReplicationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicaID = string(dAtA[iNdEx:postIndex])
+			m.Stream = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
 			}
-			m.Offset = 0
+			m.Partition = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11047,12 +29637,44 @@ func (m *ReplicationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int64(b&0x7F) << shift
+				m.Partition |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
 			}
@@ -11093,7 +29715,7 @@ func (m *ReplicationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LeaderEpochOffsetRequest) Unmarshal(dAtA []byte) error {
+func (m *KeyCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11116,17 +29738,17 @@ func (m *LeaderEpochOffsetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LeaderEpochOffsetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyCount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaderEpochOffsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyCount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.LeaderEpoch = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11136,67 +29758,31 @@ func (m *LeaderEpochOffsetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LeaderEpoch |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LeaderEpochOffsetResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LeaderEpochOffsetResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LeaderEpochOffsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.EndOffset = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11206,7 +29792,7 @@ func (m *LeaderEpochOffsetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndOffset |= int64(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11233,7 +29819,7 @@ func (m *LeaderEpochOffsetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
+func (m *ReadAmplification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11256,17 +29842,17 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PropagatedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadAmplification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PropagatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadAmplification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesAppended", wireType)
 			}
-			m.Op = 0
+			m.BytesAppended = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11276,16 +29862,16 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= Op(b&0x7F) << shift
+				m.BytesAppended |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateStreamOp", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TailBytesRead", wireType)
 			}
-			var msglen int
+			m.TailBytesRead = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11295,33 +29881,16 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TailBytesRead |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CreateStreamOp == nil {
-				m.CreateStreamOp = &CreateStreamOp{}
-			}
-			if err := m.CreateStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShrinkISROp", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalBytesRead", wireType)
 			}
-			var msglen int
+			m.HistoricalBytesRead = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11331,33 +29900,16 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.HistoricalBytesRead |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ShrinkISROp == nil {
-				m.ShrinkISROp = &ShrinkISROp{}
-			}
-			if err := m.ShrinkISROp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportLeaderOp", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationBytesRead", wireType)
 			}
-			var msglen int
+			m.ReplicationBytesRead = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11367,33 +29919,16 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ReplicationBytesRead |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ReportLeaderOp == nil {
-				m.ReportLeaderOp = &ReportLeaderOp{}
-			}
-			if err := m.ReportLeaderOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpandISROp", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColdSegmentOpens", wireType)
 			}
-			var msglen int
+			m.ColdSegmentOpens = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11403,33 +29938,78 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ColdSegmentOpens |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Factor = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthInternal
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpandISROp == nil {
-				m.ExpandISROp = &ExpandISROp{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionLoad) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
 			}
-			if err := m.ExpandISROp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionLoad: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionLoad: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteStreamOp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11439,33 +30019,29 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteStreamOp == nil {
-				m.DeleteStreamOp = &DeleteStreamOp{}
-			}
-			if err := m.DeleteStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PauseStreamOp", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
 			}
-			var msglen int
+			m.Partition = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11475,33 +30051,16 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Partition |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PauseStreamOp == nil {
-				m.PauseStreamOp = &PauseStreamOp{}
-			}
-			if err := m.PauseStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResumeStreamOp", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesInRate", wireType)
 			}
-			var msglen int
+			m.MessagesInRate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11511,31 +30070,14 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MessagesInRate |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResumeStreamOp == nil {
-				m.ResumeStreamOp = &ResumeStreamOp{}
-			}
-			if err := m.ResumeStreamOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetStreamReadonlyOp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11562,16 +30104,14 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SetStreamReadonlyOp == nil {
-				m.SetStreamReadonlyOp = &SetStreamReadonlyOp{}
-			}
-			if err := m.SetStreamReadonlyOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopKeys = append(m.TopKeys, &KeyCount{})
+			if err := m.TopKeys[len(m.TopKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinConsumerGroupOp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadAmplification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11598,18 +30138,69 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JoinConsumerGroupOp == nil {
-				m.JoinConsumerGroupOp = &JoinConsumerGroupOp{}
+			if m.ReadAmplification == nil {
+				m.ReadAmplification = &ReadAmplification{}
 			}
-			if err := m.JoinConsumerGroupOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReadAmplification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInternal(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionLoadReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionLoadReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionLoadReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaveConsumerGroupOp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11619,31 +30210,27 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LeaveConsumerGroupOp == nil {
-				m.LeaveConsumerGroupOp = &LeaveConsumerGroupOp{}
-			}
-			if err := m.LeaveConsumerGroupOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportConsumerGroupCoordinatorOp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11670,10 +30257,8 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReportConsumerGroupCoordinatorOp == nil {
-				m.ReportConsumerGroupCoordinatorOp = &ReportConsumerGroupCoordinatorOp{}
-			}
-			if err := m.ReportConsumerGroupCoordinatorOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Partitions = append(m.Partitions, &PartitionLoad{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11699,7 +30284,7 @@ func (m *PropagatedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Error) Unmarshal(dAtA []byte) error {
+func (m *BootstrapProbe) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11722,34 +30307,15 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+			return fmt.Errorf("proto: BootstrapProbe: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BootstrapProbe: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11777,7 +30343,7 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.ServerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11801,7 +30367,7 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PropagatedResponse) Unmarshal(dAtA []byte) error {
+func (m *StreamSkew) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11824,17 +30390,17 @@ func (m *PropagatedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PropagatedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamSkew: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PropagatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamSkew: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			m.Op = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -11844,14 +30410,123 @@ func (m *PropagatedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= Op(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeanRate", wireType)
+			}
+			m.MeanRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MeanRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			m.MaxRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variance", wireType)
+			}
+			m.Variance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Variance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skewed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skewed = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotPartition", wireType)
+			}
+			m.HotPartition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HotPartition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11878,16 +30553,48 @@ func (m *PropagatedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
+			m.TopKeys = append(m.TopKeys, &KeyCount{})
+			if err := m.TopKeys[len(m.TopKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &PartitionLoad{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinConsumerGroupResp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadAmplification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11914,10 +30621,10 @@ func (m *PropagatedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JoinConsumerGroupResp == nil {
-				m.JoinConsumerGroupResp = &PropagatedResponse_JoinConsumerGroupResponse{}
+			if m.ReadAmplification == nil {
+				m.ReadAmplification = &ReadAmplification{}
 			}
-			if err := m.JoinConsumerGroupResp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReadAmplification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11943,7 +30650,7 @@ func (m *PropagatedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PropagatedResponse_JoinConsumerGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *Cursor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11953,28 +30660,79 @@ func (m *PropagatedResponse_JoinConsumerGroupResponse) Unmarshal(dAtA []byte) er
 			if shift >= 64 {
 				return ErrIntOverflowInternal
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JoinConsumerGroupResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinConsumerGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CursorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12002,13 +30760,13 @@ func (m *PropagatedResponse_JoinConsumerGroupResponse) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coordinator = string(dAtA[iNdEx:postIndex])
+			m.CursorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.Epoch = 0
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12018,7 +30776,7 @@ func (m *PropagatedResponse_JoinConsumerGroupResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.Offset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12045,7 +30803,7 @@ func (m *PropagatedResponse_JoinConsumerGroupResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *ServerInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *CursorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12068,17 +30826,17 @@ func (m *ServerInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServerInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CursorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServerInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CursorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12088,24 +30846,48 @@ func (m *ServerInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Cursor == nil {
+				m.Cursor = &Cursor{}
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Set = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -12128,7 +30910,7 @@ func (m *ServerInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServerInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *CursorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12151,17 +30933,17 @@ func (m *ServerInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServerInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CursorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServerInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CursorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var stringLen uint64
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12171,29 +30953,16 @@ func (m *ServerInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Offset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12203,43 +30972,28 @@ func (m *ServerInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInternal
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInternal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Host = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			if m.Error == nil {
+				m.Error = &Error{}
 			}
-			m.Port = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Port |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -12262,7 +31016,7 @@ func (m *ServerInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PartitionStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *ScheduledOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12285,13 +31039,51 @@ func (m *PartitionStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PartitionStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PartitionStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ScheduledOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
@@ -12323,79 +31115,85 @@ func (m *PartitionStatusRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Stream = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
-			}
-			m.Partition = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowInternal
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Partitions = append(m.Partitions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowInternal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthInternal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthInternal
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Partition |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Partitions) == 0 {
+					m.Partitions = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowInternal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Partitions = append(m.Partitions, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PartitionStatusResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PartitionStatusResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PartitionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12412,12 +31210,12 @@ func (m *PartitionStatusResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Exists = bool(v != 0)
-		case 2:
+			m.Readonly = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAt", wireType)
 			}
-			var v int
+			m.ExecuteAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12427,66 +31225,14 @@ func (m *PartitionStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExecuteAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsLeader = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PartitionNotification) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PartitionNotification: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PartitionNotification: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12514,13 +31260,13 @@ func (m *PartitionNotification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			m.Partition = 0
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12530,67 +31276,16 @@ func (m *PartitionNotification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partition |= int32(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInternal(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInternal
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Cursor) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInternal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Cursor: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cursor: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12600,29 +31295,35 @@ func (m *Cursor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.State |= ScheduledOperationState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInternal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInternal
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedIndex", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ExecutedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Stream = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
 			}
-			m.Partition = 0
+			m.ExecutedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12632,14 +31333,14 @@ func (m *Cursor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partition |= int32(b&0x7F) << shift
+				m.ExecutedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CursorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12667,13 +31368,13 @@ func (m *Cursor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CursorId = string(dAtA[iNdEx:postIndex])
+			m.ExecutedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.Offset = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInternal
@@ -12683,11 +31384,24 @@ func (m *Cursor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInternal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInternal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInternal(dAtA[iNdEx:])
@@ -106,6 +106,7 @@ type raftNode struct {
 	logInput  io.WriteCloser
 	joinSub   *nats.Subscription
 	notifyCh  <-chan bool
+	contacts  *raftContactTracker
 }
 
 // isLeader indicates if the Raft node is currently the leader.
@@ -180,6 +181,9 @@ func (r *raftNode) shutdown() error {
 	}
 	r.closed = true
 	r.Unlock()
+	if r.contacts != nil {
+		r.contacts.close(r.Raft)
+	}
 	if r.Raft != nil {
 		r.Raft.Shutdown()
 	}
@@ -262,20 +266,27 @@ func (s *Server) setupMetadataRaft() (*raftNode, error) {
 		s.logger.Debug("Successfully bootstrapped metadata Raft group")
 	} else if !existingState {
 		// Attempt to join the cluster if we're not bootstrapping.
+		address := s.getConnectionAddress()
 		req, err := proto.MarshalRaftJoinRequest(&proto.RaftJoinRequest{
-			NodeID:   s.config.Clustering.ServerID,
-			NodeAddr: s.config.Clustering.ServerID, // NATS transport uses ID for addr.
+			NodeID:        s.config.Clustering.ServerID,
+			NodeAddr:      s.config.Clustering.ServerID, // NATS transport uses ID for addr.
+			Host:          address.Host,
+			Port:          int32(address.Port),
+			SubjectPrefix: s.getSubjectPrefix(),
 		})
 		if err != nil {
 			panic(err)
 		}
-		joined := false
+		var (
+			joined   = false
+			rejected string
+		)
 		// Attempt to join for up to 30 seconds before giving up.
 		for i := 0; i < raftJoinAttempts; i++ {
 			s.logger.Debug("Attempting to join metadata Raft group...")
-			r, err := s.ncRaft.Request(fmt.Sprintf("%s.join", s.baseMetadataRaftSubject()),
-				req, defaultJoinRaftGroupTimeout)
-			if err != nil {
+			r, err := s.ncRaft.Request(fmt.Sprintf("%s.join", s.baseClusterRaftSubject()),
+				s.auth.sign(req), defaultJoinRaftGroupTimeout)
+			if err != nil || !s.auth.verify(r.Subject, r.Data) {
 				time.Sleep(time.Second)
 				continue
 			}
@@ -285,6 +296,8 @@ func (s *Server) setupMetadataRaft() (*raftNode, error) {
 				continue
 			}
 			if resp.Error != "" {
+				s.logger.Warnf("Request to join metadata Raft group rejected: %s", resp.Error)
+				rejected = resp.Error
 				time.Sleep(time.Second)
 				continue
 			}
@@ -295,6 +308,9 @@ func (s *Server) setupMetadataRaft() (*raftNode, error) {
 			s.logger.Debug("Successfully joined metadata Raft group")
 		} else {
 			node.shutdown()
+			if rejected != "" {
+				return nil, fmt.Errorf("failed to join metadata Raft group: %s", rejected)
+			}
 			return nil, errors.New("failed to join metadata Raft group")
 		}
 	}
@@ -379,22 +395,30 @@ func (s *Server) bootstrapCluster(node *raft.Raft) error {
 
 // detectBootstrapMisconfig attempts to detect if any other servers were
 // started in bootstrap seed mode. If any are detected, the server will panic
-// since this is a fatal state.
+// since this is a fatal state. Probes are authenticated like other internal
+// messages since a forged probe would otherwise stop the server.
 func (s *Server) detectBootstrapMisconfig() {
-	srvID := []byte(s.config.Clustering.ServerID)
-	subj := fmt.Sprintf("%s.bootstrap", s.baseMetadataRaftSubject())
+	probe, err := proto.MarshalBootstrapProbe(&proto.BootstrapProbe{
+		ServerID: s.config.Clustering.ServerID,
+	})
+	if err != nil {
+		panic(err)
+	}
+	probe = s.auth.sign(probe)
+	subj := fmt.Sprintf("%s.bootstrap", s.baseClusterRaftSubject())
 	s.ncRaft.Subscribe(subj, func(m *nats.Msg) {
-		if m.Data != nil {
-			// Ignore message to ourself
-			if string(m.Data) != s.config.Clustering.ServerID {
-				m.Respond(srvID)
-				s.logger.Fatalf("Server %s was also started with raft.bootstrap.seed", string(m.Data))
-			}
+		id, ok := s.bootstrapProbeServer(m)
+		// Ignore message to ourself
+		if ok && id != s.config.Clustering.ServerID {
+			m.Respond(probe)
+			s.logger.Fatalf("Server %s was also started with raft.bootstrap.seed", id)
 		}
 	})
-	inbox := fmt.Sprintf("%s.bootstrap.reply", s.baseMetadataRaftSubject())
+	inbox := fmt.Sprintf("%s.bootstrap.reply", s.baseClusterRaftSubject())
 	s.ncRaft.Subscribe(inbox, func(m *nats.Msg) {
-		s.logger.Fatalf("Server %s was also started with raft.bootstrap.seed", string(m.Data))
+		if id, ok := s.bootstrapProbeServer(m); ok {
+			s.logger.Fatalf("Server %s was also started with raft.bootstrap.seed", id)
+		}
 	})
 	if err := s.ncRaft.Flush(); err != nil {
 		s.logger.Errorf("Error setting up bootstrap misconfiguration detection: %v", err)
@@ -407,13 +431,35 @@ func (s *Server) detectBootstrapMisconfig() {
 		case <-s.shutdownCh:
 			return
 		case <-ticker.C:
-			if err := s.ncRaft.PublishRequest(subj, inbox, srvID); err != nil {
+			if err := s.ncRaft.PublishRequest(subj, inbox, probe); err != nil {
 				s.logger.Errorf("Error publishing bootstrap misconfiguration detection message: %v", err)
 			}
 		}
 	}
 }
 
+// bootstrapProbeServer returns the ID of the server which sent the given
+// bootstrap probe or reply and false if it can't be trusted. Servers which
+// predate authenticated probes send their bare ID, which is only trusted when
+// unsigned messages are.
+func (s *Server) bootstrapProbeServer(m *nats.Msg) (string, bool) {
+	if len(m.Data) == 0 {
+		return "", false
+	}
+	probe, err := proto.UnmarshalBootstrapProbe(m.Data)
+	if err != nil {
+		if s.auth.enabled() && s.auth.strict {
+			s.auth.drop(m.Subject, errUnsignedEnvelope)
+			return "", false
+		}
+		return string(m.Data), true
+	}
+	if !s.auth.verify(m.Subject, m.Data) {
+		return "", false
+	}
+	return probe.ServerID, true
+}
+
 // createRaftNode creates and starts an embedded Raft node for replicating
 // cluster metadata. It returns a bool indicating if the Raft node had existing
 // state that was loaded.
@@ -482,7 +528,7 @@ func (s *Server) createRaftNode() (*raftNode, bool, error) {
 	}
 
 	// Handle requests to join the cluster.
-	subj := fmt.Sprintf("%s.join", s.baseMetadataRaftSubject())
+	subj := fmt.Sprintf("%s.join", s.baseClusterRaftSubject())
 	sub, err := s.ncRaft.Subscribe(subj, s.newClusterJoinRequestHandler(node))
 	if err != nil {
 		node.Shutdown()
@@ -498,6 +544,7 @@ func (s *Server) createRaftNode() (*raftNode, bool, error) {
 		logInput:  logWriter,
 		notifyCh:  raftNotifyCh,
 		joinSub:   sub,
+		contacts:  newRaftContactTracker(node),
 	}
 	s.setRaft(raftNode)
 
@@ -515,6 +562,9 @@ func (s *Server) newClusterJoinRequestHandler(node *raft.Raft) func(*nats.Msg) {
 		if node.State() != raft.Leader {
 			return
 		}
+		if !s.auth.verify(msg.Subject, msg.Data) {
+			return
+		}
 		req, err := proto.UnmarshalRaftJoinRequest(msg.Data)
 		if err != nil {
 			s.logger.Warn("Invalid join request for metadata Raft group")
@@ -523,20 +573,31 @@ func (s *Server) newClusterJoinRequestHandler(node *raft.Raft) func(*nats.Msg) {
 
 		resp := &proto.RaftJoinResponse{}
 
+		// Reject servers using a different subject prefix or the ID of
+		// another server.
+		joinErr := s.checkJoinSubjectPrefix(req)
+		if joinErr == nil {
+			joinErr = s.checkJoinServerID(node, req)
+		}
+
 		// No-op if the request came from ourselves.
-		if req.NodeID == s.config.Clustering.ServerID {
+		if joinErr == nil && req.NodeID == s.config.Clustering.ServerID {
 			r, err := proto.MarshalRaftJoinResponse(resp)
 			if err != nil {
 				panic(err)
 			}
-			msg.Respond(r)
+			msg.Respond(s.auth.sign(r))
 			return
 		}
 
 		// Add the node to the cluster with appropriate suffrage. This is
-		// idempotent.
-		isVoter, err := s.addAsVoter(node)
-		if err != nil {
+		// idempotent. Servers which were removed must be added back
+		// explicitly.
+		if joinErr != nil {
+			resp.Error = joinErr.Error()
+		} else if s.metadata.IsServerRemoved(req.NodeID) {
+			resp.Error = ErrServerRemoved.Error()
+		} else if isVoter, err := s.addAsVoter(node); err != nil {
 			resp.Error = err.Error()
 		} else {
 			var future raft.IndexFuture
@@ -565,7 +626,7 @@ func (s *Server) newClusterJoinRequestHandler(node *raft.Raft) func(*nats.Msg) {
 		if err != nil {
 			panic(err)
 		}
-		msg.Respond(r)
+		msg.Respond(s.auth.sign(r))
 	}
 }
 
@@ -596,6 +657,15 @@ func (s *Server) addAsVoter(node *raft.Raft) (bool, error) {
 // baseMetadataRaftSubject returns the base NATS subject used for Raft-related
 // operations.
 func (s *Server) baseMetadataRaftSubject() string {
+	return fmt.Sprintf("%s.raft.metadata", s.getSubjectPrefix())
+}
+
+// baseClusterRaftSubject returns the base NATS subject used for joining the
+// metadata Raft group and detecting bootstrap misconfigurations. Unlike other
+// internal subjects, this is scoped by the namespace rather than the subject
+// prefix so that servers configured with a different prefix can be told why
+// they can't join.
+func (s *Server) baseClusterRaftSubject() string {
 	return fmt.Sprintf("%s.raft.metadata", s.config.Clustering.Namespace)
 }
 
@@ -0,0 +1,237 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// reassignmentReplicating is the phase of a reassignment while a target
+	// replica is catching up to the leader and isn't in the ISR yet.
+	reassignmentReplicating = "replicating"
+
+	// reassignmentElectingLeader is the phase of a reassignment once every
+	// target replica is in the ISR but the partition leader isn't one of the
+	// targets.
+	reassignmentElectingLeader = "electing_leader"
+
+	// reassignmentDone is the phase of a reassignment once every target
+	// replica is in the ISR and one of them leads the partition.
+	reassignmentDone = "done"
+)
+
+// ReassignmentStatus is the progress of moving a partition to a new set of
+// replicas.
+type ReassignmentStatus struct {
+	TargetReplicas []string  // Replicas the partition is being moved to
+	CurrentISR     []string  // Replicas currently in the ISR
+	BytesRemaining int64     // Estimated bytes the targets have yet to replicate, -1 if unknown
+	StartedAt      time.Time // When the reassignment was applied by this server
+	Phase          string
+}
+
+// reassignmentTracker tracks the reassignments of partition replicas, i.e.
+// replicas replaced by replica repair, as they are applied to the metadata so
+// their progress can be reported. Each reassignment is kept until its
+// partition is deleted so its status remains available once it's done.
+type reassignmentTracker struct {
+	mu            sync.Mutex
+	reassignments map[string]*ReassignmentStatus // Keyed by partitionKey
+}
+
+func newReassignmentTracker() *reassignmentTracker {
+	return &reassignmentTracker{reassignments: make(map[string]*ReassignmentStatus)}
+}
+
+// started records a reassignment of the given partition to its current
+// replicas. If a reassignment of the partition is already in progress, it's
+// retargeted but keeps its start time.
+func (r *reassignmentTracker) started(partition *partition, now time.Time) {
+	targets := partition.GetReplicas()
+	sort.Strings(targets)
+	leader, _ := partition.GetLeader()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := partitionKey(partition.Stream, partition.Id)
+	if existing, ok := r.reassignments[key]; ok && existing.Phase != reassignmentDone {
+		now = existing.StartedAt
+	}
+	r.reassignments[key] = &ReassignmentStatus{
+		TargetReplicas: targets,
+		StartedAt:      now,
+		Phase:          reassignmentPhase(targets, partition.GetISR(), leader),
+	}
+}
+
+// update re-evaluates the phase of the given partition's reassignment, if any,
+// after its ISR or leader changed. A reassignment which is done stays done.
+func (r *reassignmentTracker) update(partition *partition) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	reassignment, ok := r.reassignments[partitionKey(partition.Stream, partition.Id)]
+	if !ok || reassignment.Phase == reassignmentDone {
+		return
+	}
+	leader, _ := partition.GetLeader()
+	reassignment.Phase = reassignmentPhase(reassignment.TargetReplicas, partition.GetISR(), leader)
+}
+
+// get returns a copy of the given partition's reassignment or nil if it has
+// none.
+func (r *reassignmentTracker) get(stream string, partition int32) *ReassignmentStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	reassignment, ok := r.reassignments[partitionKey(stream, partition)]
+	if !ok {
+		return nil
+	}
+	cp := *reassignment
+	cp.TargetReplicas = append([]string{}, reassignment.TargetReplicas...)
+	return &cp
+}
+
+// remove forgets the reassignment of the given partition.
+func (r *reassignmentTracker) remove(stream string, partition int32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.reassignments, partitionKey(stream, partition))
+}
+
+// reset forgets all reassignments.
+func (r *reassignmentTracker) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reassignments = make(map[string]*ReassignmentStatus)
+}
+
+// reassignmentPhase returns the phase of a reassignment to the given target
+// replicas with the given ISR and partition leader.
+func reassignmentPhase(targets, isr []string, leader string) string {
+	inISR := make(map[string]struct{}, len(isr))
+	for _, replica := range isr {
+		inISR[replica] = struct{}{}
+	}
+	leaderIsTarget := false
+	for _, target := range targets {
+		if _, ok := inISR[target]; !ok {
+			return reassignmentReplicating
+		}
+		if target == leader {
+			leaderIsTarget = true
+		}
+	}
+	if !leaderIsTarget {
+		return reassignmentElectingLeader
+	}
+	return reassignmentDone
+}
+
+// GetReassignmentStatus returns the progress of the latest reassignment of the
+// given partition's replicas. While target replicas are catching up, the bytes
+// they have yet to replicate are estimated from their lag behind the leader,
+// which is asked for along with the lagging targets for their view of the
+// partition.
+func (m *metadataAPI) GetReassignmentStatus(ctx context.Context, streamName string,
+	partitionID int32) (*ReassignmentStatus, *status.Status) {
+
+	partition := m.GetPartition(streamName, partitionID)
+	if partition == nil {
+		return nil, status.Newf(codes.NotFound, "No such partition [stream=%s, partition=%d]",
+			streamName, partitionID)
+	}
+	reassignment := m.reassignments.get(streamName, partitionID)
+	if reassignment == nil {
+		return nil, status.Newf(codes.NotFound, "No reassignment of partition [stream=%s, partition=%d]",
+			streamName, partitionID)
+	}
+
+	reassignment.CurrentISR = partition.GetISR()
+	sort.Strings(reassignment.CurrentISR)
+
+	isr := make(map[string]struct{}, len(reassignment.CurrentISR))
+	for _, replica := range reassignment.CurrentISR {
+		isr[replica] = struct{}{}
+	}
+	var lagging []string
+	for _, target := range reassignment.TargetReplicas {
+		if _, ok := isr[target]; !ok {
+			lagging = append(lagging, target)
+		}
+	}
+	if reassignment.Phase == reassignmentDone || len(lagging) == 0 {
+		return reassignment, nil
+	}
+
+	leader, _ := partition.GetLeader()
+	if leader == "" {
+		reassignment.BytesRemaining = -1
+		return reassignment, nil
+	}
+	reassignment.BytesRemaining = m.reassignmentBytesRemaining(ctx, partition, leader, lagging)
+	return reassignment, nil
+}
+
+// reassignmentBytesRemaining asks the given partition leader and lagging
+// replicas for their view of the partition in parallel and estimates the
+// bytes the replicas have yet to replicate, assuming the leader's messages are
+// evenly sized. It returns -1 if any of them can't be reached.
+func (m *metadataAPI) reassignmentBytesRemaining(ctx context.Context, partition *partition,
+	leader string, lagging []string) int64 {
+
+	var (
+		replicas = append([]string{leader}, lagging...)
+		statuses = make([]*proto.PartitionReplicaStatus, len(replicas))
+		wg       sync.WaitGroup
+	)
+	for i, replica := range replicas {
+		if replica == m.config.Clustering.ServerID {
+			statuses[i] = m.localPartitionStatus(partition.Stream, partition.Id)
+			continue
+		}
+		wg.Add(1)
+		go func(i int, replica string) {
+			defer wg.Done()
+			statuses[i] = m.fetchReplicaStatus(ctx, replica, partition.Stream, partition.Id)
+		}(i, replica)
+	}
+	wg.Wait()
+
+	for _, st := range statuses {
+		if st.Error != "" {
+			return -1
+		}
+	}
+	remaining := int64(0)
+	for _, st := range statuses[1:] {
+		remaining += replicationBytesRemaining(statuses[0], st)
+	}
+	return remaining
+}
+
+// replicationBytesRemaining estimates the bytes the given follower has yet to
+// replicate from the given leader, assuming the leader's messages are evenly
+// sized.
+func replicationBytesRemaining(leader, follower *proto.PartitionReplicaStatus) int64 {
+	messages := leader.LogEndOffset - leader.LogStartOffset
+	if messages <= 0 {
+		return 0
+	}
+	replicated := follower.LogEndOffset
+	if replicated < leader.LogStartOffset {
+		replicated = leader.LogStartOffset
+	}
+	lag := leader.LogEndOffset - replicated
+	if lag <= 0 {
+		return 0
+	}
+	// Use floating point since the product can overflow for large logs.
+	return int64(float64(leader.SizeBytes) * float64(lag) / float64(messages))
+}
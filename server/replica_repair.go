@@ -0,0 +1,364 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// replicaRepairTimeout is how long the metadata leader waits for the survey of
+// the cluster and each replica replacement to be applied.
+const replicaRepairTimeout = 30 * time.Second
+
+// replicaRepairer tracks the brokers the metadata leader found unavailable so
+// the replicas they host can be replaced once they have been unavailable for
+// longer than the grace period. The tracking only applies while this server is
+// the metadata leader, so it's reset when leadership is lost.
+type replicaRepairer struct {
+	mu          sync.Mutex
+	unavailable map[string]time.Time // Time each broker was first found unavailable
+	ctx         context.Context      // Canceled when leadership is lost
+	cancel      context.CancelFunc
+}
+
+func newReplicaRepairer() *replicaRepairer {
+	r := &replicaRepairer{}
+	r.reset()
+	return r
+}
+
+// reset forgets the unavailable brokers and cancels the repairs in progress.
+func (r *replicaRepairer) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.unavailable = make(map[string]time.Time)
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+}
+
+// context returns a context which is canceled when the repairer is reset.
+func (r *replicaRepairer) context() context.Context {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ctx
+}
+
+// lostBrokers records which of the given brokers are available at the given
+// time and returns those which have been unavailable for at least the grace
+// period. Brokers which are available again are forgotten.
+func (r *replicaRepairer) lostBrokers(brokers []string, available map[string]bool, now time.Time,
+	grace time.Duration) map[string]struct{} {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lost := make(map[string]struct{})
+	for _, broker := range brokers {
+		if available[broker] {
+			delete(r.unavailable, broker)
+			continue
+		}
+		since, ok := r.unavailable[broker]
+		if !ok {
+			since = now
+			r.unavailable[broker] = since
+		}
+		if now.Sub(since) >= grace {
+			lost[broker] = struct{}{}
+		}
+	}
+	return lost
+}
+
+// replicaRepairLoop periodically replaces the replicas hosted on lost brokers
+// while this server is the metadata leader until the server shuts down.
+func (s *Server) replicaRepairLoop() {
+	ticker := time.NewTicker(s.config.Clustering.ReplicaRepair.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-ticker.C:
+		}
+		if s.IsLeader() {
+			s.metadata.repairUnderReplicatedPartitions(time.Now())
+		}
+	}
+}
+
+// repairUnderReplicatedPartitions replaces a replica hosted on a lost broker
+// for up to the configured number of partitions. A broker is lost once it has
+// been missing from the metadata Raft group or hasn't answered the server info
+// survey for the grace period. Partitions whose leader is lost are left for
+// leader failover first. Replacements are placed on the available brokers
+// which host the fewest partitions, excluding those which are draining.
+func (m *metadataAPI) repairUnderReplicatedPartitions(now time.Time) {
+	ctx, cancel := context.WithTimeout(m.replicaRepair.context(), replicaRepairTimeout)
+	defer cancel()
+
+	members, err := m.getClusterServerIDs(false)
+	if err != nil {
+		m.logger.Errorf("Failed to check for under-replicated partitions: %v", err)
+		return
+	}
+	surveyCtx, cancelSurvey := context.WithTimeout(ctx, defaultFetchBrokerInfoTimeout)
+	brokers, st := m.fetchBrokerInfo(surveyCtx, len(members)-1)
+	cancelSurvey()
+	if st != nil {
+		m.logger.Errorf("Failed to check for under-replicated partitions: %v", st.Err())
+		return
+	}
+	isMember := make(map[string]bool, len(members))
+	for _, id := range members {
+		isMember[id] = true
+	}
+	var (
+		available = make(map[string]bool, len(brokers))
+		draining  = make(map[string]bool)
+	)
+	for _, broker := range brokers {
+		available[broker.id] = isMember[broker.id]
+		if broker.draining {
+			draining[broker.id] = true
+		}
+	}
+
+	// Track every member and every broker hosting a replica since brokers
+	// removed from the Raft group may still host replicas.
+	var (
+		partitions = m.getRepairCandidates()
+		tracked    = make(map[string]struct{}, len(members))
+		ids        = make([]string, 0, len(members))
+	)
+	track := func(id string) {
+		if _, ok := tracked[id]; !ok {
+			tracked[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	for _, id := range members {
+		track(id)
+	}
+	for _, partition := range partitions {
+		for _, replica := range partition.GetReplicas() {
+			track(replica)
+		}
+	}
+	lost := m.replicaRepair.lostBrokers(ids, available, now, m.config.Clustering.ReplicaRepair.GracePeriod)
+	if len(lost) == 0 {
+		return
+	}
+
+	// Lost brokers may never have reported their version to this server, so
+	// only the available brokers, which the survey just updated, are checked.
+	if version := m.minAvailableVersion(available); version < opProtocolVersions[proto.Op_REPLACE_REPLICA] {
+		m.logger.Warnf("Not repairing under-replicated partitions since the cluster minimum protocol "+
+			"version is %d", version)
+		return
+	}
+
+	targets, err := m.getRepairTargets(available, draining)
+	if err != nil {
+		m.logger.Errorf("Failed to check for under-replicated partitions: %v", err)
+		return
+	}
+
+	repaired := 0
+	for _, partition := range partitions {
+		if repaired >= m.config.Clustering.ReplicaRepair.MaxPerInterval || ctx.Err() != nil {
+			return
+		}
+		replica := lostReplica(partition, lost)
+		if replica == "" {
+			continue
+		}
+		if leader, _ := partition.GetLeader(); !available[leader] {
+			// Wait for a new leader to be elected first.
+			continue
+		}
+		replacement := selectReplacement(partition, targets)
+		if replacement == "" {
+			m.logger.Warnf("No broker available to replace replica %s of partition %s", replica, partition)
+			continue
+		}
+		op := &proto.ReplaceReplicaOp{
+			Stream:      partition.Stream,
+			Partition:   partition.Id,
+			Replica:     replica,
+			Replacement: replacement,
+		}
+		m.logger.Infof("Replacing replica %s of under-replicated partition %s with %s",
+			replica, partition, replacement)
+		if st := m.replaceReplica(ctx, op); st != nil {
+			m.logger.Errorf("Failed to replace replica %s of partition %s: %v", replica, partition, st.Err())
+			continue
+		}
+		repaired++
+	}
+}
+
+// minAvailableVersion returns the oldest protocol version implemented by the
+// given available brokers other than this server.
+func (m *metadataAPI) minAvailableVersion(available map[string]bool) uint32 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	minVersion := m.protocolVersion
+	for id, ok := range available {
+		if !ok || id == m.config.Clustering.ServerID {
+			continue
+		}
+		if version := m.brokerVersions[id]; version < minVersion {
+			minVersion = version
+		}
+	}
+	return minVersion
+}
+
+// getRepairCandidates returns the partitions which can be repaired, in stream
+// name and partition ID order. Paused partitions and partitions of streams
+// being deleted are left out.
+func (m *metadataAPI) getRepairCandidates() []*partition {
+	streams := m.GetStreams()
+	sort.Slice(streams, func(i, j int) bool { return streams[i].GetName() < streams[j].GetName() })
+	var partitions []*partition
+	for _, stream := range streams {
+		if stream.IsTombstoned() {
+			continue
+		}
+		streamPartitions := stream.GetPartitions()
+		ids := make([]int32, 0, len(streamPartitions))
+		for id := range streamPartitions {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		for _, id := range ids {
+			if partition := streamPartitions[id]; !partition.IsPaused() {
+				partitions = append(partitions, partition)
+			}
+		}
+	}
+	return partitions
+}
+
+// getRepairTargets returns the brokers replacement replicas can be placed on,
+// ordered by partition load. These are the available brokers which aren't
+// draining, following the same rules as placing new partitions.
+func (m *metadataAPI) getRepairTargets(available, draining map[string]bool) ([]string, error) {
+	ids, err := m.getClusterServerIDs(m.config.Clustering.ExcludeObservers)
+	if err != nil {
+		return nil, err
+	}
+	ids = m.duplicateIDs.filter(ids)
+	targets := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if available[id] && !draining[id] {
+			targets = append(targets, id)
+		}
+	}
+
+	// Order servers by partition load.
+	m.stats.RLock()
+	sort.SliceStable(targets, func(i, j int) bool {
+		return m.stats.brokerPartitionLoad[targets[i]] < m.stats.brokerPartitionLoad[targets[j]]
+	})
+	m.stats.RUnlock()
+	return targets, nil
+}
+
+// lostReplica returns the first replica, in the partition's replica order, of
+// the given partition which is hosted on a lost broker if the partition has
+// fewer replicas on other brokers than its replication factor. Otherwise, it
+// returns an empty string.
+func lostReplica(partition *partition, lost map[string]struct{}) string {
+	partition.mu.RLock()
+	defer partition.mu.RUnlock()
+	var (
+		first = ""
+		live  = 0
+	)
+	for _, replica := range partition.Replicas {
+		if _, ok := lost[replica]; !ok {
+			live++
+		} else if first == "" {
+			first = replica
+		}
+	}
+	// Partitions replicated to every broker have as many replicas as there
+	// were brokers when they were created.
+	replicationFactor := int(partition.ReplicationFactor)
+	if replicationFactor <= 0 {
+		replicationFactor = len(partition.Replicas)
+	}
+	if live >= replicationFactor {
+		return ""
+	}
+	return first
+}
+
+// selectReplacement returns the first of the given targets which isn't
+// already a replica of the partition, or an empty string if there is none.
+func selectReplacement(partition *partition, targets []string) string {
+	for _, target := range targets {
+		if !partition.IsReplica(target) {
+			return target
+		}
+	}
+	return ""
+}
+
+// replaceReplica replaces a replica of a partition with another broker. This
+// must be called on the metadata leader once it has checked the available
+// brokers support the operation. This operation is replicated by Raft. If successful, this will return once the replacement has been
+// applied.
+func (m *metadataAPI) replaceReplica(ctx context.Context, req *proto.ReplaceReplicaOp) *status.Status {
+	op := &proto.RaftLog{
+		Op:               proto.Op_REPLACE_REPLICA,
+		ReplaceReplicaOp: req,
+	}
+
+	// Wait on result of replication.
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkReplaceReplicaPreconditions)
+	if err != nil {
+		code := codes.FailedPrecondition
+		if err == ErrPartitionNotFound {
+			code = codes.NotFound
+		}
+		return status.Newf(code, "%s", err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to replace replica: %v", err.Error())
+	}
+
+	return nil
+}
+
+// checkReplaceReplicaPreconditions checks that the partition exists, the
+// replica being replaced is still a replica but not the leader, and the
+// replacement isn't a replica already, since the partition may have changed
+// since it was checked.
+func (m *metadataAPI) checkReplaceReplicaPreconditions(op *proto.RaftLog) error {
+	req := op.ReplaceReplicaOp
+	partition := m.GetPartition(req.Stream, req.Partition)
+	if partition == nil {
+		return ErrPartitionNotFound
+	}
+	if !partition.IsReplica(req.Replica) {
+		return errors.Errorf("%s is no longer a replica", req.Replica)
+	}
+	if leader, _ := partition.GetLeader(); leader == req.Replica {
+		return errors.Errorf("%s is the partition leader", req.Replica)
+	}
+	if partition.IsReplica(req.Replacement) {
+		return errors.Errorf("%s is already a replica", req.Replacement)
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure brokers are only lost once they have been unavailable for the grace
+// period, brokers which become available again are forgotten, and resetting
+// the repairer forgets every broker and cancels its context.
+func TestReplicaRepairerLostBrokers(t *testing.T) {
+	var (
+		r       = newReplicaRepairer()
+		brokers = []string{"a", "b", "c"}
+		now     = time.Now()
+		grace   = time.Minute
+	)
+
+	lost := r.lostBrokers(brokers, map[string]bool{"a": true}, now, grace)
+	require.Empty(t, lost)
+
+	lost = r.lostBrokers(brokers, map[string]bool{"a": true, "b": true}, now.Add(grace), grace)
+	require.Equal(t, map[string]struct{}{"c": {}}, lost)
+
+	// b was available in between, so it's unavailable since now.
+	lost = r.lostBrokers(brokers, map[string]bool{"a": true}, now.Add(grace+time.Second), grace)
+	require.Equal(t, map[string]struct{}{"c": {}}, lost)
+
+	ctx := r.context()
+	r.reset()
+	require.Error(t, ctx.Err())
+	require.NoError(t, r.context().Err())
+	lost = r.lostBrokers(brokers, map[string]bool{"a": true}, now.Add(2*grace), grace)
+	require.Empty(t, lost)
+}
+
+// Ensure a lost replica is only returned for partitions with fewer live
+// replicas than their replication factor.
+func TestLostReplica(t *testing.T) {
+	newTestPartition := func(replicationFactor int32, replicas ...string) *partition {
+		return &partition{Partition: &proto.Partition{
+			ReplicationFactor: replicationFactor,
+			Replicas:          replicas,
+		}}
+	}
+	lost := map[string]struct{}{"b": {}, "c": {}}
+
+	require.Equal(t, "b", lostReplica(newTestPartition(3, "a", "b", "c"), lost))
+	require.Equal(t, "c", lostReplica(newTestPartition(3, "a", "c", "d"), lost))
+	require.Equal(t, "", lostReplica(newTestPartition(3, "a", "d", "e"), lost))
+	require.Equal(t, "", lostReplica(newTestPartition(2, "a", "d", "b"), lost))
+	require.Equal(t, "b", lostReplica(newTestPartition(maxReplicationFactor, "a", "b"), lost))
+}
+
+// Ensure a reassignment is replicating until every target is in the ISR and
+// done once one of them also leads the partition, and the bytes a follower has
+// yet to replicate are estimated from its lag.
+func TestReassignmentPhase(t *testing.T) {
+	targets := []string{"a", "b", "c"}
+	require.Equal(t, reassignmentReplicating, reassignmentPhase(targets, []string{"a", "b"}, "a"))
+	require.Equal(t, reassignmentElectingLeader, reassignmentPhase(targets, []string{"a", "b", "c", "d"}, "d"))
+	require.Equal(t, reassignmentDone, reassignmentPhase(targets, []string{"a", "b", "c"}, "b"))
+
+	leader := &proto.PartitionReplicaStatus{LogStartOffset: 10, LogEndOffset: 110, SizeBytes: 1000}
+	require.Equal(t, int64(1000), replicationBytesRemaining(leader, &proto.PartitionReplicaStatus{}))
+	require.Equal(t, int64(250), replicationBytesRemaining(leader, &proto.PartitionReplicaStatus{LogEndOffset: 85}))
+	require.Equal(t, int64(0), replicationBytesRemaining(leader, &proto.PartitionReplicaStatus{LogEndOffset: 110}))
+	require.Equal(t, int64(0), replicationBytesRemaining(&proto.PartitionReplicaStatus{}, &proto.PartitionReplicaStatus{}))
+}
+
+// Ensure the metadata leader replaces the replica of a partition hosted on a
+// broker which has been down for the grace period with a replica on another
+// broker, which then catches up and joins the ISR.
+func TestReplicaRepair(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	servers := make(map[string]*Server)
+	for i, id := range []string{"a", "b", "c", "d"} {
+		config := getTestConfig(id, i == 0, 5050+i)
+		config.EmbeddedNATS = false
+		config.Clustering.ReplicaRepair.Interval = 100 * time.Millisecond
+		config.Clustering.ReplicaRepair.GracePeriod = time.Second
+		config.Clustering.ReplicaMaxLagTime = time.Second
+		// Idle followers must fetch within the max lag time to stay caught
+		// up long enough to rejoin the ISR.
+		config.Clustering.ReplicaMaxIdleWait = 500 * time.Millisecond
+		config.Clustering.ISRExpansionMinCatchupTime = time.Second
+		s := runServerWithConfig(t, config)
+		defer s.Stop()
+		servers[id] = s
+	}
+	all := []*Server{servers["a"], servers["b"], servers["c"], servers["d"]}
+	leader := getMetadataLeader(t, 10*time.Second, all...)
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", leader.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	api := client.NewAPIClient(conn)
+
+	_, err = api.CreateStream(context.Background(), &client.CreateStreamRequest{
+		Name:              "foo",
+		Subject:           "foo",
+		ReplicationFactor: 3,
+	})
+	require.NoError(t, err)
+	waitForPartition(t, 10*time.Second, "foo", 0, all...)
+	waitForISR(t, 10*time.Second, "foo", 0, 3, all...)
+
+	// Stop a follower which isn't the metadata leader.
+	partition := leader.metadata.GetPartition("foo", 0)
+	partitionLeader, _ := partition.GetLeader()
+	var stopped, spare string
+	for id := range servers {
+		switch {
+		case !partition.IsReplica(id):
+			spare = id
+		case id != partitionLeader && id != leader.config.Clustering.ServerID:
+			stopped = id
+		}
+	}
+	servers[stopped].Stop()
+
+	// The replica is replaced by the spare broker, which joins the ISR.
+	require.Eventually(t, func() bool {
+		return partition.IsReplica(spare) && !partition.IsReplica(stopped)
+	}, 10*time.Second, 50*time.Millisecond)
+	require.Eventually(t, func() bool {
+		return partition.inISR(spare)
+	}, 10*time.Second, 50*time.Millisecond)
+	require.Len(t, partition.GetReplicas(), 3)
+
+	// The partition still works with the replacement.
+	_, err = api.Publish(context.Background(), &client.PublishRequest{
+		Stream:    "foo",
+		Value:     []byte("hello"),
+		AckPolicy: client.AckPolicy_ALL,
+	})
+	require.NoError(t, err)
+}
+
+// Ensure the status of a partition's reassignment by replica repair reports
+// the replacement catching up with a decreasing number of bytes remaining
+// until it joins the ISR and the reassignment is done.
+func TestReassignmentStatus(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	servers := make(map[string]*Server)
+	for i, id := range []string{"a", "b", "c", "d"} {
+		config := getTestConfig(id, i == 0, 5050+i)
+		config.EmbeddedNATS = false
+		config.Clustering.ReplicaRepair.Interval = 100 * time.Millisecond
+		config.Clustering.ReplicaRepair.GracePeriod = time.Second
+		config.Clustering.ReplicaMaxLagTime = time.Second
+		config.Clustering.ReplicaMaxIdleWait = 500 * time.Millisecond
+		config.Clustering.ISRExpansionMinCatchupTime = time.Second
+		// Throttle the replacement so it takes a few seconds to catch up.
+		config.Clustering.ReplicationThrottle.ReplicaRate = 20000
+		config.Clustering.ReplicationThrottle.LagThreshold = 10
+		s := runServerWithConfig(t, config)
+		defer s.Stop()
+		servers[id] = s
+	}
+	all := []*Server{servers["a"], servers["b"], servers["c"], servers["d"]}
+	leader := getMetadataLeader(t, 10*time.Second, all...)
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", leader.config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	api := client.NewAPIClient(conn)
+	admin := proto.NewAdminAPIClient(conn)
+
+	_, err = api.CreateStream(context.Background(), &client.CreateStreamRequest{
+		Name:              "foo",
+		Subject:           "foo",
+		ReplicationFactor: 3,
+	})
+	require.NoError(t, err)
+	waitForPartition(t, 10*time.Second, "foo", 0, all...)
+	waitForISR(t, 10*time.Second, "foo", 0, 3, all...)
+
+	// Nothing has been reassigned yet.
+	_, err = admin.GetReassignmentStatus(context.Background(), &proto.GetReassignmentStatusRequest{
+		Stream: "foo",
+	})
+	require.Error(t, err)
+
+	value := make([]byte, 1000)
+	for i := 0; i < 100; i++ {
+		_, err = api.Publish(context.Background(), &client.PublishRequest{
+			Stream:    "foo",
+			Value:     value,
+			AckPolicy: client.AckPolicy_ALL,
+		})
+		require.NoError(t, err)
+	}
+
+	// Stop a follower which isn't the metadata leader.
+	partition := leader.metadata.GetPartition("foo", 0)
+	partitionLeader, _ := partition.GetLeader()
+	var stopped, spare string
+	for id := range servers {
+		switch {
+		case !partition.IsReplica(id):
+			spare = id
+		case id != partitionLeader && id != leader.config.Clustering.ServerID:
+			stopped = id
+		}
+	}
+	servers[stopped].Stop()
+
+	var (
+		resp      *proto.GetReassignmentStatusResponse
+		remaining []int64
+	)
+	require.Eventually(t, func() bool {
+		resp, err = admin.GetReassignmentStatus(context.Background(), &proto.GetReassignmentStatusRequest{
+			Stream: "foo",
+		})
+		if err != nil {
+			return false
+		}
+		if resp.Phase == reassignmentReplicating {
+			remaining = append(remaining, resp.BytesRemaining)
+		}
+		return resp.Phase == reassignmentDone
+	}, 30*time.Second, 100*time.Millisecond)
+
+	// The replacement was seen catching up with a decreasing number of bytes
+	// remaining.
+	require.NotEmpty(t, remaining)
+	require.Greater(t, remaining[0], int64(0))
+	for i := 1; i < len(remaining); i++ {
+		require.LessOrEqual(t, remaining[i], remaining[i-1])
+	}
+
+	expected := []string{partitionLeader, spare}
+	for _, replica := range partition.GetReplicas() {
+		if replica != partitionLeader && replica != spare {
+			expected = append(expected, replica)
+		}
+	}
+	sort.Strings(expected)
+	require.Equal(t, expected, resp.TargetReplicas)
+	require.Equal(t, expected, resp.CurrentIsr)
+	require.Equal(t, int64(0), resp.BytesRemaining)
+	require.NotZero(t, resp.StartedAt)
+}
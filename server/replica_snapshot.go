@@ -0,0 +1,212 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// snapshotChunkAttempts is the number of times a follower requests a chunk of
+// a snapshot from the leader before giving up on the snapshot.
+const snapshotChunkAttempts = 3
+
+var (
+	// errSnapshotInstalled is returned by handleReplicationResponse when the
+	// follower caught up on the leader's log by installing a snapshot.
+	errSnapshotInstalled = errors.New("log caught up from a snapshot")
+
+	// errSnapshotFailed is returned by handleReplicationResponse when the
+	// follower failed to transfer or install a snapshot offered by the
+	// leader.
+	errSnapshotFailed = errors.New("snapshot transfer failed")
+)
+
+// snapshotFileTypes are the files of each segment transferred in a snapshot.
+var snapshotFileTypes = []proto.SnapshotFileType{
+	proto.SnapshotFileType_SEGMENT_LOG,
+	proto.SnapshotFileType_SEGMENT_INDEX,
+}
+
+// getSnapshotChunkInbox returns the NATS subject to send snapshot chunk
+// requests to.
+func (p *partition) getSnapshotChunkInbox() string {
+	return fmt.Sprintf("%s.%s.%d.snapshot",
+		p.srv.getSubjectPrefix(), p.Stream, p.Id)
+}
+
+// handleSnapshotChunkRequest is a NATS handler that's invoked when the leader
+// receives a request for a chunk of the snapshot it offered to a follower.
+func (p *partition) handleSnapshotChunkRequest(msg *nats.Msg) {
+	req, err := proto.UnmarshalSnapshotChunkRequest(msg.Data)
+	if err != nil {
+		p.srv.logger.Errorf("Invalid snapshot chunk request for partition %s: %v", p, err)
+		return
+	}
+	p.mu.RLock()
+	replicator, ok := p.replicators[req.ReplicaID]
+	p.mu.RUnlock()
+	resp := &proto.SnapshotChunkResponse{Error: "snapshot not found"}
+	if ok {
+		resp = replicator.readSnapshotChunk(req)
+	}
+	data, err := proto.MarshalSnapshotChunkResponse(resp)
+	if err != nil {
+		panic(err)
+	}
+	if err := msg.Respond(data); err != nil {
+		p.srv.logger.Errorf("Failed to respond to snapshot chunk request: %v", err)
+	}
+}
+
+// handleReplicationSnapshotOffer is invoked when a follower receives an offer
+// of the sealed segments following the end of its log from the leader. The
+// follower transfers the segment files, verifying their checksums, and adds
+// them to its log, returning errSnapshotInstalled. If this fails, the files
+// transferred are discarded and errSnapshotFailed is returned so that the
+// follower replicates the messages instead. Offers made before the follower's
+// log reached the segments are ignored.
+func (p *partition) handleReplicationSnapshotOffer(offer *proto.ReplicationSnapshotOffer) error {
+	p.mu.RLock()
+	if !p.isFollowing || p.LeaderEpoch != offer.LeaderEpoch {
+		p.mu.RUnlock()
+		return nil
+	}
+	p.mu.RUnlock()
+
+	if len(offer.Segments) == 0 || offer.Segments[0].BaseOffset <= p.log.NewestOffset() {
+		return nil
+	}
+	var (
+		segments = make([]commitlog.SnapshotSegment, len(offer.Segments))
+		epochs   = make([]commitlog.EpochOffset, len(offer.EpochOffsets))
+		size     int64
+	)
+	for i, seg := range offer.Segments {
+		segments[i] = commitlog.SnapshotSegment{
+			BaseOffset: seg.BaseOffset,
+			LastOffset: seg.LastOffset,
+			LogSize:    seg.LogSize,
+			IndexSize:  seg.IndexSize,
+		}
+		size += seg.LogSize
+	}
+	for i, epoch := range offer.EpochOffsets {
+		epochs[i] = commitlog.EpochOffset{
+			LeaderEpoch: epoch.LeaderEpoch,
+			StartOffset: epoch.StartOffset,
+		}
+	}
+
+	p.srv.logger.Infof("Transferring %d segments (%d bytes) of partition %s from the leader",
+		len(segments), size, p)
+	if err := p.transferSnapshot(offer, segments, epochs); err != nil {
+		p.srv.logger.Errorf("Failed to transfer snapshot of partition %s, "+
+			"replicating messages instead: %v", p, err)
+		return errSnapshotFailed
+	}
+	p.srv.logger.Infof("Installed snapshot of partition %s up to offset %d",
+		p, segments[len(segments)-1].LastOffset)
+	return errSnapshotInstalled
+}
+
+// transferSnapshot fetches the files of the offered segments from the leader
+// and installs them in the log.
+func (p *partition) transferSnapshot(offer *proto.ReplicationSnapshotOffer,
+	segments []commitlog.SnapshotSegment, epochs []commitlog.EpochOffset) error {
+
+	w, err := p.log.NewSnapshotWriter()
+	if err != nil {
+		return err
+	}
+	for _, seg := range segments {
+		for _, file := range snapshotFileTypes {
+			if err := p.transferSnapshotFile(w, offer, seg.BaseOffset, file); err != nil {
+				w.Discard()
+				return err
+			}
+		}
+	}
+	if err := w.Install(segments, epochs, offer.HighWatermark); err != nil {
+		w.Discard()
+		return err
+	}
+	return nil
+}
+
+// transferSnapshotFile fetches the given file of the offered segment with the
+// given base offset from the leader chunk by chunk and commits it once the
+// last chunk's checksum matches.
+func (p *partition) transferSnapshotFile(w *commitlog.SnapshotWriter,
+	offer *proto.ReplicationSnapshotOffer, baseOffset int64, file proto.SnapshotFileType) error {
+
+	fw, err := w.Create(baseOffset, commitlog.SnapshotFile(file))
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := p.fetchSnapshotChunk(offer, baseOffset, file, fw.Size())
+		if err != nil {
+			fw.Close()
+			return err
+		}
+		if len(resp.Data) == 0 && !resp.Last {
+			fw.Close()
+			return errors.New("leader sent an empty snapshot chunk")
+		}
+		if _, err := fw.Write(resp.Data); err != nil {
+			fw.Close()
+			return err
+		}
+		if resp.Last {
+			return fw.Commit(resp.Checksum)
+		}
+	}
+}
+
+// fetchSnapshotChunk requests the chunk of the given file of the offered
+// segment with the given base offset starting at the given position from the
+// leader, retrying requests which time out. It fails if the follower stops
+// following the leader which made the offer.
+func (p *partition) fetchSnapshotChunk(offer *proto.ReplicationSnapshotOffer, baseOffset int64,
+	file proto.SnapshotFileType, position int64) (*proto.SnapshotChunkResponse, error) {
+
+	data, err := proto.MarshalSnapshotChunkRequest(&proto.SnapshotChunkRequest{
+		ReplicaID:  p.srv.config.Clustering.ServerID,
+		SnapshotID: offer.SnapshotID,
+		BaseOffset: baseOffset,
+		File:       file,
+		Position:   position,
+		MaxBytes:   p.srv.config.Clustering.ReplicationMaxBytes,
+	})
+	if err != nil {
+		panic(err)
+	}
+	for attempt := 1; ; attempt++ {
+		p.mu.RLock()
+		following := p.isFollowing && p.LeaderEpoch == offer.LeaderEpoch
+		p.mu.RUnlock()
+		if !following {
+			return nil, errors.New("no longer following the leader which offered the snapshot")
+		}
+		msg, err := p.srv.ncRepl.Request(p.getSnapshotChunkInbox(), data,
+			p.srv.config.Clustering.ReplicaFetchTimeout)
+		if err != nil {
+			if attempt < snapshotChunkAttempts {
+				continue
+			}
+			return nil, err
+		}
+		resp, err := proto.UnmarshalSnapshotChunkResponse(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Error != "" {
+			return nil, errors.New(resp.Error)
+		}
+		return resp, nil
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nuid"
 
 	"github.com/liftbridge-io/liftbridge/server/commitlog"
 	proto "github.com/liftbridge-io/liftbridge/server/protocol"
@@ -29,30 +32,43 @@ type replicationRequest struct {
 // its health. Requests are received on the requests channel and a long-running
 // loop processes them and sends responses. If the replica does not catch up to
 // the leader's log in maxLagTime, it's removed from the ISR until it catches
-// back up.
+// back up and stays caught up for minCatchupTime.
 type replicator struct {
-	partition    *partition
-	replica      string
-	maxLagTime   time.Duration
-	lastCaughtUp time.Time
-	lastSeen     time.Time
-	requests     chan replicationRequest
-	mu           sync.RWMutex
-	leader       string
-	epoch        uint64
-	headersBuf   [28]byte // scratch buffer for reading message headers
-	writer       replicationProtocolWriter
-	waiter       <-chan struct{}
+	partition      *partition
+	replica        string
+	maxLagTime     time.Duration
+	minCatchupTime time.Duration
+	lastCaughtUp   time.Time
+	lastSeen       time.Time
+	caughtUpSince  time.Time // Start of the replica's current time in sync, zero if out of sync
+	lastFetched    int64     // Offset reported in the replica's latest request
+	sentOffset     int64     // Last offset sent to the replica, used for pipelined requests
+	requests       chan replicationRequest
+	mu             sync.RWMutex
+	leader         string
+	epoch          uint64
+	writer         replicationProtocolWriter
+	waiter         <-chan struct{}
+	limiter        *byteRateLimiter    // limits the rate messages are sent while throttled
+	throttled      bool                // whether the latest request was throttled
+	throttleTime   time.Duration       // total time requests were delayed by the throttle
+	snapshot       *commitlog.Snapshot // snapshot offered to the replica, if any
+	snapshotID     string
+	snapshotSeen   time.Time // last time the replica fetched a chunk of the snapshot
 }
 
 func newReplicator(epoch uint64, replica string, p *partition) *replicator {
 	return &replicator{
-		epoch:      epoch,
-		replica:    replica,
-		partition:  p,
-		requests:   make(chan replicationRequest, 1),
-		maxLagTime: p.srv.config.Clustering.ReplicaMaxLagTime,
-		leader:     p.srv.config.Clustering.ServerID,
+		epoch:          epoch,
+		replica:        replica,
+		partition:      p,
+		requests:       make(chan replicationRequest, p.srv.config.Clustering.ReplicaFetchPipelineDepth),
+		maxLagTime:     p.srv.config.Clustering.ReplicaMaxLagTime,
+		minCatchupTime: p.srv.config.Clustering.ISRExpansionMinCatchupTime,
+		leader:         p.srv.config.Clustering.ServerID,
+		lastFetched:    -1,
+		sentOffset:     -1,
+		limiter:        newByteRateLimiter(p.srv.replThrottle.getConfig().ReplicaRate),
 	}
 }
 
@@ -63,7 +79,14 @@ func newReplicator(epoch uint64, replica string, p *partition) *replicator {
 // available. The response will also include the leader epoch and HW. If the
 // replica doesn't send a request or catch up to the leader's log in
 // maxLagTime, it will be removed from the ISR until it catches back up.
+// Pipelined requests are sent by the replica before it has received the
+// responses to its previous requests, so the batch for a pipelined request
+// starts after the last offset sent rather than the requested offset. A
+// replica lagging by enough sealed segments is offered them for direct
+// transfer instead of their messages.
 func (r *replicator) start(stop <-chan struct{}) {
+	defer r.releaseSnapshot()
+
 	r.mu.Lock()
 	now := time.Now()
 	r.lastSeen = now
@@ -83,8 +106,10 @@ func (r *replicator) start(stop <-chan struct{}) {
 		}
 
 		r.mu.Lock()
-		r.lastSeen = req.received
+		r.markSeen(req.received)
+		r.lastFetched = req.Offset
 		r.mu.Unlock()
+		r.partition.updateRetentionFloor()
 
 		// Update the ISR replica's latest offset for the partition. This is
 		// used by the leader to know when to commit messages.
@@ -93,26 +118,66 @@ func (r *replicator) start(stop <-chan struct{}) {
 		var (
 			latest   = r.partition.log.NewestOffset()
 			earliest = r.partition.log.OldestOffset()
+			from     = req.Offset
 		)
+		if !req.Pipelined {
+			r.sentOffset = req.Offset
+		} else if r.sentOffset > from {
+			from = r.sentOffset
+		}
+
+		// If the messages the replica is missing were deleted from the start
+		// of the log, it needs to restart from the log start offset.
+		if logStart := r.partition.log.LogStartOffset(); from+1 < logStart {
+			r.partition.srv.logger.Warnf("Replica %s for partition %s requested offset %d "+
+				"before the log start offset %d", r.replica, r.partition, from+1, logStart)
+			if err := r.sendOffsetOutOfRange(req.request, logStart); err != nil {
+				r.partition.srv.logger.Errorf("Failed to send log start offset for partition %s to replica %s: %v",
+					r.partition, req.ReplicaID, err)
+			}
+			continue
+		}
+
+		// The replica only sends a request which isn't pipelined once it's
+		// done with any snapshot it was offered. Pipelined requests sent
+		// before it received the offer are answered with just the HW.
+		if !req.Pipelined {
+			r.releaseSnapshot()
+			if r.offerSnapshot(req) {
+				continue
+			}
+		} else if r.hasSnapshot() {
+			if err := r.sendHW(req.request); err != nil {
+				r.partition.srv.logger.Errorf("Failed to send HW for partition %s to replica %s: %v",
+					r.partition, req.ReplicaID, err)
+			}
+			continue
+		}
 
 		// Check if we're caught up.
-		if req.Offset >= latest {
+		if from >= latest {
 			r.caughtUp(stop, latest, req)
 			continue
 		}
 
+		// Throttle the replica if it's catching up.
+		maxBytes, throttled, ok := r.throttle(stop, req, latest)
+		if !ok {
+			continue
+		}
+
 		// Create a log reader starting at the requested offset. Wrap this in
 		// an anonymous function to avoid leaking the deferred context cancel.
 		func() {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
-			reader, err := r.partition.log.NewReader(req.Offset+1, true)
+			reader, err := r.partition.log.NewReader(from+1, true)
 			if err != nil {
 				r.partition.srv.logger.Errorf(
 					"Failed to create replication reader for partition %s "+
 						"and replica %s (requested offset %d, earliest %d, latest %d): %v",
-					r.partition, r.replica, req.Offset+1, earliest, latest, err)
+					r.partition, r.replica, from+1, earliest, latest, err)
 				// Send a response to short-circuit request timeout.
 				if err := r.sendHW(req.request); err != nil {
 					r.partition.srv.logger.Errorf("Failed to send HW for partition %s to replica %s: %v",
@@ -122,7 +187,7 @@ func (r *replicator) start(stop <-chan struct{}) {
 			}
 
 			// Send a batch of messages to the replica.
-			if err := r.replicate(ctx, reader, req.request, req.Offset); err != nil {
+			if err := r.replicate(ctx, reader, req.request, from, maxBytes, throttled); err != nil {
 				// Send a response to short-circuit request timeout.
 				if err := r.sendHW(req.request); err != nil {
 					r.partition.srv.logger.Errorf("Failed to send HW for partition %s to replica %s: %v",
@@ -134,6 +199,90 @@ func (r *replicator) start(stop <-chan struct{}) {
 	}
 }
 
+// getLastFetched returns the latest log offset the replica reported having
+// when it last requested data from the leader, or -1 if it has not sent a
+// request yet.
+func (r *replicator) getLastFetched() int64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.lastFetched
+}
+
+// getThrottleStats returns whether the replica's latest request was throttled
+// and the total time requests were delayed by the replication throttle.
+func (r *replicator) getThrottleStats() (bool, time.Duration) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.throttled, r.throttleTime
+}
+
+// throttle applies the server's replication throttle to the given request. It
+// returns the maximum size of the batch to send, whether the batch is
+// throttled, and false if the request was already responded to. A throttled
+// request waits for the bytes previously sent to the replica, and to all
+// throttled replicas, to be paid back. If that would take more than half the
+// replica fetch timeout, the request is answered with just the HW after
+// waiting that long so that it doesn't time out, and the replica is notified
+// to send another request.
+func (r *replicator) throttle(stop <-chan struct{}, req replicationRequest, latest int64) (
+	int64, bool, bool) {
+
+	var (
+		throttle = r.partition.srv.replThrottle
+		config   = throttle.getConfig()
+		maxBytes = r.partition.srv.config.Clustering.ReplicationMaxBytes
+	)
+	r.limiter.setRate(config.ReplicaRate)
+	throttled := throttle.shouldThrottle(r.partition.inISR(r.replica), latest-req.Offset)
+	r.mu.Lock()
+	r.throttled = throttled
+	r.mu.Unlock()
+	if !throttled {
+		return maxBytes, false, true
+	}
+
+	// Limit batches to a second's worth of bytes so that they don't borrow
+	// more than can be paid back before the next request.
+	for _, rate := range []int64{config.ReplicaRate, config.BrokerRate} {
+		if rate > 0 && rate < maxBytes {
+			maxBytes = rate
+		}
+	}
+
+	var (
+		delay   = r.limiter.delay()
+		maxWait = r.partition.srv.config.Clustering.ReplicaFetchTimeout / 2
+	)
+	if brokerDelay := throttle.broker.delay(); brokerDelay > delay {
+		delay = brokerDelay
+	}
+	wait := delay
+	if wait > maxWait {
+		wait = maxWait
+	}
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-stop:
+			timer.Stop()
+			return 0, true, false
+		}
+		r.mu.Lock()
+		r.throttleTime += wait
+		r.mu.Unlock()
+	}
+	if delay > maxWait {
+		if err := r.sendHW(req.request); err != nil {
+			r.partition.srv.logger.Errorf("Failed to send HW for partition %s to replica %s: %v",
+				r.partition, req.ReplicaID, err)
+		}
+		r.partition.sendPartitionNotification(req.ReplicaID)
+		return 0, true, false
+	}
+	return maxBytes, true, true
+}
+
 func (r *replicator) request(req replicationRequest) {
 	select {
 	case r.requests <- req:
@@ -156,14 +305,9 @@ func (r *replicator) tick(stop <-chan struct{}) {
 			return
 		case <-timer.C:
 		}
-		r.mu.RLock()
-		var (
-			now                 = time.Now()
-			lastSeenElapsed     = now.Sub(r.lastSeen)
-			lastCaughtUpElapsed = now.Sub(r.lastCaughtUp)
-		)
-		r.mu.RUnlock()
-		outOfSync := lastSeenElapsed > r.maxLagTime || lastCaughtUpElapsed > r.maxLagTime
+		now := time.Now()
+		lastSeenElapsed, lastCaughtUpElapsed, inSyncFor, outOfSync := r.checkSync(now)
+		tick := computeTick(lastCaughtUpElapsed, r.maxLagTime)
 		if outOfSync && r.partition.inISR(r.replica) {
 			// Follower has not sent a request or has not caught up in
 			// maxLagTime, so remove it from the ISR.
@@ -173,16 +317,79 @@ func (r *replicator) tick(stop <-chan struct{}) {
 
 			r.shrinkISR()
 		} else if !outOfSync && !r.partition.inISR(r.replica) {
-			// Add replica back into ISR.
-			r.partition.srv.logger.Infof("Replica %s for partition %s caught back up with leader, "+
-				"rejoining ISR", r.replica, r.partition)
-			r.expandISR()
+			if wait := r.minCatchupTime - inSyncFor; wait > 0 {
+				// Wait for the replica to stay caught up so that a replica
+				// whose connection is flapping doesn't repeatedly rejoin and
+				// leave the ISR.
+				r.partition.srv.logger.Debugf("Replica %s for partition %s caught up with leader "+
+					"for %s, waiting %s before rejoining ISR", r.replica, r.partition, inSyncFor, wait)
+				if wait < tick {
+					tick = wait
+				}
+			} else {
+				// Add replica back into ISR.
+				r.partition.srv.logger.Infof("Replica %s for partition %s caught back up with leader, "+
+					"rejoining ISR", r.replica, r.partition)
+				r.expandISR()
+			}
+		}
+
+		// Let the segments of a snapshot the replica stopped fetching be
+		// cleaned again.
+		r.mu.RLock()
+		abandoned := r.snapshot != nil && now.Sub(r.snapshotSeen) > r.maxLagTime
+		r.mu.RUnlock()
+		if abandoned {
+			r.partition.srv.logger.Warnf("Replica %s for partition %s stopped fetching its snapshot, "+
+				"releasing it", r.replica, r.partition)
+			r.releaseSnapshot()
 		}
 
-		timer.Reset(computeTick(lastCaughtUpElapsed, r.maxLagTime))
+		timer.Reset(tick)
 	}
 }
 
+// checkSync returns the time elapsed as of now since the replica last sent a
+// request and last caught up with the leader's log, how long it has been
+// continuously in sync, and whether it's out of sync, i.e. either elapsed time
+// exceeds maxLagTime. Falling out of sync resets the time in sync.
+func (r *replicator) checkSync(now time.Time) (time.Duration, time.Duration, time.Duration, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var (
+		lastSeenElapsed     = now.Sub(r.lastSeen)
+		lastCaughtUpElapsed = now.Sub(r.lastCaughtUp)
+		outOfSync           = lastSeenElapsed > r.maxLagTime || lastCaughtUpElapsed > r.maxLagTime
+		inSyncFor           time.Duration
+	)
+	if outOfSync {
+		r.caughtUpSince = time.Time{}
+	} else if !r.caughtUpSince.IsZero() {
+		inSyncFor = now.Sub(r.caughtUpSince)
+	}
+	return lastSeenElapsed, lastCaughtUpElapsed, inSyncFor, outOfSync
+}
+
+// markSeen records a request received from the replica. A gap of more than
+// maxLagTime since its previous request resets the time it has been in sync.
+// This must be called with the lock held.
+func (r *replicator) markSeen(received time.Time) {
+	if received.Sub(r.lastSeen) > r.maxLagTime {
+		r.caughtUpSince = time.Time{}
+	}
+	r.lastSeen = received
+}
+
+// markCaughtUp records that the replica caught up with the leader's log. This
+// starts the time it has been in sync unless it was already in sync, i.e. it
+// last caught up within maxLagTime. This must be called with the lock held.
+func (r *replicator) markCaughtUp(received time.Time) {
+	if r.caughtUpSince.IsZero() || received.Sub(r.lastCaughtUp) > r.maxLagTime {
+		r.caughtUpSince = received
+	}
+	r.lastCaughtUp = received
+}
+
 // shrinkISR sends a ShrinkISR request to the controller to remove the replica
 // from the ISR.
 func (r *replicator) shrinkISR() {
@@ -217,51 +424,64 @@ func (r *replicator) expandISR() {
 	}
 }
 
-// replicate sends a batch of messages to the given NATS inbox along with the
-// leader epoch and HW.
-func (r *replicator) replicate(
-	ctx context.Context, reader *commitlog.Reader, request *nats.Msg, offset int64) error {
-
-	var (
-		newestOffset = r.partition.log.NewestOffset()
-		message      commitlog.SerializedMessage
-		err          error
-	)
-	for offset < newestOffset && int64(r.writer.Len()) < r.partition.srv.config.Clustering.ReplicationMaxBytes {
-		message, offset, _, _, err = reader.ReadMessage(ctx, r.headersBuf[:])
+// replicate sends a batch of messages of up to maxBytes to the given NATS inbox
+// along with the leader epoch and HW. If the batch is throttled, the bytes
+// sent are counted against the replication throttle limits.
+func (r *replicator) replicate(ctx context.Context, reader *commitlog.Reader,
+	request *nats.Msg, offset, maxBytes int64, throttled bool) error {
+
+	lastWritten := offset
+	if offset < r.partition.log.NewestOffset() && int64(r.writer.Len()) < maxBytes {
+		// Read the batch as a single message set. A batch always includes at
+		// least one message so that messages larger than the batch size, such
+		// as ones written before the max message size was lowered, are still
+		// replicated rather than stalling the follower at their offset.
+		messages, first, last, err := reader.ReadMessageSet(ctx, maxBytes-int64(r.writer.Len()))
 		if err != nil {
-			r.partition.srv.logger.Errorf("Failed to read message while replicating: %v", err)
+			r.partition.srv.logger.Errorf("Failed to read messages while replicating: %v", err)
 			return err
 		}
-
-		// Check if this message will put us over the batch size limit. If it
-		// does, flush the batch now.
-		batchSize := int64(len(message)) + int64(len(r.headersBuf)) + int64(r.writer.Len())
+		batchSize := int64(len(messages)) + int64(r.writer.Len())
 		if batchSize > r.partition.srv.config.Clustering.ReplicationMaxBytes {
-			break
+			r.partition.srv.logger.Warnf("Replicating message at offset %d of partition %s to %s "+
+				"which exceeds clustering.replication.max.bytes (%d)",
+				first, r.partition, r.replica, r.partition.srv.config.Clustering.ReplicationMaxBytes)
 		}
 
-		// Write the message to the buffer.
-		if err := r.writer.Write(offset, r.headersBuf[:], message); err != nil {
-			r.partition.srv.logger.Errorf("Failed to write message to buffer while replicating: %v", err)
+		// Write the messages to the buffer.
+		if err := r.writer.WriteMessageSet(last, messages); err != nil {
+			r.partition.srv.logger.Errorf("Failed to write messages to buffer while replicating: %v", err)
 			return err
 		}
+		lastWritten = last
+
+		atomic.AddInt64(&r.partition.metrics.replicationBytesRead, commitlog.MessageSetPayloadSize(messages))
 	}
 
 	// Flush the batch.
+	size := r.writer.Len()
+	if throttled {
+		r.limiter.take(size)
+		r.partition.srv.replThrottle.broker.take(size)
+	}
 	if err := r.writer.Flush(request.Respond); err != nil {
 		r.partition.srv.logger.Errorf("Failed to flush buffer while replicating: %v", err)
 		return err
 	}
+	r.partition.metrics.bytesOut.mark(int64(size))
+	r.sentOffset = lastWritten
 	return nil
 }
 
-// caughtUp is called when the follower has caught up with the leader's log.
-// This will register a data waiter on the log so that the leader can notify
-// the follower when new data is available to replicate.
+// caughtUp is called when the follower has caught up with the leader's log,
+// or has pipelined requests for all of it. This will register a data waiter on
+// the log so that the leader can notify the follower when new data is
+// available to replicate.
 func (r *replicator) caughtUp(stop <-chan struct{}, leo int64, req replicationRequest) {
 	r.mu.Lock()
-	r.lastCaughtUp = req.received
+	if req.Offset >= leo {
+		r.markCaughtUp(req.received)
+	}
 	waiter := r.waiter
 	if waiter == nil {
 		// Register a waiter to be notified when new messages are written after
@@ -287,6 +507,128 @@ func (r *replicator) caughtUp(stop <-chan struct{}, leo int64, req replicationRe
 	}
 }
 
+// offerSnapshot offers the replica the sealed segments following the end of
+// its log for direct transfer if they add up to at least
+// clustering.replica.snapshot.min.bytes and the replica didn't decline
+// snapshots. It returns true if the request was answered with the offer.
+func (r *replicator) offerSnapshot(req replicationRequest) bool {
+	minBytes := r.partition.srv.config.Clustering.ReplicaSnapshotMinBytes
+	if minBytes <= 0 || req.DeclineSnapshot {
+		return false
+	}
+	snapshot := r.partition.log.Snapshot(req.Offset, minBytes)
+	if snapshot == nil {
+		return false
+	}
+
+	offer := &proto.ReplicationSnapshotOffer{
+		LeaderEpoch:   r.epoch,
+		SnapshotID:    nuid.Next(),
+		HighWatermark: r.partition.log.HighWatermark(),
+	}
+	for _, seg := range snapshot.Segments {
+		offer.Segments = append(offer.Segments, &proto.SnapshotSegment{
+			BaseOffset: seg.BaseOffset,
+			LastOffset: seg.LastOffset,
+			LogSize:    seg.LogSize,
+			IndexSize:  seg.IndexSize,
+		})
+	}
+	for _, epoch := range snapshot.Epochs {
+		offer.EpochOffsets = append(offer.EpochOffsets, &proto.EpochOffset{
+			LeaderEpoch: epoch.LeaderEpoch,
+			StartOffset: epoch.StartOffset,
+		})
+	}
+	data, err := proto.MarshalReplicationSnapshotOffer(offer)
+	if err != nil {
+		panic(err)
+	}
+
+	r.mu.Lock()
+	r.snapshot = snapshot
+	r.snapshotID = offer.SnapshotID
+	r.snapshotSeen = time.Now()
+	r.mu.Unlock()
+
+	r.partition.srv.logger.Infof("Offering %d segments (%d bytes) of partition %s to replica %s",
+		len(snapshot.Segments), snapshot.Size(), r.partition, r.replica)
+	if err := req.request.Respond(data); err != nil {
+		r.partition.srv.logger.Errorf("Failed to send snapshot offer for partition %s to replica %s: %v",
+			r.partition, req.ReplicaID, err)
+	}
+	return true
+}
+
+// hasSnapshot indicates if the replica was offered a snapshot which wasn't
+// released yet.
+func (r *replicator) hasSnapshot() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.snapshot != nil
+}
+
+// releaseSnapshot releases the snapshot offered to the replica, if any.
+func (r *replicator) releaseSnapshot() {
+	r.mu.Lock()
+	snapshot := r.snapshot
+	r.snapshot = nil
+	r.snapshotID = ""
+	r.mu.Unlock()
+	if snapshot != nil {
+		snapshot.Release()
+	}
+}
+
+// readSnapshotChunk reads the chunk of the snapshot offered to the replica
+// requested by it. The response to the chunk which ends a file includes the
+// file's checksum.
+func (r *replicator) readSnapshotChunk(req *proto.SnapshotChunkRequest) *proto.SnapshotChunkResponse {
+	r.mu.Lock()
+	snapshot := r.snapshot
+	if snapshot == nil || r.snapshotID != req.SnapshotID {
+		r.mu.Unlock()
+		return &proto.SnapshotChunkResponse{Error: "snapshot not found"}
+	}
+	r.snapshotSeen = time.Now()
+	r.mu.Unlock()
+
+	maxBytes := r.partition.srv.config.Clustering.ReplicationMaxBytes
+	if req.MaxBytes > 0 && req.MaxBytes < maxBytes {
+		maxBytes = req.MaxBytes
+	}
+	var (
+		file   = commitlog.SnapshotFile(req.File)
+		buf    = make([]byte, maxBytes)
+		n, err = snapshot.ReadAt(req.BaseOffset, file, buf, req.Position)
+		resp   = &proto.SnapshotChunkResponse{Data: buf[:n]}
+	)
+	if err == io.EOF {
+		resp.Last = true
+		resp.Checksum, err = snapshot.Checksum(req.BaseOffset, file)
+	}
+	if err != nil {
+		return &proto.SnapshotChunkResponse{Error: err.Error()}
+	}
+	r.partition.metrics.bytesOut.mark(int64(n))
+	return resp
+}
+
+// sendOffsetOutOfRange tells the replica, via the given NATS inbox, that its
+// log ends before the start of the leader's log so that it truncates its log
+// and restarts replicating from the log start offset.
+func (r *replicator) sendOffsetOutOfRange(request *nats.Msg, logStart int64) error {
+	data, err := proto.MarshalReplicationOffsetOutOfRange(&proto.ReplicationOffsetOutOfRange{
+		LeaderEpoch:    r.epoch,
+		LogStartOffset: logStart,
+		HighWatermark:  r.partition.log.HighWatermark(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return request.Respond(data)
+}
+
 // sendHW sends the leader epoch and HW to the given NATS inbox.
 func (r *replicator) sendHW(request *nats.Msg) error {
 	r.writer.Reset()
@@ -294,7 +636,7 @@ func (r *replicator) sendHW(request *nats.Msg) error {
 }
 
 type replicationProtocolWriter interface {
-	Write(offset int64, headers, message []byte) error
+	WriteMessageSet(lastOffset int64, messages []byte) error
 	Flush(func(data []byte) error) error
 	Len() int
 	Reset()
@@ -320,14 +662,11 @@ func newReplicationProtocolWriter(r *replicator, stop <-chan struct{}) replicati
 	return w
 }
 
-func (w *protocolWriter) Write(offset int64, headers, message []byte) error {
-	if _, err := w.buf.Write(headers); err != nil {
-		return err
-	}
-	if _, err := w.buf.Write(message); err != nil {
+func (w *protocolWriter) WriteMessageSet(lastOffset int64, messages []byte) error {
+	if _, err := w.buf.Write(messages); err != nil {
 		return err
 	}
-	w.lastOffset = offset
+	w.lastOffset = lastOffset
 	return nil
 }
 
@@ -2,6 +2,8 @@ package server
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -11,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
 	proto "github.com/liftbridge-io/liftbridge/server/protocol"
 )
 
@@ -232,6 +235,89 @@ func TestPartitionLeaderFailover(t *testing.T) {
 	require.Equal(t, 2, partitionCounts[leader.config.Clustering.ServerID])
 }
 
+// Ensure that, after a full cluster outage, a follower which is behind but
+// still in the recovered ISR isn't elected partition leader when the leader
+// doesn't come back, even if it's restarted first.
+func TestPartitionLeaderFailoverPrefersCaughtUpReplica(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	var (
+		configs = make(map[string]*Config)
+		servers []*Server
+	)
+	for i, id := range []string{"a", "b", "c"} {
+		config := getTestConfig(id, i == 0, 5050+i)
+		config.EmbeddedNATS = false
+		config.Clustering.ReplicaMaxLeaderTimeout = time.Second
+		config.Clustering.ReplicaMaxIdleWait = 500 * time.Millisecond
+		config.Clustering.ReplicaFetchTimeout = 500 * time.Millisecond
+		configs[id] = config
+		s := runServerWithConfig(t, config)
+		defer s.Stop()
+		servers = append(servers, s)
+	}
+
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	client, err := lift.Connect([]string{"localhost:5050", "localhost:5051", "localhost:5052"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.CreateStream(ctx, name, name, lift.ReplicationFactor(3)))
+	waitForISR(t, 10*time.Second, name, 0, 3, servers...)
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+
+	num := 10
+	for i := 0; i < num; i++ {
+		_, err := client.Publish(ctx, name, []byte(strconv.Itoa(i)), lift.AckPolicyAll())
+		require.NoError(t, err)
+	}
+	waitForHW(t, 5*time.Second, name, 0, int64(num-1), servers...)
+	require.NoError(t, client.Close())
+
+	var followers []*Server
+	for _, s := range servers {
+		if s != leader {
+			followers = append(followers, s)
+		}
+	}
+	behind, caughtUp := followers[0], followers[1]
+
+	// Stop the whole cluster, leader last so that it isn't failed over
+	// before the followers are stopped.
+	for _, s := range append(followers, leader) {
+		s.Stop()
+	}
+
+	// Simulate the behind follower losing unflushed writes in the outage.
+	behindLog, err := commitlog.New(commitlog.Options{Path: behind.partitionDir(name, 0)})
+	require.NoError(t, err)
+	require.NoError(t, behindLog.Truncate(int64(num/2)))
+	behindLog.OverrideHighWatermark(int64(num/2 - 1))
+	require.NoError(t, behindLog.Close())
+
+	// Restart the behind follower first, then the other one. The leader
+	// stays down, so the followers report it and a new leader is elected.
+	behind = runServerWithConfig(t, configs[behind.config.Clustering.ServerID])
+	defer behind.Stop()
+	caughtUp = runServerWithConfig(t, configs[caughtUp.config.Clustering.ServerID])
+	defer caughtUp.Stop()
+
+	leader = getPartitionLeader(t, 10*time.Second, name, 0, behind, caughtUp)
+	require.Equal(t, caughtUp.config.Clustering.ServerID, leader.config.Clustering.ServerID)
+
+	// The behind follower catches up from the new leader.
+	waitForHW(t, 10*time.Second, name, 0, int64(num-1), behind, caughtUp)
+	require.Equal(t, int64(num-1), behind.metadata.GetPartition(name, 0).log.NewestOffset())
+}
+
 // Ensure the leader commits when the ISR shrinks if it causes pending messages
 // to now be replicated by all replicas in ISR.
 func TestCommitOnISRShrink(t *testing.T) {
@@ -1025,3 +1111,293 @@ func TestShrinkExpandISR(t *testing.T) {
 	// Wait for ISR to expand to 3.
 	waitForISR(t, 10*time.Second, name, 0, 3, servers...)
 }
+
+// Ensure a follower which alternates between being caught up and lagging is
+// only ready to rejoin the ISR once it has been caught up for
+// minCatchupTime continuously, not as soon as it first catches up.
+func TestReplicatorISRExpansionMinCatchupTime(t *testing.T) {
+	var (
+		start = time.Now()
+		r     = &replicator{
+			maxLagTime:     time.Second,
+			minCatchupTime: 6 * time.Second,
+			lastSeen:       start,
+			lastCaughtUp:   start,
+		}
+		readyAt time.Duration
+	)
+	// The follower sends a request every 500ms. It's caught up for 2 seconds
+	// and lagging for the next 2 seconds until 8 seconds in, after which it
+	// stays caught up. The leader checks whether it can rejoin the ISR every
+	// 500ms.
+	for elapsed := time.Duration(0); elapsed <= 20*time.Second; elapsed += 500 * time.Millisecond {
+		now := start.Add(elapsed)
+		r.mu.Lock()
+		r.markSeen(now)
+		if elapsed >= 8*time.Second || (elapsed/(2*time.Second))%2 == 0 {
+			r.markCaughtUp(now)
+		}
+		r.mu.Unlock()
+
+		_, _, inSyncFor, outOfSync := r.checkSync(now)
+		if !outOfSync && inSyncFor >= r.minCatchupTime {
+			readyAt = elapsed
+			break
+		}
+		if elapsed == 3*time.Second || elapsed == 7*time.Second {
+			require.True(t, outOfSync)
+		}
+	}
+	require.Equal(t, 14*time.Second, readyAt)
+}
+
+// Ensure a follower whose leader epoch checkpoint file was corrupted while it
+// was down recovers its partition and keeps replicating from the leader
+// without losing messages.
+func TestFollowerRecoversCorruptLeaderEpochs(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	// Configure servers.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.EmbeddedNATS = false
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+	s3Config := getTestConfig("c", false, 5052)
+	s3 := runServerWithConfig(t, s3Config)
+	defer s3.Stop()
+	servers := []*Server{s1, s2, s3}
+	configs := map[*Server]*Config{s1: s1Config, s2: s2Config, s3: s3Config}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	client, err := lift.Connect([]string{"localhost:5050", "localhost:5051", "localhost:5052"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	require.NoError(t, client.CreateStream(context.Background(), name, name, lift.ReplicationFactor(3)))
+	waitForPartition(t, 5*time.Second, name, 0, servers...)
+
+	publish := func(num int, opts ...lift.MessageOption) {
+	PublishLoop:
+		for i := 0; i < num; i++ {
+			for retries := 0; retries < 5; retries++ {
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				_, err = client.Publish(ctx, name, []byte("hello"), opts...)
+				cancel()
+				if err != nil {
+					time.Sleep(50 * time.Millisecond)
+					continue
+				}
+				continue PublishLoop
+			}
+			t.Fatal(err)
+		}
+	}
+	publish(5, lift.AckPolicyAll())
+
+	// Stop a follower, other than the metadata leader, and corrupt its leader
+	// epoch checkpoint file.
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+	follower := s2
+	if follower == leader {
+		follower = s3
+	}
+	follower.Stop()
+	file := filepath.Join(follower.partitionDir(name, 0), "leader-epoch-checkpoint")
+	require.NoError(t, os.WriteFile(file, []byte{0xde, 0xad, 0xbe, 0xef}, 0600))
+
+	// Restart the follower, which rebuilds its leader epochs from its log and
+	// keeps replicating from the leader.
+	follower = runServerWithConfig(t, configs[follower])
+	defer follower.Stop()
+	waitForHW(t, 10*time.Second, name, 0, 4, follower)
+	publish(5)
+	waitForHW(t, 10*time.Second, name, 0, 9, follower)
+
+	followerLog := follower.metadata.GetPartition(name, 0).log
+	leaderLog := leader.metadata.GetPartition(name, 0).log
+	require.Equal(t, int64(9), followerLog.NewestOffset())
+	require.Equal(t, leaderLog.LastLeaderEpoch(), followerLog.LastLeaderEpoch())
+	require.Equal(t, leaderLog.LastOffsetForLeaderEpoch(leaderLog.LastLeaderEpoch()),
+		followerLog.LastOffsetForLeaderEpoch(followerLog.LastLeaderEpoch()))
+}
+
+// runOfflineFollowerCluster starts three servers with aggressive retention,
+// creates a stream replicated to all of them, and publishes messages while
+// one of the followers is offline. Snapshots are offered to followers lagging
+// by snapshotMinBytes if it's positive. It returns the partition leader, the
+// config of the stopped follower, and the running servers. The servers are
+// stopped and their storage removed when the test finishes.
+func runOfflineFollowerCluster(t *testing.T, followerFloor bool, snapshotMinBytes int64) (
+	*Server, *Config, []*Server) {
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+
+	// Retain two messages, each in its own segment.
+	configure := func(config *Config) *Config {
+		config.Streams.SegmentMaxBytes = 1
+		config.Streams.RetentionMaxMessages = 2
+		config.Streams.CleanerInterval = 10 * time.Millisecond
+		config.Streams.RetentionFollowerFloor = followerFloor
+		config.Clustering.ReplicaMaxLagTime = time.Second
+		config.Clustering.ReplicaMaxIdleWait = 10 * time.Millisecond
+		config.Clustering.ReplicaSnapshotMinBytes = snapshotMinBytes
+		return config
+	}
+	s1Config := configure(getTestConfig("a", true, 5050))
+	s1Config.EmbeddedNATS = false
+	servers := []*Server{
+		runServerWithConfig(t, s1Config),
+		runServerWithConfig(t, configure(getTestConfig("b", false, 5051))),
+		runServerWithConfig(t, configure(getTestConfig("c", false, 5052))),
+	}
+	t.Cleanup(func() {
+		for _, s := range servers {
+			s.Stop()
+		}
+		ns.Shutdown()
+		cleanupStorage(t)
+	})
+	metadataLeader := getMetadataLeader(t, 10*time.Second, servers...)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	name := "foo"
+	require.NoError(t, client.CreateStream(context.Background(), name, name,
+		lift.ReplicationFactor(3)))
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+	waitForISR(t, 10*time.Second, name, 0, 3, servers...)
+
+	for i := 0; i < 5; i++ {
+		_, err := client.Publish(context.Background(), name, []byte(strconv.Itoa(i)),
+			lift.AckPolicyAll())
+		require.NoError(t, err)
+	}
+	waitForHW(t, 10*time.Second, name, 0, 4, servers...)
+
+	// Stop a follower which isn't the metadata leader so the cluster keeps
+	// running.
+	var follower *Server
+	for _, s := range servers {
+		if s != leader && s != metadataLeader {
+			follower = s
+			break
+		}
+	}
+	follower.Stop()
+	running := make([]*Server, 0, 2)
+	for _, s := range servers {
+		if s != follower {
+			running = append(running, s)
+		}
+	}
+	waitForISR(t, 10*time.Second, name, 0, 2, running...)
+
+	// Publish on the stream's subject since the client may route publishes
+	// through the stopped follower.
+	nc, err := nats.GetDefaultOptions().Connect()
+	require.NoError(t, err)
+	defer nc.Close()
+	for i := 5; i < 15; i++ {
+		require.NoError(t, nc.Publish(name, []byte(strconv.Itoa(i))))
+	}
+	waitForHW(t, 10*time.Second, name, 0, 14, running...)
+	return leader, follower.config, running
+}
+
+// Ensure the leader doesn't delete messages an offline follower hasn't fetched
+// when streams.retention.follower.floor is enabled, and deletes them once the
+// follower has caught up.
+func TestRetentionFollowerFloor(t *testing.T) {
+	leader, followerConfig, _ := runOfflineFollowerCluster(t, true, 0)
+	leaderLog := leader.metadata.GetPartition("foo", 0).log
+
+	// The follower fetched up to offset 4 before it was stopped.
+	require.Eventually(t, func() bool {
+		return leaderLog.LogStartOffset() == 5
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, int64(4), leaderLog.RetentionFloor())
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, int64(5), leaderLog.LogStartOffset())
+	require.Equal(t, int64(5), leaderLog.OldestOffset())
+
+	// Restart the follower, which catches up without missing messages.
+	follower := runServerWithConfig(t, followerConfig)
+	defer follower.Stop()
+	waitForHW(t, 10*time.Second, "foo", 0, 14, follower)
+
+	require.Eventually(t, func() bool {
+		return leaderLog.LogStartOffset() > 5
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+// Ensure a follower whose log ends before the start of the leader's log, since
+// the leader deleted the messages it's missing while it was offline, truncates
+// its log to restart replicating from the leader's log start offset.
+func TestFollowerTruncatesToLeaderLogStart(t *testing.T) {
+	leader, followerConfig, running := runOfflineFollowerCluster(t, false, 0)
+	leaderLog := leader.metadata.GetPartition("foo", 0).log
+
+	// Without the follower floor, retention only waits for the HW.
+	require.Eventually(t, func() bool {
+		return leaderLog.LogStartOffset() > 5
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// Restart the follower, which restarts from the leader's log start.
+	follower := runServerWithConfig(t, followerConfig)
+	defer follower.Stop()
+	waitForHW(t, 10*time.Second, "foo", 0, 14, follower)
+	followerLog := follower.metadata.GetPartition("foo", 0).log
+	require.Greater(t, followerLog.LogStartOffset(), int64(5))
+	require.Equal(t, int64(14), followerLog.NewestOffset())
+
+	// The follower rejoins the ISR and keeps replicating.
+	servers := append(running, follower)
+	waitForISR(t, 10*time.Second, "foo", 0, 3, servers...)
+	nc, err := nats.GetDefaultOptions().Connect()
+	require.NoError(t, err)
+	defer nc.Close()
+	require.NoError(t, nc.Publish("foo", []byte("15")))
+	waitForHW(t, 10*time.Second, "foo", 0, 15, servers...)
+}
+
+// Ensure a follower lagging by enough sealed segments catches up by
+// transferring them from the leader rather than replicating their messages.
+func TestFollowerInstallsSnapshot(t *testing.T) {
+	leader, followerConfig, running := runOfflineFollowerCluster(t, true, 1)
+	leaderLog := leader.metadata.GetPartition("foo", 0).log
+
+	// Restart the follower, which transfers the sealed segments from offset
+	// 5 on and replicates the message in the leader's active segment.
+	follower := runServerWithConfig(t, followerConfig)
+	defer follower.Stop()
+	waitForHW(t, 10*time.Second, "foo", 0, 14, follower)
+	partition := follower.metadata.GetPartition("foo", 0)
+	require.Equal(t, int64(14), partition.log.NewestOffset())
+	replicated, _ := partition.metrics.messagesIn.snapshot()
+	require.Less(t, replicated, int64(10))
+	require.Equal(t, leaderLog.LastLeaderEpoch(), partition.log.LastLeaderEpoch())
+
+	// The follower rejoins the ISR and keeps replicating, and the leader
+	// cleans the segments again once the transfer is done.
+	servers := append(running, follower)
+	waitForISR(t, 10*time.Second, "foo", 0, 3, servers...)
+	nc, err := nats.GetDefaultOptions().Connect()
+	require.NoError(t, err)
+	defer nc.Close()
+	require.NoError(t, nc.Publish("foo", []byte("15")))
+	waitForHW(t, 10*time.Second, "foo", 0, 15, servers...)
+	require.Eventually(t, func() bool {
+		return leaderLog.LogStartOffset() > 5
+	}, 10*time.Second, 10*time.Millisecond)
+}
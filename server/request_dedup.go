@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// requestIDMetadataKey is the gRPC metadata key used to set the ID of a
+	// CreateStream request so retries of it can be deduplicated, since
+	// CreateStreamRequest has no field for it.
+	requestIDMetadataKey = "liftbridge-request-id"
+
+	// requestDedupCacheSize is the maximum number of request IDs remembered
+	// by the metadata leader, regardless of the deduplication window.
+	requestDedupCacheSize = 4096
+)
+
+// requestIDFromContext returns the request ID set in the incoming gRPC
+// metadata of the given context, if any.
+func requestIDFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	values := md.Get(requestIDMetadataKey)
+	switch len(values) {
+	case 0:
+		return "", nil
+	case 1:
+		if values[0] == "" {
+			return "", fmt.Errorf("%s can't be empty", requestIDMetadataKey)
+		}
+		return values[0], nil
+	default:
+		return "", fmt.Errorf("only one %s can be set", requestIDMetadataKey)
+	}
+}
+
+// dedupEntry tracks a request performed by the metadata leader. done is
+// closed once the request completes, after which status holds its outcome.
+type dedupEntry struct {
+	done      chan struct{}
+	status    *status.Status
+	completed time.Time
+}
+
+// requestDeduplicator remembers the outcome of requests by their
+// client-supplied ID for a window of time after they complete, so a retry of a
+// request whose response was lost gets the original outcome instead of being
+// performed again. Retries of requests which are still in progress wait for
+// them to complete. IDs are evicted least recently used first once the cache
+// is full.
+type requestDeduplicator struct {
+	mu     sync.Mutex
+	cache  *lru.Cache
+	window time.Duration
+}
+
+// newRequestDeduplicator creates a requestDeduplicator remembering completed
+// requests for the given window. A window of 0 disables deduplication.
+func newRequestDeduplicator(window time.Duration) *requestDeduplicator {
+	// Ignoring error here because it's only returned if size is <= 0.
+	cache, _ := lru.New(requestDedupCacheSize)
+	return &requestDeduplicator{cache: cache, window: window}
+}
+
+// begin starts tracking the request with the given ID. If a request with the
+// same ID is in progress or completed within the window, its entry is
+// returned along with false and the caller should wait for its outcome rather
+// than perform the request. Otherwise, the new entry is returned along with
+// true and the caller must complete it once the request is done.
+func (d *requestDeduplicator) begin(id string) (*dedupEntry, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if cached, ok := d.cache.Get(id); ok {
+		entry := cached.(*dedupEntry)
+		select {
+		case <-entry.done:
+			if time.Since(entry.completed) < d.window {
+				return entry, false
+			}
+		default:
+			return entry, false
+		}
+	}
+	entry := &dedupEntry{done: make(chan struct{})}
+	d.cache.Add(id, entry)
+	return entry, true
+}
+
+// complete records the outcome of the request tracked by the given entry and
+// releases the requests waiting for it.
+func (d *requestDeduplicator) complete(entry *dedupEntry, st *status.Status) {
+	d.mu.Lock()
+	entry.status = st
+	entry.completed = time.Now()
+	d.mu.Unlock()
+	close(entry.done)
+}
+
+// wait returns the outcome of the request tracked by the given entry once it
+// completes, or a DeadlineExceeded or Canceled status if the context is done
+// first.
+func (d *requestDeduplicator) wait(ctx context.Context, entry *dedupEntry) *status.Status {
+	select {
+	case <-entry.done:
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		return entry.status
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err())
+	}
+}
+
+// enabled indicates if requests are deduplicated.
+func (d *requestDeduplicator) enabled() bool {
+	return d.window > 0
+}
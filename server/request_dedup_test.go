@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the request ID is parsed from the request metadata.
+func TestRequestIDFromContext(t *testing.T) {
+	id, err := requestIDFromContext(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "", id)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		requestIDMetadataKey, "foo"))
+	id, err = requestIDFromContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "foo", id)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		requestIDMetadataKey, ""))
+	_, err = requestIDFromContext(ctx)
+	require.Error(t, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		requestIDMetadataKey, "foo", requestIDMetadataKey, "bar"))
+	_, err = requestIDFromContext(ctx)
+	require.Error(t, err)
+}
+
+// Ensure requests with the same ID wait for the original request while it's in
+// progress, get its outcome within the window, and are performed again once
+// the window has passed.
+func TestRequestDeduplicator(t *testing.T) {
+	d := newRequestDeduplicator(100 * time.Millisecond)
+	require.True(t, d.enabled())
+	require.False(t, newRequestDeduplicator(0).enabled())
+
+	entry, first := d.begin("foo")
+	require.True(t, first)
+
+	retry, first := d.begin("foo")
+	require.False(t, first)
+	require.Equal(t, entry, retry)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.Equal(t, codes.DeadlineExceeded, d.wait(ctx, retry).Code())
+
+	original := status.New(codes.AlreadyExists, "stream already exists")
+	d.complete(entry, original)
+	require.Equal(t, original, d.wait(context.Background(), retry))
+
+	_, first = d.begin("bar")
+	require.True(t, first)
+
+	time.Sleep(100 * time.Millisecond)
+	_, first = d.begin("foo")
+	require.True(t, first)
+}
+
+// Ensure a CreateStream retried with the same request ID after the client
+// gave up on the original request waits for it and succeeds rather than
+// failing because the stream exists, and the stream is only created once.
+func TestCreateStreamRequestDeduplication(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	config := getTestConfig("a", true, 5050)
+	config.EmbeddedNATS = false
+	s := runServerWithConfig(t, config)
+	defer s.Stop()
+	getMetadataLeader(t, 10*time.Second, s)
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	api := client.NewAPIClient(conn)
+
+	create := func(ctx context.Context, requestID string) error {
+		if requestID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, requestIDMetadataKey, requestID)
+		}
+		_, err := api.CreateStream(ctx, &client.CreateStreamRequest{
+			Name:    "foo",
+			Subject: "foo",
+		})
+		return err
+	}
+
+	// Hold up Raft so the request is still in progress when the client gives
+	// up on it.
+	raft := s.getRaft()
+	raft.Lock()
+	locked := true
+	defer func() {
+		if locked {
+			raft.Unlock()
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errC := make(chan error, 1)
+	go func() {
+		errC <- create(ctx, "req-1")
+	}()
+	require.Eventually(t, func() bool {
+		return s.metadata.createRequests.cache.Contains("req-1")
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	require.Equal(t, codes.Canceled, status.Code(<-errC))
+
+	// The retry waits for the original request.
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	go func() {
+		errC <- create(ctx, "req-1")
+	}()
+	time.Sleep(200 * time.Millisecond)
+	select {
+	case err := <-errC:
+		t.Fatalf("CreateStream returned before the original request completed: %v", err)
+	default:
+	}
+
+	raft.Unlock()
+	locked = false
+	require.NoError(t, <-errC)
+
+	// Retrying again gets the original outcome too.
+	require.NoError(t, create(ctx, "req-1"))
+
+	// Requests without the ID or with another ID aren't deduplicated.
+	require.Equal(t, codes.AlreadyExists, status.Code(create(ctx, "")))
+	require.Equal(t, codes.AlreadyExists, status.Code(create(ctx, "req-2")))
+
+	resp, err := api.FetchMetadata(ctx, &client.FetchMetadataRequest{})
+	require.NoError(t, err)
+	count := 0
+	for _, stream := range resp.StreamMetadata {
+		if stream.Name == "foo" {
+			count++
+		}
+	}
+	require.Equal(t, 1, count)
+}
@@ -0,0 +1,264 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"path"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	restoreRetryInterval = 2 * time.Second
+	restoreBatchBytes    = 1024 * 1024
+)
+
+// backedUpStreams returns the names of the streams in the backed up metadata
+// which don't exist.
+func (s *Server) backedUpStreams(ctx context.Context) ([]string, *status.Status) {
+	data, err := s.getBackup(ctx, backupSnapshotPath)
+	if err == errBackupNotFound {
+		return nil, status.New(codes.NotFound, "No metadata backup")
+	}
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "Failed to read metadata backup: %v", err)
+	}
+	snapshot := &proto.MetadataSnapshot{}
+	if err := snapshot.Unmarshal(data); err != nil {
+		return nil, status.Newf(codes.Internal, "Invalid metadata backup: %v", err)
+	}
+	var names []string
+	for _, stream := range snapshot.Streams {
+		if isReservedStream(stream.Name) || s.metadata.GetStream(stream.Name) != nil {
+			continue
+		}
+		names = append(names, stream.Name)
+	}
+	return names, nil
+}
+
+// restoreStream recreates the given stream from its latest backup. The leader
+// of each partition copies the backed up messages into its log before it
+// starts consuming messages. It returns the offset each partition is restored
+// to.
+func (s *Server) restoreStream(ctx context.Context, name string) ([]*proto.RestoredPartition, *status.Status) {
+	data, err := s.getBackup(ctx, path.Join(backupStreamPrefix(name), backupStreamKey))
+	if err == errBackupNotFound {
+		return nil, status.Newf(codes.NotFound, "No backup of stream %s", name)
+	}
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "Failed to read backup of stream %s: %v", name, err)
+	}
+	backedUp := &proto.Stream{}
+	if err := backedUp.Unmarshal(data); err != nil {
+		return nil, status.Newf(codes.Internal, "Invalid backup of stream %s: %v", name, err)
+	}
+
+	var (
+		offsets    = make([]int64, len(backedUp.Partitions))
+		partitions = make([]*proto.Partition, len(backedUp.Partitions))
+		restored   = make([]*proto.RestoredPartition, len(backedUp.Partitions))
+	)
+	for i, partition := range backedUp.Partitions {
+		if int(partition.Id) >= len(offsets) {
+			return nil, status.Newf(codes.Internal, "Invalid backup of stream %s: unexpected partition %d",
+				name, partition.Id)
+		}
+		manifest, err := s.readPartitionBackupManifest(ctx, name, partition.Id)
+		if err != nil {
+			return nil, status.Newf(codes.Internal, "Failed to read backup of partition %d of stream %s: %v",
+				partition.Id, name, err)
+		}
+		offset := int64(-1)
+		// Ignore backups of other incarnations of the stream.
+		if manifest != nil && manifest.CreationTimestamp == backedUp.CreationTimestamp {
+			offset = manifest.backedUpOffset()
+		}
+		offsets[partition.Id] = offset
+		partitions[i] = &proto.Partition{
+			Subject:           partition.Subject,
+			Stream:            name,
+			Group:             partition.Group,
+			ReplicationFactor: partition.ReplicationFactor,
+			Id:                partition.Id,
+		}
+		restored[i] = &proto.RestoredPartition{
+			Stream:    name,
+			Partition: partition.Id,
+			Offset:    offset,
+		}
+	}
+
+	config := &proto.StreamConfig{}
+	if backedUp.Config != nil {
+		*config = *backedUp.Config
+	}
+	config.RestoreSource = &proto.RestoreSource{Offsets: offsets}
+	stream := &proto.Stream{
+		Name:       name,
+		Subject:    backedUp.Subject,
+		Partitions: partitions,
+		Config:     config,
+		Origin:     backedUp.Origin,
+	}
+	if st := s.metadata.CreateStream(ctx, &proto.CreateStreamOp{Stream: stream}); st != nil {
+		return nil, st
+	}
+	return restored, nil
+}
+
+// restoreTarget returns the offset the partition is restored to from a backup
+// or -1 if it wasn't restored from one.
+func (p *partition) restoreTarget() int64 {
+	if p.restoreSource == nil || int(p.Id) >= len(p.restoreSource.Offsets) {
+		return -1
+	}
+	return p.restoreSource.Offsets[p.Id]
+}
+
+// restoreLoop is run by the leader of a partition restored from a backup
+// whose log doesn't contain the backed up messages yet. It copies them into
+// the log, retrying after restoreRetryInterval if the backup can't be read,
+// and then starts consuming messages unless the stop channel is closed.
+func (p *partition) restoreLoop(stop <-chan struct{}, epoch uint64, producers *producerState) {
+	defer atomic.StoreInt32(&p.restoring, 0)
+	for {
+		err := p.restore(stop, epoch, p.restoreTarget())
+		if err == nil {
+			break
+		}
+		p.srv.logger.Errorf("Failed to restore partition %s from backup: %v", p, err)
+		select {
+		case <-stop:
+			return
+		case <-time.After(restoreRetryInterval):
+		}
+	}
+
+	// Record the idempotent producers of the restored messages.
+	restored, err := loadProducerState(p.producerStateFile(), p.log)
+	if err != nil {
+		p.srv.logger.Errorf("Failed to load producer state for partition %s: %v", p, err)
+	} else {
+		producers.reset(restored)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	select {
+	case <-stop:
+		return
+	default:
+	}
+	if err := p.startConsuming(epoch, producers); err != nil {
+		p.srv.logger.Errorf("Failed to start leading partition %s after restoring it: %v", p, err)
+		return
+	}
+	p.srv.logger.Infof("Restored partition %s from backup up to offset %d", p, p.log.NewestOffset())
+}
+
+// restore copies the backed up messages up to the target offset which the
+// log doesn't contain yet into the log. It returns nil once they are copied
+// or the stop channel is closed. If the backup ends before the target offset,
+// the partition is restored up to the end of the backup.
+func (p *partition) restore(stop <-chan struct{}, epoch uint64, target int64) error {
+	if p.srv.backup == nil {
+		return errors.New("backups are not configured")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	manifest, err := p.srv.readPartitionBackupManifest(ctx, p.Stream, p.Id)
+	if err != nil {
+		return errors.Wrap(err, "failed to read backup manifest")
+	}
+	if manifest == nil {
+		return errors.New("partition has not been backed up")
+	}
+	for _, segment := range manifest.Segments {
+		if segment.BaseOffset > target {
+			break
+		}
+		if segment.LastOffset <= p.log.NewestOffset() {
+			continue
+		}
+		if err := p.restoreSegment(ctx, segment.Key, epoch, target); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.Wrapf(err, "failed to restore segment %d", segment.BaseOffset)
+		}
+	}
+
+	if newest := p.log.NewestOffset(); newest < target {
+		p.srv.logger.Warnf("Restored partition %s up to offset %d, the backup does not "+
+			"contain messages up to offset %d", p, newest, target)
+	}
+	return nil
+}
+
+// restoreSegment appends the messages of the backed up segment with the given
+// key up to the target offset which the log doesn't contain yet. The messages
+// keep their offsets but are assigned the current leader epoch.
+func (p *partition) restoreSegment(ctx context.Context, key string, epoch uint64, target int64) error {
+	r, err := p.srv.backup.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	var (
+		reader = bufio.NewReader(&throttledReader{ctx: ctx, r: r, limiter: p.srv.backupLimiter})
+		newest = p.log.NewestOffset()
+		batch  []byte
+	)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		commitlog.SetMessageSetLeaderEpoch(batch, epoch)
+		offsets, err := p.log.AppendMessageSet(batch)
+		if err != nil {
+			return errors.Wrap(err, "failed to append to log")
+		}
+		// Update this replica's latest offset so the HW is advanced.
+		p.updateISRLatestOffset(p.srv.config.Clustering.ServerID, offsets[len(offsets)-1])
+		batch = nil
+		return ctx.Err()
+	}
+	for {
+		entry, offset, err := commitlog.ReadMessageSetEntry(reader)
+		if err == io.EOF || (err == nil && offset > target) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if offset <= newest {
+			continue
+		}
+		batch = append(batch, entry...)
+		newest = offset
+		if len(batch) >= restoreBatchBytes {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
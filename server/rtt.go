@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// maxRTTProbeTimeout caps how long a broker waits for its peers to
+	// respond to an RTT probe.
+	maxRTTProbeTimeout = 3 * time.Second
+
+	// rttMaxAgeIntervals is the number of probe intervals after which a
+	// broker's RTT measurements are considered stale and ignored.
+	rttMaxAgeIntervals = 3
+)
+
+// rttRow is the set of round-trip times measured by a single broker.
+type rttRow struct {
+	rtts       map[string]time.Duration
+	measuredAt time.Time
+}
+
+// rttMatrix holds the most recent round-trip times measured between brokers.
+// Each broker periodically measures its RTT to every peer and includes the
+// results in its next probe, which is broadcast to every broker. As a result,
+// each broker, and in particular the metadata leader, has a copy of the full
+// matrix.
+type rttMatrix struct {
+	mu     sync.RWMutex
+	rows   map[string]*rttRow
+	maxAge time.Duration
+}
+
+// newRTTMatrix creates an rttMatrix which ignores rows older than maxAge. If
+// maxAge is 0, rows never expire.
+func newRTTMatrix(maxAge time.Duration) *rttMatrix {
+	return &rttMatrix{
+		rows:   make(map[string]*rttRow),
+		maxAge: maxAge,
+	}
+}
+
+// update replaces the RTTs measured by the given broker.
+func (r *rttMatrix) update(broker string, rtts map[string]time.Duration) {
+	r.mu.Lock()
+	r.rows[broker] = &rttRow{rtts: rtts, measuredAt: time.Now()}
+	r.mu.Unlock()
+}
+
+// row returns the RTTs measured by the given broker as protobufs.
+func (r *rttMatrix) row(broker string) []*proto.BrokerRTT {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	row := r.currentRow(broker)
+	if row == nil {
+		return nil
+	}
+	rtts := make([]*proto.BrokerRTT, 0, len(row.rtts))
+	for peer, rtt := range row.rtts {
+		rtts = append(rtts, &proto.BrokerRTT{Broker: peer, Rtt: int64(rtt)})
+	}
+	sort.Slice(rtts, func(i, j int) bool { return rtts[i].Broker < rtts[j].Broker })
+	return rtts
+}
+
+// rtt returns the RTT between the two brokers. Since the RTT is symmetric,
+// the measurement taken by the second broker is used if the first has none.
+// The bool is false if neither broker has a current measurement.
+func (r *rttMatrix) rtt(from, to string) (time.Duration, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if row := r.currentRow(from); row != nil {
+		if rtt, ok := row.rtts[to]; ok {
+			return rtt, true
+		}
+	}
+	if row := r.currentRow(to); row != nil {
+		if rtt, ok := row.rtts[from]; ok {
+			return rtt, true
+		}
+	}
+	return 0, false
+}
+
+// medianRTT returns the median RTT from the broker to the given peers,
+// ignoring the broker itself and any peers without a measurement. The bool is
+// false if there are no measurements.
+func (r *rttMatrix) medianRTT(broker string, peers []string) (time.Duration, bool) {
+	rtts := make([]time.Duration, 0, len(peers))
+	for _, peer := range peers {
+		if peer == broker {
+			continue
+		}
+		if rtt, ok := r.rtt(broker, peer); ok {
+			rtts = append(rtts, rtt)
+		}
+	}
+	if len(rtts) == 0 {
+		return 0, false
+	}
+	sort.Slice(rtts, func(i, j int) bool { return rtts[i] < rtts[j] })
+	mid := len(rtts) / 2
+	if len(rtts)%2 == 0 {
+		return (rtts[mid-1] + rtts[mid]) / 2, true
+	}
+	return rtts[mid], true
+}
+
+// snapshot returns every current row of the matrix ordered by broker ID.
+func (r *rttMatrix) snapshot() []*proto.BrokerRTTs {
+	r.mu.RLock()
+	brokers := make([]string, 0, len(r.rows))
+	for broker := range r.rows {
+		if r.currentRow(broker) != nil {
+			brokers = append(brokers, broker)
+		}
+	}
+	r.mu.RUnlock()
+	sort.Strings(brokers)
+
+	rows := make([]*proto.BrokerRTTs, 0, len(brokers))
+	for _, broker := range brokers {
+		r.mu.RLock()
+		row := r.currentRow(broker)
+		r.mu.RUnlock()
+		if row == nil {
+			continue
+		}
+		peers := make([]*proto.PeerRTT, 0, len(row.rtts))
+		for peer, rtt := range row.rtts {
+			peers = append(peers, &proto.PeerRTT{Peer: peer, Rtt: int64(rtt)})
+		}
+		sort.Slice(peers, func(i, j int) bool { return peers[i].Peer < peers[j].Peer })
+		rows = append(rows, &proto.BrokerRTTs{
+			Broker:     broker,
+			MeasuredAt: row.measuredAt.UnixNano(),
+			Peers:      peers,
+		})
+	}
+	return rows
+}
+
+// currentRow returns the broker's row if it hasn't expired. Must be called
+// with the lock held.
+func (r *rttMatrix) currentRow(broker string) *rttRow {
+	row, ok := r.rows[broker]
+	if !ok {
+		return nil
+	}
+	if r.maxAge > 0 && time.Since(row.measuredAt) > r.maxAge {
+		return nil
+	}
+	return row
+}
+
+// rttProbeLoop periodically measures the RTT to each peer until the server
+// shuts down.
+func (s *Server) rttProbeLoop() {
+	ticker := time.NewTicker(s.config.Clustering.RTTProbeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-ticker.C:
+		}
+		if err := s.probeRTT(); err != nil {
+			s.logger.Debugf("Failed to probe broker RTTs: %v", err)
+		}
+	}
+}
+
+// probeRTT measures the RTT to each peer by timing their responses to a
+// server info request sent on the server info subject. The request carries
+// the RTTs measured by the previous probe so that every broker learns them.
+func (s *Server) probeRTT() error {
+	servers, err := s.metadata.getClusterServerIDs(false)
+	if err != nil {
+		return err
+	}
+	numPeers := len(servers) - 1
+	if numPeers <= 0 {
+		return nil
+	}
+
+	inbox := s.getMetadataReplyInbox()
+	sub, err := s.ncRaft.SubscribeSync(inbox)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	var (
+		serverID = s.config.Clustering.ServerID
+		address  = s.getConnectionAddress()
+	)
+	req, err := proto.MarshalServerInfoRequest(&proto.ServerInfoRequest{
+		Id:   serverID,
+		Rtts: s.rtts.row(serverID),
+		Host: address.Host,
+		Port: int32(address.Port),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	timeout := s.config.Clustering.RTTProbeInterval
+	if timeout > maxRTTProbeTimeout {
+		timeout = maxRTTProbeTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := s.ncRaft.PublishRequest(s.getServerInfoInbox(), inbox, s.auth.sign(req)); err != nil {
+		return err
+	}
+
+	var (
+		rtts      = make(map[string]time.Duration, numPeers)
+		addresses = make(serverAddresses, numPeers)
+	)
+	for len(rtts) < numPeers {
+		msg, err := sub.NextMsgWithContext(ctx)
+		if err != nil {
+			break
+		}
+		rtt := time.Since(start)
+		if !s.auth.verify(msg.Subject, msg.Data) {
+			continue
+		}
+		resp, err := proto.UnmarshalServerInfoResponse(msg.Data)
+		if err != nil {
+			s.logger.Warnf("Received invalid server info response: %v", err)
+			continue
+		}
+		rtts[resp.Id] = rtt
+		addresses.add(resp.Id, HostPort{Host: resp.Host, Port: int(resp.Port)})
+	}
+	s.rtts.update(serverID, rtts)
+	s.detectDuplicateServerIDs(addresses)
+	return nil
+}
+
+// selectLeaderByLatency selects a leader from the given replicas, which must
+// be ordered by leadership load, least loaded first. Among the replicas whose
+// load is within the configured tolerance of the least loaded, the one with
+// the lowest median RTT to the other replicas is selected. Replicas without
+// RTT measurements are only selected if none of the eligible replicas have
+// measurements.
+func (m *metadataAPI) selectLeaderByLatency(replicas []string, loads map[string]int) string {
+	var (
+		leader   = replicas[0]
+		maxLoad  = loads[replicas[0]] + m.config.Clustering.LeaderLoadTolerance
+		bestRTT  time.Duration
+		haveBest bool
+	)
+	for _, replica := range replicas {
+		if loads[replica] > maxLoad {
+			break
+		}
+		rtt, ok := m.rtts.medianRTT(replica, replicas)
+		if !ok {
+			continue
+		}
+		if !haveBest || rtt < bestRTT {
+			leader = replica
+			bestRTT = rtt
+			haveBest = true
+		}
+	}
+	return leader
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Ensure medianRTT falls back to the peer's measurement when the broker has
+// none and ignores stale rows.
+func TestRTTMatrixMedianRTT(t *testing.T) {
+	rtts := newRTTMatrix(time.Hour)
+	rtts.update("a", map[string]time.Duration{
+		"b": 1 * time.Millisecond,
+		"c": 5 * time.Millisecond,
+	})
+	rtts.update("d", map[string]time.Duration{"a": 3 * time.Millisecond})
+
+	rtt, ok := rtts.medianRTT("a", []string{"a", "b", "c", "d"})
+	require.True(t, ok)
+	require.Equal(t, 3*time.Millisecond, rtt)
+
+	rtt, ok = rtts.medianRTT("a", []string{"a", "b", "c"})
+	require.True(t, ok)
+	require.Equal(t, 3*time.Millisecond, rtt)
+
+	// b has no row of its own but a measured the RTT to it.
+	rtt, ok = rtts.medianRTT("b", []string{"a", "b"})
+	require.True(t, ok)
+	require.Equal(t, time.Millisecond, rtt)
+
+	_, ok = rtts.medianRTT("b", []string{"b", "c"})
+	require.False(t, ok)
+
+	// Stale rows are ignored.
+	rtts.rows["a"].measuredAt = time.Now().Add(-2 * time.Hour)
+	_, ok = rtts.medianRTT("a", []string{"a", "b", "c"})
+	require.False(t, ok)
+	require.Len(t, rtts.snapshot(), 1)
+	require.Nil(t, rtts.row("a"))
+}
+
+// Ensure selectPartitionLeader prefers the replica with the lowest median RTT
+// to the other replicas when latency-aware placement is enabled, but only
+// among the replicas within the load tolerance.
+func TestSelectPartitionLeaderLatency(t *testing.T) {
+	config := getTestConfig("a", true, 0)
+	config.Clustering.LeaderPlacement = LeaderPlacementLatency
+	config.Clustering.LeaderLoadTolerance = 1
+	metadata := newMetadataAPI(New(config))
+
+	// a and b are in one zone, c and d in another, with 20ms between zones.
+	rtts := map[string]map[string]time.Duration{
+		"a": {"b": 1 * time.Millisecond, "c": 20 * time.Millisecond, "d": 20 * time.Millisecond},
+		"b": {"a": 1 * time.Millisecond, "c": 20 * time.Millisecond, "d": 20 * time.Millisecond},
+		"c": {"a": 20 * time.Millisecond, "b": 20 * time.Millisecond, "d": 1 * time.Millisecond},
+		"d": {"a": 20 * time.Millisecond, "b": 20 * time.Millisecond, "c": 1 * time.Millisecond},
+	}
+	for broker, row := range rtts {
+		metadata.rtts.update(broker, row)
+	}
+
+	setLoads := func(loads map[string]int) {
+		metadata.stats.Lock()
+		metadata.stats.brokerLeaderLoad = loads
+		metadata.stats.Unlock()
+	}
+
+	// c is least loaded, but a is within the tolerance and has the lowest
+	// median RTT to the other replicas.
+	setLoads(map[string]int{"a": 1, "b": 2, "c": 0})
+	require.Equal(t, "a", metadata.selectPartitionLeader([]string{"a", "b", "c"}))
+
+	// b is closer to the other replicas, but it's outside the tolerance.
+	setLoads(map[string]int{"a": 3, "b": 2, "c": 0, "d": 1})
+	require.Equal(t, "c", metadata.selectPartitionLeader([]string{"a", "b", "c"}))
+
+	// The tolerance is relative to the least loaded replica, so b is
+	// preferred when d is the least loaded.
+	require.Equal(t, "b", metadata.selectPartitionLeader([]string{"a", "b", "d"}))
+
+	// Ties on RTT keep the load ordering.
+	setLoads(map[string]int{"a": 1, "b": 0})
+	require.Equal(t, "b", metadata.selectPartitionLeader([]string{"a", "b"}))
+
+	// Without measurements, selection falls back to load.
+	setLoads(map[string]int{"x": 1, "y": 0})
+	require.Equal(t, "y", metadata.selectPartitionLeader([]string{"x", "y"}))
+
+	// Load-only placement ignores RTTs.
+	config.Clustering.LeaderPlacement = LeaderPlacementLoad
+	setLoads(map[string]int{"a": 1, "b": 2, "c": 0})
+	require.Equal(t, "c", metadata.selectPartitionLeader([]string{"a", "b", "c"}))
+}
@@ -0,0 +1,425 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// scheduledOperationPastTolerance is how far before the metadata leader's
+	// current time an operation may be scheduled to execute, allowing for
+	// clock skew between the requester and the leader. Such operations
+	// execute right away, while ones scheduled further in the past are
+	// rejected.
+	scheduledOperationPastTolerance = time.Minute
+
+	// scheduledOperationExecuteTimeout is how long the metadata leader waits
+	// for the execution of a due scheduled operation to be applied.
+	scheduledOperationExecuteTimeout = 30 * time.Second
+
+	// maxFinishedScheduledOperations is the number of executed and canceled
+	// scheduled operations kept in the metadata so they can be listed. The
+	// ones which finished first are dropped when more finish.
+	maxFinishedScheduledOperations = 100
+
+	// scheduledOperationHeader is set on the activity stream events of
+	// executed scheduled operations to the ID of the scheduled operation.
+	scheduledOperationHeader = "liftbridge-scheduled-operation"
+)
+
+// copyScheduledOperation returns a copy of the given scheduled operation
+// which can be handed out while the FSM keeps changing the original.
+func copyScheduledOperation(op *proto.ScheduledOperation) *proto.ScheduledOperation {
+	cp := *op
+	cp.Partitions = append([]int32(nil), op.Partitions...)
+	return &cp
+}
+
+// addScheduledOperation adds the given scheduled operation to the metadata
+// store.
+func (m *metadataAPI) addScheduledOperation(op *proto.ScheduledOperation) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.scheduledOps[op.Id] = op
+}
+
+// getScheduledOperation returns a copy of the scheduled operation with the
+// given ID or nil if it doesn't exist.
+func (m *metadataAPI) getScheduledOperation(id uint64) *proto.ScheduledOperation {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	op, ok := m.scheduledOps[id]
+	if !ok {
+		return nil
+	}
+	return copyScheduledOperation(op)
+}
+
+// GetScheduledOperations returns copies of the scheduled operations on the
+// given stream, or on all streams if it's empty, ordered by ID. Executed and
+// canceled operations are only included if includeFinished is set.
+func (m *metadataAPI) GetScheduledOperations(stream string, includeFinished bool) []*proto.ScheduledOperation {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ops := make([]*proto.ScheduledOperation, 0, len(m.scheduledOps))
+	for _, op := range m.scheduledOps {
+		if stream != "" && op.Stream != stream {
+			continue
+		}
+		if !includeFinished && op.State != proto.ScheduledOperationState_SCHEDULED_OPERATION_PENDING {
+			continue
+		}
+		ops = append(ops, copyScheduledOperation(op))
+	}
+	sort.Slice(ops, func(i, j int) bool { return ops[i].Id < ops[j].Id })
+	return ops
+}
+
+// finishScheduledOperation applies the given update to the pending scheduled
+// operation with the given ID, which must move it out of the pending state,
+// and returns a copy of the result. It returns nil if the operation doesn't
+// exist or isn't pending. The finished operations beyond the most recent
+// maxFinishedScheduledOperations are dropped.
+func (m *metadataAPI) finishScheduledOperation(id uint64,
+	update func(*proto.ScheduledOperation)) *proto.ScheduledOperation {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	op, ok := m.scheduledOps[id]
+	if !ok || op.State != proto.ScheduledOperationState_SCHEDULED_OPERATION_PENDING {
+		return nil
+	}
+	update(op)
+
+	finished := make([]*proto.ScheduledOperation, 0, len(m.scheduledOps))
+	for _, op := range m.scheduledOps {
+		if op.State != proto.ScheduledOperationState_SCHEDULED_OPERATION_PENDING {
+			finished = append(finished, op)
+		}
+	}
+	if len(finished) > maxFinishedScheduledOperations {
+		sort.Slice(finished, func(i, j int) bool {
+			return finished[i].ExecutedIndex < finished[j].ExecutedIndex
+		})
+		for _, op := range finished[:len(finished)-maxFinishedScheduledOperations] {
+			delete(m.scheduledOps, op.Id)
+		}
+	}
+	return copyScheduledOperation(op)
+}
+
+// scheduledOperationPartitions returns the partitions the given scheduled
+// operation applies to, which are all of the stream's partitions if none
+// were specified. It returns ErrStreamNotFound if the stream doesn't exist or
+// is being deleted and ErrPartitionNotFound if one of the partitions doesn't
+// exist.
+func (m *metadataAPI) scheduledOperationPartitions(op *proto.ScheduledOperation) ([]int32, error) {
+	stream := m.GetStream(op.Stream)
+	if stream == nil || stream.IsTombstoned() {
+		return nil, ErrStreamNotFound
+	}
+	if len(op.Partitions) == 0 {
+		partitions := stream.GetPartitions()
+		ids := make([]int32, 0, len(partitions))
+		for id := range partitions {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		return ids, nil
+	}
+	for _, id := range op.Partitions {
+		if stream.GetPartition(id) == nil {
+			return nil, ErrPartitionNotFound
+		}
+	}
+	return op.Partitions, nil
+}
+
+// ScheduleOperation schedules a stream operation if this server is the
+// metadata leader. If it is not, it will forward the request to the leader
+// and return the response. This operation is replicated by Raft. The Raft
+// index of the operation is returned as the scheduled operation's ID.
+func (m *metadataAPI) ScheduleOperation(ctx context.Context, req *proto.ScheduleOperationOp) (
+	*proto.ScheduledOperation, *status.Status) {
+
+	// Forward the request if we're not the leader.
+	if !m.IsLeader() {
+		resp, isLeader, st := m.propagateScheduleOperation(ctx, req)
+		if st != nil {
+			return nil, st
+		}
+		// If we have since become leader, continue on with the request.
+		if !isLeader {
+			return resp.Operation, nil
+		}
+	}
+
+	// Operations are due according to the leader's clock, so it's also the
+	// one checking the execution time isn't in the past.
+	now := time.Now()
+	operation := req.Operation
+	if operation.ExecuteAt < now.Add(-scheduledOperationPastTolerance).UnixNano() {
+		return nil, status.Newf(codes.InvalidArgument, "Execution time %s is in the past",
+			time.Unix(0, operation.ExecuteAt).UTC().Format(time.RFC3339Nano))
+	}
+	operation.CreatedAt = now.UnixNano()
+
+	// Replicate the scheduled operation through Raft.
+	op := &proto.RaftLog{
+		Op:                  proto.Op_SCHEDULE_OPERATION,
+		ScheduleOperationOp: req,
+	}
+
+	// Older servers are unable to apply the operation.
+	if err := m.assertMinVersion(ctx, opProtocolVersions[op.Op]); err != nil {
+		code := codes.Internal
+		if errors.Cause(err) == ErrProtocolVersion {
+			code = codes.FailedPrecondition
+		}
+		return nil, status.New(code, err.Error())
+	}
+
+	// Wait on result of replication.
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkScheduleOperationPreconditions)
+	if err != nil {
+		code := codes.FailedPrecondition
+		if err == ErrStreamNotFound || err == ErrPartitionNotFound {
+			code = codes.NotFound
+		}
+		return nil, status.Newf(code, "%s", err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return nil, status.Newf(codes.Internal, "Failed to schedule operation: %v", err.Error())
+	}
+
+	operation.Id = future.Index()
+	return operation, nil
+}
+
+// CancelScheduledOperation cancels a pending scheduled operation if this
+// server is the metadata leader. If it is not, it will forward the request to
+// the leader and return the response. This operation is replicated by Raft.
+func (m *metadataAPI) CancelScheduledOperation(ctx context.Context, req *proto.CancelScheduledOperationOp) *status.Status {
+	// Forward the request if we're not the leader.
+	if !m.IsLeader() {
+		isLeader, st := m.propagateCancelScheduledOperation(ctx, req)
+		if st != nil {
+			return st
+		}
+		// If we have since become leader, continue on with the request.
+		if !isLeader {
+			return nil
+		}
+	}
+
+	// Replicate the cancellation through Raft.
+	op := &proto.RaftLog{
+		Op:                         proto.Op_CANCEL_SCHEDULED_OPERATION,
+		CancelScheduledOperationOp: req,
+	}
+
+	// Wait on result of replication.
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkCancelScheduledOperationPreconditions)
+	if err != nil {
+		code := codes.FailedPrecondition
+		if err == ErrScheduledOperationNotFound {
+			code = codes.NotFound
+		}
+		return status.Newf(code, "%s", err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to cancel scheduled operation: %v", err.Error())
+	}
+
+	return nil
+}
+
+// ExecuteScheduledOperation executes the pending scheduled operation with the
+// given ID. This must be called on the metadata leader. This operation is
+// replicated by Raft, which executes the operation and records the outcome
+// in the same entry. An operation which was already executed or canceled,
+// e.g. by a previous leader, is left alone, so operations are executed at
+// most once.
+func (m *metadataAPI) ExecuteScheduledOperation(ctx context.Context, id uint64, now time.Time) *status.Status {
+	op := &proto.RaftLog{
+		Op: proto.Op_EXECUTE_SCHEDULED_OPERATION,
+		ExecuteScheduledOperationOp: &proto.ExecuteScheduledOperationOp{
+			Id:         id,
+			ExecutedAt: now.UnixNano(),
+			ExecutedBy: m.config.Clustering.ServerID,
+		},
+	}
+
+	// Wait on result of replication.
+	future, err := m.getRaft().applyOperation(ctx, op, m.checkExecuteScheduledOperationPreconditions)
+	if err == ErrScheduledOperationNotFound || err == ErrScheduledOperationNotPending {
+		return nil
+	}
+	if err != nil {
+		return status.Newf(codes.FailedPrecondition, "%s", err.Error())
+	}
+	if err := future.Error(); err != nil {
+		return status.Newf(codes.Internal, "Failed to execute scheduled operation: %v", err.Error())
+	}
+
+	return nil
+}
+
+// checkScheduleOperationPreconditions checks if the stream and partitions the
+// operation is scheduled on exist. If the stream doesn't exist, it returns
+// ErrStreamNotFound. If one or more specified partitions don't exist, it
+// returns ErrPartitionNotFound. Otherwise, it returns nil.
+func (m *metadataAPI) checkScheduleOperationPreconditions(op *proto.RaftLog) error {
+	_, err := m.scheduledOperationPartitions(op.ScheduleOperationOp.Operation)
+	return err
+}
+
+// checkCancelScheduledOperationPreconditions checks if the scheduled
+// operation being canceled is pending. If it doesn't exist, it returns
+// ErrScheduledOperationNotFound. If it was already executed or canceled, it
+// returns ErrScheduledOperationNotPending. Otherwise, it returns nil.
+func (m *metadataAPI) checkCancelScheduledOperationPreconditions(op *proto.RaftLog) error {
+	return m.checkScheduledOperationPending(op.CancelScheduledOperationOp.Id)
+}
+
+// checkExecuteScheduledOperationPreconditions checks if the scheduled
+// operation being executed is pending. If it doesn't exist, it returns
+// ErrScheduledOperationNotFound. If it was already executed or canceled, it
+// returns ErrScheduledOperationNotPending. Otherwise, it returns nil.
+func (m *metadataAPI) checkExecuteScheduledOperationPreconditions(op *proto.RaftLog) error {
+	return m.checkScheduledOperationPending(op.ExecuteScheduledOperationOp.Id)
+}
+
+// checkScheduledOperationPending returns ErrScheduledOperationNotFound if the
+// scheduled operation with the given ID doesn't exist and
+// ErrScheduledOperationNotPending if it isn't pending.
+func (m *metadataAPI) checkScheduledOperationPending(id uint64) error {
+	op := m.getScheduledOperation(id)
+	if op == nil {
+		return ErrScheduledOperationNotFound
+	}
+	if op.State != proto.ScheduledOperationState_SCHEDULED_OPERATION_PENDING {
+		return ErrScheduledOperationNotPending
+	}
+	return nil
+}
+
+// propagateScheduleOperation forwards a ScheduleOperation request to the
+// metadata leader. The bool indicates if this server has since become leader
+// and the request should be performed locally. A Status is returned if the
+// propagated request failed.
+func (m *metadataAPI) propagateScheduleOperation(ctx context.Context, req *proto.ScheduleOperationOp) (
+	*proto.PropagatedResponse_ScheduleOperationResponse, bool, *status.Status) {
+	propagate := &proto.PropagatedRequest{
+		Op:                  proto.Op_SCHEDULE_OPERATION,
+		ScheduleOperationOp: req,
+	}
+	resp, isLeader, status := m.propagateRequest(ctx, propagate)
+	if status != nil {
+		return nil, false, status
+	}
+	if isLeader {
+		return nil, true, nil
+	}
+	return resp.ScheduleOperationResp, isLeader, status
+}
+
+// propagateCancelScheduledOperation forwards a CancelScheduledOperation
+// request to the metadata leader. The bool indicates if this server has since
+// become leader and the request should be performed locally. A Status is
+// returned if the propagated request failed.
+func (m *metadataAPI) propagateCancelScheduledOperation(ctx context.Context,
+	req *proto.CancelScheduledOperationOp) (bool, *status.Status) {
+	propagate := &proto.PropagatedRequest{
+		Op:                         proto.Op_CANCEL_SCHEDULED_OPERATION,
+		CancelScheduledOperationOp: req,
+	}
+	_, isLeader, status := m.propagateRequest(ctx, propagate)
+	return isLeader, status
+}
+
+// scheduledOperationsLoop periodically executes the scheduled operations which
+// are due while this server is the metadata leader until the server shuts
+// down.
+func (s *Server) scheduledOperationsLoop() {
+	ticker := time.NewTicker(s.config.Clustering.ScheduledOpCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-ticker.C:
+		}
+		if s.IsLeader() {
+			s.executeDueOperations(time.Now())
+		}
+	}
+}
+
+// executeDueOperations executes the pending scheduled operations whose
+// execution time is at or before the given time, in the order they're due.
+// Executions go through Raft, so an operation which is found due again before
+// its execution is applied, e.g. by a new leader, is only executed once.
+func (s *Server) executeDueOperations(now time.Time) {
+	ops := s.metadata.GetScheduledOperations("", false)
+	sort.SliceStable(ops, func(i, j int) bool { return ops[i].ExecuteAt < ops[j].ExecuteAt })
+	for _, op := range ops {
+		if op.ExecuteAt > now.UnixNano() {
+			break
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), scheduledOperationExecuteTimeout)
+		st := s.metadata.ExecuteScheduledOperation(ctx, op.Id, now)
+		cancel()
+		if st != nil {
+			s.logger.Errorf("Failed to execute scheduled operation %d: %v", op.Id, st.Err())
+		}
+	}
+}
+
+// scheduledOperationActivityEvent fills in the activity stream event for the
+// given executed scheduled operation. Scheduled operations are reported as
+// the stream operation they performed.
+func scheduledOperationActivityEvent(op *proto.ScheduledOperation, event *client.ActivityStreamEvent) (
+	map[string][]byte, bool) {
+
+	switch op.Type {
+	case proto.ScheduledOperationType_SCHEDULED_PAUSE_STREAM:
+		event.Op = client.ActivityStreamOp_PAUSE_STREAM
+		event.PauseStreamOp = &client.PauseStreamOp{
+			Stream:     op.Stream,
+			Partitions: op.Partitions,
+		}
+	case proto.ScheduledOperationType_SCHEDULED_RESUME_STREAM:
+		event.Op = client.ActivityStreamOp_RESUME_STREAM
+		event.ResumeStreamOp = &client.ResumeStreamOp{
+			Stream:     op.Stream,
+			Partitions: op.Partitions,
+		}
+	case proto.ScheduledOperationType_SCHEDULED_SET_STREAM_READONLY:
+		event.Op = client.ActivityStreamOp_SET_STREAM_READONLY
+		event.SetStreamReadonlyOp = &client.SetStreamReadonlyOp{
+			Stream:     op.Stream,
+			Partitions: op.Partitions,
+			Readonly:   op.Readonly,
+		}
+	case proto.ScheduledOperationType_SCHEDULED_DELETE_STREAM:
+		event.Op = client.ActivityStreamOp_DELETE_STREAM
+		event.DeleteStreamOp = &client.DeleteStreamOp{
+			Stream: op.Stream,
+		}
+	default:
+		return nil, false
+	}
+	return map[string][]byte{
+		scheduledOperationHeader: []byte(strconv.FormatUint(op.Id, 10)),
+	}, true
+}
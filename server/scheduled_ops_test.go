@@ -0,0 +1,218 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure a scheduled operation is executed exactly once by the new metadata
+// leader when the leader it was scheduled on fails before it's due.
+func TestScheduledOperationFailover(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server so the servers can be stopped.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	// Configure servers.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.EmbeddedNATS = false
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+	s3Config := getTestConfig("c", false, 5052)
+	s3 := runServerWithConfig(t, s3Config)
+	defer s3.Stop()
+	servers := []*Server{s1, s2, s3}
+	leader := getMetadataLeader(t, 10*time.Second, servers...)
+
+	// Count the executions applied by each server.
+	executions := make(map[*Server]*int32)
+	for _, s := range servers {
+		count := new(int32)
+		executions[s] = count
+		s.AddRaftLogListener(&raftLogListener{func(log *RaftLog) {
+			op := &proto.RaftLog{}
+			if err := op.Unmarshal(log.Data); err == nil && op.Op == proto.Op_EXECUTE_SCHEDULED_OPERATION {
+				atomic.AddInt32(count, 1)
+			}
+		}})
+	}
+
+	lc, err := lift.Connect([]string{"localhost:5050", "localhost:5051", "localhost:5052"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	name := "foo"
+	require.NoError(t, lc.CreateStream(context.Background(), name, name, lift.ReplicationFactor(3)))
+	waitForPartition(t, 10*time.Second, name, 0, servers...)
+
+	var followers []*Server
+	for _, s := range servers {
+		if s != leader {
+			followers = append(followers, s)
+		}
+	}
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", followers[0].config.Port), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+
+	// Schedule the stream to become readonly through a follower.
+	executeAt := time.Now().Add(4 * time.Second)
+	resp, err := admin.ScheduleStreamOperation(context.Background(), &proto.ScheduleStreamOperationRequest{
+		Type:      proto.ScheduledOperationType_SCHEDULED_SET_STREAM_READONLY,
+		Stream:    name,
+		Readonly:  true,
+		ExecuteAt: executeAt.UnixNano(),
+	})
+	require.NoError(t, err)
+	id := resp.Operation.Id
+	require.NotZero(t, id)
+	require.Equal(t, proto.ScheduledOperationState_SCHEDULED_OPERATION_PENDING, resp.Operation.State)
+
+	// Wait for the followers to apply it, then fail over the metadata leader
+	// before it's due.
+	require.Eventually(t, func() bool {
+		for _, s := range followers {
+			if len(s.metadata.GetScheduledOperations(name, false)) != 1 {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+	require.True(t, time.Now().Before(executeAt))
+	leader.Stop()
+	newLeader := getMetadataLeader(t, 10*time.Second, followers...)
+
+	// The new leader executes the operation once it's due.
+	require.Eventually(t, func() bool {
+		for _, s := range followers {
+			op := s.metadata.getScheduledOperation(id)
+			if op.State != proto.ScheduledOperationState_SCHEDULED_OPERATION_SUCCEEDED ||
+				!s.metadata.GetPartition(name, 0).IsReadonly() {
+				return false
+			}
+		}
+		return true
+	}, 15*time.Second, 10*time.Millisecond)
+	op := newLeader.metadata.getScheduledOperation(id)
+	require.Equal(t, newLeader.config.Clustering.ServerID, op.ExecutedBy)
+	require.GreaterOrEqual(t, op.ExecutedAt, executeAt.UnixNano())
+	require.Equal(t, []int32{0}, op.Partitions)
+
+	// Executing it again does nothing.
+	require.Nil(t, newLeader.metadata.ExecuteScheduledOperation(context.Background(), id, time.Now()))
+	time.Sleep(2 * newLeader.config.Clustering.ScheduledOpCheckInterval)
+	for _, s := range followers {
+		require.Equal(t, int32(1), atomic.LoadInt32(executions[s]))
+	}
+	require.Equal(t, op, newLeader.metadata.getScheduledOperation(id))
+
+	// Executed operations can't be canceled.
+	_, err = admin.CancelScheduledOperation(context.Background(), &proto.CancelScheduledOperationRequest{Id: id})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// Ensure scheduled operations can be listed and canceled and are marked
+// failed if their stream no longer exists when they're due.
+func TestScheduledOperationLifecycle(t *testing.T) {
+	defer cleanupStorage(t)
+
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	lc, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer lc.Close()
+	require.NoError(t, lc.CreateStream(context.Background(), "foo", "foo"))
+	require.NoError(t, lc.CreateStream(context.Background(), "bar", "bar"))
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	admin := proto.NewAdminAPIClient(conn)
+	schedule := func(stream string, executeAt time.Time) (*proto.ScheduledOperation, error) {
+		resp, err := admin.ScheduleStreamOperation(context.Background(), &proto.ScheduleStreamOperationRequest{
+			Type:      proto.ScheduledOperationType_SCHEDULED_PAUSE_STREAM,
+			Stream:    stream,
+			ExecuteAt: executeAt.UnixNano(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Operation, nil
+	}
+	list := func(stream string, includeFinished bool) []*proto.ScheduledOperation {
+		resp, err := admin.ListScheduledOperations(context.Background(), &proto.ListScheduledOperationsRequest{
+			Stream:          stream,
+			IncludeFinished: includeFinished,
+		})
+		require.NoError(t, err)
+		return resp.Operations
+	}
+
+	// Invalid operations are rejected.
+	_, err = schedule("baz", time.Now().Add(time.Hour))
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = schedule("foo", time.Now().Add(-time.Hour))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Pending operations are listed.
+	foo, err := schedule("foo", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	bar, err := schedule("bar", time.Now().Add(2*time.Second))
+	require.NoError(t, err)
+	require.Len(t, list("", false), 2)
+	ops := list("foo", false)
+	require.Len(t, ops, 1)
+	require.Equal(t, foo.Id, ops[0].Id)
+	require.Equal(t, proto.ScheduledOperationType_SCHEDULED_PAUSE_STREAM, ops[0].Type)
+	require.Equal(t, foo.ExecuteAt, ops[0].ExecuteAt)
+
+	// Canceled operations are only listed as finished.
+	_, err = admin.CancelScheduledOperation(context.Background(), &proto.CancelScheduledOperationRequest{Id: foo.Id})
+	require.NoError(t, err)
+	require.Empty(t, list("foo", false))
+	ops = list("foo", true)
+	require.Len(t, ops, 1)
+	require.Equal(t, proto.ScheduledOperationState_SCHEDULED_OPERATION_CANCELED, ops[0].State)
+	_, err = admin.CancelScheduledOperation(context.Background(), &proto.CancelScheduledOperationRequest{Id: foo.Id})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = admin.CancelScheduledOperation(context.Background(), &proto.CancelScheduledOperationRequest{Id: 1000})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// An operation on a stream deleted before it's due fails.
+	require.NoError(t, lc.DeleteStream(context.Background(), "bar"))
+	require.Eventually(t, func() bool {
+		ops := list("bar", true)
+		return len(ops) == 1 && ops[0].State == proto.ScheduledOperationState_SCHEDULED_OPERATION_FAILED
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, ErrStreamNotFound.Error(), list("bar", true)[0].Error)
+	require.Equal(t, bar.Id, list("bar", true)[0].Id)
+
+	// Scheduled operations are restored from snapshots.
+	expected := s1.metadata.GetScheduledOperations("", true)
+	require.NoError(t, s1.getRaft().Snapshot().Error())
+	s1.Stop()
+	s1 = runServerWithConfig(t, s1.config)
+	defer s1.Stop()
+	getMetadataLeader(t, 10*time.Second, s1)
+	require.Equal(t, expected, s1.metadata.GetScheduledOperations("", true))
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/x509"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -19,6 +20,8 @@ import (
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nuid"
 	"github.com/pkg/errors"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
@@ -62,35 +65,58 @@ type authzEnforcer struct {
 // Server is the main Liftbridge object. Create it by calling New or
 // RunServerWithConfig.
 type Server struct {
-	config             *Config
-	listener           net.Listener
-	port               int
-	embeddedNATS       *gnatsd.Server
-	nc                 *nats.Conn
-	ncRaft             *nats.Conn
-	ncRepl             *nats.Conn
-	ncAcks             *nats.Conn
-	ncPublishes        *nats.Conn
-	logger             logger.Logger
-	grpcServer         *grpc.Server
-	api                *apiServer
-	metadata           *metadataAPI
-	shutdownCh         chan struct{}
-	raftInitialized    chan struct{}
-	raft               atomic.Value
-	leaderSub          *nats.Subscription
-	recoveryStarted    bool
-	latestRecoveredLog *raft.Log
-	mu                 sync.RWMutex
-	shutdown           bool
-	running            bool
-	goroutineWait      sync.WaitGroup
-	activity           *activityManager
-	cursors            *cursorManager
-	raftLogListenersMu sync.RWMutex
-	raftLogListeners   []RaftLogListener
-	authzEnforcer      *authzEnforcer
-	telemetry          *telemetry.Collector
+	config              *Config
+	listener            net.Listener
+	port                int
+	namedListeners      []*namedListener
+	embeddedNATS        *gnatsd.Server
+	nc                  *nats.Conn
+	ncRaft              *nats.Conn
+	ncRepl              *nats.Conn
+	ncAcks              *nats.Conn
+	ncPublishes         *nats.Conn
+	logger              logger.Logger
+	grpcServer          *grpc.Server
+	streamInterceptors  []grpc.StreamServerInterceptor // Chained by the gRPC server, shared by the WebSocket server
+	tlsConfig           *tls.Config                    // nil if TLS is disabled
+	webSocketServer     *http.Server                   // nil if the WebSocket server is disabled
+	api                 *apiServer
+	metadata            *metadataAPI
+	shutdownCh          chan struct{}
+	raftInitialized     chan struct{}
+	raft                atomic.Value
+	leaderSub           *nats.Subscription
+	recoveryStarted     bool
+	latestRecoveredLog  *raft.Log
+	mu                  sync.RWMutex
+	shutdown            bool
+	running             bool
+	goroutineWait       sync.WaitGroup
+	activity            *activityManager
+	cursors             *cursorManager
+	raftLogListenersMu  sync.RWMutex
+	raftLogListeners    []RaftLogListener
+	authzEnforcer       *authzEnforcer
+	telemetry           *telemetry.Collector
+	allocations         *allocationTracker
+	auth                *clusterAuth
+	rtts                *rttMatrix
+	duplicateIDs        *duplicateServerIDs
+	skew                *skewTracker
+	intake              *intakeRegistry
+	exportLimiter       *byteRateLimiter
+	backup              backupSink // nil if backups are disabled
+	backupLimiter       *byteRateLimiter
+	replThrottle        *replicationThrottle
+	subLimiter          *subscriptionLimiter
+	emergencyRetention  emergencyRetention
+	tracer              trace.Tracer
+	tracerProvider      *sdktrace.TracerProvider // set if spans are exported to an OTLP endpoint
+	protocolVersion     uint32
+	replDownSince       int64 // Unix nanoseconds the replication NATS connection went down, 0 if up
+	draining            int32 // Set while gracefully stopping, see GracefulStop
+	inflightPublishes   int64 // Publishes waiting for an ack on this server
+	activeSubscriptions int64 // Subscribe RPCs being served
 }
 
 // RunServerWithConfig creates and starts a new Server with the given
@@ -108,7 +134,11 @@ func New(config *Config) *Server {
 	if config.DataDir == "" {
 		config.DataDir = filepath.Join("/tmp", "liftbridge", config.Clustering.Namespace)
 	}
-	logger := logger.NewLogger(config.LogLevel)
+	newLogger := logger.NewLogger
+	if config.LogFormat == LogFormatJSON {
+		newLogger = logger.NewJSONLogger
+	}
+	logger := newLogger(config.LogLevel)
 	if config.LogSilent {
 		logger.Silent(true)
 	}
@@ -117,10 +147,25 @@ func New(config *Config) *Server {
 		logger:          logger,
 		shutdownCh:      make(chan struct{}),
 		raftInitialized: make(chan struct{}),
+		protocolVersion: currentProtocolVersion,
 	}
 	s.metadata = newMetadataAPI(s)
 	s.activity = newActivityManager(s)
 	s.cursors = newCursorManager(s)
+	s.allocations = newAllocationTracker(config.Diagnostics.AllocationSampleRate)
+	s.auth = newClusterAuth(config.Clustering, logger)
+	s.rtts = newRTTMatrix(rttMaxAgeIntervals * config.Clustering.RTTProbeInterval)
+	s.duplicateIDs = newDuplicateServerIDs()
+	s.skew = newSkewTracker(skewMaxAgeIntervals * config.Skew.ReportInterval)
+	s.intake = newIntakeRegistry(s)
+	s.exportLimiter = newByteRateLimiter(config.Export.MaxBytesPerSecond)
+	if config.Backup.Dir != "" {
+		s.backup = newDirBackupSink(config.Backup.Dir)
+	}
+	s.backupLimiter = newByteRateLimiter(config.Backup.MaxBytesPerSecond)
+	s.replThrottle = newReplicationThrottle(config.Clustering.ReplicationThrottle)
+	s.subLimiter = newSubscriptionLimiter(config.SubscriptionMaxPerPartition, config.SubscriptionMaxPerServer)
+	s.tracer = newTracer()
 	return s
 }
 
@@ -133,6 +178,10 @@ func (s *Server) Start() (err error) {
 		}
 	}()
 
+	if s.config.Clustering.AuthStrict && !s.auth.enabled() {
+		return errors.New("strict cluster authentication requires auth keys")
+	}
+
 	// Create the data directory if it doesn't exist.
 	if err := os.MkdirAll(s.config.DataDir, os.ModePerm); err != nil {
 		return errors.Wrap(err, "failed to create data path directories")
@@ -157,9 +206,14 @@ func (s *Server) Start() (err error) {
 		}
 	}
 
+	if err := s.startTracing(); err != nil {
+		return errors.Wrap(err, "failed to start tracing")
+	}
+
 	s.logger.Infof("Liftbridge Version:        %s", Version)
 	s.logger.Infof("Server ID:                 %s", s.config.Clustering.ServerID)
 	s.logger.Infof("Namespace:                 %s", s.config.Clustering.Namespace)
+	s.logger.Infof("Subject Prefix:            %s", s.getSubjectPrefix())
 	s.logger.Infof("NATS Servers:              %s", s.config.NATSServersString())
 	s.logger.Infof("Default Retention Policy:  %s", s.config.Streams.RetentionString())
 	s.logger.Infof("Default Partition Pausing: %s", s.config.Streams.AutoPauseString())
@@ -187,6 +241,10 @@ func (s *Server) Start() (err error) {
 	s.logger.Infof("Starting Liftbridge server on %s...",
 		net.JoinHostPort(listenAddress.Host, strconv.Itoa(s.port)))
 
+	if err := s.startNamedListeners(); err != nil {
+		return err
+	}
+
 	// Set a lower bound of one second for SegmentMaxAge to avoid frequent log
 	// rolls which will cause performance problems. This is mainly here because
 	// SegmentMaxAge defaults to RetentionMaxAge if it's not set explicitly,
@@ -205,11 +263,58 @@ func (s *Server) Start() (err error) {
 		return errors.Wrap(err, "failed to subscribe to server info subject")
 	}
 
+	if s.config.Clustering.RTTProbeInterval > 0 {
+		s.startGoroutine(s.rttProbeLoop)
+	}
+
+	s.startGoroutine(s.metadataSweepLoop)
+
+	if s.config.Clustering.StreamTTLCheckInterval > 0 {
+		s.startGoroutine(s.streamTTLLoop)
+	}
+
+	if s.config.Clustering.ScheduledOpCheckInterval > 0 {
+		s.startGoroutine(s.scheduledOperationsLoop)
+	}
+
+	if s.config.Clustering.ReplicaRepair.Interval > 0 {
+		s.startGoroutine(s.replicaRepairLoop)
+	}
+
+	if s.config.Disk.EmergencyThreshold > 0 {
+		s.startGoroutine(s.diskMonitorLoop)
+	}
+
+	if s.backup != nil {
+		s.startGoroutine(s.backupLoop)
+	}
+
+	if s.config.Skew.ReportInterval > 0 {
+		if _, err := s.ncRaft.Subscribe(s.getPartitionLoadInbox(), s.handlePartitionLoadReport); err != nil {
+			return errors.Wrap(err, "failed to subscribe to partition load subject")
+		}
+		s.startGoroutine(s.skewReportLoop)
+	}
+
 	inbox := s.getPartitionStatusInbox(s.config.Clustering.ServerID)
 	if _, err := s.ncRaft.Subscribe(inbox, s.handlePartitionStatusRequest); err != nil {
 		return errors.Wrap(err, "failed to subscribe to partition status subject")
 	}
 
+	inbox = s.getPartitionCleanInbox(s.config.Clustering.ServerID)
+	if _, err := s.ncRaft.Subscribe(inbox, s.handlePartitionCleanRequest); err != nil {
+		return errors.Wrap(err, "failed to subscribe to partition clean subject")
+	}
+
+	inbox = s.getCursorInbox(s.config.Clustering.ServerID)
+	if _, err := s.ncRaft.Subscribe(inbox, s.handleCursorRequest); err != nil {
+		return errors.Wrap(err, "failed to subscribe to cursor subject")
+	}
+
+	if _, err := s.ncRaft.Subscribe(s.getPartitionStartedInbox(), s.handlePartitionStarted); err != nil {
+		return errors.Wrap(err, "failed to subscribe to partition started subject")
+	}
+
 	inbox = s.getPartitionNotificationInbox(s.config.Clustering.ServerID)
 	if _, err := s.ncRepl.Subscribe(inbox, s.handlePartitionNotification); err != nil {
 		return errors.Wrap(err, "failed to subscribe to partition notification subject")
@@ -221,6 +326,12 @@ func (s *Server) Start() (err error) {
 		return errors.Wrap(err, "failed to start API server")
 	}
 
+	if s.config.WebSocket.Enabled {
+		if err := s.startWebSocketServer(); err != nil {
+			return errors.Wrap(err, "failed to start WebSocket server")
+		}
+	}
+
 	s.startRaftLeadershipLoop(raftNode)
 
 	// Start telemetry collector.
@@ -261,10 +372,16 @@ func (s *Server) Stop() error {
 	if s.grpcServer != nil {
 		s.grpcServer.Stop()
 	}
+	if s.webSocketServer != nil {
+		s.webSocketServer.Close()
+	}
 
 	if s.listener != nil {
 		s.listener.Close()
 	}
+	for _, l := range s.namedListeners {
+		l.listener.Close()
+	}
 
 	if s.metadata != nil {
 		if err := s.metadata.Reset(); err != nil {
@@ -292,6 +409,8 @@ func (s *Server) Stop() error {
 	// Wait for goroutines to stop.
 	s.goroutineWait.Wait()
 
+	s.stopTracing()
+
 	return nil
 }
 
@@ -462,7 +581,11 @@ func (s *Server) closeNATSConns() {
 
 // startAPIServer configures and starts the gRPC API server.
 func (s *Server) startAPIServer() error {
-	opts := []grpc.ServerOption{}
+	var (
+		opts               []grpc.ServerOption
+		unaryInterceptors  = []grpc.UnaryServerInterceptor{s.traceUnaryInterceptor}
+		streamInterceptors = []grpc.StreamServerInterceptor{s.traceStreamInterceptor}
+	)
 
 	// Setup TLS if key/cert is set.
 	if s.config.TLSKey != "" && s.config.TLSCert != "" {
@@ -497,7 +620,8 @@ func (s *Server) startAPIServer() error {
 		}
 		// Configure authorization
 		if s.config.TLSClientAuthz && s.config.TLSClientAuthzModel != "" && s.config.TLSClientAuthzPolicy != "" {
-			opts = append(opts, grpc.UnaryInterceptor(AuthzUnaryInterceptor), grpc.StreamInterceptor(AuthzStreamInterceptor))
+			unaryInterceptors = append(unaryInterceptors, AuthzUnaryInterceptor)
+			streamInterceptors = append(streamInterceptors, AuthzStreamInterceptor)
 			policyEnforcer, err := casbin.NewEnforcer(s.config.TLSClientAuthzModel, s.config.TLSClientAuthzPolicy)
 			if err != nil {
 				return errors.Wrap(err, "failed to initialize authorization policy enforcer")
@@ -511,14 +635,36 @@ func (s *Server) startAPIServer() error {
 			s.authzEnforcer = &authzEnforcer{enforcer: policyEnforcer}
 		}
 
+		s.tlsConfig = &config
 		creds := credentials.NewTLS(&config)
 		opts = append(opts, grpc.Creds(creds))
 	}
 
+	// Authenticate clients with SASL credentials if enabled. This is chained
+	// after the TLS authorization interceptor so the authenticated username
+	// takes precedence over the client certificate for authorization.
+	if s.config.Auth.Enabled {
+		auth, err := newClientAuthenticator(s.config.Auth)
+		if err != nil {
+			return errors.Wrap(err, "failed to initialize client authentication")
+		}
+		unaryInterceptors = append(unaryInterceptors, auth.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, auth.streamInterceptor)
+	}
+
+	// Enforce stream ACLs once the caller's principal is known.
+	unaryInterceptors = append(unaryInterceptors, s.streamACLUnaryInterceptor)
+	streamInterceptors = append(streamInterceptors, s.streamACLStreamInterceptor)
+
+	opts = append(opts, grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...))
+	s.streamInterceptors = streamInterceptors
+
 	grpcServer := grpc.NewServer(opts...)
 	s.grpcServer = grpcServer
 	s.api = &apiServer{Server: s}
 	client.RegisterAPIServer(grpcServer, s.api)
+	proto.RegisterAdminAPIServer(grpcServer, s.api)
 
 	health.Register(grpcServer)
 
@@ -541,6 +687,19 @@ func (s *Server) startAPIServer() error {
 		}
 	})
 
+	for _, l := range s.namedListeners {
+		s.startGoroutine(func() {
+			if err := grpcServer.Serve(l.listener); err != nil {
+				select {
+				case <-s.shutdownCh:
+					return
+				default:
+					s.logger.Fatal(err)
+				}
+			}
+		})
+	}
+
 	return nil
 }
 
@@ -714,6 +873,9 @@ func (s *Server) natsDisconnectedHandler(nc *nats.Conn) {
 	} else {
 		s.logger.Errorf("Connection %q has been disconnected from NATS", nc.Opts.Name)
 	}
+	if isReplicationConn(nc) {
+		s.replicationDisconnected()
+	}
 }
 
 // natsReconnectedHandler fires when the given NATS connection has successfully
@@ -721,6 +883,9 @@ func (s *Server) natsDisconnectedHandler(nc *nats.Conn) {
 func (s *Server) natsReconnectedHandler(nc *nats.Conn) {
 	s.logger.Infof("Connection %q reconnected to NATS at %q",
 		nc.Opts.Name, nc.ConnectedUrl())
+	if isReplicationConn(nc) {
+		s.replicationReconnected()
+	}
 }
 
 // natsClosedHandler fires when the given NATS connection has been closed, i.e.
@@ -755,36 +920,59 @@ func (s *Server) natsErrorHandler(nc *nats.Conn, sub *nats.Subscription, err err
 // handleServerInfoRequest is a NATS handler used to process requests for
 // server information used in the metadata API.
 func (s *Server) handleServerInfoRequest(m *nats.Msg) {
+	if !s.auth.verify(m.Subject, m.Data) {
+		return
+	}
 	req, err := proto.UnmarshalServerInfoRequest(m.Data)
 	if err != nil {
 		s.logger.Warnf("Dropping invalid server info request: %v", err)
 		return
 	}
 
-	// Ignore requests from ourself.
-	if req.Id == s.config.Clustering.ServerID {
+	// Ignore requests from ourself. Requests from another server using our
+	// ID are answered so that it can tell it isn't alone.
+	connectionAddress := s.getConnectionAddress()
+	if req.Id == s.config.Clustering.ServerID && (req.Host == "" ||
+		HostPort{Host: req.Host, Port: int(req.Port)} == connectionAddress) {
 		return
 	}
 
-	connectionAddress := s.getConnectionAddress()
 	data, err := proto.MarshalServerInfoResponse(&proto.ServerInfoResponse{
-		Id:   s.config.Clustering.ServerID,
-		Host: connectionAddress.Host,
-		Port: int32(connectionAddress.Port),
+		Id:              s.config.Clustering.ServerID,
+		Host:            connectionAddress.Host,
+		Port:            int32(connectionAddress.Port),
+		ProtocolVersion: s.protocolVersion,
+		Listeners:       s.advertisedListeners(),
+		Draining:        s.isDraining(),
 	})
 	if err != nil {
 		panic(err)
 	}
 
-	if err := m.Respond(data); err != nil {
+	if err := m.Respond(s.auth.sign(data)); err != nil {
 		s.logger.Errorf("Failed to respond to server info request: %v", err)
 	}
+
+	// RTT probes carry the RTTs the requester measured on its previous probe.
+	// Requests used to fetch broker info don't, so they shouldn't replace the
+	// requester's measurements.
+	if len(req.Rtts) > 0 {
+		rtts := make(map[string]time.Duration, len(req.Rtts))
+		for _, rtt := range req.Rtts {
+			rtts[rtt.Broker] = time.Duration(rtt.Rtt)
+		}
+		s.rtts.update(req.Id, rtts)
+	}
 }
 
 // handlePartitionStatusRequest is a NATS handler used to process requests
 // querying the status of a partition. This is used as a readiness check to
-// determine if a created partition has actually started.
+// determine if a created partition has actually started and to report the
+// partition's status to FetchPartitionStatus.
 func (s *Server) handlePartitionStatusRequest(m *nats.Msg) {
+	if !s.auth.verify(m.Subject, m.Data) {
+		return
+	}
 	req, err := proto.UnmarshalPartitionStatusRequest(m.Data)
 	if err != nil {
 		s.logger.Warnf("Dropping invalid partition status request: %v", err)
@@ -793,7 +981,10 @@ func (s *Server) handlePartitionStatusRequest(m *nats.Msg) {
 
 	partition := s.metadata.GetPartition(req.Stream, req.Partition)
 
-	resp := &proto.PartitionStatusResponse{Exists: partition != nil}
+	resp := &proto.PartitionStatusResponse{
+		Exists: partition != nil,
+		Status: s.metadata.localPartitionStatus(req.Stream, req.Partition),
+	}
 	if partition != nil {
 		resp.IsLeader = partition.IsLeader()
 	}
@@ -803,11 +994,72 @@ func (s *Server) handlePartitionStatusRequest(m *nats.Msg) {
 		panic(err)
 	}
 
-	if err := m.Respond(data); err != nil {
+	if err := m.Respond(s.auth.sign(data)); err != nil {
 		s.logger.Errorf("Failed to respond to partition status request: %v", err)
 	}
 }
 
+// handlePartitionCleanRequest is a NATS handler used to process requests to
+// clean this server's replica of a partition sent by the server handling a
+// CleanPartition request. Requests are handled one at a time, so requested
+// cleans don't run concurrently on this server.
+func (s *Server) handlePartitionCleanRequest(m *nats.Msg) {
+	if !s.auth.verify(m.Subject, m.Data) {
+		return
+	}
+	req, err := proto.UnmarshalPartitionCleanRequest(m.Data)
+	if err != nil {
+		s.logger.Warnf("Dropping invalid partition clean request: %v", err)
+		return
+	}
+
+	resp := &proto.PartitionCleanResponse{
+		Result: s.metadata.localPartitionClean(req.Stream, req.Partition),
+	}
+
+	data, err := proto.MarshalPartitionCleanResponse(resp)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := m.Respond(s.auth.sign(data)); err != nil {
+		s.logger.Errorf("Failed to respond to partition clean request: %v", err)
+	}
+}
+
+// handlePartitionStarted is a NATS handler used to process announcements from
+// partition leaders that they have finished starting a partition. This wakes
+// up any requests on this server waiting for the partition to start.
+func (s *Server) handlePartitionStarted(m *nats.Msg) {
+	if !s.auth.verify(m.Subject, m.Data) {
+		return
+	}
+	req, err := proto.UnmarshalPartitionStarted(m.Data)
+	if err != nil {
+		s.logger.Warnf("Dropping invalid partition started notification: %v", err)
+		return
+	}
+	s.metadata.partitionStarted(req.Stream, req.Partition)
+}
+
+// handlePartitionLoadReport is a NATS handler used to process the partition
+// loads periodically reported by each broker for skew detection.
+func (s *Server) handlePartitionLoadReport(m *nats.Msg) {
+	if !s.auth.verify(m.Subject, m.Data) {
+		return
+	}
+	report, err := proto.UnmarshalPartitionLoadReport(m.Data)
+	if err != nil {
+		s.logger.Warnf("Dropping invalid partition load report: %v", err)
+		return
+	}
+	// Our own report is recorded when it's sent.
+	if report.Id == s.config.Clustering.ServerID {
+		return
+	}
+	s.skew.update(report.Id, report.Partitions)
+}
+
 // handlePartitionNotification is a NATS handler used to process notifications
 // from a leader that new data is available on a partition for the follower to
 // replicate if the follower is idle.
@@ -819,6 +1071,9 @@ func (s *Server) handlePartitionStatusRequest(m *nats.Msg) {
 // caught up and send a notification in order to wake an idle follower back up
 // when new data is written to the log.
 func (s *Server) handlePartitionNotification(m *nats.Msg) {
+	if !s.auth.verify(m.Subject, m.Data) {
+		return
+	}
 	req, err := proto.UnmarshalPartitionNotification(m.Data)
 	if err != nil {
 		s.logger.Warnf("Dropping invalid partition notification: %v", err)
@@ -848,8 +1103,32 @@ func (s *Server) getPartitionStatusInbox(id string) string {
 	return fmt.Sprintf("%s.status.%s", s.baseMetadataRaftSubject(), id)
 }
 
+// getPartitionCleanInbox returns the NATS subject used for handling requests to
+// clean the given server's replica of a partition.
+func (s *Server) getPartitionCleanInbox(id string) string {
+	return fmt.Sprintf("%s.clean.%s", s.baseMetadataRaftSubject(), id)
+}
+
+// getCursorInbox returns the NATS subject used for handling cursor requests
+// forwarded to the given server.
+func (s *Server) getCursorInbox(id string) string {
+	return fmt.Sprintf("%s.cursor.%s", s.baseMetadataRaftSubject(), id)
+}
+
+// getPartitionStartedInbox returns the NATS subject used for partition leaders
+// to announce they have finished starting a partition.
+func (s *Server) getPartitionStartedInbox() string {
+	return fmt.Sprintf("%s.started", s.baseMetadataRaftSubject())
+}
+
+// getPartitionLoadInbox returns the NATS subject used for brokers to report
+// the load of the partitions they lead.
+func (s *Server) getPartitionLoadInbox() string {
+	return fmt.Sprintf("%s.load", s.baseMetadataRaftSubject())
+}
+
 // getMetadataReplyInbox returns a random NATS subject to use for metadata
-// responses scoped to the cluster namespace.
+// responses scoped to the subject prefix.
 func (s *Server) getMetadataReplyInbox() string {
 	return fmt.Sprintf("%s.fetch.%s", s.baseMetadataRaftSubject(), nuid.Next())
 }
@@ -858,25 +1137,31 @@ func (s *Server) getMetadataReplyInbox() string {
 // indicate new data is available on a partition for a follower to replicate if
 // the follower is idle.
 func (s *Server) getPartitionNotificationInbox(id string) string {
-	return fmt.Sprintf("%s.notify.%s", s.config.Clustering.Namespace, id)
+	return fmt.Sprintf("%s.notify.%s", s.getSubjectPrefix(), id)
 }
 
 // getAckInbox returns a random NATS subject to use for publish acks scoped to
-// the cluster namespace.
+// the subject prefix.
 func (s *Server) getAckInbox() string {
-	return fmt.Sprintf("%s.ack.%s", s.config.Clustering.Namespace, nuid.Next())
+	return fmt.Sprintf("%s.ack.%s", s.getSubjectPrefix(), nuid.Next())
+}
+
+// getReplicationReplyInbox returns a random NATS subject to use for
+// replication responses scoped to the subject prefix.
+func (s *Server) getReplicationReplyInbox() string {
+	return fmt.Sprintf("%s.inbox.%s", s.getSubjectPrefix(), nuid.Next())
 }
 
 // getActivityStreamSubject returns the NATS subject used for publishing
 // activity stream events.
 func (s *Server) getActivityStreamSubject() string {
-	return fmt.Sprintf("%s.activity", s.config.Clustering.Namespace)
+	return fmt.Sprintf("%s.activity", s.getSubjectPrefix())
 }
 
 // getCursorStreamSubject returns the NATS subject used for storing consumer
 // partition cursors.
 func (s *Server) getCursorStreamSubject() string {
-	return fmt.Sprintf("%s.cursors", s.config.Clustering.Namespace)
+	return fmt.Sprintf("%s.cursors", s.getSubjectPrefix())
 }
 
 // startGoroutine starts a goroutine which is managed by the server. This adds
@@ -900,7 +1185,7 @@ func (s *Server) startGoroutine(f func()) {
 // Done() on the provided WaitGroup upon completion. This adds the goroutine to
 // a WaitGroup so that the server can wait for all running goroutines to stop
 // on shutdown. This should be used instead of a "naked" goroutine.
-func (s *Server) startGoroutineWG(f func(), wg sync.WaitGroup) {
+func (s *Server) startGoroutineWG(f func(), wg *sync.WaitGroup) {
 	select {
 	case <-s.shutdownCh:
 		return
@@ -937,7 +1222,7 @@ func (s *Server) startGoroutineWithArgs(f func(...interface{}), args ...interfac
 // WaitGroup upon completion. This adds the goroutine to a WaitGroup so that
 // the server can wait for all running goroutines to stop on shutdown. This
 // should be used instead of a "naked" goroutine.
-func (s *Server) startGoroutineWithArgsWG(f func(...interface{}), wg sync.WaitGroup, args ...interface{}) {
+func (s *Server) startGoroutineWithArgsWG(f func(...interface{}), wg *sync.WaitGroup, args ...interface{}) {
 	select {
 	case <-s.shutdownCh:
 		return
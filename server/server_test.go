@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"sync/atomic"
+	"syscall"
 	"testing"
 	"time"
 
@@ -923,6 +924,70 @@ func TestStreamRetentionMessages(t *testing.T) {
 	}
 }
 
+// Ensure sending SIGHUP reloads the stream retention settings from the config
+// file and the new limits are enforced on the next cleaner run.
+func TestStreamRetentionReload(t *testing.T) {
+	defer cleanupStorage(t)
+
+	configFile := filepath.Join(t.TempDir(), "liftbridge.yaml")
+	writeConfig := func(maxMessages int) {
+		data := fmt.Sprintf("streams:\n  retention.max.messages: %d\n", maxMessages)
+		require.NoError(t, os.WriteFile(configFile, []byte(data), 0600))
+	}
+	writeConfig(1000)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.ConfigFile = configFile
+	s1Config.Streams.SegmentMaxBytes = 1
+	s1Config.Streams.RetentionMaxMessages = 1000
+	s1Config.Streams.CleanerInterval = 100 * time.Millisecond
+	s1Config.BatchMaxMessages = 1
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Wait for server to elect itself leader.
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	// Create stream.
+	name := "foo"
+	subject := "foo"
+	err = client.CreateStream(context.Background(), subject, name)
+	require.NoError(t, err)
+
+	// Publish some messages.
+	num := 10
+	for i := 0; i < num; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		_, err = client.Publish(ctx, name, []byte("hello"))
+		cancel()
+		require.NoError(t, err)
+	}
+
+	// Nothing is deleted under the original limit.
+	partition := s1.metadata.GetPartition(name, 0)
+	require.NotNil(t, partition)
+	time.Sleep(3 * s1Config.Streams.CleanerInterval)
+	require.Equal(t, int64(0), partition.log.OldestOffset())
+
+	// Lower the limit and reload.
+	writeConfig(5)
+	require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGHUP))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if partition.log.OldestOffset() == int64(num-5) {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.Equal(t, int64(num-5), partition.log.OldestOffset())
+}
+
 // Ensure the stream message age retention ensures data is deleted when log
 // segments exceed the TTL.
 func TestStreamRetentionAge(t *testing.T) {
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// gracefulShutdownSecondsAttribute records the time GracefulStop spent
+// handing off leadership and draining publishes.
+const gracefulShutdownSecondsAttribute = attribute.Key("liftbridge_graceful_shutdown_seconds")
+
+// GracefulStop hands off leadership of the partitions this server leads to
+// other members of their ISR, waits for publishes in flight on the server to
+// be acked, and then stops the server. Leadership transfers are abandoned
+// when the Context expires and publishes are waited on for up to the drain
+// timeout, after which the server is stopped regardless. While stopping, new
+// subscriptions are rejected with an Unavailable status.
+func (s *Server) GracefulStop(ctx context.Context) error {
+	start := time.Now()
+	ctx, span := s.tracer.Start(ctx, "server.GracefulStop")
+	atomic.StoreInt32(&s.draining, 1)
+
+	s.logger.Info("Gracefully shutting down...")
+	s.handOffLeadership(ctx)
+	s.drainPublishes(ctx)
+
+	elapsed := time.Since(start)
+	span.SetAttributes(gracefulShutdownSecondsAttribute.Float64(elapsed.Seconds()))
+	span.End()
+	s.logger.Infof("Handed off leadership and drained publishes in %s", elapsed)
+
+	return s.Stop()
+}
+
+// isDraining indicates if the server is gracefully stopping.
+func (s *Server) isDraining() bool {
+	return atomic.LoadInt32(&s.draining) == 1
+}
+
+// handOffLeadership transfers leadership of each partition this server leads
+// to the ISR member leading the fewest partitions and waits for the new
+// leaders to take over or the Context to expire. Partitions whose ISR has no
+// other member are left as they are.
+func (s *Server) handOffLeadership(ctx context.Context) {
+	var (
+		wg     sync.WaitGroup
+		counts = s.metadata.BrokerLeaderCounts()
+		self   = s.config.Clustering.ServerID
+	)
+	for _, stream := range s.metadata.GetStreams() {
+		for _, partition := range stream.GetPartitions() {
+			if !partition.IsLeader() {
+				continue
+			}
+			target := selectHandOffTarget(partition.GetISR(), self, counts)
+			if target == "" {
+				s.logger.Warnf("Not handing off leadership of partition %s, ISR has no other members",
+					partition)
+				continue
+			}
+			// Account for the transfer so that partitions are spread across
+			// the remaining brokers.
+			counts[target]++
+
+			var (
+				streamName  = partition.Stream
+				partitionID = partition.Id
+			)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				st := s.metadata.TransferLeader(ctx, streamName, partitionID, target)
+				if st == nil {
+					st = s.metadata.WaitForLeaderTransfer(ctx, streamName, partitionID, target)
+				}
+				if st != nil {
+					s.logger.Errorf("Failed to hand off leadership of partition "+
+						"[stream=%s, partition=%d] to %s: %v", streamName, partitionID, target, st.Err())
+					return
+				}
+				trace.SpanFromContext(ctx).AddEvent("leadership handed off", trace.WithAttributes(
+					streamAttribute.String(streamName),
+					partitionAttribute.Int64(int64(partitionID)),
+					leaderAttribute.String(target),
+				))
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+// selectHandOffTarget returns the member of the given ISR, other than the
+// given broker, which leads the fewest partitions or an empty string if there
+// is none. Ties go to the lowest broker ID.
+func selectHandOffTarget(isr []string, self string, leaderCounts map[string]int) string {
+	candidates := make([]string, 0, len(isr))
+	for _, replica := range isr {
+		if replica != self {
+			candidates = append(candidates, replica)
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		ci, cj := leaderCounts[candidates[i]], leaderCounts[candidates[j]]
+		if ci != cj {
+			return ci < cj
+		}
+		return candidates[i] < candidates[j]
+	})
+	return candidates[0]
+}
+
+// drainPublishes waits until no publishes on this server are waiting for an
+// ack, up to the drain timeout or until the Context expires.
+func (s *Server) drainPublishes(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, s.config.DrainTimeout)
+	defer cancel()
+	for {
+		inflight := atomic.LoadInt64(&s.inflightPublishes)
+		if inflight <= 0 {
+			return
+		}
+		select {
+		case <-time.After(10 * time.Millisecond):
+		case <-ctx.Done():
+			s.logger.Warnf("Stopping with %d publishes waiting for an ack", inflight)
+			return
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure GracefulStop hands off leadership of the partitions the server leads
+// before stopping and messages acked while it stops aren't lost.
+func TestGracefulStopHandsOffLeadership(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	// Configure third server.
+	s3Config := getTestConfig("c", false, 5052)
+	s3 := runServerWithConfig(t, s3Config)
+	defer s3.Stop()
+
+	servers := []*Server{s1, s2, s3}
+	getMetadataLeader(t, 10*time.Second, servers...)
+
+	client1, err := lift.Connect([]string{"localhost:5050", "localhost:5051", "localhost:5052"})
+	require.NoError(t, err)
+	defer client1.Close()
+
+	name := "foo"
+	require.NoError(t, client1.CreateStream(context.Background(), "foo", name,
+		lift.ReplicationFactor(3)))
+	waitForISR(t, 10*time.Second, name, 0, 3, servers...)
+
+	leader := getPartitionLeader(t, 10*time.Second, name, 0, servers...)
+	var remaining []*Server
+	for _, s := range servers {
+		if s != leader {
+			remaining = append(remaining, s)
+		}
+	}
+
+	// Publish through one of the remaining servers while the leader stops,
+	// recording the messages which were acked.
+	var (
+		acked   = make(map[string]struct{})
+		ackedMu sync.Mutex
+		stop    = make(chan struct{})
+		wg      sync.WaitGroup
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			value := strconv.Itoa(i)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			_, err := remaining[0].api.Publish(ctx, &client.PublishRequest{
+				Stream:    name,
+				Value:     []byte(value),
+				AckPolicy: client.AckPolicy_ALL,
+			})
+			cancel()
+			if err == nil {
+				ackedMu.Lock()
+				acked[value] = struct{}{}
+				ackedMu.Unlock()
+			}
+		}
+	}()
+
+	// Let some messages through before stopping the leader.
+	time.Sleep(200 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	require.NoError(t, leader.GracefulStop(ctx))
+
+	// Leadership was handed off before the leader stopped rather than after
+	// the followers noticed it was gone.
+	var newLeader *Server
+	for _, s := range remaining {
+		if s.metadata.GetPartition(name, 0).IsLeader() {
+			newLeader = s
+		}
+	}
+	require.NotNil(t, newLeader)
+
+	time.Sleep(200 * time.Millisecond)
+	close(stop)
+	wg.Wait()
+	ackedMu.Lock()
+	defer ackedMu.Unlock()
+	require.NotEmpty(t, acked)
+
+	// Every acked message is in the new leader's log. The new leader may not
+	// have committed the messages acked by the old one yet since nothing has
+	// been published since, so read up to the end of its log.
+	partition := newLeader.metadata.GetPartition(name, 0)
+	newest := partition.log.NewestOffset()
+	received := make(map[string]struct{})
+	reader, err := partition.log.NewReader(0, true)
+	require.NoError(t, err)
+	for offset := int64(0); offset <= newest; offset++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		msg, _, _, _, err := reader.ReadMessage(ctx, make([]byte, 28))
+		cancel()
+		require.NoError(t, err)
+		received[string(msg.Value())] = struct{}{}
+	}
+	for value := range acked {
+		require.Contains(t, received, value)
+	}
+}
+
+// Ensure subscriptions are rejected with Unavailable while the server is
+// gracefully stopping.
+func TestSubscribeRejectedWhileDraining(t *testing.T) {
+	defer cleanupStorage(t)
+
+	server := New(getTestConfig("a", true, 0))
+	api := &apiServer{Server: server}
+	atomic.StoreInt32(&server.draining, 1)
+
+	_, err := api.SubscribeInternal(context.Background(), &client.SubscribeRequest{Stream: "foo"})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+// Ensure the hand-off target is the ISR member leading the fewest partitions.
+func TestSelectHandOffTarget(t *testing.T) {
+	counts := map[string]int{"a": 0, "b": 3, "c": 1, "d": 1}
+	require.Equal(t, "c", selectHandOffTarget([]string{"a", "b", "c", "d"}, "a", counts))
+	require.Equal(t, "b", selectHandOffTarget([]string{"a", "b"}, "a", counts))
+	require.Equal(t, "", selectHandOffTarget([]string{"a"}, "a", counts))
+}
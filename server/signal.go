@@ -4,9 +4,13 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
 )
 
-// handleSignals sets up a handler for SIGINT to do a graceful shutdown.
+// handleSignals sets up a handler for SIGINT to do a graceful shutdown and for
+// SIGHUP to reload retention settings, replication throttle limits, and
+// authorization permissions.
 func (s *Server) handleSignals() {
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGHUP)
@@ -23,6 +27,14 @@ func (s *Server) handleSignals() {
 				os.Exit(0)
 
 			case syscall.SIGHUP:
+				if err := s.reloadConfig(); err != nil {
+					s.logger.Errorf("Error occurred while reloading configuration: %v", err)
+				}
+
+				if s.authzEnforcer == nil {
+					continue
+				}
+
 				// Reload authz permissions from storage
 				s.authzEnforcer.authzLock.Lock()
 				// LoadPolicy, if fails, will likely throw panic
@@ -40,3 +52,45 @@ func (s *Server) handleSignals() {
 		}
 	}()
 }
+
+// reloadConfig re-reads the configuration file the server was started with and
+// applies its stream retention settings to all partitions and its replication
+// throttle limits. Other settings are not reloaded. This does nothing if the
+// server was not started with a configuration file.
+func (s *Server) reloadConfig() error {
+	if s.config.ConfigFile == "" {
+		return nil
+	}
+	config, err := NewConfig(s.config.ConfigFile)
+	if err != nil {
+		return err
+	}
+	if err := s.reloadRetentionConfig(config); err != nil {
+		return err
+	}
+	s.reloadReplicationThrottle(config)
+	return nil
+}
+
+// reloadRetentionConfig applies the stream retention settings of the given
+// config to all partitions.
+func (s *Server) reloadRetentionConfig(config *Config) error {
+	if err := s.metadata.UpdateRetentionConfig(commitlog.Options{
+		MaxLogBytes:    config.Streams.RetentionMaxBytes,
+		MaxLogMessages: config.Streams.RetentionMaxMessages,
+		MaxLogAge:      config.Streams.RetentionMaxAge,
+	}); err != nil {
+		return err
+	}
+	s.logger.Infof("Reloaded retention configuration: %s", config.Streams.RetentionString())
+	return nil
+}
+
+// reloadReplicationThrottle applies the replication throttle limits of the
+// given config.
+func (s *Server) reloadReplicationThrottle(config *Config) {
+	throttle := config.Clustering.ReplicationThrottle
+	s.replThrottle.setConfig(throttle)
+	s.logger.Infof("Reloaded replication throttle: replica rate %d B/s, broker rate %d B/s, lag threshold %d",
+		throttle.ReplicaRate, throttle.BrokerRate, throttle.LagThreshold)
+}
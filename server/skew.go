@@ -0,0 +1,398 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// keySketchCapacity is the number of distinct keys a partition leader
+	// counts. Keys beyond this replace the least frequent one.
+	keySketchCapacity = 64
+
+	// keySketchSampleRate is the inverse of the fraction of keyed messages
+	// counted by a partition leader's key sketch.
+	keySketchSampleRate = 8
+
+	// keySketchHalfLife is how often the key counts of a key sketch are
+	// halved so that they reflect recent traffic, roughly over the same
+	// window as the partition's append rate.
+	keySketchHalfLife = meterWindow
+
+	// skewTopKeys is the number of most frequent keys reported for each
+	// partition.
+	skewTopKeys = 5
+
+	// skewMaxAgeIntervals is the number of report intervals after which a
+	// broker's partition load report is considered stale and ignored.
+	skewMaxAgeIntervals = 3
+
+	// skewReportTimeout bounds how long the metadata leader waits to record a
+	// change in a stream's skew.
+	skewReportTimeout = 5 * time.Second
+)
+
+// keySketch estimates the most frequent keys published to a partition using
+// the Space-Saving algorithm over a sample of the keyed messages. Counts are
+// scaled up by the sample rate so they estimate the number of messages. Like
+// a rateMeter, the counts are decayed lazily whenever the sketch is updated or
+// read.
+type keySketch struct {
+	mu         sync.Mutex
+	counts     map[string]int64
+	capacity   int
+	sampleRate int
+	rand       *rand.Rand
+	lastDecay  time.Time
+}
+
+func newKeySketch(capacity, sampleRate int) *keySketch {
+	return &keySketch{
+		counts:     make(map[string]int64, capacity),
+		capacity:   capacity,
+		sampleRate: sampleRate,
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
+		lastDecay:  time.Now(),
+	}
+}
+
+// addBatch counts a sample of the keys of the given messages. Messages
+// without a key are ignored.
+func (k *keySketch) addBatch(msgs []*commitlog.Message) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.decay(time.Now())
+	for _, msg := range msgs {
+		if len(msg.Key) == 0 {
+			continue
+		}
+		if k.sampleRate > 1 && k.rand.Intn(k.sampleRate) != 0 {
+			continue
+		}
+		k.add(string(msg.Key), int64(k.sampleRate))
+	}
+}
+
+// add increases the count of the given key. If the sketch is full and the key
+// isn't in it, the key with the lowest count is replaced, and the new key
+// inherits that count since it may have been seen while untracked. Must be
+// called with the lock held.
+func (k *keySketch) add(key string, n int64) {
+	if count, ok := k.counts[key]; ok || len(k.counts) < k.capacity {
+		k.counts[key] = count + n
+		return
+	}
+	var (
+		minKey   string
+		minCount int64 = math.MaxInt64
+	)
+	for key, count := range k.counts {
+		if count < minCount {
+			minKey, minCount = key, count
+		}
+	}
+	delete(k.counts, minKey)
+	k.counts[key] = minCount + n
+}
+
+// top returns up to n of the most frequent keys, most frequent first.
+func (k *keySketch) top(n int) []*proto.KeyCount {
+	k.mu.Lock()
+	k.decay(time.Now())
+	keys := make([]*proto.KeyCount, 0, len(k.counts))
+	for key, count := range k.counts {
+		keys = append(keys, &proto.KeyCount{Key: []byte(key), Count: count})
+	}
+	k.mu.Unlock()
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Count != keys[j].Count {
+			return keys[i].Count > keys[j].Count
+		}
+		return bytes.Compare(keys[i].Key, keys[j].Key) < 0
+	})
+	if len(keys) > n {
+		keys = keys[:n]
+	}
+	return keys
+}
+
+// decay halves the count of every key once for each half-life elapsed since
+// the last decay, forgetting keys whose count drops to zero. Must be called
+// with the lock held.
+func (k *keySketch) decay(now time.Time) {
+	halvings := int64(now.Sub(k.lastDecay) / keySketchHalfLife)
+	if halvings <= 0 {
+		return
+	}
+	for key, count := range k.counts {
+		if halvings >= 63 {
+			count = 0
+		} else {
+			count >>= uint(halvings)
+		}
+		if count == 0 {
+			delete(k.counts, key)
+		} else {
+			k.counts[key] = count
+		}
+	}
+	k.lastDecay = k.lastDecay.Add(time.Duration(halvings) * keySketchHalfLife)
+}
+
+// loadReport is the most recent partition load report received from a
+// broker.
+type loadReport struct {
+	partitions []*proto.PartitionLoad
+	reportedAt time.Time
+}
+
+// skewTracker holds the partition loads most recently reported by each
+// broker, which every broker broadcasts for the partitions it leads, along
+// with the hot partition of each stream the metadata leader has reported as
+// skewed through Raft.
+type skewTracker struct {
+	mu      sync.RWMutex
+	reports map[string]*loadReport
+	maxAge  time.Duration
+	skewed  map[string]int32
+}
+
+// newSkewTracker creates a skewTracker which ignores reports older than
+// maxAge. If maxAge is 0, reports never expire.
+func newSkewTracker(maxAge time.Duration) *skewTracker {
+	return &skewTracker{
+		reports: make(map[string]*loadReport),
+		maxAge:  maxAge,
+		skewed:  make(map[string]int32),
+	}
+}
+
+// update replaces the partition loads reported by the given broker.
+func (s *skewTracker) update(broker string, partitions []*proto.PartitionLoad) {
+	s.mu.Lock()
+	s.reports[broker] = &loadReport{partitions: partitions, reportedAt: time.Now()}
+	s.mu.Unlock()
+}
+
+// streamLoads returns the current partition loads of each stream. If a
+// partition was reported by more than one broker, e.g. because its leader
+// changed, the most recent report is used.
+func (s *skewTracker) streamLoads() map[string][]*proto.PartitionLoad {
+	type reportedLoad struct {
+		load       *proto.PartitionLoad
+		reportedAt time.Time
+	}
+	s.mu.RLock()
+	latest := make(map[string]reportedLoad)
+	for _, report := range s.reports {
+		if s.maxAge > 0 && time.Since(report.reportedAt) > s.maxAge {
+			continue
+		}
+		for _, load := range report.partitions {
+			key := partitionKey(load.Stream, load.Partition)
+			if existing, ok := latest[key]; !ok || report.reportedAt.After(existing.reportedAt) {
+				latest[key] = reportedLoad{load: load, reportedAt: report.reportedAt}
+			}
+		}
+	}
+	s.mu.RUnlock()
+
+	streams := make(map[string][]*proto.PartitionLoad)
+	for _, reported := range latest {
+		streams[reported.load.Stream] = append(streams[reported.load.Stream], reported.load)
+	}
+	return streams
+}
+
+// hotPartition returns the hot partition of the given stream if it was last
+// reported as skewed.
+func (s *skewTracker) hotPartition(stream string) (int32, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	partition, ok := s.skewed[stream]
+	return partition, ok
+}
+
+// setReported records the skew of a stream reported by the metadata leader.
+func (s *skewTracker) setReported(skew *proto.StreamSkew) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if skew.Skewed {
+		s.skewed[skew.Stream] = skew.HotPartition
+	} else {
+		delete(s.skewed, skew.Stream)
+	}
+}
+
+// removeStream forgets that the given stream was reported as skewed.
+func (s *skewTracker) removeStream(stream string) {
+	s.mu.Lock()
+	delete(s.skewed, stream)
+	s.mu.Unlock()
+}
+
+// removePartition forgets that the given partition was its stream's hot
+// partition.
+func (s *skewTracker) removePartition(stream string, partition int32) {
+	s.mu.Lock()
+	if hot, ok := s.skewed[stream]; ok && hot == partition {
+		delete(s.skewed, stream)
+	}
+	s.mu.Unlock()
+}
+
+// computeStreamSkew summarizes the spread of a stream's load over the given
+// partition loads. The stream is skewed if at least two partitions were
+// reported, the mean partition rate is at least minRate, and the highest
+// partition rate exceeds the mean by more than the given factor. The hot
+// partition is the one with the highest rate, the lowest ID breaking ties.
+func computeStreamSkew(stream string, loads []*proto.PartitionLoad, threshold float64,
+	minRate int64) *proto.StreamSkew {
+
+	loads = append([]*proto.PartitionLoad(nil), loads...)
+	sort.Slice(loads, func(i, j int) bool { return loads[i].Partition < loads[j].Partition })
+	skew := &proto.StreamSkew{
+		Stream:            stream,
+		Partitions:        loads,
+		ReadAmplification: sumReadAmplification(loads),
+	}
+	if len(loads) == 0 {
+		return skew
+	}
+
+	var (
+		sum float64
+		hot = loads[0]
+	)
+	for _, load := range loads {
+		sum += float64(load.MessagesInRate)
+		if load.MessagesInRate > hot.MessagesInRate {
+			hot = load
+		}
+	}
+	mean := sum / float64(len(loads))
+	var variance float64
+	for _, load := range loads {
+		diff := float64(load.MessagesInRate) - mean
+		variance += diff * diff
+	}
+	variance /= float64(len(loads))
+
+	skew.MeanRate = int64(math.Round(mean))
+	skew.MaxRate = hot.MessagesInRate
+	skew.Variance = int64(math.Round(variance))
+	skew.HotPartition = hot.Partition
+	skew.TopKeys = hot.TopKeys
+	skew.Skewed = len(loads) > 1 && mean > 0 && mean >= float64(minRate) &&
+		float64(hot.MessagesInRate)/mean > threshold
+	return skew
+}
+
+// streamSkews returns the skew of the given streams, or of every stream with
+// reported partition loads if none are given, ordered by stream name.
+func (s *Server) streamSkews(streams []string) []*proto.StreamSkew {
+	loads := s.skew.streamLoads()
+	if len(streams) == 0 {
+		for stream := range loads {
+			streams = append(streams, stream)
+		}
+	} else {
+		streams = append([]string(nil), streams...)
+	}
+	sort.Strings(streams)
+	skews := make([]*proto.StreamSkew, len(streams))
+	for i, stream := range streams {
+		skews[i] = computeStreamSkew(stream, loads[stream],
+			s.config.Skew.Threshold, s.config.Skew.MinRate)
+	}
+	return skews
+}
+
+// skewReportLoop periodically broadcasts the load of the partitions this
+// server leads and, if it's the metadata leader, checks every stream for skew
+// until the server shuts down.
+func (s *Server) skewReportLoop() {
+	ticker := time.NewTicker(s.config.Skew.ReportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-ticker.C:
+		}
+		if err := s.reportPartitionLoad(); err != nil {
+			s.logger.Debugf("Failed to report partition load: %v", err)
+		}
+		if s.IsLeader() {
+			s.detectPartitionSkew()
+		}
+	}
+}
+
+// reportPartitionLoad broadcasts the append rate, most frequent keys, and read
+// amplification of each partition this server leads.
+func (s *Server) reportPartitionLoad() error {
+	var loads []*proto.PartitionLoad
+	for _, stream := range s.metadata.GetStreams() {
+		for _, partition := range stream.GetPartitions() {
+			if !partition.IsLeader() {
+				continue
+			}
+			_, rate := partition.metrics.messagesIn.snapshot()
+			load := &proto.PartitionLoad{
+				Stream:            partition.Stream,
+				Partition:         partition.Id,
+				MessagesInRate:    int64(math.Round(rate)),
+				ReadAmplification: partition.readAmplification(),
+			}
+			if partition.keys != nil {
+				load.TopKeys = partition.keys.top(skewTopKeys)
+			}
+			loads = append(loads, load)
+		}
+	}
+
+	serverID := s.config.Clustering.ServerID
+	s.skew.update(serverID, loads)
+	data, err := proto.MarshalPartitionLoadReport(&proto.PartitionLoadReport{
+		Id:         serverID,
+		Partitions: loads,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return s.ncRaft.Publish(s.getPartitionLoadInbox(), s.auth.sign(data))
+}
+
+// detectPartitionSkew checks the skew of each stream with reported partition
+// loads and records any stream which became skewed, changed hot partition, or
+// is no longer skewed through Raft so that the change is published to the
+// activity stream.
+func (s *Server) detectPartitionSkew() {
+	for _, skew := range s.streamSkews(nil) {
+		hot, reported := s.skew.hotPartition(skew.Stream)
+		if skew.Skewed == reported && (!reported || hot == skew.HotPartition) {
+			continue
+		}
+		if skew.Skewed {
+			s.logger.Warnf("Detected skewed partition load on stream %s: partition %d has %d msgs/sec, "+
+				"mean is %d msgs/sec", skew.Stream, skew.HotPartition, skew.MaxRate, skew.MeanRate)
+		} else {
+			s.logger.Infof("Partition load on stream %s is no longer skewed", skew.Stream)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), skewReportTimeout)
+		err := s.metadata.ReportPartitionSkew(ctx, skew)
+		cancel()
+		if err != nil {
+			s.logger.Errorf("Failed to report partition skew of stream %s: %v", skew.Stream, err)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/liftbridge-io/liftbridge/server/commitlog"
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+func keyedMessages(keys ...string) []*commitlog.Message {
+	msgs := make([]*commitlog.Message, len(keys))
+	for i, key := range keys {
+		msgs[i] = &commitlog.Message{Key: []byte(key)}
+	}
+	return msgs
+}
+
+// Ensure the key sketch counts the most frequent keys, replacing the least
+// frequent key when full and ignoring messages without a key.
+func TestKeySketch(t *testing.T) {
+	sketch := newKeySketch(3, 1)
+	sketch.addBatch(keyedMessages("a", "a", "a", "b", "b", "c", ""))
+
+	top := sketch.top(2)
+	require.Len(t, top, 2)
+	require.Equal(t, "a", string(top[0].Key))
+	require.Equal(t, int64(3), top[0].Count)
+	require.Equal(t, "b", string(top[1].Key))
+	require.Equal(t, int64(2), top[1].Count)
+
+	// A new key replaces the least frequent one and inherits its count.
+	sketch.addBatch(keyedMessages("d"))
+	top = sketch.top(10)
+	require.Len(t, top, 3)
+	require.Equal(t, "a", string(top[0].Key))
+	require.Equal(t, "b", string(top[1].Key))
+	require.Equal(t, "d", string(top[2].Key))
+	require.Equal(t, int64(2), top[2].Count)
+}
+
+// Ensure sampled key counts are scaled by the sample rate.
+func TestKeySketchSampling(t *testing.T) {
+	sketch := newKeySketch(10, 4)
+	keys := make([]string, 4000)
+	for i := range keys {
+		keys[i] = "a"
+	}
+	sketch.addBatch(keyedMessages(keys...))
+
+	top := sketch.top(1)
+	require.Len(t, top, 1)
+	require.Equal(t, "a", string(top[0].Key))
+	require.Zero(t, top[0].Count%4)
+	require.InDelta(t, 4000, top[0].Count, 800)
+}
+
+// Ensure key counts are halved for each elapsed half-life and keys whose count
+// drops to zero are forgotten.
+func TestKeySketchDecay(t *testing.T) {
+	sketch := newKeySketch(10, 1)
+	sketch.addBatch(keyedMessages("a", "a", "a", "a", "b"))
+
+	sketch.mu.Lock()
+	sketch.decay(sketch.lastDecay.Add(keySketchHalfLife / 2))
+	require.Equal(t, int64(4), sketch.counts["a"])
+	sketch.decay(sketch.lastDecay.Add(2 * keySketchHalfLife))
+	require.Equal(t, int64(1), sketch.counts["a"])
+	require.NotContains(t, sketch.counts, "b")
+	sketch.decay(sketch.lastDecay.Add(100 * keySketchHalfLife))
+	require.Empty(t, sketch.counts)
+	sketch.mu.Unlock()
+}
+
+func partitionLoad(partition int32, rate int64, keys ...string) *proto.PartitionLoad {
+	load := &proto.PartitionLoad{Stream: "foo", Partition: partition, MessagesInRate: rate}
+	for _, key := range keys {
+		load.TopKeys = append(load.TopKeys, &proto.KeyCount{Key: []byte(key), Count: 1})
+	}
+	return load
+}
+
+// Ensure computeStreamSkew flags streams whose hot partition rate exceeds the
+// mean by more than the threshold once the mean reaches the minimum rate.
+func TestComputeStreamSkew(t *testing.T) {
+	// Balanced load isn't skewed.
+	skew := computeStreamSkew("foo", []*proto.PartitionLoad{
+		partitionLoad(0, 100), partitionLoad(1, 110), partitionLoad(2, 90),
+	}, 2, 10)
+	require.False(t, skew.Skewed)
+	require.Equal(t, int64(100), skew.MeanRate)
+	require.Equal(t, int64(110), skew.MaxRate)
+	require.Equal(t, int64(67), skew.Variance)
+	require.Equal(t, int32(1), skew.HotPartition)
+
+	// A partition with more than twice the mean rate is hot. Partitions are
+	// ordered by ID.
+	skew = computeStreamSkew("foo", []*proto.PartitionLoad{
+		partitionLoad(3, 10), partitionLoad(2, 300, "x", "y"), partitionLoad(1, 10), partitionLoad(0, 0),
+	}, 2, 10)
+	require.True(t, skew.Skewed)
+	require.Equal(t, "foo", skew.Stream)
+	require.Equal(t, int64(80), skew.MeanRate)
+	require.Equal(t, int64(300), skew.MaxRate)
+	require.Equal(t, int32(2), skew.HotPartition)
+	require.Len(t, skew.TopKeys, 2)
+	require.Equal(t, "x", string(skew.TopKeys[0].Key))
+	for i, load := range skew.Partitions {
+		require.Equal(t, int32(i), load.Partition)
+	}
+
+	// Streams below the minimum rate aren't skewed.
+	skew = computeStreamSkew("foo", []*proto.PartitionLoad{
+		partitionLoad(0, 0), partitionLoad(1, 9),
+	}, 1.5, 10)
+	require.False(t, skew.Skewed)
+
+	// Nor are streams with a single partition reported.
+	skew = computeStreamSkew("foo", []*proto.PartitionLoad{partitionLoad(0, 1000)}, 1.5, 10)
+	require.False(t, skew.Skewed)
+
+	skew = computeStreamSkew("foo", nil, 2, 10)
+	require.False(t, skew.Skewed)
+	require.Empty(t, skew.Partitions)
+}
+
+// Ensure the skew tracker uses the most recent report of each partition and
+// ignores stale reports.
+func TestSkewTrackerStreamLoads(t *testing.T) {
+	tracker := newSkewTracker(50 * time.Millisecond)
+	tracker.update("a", []*proto.PartitionLoad{partitionLoad(0, 5), partitionLoad(1, 5)})
+	time.Sleep(5 * time.Millisecond)
+	// Leadership of partition 1 moved to b.
+	tracker.update("b", []*proto.PartitionLoad{
+		partitionLoad(1, 20),
+		{Stream: "bar", Partition: 0, MessagesInRate: 1},
+	})
+
+	loads := tracker.streamLoads()
+	require.Len(t, loads, 2)
+	require.Len(t, loads["foo"], 2)
+	for _, load := range loads["foo"] {
+		if load.Partition == 1 {
+			require.Equal(t, int64(20), load.MessagesInRate)
+		}
+	}
+	require.Len(t, loads["bar"], 1)
+
+	time.Sleep(60 * time.Millisecond)
+	tracker.update("b", []*proto.PartitionLoad{partitionLoad(1, 20)})
+	loads = tracker.streamLoads()
+	require.Len(t, loads, 1)
+	require.Len(t, loads["foo"], 1)
+
+	// Reported skew is tracked until the stream is no longer skewed or is
+	// deleted.
+	tracker.setReported(&proto.StreamSkew{Stream: "foo", Skewed: true, HotPartition: 1})
+	hot, ok := tracker.hotPartition("foo")
+	require.True(t, ok)
+	require.Equal(t, int32(1), hot)
+	tracker.setReported(&proto.StreamSkew{Stream: "foo"})
+	_, ok = tracker.hotPartition("foo")
+	require.False(t, ok)
+	tracker.setReported(&proto.StreamSkew{Stream: "foo", Skewed: true, HotPartition: 1})
+	tracker.removeStream("foo")
+	_, ok = tracker.hotPartition("foo")
+	require.False(t, ok)
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -18,13 +19,19 @@ type stream struct {
 	resumeAll    bool // When partition(s) are paused, this indicates if all should be resumed
 	tombstone    bool // Indicates if the stream is marked for deletion during Raft recovery
 	creationTime time.Time
+	origin       *proto.StreamOrigin
+	producers    map[string]*proto.ExclusiveProducer // Exclusive producer registrations by name
+	primary      string                              // Stream this is a companion of, if any
+	companions   []string
+	tags         []*proto.StreamTag
+	acl          *proto.StreamACL
 	mu           sync.RWMutex
 }
 
 // newStream creates a stream for the given NATS subject. All stream
 // interactions should only go through the exported functions.
 func newStream(name, subject string, config *proto.StreamConfig, creationTime time.Time,
-	srvConfig *Config) *stream {
+	origin *proto.StreamOrigin, srvConfig *Config) *stream {
 
 	s := &stream{
 		name:         name,
@@ -32,6 +39,8 @@ func newStream(name, subject string, config *proto.StreamConfig, creationTime ti
 		config:       config,
 		partitions:   make(map[int32]*partition),
 		creationTime: creationTime,
+		origin:       origin,
+		producers:    make(map[string]*proto.ExclusiveProducer),
 	}
 	if isReservedStream(name) {
 		applyReservedStreamOverrides(s, srvConfig)
@@ -81,6 +90,50 @@ func (s *stream) GetConfig() *proto.StreamConfig {
 	return s.config
 }
 
+// UpdateConfig replaces the settings of the stream's configuration which are
+// set in the given config and returns the resulting configuration.
+func (s *stream) UpdateConfig(update *proto.StreamConfig) *proto.StreamConfig {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The config is shared with partitions, snapshots, and the Raft log, so
+	// replace it rather than modifying it in place.
+	config := new(proto.StreamConfig)
+	if s.config != nil {
+		*config = *s.config
+	}
+	if update.RetentionMaxBytes != nil {
+		config.RetentionMaxBytes = update.RetentionMaxBytes
+	}
+	if update.RetentionMaxMessages != nil {
+		config.RetentionMaxMessages = update.RetentionMaxMessages
+	}
+	if update.RetentionMaxAge != nil {
+		config.RetentionMaxAge = update.RetentionMaxAge
+	}
+	if update.SegmentMaxBytes != nil {
+		config.SegmentMaxBytes = update.SegmentMaxBytes
+	}
+	if update.SegmentMaxAge != nil {
+		config.SegmentMaxAge = update.SegmentMaxAge
+	}
+	if update.CompactEnabled != nil {
+		config.CompactEnabled = update.CompactEnabled
+	}
+	s.config = config
+	return config
+}
+
+// GetTTL returns how long after its creation the stream is deleted or 0 if it
+// isn't.
+func (s *stream) GetTTL() time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.config == nil || s.config.Ttl == nil {
+		return 0
+	}
+	return time.Duration(s.config.Ttl.Value) * time.Millisecond
+}
+
 // GetResumeAll returns a bool indicating if the stream was paused with
 // ResumeAll enabled. This means a message published to any of the stream's
 // partitions will resume any paused partitions.
@@ -121,6 +174,40 @@ func (s *stream) SetPartition(id int32, p *partition) {
 	s.partitions[id] = p
 }
 
+// RemovePartition removes the partition with the given ID from the stream and
+// returns it, or nil if there is no such partition. The partition map is
+// replaced rather than modified since GetPartitions hands it out to callers
+// which range over it without holding the stream's lock.
+func (s *stream) RemovePartition(id int32) *partition {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	removed, ok := s.partitions[id]
+	if !ok {
+		return nil
+	}
+	partitions := make(map[int32]*partition, len(s.partitions)-1)
+	for partitionID, partition := range s.partitions {
+		if partitionID != id {
+			partitions[partitionID] = partition
+		}
+	}
+	s.partitions = partitions
+	return removed
+}
+
+// GetPartitionIDs returns the IDs of the stream's partitions in ascending
+// order. The IDs aren't contiguous if partitions were deleted.
+func (s *stream) GetPartitionIDs() []int32 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ids := make([]int32, 0, len(s.partitions))
+	for id := range s.partitions {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
 // GetCreationTime returns the steam's creation time.
 func (s *stream) GetCreationTime() time.Time {
 	s.mu.RLock()
@@ -128,6 +215,200 @@ func (s *stream) GetCreationTime() time.Time {
 	return s.creationTime
 }
 
+// GetOrigin returns the origin recorded when the stream was created or nil if
+// the stream predates origin tracking.
+func (s *stream) GetOrigin() *proto.StreamOrigin {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.origin
+}
+
+// SetOwner sets the owner recorded in the stream's origin.
+func (s *stream) SetOwner(owner string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The origin is shared with snapshots and the Raft log, so replace it
+	// rather than modifying it in place.
+	origin := new(proto.StreamOrigin)
+	if s.origin != nil {
+		*origin = *s.origin
+	}
+	origin.Owner = owner
+	s.origin = origin
+}
+
+// GetTags returns the stream's tags.
+func (s *stream) GetTags() []*proto.StreamTag {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.tags
+}
+
+// SetTags replaces the stream's tags.
+func (s *stream) SetTags(tags []*proto.StreamTag) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The tags are shared with snapshots and the Raft log, so they're never
+	// modified in place.
+	s.tags = tags
+}
+
+// GetACL returns the stream's access control list or nil if access to the
+// stream isn't restricted.
+func (s *stream) GetACL() *proto.StreamACL {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.acl
+}
+
+// SetACL replaces the stream's access control list.
+func (s *stream) SetACL(acl *proto.StreamACL) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acl = acl
+}
+
+// GetExclusiveProducer returns the registration of the exclusive producer
+// with the given name or nil if it has never been registered.
+func (s *stream) GetExclusiveProducer(name string) *proto.ExclusiveProducer {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.producers[name]
+}
+
+// RegisterExclusiveProducer registers the exclusive producer with the given
+// epoch, replacing its previous registration. Registrations with an older
+// epoch than the current one are ignored so that replaying the Raft log is
+// idempotent.
+func (s *stream) RegisterExclusiveProducer(name string, epoch uint64, expiresAt int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.producers[name]; ok && existing.Epoch > epoch {
+		return
+	}
+	s.producers[name] = &proto.ExclusiveProducer{
+		Name:      name,
+		Epoch:     epoch,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// ReleaseExclusiveProducer releases the registration of the exclusive
+// producer if it has the given epoch. The released registration is kept so
+// that its epoch stays fenced. It returns ErrProducerNotRegistered if the
+// producer is not registered and ErrProducerFenced if it was registered with
+// a different epoch since.
+func (s *stream) ReleaseExclusiveProducer(name string, epoch uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, ok := s.producers[name]
+	if !ok {
+		return ErrProducerNotRegistered
+	}
+	if existing.Epoch != epoch {
+		return ErrProducerFenced
+	}
+	// Registrations are shared with snapshots, so replace it rather than
+	// modifying it in place.
+	released := *existing
+	released.Released = true
+	s.producers[name] = &released
+	return nil
+}
+
+// setExclusiveProducers restores the exclusive producer registrations of a
+// stream recovered from a snapshot.
+func (s *stream) setExclusiveProducers(producers []*proto.ExclusiveProducer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, producer := range producers {
+		s.producers[producer.Name] = producer
+	}
+}
+
+// GetPrimary returns the name of the stream this stream is a companion of or
+// an empty string if it's not a companion.
+func (s *stream) GetPrimary() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.primary
+}
+
+// GetCompanions returns the names of the stream's companion streams.
+func (s *stream) GetCompanions() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	companions := make([]string, len(s.companions))
+	copy(companions, s.companions)
+	return companions
+}
+
+// setCompanionLinks sets the primary stream and companion streams the stream
+// is linked to.
+func (s *stream) setCompanionLinks(primary string, companions []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.primary = primary
+	s.companions = companions
+}
+
+// unlinkCompanion removes the link to the given companion or primary stream,
+// e.g. when it is deleted.
+func (s *stream) unlinkCompanion(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.primary == name {
+		s.primary = ""
+	}
+	// The companions slice is shared with snapshots, so replace it rather
+	// than modifying it in place.
+	companions := make([]string, 0, len(s.companions))
+	for _, companion := range s.companions {
+		if companion != name {
+			companions = append(companions, companion)
+		}
+	}
+	s.companions = companions
+}
+
+// Proto returns the protobuf representation of the stream.
+func (s *stream) Proto() *proto.Stream {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	protoStream := &proto.Stream{
+		Name:       s.name,
+		Subject:    s.subject,
+		Config:     s.config,
+		Partitions: make([]*proto.Partition, 0, len(s.partitions)),
+		Origin:     s.origin,
+		Primary:    s.primary,
+		Companions: s.companions,
+		Tags:       s.tags,
+		Acl:        s.acl,
+	}
+	if !s.creationTime.IsZero() {
+		protoStream.CreationTimestamp = s.creationTime.UnixNano()
+	}
+	// Partitions are ordered by ID, which may have gaps if partitions were
+	// deleted.
+	for _, partition := range s.partitions {
+		protoStream.Partitions = append(protoStream.Partitions, partition.Partition)
+	}
+	sort.Slice(protoStream.Partitions, func(i, j int) bool {
+		return protoStream.Partitions[i].Id < protoStream.Partitions[j].Id
+	})
+	if len(s.producers) > 0 {
+		protoStream.Producers = make([]*proto.ExclusiveProducer, 0, len(s.producers))
+		for _, producer := range s.producers {
+			protoStream.Producers = append(protoStream.Producers, producer)
+		}
+		sort.Slice(protoStream.Producers, func(i, j int) bool {
+			return protoStream.Producers[i].Name < protoStream.Producers[j].Name
+		})
+	}
+	return protoStream
+}
+
 // Close the stream by closing each of its partitions.
 func (s *stream) Close() error {
 	s.mu.Lock()
@@ -141,7 +422,8 @@ func (s *stream) Close() error {
 }
 
 // Pause some or all the partitions of this stream. Returns a list of the
-// partitions that were paused.
+// partitions that were paused by this call, i.e. excluding any which were
+// already paused.
 func (s *stream) Pause(partitions []int32, resumeAll bool) ([]*partition, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -161,14 +443,19 @@ func (s *stream) Pause(partitions []int32, resumeAll bool) ([]*partition, error)
 		}
 	}
 
+	paused := make([]*partition, 0, len(toPause))
 	for _, partition := range toPause {
+		wasPaused := partition.IsPaused()
 		if err := partition.Pause(); err != nil {
 			return nil, err
 		}
+		if !wasPaused {
+			paused = append(paused, partition)
+		}
 	}
 
 	s.resumeAll = resumeAll
-	return toPause, nil
+	return paused, nil
 }
 
 // Delete the stream by closing and deleting each of its partitions.
@@ -184,8 +471,9 @@ func (s *stream) Delete() error {
 }
 
 // SetReadonly sets the readonly flag on some or all the partitions of this
-// stream.
-func (s *stream) SetReadonly(partitions []int32, readonly bool) error {
+// stream. If a target offset is given, the partitions become readonly once
+// they reach it.
+func (s *stream) SetReadonly(partitions []int32, readonly bool, target *proto.NullableInt64) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -205,7 +493,7 @@ func (s *stream) SetReadonly(partitions []int32, readonly bool) error {
 	}
 
 	for _, partition := range toSetReadonly {
-		partition.SetReadonly(readonly)
+		partition.SetReadonly(readonly, target)
 	}
 
 	return nil
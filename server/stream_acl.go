@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// streamACLWildcard is the principal which, when listed in a stream's ACL,
+// allows access to any authenticated principal.
+const streamACLWildcard = "*"
+
+// validateStreamACL returns an error wrapping ErrInvalidStreamACL if any of
+// the principals in the given ACL are empty.
+func validateStreamACL(acl *proto.StreamACL) error {
+	if acl == nil {
+		return nil
+	}
+	for _, principals := range [][]string{acl.ReadPrincipals, acl.WritePrincipals} {
+		for _, principal := range principals {
+			if principal == "" {
+				return errors.Wrap(ErrInvalidStreamACL, "principal can't be empty")
+			}
+		}
+	}
+	return nil
+}
+
+// streamACLAllows indicates if the given principal is in the list of allowed
+// principals. An empty list allows anyone, even callers which aren't
+// authenticated, while the wildcard only allows authenticated principals.
+func streamACLAllows(principals []string, principal string) bool {
+	if len(principals) == 0 {
+		return true
+	}
+	if principal == "" {
+		return false
+	}
+	for _, allowed := range principals {
+		if allowed == streamACLWildcard || allowed == principal {
+			return true
+		}
+	}
+	return false
+}
+
+// checkStreamACL returns a PermissionDenied error if the ACL of the given
+// stream doesn't allow the principal of the caller in the given context to
+// publish to it, if write is true, or to subscribe to it otherwise. Streams
+// which don't exist aren't checked so the handler can report them as such.
+func (s *Server) checkStreamACL(ctx context.Context, streamName string, write bool) error {
+	stream := s.metadata.GetStream(streamName)
+	if stream == nil {
+		return nil
+	}
+	acl := stream.GetACL()
+	if acl == nil {
+		return nil
+	}
+	principals, action := acl.ReadPrincipals, "subscribe to"
+	if write {
+		principals, action = acl.WritePrincipals, "publish to"
+	}
+	principal, _ := ctx.Value("clientID").(string)
+	if !streamACLAllows(principals, principal) {
+		return status.Errorf(codes.PermissionDenied, "Principal %q is not allowed to %s stream %s",
+			principal, action, streamName)
+	}
+	return nil
+}
+
+// streamACLUnaryInterceptor rejects Publish calls to streams whose ACL doesn't
+// allow the caller to publish. It must be chained after the interceptors which
+// set the caller's principal.
+func (s *Server) streamACLUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if publish, ok := req.(*client.PublishRequest); ok {
+		if err := s.checkStreamACL(ctx, publish.Stream, true); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
+
+// streamACLStreamInterceptor rejects Subscribe calls to streams whose ACL
+// doesn't allow the caller to subscribe. PublishAsync streams are checked for
+// each message by the publish loop so a denied message doesn't end the
+// stream. It must be chained after the interceptors which set the caller's
+// principal.
+func (s *Server) streamACLStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &streamACLServerStream{ServerStream: ss, server: s})
+}
+
+// streamACLServerStream checks the ACL of the stream in the request received
+// on a Subscribe stream before it's handled.
+type streamACLServerStream struct {
+	grpc.ServerStream
+	server *Server
+}
+
+// RecvMsg receives the next message on the stream and, if it's a subscribe
+// request, returns a PermissionDenied error if the caller isn't allowed to
+// subscribe to the stream.
+func (s *streamACLServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if subscribe, ok := m.(*client.SubscribeRequest); ok {
+		return s.server.checkStreamACL(s.Context(), subscribe.Stream, false)
+	}
+	return nil
+}
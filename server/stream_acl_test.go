@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure streamACLAllows only allows the listed principals, the wildcard only
+// allows authenticated principals, and an empty list allows anyone.
+func TestStreamACLAllows(t *testing.T) {
+	require.True(t, streamACLAllows(nil, ""))
+	require.True(t, streamACLAllows(nil, "alice"))
+	require.True(t, streamACLAllows([]string{"bob", "alice"}, "alice"))
+	require.False(t, streamACLAllows([]string{"bob"}, "alice"))
+	require.False(t, streamACLAllows([]string{"bob"}, ""))
+	require.True(t, streamACLAllows([]string{streamACLWildcard}, "alice"))
+	require.False(t, streamACLAllows([]string{streamACLWildcard}, ""))
+}
+
+// Ensure publishes and subscriptions are rejected with PermissionDenied when
+// the stream's ACL doesn't allow the authenticated caller and succeed when it
+// does.
+func TestStreamACL(t *testing.T) {
+	defer cleanupStorage(t)
+
+	s, conn := runAuthServer(t)
+	defer s.Stop()
+	defer conn.Close()
+	getMetadataLeader(t, 10*time.Second, s)
+	api := client.NewAPIClient(conn)
+	admin := proto.NewAdminAPIClient(conn)
+
+	_, err := api.CreateStream(plainContext("alice", "secret"), &client.CreateStreamRequest{
+		Subject: "foo",
+		Name:    "foo",
+	})
+	require.NoError(t, err)
+	waitForPartition(t, 10*time.Second, "foo", 0, s)
+
+	publish := func(ctx context.Context) error {
+		cctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		_, err := api.Publish(cctx, &client.PublishRequest{
+			Stream:    "foo",
+			Value:     []byte("hello"),
+			AckPolicy: client.AckPolicy_LEADER,
+		})
+		return err
+	}
+	subscribe := func(ctx context.Context) error {
+		cctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		stream, err := api.Subscribe(cctx, &client.SubscribeRequest{Stream: "foo"})
+		require.NoError(t, err)
+		// The server sends an empty message once the subscription is
+		// created.
+		_, err = stream.Recv()
+		return err
+	}
+
+	// Without an ACL, any authenticated user has access.
+	require.NoError(t, publish(plainContext("bob", "secret")))
+
+	_, err = admin.SetStreamACL(plainContext("alice", "secret"), &proto.SetStreamACLRequest{
+		Stream: "foo",
+		Acl: &proto.StreamACL{
+			ReadPrincipals:  []string{streamACLWildcard},
+			WritePrincipals: []string{"alice"},
+		},
+	})
+	require.NoError(t, err)
+
+	err = publish(plainContext("bob", "secret"))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.NoError(t, publish(plainContext("alice", "secret")))
+
+	// The wildcard allows any authenticated user to subscribe.
+	require.NoError(t, subscribe(plainContext("bob", "secret")))
+
+	// PublishAsync rejects the message without ending the stream.
+	pubStream, err := api.PublishAsync(plainContext("bob", "secret"))
+	require.NoError(t, err)
+	require.NoError(t, pubStream.Send(&client.PublishRequest{
+		Stream:        "foo",
+		Value:         []byte("hello"),
+		CorrelationId: "1",
+		AckPolicy:     client.AckPolicy_LEADER,
+	}))
+	resp, err := pubStream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "1", resp.CorrelationId)
+	require.Equal(t, client.PublishAsyncError_PERMISSION_DENIED, resp.AsyncError.Code)
+	require.NoError(t, pubStream.CloseSend())
+
+	_, err = admin.SetStreamACL(plainContext("alice", "secret"), &proto.SetStreamACLRequest{
+		Stream: "foo",
+		Acl:    &proto.StreamACL{ReadPrincipals: []string{"alice"}},
+	})
+	require.NoError(t, err)
+
+	err = subscribe(plainContext("bob", "secret"))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.NoError(t, subscribe(plainContext("alice", "secret")))
+
+	// Removing the write restriction allows anyone to publish again.
+	require.NoError(t, publish(plainContext("bob", "secret")))
+
+	_, err = admin.SetStreamACL(plainContext("alice", "secret"), &proto.SetStreamACLRequest{
+		Stream: "foo",
+		Acl:    &proto.StreamACL{WritePrincipals: []string{""}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = admin.SetStreamACL(plainContext("alice", "secret"), &proto.SetStreamACLRequest{
+		Stream: "bar",
+		Acl:    &proto.StreamACL{WritePrincipals: []string{"alice"}},
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// streamTagsMetadataKey is the gRPC metadata key used to tag a stream
+	// when creating it since CreateStreamRequest has no field for it. Each
+	// value is a tag in the form key=value.
+	streamTagsMetadataKey = "liftbridge-stream-tags"
+
+	// streamTagSelectorMetadataKey is the gRPC metadata key used to only
+	// fetch the metadata of the streams matching a tag selector since
+	// FetchMetadataRequest has no field for it. Each value is a requirement
+	// in the form key=value, or just key if the stream only needs to have the
+	// tag, and streams must match all of them.
+	streamTagSelectorMetadataKey = "liftbridge-stream-tag-selector"
+
+	// streamTagsHeader is the FetchMetadata response header set to the tags
+	// of the streams in the response since StreamMetadata has no field for
+	// them. Each value is a serialized StreamTags. Since it's a binary
+	// header, gRPC takes care of encoding the values.
+	streamTagsHeader = "liftbridge-stream-tags-bin"
+
+	// maxStreamTags is the maximum number of tags a stream can have.
+	maxStreamTags = 16
+
+	// maxStreamTagBytes is the maximum size of a tag's key and of its value.
+	maxStreamTagBytes = 256
+)
+
+// validateStreamTags returns an error wrapping ErrInvalidStreamTags if there
+// are too many of the given tags, their keys or values are too large, or their
+// keys are empty or repeated.
+func validateStreamTags(tags []*proto.StreamTag) error {
+	if len(tags) > maxStreamTags {
+		return errors.Wrapf(ErrInvalidStreamTags, "stream can't have more than %d tags", maxStreamTags)
+	}
+	keys := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		if tag.Key == "" {
+			return errors.Wrap(ErrInvalidStreamTags, "tag key can't be empty")
+		}
+		if len(tag.Key) > maxStreamTagBytes || len(tag.Value) > maxStreamTagBytes {
+			return errors.Wrapf(ErrInvalidStreamTags, "tag %s exceeds %d bytes", tag.Key, maxStreamTagBytes)
+		}
+		if _, ok := keys[tag.Key]; ok {
+			return errors.Wrapf(ErrInvalidStreamTags, "tag %s is set more than once", tag.Key)
+		}
+		keys[tag.Key] = struct{}{}
+	}
+	return nil
+}
+
+// streamTagsFromContext returns the stream tags set in the incoming gRPC
+// metadata, if any.
+func streamTagsFromContext(ctx context.Context) ([]*proto.StreamTag, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(streamTagsMetadataKey)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	tags := make([]*proto.StreamTag, len(values))
+	for i, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid %s %q, expected key=value", streamTagsMetadataKey, value)
+		}
+		tags[i] = &proto.StreamTag{Key: parts[0], Value: parts[1]}
+	}
+	return tags, nil
+}
+
+// tagRequirement is a requirement of a tag selector. If hasValue isn't set,
+// the tag only needs to be present.
+type tagRequirement struct {
+	key      string
+	value    string
+	hasValue bool
+}
+
+// tagSelectorFromContext returns the requirements of the stream tag selector
+// set in the incoming gRPC metadata, if any.
+func tagSelectorFromContext(ctx context.Context) ([]tagRequirement, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(streamTagSelectorMetadataKey)
+	selector := make([]tagRequirement, len(values))
+	for i, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if parts[0] == "" {
+			return nil, fmt.Errorf("invalid %s %q, expected key=value or key",
+				streamTagSelectorMetadataKey, value)
+		}
+		selector[i] = tagRequirement{key: parts[0]}
+		if len(parts) == 2 {
+			selector[i].value = parts[1]
+			selector[i].hasValue = true
+		}
+	}
+	return selector, nil
+}
+
+// matchesTagSelector indicates if the given tags meet every requirement of
+// the selector.
+func matchesTagSelector(tags []*proto.StreamTag, selector []tagRequirement) bool {
+	for _, req := range selector {
+		matched := false
+		for _, tag := range tags {
+			if tag.Key == req.key && (!req.hasValue || tag.Value == req.value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// selectTaggedStreams removes the streams which don't match the given tag
+// selector from the FetchMetadata response, including the ones which don't
+// exist, and returns the tags of the remaining streams which have any.
+func (a *apiServer) selectTaggedStreams(resp *client.FetchMetadataResponse,
+	selector []tagRequirement) []*proto.StreamTags {
+
+	var (
+		selected = resp.StreamMetadata[:0]
+		tags     []*proto.StreamTags
+	)
+	for _, streamMetadata := range resp.StreamMetadata {
+		var streamTags []*proto.StreamTag
+		if stream := a.metadata.GetStream(streamMetadata.Name); stream != nil {
+			streamTags = stream.GetTags()
+		} else if len(selector) > 0 {
+			continue
+		}
+		if !matchesTagSelector(streamTags, selector) {
+			continue
+		}
+		selected = append(selected, streamMetadata)
+		if len(streamTags) > 0 {
+			tags = append(tags, &proto.StreamTags{Stream: streamMetadata.Name, Tags: streamTags})
+		}
+	}
+	resp.StreamMetadata = selected
+	return tags
+}
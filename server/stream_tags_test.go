@@ -0,0 +1,257 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure validateStreamTags enforces the tag limits.
+func TestValidateStreamTags(t *testing.T) {
+	require.NoError(t, validateStreamTags(nil))
+	require.NoError(t, validateStreamTags([]*proto.StreamTag{
+		{Key: "owner", Value: "billing"},
+		{Key: "pii", Value: ""},
+		{Key: strings.Repeat("k", maxStreamTagBytes), Value: strings.Repeat("v", maxStreamTagBytes)},
+	}))
+
+	tags := make([]*proto.StreamTag, maxStreamTags+1)
+	for i := range tags {
+		tags[i] = &proto.StreamTag{Key: fmt.Sprintf("key-%d", i)}
+	}
+	require.NoError(t, validateStreamTags(tags[:maxStreamTags]))
+
+	for _, invalid := range [][]*proto.StreamTag{
+		tags,
+		{{Key: "", Value: "billing"}},
+		{{Key: strings.Repeat("k", maxStreamTagBytes+1)}},
+		{{Key: "owner", Value: strings.Repeat("v", maxStreamTagBytes+1)}},
+		{{Key: "owner", Value: "billing"}, {Key: "owner", Value: "search"}},
+	} {
+		err := validateStreamTags(invalid)
+		require.Error(t, err)
+		require.Equal(t, ErrInvalidStreamTags, errors.Cause(err))
+	}
+}
+
+// Ensure stream tags and tag selectors are parsed from the request metadata
+// and selectors match the expected tags.
+func TestStreamTagsFromContext(t *testing.T) {
+	tags, err := streamTagsFromContext(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, tags)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		streamTagsMetadataKey, "owner=billing",
+		streamTagsMetadataKey, "query=a=b",
+		streamTagsMetadataKey, "pii="))
+	tags, err = streamTagsFromContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*proto.StreamTag{
+		{Key: "owner", Value: "billing"},
+		{Key: "query", Value: "a=b"},
+		{Key: "pii", Value: ""},
+	}, tags)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		streamTagsMetadataKey, "owner"))
+	_, err = streamTagsFromContext(ctx)
+	require.Error(t, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		streamTagSelectorMetadataKey, "owner=billing",
+		streamTagSelectorMetadataKey, "pii"))
+	selector, err := tagSelectorFromContext(ctx)
+	require.NoError(t, err)
+	require.True(t, matchesTagSelector(tags, selector))
+	require.True(t, matchesTagSelector(tags, nil))
+	require.False(t, matchesTagSelector(tags[:1], selector))
+	require.False(t, matchesTagSelector([]*proto.StreamTag{
+		{Key: "owner", Value: "search"},
+		{Key: "pii", Value: "true"},
+	}, selector))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		streamTagSelectorMetadataKey, "=billing"))
+	_, err = tagSelectorFromContext(ctx)
+	require.Error(t, err)
+}
+
+// Ensure stream tags set when creating a stream and with SetStreamTags are
+// returned and filtered by FetchMetadata, survive metadata leader failover
+// and recovery, and are dropped when the stream is deleted.
+func TestStreamTags(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use an external NATS server.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	var (
+		configs []*Config
+		servers []*Server
+	)
+	for i, id := range []string{"a", "b", "c"} {
+		config := getTestConfig(id, i == 0, 5050+i)
+		config.EmbeddedNATS = false
+		configs = append(configs, config)
+		s := runServerWithConfig(t, config)
+		defer s.Stop()
+		servers = append(servers, s)
+	}
+	leader := getMetadataLeader(t, 10*time.Second, servers...)
+
+	conns := make(map[*Server]*grpc.ClientConn)
+	for _, s := range servers {
+		conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", s.config.Port), grpc.WithInsecure())
+		require.NoError(t, err)
+		defer conn.Close()
+		conns[s] = conn
+	}
+	var follower *Server
+	for _, s := range servers {
+		if s != leader {
+			follower = s
+			break
+		}
+	}
+
+	create := func(s *Server, name string, tags ...string) error {
+		ctx := context.Background()
+		for _, tag := range tags {
+			ctx = metadata.AppendToOutgoingContext(ctx, streamTagsMetadataKey, tag)
+		}
+		_, err := client.NewAPIClient(conns[s]).CreateStream(ctx, &client.CreateStreamRequest{
+			Name:    name,
+			Subject: name,
+		})
+		return err
+	}
+	// fetch returns the names of the streams FetchMetadata returns on the
+	// given server for the given tag selector, along with their tags.
+	fetch := func(s *Server, selector ...string) ([]string, map[string][]*proto.StreamTag) {
+		ctx := context.Background()
+		for _, requirement := range selector {
+			ctx = metadata.AppendToOutgoingContext(ctx, streamTagSelectorMetadataKey, requirement)
+		}
+		var header metadata.MD
+		resp, err := client.NewAPIClient(conns[s]).FetchMetadata(ctx, &client.FetchMetadataRequest{},
+			grpc.Header(&header))
+		require.NoError(t, err)
+		var names []string
+		for _, stream := range resp.StreamMetadata {
+			names = append(names, stream.Name)
+		}
+		tags := make(map[string][]*proto.StreamTag)
+		for _, value := range header.Get(streamTagsHeader) {
+			streamTags := new(proto.StreamTags)
+			require.NoError(t, streamTags.Unmarshal([]byte(value)))
+			tags[streamTags.Stream] = streamTags.Tags
+		}
+		return names, tags
+	}
+
+	require.NoError(t, create(follower, "foo", "owner=billing", "env=prod"))
+	require.NoError(t, create(leader, "bar", "owner=search", "env=prod"))
+	require.NoError(t, create(leader, "baz"))
+
+	// Invalid tags are rejected.
+	err := create(leader, "qux", "owner=billing", "owner=search")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = create(leader, "qux", "owner")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	fooTags := []*proto.StreamTag{{Key: "owner", Value: "billing"}, {Key: "env", Value: "prod"}}
+	barTags := []*proto.StreamTag{{Key: "owner", Value: "search"}, {Key: "env", Value: "prod"}}
+	for _, s := range servers {
+		require.Eventually(t, func() bool {
+			names, _ := fetch(s)
+			return len(names) == 3
+		}, 10*time.Second, 10*time.Millisecond)
+		names, tags := fetch(s, "owner=billing")
+		require.Equal(t, []string{"foo"}, names)
+		require.Equal(t, map[string][]*proto.StreamTag{"foo": fooTags}, tags)
+		names, tags = fetch(s, "env=prod", "owner")
+		require.ElementsMatch(t, []string{"foo", "bar"}, names)
+		require.Equal(t, map[string][]*proto.StreamTag{"foo": fooTags, "bar": barTags}, tags)
+		names, _ = fetch(s, "env=dev")
+		require.Empty(t, names)
+	}
+
+	// Tags can be replaced through any server.
+	admin := proto.NewAdminAPIClient(conns[follower])
+	fooTags = []*proto.StreamTag{{Key: "owner", Value: "search"}}
+	_, err = admin.SetStreamTags(context.Background(), &proto.SetStreamTagsRequest{
+		Stream: "foo",
+		Tags:   fooTags,
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		names, _ := fetch(follower, "owner=search")
+		return len(names) == 2
+	}, 10*time.Second, 10*time.Millisecond)
+
+	_, err = admin.SetStreamTags(context.Background(), &proto.SetStreamTagsRequest{Stream: "qux"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	tooMany := make([]*proto.StreamTag, maxStreamTags+1)
+	for i := range tooMany {
+		tooMany[i] = &proto.StreamTag{Key: fmt.Sprintf("key-%d", i)}
+	}
+	_, err = admin.SetStreamTags(context.Background(), &proto.SetStreamTagsRequest{
+		Stream: "foo",
+		Tags:   tooMany,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Tags survive metadata leader failover.
+	leader.Stop()
+	var remaining []*Server
+	for _, s := range servers {
+		if s != leader {
+			remaining = append(remaining, s)
+		}
+	}
+	newLeader := getMetadataLeader(t, 10*time.Second, remaining...)
+	require.Eventually(t, func() bool {
+		names, _ := fetch(newLeader, "owner=search")
+		return len(names) == 2
+	}, 10*time.Second, 10*time.Millisecond)
+	_, tags := fetch(newLeader, "owner=search")
+	require.Equal(t, map[string][]*proto.StreamTag{"foo": fooTags, "bar": barTags}, tags)
+
+	// Deleting a stream drops its tags.
+	_, err = client.NewAPIClient(conns[newLeader]).DeleteStream(context.Background(),
+		&client.DeleteStreamRequest{Name: "bar"})
+	require.NoError(t, err)
+	require.NoError(t, create(newLeader, "bar"))
+	names, _ := fetch(newLeader, "owner=search")
+	require.Equal(t, []string{"foo"}, names)
+
+	// Tags are recovered by the restarted server.
+	for i, s := range servers {
+		if s != leader {
+			continue
+		}
+		restarted := runServerWithConfig(t, configs[i])
+		defer restarted.Stop()
+		require.Eventually(t, func() bool {
+			stream := restarted.metadata.GetStream("bar")
+			return stream != nil && len(stream.GetTags()) == 0 &&
+				restarted.metadata.GetStream("foo") != nil
+		}, 10*time.Second, 10*time.Millisecond)
+		require.Equal(t, fooTags, restarted.metadata.GetStream("foo").GetTags())
+	}
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	subjectTokenSeparator = "."
+	singleTokenWildcard   = "*"
+	multiTokenWildcard    = ">"
+)
+
+// validateSubject returns an error if the given string is not a valid NATS
+// subject for a stream to attach to. Subjects consist of non-empty tokens
+// separated by dots and can't contain whitespace. The "*" wildcard matches a
+// single token and the ">" wildcard matches one or more trailing tokens, so
+// either must make up a whole token and ">" must be the last token.
+func validateSubject(subject string) error {
+	if subject == "" {
+		return errors.New("subject is empty")
+	}
+	if strings.ContainsAny(subject, " \t\r\n") {
+		return fmt.Errorf("subject %q contains whitespace", subject)
+	}
+	tokens := strings.Split(subject, subjectTokenSeparator)
+	for i, token := range tokens {
+		if token == "" {
+			return fmt.Errorf("subject %q contains an empty token", subject)
+		}
+		if token == multiTokenWildcard && i != len(tokens)-1 {
+			return fmt.Errorf("subject %q has a %q wildcard before its last token",
+				subject, multiTokenWildcard)
+		}
+		if token != singleTokenWildcard && token != multiTokenWildcard &&
+			strings.ContainsAny(token, singleTokenWildcard+multiTokenWildcard) {
+			return fmt.Errorf("subject %q has a wildcard within token %q", subject, token)
+		}
+	}
+	return nil
+}
+
+// validateStreamSubject returns an error if the given subject is not valid for
+// a stream with the given number of partitions. Partitions other than the
+// first append their ID as a token to the stream subject, so a subject ending
+// with the ">" wildcard can only be used by a single-partition stream.
+func validateStreamSubject(subject string, partitions int32) error {
+	if err := validateSubject(subject); err != nil {
+		return err
+	}
+	endsWithWildcard := subject == multiTokenWildcard ||
+		strings.HasSuffix(subject, subjectTokenSeparator+multiTokenWildcard)
+	if partitions > 1 && endsWithWildcard {
+		return fmt.Errorf("subject %q ends with a %q wildcard so its stream can't have more than one partition",
+			subject, multiTokenWildcard)
+	}
+	return nil
+}
+
+// subjectsOverlap indicates if there is a subject which both of the given
+// subjects match, taking wildcards into account. For example, "foo.*"
+// overlaps "foo.bar" and "*.bar", but not "foo" or "foo.bar.baz". Both
+// subjects must be valid.
+func subjectsOverlap(a, b string) bool {
+	var (
+		aTokens = strings.Split(a, subjectTokenSeparator)
+		bTokens = strings.Split(b, subjectTokenSeparator)
+	)
+	for i := 0; i < len(aTokens) && i < len(bTokens); i++ {
+		aToken, bToken := aTokens[i], bTokens[i]
+		// Since both subjects have a token here, a ">" matches it and
+		// whatever follows in the other subject.
+		if aToken == multiTokenWildcard || bToken == multiTokenWildcard {
+			return true
+		}
+		if aToken != bToken && aToken != singleTokenWildcard && bToken != singleTokenWildcard {
+			return false
+		}
+	}
+	// Otherwise every token matched, so the subjects only overlap if they're
+	// the same length. A trailing ">" would have been handled above since it
+	// requires at least one token.
+	return len(aTokens) == len(bTokens)
+}
+
+// partitionSubject returns the NATS subject of the partition with the given
+// ID for a stream attached to the given subject.
+func partitionSubject(subject string, id int32) string {
+	if id == 0 {
+		return subject
+	}
+	return fmt.Sprintf("%s.%d", subject, id)
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Ensure validateSubject accepts valid NATS subjects, including wildcards, and
+// rejects invalid ones.
+func TestValidateSubject(t *testing.T) {
+	valid := []string{"foo", "foo.bar", "foo.*", "*.bar", "foo.>", ">", "*", "foo.*.>", "foo-bar_baz"}
+	for _, subject := range valid {
+		require.NoError(t, validateSubject(subject), subject)
+	}
+
+	invalid := []string{"", "foo bar", "foo\tbar", ".foo", "foo.", "foo..bar", "foo.>.bar",
+		">.foo", "foo.b*r", "foo*", "foo.>>", "foo.**"}
+	for _, subject := range invalid {
+		require.Error(t, validateSubject(subject), subject)
+	}
+}
+
+// Ensure validateStreamSubject only allows a trailing ">" wildcard for
+// single-partition streams.
+func TestValidateStreamSubject(t *testing.T) {
+	require.NoError(t, validateStreamSubject("foo.>", 1))
+	require.NoError(t, validateStreamSubject(">", 1))
+	require.NoError(t, validateStreamSubject("foo.*", 3))
+	require.Error(t, validateStreamSubject("foo.>", 2))
+	require.Error(t, validateStreamSubject(">", 2))
+	require.Error(t, validateStreamSubject("foo..bar", 1))
+}
+
+// Ensure subjectsOverlap follows NATS wildcard matching rules.
+func TestSubjectsOverlap(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		overlaps bool
+	}{
+		{"foo", "foo", true},
+		{"foo", "bar", false},
+		{"foo", "foo.bar", false},
+		{"foo.bar", "foo.baz", false},
+		{"foo.*", "foo.bar", true},
+		{"foo.*", "foo", false},
+		{"foo.*", "foo.bar.baz", false},
+		{"*.bar", "foo.*", true},
+		{"*.bar", "foo.baz", false},
+		{"foo.>", "foo.bar.baz", true},
+		{"foo.>", "foo", false},
+		{"foo.>", "foo.*", true},
+		{"foo.>", "bar.>", false},
+		{">", "foo", true},
+		{">", "foo.bar", true},
+		{"*", "foo.bar", false},
+		{"foo.*.baz", "foo.bar.*", true},
+		{"foo.*.baz", "foo.bar.qux", false},
+		{"foo.bar.>", "foo.*", false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.overlaps, subjectsOverlap(test.a, test.b), "%s %s", test.a, test.b)
+		require.Equal(t, test.overlaps, subjectsOverlap(test.b, test.a), "%s %s", test.b, test.a)
+	}
+}
+
+// Ensure partitionSubject appends the partition ID for all but the first
+// partition.
+func TestPartitionSubject(t *testing.T) {
+	require.Equal(t, "foo", partitionSubject("foo", 0))
+	require.Equal(t, "foo.1", partitionSubject("foo", 1))
+	require.Equal(t, "foo.*.12", partitionSubject("foo.*", 12))
+}
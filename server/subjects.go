@@ -0,0 +1,93 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// ErrSubjectPrefixMismatch is returned when a server asks to join the cluster
+// with a subject prefix which differs from the cluster's.
+var ErrSubjectPrefixMismatch = errors.New("subject prefix mismatch")
+
+// isValidSubjectPrefix indicates if the given subject prefix can be used to
+// build NATS subjects. An empty prefix is valid and means the namespace is
+// used.
+func isValidSubjectPrefix(prefix string) bool {
+	if prefix == "" {
+		return true
+	}
+	if strings.ContainsAny(prefix, " \t\r\n*>") {
+		return false
+	}
+	for _, token := range strings.Split(prefix, ".") {
+		if token == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// getSubjectPrefix returns the prefix of the NATS subjects used for internal
+// communication between servers and for the internal streams. This is the
+// cluster namespace unless a subject prefix is configured.
+func (s *Server) getSubjectPrefix() string {
+	if s.config.Clustering.SubjectPrefix != "" {
+		return s.config.Clustering.SubjectPrefix
+	}
+	return s.config.Clustering.Namespace
+}
+
+// checkJoinSubjectPrefix returns ErrSubjectPrefixMismatch if the server asking
+// to join uses a different subject prefix than this server. Servers which
+// predate subject prefixes don't send one and always use the namespace.
+func (s *Server) checkJoinSubjectPrefix(req *proto.RaftJoinRequest) error {
+	prefix := req.SubjectPrefix
+	if prefix == "" {
+		prefix = s.config.Clustering.Namespace
+	}
+	if ours := s.getSubjectPrefix(); prefix != ours {
+		return errors.Wrapf(ErrSubjectPrefixMismatch, "server %s uses %q but the cluster uses %q",
+			req.NodeID, prefix, ours)
+	}
+	return nil
+}
+
+// getSubjectLayout returns the NATS subjects used by the server, with
+// wildcards in place of the tokens which vary, e.g. server and partition IDs.
+func (s *Server) getSubjectLayout() []*proto.SubjectLayoutEntry {
+	var (
+		prefix  = s.getSubjectPrefix()
+		join    = s.baseClusterRaftSubject()
+		raftSub = s.baseMetadataRaftSubject()
+	)
+	entry := func(name, subject, description string) *proto.SubjectLayoutEntry {
+		return &proto.SubjectLayoutEntry{Name: name, Subject: subject, Description: description}
+	}
+	return []*proto.SubjectLayoutEntry{
+		entry("join", join+".join", "Requests to join the metadata Raft group"),
+		entry("bootstrap", join+".bootstrap", "Detection of servers misconfigured to bootstrap"),
+		entry("bootstrap.reply", join+".bootstrap.reply", "Replies to bootstrap detection"),
+		entry("raft.accept", raftSub+".*.accept", "Metadata Raft transport connections, one subject per server"),
+		entry("raft.request", raftSub+".*.request.>", "Metadata Raft transport requests, per connection"),
+		entry("propagate", s.getPropagateInbox(), "Requests propagated to the metadata leader"),
+		entry("info", s.getServerInfoInbox(), "Server information requests"),
+		entry("status", s.getPartitionStatusInbox("*"), "Partition status requests, one subject per server"),
+		entry("clean", s.getPartitionCleanInbox("*"), "Partition clean requests, one subject per server"),
+		entry("cursor", s.getCursorInbox("*"), "Cursor requests forwarded to cursors partition leaders, one subject per server"),
+		entry("started", s.getPartitionStartedInbox(), "Partition leaders announcing started partitions"),
+		entry("load", s.getPartitionLoadInbox(), "Partition load reports"),
+		entry("fetch", fmt.Sprintf("%s.fetch.*", raftSub), "Replies to metadata requests"),
+		entry("notify", s.getPartitionNotificationInbox("*"), "Notifications to idle followers, one subject per server"),
+		entry("replicate", fmt.Sprintf("%s.*.*.replicate", prefix), "Replication requests, one subject per partition"),
+		entry("offset", fmt.Sprintf("%s.*.*.offset", prefix), "Leader epoch offset requests, one subject per partition"),
+		entry("snapshot", fmt.Sprintf("%s.*.*.snapshot", prefix), "Snapshot chunk requests, one subject per partition"),
+		entry("replicate.reply", fmt.Sprintf("%s.inbox.*", prefix), "Replies to replication requests"),
+		entry("ack", fmt.Sprintf("%s.ack.*", prefix), "Publish acks for messages published by the server"),
+		entry("activity", s.getActivityStreamSubject(), "Activity stream"),
+		entry("cursors", s.getCursorStreamSubject(), "Cursors stream"),
+	}
+}
@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	natsdTest "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure subject prefixes are validated.
+func TestIsValidSubjectPrefix(t *testing.T) {
+	require.True(t, isValidSubjectPrefix(""))
+	require.True(t, isValidSubjectPrefix("foo"))
+	require.True(t, isValidSubjectPrefix("_lift.foo"))
+	require.False(t, isValidSubjectPrefix("foo bar"))
+	require.False(t, isValidSubjectPrefix("foo.*"))
+	require.False(t, isValidSubjectPrefix("foo.>"))
+	require.False(t, isValidSubjectPrefix(".foo"))
+	require.False(t, isValidSubjectPrefix("foo."))
+	require.False(t, isValidSubjectPrefix("foo..bar"))
+}
+
+// Ensure the subject prefix defaults to the namespace so existing deployments
+// keep their subjects.
+func TestSubjectPrefixDefault(t *testing.T) {
+	config := getTestConfig("a", true, 5050)
+	config.Clustering.Namespace = "foo"
+	s := New(config)
+	require.Equal(t, "foo", s.getSubjectPrefix())
+	require.Equal(t, "foo.raft.metadata", s.baseMetadataRaftSubject())
+	require.Equal(t, "foo.raft.metadata", s.baseClusterRaftSubject())
+	require.Equal(t, "foo.notify.b", s.getPartitionNotificationInbox("b"))
+
+	config.Clustering.SubjectPrefix = "_lift.foo"
+	require.Equal(t, "_lift.foo", s.getSubjectPrefix())
+	require.Equal(t, "_lift.foo.raft.metadata", s.baseMetadataRaftSubject())
+	require.Equal(t, "foo.raft.metadata", s.baseClusterRaftSubject())
+	require.Equal(t, "_lift.foo.notify.b", s.getPartitionNotificationInbox("b"))
+}
+
+// Ensure two clusters using different subject prefixes on the same NATS
+// server are isolated from each other, even with the same server IDs and
+// stream names, and that their internal subjects use the prefix.
+func TestSubjectPrefixClusterIsolation(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Use a central NATS server shared by both clusters.
+	ns := natsdTest.RunDefaultServer()
+	defer ns.Shutdown()
+
+	// Record the subjects used on the NATS server.
+	var (
+		mu       sync.Mutex
+		subjects = make(map[string]struct{})
+	)
+	nc, err := nats.Connect(nats.DefaultURL)
+	require.NoError(t, err)
+	defer nc.Close()
+	_, err = nc.Subscribe(">", func(msg *nats.Msg) {
+		mu.Lock()
+		subjects[msg.Subject] = struct{}{}
+		mu.Unlock()
+	})
+	require.NoError(t, err)
+	require.NoError(t, nc.Flush())
+
+	runCluster := func(namespace string, port int) []*Server {
+		servers := make([]*Server, 2)
+		for i, id := range []string{"a", "b"} {
+			config := getTestConfig(id, i == 0, port+i)
+			config.EmbeddedNATS = false
+			config.DataDir = filepath.Join(storagePath, namespace, id)
+			config.Clustering.Namespace = namespace
+			config.Clustering.SubjectPrefix = "_lift." + namespace
+			servers[i] = runServerWithConfig(t, config)
+		}
+		getMetadataLeader(t, 10*time.Second, servers...)
+		return servers
+	}
+	alpha := runCluster("alpha", 5050)
+	for _, s := range alpha {
+		defer s.Stop()
+	}
+	beta := runCluster("beta", 5060)
+	for _, s := range beta {
+		defer s.Stop()
+	}
+
+	for _, cluster := range []struct {
+		namespace string
+		port      int
+		servers   []*Server
+	}{
+		{"alpha", 5050, alpha},
+		{"beta", 5060, beta},
+	} {
+		client, err := lift.Connect([]string{fmt.Sprintf("localhost:%d", cluster.port)})
+		require.NoError(t, err)
+		defer client.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		require.NoError(t, client.CreateStream(ctx, cluster.namespace+".foo", "foo",
+			lift.ReplicationFactor(2)))
+		waitForISR(t, 10*time.Second, "foo", 0, 2, cluster.servers...)
+
+		for i := 0; i < 5; i++ {
+			_, err := client.Publish(ctx, "foo", []byte(fmt.Sprintf("%s-%d", cluster.namespace, i)),
+				lift.AckPolicyAll())
+			require.NoError(t, err)
+		}
+
+		// Each cluster only knows about its own servers.
+		metadata, err := client.FetchMetadata(ctx)
+		require.NoError(t, err)
+		require.Len(t, metadata.Brokers(), 2)
+		future := cluster.servers[0].getRaft().GetConfiguration()
+		require.NoError(t, future.Error())
+		require.Len(t, future.Configuration().Servers, 2)
+
+		// Each cluster only has its own messages, replicated to both
+		// servers.
+		msgs := make(chan *lift.Message, 10)
+		subCtx, subCancel := context.WithCancel(context.Background())
+		defer subCancel()
+		err = client.Subscribe(subCtx, "foo", func(msg *lift.Message, err error) {
+			require.NoError(t, err)
+			msgs <- msg
+		}, lift.StartAtEarliestReceived())
+		require.NoError(t, err)
+		for i := 0; i < 5; i++ {
+			select {
+			case msg := <-msgs:
+				require.Equal(t, fmt.Sprintf("%s-%d", cluster.namespace, i), string(msg.Value()))
+			case <-time.After(5 * time.Second):
+				t.Fatal("Did not receive expected message")
+			}
+		}
+		for _, s := range cluster.servers {
+			partition := s.metadata.GetPartition("foo", 0)
+			require.NotNil(t, partition)
+			require.Equal(t, int64(4), partition.log.NewestOffset())
+		}
+
+		// The subject layout reflects the prefix.
+		layout, err := cluster.servers[0].api.FetchSubjectLayout(ctx, &proto.FetchSubjectLayoutRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "a", layout.BrokerId)
+		require.Equal(t, cluster.namespace, layout.Namespace)
+		require.Equal(t, "_lift."+cluster.namespace, layout.SubjectPrefix)
+		resolved := make(map[string]string, len(layout.Subjects))
+		for _, entry := range layout.Subjects {
+			require.NotEmpty(t, entry.Description)
+			resolved[entry.Name] = entry.Subject
+		}
+		prefix := "_lift." + cluster.namespace
+		require.Equal(t, cluster.namespace+".raft.metadata.join", resolved["join"])
+		require.Equal(t, prefix+".raft.metadata.propagate", resolved["propagate"])
+		require.Equal(t, prefix+".raft.metadata.info", resolved["info"])
+		require.Equal(t, prefix+".raft.metadata.status.*", resolved["status"])
+		require.Equal(t, prefix+".notify.*", resolved["notify"])
+		require.Equal(t, prefix+".*.*.replicate", resolved["replicate"])
+		require.Equal(t, prefix+".*.*.snapshot", resolved["snapshot"])
+		require.Equal(t, prefix+".activity", resolved["activity"])
+		require.Equal(t, prefix+".cursors", resolved["cursors"])
+	}
+
+	// Internal traffic used the prefixes. Only the join and bootstrap
+	// subjects and the stream subjects start with the namespaces.
+	mu.Lock()
+	defer mu.Unlock()
+	for _, namespace := range []string{"alpha", "beta"} {
+		require.Contains(t, subjects, "_lift."+namespace+".foo.0.replicate")
+	}
+	for subject := range subjects {
+		for _, namespace := range []string{"alpha", "beta"} {
+			if !strings.HasPrefix(subject, namespace+".") {
+				continue
+			}
+			switch subject {
+			case namespace + ".foo",
+				namespace + ".raft.metadata.join",
+				namespace + ".raft.metadata.bootstrap",
+				namespace + ".raft.metadata.bootstrap.reply":
+			default:
+				t.Fatalf("Unexpected subject %s", subject)
+			}
+		}
+	}
+}
+
+// Ensure a server configured with a different subject prefix than the cluster
+// is rejected when it asks to join and the cluster remains healthy.
+func TestSubjectPrefixMismatchJoinRejected(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Clustering.SubjectPrefix = "_lift.foo"
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	// Configure second server with the default prefix.
+	raftJoinAttempts = 1
+	defer func() {
+		raftJoinAttempts = defaultRaftJoinAttempts
+	}()
+	s2Config := getTestConfig("b", false, 5051)
+	s2 := New(s2Config)
+	err := s2.Start()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), ErrSubjectPrefixMismatch.Error())
+	require.Contains(t, err.Error(), `"_lift.foo"`)
+
+	// The cluster is unaffected.
+	future := s1.getRaft().GetConfiguration()
+	require.NoError(t, future.Error())
+	require.Len(t, future.Configuration().Servers, 1)
+}
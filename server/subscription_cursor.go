@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// resumeFromCursorMetadataKey is the Subscribe request metadata key used
+	// to set the ID of the cursor to resume the subscription from, since
+	// SubscribeRequest has no field for it.
+	resumeFromCursorMetadataKey = "liftbridge-resume-from-cursor"
+
+	// autoCommitCursorMetadataKey is the Subscribe request metadata key used
+	// to set how often the partition leader commits the offset of the last
+	// message delivered to the cursor the subscription resumed from, e.g. 5s.
+	autoCommitCursorMetadataKey = "liftbridge-auto-commit-cursor"
+)
+
+// subscriptionCursorFromContext returns the cursor ID to resume from and the
+// auto-commit interval set in the incoming gRPC metadata of the given
+// context, if any. Auto-committing requires a cursor to resume from.
+func subscriptionCursorFromContext(ctx context.Context) (string, time.Duration, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", 0, nil
+	}
+	var cursorID string
+	switch values := md.Get(resumeFromCursorMetadataKey); len(values) {
+	case 0:
+	case 1:
+		if values[0] == "" {
+			return "", 0, fmt.Errorf("%s can't be empty", resumeFromCursorMetadataKey)
+		}
+		cursorID = values[0]
+	default:
+		return "", 0, fmt.Errorf("only one %s can be set", resumeFromCursorMetadataKey)
+	}
+
+	var interval time.Duration
+	switch values := md.Get(autoCommitCursorMetadataKey); len(values) {
+	case 0:
+	case 1:
+		var err error
+		interval, err = time.ParseDuration(values[0])
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid %s: %v", autoCommitCursorMetadataKey, err)
+		}
+		if interval <= 0 {
+			return "", 0, fmt.Errorf("%s must be positive", autoCommitCursorMetadataKey)
+		}
+		if cursorID == "" {
+			return "", 0, fmt.Errorf("%s requires %s", autoCommitCursorMetadataKey, resumeFromCursorMetadataKey)
+		}
+	default:
+		return "", 0, fmt.Errorf("only one %s can be set", autoCommitCursorMetadataKey)
+	}
+	return cursorID, interval, nil
+}
+
+// resumeFromCursor changes the start position of the subscription request to
+// the offset after the given cursor. The request's own start position is kept
+// if the cursor doesn't exist or the offset after it is no longer in the
+// partition, e.g. because it was removed by retention.
+func (a *apiServer) resumeFromCursor(ctx context.Context, req *client.SubscribeRequest,
+	cursorID string) *status.Status {
+
+	if req.Reverse {
+		return status.New(codes.InvalidArgument, "Reverse subscriptions can't resume from a cursor")
+	}
+	partition := a.metadata.GetPartition(req.Stream, req.Partition)
+	if partition == nil {
+		// Subscribing reports the missing partition.
+		return nil
+	}
+
+	offset, st := a.cursors.FetchCursor(ctx, req.Stream, cursorID, req.Partition)
+	if st != nil {
+		return status.Newf(st.Code(), "Failed to fetch cursor %s: %s", cursorID, st.Message())
+	}
+	if offset < 0 {
+		a.logger.Debugf("api: Cursor %s doesn't exist for partition %s, subscribing from %s",
+			cursorID, partition, req.StartPosition)
+		return nil
+	}
+	next := offset + 1
+	if next < partition.log.OldestOffset() || next > partition.log.HighWatermark()+1 {
+		a.logger.Debugf("api: Offset %d after cursor %s is no longer in partition %s, subscribing from %s",
+			next, cursorID, partition, req.StartPosition)
+		return nil
+	}
+	req.StartPosition = client.StartPosition_OFFSET
+	req.StartOffset = next
+	return nil
+}
+
+// autoCommitCursor commits the offset of the last message delivered on the
+// subscription to the given cursor every interval until the returned function
+// is called, which waits for a commit in progress to finish. Only delivered
+// messages are committed, so those skipped by the subscription's filter after
+// the last delivered message aren't.
+func (a *apiServer) autoCommitCursor(sub *subscription, req *client.SubscribeRequest, cursorID string,
+	interval time.Duration) func() {
+
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		done        = make(chan struct{})
+	)
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		committed := int64(-1)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			delivered := atomic.LoadInt64(&sub.delivered)
+			if delivered <= committed {
+				continue
+			}
+			if st := a.cursors.CommitCursor(ctx, req.Stream, cursorID, req.Partition, delivered); st != nil {
+				if ctx.Err() == nil {
+					a.logger.Warnf("api: Failed to commit cursor %s [stream=%s, partition=%d]: %v",
+						cursorID, req.Stream, req.Partition, st.Err())
+				}
+				continue
+			}
+			committed = delivered
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// ensureCursorPermissions checks that the caller may fetch cursors of the
+// given stream and, if commit is set, set them, as if it used the cursors API
+// itself.
+func (a *apiServer) ensureCursorPermissions(ctx context.Context, stream string, commit bool) error {
+	if err := a.ensureAuthorizationPermission(ctx, stream, "FetchCursor"); err != nil {
+		return err
+	}
+	if commit {
+		return a.ensureAuthorizationPermission(ctx, stream, "SetCursor")
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure subscriptionCursorFromContext parses the cursor to resume from and
+// the auto-commit interval, which requires the cursor.
+func TestSubscriptionCursorFromContext(t *testing.T) {
+	parse := func(kv ...string) (string, time.Duration, error) {
+		return subscriptionCursorFromContext(metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs(kv...)))
+	}
+
+	cursorID, interval, err := subscriptionCursorFromContext(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "", cursorID)
+	require.Equal(t, time.Duration(0), interval)
+
+	cursorID, interval, err = parse(resumeFromCursorMetadataKey, "abc")
+	require.NoError(t, err)
+	require.Equal(t, "abc", cursorID)
+	require.Equal(t, time.Duration(0), interval)
+
+	cursorID, interval, err = parse(resumeFromCursorMetadataKey, "abc", autoCommitCursorMetadataKey, "5s")
+	require.NoError(t, err)
+	require.Equal(t, "abc", cursorID)
+	require.Equal(t, 5*time.Second, interval)
+
+	_, _, err = parse(resumeFromCursorMetadataKey, "")
+	require.Error(t, err)
+	_, _, err = parse(resumeFromCursorMetadataKey, "a", resumeFromCursorMetadataKey, "b")
+	require.Error(t, err)
+	_, _, err = parse(autoCommitCursorMetadataKey, "5s")
+	require.Error(t, err)
+	_, _, err = parse(resumeFromCursorMetadataKey, "abc", autoCommitCursorMetadataKey, "foo")
+	require.Error(t, err)
+	_, _, err = parse(resumeFromCursorMetadataKey, "abc", autoCommitCursorMetadataKey, "0s")
+	require.Error(t, err)
+}
+
+// Ensure a subscriber resuming from a cursor with auto-commit enabled picks
+// up after the last message delivered before it was restarted, starts from
+// the requested position if the cursor doesn't exist, and never commits
+// messages skipped by its filter.
+func TestSubscribeResumeFromCursor(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.CursorsStream.Partitions = 1
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	api := client.NewAPIClient(conn)
+
+	_, err = api.CreateStream(context.Background(), &client.CreateStreamRequest{
+		Name:    "foo",
+		Subject: "foo",
+	})
+	require.NoError(t, err)
+	waitForPartition(t, 10*time.Second, "foo", 0, s1)
+
+	publish := func(eventType string) {
+		_, err := api.Publish(context.Background(), &client.PublishRequest{
+			Stream:    "foo",
+			Value:     []byte(eventType),
+			Headers:   map[string][]byte{"type": []byte(eventType)},
+			AckPolicy: client.AckPolicy_LEADER,
+		})
+		require.NoError(t, err)
+	}
+	// Every third message is an order.
+	for i := 0; i < 10; i++ {
+		if i%3 == 0 {
+			publish("order")
+		} else {
+			publish("refund")
+		}
+	}
+
+	const interval = 100 * time.Millisecond
+	subscribe := func(ctx context.Context, cursorID string, kv ...string) client.API_SubscribeClient {
+		ctx = metadata.AppendToOutgoingContext(ctx,
+			append([]string{
+				resumeFromCursorMetadataKey, cursorID,
+				autoCommitCursorMetadataKey, interval.String(),
+			}, kv...)...)
+		stream, err := api.Subscribe(ctx, &client.SubscribeRequest{
+			Stream:        "foo",
+			StartPosition: client.StartPosition_EARLIEST,
+		})
+		require.NoError(t, err)
+		// Wait for the empty message signaling the subscription was created.
+		_, err = stream.Recv()
+		require.NoError(t, err)
+		return stream
+	}
+	fetchCursor := func(cursorID string) int64 {
+		resp, err := api.FetchCursor(context.Background(), &client.FetchCursorRequest{
+			Stream:   "foo",
+			CursorId: cursorID,
+		})
+		require.NoError(t, err)
+		return resp.Offset
+	}
+
+	// Without a cursor, the subscription starts at the requested position.
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := subscribe(ctx, "c1")
+	for i := int64(0); i < 10; i++ {
+		msg, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, i, msg.Offset)
+	}
+
+	// Stop the subscriber once the delivered offset was committed.
+	require.Eventually(t, func() bool {
+		return fetchCursor("c1") == 9
+	}, 10*interval, 10*time.Millisecond)
+	cancel()
+	publish("refund")
+	publish("refund")
+
+	// The restarted subscriber resumes after the committed offset.
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	stream = subscribe(ctx, "c1")
+	msg, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(10), msg.Offset)
+
+	// Filtered messages after the last delivered one aren't committed.
+	filtered := subscribe(ctx, "c2", headerFilterMetadataKey, "type=order")
+	for _, expected := range []int64{0, 3, 6, 9} {
+		msg, err := filtered.Recv()
+		require.NoError(t, err)
+		require.Equal(t, expected, msg.Offset)
+	}
+	require.Eventually(t, func() bool {
+		return fetchCursor("c2") == 9
+	}, 10*interval, 10*time.Millisecond)
+	time.Sleep(3 * interval)
+	require.Equal(t, int64(9), fetchCursor("c2"))
+
+	// Auto-commit requires a cursor to resume from.
+	ctx = metadata.AppendToOutgoingContext(context.Background(), autoCommitCursorMetadataKey, "1s")
+	invalid, err := api.Subscribe(ctx, &client.SubscribeRequest{Stream: "foo"})
+	require.NoError(t, err)
+	_, err = invalid.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
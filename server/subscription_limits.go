@@ -0,0 +1,79 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// subscribedPartition identifies a partition subscriptions are counted
+// against. Partitions are identified by name rather than by the partition
+// object since subscriptions outlive the object when parked across a pause.
+type subscribedPartition struct {
+	stream    string
+	partition int32
+}
+
+// subscriptionLimiter caps the number of client subscriptions served by the
+// server at once, per partition and in total. Each subscription's reader
+// parks a goroutine and registers HW and LEO waiters on the partition's log
+// whenever it's caught up, so without a cap a client opening a large number
+// of subscriptions could degrade the broker. A limit of 0 means unlimited.
+type subscriptionLimiter struct {
+	mu              sync.Mutex
+	maxPerPartition int
+	maxPerServer    int
+	total           int
+	partitions      map[subscribedPartition]int
+}
+
+func newSubscriptionLimiter(maxPerPartition, maxPerServer int) *subscriptionLimiter {
+	return &subscriptionLimiter{
+		maxPerPartition: maxPerPartition,
+		maxPerServer:    maxPerServer,
+		partitions:      make(map[subscribedPartition]int),
+	}
+}
+
+// acquire reserves a subscription slot on the given partition. It returns a
+// ResourceExhausted status if either limit has been reached. Otherwise, the
+// returned function must be called to release the slot once the subscription
+// ends.
+func (l *subscriptionLimiter) acquire(stream string, partition int32) (func(), *status.Status) {
+	key := subscribedPartition{stream: stream, partition: partition}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.maxPerServer > 0 && l.total >= l.maxPerServer {
+		return nil, status.New(codes.ResourceExhausted, fmt.Sprintf(
+			"Server has reached the limit of %d subscriptions", l.maxPerServer))
+	}
+	if l.maxPerPartition > 0 && l.partitions[key] >= l.maxPerPartition {
+		return nil, status.New(codes.ResourceExhausted, fmt.Sprintf(
+			"Partition has reached the limit of %d subscriptions", l.maxPerPartition))
+	}
+	l.total++
+	l.partitions[key]++
+
+	var once sync.Once
+	return func() { once.Do(func() { l.release(key) }) }, nil
+}
+
+// release frees a subscription slot on the given partition.
+func (l *subscriptionLimiter) release(key subscribedPartition) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.total--
+	if l.partitions[key]--; l.partitions[key] <= 0 {
+		delete(l.partitions, key)
+	}
+}
+
+// count returns the number of subscriptions counted against the given
+// partition and against the server in total.
+func (l *subscriptionLimiter) count(stream string, partition int32) (int, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.partitions[subscribedPartition{stream: stream, partition: partition}], l.total
+}
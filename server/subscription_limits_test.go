@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the subscription limiter enforces the per-partition and per-server
+// limits and frees slots once released.
+func TestSubscriptionLimiter(t *testing.T) {
+	l := newSubscriptionLimiter(2, 3)
+
+	releaseA1, st := l.acquire("a", 0)
+	require.Nil(t, st)
+	_, st = l.acquire("a", 0)
+	require.Nil(t, st)
+
+	// Partition limit reached.
+	_, st = l.acquire("a", 0)
+	require.NotNil(t, st)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+
+	_, st = l.acquire("a", 1)
+	require.Nil(t, st)
+
+	// Server limit reached.
+	_, st = l.acquire("b", 0)
+	require.NotNil(t, st)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+
+	// Releasing is idempotent.
+	releaseA1()
+	releaseA1()
+	partition, total := l.count("a", 0)
+	require.Equal(t, 1, partition)
+	require.Equal(t, 2, total)
+
+	_, st = l.acquire("b", 0)
+	require.Nil(t, st)
+
+	// No limits.
+	l = newSubscriptionLimiter(0, 0)
+	for i := 0; i < 100; i++ {
+		_, st = l.acquire("a", 0)
+		require.Nil(t, st)
+	}
+}
+
+// Ensure subscriptions past the per-partition limit are rejected with
+// ResourceExhausted and accepted again once a subscription ends.
+func TestSubscribeMaxPerPartition(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.SubscriptionMaxPerPartition = 1
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	conn, err := grpc.Dial("localhost:5050", grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+	apiClient := client.NewAPIClient(conn)
+
+	_, err = apiClient.CreateStream(context.Background(), &client.CreateStreamRequest{
+		Name:    "foo",
+		Subject: "foo",
+	})
+	require.NoError(t, err)
+	waitForPartition(t, 10*time.Second, "foo", 0, s1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := apiClient.Subscribe(ctx, &client.SubscribeRequest{Stream: "foo"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	stream2, err := apiClient.Subscribe(context.Background(), &client.SubscribeRequest{Stream: "foo"})
+	require.NoError(t, err)
+	_, err = stream2.Recv()
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	cancel()
+	require.Eventually(t, func() bool {
+		partition, _ := s1.subLimiter.count("foo", 0)
+		return partition == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	stream, err = apiClient.Subscribe(ctx, &client.SubscribeRequest{Stream: "foo"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+}
@@ -0,0 +1,50 @@
+package server
+
+import "sync"
+
+// replicationThrottle holds the limits on the rate at which the server, as a
+// partition leader, sends messages to followers which are catching up so that
+// their replication doesn't saturate the server's disk and NATS connection
+// and hurt live traffic. Each replicator limits its follower to the
+// per-follower rate while the broker limiter is shared by all throttled
+// followers. The limits can be changed while the server is running.
+type replicationThrottle struct {
+	mu     sync.RWMutex
+	config ReplicationThrottleConfig
+	broker *byteRateLimiter
+}
+
+func newReplicationThrottle(config ReplicationThrottleConfig) *replicationThrottle {
+	return &replicationThrottle{
+		config: config,
+		broker: newByteRateLimiter(config.BrokerRate),
+	}
+}
+
+// getConfig returns the current throttle limits.
+func (t *replicationThrottle) getConfig() ReplicationThrottleConfig {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.config
+}
+
+// setConfig changes the throttle limits. Replicators pick up the new
+// per-follower rate on the next replication request.
+func (t *replicationThrottle) setConfig(config ReplicationThrottleConfig) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.config = config
+	t.broker.setRate(config.BrokerRate)
+}
+
+// shouldThrottle indicates if replication to a follower with the given lag
+// should be throttled. Followers in the ISR are never throttled so that
+// commits aren't delayed, nor are followers lagging at most the configured
+// threshold so they can finish catching up and rejoin the ISR.
+func (t *replicationThrottle) shouldThrottle(inISR bool, lag int64) bool {
+	config := t.getConfig()
+	if config.ReplicaRate == 0 && config.BrokerRate == 0 {
+		return false
+	}
+	return !inISR && lag > config.LagThreshold
+}
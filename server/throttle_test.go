@@ -0,0 +1,32 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Ensure only followers outside the ISR lagging more than the threshold are
+// throttled and that changing the limits applies to the broker limiter.
+func TestReplicationThrottleShouldThrottle(t *testing.T) {
+	throttle := newReplicationThrottle(ReplicationThrottleConfig{})
+	require.False(t, throttle.shouldThrottle(false, 1000))
+
+	throttle.setConfig(ReplicationThrottleConfig{
+		ReplicaRate:  1024,
+		BrokerRate:   4096,
+		LagThreshold: 100,
+	})
+	require.Equal(t, int64(4096), throttle.broker.limit())
+	require.True(t, throttle.shouldThrottle(false, 101))
+	require.False(t, throttle.shouldThrottle(false, 100))
+	require.False(t, throttle.shouldThrottle(true, 1000))
+
+	// Either limit enables throttling.
+	throttle.setConfig(ReplicationThrottleConfig{BrokerRate: 4096})
+	require.True(t, throttle.shouldThrottle(false, 1))
+	require.False(t, throttle.shouldThrottle(false, 0))
+	throttle.setConfig(ReplicationThrottleConfig{ReplicaRate: 1024})
+	require.Zero(t, throttle.broker.limit())
+	require.True(t, throttle.shouldThrottle(false, 1))
+}
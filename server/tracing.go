@@ -0,0 +1,183 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// tracerName identifies the instrumentation library spans are created by.
+const tracerName = "github.com/liftbridge-io/liftbridge/server"
+
+// Attributes set on spans for metadata operations.
+const (
+	streamAttribute         = attribute.Key("liftbridge.stream")
+	partitionAttribute      = attribute.Key("liftbridge.partition")
+	leaderAttribute         = attribute.Key("liftbridge.leader")
+	previousLeaderAttribute = attribute.Key("liftbridge.previous_leader")
+	metadataLeaderAttribute = attribute.Key("liftbridge.metadata_leader")
+	opAttribute             = attribute.Key("liftbridge.op")
+	expectedPeersAttribute  = attribute.Key("liftbridge.expected_peers")
+	brokersAttribute        = attribute.Key("liftbridge.brokers")
+)
+
+// tracePropagator extracts the trace context clients set in gRPC request
+// metadata so that RPC spans join the client's trace.
+var tracePropagator = propagation.TraceContext{}
+
+// newTracer returns the tracer the server creates spans with before tracing
+// is started. It uses the global tracer provider, which discards spans unless
+// the application embedding the server installs one.
+func newTracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// startTracing installs an OTLP exporter sending spans to the configured
+// endpoint. This does nothing if no endpoint is configured, in which case
+// spans go to the global tracer provider.
+func (s *Server) startTracing() error {
+	endpoint := s.config.Tracing.OTLPEndpoint
+	if endpoint == "" {
+		return nil
+	}
+	var opt otlptracegrpc.Option
+	if strings.Contains(endpoint, "://") {
+		opt = otlptracegrpc.WithEndpointURL(endpoint)
+	} else {
+		opt = otlptracegrpc.WithEndpoint(endpoint)
+	}
+	exporter, err := otlptracegrpc.New(context.Background(), opt)
+	if err != nil {
+		return errors.Wrap(err, "failed to create OTLP exporter")
+	}
+	s.tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", "liftbridge"),
+			attribute.String("service.instance.id", s.config.Clustering.ServerID),
+		)),
+	)
+	s.tracer = s.tracerProvider.Tracer(tracerName)
+	s.logger.Infof("Exporting traces to %s", endpoint)
+	return nil
+}
+
+// stopTracing flushes spans that haven't been exported yet and stops the OTLP
+// exporter, if tracing was started.
+func (s *Server) stopTracing() {
+	if s.tracerProvider == nil {
+		return
+	}
+	if err := s.tracerProvider.Shutdown(context.Background()); err != nil {
+		s.logger.Warnf("Failed to flush traces: %v", err)
+	}
+}
+
+// traceUnaryInterceptor creates a span for each unary RPC.
+func (s *Server) traceUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, span := s.startRPCSpan(ctx, info.FullMethod)
+	resp, err := handler(ctx, req)
+	endRPCSpan(span, err)
+	return resp, err
+}
+
+// traceStreamInterceptor creates a span for each streaming RPC which lasts
+// for the lifetime of the stream.
+func (s *Server) traceStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	stream := grpc_middleware.WrapServerStream(ss)
+	ctx, span := s.startRPCSpan(ss.Context(), info.FullMethod)
+	stream.WrappedContext = ctx
+	err := handler(srv, stream)
+	endRPCSpan(span, err)
+	return err
+}
+
+// startRPCSpan starts a server span for the given gRPC method, e.g.
+// /proto.API/CreateStream, as a child of the trace context in the request
+// metadata, if any.
+func (s *Server) startRPCSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = tracePropagator.Extract(ctx, metadataCarrier(md))
+	service, method := splitFullMethod(fullMethod)
+	return s.tracer.Start(ctx, fullMethod,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method),
+		))
+}
+
+// endRPCSpan records the status code of the RPC and ends its span.
+func endRPCSpan(span trace.Span, err error) {
+	code := status.Code(err)
+	span.SetAttributes(attribute.Int64("rpc.grpc.status_code", int64(code)))
+	if err != nil {
+		span.SetStatus(otelcodes.Error, status.Convert(err).Message())
+	}
+	span.End()
+}
+
+// endSpan records the status of a metadata operation and ends its span.
+func endSpan(span trace.Span, st *status.Status) {
+	if st != nil {
+		span.SetStatus(otelcodes.Error, st.Message())
+	}
+	span.End()
+}
+
+// splitFullMethod splits a gRPC method name such as /proto.API/CreateStream
+// into its service and method.
+func splitFullMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "", fullMethod
+}
+
+// metadataCarrier adapts gRPC metadata to a propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Ensure CreateStream RPCs create a span with child spans for the metadata
+// operations when the request is sent to the metadata follower.
+func TestTracingCreateStream(t *testing.T) {
+	defer cleanupStorage(t)
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prevProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(prevProvider)
+
+	// Configure first server.
+	s1Config := getTestConfig("a", true, 0)
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	// Configure second server.
+	s2Config := getTestConfig("b", false, 5050)
+	s2 := runServerWithConfig(t, s2Config)
+	defer s2.Stop()
+
+	// Connect and send the request to the follower.
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.CreateStream(ctx, "foo", "bar"))
+
+	spans := exporter.GetSpans()
+	findSpan := func(name string, parent trace.SpanID, attr attribute.KeyValue) *tracetest.SpanStub {
+		for i, span := range spans {
+			if span.Name != name || span.Parent.SpanID() != parent {
+				continue
+			}
+			for _, a := range span.Attributes {
+				if a == attr {
+					return &spans[i]
+				}
+			}
+		}
+		return nil
+	}
+
+	// The RPC span is the root of the follower's trace.
+	rpcSpan := findSpan("/proto.API/CreateStream", trace.SpanID{},
+		attribute.String("rpc.method", "CreateStream"))
+	require.NotNil(t, rpcSpan)
+	require.Equal(t, trace.SpanKindServer, rpcSpan.SpanKind)
+
+	// The follower's CreateStream span is a child of the RPC span.
+	createSpan := findSpan("metadata.CreateStream", rpcSpan.SpanContext.SpanID(),
+		streamAttribute.String("bar"))
+	require.NotNil(t, createSpan)
+	require.Equal(t, rpcSpan.SpanContext.TraceID(), createSpan.SpanContext.TraceID())
+
+	// The request is propagated to the metadata leader.
+	propagateSpan := findSpan("metadata.propagateRequest", createSpan.SpanContext.SpanID(),
+		opAttribute.String("CREATE_STREAM"))
+	require.NotNil(t, propagateSpan)
+	require.Contains(t, propagateSpan.Attributes, metadataLeaderAttribute.String("a"))
+
+	// The metadata leader assigns the partition a leader.
+	leaderSpan := findSpan("metadata.CreateStream", trace.SpanID{}, streamAttribute.String("bar"))
+	require.NotNil(t, leaderSpan)
+	require.Len(t, leaderSpan.Events, 1)
+	event := leaderSpan.Events[0]
+	require.Equal(t, "partition assigned", event.Name)
+	require.Contains(t, event.Attributes, partitionAttribute.Int64(0))
+	var leader string
+	for _, a := range event.Attributes {
+		if a.Key == leaderAttribute {
+			leader = a.Value.AsString()
+		}
+	}
+	require.Contains(t, []string{"a", "b"}, leader)
+}
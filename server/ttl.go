@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+const (
+	// streamTTLMetadataKey is the gRPC metadata key used to set the TTL of a
+	// stream, in milliseconds, when creating it since CreateStreamRequest has
+	// no field for it.
+	streamTTLMetadataKey = "liftbridge-stream-ttl"
+
+	// streamTTLDeleteTimeout is how long the metadata leader waits for the
+	// deletion of an expired stream to be applied.
+	streamTTLDeleteTimeout = 30 * time.Second
+)
+
+// streamTTLFromContext returns the stream TTL set in the incoming gRPC
+// metadata, if any.
+func streamTTLFromContext(ctx context.Context) (*proto.NullableInt64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	values := md.Get(streamTTLMetadataKey)
+	switch {
+	case len(values) == 0:
+		return nil, nil
+	case len(values) > 1:
+		return nil, fmt.Errorf("only one %s can be set", streamTTLMetadataKey)
+	}
+	ttl, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil || ttl <= 0 {
+		return nil, fmt.Errorf("invalid %s %q", streamTTLMetadataKey, values[0])
+	}
+	return &proto.NullableInt64{Value: ttl}, nil
+}
+
+// streamTTLLoop periodically deletes the streams whose TTL expired while this
+// server is the metadata leader until the server shuts down.
+func (s *Server) streamTTLLoop() {
+	ticker := time.NewTicker(s.config.Clustering.StreamTTLCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownCh:
+			return
+		case <-ticker.C:
+		}
+		if s.IsLeader() {
+			s.deleteExpiredStreams(time.Now())
+		}
+	}
+}
+
+// deleteExpiredStreams deletes the streams whose creation time plus TTL is at
+// or before the given time. Deletions go through Raft, so a stream which is
+// found expired again before its deletion is applied, e.g. by a new leader,
+// is only deleted once.
+func (s *Server) deleteExpiredStreams(now time.Time) {
+	for _, stream := range s.metadata.GetStreams() {
+		ttl := stream.GetTTL()
+		if ttl <= 0 || stream.IsTombstoned() {
+			continue
+		}
+		created := stream.GetCreationTime()
+		if created.IsZero() || now.Before(created.Add(ttl)) {
+			continue
+		}
+		name := stream.GetName()
+		s.logger.Infof("Deleting stream %s since its TTL of %s expired", name, ttl)
+		ctx, cancel := context.WithTimeout(context.Background(), streamTTLDeleteTimeout)
+		st := s.metadata.DeleteStream(ctx, &proto.DeleteStreamOp{
+			Stream:       name,
+			TtlDeletedAt: now.UnixNano(),
+		})
+		cancel()
+		if st != nil && st.Code() != codes.NotFound {
+			s.logger.Errorf("Failed to delete expired stream %s: %v", name, st.Err())
+		}
+	}
+}
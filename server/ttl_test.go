@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	proto "github.com/liftbridge-io/liftbridge/server/protocol"
+)
+
+// Ensure streamTTLFromContext parses the stream TTL from the request metadata.
+func TestStreamTTLFromContext(t *testing.T) {
+	ttl, err := streamTTLFromContext(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, ttl)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		streamTTLMetadataKey, "500"))
+	ttl, err = streamTTLFromContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(500), ttl.Value)
+
+	for _, value := range []string{"0", "-1", "1s"} {
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			streamTTLMetadataKey, value))
+		_, err = streamTTLFromContext(ctx)
+		require.Error(t, err)
+	}
+}
+
+// Ensure streams are deleted from every server once their TTL expires and
+// streams without a TTL are left alone.
+func TestStreamTTLDeletion(t *testing.T) {
+	defer cleanupStorage(t)
+
+	var servers []*Server
+	for i, id := range []string{"a", "b", "c"} {
+		config := getTestConfig(id, i == 0, 5050+i)
+		config.Clustering.StreamTTLCheckInterval = 100 * time.Millisecond
+		s := runServerWithConfig(t, config)
+		defer s.Stop()
+		servers = append(servers, s)
+	}
+	leader := getMetadataLeader(t, 10*time.Second, servers...)
+	watcher, unwatch := leader.metadata.events.watch([]string{"foo"})
+	defer unwatch()
+
+	client, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	created := time.Now()
+	ctx := metadata.AppendToOutgoingContext(context.Background(), streamTTLMetadataKey, "500")
+	require.NoError(t, client.CreateStream(ctx, "foo", "foo", lift.ReplicationFactor(3)))
+	require.NoError(t, client.CreateStream(context.Background(), "bar", "bar",
+		lift.ReplicationFactor(3)))
+	waitForPartition(t, 5*time.Second, "foo", 0, servers...)
+	waitForPartition(t, 5*time.Second, "bar", 0, servers...)
+	for _, s := range servers {
+		require.Equal(t, 500*time.Millisecond, s.metadata.GetStream("foo").GetTTL())
+		require.Zero(t, s.metadata.GetStream("bar").GetTTL())
+	}
+
+	time.Sleep(time.Second)
+	require.Eventually(t, func() bool {
+		for _, s := range servers {
+			if s.metadata.GetStream("foo") != nil {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// The deletion records when the stream was found expired.
+	var deleted *proto.MetadataEvent
+	for deleted == nil {
+		select {
+		case event := <-watcher.events:
+			if event.Type == proto.MetadataEventType_STREAM_DELETED {
+				deleted = event
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Did not receive stream deleted event")
+		}
+	}
+	require.Equal(t, "foo", deleted.Stream)
+	require.False(t, time.Unix(0, deleted.TtlDeletedAt).Before(created.Add(500*time.Millisecond)))
+
+	// Streams without a TTL are never deleted.
+	time.Sleep(500 * time.Millisecond)
+	for _, s := range servers {
+		require.NotNil(t, s.metadata.GetStream("bar"))
+	}
+}
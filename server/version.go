@@ -1,7 +1,43 @@
 package server
 
+import proto "github.com/liftbridge-io/liftbridge/server/protocol"
+
 // Version of the Liftbridge server.
 // This variable can be overridden at build time using:
 //
 //	go build -ldflags "-X github.com/liftbridge-io/liftbridge/server.Version=v1.0.0"
 var Version = "dev"
+
+// currentProtocolVersion is the version of the inter-broker protocol
+// implemented by this server. It must be incremented when adding a Raft
+// operation which servers running an older version are unable to apply.
+// Servers which predate protocol versioning report version 0.
+const currentProtocolVersion uint32 = 12
+
+// readonlyTargetProtocolVersion is the protocol version every server must
+// implement before SetStreamReadonly operations with a target offset are
+// applied. Older servers ignore the target and would make the partitions
+// readonly immediately.
+const readonlyTargetProtocolVersion uint32 = 3
+
+// opProtocolVersions maps Raft operations to the protocol version every
+// server in the cluster must implement before the metadata leader applies
+// them. Operations which aren't listed are supported by all versions.
+var opProtocolVersions = map[proto.Op]uint32{
+	proto.Op_UPDATE_STREAM_OWNER:         1,
+	proto.Op_REPORT_PARTITION_SKEW:       1,
+	proto.Op_REGISTER_PRODUCER:           2,
+	proto.Op_RELEASE_PRODUCER:            2,
+	proto.Op_SET_SERVER_REMOVED:          4,
+	proto.Op_SCHEDULE_OPERATION:          5,
+	proto.Op_CANCEL_SCHEDULED_OPERATION:  5,
+	proto.Op_EXECUTE_SCHEDULED_OPERATION: 5,
+	proto.Op_UPDATE_STREAM_CONFIG:        6,
+	proto.Op_SET_STREAM_TAGS:             7,
+	proto.Op_DELETE_PARTITION_DATA:       8,
+	proto.Op_SET_STREAM_ACL:              9,
+	proto.Op_REPLACE_REPLICA:             10,
+	proto.Op_SET_PRODUCER_QUOTA:          11,
+	proto.Op_DELETE_PRODUCER_QUOTA:       11,
+	proto.Op_DELETE_PARTITION:            12,
+}
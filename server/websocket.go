@@ -0,0 +1,282 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// webSocketSubscribePath is the path of the WebSocket endpoint clients
+	// subscribe to stream partitions on.
+	webSocketSubscribePath = "/subscribe"
+
+	// webSocketCloseCodeBase is added to the gRPC status code a subscription
+	// ended with to get the WebSocket close code, which puts it in the range
+	// reserved for applications.
+	webSocketCloseCodeBase = 4000
+
+	webSocketRequestTimeout = 10 * time.Second
+	webSocketCloseTimeout   = time.Second
+)
+
+// webSocketSubscribeRequest is the first message a WebSocket client sends to
+// subscribe to a stream partition.
+type webSocketSubscribeRequest struct {
+	Metadata  map[string]string `json:"metadata"`  // Request metadata, e.g. credentials, since browsers can't set headers
+	Subscribe json.RawMessage   `json:"subscribe"` // SubscribeRequest in its protobuf JSON form
+}
+
+// webSocketMessage is a message sent to a WebSocket subscriber. Byte slices
+// are base64-encoded.
+type webSocketMessage struct {
+	Stream    string            `json:"stream"`
+	Partition int32             `json:"partition"`
+	Offset    int64             `json:"offset"`
+	Key       []byte            `json:"key"`
+	Value     []byte            `json:"value"`
+	Headers   map[string][]byte `json:"headers"`
+	Timestamp int64             `json:"timestamp"`
+}
+
+var webSocketUpgrader = websocket.Upgrader{}
+
+// startWebSocketServer starts the HTTP server which upgrades connections to
+// WebSocket and serves subscriptions on them.
+func (s *Server) startWebSocketServer() error {
+	l, err := net.Listen("tcp", s.config.WebSocket.ListenAddress)
+	if err != nil {
+		return errors.Wrap(err, "failed starting WebSocket listener")
+	}
+	if s.tlsConfig != nil {
+		l = tls.NewListener(l, s.tlsConfig)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(webSocketSubscribePath, s.handleWebSocketSubscribe)
+	s.webSocketServer = &http.Server{Handler: mux}
+
+	s.logger.Infof("Starting WebSocket server on %s...", l.Addr())
+	s.startGoroutine(func() {
+		if err := s.webSocketServer.Serve(l); err != nil && err != http.ErrServerClosed {
+			select {
+			case <-s.shutdownCh:
+				return
+			default:
+				s.logger.Fatal(err)
+			}
+		}
+	})
+	return nil
+}
+
+// handleWebSocketSubscribe upgrades the request to a WebSocket connection,
+// reads the subscribe request from it, and serves the Subscribe RPC over it
+// through the same interceptors as the gRPC server, so it's authenticated and
+// authorized the same way. The connection is closed once the subscription
+// ends, with a close code of webSocketCloseCodeBase plus the gRPC status code
+// if it ended with an error.
+func (s *Server) handleWebSocketSubscribe(w http.ResponseWriter, r *http.Request) {
+	conn, err := webSocketUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader already replied with an HTTP error.
+		s.logger.Debugf("api: Failed to upgrade WebSocket connection from %s: %v", r.RemoteAddr, err)
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	var req webSocketSubscribeRequest
+	conn.SetReadDeadline(time.Now().Add(webSocketRequestTimeout))
+	if err := conn.ReadJSON(&req); err != nil {
+		closeWebSocket(conn, status.Errorf(codes.InvalidArgument, "Invalid subscribe request: %v", err))
+		return
+	}
+	if len(req.Subscribe) == 0 {
+		closeWebSocket(conn, status.Error(codes.InvalidArgument, "No subscribe request provided"))
+		return
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	// Clients don't send anything else, but reading is needed to handle
+	// control messages and to end the subscription when the client goes away.
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+	go func() {
+		select {
+		case <-s.shutdownCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	stream := &webSocketServerStream{
+		ctx:     webSocketContext(ctx, r, req.Metadata),
+		conn:    conn,
+		request: req.Subscribe,
+	}
+	closeWebSocket(conn, s.serveWebSocketStream(client.API_Subscribe_FullMethodName, stream))
+}
+
+// serveWebSocketStream handles the given streaming RPC of the API service on
+// the given stream, chaining the gRPC server's stream interceptors.
+func (s *Server) serveWebSocketStream(fullMethod string, stream grpc.ServerStream) error {
+	var desc *grpc.StreamDesc
+	for i, d := range client.API_ServiceDesc.Streams {
+		if "/"+client.API_ServiceDesc.ServiceName+"/"+d.StreamName == fullMethod {
+			desc = &client.API_ServiceDesc.Streams[i]
+			break
+		}
+	}
+	if desc == nil {
+		return status.Errorf(codes.Unimplemented, "Unknown method %s", fullMethod)
+	}
+
+	info := &grpc.StreamServerInfo{
+		FullMethod:     fullMethod,
+		IsClientStream: desc.ClientStreams,
+		IsServerStream: desc.ServerStreams,
+	}
+	handler := desc.Handler
+	for i := len(s.streamInterceptors) - 1; i >= 0; i-- {
+		interceptor, next := s.streamInterceptors[i], handler
+		handler = func(srv interface{}, ss grpc.ServerStream) error {
+			return interceptor(srv, ss, info, next)
+		}
+	}
+	return handler(s.api, stream)
+}
+
+// webSocketContext returns the context of an RPC served over the WebSocket
+// connection upgraded from the given request, carrying the given request
+// metadata and the peer the request came from like a gRPC context.
+func webSocketContext(ctx context.Context, r *http.Request, md map[string]string) context.Context {
+	p := &peer.Peer{Addr: webSocketAddr(r.RemoteAddr)}
+	if local, ok := r.Context().Value(http.LocalAddrContextKey).(net.Addr); ok {
+		p.LocalAddr = local
+	}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+	}
+	return metadata.NewIncomingContext(peer.NewContext(ctx, p), metadata.New(md))
+}
+
+// webSocketAddr parses the given remote address of an HTTP request.
+func webSocketAddr(address string) net.Addr {
+	addr, err := net.ResolveTCPAddr("tcp", address)
+	if err != nil {
+		return &net.TCPAddr{}
+	}
+	return addr
+}
+
+// closeWebSocket sends a close message for the given error, or a normal
+// closure if it's nil, on the connection.
+func closeWebSocket(conn *websocket.Conn, err error) {
+	code, text := websocket.CloseNormalClosure, ""
+	if err != nil {
+		st := status.Convert(err)
+		code, text = webSocketCloseCodeBase+int(st.Code()), st.Message()
+		// Close messages are limited to a single control frame.
+		if len(text) > 120 {
+			text = text[:120]
+		}
+	}
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text),
+		time.Now().Add(webSocketCloseTimeout))
+}
+
+// webSocketServerStream is a grpc.ServerStream which translates a
+// server-streaming RPC to a WebSocket connection. The request is the
+// subscribe request the client sent on the connection and responses are sent
+// as JSON text messages.
+type webSocketServerStream struct {
+	ctx     context.Context
+	conn    *websocket.Conn
+	request json.RawMessage // nil once received
+	created bool            // Set once the subscription created message is sent
+}
+
+// SetHeader is a no-op since the WebSocket connection is already established.
+func (w *webSocketServerStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+// SendHeader is a no-op since the WebSocket connection is already
+// established.
+func (w *webSocketServerStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+// SetTrailer is a no-op since the connection is closed with just the status.
+func (w *webSocketServerStream) SetTrailer(metadata.MD) {}
+
+// Context returns the context of the RPC.
+func (w *webSocketServerStream) Context() context.Context {
+	return w.ctx
+}
+
+// SendMsg sends the given message to the client as JSON. The empty message
+// Subscribe sends first to signal the subscription was created is dropped
+// since WebSocket clients only receive messages from the partition.
+func (w *webSocketServerStream) SendMsg(m interface{}) error {
+	msg, ok := m.(*client.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "Unexpected message type %T", m)
+	}
+	if !w.created {
+		w.created = true
+		return nil
+	}
+	return w.conn.WriteJSON(&webSocketMessage{
+		Stream:    msg.Stream,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   msg.Headers,
+		Timestamp: msg.Timestamp,
+	})
+}
+
+// RecvMsg decodes the request the client sent into the given message. The
+// client sends a single request, so io.EOF is returned after the first call.
+func (w *webSocketServerStream) RecvMsg(m interface{}) error {
+	if w.request == nil {
+		return io.EOF
+	}
+	msg, ok := m.(protoreflect.ProtoMessage)
+	if !ok {
+		return status.Errorf(codes.Internal, "Unexpected request type %T", m)
+	}
+	request := w.request
+	w.request = nil
+	if err := protojson.Unmarshal(request, msg); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid subscribe request: %v", err)
+	}
+	return nil
+}
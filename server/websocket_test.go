@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	lift "github.com/liftbridge-io/go-liftbridge/v2"
+	client "github.com/liftbridge-io/liftbridge-api/v2/go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// dialWebSocketSubscribe connects to the WebSocket server at the given address
+// and sends the given subscribe request with the given request metadata.
+func dialWebSocketSubscribe(t *testing.T, address, subscribe string, md map[string]string) *websocket.Conn {
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+address+webSocketSubscribePath, nil)
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{
+		"metadata":  md,
+		"subscribe": json.RawMessage(subscribe),
+	}))
+	return conn
+}
+
+// Ensure messages published through gRPC are received in order by a client
+// subscribed over WebSocket.
+func TestWebSocketSubscribe(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.WebSocket.Enabled = true
+	s1Config.WebSocket.ListenAddress = "localhost:5060"
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	lc, err := lift.Connect([]string{"localhost:5050"})
+	require.NoError(t, err)
+	defer lc.Close()
+
+	require.NoError(t, lc.CreateStream(context.Background(), "foo", "foo"))
+
+	conn := dialWebSocketSubscribe(t, "localhost:5060",
+		`{"stream": "foo", "startPosition": "EARLIEST"}`, nil)
+	defer conn.Close()
+
+	for i := 0; i < 10; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		_, err := lc.Publish(ctx, "foo", []byte(strconv.Itoa(i)),
+			lift.Key([]byte("key")), lift.Header("i", []byte(strconv.Itoa(i))))
+		cancel()
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Second)))
+	for i := 0; i < 10; i++ {
+		var msg map[string]interface{}
+		require.NoError(t, conn.ReadJSON(&msg))
+		require.Equal(t, "foo", msg["stream"])
+		require.Equal(t, float64(0), msg["partition"])
+		require.Equal(t, float64(i), msg["offset"])
+		require.Equal(t, base64.StdEncoding.EncodeToString([]byte("key")), msg["key"])
+		require.Equal(t, base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(i))), msg["value"])
+		headers := msg["headers"].(map[string]interface{})
+		require.Equal(t, base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(i))), headers["i"])
+		require.NotZero(t, msg["timestamp"])
+	}
+
+	// Subscribing to a partition which doesn't exist closes the connection
+	// with the gRPC status code.
+	conn = dialWebSocketSubscribe(t, "localhost:5060", `{"stream": "bar"}`, nil)
+	defer conn.Close()
+	_, _, err = conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, webSocketCloseCodeBase+int(codes.NotFound)), err)
+}
+
+// Ensure WebSocket subscriptions are authenticated like gRPC calls.
+func TestWebSocketSubscribeAuth(t *testing.T) {
+	defer cleanupStorage(t)
+
+	// Configure server.
+	s1Config := getTestConfig("a", true, 5050)
+	s1Config.Auth.Enabled = true
+	s1Config.Auth.CredentialsFile = writeCredentialsFile(t)
+	s1Config.WebSocket.Enabled = true
+	s1Config.WebSocket.ListenAddress = "localhost:5060"
+	s1 := runServerWithConfig(t, s1Config)
+	defer s1.Stop()
+
+	getMetadataLeader(t, 10*time.Second, s1)
+
+	_, err := s1.api.CreateStream(context.Background(), &client.CreateStreamRequest{
+		Subject:    "foo",
+		Name:       "foo",
+		Partitions: 1,
+	})
+	require.NoError(t, err)
+	waitForPartition(t, 10*time.Second, "foo", 0, s1)
+
+	conn := dialWebSocketSubscribe(t, "localhost:5060", `{"stream": "foo"}`, nil)
+	defer conn.Close()
+	_, _, err = conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, webSocketCloseCodeBase+int(codes.Unauthenticated)), err)
+
+	creds := base64.StdEncoding.EncodeToString([]byte("alice:secret"))
+	conn = dialWebSocketSubscribe(t, "localhost:5060", `{"stream": "foo"}`,
+		map[string]string{authorizationMetadataKey: fmt.Sprintf("%s %s", saslPlain, creds)})
+	defer conn.Close()
+
+	// The subscription is served, so it stays open until it's closed.
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, _, err = conn.ReadMessage()
+	require.True(t, err.(interface{ Timeout() bool }).Timeout(), err)
+}